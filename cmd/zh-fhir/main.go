@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/zs-health/zh-fhir-go/cmd/zh-fhir/internal/cli"
 	"github.com/zs-health/zh-fhir-go/internal/ig"
@@ -23,17 +24,33 @@ func main() {
 	termServer := flag.Bool("term-server", false, "Start the legacy terminology server")
 	port := flag.Int("port", 8080, "Port for the server")
 	igPath := flag.String("ig", "./BD-Core-FHIR-IG", "Path to the Bangladesh FHIR IG")
+	var packages []string
+	flag.Func("package", "FHIR package to load: a package.tgz, a package directory or name#version from the package cache (repeatable)", func(s string) error {
+		packages = append(packages, s)
+		return nil
+	})
 	flag.Parse()
 
 	if *serverMode {
 		loader := ig.NewLoader()
+		if err := loader.LoadBuiltin(); err != nil {
+			log.Printf("Warning: Failed to load built-in terminology: %v", err)
+		}
+		for _, p := range packages {
+			log.Printf("Loading package %s...", p)
+			if err := loadPackage(loader, p); err != nil {
+				log.Printf("Warning: Failed to load package %s: %v", p, err)
+			}
+		}
 		log.Printf("Loading IG data from %s...", *igPath)
 		if err := loader.LoadFromIG(*igPath); err != nil {
 			log.Printf("Warning: Failed to load IG: %v", err)
 		}
-		log.Printf("Loaded %d CodeSystems and %d ValueSets", len(loader.CodeSystems), len(loader.ValueSets))
+		log.Printf("Loaded %d CodeSystems, %d ValueSets and %d StructureDefinitions from %d packages",
+			len(loader.Registry.List("CodeSystem")), len(loader.Registry.List("ValueSet")),
+			len(loader.Registry.List("StructureDefinition")), len(loader.Packages))
 
-		s := server.NewServer(loader)
+		s := server.NewServer(loader.Registry)
 		s.Start(*port)
 		return
 	}
@@ -48,3 +65,16 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// loadPackage loads a package given as a path, or as name#version from the
+// package cache when no such path exists.
+func loadPackage(loader *ig.Loader, ref string) error {
+	if _, err := os.Stat(ref); err == nil {
+		return loader.LoadPackage(ref)
+	}
+	name, version, ok := strings.Cut(ref, "#")
+	if !ok {
+		version = "latest"
+	}
+	return loader.LoadCachedPackage(name, version)
+}
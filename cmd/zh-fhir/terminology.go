@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
 )
 
 // Concept represents a simple terminology concept
@@ -61,9 +63,12 @@ func StartTerminologyServer(port int) {
 	server.AddConcept("http://id.who.int/icd/release/11/mms", "BA00", "Essential hypertension")
 	server.AddConcept("http://id.who.int/icd/release/11/mms", "1B10", "Tuberculosis of the lung")
 
-	// Add Bangladesh Divisions
-	server.AddConcept("https://health.zarishsphere.com/fhir/ValueSet/bd-divisions", "DH", "Dhaka")
-	server.AddConcept("https://health.zarishsphere.com/fhir/ValueSet/bd-divisions", "CH", "Chattogram")
+	// Add the Bangladesh administrative geography, one list per level
+	for _, vs := range bd.GeographyValueSets() {
+		for _, c := range vs.Compose.Include[0].Concept {
+			server.AddConcept(*vs.URL, c.Code, *c.Display)
+		}
+	}
 
 	http.HandleFunc("/fhir/ValueSet/$expand", server.HandleExpand)
 
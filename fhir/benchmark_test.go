@@ -18,6 +18,9 @@ var (
 	boolPtr   = testutil.BoolPtr
 )
 
+// ptr returns a pointer to v, such as a code of a typed enum.
+func ptr[T any](v T) *T { return &v }
+
 // Benchmark JSON marshaling of a typical Patient resource
 func BenchmarkPatient_Marshal(b *testing.B) {
 	patient := createTestPatient()
@@ -95,7 +98,7 @@ func BenchmarkBundle_Create(b *testing.B) {
 			Type: "searchset",
 		}
 
-		helper := fhir.NewBundleHelper(bundle)
+		helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 		for j := 0; j < 10; j++ {
 			patient := createTestPatient()
 			_ = helper.AddEntry(patient, stringPtr("Patient/"+string(rune('A'+j))))
@@ -106,7 +109,7 @@ func BenchmarkBundle_Create(b *testing.B) {
 // Benchmark Bundle resource lookup
 func BenchmarkBundle_GetResourceByID(b *testing.B) {
 	bundle := createTestBundle(100)
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -117,7 +120,7 @@ func BenchmarkBundle_GetResourceByID(b *testing.B) {
 // Benchmark Bundle reference resolution
 func BenchmarkBundle_ResolveReference(b *testing.B) {
 	bundle := createTestBundle(100)
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -128,7 +131,7 @@ func BenchmarkBundle_ResolveReference(b *testing.B) {
 // Benchmark Bundle type filtering
 func BenchmarkBundle_GetPatients(b *testing.B) {
 	bundle := createTestBundle(100)
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -153,7 +156,7 @@ func BenchmarkObservation_Create(b *testing.B) {
 			},
 		}
 		obs.ID = stringPtr("obs-001")
-		obs.ResourceType = "Observation"
+		obs.BaseResource.ResourceType = "Observation"
 		runtime.KeepAlive(obs)
 	}
 }
@@ -199,7 +202,7 @@ func BenchmarkObservation_WithComponents(b *testing.B) {
 			},
 		}
 		obs.ID = stringPtr("obs-bp-001")
-		obs.ResourceType = "Observation"
+		obs.BaseResource.ResourceType = "Observation"
 		runtime.KeepAlive(obs)
 	}
 }
@@ -211,16 +214,16 @@ func createTestPatient() *r5.Patient {
 		Active: boolPtr(true),
 		Name: []r5.HumanName{
 			{
-				Use:    stringPtr("official"),
+				Use:    ptr(r5.NameUseOfficial),
 				Family: stringPtr("Doe"),
 				Given:  []string{"John"},
 			},
 		},
-		Gender:    stringPtr("male"),
+		Gender:    ptr(r5.AdministrativeGenderMale),
 		BirthDate: &birthDate,
 		Telecom: []r5.ContactPoint{
 			{
-				System: stringPtr("phone"),
+				System: ptr(r5.ContactPointSystemPhone),
 				Value:  stringPtr("555-1234"),
 			},
 		},
@@ -235,7 +238,7 @@ func createTestPatient() *r5.Patient {
 	}
 	// Set ID on embedded Resource struct
 	patient.ID = stringPtr("example")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 	return patient
 }
 
@@ -244,7 +247,7 @@ func createTestBundle(size int) *fhir.Bundle {
 		Type: "searchset",
 	}
 
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	for i := 0; i < size; i++ {
 		patient := createTestPatient()
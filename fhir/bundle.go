@@ -4,13 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
 // Bundle represents a FHIR Bundle resource.
 type Bundle struct {
 	DomainResource
 	Type      string        `json:"type" fhir:"cardinality=1..1,required"`
-	Total     *int          `json:"total,omitempty" fhir:"cardinality=0..1,summary"`
+	Total     *int          `json:"total,omitempty" fhir:"cardinality=0..1,summary,type=unsignedInt"`
 	Link      []BundleLink  `json:"link,omitempty" fhir:"cardinality=0..*,summary"`
 	Entry     []BundleEntry `json:"entry,omitempty" fhir:"cardinality=0..*,summary"`
 	Signature *string       `json:"signature,omitempty" fhir:"cardinality=0..1"`
@@ -19,14 +21,14 @@ type Bundle struct {
 // BundleLink represents a link in a bundle.
 type BundleLink struct {
 	Relation string `json:"relation" fhir:"cardinality=1..1,required"`
-	URL      string `json:"url" fhir:"cardinality=1..1,required"`
+	URL      string `json:"url" fhir:"cardinality=1..1,required,type=uri"`
 }
 
 // BundleEntry represents an entry in a bundle.
 type BundleEntry struct {
 	Link     []BundleLink         `json:"link,omitempty" fhir:"cardinality=0..*"`
 	Resource json.RawMessage      `json:"resource,omitempty" fhir:"cardinality=0..1,summary"`
-	FullURL  *string              `json:"fullUrl,omitempty" fhir:"cardinality=0..1,summary"`
+	FullURL  *string              `json:"fullUrl,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	Search   *BundleEntrySearch   `json:"search,omitempty" fhir:"cardinality=0..1,summary"`
 	Request  *BundleEntryRequest  `json:"request,omitempty" fhir:"cardinality=0..1,summary"`
 	Response *BundleEntryResponse `json:"response,omitempty" fhir:"cardinality=0..1,summary"`
@@ -34,8 +36,8 @@ type BundleEntry struct {
 
 // BundleEntrySearch represents search metadata in a bundle entry.
 type BundleEntrySearch struct {
-	Mode  *string  `json:"mode,omitempty" fhir:"cardinality=0..1,summary"`
-	Score *float64 `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
+	Mode  *string             `json:"mode,omitempty" fhir:"cardinality=0..1,summary"`
+	Score *primitives.Decimal `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
 // BundleEntryRequest represents request information in a bundle entry.
@@ -45,88 +47,130 @@ type BundleEntryRequest struct {
 	IfMatch         *string `json:"ifMatch,omitempty" fhir:"cardinality=0..1,summary"`
 	IfNoneExist     *string `json:"ifNoneExist,omitempty" fhir:"cardinality=0..1,summary"`
 	Method          string  `json:"method" fhir:"cardinality=1..1,required,summary"`
-	URL             string  `json:"url" fhir:"cardinality=1..1,required,summary"`
+	URL             string  `json:"url" fhir:"cardinality=1..1,required,summary,type=uri"`
 }
 
 // BundleEntryResponse represents response information in a bundle entry.
 type BundleEntryResponse struct {
 	Status       string          `json:"status" fhir:"cardinality=1..1,required,summary"`
-	Location     *string         `json:"location,omitempty" fhir:"cardinality=0..1,summary"`
+	Location     *string         `json:"location,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	Etag         *string         `json:"etag,omitempty" fhir:"cardinality=0..1,summary"`
 	LastModified *string         `json:"lastModified,omitempty" fhir:"cardinality=0..1,summary"`
 	Outcome      json.RawMessage `json:"outcome,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
-// BundleHelper provides utilities for working with FHIR Bundles.
-type BundleHelper struct {
-	bundle *Bundle
+// BundleHelper provides utilities for working with FHIR Bundles. It
+// unmarshals entries into the resources of a FHIR version, R, with the
+// UnmarshalAny function of the version package.
+type BundleHelper[R AnyResource] struct {
+	bundle    *Bundle
+	unmarshal func(json.RawMessage) (R, error)
+}
+
+// NewBundleHelper creates a new BundleHelper for the given bundle, whose
+// entries are unmarshaled with unmarshal.
+//
+// Example:
+//
+//	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
+//	patients, err := helper.GetPatients() // []r5.Resource holding *r5.Patient
+func NewBundleHelper[R AnyResource](bundle *Bundle, unmarshal func(json.RawMessage) (R, error)) *BundleHelper[R] {
+	return &BundleHelper[R]{bundle: bundle, unmarshal: unmarshal}
 }
 
-// NewBundleHelper creates a new BundleHelper for the given bundle.
-func NewBundleHelper(bundle *Bundle) *BundleHelper {
-	return &BundleHelper{bundle: bundle}
+// resourceHeader is the part of an entry's resource read to find it
+// without unmarshaling all of it.
+type resourceHeader struct {
+	ResourceType string `json:"resourceType"`
+	ID           string `json:"id"`
+}
+
+// header reads the type and id of a resource.
+func header(raw json.RawMessage) (resourceHeader, error) {
+	var h resourceHeader
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return h, fmt.Errorf("failed to parse resource: %w", err)
+	}
+	return h, nil
+}
+
+// resource unmarshals the resource of an entry.
+func (h *BundleHelper[R]) resource(raw json.RawMessage) (R, error) {
+	resource, err := h.unmarshal(raw)
+	if err != nil {
+		var zero R
+		return zero, fmt.Errorf("failed to unmarshal resource: %w", err)
+	}
+	return resource, nil
 }
 
 // FindResourcesByType returns all resources of the specified type from the bundle.
 // resourceType should be the FHIR resource type name (e.g., "Patient", "Observation").
-func (h *BundleHelper) FindResourcesByType(resourceType string) ([]json.RawMessage, error) {
-	var resources []json.RawMessage
+func (h *BundleHelper[R]) FindResourcesByType(resourceType string) ([]R, error) {
+	var resources []R
 
 	for _, entry := range h.bundle.Entry {
 		if entry.Resource == nil {
 			continue
 		}
 
-		// Parse resource to check type
-		var resource map[string]interface{}
-		if err := json.Unmarshal(entry.Resource, &resource); err != nil {
-			return nil, fmt.Errorf("failed to parse resource: %w", err)
+		// Parse the resource type before unmarshaling all of it
+		hdr, err := header(entry.Resource)
+		if err != nil {
+			return nil, err
+		}
+		if hdr.ResourceType != resourceType {
+			continue
 		}
 
-		if resType, ok := resource["resourceType"].(string); ok && resType == resourceType {
-			resources = append(resources, entry.Resource)
+		resource, err := h.resource(entry.Resource)
+		if err != nil {
+			return nil, err
 		}
+		resources = append(resources, resource)
 	}
 
 	return resources, nil
 }
 
 // GetResourceByID finds a resource by its ID and type.
-// Returns the resource as RawMessage, or nil if not found.
-func (h *BundleHelper) GetResourceByID(resourceType, id string) (json.RawMessage, error) {
+// Returns the resource, or nil if not found.
+func (h *BundleHelper[R]) GetResourceByID(resourceType, id string) (R, error) {
+	var zero R
 	for _, entry := range h.bundle.Entry {
 		if entry.Resource == nil {
 			continue
 		}
 
-		var resource map[string]interface{}
-		if err := json.Unmarshal(entry.Resource, &resource); err != nil {
-			return nil, fmt.Errorf("failed to parse resource: %w", err)
+		hdr, err := header(entry.Resource)
+		if err != nil {
+			return zero, err
 		}
 
-		resType, typeOk := resource["resourceType"].(string)
-		resID, idOk := resource["id"].(string)
-
-		if typeOk && idOk && resType == resourceType && resID == id {
-			return entry.Resource, nil
+		if hdr.ResourceType == resourceType && hdr.ID == id {
+			return h.resource(entry.Resource)
 		}
 	}
 
-	return nil, nil // Not found
+	return zero, nil // Not found
 }
 
 // ResolveReference resolves a FHIR reference to the actual resource in the bundle.
 // reference should be in the format "ResourceType/id" or a fullUrl.
-// Returns the resource as RawMessage, or nil if not found.
-func (h *BundleHelper) ResolveReference(reference string) (json.RawMessage, error) {
+// Returns the resource, or nil if not found.
+func (h *BundleHelper[R]) ResolveReference(reference string) (R, error) {
+	var zero R
 	if reference == "" {
-		return nil, fmt.Errorf("empty reference")
+		return zero, fmt.Errorf("empty reference")
 	}
 
 	// Try to resolve by fullUrl first
 	for _, entry := range h.bundle.Entry {
 		if entry.FullURL != nil && *entry.FullURL == reference {
-			return entry.Resource, nil
+			if entry.Resource == nil {
+				return zero, nil
+			}
+			return h.resource(entry.Resource)
 		}
 	}
 
@@ -138,12 +182,12 @@ func (h *BundleHelper) ResolveReference(reference string) (json.RawMessage, erro
 		return h.GetResourceByID(resourceType, id)
 	}
 
-	return nil, fmt.Errorf("reference not found: %s", reference)
+	return zero, fmt.Errorf("reference not found: %s", reference)
 }
 
 // AddEntry adds a new entry to the bundle with the given resource.
 // The resource will be marshaled to JSON.
-func (h *BundleHelper) AddEntry(resource interface{}, fullURL *string) error {
+func (h *BundleHelper[R]) AddEntry(resource interface{}, fullURL *string) error {
 	data, err := json.Marshal(resource)
 	if err != nil {
 		return fmt.Errorf("failed to marshal resource: %w", err)
@@ -168,63 +212,68 @@ func (h *BundleHelper) AddEntry(resource interface{}, fullURL *string) error {
 }
 
 // GetPatients returns all Patient resources from the bundle.
-func (h *BundleHelper) GetPatients() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetPatients() ([]R, error) {
 	return h.FindResourcesByType("Patient")
 }
 
 // GetObservations returns all Observation resources from the bundle.
-func (h *BundleHelper) GetObservations() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetObservations() ([]R, error) {
 	return h.FindResourcesByType("Observation")
 }
 
 // GetPractitioners returns all Practitioner resources from the bundle.
-func (h *BundleHelper) GetPractitioners() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetPractitioners() ([]R, error) {
 	return h.FindResourcesByType("Practitioner")
 }
 
 // GetOrganizations returns all Organization resources from the bundle.
-func (h *BundleHelper) GetOrganizations() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetOrganizations() ([]R, error) {
 	return h.FindResourcesByType("Organization")
 }
 
 // GetMedications returns all Medication resources from the bundle.
-func (h *BundleHelper) GetMedications() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetMedications() ([]R, error) {
 	return h.FindResourcesByType("Medication")
 }
 
 // GetEncounters returns all Encounter resources from the bundle.
-func (h *BundleHelper) GetEncounters() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetEncounters() ([]R, error) {
 	return h.FindResourcesByType("Encounter")
 }
 
 // GetConditions returns all Condition resources from the bundle.
-func (h *BundleHelper) GetConditions() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetConditions() ([]R, error) {
 	return h.FindResourcesByType("Condition")
 }
 
 // GetProcedures returns all Procedure resources from the bundle.
-func (h *BundleHelper) GetProcedures() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetProcedures() ([]R, error) {
 	return h.FindResourcesByType("Procedure")
 }
 
 // GetDiagnosticReports returns all DiagnosticReport resources from the bundle.
-func (h *BundleHelper) GetDiagnosticReports() ([]json.RawMessage, error) {
+func (h *BundleHelper[R]) GetDiagnosticReports() ([]R, error) {
 	return h.FindResourcesByType("DiagnosticReport")
 }
 
 // GetAllResources returns all resources from the bundle, regardless of type.
-func (h *BundleHelper) GetAllResources() []json.RawMessage {
-	var resources []json.RawMessage
+func (h *BundleHelper[R]) GetAllResources() ([]R, error) {
+	var resources []R
 	for _, entry := range h.bundle.Entry {
-		if entry.Resource != nil {
-			resources = append(resources, entry.Resource)
+		if entry.Resource == nil {
+			continue
+		}
+		resource, err := h.resource(entry.Resource)
+		if err != nil {
+			return nil, err
 		}
+		resources = append(resources, resource)
 	}
-	return resources
+	return resources, nil
 }
 
 // GetResourceTypes returns a list of unique resource types in the bundle.
-func (h *BundleHelper) GetResourceTypes() ([]string, error) {
+func (h *BundleHelper[R]) GetResourceTypes() ([]string, error) {
 	typeMap := make(map[string]bool)
 
 	for _, entry := range h.bundle.Entry {
@@ -232,13 +281,13 @@ func (h *BundleHelper) GetResourceTypes() ([]string, error) {
 			continue
 		}
 
-		var resource map[string]interface{}
-		if err := json.Unmarshal(entry.Resource, &resource); err != nil {
-			return nil, fmt.Errorf("failed to parse resource: %w", err)
+		hdr, err := header(entry.Resource)
+		if err != nil {
+			return nil, err
 		}
 
-		if resType, ok := resource["resourceType"].(string); ok {
-			typeMap[resType] = true
+		if hdr.ResourceType != "" {
+			typeMap[hdr.ResourceType] = true
 		}
 	}
 
@@ -251,21 +300,31 @@ func (h *BundleHelper) GetResourceTypes() ([]string, error) {
 }
 
 // Count returns the number of entries in the bundle.
-func (h *BundleHelper) Count() int {
+func (h *BundleHelper[R]) Count() int {
 	return len(h.bundle.Entry)
 }
 
 // CountByType returns the number of resources of the specified type.
-func (h *BundleHelper) CountByType(resourceType string) (int, error) {
-	resources, err := h.FindResourcesByType(resourceType)
-	if err != nil {
-		return 0, err
+func (h *BundleHelper[R]) CountByType(resourceType string) (int, error) {
+	count := 0
+	for _, entry := range h.bundle.Entry {
+		if entry.Resource == nil {
+			continue
+		}
+
+		hdr, err := header(entry.Resource)
+		if err != nil {
+			return 0, err
+		}
+		if hdr.ResourceType == resourceType {
+			count++
+		}
 	}
-	return len(resources), nil
+	return count, nil
 }
 
 // GetNextLink returns the URL for the next page of results, if available.
-func (h *BundleHelper) GetNextLink() *string {
+func (h *BundleHelper[R]) GetNextLink() *string {
 	for _, link := range h.bundle.Link {
 		if link.Relation == "next" {
 			return &link.URL
@@ -275,7 +334,7 @@ func (h *BundleHelper) GetNextLink() *string {
 }
 
 // GetPreviousLink returns the URL for the previous page of results, if available.
-func (h *BundleHelper) GetPreviousLink() *string {
+func (h *BundleHelper[R]) GetPreviousLink() *string {
 	for _, link := range h.bundle.Link {
 		if link.Relation == "previous" || link.Relation == "prev" {
 			return &link.URL
@@ -285,7 +344,7 @@ func (h *BundleHelper) GetPreviousLink() *string {
 }
 
 // GetSelfLink returns the self link URL, if available.
-func (h *BundleHelper) GetSelfLink() *string {
+func (h *BundleHelper[R]) GetSelfLink() *string {
 	for _, link := range h.bundle.Link {
 		if link.Relation == "self" {
 			return &link.URL
@@ -6,6 +6,25 @@ import (
 	"testing"
 )
 
+// testResource is a resource of any type, for testing the BundleHelper
+// without a version package.
+type testResource struct {
+	Resource
+	Active *bool `json:"active,omitempty"`
+}
+
+func (r *testResource) ResourceType() string {
+	return r.Resource.ResourceType
+}
+
+func unmarshalTestResource(raw json.RawMessage) (*testResource, error) {
+	var r testResource
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
 func createTestBundle() *Bundle {
 	patient1 := map[string]interface{}{
 		"resourceType": "Patient",
@@ -62,7 +81,7 @@ func createTestBundle() *Bundle {
 
 func TestBundleHelper_FindResourcesByType(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Find patients
 	patients, err := helper.FindResourcesByType("Patient")
@@ -94,7 +113,7 @@ func TestBundleHelper_FindResourcesByType(t *testing.T) {
 
 func TestBundleHelper_GetResourceByID(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Find existing patient
 	resource, err := helper.GetResourceByID("Patient", "patient-1")
@@ -105,10 +124,8 @@ func TestBundleHelper_GetResourceByID(t *testing.T) {
 		t.Fatal("Expected resource, got nil")
 	}
 
-	var patient map[string]interface{}
-	_ = json.Unmarshal(resource, &patient)
-	if patient["id"] != "patient-1" {
-		t.Errorf("Expected id 'patient-1', got %v", patient["id"])
+	if resource.ResourceType() != "Patient" || *resource.GetID() != "patient-1" || !*resource.Active {
+		t.Errorf("Expected active Patient 'patient-1', got %+v", resource)
 	}
 
 	// Find non-existent resource
@@ -123,7 +140,7 @@ func TestBundleHelper_GetResourceByID(t *testing.T) {
 
 func TestBundleHelper_ResolveReference(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	tests := []struct {
 		name      string
@@ -182,7 +199,7 @@ func TestBundleHelper_AddEntry(t *testing.T) {
 		Type:  "collection",
 		Entry: []BundleEntry{},
 	}
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	initialCount := helper.Count()
 
@@ -222,7 +239,7 @@ func TestBundleHelper_AddEntry(t *testing.T) {
 
 func TestBundleHelper_TypeSpecificGetters(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Test GetPatients
 	patients, err := helper.GetPatients()
@@ -254,9 +271,12 @@ func TestBundleHelper_TypeSpecificGetters(t *testing.T) {
 
 func TestBundleHelper_GetAllResources(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
-	resources := helper.GetAllResources()
+	resources, err := helper.GetAllResources()
+	if err != nil {
+		t.Fatalf("GetAllResources() error = %v", err)
+	}
 	if len(resources) != 3 {
 		t.Errorf("GetAllResources() = %d, want 3", len(resources))
 	}
@@ -264,7 +284,7 @@ func TestBundleHelper_GetAllResources(t *testing.T) {
 
 func TestBundleHelper_GetResourceTypes(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	types, err := helper.GetResourceTypes()
 	if err != nil {
@@ -297,7 +317,7 @@ func TestBundleHelper_GetResourceTypes(t *testing.T) {
 
 func TestBundleHelper_Count(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	count := helper.Count()
 	if count != 3 {
@@ -307,7 +327,7 @@ func TestBundleHelper_Count(t *testing.T) {
 
 func TestBundleHelper_CountByType(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Count patients
 	count, err := helper.CountByType("Patient")
@@ -339,7 +359,7 @@ func TestBundleHelper_CountByType(t *testing.T) {
 
 func TestBundleHelper_GetLinks(t *testing.T) {
 	bundle := createTestBundle()
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Test GetNextLink
 	nextLink := helper.GetNextLink()
@@ -376,7 +396,7 @@ func TestBundleHelper_EmptyBundle(t *testing.T) {
 		Type:  "searchset",
 		Entry: []BundleEntry{},
 	}
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Test with empty bundle
 	if helper.Count() != 0 {
@@ -406,7 +426,7 @@ func TestBundleHelper_RoundTrip(t *testing.T) {
 		Entry: []BundleEntry{},
 	}
 
-	helper := NewBundleHelper(bundle)
+	helper := NewBundleHelper(bundle, unmarshalTestResource)
 
 	// Add multiple resources
 	patient := map[string]interface{}{
@@ -434,7 +454,7 @@ func TestBundleHelper_RoundTrip(t *testing.T) {
 	}
 
 	// Create helper for loaded bundle
-	loadedHelper := NewBundleHelper(&loaded)
+	loadedHelper := NewBundleHelper(&loaded, unmarshalTestResource)
 
 	// Verify contents
 	if loadedHelper.Count() != 2 {
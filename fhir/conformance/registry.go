@@ -0,0 +1,297 @@
+// Package conformance stores canonical resources, such as StructureDefinition,
+// ValueSet and CodeSystem, by URL and version, and resolves canonical
+// references (url or url|version) to them.
+//
+// A Registry can hold resources of several FHIR releases side by side. Each
+// entry records the release it was written for, so that R4 and R5 copies of
+// the same canonical resource can be told apart. Adding a second, different
+// resource under a URL and version already taken is a conflict: the newer one
+// replaces the older and the conflict is recorded.
+package conformance
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Entry is a canonical resource held by a Registry.
+type Entry struct {
+	ResourceType string
+	URL          string
+	// Version is the business version of the resource, which may be empty.
+	Version string
+	// FHIRVersion is the FHIR release the resource was written for, such as
+	// 4.0.1, or empty if unknown.
+	FHIRVersion string
+	// Source describes where the resource came from, such as a package id
+	// or a file name.
+	Source string
+	// Resource is the resource itself, usually a generated struct such as
+	// *r4.CodeSystem.
+	Resource any
+
+	seq int // order of addition, used to rank unversioned entries
+}
+
+// Canonical returns url|version, or the URL if there is no version.
+func (e *Entry) Canonical() string {
+	if e.Version == "" {
+		return e.URL
+	}
+	return e.URL + "|" + e.Version
+}
+
+// Conflict records two different resources added under the same canonical.
+type Conflict struct {
+	Existing *Entry
+	Added    *Entry
+}
+
+// Error describes the conflict.
+func (c *Conflict) Error() string {
+	what := "a different resource"
+	if c.Existing.ResourceType != c.Added.ResourceType {
+		what = "a " + c.Added.ResourceType + " over a " + c.Existing.ResourceType
+	}
+	return fmt.Sprintf("%s: %s replaces %s from %s", c.Added.Canonical(), sourceOf(c.Added), what, sourceOf(c.Existing))
+}
+
+func sourceOf(e *Entry) string {
+	if e.Source == "" {
+		return "an unnamed source"
+	}
+	return e.Source
+}
+
+// Registry stores canonical resources. It is safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	byURL     map[string][]*Entry
+	conflicts []*Conflict
+	seq       int
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{byURL: make(map[string][]*Entry)}
+}
+
+// Add stores an entry. If an entry with the same URL, version and FHIR
+// release exists and holds a different resource, the new entry replaces it
+// and the returned error is a *Conflict. Adding an identical resource again
+// is not a conflict.
+func (r *Registry) Add(e *Entry) error {
+	if e.URL == "" {
+		return fmt.Errorf("%s has no url", e.ResourceType)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	e.seq = r.seq
+	entries := r.byURL[e.URL]
+	for i, old := range entries {
+		if old.Version != e.Version || release(old.FHIRVersion) != release(e.FHIRVersion) {
+			continue
+		}
+		entries[i] = e
+		if old.ResourceType == e.ResourceType && reflect.DeepEqual(old.Resource, e.Resource) {
+			return nil
+		}
+		c := &Conflict{Existing: old, Added: e}
+		r.conflicts = append(r.conflicts, c)
+		return c
+	}
+	r.byURL[e.URL] = append(entries, e)
+	return nil
+}
+
+// Register adds a generated resource struct, such as *r4.ValueSet, reading
+// its resource type, url and version from the struct.
+func (r *Registry) Register(resource any, fhirVersion, source string) error {
+	e, err := NewEntry(resource)
+	if err != nil {
+		return err
+	}
+	e.FHIRVersion = fhirVersion
+	e.Source = source
+	return r.Add(e)
+}
+
+// NewEntry makes an entry for a generated resource struct. The resource type
+// is the struct's type name; the url and version come from its URL and
+// Version fields.
+func NewEntry(resource any) (*Entry, error) {
+	v := reflect.ValueOf(resource)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("nil resource")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a resource struct", resource)
+	}
+	return &Entry{
+		ResourceType: v.Type().Name(),
+		URL:          stringField(v, "URL"),
+		Version:      stringField(v, "Version"),
+		Resource:     resource,
+	}, nil
+}
+
+// stringField returns a string or *string field, or "" if it is absent.
+func stringField(v reflect.Value, name string) string {
+	f := v.FieldByName(name)
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	if f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
+
+// Resolve returns the entry a canonical reference points to. resourceType
+// restricts the result unless it is empty. A reference without a version
+// resolves to the latest version; see Latest. A version may also be partial,
+// such as 1.2 or 1.x, in which case the latest matching version is chosen.
+func (r *Registry) Resolve(resourceType, canonical string) (*Entry, bool) {
+	return r.ResolveFHIR(resourceType, canonical, "")
+}
+
+// ResolveFHIR is Resolve limited to entries written for the same FHIR
+// release as fhirVersion (4.0 for 4.0.1). Entries with no FHIR version match
+// any release. An empty fhirVersion matches everything.
+func (r *Registry) ResolveFHIR(resourceType, canonical, fhirVersion string) (*Entry, bool) {
+	url, ver, _ := strings.Cut(canonical, "|")
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var candidates []*Entry
+	for _, e := range r.byURL[url] {
+		if resourceType != "" && e.ResourceType != resourceType {
+			continue
+		}
+		if fhirVersion != "" && e.FHIRVersion != "" && release(e.FHIRVersion) != release(fhirVersion) {
+			continue
+		}
+		if ver != "" && e.Version == ver {
+			return e, true
+		}
+		if MatchVersion(ver, e.Version) {
+			candidates = append(candidates, e)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, false
+	}
+	return Latest(candidates), true
+}
+
+// Latest returns the newest of entries that share a URL. Releases win over
+// pre-releases, which win over versions that are not semantic versions;
+// otherwise higher versions win. Between entries that rank the same, such as
+// unversioned ones, the one added last wins.
+func Latest(entries []*Entry) *Entry {
+	var best *Entry
+	for _, e := range entries {
+		if best == nil || newer(e, best) {
+			best = e
+		}
+	}
+	return best
+}
+
+func newer(a, b *Entry) bool {
+	va, oka := parseVersion(a.Version)
+	vb, okb := parseVersion(b.Version)
+	rank := func(v version, ok bool) int {
+		switch {
+		case !ok:
+			return 0
+		case v.pre != "":
+			return 1
+		}
+		return 2
+	}
+	ra, rb := rank(va, oka), rank(vb, okb)
+	if ra != rb {
+		return ra > rb
+	}
+	if oka && okb {
+		if c := va.compare(vb); c != 0 {
+			return c > 0
+		}
+	}
+	return a.seq > b.seq
+}
+
+// Versions returns every entry for url, newest first.
+func (r *Registry) Versions(url string) []*Entry {
+	r.mu.RLock()
+	entries := append([]*Entry(nil), r.byURL[url]...)
+	r.mu.RUnlock()
+	sort.SliceStable(entries, func(i, j int) bool { return newer(entries[i], entries[j]) })
+	return entries
+}
+
+// List returns the latest entry of each URL holding resources of
+// resourceType, or of every type if resourceType is empty, ordered by URL.
+func (r *Registry) List(resourceType string) []*Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	urls := make([]string, 0, len(r.byURL))
+	for url := range r.byURL {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	var out []*Entry
+	for _, url := range urls {
+		var matching []*Entry
+		for _, e := range r.byURL[url] {
+			if resourceType == "" || e.ResourceType == resourceType {
+				matching = append(matching, e)
+			}
+		}
+		if len(matching) > 0 {
+			out = append(out, Latest(matching))
+		}
+	}
+	return out
+}
+
+// Len returns the number of entries.
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	n := 0
+	for _, entries := range r.byURL {
+		n += len(entries)
+	}
+	return n
+}
+
+// Conflicts returns the conflicts found so far, in the order they occurred.
+func (r *Registry) Conflicts() []*Conflict {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*Conflict(nil), r.conflicts...)
+}
+
+// release returns the major.minor part of a FHIR version: 4.0 for 4.0.1.
+func release(fhirVersion string) string {
+	parts := strings.SplitN(fhirVersion, ".", 3)
+	if len(parts) < 2 {
+		return fhirVersion
+	}
+	return parts[0] + "." + parts[1]
+}
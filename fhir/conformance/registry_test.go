@@ -0,0 +1,138 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func addValueSets(t *testing.T, r *Registry, url string, versions ...string) {
+	t.Helper()
+	for _, v := range versions {
+		vs := &r4.ValueSet{URL: ptr(url)}
+		if v != "" {
+			vs.Version = ptr(v)
+		}
+		require.NoError(t, r.Register(vs, "4.0.1", "test"))
+	}
+}
+
+func TestRegistryResolve(t *testing.T) {
+	const url = "http://example.org/ValueSet/a"
+	r := NewRegistry()
+	addValueSets(t, r, url, "1.0.0", "1.2.0", "2.0.0-ballot", "1.10.0", "1.2.1")
+
+	tests := []struct {
+		canonical string
+		want      string
+	}{
+		{url, "1.10.0"},
+		{url + "|1.2.0", "1.2.0"},
+		{url + "|1.2", "1.2.1"},
+		{url + "|1.x", "1.10.0"},
+		{url + "|2.0.0-ballot", "2.0.0-ballot"},
+		{url + "|latest", "1.10.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.canonical, func(t *testing.T) {
+			e, ok := r.Resolve("ValueSet", tt.canonical)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, e.Version)
+			assert.Equal(t, tt.want, *e.Resource.(*r4.ValueSet).Version)
+		})
+	}
+
+	_, ok := r.Resolve("ValueSet", url+"|3.0.0")
+	assert.False(t, ok)
+	_, ok = r.Resolve("CodeSystem", url)
+	assert.False(t, ok, "the resource type must match")
+	_, ok = r.Resolve("", url)
+	assert.True(t, ok, "an empty resource type matches any")
+}
+
+func TestRegistryLatest(t *testing.T) {
+	r := NewRegistry()
+	addValueSets(t, r, "http://example.org/pre", "1.0.0-ballot", "0.9.0")
+	addValueSets(t, r, "http://example.org/dated", "draft", "0.1.0")
+	addValueSets(t, r, "http://example.org/unversioned", "", "")
+
+	e, _ := r.Resolve("ValueSet", "http://example.org/pre")
+	assert.Equal(t, "0.9.0", e.Version, "releases win over pre-releases")
+	e, _ = r.Resolve("ValueSet", "http://example.org/dated")
+	assert.Equal(t, "0.1.0", e.Version, "semantic versions win over others")
+
+	versions := r.Versions("http://example.org/pre")
+	require.Len(t, versions, 2)
+	assert.Equal(t, "1.0.0-ballot", versions[1].Version)
+	assert.Len(t, r.Versions("http://example.org/unversioned"), 1, "the same resource twice is one entry")
+}
+
+func TestRegistryFHIRVersions(t *testing.T) {
+	const url = "http://example.org/CodeSystem/a"
+	r := NewRegistry()
+	require.NoError(t, r.Register(&r4.CodeSystem{URL: ptr(url), Version: ptr("1.0.0")}, "4.0.1", "r4"))
+	require.NoError(t, r.Register(&r5.CodeSystem{URL: ptr(url), Version: ptr("1.0.0")}, "5.0.0", "r5"),
+		"the same version for another FHIR release is not a conflict")
+
+	e, ok := r.ResolveFHIR("CodeSystem", url+"|1.0.0", "4.0.0")
+	require.True(t, ok)
+	assert.IsType(t, &r4.CodeSystem{}, e.Resource)
+	e, ok = r.ResolveFHIR("CodeSystem", url, "5.0.0")
+	require.True(t, ok)
+	assert.IsType(t, &r5.CodeSystem{}, e.Resource)
+	_, ok = r.ResolveFHIR("CodeSystem", url, "4.3.0")
+	assert.False(t, ok)
+	assert.Empty(t, r.Conflicts())
+}
+
+func TestRegistryConflicts(t *testing.T) {
+	const url = "http://example.org/ValueSet/a"
+	r := NewRegistry()
+	require.NoError(t, r.Register(&r4.ValueSet{URL: ptr(url), Version: ptr("1.0.0"), Title: ptr("A")}, "4.0.1", "one#1.0.0"))
+	require.NoError(t, r.Register(&r4.ValueSet{URL: ptr(url), Version: ptr("1.0.0"), Title: ptr("A")}, "4.0.1", "two#1.0.0"),
+		"an identical resource is not a conflict")
+
+	err := r.Register(&r4.ValueSet{URL: ptr(url), Version: ptr("1.0.0"), Title: ptr("B")}, "4.0.1", "three#1.0.0")
+	var conflict *Conflict
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, "http://example.org/ValueSet/a|1.0.0: three#1.0.0 replaces a different resource from two#1.0.0", err.Error())
+
+	err = r.Register(&r4.CodeSystem{URL: ptr(url), Version: ptr("1.0.0")}, "4.0.1", "")
+	assert.EqualError(t, err, "http://example.org/ValueSet/a|1.0.0: an unnamed source replaces a CodeSystem over a ValueSet from three#1.0.0")
+
+	assert.Len(t, r.Conflicts(), 2)
+	e, _ := r.Resolve("", url)
+	assert.Equal(t, "CodeSystem", e.ResourceType, "the last resource added wins")
+	assert.Equal(t, 1, r.Len())
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r := NewRegistry()
+	assert.EqualError(t, r.Register(&r4.ValueSet{}, "4.0.1", ""), "ValueSet has no url")
+	assert.Error(t, r.Register((*r4.ValueSet)(nil), "4.0.1", ""))
+	assert.Error(t, r.Register("ValueSet", "4.0.1", ""))
+
+	require.NoError(t, r.Register(&r4.StructureDefinition{URL: "http://example.org/sd", Version: ptr("1.0")}, "4.0.1", ""))
+	e, ok := r.Resolve("StructureDefinition", "http://example.org/sd|1.0")
+	require.True(t, ok)
+	assert.Equal(t, "http://example.org/sd|1.0", e.Canonical())
+}
+
+func TestRegistryList(t *testing.T) {
+	r := NewRegistry()
+	addValueSets(t, r, "http://example.org/b", "1.0.0", "2.0.0")
+	addValueSets(t, r, "http://example.org/a", "1.0.0")
+	require.NoError(t, r.Register(&r4.CodeSystem{URL: ptr("http://example.org/c")}, "4.0.1", ""))
+
+	list := r.List("ValueSet")
+	require.Len(t, list, 2)
+	assert.Equal(t, "http://example.org/a|1.0.0", list[0].Canonical())
+	assert.Equal(t, "http://example.org/b|2.0.0", list[1].Canonical())
+	assert.Len(t, r.List(""), 3)
+	assert.Equal(t, 4, r.Len())
+}
@@ -0,0 +1,133 @@
+package conformance
+
+import (
+	"strconv"
+	"strings"
+)
+
+// version is a parsed semantic version. FHIR business versions often omit
+// the patch or minor part ("2.77", "4"), which count as zero.
+type version struct {
+	parts [3]int
+	pre   string
+}
+
+// parseVersion parses major[.minor[.patch]][-pre][+build].
+func parseVersion(s string) (version, bool) {
+	var v version
+	if s == "" {
+		return v, false
+	}
+	s, _, _ = strings.Cut(s, "+")
+	s, v.pre, _ = strings.Cut(s, "-")
+	nums := strings.Split(s, ".")
+	if len(nums) > 3 {
+		return v, false
+	}
+	for i, n := range nums {
+		x, err := strconv.Atoi(n)
+		if err != nil || x < 0 {
+			return v, false
+		}
+		v.parts[i] = x
+	}
+	return v, true
+}
+
+func (a version) compare(b version) int {
+	for i := range a.parts {
+		if a.parts[i] != b.parts[i] {
+			if a.parts[i] < b.parts[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case a.pre == b.pre:
+		return 0
+	case a.pre == "":
+		return 1
+	case b.pre == "":
+		return -1
+	}
+	return comparePre(a.pre, b.pre)
+}
+
+// comparePre orders pre-release identifiers as semver does: dot-separated
+// parts compare numerically when both are numbers and as text otherwise.
+func comparePre(a, b string) int {
+	ap, bp := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(ap) && i < len(bp); i++ {
+		x, errx := strconv.Atoi(ap[i])
+		y, erry := strconv.Atoi(bp[i])
+		switch {
+		case errx == nil && erry == nil:
+			if x != y {
+				if x < y {
+					return -1
+				}
+				return 1
+			}
+		case errx == nil:
+			return -1
+		case erry == nil:
+			return 1
+		default:
+			if c := strings.Compare(ap[i], bp[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	switch {
+	case len(ap) < len(bp):
+		return -1
+	case len(ap) > len(bp):
+		return 1
+	}
+	return 0
+}
+
+// CompareVersions orders two versions: -1 if a is older than b, 1 if newer
+// and 0 if equal. Semantic versions compare by their numbers, and a
+// pre-release (1.0.0-ballot) sorts before its release. Versions that are not
+// semantic versions sort before those that are and among themselves as text.
+func CompareVersions(a, b string) int {
+	va, oka := parseVersion(a)
+	vb, okb := parseVersion(b)
+	switch {
+	case oka && okb:
+		return va.compare(vb)
+	case oka:
+		return 1
+	case okb:
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+// MatchVersion reports whether v satisfies pattern. A pattern is an exact
+// version, a partial version whose missing or x/* parts match anything
+// ("1.2", "1.x", "1.2.*"), or one of latest, current and dev, which match
+// every version.
+func MatchVersion(pattern, v string) bool {
+	switch pattern {
+	case "", "latest", "current", "dev":
+		return true
+	}
+	if pattern == v {
+		return true
+	}
+	pp := strings.Split(pattern, ".")
+	vp := strings.Split(strings.SplitN(v, "-", 2)[0], ".")
+	for i, p := range pp {
+		if p == "x" || p == "X" || p == "*" {
+			return true
+		}
+		if i >= len(vp) || p != vp[i] {
+			return false
+		}
+	}
+	// A partial pattern such as 1.2 matches 1.2.3 but not 1.2.3-ballot
+	return len(pp) < len(vp) && !strings.Contains(v, "-")
+}
@@ -0,0 +1,56 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"2.0", "1.9.9", 1},
+		{"1.0", "1.0.0", 0},
+		{"1.0.0-ballot", "1.0.0", -1},
+		{"1.0.0-ballot.2", "1.0.0-ballot.10", -1},
+		{"1.0.0-1", "1.0.0-alpha", -1},
+		{"1.0.0+build", "1.0.0", 0},
+		{"draft", "0.1.0", -1},
+		{"draft-b", "draft-a", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareVersions(tt.a, tt.b))
+			assert.Equal(t, -tt.want, CompareVersions(tt.b, tt.a))
+		})
+	}
+}
+
+func TestMatchVersion(t *testing.T) {
+	tests := []struct {
+		pattern, v string
+		want       bool
+	}{
+		{"", "1.0.0", true},
+		{"latest", "1.0.0", true},
+		{"1.0.0", "1.0.0", true},
+		{"1.0.0", "1.0.1", false},
+		{"1.0", "1.0.3", true},
+		{"1.0", "1.1.0", false},
+		{"1.x", "1.4.2", true},
+		{"1.0.*", "1.0.9", true},
+		{"1.0", "1.0.0-ballot", false},
+		{"1.0.0-ballot", "1.0.0-ballot", true},
+		{"1.0.0", "1.0.0-ballot", false},
+		{"2024-01", "2024-01", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.v, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchVersion(tt.pattern, tt.v))
+		})
+	}
+}
@@ -0,0 +1,442 @@
+// Package convert converts resources between FHIR R4 and R5, following the
+// official R4↔R5 structure maps for Patient, Encounter, Observation,
+// Condition, MedicationRequest, MedicationStatement, Immunization,
+// AllergyIntolerance, Practitioner, Organization and DeviceUseStatement,
+// which R5 renamed DeviceUsage.
+//
+// Conversion works on the JSON of a resource, in two steps. A structure map
+// first moves the elements that were renamed or restructured, such as
+// Encounter.period to Encounter.actualPeriod. Then every element is matched
+// against the target type: a single value becomes a list where the target
+// repeats, and elements the target release lacks are carried in
+// cross-version extensions, such as
+// http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.classHistory.
+// Converting back puts those elements in place again, so a round trip keeps
+// them. Codes without a counterpart, such as the R4 Encounter status
+// arrived, are kept the same way.
+//
+// Resources without a structure map are converted element by element, and
+// contained resources are converted along with their container.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// release describes one side of a conversion.
+type release struct {
+	name string
+	// version is the release as used in cross-version extension URLs.
+	version     string
+	newResource func(resourceType string) (any, error)
+	// renames maps resource types to their name in the other release.
+	renames map[string]string
+	// maps holds the structure maps to the other release by resource type.
+	maps map[string]structureMap
+	// values maps Go type names to the value[x] element that holds them in
+	// an Extension of the other release, where carried elements go.
+	values map[string]string
+}
+
+// structureMap moves the elements of a resource of release r, held as
+// decoded JSON, to where the other release has them.
+type structureMap func(m object, r *release)
+
+var (
+	releaseR4 = &release{
+		name:        "R4",
+		version:     "4.0",
+		newResource: func(t string) (any, error) { return r4.NewResource(t) },
+		renames:     r4Renames,
+		maps:        r4ToR5,
+		values:      extensionValues(reflect.TypeOf(r5.Extension{})),
+	}
+	releaseR5 = &release{
+		name:        "R5",
+		version:     "5.0",
+		newResource: func(t string) (any, error) { return r5.NewResource(t) },
+		renames:     invert(r4Renames),
+		maps:        r5ToR4,
+		values:      extensionValues(reflect.TypeOf(r4.Extension{})),
+	}
+)
+
+// R4ToR5 converts an R4 resource to R5. A resource renamed in R5, such as
+// *r4.DeviceUseStatement, becomes the new type (*r5.DeviceUsage).
+func R4ToR5(resource r4.Resource) (r5.Resource, error) {
+	out, err := convert(resource, releaseR4, releaseR5)
+	if err != nil {
+		return nil, err
+	}
+	return out.(r5.Resource), nil
+}
+
+// R5ToR4 converts an R5 resource to R4. It fails for resources that are new
+// in R5, such as *r5.Transport.
+func R5ToR4(resource r5.Resource) (r4.Resource, error) {
+	out, err := convert(resource, releaseR5, releaseR4)
+	if err != nil {
+		return nil, err
+	}
+	return out.(r4.Resource), nil
+}
+
+// convert converts resource from one release to the other.
+func convert(resource fhir.AnyResource, from, to *release) (any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("marshal resource: %w", err)
+	}
+	var m object
+	if err := decode(data, &m); err != nil {
+		return nil, fmt.Errorf("decode resource: %w", err)
+	}
+	// The resourceType field is left unset by a struct literal.
+	m["resourceType"] = resource.ResourceType()
+
+	target, err := convertObject(m, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s: %w", m["resourceType"], err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(target); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", m["resourceType"], err)
+	}
+	return target, nil
+}
+
+// convertObject converts the decoded JSON of a resource in place, and returns
+// an empty resource of the target type.
+func convertObject(m object, from, to *release) (any, error) {
+	sourceType, _ := m["resourceType"].(string)
+	if sourceType == "" {
+		return nil, fmt.Errorf("resource has no resourceType")
+	}
+	targetType := sourceType
+	if renamed, ok := from.renames[sourceType]; ok {
+		targetType = renamed
+	}
+
+	source, err := from.newResource(sourceType)
+	if err != nil {
+		return nil, fmt.Errorf("convert %s: %w", sourceType, err)
+	}
+	target, err := to.newResource(targetType)
+	if err != nil {
+		return nil, fmt.Errorf("convert %s: no %s resource: %w", sourceType, to.name, err)
+	}
+
+	if structureMap := from.maps[sourceType]; structureMap != nil {
+		structureMap(m, from)
+	}
+	m["resourceType"] = targetType
+
+	if contained, ok := m["contained"].([]any); ok {
+		for i, c := range contained {
+			cm, ok := c.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("convert %s: contained[%d] is not a resource", sourceType, i)
+			}
+			if _, err := convertObject(cm, from, to); err != nil {
+				return nil, fmt.Errorf("convert %s: contained[%d]: %w", sourceType, i, err)
+			}
+		}
+	}
+
+	c := &converter{from: from, to: to}
+	if carried := c.fit(m, reflect.TypeOf(target), reflect.TypeOf(source), sourceType); len(carried) > 0 {
+		return nil, fmt.Errorf("convert %s: %s has no extensions to carry elements in", sourceType, targetType)
+	}
+	return target, nil
+}
+
+// converter matches decoded JSON against the types of the target release.
+type converter struct {
+	from, to *release
+}
+
+// fit makes obj, of Go type t in the target release, fit that type. s is the
+// type of obj in the source release, if any, and path its FHIR path. It
+// returns the cross-version extensions that obj can't hold itself.
+func (c *converter) fit(obj object, t, s reflect.Type, path string) []any {
+	fields := jsonFields(t)
+	sourceFields := jsonFields(s)
+	c.restore(obj, fields)
+
+	var carried []any
+	for _, key := range sortedKeys(obj) {
+		if _, ok := obj[key]; !ok || key == "resourceType" || key == "extension" {
+			continue
+		}
+		field, ok := fields[key]
+		if !ok || !c.fitValue(obj, key, field.Type, sourceFields[key].Type, path+"."+key, &carried) {
+			if strings.HasPrefix(key, "_") {
+				// The extensions of a primitive without a counterpart
+				delete(obj, key)
+				continue
+			}
+			carried = append(carried, c.from.extensions(path+"."+key, obj[key], sourceFields[key].Type)...)
+			delete(obj, key)
+			delete(obj, "_"+key)
+		}
+	}
+
+	if len(carried) == 0 {
+		return nil
+	}
+	if _, ok := fields["extension"]; !ok {
+		return carried
+	}
+	exts, _ := obj["extension"].([]any)
+	obj["extension"] = append(exts, carried...)
+	return nil
+}
+
+// fitValue makes obj[key] fit the field type t, and reports whether it can.
+// Extensions that elements within the value can't hold go to carried.
+func (c *converter) fitValue(obj object, key string, t, s reflect.Type, path string, carried *[]any) bool {
+	v := obj[key]
+	if isRaw(t) {
+		return true
+	}
+	list, isList := v.([]any)
+	switch {
+	case isSlice(t) && !isList:
+		list, isList = []any{v}, true
+	case !isSlice(t) && isList:
+		if len(list) != 1 {
+			return false
+		}
+		v, list, isList = list[0], nil, false
+	}
+
+	items := list
+	if !isList {
+		items = []any{v}
+	}
+	want := isObject(t)
+	for _, item := range items {
+		if _, ok := item.(map[string]any); ok != want {
+			return false
+		}
+	}
+
+	if want {
+		for _, item := range items {
+			*carried = append(*carried, c.fit(item.(map[string]any), elem(t), elem(s), path)...)
+		}
+	}
+	if isList {
+		obj[key] = list
+	} else {
+		obj[key] = v
+	}
+	return true
+}
+
+// restore puts back the elements of the target release that a previous
+// conversion carried in cross-version extensions on obj.
+func (c *converter) restore(obj object, fields map[string]reflect.StructField) {
+	exts, _ := obj["extension"].([]any)
+	prefix := c.to.extensionPrefix()
+
+	var kept []any
+	restored := map[string][]any{}
+	for _, e := range exts {
+		ext, _ := e.(map[string]any)
+		url, _ := ext["url"].(string)
+		if element, ok := strings.CutPrefix(url, prefix); ok {
+			key := element[strings.LastIndex(element, ".")+1:]
+			if field, ok := fields[key]; ok {
+				restored[key] = append(restored[key], extensionValue(ext, field.Type))
+				continue
+			}
+		}
+		kept = append(kept, e)
+	}
+
+	for key, values := range restored {
+		if isSlice(fields[key].Type) {
+			// Carried repetitions follow those the structure map kept
+			obj[key] = append(list(obj[key]), values...)
+		} else {
+			obj[key] = values[0]
+		}
+	}
+	if len(kept) > 0 {
+		obj["extension"] = kept
+	} else {
+		delete(obj, "extension")
+	}
+}
+
+// extensionPrefix returns the start of the URLs of the cross-version
+// extensions for elements of r.
+func (r *release) extensionPrefix() string {
+	return "http://hl7.org/fhir/" + r.version + "/StructureDefinition/extension-"
+}
+
+// extensions returns the cross-version extensions carrying the element at
+// path, with value v and Go type s in r, one per repetition.
+func (r *release) extensions(path string, v any, s reflect.Type) []any {
+	items, ok := v.([]any)
+	if !ok {
+		items = []any{v}
+	}
+	var exts []any
+	for _, item := range items {
+		exts = append(exts, r.extension(r.extensionPrefix()+path, item, elem(s)))
+	}
+	return exts
+}
+
+// extension returns an extension with URL url and value v, of Go type s. A
+// value no value[x] type fits, such as a BackboneElement, becomes a complex
+// extension with an extension per element.
+func (r *release) extension(url string, v any, s reflect.Type) object {
+	ext := object{"url": url}
+	if name := r.valueElement(v, s); name != "" {
+		ext[name] = v
+		return ext
+	}
+
+	obj := v.(map[string]any)
+	fields := jsonFields(s)
+	var children []any
+	for _, key := range sortedKeys(obj) {
+		if key == "id" || key == "extension" || key == "modifierExtension" || strings.HasPrefix(key, "_") {
+			continue
+		}
+		items, ok := obj[key].([]any)
+		if !ok {
+			items = []any{obj[key]}
+		}
+		for _, item := range items {
+			children = append(children, r.extension(key, item, elem(fields[key].Type)))
+		}
+	}
+	ext["extension"] = children
+	return ext
+}
+
+// valueElement returns the value[x] element of an Extension of the other
+// release that holds v, of Go type s in r, or "" if v needs a complex
+// extension.
+func (r *release) valueElement(v any, s reflect.Type) string {
+	if s == nil {
+		switch v.(type) {
+		case bool:
+			return "valueBoolean"
+		case json.Number:
+			return "valueDecimal"
+		case string:
+			return "valueString"
+		}
+		return ""
+	}
+	switch s.Kind() {
+	case reflect.Bool:
+		return "valueBoolean"
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return "valueInteger"
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return "valueUnsignedInt"
+	case reflect.String:
+		if s.PkgPath() == "" {
+			return "valueString"
+		}
+		if name, ok := r.values[s.Name()]; ok {
+			return name
+		}
+		// A typed enum
+		return "valueCode"
+	}
+	return r.values[s.Name()]
+}
+
+// extensionValue returns the value of a cross-version extension for an
+// element of Go type t, as decoded JSON.
+func extensionValue(ext object, t reflect.Type) any {
+	for key, v := range ext {
+		if strings.HasPrefix(key, "value") {
+			return v
+		}
+	}
+
+	fields := jsonFields(elem(t))
+	obj := object{}
+	children, _ := ext["extension"].([]any)
+	for _, c := range children {
+		child, _ := c.(map[string]any)
+		key, _ := child["url"].(string)
+		field, ok := fields[key]
+		if !ok {
+			continue
+		}
+		v := extensionValue(child, field.Type)
+		if isSlice(field.Type) {
+			list, _ := obj[key].([]any)
+			obj[key] = append(list, v)
+		} else {
+			obj[key] = v
+		}
+	}
+	return obj
+}
+
+// extensionValues maps the Go type names of the value[x] elements of an
+// Extension type to their JSON names, keeping the first for each type.
+func extensionValues(t reflect.Type) map[string]string {
+	values := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !strings.HasPrefix(name, "value") {
+			continue
+		}
+		if _, ok := values[elem(f.Type).Name()]; !ok {
+			values[elem(f.Type).Name()] = name
+		}
+	}
+	return values
+}
+
+// invert returns the inverse of a map of names.
+func invert(m map[string]string) map[string]string {
+	inverse := make(map[string]string, len(m))
+	for k, v := range m {
+		inverse[v] = k
+	}
+	return inverse
+}
+
+// decode unmarshals JSON keeping numbers as written.
+func decode(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func sortedKeys(obj object) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,296 @@
+package convert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// r4Examples are R4 resources whose R5 conversion moves elements, keyed by
+// resource type, with a JSON fragment the R5 form must contain.
+var r4Examples = []struct {
+	name string
+	json string
+	want []string
+}{
+	{
+		name: "Patient",
+		json: `{"resourceType":"Patient","id":"p1","active":true,"name":[{"family":"Rahman","given":["Ayesha"]}],"gender":"female","birthDate":"1990-04-01","communication":[{"language":{"text":"Bengali"},"preferred":true}]}`,
+		want: []string{`"name":[{"family":"Rahman","given":["Ayesha"]}]`},
+	},
+	{
+		name: "Encounter",
+		json: `{"resourceType":"Encounter","id":"e1","status":"arrived",` +
+			`"statusHistory":[{"status":"planned","period":{"start":"2024-01-01"}}],` +
+			`"class":{"system":"http://terminology.hl7.org/CodeSystem/v3-ActCode","code":"AMB"},` +
+			`"serviceType":{"text":"General practice"},"subject":{"reference":"Patient/p1"},` +
+			`"participant":[{"individual":{"reference":"Practitioner/d1"}}],` +
+			`"period":{"start":"2024-01-02"},"reasonCode":[{"text":"Fever"}],` +
+			`"diagnosis":[{"condition":{"reference":"Condition/c1"},"rank":1}],` +
+			`"hospitalization":{"admitSource":{"text":"Referral"},"dietPreference":[{"text":"Halal"}]},` +
+			`"location":[{"location":{"reference":"Location/l1"},"physicalType":{"text":"Ward"}}]}`,
+		want: []string{
+			`"status":"in-progress"`,
+			`"class":[{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/v3-ActCode","code":"AMB"}]}]`,
+			`"serviceType":[{"concept":{"text":"General practice"}}]`,
+			`"participant":[{"actor":{"reference":"Practitioner/d1"}}]`,
+			`"actualPeriod":{"start":"2024-01-02"}`,
+			`"reason":[{"value":[{"concept":{"text":"Fever"}}]}]`,
+			`"condition":[{"reference":{"reference":"Condition/c1"}}]`,
+			`"url":"http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.diagnosis.rank","valueInteger":1`,
+			`"dietPreference":[{"text":"Halal"}]`,
+			`"admission":{"admitSource":{"text":"Referral"}}`,
+			`"form":{"text":"Ward"}`,
+			`"url":"http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.status","valueCode":"arrived"`,
+			`"extension":[{"url":"period","valuePeriod":{"start":"2024-01-01"}},{"url":"status","valueCode":"planned"}],"url":"http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.statusHistory"`,
+		},
+	},
+	{
+		name: "Observation",
+		json: `{"resourceType":"Observation","id":"o1","status":"final","code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]},"subject":{"reference":"Patient/p1"},"effectiveDateTime":"2024-01-02T10:00:00Z","valueQuantity":{"value":120.50,"unit":"mmHg"}}`,
+		want: []string{`"valueQuantity":{"value":120.50,"unit":"mmHg"}`},
+	},
+	{
+		name: "Condition",
+		json: `{"resourceType":"Condition","id":"c1","clinicalStatus":{"text":"active"},"code":{"text":"Malaria"},"subject":{"reference":"Patient/p1"},"recorder":{"reference":"Practitioner/d1"},"asserter":{"reference":"Patient/p1"},"evidence":[{"code":[{"text":"Fever"}],"detail":[{"reference":"Observation/o1"}]}]}`,
+		want: []string{
+			`"evidence":[{"concept":{"text":"Fever"}},{"reference":{"reference":"Observation/o1"}}]`,
+			`{"function":{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/provenance-participant-type","code":"author"}]},"actor":{"reference":"Practitioner/d1"}}`,
+		},
+	},
+	{
+		name: "MedicationRequest",
+		json: `{"resourceType":"MedicationRequest","id":"m1","status":"active","intent":"order","reportedBoolean":false,"medicationCodeableConcept":{"text":"Paracetamol 500mg"},"subject":{"reference":"Patient/p1"},"performer":{"reference":"Practitioner/d1"},"reasonReference":[{"reference":"Condition/c1"}],"dosageInstruction":[{"text":"As needed for fever","asNeededCodeableConcept":{"text":"Fever"},"maxDosePerPeriod":{"numerator":{"value":4}}}],"dispenseRequest":{"performer":{"reference":"Organization/o1"}},"detectedIssue":[{"reference":"DetectedIssue/i1"}]}`,
+		want: []string{
+			`"medication":{"concept":{"text":"Paracetamol 500mg"}}`,
+			`"reported":false`,
+			`"performer":[{"reference":"Practitioner/d1"}]`,
+			`"reason":[{"reference":{"reference":"Condition/c1"}}]`,
+			`"asNeededFor":[{"text":"Fever"}]`,
+			`"maxDosePerPeriod":[{"numerator":{"value":4}}]`,
+			`"dispenser":{"reference":"Organization/o1"}`,
+			`"url":"http://hl7.org/fhir/4.0/StructureDefinition/extension-MedicationRequest.detectedIssue","valueReference":{"reference":"DetectedIssue/i1"}`,
+		},
+	},
+	{
+		name: "MedicationStatement",
+		json: `{"resourceType":"MedicationStatement","id":"ms1","status":"not-taken","statusReason":[{"text":"Side effects"}],"medicationReference":{"reference":"Medication/med1"},"subject":{"reference":"Patient/p1"},"context":{"reference":"Encounter/e1"},"informationSource":{"reference":"Patient/p1"},"reasonCode":[{"text":"Hypertension"}]}`,
+		want: []string{
+			`"status":"recorded"`,
+			`"adherence":{"code":{"coding":[{"system":"http://hl7.org/fhir/CodeSystem/medication-statement-adherence","code":"not-taking"}]},"reason":{"text":"Side effects"}}`,
+			`"medication":{"reference":{"reference":"Medication/med1"}}`,
+			`"encounter":{"reference":"Encounter/e1"}`,
+			`"informationSource":[{"reference":"Patient/p1"}]`,
+		},
+	},
+	{
+		name: "Immunization",
+		json: `{"resourceType":"Immunization","id":"i1","status":"completed","vaccineCode":{"text":"BCG"},"patient":{"reference":"Patient/p1"},"occurrenceDateTime":"2024-01-02","recorded":"2024-01-03","primarySource":true,"reportOrigin":{"text":"Parent"},"manufacturer":{"reference":"Organization/o1"},"reasonCode":[{"text":"Routine"}],"programEligibility":[{"text":"Uninsured"}],"reaction":[{"detail":{"reference":"Observation/o1"}}],"protocolApplied":[{"series":"EPI","doseNumberPositiveInt":1,"seriesDosesString":"one"}]}`,
+		want: []string{
+			`"informationSource":{"concept":{"text":"Parent"}}`,
+			`"manufacturer":{"reference":{"reference":"Organization/o1"}}`,
+			`"url":"http://hl7.org/fhir/4.0/StructureDefinition/extension-Immunization.programEligibility","valueCodeableConcept":{"text":"Uninsured"}`,
+			`"reaction":[{"manifestation":{"reference":{"reference":"Observation/o1"}}}]`,
+			`"doseNumber":"1"`,
+			`"seriesDoses":"one"`,
+			`"url":"http://hl7.org/fhir/4.0/StructureDefinition/extension-Immunization.recorded","valueDateTime":"2024-01-03"`,
+		},
+	},
+	{
+		name: "AllergyIntolerance",
+		json: `{"resourceType":"AllergyIntolerance","id":"a1","type":"allergy","category":["food"],"code":{"text":"Peanut"},"patient":{"reference":"Patient/p1"},"recorder":{"reference":"Practitioner/d1"},"reaction":[{"manifestation":[{"text":"Hives"}]}]}`,
+		want: []string{
+			`"type":{"coding":[{"system":"http://hl7.org/fhir/allergy-intolerance-type","code":"allergy"}]}`,
+			`"manifestation":[{"concept":{"text":"Hives"}}]`,
+		},
+	},
+	{
+		name: "Practitioner",
+		json: `{"resourceType":"Practitioner","id":"d1","name":[{"family":"Hossain"}],"communication":[{"text":"Bengali"},{"text":"English"}]}`,
+		want: []string{`"communication":[{"language":{"text":"Bengali"}},{"language":{"text":"English"}}]`},
+	},
+	{
+		name: "Organization",
+		json: `{"resourceType":"Organization","id":"o1","name":"Cox's Bazar District Hospital","telecom":[{"system":"phone","value":"0341"}],"address":[{"city":"Cox's Bazar"},{"city":"Ukhia"}],"contact":[{"purpose":{"text":"Admin"},"name":{"text":"Front desk"}}]}`,
+		want: []string{`"contact":[{"telecom":[{"system":"phone","value":"0341"}],"address":{"city":"Cox's Bazar"}},{"address":{"city":"Ukhia"}},{"purpose":{"text":"Admin"},"name":[{"text":"Front desk"}]}]`},
+	},
+	{
+		name: "DeviceUseStatement",
+		json: `{"resourceType":"DeviceUseStatement","id":"du1","status":"active","subject":{"reference":"Patient/p1"},"recordedOn":"2024-01-02T10:00:00Z","source":{"reference":"Practitioner/d1"},"device":{"reference":"Device/dev1"},"reasonCode":[{"text":"Asthma"}],"bodySite":{"text":"Chest"}}`,
+		want: []string{
+			`"resourceType":"DeviceUsage"`,
+			`"patient":{"reference":"Patient/p1"}`,
+			`"dateAsserted":"2024-01-02T10:00:00Z"`,
+			`"informationSource":{"reference":"Practitioner/d1"}`,
+			`"device":{"reference":{"reference":"Device/dev1"}}`,
+			`"bodySite":{"concept":{"text":"Chest"}}`,
+		},
+	},
+}
+
+func TestR4ToR5_RoundTrip(t *testing.T) {
+	for _, tt := range r4Examples {
+		t.Run(tt.name, func(t *testing.T) {
+			original, err := r4.UnmarshalAny(json.RawMessage(tt.json))
+			if err != nil {
+				t.Fatalf("UnmarshalAny() error = %v", err)
+			}
+
+			converted, err := R4ToR5(original)
+			if err != nil {
+				t.Fatalf("R4ToR5() error = %v", err)
+			}
+			data := marshal(t, converted)
+			for _, want := range tt.want {
+				if !strings.Contains(data, want) {
+					t.Errorf("R5 form lacks %s\n%s", want, data)
+				}
+			}
+
+			back, err := R5ToR4(converted)
+			if err != nil {
+				t.Fatalf("R5ToR4() error = %v", err)
+			}
+			if got, want := marshal(t, back), marshal(t, original); got != want {
+				t.Errorf("round trip =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestR4ToR5_StatusHistory(t *testing.T) {
+	input := `{"resourceType":"Encounter","status":"finished","class":{"code":"AMB"},` +
+		`"statusHistory":[{"status":"arrived","period":{"start":"2024-01-01"}},{"status":"in-progress","period":{"start":"2024-01-01T10:00:00Z"}}]}`
+	original, err := r4.UnmarshalAny(json.RawMessage(input))
+	if err != nil {
+		t.Fatalf("UnmarshalAny() error = %v", err)
+	}
+
+	converted, err := R4ToR5(original)
+	if err != nil {
+		t.Fatalf("R4ToR5() error = %v", err)
+	}
+	var statuses []string
+	for _, ext := range converted.(*r5.Encounter).Extension {
+		if ext.URL != "http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.statusHistory" {
+			continue
+		}
+		for _, sub := range ext.Extension {
+			if sub.URL != "status" {
+				continue
+			}
+			if sub.ValueCode == nil || sub.ValueString != nil {
+				t.Errorf("status sub-extension = %s, want valueCode", marshal(t, sub))
+				continue
+			}
+			statuses = append(statuses, *sub.ValueCode)
+		}
+	}
+	if got := strings.Join(statuses, ","); got != "arrived,in-progress" {
+		t.Errorf("statusHistory codes = %s, want arrived,in-progress", got)
+	}
+
+	back, err := R5ToR4(converted)
+	if err != nil {
+		t.Fatalf("R5ToR4() error = %v", err)
+	}
+	if got, want := marshal(t, back), marshal(t, original); got != want {
+		t.Errorf("round trip =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestR5ToR4_RoundTrip(t *testing.T) {
+	input := `{"resourceType":"Encounter","id":"e1","status":"discharged",` +
+		`"class":[{"coding":[{"code":"IMP"}]},{"text":"Inpatient ward"}],` +
+		`"subject":{"reference":"Patient/p1"},"subjectStatus":{"text":"arrived"},` +
+		`"virtualService":[{"addressUrl":"https://meet.example.org/e1"}],` +
+		`"reason":[{"use":[{"text":"Chief complaint"}],"value":[{"concept":{"text":"Cough"}}]}],` +
+		`"diagnosis":[{"condition":[{"concept":{"text":"Pneumonia"}}],"use":[{"text":"Admission"}]}],` +
+		`"admission":{"admitSource":{"text":"Emergency"}},"specialCourtesy":[{"text":"VIP"}]}`
+
+	original, err := r5.UnmarshalAny(json.RawMessage(input))
+	if err != nil {
+		t.Fatalf("UnmarshalAny() error = %v", err)
+	}
+	converted, err := R5ToR4(original)
+	if err != nil {
+		t.Fatalf("R5ToR4() error = %v", err)
+	}
+	encounter := converted.(*r4.Encounter)
+	if encounter.Status != "finished" || encounter.Class.Code == nil || *encounter.Class.Code != "IMP" {
+		t.Errorf("status = %q, class = %+v", encounter.Status, encounter.Class)
+	}
+	if encounter.Hospitalization == nil || len(encounter.Hospitalization.SpecialCourtesy) != 1 {
+		t.Errorf("hospitalization = %+v, want the special courtesy", encounter.Hospitalization)
+	}
+
+	back, err := R4ToR5(converted)
+	if err != nil {
+		t.Fatalf("R4ToR5() error = %v", err)
+	}
+	if got, want := marshal(t, back), marshal(t, original); got != want {
+		t.Errorf("round trip =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestConvert_Contained(t *testing.T) {
+	input := `{"resourceType":"MedicationStatement","status":"active",` +
+		`"contained":[{"resourceType":"Practitioner","id":"d1","communication":[{"text":"Bengali"}]}],` +
+		`"medicationCodeableConcept":{"text":"Metformin"},"subject":{"reference":"Patient/p1"},"informationSource":{"reference":"#d1"}}`
+	original, err := r4.UnmarshalAny(json.RawMessage(input))
+	if err != nil {
+		t.Fatalf("UnmarshalAny() error = %v", err)
+	}
+
+	converted, err := R4ToR5(original)
+	if err != nil {
+		t.Fatalf("R4ToR5() error = %v", err)
+	}
+	statement := converted.(*r5.MedicationStatement)
+	var practitioner r5.Practitioner
+	if err := json.Unmarshal(statement.Contained[0], &practitioner); err != nil {
+		t.Fatalf("contained: %v", err)
+	}
+	if len(practitioner.Communication) != 1 || *practitioner.Communication[0].Language.Text != "Bengali" {
+		t.Errorf("contained Practitioner = %s", statement.Contained[0])
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	transport, err := r5.NewResource("Transport")
+	if err != nil {
+		t.Fatalf("NewResource() error = %v", err)
+	}
+	if _, err := R5ToR4(transport); err == nil || !strings.Contains(err.Error(), "no R4 resource") {
+		t.Errorf("R5ToR4(Transport) error = %v, want no R4 resource", err)
+	}
+
+	patient := &r4.Patient{}
+	patient.Contained = []json.RawMessage{json.RawMessage(`{"id":"x"}`)}
+	if _, err := R4ToR5(patient); err == nil || !strings.Contains(err.Error(), "resourceType") {
+		t.Errorf("R4ToR5() with untyped contained resource error = %v", err)
+	}
+}
+
+func TestConvert_StructLiteral(t *testing.T) {
+	active := true
+	converted, err := R4ToR5(&r4.Patient{Active: &active})
+	if err != nil {
+		t.Fatalf("R4ToR5() error = %v", err)
+	}
+	if got := marshal(t, converted); got != `{"resourceType":"Patient","active":true}` {
+		t.Errorf("R4ToR5() = %s", got)
+	}
+}
+
+func marshal(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,771 @@
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// Code systems used by the structure maps.
+const (
+	systemParticipantType = "http://terminology.hl7.org/CodeSystem/provenance-participant-type"
+	systemAllergyType     = "http://hl7.org/fhir/allergy-intolerance-type"
+	systemAdherence       = "http://hl7.org/fhir/CodeSystem/medication-statement-adherence"
+)
+
+// r4Renames maps R4 resource types to their R5 names.
+var r4Renames = map[string]string{
+	"DeviceUseStatement":             "DeviceUsage",
+	"MedicinalProduct":               "MedicinalProductDefinition",
+	"MedicinalProductAuthorization":  "RegulatedAuthorization",
+	"MedicinalProductIngredient":     "Ingredient",
+	"MedicinalProductManufactured":   "ManufacturedItemDefinition",
+	"MedicinalProductPackaged":       "PackagedProductDefinition",
+	"MedicinalProductPharmaceutical": "AdministrableProductDefinition",
+	"RequestGroup":                   "RequestOrchestration",
+	"SubstanceSpecification":         "SubstanceDefinition",
+}
+
+// r4ToR5 holds the structure maps from R4 to R5. Patient and Observation
+// only differ in elements new in R5, and need none.
+var r4ToR5 = map[string]structureMap{
+	"AllergyIntolerance":  allergyIntoleranceR4ToR5,
+	"Condition":           conditionR4ToR5,
+	"DeviceUseStatement":  deviceUseStatementR4ToR5,
+	"Encounter":           encounterR4ToR5,
+	"Immunization":        immunizationR4ToR5,
+	"MedicationRequest":   medicationRequestR4ToR5,
+	"MedicationStatement": medicationStatementR4ToR5,
+	"Organization":        organizationR4ToR5,
+	"Practitioner":        practitionerR4ToR5,
+}
+
+// r5ToR4 holds the structure maps from R5 to R4.
+var r5ToR4 = map[string]structureMap{
+	"AllergyIntolerance":  allergyIntoleranceR5ToR4,
+	"Condition":           conditionR5ToR4,
+	"DeviceUsage":         deviceUsageR5ToR4,
+	"Encounter":           encounterR5ToR4,
+	"Immunization":        immunizationR5ToR4,
+	"MedicationRequest":   medicationRequestR5ToR4,
+	"MedicationStatement": medicationStatementR5ToR4,
+	"Organization":        organizationR5ToR4,
+	"Practitioner":        practitionerR5ToR4,
+}
+
+var (
+	encounterStatusR4ToR5 = map[string]string{
+		"planned":          "planned",
+		"arrived":          "in-progress",
+		"triaged":          "in-progress",
+		"in-progress":      "in-progress",
+		"onleave":          "on-hold",
+		"finished":         "completed",
+		"cancelled":        "cancelled",
+		"entered-in-error": "entered-in-error",
+		"unknown":          "unknown",
+	}
+	encounterStatusR5ToR4 = map[string]string{
+		"planned":          "planned",
+		"in-progress":      "in-progress",
+		"on-hold":          "onleave",
+		"discharged":       "finished",
+		"completed":        "finished",
+		"cancelled":        "cancelled",
+		"discontinued":     "cancelled",
+		"entered-in-error": "entered-in-error",
+		"unknown":          "unknown",
+	}
+
+	// adherenceR4ToR5 maps the R4 MedicationStatement status to the R5
+	// adherence code.
+	adherenceR4ToR5 = map[string]string{
+		"active":    "taking",
+		"not-taken": "not-taking",
+		"on-hold":   "on-hold",
+		"stopped":   "stopped",
+	}
+	adherenceR5ToR4 = invert(adherenceR4ToR5)
+)
+
+// code is the Go type of a FHIR code carried in an extension, as valueCode.
+type code string
+
+// encounterStatusHistory is the Go type of an R4 Encounter.statusHistory
+// carried in an extension, with its status as a code.
+type encounterStatusHistory struct {
+	Status code      `json:"status"`
+	Period r4.Period `json:"period"`
+}
+
+var (
+	codeType                = reflect.TypeOf(code(""))
+	r5CodeableReferenceType = reflect.TypeOf(r5.CodeableReference{})
+)
+
+func encounterR4ToR5(m object, r *release) {
+	r.mapCode(m, "Encounter.status", encounterStatusR4ToR5, encounterStatusR5ToR4)
+	if history, ok := m["statusHistory"]; ok {
+		r.carry(m, "Encounter.statusHistory", history, reflect.TypeOf(encounterStatusHistory{}))
+		delete(m, "statusHistory")
+	}
+	if class, ok := m["class"]; ok {
+		m["class"] = []any{object{"coding": []any{class}}}
+	}
+	toCodeableReference(m, "serviceType", "serviceType", "concept")
+	move(m, "period", "actualPeriod")
+	for _, p := range objects(m["participant"]) {
+		move(p, "individual", "actor")
+	}
+
+	var reasons []any
+	for _, c := range list(m["reasonCode"]) {
+		reasons = append(reasons, object{"value": []any{object{"concept": c}}})
+	}
+	for _, ref := range list(m["reasonReference"]) {
+		reasons = append(reasons, object{"value": []any{object{"reference": ref}}})
+	}
+	delete(m, "reasonCode")
+	delete(m, "reasonReference")
+	if len(reasons) > 0 {
+		m["reason"] = reasons
+	}
+
+	for _, d := range objects(m["diagnosis"]) {
+		toCodeableReference(d, "condition", "condition", "reference")
+	}
+	if h, ok := m["hospitalization"].(map[string]any); ok {
+		for _, key := range []string{"dietPreference", "specialArrangement", "specialCourtesy"} {
+			if v, ok := h[key]; ok {
+				m[key] = v
+				delete(h, key)
+			}
+		}
+		move(m, "hospitalization", "admission")
+	}
+	for _, l := range objects(m["location"]) {
+		move(l, "physicalType", "form")
+	}
+}
+
+func encounterR5ToR4(m object, r *release) {
+	r.mapCode(m, "Encounter.status", encounterStatusR5ToR4, encounterStatusR4ToR5)
+
+	// R4 has a single class Coding
+	classes := list(m["class"])
+	delete(m, "class")
+	if len(classes) > 0 {
+		if c, ok := classes[0].(map[string]any); ok && len(c) == 1 && len(list(c["coding"])) == 1 {
+			m["class"] = list(c["coding"])[0]
+			classes = classes[1:]
+		}
+	}
+	if len(classes) > 0 {
+		r.carry(m, "Encounter.class", classes, reflect.TypeOf(r5.CodeableConcept{}))
+	}
+
+	serviceTypes := list(m["serviceType"])
+	delete(m, "serviceType")
+	if len(serviceTypes) > 0 {
+		if st, ok := serviceTypes[0].(map[string]any); ok && len(st) == 1 && st["concept"] != nil {
+			m["serviceType"] = st["concept"]
+			serviceTypes = serviceTypes[1:]
+		}
+	}
+	if len(serviceTypes) > 0 {
+		r.carry(m, "Encounter.serviceType", serviceTypes, r5CodeableReferenceType)
+	}
+
+	move(m, "actualPeriod", "period")
+	for _, p := range objects(m["participant"]) {
+		move(p, "actor", "individual")
+	}
+
+	var codes, refs, carried []any
+	for _, reason := range objects(m["reason"]) {
+		if _, ok := reason["use"]; ok || len(reason) != 1 {
+			carried = append(carried, reason)
+			continue
+		}
+		for _, v := range objects(reason["value"]) {
+			if c, ok := v["concept"]; ok {
+				codes = append(codes, c)
+			}
+			if ref, ok := v["reference"]; ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	delete(m, "reason")
+	setList(m, "reasonCode", codes)
+	setList(m, "reasonReference", refs)
+	if len(carried) > 0 {
+		r.carry(m, "Encounter.reason", carried, reflect.TypeOf(r5.EncounterReason{}))
+	}
+
+	var diagnoses []any
+	carried = nil
+	for _, d := range objects(m["diagnosis"]) {
+		conditions := objects(d["condition"])
+		if len(conditions) != 1 || len(conditions[0]) != 1 || conditions[0]["reference"] == nil {
+			carried = append(carried, d)
+			continue
+		}
+		d["condition"] = conditions[0]["reference"]
+		diagnoses = append(diagnoses, d)
+	}
+	delete(m, "diagnosis")
+	setList(m, "diagnosis", diagnoses)
+	if len(carried) > 0 {
+		r.carry(m, "Encounter.diagnosis", carried, reflect.TypeOf(r5.EncounterDiagnosis{}))
+	}
+
+	move(m, "admission", "hospitalization")
+	for _, key := range []string{"dietPreference", "specialArrangement", "specialCourtesy"} {
+		if v, ok := m[key]; ok {
+			h, _ := m["hospitalization"].(map[string]any)
+			if h == nil {
+				h = object{}
+				m["hospitalization"] = h
+			}
+			h[key] = v
+			delete(m, key)
+		}
+	}
+	for _, l := range objects(m["location"]) {
+		move(l, "form", "physicalType")
+	}
+}
+
+func conditionR4ToR5(m object, r *release) {
+	var evidence []any
+	for _, e := range objects(m["evidence"]) {
+		evidence = append(evidence, codeableReferences(e["code"], "concept")...)
+		evidence = append(evidence, codeableReferences(e["detail"], "reference")...)
+	}
+	delete(m, "evidence")
+	setList(m, "evidence", evidence)
+	participantsR4ToR5(m)
+}
+
+func conditionR5ToR4(m object, r *release) {
+	// R5 evidence is flat, so it becomes a single R4 evidence
+	var codes, details []any
+	for _, e := range objects(m["evidence"]) {
+		if c, ok := e["concept"]; ok {
+			codes = append(codes, c)
+		}
+		if ref, ok := e["reference"]; ok {
+			details = append(details, ref)
+		}
+	}
+	delete(m, "evidence")
+	if len(codes) > 0 || len(details) > 0 {
+		e := object{}
+		setList(e, "code", codes)
+		setList(e, "detail", details)
+		m["evidence"] = []any{e}
+	}
+	participantsR5ToR4(m, r, "Condition", reflect.TypeOf(r5.ConditionParticipant{}))
+}
+
+func allergyIntoleranceR4ToR5(m object, r *release) {
+	if t, ok := m["type"]; ok {
+		m["type"] = object{"coding": []any{object{"system": systemAllergyType, "code": t}}}
+	}
+	participantsR4ToR5(m)
+	for _, reaction := range objects(m["reaction"]) {
+		toCodeableReferences(reaction, "manifestation", "", "manifestation")
+	}
+}
+
+func allergyIntoleranceR5ToR4(m object, r *release) {
+	if t, ok := m["type"].(map[string]any); ok {
+		if c := codingCode(t, systemAllergyType); c != "" && len(t) == 1 && len(list(t["coding"])) == 1 {
+			m["type"] = c
+		} else {
+			r.carry(m, "AllergyIntolerance.type", t, reflect.TypeOf(r5.CodeableConcept{}))
+			delete(m, "type")
+		}
+	}
+	participantsR5ToR4(m, r, "AllergyIntolerance", reflect.TypeOf(r5.AllergyIntoleranceParticipant{}))
+	for _, reaction := range objects(m["reaction"]) {
+		var concepts, carried []any
+		for _, mf := range objects(reaction["manifestation"]) {
+			if c, ok := mf["concept"]; ok && len(mf) == 1 {
+				concepts = append(concepts, c)
+			} else {
+				carried = append(carried, mf)
+			}
+		}
+		delete(reaction, "manifestation")
+		setList(reaction, "manifestation", concepts)
+		if len(carried) > 0 {
+			r.carry(reaction, "AllergyIntolerance.reaction.manifestation", carried, r5CodeableReferenceType)
+		}
+	}
+}
+
+func practitionerR4ToR5(m object, r *release) {
+	var communication []any
+	for _, c := range list(m["communication"]) {
+		communication = append(communication, object{"language": c})
+	}
+	delete(m, "communication")
+	setList(m, "communication", communication)
+}
+
+func practitionerR5ToR4(m object, r *release) {
+	var languages, carried []any
+	for _, c := range objects(m["communication"]) {
+		if language, ok := c["language"]; ok && len(c) == 1 {
+			languages = append(languages, language)
+		} else {
+			carried = append(carried, c)
+		}
+	}
+	delete(m, "communication")
+	setList(m, "communication", languages)
+	if len(carried) > 0 {
+		r.carry(m, "Practitioner.communication", carried, reflect.TypeOf(r5.PractitionerCommunication{}))
+	}
+}
+
+func organizationR4ToR5(m object, r *release) {
+	// R5 keeps the telecom and addresses of an organization in contact,
+	// which has a single address
+	var contacts []any
+	telecom, addresses := m["telecom"], list(m["address"])
+	if telecom != nil || len(addresses) > 0 {
+		first := object{}
+		if telecom != nil {
+			first["telecom"] = telecom
+		}
+		if len(addresses) > 0 {
+			first["address"] = addresses[0]
+		}
+		contacts = append(contacts, first)
+		for _, a := range addresses[1:] {
+			contacts = append(contacts, object{"address": a})
+		}
+	}
+	delete(m, "telecom")
+	delete(m, "address")
+	setList(m, "contact", append(contacts, list(m["contact"])...))
+}
+
+func organizationR5ToR4(m object, r *release) {
+	var telecom, addresses, contacts []any
+	for _, c := range objects(m["contact"]) {
+		if !onlyKeys(c, "telecom", "address") {
+			contacts = append(contacts, c)
+			continue
+		}
+		telecom = append(telecom, list(c["telecom"])...)
+		if a, ok := c["address"]; ok {
+			addresses = append(addresses, a)
+		}
+	}
+	delete(m, "contact")
+	setList(m, "telecom", telecom)
+	setList(m, "address", addresses)
+	setList(m, "contact", contacts)
+}
+
+func medicationRequestR4ToR5(m object, r *release) {
+	medicationR4ToR5(m)
+	move(m, "reportedBoolean", "reported")
+	if ref, ok := m["reportedReference"]; ok {
+		m["informationSource"] = []any{ref}
+		delete(m, "reportedReference")
+	}
+	toCodeableReferences(m, "reasonCode", "reasonReference", "reason")
+	if d, ok := m["dispenseRequest"].(map[string]any); ok {
+		move(d, "performer", "dispenser")
+	}
+	for _, d := range objects(m["dosageInstruction"]) {
+		dosageR4ToR5(d)
+	}
+}
+
+func medicationRequestR5ToR4(m object, r *release) {
+	medicationR5ToR4(m)
+	move(m, "reported", "reportedBoolean")
+	if sources := list(m["informationSource"]); len(sources) == 1 && m["reportedBoolean"] == nil {
+		m["reportedReference"] = sources[0]
+		delete(m, "informationSource")
+	}
+	fromCodeableReferences(m, "reason", "reasonCode", "reasonReference", r, "MedicationRequest.reason")
+	if d, ok := m["dispenseRequest"].(map[string]any); ok {
+		move(d, "dispenser", "performer")
+	}
+	for _, d := range objects(m["dosageInstruction"]) {
+		dosageR5ToR4(d, r)
+	}
+}
+
+func medicationStatementR4ToR5(m object, r *release) {
+	// R5 has a status for the record only, and the R4 status becomes
+	// adherence
+	if status, _ := m["status"].(string); status != "" && status != "entered-in-error" {
+		m["status"] = "recorded"
+		r.carry(m, "MedicationStatement.status", status, codeType)
+		if adherence, ok := adherenceR4ToR5[status]; ok {
+			a := object{"code": object{"coding": []any{object{"system": systemAdherence, "code": adherence}}}}
+			if reasons := list(m["statusReason"]); len(reasons) == 1 {
+				a["reason"] = reasons[0]
+				delete(m, "statusReason")
+			}
+			m["adherence"] = a
+		}
+	}
+	medicationR4ToR5(m)
+	move(m, "context", "encounter")
+	toCodeableReferences(m, "reasonCode", "reasonReference", "reason")
+	for _, d := range objects(m["dosage"]) {
+		dosageR4ToR5(d)
+	}
+}
+
+func medicationStatementR5ToR4(m object, r *release) {
+	if m["status"] != "entered-in-error" {
+		m["status"] = "unknown"
+		if a, ok := m["adherence"].(map[string]any); ok {
+			if status, ok := adherenceR5ToR4[codingCode(a["code"], systemAdherence)]; ok {
+				m["status"] = status
+				if reason, ok := a["reason"]; ok {
+					m["statusReason"] = []any{reason}
+				}
+				delete(m, "adherence")
+			}
+		}
+	}
+	medicationR5ToR4(m)
+	move(m, "encounter", "context")
+	fromCodeableReferences(m, "reason", "reasonCode", "reasonReference", r, "MedicationStatement.reason")
+	for _, d := range objects(m["dosage"]) {
+		dosageR5ToR4(d, r)
+	}
+}
+
+func immunizationR4ToR5(m object, r *release) {
+	toCodeableReferences(m, "reasonCode", "reasonReference", "reason")
+	toCodeableReference(m, "reportOrigin", "informationSource", "concept")
+	toCodeableReference(m, "manufacturer", "manufacturer", "reference")
+	for _, reaction := range objects(m["reaction"]) {
+		toCodeableReference(reaction, "detail", "manifestation", "reference")
+	}
+	for _, pa := range objects(m["protocolApplied"]) {
+		for _, element := range []string{"doseNumber", "seriesDoses"} {
+			if n, ok := pa[element+"PositiveInt"].(json.Number); ok {
+				pa[element] = n.String()
+				delete(pa, element+"PositiveInt")
+			}
+			move(pa, element+"String", element)
+		}
+	}
+	// R5 requires the program, which R4 lacks.
+	if eligibility, ok := m["programEligibility"]; ok {
+		delete(m, "programEligibility")
+		r.carry(m, "Immunization.programEligibility", eligibility, reflect.TypeOf(r4.CodeableConcept{}))
+	}
+}
+
+func immunizationR5ToR4(m object, r *release) {
+	fromCodeableReferences(m, "reason", "reasonCode", "reasonReference", r, "Immunization.reason")
+	fromCodeableReference(m, "informationSource", "reportOrigin", "")
+	fromCodeableReference(m, "manufacturer", "", "manufacturer")
+	for _, reaction := range objects(m["reaction"]) {
+		fromCodeableReference(reaction, "manifestation", "", "detail")
+	}
+	for _, pa := range objects(m["protocolApplied"]) {
+		for _, element := range []string{"doseNumber", "seriesDoses"} {
+			s, ok := pa[element].(string)
+			if !ok {
+				continue
+			}
+			if n, err := strconv.ParseUint(s, 10, 32); err == nil && n > 0 {
+				pa[element+"PositiveInt"] = json.Number(s)
+				delete(pa, element)
+				delete(pa, "_"+element)
+			} else {
+				move(pa, element, element+"String")
+			}
+		}
+	}
+	if eligibility, ok := m["programEligibility"]; ok {
+		delete(m, "programEligibility")
+		r.carry(m, "Immunization.programEligibility", eligibility, reflect.TypeOf(r5.ImmunizationProgramEligibility{}))
+	}
+}
+
+func deviceUseStatementR4ToR5(m object, r *release) {
+	move(m, "subject", "patient")
+	move(m, "recordedOn", "dateAsserted")
+	move(m, "source", "informationSource")
+	toCodeableReference(m, "device", "device", "reference")
+	toCodeableReferences(m, "reasonCode", "reasonReference", "reason")
+	toCodeableReference(m, "bodySite", "bodySite", "concept")
+}
+
+func deviceUsageR5ToR4(m object, r *release) {
+	move(m, "patient", "subject")
+	move(m, "dateAsserted", "recordedOn")
+	move(m, "informationSource", "source")
+	fromCodeableReference(m, "device", "", "device")
+	fromCodeableReferences(m, "reason", "reasonCode", "reasonReference", r, "DeviceUsage.reason")
+	fromCodeableReference(m, "bodySite", "bodySite", "")
+}
+
+// medicationR4ToR5 moves medication[x] to the R5 CodeableReference.
+func medicationR4ToR5(m object) {
+	toCodeableReference(m, "medicationCodeableConcept", "medication", "concept")
+	toCodeableReference(m, "medicationReference", "medication", "reference")
+}
+
+// medicationR5ToR4 moves the R5 medication to medication[x].
+func medicationR5ToR4(m object) {
+	fromCodeableReference(m, "medication", "medicationCodeableConcept", "medicationReference")
+}
+
+// dosageR4ToR5 moves asNeeded[x] of a Dosage to asNeeded and asNeededFor.
+func dosageR4ToR5(d object) {
+	move(d, "asNeededBoolean", "asNeeded")
+	if c, ok := d["asNeededCodeableConcept"]; ok {
+		d["asNeededFor"] = []any{c}
+		delete(d, "asNeededCodeableConcept")
+	}
+}
+
+// dosageR5ToR4 moves asNeeded and asNeededFor of a Dosage to asNeeded[x].
+// A reason for use as needed implies it, so asNeeded is dropped then.
+func dosageR5ToR4(d object, r *release) {
+	reasons := list(d["asNeededFor"])
+	delete(d, "asNeededFor")
+	if len(reasons) == 0 {
+		move(d, "asNeeded", "asNeededBoolean")
+		return
+	}
+	if d["asNeeded"] == true {
+		delete(d, "asNeeded")
+		delete(d, "_asNeeded")
+	} else {
+		move(d, "asNeeded", "asNeededBoolean")
+	}
+	d["asNeededCodeableConcept"] = reasons[0]
+	if len(reasons) > 1 {
+		r.carry(d, "Dosage.asNeededFor", reasons[1:], reflect.TypeOf(r5.CodeableConcept{}))
+	}
+}
+
+// participantsR4ToR5 moves the recorder and asserter of an R4 Condition or
+// AllergyIntolerance to participants, as author and informant.
+func participantsR4ToR5(m object) {
+	for _, p := range []struct{ element, function string }{{"recorder", "author"}, {"asserter", "informant"}} {
+		actor, ok := m[p.element]
+		if !ok {
+			continue
+		}
+		m["participant"] = append(list(m["participant"]), object{
+			"function": object{"coding": []any{object{"system": systemParticipantType, "code": p.function}}},
+			"actor":    actor,
+		})
+		delete(m, p.element)
+	}
+}
+
+// participantsR5ToR4 moves the first author and informant participants of
+// an R5 Condition or AllergyIntolerance to recorder and asserter. Other
+// participants are carried in an extension.
+func participantsR5ToR4(m object, r *release, resourceType string, participantType reflect.Type) {
+	var carried []any
+	for _, p := range objects(m["participant"]) {
+		element := ""
+		switch codingCode(p["function"], systemParticipantType) {
+		case "author":
+			element = "recorder"
+		case "informant":
+			element = "asserter"
+		}
+		if _, taken := m[element]; element == "" || taken || !onlyKeys(p, "function", "actor") {
+			carried = append(carried, p)
+			continue
+		}
+		m[element] = p["actor"]
+	}
+	delete(m, "participant")
+	if len(carried) > 0 {
+		r.carry(m, resourceType+".participant", carried, participantType)
+	}
+}
+
+// mapCode replaces the code of the element at path, a child of m, with its
+// counterpart in table. The original code is carried in an extension if
+// back doesn't map the new code to it.
+func (r *release) mapCode(m object, path string, table, back map[string]string) {
+	key := path[strings.LastIndex(path, ".")+1:]
+	c, _ := m[key].(string)
+	mapped, ok := table[c]
+	if !ok {
+		return
+	}
+	m[key] = mapped
+	if back[mapped] != c {
+		r.carry(m, path, c, codeType)
+	}
+}
+
+// carry adds cross-version extensions to m for the element at path, with
+// value v of Go type t, so that converting back restores it.
+func (r *release) carry(m object, path string, v any, t reflect.Type) {
+	m["extension"] = append(list(m["extension"]), r.extensions(path, v, t)...)
+}
+
+// move renames element from of m to to, along with its primitive extension.
+func move(m object, from, to string) {
+	for _, prefix := range []string{"", "_"} {
+		if v, ok := m[prefix+from]; ok {
+			delete(m, prefix+from)
+			m[prefix+to] = v
+		}
+	}
+}
+
+// toCodeableReference moves m[from] to m[to] as a CodeableReference, with
+// the value under key, concept or reference.
+func toCodeableReference(m object, from, to, key string) {
+	v, ok := m[from]
+	if !ok {
+		return
+	}
+	delete(m, from)
+	if items, ok := v.([]any); ok {
+		m[to] = codeableReferences(items, key)
+		return
+	}
+	m[to] = object{key: v}
+}
+
+// fromCodeableReference moves the CodeableReference at m[from] to
+// m[concept] or m[reference], whichever it holds. One holding both, or a
+// kind the other release has no element for (""), stays to be carried in an
+// extension.
+func fromCodeableReference(m object, from, concept, reference string) {
+	ref, ok := m[from].(map[string]any)
+	if !ok {
+		return
+	}
+	c, hasConcept := ref["concept"]
+	r, hasReference := ref["reference"]
+	switch {
+	case hasConcept && !hasReference && len(ref) == 1 && concept != "":
+		delete(m, from)
+		m[concept] = c
+	case hasReference && !hasConcept && len(ref) == 1 && reference != "":
+		delete(m, from)
+		m[reference] = r
+	}
+}
+
+// toCodeableReferences moves the CodeableConcepts at m[concepts] and the
+// References at m[references] to m[to] as CodeableReferences.
+func toCodeableReferences(m object, concepts, references, to string) {
+	refs := append(codeableReferences(m[concepts], "concept"), codeableReferences(m[references], "reference")...)
+	delete(m, concepts)
+	delete(m, references)
+	setList(m, to, refs)
+}
+
+// fromCodeableReferences moves the CodeableReferences at m[from] to the
+// CodeableConcepts at m[concepts] and the References at m[references].
+// Those holding both are carried in an extension for the element at path.
+func fromCodeableReferences(m object, from, concepts, references string, r *release, path string) {
+	var cs, refs, carried []any
+	for _, ref := range objects(m[from]) {
+		c, hasConcept := ref["concept"]
+		v, hasReference := ref["reference"]
+		switch {
+		case hasConcept && !hasReference && len(ref) == 1:
+			cs = append(cs, c)
+		case hasReference && !hasConcept && len(ref) == 1:
+			refs = append(refs, v)
+		default:
+			carried = append(carried, ref)
+		}
+	}
+	delete(m, from)
+	setList(m, concepts, cs)
+	setList(m, references, refs)
+	if len(carried) > 0 {
+		r.carry(m, path, carried, r5CodeableReferenceType)
+	}
+}
+
+// codeableReferences returns CodeableReferences with the values in v, a
+// value or a list, under key.
+func codeableReferences(v any, key string) []any {
+	var refs []any
+	for _, item := range list(v) {
+		refs = append(refs, object{key: item})
+	}
+	return refs
+}
+
+// codingCode returns the code of the first Coding of CodeableConcept v in
+// system, or "" if it has none.
+func codingCode(v any, system string) string {
+	cc, _ := v.(map[string]any)
+	for _, coding := range objects(cc["coding"]) {
+		if coding["system"] == system {
+			c, _ := coding["code"].(string)
+			return c
+		}
+	}
+	return ""
+}
+
+// onlyKeys reports whether obj has no elements other than keys.
+func onlyKeys(obj object, keys ...string) bool {
+	n := 0
+	for _, k := range keys {
+		if _, ok := obj[k]; ok {
+			n++
+		}
+	}
+	return n == len(obj)
+}
+
+// setList sets m[key] to items, or removes it if there are none.
+func setList(m object, key string, items []any) {
+	if len(items) == 0 {
+		delete(m, key)
+		return
+	}
+	m[key] = items
+}
+
+// list returns v, a value or a list, as a list.
+func list(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	}
+	return []any{v}
+}
+
+// objects returns the objects in v, a value or a list.
+func objects(v any) []object {
+	var objs []object
+	for _, item := range list(v) {
+		if obj, ok := item.(map[string]any); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
@@ -0,0 +1,76 @@
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// object is a decoded JSON object.
+type object = map[string]any
+
+var (
+	rawMessageType  = reflect.TypeOf(json.RawMessage{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+var fieldCache sync.Map // reflect.Type -> map[string]reflect.StructField
+
+// jsonFields returns the fields of a struct type by JSON name, including
+// those of embedded structs, or nil if t isn't a struct.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	t = elem(t)
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.(map[string]reflect.StructField)
+	}
+
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			for name, ef := range jsonFields(f.Type) {
+				fields[name] = ef
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = f
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// elem returns t without pointers and slices, other than json.RawMessage.
+func elem(t reflect.Type) reflect.Type {
+	for t != nil && t != rawMessageType && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	return t
+}
+
+// isSlice reports whether a field of type t repeats.
+func isSlice(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Slice && t != rawMessageType
+}
+
+// isRaw reports whether a field of type t holds raw JSON, such as a
+// contained resource.
+func isRaw(t reflect.Type) bool {
+	return elem(t) == rawMessageType
+}
+
+// isObject reports whether the values of a field of type t are JSON objects.
+func isObject(t reflect.Type) bool {
+	t = elem(t)
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(unmarshalerType)
+}
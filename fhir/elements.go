@@ -0,0 +1,217 @@
+package fhir
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// MarshalWithElements marshals a resource to JSON including only the named
+// elements. This is equivalent to the FHIR _elements parameter.
+//
+// Elements are named by their JSON names, and a choice element by its name
+// without the type, such as "value" for valueQuantity. A dotted name, such as
+// "contact.name", selects part of a backbone element or data type; its other
+// parts are left out. resourceType, id, meta and mandatory elements are
+// always included, as are the primitive extensions (_birthDate) of included
+// elements.
+func MarshalWithElements(resource interface{}, elements []string) ([]byte, error) {
+	selection := make(elementSelection)
+	for _, element := range elements {
+		selection.add(strings.Split(strings.TrimSpace(element), "."))
+	}
+	return json.Marshal(filterElements(reflect.ValueOf(resource), selection, true))
+}
+
+// FilterElements filters a resource held as decoded JSON, such as one a
+// server stores, like MarshalWithElements. t is the Go type of the resource,
+// which gives its mandatory and choice elements; elements t doesn't have are
+// kept only when named. The result shares its values with resource.
+func FilterElements(resource map[string]interface{}, t reflect.Type, elements []string) map[string]interface{} {
+	selection := make(elementSelection)
+	for _, element := range elements {
+		selection.add(strings.Split(strings.TrimSpace(element), "."))
+	}
+	filtered, _ := filterJSONElements(resource, t, selection, true).(map[string]interface{})
+	return filtered
+}
+
+// filterJSONElements keeps the selected elements of decoded JSON v, of Go
+// type t. top is whether v is the resource itself.
+func filterJSONElements(v interface{}, t reflect.Type, selection elementSelection, top bool) interface{} {
+	if selection == nil {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		t = jsonStructType(t)
+		result := make(map[string]interface{})
+		for key, value := range v {
+			name := strings.TrimPrefix(key, "_")
+			var field reflect.StructField
+			ok := false
+			if t != nil {
+				field, ok = fieldByJSONName(t, name)
+			}
+			if !ok {
+				if sub, selected := selection[name]; selected {
+					result[key] = filterJSONElements(value, nil, sub, false)
+				} else if top && name == "resourceType" {
+					result[key] = value
+				}
+				continue
+			}
+
+			// A primitive extension goes with its element
+			sub, selected := selectedElement(field, selection)
+			if !selected {
+				if !(top && isResourceMetadata(field)) && !strings.Contains(field.Tag.Get("fhir"), "required") {
+					continue
+				}
+				sub = nil
+			}
+			result[key] = filterJSONElements(value, field.Type, sub, false)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = filterJSONElements(item, t, selection, top)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// elementSelection holds the selected elements by name. A nil selection
+// stands for the whole element.
+type elementSelection map[string]elementSelection
+
+// add selects the element at path.
+func (s elementSelection) add(path []string) {
+	name := strings.TrimSuffix(path[0], "[x]")
+	if name == "" {
+		return
+	}
+	sub, ok := s[name]
+	if ok && sub == nil {
+		return // already selected whole
+	}
+	if len(path) == 1 {
+		s[name] = nil
+		return
+	}
+	if sub == nil {
+		sub = make(elementSelection)
+		s[name] = sub
+	}
+	sub.add(path[1:])
+}
+
+// filterElements keeps the selected elements of v. top is whether v is the
+// resource itself, whose resourceType, id and meta are kept.
+func filterElements(v reflect.Value, selection elementSelection, top bool) interface{} {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if selection == nil || v.Type().Implements(marshalerType) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		result := make(map[string]interface{})
+		filterStructElements(v, selection, top, result)
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result[i] = filterElements(v.Index(i), selection, top)
+		}
+		return result
+	default:
+		return v.Interface()
+	}
+}
+
+// filterStructElements adds the selected fields of struct v to result.
+func filterStructElements(v reflect.Value, selection elementSelection, top bool, result map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		// Embedded structs hold elements of the same level
+		if field.Anonymous {
+			for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() == reflect.Struct {
+				filterStructElements(fieldValue, selection, top, result)
+			}
+			continue
+		}
+
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "" || jsonTag == "-" {
+			continue
+		}
+		jsonName := strings.Split(jsonTag, ",")[0]
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+		if strings.Contains(jsonTag, "omitempty") && isZeroValue(fieldValue) {
+			continue
+		}
+
+		// A primitive extension goes with its element
+		element := field
+		if strings.HasPrefix(jsonName, "_") {
+			if f, ok := fieldByJSONName(t, jsonName[1:]); ok {
+				element = f
+			}
+		}
+		sub, ok := selectedElement(element, selection)
+		if !ok {
+			if !(top && isResourceMetadata(element)) && !strings.Contains(element.Tag.Get("fhir"), "required") {
+				continue
+			}
+			sub = nil
+		}
+		result[jsonName] = filterElements(fieldValue, sub, false)
+	}
+}
+
+// selectedElement returns the selection within field, and whether field is
+// selected at all.
+func selectedElement(field reflect.StructField, selection elementSelection) (elementSelection, bool) {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if sub, ok := selection[name]; ok {
+		return sub, true
+	}
+	for _, part := range strings.Split(field.Tag.Get("fhir"), ",") {
+		if choice, ok := strings.CutPrefix(part, "choice="); ok {
+			if sub, ok := selection[choice]; ok {
+				return sub, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// isResourceMetadata reports whether field is the resourceType, id or meta
+// of a resource.
+func isResourceMetadata(field reflect.StructField) bool {
+	return field.Name == "ResourceType" || field.Name == "ID" || field.Name == "Meta"
+}
@@ -0,0 +1,140 @@
+package fhir_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+const elementsPatient = `{
+	"resourceType": "Patient",
+	"id": "example",
+	"meta": {"versionId": "1"},
+	"text": {"status": "generated", "div": "<div>Rahim</div>"},
+	"active": true,
+	"gender": "male",
+	"birthDate": "1990-01-02",
+	"_birthDate": {"extension": [{"url": "http://example.org/accuracy", "valueString": "estimated"}]},
+	"deceasedBoolean": false,
+	"name": [{"family": "Uddin", "given": ["Rahim"]}],
+	"contact": [{"name": {"family": "Begum"}, "gender": "female"}],
+	"link": [{"other": {"reference": "Patient/other"}, "type": "seealso"}]
+}`
+
+func TestMarshalWithElements(t *testing.T) {
+	resource, err := r4.UnmarshalAny(json.RawMessage(elementsPatient))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		elements []string
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "top-level",
+			elements: []string{"birthDate", "gender"},
+			want:     []string{"resourceType", "id", "meta", "birthDate", "_birthDate", "gender"},
+			notWant:  []string{"text", "active", "name", "contact", "deceasedBoolean"},
+		},
+		{
+			name:     "choice",
+			elements: []string{"deceased[x]"},
+			want:     []string{"deceasedBoolean"},
+			notWant:  []string{"birthDate", "gender"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := fhir.MarshalWithElements(resource, tt.elements)
+			if err != nil {
+				t.Fatalf("MarshalWithElements() error = %v", err)
+			}
+			var result map[string]any
+			if err := json.Unmarshal(data, &result); err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range tt.want {
+				if _, ok := result[key]; !ok {
+					t.Errorf("%s should be included: %s", key, data)
+				}
+			}
+			for _, key := range tt.notWant {
+				if _, ok := result[key]; ok {
+					t.Errorf("%s should not be included: %s", key, data)
+				}
+			}
+		})
+	}
+}
+
+func TestMarshalWithElements_Nested(t *testing.T) {
+	resource, err := r4.UnmarshalAny(json.RawMessage(elementsPatient))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := fhir.MarshalWithElements(resource, []string{"contact.gender", "name", "link.other"})
+	if err != nil {
+		t.Fatalf("MarshalWithElements() error = %v", err)
+	}
+	var result struct {
+		Name    []map[string]any `json:"name"`
+		Contact []map[string]any `json:"contact"`
+		Link    []map[string]any `json:"link"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Name) != 1 || result.Name[0]["family"] != "Uddin" {
+		t.Errorf("name should be included whole: %s", data)
+	}
+	if len(result.Contact) != 1 || result.Contact[0]["gender"] != "female" {
+		t.Errorf("contact.gender should be included: %s", data)
+	}
+	if _, ok := result.Contact[0]["name"]; ok {
+		t.Errorf("contact.name should not be included: %s", data)
+	}
+	if len(result.Link) != 1 || result.Link[0]["type"] != "seealso" {
+		t.Errorf("link.type is mandatory and should be included: %s", data)
+	}
+}
+
+func TestFilterElements(t *testing.T) {
+	var resource map[string]any
+	if err := json.Unmarshal([]byte(elementsPatient), &resource); err != nil {
+		t.Fatal(err)
+	}
+	resource["unknownElement"] = "kept when named"
+	resource["otherUnknown"] = true
+
+	got := fhir.FilterElements(resource, reflect.TypeOf(&r4.Patient{}), []string{"birthDate", "deceased", "contact.gender", "link.other", "unknownElement"})
+
+	for _, key := range []string{"resourceType", "id", "meta", "birthDate", "_birthDate", "deceasedBoolean", "contact", "link", "unknownElement"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("%s should be included: %v", key, got)
+		}
+	}
+	for _, key := range []string{"text", "active", "gender", "name", "otherUnknown"} {
+		if _, ok := got[key]; ok {
+			t.Errorf("%s should not be included: %v", key, got)
+		}
+	}
+	contact := got["contact"].([]any)[0].(map[string]any)
+	if _, ok := contact["name"]; ok || contact["gender"] != "female" {
+		t.Errorf("contact = %v, want only its gender", contact)
+	}
+	link := got["link"].([]any)[0].(map[string]any)
+	if link["type"] != "seealso" {
+		t.Errorf("link = %v, link.type is mandatory and should be included", link)
+	}
+	if _, ok := resource["text"]; !ok {
+		t.Error("FilterElements() should leave the resource as it is")
+	}
+}
@@ -18,23 +18,23 @@ func ExamplePatient() {
 		Active: boolPtr(true),
 		Name: []r5.HumanName{
 			{
-				Use:    stringPtr("official"),
+				Use:    ptr(r5.NameUseOfficial),
 				Family: stringPtr("Doe"),
 				Given:  []string{"John"},
 			},
 		},
-		Gender:    stringPtr("male"),
+		Gender:    ptr(r5.AdministrativeGenderMale),
 		BirthDate: &birthDate,
 		Telecom: []r5.ContactPoint{
 			{
-				System: stringPtr("phone"),
+				System: ptr(r5.ContactPointSystemPhone),
 				Value:  stringPtr("+1-555-1234"),
-				Use:    stringPtr("home"),
+				Use:    ptr(r5.ContactPointUseHome),
 			},
 		},
 	}
 	patient.ID = stringPtr("example-patient")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 
 	// Marshal to JSON
 	data, _ := json.MarshalIndent(patient, "", "  ")
@@ -62,7 +62,7 @@ func ExampleObservation() {
 		},
 	}
 	obs.ID = stringPtr("heart-rate-example")
-	obs.ResourceType = "Observation"
+	obs.BaseResource.ResourceType = "Observation"
 
 	data, _ := json.MarshalIndent(obs, "", "  ")
 	fmt.Printf("Created observation: %s\n", *obs.ID)
@@ -76,7 +76,7 @@ func ExampleBundle_searchset() {
 		Type: "searchset",
 	}
 
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	// Add a patient
 	patient := &r5.Patient{
@@ -89,7 +89,7 @@ func ExampleBundle_searchset() {
 		},
 	}
 	patient.ID = stringPtr("patient-1")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 
 	_ = helper.AddEntry(patient, stringPtr("Patient/patient-1"))
 
@@ -100,7 +100,7 @@ func ExampleBundle_searchset() {
 // ExampleBundleHelper_GetPatients demonstrates filtering resources by type.
 func ExampleBundleHelper_GetPatients() {
 	bundle := &fhir.Bundle{Type: "searchset"}
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	// Add multiple resources (using maps with resourceType until struct support is added)
 	patient1 := map[string]interface{}{
@@ -115,10 +115,13 @@ func ExampleBundleHelper_GetPatients() {
 	_ = helper.AddEntry(patient1, stringPtr("Patient/patient-1"))
 	_ = helper.AddEntry(patient2, stringPtr("Patient/patient-2"))
 
-	// Get all patients
+	// Get all patients, unmarshaled into *r5.Patient
 	patients, _ := helper.GetPatients()
 	fmt.Printf("Found %d patients\n", len(patients))
-	// Output: Found 2 patients
+	fmt.Println(*patients[0].(*r5.Patient).ID)
+	// Output:
+	// Found 2 patients
+	// patient-1
 }
 
 // ExampleMarshalSummaryJSON demonstrates FHIR summary mode serialization.
@@ -133,7 +136,7 @@ func ExampleMarshalSummaryJSON() {
 		},
 	}
 	patient.ID = stringPtr("example")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 
 	// Marshal with summary mode (only summary elements)
 	summaryData, _ := fhir.MarshalSummaryJSON(patient)
@@ -155,7 +158,7 @@ func ExampleFHIRValidator() {
 		},
 	}
 	patient.ID = stringPtr("valid-patient")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 
 	err := validator.Validate(patient)
 	if err != nil {
@@ -14,7 +14,10 @@ func BangladeshPatientExample() {
 	patient.ID = stringPtrBD("patient-bd-001")
 
 	// Set DGHS standard identifiers
-	patient.AddIdentifier("http://dghs.gov.bd/identifier/nid", "19901234567890123")
+	if err := patient.AddIdentifier(bd.SystemNID, "19901234567890123"); err != nil {
+		fmt.Printf("Invalid NID: %v\n", err)
+		return
+	}
 
 	// Set Names (English text)
 	patient.SetNames("Abul Bashar", "আবুল বাশার")
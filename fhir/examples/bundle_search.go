@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
 )
 
 // SearchPatientsExample demonstrates searching for patients and processing results
@@ -50,7 +51,7 @@ func SearchPatientsExample() {
 	}
 
 	// Create helper
-	helper := fhir.NewBundleHelper(&bundle)
+	helper := fhir.NewBundleHelper(&bundle, r5.UnmarshalAny)
 
 	// Get summary
 	fmt.Printf("Search returned %d total results\n", *bundle.Total)
@@ -59,13 +60,12 @@ func SearchPatientsExample() {
 	// Get all patients
 	patients, _ := helper.GetPatients()
 	fmt.Printf("\nPatients:\n")
-	for i, patientJSON := range patients {
-		var patient map[string]interface{}
-		json.Unmarshal(patientJSON, &patient)
+	for i, resource := range patients {
+		patient := resource.(*r5.Patient)
 		fmt.Printf("  %d. ID: %s, Gender: %s\n",
 			i+1,
-			patient["id"],
-			patient["gender"])
+			*patient.ID,
+			*patient.Gender)
 	}
 
 	// Check for next page
@@ -76,7 +76,7 @@ func SearchPatientsExample() {
 
 // PaginatedSearchExample demonstrates handling paginated search results
 func PaginatedSearchExample(baseURL string) error {
-	var allPatients []map[string]interface{}
+	var allPatients []*r5.Patient
 	nextURL := baseURL + "/Patient?name=smith"
 
 	for nextURL != "" {
@@ -94,18 +94,16 @@ func PaginatedSearchExample(baseURL string) error {
 		}
 		resp.Body.Close()
 
-		helper := fhir.NewBundleHelper(&bundle)
+		helper := fhir.NewBundleHelper(&bundle, r5.UnmarshalAny)
 
 		// Extract patients from this page
-		patientJSONs, _ := helper.GetPatients()
-		for _, pJSON := range patientJSONs {
-			var patient map[string]interface{}
-			json.Unmarshal(pJSON, &patient)
-			allPatients = append(allPatients, patient)
+		patients, _ := helper.GetPatients()
+		for _, resource := range patients {
+			allPatients = append(allPatients, resource.(*r5.Patient))
 		}
 
 		fmt.Printf("Fetched page with %d patients (total so far: %d)\n",
-			len(patientJSONs), len(allPatients))
+			len(patients), len(allPatients))
 
 		// Get next page URL
 		if nextLink := helper.GetNextLink(); nextLink != nil {
@@ -154,7 +152,7 @@ func FilterBundleExample() {
 	var bundle fhir.Bundle
 	json.Unmarshal([]byte(bundleJSON), &bundle)
 
-	helper := fhir.NewBundleHelper(&bundle)
+	helper := fhir.NewBundleHelper(&bundle, r5.UnmarshalAny)
 
 	// Get resource type summary
 	types, _ := helper.GetResourceTypes()
@@ -167,12 +165,10 @@ func FilterBundleExample() {
 	// Filter active patients
 	fmt.Println("\nActive patients:")
 	patients, _ := helper.GetPatients()
-	for _, pJSON := range patients {
-		var patient map[string]interface{}
-		json.Unmarshal(pJSON, &patient)
-
-		if active, ok := patient["active"].(bool); ok && active {
-			fmt.Printf("  - Patient %s is active\n", patient["id"])
+	for _, resource := range patients {
+		patient := resource.(*r5.Patient)
+		if patient.Active != nil && *patient.Active {
+			fmt.Printf("  - Patient %s is active\n", *patient.ID)
 		}
 	}
 }
@@ -208,28 +204,21 @@ func ResolveReferencesExample() {
 	var bundle fhir.Bundle
 	json.Unmarshal([]byte(bundleJSON), &bundle)
 
-	helper := fhir.NewBundleHelper(&bundle)
+	helper := fhir.NewBundleHelper(&bundle, r5.UnmarshalAny)
 
 	// Get observations
 	observations, _ := helper.GetObservations()
 
-	for _, obsJSON := range observations {
-		var obs map[string]interface{}
-		json.Unmarshal(obsJSON, &obs)
-
-		// Get subject reference
-		if subject, ok := obs["subject"].(map[string]interface{}); ok {
-			if ref, ok := subject["reference"].(string); ok {
-				// Resolve reference
-				patientJSON, _ := helper.ResolveReference(ref)
-				if patientJSON != nil {
-					var patient map[string]interface{}
-					json.Unmarshal(patientJSON, &patient)
-
-					fmt.Printf("Observation %s is for patient: %s\n",
-						obs["id"],
-						patient["id"])
-				}
+	for _, resource := range observations {
+		obs := resource.(*r5.Observation)
+
+		// Resolve the subject reference
+		if obs.Subject != nil && obs.Subject.Reference != nil {
+			patient, _ := helper.ResolveReference(*obs.Subject.Reference)
+			if patient != nil {
+				fmt.Printf("Observation %s is for patient: %s\n",
+					*obs.ID,
+					*patient.GetID())
 			}
 		}
 	}
@@ -69,7 +69,7 @@ func createBloodPressureObservation() r5.Observation {
 					},
 				},
 				ValueQuantity: &r5.Quantity{
-					Value:  float64Ptr(120),
+					Value:  decimalPtr("120"),
 					Unit:   testutil.StringPtr("mmHg"),
 					System: testutil.StringPtr("http://unitsofmeasure.org"),
 					Code:   testutil.StringPtr("mm[Hg]"),
@@ -86,7 +86,7 @@ func createBloodPressureObservation() r5.Observation {
 					},
 				},
 				ValueQuantity: &r5.Quantity{
-					Value:  float64Ptr(80),
+					Value:  decimalPtr("80"),
 					Unit:   testutil.StringPtr("mmHg"),
 					System: testutil.StringPtr("http://unitsofmeasure.org"),
 					Code:   testutil.StringPtr("mm[Hg]"),
@@ -161,7 +161,7 @@ func createVitalSigns(patientID, encounterID *string) []*r5.Observation {
 					},
 				},
 				ValueQuantity: &r5.Quantity{
-					Value:  float64Ptr(120),
+					Value:  decimalPtr("120"),
 					Unit:   testutil.StringPtr("mmHg"),
 					System: testutil.StringPtr("http://unitsofmeasure.org"),
 					Code:   testutil.StringPtr("mm[Hg]"),
@@ -178,7 +178,7 @@ func createVitalSigns(patientID, encounterID *string) []*r5.Observation {
 					},
 				},
 				ValueQuantity: &r5.Quantity{
-					Value:  float64Ptr(80),
+					Value:  decimalPtr("80"),
 					Unit:   testutil.StringPtr("mmHg"),
 					System: testutil.StringPtr("http://unitsofmeasure.org"),
 					Code:   testutil.StringPtr("mm[Hg]"),
@@ -220,7 +220,7 @@ func createVitalSigns(patientID, encounterID *string) []*r5.Observation {
 		},
 		EffectiveDateTime: &effectiveDateTime,
 		ValueQuantity: &r5.Quantity{
-			Value:  float64Ptr(72),
+			Value:  decimalPtr("72"),
 			Unit:   testutil.StringPtr("beats/minute"),
 			System: testutil.StringPtr("http://unitsofmeasure.org"),
 			Code:   testutil.StringPtr("/min"),
@@ -273,7 +273,7 @@ func createBundle(patient *r5.Patient, encounter *r5.Encounter,
 		Type: "collection",
 	}
 
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	// Add all resources to bundle
 	_ = helper.AddEntry(patient, testutil.StringPtr(fmt.Sprintf("Patient/%s", *patient.ID)))
@@ -314,8 +314,9 @@ func validateBundle(bundle *fhir.Bundle) error {
 	return nil
 }
 
-func float64Ptr(f float64) *float64 {
-	return &f
+func decimalPtr(s string) *primitives.Decimal {
+	d := primitives.MustDecimal(s)
+	return &d
 }
 
 func min(a, b int) int {
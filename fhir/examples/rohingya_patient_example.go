@@ -20,14 +20,17 @@ func RohingyaPatientExample() {
 	)
 
 	// Set detailed shelter location
-	patient.SetShelterLocation(
+	if err := patient.SetShelterLocation(
 		"Camp 1E",     // Camp
 		"Block A",     // Block
 		"Sub-block 1", // Sub-block
 		"Shelter 101", // Shelter/House Number
-	)
+	); err != nil {
+		fmt.Printf("Invalid shelter location: %v\n", err)
+		return
+	}
 
-	fmt.Printf("Created Rohingya Patient: %s\n", *patient.ID)
+	fmt.Printf("Created Rohingya Patient: %s (FCN %s, camp %s)\n", *patient.ID, patient.FCN(), patient.Camp())
 }
 
 func stringPtrRohingya(s string) *string {
@@ -21,7 +21,7 @@ func main() {
 	bundle := loadSearchBundle()
 
 	// Create bundle helper for easy navigation
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	// Example 1: Count resources by type
 	fmt.Println("=== Resource Count ===")
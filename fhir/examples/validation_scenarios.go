@@ -60,7 +60,7 @@ func main() {
 		},
 		EffectiveDateTime: &effectiveDateTime,
 		ValueQuantity: &r5.Quantity{
-			Value:  float64Ptr(72),
+			Value:  decimalPtr("72"),
 			Unit:   testutil.StringPtr("beats/minute"),
 			System: testutil.StringPtr("http://unitsofmeasure.org"),
 			Code:   testutil.StringPtr("/min"),
@@ -221,8 +221,9 @@ func validateMultipleResources() {
 	fmt.Printf("\nResults: %d valid, %d invalid\n", validCount, invalidCount)
 }
 
-func float64Ptr(f float64) *float64 {
-	return &f
+func decimalPtr(s string) *primitives.Decimal {
+	d := primitives.MustDecimal(s)
+	return &d
 }
 
 func init() {
@@ -0,0 +1,187 @@
+package fhirpath
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// suite is the layout of the FHIRPath conformance suite files published
+// with the specification (tests-fhir-r4.xml).
+type suite struct {
+	Groups []struct {
+		Name  string `xml:"name,attr"`
+		Tests []struct {
+			Name       string `xml:"name,attr"`
+			InputFile  string `xml:"inputfile,attr"`
+			Predicate  string `xml:"predicate,attr"`
+			Expression struct {
+				Invalid string `xml:"invalid,attr"`
+				Text    string `xml:",chardata"`
+			} `xml:"expression"`
+			Outputs []output `xml:"output"`
+		} `xml:"test"`
+	} `xml:"group"`
+}
+
+// skipped lists the conformance tests that are known to fail, by name, with
+// the reason. A listed test still runs: it is reported as skipped while it
+// fails, and as an error once it passes, so that the list stays accurate.
+var skipped = map[string]string{
+	"testSimpleFail":             "semantic errors (invalid=\"semantic\") need a model of the core resources, which is not loaded",
+	"testSimpleWithWrongContext": "semantic errors (invalid=\"semantic\") need a model of the core resources, which is not loaded",
+	"testPolymorphismB":          "semantic errors (invalid=\"semantic\") need a model of the core resources, which is not loaded",
+}
+
+func TestConformance(t *testing.T) {
+	// The official suites are tests-*.xml; until they are vendored, a
+	// transcribed subset runs instead, which is not a conformance result
+	files, err := filepath.Glob(filepath.Join("testdata", "tests-*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Log("the official suite is not in testdata; running the transcribed subset-fhir-r4.xml, see testdata/README.md")
+		files = []string{filepath.Join("testdata", "subset-fhir-r4.xml")}
+	}
+	inputs := map[string][]byte{}
+	seen := map[string]bool{}
+	now := time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s suite
+		if err := xml.Unmarshal(data, &s); err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		for _, g := range s.Groups {
+			for _, tc := range g.Tests {
+				seen[tc.Name] = true
+				t.Run(g.Name+"/"+tc.Name, func(t *testing.T) {
+					input, ok := inputs[tc.InputFile]
+					if !ok {
+						input, err = loadInput(tc.InputFile)
+						if err != nil {
+							t.Fatal(err)
+						}
+						inputs[tc.InputFile] = input
+					}
+
+					err := runCase(input, tc.Expression.Text, tc.Expression.Invalid != "", tc.Predicate == "true", tc.Outputs, now)
+					if reason, ok := skipped[tc.Name]; ok {
+						if err == nil {
+							t.Errorf("passes; remove it from the skip list (%s)", reason)
+							return
+						}
+						t.Skipf("%s: %v", reason, err)
+					}
+					if err != nil {
+						t.Error(err)
+					}
+				})
+			}
+		}
+	}
+	for name := range skipped {
+		if !seen[name] {
+			t.Errorf("skip list names %s, which is not in the suites", name)
+		}
+	}
+}
+
+// loadInput returns the JSON of a suite input. The suite's inputs are FHIR
+// XML files, which are converted; when only the JSON form of an input is in
+// testdata, it is read instead.
+func loadInput(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err == nil {
+		if filepath.Ext(name) != ".xml" {
+			return data, nil
+		}
+		return xmlToJSON(data)
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join("testdata", strings.TrimSuffix(name, ".xml")+".json"))
+}
+
+// output is an expected value of a conformance test.
+type output struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// runCase evaluates one conformance test and returns how its result
+// differs from the expected outputs, or nil if it matches.
+func runCase(input []byte, expr string, invalid, predicate bool, outputs []output, now time.Time) error {
+	got, err := Evaluate(input, expr, WithNow(now))
+	if invalid {
+		if err == nil {
+			return fmt.Errorf("%s: want an error, got %s", expr, got)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", expr, err)
+	}
+	if predicate {
+		b, known, err := toBoolean(got)
+		if err != nil {
+			return err
+		}
+		got = Collection{Boolean(known && b)}
+	}
+	if len(got) != len(outputs) {
+		return fmt.Errorf("%s: got %s, want %d values", expr, got, len(outputs))
+	}
+	for i, want := range outputs {
+		if s := render(got[i], want.Type); s != want.Text {
+			return fmt.Errorf("%s: value %d is %s, want %s (%s)", expr, i, s, want.Text, want.Type)
+		}
+	}
+	return nil
+}
+
+// render formats a value as the suite writes outputs of type typ. Dates
+// read from JSON without a model are strings, written as the suite writes
+// dates.
+func render(v Value, typ string) string {
+	if e, ok := v.(*Element); ok {
+		if p, ok := e.primitive(); ok {
+			v = p
+		}
+	}
+	switch v := v.(type) {
+	case String:
+		switch typ {
+		case "date", "dateTime":
+			return "@" + string(v)
+		case "time":
+			return "@T" + string(v)
+		}
+		return string(v)
+	case Boolean:
+		return strconv.FormatBool(bool(v))
+	case Integer:
+		return strconv.FormatInt(int64(v), 10)
+	case Date, DateTime:
+		return "@" + v.(interface{ String() string }).String()
+	case Time:
+		return "@T" + v.String()
+	case Decimal:
+		return v.String()
+	case Quantity:
+		return v.String()
+	case *Element:
+		return v.String()
+	}
+	return Collection{v}.String()
+}
@@ -0,0 +1,648 @@
+package fhirpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Element is a node of the evaluated input: a resource, a data type value or
+// a primitive. It reads generated resource structs through their json tags,
+// and decoded JSON, alike.
+type Element struct {
+	name string // property the element was read from
+	typ  string // FHIR type, or "" if unknown
+	path string // definition path for the types of children, such as Patient.contact
+
+	rv   reflect.Value // a struct, for input read by reflection
+	json any           // otherwise the JSON value
+	// ext holds the id and extensions of a primitive, from the _name
+	// property that accompanies it in JSON.
+	ext map[string]any
+	// container is the resource the element is part of, for resolving
+	// references to contained resources.
+	container *Element
+}
+
+// Type implements Value. Elements whose type the input doesn't tell are
+// typed by their JSON value: string, boolean, integer or decimal for
+// primitives and Element otherwise.
+func (e *Element) Type() TypeInfo {
+	if e.typ != "" {
+		return TypeInfo{Namespace: "FHIR", Name: e.typ}
+	}
+	switch v := e.json.(type) {
+	case string:
+		return TypeInfo{Namespace: "FHIR", Name: "string"}
+	case bool:
+		return TypeInfo{Namespace: "FHIR", Name: "boolean"}
+	case json.Number:
+		if strings.ContainsAny(string(v), ".eE") {
+			return TypeInfo{Namespace: "FHIR", Name: "decimal"}
+		}
+		return TypeInfo{Namespace: "FHIR", Name: "integer"}
+	case float64:
+		return TypeInfo{Namespace: "FHIR", Name: "decimal"}
+	}
+	return TypeInfo{Namespace: "FHIR", Name: "Element"}
+}
+
+// Value returns the input the element stands for: a pointer to a generated
+// struct, or a decoded JSON value.
+func (e *Element) Value() any {
+	if e.rv.IsValid() {
+		if e.rv.CanAddr() {
+			return e.rv.Addr().Interface()
+		}
+		return e.rv.Interface()
+	}
+	return e.json
+}
+
+// String renders a primitive as its value and anything else as JSON.
+func (e *Element) String() string {
+	if v, ok := e.primitive(); ok {
+		if s, ok := v.(fmt.Stringer); ok {
+			return s.String()
+		}
+		return fmt.Sprint(v)
+	}
+	data, _ := json.Marshal(e.Value())
+	return string(data)
+}
+
+// isPrimitive reports whether the element is a primitive, possibly one
+// with only an id or extensions.
+func (e *Element) isPrimitive() bool {
+	if e.rv.IsValid() {
+		return false
+	}
+	switch e.json.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	return true
+}
+
+// primitive returns the System value of a primitive element.
+func (e *Element) primitive() (Value, bool) {
+	if !e.isPrimitive() || e.json == nil {
+		return nil, false
+	}
+	switch v := e.json.(type) {
+	case bool:
+		return Boolean(v), true
+	case float64:
+		d, err := ParseDecimal(fmt.Sprint(v))
+		return d, err == nil
+	case json.Number:
+		if isIntegerType(e.typ) || e.typ == "" && !strings.ContainsAny(string(v), ".eE") {
+			if n, err := v.Int64(); err == nil {
+				return Integer(n), true
+			}
+		}
+		d, err := ParseDecimal(string(v))
+		return d, err == nil
+	case string:
+		switch e.typ {
+		case "date":
+			if d, err := ParseDate(v); err == nil {
+				return d, true
+			}
+		case "dateTime", "instant":
+			if d, err := ParseDateTime(v); err == nil {
+				return d, true
+			}
+		case "time":
+			if t, err := ParseTime(v); err == nil {
+				return t, true
+			}
+		case "integer64":
+			var n Integer
+			if _, err := fmt.Sscan(v, &n); err == nil {
+				return n, true
+			}
+		}
+		return String(v), true
+	}
+	return nil, false
+}
+
+func isIntegerType(typ string) bool {
+	switch typ {
+	case "integer", "positiveInt", "unsignedInt", "integer64":
+		return true
+	}
+	return false
+}
+
+// object returns the JSON object of an element read from JSON, or the
+// primitive's id and extensions.
+func (e *Element) object() map[string]any {
+	if m, ok := e.json.(map[string]any); ok {
+		return m
+	}
+	return e.ext
+}
+
+// newElement makes the root element of an input: a generated struct or a
+// pointer to one, decoded JSON (an object or a primitive), or JSON bytes.
+func newElement(input any) (*Element, error) {
+	switch v := input.(type) {
+	case *Element:
+		return v, nil
+	case []byte:
+		return decodeElement(v)
+	case json.RawMessage:
+		return decodeElement(v)
+	case map[string]any:
+		return rootElement(v), nil
+	case string, bool, json.Number, float64:
+		return rootElement(v), nil
+	}
+	rv := reflect.ValueOf(input)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("fhirpath: nil input")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("fhirpath: cannot evaluate over %T", input)
+	}
+	e := &Element{rv: rv}
+	e.typ = structType(rv, "")
+	e.path = e.typ
+	return e, nil
+}
+
+func decodeElement(data []byte) (*Element, error) {
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("fhirpath: %w", err)
+	}
+	return rootElement(v), nil
+}
+
+func rootElement(v any) *Element {
+	e := jsonElement(v, "", "", nil)
+	e.path = e.typ
+	return e
+}
+
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// jsonElement wraps a JSON value. Resources are typed by resourceType.
+func jsonElement(v any, name, typ string, ext map[string]any) *Element {
+	e := &Element{name: name, typ: typ, json: v, ext: ext}
+	if m, ok := v.(map[string]any); ok {
+		if rt, ok := m["resourceType"].(string); ok && rt != "" {
+			e.typ = rt
+		}
+	}
+	return e
+}
+
+// children returns the values of a property. A choice property such as
+// value also returns valueQuantity, typed by its suffix.
+func (e *Element) children(name string, m Model) []*Element {
+	if e.rv.IsValid() {
+		return e.structChildren(name, m)
+	}
+	o := e.object()
+	if o == nil {
+		return nil
+	}
+	if _, ok := o[name]; ok || o["_"+name] != nil {
+		return e.jsonProperty(o, name, name, e.childType(name, m))
+	}
+	var keys []string
+	for k := range o {
+		if k == "resourceType" {
+			continue
+		}
+		if typ, ok := choiceSuffix(k, name); ok && typ != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var out []*Element
+	for _, k := range keys {
+		typ, _ := choiceSuffix(k, name)
+		out = append(out, e.jsonProperty(o, k, name, typ)...)
+	}
+	return out
+}
+
+// jsonProperty returns the values of o[key] as elements named name.
+func (e *Element) jsonProperty(o map[string]any, key, name, typ string) []*Element {
+	values, exts := listOf(o[key]), listOf(o["_"+key])
+	n := max(len(values), len(exts))
+	out := make([]*Element, 0, n)
+	for i := 0; i < n; i++ {
+		var v any
+		var ext map[string]any
+		if i < len(values) {
+			v = values[i]
+		}
+		if i < len(exts) {
+			ext, _ = exts[i].(map[string]any)
+		}
+		if v == nil && ext == nil {
+			continue
+		}
+		c := jsonElement(v, name, typ, ext)
+		c.path = e.childPath(name, c.typ)
+		c.container = e.enclosing()
+		out = append(out, c)
+	}
+	return out
+}
+
+func listOf(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	}
+	return []any{v}
+}
+
+// enclosing returns the resource an element's children are part of: the
+// element itself if it is a resource.
+func (e *Element) enclosing() *Element {
+	if e.isResource() {
+		return e
+	}
+	return e.container
+}
+
+// childType returns the type of a property from the model.
+func (e *Element) childType(name string, m Model) string {
+	if m == nil || e.path == "" {
+		return ""
+	}
+	return m.ElementType(e.path + "." + name)
+}
+
+// childPath returns the definition path of a child: its type when that is
+// a named type, and otherwise the path below the parent's.
+func (e *Element) childPath(name, typ string) string {
+	switch {
+	case typ != "" && typ != "BackboneElement" && typ != "Element" && isUpper(typ):
+		return typ
+	case e.path != "":
+		return e.path + "." + name
+	}
+	return ""
+}
+
+func isUpper(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
+
+// primitiveTypes lists the FHIR primitive types, which choice suffixes
+// capitalize.
+var primitiveTypes = []string{
+	"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal",
+	"id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt",
+	"string", "time", "unsignedInt", "uri", "url", "uuid", "xhtml",
+}
+
+// choiceSuffix returns the type a choice property such as valueQuantity
+// names for the choice name value.
+func choiceSuffix(key, name string) (string, bool) {
+	if !strings.HasPrefix(key, name) || len(key) == len(name) {
+		return "", false
+	}
+	suffix := key[len(name):]
+	if !isUpper(suffix) {
+		return "", false
+	}
+	for _, p := range primitiveTypes {
+		// Suffixes are matched ignoring case, as the generated structs
+		// spell some acronyms in capitals (valueURI)
+		if strings.EqualFold(p, suffix) {
+			return p, true
+		}
+	}
+	return suffix, true
+}
+
+// structField is a property of a generated struct, found through its json
+// tag.
+type structField struct {
+	name  string
+	index []int
+}
+
+var structFieldCache sync.Map // reflect.Type -> []structField
+
+// fieldsOf lists the JSON properties of a struct type, including those of
+// embedded structs such as r5.DomainResource.
+func fieldsOf(t reflect.Type) []structField {
+	if f, ok := structFieldCache.Load(t); ok {
+		return f.([]structField)
+	}
+	var fields []structField
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			idx := append(append([]int(nil), index...), i)
+			tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if f.Anonymous && tag == "" {
+				ft := f.Type
+				if ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					walk(ft, idx)
+					continue
+				}
+			}
+			if !f.IsExported() || tag == "-" {
+				continue
+			}
+			if tag == "" {
+				tag = f.Name
+			}
+			fields = append(fields, structField{name: tag, index: idx})
+		}
+	}
+	walk(t, nil)
+	structFieldCache.Store(t, fields)
+	return fields
+}
+
+// field returns the value of a struct field by index, or an invalid value
+// when an embedded pointer on the way is nil.
+func field(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 {
+			for v.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					return reflect.Value{}
+				}
+				v = v.Elem()
+			}
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+func (e *Element) structChildren(name string, m Model) []*Element {
+	if c, ok := e.structExact(name, e.childType(name, m)); ok {
+		return c
+	}
+	var out []*Element
+	for _, f := range fieldsOf(e.rv.Type()) {
+		if typ, ok := choiceSuffix(f.name, name); ok {
+			c, _ := e.structExact(f.name, typ)
+			for _, x := range c {
+				x.name = name
+			}
+			out = append(out, c...)
+		}
+	}
+	return out
+}
+
+// structExact returns the values of the struct field with the JSON name
+// key, and whether there is such a field.
+func (e *Element) structExact(key, typ string) ([]*Element, bool) {
+	fields := fieldsOf(e.rv.Type())
+	var companion reflect.Value
+	for _, f := range fields {
+		if f.name == "_"+key {
+			companion = field(e.rv, f.index)
+		}
+	}
+	for _, f := range fields {
+		if f.name == key {
+			return e.structProperty(field(e.rv, f.index), companion, key, typ), true
+		}
+	}
+	return nil, false
+}
+
+var (
+	jsonMarshaler = reflect.TypeFor[json.Marshaler]()
+	rawMessage    = reflect.TypeFor[json.RawMessage]()
+)
+
+// structProperty returns the values of a struct field as elements.
+func (e *Element) structProperty(v, companion reflect.Value, name, typ string) []*Element {
+	values := items(v)
+	var exts []any
+	if c := items(companion); len(c) > 0 {
+		for _, x := range c {
+			ext, _ := toJSON(x)
+			exts = append(exts, ext)
+		}
+	}
+	n := max(len(values), len(exts))
+	out := make([]*Element, 0, n)
+	for i := 0; i < n; i++ {
+		var ext map[string]any
+		if i < len(exts) {
+			ext, _ = exts[i].(map[string]any)
+		}
+		var c *Element
+		if i < len(values) {
+			c = e.structValue(values[i], name, typ)
+		}
+		if c == nil {
+			if ext == nil {
+				continue
+			}
+			c = &Element{name: name, typ: typ}
+		}
+		c.ext = ext
+		if c.path == "" {
+			c.path = e.childPath(name, c.typ)
+		}
+		c.container = e.enclosing()
+		out = append(out, c)
+	}
+	return out
+}
+
+// items returns the values of a field: the items of a slice, or the value
+// itself, dereferenced. Nil values are skipped.
+func items(v reflect.Value) []reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Kind() == reflect.Slice && v.Type() != rawMessage && v.Type().Elem().Kind() != reflect.Uint8 {
+		var out []reflect.Value
+		for i := 0; i < v.Len(); i++ {
+			out = append(out, items(v.Index(i))...)
+		}
+		return out
+	}
+	if v.Kind() == reflect.String && v.Len() == 0 {
+		// A required string field left empty is absent, as in JSON
+		return nil
+	}
+	return []reflect.Value{v}
+}
+
+// structValue wraps one value of a struct field.
+func (e *Element) structValue(v reflect.Value, name, typ string) *Element {
+	if v.Kind() == reflect.Struct && !v.Type().Implements(jsonMarshaler) && !reflect.PointerTo(v.Type()).Implements(jsonMarshaler) {
+		c := &Element{name: name, rv: v, typ: typ}
+		if c.typ == "" {
+			c.typ = structType(v, e.rv.Type().Name())
+		}
+		c.path = e.childPath(name, c.typ)
+		return c
+	}
+	j, err := toJSON(v)
+	if err != nil {
+		return nil
+	}
+	if typ == "" {
+		typ = leafType(v.Type())
+	}
+	c := jsonElement(j, name, typ, nil)
+	c.path = e.childPath(name, c.typ)
+	return c
+}
+
+// structType returns the FHIR type of a generated struct: the resource type
+// of a resource, BackboneElement for a struct named after the struct it is
+// in, such as PatientContact in Patient, and otherwise the struct's name.
+func structType(v reflect.Value, parent string) string {
+	t := v.Type()
+	if f, ok := t.FieldByName("ResourceType"); ok && f.Type.Kind() == reflect.String {
+		if rt := field(v, f.Index); rt.IsValid() && rt.String() != "" {
+			return rt.String()
+		}
+		return t.Name()
+	}
+	if parent != "" && len(t.Name()) > len(parent) && strings.HasPrefix(t.Name(), parent) {
+		return "BackboneElement"
+	}
+	return t.Name()
+}
+
+// leafType returns the FHIR type of a Go primitive when it tells one.
+func leafType(t reflect.Type) string {
+	switch t.Name() {
+	case "Date":
+		return "date"
+	case "DateTime":
+		return "dateTime"
+	case "Instant":
+		return "instant"
+	case "Time":
+		return "time"
+	case "Decimal":
+		return "decimal"
+	case "Base64Binary":
+		return "base64Binary"
+	case "Canonical":
+		return "canonical"
+	case "Integer64":
+		return "integer64"
+	case "Uri":
+		return "uri"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "decimal"
+	}
+	return ""
+}
+
+// toJSON converts a Go value to its decoded JSON form.
+func toJSON(v reflect.Value) (any, error) {
+	if v.Type() == rawMessage {
+		return decodeJSON(v.Bytes())
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(data)
+}
+
+// allChildren returns the values of every property, in property order for
+// structs and key order for JSON.
+func (e *Element) allChildren(m Model) []*Element {
+	var names []string
+	if e.rv.IsValid() {
+		seen := make(map[string]bool)
+		for _, f := range fieldsOf(e.rv.Type()) {
+			name := strings.TrimPrefix(f.name, "_")
+			if !seen[name] && name != "resourceType" {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	} else {
+		o := e.object()
+		seen := make(map[string]bool)
+		for k := range o {
+			name := strings.TrimPrefix(k, "_")
+			if !seen[name] && name != "resourceType" {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	var out []*Element
+	for _, name := range names {
+		out = append(out, e.exactChildren(name, m)...)
+	}
+	return out
+}
+
+// exactChildren returns the values of the property with exactly this name.
+func (e *Element) exactChildren(key string, m Model) []*Element {
+	if e.rv.IsValid() {
+		c, _ := e.structExact(key, e.childType(key, m))
+		return c
+	}
+	return e.jsonProperty(e.object(), key, key, e.childType(key, m))
+}
+
+// toJSONValue returns the decoded JSON form of the element, for comparing
+// complex values.
+func (e *Element) toJSONValue() any {
+	if e.rv.IsValid() {
+		v, _ := toJSON(e.rv)
+		return v
+	}
+	return e.json
+}
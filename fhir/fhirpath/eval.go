@@ -0,0 +1,974 @@
+package fhirpath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// evaluator holds the state of one evaluation.
+type evaluator struct {
+	opts     *options
+	context  Collection // %context, the input
+	resource Collection // %resource
+	root     Collection // %rootResource
+	now      time.Time
+}
+
+// frame is the scope of the expression an iterating function, such as
+// where, evaluates for each item.
+type frame struct {
+	this  Value
+	index int
+	total Collection
+}
+
+// focus returns the input of the expressions evaluated in f: $this, or
+// the evaluation input outside any iterating function.
+func (ev *evaluator) focus(f *frame) Collection {
+	if f != nil && f.this != nil {
+		return Collection{f.this}
+	}
+	return ev.context
+}
+
+func (ev *evaluator) eval(n node, input Collection, f *frame) (Collection, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		return n.value, nil
+	case *identNode:
+		return ev.member(input, n.name, true), nil
+	case *specialNode:
+		switch n.name {
+		case "$this":
+			return ev.focus(f), nil
+		case "$index":
+			if f == nil || f.this == nil {
+				return nil, fmt.Errorf("fhirpath: $index used outside an iterating function")
+			}
+			return Collection{Integer(f.index)}, nil
+		case "$total":
+			if f == nil {
+				return nil, fmt.Errorf("fhirpath: $total used outside aggregate")
+			}
+			return f.total, nil
+		}
+		return nil, fmt.Errorf("fhirpath: unknown %s", n.name)
+	case *variableNode:
+		return ev.variable(n.name)
+	case *funcNode:
+		return ev.call(n, input, f)
+	case *invokeNode:
+		target, err := ev.eval(n.target, input, f)
+		if err != nil {
+			return nil, err
+		}
+		switch m := n.member.(type) {
+		case *identNode:
+			return ev.member(target, m.name, false), nil
+		case *funcNode:
+			return ev.call(m, target, f)
+		}
+		return ev.eval(n.member, target, f)
+	case *indexNode:
+		target, err := ev.eval(n.target, input, f)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := ev.eval(n.index, input, f)
+		if err != nil {
+			return nil, err
+		}
+		i, ok, err := integerOf(idx)
+		if err != nil || !ok {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(target) {
+			return nil, nil
+		}
+		return Collection{target[i]}, nil
+	case *unaryNode:
+		c, err := ev.eval(n.operand, input, f)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "+" {
+			return c, nil
+		}
+		v, err := singleton(c)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		switch v := normalize(v).(type) {
+		case Integer:
+			return Collection{-v}, nil
+		case Decimal:
+			return Collection{Decimal{r: new(big.Rat).Neg(v.rat()), scale: v.scale}}, nil
+		case Quantity:
+			return Collection{Quantity{Value: Decimal{r: new(big.Rat).Neg(v.Value.rat()), scale: v.Value.scale}, Unit: v.Unit}}, nil
+		}
+		return nil, fmt.Errorf("fhirpath: cannot negate %s", v.Type())
+	case *typeNode:
+		c, err := ev.eval(n.operand, input, f)
+		if err != nil {
+			return nil, err
+		}
+		return typeOperator(n.op, c, n.typ)
+	case *binaryNode:
+		return ev.binary(n, input, f)
+	}
+	return nil, fmt.Errorf("fhirpath: cannot evaluate %s", n)
+}
+
+// member returns the values of a property of each item. At the start of an
+// expression, a type name such as Patient selects the items of that type
+// instead.
+func (ev *evaluator) member(input Collection, name string, first bool) Collection {
+	var out Collection
+	for _, v := range input {
+		switch v := v.(type) {
+		case *Element:
+			if first && isTypeName(name) {
+				if v.is(name) {
+					out = append(out, v)
+				}
+				continue
+			}
+			for _, c := range v.children(name, ev.opts.model) {
+				out = append(out, c)
+			}
+		case typeInfoValue:
+			switch name {
+			case "namespace":
+				out = append(out, String(v.Namespace))
+			case "name":
+				out = append(out, String(v.Name))
+			}
+		}
+	}
+	return out
+}
+
+// variable returns an environment variable: one set by an option, or one
+// the specification defines.
+func (ev *evaluator) variable(name string) (Collection, error) {
+	if v, ok := ev.opts.vars[name]; ok {
+		return v, nil
+	}
+	switch name {
+	case "context":
+		return ev.context, nil
+	case "resource":
+		return ev.resource, nil
+	case "rootResource":
+		return ev.root, nil
+	case "ucum":
+		return Collection{String("http://unitsofmeasure.org")}, nil
+	case "sct":
+		return Collection{String("http://snomed.info/sct")}, nil
+	case "loinc":
+		return Collection{String("http://loinc.org")}, nil
+	}
+	if id, ok := strings.CutPrefix(name, "vs-"); ok {
+		return Collection{String("http://hl7.org/fhir/ValueSet/" + id)}, nil
+	}
+	if id, ok := strings.CutPrefix(name, "ext-"); ok {
+		return Collection{String("http://hl7.org/fhir/StructureDefinition/" + id)}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: unknown variable %%%s", name)
+}
+
+// singleton returns the only item of a collection, nil if it is empty, or
+// an error if it has more.
+func singleton(c Collection) (Value, error) {
+	switch len(c) {
+	case 0:
+		return nil, nil
+	case 1:
+		return c[0], nil
+	}
+	return nil, fmt.Errorf("fhirpath: expected a single value, got %d", len(c))
+}
+
+// normalize converts a primitive element to its System value and a
+// quantity element to a System.Quantity. Other values are returned as they
+// are.
+func normalize(v Value) Value {
+	e, ok := v.(*Element)
+	if !ok {
+		return v
+	}
+	if p, ok := e.primitive(); ok {
+		return p
+	}
+	if isQuantityType(e.typ) {
+		if q, ok := e.quantity(); ok {
+			return q
+		}
+	}
+	return v
+}
+
+var quantityTypes = map[string]bool{
+	"Quantity": true, "Age": true, "Count": true, "Distance": true, "Duration": true,
+	"MoneyQuantity": true, "SimpleQuantity": true,
+}
+
+func isQuantityType(typ string) bool { return quantityTypes[typ] }
+
+// quantity reads a Quantity element as a System.Quantity, using its UCUM
+// code when it has one.
+func (e *Element) quantity() (Quantity, bool) {
+	o, ok := e.toJSONValue().(map[string]any)
+	if !ok {
+		return Quantity{}, false
+	}
+	d, ok := decimalOfJSON(o["value"])
+	if !ok {
+		return Quantity{}, false
+	}
+	unit, _ := o["unit"].(string)
+	if code, ok := o["code"].(string); ok && (o["system"] == nil || o["system"] == "http://unitsofmeasure.org") {
+		unit = code
+	}
+	if unit == "" {
+		unit = "1"
+	}
+	return Quantity{Value: d, Unit: unit}, true
+}
+
+func decimalOfJSON(v any) (Decimal, bool) {
+	switch v := v.(type) {
+	case fmt.Stringer:
+		d, err := ParseDecimal(v.String())
+		return d, err == nil
+	case float64:
+		d, err := ParseDecimal(fmt.Sprint(v))
+		return d, err == nil
+	}
+	return Decimal{}, false
+}
+
+// untypedString reports whether v is a primitive element read from a JSON
+// string of unknown type, which compares with dates and times as one.
+func untypedString(v Value) bool {
+	e, ok := v.(*Element)
+	if !ok {
+		return false
+	}
+	_, isString := e.json.(string)
+	return isString && (e.typ == "" || e.typ == "string")
+}
+
+// coerce normalizes two operands and applies the implicit conversions of
+// FHIRPath: Integer to Decimal, Date to DateTime and, for strings read from
+// JSON without their type, String to Date, DateTime or Time.
+func coerce(a, b Value) (Value, Value) {
+	ua, ub := untypedString(a), untypedString(b)
+	a, b = normalize(a), normalize(b)
+	if ua {
+		a = parseTemporalLike(a, b)
+	}
+	if ub {
+		b = parseTemporalLike(b, a)
+	}
+	switch x := a.(type) {
+	case Integer:
+		if _, ok := b.(Decimal); ok {
+			a = DecimalFromInt(int64(x))
+		}
+		if q, ok := b.(Quantity); ok {
+			a, b = Quantity{Value: DecimalFromInt(int64(x)), Unit: "1"}, q
+		}
+	case Decimal:
+		if y, ok := b.(Integer); ok {
+			b = DecimalFromInt(int64(y))
+		}
+	case Date:
+		if _, ok := b.(DateTime); ok {
+			a = x.dateTime()
+		}
+	case DateTime:
+		if y, ok := b.(Date); ok {
+			b = y.dateTime()
+		}
+	}
+	if y, ok := b.(Integer); ok {
+		if _, ok := a.(Quantity); ok {
+			b = Quantity{Value: DecimalFromInt(int64(y)), Unit: "1"}
+		}
+	}
+	if e, ok := a.(*Element); ok {
+		if _, ok := b.(Quantity); ok {
+			if q, ok := e.quantity(); ok {
+				a = q
+			}
+		}
+	}
+	if e, ok := b.(*Element); ok {
+		if _, ok := a.(Quantity); ok {
+			if q, ok := e.quantity(); ok {
+				b = q
+			}
+		}
+	}
+	return a, b
+}
+
+// parseTemporalLike parses a string as the kind of temporal value other is.
+func parseTemporalLike(v, other Value) Value {
+	s, ok := v.(String)
+	if !ok {
+		return v
+	}
+	switch other.(type) {
+	case Date:
+		if d, err := ParseDate(string(s)); err == nil {
+			return d
+		}
+		if d, err := ParseDateTime(string(s)); err == nil {
+			return d
+		}
+	case DateTime:
+		if d, err := ParseDateTime(string(s)); err == nil {
+			return d
+		}
+	case Time:
+		if t, err := ParseTime(string(s)); err == nil {
+			return t
+		}
+	}
+	return v
+}
+
+// equal compares two items for =. ok is false when the result is unknown,
+// as for dates of different precision.
+func equal(a, b Value) (eq, ok bool) {
+	a, b = coerce(a, b)
+	switch x := a.(type) {
+	case Boolean, String, Integer:
+		return a == b, true
+	case Decimal:
+		y, isDec := b.(Decimal)
+		return isDec && x.Cmp(y) == 0, true
+	case Date:
+		if y, same := b.(Date); same {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c == 0, ok
+		}
+		if y, isDT := b.(DateTime); isDT {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c == 0, ok
+		}
+	case DateTime:
+		if y, same := b.(DateTime); same {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c == 0, ok
+		}
+		if y, isDate := b.(Date); isDate {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c == 0, ok
+		}
+	case Time:
+		if y, same := b.(Time); same {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c == 0, ok
+		}
+	case Quantity:
+		y, isQty := b.(Quantity)
+		if !isQty {
+			return false, true
+		}
+		if inexactDuration(x.Unit) != inexactDuration(y.Unit) && isDuration(x.Unit) && isDuration(y.Unit) {
+			// Calendar years and months are not UCUM years and months
+			return false, false
+		}
+		v, ok := convertQuantity(y, quantityUnit(x.Unit))
+		if !ok {
+			return false, true
+		}
+		return x.Value.Cmp(v) == 0, true
+	case *Element:
+		y, isElem := b.(*Element)
+		if !isElem {
+			return false, true
+		}
+		return reflect.DeepEqual(x.toJSONValue(), y.toJSONValue()), true
+	case typeInfoValue:
+		return a == b, true
+	}
+	return false, true
+}
+
+// inexactDuration reports whether a unit is the calendar year or month
+// keyword.
+func inexactDuration(unit string) bool {
+	u := calendarUnit(unit)
+	return u == "year" || u == "month"
+}
+
+func isDuration(unit string) bool { return durationUnit(unit) != "" }
+
+// quantityUnit returns the unit used to convert to a quantity's unit,
+// counting calendar years and months as UCUM years and months.
+func quantityUnit(unit string) string {
+	switch calendarUnit(unit) {
+	case "year":
+		return "a"
+	case "month":
+		return "mo"
+	}
+	return unit
+}
+
+// equivalent compares two items for ~.
+func equivalent(a, b Value) bool {
+	a, b = coerce(a, b)
+	switch x := a.(type) {
+	case String:
+		y, ok := b.(String)
+		return ok && normalizeSpace(string(x)) == normalizeSpace(string(y))
+	case Decimal:
+		y, ok := b.(Decimal)
+		if !ok {
+			return false
+		}
+		scale := min(x.scale, y.scale)
+		return roundDecimal(x.rat(), scale).Cmp(roundDecimal(y.rat(), scale)) == 0
+	case Quantity:
+		y, ok := b.(Quantity)
+		if !ok {
+			return false
+		}
+		v, ok := convertQuantity(y, quantityUnit(x.Unit))
+		if !ok {
+			return false
+		}
+		scale := min(x.Value.scale, v.scale)
+		return roundDecimal(x.Value.rat(), scale).Cmp(roundDecimal(v.rat(), scale)) == 0
+	case *Element:
+		y, ok := b.(*Element)
+		return ok && equivalentJSON(x.toJSONValue(), y.toJSONValue())
+	}
+	eq, ok := equal(a, b)
+	return eq && ok
+}
+
+func normalizeSpace(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// equivalentJSON compares complex values for ~, ignoring ids and the case
+// and spacing of strings.
+func equivalentJSON(a, b any) bool {
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok {
+			return false
+		}
+		keys := make(map[string]bool)
+		for k := range x {
+			keys[k] = true
+		}
+		for k := range y {
+			keys[k] = true
+		}
+		for k := range keys {
+			if k == "id" {
+				continue
+			}
+			if !equivalentJSON(x[k], y[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equivalentJSON(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		y, ok := b.(string)
+		return ok && normalizeSpace(x) == normalizeSpace(y)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// equalCollections implements =: empty when either side is empty or an
+// item comparison is unknown.
+func equalCollections(a, b Collection) Collection {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	if len(a) != len(b) {
+		return Collection{Boolean(false)}
+	}
+	unknown := false
+	for i := range a {
+		eq, ok := equal(a[i], b[i])
+		if !ok {
+			unknown = true
+			continue
+		}
+		if !eq {
+			return Collection{Boolean(false)}
+		}
+	}
+	if unknown {
+		return nil
+	}
+	return Collection{Boolean(true)}
+}
+
+// equivalentCollections implements ~, which ignores order.
+func equivalentCollections(a, b Collection) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	used := make([]bool, len(b))
+	for _, x := range a {
+		found := false
+		for j, y := range b {
+			if !used[j] && equivalent(x, y) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// compare orders two items. ok is false when the order is unknown.
+func compare(a, b Value) (int, bool, error) {
+	a, b = coerce(a, b)
+	switch x := a.(type) {
+	case Integer:
+		if y, same := b.(Integer); same {
+			switch {
+			case x < y:
+				return -1, true, nil
+			case x > y:
+				return 1, true, nil
+			}
+			return 0, true, nil
+		}
+	case Decimal:
+		if y, same := b.(Decimal); same {
+			return x.Cmp(y), true, nil
+		}
+	case String:
+		if y, same := b.(String); same {
+			return strings.Compare(string(x), string(y)), true, nil
+		}
+	case Date:
+		if y, same := b.(Date); same {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c, ok, nil
+		}
+	case DateTime:
+		if y, same := b.(DateTime); same {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c, ok, nil
+		}
+	case Time:
+		if y, same := b.(Time); same {
+			c, ok := compareTemporal(x.temporal, y.temporal)
+			return c, ok, nil
+		}
+	case Quantity:
+		if y, same := b.(Quantity); same {
+			if inexactDuration(x.Unit) != inexactDuration(y.Unit) && isDuration(x.Unit) && isDuration(y.Unit) {
+				return 0, false, nil
+			}
+			v, ok := convertQuantity(y, quantityUnit(x.Unit))
+			if !ok {
+				return 0, false, nil
+			}
+			return x.Value.Cmp(v), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("fhirpath: cannot compare %s and %s", a.Type(), b.Type())
+}
+
+// toBoolean evaluates a collection as a boolean: empty is unknown, a single
+// Boolean is its value and any other single item is true.
+func toBoolean(c Collection) (b, known bool, err error) {
+	v, err := singleton(c)
+	if err != nil || v == nil {
+		return false, false, err
+	}
+	if x, ok := normalize(v).(Boolean); ok {
+		return bool(x), true, nil
+	}
+	return true, true, nil
+}
+
+func boolResult(b, known bool) Collection {
+	if !known {
+		return nil
+	}
+	return Collection{Boolean(b)}
+}
+
+func (ev *evaluator) binary(n *binaryNode, input Collection, f *frame) (Collection, error) {
+	left, err := ev.eval(n.left, input, f)
+	if err != nil {
+		return nil, err
+	}
+	right, err := ev.eval(n.right, input, f)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "and", "or", "xor", "implies":
+		return logical(n.op, left, right)
+	case "=":
+		return equalCollections(left, right), nil
+	case "!=":
+		r := equalCollections(left, right)
+		if len(r) == 1 {
+			r = Collection{!r[0].(Boolean)}
+		}
+		return r, nil
+	case "~":
+		return Collection{Boolean(equivalentCollections(left, right))}, nil
+	case "!~":
+		return Collection{Boolean(!equivalentCollections(left, right))}, nil
+	case "<", ">", "<=", ">=":
+		a, err := singleton(left)
+		if err != nil {
+			return nil, err
+		}
+		b, err := singleton(right)
+		if err != nil || a == nil || b == nil {
+			return nil, err
+		}
+		c, ok, err := compare(a, b)
+		if err != nil || !ok {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return Collection{Boolean(c < 0)}, nil
+		case ">":
+			return Collection{Boolean(c > 0)}, nil
+		case "<=":
+			return Collection{Boolean(c <= 0)}, nil
+		}
+		return Collection{Boolean(c >= 0)}, nil
+	case "|":
+		return union(left, right), nil
+	case "in":
+		return membership(left, right)
+	case "contains":
+		return membership(right, left)
+	case "&":
+		a, err := singleton(left)
+		if err != nil {
+			return nil, err
+		}
+		b, err := singleton(right)
+		if err != nil {
+			return nil, err
+		}
+		return Collection{String(stringOf(a) + stringOf(b))}, nil
+	}
+	return arithmetic(n.op, left, right)
+}
+
+// stringOf returns the string of a String item, or "" for none.
+func stringOf(v Value) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := normalize(v).(String); ok {
+		return string(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// logical implements the three-valued and, or, xor and implies.
+func logical(op string, left, right Collection) (Collection, error) {
+	a, ka, err := toBoolean(left)
+	if err != nil {
+		return nil, err
+	}
+	b, kb, err := toBoolean(right)
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "and":
+		if ka && !a || kb && !b {
+			return boolResult(false, true), nil
+		}
+		return boolResult(true, ka && kb), nil
+	case "or":
+		if ka && a || kb && b {
+			return boolResult(true, true), nil
+		}
+		return boolResult(false, ka && kb), nil
+	case "xor":
+		return boolResult(a != b, ka && kb), nil
+	}
+	// implies
+	switch {
+	case ka && !a, kb && b:
+		return boolResult(true, true), nil
+	case ka && a:
+		return boolResult(false, kb), nil
+	}
+	return nil, nil
+}
+
+// union returns the items of both collections without duplicates.
+func union(a, b Collection) Collection {
+	var out Collection
+	for _, v := range append(append(Collection(nil), a...), b...) {
+		if !containsValue(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func containsValue(c Collection, v Value) bool {
+	for _, x := range c {
+		if eq, ok := equal(x, v); eq && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func membership(item, c Collection) (Collection, error) {
+	v, err := singleton(item)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return Collection{Boolean(containsValue(c, v))}, nil
+}
+
+// arithmetic implements + - * / div and mod.
+func arithmetic(op string, left, right Collection) (Collection, error) {
+	x, err := singleton(left)
+	if err != nil {
+		return nil, err
+	}
+	y, err := singleton(right)
+	if err != nil || x == nil || y == nil {
+		return nil, err
+	}
+	a, b := coerce(x, y)
+	fail := func() (Collection, error) {
+		return nil, fmt.Errorf("fhirpath: cannot apply %s to %s and %s", op, a.Type(), b.Type())
+	}
+	switch a := a.(type) {
+	case Integer:
+		b, ok := b.(Integer)
+		if !ok {
+			return fail()
+		}
+		if op == "/" {
+			return decimalArithmetic(op, DecimalFromInt(int64(a)), DecimalFromInt(int64(b)))
+		}
+		return integerArithmetic(op, a, b)
+	case Decimal:
+		b, ok := b.(Decimal)
+		if !ok {
+			return fail()
+		}
+		return decimalArithmetic(op, a, b)
+	case String:
+		b, ok := b.(String)
+		if !ok || op != "+" {
+			return fail()
+		}
+		return Collection{a + b}, nil
+	case Date, DateTime, Time:
+		q, ok := b.(Quantity)
+		if !ok || op != "+" && op != "-" {
+			return fail()
+		}
+		return temporalArithmetic(a, q, op == "-")
+	case Quantity:
+		return quantityArithmetic(op, a, b)
+	}
+	return fail()
+}
+
+func integerArithmetic(op string, a, b Integer) (Collection, error) {
+	var r *big.Int
+	x, y := big.NewInt(int64(a)), big.NewInt(int64(b))
+	switch op {
+	case "+":
+		r = x.Add(x, y)
+	case "-":
+		r = x.Sub(x, y)
+	case "*":
+		r = x.Mul(x, y)
+	case "div":
+		if b == 0 {
+			return nil, nil
+		}
+		r = x.Quo(x, y)
+	case "mod":
+		if b == 0 {
+			return nil, nil
+		}
+		r = x.Rem(x, y)
+	default:
+		return nil, fmt.Errorf("fhirpath: unknown operator %s", op)
+	}
+	if !r.IsInt64() {
+		// Overflow gives no result
+		return nil, nil
+	}
+	return Collection{Integer(r.Int64())}, nil
+}
+
+func decimalArithmetic(op string, a, b Decimal) (Collection, error) {
+	x, y := a.rat(), b.rat()
+	switch op {
+	case "+":
+		return Collection{Decimal{r: new(big.Rat).Add(x, y), scale: max(a.scale, b.scale)}}, nil
+	case "-":
+		return Collection{Decimal{r: new(big.Rat).Sub(x, y), scale: max(a.scale, b.scale)}}, nil
+	case "*":
+		return Collection{decimalOf(new(big.Rat).Mul(x, y), min(a.scale+b.scale, maxScale))}, nil
+	case "/":
+		if y.Sign() == 0 {
+			return nil, nil
+		}
+		return Collection{decimalOf(new(big.Rat).Quo(x, y), 0)}, nil
+	case "div":
+		if y.Sign() == 0 {
+			return nil, nil
+		}
+		return Collection{Integer(truncate(new(big.Rat).Quo(x, y)))}, nil
+	case "mod":
+		if y.Sign() == 0 {
+			return nil, nil
+		}
+		q := new(big.Rat).SetInt64(truncate(new(big.Rat).Quo(x, y)))
+		r := new(big.Rat).Sub(x, q.Mul(q, y))
+		return Collection{Decimal{r: r, scale: max(a.scale, b.scale)}}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: unknown operator %s", op)
+}
+
+func temporalArithmetic(v Value, q Quantity, negate bool) (Collection, error) {
+	var t temporal
+	switch v := v.(type) {
+	case Date:
+		t = v.temporal
+	case DateTime:
+		t = v.temporal
+	case Time:
+		t = v.temporal
+		if u := durationUnit(q.Unit); u == "year" || u == "month" || u == "week" || u == "day" {
+			return nil, fmt.Errorf("fhirpath: cannot add %s to a time", q)
+		}
+	}
+	if durationUnit(q.Unit) == "" {
+		return nil, fmt.Errorf("fhirpath: %s is not a duration", q)
+	}
+	r, err := addDuration(t, q, negate)
+	if err != nil {
+		return nil, nil
+	}
+	switch v.(type) {
+	case Date:
+		return Collection{Date{r}}, nil
+	case DateTime:
+		return Collection{DateTime{r}}, nil
+	}
+	// Times wrap around midnight
+	r.t = time.Date(0, 1, 1, r.t.Hour(), r.t.Minute(), r.t.Second(), r.t.Nanosecond(), r.t.Location())
+	return Collection{Time{r}}, nil
+}
+
+func quantityArithmetic(op string, a Quantity, b Value) (Collection, error) {
+	switch b := b.(type) {
+	case Quantity:
+		switch op {
+		case "+", "-":
+			v, ok := convertQuantity(b, quantityUnit(a.Unit))
+			if !ok {
+				return nil, nil
+			}
+			r, err := decimalArithmetic(op, a.Value, v)
+			if err != nil || len(r) == 0 {
+				return nil, err
+			}
+			return Collection{Quantity{Value: r[0].(Decimal), Unit: a.Unit}}, nil
+		case "*", "/":
+			r, err := decimalArithmetic(op, a.Value, b.Value)
+			if err != nil || len(r) == 0 {
+				return nil, err
+			}
+			sep := "."
+			if op == "/" {
+				sep = "/"
+			}
+			unit := ucumCode(a.Unit) + sep + ucumCode(b.Unit)
+			if ucumCode(a.Unit) == ucumCode(b.Unit) && op == "/" {
+				unit = "1"
+			}
+			return Collection{Quantity{Value: r[0].(Decimal), Unit: unit}}, nil
+		}
+	case Integer:
+		return quantityArithmetic(op, a, DecimalFromInt(int64(b)))
+	case Decimal:
+		if op == "*" || op == "/" {
+			r, err := decimalArithmetic(op, a.Value, b)
+			if err != nil || len(r) == 0 {
+				return nil, err
+			}
+			return Collection{Quantity{Value: r[0].(Decimal), Unit: a.Unit}}, nil
+		}
+	}
+	return nil, fmt.Errorf("fhirpath: cannot apply %s to %s and %s", op, a.Type(), b.Type())
+}
+
+// integerOf returns the integer of a single-item collection.
+func integerOf(c Collection) (int64, bool, error) {
+	v, err := singleton(c)
+	if err != nil || v == nil {
+		return 0, false, err
+	}
+	switch v := normalize(v).(type) {
+	case Integer:
+		return int64(v), true, nil
+	case Decimal:
+		if v.isInt() {
+			return truncate(v.rat()), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("fhirpath: expected an integer, got %s", v.Type())
+}
+
+// floatDecimal makes a decimal of the result of a math function, or
+// reports that there is none.
+func floatDecimal(f float64) (Decimal, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, false
+	}
+	r := new(big.Rat)
+	r.SetFloat64(f)
+	return decimalOf(roundDecimal(r, maxScale).rat(), 0), true
+}
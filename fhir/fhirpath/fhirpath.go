@@ -0,0 +1,268 @@
+// Package fhirpath evaluates FHIRPath expressions over FHIR resources.
+//
+// Expressions evaluate over the generated r4 and r5 structs, read through
+// their json tags, and over raw JSON alike:
+//
+//	expr, err := fhirpath.Parse("Patient.name.where(use = 'official').given.first()")
+//	if err != nil {
+//		return err
+//	}
+//	given, err := expr.Evaluate(patient) // *r5.Patient, []byte or map[string]any
+//
+// Results are collections of System values (Boolean, String, Integer,
+// Decimal, Date, DateTime, Time and Quantity) and *Element values for the
+// parts of the input that aren't primitives.
+//
+// The package implements the FHIRPath 2.0 normative grammar and function
+// library, with the three-valued logic of and, or, xor and implies, the
+// implicit conversions between Integer, Decimal, Date and DateTime, and
+// date and time arithmetic with calendar durations and UCUM quantities.
+// Quantities convert between the common UCUM units; see ucum.go for the
+// table.
+//
+// The input tells the types of resources, choice elements such as
+// valueQuantity and the fields of generated structs. A Model, set with
+// WithModel, types the rest, such as codes and dates read from JSON; without
+// one, JSON strings compare with dates and times when they parse as one.
+package fhirpath
+
+import (
+	"fmt"
+	"time"
+)
+
+// Expression is a parsed FHIRPath expression. It is safe for concurrent
+// use.
+type Expression struct {
+	src  string
+	root node
+}
+
+// Parse parses a FHIRPath expression. It reports syntax errors, calls of
+// unknown functions and calls with the wrong number of arguments.
+func Parse(expr string) (*Expression, error) {
+	root, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	if err := check(root); err != nil {
+		return nil, err
+	}
+	return &Expression{src: expr, root: root}, nil
+}
+
+// MustParse is like Parse but panics if the expression doesn't parse. It
+// is meant for expressions fixed in code.
+func MustParse(expr string) *Expression {
+	e, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string { return e.src }
+
+// check verifies the function calls of a parsed expression.
+func check(n node) error {
+	switch n := n.(type) {
+	case *funcNode:
+		fn, ok := functions[n.name]
+		if !ok {
+			return fmt.Errorf("fhirpath: unknown function %s", n.name)
+		}
+		if len(n.args) < fn.min || len(n.args) > fn.max {
+			return fmt.Errorf("fhirpath: %s takes %s, got %d", n.name, arity(fn), len(n.args))
+		}
+		switch n.name {
+		case "ofType", "is", "as":
+			if _, err := typeSpecOf(n.args[0]); err != nil {
+				return err
+			}
+			return nil
+		}
+		for _, a := range n.args {
+			if err := check(a); err != nil {
+				return err
+			}
+		}
+	case *invokeNode:
+		if err := check(n.target); err != nil {
+			return err
+		}
+		return check(n.member)
+	case *indexNode:
+		if err := check(n.target); err != nil {
+			return err
+		}
+		return check(n.index)
+	case *unaryNode:
+		return check(n.operand)
+	case *binaryNode:
+		if err := check(n.left); err != nil {
+			return err
+		}
+		return check(n.right)
+	case *typeNode:
+		return check(n.operand)
+	}
+	return nil
+}
+
+func arity(fn function) string {
+	switch {
+	case fn.min == fn.max && fn.min == 1:
+		return "1 argument"
+	case fn.min == fn.max:
+		return fmt.Sprintf("%d arguments", fn.min)
+	}
+	return fmt.Sprintf("%d to %d arguments", fn.min, fn.max)
+}
+
+// Option configures an evaluation.
+type Option func(*options)
+
+type options struct {
+	model    Model
+	vars     map[string]Collection
+	resolver func(reference string) (any, error)
+	trace    func(name string, c Collection)
+	now      time.Time
+	resource any
+	typ      string
+}
+
+// WithModel types the elements of the input from a model.
+func WithModel(m Model) Option {
+	return func(o *options) { o.model = m }
+}
+
+// WithVariable sets an environment variable, used as %name. The value is a
+// Collection, a Value, a Go string, bool, int or float64, or an input such
+// as a generated struct.
+func WithVariable(name string, value any) Option {
+	return func(o *options) {
+		if o.vars == nil {
+			o.vars = make(map[string]Collection)
+		}
+		o.vars[name] = collectionOf(value)
+	}
+}
+
+// WithResolver sets the function resolve() calls for references that are
+// neither contained nor in the Bundle being evaluated. It returns an input
+// such as a generated struct or JSON, or nil if the reference doesn't
+// resolve.
+func WithResolver(resolve func(reference string) (any, error)) Option {
+	return func(o *options) { o.resolver = resolve }
+}
+
+// WithTrace sets the function trace() logs to.
+func WithTrace(trace func(name string, c Collection)) Option {
+	return func(o *options) { o.trace = trace }
+}
+
+// WithNow fixes the time now(), today() and timeOfDay() return. It is the
+// current time by default.
+func WithNow(t time.Time) Option {
+	return func(o *options) { o.now = t }
+}
+
+// WithResource sets %resource and %rootResource when the input is part of
+// a resource, such as an element being validated.
+func WithResource(resource any) Option {
+	return func(o *options) { o.resource = resource }
+}
+
+// WithType sets the FHIR type of the input when the input doesn't tell it,
+// such as a Quantity or a code read from JSON.
+func WithType(typ string) Option {
+	return func(o *options) { o.typ = typ }
+}
+
+// collectionOf converts a Go value to a collection.
+func collectionOf(v any) Collection {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case Collection:
+		return v
+	case Value:
+		return Collection{v}
+	case string:
+		return Collection{String(v)}
+	case bool:
+		return Collection{Boolean(v)}
+	case int:
+		return Collection{Integer(v)}
+	case int64:
+		return Collection{Integer(v)}
+	case float64:
+		d, _ := floatDecimal(v)
+		return Collection{d}
+	}
+	e, err := newElement(v)
+	if err != nil {
+		return nil
+	}
+	return Collection{e}
+}
+
+// Evaluate evaluates the expression over an input: a generated resource or
+// data type struct, JSON bytes, decoded JSON such as a map[string]any, or an
+// *Element from an earlier result.
+func (e *Expression) Evaluate(input any, opts ...Option) (Collection, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.now.IsZero() {
+		o.now = time.Now()
+	}
+	root, err := newElement(input)
+	if err != nil {
+		return nil, err
+	}
+	if o.typ != "" && root.typ == "" {
+		typed := *root
+		typed.typ = o.typ
+		if isUpper(o.typ) {
+			typed.path = o.typ
+		}
+		root = &typed
+	}
+	ev := &evaluator{opts: o, context: Collection{root}, now: o.now}
+	ev.resource, ev.root = ev.context, ev.context
+	if o.resource != nil {
+		res, err := newElement(o.resource)
+		if err != nil {
+			return nil, err
+		}
+		ev.resource, ev.root = Collection{res}, Collection{res}
+	}
+	return ev.eval(e.root, ev.context, nil)
+}
+
+// EvaluateBool evaluates a boolean expression, such as an invariant. An
+// empty result is false.
+func (e *Expression) EvaluateBool(input any, opts ...Option) (bool, error) {
+	c, err := e.Evaluate(input, opts...)
+	if err != nil {
+		return false, err
+	}
+	b, known, err := toBoolean(c)
+	if err != nil {
+		return false, fmt.Errorf("fhirpath: %s: %w", e.src, err)
+	}
+	return known && b, nil
+}
+
+// Evaluate parses and evaluates an expression.
+func Evaluate(input any, expr string, opts ...Option) (Collection, error) {
+	e, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return e.Evaluate(input, opts...)
+}
@@ -0,0 +1,238 @@
+package fhirpath
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func testPatient() *r4.Patient {
+	p := &r4.Patient{
+		Active: ptr(true),
+		Name: []r4.HumanName{
+			{Use: ptr(r4.NameUseOfficial), Family: ptr("Rahman"), Given: []string{"Abdul", "Karim"}},
+			{Use: ptr(r4.NameUseUsual), Given: []string{"Karim"}},
+		},
+		Gender:          ptr(r4.AdministrativeGenderMale),
+		BirthDate:       ptr(primitives.MustDate("1990-04-12")),
+		DeceasedBoolean: ptr(false),
+	}
+	p.ID = ptr("pat-1")
+	return p
+}
+
+func TestEvaluateStruct(t *testing.T) {
+	p := testPatient()
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Patient.name.where(use = 'official').given.first()", "['Abdul']"},
+		{"name.given", "['Abdul', 'Karim', 'Karim']"},
+		{"name.given.distinct().count()", "[2]"},
+		{"Patient.id", "['pat-1']"},
+		{"active and deceased = false", "[true]"},
+		{"deceased is boolean", "[true]"},
+		{"deceased.ofType(dateTime).exists()", "[false]"},
+		{"birthDate < @2000-01-01", "[true]"},
+		{"birthDate + 10 years", "[2000-04-12]"},
+		{"name.exists(family.startsWith('Rah'))", "[true]"},
+		{"gender in ('male' | 'female')", "[true]"},
+		{"name.select(given.first() & ' ' & family)", "['Abdul Rahman', 'Karim ']"},
+		{"Patient.name.family.single()", "['Rahman']"},
+		{"photo.empty()", "[true]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Evaluate(p, tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestEvaluateTypes(t *testing.T) {
+	obs := &r4.Observation{
+		Status: "final",
+		Code: r4.CodeableConcept{Coding: []r4.Coding{
+			{System: ptr("http://loinc.org"), Code: ptr("29463-7")},
+		}},
+		ValueQuantity: &r4.Quantity{Value: ptr(primitives.MustDecimal("72.50")), Unit: ptr("kg"), System: ptr("http://unitsofmeasure.org"), Code: ptr("kg")},
+	}
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Observation.value.is(Quantity)", "[true]"},
+		{"(value as Quantity).unit", "['kg']"},
+		{"value.as(string).empty()", "[true]"},
+		{"value.type().name", "['Quantity']"},
+		{"value > 70000 'g'", "[true]"},
+		{"value = 72.5 'kg'", "[true]"},
+		{"value.value", "[72.50]"},
+		{"value.value.type().name", "['decimal']"},
+		{"code.coding.where(system = %loinc).code", "['29463-7']"},
+		{"Observation.type().namespace", "['FHIR']"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Evaluate(obs, tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestEvaluateJSON(t *testing.T) {
+	patient := readTestdata(t, "patient-example.json")
+
+	got, err := Evaluate(patient, "Patient.contact.name.family.extension.value")
+	require.NoError(t, err)
+	assert.Equal(t, "['VV']", got.String())
+
+	got, err = Evaluate(patient, "managingOrganization.reference")
+	require.NoError(t, err)
+	assert.Equal(t, "['Organization/1']", got.String())
+
+	// A JSON string compares as a date when it parses as one
+	ok, err := MustParse("birthDate = @1974-12-25").EvaluateBool(patient)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestModel(t *testing.T) {
+	reg := conformance.NewRegistry()
+	require.NoError(t, reg.Add(&conformance.Entry{
+		ResourceType: "StructureDefinition",
+		URL:          "http://hl7.org/fhir/StructureDefinition/Patient",
+		Version:      "4.0.1",
+		FHIRVersion:  "4.0.1",
+		Resource:     readTestdata(t, "StructureDefinition-Patient.json"),
+	}))
+	m := NewModel(reg, "4.0.1")
+	assert.Equal(t, "date", m.ElementType("Patient.birthDate"))
+	assert.Equal(t, "dateTime", m.ElementType("Patient.deceasedDateTime"))
+	assert.Equal(t, "HumanName", m.ElementType("Patient.contact.name"))
+	assert.Equal(t, "", m.ElementType("Patient.unknown"))
+
+	patient := readTestdata(t, "patient-example.json")
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"gender.type().name", "['code']"},
+		{"birthDate.type().name", "['date']"},
+		{"birthDate.is(date)", "[true]"},
+		{"name.ofType(HumanName).count()", "[3]"},
+		{"contact.name.is(HumanName)", "[true]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Evaluate(patient, tt.expr, WithModel(m))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	bundle := []byte(`{
+		"resourceType": "Bundle",
+		"type": "collection",
+		"entry": [
+			{"fullUrl": "http://example.org/fhir/Patient/p1", "resource": {"resourceType": "Patient", "id": "p1", "gender": "female"}},
+			{"fullUrl": "urn:uuid:7f3c", "resource": {"resourceType": "Observation", "id": "o1", "status": "final",
+				"subject": {"reference": "Patient/p1"},
+				"contained": [{"resourceType": "Device", "id": "scale"}],
+				"device": {"reference": "#scale"}}}
+		]
+	}`)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"entry.resource.ofType(Observation).subject.resolve().gender", "['female']"},
+		{"entry.resource.ofType(Observation).device.resolve().id", "['scale']"},
+		{"entry.resource.ofType(Observation).subject.resolve() is Patient", "[true]"},
+		{"entry.resource.ofType(Observation).performer.resolve().empty()", "[true]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Evaluate(bundle, tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+
+	resolver := WithResolver(func(ref string) (any, error) {
+		if ref == "Organization/1" {
+			return []byte(`{"resourceType": "Organization", "id": "1", "name": "Gastro"}`), nil
+		}
+		return nil, nil
+	})
+	got, err := Evaluate(readTestdata(t, "patient-example.json"), "managingOrganization.resolve().name", resolver)
+	require.NoError(t, err)
+	assert.Equal(t, "['Gastro']", got.String())
+}
+
+func TestOptions(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	p := testPatient()
+
+	got, err := Evaluate(p, "today() = @2026-03-01 and birthDate + 35 years < today()", WithNow(now))
+	require.NoError(t, err)
+	assert.Equal(t, "[true]", got.String())
+
+	got, err = Evaluate(p, "name.where(use = %use).given", WithVariable("use", "usual"))
+	require.NoError(t, err)
+	assert.Equal(t, "['Karim']", got.String())
+
+	var traced []string
+	_, err = Evaluate(p, "name.trace('names').given", WithTrace(func(name string, c Collection) {
+		traced = append(traced, name)
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"names"}, traced)
+
+	got, err = Evaluate(p.Name[0], "%resource.id", WithResource(p))
+	require.NoError(t, err)
+	assert.Equal(t, "['pat-1']", got.String())
+
+	_, err = Evaluate(p, "%missing")
+	assert.Error(t, err)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"name.where(",
+		"name.given.nosuchfunction()",
+		"name.where()",
+		"name.ofType(1)",
+		"'unterminated",
+		"1 +",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+
+	var se *SyntaxError
+	_, err := Parse("name..given")
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, 5, se.Offset)
+}
@@ -0,0 +1,1487 @@
+package fhirpath
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// function is a function of the library. Its arguments are passed
+// unevaluated, as some are evaluated for each input item.
+type function struct {
+	min, max int
+	eval     func(ev *evaluator, input Collection, args []node, f *frame) (Collection, error)
+}
+
+var functions map[string]function
+
+func init() {
+	functions = map[string]function{
+		// Existence
+		"empty":      {0, 0, fnEmpty},
+		"exists":     {0, 1, fnExists},
+		"all":        {1, 1, fnAll},
+		"allTrue":    {0, 0, boolTest(true, true)},
+		"anyTrue":    {0, 0, boolTest(false, true)},
+		"allFalse":   {0, 0, boolTest(true, false)},
+		"anyFalse":   {0, 0, boolTest(false, false)},
+		"subsetOf":   {1, 1, fnSubsetOf},
+		"supersetOf": {1, 1, fnSupersetOf},
+		"count":      {0, 0, fnCount},
+		"distinct":   {0, 0, fnDistinct},
+		"isDistinct": {0, 0, fnIsDistinct},
+
+		// Filtering and projection
+		"where":  {1, 1, fnWhere},
+		"select": {1, 1, fnSelect},
+		"repeat": {1, 1, fnRepeat},
+		"ofType": {1, 1, fnOfType},
+
+		// Subsetting
+		"single":    {0, 0, fnSingle},
+		"first":     {0, 0, fnFirst},
+		"last":      {0, 0, fnLast},
+		"tail":      {0, 0, fnTail},
+		"skip":      {1, 1, fnSkip},
+		"take":      {1, 1, fnTake},
+		"intersect": {1, 1, fnIntersect},
+		"exclude":   {1, 1, fnExclude},
+
+		// Combining
+		"union":   {1, 1, fnUnion},
+		"combine": {1, 1, fnCombine},
+
+		// Conversion
+		"iif":                {2, 3, fnIif},
+		"toBoolean":          {0, 0, conversion(toBooleanValue, false)},
+		"convertsToBoolean":  {0, 0, conversion(toBooleanValue, true)},
+		"toInteger":          {0, 0, conversion(toIntegerValue, false)},
+		"convertsToInteger":  {0, 0, conversion(toIntegerValue, true)},
+		"toDecimal":          {0, 0, conversion(toDecimalValue, false)},
+		"convertsToDecimal":  {0, 0, conversion(toDecimalValue, true)},
+		"toString":           {0, 0, conversion(toStringValue, false)},
+		"convertsToString":   {0, 0, conversion(toStringValue, true)},
+		"toDate":             {0, 0, conversion(toDateValue, false)},
+		"convertsToDate":     {0, 0, conversion(toDateValue, true)},
+		"toDateTime":         {0, 0, conversion(toDateTimeValue, false)},
+		"convertsToDateTime": {0, 0, conversion(toDateTimeValue, true)},
+		"toTime":             {0, 0, conversion(toTimeValue, false)},
+		"convertsToTime":     {0, 0, conversion(toTimeValue, true)},
+		"toQuantity":         {0, 1, fnToQuantity(false)},
+		"convertsToQuantity": {0, 1, fnToQuantity(true)},
+
+		// Strings
+		"indexOf":        {1, 1, fnIndexOf},
+		"lastIndexOf":    {1, 1, fnLastIndexOf},
+		"substring":      {1, 2, fnSubstring},
+		"startsWith":     {1, 1, stringTest(strings.HasPrefix)},
+		"endsWith":       {1, 1, stringTest(strings.HasSuffix)},
+		"contains":       {1, 1, stringTest(strings.Contains)},
+		"upper":          {0, 0, stringMap(strings.ToUpper)},
+		"lower":          {0, 0, stringMap(strings.ToLower)},
+		"trim":           {0, 0, stringMap(strings.TrimSpace)},
+		"replace":        {2, 2, fnReplace},
+		"matches":        {1, 1, fnMatches(false)},
+		"matchesFull":    {1, 1, fnMatches(true)},
+		"replaceMatches": {2, 2, fnReplaceMatches},
+		"length":         {0, 0, fnLength},
+		"toChars":        {0, 0, fnToChars},
+		"split":          {1, 1, fnSplit},
+		"join":           {0, 1, fnJoin},
+		"encode":         {1, 1, fnEncode},
+		"decode":         {1, 1, fnDecode},
+		"escape":         {1, 1, fnEscape},
+		"unescape":       {1, 1, fnUnescape},
+
+		// Math
+		"abs":      {0, 0, fnAbs},
+		"ceiling":  {0, 0, rounding(math.Ceil)},
+		"floor":    {0, 0, rounding(math.Floor)},
+		"truncate": {0, 0, rounding(math.Trunc)},
+		"exp":      {0, 0, floatMath(math.Exp)},
+		"ln":       {0, 0, floatMath(math.Log)},
+		"sqrt":     {0, 0, floatMath(math.Sqrt)},
+		"log":      {1, 1, fnLog},
+		"power":    {1, 1, fnPower},
+		"round":    {0, 1, fnRound},
+
+		// Aggregates
+		"aggregate": {1, 2, fnAggregate},
+		"sum":       {0, 0, fnSum},
+		"min":       {0, 0, extreme(-1)},
+		"max":       {0, 0, extreme(1)},
+		"avg":       {0, 0, fnAvg},
+
+		// Tree navigation
+		"children":    {0, 0, fnChildren},
+		"descendants": {0, 0, fnDescendants},
+
+		// Utility
+		"trace":      {1, 2, fnTrace},
+		"now":        {0, 0, fnNow},
+		"today":      {0, 0, fnToday},
+		"timeOfDay":  {0, 0, fnTimeOfDay},
+		"not":        {0, 0, fnNot},
+		"is":         {1, 1, typeFunction("is")},
+		"as":         {1, 1, typeFunction("as")},
+		"type":       {0, 0, fnType},
+		"precision":  {0, 0, fnPrecision},
+		"comparable": {1, 1, fnComparable},
+
+		// FHIR
+		"extension":  {1, 1, fnExtension},
+		"hasValue":   {0, 0, fnHasValue},
+		"getValue":   {0, 0, fnGetValue},
+		"resolve":    {0, 0, fnResolve},
+		"htmlChecks": {0, 0, fnHTMLChecks},
+	}
+}
+
+func (ev *evaluator) call(n *funcNode, input Collection, f *frame) (Collection, error) {
+	fn, ok := functions[n.name]
+	if !ok {
+		return nil, fmt.Errorf("fhirpath: unknown function %s", n.name)
+	}
+	return fn.eval(ev, input, n.args, f)
+}
+
+// arg evaluates an argument that isn't evaluated per item.
+func (ev *evaluator) arg(n node, f *frame) (Collection, error) {
+	return ev.eval(n, ev.focus(f), f)
+}
+
+// argString evaluates a string argument. ok is false if it is empty.
+func (ev *evaluator) argString(n node, f *frame) (s string, ok bool, err error) {
+	c, err := ev.arg(n, f)
+	if err != nil {
+		return "", false, err
+	}
+	v, err := singleton(c)
+	if err != nil || v == nil {
+		return "", false, err
+	}
+	str, isString := normalize(v).(String)
+	if !isString {
+		return "", false, fmt.Errorf("fhirpath: expected a string argument, got %s", v.Type())
+	}
+	return string(str), true, nil
+}
+
+// argInt evaluates an integer argument. ok is false if it is empty.
+func (ev *evaluator) argInt(n node, f *frame) (int64, bool, error) {
+	c, err := ev.arg(n, f)
+	if err != nil {
+		return 0, false, err
+	}
+	return integerOf(c)
+}
+
+// each evaluates an expression for each item, with the item as $this.
+func (ev *evaluator) each(input Collection, n node, f *frame, fn func(i int, item Value, result Collection) (bool, error)) error {
+	for i, item := range input {
+		inner := &frame{this: item, index: i}
+		if f != nil {
+			inner.total = f.total
+		}
+		r, err := ev.eval(n, Collection{item}, inner)
+		if err != nil {
+			return err
+		}
+		more, err := fn(i, item, r)
+		if err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+func fnEmpty(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	return Collection{Boolean(len(input) == 0)}, nil
+}
+
+func fnExists(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	if len(args) == 1 {
+		var err error
+		if input, err = fnWhere(ev, input, args, f); err != nil {
+			return nil, err
+		}
+	}
+	return Collection{Boolean(len(input) > 0)}, nil
+}
+
+func fnAll(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	all := true
+	err := ev.each(input, args[0], f, func(_ int, _ Value, r Collection) (bool, error) {
+		b, known, err := toBoolean(r)
+		if err != nil {
+			return false, err
+		}
+		all = known && b
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return Collection{Boolean(all)}, nil
+}
+
+// boolTest makes allTrue, anyTrue, allFalse and anyFalse.
+func boolTest(all, want bool) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+		for _, v := range input {
+			b, ok := normalize(v).(Boolean)
+			if !ok {
+				return nil, fmt.Errorf("fhirpath: expected booleans, got %s", v.Type())
+			}
+			if all && bool(b) != want {
+				return Collection{Boolean(false)}, nil
+			}
+			if !all && bool(b) == want {
+				return Collection{Boolean(true)}, nil
+			}
+		}
+		return Collection{Boolean(all)}, nil
+	}
+}
+
+func subset(a, b Collection) bool {
+	for _, v := range a {
+		if !containsValue(b, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func fnSubsetOf(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	other, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	return Collection{Boolean(subset(input, other))}, nil
+}
+
+func fnSupersetOf(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	other, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	return Collection{Boolean(subset(other, input))}, nil
+}
+
+func fnCount(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	return Collection{Integer(len(input))}, nil
+}
+
+func fnDistinct(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	return union(input, nil), nil
+}
+
+func fnIsDistinct(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	return Collection{Boolean(len(union(input, nil)) == len(input))}, nil
+}
+
+func fnWhere(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	var out Collection
+	err := ev.each(input, args[0], f, func(_ int, item Value, r Collection) (bool, error) {
+		b, known, err := toBoolean(r)
+		if known && b {
+			out = append(out, item)
+		}
+		return true, err
+	})
+	return out, err
+}
+
+func fnSelect(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	var out Collection
+	err := ev.each(input, args[0], f, func(_ int, _ Value, r Collection) (bool, error) {
+		out = append(out, r...)
+		return true, nil
+	})
+	return out, err
+}
+
+func fnRepeat(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	var out Collection
+	queue := input
+	for len(queue) > 0 {
+		var next Collection
+		err := ev.each(queue, args[0], f, func(_ int, _ Value, r Collection) (bool, error) {
+			for _, v := range r {
+				if !containsValue(out, v) {
+					out = append(out, v)
+					next = append(next, v)
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		queue = next
+	}
+	return out, nil
+}
+
+func fnOfType(_ *evaluator, input Collection, args []node, _ *frame) (Collection, error) {
+	spec, err := typeSpecOf(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return ofType(input, spec), nil
+}
+
+func fnSingle(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	v, err := singleton(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return Collection{v}, nil
+}
+
+func fnFirst(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	return input[:1], nil
+}
+
+func fnLast(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	return input[len(input)-1:], nil
+}
+
+func fnTail(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	return input[1:], nil
+}
+
+func fnSkip(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	n, ok, err := ev.argInt(args[0], f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if n <= 0 {
+		return input, nil
+	}
+	if n >= int64(len(input)) {
+		return nil, nil
+	}
+	return input[n:], nil
+}
+
+func fnTake(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	n, ok, err := ev.argInt(args[0], f)
+	if err != nil || !ok || n <= 0 {
+		return nil, err
+	}
+	if n >= int64(len(input)) {
+		return input, nil
+	}
+	return input[:n], nil
+}
+
+func fnIntersect(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	other, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	var out Collection
+	for _, v := range input {
+		if containsValue(other, v) && !containsValue(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func fnExclude(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	other, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	var out Collection
+	for _, v := range input {
+		if !containsValue(other, v) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func fnUnion(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	other, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	return union(input, other), nil
+}
+
+func fnCombine(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	other, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(Collection(nil), input...), other...), nil
+}
+
+func fnIif(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	if len(input) > 1 {
+		return nil, fmt.Errorf("fhirpath: iif expects a single input, got %d", len(input))
+	}
+	if len(input) == 1 {
+		// $this is the input; $index and $total still belong to the
+		// enclosing where, select or aggregate.
+		nf := frame{this: input[0]}
+		if f != nil {
+			nf.index, nf.total = f.index, f.total
+		}
+		f = &nf
+	}
+	cond, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	b, known, err := toBoolean(cond)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case known && b:
+		return ev.arg(args[1], f)
+	case len(args) == 3:
+		return ev.arg(args[2], f)
+	}
+	return nil, nil
+}
+
+// conversion makes a toX function, or the convertsToX function that tells
+// whether toX gives a value.
+func conversion(convert func(Value) (Value, bool), test bool) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+		v, err := singleton(input)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		r, ok := convert(normalize(v))
+		if test {
+			return Collection{Boolean(ok)}, nil
+		}
+		if !ok {
+			return nil, nil
+		}
+		return Collection{r}, nil
+	}
+}
+
+var (
+	integerString = regexp.MustCompile(`^[+-]?\d+$`)
+	decimalString = regexp.MustCompile(`^[+-]?\d+(\.\d+)?$`)
+)
+
+func toBooleanValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case Boolean:
+		return v, true
+	case Integer:
+		if v == 0 || v == 1 {
+			return Boolean(v == 1), true
+		}
+	case Decimal:
+		if v.Cmp(DecimalFromInt(0)) == 0 || v.Cmp(DecimalFromInt(1)) == 0 {
+			return Boolean(v.Cmp(DecimalFromInt(1)) == 0), true
+		}
+	case String:
+		switch strings.ToLower(string(v)) {
+		case "true", "t", "yes", "y", "1", "1.0":
+			return Boolean(true), true
+		case "false", "f", "no", "n", "0", "0.0":
+			return Boolean(false), true
+		}
+	}
+	return nil, false
+}
+
+func toIntegerValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case Integer:
+		return v, true
+	case Boolean:
+		if v {
+			return Integer(1), true
+		}
+		return Integer(0), true
+	case String:
+		if integerString.MatchString(string(v)) {
+			n, err := strconv.ParseInt(string(v), 10, 64)
+			return Integer(n), err == nil
+		}
+	}
+	return nil, false
+}
+
+func toDecimalValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case Decimal:
+		return v, true
+	case Integer:
+		return DecimalFromInt(int64(v)), true
+	case Boolean:
+		if v {
+			return Decimal{r: big.NewRat(1, 1), scale: 1}, true
+		}
+		return Decimal{r: new(big.Rat), scale: 1}, true
+	case String:
+		if decimalString.MatchString(string(v)) {
+			d, err := ParseDecimal(string(v))
+			return d, err == nil
+		}
+	}
+	return nil, false
+}
+
+func toStringValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case String:
+		return v, true
+	case Boolean:
+		return String(strconv.FormatBool(bool(v))), true
+	case Integer:
+		return String(strconv.FormatInt(int64(v), 10)), true
+	case Decimal, Quantity, Date, DateTime, Time:
+		return String(v.(fmt.Stringer).String()), true
+	}
+	return nil, false
+}
+
+func toDateValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case Date:
+		return v, true
+	case DateTime:
+		d := Date(v)
+		d.prec = min(d.prec, precDay)
+		d.hasTZ = false
+		return d, true
+	case String:
+		if d, err := ParseDate(string(v)); err == nil {
+			return d, true
+		}
+		// A string with a time converts, but not one that ends at the T.
+		if dt, err := ParseDateTime(string(v)); err == nil && dt.prec > precDay {
+			return toDateValue(dt)
+		}
+	}
+	return nil, false
+}
+
+func toDateTimeValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case DateTime:
+		return v, true
+	case Date:
+		return v.dateTime(), true
+	case String:
+		if d, err := ParseDateTime(string(v)); err == nil {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+func toTimeValue(v Value) (Value, bool) {
+	switch v := v.(type) {
+	case Time:
+		return v, true
+	case String:
+		if t, err := ParseTime(strings.TrimPrefix(string(v), "T")); err == nil {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+var quantityString = regexp.MustCompile(`^([+-]?\d+(?:\.\d+)?)\s*(?:'([^']+)'|([a-z]+))?$`)
+
+func toQuantityValue(v Value) (Quantity, bool) {
+	switch v := v.(type) {
+	case Quantity:
+		return v, true
+	case Integer:
+		return Quantity{Value: DecimalFromInt(int64(v)), Unit: "1"}, true
+	case Decimal:
+		return Quantity{Value: v, Unit: "1"}, true
+	case Boolean:
+		n := int64(0)
+		if v {
+			n = 1
+		}
+		return Quantity{Value: Decimal{r: big.NewRat(n, 1), scale: 1}, Unit: "1"}, true
+	case String:
+		m := quantityString.FindStringSubmatch(string(v))
+		if m == nil {
+			return Quantity{}, false
+		}
+		d, err := ParseDecimal(m[1])
+		if err != nil {
+			return Quantity{}, false
+		}
+		unit := m[2]
+		if m[3] != "" {
+			if calendarUnit(m[3]) == "" {
+				return Quantity{}, false
+			}
+			unit = m[3]
+		}
+		if unit == "" {
+			unit = "1"
+		}
+		return Quantity{Value: d, Unit: unit}, true
+	}
+	return Quantity{}, false
+}
+
+func fnToQuantity(test bool) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+		v, err := singleton(input)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		q, ok := toQuantityValue(normalize(v))
+		if ok && len(args) == 1 {
+			unit, has, err := ev.argString(args[0], f)
+			if err != nil {
+				return nil, err
+			}
+			if has {
+				var d Decimal
+				if d, ok = convertQuantity(q, quantityUnit(unit)); ok {
+					q = Quantity{Value: d, Unit: unit}
+				}
+			}
+		}
+		if test {
+			return Collection{Boolean(ok)}, nil
+		}
+		if !ok {
+			return nil, nil
+		}
+		return Collection{q}, nil
+	}
+}
+
+// inputString returns the string of a single-string input. ok is false if
+// the input is empty.
+func inputString(input Collection) (string, bool, error) {
+	v, err := singleton(input)
+	if err != nil || v == nil {
+		return "", false, err
+	}
+	s, ok := normalize(v).(String)
+	if !ok {
+		return "", false, fmt.Errorf("fhirpath: expected a string, got %s", v.Type())
+	}
+	return string(s), true, nil
+}
+
+// stringArgs reads a string input and string arguments, reporting ok
+// false when any is empty.
+func (ev *evaluator) stringArgs(input Collection, args []node, f *frame) (string, []string, bool, error) {
+	s, ok, err := inputString(input)
+	if err != nil || !ok {
+		return "", nil, false, err
+	}
+	vals := make([]string, len(args))
+	for i, a := range args {
+		v, ok, err := ev.argString(a, f)
+		if err != nil || !ok {
+			return "", nil, false, err
+		}
+		vals[i] = v
+	}
+	return s, vals, true, nil
+}
+
+// runeIndex converts a byte offset to a character offset.
+func runeIndex(s string, i int) int {
+	if i < 0 {
+		return i
+	}
+	return utf8.RuneCountInString(s[:i])
+}
+
+func fnIndexOf(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return Collection{Integer(runeIndex(s, strings.Index(s, a[0])))}, nil
+}
+
+func fnLastIndexOf(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return Collection{Integer(runeIndex(s, strings.LastIndex(s, a[0])))}, nil
+}
+
+func fnSubstring(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, ok, err := inputString(input)
+	if err != nil || !ok {
+		return nil, err
+	}
+	start, ok, err := ev.argInt(args[0], f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	runes := []rune(s)
+	if start < 0 || start >= int64(len(runes)) {
+		return nil, nil
+	}
+	end := int64(len(runes))
+	if len(args) == 2 {
+		n, ok, err := ev.argInt(args[1], f)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			end = min(start+max(n, 0), end)
+		}
+	}
+	return Collection{String(runes[start:end])}, nil
+}
+
+func stringTest(test func(s, arg string) bool) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+		s, a, ok, err := ev.stringArgs(input, args, f)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return Collection{Boolean(test(s, a[0]))}, nil
+	}
+}
+
+func stringMap(fn func(string) string) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+		s, ok, err := inputString(input)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return Collection{String(fn(s))}, nil
+	}
+}
+
+func fnReplace(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return Collection{String(strings.ReplaceAll(s, a[0], a[1]))}, nil
+}
+
+func compileRegexp(pattern string, full bool) (*regexp.Regexp, error) {
+	if full {
+		pattern = "^(?:" + pattern + ")$"
+	}
+	re, err := regexp.Compile("(?s)" + pattern)
+	if err != nil {
+		return nil, fmt.Errorf("fhirpath: invalid regular expression: %w", err)
+	}
+	return re, nil
+}
+
+func fnMatches(full bool) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+		s, a, ok, err := ev.stringArgs(input, args, f)
+		if err != nil || !ok {
+			return nil, err
+		}
+		re, err := compileRegexp(a[0], full)
+		if err != nil {
+			return nil, err
+		}
+		return Collection{Boolean(re.MatchString(s))}, nil
+	}
+}
+
+func fnReplaceMatches(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	re, err := compileRegexp(a[0], false)
+	if err != nil {
+		return nil, err
+	}
+	return Collection{String(re.ReplaceAllString(s, a[1]))}, nil
+}
+
+func fnLength(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	s, ok, err := inputString(input)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return Collection{Integer(utf8.RuneCountInString(s))}, nil
+}
+
+func fnToChars(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	s, ok, err := inputString(input)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var out Collection
+	for _, r := range s {
+		out = append(out, String(r))
+	}
+	return out, nil
+}
+
+func fnSplit(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var out Collection
+	for _, part := range strings.Split(s, a[0]) {
+		out = append(out, String(part))
+	}
+	return out, nil
+}
+
+func fnJoin(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	sep := ""
+	if len(args) == 1 {
+		s, _, err := ev.argString(args[0], f)
+		if err != nil {
+			return nil, err
+		}
+		sep = s
+	}
+	parts := make([]string, len(input))
+	for i, v := range input {
+		s, ok := normalize(v).(String)
+		if !ok {
+			return nil, fmt.Errorf("fhirpath: join expects strings, got %s", v.Type())
+		}
+		parts[i] = string(s)
+	}
+	return Collection{String(strings.Join(parts, sep))}, nil
+}
+
+func fnEncode(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	switch a[0] {
+	case "base64":
+		return Collection{String(base64.StdEncoding.EncodeToString([]byte(s)))}, nil
+	case "urlbase64":
+		return Collection{String(base64.URLEncoding.EncodeToString([]byte(s)))}, nil
+	case "hex":
+		return Collection{String(hex.EncodeToString([]byte(s)))}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: unknown encoding %q", a[0])
+}
+
+func fnDecode(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var data []byte
+	switch a[0] {
+	case "base64":
+		data, err = base64.StdEncoding.DecodeString(s)
+	case "urlbase64":
+		data, err = base64.URLEncoding.DecodeString(s)
+	case "hex":
+		data, err = hex.DecodeString(s)
+	default:
+		return nil, fmt.Errorf("fhirpath: unknown encoding %q", a[0])
+	}
+	if err != nil {
+		return nil, nil
+	}
+	return Collection{String(data)}, nil
+}
+
+func fnEscape(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	switch a[0] {
+	case "html":
+		return Collection{String(html.EscapeString(s))}, nil
+	case "json":
+		var sb strings.Builder
+		enc := json.NewEncoder(&sb)
+		enc.SetEscapeHTML(false)
+		_ = enc.Encode(s)
+		data := strings.TrimSuffix(sb.String(), "\n")
+		return Collection{String(data[1 : len(data)-1])}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: unknown escape target %q", a[0])
+}
+
+func fnUnescape(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	s, a, ok, err := ev.stringArgs(input, args, f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	switch a[0] {
+	case "html":
+		return Collection{String(html.UnescapeString(s))}, nil
+	case "json":
+		// Quotes may come unescaped; a JSON string literal needs them
+		// escaped.
+		var sb strings.Builder
+		for i := 0; i < len(s); i++ {
+			switch {
+			case s[i] == '\\' && i+1 < len(s):
+				sb.WriteString(s[i : i+2])
+				i++
+			case s[i] == '"':
+				sb.WriteString(`\"`)
+			default:
+				sb.WriteByte(s[i])
+			}
+		}
+		var out string
+		if err := json.Unmarshal([]byte(`"`+sb.String()+`"`), &out); err != nil {
+			return nil, nil
+		}
+		return Collection{String(out)}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: unknown escape target %q", a[0])
+}
+
+// inputNumber returns a single Integer, Decimal or Quantity input.
+func inputNumber(input Collection) (Value, error) {
+	v, err := singleton(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch v := normalize(v).(type) {
+	case Integer, Decimal, Quantity:
+		return v, nil
+	}
+	return nil, fmt.Errorf("fhirpath: expected a number, got %s", v.Type())
+}
+
+func fnAbs(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	v, err := inputNumber(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case Integer:
+		return Collection{Integer(max(v, -v))}, nil
+	case Decimal:
+		return Collection{Decimal{r: new(big.Rat).Abs(v.rat()), scale: v.scale}}, nil
+	case Quantity:
+		return Collection{Quantity{Value: Decimal{r: new(big.Rat).Abs(v.Value.rat()), scale: v.Value.scale}, Unit: v.Unit}}, nil
+	}
+	return nil, nil
+}
+
+// rounding makes ceiling, floor and truncate, which return integers.
+func rounding(fn func(float64) float64) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+		v, err := inputNumber(input)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case Integer:
+			return Collection{v}, nil
+		case Decimal:
+			// Round exactly: the float only decides the direction
+			t := truncate(v.rat())
+			if !v.isInt() && fn(v.Float64()) != float64(t) {
+				if v.rat().Sign() > 0 {
+					t++
+				} else {
+					t--
+				}
+			}
+			return Collection{Integer(t)}, nil
+		}
+		return nil, fmt.Errorf("fhirpath: expected a number, got %s", v.Type())
+	}
+}
+
+func numberFloat(v Value) (float64, bool) {
+	switch v := normalize(v).(type) {
+	case Integer:
+		return float64(v), true
+	case Decimal:
+		return v.Float64(), true
+	}
+	return 0, false
+}
+
+func floatMath(fn func(float64) float64) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+		v, err := inputNumber(input)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		x, ok := numberFloat(v)
+		if !ok {
+			return nil, fmt.Errorf("fhirpath: expected a number, got %s", v.Type())
+		}
+		d, ok := floatDecimal(fn(x))
+		if !ok {
+			return nil, nil
+		}
+		return Collection{d}, nil
+	}
+}
+
+func (ev *evaluator) argFloat(n node, f *frame) (Value, float64, error) {
+	c, err := ev.arg(n, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	v, err := inputNumber(c)
+	if err != nil || v == nil {
+		return nil, 0, err
+	}
+	x, ok := numberFloat(v)
+	if !ok {
+		return nil, 0, fmt.Errorf("fhirpath: expected a number, got %s", v.Type())
+	}
+	return v, x, nil
+}
+
+func fnLog(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	v, err := inputNumber(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	x, _ := numberFloat(v)
+	b, base, err := ev.argFloat(args[0], f)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	d, ok := floatDecimal(math.Log(x) / math.Log(base))
+	if !ok {
+		return nil, nil
+	}
+	return Collection{d}, nil
+}
+
+func fnPower(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	v, err := inputNumber(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	e, exp, err := ev.argFloat(args[0], f)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	base, _ := numberFloat(v)
+	bi, ok1 := v.(Integer)
+	ei, ok2 := normalize(e).(Integer)
+	if ok1 && ok2 && ei >= 0 {
+		r := new(big.Int).Exp(big.NewInt(int64(bi)), big.NewInt(int64(ei)), nil)
+		if !r.IsInt64() {
+			return nil, nil
+		}
+		return Collection{Integer(r.Int64())}, nil
+	}
+	d, ok := floatDecimal(math.Pow(base, exp))
+	if !ok {
+		return nil, nil
+	}
+	return Collection{d}, nil
+}
+
+func fnRound(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	v, err := inputNumber(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	scale := int64(0)
+	if len(args) == 1 {
+		n, ok, err := ev.argInt(args[0], f)
+		if err != nil || !ok {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("fhirpath: round precision must not be negative")
+		}
+		scale = n
+	}
+	switch v := v.(type) {
+	case Integer:
+		return Collection{roundDecimal(DecimalFromInt(int64(v)).rat(), int(scale))}, nil
+	case Decimal:
+		return Collection{roundDecimal(v.rat(), int(scale))}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: expected a number, got %s", v.Type())
+}
+
+func fnAggregate(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	var total Collection
+	if len(args) == 2 {
+		var err error
+		if total, err = ev.arg(args[1], f); err != nil {
+			return nil, err
+		}
+	}
+	for i, item := range input {
+		r, err := ev.eval(args[0], Collection{item}, &frame{this: item, index: i, total: total})
+		if err != nil {
+			return nil, err
+		}
+		total = r
+	}
+	return total, nil
+}
+
+func fnSum(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	if len(input) == 0 {
+		return Collection{Integer(0)}, nil
+	}
+	total := Collection{input[0]}
+	for _, v := range input[1:] {
+		var err error
+		if total, err = arithmetic("+", total, Collection{v}); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+// extreme makes min and max.
+func extreme(sign int) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+		var best Value
+		for _, v := range input {
+			if best == nil {
+				best = v
+				continue
+			}
+			c, ok, err := compare(v, best)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, nil
+			}
+			if c*sign > 0 {
+				best = v
+			}
+		}
+		if best == nil {
+			return nil, nil
+		}
+		return Collection{normalize(best)}, nil
+	}
+}
+
+func fnAvg(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	total, err := fnSum(ev, input, args, f)
+	if err != nil || len(total) == 0 {
+		return nil, err
+	}
+	return arithmetic("/", total, Collection{Integer(len(input))})
+}
+
+func fnChildren(ev *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	var out Collection
+	for _, v := range input {
+		if e, ok := v.(*Element); ok {
+			for _, c := range e.allChildren(ev.opts.model) {
+				out = append(out, c)
+			}
+		}
+	}
+	return out, nil
+}
+
+func fnDescendants(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	var out Collection
+	queue := input
+	for len(queue) > 0 {
+		next, _ := fnChildren(ev, queue, args, f)
+		out = append(out, next...)
+		queue = next
+	}
+	return out, nil
+}
+
+func fnTrace(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	name, _, err := ev.argString(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	if ev.opts.trace == nil {
+		return input, nil
+	}
+	traced := input
+	if len(args) == 2 {
+		if traced, err = fnSelect(ev, input, args[1:], f); err != nil {
+			return nil, err
+		}
+	}
+	ev.opts.trace(name, traced)
+	return input, nil
+}
+
+func fnNow(ev *evaluator, _ Collection, _ []node, _ *frame) (Collection, error) {
+	return Collection{DateTime{temporal{t: ev.now, prec: precMillisecond, hasTZ: true}}}, nil
+}
+
+func fnToday(ev *evaluator, _ Collection, _ []node, _ *frame) (Collection, error) {
+	y, m, d := ev.now.Date()
+	return Collection{Date{temporal{t: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), prec: precDay}}}, nil
+}
+
+func fnTimeOfDay(ev *evaluator, _ Collection, _ []node, _ *frame) (Collection, error) {
+	t := ev.now
+	return Collection{Time{temporal{t: time.Date(0, 1, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC), prec: precMillisecond}}}, nil
+}
+
+func fnNot(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	b, known, err := toBoolean(input)
+	if err != nil {
+		return nil, err
+	}
+	return boolResult(!b, known), nil
+}
+
+func typeFunction(op string) func(*evaluator, Collection, []node, *frame) (Collection, error) {
+	return func(_ *evaluator, input Collection, args []node, _ *frame) (Collection, error) {
+		spec, err := typeSpecOf(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return typeOperator(op, input, spec)
+	}
+}
+
+func fnType(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	out := make(Collection, len(input))
+	for i, v := range input {
+		out[i] = typeInfoValue{v.Type()}
+	}
+	return out, nil
+}
+
+// temporalDigits are the digits of each precision of a date and a time,
+// which precision() counts.
+var (
+	dateDigits = map[precision]int{precYear: 4, precMonth: 6, precDay: 8, precHour: 10, precMinute: 12, precSecond: 14, precMillisecond: 17}
+	timeDigits = map[precision]int{precHour: 2, precMinute: 4, precSecond: 6, precMillisecond: 9}
+)
+
+func fnPrecision(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	v, err := singleton(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch v := normalize(v).(type) {
+	case Decimal:
+		return Collection{Integer(v.scale)}, nil
+	case Integer:
+		return Collection{Integer(0)}, nil
+	case Date:
+		return Collection{Integer(dateDigits[v.prec])}, nil
+	case DateTime:
+		return Collection{Integer(dateDigits[v.prec])}, nil
+	case Time:
+		return Collection{Integer(timeDigits[v.prec])}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: precision of %s", v.Type())
+}
+
+func fnComparable(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	v, err := singleton(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	c, err := ev.arg(args[0], f)
+	if err != nil {
+		return nil, err
+	}
+	w, err := singleton(c)
+	if err != nil || w == nil {
+		return nil, err
+	}
+	a, aok := normalize(v).(Quantity)
+	b, bok := normalize(w).(Quantity)
+	if !aok || !bok {
+		return nil, fmt.Errorf("fhirpath: comparable expects quantities")
+	}
+	_, ok := convertQuantity(b, quantityUnit(a.Unit))
+	return Collection{Boolean(ok)}, nil
+}
+
+func fnExtension(ev *evaluator, input Collection, args []node, f *frame) (Collection, error) {
+	url, ok, err := ev.argString(args[0], f)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var out Collection
+	for _, v := range input {
+		e, ok := v.(*Element)
+		if !ok {
+			continue
+		}
+		for _, x := range e.children("extension", ev.opts.model) {
+			for _, u := range x.children("url", nil) {
+				if p, ok := u.primitive(); ok && p == String(url) {
+					out = append(out, x)
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+func fnHasValue(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	if len(input) != 1 {
+		return Collection{Boolean(false)}, nil
+	}
+	if e, ok := input[0].(*Element); ok {
+		_, has := e.primitive()
+		return Collection{Boolean(has)}, nil
+	}
+	return Collection{Boolean(true)}, nil
+}
+
+func fnGetValue(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	if len(input) != 1 {
+		return nil, nil
+	}
+	if e, ok := input[0].(*Element); ok {
+		if p, has := e.primitive(); has {
+			return Collection{p}, nil
+		}
+		return nil, nil
+	}
+	return input, nil
+}
+
+func fnResolve(ev *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	var out Collection
+	for _, v := range input {
+		var ref string
+		var from *Element
+		switch x := normalize(v).(type) {
+		case String:
+			ref = string(x)
+		case *Element:
+			from = x.container
+			for _, r := range x.children("reference", nil) {
+				if p, ok := r.primitive(); ok {
+					ref = stringOf(p)
+				}
+			}
+		}
+		if ref == "" {
+			continue
+		}
+		r, err := ev.resolve(ref, from)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+// resolve finds the resource a reference points at: a contained resource,
+// an entry of the Bundle being evaluated, or one the resolver option
+// returns. from is the resource the reference is part of, if known.
+func (ev *evaluator) resolve(ref string, from *Element) (*Element, error) {
+	roots := append(append(Collection(nil), ev.resource...), ev.root...)
+	if id, ok := strings.CutPrefix(ref, "#"); ok {
+		if from != nil {
+			roots = Collection{from}
+		}
+		for _, r := range roots {
+			e, ok := r.(*Element)
+			if !ok {
+				continue
+			}
+			if id == "" {
+				return e, nil
+			}
+			for _, c := range e.children("contained", nil) {
+				if resourceID(c) == id {
+					return c, nil
+				}
+			}
+		}
+		return nil, nil
+	}
+	for _, r := range roots {
+		e, ok := r.(*Element)
+		if !ok || !e.is("Bundle") {
+			continue
+		}
+		for _, entry := range e.children("entry", nil) {
+			fullURL := ""
+			for _, u := range entry.children("fullUrl", nil) {
+				fullURL = u.String()
+			}
+			for _, res := range entry.children("resource", nil) {
+				local := res.Type().Name + "/" + resourceID(res)
+				if fullURL == ref || ref == local || strings.HasSuffix(ref, "/"+local) || strings.HasSuffix(fullURL, "/"+ref) {
+					return res, nil
+				}
+			}
+		}
+	}
+	if ev.opts.resolver == nil {
+		return nil, nil
+	}
+	v, err := ev.opts.resolver(ref)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return newElement(v)
+}
+
+func resourceID(e *Element) string {
+	for _, id := range e.children("id", nil) {
+		return id.String()
+	}
+	return ""
+}
+
+// fnHTMLChecks checks only that a narrative is an XHTML div, not the full
+// rules of the specification.
+func fnHTMLChecks(_ *evaluator, input Collection, _ []node, _ *frame) (Collection, error) {
+	v, err := singleton(input)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	s := strings.TrimSpace(stringOf(v))
+	return Collection{Boolean(strings.HasPrefix(s, "<div") && strings.HasSuffix(s, "</div>"))}, nil
+}
@@ -0,0 +1,146 @@
+package fhirpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+)
+
+// Model tells the FHIR types of elements. The input only tells some types:
+// resourceType, choice suffixes such as valueQuantity and, for generated
+// structs, the Go types. With a model, codes, uris and dates read from JSON
+// strings are typed too, which is, as, ofType and type() see, and dates
+// compare as dates.
+type Model interface {
+	// ElementType returns the type of the element at a path such as
+	// Patient.contact.name, or "" if it is not known. Paths start at a
+	// resource or data type name.
+	ElementType(path string) string
+}
+
+// NewModel returns a Model that reads the core StructureDefinitions of a
+// FHIR release, such as 4.0.1, from a registry. The registry is usually
+// filled from the release's core package, hl7.fhir.r4.core or
+// hl7.fhir.r5.core.
+func NewModel(reg *conformance.Registry, fhirVersion string) Model {
+	return &registryModel{reg: reg, fhirVersion: fhirVersion, types: make(map[string]map[string]modelElement)}
+}
+
+type registryModel struct {
+	reg         *conformance.Registry
+	fhirVersion string
+	mu          sync.Mutex
+	types       map[string]map[string]modelElement // type name -> path -> element
+}
+
+type modelElement struct {
+	types            []string
+	contentReference string
+}
+
+func (m *registryModel) ElementType(path string) string {
+	return m.lookup(path, 0)
+}
+
+func (m *registryModel) lookup(path string, depth int) string {
+	root, _, _ := strings.Cut(path, ".")
+	elems := m.elements(root)
+	if elems == nil || depth > 8 {
+		return ""
+	}
+	if e, ok := elems[path]; ok {
+		if len(e.types) == 1 {
+			return e.types[0]
+		}
+		return ""
+	}
+	parent, name := path, ""
+	if i := strings.LastIndexByte(path, '.'); i >= 0 {
+		parent, name = path[:i], path[i+1:]
+	}
+	// A typed choice, such as Observation.valueQuantity
+	for p, e := range elems {
+		base, ok := strings.CutSuffix(p, "[x]")
+		if !ok || !strings.HasPrefix(base, parent+".") || strings.Contains(base[len(parent)+1:], ".") {
+			continue
+		}
+		if typ, ok := choiceSuffix(name, base[len(parent)+1:]); ok {
+			for _, t := range e.types {
+				if strings.EqualFold(t, typ) {
+					return t
+				}
+			}
+		}
+	}
+	// A path below an element that reuses another's definition, such as
+	// Questionnaire.item.item
+	segments := strings.Split(path, ".")
+	for i := len(segments) - 1; i > 1; i-- {
+		prefix := strings.Join(segments[:i], ".")
+		if e, ok := elems[prefix]; ok && e.contentReference != "" {
+			ref := e.contentReference[strings.IndexByte(e.contentReference, '#')+1:]
+			return m.lookup(ref+"."+strings.Join(segments[i:], "."), depth+1)
+		}
+	}
+	return ""
+}
+
+// elements returns the elements of a core type by path, reading its
+// definition on first use.
+func (m *registryModel) elements(typ string) map[string]modelElement {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if elems, ok := m.types[typ]; ok {
+		return elems
+	}
+	var elems map[string]modelElement
+	if e, ok := m.reg.ResolveFHIR("StructureDefinition", "http://hl7.org/fhir/StructureDefinition/"+typ, m.fhirVersion); ok {
+		elems = readElements(e.Resource)
+	}
+	m.types[typ] = elems
+	return elems
+}
+
+// readElements reads the types of a StructureDefinition's snapshot.
+func readElements(sd any) map[string]modelElement {
+	var def struct {
+		Snapshot struct {
+			Element []struct {
+				Path             string `json:"path"`
+				ContentReference string `json:"contentReference"`
+				Type             []struct {
+					Code string `json:"code"`
+				} `json:"type"`
+			} `json:"element"`
+		} `json:"snapshot"`
+	}
+	data, ok := sd.([]byte)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(sd); err != nil {
+			return nil
+		}
+	}
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(&def); err != nil {
+		return nil
+	}
+	elems := make(map[string]modelElement, len(def.Snapshot.Element))
+	for _, e := range def.Snapshot.Element {
+		me := modelElement{contentReference: e.ContentReference}
+		for _, t := range e.Type {
+			code := t.Code
+			// The FHIRPath System types used for ids and urls
+			if s, ok := strings.CutPrefix(code, "http://hl7.org/fhirpath/System."); ok {
+				code = strings.ToLower(s)
+			}
+			me.types = append(me.types, code)
+		}
+		if _, ok := elems[e.Path]; !ok {
+			elems[e.Path] = me
+		}
+	}
+	return elems
+}
@@ -0,0 +1,576 @@
+package fhirpath
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind classifies the tokens of an expression.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokDelimited // `identifier`
+	tokString
+	tokNumber
+	tokTemporal // @2024-01-01, @T10:00
+	tokVariable // %name
+	tokSpecial  // $this, $index, $total
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string // the value: unquoted for strings and identifiers
+	pos  int
+}
+
+// SyntaxError reports an expression that doesn't parse.
+type SyntaxError struct {
+	Expr   string
+	Offset int // byte offset of the error
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("fhirpath: %s at offset %d in %q", e.Msg, e.Offset, e.Expr)
+}
+
+var temporalLiteral = regexp.MustCompile(`^@(?:T\d{2}(?::\d{2}(?::\d{2}(?:\.\d+)?)?)?|\d{4}(?:-\d{2}(?:-\d{2})?)?(?:T(?:\d{2}(?::\d{2}(?::\d{2}(?:\.\d+)?)?)?(?:Z|[+-]\d{2}:\d{2})?)?)?)`)
+
+// operators, longest first so <= wins over <.
+var operators = []string{"<=", ">=", "!=", "!~", "=", "~", "<", ">", "+", "-", "*", "/", "&", "|", ".", "(", ")", "[", "]", "{", "}", ","}
+
+func tokenize(expr string) ([]token, error) {
+	var toks []token
+	i := 0
+	fail := func(pos int, format string, args ...any) error {
+		return &SyntaxError{Expr: expr, Offset: pos, Msg: fmt.Sprintf(format, args...)}
+	}
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.HasPrefix(expr[i:], "//"):
+			for i < len(expr) && expr[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(expr[i:], "/*"):
+			end := strings.Index(expr[i+2:], "*/")
+			if end < 0 {
+				return nil, fail(i, "unterminated comment")
+			}
+			i += end + 4
+		case c == '\'' || c == '`':
+			s, n, err := unquote(expr[i:], c)
+			if err != nil {
+				return nil, fail(i, "%v", err)
+			}
+			kind := tokString
+			if c == '`' {
+				kind = tokDelimited
+			}
+			toks = append(toks, token{kind: kind, text: s, pos: i})
+			i += n
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(expr) && isDigit(expr[j]) {
+				j++
+			}
+			if j+1 < len(expr) && expr[j] == '.' && isDigit(expr[j+1]) {
+				j++
+				for j < len(expr) && isDigit(expr[j]) {
+					j++
+				}
+			}
+			toks = append(toks, token{kind: tokNumber, text: expr[i:j], pos: i})
+			i = j
+		case c == '@':
+			m := temporalLiteral.FindString(expr[i:])
+			if len(m) <= 1 {
+				return nil, fail(i, "invalid date/time literal")
+			}
+			toks = append(toks, token{kind: tokTemporal, text: m[1:], pos: i})
+			i += len(m)
+		case c == '%':
+			if i+1 < len(expr) && (expr[i+1] == '`' || expr[i+1] == '\'') {
+				s, n, err := unquote(expr[i+1:], expr[i+1])
+				if err != nil {
+					return nil, fail(i, "%v", err)
+				}
+				toks = append(toks, token{kind: tokVariable, text: s, pos: i})
+				i += n + 1
+				continue
+			}
+			j := i + 1
+			for j < len(expr) && (isIdentChar(expr[j]) || expr[j] == '-') {
+				j++
+			}
+			if j == i+1 {
+				return nil, fail(i, "missing variable name")
+			}
+			toks = append(toks, token{kind: tokVariable, text: expr[i+1 : j], pos: i})
+			i = j
+		case c == '$':
+			j := i + 1
+			for j < len(expr) && isIdentChar(expr[j]) {
+				j++
+			}
+			toks = append(toks, token{kind: tokSpecial, text: expr[i:j], pos: i})
+			i = j
+		case isIdentStart(c):
+			j := i
+			for j < len(expr) && isIdentChar(expr[j]) {
+				j++
+			}
+			toks = append(toks, token{kind: tokIdent, text: expr[i:j], pos: i})
+			i = j
+		default:
+			found := false
+			for _, op := range operators {
+				if strings.HasPrefix(expr[i:], op) {
+					toks = append(toks, token{kind: tokOp, text: op, pos: i})
+					i += len(op)
+					found = true
+					break
+				}
+			}
+			if !found {
+				r, _ := utf8.DecodeRuneInString(expr[i:])
+				return nil, fail(i, "unexpected character %q", r)
+			}
+		}
+	}
+	return append(toks, token{kind: tokEOF, pos: len(expr)}), nil
+}
+
+func isDigit(c byte) bool      { return c >= '0' && c <= '9' }
+func isIdentStart(c byte) bool { return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isIdentChar(c byte) bool  { return isIdentStart(c) || isDigit(c) }
+
+// unquote reads a quoted string or identifier at the start of s, returning
+// its value and length.
+func unquote(s string, quote byte) (string, int, error) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote:
+			return sb.String(), i + 1, nil
+		case c == '\\':
+			if i+1 >= len(s) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'u':
+				if i+4 >= len(s) {
+					return "", 0, fmt.Errorf("invalid unicode escape")
+				}
+				n, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid unicode escape")
+				}
+				sb.WriteRune(rune(n))
+				i += 4
+			default:
+				// \' \" \` \\ \/ stand for the character itself
+				sb.WriteByte(s[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// node is a node of a parsed expression.
+type node interface {
+	String() string
+}
+
+type (
+	literalNode struct {
+		value Collection
+		text  string
+	}
+	identNode struct {
+		name string
+	}
+	funcNode struct {
+		name string
+		args []node
+	}
+	specialNode struct {
+		name string // $this, $index or $total
+	}
+	variableNode struct {
+		name string
+	}
+	invokeNode struct {
+		target node
+		member node // an identNode or a funcNode
+	}
+	indexNode struct {
+		target, index node
+	}
+	unaryNode struct {
+		op      string
+		operand node
+	}
+	binaryNode struct {
+		op          string
+		left, right node
+	}
+	typeNode struct {
+		op      string // is or as
+		operand node
+		typ     typeSpec
+	}
+)
+
+// typeSpec is a type named in an expression, such as FHIR.Patient or
+// String.
+type typeSpec struct {
+	namespace, name string
+}
+
+func (t typeSpec) String() string {
+	if t.namespace == "" {
+		return t.name
+	}
+	return t.namespace + "." + t.name
+}
+
+func (n *literalNode) String() string  { return n.text }
+func (n *identNode) String() string    { return n.name }
+func (n *specialNode) String() string  { return n.name }
+func (n *variableNode) String() string { return "%" + n.name }
+func (n *funcNode) String() string {
+	args := make([]string, len(n.args))
+	for i, a := range n.args {
+		args[i] = a.String()
+	}
+	return n.name + "(" + strings.Join(args, ", ") + ")"
+}
+func (n *invokeNode) String() string { return n.target.String() + "." + n.member.String() }
+func (n *indexNode) String() string  { return n.target.String() + "[" + n.index.String() + "]" }
+func (n *unaryNode) String() string  { return n.op + n.operand.String() }
+func (n *binaryNode) String() string {
+	return "(" + n.left.String() + " " + n.op + " " + n.right.String() + ")"
+}
+func (n *typeNode) String() string { return n.operand.String() + " " + n.op + " " + n.typ.String() }
+
+// binding powers of the binary operators; higher binds tighter.
+var precedence = map[string]int{
+	"implies": 1,
+	"or":      2, "xor": 2,
+	"and": 3,
+	"in":  4, "contains": 4,
+	"=": 5, "~": 5, "!=": 5, "!~": 5,
+	"<": 6, ">": 6, "<=": 6, ">=": 6,
+	"|":  7,
+	"is": 8, "as": 8,
+	"+": 9, "-": 9, "&": 9,
+	"*": 10, "/": 10, "div": 10, "mod": 10,
+}
+
+const unaryPrecedence = 11
+
+type parser struct {
+	expr string
+	toks []token
+	pos  int
+}
+
+func parse(expr string) (node, error) {
+	toks, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{expr: expr, toks: toks}
+	n, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return &SyntaxError{Expr: p.expr, Offset: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) expect(op string) error {
+	if t := p.next(); t.kind != tokOp || t.text != op {
+		if t.kind == tokEOF {
+			return p.errorf(t, "expected %q, found the end", op)
+		}
+		return p.errorf(t, "expected %q, found %q", op, t.text)
+	}
+	return nil
+}
+
+// binaryOp returns the binary operator at t, if any.
+func binaryOp(t token) (string, bool) {
+	switch t.kind {
+	case tokOp, tokIdent:
+		if _, ok := precedence[t.text]; ok {
+			return t.text, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expression(minPrec int) (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := binaryOp(p.peek())
+		if !ok || precedence[op] <= minPrec {
+			return left, nil
+		}
+		p.next()
+		if op == "is" || op == "as" {
+			typ, err := p.typeSpecifier()
+			if err != nil {
+				return nil, err
+			}
+			left = &typeNode{op: op, operand: left, typ: typ}
+			continue
+		}
+		right, err := p.expression(precedence[op])
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) typeSpecifier() (typeSpec, error) {
+	t := p.next()
+	if t.kind != tokIdent && t.kind != tokDelimited {
+		return typeSpec{}, p.errorf(t, "expected a type name")
+	}
+	spec := typeSpec{name: t.text}
+	if n := p.peek(); n.kind == tokOp && n.text == "." {
+		p.next()
+		t2 := p.next()
+		if t2.kind != tokIdent && t2.kind != tokDelimited {
+			return typeSpec{}, p.errorf(t2, "expected a type name")
+		}
+		spec = typeSpec{namespace: t.text, name: t2.text}
+	}
+	return spec, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if t := p.peek(); t.kind == tokOp && (t.text == "-" || t.text == "+") {
+		p.next()
+		operand, err := p.expression(unaryPrecedence)
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: t.text, operand: operand}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	n, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		switch {
+		case t.kind == tokOp && t.text == ".":
+			p.next()
+			member, err := p.invocation()
+			if err != nil {
+				return nil, err
+			}
+			n = &invokeNode{target: n, member: member}
+		case t.kind == tokOp && t.text == "[":
+			p.next()
+			index, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = &indexNode{target: n, index: index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+// invocation parses a member name or a function call.
+func (p *parser) invocation() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokIdent, tokDelimited:
+		if n := p.peek(); t.kind == tokIdent && n.kind == tokOp && n.text == "(" {
+			return p.call(t.text)
+		}
+		return &identNode{name: t.text}, nil
+	case tokSpecial:
+		return &specialNode{name: t.text}, nil
+	case tokEOF:
+		return nil, p.errorf(t, "expected a name, found the end")
+	}
+	return nil, p.errorf(t, "expected a name, found %q", t.text)
+}
+
+func (p *parser) call(name string) (node, error) {
+	p.next() // (
+	fn := &funcNode{name: name}
+	if t := p.peek(); t.kind == tokOp && t.text == ")" {
+		p.next()
+		return fn, nil
+	}
+	for {
+		arg, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		fn.args = append(fn.args, arg)
+		t := p.next()
+		if t.kind == tokOp && t.text == ")" {
+			return fn, nil
+		}
+		if t.kind != tokOp || t.text != "," {
+			return nil, p.errorf(t, "expected \",\" or \")\"")
+		}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokOp:
+		switch t.text {
+		case "(":
+			p.next()
+			n, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			return n, p.expect(")")
+		case "{":
+			p.next()
+			return &literalNode{text: "{}"}, p.expect("}")
+		}
+	case tokString:
+		p.next()
+		return &literalNode{value: Collection{String(t.text)}, text: "'" + t.text + "'"}, nil
+	case tokNumber:
+		p.next()
+		return p.number(t)
+	case tokTemporal:
+		p.next()
+		v, err := temporalValue(t.text)
+		if err != nil {
+			return nil, p.errorf(t, "%v", err)
+		}
+		return &literalNode{value: Collection{v}, text: "@" + t.text}, nil
+	case tokVariable:
+		p.next()
+		return &variableNode{name: t.text}, nil
+	case tokIdent:
+		if t.text == "true" || t.text == "false" {
+			p.next()
+			return &literalNode{value: Collection{Boolean(t.text == "true")}, text: t.text}, nil
+		}
+		return p.invocation()
+	case tokDelimited, tokSpecial:
+		return p.invocation()
+	case tokEOF:
+		return nil, p.errorf(t, "unexpected end of expression")
+	}
+	return nil, p.errorf(t, "unexpected %q", t.text)
+}
+
+// number parses a number literal, and the unit that makes it a quantity.
+func (p *parser) number(t token) (node, error) {
+	var v Value
+	if strings.Contains(t.text, ".") {
+		d, err := ParseDecimal(t.text)
+		if err != nil {
+			return nil, p.errorf(t, "%v", err)
+		}
+		v = d
+	} else {
+		n, err := strconv.ParseInt(t.text, 10, 64)
+		if err != nil {
+			return nil, p.errorf(t, "integer %s out of range", t.text)
+		}
+		v = Integer(n)
+	}
+	u := p.peek()
+	unit := ""
+	switch {
+	case u.kind == tokString:
+		unit = u.text
+	case u.kind == tokIdent && calendarUnit(u.text) != "":
+		unit = u.text
+	default:
+		return &literalNode{value: Collection{v}, text: t.text}, nil
+	}
+	p.next()
+	q := Quantity{Unit: unit}
+	switch v := v.(type) {
+	case Integer:
+		q.Value = DecimalFromInt(int64(v))
+	case Decimal:
+		q.Value = v
+	}
+	return &literalNode{value: Collection{q}, text: q.String()}, nil
+}
+
+func temporalValue(s string) (Value, error) {
+	switch {
+	case strings.HasPrefix(s, "T"):
+		return ParseTime(s[1:])
+	case strings.Contains(s, "T"):
+		return ParseDateTime(strings.TrimSuffix(s, "T"))
+	}
+	return ParseDate(s)
+}
+
+// isTypeName reports whether an identifier looks like a type name, which
+// starts with a capital.
+func isTypeName(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
@@ -0,0 +1,322 @@
+package fhirpath
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// precision is how much of a date or time is known.
+type precision int
+
+const (
+	precYear precision = iota
+	precMonth
+	precDay
+	precHour
+	precMinute
+	precSecond
+	precMillisecond
+)
+
+// temporal is a point in time known to some precision. Times are kept as
+// a time.Time on 0000-01-01.
+type temporal struct {
+	t     time.Time
+	prec  precision
+	hasTZ bool
+}
+
+// Date is a System.Date, such as 2024-03 with month precision.
+type Date struct{ temporal }
+
+// DateTime is a System.DateTime, such as 2024-03-05T10:30:00+06:00.
+type DateTime struct{ temporal }
+
+// Time is a System.Time, such as 10:30.
+type Time struct{ temporal }
+
+// Type implements Value.
+func (Date) Type() TypeInfo { return systemType("Date") }
+
+// Type implements Value.
+func (DateTime) Type() TypeInfo { return systemType("DateTime") }
+
+// Type implements Value.
+func (Time) Type() TypeInfo { return systemType("Time") }
+
+// Time returns the start of the period the value covers.
+func (t temporal) Time() time.Time { return t.t }
+
+var (
+	datePattern     = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?)?$`)
+	dateTimePattern = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?)?(?:T(?:(\d{2})(?::(\d{2})(?::(\d{2})(?:\.(\d+))?)?)?)?(Z|[+-]\d{2}:\d{2})?)?$`)
+	timePattern     = regexp.MustCompile(`^(\d{2})(?::(\d{2})(?::(\d{2})(?:\.(\d+))?)?)?$`)
+)
+
+// ParseDate parses a date: YYYY, YYYY-MM or YYYY-MM-DD.
+func ParseDate(s string) (Date, error) {
+	m := datePattern.FindStringSubmatch(s)
+	if m == nil {
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+	t, err := makeTemporal(m[1], m[2], m[3], "", "", "", "", "")
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return Date{t}, nil
+}
+
+// ParseDateTime parses a FHIR or FHIRPath dateTime, such as 2024,
+// 2024-03-05T10:30:00.000+06:00 or 2024T.
+func ParseDateTime(s string) (DateTime, error) {
+	m := dateTimePattern.FindStringSubmatch(s)
+	if m == nil || m[8] != "" && m[4] == "" {
+		return DateTime{}, fmt.Errorf("invalid dateTime %q", s)
+	}
+	t, err := makeTemporal(m[1], m[2], m[3], m[4], m[5], m[6], m[7], m[8])
+	if err != nil {
+		return DateTime{}, fmt.Errorf("invalid dateTime %q: %w", s, err)
+	}
+	return DateTime{t}, nil
+}
+
+// ParseTime parses a time of day: hh, hh:mm, hh:mm:ss or hh:mm:ss.fff.
+func ParseTime(s string) (Time, error) {
+	m := timePattern.FindStringSubmatch(s)
+	if m == nil {
+		return Time{}, fmt.Errorf("invalid time %q", s)
+	}
+	t, err := makeTemporal("0000", "01", "01", m[1], m[2], m[3], m[4], "")
+	if err != nil {
+		return Time{}, fmt.Errorf("invalid time %q: %w", s, err)
+	}
+	return Time{t}, nil
+}
+
+func makeTemporal(year, month, day, hour, minute, second, frac, tz string) (temporal, error) {
+	parts := []string{year, month, day, hour, minute, second}
+	limits := [][2]int{{0, 9999}, {1, 12}, {1, 31}, {0, 23}, {0, 59}, {0, 59}}
+	vals := []int{0, 1, 1, 0, 0, 0}
+	prec := precision(-1)
+	for i, p := range parts {
+		if p == "" {
+			break
+		}
+		n, _ := strconv.Atoi(p)
+		if n < limits[i][0] || n > limits[i][1] {
+			return temporal{}, fmt.Errorf("%s out of range", p)
+		}
+		vals[i] = n
+		prec = precision(i)
+	}
+	nanos := 0
+	if frac != "" {
+		prec = precMillisecond
+		f := (frac + "000000000")[:9]
+		nanos, _ = strconv.Atoi(f)
+	}
+	loc := time.UTC
+	if tz != "" && tz != "Z" {
+		h, _ := strconv.Atoi(tz[1:3])
+		m, _ := strconv.Atoi(tz[4:6])
+		off := h*3600 + m*60
+		if tz[0] == '-' {
+			off = -off
+		}
+		loc = time.FixedZone(tz, off)
+	}
+	t := time.Date(vals[0], time.Month(vals[1]), vals[2], vals[3], vals[4], vals[5], nanos, loc)
+	if t.Day() != vals[2] {
+		return temporal{}, fmt.Errorf("day %d out of range", vals[2])
+	}
+	return temporal{t: t, prec: prec, hasTZ: tz != ""}, nil
+}
+
+// String formats a date as YYYY-MM-DD to its precision.
+func (d Date) String() string { return d.format(false) }
+
+// String formats a dateTime to its precision, with its time zone.
+func (d DateTime) String() string { return d.format(true) }
+
+// String formats a time to its precision.
+func (t Time) String() string {
+	s := t.temporal.format(true)
+	_, s, _ = strings.Cut(s, "T")
+	return s
+}
+
+func (t temporal) format(dateTime bool) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%04d", t.t.Year())
+	if t.prec >= precMonth {
+		fmt.Fprintf(&sb, "-%02d", int(t.t.Month()))
+	}
+	if t.prec >= precDay {
+		fmt.Fprintf(&sb, "-%02d", t.t.Day())
+	}
+	if t.prec >= precHour {
+		fmt.Fprintf(&sb, "T%02d", t.t.Hour())
+	}
+	if t.prec >= precMinute {
+		fmt.Fprintf(&sb, ":%02d", t.t.Minute())
+	}
+	if t.prec >= precSecond {
+		fmt.Fprintf(&sb, ":%02d", t.t.Second())
+	}
+	if t.prec >= precMillisecond {
+		fmt.Fprintf(&sb, ".%03d", t.t.Nanosecond()/int(time.Millisecond))
+	}
+	if dateTime && t.hasTZ && t.prec >= precHour {
+		if _, off := t.t.Zone(); off == 0 {
+			sb.WriteString("Z")
+		} else {
+			sb.WriteString(t.t.Format("-07:00"))
+		}
+	}
+	return sb.String()
+}
+
+// fields returns the parts of t down to prec, with seconds and
+// milliseconds counted as one part, in UTC when the precision reaches hours
+// and t has a time zone.
+func (t temporal) fields() []int {
+	tt := t.t
+	if t.hasTZ && t.prec >= precHour {
+		tt = tt.UTC()
+	}
+	all := []int{tt.Year(), int(tt.Month()), tt.Day(), tt.Hour(), tt.Minute(), tt.Second()*1000 + tt.Nanosecond()/int(time.Millisecond)}
+	n := int(min(t.prec, precSecond)) + 1
+	return all[:n]
+}
+
+// compareTemporal compares two values of the same kind. ok is false when
+// the result is unknown because one is less precise and they agree as far
+// as both go. A value with seconds compares with one with milliseconds as
+// if its milliseconds were zero. A time of day with a time zone doesn't
+// compare with one without.
+func compareTemporal(a, b temporal) (cmp int, ok bool) {
+	if a.hasTZ != b.hasTZ && a.prec >= precHour && b.prec >= precHour {
+		return 0, false
+	}
+	fa, fb := a.fields(), b.fields()
+	n := min(len(fa), len(fb))
+	for i := 0; i < n; i++ {
+		if fa[i] != fb[i] {
+			if fa[i] < fb[i] {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	if len(fa) != len(fb) {
+		return 0, false
+	}
+	return 0, true
+}
+
+// dateTime converts a Date to a DateTime of the same precision.
+func (d Date) dateTime() DateTime { return DateTime(d) }
+
+// calendarUnits maps the calendar duration keywords of FHIRPath to the
+// precision they count in.
+var calendarUnits = map[string]precision{
+	"year": precYear, "years": precYear,
+	"month": precMonth, "months": precMonth,
+	"week": precDay, "weeks": precDay,
+	"day": precDay, "days": precDay,
+	"hour": precHour, "hours": precHour,
+	"minute": precMinute, "minutes": precMinute,
+	"second": precSecond, "seconds": precSecond,
+	"millisecond": precMillisecond, "milliseconds": precMillisecond,
+}
+
+// calendarUnit returns the singular calendar keyword of a unit, or "".
+func calendarUnit(unit string) string {
+	if _, ok := calendarUnits[unit]; ok {
+		return strings.TrimSuffix(unit, "s")
+	}
+	return ""
+}
+
+// ucumDurations are the UCUM units usable as calendar durations.
+var ucumDurations = map[string]string{
+	"a": "year", "mo": "month", "wk": "week", "d": "day",
+	"h": "hour", "min": "minute", "s": "second", "ms": "millisecond",
+}
+
+// durationUnit returns the calendar keyword for a duration unit, whether a
+// keyword or a UCUM unit, or "".
+func durationUnit(unit string) string {
+	if u := calendarUnit(unit); u != "" {
+		return u
+	}
+	return ucumDurations[unit]
+}
+
+// addDuration adds a quantity of a duration unit to t. Units finer than the
+// precision of t are converted to its precision and truncated, so adding 25
+// hours to a date adds a day.
+func addDuration(t temporal, q Quantity, negate bool) (temporal, error) {
+	unit := durationUnit(q.Unit)
+	if unit == "" {
+		return t, fmt.Errorf("%s is not a duration", q)
+	}
+	amount := new(big.Rat).Set(q.Value.rat())
+	if negate {
+		amount.Neg(amount)
+	}
+
+	// Months and years, or a fixed number of milliseconds
+	var months int64
+	var millis *big.Rat
+	switch unit {
+	case "year":
+		months = truncate(new(big.Rat).Mul(amount, big.NewRat(12, 1)))
+	case "month":
+		months = truncate(amount)
+	default:
+		ms := map[string]int64{"week": 7 * 86400000, "day": 86400000, "hour": 3600000, "minute": 60000, "second": 1000, "millisecond": 1}[unit]
+		millis = new(big.Rat).Mul(amount, big.NewRat(ms, 1))
+	}
+
+	out := t
+	if millis != nil {
+		perUnit := map[precision]int64{precYear: 365 * 86400000, precMonth: 30 * 86400000, precDay: 86400000, precHour: 3600000, precMinute: 60000, precSecond: 1000, precMillisecond: 1}[t.prec]
+		if t.prec <= precMonth {
+			// Convert days to whole months or years
+			n := truncate(new(big.Rat).Quo(millis, big.NewRat(perUnit, 1)))
+			if t.prec == precYear {
+				n *= 12
+			}
+			months = n
+		} else {
+			n := truncate(new(big.Rat).Quo(millis, big.NewRat(perUnit, 1)))
+			out.t = out.t.Add(time.Duration(n*perUnit) * time.Millisecond)
+			return out, nil
+		}
+	}
+	if t.prec == precYear {
+		months = months / 12 * 12
+	}
+	y, m, d := t.t.Date()
+	total := int64(y)*12 + int64(m-1) + months
+	ny, nm := int(total/12), time.Month(total%12+1)
+	if total < 0 || ny > 9999 {
+		return t, fmt.Errorf("date out of range")
+	}
+	// The day is clamped to the end of a shorter month
+	if last := time.Date(ny, nm+1, 0, 0, 0, 0, 0, time.UTC).Day(); d > last {
+		d = last
+	}
+	out.t = time.Date(ny, nm, d, t.t.Hour(), t.t.Minute(), t.t.Second(), t.t.Nanosecond(), t.t.Location())
+	return out, nil
+}
+
+// truncate returns the integer part of r.
+func truncate(r *big.Rat) int64 {
+	return new(big.Int).Quo(r.Num(), r.Denom()).Int64()
+}
@@ -0,0 +1,147 @@
+package fhirpath
+
+import (
+	"fmt"
+)
+
+// typeInfoValue is the result of type(): its namespace and name can be
+// navigated as properties.
+type typeInfoValue struct {
+	TypeInfo
+}
+
+// Type implements Value.
+func (typeInfoValue) Type() TypeInfo { return systemType("SimpleTypeInfo") }
+
+// String returns the type's qualified name.
+func (t typeInfoValue) String() string { return t.TypeInfo.String() }
+
+var (
+	stringTypes = map[string]bool{"code": true, "id": true, "markdown": true}
+	uriTypes    = map[string]bool{"url": true, "canonical": true, "oid": true, "uuid": true}
+	integerSub  = map[string]bool{"positiveInt": true, "unsignedInt": true}
+	// untypedStrings are the types a JSON string of unknown type may be.
+	untypedStrings = map[string]bool{
+		"string": true, "code": true, "id": true, "markdown": true, "uri": true, "url": true,
+		"canonical": true, "oid": true, "uuid": true, "base64Binary": true,
+	}
+	// directResources are the resources that are not DomainResources.
+	directResources = map[string]bool{"Bundle": true, "Binary": true, "Parameters": true}
+)
+
+// isResource reports whether the element is a resource.
+func (e *Element) isResource() bool {
+	if e.rv.IsValid() {
+		_, ok := e.rv.Type().FieldByName("ResourceType")
+		return ok
+	}
+	m, ok := e.json.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = m["resourceType"].(string)
+	return ok
+}
+
+// baseType returns the type a FHIR type specializes, or "".
+func (e *Element) baseType(t string) string {
+	switch {
+	case stringTypes[t]:
+		return "string"
+	case uriTypes[t]:
+		return "uri"
+	case integerSub[t]:
+		return "integer"
+	case isQuantityType(t) && t != "Quantity":
+		return "Quantity"
+	case t == "Resource", t == "Element":
+		return ""
+	case t == "DomainResource":
+		return "Resource"
+	case t == e.typ && e.isResource():
+		if directResources[t] {
+			return "Resource"
+		}
+		return "DomainResource"
+	}
+	return "Element"
+}
+
+// is reports whether the element is of a FHIR type or a type derived from
+// it. Strings read from JSON without a model match any type a JSON string
+// can hold, and dates and times when they parse as one.
+func (e *Element) is(name string) bool {
+	if e.typ == "" && e.isPrimitive() {
+		if s, ok := e.json.(string); ok {
+			switch name {
+			case "date":
+				_, err := ParseDate(s)
+				return err == nil
+			case "dateTime", "instant":
+				_, err := ParseDateTime(s)
+				return err == nil
+			case "time":
+				_, err := ParseTime(s)
+				return err == nil
+			}
+			return untypedStrings[name] || name == "Element"
+		}
+	}
+	for t := e.Type().Name; t != ""; t = e.baseType(t) {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeMatches reports whether a value is of the type named in an
+// expression. Unqualified names are looked up in the FHIR namespace first
+// and then in System.
+func typeMatches(v Value, spec typeSpec) bool {
+	if e, ok := v.(*Element); ok {
+		return spec.namespace != "System" && e.is(spec.name)
+	}
+	return spec.namespace != "FHIR" && v.Type().Name == spec.name
+}
+
+// typeOperator implements is and as.
+func typeOperator(op string, c Collection, spec typeSpec) (Collection, error) {
+	if op == "is" {
+		v, err := singleton(c)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		return Collection{Boolean(typeMatches(v, spec))}, nil
+	}
+	if len(c) > 1 {
+		return nil, fmt.Errorf("fhirpath: as expects a single value, got %d", len(c))
+	}
+	return ofType(c, spec), nil
+}
+
+func ofType(c Collection, spec typeSpec) Collection {
+	var out Collection
+	for _, v := range c {
+		if typeMatches(v, spec) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// typeSpecOf reads the type named by a function argument, such as
+// ofType(FHIR.Quantity).
+func typeSpecOf(n node) (typeSpec, error) {
+	switch n := n.(type) {
+	case *identNode:
+		return typeSpec{name: n.name}, nil
+	case *invokeNode:
+		ns, ok1 := n.target.(*identNode)
+		name, ok2 := n.member.(*identNode)
+		if ok1 && ok2 {
+			return typeSpec{namespace: ns.name, name: name.name}, nil
+		}
+	}
+	return typeSpec{}, fmt.Errorf("fhirpath: %s is not a type", n)
+}
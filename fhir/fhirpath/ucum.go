@@ -0,0 +1,196 @@
+package fhirpath
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// dimension counts the base units of a unit: grams, metres, seconds, moles
+// and international units.
+type dimension [5]int
+
+// unit is a UCUM unit reduced to a factor of its base units.
+type unit struct {
+	factor *big.Rat
+	dim    dimension
+}
+
+func rat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic("fhirpath: bad factor " + s)
+	}
+	return r
+}
+
+// ucumAtoms are the UCUM units supported for conversion. Units outside
+// this table compare only with the same unit.
+var ucumAtoms = map[string]unit{
+	"1":       {rat("1"), dimension{}},
+	"%":       {rat("0.01"), dimension{}},
+	"g":       {rat("1"), dimension{1, 0, 0, 0, 0}},
+	"m":       {rat("1"), dimension{0, 1, 0, 0, 0}},
+	"s":       {rat("1"), dimension{0, 0, 1, 0, 0}},
+	"mol":     {rat("1"), dimension{0, 0, 0, 1, 0}},
+	"[iU]":    {rat("1"), dimension{0, 0, 0, 0, 1}},
+	"[IU]":    {rat("1"), dimension{0, 0, 0, 0, 1}},
+	"U":       {rat("1/60000000"), dimension{0, 0, -1, 1, 0}},
+	"min":     {rat("60"), dimension{0, 0, 1, 0, 0}},
+	"h":       {rat("3600"), dimension{0, 0, 1, 0, 0}},
+	"d":       {rat("86400"), dimension{0, 0, 1, 0, 0}},
+	"wk":      {rat("604800"), dimension{0, 0, 1, 0, 0}},
+	"mo":      {rat("2629800"), dimension{0, 0, 1, 0, 0}},
+	"a":       {rat("31557600"), dimension{0, 0, 1, 0, 0}},
+	"L":       {rat("1/1000"), dimension{0, 3, 0, 0, 0}},
+	"l":       {rat("1/1000"), dimension{0, 3, 0, 0, 0}},
+	"Pa":      {rat("1000"), dimension{1, -1, -2, 0, 0}},
+	"bar":     {rat("100000000"), dimension{1, -1, -2, 0, 0}},
+	"mm[Hg]":  {rat("133322.387415"), dimension{1, -1, -2, 0, 0}},
+	"J":       {rat("1000"), dimension{1, 2, -2, 0, 0}},
+	"cal":     {rat("4184"), dimension{1, 2, -2, 0, 0}},
+	"[lb_av]": {rat("453.59237"), dimension{1, 0, 0, 0, 0}},
+	"[oz_av]": {rat("28.349523125"), dimension{1, 0, 0, 0, 0}},
+	"[in_i]":  {rat("0.0254"), dimension{0, 1, 0, 0, 0}},
+	"[ft_i]":  {rat("0.3048"), dimension{0, 1, 0, 0, 0}},
+	"[mi_i]":  {rat("1609.344"), dimension{0, 1, 0, 0, 0}},
+}
+
+var ucumPrefixes = map[string]*big.Rat{
+	"T": rat("1e12"), "G": rat("1e9"), "M": rat("1e6"), "k": rat("1e3"),
+	"h": rat("1e2"), "da": rat("1e1"), "d": rat("1e-1"), "c": rat("1e-2"),
+	"m": rat("1e-3"), "u": rat("1e-6"), "n": rat("1e-9"), "p": rat("1e-12"),
+	"f": rat("1e-15"),
+}
+
+// calendarToUCUM maps the calendar keywords for definite durations to their
+// UCUM units. Years and months are not definite and have none.
+var calendarToUCUM = map[string]string{
+	"week": "wk", "day": "d", "hour": "h", "minute": "min", "second": "s", "millisecond": "ms",
+}
+
+var (
+	annotation   = regexp.MustCompile(`\{[^}]*\}`)
+	unitExponent = regexp.MustCompile(`^(.*?[^+\-\d])([+-]?\d+)$`)
+)
+
+// parseUnit reduces a UCUM unit such as mg/dL or kg.m-2 to base units.
+func parseUnit(code string) (unit, bool) {
+	code = annotation.ReplaceAllString(code, "")
+	if code == "" {
+		code = "1"
+	}
+	u := unit{factor: rat("1")}
+	div := false
+	for code != "" {
+		i := strings.IndexAny(code, "./")
+		term := code
+		if i >= 0 {
+			term = code[:i]
+		}
+		if term != "" {
+			t, ok := parseTerm(term)
+			if !ok {
+				return unit{}, false
+			}
+			sign := 1
+			if div {
+				sign = -1
+				t.factor = new(big.Rat).Inv(t.factor)
+			}
+			u.factor.Mul(u.factor, t.factor)
+			for k := range u.dim {
+				u.dim[k] += sign * t.dim[k]
+			}
+		}
+		if i < 0 {
+			break
+		}
+		div = code[i] == '/'
+		code = code[i+1:]
+	}
+	return u, true
+}
+
+// parseTerm reduces one term of a unit, such as cm3 or 10*3.
+func parseTerm(term string) (unit, bool) {
+	if n, ok := strings.CutPrefix(term, "10*"); ok {
+		e, err := strconv.Atoi(n)
+		if err != nil {
+			return unit{}, false
+		}
+		return unit{factor: powRat(10, e), dim: dimension{}}, true
+	}
+	exp := 1
+	if m := unitExponent.FindStringSubmatch(term); m != nil && term != "1" {
+		term = m[1]
+		exp, _ = strconv.Atoi(m[2])
+	}
+	atom, ok := ucumAtom(term)
+	if !ok {
+		return unit{}, false
+	}
+	u := unit{factor: rat("1")}
+	for range max(exp, -exp) {
+		u.factor.Mul(u.factor, atom.factor)
+	}
+	if exp < 0 {
+		u.factor.Inv(u.factor)
+	}
+	for k := range u.dim {
+		u.dim[k] = atom.dim[k] * exp
+	}
+	return u, true
+}
+
+func ucumAtom(s string) (unit, bool) {
+	if a, ok := ucumAtoms[s]; ok {
+		return a, true
+	}
+	for _, p := range []string{"da", "T", "G", "M", "k", "h", "d", "c", "m", "u", "n", "p", "f"} {
+		if rest, ok := strings.CutPrefix(s, p); ok {
+			if a, ok := ucumAtoms[rest]; ok && rest != "1" && rest != "%" {
+				return unit{factor: new(big.Rat).Mul(ucumPrefixes[p], a.factor), dim: a.dim}, true
+			}
+		}
+	}
+	return unit{}, false
+}
+
+func powRat(base int64, exp int) *big.Rat {
+	r := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(max(exp, -exp))), nil))
+	if exp < 0 {
+		r.Inv(r)
+	}
+	return r
+}
+
+// ucumCode returns the UCUM code of a quantity's unit, mapping calendar
+// keywords for definite durations to UCUM.
+func ucumCode(u string) string {
+	if c := calendarUnit(u); c != "" {
+		if code, ok := calendarToUCUM[c]; ok {
+			return code
+		}
+		return c
+	}
+	return u
+}
+
+// convertQuantity expresses q in the unit of to. ok is false if the units
+// measure different things or are unknown.
+func convertQuantity(q Quantity, to string) (Decimal, bool) {
+	from, target := ucumCode(q.Unit), ucumCode(to)
+	if from == target {
+		return q.Value, true
+	}
+	fu, ok1 := parseUnit(from)
+	tu, ok2 := parseUnit(target)
+	if !ok1 || !ok2 || fu.dim != tu.dim {
+		return Decimal{}, false
+	}
+	r := new(big.Rat).Mul(q.Value.rat(), fu.factor)
+	r.Quo(r, tu.factor)
+	return decimalOf(r, q.Value.scale), true
+}
@@ -0,0 +1,193 @@
+package fhirpath
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Value is an item of a Collection: a System value (Boolean, String,
+// Integer, Decimal, Date, DateTime, Time or Quantity) or an *Element of the
+// evaluated resource.
+type Value interface {
+	// Type returns the type of the value, such as System.String or
+	// FHIR.HumanName.
+	Type() TypeInfo
+}
+
+// TypeInfo names a type of the System or the FHIR namespace.
+type TypeInfo struct {
+	Namespace string
+	Name      string
+}
+
+// String returns Namespace.Name.
+func (t TypeInfo) String() string {
+	if t.Namespace == "" {
+		return t.Name
+	}
+	return t.Namespace + "." + t.Name
+}
+
+func systemType(name string) TypeInfo { return TypeInfo{Namespace: "System", Name: name} }
+
+// Boolean is a System.Boolean.
+type Boolean bool
+
+// Type implements Value.
+func (Boolean) Type() TypeInfo { return systemType("Boolean") }
+
+// String is a System.String.
+type String string
+
+// Type implements Value.
+func (String) Type() TypeInfo { return systemType("String") }
+
+// Integer is a System.Integer.
+type Integer int64
+
+// Type implements Value.
+func (Integer) Type() TypeInfo { return systemType("Integer") }
+
+// Decimal is a System.Decimal. It is exact and remembers its scale, the
+// number of digits after the decimal point, so 1.50 stays 1.50.
+type Decimal struct {
+	r     *big.Rat
+	scale int
+}
+
+// Type implements Value.
+func (Decimal) Type() TypeInfo { return systemType("Decimal") }
+
+// maxScale is the number of decimal places kept by division and the
+// functions, such as sqrt, whose results are not exact.
+const maxScale = 8
+
+// ParseDecimal parses a decimal such as -12.50 or 1e3.
+func ParseDecimal(s string) (Decimal, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	scale := 0
+	mantissa, exp, hasExp := strings.Cut(strings.ToLower(s), "e")
+	if _, frac, ok := strings.Cut(mantissa, "."); ok {
+		scale = len(frac)
+	}
+	if hasExp {
+		if e, err := strconv.Atoi(exp); err == nil {
+			scale = max(scale-e, 0)
+		}
+	}
+	return Decimal{r: r, scale: scale}, nil
+}
+
+// DecimalFromInt returns n as a Decimal.
+func DecimalFromInt(n int64) Decimal {
+	return Decimal{r: new(big.Rat).SetInt64(n)}
+}
+
+func (d Decimal) rat() *big.Rat {
+	if d.r == nil {
+		return new(big.Rat)
+	}
+	return d.r
+}
+
+// String formats the decimal with its scale.
+func (d Decimal) String() string {
+	return d.rat().FloatString(d.scale)
+}
+
+// Float64 returns the nearest float64.
+func (d Decimal) Float64() float64 {
+	f, _ := d.rat().Float64()
+	return f
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int { return d.scale }
+
+// Cmp compares d and e, returning -1, 0 or +1.
+func (d Decimal) Cmp(e Decimal) int { return d.rat().Cmp(e.rat()) }
+
+func (d Decimal) isInt() bool { return d.rat().IsInt() }
+
+// decimalOf makes a decimal of an exact result, with a scale that shows it
+// whole when it fits within limit digits.
+func decimalOf(r *big.Rat, scale int) Decimal {
+	for s := scale; s <= maxScale; s++ {
+		if new(big.Rat).Mul(r, pow10(s)).IsInt() {
+			return Decimal{r: r, scale: s}
+		}
+	}
+	return roundDecimal(r, maxScale)
+}
+
+// roundDecimal rounds half away from zero to scale digits.
+func roundDecimal(r *big.Rat, scale int) Decimal {
+	p := pow10(scale)
+	x := new(big.Rat).Mul(r, p)
+	num, den := x.Num(), x.Denom()
+	q, m := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(m), big.NewInt(2)).Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return Decimal{r: new(big.Rat).SetFrac(q, p.Num()), scale: scale}
+}
+
+func pow10(n int) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+}
+
+// Quantity is a System.Quantity: a decimal value with a UCUM unit, or a
+// calendar duration unit such as year or days.
+type Quantity struct {
+	Value Decimal
+	Unit  string
+}
+
+// Type implements Value.
+func (Quantity) Type() TypeInfo { return systemType("Quantity") }
+
+// String formats the quantity as FHIRPath writes it, such as 4.5 'mg' or
+// 3 days.
+func (q Quantity) String() string {
+	if calendarUnit(q.Unit) != "" {
+		return q.Value.String() + " " + q.Unit
+	}
+	return q.Value.String() + " '" + q.Unit + "'"
+}
+
+// Collection is the result of an expression: an ordered list of values.
+type Collection []Value
+
+// String renders the collection for messages, such as ['a', 2].
+func (c Collection) String() string {
+	parts := make([]string, len(c))
+	for i, v := range c {
+		if e, ok := v.(*Element); ok {
+			if p, ok := e.primitive(); ok {
+				v = p
+			}
+		}
+		switch v := v.(type) {
+		case String:
+			parts[i] = "'" + string(v) + "'"
+		case Boolean:
+			parts[i] = strconv.FormatBool(bool(v))
+		case Integer:
+			parts[i] = strconv.FormatInt(int64(v), 10)
+		case fmt.Stringer:
+			parts[i] = v.String()
+		default:
+			parts[i] = fmt.Sprint(v)
+		}
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
@@ -0,0 +1,257 @@
+package fhirpath
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+// xmlNode is an element of a FHIR XML document.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []xmlNode  `xml:",any"`
+	Inner    string     `xml:",innerxml"`
+}
+
+func (n *xmlNode) attr(name string) (string, bool) {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name && a.Name.Space == "" {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+var (
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	decimalType    = reflect.TypeOf(primitives.Decimal{})
+	extensionType  = reflect.TypeOf(r4.Extension{})
+)
+
+// xmlToJSON converts a resource in the FHIR XML format, such as the inputs
+// of the conformance suite, to its JSON form. The generated r4 structs say
+// which elements repeat and which primitives are JSON booleans or numbers;
+// elements they don't know repeat when they occur more than once and hold
+// strings.
+func xmlToJSON(data []byte) ([]byte, error) {
+	var root xmlNode
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	return json.Marshal(resourceJSON(&root))
+}
+
+func resourceJSON(n *xmlNode) map[string]any {
+	m := map[string]any{"resourceType": n.XMLName.Local}
+	var t reflect.Type
+	if r, err := r4.NewResource(n.XMLName.Local); err == nil {
+		t = reflect.TypeOf(r).Elem()
+	}
+	elementJSON(n, t, m)
+	return m
+}
+
+// elementJSON adds the attributes and child elements of n, an element of
+// Go type t, to m.
+func elementJSON(n *xmlNode, t reflect.Type, m map[string]any) {
+	for _, name := range []string{"id", "url"} {
+		if v, ok := n.attr(name); ok {
+			m[name] = v
+		}
+	}
+
+	var order []string
+	groups := map[string][]*xmlNode{}
+	for i := range n.Children {
+		c := &n.Children[i]
+		name := c.XMLName.Local
+		if _, ok := groups[name]; !ok {
+			order = append(order, name)
+		}
+		groups[name] = append(groups[name], c)
+	}
+
+	for _, name := range order {
+		nodes := groups[name]
+		ft := fieldType(t, name)
+		repeats := len(nodes) > 1 || name == "extension" || name == "modifierExtension"
+		if ft != nil && ft.Kind() == reflect.Slice && ft != rawMessageType {
+			repeats = true
+			ft = ft.Elem()
+		}
+
+		values := make([]any, len(nodes))
+		exts := make([]any, len(nodes))
+		hasValue, hasExt := false, false
+		for i, c := range nodes {
+			switch {
+			case name == "div":
+				values[i] = fmt.Sprintf(`<div xmlns="http://www.w3.org/1999/xhtml">%s</div>`, c.Inner)
+				hasValue = true
+			case ft == rawMessageType || ft == nil && isResourceSlot(name):
+				if len(c.Children) > 0 {
+					values[i] = resourceJSON(&c.Children[0])
+					hasValue = true
+				}
+			default:
+				if v, ok := c.attr("value"); ok {
+					values[i] = primitiveJSON(v, ft)
+					hasValue = true
+					if ext := primitiveExtension(c); ext != nil {
+						exts[i] = ext
+						hasExt = true
+					}
+					continue
+				}
+				o := map[string]any{}
+				elementJSON(c, ft, o)
+				values[i] = o
+				hasValue = true
+			}
+		}
+
+		if hasValue {
+			if repeats {
+				m[name] = values
+			} else {
+				m[name] = values[0]
+			}
+		}
+		if hasExt {
+			if repeats {
+				m["_"+name] = exts
+			} else {
+				m["_"+name] = exts[0]
+			}
+		}
+	}
+}
+
+// primitiveExtension returns the id and extensions of a primitive element,
+// written as _name in JSON, or nil if it has neither.
+func primitiveExtension(n *xmlNode) map[string]any {
+	m := map[string]any{}
+	if id, ok := n.attr("id"); ok {
+		m["id"] = id
+	}
+	var exts []any
+	for i := range n.Children {
+		if n.Children[i].XMLName.Local == "extension" {
+			o := map[string]any{}
+			elementJSON(&n.Children[i], extensionType, o)
+			exts = append(exts, o)
+		}
+	}
+	if len(exts) > 0 {
+		m["extension"] = exts
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// primitiveJSON returns a primitive value as the JSON type of t.
+func primitiveJSON(v string, t reflect.Type) any {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == nil:
+		return v
+	case t == decimalType:
+		return json.Number(v)
+	case t.Kind() == reflect.Bool:
+		return v == "true"
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		return json.Number(v)
+	}
+	return v
+}
+
+// isResourceSlot reports whether an element holds a resource, for
+// elements the structs don't know.
+func isResourceSlot(name string) bool {
+	return name == "contained" || name == "resource" || name == "outcome"
+}
+
+// fieldType returns the Go type of the struct field of t with the given
+// JSON name, looking into embedded structs, or nil.
+func fieldType(t reflect.Type, name string) reflect.Type {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			if ft := fieldType(f.Type, name); ft != nil {
+				return ft
+			}
+			continue
+		}
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == name {
+			return f.Type
+		}
+	}
+	return nil
+}
+
+func TestXMLToJSON(t *testing.T) {
+	src := `<Patient xmlns="http://hl7.org/fhir">
+  <id value="example"/>
+  <text>
+    <status value="generated"/>
+    <div xmlns="http://www.w3.org/1999/xhtml"><p>Peter</p></div>
+  </text>
+  <extension url="http://example.org/ext">
+    <valueBoolean value="true"/>
+  </extension>
+  <active value="true"/>
+  <name>
+    <given value="Peter"/>
+    <given id="g2" value="James"/>
+  </name>
+  <multipleBirthInteger value="2"/>
+  <contained>
+    <Organization>
+      <id value="org"/>
+    </Organization>
+  </contained>
+</Patient>`
+
+	data, err := xmlToJSON([]byte(src))
+	require.NoError(t, err)
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assert.Equal(t, "Patient", got["resourceType"])
+	assert.Equal(t, "example", got["id"])
+	assert.Equal(t, true, got["active"])
+	assert.Equal(t, float64(2), got["multipleBirthInteger"])
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml"><p>Peter</p></div>`, got["text"].(map[string]any)["div"])
+
+	name := got["name"].([]any)[0].(map[string]any)
+	assert.Equal(t, []any{"Peter", "James"}, name["given"])
+	assert.Equal(t, []any{nil, map[string]any{"id": "g2"}}, name["_given"])
+
+	ext := got["extension"].([]any)[0].(map[string]any)
+	assert.Equal(t, "http://example.org/ext", ext["url"])
+	assert.Equal(t, true, ext["valueBoolean"])
+
+	contained := got["contained"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Organization", contained["resourceType"])
+}
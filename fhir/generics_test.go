@@ -32,8 +32,8 @@ func TestUnmarshalResource(t *testing.T) {
 		}
 
 		// Verify the patient was unmarshaled correctly
-		if patient.ResourceType != "Patient" {
-			t.Errorf("Expected ResourceType Patient, got %s", patient.ResourceType)
+		if patient.BaseResource.ResourceType != "Patient" {
+			t.Errorf("Expected ResourceType Patient, got %s", patient.BaseResource.ResourceType)
 		}
 
 		if patient.ID == nil || *patient.ID != "example" {
@@ -72,8 +72,8 @@ func TestUnmarshalResource(t *testing.T) {
 			t.Fatalf("UnmarshalResource failed: %v", err)
 		}
 
-		if observation.ResourceType != "Observation" {
-			t.Errorf("Expected ResourceType Observation, got %s", observation.ResourceType)
+		if observation.BaseResource.ResourceType != "Observation" {
+			t.Errorf("Expected ResourceType Observation, got %s", observation.BaseResource.ResourceType)
 		}
 
 		if observation.Status != "final" {
@@ -162,8 +162,8 @@ func TestAddContainedResource(t *testing.T) {
 
 		// Create a Patient
 		patient := r5.Patient{
-			DomainResource: fhir.DomainResource{
-				Resource: fhir.Resource{
+			DomainResource: r5.DomainResource{
+				BaseResource: r5.BaseResource{
 					ResourceType: "Patient",
 					ID:           testutil.StringPtr("patient-1"),
 				},
@@ -203,8 +203,8 @@ func TestAddContainedResource(t *testing.T) {
 
 		// Add Patient
 		patient := r5.Patient{
-			DomainResource: fhir.DomainResource{
-				Resource: fhir.Resource{
+			DomainResource: r5.DomainResource{
+				BaseResource: r5.BaseResource{
 					ResourceType: "Patient",
 					ID:           testutil.StringPtr("patient-1"),
 				},
@@ -219,8 +219,8 @@ func TestAddContainedResource(t *testing.T) {
 
 		// Add Organization
 		org := r5.Organization{
-			DomainResource: fhir.DomainResource{
-				Resource: fhir.Resource{
+			DomainResource: r5.DomainResource{
+				BaseResource: r5.BaseResource{
 					ResourceType: "Organization",
 					ID:           testutil.StringPtr("org-1"),
 				},
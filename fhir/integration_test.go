@@ -8,6 +8,7 @@ import (
 	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/internal/testutil"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
 	"github.com/zs-health/zh-fhir-go/fhir/r5"
 	"github.com/zs-health/zh-fhir-go/fhir/validation"
 )
@@ -22,16 +23,16 @@ func TestIntegration_PatientFullWorkflow(t *testing.T) {
 		Active: &active,
 		Name: []r5.HumanName{
 			{
-				Use:    testutil.StringPtr("official"),
+				Use:    ptr(r5.NameUseOfficial),
 				Family: testutil.StringPtr("Doe"),
 				Given:  []string{"John"},
 			},
 		},
-		Gender:    testutil.StringPtr("male"),
+		Gender:    ptr(r5.AdministrativeGenderMale),
 		BirthDate: &birthDate,
 	}
 	patient.ID = testutil.StringPtr("example")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 
 	// 2. Validate the patient
 	validator := validation.NewFHIRValidator()
@@ -83,7 +84,7 @@ func TestIntegration_BundleBasics(t *testing.T) {
 	}
 
 	// Use bundle helper
-	helper := fhir.NewBundleHelper(bundle)
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
 
 	// Count resources
 	count := helper.Count()
@@ -125,7 +126,7 @@ func TestIntegration_ValidationErrors(t *testing.T) {
 		// Missing Status - required field
 	}
 	obs.ID = testutil.StringPtr("obs-1")
-	obs.ResourceType = "Observation"
+	obs.BaseResource.ResourceType = "Observation"
 	err := validator.Validate(obs)
 	// Note: validation might pass if status has a zero value, this test is informational
 	if err != nil {
@@ -140,12 +141,12 @@ func TestIntegration_SummaryMode(t *testing.T) {
 		Active: testutil.BoolPtr(true),
 		Name: []r5.HumanName{
 			{
-				Use:    testutil.StringPtr("official"),
+				Use:    ptr(r5.NameUseOfficial),
 				Family: testutil.StringPtr("Doe"),
 				Given:  []string{"John"},
 			},
 		},
-		Gender: testutil.StringPtr("male"),
+		Gender: ptr(r5.AdministrativeGenderMale),
 		Address: []r5.Address{
 			{
 				Line: []string{"123 Main St"},
@@ -154,7 +155,7 @@ func TestIntegration_SummaryMode(t *testing.T) {
 		},
 	}
 	patient.ID = testutil.StringPtr("example")
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 
 	// Full JSON
 	fullJSON, err := json.Marshal(patient)
@@ -217,14 +218,195 @@ func TestIntegration_PrimitivesHandling(t *testing.T) {
 	}
 }
 
+// TestIntegration_TypedPrimitivesRoundTrip tests that R5 base64Binary,
+// integer64, canonical and decimal values survive a JSON round-trip
+func TestIntegration_TypedPrimitivesRoundTrip(t *testing.T) {
+	input := `{"contentType":"text/plain","data":"aGVsbG8=","size":"9007199254740993"}`
+	var attachment r5.Attachment
+	if err := json.Unmarshal([]byte(input), &attachment); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if data, err := attachment.Data.Bytes(); err != nil || string(data) != "hello" {
+		t.Errorf("Data = %q, %v, want hello", data, err)
+	}
+	if *attachment.Size != 9007199254740993 {
+		t.Errorf("Size = %d, want 9007199254740993", *attachment.Size)
+	}
+	out, err := json.Marshal(attachment)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(out) != input {
+		t.Errorf("round-trip = %s, want %s", out, input)
+	}
+
+	if err := json.Unmarshal([]byte(`{"data":"not base64!"}`), &attachment); err == nil {
+		t.Error("Unmarshal accepted invalid base64Binary data")
+	}
+
+	input = `{"resource":"http://hl7.org/fhir/ValueSet/observation-status|4.0.1","type":"depends-on"}`
+	var artifact r5.RelatedArtifact
+	if err := json.Unmarshal([]byte(input), &artifact); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if artifact.Resource.URL() != "http://hl7.org/fhir/ValueSet/observation-status" || artifact.Resource.Version() != "4.0.1" {
+		t.Errorf("Resource = %q, %q", artifact.Resource.URL(), artifact.Resource.Version())
+	}
+}
+
+// TestIntegration_EnumRoundTrip tests that typed enums are plain strings in JSON.
+func TestIntegration_EnumRoundTrip(t *testing.T) {
+	input := `{"resourceType":"Patient","name":[{"use":"maiden","family":"Rahman"}],"gender":"female"}`
+	var patient r5.Patient
+	if err := json.Unmarshal([]byte(input), &patient); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if *patient.Gender != r5.AdministrativeGenderFemale || patient.Gender.Display() != "Female" {
+		t.Errorf("Gender = %q (%q), want female", *patient.Gender, patient.Gender.Display())
+	}
+	if use := *patient.Name[0].Use; use != r5.NameUseMaiden || use.Display() != "Name changed for Marriage" {
+		t.Errorf("Use = %q (%q), want maiden", use, use.Display())
+	}
+	out, err := json.Marshal(patient)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(out) != input {
+		t.Errorf("round-trip = %s, want %s", out, input)
+	}
+
+	// Codes outside the ValueSet still unmarshal, and the validator reports them
+	if err := json.Unmarshal([]byte(`{"resourceType":"Patient","gender":"M"}`), &patient); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if patient.Gender.IsValid() {
+		t.Error("IsValid() = true for M")
+	}
+	if r5.QuantityComparator("<=") != r5.QuantityComparatorLessOrEqualTo || !r5.BundleTypeSubscriptionNotification.IsValid() || r5.BundleType("search").IsValid() {
+		t.Error("enum constants do not match their ValueSets")
+	}
+}
+
+// TestIntegration_ChoiceAccessors tests that setting a choice option clears
+// the others, so that only one reaches the JSON.
+func TestIntegration_ChoiceAccessors(t *testing.T) {
+	var obs r5.Observation
+	if err := json.Unmarshal([]byte(`{"resourceType":"Observation","valueString":"high","_valueString":{"id":"v"}}`), &obs); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if obs.ValueType() != "string" || *obs.Value().(*string) != "high" {
+		t.Errorf("Value() = %v (%q), want string high", obs.Value(), obs.ValueType())
+	}
+
+	obs.SetValueQuantity(r5.Quantity{Value: ptr(primitives.MustDecimal("7.2")), Unit: ptr("mmol/L")})
+	if obs.ValueString != nil || obs.ValueStringExt != nil {
+		t.Error("SetValueQuantity did not clear valueString and its extension")
+	}
+	if obs.ValueType() != "Quantity" || obs.Value().(*r5.Quantity) != obs.ValueQuantity {
+		t.Errorf("ValueType() = %q, want Quantity", obs.ValueType())
+	}
+	out, err := json.Marshal(obs)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	want := `{"resourceType":"Observation","status":"","code":{},"valueQuantity":{"value":7.2,"unit":"mmol/L"}}`
+	if string(out) != want {
+		t.Errorf("Marshal = %s, want %s", out, want)
+	}
+
+	obs.ClearValue()
+	if obs.Value() != nil || obs.ValueType() != "" {
+		t.Errorf("Value() = %v after ClearValue", obs.Value())
+	}
+}
+
+// TestIntegration_UnmarshalAny tests decoding resources whose type is only
+// known from their JSON, directly and through a BundleHelper.
+func TestIntegration_UnmarshalAny(t *testing.T) {
+	resource, err := r5.UnmarshalAny(json.RawMessage(`{"resourceType":"Observation","id":"obs-1","meta":{"versionId":"2"},"status":"final"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalAny failed: %v", err)
+	}
+	obs, ok := resource.(*r5.Observation)
+	if !ok {
+		t.Fatalf("UnmarshalAny returned %T, want *r5.Observation", resource)
+	}
+	if obs.ResourceType() != "Observation" || *obs.GetID() != "obs-1" || *obs.GetMeta().VersionId != "2" || obs.Status != "final" {
+		t.Errorf("UnmarshalAny = %+v", obs)
+	}
+
+	for _, raw := range []string{`{"id":"x"}`, `{"resourceType":"Unknown"}`, `[]`} {
+		if _, err := r5.UnmarshalAny(json.RawMessage(raw)); err == nil {
+			t.Errorf("UnmarshalAny(%s) should fail", raw)
+		}
+	}
+
+	// R4 has its own registry, with resources R5 dropped
+	if r, err := r4.NewResource("DeviceUseStatement"); err != nil || r.ResourceType() != "DeviceUseStatement" {
+		t.Errorf("r4.NewResource(DeviceUseStatement) = %v, %v", r, err)
+	}
+	if _, err := r5.NewResource("DeviceUseStatement"); err == nil {
+		t.Error("r5.NewResource(DeviceUseStatement) should fail")
+	}
+
+	bundle := &fhir.Bundle{Type: string(r5.BundleTypeCollection)}
+	helper := fhir.NewBundleHelper(bundle, r5.UnmarshalAny)
+	patient := &r5.Patient{Active: ptr(true)}
+	patient.BaseResource.ResourceType = r5.ResourceTypePatient
+	patient.ID = ptr("pat-1")
+	if err := helper.AddEntry(patient, ptr("urn:uuid:pat-1")); err != nil {
+		t.Fatalf("AddEntry failed: %v", err)
+	}
+	found, err := helper.ResolveReference("Patient/pat-1")
+	if err != nil {
+		t.Fatalf("ResolveReference failed: %v", err)
+	}
+	if p, ok := found.(*r5.Patient); !ok || !*p.Active {
+		t.Errorf("ResolveReference = %#v, want the active *r5.Patient", found)
+	}
+}
+
+// TestIntegration_VersionedExtensions tests that extensions on a resource
+// take any value[x] type of their FHIR version.
+func TestIntegration_VersionedExtensions(t *testing.T) {
+	input := `{"resourceType":"Patient","extension":[` +
+		`{"url":"http://example.org/camp","valueCodeableConcept":{"text":"Camp 1W"}},` +
+		`{"url":"http://example.org/registrar","valueReference":{"reference":"Practitioner/p1"}},` +
+		`{"url":"http://example.org/source","valueUri":"urn:uuid:1"}]}`
+
+	var patient r5.Patient
+	if err := json.Unmarshal([]byte(input), &patient); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(patient.Extension) != 3 {
+		t.Fatalf("len(Extension) = %d, want 3", len(patient.Extension))
+	}
+	for i, want := range []string{"CodeableConcept", "Reference", "uri"} {
+		if got := patient.Extension[i].ValueType(); got != want {
+			t.Errorf("Extension[%d].ValueType() = %q, want %q", i, got, want)
+		}
+	}
+	if *patient.Extension[1].ValueReference.Reference != "Practitioner/p1" {
+		t.Errorf("valueReference = %v", patient.Extension[1].ValueReference)
+	}
+
+	out, err := json.Marshal(patient)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(out) != input {
+		t.Errorf("Marshal = %s, want %s", out, input)
+	}
+}
+
 // TestIntegration_ResourceInheritance tests resource inheritance
 func TestIntegration_ResourceInheritance(t *testing.T) {
 	// Patient extends DomainResource
 	patient := &r5.Patient{}
 	patient.ID = testutil.StringPtr("example")
-	patient.ResourceType = "Patient"
-	patient.Meta = &fhir.Meta{
-		VersionID: testutil.StringPtr("1"),
+	patient.BaseResource.ResourceType = "Patient"
+	patient.Meta = &r5.Meta{
+		VersionId: testutil.StringPtr("1"),
 	}
 
 	// Marshal and check meta is at root level (not nested)
@@ -350,7 +532,7 @@ func TestIntegration_BundleOperationsEndToEnd(t *testing.T) {
 	bundle := &r5.Bundle{
 		Type: "transaction",
 	}
-	bundle.ResourceType = "Bundle"
+	bundle.BaseResource.ResourceType = "Bundle"
 
 	// 2. Create Patient resource
 	patient := &r5.Patient{
@@ -362,7 +544,7 @@ func TestIntegration_BundleOperationsEndToEnd(t *testing.T) {
 			},
 		},
 	}
-	patient.ResourceType = "Patient"
+	patient.BaseResource.ResourceType = "Patient"
 	patient.ID = testutil.StringPtr("patient-1")
 
 	// 3. Add Patient to Bundle entry using json.RawMessage
@@ -436,7 +618,7 @@ func TestIntegration_ChoiceTypeScenariosFromSpec(t *testing.T) {
 			Active:          testutil.BoolPtr(true),
 			DeceasedBoolean: testutil.BoolPtr(true),
 		}
-		patient.ResourceType = "Patient"
+		patient.BaseResource.ResourceType = "Patient"
 		patient.ID = testutil.StringPtr("deceased-bool")
 
 		// Validate
@@ -473,7 +655,7 @@ func TestIntegration_ChoiceTypeScenariosFromSpec(t *testing.T) {
 			Active:           testutil.BoolPtr(true),
 			DeceasedDateTime: &deceasedDate,
 		}
-		patient.ResourceType = "Patient"
+		patient.BaseResource.ResourceType = "Patient"
 		patient.ID = testutil.StringPtr("deceased-datetime")
 
 		// Validate
@@ -509,19 +691,20 @@ func TestIntegration_ChoiceTypeScenariosFromSpec(t *testing.T) {
 	})
 
 	t.Run("Observation value[x] with quantity", func(t *testing.T) {
+		value := primitives.MustDecimal("120.0")
 		obs := &r5.Observation{
 			Status: "final",
 			Code: r5.CodeableConcept{
 				Text: testutil.StringPtr("Blood Pressure"),
 			},
 			ValueQuantity: &r5.Quantity{
-				Value:  testutil.Float64Ptr(120.0),
+				Value:  &value,
 				Unit:   testutil.StringPtr("mmHg"),
 				System: testutil.StringPtr("http://unitsofmeasure.org"),
 				Code:   testutil.StringPtr("mm[Hg]"),
 			},
 		}
-		obs.ResourceType = "Observation"
+		obs.BaseResource.ResourceType = "Observation"
 		obs.ID = testutil.StringPtr("bp-value")
 
 		// Validate
@@ -545,8 +728,8 @@ func TestIntegration_ChoiceTypeScenariosFromSpec(t *testing.T) {
 			t.Fatal("ValueQuantity should not be nil")
 		}
 
-		if *obs2.ValueQuantity.Value != 120.0 {
-			t.Errorf("ValueQuantity.Value mismatch: got %f, want 120.0", *obs2.ValueQuantity.Value)
+		if got := obs2.ValueQuantity.Value.String(); got != "120.0" {
+			t.Errorf("ValueQuantity.Value mismatch: got %s, want 120.0", got)
 		}
 
 		t.Log("✓ Observation.value[x] with quantity works correctly")
@@ -564,7 +747,7 @@ func TestIntegration_ErrorCasesAndValidationFailures(t *testing.T) {
 			DeceasedBoolean:  testutil.BoolPtr(true),
 			DeceasedDateTime: &deceasedDate, // Both set - violates mutual exclusion
 		}
-		patient.ResourceType = "Patient"
+		patient.BaseResource.ResourceType = "Patient"
 
 		err := validator.Validate(patient)
 		if err == nil {
@@ -594,13 +777,13 @@ func TestIntegration_ErrorCasesAndValidationFailures(t *testing.T) {
 				},
 			},
 		}
-		bundle.ResourceType = "Bundle"
+		bundle.BaseResource.ResourceType = "Bundle"
 
 		// Skip validation for json.RawMessage fields (validator counts bytes, not elements)
 		// Try to extract resource - JSON unmarshaling is permissive and won't fail on missing fields
 		patient, err := fhir.UnmarshalResource[r5.Patient](bundle.Entry[0].Resource)
 		// Note: This succeeds but produces an empty/invalid Patient
-		t.Logf("Unmarshal result: error=%v, patient.ResourceType=%s", err, patient.ResourceType)
+		t.Logf("Unmarshal result: error=%v, patient.ResourceType=%s", err, patient.BaseResource.ResourceType)
 
 		// However, validation should catch the invalid resource
 		validator2 := validation.NewFHIRValidator()
@@ -613,7 +796,7 @@ func TestIntegration_ErrorCasesAndValidationFailures(t *testing.T) {
 		patient := &r5.Patient{
 			Active: testutil.BoolPtr(true),
 		}
-		patient.ResourceType = "Patient"
+		patient.BaseResource.ResourceType = "Patient"
 		patient.ID = testutil.StringPtr("contained-test")
 
 		// Add invalid contained resource
@@ -626,6 +809,6 @@ func TestIntegration_ErrorCasesAndValidationFailures(t *testing.T) {
 		err := json.Unmarshal(patient.Contained[0], &result)
 		// Note: JSON unmarshaling is permissive and won't fail on unknown resourceType
 		// This test documents that behavior
-		t.Logf("Unmarshal result for unknown resourceType: error=%v, result.ResourceType=%s", err, result.ResourceType)
+		t.Logf("Unmarshal result for unknown resourceType: error=%v, result.ResourceType=%s", err, result.BaseResource.ResourceType)
 	})
 }
@@ -0,0 +1,12 @@
+package fhir
+
+// Invariant is a rule every instance of a FHIR type must meet, such as
+// obs-6 or per-1, taken from the constraints of its StructureDefinition.
+// The generated types of the version packages list theirs with an
+// Invariants method, which the validator checks.
+type Invariant struct {
+	Key        string // e.g., "obs-6"
+	Severity   string // error or warning
+	Human      string // Description of the rule
+	Expression string // FHIRPath, evaluated on an instance of the type
+}
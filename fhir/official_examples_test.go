@@ -210,7 +210,7 @@ func TestOfficialFHIRExamples(t *testing.T) {
 				}
 
 				// Test bundle helper
-				helper := fhir.NewBundleHelper(&bundle)
+				helper := fhir.NewBundleHelper(&bundle, r5.UnmarshalAny)
 				count := helper.Count()
 
 				t.Logf("Successfully processed Bundle with %d entries", count)
@@ -0,0 +1,133 @@
+package primitives
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Base64Binary represents a FHIR base64Binary primitive, such as
+// Attachment.data. It holds the data base64 encoded, as it is in JSON, and
+// decodes it only when asked, so large attachments pass through untouched.
+type Base64Binary struct {
+	value string
+}
+
+// NewBase64Binary creates a new Base64Binary from base64 encoded data.
+// Returns error if the data is not valid base64.
+func NewBase64Binary(encoded string) (Base64Binary, error) {
+	b := Base64Binary{value: encoded}
+	if err := b.Validate(); err != nil {
+		return Base64Binary{}, err
+	}
+	return b, nil
+}
+
+// MustBase64Binary creates a new Base64Binary, panicking if invalid.
+func MustBase64Binary(encoded string) Base64Binary {
+	b, err := NewBase64Binary(encoded)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Base64BinaryFromBytes creates a Base64Binary that holds data.
+func Base64BinaryFromBytes(data []byte) Base64Binary {
+	return Base64Binary{value: base64.StdEncoding.EncodeToString(data)}
+}
+
+// Base64BinaryFromReader creates a Base64Binary from all of r, encoding as
+// it reads rather than holding the raw data as well.
+func Base64BinaryFromReader(r io.Reader) (Base64Binary, error) {
+	var sb strings.Builder
+	w := base64.NewEncoder(base64.StdEncoding, &sb)
+	if _, err := io.Copy(w, r); err != nil {
+		return Base64Binary{}, err
+	}
+	if err := w.Close(); err != nil {
+		return Base64Binary{}, err
+	}
+	return Base64Binary{value: sb.String()}, nil
+}
+
+// String returns the data base64 encoded.
+func (b Base64Binary) String() string {
+	return b.value
+}
+
+// Validate checks that the value is base64 encoded data.
+func (b Base64Binary) Validate() error {
+	if b.value == "" {
+		return fmt.Errorf("base64Binary cannot be empty")
+	}
+	return Validate("base64Binary", b.value)
+}
+
+// Bytes decodes the data.
+func (b Base64Binary) Bytes() ([]byte, error) {
+	return io.ReadAll(b.Reader())
+}
+
+// Reader returns a reader that decodes the data as it is read. Whitespace
+// between blocks, which FHIR allows, is skipped.
+func (b Base64Binary) Reader() io.Reader {
+	return base64.NewDecoder(base64.StdEncoding, strings.NewReader(stripSpace(b.value)))
+}
+
+// Len returns the length of the decoded data, without decoding it.
+func (b Base64Binary) Len() int {
+	s := stripSpace(b.value)
+	n := base64.StdEncoding.DecodedLen(len(s))
+	return n - (len(s) - len(strings.TrimRight(s, "=")))
+}
+
+// stripSpace drops the whitespace FHIR allows in base64Binary.
+func stripSpace(s string) string {
+	if !strings.ContainsAny(s, " \t\r\n") {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\t' || r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Base64Binary) MarshalJSON() ([]byte, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(b.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The data is checked but kept
+// encoded.
+func (b *Base64Binary) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bin, err := NewBase64Binary(s)
+	if err != nil {
+		return err
+	}
+
+	*b = bin
+	return nil
+}
+
+// IsZero reports whether the value is the zero value.
+func (b Base64Binary) IsZero() bool {
+	return b.value == ""
+}
+
+// Equal reports whether b and other hold the same data.
+func (b Base64Binary) Equal(other Base64Binary) bool {
+	return stripSpace(b.value) == stripSpace(other.value)
+}
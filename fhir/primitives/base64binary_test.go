@@ -0,0 +1,61 @@
+package primitives
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBase64Binary(t *testing.T) {
+	b, err := NewBase64Binary("aGVsbG8=")
+	require.NoError(t, err)
+	assert.Equal(t, "aGVsbG8=", b.String())
+
+	for _, input := range []string{"", "aGVsbG8", "not base64!"} {
+		_, err := NewBase64Binary(input)
+		assert.Error(t, err, input)
+	}
+	assert.Panics(t, func() { MustBase64Binary("aGVsbG8") })
+}
+
+func TestBase64Binary_Decode(t *testing.T) {
+	b := MustBase64Binary("aGVs bG8g\nd29y bGQ=")
+	data, err := b.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+	assert.Equal(t, 11, b.Len())
+
+	streamed, err := io.ReadAll(b.Reader())
+	require.NoError(t, err)
+	assert.Equal(t, data, streamed)
+}
+
+func TestBase64Binary_FromBytes(t *testing.T) {
+	b := Base64BinaryFromBytes([]byte("hello"))
+	assert.Equal(t, "aGVsbG8=", b.String())
+	assert.Equal(t, 5, b.Len())
+
+	r, err := Base64BinaryFromReader(strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.True(t, r.Equal(b))
+}
+
+func TestBase64Binary_RoundTrip(t *testing.T) {
+	type attachment struct {
+		Data *Base64Binary `json:"data,omitempty"`
+	}
+
+	input := `{"data":"aGVsbG8="}`
+	var a attachment
+	require.NoError(t, json.Unmarshal([]byte(input), &a))
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+	assert.Equal(t, input, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"data":"junk!"}`), &a))
+	assert.Error(t, json.Unmarshal([]byte(`{"data":42}`), &a))
+}
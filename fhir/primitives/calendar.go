@@ -0,0 +1,385 @@
+package primitives
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Precisions of a date, dateTime or instant, from coarsest to finest.
+// Seconds and fractional seconds count as the same precision, as they do in
+// FHIRPath.
+const (
+	precisionYear = iota
+	precisionMonth
+	precisionDay
+	precisionSecond
+)
+
+// moment is a parsed date, dateTime or instant: where it starts, how
+// precise it is, and enough of the original text to write it back out.
+type moment struct {
+	start     time.Time // in the value's own zone; UTC when it has none
+	precision int
+	fraction  int    // digits of fractional seconds
+	zone      string // "Z", "+06:00" or "" when there is no time zone
+}
+
+// parseMoment parses a date, dateTime or instant value.
+func parseMoment(s string) (moment, bool) {
+	var m moment
+	var err error
+	switch {
+	case len(s) == 4:
+		m.precision = precisionYear
+		m.start, err = time.Parse("2006", s)
+	case len(s) == 7:
+		m.precision = precisionMonth
+		m.start, err = time.Parse("2006-01", s)
+	case len(s) == 10:
+		m.precision = precisionDay
+		m.start, err = time.Parse("2006-01-02", s)
+	case len(s) >= 19:
+		m.precision = precisionSecond
+		rest := s[19:]
+		if strings.HasPrefix(rest, ".") {
+			m.fraction = 1
+			for m.fraction < len(rest) && rest[m.fraction] >= '0' && rest[m.fraction] <= '9' {
+				m.fraction++
+			}
+			rest = rest[m.fraction:]
+			m.fraction--
+		}
+		m.zone = rest
+		loc := time.UTC
+		if rest != "" && rest != "Z" {
+			offset, err := time.Parse("-07:00", rest)
+			if err != nil {
+				return moment{}, false
+			}
+			_, secs := offset.Zone()
+			loc = time.FixedZone(rest, secs)
+		}
+		m.start, err = time.ParseInLocation("2006-01-02T15:04:05", s[:19], loc)
+		if err == nil && m.fraction > 0 {
+			m.start = m.start.Add(fractionOf(s[20 : 20+m.fraction]))
+		}
+	default:
+		return moment{}, false
+	}
+	return m, err == nil
+}
+
+// fractionOf converts the digits of a fractional second to a duration,
+// dropping anything finer than a nanosecond.
+func fractionOf(digits string) time.Duration {
+	var ns time.Duration
+	for i := range 9 {
+		ns *= 10
+		if i < len(digits) {
+			ns += time.Duration(digits[i] - '0')
+		}
+	}
+	return ns
+}
+
+// String formats the moment at its own precision.
+func (m moment) String() string {
+	switch m.precision {
+	case precisionYear:
+		return m.start.Format("2006")
+	case precisionMonth:
+		return m.start.Format("2006-01")
+	case precisionDay:
+		return m.start.Format("2006-01-02")
+	}
+	s := m.start.Format("2006-01-02T15:04:05")
+	if m.fraction > 0 {
+		ns := fmt.Sprintf("%09d", m.start.Nanosecond())
+		s += "." + ns[:min(m.fraction, 9)] + strings.Repeat("0", max(m.fraction-9, 0))
+	}
+	return s + m.zone
+}
+
+// end returns the first instant after the moment.
+func (m moment) end() time.Time {
+	switch m.precision {
+	case precisionYear:
+		return m.start.AddDate(1, 0, 0)
+	case precisionMonth:
+		return m.start.AddDate(0, 1, 0)
+	case precisionDay:
+		return m.start.AddDate(0, 0, 1)
+	}
+	step := time.Second
+	for range min(m.fraction, 9) {
+		step /= 10
+	}
+	return m.start.Add(step)
+}
+
+// compare compares two moments following the FHIRPath rules: values with
+// a time are compared as instants, anything else component by component
+// down to the coarser precision. If all those components match but the
+// precisions differ, the result is indeterminate and ok is false.
+func (m moment) compare(other moment) (cmp int, ok bool) {
+	if m.precision == precisionSecond && other.precision == precisionSecond {
+		return m.start.Compare(other.start), true
+	}
+	a := [3]int{m.start.Year(), int(m.start.Month()), m.start.Day()}
+	b := [3]int{other.start.Year(), int(other.start.Month()), other.start.Day()}
+	for i := range min(m.precision, other.precision, precisionDay) + 1 {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, m.precision == other.precision
+}
+
+// addCalendar adds years, months and days. Amounts finer than the moment's
+// precision are converted to whole units of it where that is exact (months
+// to years) and dropped otherwise, so "2024" plus 18 months is "2025". A day
+// past the end of the new month is clamped, so 2024-01-31 plus a month is
+// 2024-02-29.
+func (m moment) addCalendar(years, months, days int) moment {
+	switch m.precision {
+	case precisionYear:
+		years, months, days = years+months/12, 0, 0
+	case precisionMonth:
+		days = 0
+	}
+	t := m.start
+	y, mon := t.Year()+years, t.Month()+time.Month(months)
+	first := time.Date(y, mon, 1, 0, 0, 0, 0, time.UTC)
+	day := min(t.Day(), daysIn(first.Year(), first.Month()))
+	m.start = time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).
+		AddDate(0, 0, days)
+	return m
+}
+
+// addDuration adds d. Values with a time move by d exactly; dates move by
+// the whole days in d, and year and month values are unchanged.
+func (m moment) addDuration(d time.Duration) moment {
+	switch m.precision {
+	case precisionSecond:
+		m.start = m.start.Add(d)
+	case precisionDay:
+		m.start = m.start.AddDate(0, 0, int(d/(24*time.Hour)))
+	}
+	return m
+}
+
+// daysIn returns the number of days in a month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Range returns the period the date covers, from the start of its first day
+// to the start of the day after its last, in UTC. "2024-03" covers
+// [2024-03-01, 2024-04-01). The zero values are returned if the date is not
+// valid.
+func (d Date) Range() (start, end time.Time) {
+	m, ok := parseMoment(d.value)
+	if !ok {
+		return time.Time{}, time.Time{}
+	}
+	return m.start, m.end()
+}
+
+// Compare compares d with other. It returns -1, 0 or +1 and true, or false
+// if the answer depends on parts of a date that one of them leaves out:
+// "2024-03" is before "2024-04-15" but neither before nor after
+// "2024-03-15".
+func (d Date) Compare(other Date) (int, bool) {
+	return compareValues(d.value, other.value)
+}
+
+// AddCalendar adds years, months and days to the date, keeping its
+// precision. Amounts finer than the precision are converted to whole units
+// of it when they can be (months to years) and dropped otherwise, and a day
+// that falls past the end of the new month is clamped to its last day.
+func (d Date) AddCalendar(years, months, days int) Date {
+	m, ok := parseMoment(d.value)
+	if !ok {
+		return d
+	}
+	return Date{value: m.addCalendar(years, months, days).String()}
+}
+
+// AddDuration adds the whole days in dur to a full date. Year and month
+// dates are returned unchanged.
+func (d Date) AddDuration(dur time.Duration) Date {
+	m, ok := parseMoment(d.value)
+	if !ok {
+		return d
+	}
+	return Date{value: m.addDuration(dur).String()}
+}
+
+// DateTime returns the date as a dateTime of the same precision.
+func (d Date) DateTime() DateTime {
+	return DateTime{value: d.value}
+}
+
+// Range returns the period the dateTime covers, as for Date.Range. A value
+// with a time covers one unit of its last digit: "10:30:00" covers a second
+// and "10:30:00.25" a hundredth of one. Values without a time zone are taken
+// as UTC.
+func (dt DateTime) Range() (start, end time.Time) {
+	m, ok := parseMoment(dt.value)
+	if !ok {
+		return time.Time{}, time.Time{}
+	}
+	return m.start, m.end()
+}
+
+// Compare compares dt with other, as for Date.Compare. Values with a time
+// are compared as instants, whatever their time zones.
+func (dt DateTime) Compare(other DateTime) (int, bool) {
+	return compareValues(dt.value, other.value)
+}
+
+// AddCalendar adds years, months and days, as for Date.AddCalendar. The
+// time and time zone are kept.
+func (dt DateTime) AddCalendar(years, months, days int) DateTime {
+	m, ok := parseMoment(dt.value)
+	if !ok {
+		return dt
+	}
+	return DateTime{value: m.addCalendar(years, months, days).String()}
+}
+
+// AddDuration adds dur. A value with a time moves by dur, keeping its time
+// zone and number of fractional digits; a full date moves by the whole days
+// in dur, and year and month values are unchanged.
+func (dt DateTime) AddDuration(dur time.Duration) DateTime {
+	m, ok := parseMoment(dt.value)
+	if !ok {
+		return dt
+	}
+	return DateTime{value: m.addDuration(dur).String()}
+}
+
+// Range returns the period the instant covers: one unit of its last digit.
+func (i Instant) Range() (start, end time.Time) {
+	m, ok := parseMoment(i.value)
+	if !ok {
+		return time.Time{}, time.Time{}
+	}
+	return m.start, m.end()
+}
+
+// Compare compares i with other. Instants are always precise enough to
+// compare, so ok is false only if one of them is not valid.
+func (i Instant) Compare(other Instant) (int, bool) {
+	return compareValues(i.value, other.value)
+}
+
+// AddCalendar adds years, months and days, keeping the time and time zone.
+// A day that falls past the end of the new month is clamped to its last day.
+func (i Instant) AddCalendar(years, months, days int) Instant {
+	m, ok := parseMoment(i.value)
+	if !ok {
+		return i
+	}
+	return Instant{value: m.addCalendar(years, months, days).String()}
+}
+
+// AddDuration adds dur, keeping the time zone and number of fractional
+// digits.
+func (i Instant) AddDuration(dur time.Duration) Instant {
+	m, ok := parseMoment(i.value)
+	if !ok {
+		return i
+	}
+	return Instant{value: m.addDuration(dur).String()}
+}
+
+// DateTime returns the instant as a dateTime.
+func (i Instant) DateTime() DateTime {
+	return DateTime{value: i.value}
+}
+
+// compareValues parses and compares two values.
+func compareValues(a, b string) (int, bool) {
+	ma, ok := parseMoment(a)
+	if !ok {
+		return 0, false
+	}
+	mb, ok := parseMoment(b)
+	if !ok {
+		return 0, false
+	}
+	return ma.compare(mb)
+}
+
+// Age is an age in whole years, months and days, the way it is usually
+// stated: 2 years, 3 months and 10 days.
+type Age struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// TotalMonths returns the age in whole months, as paediatric growth
+// charts use past the first weeks.
+func (a Age) TotalMonths() int {
+	return a.Years*12 + a.Months
+}
+
+// String formats the age, such as "2y 3m 10d".
+func (a Age) String() string {
+	return fmt.Sprintf("%dy %dm %dd", a.Years, a.Months, a.Days)
+}
+
+// AgeAt returns the age at t of someone born on the date. A birthday on
+// the 29th to 31st of a month counts as reached on the last day of a shorter
+// month. It fails if the date is not a full date or t is before it.
+func (d Date) AgeAt(t time.Time) (Age, error) {
+	birth, on, err := d.ageSpan(t)
+	if err != nil {
+		return Age{}, err
+	}
+	years := on.Year() - birth.Year()
+	months := int(on.Month()) - int(birth.Month())
+	days := on.Day() - birth.Day()
+	if days < 0 && on.Day() < daysIn(on.Year(), on.Month()) {
+		months--
+		prev := on.AddDate(0, 0, -on.Day())
+		days += max(daysIn(prev.Year(), prev.Month()), birth.Day())
+	} else if days < 0 {
+		days = 0
+	}
+	if months < 0 {
+		years--
+		months += 12
+	}
+	return Age{Years: years, Months: months, Days: days}, nil
+}
+
+// AgeInDays returns the age in days at t of someone born on the date, as
+// growth charts use for the first years of life. It fails as AgeAt does.
+func (d Date) AgeInDays(t time.Time) (int, error) {
+	birth, on, err := d.ageSpan(t)
+	if err != nil {
+		return 0, err
+	}
+	return int(on.Sub(birth) / (24 * time.Hour)), nil
+}
+
+// ageSpan returns the birth date and the calendar date of t, both at
+// midnight UTC.
+func (d Date) ageSpan(t time.Time) (birth, on time.Time, err error) {
+	m, ok := parseMoment(d.value)
+	if !ok || m.precision != precisionDay {
+		return time.Time{}, time.Time{}, fmt.Errorf("age needs a full birth date, got %q", d.value)
+	}
+	on = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if on.Before(m.start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%s is before the birth date %s", on.Format("2006-01-02"), d.value)
+	}
+	return m.start, on, nil
+}
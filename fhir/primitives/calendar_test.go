@@ -0,0 +1,178 @@
+package primitives
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRange(t *testing.T) {
+	utc := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339Nano, s)
+		require.NoError(t, err)
+		return v
+	}
+
+	tests := []struct {
+		input      string
+		start, end string
+	}{
+		{"2024", "2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z"},
+		{"2024-02", "2024-02-01T00:00:00Z", "2024-03-01T00:00:00Z"},
+		{"2024-02-29", "2024-02-29T00:00:00Z", "2024-03-01T00:00:00Z"},
+		{"2024-02-29T10:30:00+06:00", "2024-02-29T04:30:00Z", "2024-02-29T04:30:01Z"},
+		{"2024-02-29T10:30:00.25Z", "2024-02-29T10:30:00.25Z", "2024-02-29T10:30:00.26Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			start, end := MustDateTime(tt.input).Range()
+			assert.True(t, utc(tt.start).Equal(start), "start = %v", start)
+			assert.True(t, utc(tt.end).Equal(end), "end = %v", end)
+		})
+	}
+
+	start, end := MustDate("2024-03").Range()
+	assert.Equal(t, "2024-03-01", start.Format(time.DateOnly))
+	assert.Equal(t, "2024-04-01", end.Format(time.DateOnly))
+
+	start, end = Date{}.Range()
+	assert.True(t, start.IsZero() && end.IsZero())
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		cmp  int
+		ok   bool
+	}{
+		{"2024-03", "2024-04-15", -1, true},
+		{"2024-03", "2024-03-15", 0, false},
+		{"2024", "2023-12-31", 1, true},
+		{"2024-03-15", "2024-03-15", 0, true},
+		{"2024-03-15", "2024-03-15T10:00:00Z", 0, false},
+		{"2024-03-14", "2024-03-15T10:00:00Z", -1, true},
+		{"2024-03-15T10:00:00+06:00", "2024-03-15T04:00:00Z", 0, true},
+		{"2024-03-15T10:00:00.5Z", "2024-03-15T10:00:00Z", 1, true},
+		{"2024-03-15T10:00:00Z", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			cmp, ok := DateTime{value: tt.a}.Compare(DateTime{value: tt.b})
+			assert.Equal(t, tt.cmp, cmp)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+
+	cmp, ok := MustDate("2024-03").Compare(MustDate("2024-02-29"))
+	assert.Equal(t, 1, cmp)
+	assert.True(t, ok)
+
+	cmp, ok = MustInstant("2024-03-15T10:00:00Z").Compare(MustInstant("2024-03-15T10:00:01.5+00:00"))
+	assert.Equal(t, -1, cmp)
+	assert.True(t, ok)
+
+	cmp, ok = MustDate("2024-03-15").DateTime().Compare(MustInstant("2024-03-16T00:00:00Z").DateTime())
+	assert.Equal(t, -1, cmp)
+	assert.True(t, ok)
+}
+
+func TestAddCalendar(t *testing.T) {
+	tests := []struct {
+		input               string
+		years, months, days int
+		want                string
+	}{
+		{"2024", 1, 0, 0, "2025"},
+		{"2024", 0, 18, 40, "2025"},
+		{"2024-11", 0, 3, 40, "2025-02"},
+		{"2024-01-31", 0, 1, 0, "2024-02-29"},
+		{"2024-02-29", 1, 0, 0, "2025-02-28"},
+		{"2024-12-30", 0, 0, 5, "2025-01-04"},
+		{"2024-01-31T23:00:00.50+06:00", 0, 1, 1, "2024-03-01T23:00:00.50+06:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := MustDateTime(tt.input).AddCalendar(tt.years, tt.months, tt.days)
+			assert.Equal(t, tt.want, got.String())
+			require.NoError(t, got.Validate())
+		})
+	}
+
+	assert.Equal(t, "2024-04-30", MustDate("2024-03-31").AddCalendar(0, 1, 0).String())
+	assert.Equal(t, "2025-01-01T00:00:00Z", MustInstant("2024-12-31T00:00:00Z").AddCalendar(0, 0, 1).String())
+}
+
+func TestAddDuration(t *testing.T) {
+	tests := []struct {
+		input string
+		d     time.Duration
+		want  string
+	}{
+		{"2024", 400 * 24 * time.Hour, "2024"},
+		{"2024-02", 40 * 24 * time.Hour, "2024-02"},
+		{"2024-02-28", 36 * time.Hour, "2024-02-29"},
+		{"2024-02-28", -24 * time.Hour, "2024-02-27"},
+		{"2024-02-28T23:30:00+06:00", time.Hour, "2024-02-29T00:30:00+06:00"},
+		{"2024-02-28T23:30:00.000Z", 1500 * time.Millisecond, "2024-02-28T23:30:01.500Z"},
+		{"2024-02-28T23:30:00", time.Minute, "2024-02-28T23:31:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, MustDateTime(tt.input).AddDuration(tt.d).String())
+		})
+	}
+
+	assert.Equal(t, "2024-03-01", MustDate("2024-02-29").AddDuration(24*time.Hour).String())
+	assert.Equal(t, "2024-01-01T05:59:59+06:00", MustInstant("2024-01-01T06:00:00+06:00").AddDuration(-time.Second).String())
+}
+
+func TestAgeAt(t *testing.T) {
+	day := func(s string) time.Time {
+		v, err := time.Parse(time.DateOnly, s)
+		require.NoError(t, err)
+		return v
+	}
+
+	tests := []struct {
+		birth, on string
+		want      Age
+	}{
+		{"2022-03-15", "2024-06-25", Age{Years: 2, Months: 3, Days: 10}},
+		{"2022-03-15", "2024-03-15", Age{Years: 2}},
+		{"2022-03-15", "2024-03-14", Age{Years: 1, Months: 11, Days: 28}},
+		{"2024-01-31", "2024-02-29", Age{Months: 1}},
+		{"2024-01-31", "2024-03-01", Age{Months: 1, Days: 1}},
+		{"2024-03-31", "2024-05-30", Age{Months: 1, Days: 30}},
+		{"2024-06-01", "2024-06-01", Age{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.birth+" "+tt.on, func(t *testing.T) {
+			age, err := MustDate(tt.birth).AgeAt(day(tt.on))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, age)
+		})
+	}
+
+	age, err := MustDate("2022-03-15").AgeAt(day("2024-06-25"))
+	require.NoError(t, err)
+	assert.Equal(t, 27, age.TotalMonths())
+	assert.Equal(t, "2y 3m 10d", age.String())
+
+	// The calendar date of t is used, in t's own zone
+	dhaka := time.FixedZone("BDT", 6*60*60)
+	days, err := MustDate("2024-01-01").AgeInDays(time.Date(2024, 3, 1, 1, 0, 0, 0, dhaka))
+	require.NoError(t, err)
+	assert.Equal(t, 60, days)
+
+	_, err = MustDate("2024-01").AgeAt(day("2024-06-01"))
+	assert.Error(t, err)
+	_, err = MustDate("2024-06-02").AgeInDays(day("2024-06-01"))
+	assert.Error(t, err)
+}
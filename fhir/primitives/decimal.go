@@ -0,0 +1,197 @@
+package primitives
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal represents a FHIR decimal primitive. It keeps the number as
+// written, so 1.50 stays 1.50 through JSON round-trips, and does its
+// arithmetic exactly rather than in floating point. The zero value is an
+// unset decimal, not 0.
+type Decimal struct {
+	value string
+}
+
+// NewDecimal creates a new Decimal from its lexical form, such as 72.50 or
+// 1.5e3. Returns error if the format is invalid.
+func NewDecimal(value string) (Decimal, error) {
+	d := Decimal{value: value}
+	if err := d.Validate(); err != nil {
+		return Decimal{}, err
+	}
+	return d, nil
+}
+
+// MustDecimal creates a new Decimal, panicking if invalid.
+func MustDecimal(value string) Decimal {
+	d, err := NewDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromInt creates a Decimal from an integer, with no decimal places.
+func DecimalFromInt(n int64) Decimal {
+	return Decimal{value: strconv.FormatInt(n, 10)}
+}
+
+// DecimalFromFloat creates a Decimal from the shortest form of f that reads
+// back as f, so 0.1 is 0.1 and not 0.1000000000000000055511151231257827.
+// NaN and infinities give a Decimal that fails Validate.
+func DecimalFromFloat(f float64) Decimal {
+	return Decimal{value: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+// String returns the decimal as written.
+func (d Decimal) String() string {
+	return d.value
+}
+
+// Validate checks if the decimal string conforms to the FHIR decimal format.
+func (d Decimal) Validate() error {
+	if d.value == "" {
+		return fmt.Errorf("decimal cannot be empty")
+	}
+	return Validate("decimal", d.value)
+}
+
+// IsZero reports whether the decimal is unset. A decimal of 0 is not zero;
+// use Sign for that.
+func (d Decimal) IsZero() bool {
+	return d.value == ""
+}
+
+// parts returns the value of d and the exponent of its last significant
+// digit: -2 for 1.50, 0 for 150 and 2 for 1.5e3. An invalid or unset
+// decimal is 0.
+func (d Decimal) parts() (*big.Rat, int) {
+	r, ok := new(big.Rat).SetString(d.value)
+	if !ok || d.Validate() != nil {
+		return new(big.Rat), 0
+	}
+	mantissa, exp, hasExp := strings.Cut(strings.ToLower(d.value), "e")
+	e := 0
+	if _, frac, ok := strings.Cut(mantissa, "."); ok {
+		e = -len(frac)
+	}
+	if hasExp {
+		n, _ := strconv.Atoi(exp)
+		e += n
+	}
+	return r, e
+}
+
+// Rat returns the exact value of the decimal.
+func (d Decimal) Rat() *big.Rat {
+	r, _ := d.parts()
+	return r
+}
+
+// Float64 returns the nearest float64.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Scale returns the number of digits after the decimal point: 2 for 1.50,
+// and 0 for 150 and 1.5e3.
+func (d Decimal) Scale() int {
+	_, e := d.parts()
+	return max(-e, 0)
+}
+
+// Sign returns -1, 0 or +1 as the decimal is below, equal to or above 0.
+func (d Decimal) Sign() int {
+	return d.Rat().Sign()
+}
+
+// Cmp compares the values of d and other, returning -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Equal reports whether d and other have the same value; 1.5 equals 1.50.
+// Compare String values to tell their precision apart.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Add returns d + other, with the decimal places of the more precise one.
+func (d Decimal) Add(other Decimal) Decimal {
+	return decimalOf(new(big.Rat).Add(d.Rat(), other.Rat()), max(d.Scale(), other.Scale()))
+}
+
+// Sub returns d - other, with the decimal places of the more precise one.
+func (d Decimal) Sub(other Decimal) Decimal {
+	return decimalOf(new(big.Rat).Sub(d.Rat(), other.Rat()), max(d.Scale(), other.Scale()))
+}
+
+// Mul returns d * other, with the decimal places of both, so the product is
+// exact.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return decimalOf(new(big.Rat).Mul(d.Rat(), other.Rat()), d.Scale()+other.Scale())
+}
+
+// Div returns d / other rounded to scale decimal places, halves away from
+// zero. Returns error if other is 0.
+func (d Decimal) Div(other Decimal, scale int) (Decimal, error) {
+	if other.Sign() == 0 {
+		return Decimal{}, fmt.Errorf("decimal division by zero")
+	}
+	return decimalOf(new(big.Rat).Quo(d.Rat(), other.Rat()), max(scale, 0)), nil
+}
+
+// Range returns the range of values the decimal stands for given its
+// implicit precision: half a unit of its last significant digit either
+// side, so 1.50 is 1.495 to 1.505 and 1.5e3 is 1450 to 1550. FHIR search
+// compares against this range for eq, ne and ap.
+func (d Decimal) Range() (low, high Decimal) {
+	r, e := d.parts()
+	half, _ := new(big.Rat).SetString(fmt.Sprintf("5e%d", e-1))
+	scale := max(1-e, 0)
+	return decimalOf(new(big.Rat).Sub(r, half), scale), decimalOf(new(big.Rat).Add(r, half), scale)
+}
+
+// decimalOf formats an exact value with scale decimal places.
+func decimalOf(r *big.Rat, scale int) Decimal {
+	return Decimal{value: r.FloatString(scale)}
+}
+
+// MarshalJSON implements json.Marshaler. The decimal is written as a JSON
+// number, exactly as it was read or created. An unset decimal is written as
+// 0, so a required field such as Location.position.longitude that was never
+// set still encodes.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("0"), nil
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(d.value), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It keeps the number as
+// written, with its trailing zeros.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		return fmt.Errorf("a FHIR decimal must be a JSON number, not a string")
+	}
+
+	dec, err := NewDecimal(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = dec
+	return nil
+}
@@ -0,0 +1,143 @@
+package primitives
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr bool
+		scale   int
+	}{
+		{input: "72.50", scale: 2},
+		{input: "-0.5", scale: 1},
+		{input: "150", scale: 0},
+		{input: "1.5e3", scale: 0},
+		{input: "1.5E-3", scale: 4},
+		{input: "", wantErr: true},
+		{input: "01.5", wantErr: true},
+		{input: ".5", wantErr: true},
+		{input: "1,5", wantErr: true},
+		{input: "NaN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewDecimal(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, d.String())
+			assert.Equal(t, tt.scale, d.Scale())
+		})
+	}
+}
+
+func TestMustDecimal_InvalidInput(t *testing.T) {
+	assert.Panics(t, func() { MustDecimal("1.") })
+}
+
+func TestDecimalFrom(t *testing.T) {
+	assert.Equal(t, "42", DecimalFromInt(42).String())
+	assert.Equal(t, "0.1", DecimalFromFloat(0.1).String())
+	assert.Equal(t, "120", DecimalFromFloat(120).String())
+	assert.Error(t, DecimalFromFloat(math.NaN()).Validate())
+}
+
+func TestDecimal_RoundTrip(t *testing.T) {
+	type quantity struct {
+		Value  *Decimal  `json:"value,omitempty"`
+		Values []Decimal `json:"values,omitempty"`
+	}
+
+	input := `{"value":1.50,"values":[0.10,2e-3,100]}`
+	var q quantity
+	require.NoError(t, json.Unmarshal([]byte(input), &q))
+	assert.Equal(t, "1.50", q.Value.String())
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	assert.Equal(t, input, string(data))
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	var d Decimal
+	assert.Error(t, json.Unmarshal([]byte(`"1.5"`), &d))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &d))
+	require.NoError(t, json.Unmarshal([]byte(`null`), &d))
+	assert.True(t, d.IsZero())
+
+	_, err := json.Marshal(Decimal{value: "1.5.0"})
+	assert.Error(t, err)
+}
+
+func TestDecimal_MarshalZero(t *testing.T) {
+	type position struct {
+		Longitude Decimal  `json:"longitude"`
+		Altitude  *Decimal `json:"altitude,omitempty"`
+	}
+
+	data, err := json.Marshal(position{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"longitude":0}`, string(data))
+}
+
+func TestDecimal_Compare(t *testing.T) {
+	a, b := MustDecimal("1.5"), MustDecimal("1.50")
+	assert.True(t, a.Equal(b))
+	assert.Equal(t, 0, a.Cmp(b))
+	assert.Equal(t, -1, a.Cmp(MustDecimal("1.51")))
+	assert.Equal(t, 1, MustDecimal("1e1").Cmp(MustDecimal("9.99")))
+	assert.Equal(t, -1, MustDecimal("-2").Sign())
+	assert.Equal(t, 0, MustDecimal("0.00").Sign())
+	assert.False(t, MustDecimal("0").IsZero())
+	assert.InDelta(t, 72.5, MustDecimal("72.50").Float64(), 1e-12)
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	d := MustDecimal
+
+	// No floating point error, and the precision of the operands is kept
+	assert.Equal(t, "0.3", d("0.1").Add(d("0.2")).String())
+	assert.Equal(t, "3.00", d("1.50").Add(d("1.5")).String())
+	assert.Equal(t, "-0.25", d("1.25").Sub(d("1.5")).String())
+	assert.Equal(t, "1.5000", d("1.50").Mul(d("1.00")).String())
+	assert.Equal(t, "1500", d("1.5e3").Mul(d("1")).String())
+
+	q, err := d("10").Div(d("3"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "3.33", q.String())
+	q, err = d("2").Div(d("3"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "0.67", q.String())
+	_, err = d("1").Div(d("0.0"), 2)
+	assert.Error(t, err)
+}
+
+func TestDecimal_Range(t *testing.T) {
+	tests := []struct {
+		input     string
+		low, high string
+	}{
+		{"1.50", "1.495", "1.505"},
+		{"100", "99.5", "100.5"},
+		{"1.5e3", "1450", "1550"},
+		{"-0.5", "-0.55", "-0.45"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			low, high := MustDecimal(tt.input).Range()
+			assert.Equal(t, tt.low, low.String())
+			assert.Equal(t, tt.high, high.String())
+		})
+	}
+}
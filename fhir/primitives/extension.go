@@ -32,7 +32,7 @@ type Extension struct {
 	ValueBoolean      *bool    `json:"valueBoolean,omitempty"`
 	ValueInteger      *int     `json:"valueInteger,omitempty"`
 	ValueString       *string  `json:"valueString,omitempty"`
-	ValueDecimal      *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal      *Decimal `json:"valueDecimal,omitempty"`
 	ValueUri          *string  `json:"valueUri,omitempty"`
 	ValueUrl          *string  `json:"valueUrl,omitempty"`
 	ValueCanonical    *string  `json:"valueCanonical,omitempty"`
@@ -0,0 +1,69 @@
+package primitives
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Integer64 represents a FHIR integer64 primitive, new in R5. FHIR JSON
+// writes it as a string, such as "9007199254740993", so that JavaScript
+// readers keep every digit.
+type Integer64 int64
+
+// NewInteger64 parses an integer64 as written, such as "-42". Returns
+// error if the format is invalid.
+func NewInteger64(value string) (Integer64, error) {
+	if err := Validate("integer64", value); err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return Integer64(n), nil
+}
+
+// String returns the integer in decimal.
+func (i Integer64) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+// Int64 returns the integer as an int64.
+func (i Integer64) Int64() int64 {
+	return int64(i)
+}
+
+// Validate checks the integer; every int64 is a valid integer64.
+func (i Integer64) Validate() error {
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing the integer as a string.
+func (i Integer64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It reads the string FHIR
+// writes, and also a JSON number, as some servers send.
+func (i *Integer64) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	n, err := NewInteger64(s)
+	if err != nil {
+		return fmt.Errorf("invalid FHIR integer64: %s", data)
+	}
+
+	*i = n
+	return nil
+}
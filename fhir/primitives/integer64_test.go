@@ -0,0 +1,45 @@
+package primitives
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInteger64(t *testing.T) {
+	n, err := NewInteger64("9223372036854775807")
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), n.Int64())
+
+	for _, input := range []string{"", "1.0", "007", "9223372036854775808"} {
+		_, err := NewInteger64(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestInteger64_JSON(t *testing.T) {
+	type status struct {
+		EventNumber Integer64  `json:"eventNumber"`
+		Since       *Integer64 `json:"eventsSinceSubscriptionStart,omitempty"`
+	}
+
+	input := `{"eventNumber":"9007199254740993","eventsSinceSubscriptionStart":"-5"}`
+	var s status
+	require.NoError(t, json.Unmarshal([]byte(input), &s))
+	assert.Equal(t, Integer64(9007199254740993), s.EventNumber)
+	assert.Equal(t, Integer64(-5), *s.Since)
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.Equal(t, input, string(data))
+
+	// A JSON number is read too
+	require.NoError(t, json.Unmarshal([]byte(`{"eventNumber":42}`), &s))
+	assert.Equal(t, Integer64(42), s.EventNumber)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"eventNumber":"4.2"}`), &s))
+	assert.Error(t, json.Unmarshal([]byte(`{"eventNumber":true}`), &s))
+}
@@ -0,0 +1,75 @@
+package primitives
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Uri represents a FHIR uri primitive. It is a string in JSON, and any
+// string without whitespace is a valid uri.
+type Uri string
+
+// String returns the uri.
+func (u Uri) String() string {
+	return string(u)
+}
+
+// Validate checks that the uri is not empty and has no whitespace.
+func (u Uri) Validate() error {
+	if u == "" {
+		return fmt.Errorf("uri cannot be empty")
+	}
+	return Validate("uri", string(u))
+}
+
+// Canonical represents a FHIR canonical primitive: the canonical URL of a
+// definition, such as a ValueSet, optionally followed by |version and a
+// #fragment, as in http://hl7.org/fhir/ValueSet/observation-status|4.0.1.
+type Canonical string
+
+// NewCanonical creates a Canonical from a URL and a version, which may be
+// empty.
+func NewCanonical(url, version string) Canonical {
+	if version == "" {
+		return Canonical(url)
+	}
+	return Canonical(url + "|" + version)
+}
+
+// String returns the canonical as written.
+func (c Canonical) String() string {
+	return string(c)
+}
+
+// URL returns the canonical URL, without version or fragment.
+func (c Canonical) URL() string {
+	url, _, _ := c.split()
+	return url
+}
+
+// Version returns the version after the |, or "" if there is none.
+func (c Canonical) Version() string {
+	_, version, _ := c.split()
+	return version
+}
+
+// Fragment returns the fragment after the #, which points into a contained
+// resource, or "" if there is none.
+func (c Canonical) Fragment() string {
+	_, _, fragment := c.split()
+	return fragment
+}
+
+func (c Canonical) split() (url, version, fragment string) {
+	rest, fragment, _ := strings.Cut(string(c), "#")
+	url, version, _ = strings.Cut(rest, "|")
+	return url, version, fragment
+}
+
+// Validate checks that the canonical is not empty and has no whitespace.
+func (c Canonical) Validate() error {
+	if c == "" {
+		return fmt.Errorf("canonical cannot be empty")
+	}
+	return Validate("canonical", string(c))
+}
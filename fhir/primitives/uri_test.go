@@ -0,0 +1,51 @@
+package primitives
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		canonical Canonical
+		url       string
+		version   string
+		fragment  string
+	}{
+		{"http://hl7.org/fhir/ValueSet/observation-status", "http://hl7.org/fhir/ValueSet/observation-status", "", ""},
+		{"http://hl7.org/fhir/ValueSet/observation-status|4.0.1", "http://hl7.org/fhir/ValueSet/observation-status", "4.0.1", ""},
+		{"http://example.org/Questionnaire/q|2#item1", "http://example.org/Questionnaire/q", "2", "item1"},
+		{"#vs1", "", "", "vs1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.canonical), func(t *testing.T) {
+			assert.Equal(t, tt.url, tt.canonical.URL())
+			assert.Equal(t, tt.version, tt.canonical.Version())
+			assert.Equal(t, tt.fragment, tt.canonical.Fragment())
+			assert.NoError(t, tt.canonical.Validate())
+		})
+	}
+
+	assert.Equal(t, Canonical("http://example.org/vs|1.0"), NewCanonical("http://example.org/vs", "1.0"))
+	assert.Equal(t, Canonical("http://example.org/vs"), NewCanonical("http://example.org/vs", ""))
+	assert.Error(t, Canonical("http://example.org/a b").Validate())
+	assert.Error(t, Canonical("").Validate())
+}
+
+func TestUri(t *testing.T) {
+	assert.NoError(t, Uri("urn:oid:2.16.840").Validate())
+	assert.Error(t, Uri("http://example.org/a b").Validate())
+
+	var got struct {
+		System  *Uri      `json:"system"`
+		Profile Canonical `json:"profile"`
+	}
+	input := `{"system":"http://loinc.org","profile":"http://example.org/p|1"}`
+	require.NoError(t, json.Unmarshal([]byte(input), &got))
+	assert.Equal(t, "http://loinc.org", got.System.String())
+	assert.Equal(t, "1", got.Profile.Version())
+}
@@ -0,0 +1,155 @@
+package primitives
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxStringLength is the most characters a string or markdown may hold.
+const maxStringLength = 1024 * 1024
+
+// primitiveFormat is the lexical format of a FHIR primitive type.
+type primitiveFormat struct {
+	pattern  *regexp.Regexp
+	expected string
+	// check, if set, checks what the pattern can't, such as ranges
+	check func(value string) error
+}
+
+// FHIR primitive formats, from the regex extensions of the primitive type
+// definitions. base64Binary also allows /, which the published regex
+// leaves out by mistake.
+var primitiveFormats = map[string]primitiveFormat{
+	"boolean": {pattern: regexp.MustCompile(`^(true|false)$`), expected: "true or false"},
+	"integer": {
+		pattern:  regexp.MustCompile(`^(0|[-+]?[1-9][0-9]*)$`),
+		expected: "a whole number",
+		check:    intRange("integer", math.MinInt32, math.MaxInt32),
+	},
+	"integer64": {
+		pattern:  regexp.MustCompile(`^(0|[-+]?[1-9][0-9]*)$`),
+		expected: "a whole number",
+		check:    intRange("integer64", math.MinInt64, math.MaxInt64),
+	},
+	"positiveInt": {
+		pattern:  regexp.MustCompile(`^\+?[1-9][0-9]*$`),
+		expected: "a whole number above 0",
+		check:    intRange("positiveInt", 1, math.MaxInt32),
+	},
+	"unsignedInt": {
+		pattern:  regexp.MustCompile(`^(0|[1-9][0-9]*)$`),
+		expected: "a whole number of 0 or more",
+		check:    intRange("unsignedInt", 0, math.MaxInt32),
+	},
+	"decimal": {
+		pattern:  regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`),
+		expected: "a decimal number, such as 1.50",
+	},
+	"id": {
+		pattern:  regexp.MustCompile(`^[A-Za-z0-9\-.]{1,64}$`),
+		expected: "1 to 64 letters, digits, - and .",
+	},
+	"oid": {
+		pattern:  regexp.MustCompile(`^urn:oid:[0-2](\.(0|[1-9][0-9]*))+$`),
+		expected: "urn:oid: and a dotted OID, such as urn:oid:2.16.840",
+	},
+	"uuid": {
+		pattern:  regexp.MustCompile(`^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`),
+		expected: "urn:uuid: and a lowercase UUID",
+	},
+	"uri":       {pattern: regexp.MustCompile(`^\S+$`), expected: "a URI without whitespace"},
+	"url":       {pattern: regexp.MustCompile(`^\S+$`), expected: "a URL without whitespace"},
+	"canonical": {pattern: regexp.MustCompile(`^\S+$`), expected: "a canonical URL without whitespace"},
+	"code": {
+		pattern:  regexp.MustCompile(`^[^\s]+( [^\s]+)*$`),
+		expected: "no leading, trailing or repeated whitespace",
+	},
+	"string":   {check: checkString},
+	"markdown": {check: checkString},
+	"base64Binary": {
+		pattern:  regexp.MustCompile(`^(\s*([0-9a-zA-Z+/=]){4}\s*)+$`),
+		expected: "base64 encoded data",
+		check:    checkBase64,
+	},
+	"date": {
+		pattern:  regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1]))?)?$`),
+		expected: "YYYY, YYYY-MM or YYYY-MM-DD",
+	},
+	"dateTime": {
+		pattern:  regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]+)?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00)))?)?)?$`),
+		expected: "YYYY, YYYY-MM, YYYY-MM-DD or YYYY-MM-DDThh:mm:ss[.sss] with a time zone",
+	},
+	"instant": {
+		pattern:  regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)-(0[1-9]|1[0-2])-(0[1-9]|[1-2][0-9]|3[0-1])T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]+)?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00))$`),
+		expected: "YYYY-MM-DDThh:mm:ss[.sss] with a time zone",
+	},
+	"time": {
+		pattern:  regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]+)?$`),
+		expected: "hh:mm:ss[.sss]",
+	},
+}
+
+// Validate checks a value of the FHIR primitive type typ, such as id or
+// positiveInt, as written in JSON: numbers and booleans as their literals,
+// such as 42 or true. It checks the regular expression of the type and the
+// range of integer types. Types without a format, such as xhtml, and
+// names that are not primitive types accept any value.
+func Validate(typ, value string) error {
+	f, ok := primitiveFormats[typ]
+	if !ok {
+		return nil
+	}
+	if f.pattern != nil && !f.pattern.MatchString(value) {
+		return fmt.Errorf("invalid FHIR %s: %q (expected %s)", typ, value, f.expected)
+	}
+	if f.check != nil {
+		if err := f.check(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// intRange returns a check that an integer of type typ is from lo to hi.
+func intRange(typ string, lo, hi int64) func(string) error {
+	return func(value string) error {
+		n, err := strconv.ParseInt(strings.TrimPrefix(value, "+"), 10, 64)
+		if err != nil || n < lo || n > hi {
+			return fmt.Errorf("invalid FHIR %s: %s is out of range (expected %d to %d)", typ, value, lo, hi)
+		}
+		return nil
+	}
+}
+
+// checkString checks a string or markdown: not empty, at most 1MB, and
+// without control characters other than tab, carriage return and line
+// feed.
+func checkString(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("invalid FHIR string: must have content other than whitespace")
+	}
+	if n := len([]rune(value)); n > maxStringLength {
+		return fmt.Errorf("invalid FHIR string: %d characters is more than the %d allowed", n, maxStringLength)
+	}
+	for _, r := range value {
+		if r < ' ' && r != '\t' && r != '\r' && r != '\n' {
+			return fmt.Errorf("invalid FHIR string: has control character %U", r)
+		}
+	}
+	return nil
+}
+
+// checkBase64 checks that base64Binary data decodes, without holding the
+// decoded data; whitespace is allowed between blocks.
+func checkBase64(value string) error {
+	r := base64.NewDecoder(base64.StdEncoding, strings.NewReader(stripSpace(value)))
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return fmt.Errorf("invalid FHIR base64Binary: %v", err)
+	}
+	return nil
+}
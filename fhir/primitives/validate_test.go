@@ -0,0 +1,103 @@
+package primitives
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		typ     string
+		value   string
+		wantErr bool
+	}{
+		{"id", "patient-1.a", false},
+		{"id", "has spaces!", true},
+		{"id", strings.Repeat("a", 64), false},
+		{"id", strings.Repeat("a", 65), true},
+		{"oid", "urn:oid:2.16.840.1.113883", false},
+		{"oid", "urn:oid:2.016", true},
+		{"oid", "2.16.840", true},
+		{"uuid", "urn:uuid:c757873d-ec9a-4326-a141-556f43239520", false},
+		{"uuid", "urn:uuid:C757873D-EC9A-4326-A141-556F43239520", true},
+		{"uuid", "c757873d-ec9a-4326-a141-556f43239520", true},
+		{"uri", "http://hl7.org/fhir", false},
+		{"uri", "urn:isbn:0451450523", false},
+		{"uri", "http://example.org/a b", true},
+		{"url", "https://health.zarishsphere.com", false},
+		{"canonical", "http://hl7.org/fhir/ValueSet/observation-status|4.0.1", false},
+		{"canonical", " http://hl7.org", true},
+		{"code", "final", false},
+		{"code", "two words", false},
+		{"code", "two  spaces", true},
+		{"code", " leading", true},
+		{"code", "trailing ", true},
+		{"string", "any text\nwith lines", false},
+		{"string", "   ", true},
+		{"string", "bell\a", true},
+		{"markdown", "**bold**", false},
+		{"base64Binary", "aGVsbG8=", false},
+		{"base64Binary", "aGVs bG8=", false},
+		{"base64Binary", "aGVsbG8", true},
+		{"base64Binary", "not base64!", true},
+		{"boolean", "true", false},
+		{"boolean", "yes", true},
+		{"integer", "-42", false},
+		{"integer", "+7", false},
+		{"integer", "007", true},
+		{"integer", "2147483648", true},
+		{"integer", "1.0", true},
+		{"integer64", "9223372036854775807", false},
+		{"integer64", "9223372036854775808", true},
+		{"positiveInt", "1", false},
+		{"positiveInt", "0", true},
+		{"positiveInt", "-1", true},
+		{"unsignedInt", "0", false},
+		{"unsignedInt", "2147483647", false},
+		{"unsignedInt", "2147483648", true},
+		{"decimal", "72.50", false},
+		{"decimal", "-0.5", false},
+		{"decimal", "1.5e3", false},
+		{"decimal", "01.5", true},
+		{"decimal", ".5", true},
+		{"date", "2024-02", false},
+		{"date", "24-02-01", true},
+		{"date", "1990-13-01", true},
+		{"date", "2024-02-32", true},
+		{"dateTime", "2024-02-01T10:00:00+06:00", false},
+		{"dateTime", "2024-02-01T10:00", true},
+		{"dateTime", "2024-02-01T10:00:00", true},
+		{"dateTime", "2024-02-01T25:00:00Z", true},
+		{"instant", "2024-02-01T10:00:00Z", false},
+		{"instant", "2024-02-01", true},
+		{"time", "10:30:00", false},
+		{"time", "10:30", true},
+		{"time", "24:00:00", true},
+		{"xhtml", "<div>anything</div>", false},
+		{"HumanName", "not a primitive", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ+" "+tt.value, func(t *testing.T) {
+			err := Validate(tt.typ, tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMessages(t *testing.T) {
+	err := Validate("id", "has spaces!")
+	assert.EqualError(t, err, `invalid FHIR id: "has spaces!" (expected 1 to 64 letters, digits, - and .)`)
+
+	err = Validate("positiveInt", "0")
+	assert.EqualError(t, err, `invalid FHIR positiveInt: "0" (expected a whole number above 0)`)
+
+	err = Validate("unsignedInt", "4294967295")
+	assert.EqualError(t, err, "invalid FHIR unsignedInt: 4294967295 is out of range (expected 0 to 2147483647)")
+}
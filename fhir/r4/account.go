@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type AccountGuarantor struct {
 
 // Account represents a FHIR Account.
 type Account struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Account number
@@ -85,3 +84,8 @@ type Account struct {
 	// Reference to a parent Account
 	PartOf *Reference `json:"partOf,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Account", the FHIR type of the resource.
+func (a *Account) ResourceType() string {
+	return ResourceTypeAccount
+}
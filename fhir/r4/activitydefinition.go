@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -51,7 +50,7 @@ type ActivityDefinitionDynamicValue struct {
 
 // ActivityDefinition represents a FHIR ActivityDefinition.
 type ActivityDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this activity definition, represented as a URI (globally unique)
@@ -207,3 +206,177 @@ type ActivityDefinition struct {
 	// Dynamic aspects of the definition
 	DynamicValue []ActivityDefinitionDynamicValue `json:"dynamicValue,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ActivityDefinition", the FHIR type of the resource.
+func (a *ActivityDefinition) ResourceType() string {
+	return ResourceTypeActivityDefinition
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (a *ActivityDefinition) Subject() any {
+	switch {
+	case a.SubjectCodeableConcept != nil:
+		return a.SubjectCodeableConcept
+	case a.SubjectReference != nil:
+		return a.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (a *ActivityDefinition) SubjectType() string {
+	switch {
+	case a.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case a.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (a *ActivityDefinition) ClearSubject() {
+	a.SubjectCodeableConcept = nil
+	a.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (a *ActivityDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	a.ClearSubject()
+	a.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (a *ActivityDefinition) SetSubjectReference(value Reference) {
+	a.ClearSubject()
+	a.SubjectReference = &value
+}
+
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (a *ActivityDefinition) Timing() any {
+	switch {
+	case a.TimingTiming != nil:
+		return a.TimingTiming
+	case a.TimingDateTime != nil:
+		return a.TimingDateTime
+	case a.TimingAge != nil:
+		return a.TimingAge
+	case a.TimingPeriod != nil:
+		return a.TimingPeriod
+	case a.TimingRange != nil:
+		return a.TimingRange
+	case a.TimingDuration != nil:
+		return a.TimingDuration
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "Timing", or "" if none is.
+func (a *ActivityDefinition) TimingType() string {
+	switch {
+	case a.TimingTiming != nil:
+		return "Timing"
+	case a.TimingDateTime != nil:
+		return "dateTime"
+	case a.TimingAge != nil:
+		return "Age"
+	case a.TimingPeriod != nil:
+		return "Period"
+	case a.TimingRange != nil:
+		return "Range"
+	case a.TimingDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (a *ActivityDefinition) ClearTiming() {
+	a.TimingTiming = nil
+	a.TimingDateTime = nil
+	a.TimingDateTimeExt = nil
+	a.TimingAge = nil
+	a.TimingPeriod = nil
+	a.TimingRange = nil
+	a.TimingDuration = nil
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingTiming(value Timing) {
+	a.ClearTiming()
+	a.TimingTiming = &value
+}
+
+// SetTimingDateTime sets the dateTime option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingDateTime(value primitives.DateTime) {
+	ext := a.TimingDateTimeExt
+	a.ClearTiming()
+	a.TimingDateTime, a.TimingDateTimeExt = &value, ext
+}
+
+// SetTimingAge sets the Age option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingAge(value Age) {
+	a.ClearTiming()
+	a.TimingAge = &value
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingPeriod(value Period) {
+	a.ClearTiming()
+	a.TimingPeriod = &value
+}
+
+// SetTimingRange sets the Range option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingRange(value Range) {
+	a.ClearTiming()
+	a.TimingRange = &value
+}
+
+// SetTimingDuration sets the Duration option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingDuration(value Duration) {
+	a.ClearTiming()
+	a.TimingDuration = &value
+}
+
+// Product returns the option of product[x] that is set, or nil if none is.
+func (a *ActivityDefinition) Product() any {
+	switch {
+	case a.ProductReference != nil:
+		return a.ProductReference
+	case a.ProductCodeableConcept != nil:
+		return a.ProductCodeableConcept
+	}
+	return nil
+}
+
+// ProductType returns the FHIR type of the option of product[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *ActivityDefinition) ProductType() string {
+	switch {
+	case a.ProductReference != nil:
+		return "Reference"
+	case a.ProductCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearProduct unsets every option of product[x], and their extensions.
+func (a *ActivityDefinition) ClearProduct() {
+	a.ProductReference = nil
+	a.ProductCodeableConcept = nil
+}
+
+// SetProductReference sets the Reference option of product[x], clearing the others.
+func (a *ActivityDefinition) SetProductReference(value Reference) {
+	a.ClearProduct()
+	a.ProductReference = &value
+}
+
+// SetProductCodeableConcept sets the CodeableConcept option of product[x], clearing the others.
+func (a *ActivityDefinition) SetProductCodeableConcept(value CodeableConcept) {
+	a.ClearProduct()
+	a.ProductCodeableConcept = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type AdverseEventSuspectEntity struct {
 
 // AdverseEvent represents a FHIR AdverseEvent.
 type AdverseEvent struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for the event
@@ -105,3 +104,8 @@ type AdverseEvent struct {
 	// AdverseEvent.study
 	Study []Reference `json:"study,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "AdverseEvent", the FHIR type of the resource.
+func (a *AdverseEvent) ResourceType() string {
+	return ResourceTypeAdverseEvent
+}
@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,11 +18,11 @@ type Age struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Numerical value (with implicit precision)
-	Value *float64 `json:"value,omitempty" fhir:"cardinality=0..1,summary"`
+	Value *primitives.Decimal `json:"value,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 	// < | <= | >= | > - how to understand the value
-	Comparator *string `json:"comparator,omitempty" fhir:"cardinality=0..1,summary"`
+	Comparator *QuantityComparator `json:"comparator,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/quantity-comparator|4.0.1,summary,type=code"`
 	// Extension for Comparator
 	ComparatorExt *primitives.PrimitiveExtension `json:"_comparator,omitempty" fhir:"cardinality=0..1"`
 	// Unit representation
@@ -30,11 +30,11 @@ type Age struct {
 	// Extension for Unit
 	UnitExt *primitives.PrimitiveExtension `json:"_unit,omitempty" fhir:"cardinality=0..1"`
 	// System that defines coded unit form
-	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
+	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	// Extension for System
 	SystemExt *primitives.PrimitiveExtension `json:"_system,omitempty" fhir:"cardinality=0..1"`
 	// Coded form of the unit
-	Code *string `json:"code,omitempty" fhir:"cardinality=0..1,summary"`
+	Code *string `json:"code,omitempty" fhir:"cardinality=0..1,summary,type=code"`
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 }
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -41,7 +40,7 @@ type AppointmentParticipant struct {
 
 // Appointment represents a FHIR Appointment.
 type Appointment struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this item
@@ -107,3 +106,8 @@ type Appointment struct {
 	// Potential date/time interval(s) requested to allocate the appointment within
 	RequestedPeriod []Period `json:"requestedPeriod,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Appointment", the FHIR type of the resource.
+func (a *Appointment) ResourceType() string {
+	return ResourceTypeAppointment
+}
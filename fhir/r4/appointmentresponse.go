@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeAppointmentResponse = "AppointmentResponse"
 
 // AppointmentResponse represents a FHIR AppointmentResponse.
 type AppointmentResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this item
@@ -43,3 +42,8 @@ type AppointmentResponse struct {
 	// Extension for Comment
 	CommentExt *primitives.PrimitiveExtension `json:"_comment,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "AppointmentResponse", the FHIR type of the resource.
+func (a *AppointmentResponse) ResourceType() string {
+	return ResourceTypeAppointmentResponse
+}
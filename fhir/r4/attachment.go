@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,27 +18,27 @@ type Attachment struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Mime type of the content, with charset etc.
-	ContentType *string `json:"contentType,omitempty" fhir:"cardinality=0..1,summary"`
+	ContentType *string `json:"contentType,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/mimetypes|4.0.1,summary,type=code"`
 	// Extension for ContentType
 	ContentTypeExt *primitives.PrimitiveExtension `json:"_contentType,omitempty" fhir:"cardinality=0..1"`
 	// Human language of the content (BCP-47)
-	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,summary"`
+	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,summary,type=code"`
 	// Extension for Language
 	LanguageExt *primitives.PrimitiveExtension `json:"_language,omitempty" fhir:"cardinality=0..1"`
 	// Data inline, base64ed
-	Data *string `json:"data,omitempty" fhir:"cardinality=0..1"`
+	Data *primitives.Base64Binary `json:"data,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Data
 	DataExt *primitives.PrimitiveExtension `json:"_data,omitempty" fhir:"cardinality=0..1"`
 	// Uri where the data can be found
-	URL *string `json:"url,omitempty" fhir:"cardinality=0..1,summary"`
+	URL *string `json:"url,omitempty" fhir:"cardinality=0..1,summary,type=url"`
 	// Extension for URL
 	URLExt *primitives.PrimitiveExtension `json:"_url,omitempty" fhir:"cardinality=0..1"`
 	// Number of bytes of content (if url provided)
-	Size *uint `json:"size,omitempty" fhir:"cardinality=0..1,summary"`
+	Size *uint `json:"size,omitempty" fhir:"cardinality=0..1,summary,type=unsignedInt"`
 	// Extension for Size
 	SizeExt *primitives.PrimitiveExtension `json:"_size,omitempty" fhir:"cardinality=0..1"`
 	// Hash of the data (sha-1, base64ed)
-	Hash *string `json:"hash,omitempty" fhir:"cardinality=0..1,summary"`
+	Hash *primitives.Base64Binary `json:"hash,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Hash
 	HashExt *primitives.PrimitiveExtension `json:"_hash,omitempty" fhir:"cardinality=0..1"`
 	// Label to display in place of the data
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -110,15 +109,60 @@ type AuditEventEntityDetail struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Property value - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Property value - base64Binary option
-	ValueBase64Binary string `json:"valueBase64Binary" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (a *AuditEventEntityDetail) Value() any {
+	switch {
+	case a.ValueString != nil:
+		return a.ValueString
+	case a.ValueBase64Binary != nil:
+		return a.ValueBase64Binary
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "string", or "" if none is.
+func (a *AuditEventEntityDetail) ValueType() string {
+	switch {
+	case a.ValueString != nil:
+		return "string"
+	case a.ValueBase64Binary != nil:
+		return "base64Binary"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (a *AuditEventEntityDetail) ClearValue() {
+	a.ValueString = nil
+	a.ValueStringExt = nil
+	a.ValueBase64Binary = nil
+	a.ValueBase64BinaryExt = nil
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueString(value string) {
+	ext := a.ValueStringExt
+	a.ClearValue()
+	a.ValueString, a.ValueStringExt = &value, ext
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := a.ValueBase64BinaryExt
+	a.ClearValue()
+	a.ValueBase64Binary, a.ValueBase64BinaryExt = &value, ext
+}
+
 // AuditEventEntity represents a FHIR BackboneElement for AuditEvent.entity.
 type AuditEventEntity struct {
 	// Unique id for inter-element referencing
@@ -148,7 +192,7 @@ type AuditEventEntity struct {
 	// Extension for Description
 	DescriptionExt *primitives.PrimitiveExtension `json:"_description,omitempty" fhir:"cardinality=0..1"`
 	// Query parameters
-	Query *string `json:"query,omitempty" fhir:"cardinality=0..1,summary"`
+	Query *primitives.Base64Binary `json:"query,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Query
 	QueryExt *primitives.PrimitiveExtension `json:"_query,omitempty" fhir:"cardinality=0..1"`
 	// Additional Information about the entity
@@ -157,7 +201,7 @@ type AuditEventEntity struct {
 
 // AuditEvent represents a FHIR AuditEvent.
 type AuditEvent struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Type/identifier of event
@@ -191,3 +235,8 @@ type AuditEvent struct {
 	// Data or objects used
 	Entity []AuditEventEntity `json:"entity,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "AuditEvent", the FHIR type of the resource.
+func (a *AuditEvent) ResourceType() string {
+	return ResourceTypeAuditEvent
+}
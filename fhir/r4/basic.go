@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeBasic = "Basic"
 
 // Basic represents a FHIR Basic.
 type Basic struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -31,3 +30,8 @@ type Basic struct {
 	// Who created
 	Author *Reference `json:"author,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "Basic", the FHIR type of the resource.
+func (b *Basic) ResourceType() string {
+	return ResourceTypeBasic
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,6 +34,49 @@ type BiologicallyDerivedProductCollection struct {
 	CollectedPeriod *Period `json:"collectedPeriod,omitempty" fhir:"cardinality=0..1,choice=collected"`
 }
 
+// Collected returns the option of collected[x] that is set, or nil if none is.
+func (b *BiologicallyDerivedProductCollection) Collected() any {
+	switch {
+	case b.CollectedDateTime != nil:
+		return b.CollectedDateTime
+	case b.CollectedPeriod != nil:
+		return b.CollectedPeriod
+	}
+	return nil
+}
+
+// CollectedType returns the FHIR type of the option of collected[x] that is
+// set, such as "dateTime", or "" if none is.
+func (b *BiologicallyDerivedProductCollection) CollectedType() string {
+	switch {
+	case b.CollectedDateTime != nil:
+		return "dateTime"
+	case b.CollectedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearCollected unsets every option of collected[x], and their extensions.
+func (b *BiologicallyDerivedProductCollection) ClearCollected() {
+	b.CollectedDateTime = nil
+	b.CollectedDateTimeExt = nil
+	b.CollectedPeriod = nil
+}
+
+// SetCollectedDateTime sets the dateTime option of collected[x], clearing the others.
+func (b *BiologicallyDerivedProductCollection) SetCollectedDateTime(value primitives.DateTime) {
+	ext := b.CollectedDateTimeExt
+	b.ClearCollected()
+	b.CollectedDateTime, b.CollectedDateTimeExt = &value, ext
+}
+
+// SetCollectedPeriod sets the Period option of collected[x], clearing the others.
+func (b *BiologicallyDerivedProductCollection) SetCollectedPeriod(value Period) {
+	b.ClearCollected()
+	b.CollectedPeriod = &value
+}
+
 // BiologicallyDerivedProductProcessing represents a FHIR BackboneElement for BiologicallyDerivedProduct.processing.
 type BiologicallyDerivedProductProcessing struct {
 	// Unique id for inter-element referencing
@@ -61,6 +103,49 @@ type BiologicallyDerivedProductProcessing struct {
 	TimePeriod *Period `json:"timePeriod,omitempty" fhir:"cardinality=0..1,choice=time"`
 }
 
+// Time returns the option of time[x] that is set, or nil if none is.
+func (b *BiologicallyDerivedProductProcessing) Time() any {
+	switch {
+	case b.TimeDateTime != nil:
+		return b.TimeDateTime
+	case b.TimePeriod != nil:
+		return b.TimePeriod
+	}
+	return nil
+}
+
+// TimeType returns the FHIR type of the option of time[x] that is
+// set, such as "dateTime", or "" if none is.
+func (b *BiologicallyDerivedProductProcessing) TimeType() string {
+	switch {
+	case b.TimeDateTime != nil:
+		return "dateTime"
+	case b.TimePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearTime unsets every option of time[x], and their extensions.
+func (b *BiologicallyDerivedProductProcessing) ClearTime() {
+	b.TimeDateTime = nil
+	b.TimeDateTimeExt = nil
+	b.TimePeriod = nil
+}
+
+// SetTimeDateTime sets the dateTime option of time[x], clearing the others.
+func (b *BiologicallyDerivedProductProcessing) SetTimeDateTime(value primitives.DateTime) {
+	ext := b.TimeDateTimeExt
+	b.ClearTime()
+	b.TimeDateTime, b.TimeDateTimeExt = &value, ext
+}
+
+// SetTimePeriod sets the Period option of time[x], clearing the others.
+func (b *BiologicallyDerivedProductProcessing) SetTimePeriod(value Period) {
+	b.ClearTime()
+	b.TimePeriod = &value
+}
+
 // BiologicallyDerivedProductManipulation represents a FHIR BackboneElement for BiologicallyDerivedProduct.manipulation.
 type BiologicallyDerivedProductManipulation struct {
 	// Unique id for inter-element referencing
@@ -83,6 +168,49 @@ type BiologicallyDerivedProductManipulation struct {
 	TimePeriod *Period `json:"timePeriod,omitempty" fhir:"cardinality=0..1,choice=time"`
 }
 
+// Time returns the option of time[x] that is set, or nil if none is.
+func (b *BiologicallyDerivedProductManipulation) Time() any {
+	switch {
+	case b.TimeDateTime != nil:
+		return b.TimeDateTime
+	case b.TimePeriod != nil:
+		return b.TimePeriod
+	}
+	return nil
+}
+
+// TimeType returns the FHIR type of the option of time[x] that is
+// set, such as "dateTime", or "" if none is.
+func (b *BiologicallyDerivedProductManipulation) TimeType() string {
+	switch {
+	case b.TimeDateTime != nil:
+		return "dateTime"
+	case b.TimePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearTime unsets every option of time[x], and their extensions.
+func (b *BiologicallyDerivedProductManipulation) ClearTime() {
+	b.TimeDateTime = nil
+	b.TimeDateTimeExt = nil
+	b.TimePeriod = nil
+}
+
+// SetTimeDateTime sets the dateTime option of time[x], clearing the others.
+func (b *BiologicallyDerivedProductManipulation) SetTimeDateTime(value primitives.DateTime) {
+	ext := b.TimeDateTimeExt
+	b.ClearTime()
+	b.TimeDateTime, b.TimeDateTimeExt = &value, ext
+}
+
+// SetTimePeriod sets the Period option of time[x], clearing the others.
+func (b *BiologicallyDerivedProductManipulation) SetTimePeriod(value Period) {
+	b.ClearTime()
+	b.TimePeriod = &value
+}
+
 // BiologicallyDerivedProductStorage represents a FHIR BackboneElement for BiologicallyDerivedProduct.storage.
 type BiologicallyDerivedProductStorage struct {
 	// Unique id for inter-element referencing
@@ -98,7 +226,7 @@ type BiologicallyDerivedProductStorage struct {
 	// Extension for Description
 	DescriptionExt *primitives.PrimitiveExtension `json:"_description,omitempty" fhir:"cardinality=0..1"`
 	// Storage temperature
-	Temperature *float64 `json:"temperature,omitempty" fhir:"cardinality=0..1"`
+	Temperature *primitives.Decimal `json:"temperature,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Temperature
 	TemperatureExt *primitives.PrimitiveExtension `json:"_temperature,omitempty" fhir:"cardinality=0..1"`
 	// farenheit | celsius | kelvin
@@ -111,7 +239,7 @@ type BiologicallyDerivedProductStorage struct {
 
 // BiologicallyDerivedProduct represents a FHIR BiologicallyDerivedProduct.
 type BiologicallyDerivedProduct struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External ids for this item
@@ -143,3 +271,8 @@ type BiologicallyDerivedProduct struct {
 	// Product storage
 	Storage []BiologicallyDerivedProductStorage `json:"storage,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "BiologicallyDerivedProduct", the FHIR type of the resource.
+func (b *BiologicallyDerivedProduct) ResourceType() string {
+	return ResourceTypeBiologicallyDerivedProduct
+}
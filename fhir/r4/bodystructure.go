@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeBodyStructure = "BodyStructure"
 
 // BodyStructure represents a FHIR BodyStructure.
 type BodyStructure struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Bodystructure identifier
@@ -39,3 +38,8 @@ type BodyStructure struct {
 	// Who this is about
 	Patient Reference `json:"patient" fhir:"cardinality=1..1,required,summary"`
 }
+
+// ResourceType returns "BodyStructure", the FHIR type of the resource.
+func (b *BodyStructure) ResourceType() string {
+	return ResourceTypeBodyStructure
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -377,7 +376,7 @@ type CapabilityStatementDocument struct {
 
 // CapabilityStatement represents a FHIR CapabilityStatement.
 type CapabilityStatement struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this capability statement, represented as a URI (globally unique)
@@ -469,3 +468,8 @@ type CapabilityStatement struct {
 	// Document definition
 	Document []CapabilityStatementDocument `json:"document,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "CapabilityStatement", the FHIR type of the resource.
+func (c *CapabilityStatement) ResourceType() string {
+	return ResourceTypeCapabilityStatement
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -79,6 +78,101 @@ type CarePlanActivityDetail struct {
 	DescriptionExt *primitives.PrimitiveExtension `json:"_description,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Scheduled returns the option of scheduled[x] that is set, or nil if none is.
+func (c *CarePlanActivityDetail) Scheduled() any {
+	switch {
+	case c.ScheduledTiming != nil:
+		return c.ScheduledTiming
+	case c.ScheduledPeriod != nil:
+		return c.ScheduledPeriod
+	case c.ScheduledString != nil:
+		return c.ScheduledString
+	}
+	return nil
+}
+
+// ScheduledType returns the FHIR type of the option of scheduled[x] that is
+// set, such as "Timing", or "" if none is.
+func (c *CarePlanActivityDetail) ScheduledType() string {
+	switch {
+	case c.ScheduledTiming != nil:
+		return "Timing"
+	case c.ScheduledPeriod != nil:
+		return "Period"
+	case c.ScheduledString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearScheduled unsets every option of scheduled[x], and their extensions.
+func (c *CarePlanActivityDetail) ClearScheduled() {
+	c.ScheduledTiming = nil
+	c.ScheduledPeriod = nil
+	c.ScheduledString = nil
+	c.ScheduledStringExt = nil
+}
+
+// SetScheduledTiming sets the Timing option of scheduled[x], clearing the others.
+func (c *CarePlanActivityDetail) SetScheduledTiming(value Timing) {
+	c.ClearScheduled()
+	c.ScheduledTiming = &value
+}
+
+// SetScheduledPeriod sets the Period option of scheduled[x], clearing the others.
+func (c *CarePlanActivityDetail) SetScheduledPeriod(value Period) {
+	c.ClearScheduled()
+	c.ScheduledPeriod = &value
+}
+
+// SetScheduledString sets the string option of scheduled[x], clearing the others.
+func (c *CarePlanActivityDetail) SetScheduledString(value string) {
+	ext := c.ScheduledStringExt
+	c.ClearScheduled()
+	c.ScheduledString, c.ScheduledStringExt = &value, ext
+}
+
+// Product returns the option of product[x] that is set, or nil if none is.
+func (c *CarePlanActivityDetail) Product() any {
+	switch {
+	case c.ProductCodeableConcept != nil:
+		return c.ProductCodeableConcept
+	case c.ProductReference != nil:
+		return c.ProductReference
+	}
+	return nil
+}
+
+// ProductType returns the FHIR type of the option of product[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *CarePlanActivityDetail) ProductType() string {
+	switch {
+	case c.ProductCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.ProductReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearProduct unsets every option of product[x], and their extensions.
+func (c *CarePlanActivityDetail) ClearProduct() {
+	c.ProductCodeableConcept = nil
+	c.ProductReference = nil
+}
+
+// SetProductCodeableConcept sets the CodeableConcept option of product[x], clearing the others.
+func (c *CarePlanActivityDetail) SetProductCodeableConcept(value CodeableConcept) {
+	c.ClearProduct()
+	c.ProductCodeableConcept = &value
+}
+
+// SetProductReference sets the Reference option of product[x], clearing the others.
+func (c *CarePlanActivityDetail) SetProductReference(value Reference) {
+	c.ClearProduct()
+	c.ProductReference = &value
+}
+
 // CarePlanActivity represents a FHIR BackboneElement for CarePlan.activity.
 type CarePlanActivity struct {
 	// Unique id for inter-element referencing
@@ -103,7 +197,7 @@ type CarePlanActivity struct {
 
 // CarePlan represents a FHIR CarePlan.
 type CarePlan struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this plan
@@ -167,3 +261,8 @@ type CarePlan struct {
 	// Comments about the plan
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CarePlan", the FHIR type of the resource.
+func (c *CarePlan) ResourceType() string {
+	return ResourceTypeCarePlan
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,7 +34,7 @@ type CareTeamParticipant struct {
 
 // CareTeam represents a FHIR CareTeam.
 type CareTeam struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this team
@@ -69,3 +68,8 @@ type CareTeam struct {
 	// Comments made about the CareTeam
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CareTeam", the FHIR type of the resource.
+func (c *CareTeam) ResourceType() string {
+	return ResourceTypeCareTeam
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type CatalogEntryRelatedEntry struct {
 
 // CatalogEntry represents a FHIR CatalogEntry.
 type CatalogEntry struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier of the catalog item
@@ -71,3 +70,8 @@ type CatalogEntry struct {
 	// An item that this catalog entry is related to
 	RelatedEntry []CatalogEntryRelatedEntry `json:"relatedEntry,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CatalogEntry", the FHIR type of the resource.
+func (c *CatalogEntry) ResourceType() string {
+	return ResourceTypeCatalogEntry
+}
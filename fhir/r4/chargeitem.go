@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type ChargeItemPerformer struct {
 
 // ChargeItem represents a FHIR ChargeItem.
 type ChargeItem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for item
@@ -77,7 +76,7 @@ type ChargeItem struct {
 	// Anatomical location, if relevant
 	Bodysite []CodeableConcept `json:"bodysite,omitempty" fhir:"cardinality=0..*,summary"`
 	// Factor overriding the associated rules
-	FactorOverride *float64 `json:"factorOverride,omitempty" fhir:"cardinality=0..1"`
+	FactorOverride *primitives.Decimal `json:"factorOverride,omitempty" fhir:"cardinality=0..1"`
 	// Extension for FactorOverride
 	FactorOverrideExt *primitives.PrimitiveExtension `json:"_factorOverride,omitempty" fhir:"cardinality=0..1"`
 	// Price overriding the associated rules
@@ -107,3 +106,103 @@ type ChargeItem struct {
 	// Further information supporting this charge
 	SupportingInformation []Reference `json:"supportingInformation,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ChargeItem", the FHIR type of the resource.
+func (c *ChargeItem) ResourceType() string {
+	return ResourceTypeChargeItem
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (c *ChargeItem) Occurrence() any {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return c.OccurrenceDateTime
+	case c.OccurrencePeriod != nil:
+		return c.OccurrencePeriod
+	case c.OccurrenceTiming != nil:
+		return c.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *ChargeItem) OccurrenceType() string {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return "dateTime"
+	case c.OccurrencePeriod != nil:
+		return "Period"
+	case c.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (c *ChargeItem) ClearOccurrence() {
+	c.OccurrenceDateTime = nil
+	c.OccurrenceDateTimeExt = nil
+	c.OccurrencePeriod = nil
+	c.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (c *ChargeItem) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := c.OccurrenceDateTimeExt
+	c.ClearOccurrence()
+	c.OccurrenceDateTime, c.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (c *ChargeItem) SetOccurrencePeriod(value Period) {
+	c.ClearOccurrence()
+	c.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (c *ChargeItem) SetOccurrenceTiming(value Timing) {
+	c.ClearOccurrence()
+	c.OccurrenceTiming = &value
+}
+
+// Product returns the option of product[x] that is set, or nil if none is.
+func (c *ChargeItem) Product() any {
+	switch {
+	case c.ProductReference != nil:
+		return c.ProductReference
+	case c.ProductCodeableConcept != nil:
+		return c.ProductCodeableConcept
+	}
+	return nil
+}
+
+// ProductType returns the FHIR type of the option of product[x] that is
+// set, such as "Reference", or "" if none is.
+func (c *ChargeItem) ProductType() string {
+	switch {
+	case c.ProductReference != nil:
+		return "Reference"
+	case c.ProductCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearProduct unsets every option of product[x], and their extensions.
+func (c *ChargeItem) ClearProduct() {
+	c.ProductReference = nil
+	c.ProductCodeableConcept = nil
+}
+
+// SetProductReference sets the Reference option of product[x], clearing the others.
+func (c *ChargeItem) SetProductReference(value Reference) {
+	c.ClearProduct()
+	c.ProductReference = &value
+}
+
+// SetProductCodeableConcept sets the CodeableConcept option of product[x], clearing the others.
+func (c *ChargeItem) SetProductCodeableConcept(value CodeableConcept) {
+	c.ClearProduct()
+	c.ProductCodeableConcept = &value
+}
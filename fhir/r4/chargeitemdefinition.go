@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -58,7 +57,7 @@ type ChargeItemDefinitionPropertyGroupPriceComponent struct {
 	// Code identifying the specific component
 	Code *CodeableConcept `json:"code,omitempty" fhir:"cardinality=0..1"`
 	// Factor used for calculating this component
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Monetary amount associated with this component
@@ -83,7 +82,7 @@ type ChargeItemDefinitionPropertyGroup struct {
 
 // ChargeItemDefinition represents a FHIR ChargeItemDefinition.
 type ChargeItemDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this charge item definition, represented as a URI (globally unique)
@@ -161,3 +160,8 @@ type ChargeItemDefinition struct {
 	// Group of properties which are applicable under the same conditions
 	PropertyGroup []ChargeItemDefinitionPropertyGroup `json:"propertyGroup,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ChargeItemDefinition", the FHIR type of the resource.
+func (c *ChargeItemDefinition) ResourceType() string {
+	return ResourceTypeChargeItemDefinition
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -115,6 +114,127 @@ type ClaimSupportingInfo struct {
 	Reason *CodeableConcept `json:"reason,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (c *ClaimSupportingInfo) Timing() any {
+	switch {
+	case c.TimingDate != nil:
+		return c.TimingDate
+	case c.TimingPeriod != nil:
+		return c.TimingPeriod
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "date", or "" if none is.
+func (c *ClaimSupportingInfo) TimingType() string {
+	switch {
+	case c.TimingDate != nil:
+		return "date"
+	case c.TimingPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (c *ClaimSupportingInfo) ClearTiming() {
+	c.TimingDate = nil
+	c.TimingDateExt = nil
+	c.TimingPeriod = nil
+}
+
+// SetTimingDate sets the date option of timing[x], clearing the others.
+func (c *ClaimSupportingInfo) SetTimingDate(value primitives.Date) {
+	ext := c.TimingDateExt
+	c.ClearTiming()
+	c.TimingDate, c.TimingDateExt = &value, ext
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (c *ClaimSupportingInfo) SetTimingPeriod(value Period) {
+	c.ClearTiming()
+	c.TimingPeriod = &value
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *ClaimSupportingInfo) Value() any {
+	switch {
+	case c.ValueBoolean != nil:
+		return c.ValueBoolean
+	case c.ValueString != nil:
+		return c.ValueString
+	case c.ValueQuantity != nil:
+		return c.ValueQuantity
+	case c.ValueAttachment != nil:
+		return c.ValueAttachment
+	case c.ValueReference != nil:
+		return c.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (c *ClaimSupportingInfo) ValueType() string {
+	switch {
+	case c.ValueBoolean != nil:
+		return "boolean"
+	case c.ValueString != nil:
+		return "string"
+	case c.ValueQuantity != nil:
+		return "Quantity"
+	case c.ValueAttachment != nil:
+		return "Attachment"
+	case c.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *ClaimSupportingInfo) ClearValue() {
+	c.ValueBoolean = nil
+	c.ValueBooleanExt = nil
+	c.ValueString = nil
+	c.ValueStringExt = nil
+	c.ValueQuantity = nil
+	c.ValueAttachment = nil
+	c.ValueReference = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (c *ClaimSupportingInfo) SetValueBoolean(value bool) {
+	ext := c.ValueBooleanExt
+	c.ClearValue()
+	c.ValueBoolean, c.ValueBooleanExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (c *ClaimSupportingInfo) SetValueString(value string) {
+	ext := c.ValueStringExt
+	c.ClearValue()
+	c.ValueString, c.ValueStringExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (c *ClaimSupportingInfo) SetValueQuantity(value Quantity) {
+	c.ClearValue()
+	c.ValueQuantity = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (c *ClaimSupportingInfo) SetValueAttachment(value Attachment) {
+	c.ClearValue()
+	c.ValueAttachment = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (c *ClaimSupportingInfo) SetValueReference(value Reference) {
+	c.ClearValue()
+	c.ValueReference = &value
+}
+
 // ClaimDiagnosis represents a FHIR BackboneElement for Claim.diagnosis.
 type ClaimDiagnosis struct {
 	// Unique id for inter-element referencing
@@ -130,9 +250,9 @@ type ClaimDiagnosis struct {
 	// Extension for Sequence
 	SequenceExt *primitives.PrimitiveExtension `json:"_sequence,omitempty" fhir:"cardinality=0..1"`
 	// Nature of illness or problem - CodeableConcept option
-	DiagnosisCodeableConcept CodeableConcept `json:"diagnosisCodeableConcept" fhir:"cardinality=1..1,required,choice=diagnosis"`
+	DiagnosisCodeableConcept *CodeableConcept `json:"diagnosisCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=diagnosis"`
 	// Nature of illness or problem - Reference option
-	DiagnosisReference Reference `json:"diagnosisReference" fhir:"cardinality=1..1,required,choice=diagnosis"`
+	DiagnosisReference *Reference `json:"diagnosisReference,omitempty" fhir:"cardinality=1..1,required,choice=diagnosis"`
 	// Timing or nature of the diagnosis
 	Type []CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..*"`
 	// Present on admission
@@ -141,6 +261,47 @@ type ClaimDiagnosis struct {
 	PackageCode *CodeableConcept `json:"packageCode,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Diagnosis returns the option of diagnosis[x] that is set, or nil if none is.
+func (c *ClaimDiagnosis) Diagnosis() any {
+	switch {
+	case c.DiagnosisCodeableConcept != nil:
+		return c.DiagnosisCodeableConcept
+	case c.DiagnosisReference != nil:
+		return c.DiagnosisReference
+	}
+	return nil
+}
+
+// DiagnosisType returns the FHIR type of the option of diagnosis[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ClaimDiagnosis) DiagnosisType() string {
+	switch {
+	case c.DiagnosisCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.DiagnosisReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearDiagnosis unsets every option of diagnosis[x], and their extensions.
+func (c *ClaimDiagnosis) ClearDiagnosis() {
+	c.DiagnosisCodeableConcept = nil
+	c.DiagnosisReference = nil
+}
+
+// SetDiagnosisCodeableConcept sets the CodeableConcept option of diagnosis[x], clearing the others.
+func (c *ClaimDiagnosis) SetDiagnosisCodeableConcept(value CodeableConcept) {
+	c.ClearDiagnosis()
+	c.DiagnosisCodeableConcept = &value
+}
+
+// SetDiagnosisReference sets the Reference option of diagnosis[x], clearing the others.
+func (c *ClaimDiagnosis) SetDiagnosisReference(value Reference) {
+	c.ClearDiagnosis()
+	c.DiagnosisReference = &value
+}
+
 // ClaimProcedure represents a FHIR BackboneElement for Claim.procedure.
 type ClaimProcedure struct {
 	// Unique id for inter-element referencing
@@ -162,13 +323,54 @@ type ClaimProcedure struct {
 	// Extension for Date
 	DateExt *primitives.PrimitiveExtension `json:"_date,omitempty" fhir:"cardinality=0..1"`
 	// Specific clinical procedure - CodeableConcept option
-	ProcedureCodeableConcept CodeableConcept `json:"procedureCodeableConcept" fhir:"cardinality=1..1,required,choice=procedure"`
+	ProcedureCodeableConcept *CodeableConcept `json:"procedureCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=procedure"`
 	// Specific clinical procedure - Reference option
-	ProcedureReference Reference `json:"procedureReference" fhir:"cardinality=1..1,required,choice=procedure"`
+	ProcedureReference *Reference `json:"procedureReference,omitempty" fhir:"cardinality=1..1,required,choice=procedure"`
 	// Unique device identifier
 	Udi []Reference `json:"udi,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Procedure returns the option of procedure[x] that is set, or nil if none is.
+func (c *ClaimProcedure) Procedure() any {
+	switch {
+	case c.ProcedureCodeableConcept != nil:
+		return c.ProcedureCodeableConcept
+	case c.ProcedureReference != nil:
+		return c.ProcedureReference
+	}
+	return nil
+}
+
+// ProcedureType returns the FHIR type of the option of procedure[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ClaimProcedure) ProcedureType() string {
+	switch {
+	case c.ProcedureCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.ProcedureReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearProcedure unsets every option of procedure[x], and their extensions.
+func (c *ClaimProcedure) ClearProcedure() {
+	c.ProcedureCodeableConcept = nil
+	c.ProcedureReference = nil
+}
+
+// SetProcedureCodeableConcept sets the CodeableConcept option of procedure[x], clearing the others.
+func (c *ClaimProcedure) SetProcedureCodeableConcept(value CodeableConcept) {
+	c.ClearProcedure()
+	c.ProcedureCodeableConcept = &value
+}
+
+// SetProcedureReference sets the Reference option of procedure[x], clearing the others.
+func (c *ClaimProcedure) SetProcedureReference(value Reference) {
+	c.ClearProcedure()
+	c.ProcedureReference = &value
+}
+
 // ClaimInsurance represents a FHIR BackboneElement for Claim.insurance.
 type ClaimInsurance struct {
 	// Unique id for inter-element referencing
@@ -225,6 +427,47 @@ type ClaimAccident struct {
 	LocationReference *Reference `json:"locationReference,omitempty" fhir:"cardinality=0..1,choice=location"`
 }
 
+// Location returns the option of location[x] that is set, or nil if none is.
+func (c *ClaimAccident) Location() any {
+	switch {
+	case c.LocationAddress != nil:
+		return c.LocationAddress
+	case c.LocationReference != nil:
+		return c.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "Address", or "" if none is.
+func (c *ClaimAccident) LocationType() string {
+	switch {
+	case c.LocationAddress != nil:
+		return "Address"
+	case c.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (c *ClaimAccident) ClearLocation() {
+	c.LocationAddress = nil
+	c.LocationReference = nil
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (c *ClaimAccident) SetLocationAddress(value Address) {
+	c.ClearLocation()
+	c.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (c *ClaimAccident) SetLocationReference(value Reference) {
+	c.ClearLocation()
+	c.LocationReference = &value
+}
+
 // ClaimItemDetailSubDetail represents a FHIR BackboneElement for Claim.item.detail.subDetail.
 type ClaimItemDetailSubDetail struct {
 	// Unique id for inter-element referencing
@@ -254,7 +497,7 @@ type ClaimItemDetailSubDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -292,7 +535,7 @@ type ClaimItemDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -360,7 +603,7 @@ type ClaimItem struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -377,9 +620,104 @@ type ClaimItem struct {
 	Detail []ClaimItemDetail `json:"detail,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (c *ClaimItem) Serviced() any {
+	switch {
+	case c.ServicedDate != nil:
+		return c.ServicedDate
+	case c.ServicedPeriod != nil:
+		return c.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (c *ClaimItem) ServicedType() string {
+	switch {
+	case c.ServicedDate != nil:
+		return "date"
+	case c.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (c *ClaimItem) ClearServiced() {
+	c.ServicedDate = nil
+	c.ServicedDateExt = nil
+	c.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (c *ClaimItem) SetServicedDate(value primitives.Date) {
+	ext := c.ServicedDateExt
+	c.ClearServiced()
+	c.ServicedDate, c.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (c *ClaimItem) SetServicedPeriod(value Period) {
+	c.ClearServiced()
+	c.ServicedPeriod = &value
+}
+
+// Location returns the option of location[x] that is set, or nil if none is.
+func (c *ClaimItem) Location() any {
+	switch {
+	case c.LocationCodeableConcept != nil:
+		return c.LocationCodeableConcept
+	case c.LocationAddress != nil:
+		return c.LocationAddress
+	case c.LocationReference != nil:
+		return c.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ClaimItem) LocationType() string {
+	switch {
+	case c.LocationCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.LocationAddress != nil:
+		return "Address"
+	case c.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (c *ClaimItem) ClearLocation() {
+	c.LocationCodeableConcept = nil
+	c.LocationAddress = nil
+	c.LocationReference = nil
+}
+
+// SetLocationCodeableConcept sets the CodeableConcept option of location[x], clearing the others.
+func (c *ClaimItem) SetLocationCodeableConcept(value CodeableConcept) {
+	c.ClearLocation()
+	c.LocationCodeableConcept = &value
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (c *ClaimItem) SetLocationAddress(value Address) {
+	c.ClearLocation()
+	c.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (c *ClaimItem) SetLocationReference(value Reference) {
+	c.ClearLocation()
+	c.LocationReference = &value
+}
+
 // Claim represents a FHIR Claim.
 type Claim struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for claim
@@ -443,3 +781,8 @@ type Claim struct {
 	// Total claim cost
 	Total *Money `json:"total,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Claim", the FHIR type of the resource.
+func (c *Claim) ResourceType() string {
+	return ResourceTypeClaim
+}
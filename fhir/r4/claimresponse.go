@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -30,7 +29,7 @@ type ClaimResponseItemAdjudication struct {
 	// Monetary amount
 	Amount *Money `json:"amount,omitempty" fhir:"cardinality=0..1"`
 	// Non-monetary value
-	Value *float64 `json:"value,omitempty" fhir:"cardinality=0..1"`
+	Value *primitives.Decimal `json:"value,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 }
@@ -144,7 +143,7 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -176,7 +175,7 @@ type ClaimResponseAddItemDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -238,7 +237,7 @@ type ClaimResponseAddItem struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -257,6 +256,101 @@ type ClaimResponseAddItem struct {
 	Detail []ClaimResponseAddItemDetail `json:"detail,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (c *ClaimResponseAddItem) Serviced() any {
+	switch {
+	case c.ServicedDate != nil:
+		return c.ServicedDate
+	case c.ServicedPeriod != nil:
+		return c.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (c *ClaimResponseAddItem) ServicedType() string {
+	switch {
+	case c.ServicedDate != nil:
+		return "date"
+	case c.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (c *ClaimResponseAddItem) ClearServiced() {
+	c.ServicedDate = nil
+	c.ServicedDateExt = nil
+	c.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (c *ClaimResponseAddItem) SetServicedDate(value primitives.Date) {
+	ext := c.ServicedDateExt
+	c.ClearServiced()
+	c.ServicedDate, c.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (c *ClaimResponseAddItem) SetServicedPeriod(value Period) {
+	c.ClearServiced()
+	c.ServicedPeriod = &value
+}
+
+// Location returns the option of location[x] that is set, or nil if none is.
+func (c *ClaimResponseAddItem) Location() any {
+	switch {
+	case c.LocationCodeableConcept != nil:
+		return c.LocationCodeableConcept
+	case c.LocationAddress != nil:
+		return c.LocationAddress
+	case c.LocationReference != nil:
+		return c.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ClaimResponseAddItem) LocationType() string {
+	switch {
+	case c.LocationCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.LocationAddress != nil:
+		return "Address"
+	case c.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (c *ClaimResponseAddItem) ClearLocation() {
+	c.LocationCodeableConcept = nil
+	c.LocationAddress = nil
+	c.LocationReference = nil
+}
+
+// SetLocationCodeableConcept sets the CodeableConcept option of location[x], clearing the others.
+func (c *ClaimResponseAddItem) SetLocationCodeableConcept(value CodeableConcept) {
+	c.ClearLocation()
+	c.LocationCodeableConcept = &value
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (c *ClaimResponseAddItem) SetLocationAddress(value Address) {
+	c.ClearLocation()
+	c.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (c *ClaimResponseAddItem) SetLocationReference(value Reference) {
+	c.ClearLocation()
+	c.LocationReference = &value
+}
+
 // ClaimResponseAdjudication represents a FHIR BackboneElement for ClaimResponse.adjudication.
 type ClaimResponseAdjudication struct {
 }
@@ -385,7 +479,7 @@ type ClaimResponseError struct {
 
 // ClaimResponse represents a FHIR ClaimResponse.
 type ClaimResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for a claim response
@@ -455,3 +549,8 @@ type ClaimResponse struct {
 	// Processing errors
 	Error []ClaimResponseError `json:"error,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ClaimResponse", the FHIR type of the resource.
+func (c *ClaimResponse) ResourceType() string {
+	return ResourceTypeClaimResponse
+}
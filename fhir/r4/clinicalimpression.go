@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -51,7 +50,7 @@ type ClinicalImpressionFinding struct {
 
 // ClinicalImpression represents a FHIR ClinicalImpression.
 type ClinicalImpression struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -109,3 +108,51 @@ type ClinicalImpression struct {
 	// Comments made about the ClinicalImpression
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ClinicalImpression", the FHIR type of the resource.
+func (c *ClinicalImpression) ResourceType() string {
+	return ResourceTypeClinicalImpression
+}
+
+// Effective returns the option of effective[x] that is set, or nil if none is.
+func (c *ClinicalImpression) Effective() any {
+	switch {
+	case c.EffectiveDateTime != nil:
+		return c.EffectiveDateTime
+	case c.EffectivePeriod != nil:
+		return c.EffectivePeriod
+	}
+	return nil
+}
+
+// EffectiveType returns the FHIR type of the option of effective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *ClinicalImpression) EffectiveType() string {
+	switch {
+	case c.EffectiveDateTime != nil:
+		return "dateTime"
+	case c.EffectivePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearEffective unsets every option of effective[x], and their extensions.
+func (c *ClinicalImpression) ClearEffective() {
+	c.EffectiveDateTime = nil
+	c.EffectiveDateTimeExt = nil
+	c.EffectivePeriod = nil
+}
+
+// SetEffectiveDateTime sets the dateTime option of effective[x], clearing the others.
+func (c *ClinicalImpression) SetEffectiveDateTime(value primitives.DateTime) {
+	ext := c.EffectiveDateTimeExt
+	c.ClearEffective()
+	c.EffectiveDateTime, c.EffectiveDateTimeExt = &value, ext
+}
+
+// SetEffectivePeriod sets the Period option of effective[x], clearing the others.
+func (c *ClinicalImpression) SetEffectivePeriod(value Period) {
+	c.ClearEffective()
+	c.EffectivePeriod = &value
+}
@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type Coding struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Identity of the terminology system
-	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
+	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	// Extension for System
 	SystemExt *primitives.PrimitiveExtension `json:"_system,omitempty" fhir:"cardinality=0..1"`
 	// Version of the system - if relevant
@@ -26,7 +26,7 @@ type Coding struct {
 	// Extension for Version
 	VersionExt *primitives.PrimitiveExtension `json:"_version,omitempty" fhir:"cardinality=0..1"`
 	// Symbol in syntax defined by the system
-	Code *string `json:"code,omitempty" fhir:"cardinality=0..1,summary"`
+	Code *string `json:"code,omitempty" fhir:"cardinality=0..1,summary,type=code"`
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Representation defined by the system
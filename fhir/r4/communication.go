@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,18 +23,72 @@ type CommunicationPayload struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Message part content - string option
-	ContentString string `json:"contentString" fhir:"cardinality=1..1,required,choice=content"`
+	ContentString *string `json:"contentString,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Extension for ContentString
 	ContentStringExt *primitives.PrimitiveExtension `json:"_contentString,omitempty" fhir:"cardinality=0..1"`
 	// Message part content - Attachment option
-	ContentAttachment Attachment `json:"contentAttachment" fhir:"cardinality=1..1,required,choice=content"`
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Message part content - Reference option
-	ContentReference Reference `json:"contentReference" fhir:"cardinality=1..1,required,choice=content"`
+	ContentReference *Reference `json:"contentReference,omitempty" fhir:"cardinality=1..1,required,choice=content"`
+}
+
+// Content returns the option of content[x] that is set, or nil if none is.
+func (c *CommunicationPayload) Content() any {
+	switch {
+	case c.ContentString != nil:
+		return c.ContentString
+	case c.ContentAttachment != nil:
+		return c.ContentAttachment
+	case c.ContentReference != nil:
+		return c.ContentReference
+	}
+	return nil
+}
+
+// ContentType returns the FHIR type of the option of content[x] that is
+// set, such as "string", or "" if none is.
+func (c *CommunicationPayload) ContentType() string {
+	switch {
+	case c.ContentString != nil:
+		return "string"
+	case c.ContentAttachment != nil:
+		return "Attachment"
+	case c.ContentReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearContent unsets every option of content[x], and their extensions.
+func (c *CommunicationPayload) ClearContent() {
+	c.ContentString = nil
+	c.ContentStringExt = nil
+	c.ContentAttachment = nil
+	c.ContentReference = nil
+}
+
+// SetContentString sets the string option of content[x], clearing the others.
+func (c *CommunicationPayload) SetContentString(value string) {
+	ext := c.ContentStringExt
+	c.ClearContent()
+	c.ContentString, c.ContentStringExt = &value, ext
+}
+
+// SetContentAttachment sets the Attachment option of content[x], clearing the others.
+func (c *CommunicationPayload) SetContentAttachment(value Attachment) {
+	c.ClearContent()
+	c.ContentAttachment = &value
+}
+
+// SetContentReference sets the Reference option of content[x], clearing the others.
+func (c *CommunicationPayload) SetContentReference(value Reference) {
+	c.ClearContent()
+	c.ContentReference = &value
 }
 
 // Communication represents a FHIR Communication.
 type Communication struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier
@@ -97,3 +150,8 @@ type Communication struct {
 	// Comments made about the communication
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Communication", the FHIR type of the resource.
+func (c *Communication) ResourceType() string {
+	return ResourceTypeCommunication
+}
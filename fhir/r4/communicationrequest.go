@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,18 +23,72 @@ type CommunicationRequestPayload struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Message part content - string option
-	ContentString string `json:"contentString" fhir:"cardinality=1..1,required,choice=content"`
+	ContentString *string `json:"contentString,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Extension for ContentString
 	ContentStringExt *primitives.PrimitiveExtension `json:"_contentString,omitempty" fhir:"cardinality=0..1"`
 	// Message part content - Attachment option
-	ContentAttachment Attachment `json:"contentAttachment" fhir:"cardinality=1..1,required,choice=content"`
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Message part content - Reference option
-	ContentReference Reference `json:"contentReference" fhir:"cardinality=1..1,required,choice=content"`
+	ContentReference *Reference `json:"contentReference,omitempty" fhir:"cardinality=1..1,required,choice=content"`
+}
+
+// Content returns the option of content[x] that is set, or nil if none is.
+func (c *CommunicationRequestPayload) Content() any {
+	switch {
+	case c.ContentString != nil:
+		return c.ContentString
+	case c.ContentAttachment != nil:
+		return c.ContentAttachment
+	case c.ContentReference != nil:
+		return c.ContentReference
+	}
+	return nil
+}
+
+// ContentType returns the FHIR type of the option of content[x] that is
+// set, such as "string", or "" if none is.
+func (c *CommunicationRequestPayload) ContentType() string {
+	switch {
+	case c.ContentString != nil:
+		return "string"
+	case c.ContentAttachment != nil:
+		return "Attachment"
+	case c.ContentReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearContent unsets every option of content[x], and their extensions.
+func (c *CommunicationRequestPayload) ClearContent() {
+	c.ContentString = nil
+	c.ContentStringExt = nil
+	c.ContentAttachment = nil
+	c.ContentReference = nil
+}
+
+// SetContentString sets the string option of content[x], clearing the others.
+func (c *CommunicationRequestPayload) SetContentString(value string) {
+	ext := c.ContentStringExt
+	c.ClearContent()
+	c.ContentString, c.ContentStringExt = &value, ext
+}
+
+// SetContentAttachment sets the Attachment option of content[x], clearing the others.
+func (c *CommunicationRequestPayload) SetContentAttachment(value Attachment) {
+	c.ClearContent()
+	c.ContentAttachment = &value
+}
+
+// SetContentReference sets the Reference option of content[x], clearing the others.
+func (c *CommunicationRequestPayload) SetContentReference(value Reference) {
+	c.ClearContent()
+	c.ContentReference = &value
 }
 
 // CommunicationRequest represents a FHIR CommunicationRequest.
 type CommunicationRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier
@@ -95,3 +148,51 @@ type CommunicationRequest struct {
 	// Comments made about communication request
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CommunicationRequest", the FHIR type of the resource.
+func (c *CommunicationRequest) ResourceType() string {
+	return ResourceTypeCommunicationRequest
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (c *CommunicationRequest) Occurrence() any {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return c.OccurrenceDateTime
+	case c.OccurrencePeriod != nil:
+		return c.OccurrencePeriod
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *CommunicationRequest) OccurrenceType() string {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return "dateTime"
+	case c.OccurrencePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (c *CommunicationRequest) ClearOccurrence() {
+	c.OccurrenceDateTime = nil
+	c.OccurrenceDateTimeExt = nil
+	c.OccurrencePeriod = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (c *CommunicationRequest) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := c.OccurrenceDateTimeExt
+	c.ClearOccurrence()
+	c.OccurrenceDateTime, c.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (c *CommunicationRequest) SetOccurrencePeriod(value Period) {
+	c.ClearOccurrence()
+	c.OccurrencePeriod = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -39,7 +38,7 @@ type CompartmentDefinitionResource struct {
 
 // CompartmentDefinition represents a FHIR CompartmentDefinition.
 type CompartmentDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this compartment definition, represented as a URI (globally unique)
@@ -93,3 +92,8 @@ type CompartmentDefinition struct {
 	// How a resource is related to the compartment
 	Resource []CompartmentDefinitionResource `json:"resource,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "CompartmentDefinition", the FHIR type of the resource.
+func (c *CompartmentDefinition) ResourceType() string {
+	return ResourceTypeCompartmentDefinition
+}
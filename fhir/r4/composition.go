@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -50,9 +49,50 @@ type CompositionRelatesTo struct {
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Target of the relationship - Identifier option
-	TargetIdentifier Identifier `json:"targetIdentifier" fhir:"cardinality=1..1,required,choice=target"`
+	TargetIdentifier *Identifier `json:"targetIdentifier,omitempty" fhir:"cardinality=1..1,required,choice=target"`
 	// Target of the relationship - Reference option
-	TargetReference Reference `json:"targetReference" fhir:"cardinality=1..1,required,choice=target"`
+	TargetReference *Reference `json:"targetReference,omitempty" fhir:"cardinality=1..1,required,choice=target"`
+}
+
+// Target returns the option of target[x] that is set, or nil if none is.
+func (c *CompositionRelatesTo) Target() any {
+	switch {
+	case c.TargetIdentifier != nil:
+		return c.TargetIdentifier
+	case c.TargetReference != nil:
+		return c.TargetReference
+	}
+	return nil
+}
+
+// TargetType returns the FHIR type of the option of target[x] that is
+// set, such as "Identifier", or "" if none is.
+func (c *CompositionRelatesTo) TargetType() string {
+	switch {
+	case c.TargetIdentifier != nil:
+		return "Identifier"
+	case c.TargetReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearTarget unsets every option of target[x], and their extensions.
+func (c *CompositionRelatesTo) ClearTarget() {
+	c.TargetIdentifier = nil
+	c.TargetReference = nil
+}
+
+// SetTargetIdentifier sets the Identifier option of target[x], clearing the others.
+func (c *CompositionRelatesTo) SetTargetIdentifier(value Identifier) {
+	c.ClearTarget()
+	c.TargetIdentifier = &value
+}
+
+// SetTargetReference sets the Reference option of target[x], clearing the others.
+func (c *CompositionRelatesTo) SetTargetReference(value Reference) {
+	c.ClearTarget()
+	c.TargetReference = &value
 }
 
 // CompositionEvent represents a FHIR BackboneElement for Composition.event.
@@ -115,7 +155,7 @@ type CompositionSection struct {
 
 // Composition represents a FHIR Composition.
 type Composition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Version-independent identifier for the Composition
@@ -157,3 +197,8 @@ type Composition struct {
 	// Composition is broken into sections
 	Section []CompositionSection `json:"section,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Composition", the FHIR type of the resource.
+func (c *Composition) ResourceType() string {
+	return ResourceTypeComposition
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -161,7 +160,7 @@ type ConceptMapGroup struct {
 
 // ConceptMap represents a FHIR ConceptMap.
 type ConceptMap struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this concept map, represented as a URI (globally unique)
@@ -217,21 +216,116 @@ type ConceptMap struct {
 	// Extension for Copyright
 	CopyrightExt *primitives.PrimitiveExtension `json:"_copyright,omitempty" fhir:"cardinality=0..1"`
 	// The source value set that contains the concepts that are being mapped - uri option
-	SourceURI *string `json:"sourceURI,omitempty" fhir:"cardinality=0..1,summary,choice=source"`
+	SourceURI *string `json:"sourceUri,omitempty" fhir:"cardinality=0..1,summary,choice=source"`
 	// Extension for SourceURI
-	SourceURIExt *primitives.PrimitiveExtension `json:"_sourceURI,omitempty" fhir:"cardinality=0..1"`
+	SourceURIExt *primitives.PrimitiveExtension `json:"_sourceUri,omitempty" fhir:"cardinality=0..1"`
 	// The source value set that contains the concepts that are being mapped - canonical option
-	SourceCanonical *string `json:"sourceCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=source"`
+	SourceCanonical *primitives.Canonical `json:"sourceCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=source"`
 	// Extension for SourceCanonical
 	SourceCanonicalExt *primitives.PrimitiveExtension `json:"_sourceCanonical,omitempty" fhir:"cardinality=0..1"`
 	// The target value set which provides context for the mappings - uri option
-	TargetURI *string `json:"targetURI,omitempty" fhir:"cardinality=0..1,summary,choice=target"`
+	TargetURI *string `json:"targetUri,omitempty" fhir:"cardinality=0..1,summary,choice=target"`
 	// Extension for TargetURI
-	TargetURIExt *primitives.PrimitiveExtension `json:"_targetURI,omitempty" fhir:"cardinality=0..1"`
+	TargetURIExt *primitives.PrimitiveExtension `json:"_targetUri,omitempty" fhir:"cardinality=0..1"`
 	// The target value set which provides context for the mappings - canonical option
-	TargetCanonical *string `json:"targetCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=target"`
+	TargetCanonical *primitives.Canonical `json:"targetCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=target"`
 	// Extension for TargetCanonical
 	TargetCanonicalExt *primitives.PrimitiveExtension `json:"_targetCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Same source and target systems
 	Group []ConceptMapGroup `json:"group,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ConceptMap", the FHIR type of the resource.
+func (c *ConceptMap) ResourceType() string {
+	return ResourceTypeConceptMap
+}
+
+// Source returns the option of source[x] that is set, or nil if none is.
+func (c *ConceptMap) Source() any {
+	switch {
+	case c.SourceURI != nil:
+		return c.SourceURI
+	case c.SourceCanonical != nil:
+		return c.SourceCanonical
+	}
+	return nil
+}
+
+// SourceType returns the FHIR type of the option of source[x] that is
+// set, such as "uri", or "" if none is.
+func (c *ConceptMap) SourceType() string {
+	switch {
+	case c.SourceURI != nil:
+		return "uri"
+	case c.SourceCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearSource unsets every option of source[x], and their extensions.
+func (c *ConceptMap) ClearSource() {
+	c.SourceURI = nil
+	c.SourceURIExt = nil
+	c.SourceCanonical = nil
+	c.SourceCanonicalExt = nil
+}
+
+// SetSourceURI sets the uri option of source[x], clearing the others.
+func (c *ConceptMap) SetSourceURI(value string) {
+	ext := c.SourceURIExt
+	c.ClearSource()
+	c.SourceURI, c.SourceURIExt = &value, ext
+}
+
+// SetSourceCanonical sets the canonical option of source[x], clearing the others.
+func (c *ConceptMap) SetSourceCanonical(value primitives.Canonical) {
+	ext := c.SourceCanonicalExt
+	c.ClearSource()
+	c.SourceCanonical, c.SourceCanonicalExt = &value, ext
+}
+
+// Target returns the option of target[x] that is set, or nil if none is.
+func (c *ConceptMap) Target() any {
+	switch {
+	case c.TargetURI != nil:
+		return c.TargetURI
+	case c.TargetCanonical != nil:
+		return c.TargetCanonical
+	}
+	return nil
+}
+
+// TargetType returns the FHIR type of the option of target[x] that is
+// set, such as "uri", or "" if none is.
+func (c *ConceptMap) TargetType() string {
+	switch {
+	case c.TargetURI != nil:
+		return "uri"
+	case c.TargetCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearTarget unsets every option of target[x], and their extensions.
+func (c *ConceptMap) ClearTarget() {
+	c.TargetURI = nil
+	c.TargetURIExt = nil
+	c.TargetCanonical = nil
+	c.TargetCanonicalExt = nil
+}
+
+// SetTargetURI sets the uri option of target[x], clearing the others.
+func (c *ConceptMap) SetTargetURI(value string) {
+	ext := c.TargetURIExt
+	c.ClearTarget()
+	c.TargetURI, c.TargetURIExt = &value, ext
+}
+
+// SetTargetCanonical sets the canonical option of target[x], clearing the others.
+func (c *ConceptMap) SetTargetCanonical(value primitives.Canonical) {
+	ext := c.TargetCanonicalExt
+	c.ClearTarget()
+	c.TargetCanonical, c.TargetCanonicalExt = &value, ext
+}
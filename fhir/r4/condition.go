@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,7 +48,7 @@ type ConditionEvidence struct {
 
 // Condition represents a FHIR Condition.
 type Condition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this condition
@@ -113,3 +112,164 @@ type Condition struct {
 	// Additional information about the Condition
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Condition", the FHIR type of the resource.
+func (c *Condition) ResourceType() string {
+	return ResourceTypeCondition
+}
+
+// Onset returns the option of onset[x] that is set, or nil if none is.
+func (c *Condition) Onset() any {
+	switch {
+	case c.OnsetDateTime != nil:
+		return c.OnsetDateTime
+	case c.OnsetAge != nil:
+		return c.OnsetAge
+	case c.OnsetPeriod != nil:
+		return c.OnsetPeriod
+	case c.OnsetRange != nil:
+		return c.OnsetRange
+	case c.OnsetString != nil:
+		return c.OnsetString
+	}
+	return nil
+}
+
+// OnsetType returns the FHIR type of the option of onset[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *Condition) OnsetType() string {
+	switch {
+	case c.OnsetDateTime != nil:
+		return "dateTime"
+	case c.OnsetAge != nil:
+		return "Age"
+	case c.OnsetPeriod != nil:
+		return "Period"
+	case c.OnsetRange != nil:
+		return "Range"
+	case c.OnsetString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearOnset unsets every option of onset[x], and their extensions.
+func (c *Condition) ClearOnset() {
+	c.OnsetDateTime = nil
+	c.OnsetDateTimeExt = nil
+	c.OnsetAge = nil
+	c.OnsetPeriod = nil
+	c.OnsetRange = nil
+	c.OnsetString = nil
+	c.OnsetStringExt = nil
+}
+
+// SetOnsetDateTime sets the dateTime option of onset[x], clearing the others.
+func (c *Condition) SetOnsetDateTime(value primitives.DateTime) {
+	ext := c.OnsetDateTimeExt
+	c.ClearOnset()
+	c.OnsetDateTime, c.OnsetDateTimeExt = &value, ext
+}
+
+// SetOnsetAge sets the Age option of onset[x], clearing the others.
+func (c *Condition) SetOnsetAge(value Age) {
+	c.ClearOnset()
+	c.OnsetAge = &value
+}
+
+// SetOnsetPeriod sets the Period option of onset[x], clearing the others.
+func (c *Condition) SetOnsetPeriod(value Period) {
+	c.ClearOnset()
+	c.OnsetPeriod = &value
+}
+
+// SetOnsetRange sets the Range option of onset[x], clearing the others.
+func (c *Condition) SetOnsetRange(value Range) {
+	c.ClearOnset()
+	c.OnsetRange = &value
+}
+
+// SetOnsetString sets the string option of onset[x], clearing the others.
+func (c *Condition) SetOnsetString(value string) {
+	ext := c.OnsetStringExt
+	c.ClearOnset()
+	c.OnsetString, c.OnsetStringExt = &value, ext
+}
+
+// Abatement returns the option of abatement[x] that is set, or nil if none is.
+func (c *Condition) Abatement() any {
+	switch {
+	case c.AbatementDateTime != nil:
+		return c.AbatementDateTime
+	case c.AbatementAge != nil:
+		return c.AbatementAge
+	case c.AbatementPeriod != nil:
+		return c.AbatementPeriod
+	case c.AbatementRange != nil:
+		return c.AbatementRange
+	case c.AbatementString != nil:
+		return c.AbatementString
+	}
+	return nil
+}
+
+// AbatementType returns the FHIR type of the option of abatement[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *Condition) AbatementType() string {
+	switch {
+	case c.AbatementDateTime != nil:
+		return "dateTime"
+	case c.AbatementAge != nil:
+		return "Age"
+	case c.AbatementPeriod != nil:
+		return "Period"
+	case c.AbatementRange != nil:
+		return "Range"
+	case c.AbatementString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAbatement unsets every option of abatement[x], and their extensions.
+func (c *Condition) ClearAbatement() {
+	c.AbatementDateTime = nil
+	c.AbatementDateTimeExt = nil
+	c.AbatementAge = nil
+	c.AbatementPeriod = nil
+	c.AbatementRange = nil
+	c.AbatementString = nil
+	c.AbatementStringExt = nil
+}
+
+// SetAbatementDateTime sets the dateTime option of abatement[x], clearing the others.
+func (c *Condition) SetAbatementDateTime(value primitives.DateTime) {
+	ext := c.AbatementDateTimeExt
+	c.ClearAbatement()
+	c.AbatementDateTime, c.AbatementDateTimeExt = &value, ext
+}
+
+// SetAbatementAge sets the Age option of abatement[x], clearing the others.
+func (c *Condition) SetAbatementAge(value Age) {
+	c.ClearAbatement()
+	c.AbatementAge = &value
+}
+
+// SetAbatementPeriod sets the Period option of abatement[x], clearing the others.
+func (c *Condition) SetAbatementPeriod(value Period) {
+	c.ClearAbatement()
+	c.AbatementPeriod = &value
+}
+
+// SetAbatementRange sets the Range option of abatement[x], clearing the others.
+func (c *Condition) SetAbatementRange(value Range) {
+	c.ClearAbatement()
+	c.AbatementRange = &value
+}
+
+// SetAbatementString sets the string option of abatement[x], clearing the others.
+func (c *Condition) SetAbatementString(value string) {
+	ext := c.AbatementStringExt
+	c.ClearAbatement()
+	c.AbatementString, c.AbatementStringExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -131,7 +130,7 @@ type ConsentProvision struct {
 
 // Consent represents a FHIR Consent.
 type Consent struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier for this record (external references)
@@ -167,3 +166,49 @@ type Consent struct {
 	// Constraints to the base Consent.policyRule
 	Provision *ConsentProvision `json:"provision,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "Consent", the FHIR type of the resource.
+func (c *Consent) ResourceType() string {
+	return ResourceTypeConsent
+}
+
+// Source returns the option of source[x] that is set, or nil if none is.
+func (c *Consent) Source() any {
+	switch {
+	case c.SourceAttachment != nil:
+		return c.SourceAttachment
+	case c.SourceReference != nil:
+		return c.SourceReference
+	}
+	return nil
+}
+
+// SourceType returns the FHIR type of the option of source[x] that is
+// set, such as "Attachment", or "" if none is.
+func (c *Consent) SourceType() string {
+	switch {
+	case c.SourceAttachment != nil:
+		return "Attachment"
+	case c.SourceReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSource unsets every option of source[x], and their extensions.
+func (c *Consent) ClearSource() {
+	c.SourceAttachment = nil
+	c.SourceReference = nil
+}
+
+// SetSourceAttachment sets the Attachment option of source[x], clearing the others.
+func (c *Consent) SetSourceAttachment(value Attachment) {
+	c.ClearSource()
+	c.SourceAttachment = &value
+}
+
+// SetSourceReference sets the Reference option of source[x], clearing the others.
+func (c *Consent) SetSourceReference(value Reference) {
+	c.ClearSource()
+	c.SourceReference = &value
+}
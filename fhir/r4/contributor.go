@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type Contributor struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// author | editor | reviewer | endorser
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type ContributorType `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/contributor-type|4.0.1,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Who contributed the content
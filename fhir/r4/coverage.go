@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -64,16 +63,57 @@ type CoverageCostToBeneficiary struct {
 	// Cost category
 	Type *CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..1,summary"`
 	// The amount or percentage due from the beneficiary - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// The amount or percentage due from the beneficiary - Money option
-	ValueMoney Money `json:"valueMoney" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueMoney *Money `json:"valueMoney,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Exceptions for patient payments
 	Exception []CoverageCostToBeneficiaryException `json:"exception,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *CoverageCostToBeneficiary) Value() any {
+	switch {
+	case c.ValueQuantity != nil:
+		return c.ValueQuantity
+	case c.ValueMoney != nil:
+		return c.ValueMoney
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (c *CoverageCostToBeneficiary) ValueType() string {
+	switch {
+	case c.ValueQuantity != nil:
+		return "Quantity"
+	case c.ValueMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *CoverageCostToBeneficiary) ClearValue() {
+	c.ValueQuantity = nil
+	c.ValueMoney = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (c *CoverageCostToBeneficiary) SetValueQuantity(value Quantity) {
+	c.ClearValue()
+	c.ValueQuantity = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (c *CoverageCostToBeneficiary) SetValueMoney(value Money) {
+	c.ClearValue()
+	c.ValueMoney = &value
+}
+
 // Coverage represents a FHIR Coverage.
 type Coverage struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for the coverage
@@ -123,3 +163,8 @@ type Coverage struct {
 	// Contract details
 	Contract []Reference `json:"contract,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Coverage", the FHIR type of the resource.
+func (c *Coverage) ResourceType() string {
+	return ResourceTypeCoverage
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -47,6 +46,118 @@ type CoverageEligibilityResponseInsuranceItemBenefit struct {
 	UsedMoney *Money `json:"usedMoney,omitempty" fhir:"cardinality=0..1,choice=used"`
 }
 
+// Allowed returns the option of allowed[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) Allowed() any {
+	switch {
+	case c.AllowedUnsignedInt != nil:
+		return c.AllowedUnsignedInt
+	case c.AllowedString != nil:
+		return c.AllowedString
+	case c.AllowedMoney != nil:
+		return c.AllowedMoney
+	}
+	return nil
+}
+
+// AllowedType returns the FHIR type of the option of allowed[x] that is
+// set, such as "unsignedInt", or "" if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) AllowedType() string {
+	switch {
+	case c.AllowedUnsignedInt != nil:
+		return "unsignedInt"
+	case c.AllowedString != nil:
+		return "string"
+	case c.AllowedMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearAllowed unsets every option of allowed[x], and their extensions.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) ClearAllowed() {
+	c.AllowedUnsignedInt = nil
+	c.AllowedUnsignedIntExt = nil
+	c.AllowedString = nil
+	c.AllowedStringExt = nil
+	c.AllowedMoney = nil
+}
+
+// SetAllowedUnsignedInt sets the unsignedInt option of allowed[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedUnsignedInt(value uint) {
+	ext := c.AllowedUnsignedIntExt
+	c.ClearAllowed()
+	c.AllowedUnsignedInt, c.AllowedUnsignedIntExt = &value, ext
+}
+
+// SetAllowedString sets the string option of allowed[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedString(value string) {
+	ext := c.AllowedStringExt
+	c.ClearAllowed()
+	c.AllowedString, c.AllowedStringExt = &value, ext
+}
+
+// SetAllowedMoney sets the Money option of allowed[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedMoney(value Money) {
+	c.ClearAllowed()
+	c.AllowedMoney = &value
+}
+
+// Used returns the option of used[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) Used() any {
+	switch {
+	case c.UsedUnsignedInt != nil:
+		return c.UsedUnsignedInt
+	case c.UsedString != nil:
+		return c.UsedString
+	case c.UsedMoney != nil:
+		return c.UsedMoney
+	}
+	return nil
+}
+
+// UsedType returns the FHIR type of the option of used[x] that is
+// set, such as "unsignedInt", or "" if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) UsedType() string {
+	switch {
+	case c.UsedUnsignedInt != nil:
+		return "unsignedInt"
+	case c.UsedString != nil:
+		return "string"
+	case c.UsedMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearUsed unsets every option of used[x], and their extensions.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) ClearUsed() {
+	c.UsedUnsignedInt = nil
+	c.UsedUnsignedIntExt = nil
+	c.UsedString = nil
+	c.UsedStringExt = nil
+	c.UsedMoney = nil
+}
+
+// SetUsedUnsignedInt sets the unsignedInt option of used[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedUnsignedInt(value uint) {
+	ext := c.UsedUnsignedIntExt
+	c.ClearUsed()
+	c.UsedUnsignedInt, c.UsedUnsignedIntExt = &value, ext
+}
+
+// SetUsedString sets the string option of used[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedString(value string) {
+	ext := c.UsedStringExt
+	c.ClearUsed()
+	c.UsedString, c.UsedStringExt = &value, ext
+}
+
+// SetUsedMoney sets the Money option of used[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedMoney(value Money) {
+	c.ClearUsed()
+	c.UsedMoney = &value
+}
+
 // CoverageEligibilityResponseInsuranceItem represents a FHIR BackboneElement for CoverageEligibilityResponse.insurance.item.
 type CoverageEligibilityResponseInsuranceItem struct {
 	// Unique id for inter-element referencing
@@ -135,7 +246,7 @@ type CoverageEligibilityResponseError struct {
 
 // CoverageEligibilityResponse represents a FHIR CoverageEligibilityResponse.
 type CoverageEligibilityResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for coverage eligiblity request
@@ -185,3 +296,51 @@ type CoverageEligibilityResponse struct {
 	// Processing errors
 	Error []CoverageEligibilityResponseError `json:"error,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CoverageEligibilityResponse", the FHIR type of the resource.
+func (c *CoverageEligibilityResponse) ResourceType() string {
+	return ResourceTypeCoverageEligibilityResponse
+}
+
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponse) Serviced() any {
+	switch {
+	case c.ServicedDate != nil:
+		return c.ServicedDate
+	case c.ServicedPeriod != nil:
+		return c.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (c *CoverageEligibilityResponse) ServicedType() string {
+	switch {
+	case c.ServicedDate != nil:
+		return "date"
+	case c.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (c *CoverageEligibilityResponse) ClearServiced() {
+	c.ServicedDate = nil
+	c.ServicedDateExt = nil
+	c.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (c *CoverageEligibilityResponse) SetServicedDate(value primitives.Date) {
+	ext := c.ServicedDateExt
+	c.ClearServiced()
+	c.ServicedDate, c.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (c *CoverageEligibilityResponse) SetServicedPeriod(value Period) {
+	c.ClearServiced()
+	c.ServicedPeriod = &value
+}
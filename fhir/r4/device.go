@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -36,7 +35,7 @@ type DeviceUdiCarrier struct {
 	// Extension for Jurisdiction
 	JurisdictionExt *primitives.PrimitiveExtension `json:"_jurisdiction,omitempty" fhir:"cardinality=0..1"`
 	// UDI Machine Readable Barcode String
-	CarrierAIDC *string `json:"carrierAIDC,omitempty" fhir:"cardinality=0..1,summary"`
+	CarrierAIDC *primitives.Base64Binary `json:"carrierAIDC,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for CarrierAIDC
 	CarrierAIDCExt *primitives.PrimitiveExtension `json:"_carrierAIDC,omitempty" fhir:"cardinality=0..1"`
 	// UDI Human Readable Barcode String
@@ -127,7 +126,7 @@ type DeviceProperty struct {
 
 // Device represents a FHIR Device.
 type Device struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Instance identifier
@@ -203,3 +202,8 @@ type Device struct {
 	// The parent device
 	Parent *Reference `json:"parent,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Device", the FHIR type of the resource.
+func (d *Device) ResourceType() string {
+	return ResourceTypeDevice
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -135,7 +134,7 @@ type DeviceDefinitionMaterial struct {
 
 // DeviceDefinition represents a FHIR DeviceDefinition.
 type DeviceDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Instance identifier
@@ -195,3 +194,51 @@ type DeviceDefinition struct {
 	// A substance used to create the material(s) of which the device is made
 	Material []DeviceDefinitionMaterial `json:"material,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DeviceDefinition", the FHIR type of the resource.
+func (d *DeviceDefinition) ResourceType() string {
+	return ResourceTypeDeviceDefinition
+}
+
+// Manufacturer returns the option of manufacturer[x] that is set, or nil if none is.
+func (d *DeviceDefinition) Manufacturer() any {
+	switch {
+	case d.ManufacturerString != nil:
+		return d.ManufacturerString
+	case d.ManufacturerReference != nil:
+		return d.ManufacturerReference
+	}
+	return nil
+}
+
+// ManufacturerType returns the FHIR type of the option of manufacturer[x] that is
+// set, such as "string", or "" if none is.
+func (d *DeviceDefinition) ManufacturerType() string {
+	switch {
+	case d.ManufacturerString != nil:
+		return "string"
+	case d.ManufacturerReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearManufacturer unsets every option of manufacturer[x], and their extensions.
+func (d *DeviceDefinition) ClearManufacturer() {
+	d.ManufacturerString = nil
+	d.ManufacturerStringExt = nil
+	d.ManufacturerReference = nil
+}
+
+// SetManufacturerString sets the string option of manufacturer[x], clearing the others.
+func (d *DeviceDefinition) SetManufacturerString(value string) {
+	ext := d.ManufacturerStringExt
+	d.ClearManufacturer()
+	d.ManufacturerString, d.ManufacturerStringExt = &value, ext
+}
+
+// SetManufacturerReference sets the Reference option of manufacturer[x], clearing the others.
+func (d *DeviceDefinition) SetManufacturerReference(value Reference) {
+	d.ClearManufacturer()
+	d.ManufacturerReference = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -39,7 +38,7 @@ type DeviceMetricCalibration struct {
 
 // DeviceMetric represents a FHIR DeviceMetric.
 type DeviceMetric struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Instance identifier
@@ -69,3 +68,8 @@ type DeviceMetric struct {
 	// Describes the calibrations that have been performed or that are required to be performed
 	Calibration []DeviceMetricCalibration `json:"calibration,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "DeviceMetric", the FHIR type of the resource.
+func (d *DeviceMetric) ResourceType() string {
+	return ResourceTypeDeviceMetric
+}
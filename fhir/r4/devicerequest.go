@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -37,9 +36,74 @@ type DeviceRequestParameter struct {
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DeviceRequestParameter) Value() any {
+	switch {
+	case d.ValueCodeableConcept != nil:
+		return d.ValueCodeableConcept
+	case d.ValueQuantity != nil:
+		return d.ValueQuantity
+	case d.ValueRange != nil:
+		return d.ValueRange
+	case d.ValueBoolean != nil:
+		return d.ValueBoolean
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (d *DeviceRequestParameter) ValueType() string {
+	switch {
+	case d.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case d.ValueQuantity != nil:
+		return "Quantity"
+	case d.ValueRange != nil:
+		return "Range"
+	case d.ValueBoolean != nil:
+		return "boolean"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DeviceRequestParameter) ClearValue() {
+	d.ValueCodeableConcept = nil
+	d.ValueQuantity = nil
+	d.ValueRange = nil
+	d.ValueBoolean = nil
+	d.ValueBooleanExt = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueCodeableConcept(value CodeableConcept) {
+	d.ClearValue()
+	d.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueQuantity(value Quantity) {
+	d.ClearValue()
+	d.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueRange(value Range) {
+	d.ClearValue()
+	d.ValueRange = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueBoolean(value bool) {
+	ext := d.ValueBooleanExt
+	d.ClearValue()
+	d.ValueBoolean, d.ValueBooleanExt = &value, ext
+}
+
 // DeviceRequest represents a FHIR DeviceRequest.
 type DeviceRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Request identifier
@@ -71,9 +135,9 @@ type DeviceRequest struct {
 	// Extension for Priority
 	PriorityExt *primitives.PrimitiveExtension `json:"_priority,omitempty" fhir:"cardinality=0..1"`
 	// Device requested - Reference option
-	CodeReference Reference `json:"codeReference" fhir:"cardinality=1..1,required,summary,choice=code"`
+	CodeReference *Reference `json:"codeReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=code"`
 	// Device requested - CodeableConcept option
-	CodeCodeableConcept CodeableConcept `json:"codeCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=code"`
+	CodeCodeableConcept *CodeableConcept `json:"codeCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=code"`
 	// Device details
 	Parameter []DeviceRequestParameter `json:"parameter,omitempty" fhir:"cardinality=0..*"`
 	// Focus of request
@@ -111,3 +175,103 @@ type DeviceRequest struct {
 	// Request provenance
 	RelevantHistory []Reference `json:"relevantHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DeviceRequest", the FHIR type of the resource.
+func (d *DeviceRequest) ResourceType() string {
+	return ResourceTypeDeviceRequest
+}
+
+// Code returns the option of code[x] that is set, or nil if none is.
+func (d *DeviceRequest) Code() any {
+	switch {
+	case d.CodeReference != nil:
+		return d.CodeReference
+	case d.CodeCodeableConcept != nil:
+		return d.CodeCodeableConcept
+	}
+	return nil
+}
+
+// CodeType returns the FHIR type of the option of code[x] that is
+// set, such as "Reference", or "" if none is.
+func (d *DeviceRequest) CodeType() string {
+	switch {
+	case d.CodeReference != nil:
+		return "Reference"
+	case d.CodeCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearCode unsets every option of code[x], and their extensions.
+func (d *DeviceRequest) ClearCode() {
+	d.CodeReference = nil
+	d.CodeCodeableConcept = nil
+}
+
+// SetCodeReference sets the Reference option of code[x], clearing the others.
+func (d *DeviceRequest) SetCodeReference(value Reference) {
+	d.ClearCode()
+	d.CodeReference = &value
+}
+
+// SetCodeCodeableConcept sets the CodeableConcept option of code[x], clearing the others.
+func (d *DeviceRequest) SetCodeCodeableConcept(value CodeableConcept) {
+	d.ClearCode()
+	d.CodeCodeableConcept = &value
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (d *DeviceRequest) Occurrence() any {
+	switch {
+	case d.OccurrenceDateTime != nil:
+		return d.OccurrenceDateTime
+	case d.OccurrencePeriod != nil:
+		return d.OccurrencePeriod
+	case d.OccurrenceTiming != nil:
+		return d.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (d *DeviceRequest) OccurrenceType() string {
+	switch {
+	case d.OccurrenceDateTime != nil:
+		return "dateTime"
+	case d.OccurrencePeriod != nil:
+		return "Period"
+	case d.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (d *DeviceRequest) ClearOccurrence() {
+	d.OccurrenceDateTime = nil
+	d.OccurrenceDateTimeExt = nil
+	d.OccurrencePeriod = nil
+	d.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (d *DeviceRequest) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := d.OccurrenceDateTimeExt
+	d.ClearOccurrence()
+	d.OccurrenceDateTime, d.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (d *DeviceRequest) SetOccurrencePeriod(value Period) {
+	d.ClearOccurrence()
+	d.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (d *DeviceRequest) SetOccurrenceTiming(value Timing) {
+	d.ClearOccurrence()
+	d.OccurrenceTiming = &value
+}
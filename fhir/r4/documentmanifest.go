@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type DocumentManifestRelated struct {
 
 // DocumentManifest represents a FHIR DocumentManifest.
 type DocumentManifest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique Identifier for the set of documents
@@ -67,3 +66,8 @@ type DocumentManifest struct {
 	// Related things
 	Related []DocumentManifestRelated `json:"related,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DocumentManifest", the FHIR type of the resource.
+func (d *DocumentManifest) ResourceType() string {
+	return ResourceTypeDocumentManifest
+}
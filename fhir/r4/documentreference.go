@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -75,7 +74,7 @@ type DocumentReferenceContext struct {
 
 // DocumentReference represents a FHIR DocumentReference.
 type DocumentReference struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Master Version Specific Identifier
@@ -119,3 +118,8 @@ type DocumentReference struct {
 	// Clinical context of document
 	Context *DocumentReferenceContext `json:"context,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "DocumentReference", the FHIR type of the resource.
+func (d *DocumentReference) ResourceType() string {
+	return ResourceTypeDocumentReference
+}
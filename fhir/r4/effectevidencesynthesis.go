@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -74,15 +73,15 @@ type EffectEvidenceSynthesisEffectEstimatePrecisionEstimate struct {
 	// Type of precision estimate
 	Type *CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..1"`
 	// Level of confidence interval
-	Level *float64 `json:"level,omitempty" fhir:"cardinality=0..1"`
+	Level *primitives.Decimal `json:"level,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Level
 	LevelExt *primitives.PrimitiveExtension `json:"_level,omitempty" fhir:"cardinality=0..1"`
 	// Lower bound
-	From *float64 `json:"from,omitempty" fhir:"cardinality=0..1"`
+	From *primitives.Decimal `json:"from,omitempty" fhir:"cardinality=0..1"`
 	// Extension for From
 	FromExt *primitives.PrimitiveExtension `json:"_from,omitempty" fhir:"cardinality=0..1"`
 	// Upper bound
-	To *float64 `json:"to,omitempty" fhir:"cardinality=0..1"`
+	To *primitives.Decimal `json:"to,omitempty" fhir:"cardinality=0..1"`
 	// Extension for To
 	ToExt *primitives.PrimitiveExtension `json:"_to,omitempty" fhir:"cardinality=0..1"`
 }
@@ -106,7 +105,7 @@ type EffectEvidenceSynthesisEffectEstimate struct {
 	// Variant exposure states
 	VariantState *CodeableConcept `json:"variantState,omitempty" fhir:"cardinality=0..1"`
 	// Point estimate
-	Value *float64 `json:"value,omitempty" fhir:"cardinality=0..1"`
+	Value *primitives.Decimal `json:"value,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 	// What unit is the outcome described in?
@@ -153,7 +152,7 @@ type EffectEvidenceSynthesisCertainty struct {
 
 // EffectEvidenceSynthesis represents a FHIR EffectEvidenceSynthesis.
 type EffectEvidenceSynthesis struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this effect evidence synthesis, represented as a URI (globally unique)
@@ -245,3 +244,8 @@ type EffectEvidenceSynthesis struct {
 	// How certain is the effect
 	Certainty []EffectEvidenceSynthesisCertainty `json:"certainty,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "EffectEvidenceSynthesis", the FHIR type of the resource.
+func (e *EffectEvidenceSynthesis) ResourceType() string {
+	return ResourceTypeEffectEvidenceSynthesis
+}
@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type ElementDefinitionSlicingDiscriminator struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// value | exists | pattern | type | profile
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type DiscriminatorType `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/discriminator-type|4.0.1,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Path to element value
@@ -46,7 +46,7 @@ type ElementDefinitionSlicing struct {
 	// Extension for Ordered
 	OrderedExt *primitives.PrimitiveExtension `json:"_ordered,omitempty" fhir:"cardinality=0..1"`
 	// closed | open | openAtEnd
-	Rules string `json:"rules" fhir:"cardinality=1..1,required,summary"`
+	Rules SlicingRules `json:"rules" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/resource-slicing-rules|4.0.1,summary,type=code"`
 	// Extension for Rules
 	RulesExt *primitives.PrimitiveExtension `json:"_rules,omitempty" fhir:"cardinality=0..1"`
 }
@@ -64,7 +64,7 @@ type ElementDefinitionBase struct {
 	// Extension for Path
 	PathExt *primitives.PrimitiveExtension `json:"_path,omitempty" fhir:"cardinality=0..1"`
 	// Min cardinality of the base element
-	Min uint `json:"min" fhir:"cardinality=1..1,required,summary"`
+	Min uint `json:"min" fhir:"cardinality=1..1,required,summary,type=unsignedInt"`
 	// Extension for Min
 	MinExt *primitives.PrimitiveExtension `json:"_min,omitempty" fhir:"cardinality=0..1"`
 	// Max cardinality of the base element
@@ -82,23 +82,23 @@ type ElementDefinitionType struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Data type or Resource (reference to definition)
-	Code string `json:"code" fhir:"cardinality=1..1,required,summary"`
+	Code string `json:"code" fhir:"cardinality=1..1,required,binding=extensible:http://hl7.org/fhir/ValueSet/defined-types,summary,type=uri"`
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Profiles (StructureDefinition or IG) - one must apply
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
+	Profile []primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Profile (StructureDefinition or IG) on the Reference/canonical target - one must apply
-	TargetProfile []string `json:"targetProfile,omitempty" fhir:"cardinality=0..*,summary"`
+	TargetProfile []primitives.Canonical `json:"targetProfile,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for TargetProfile
 	TargetProfileExt *primitives.PrimitiveExtension `json:"_targetProfile,omitempty" fhir:"cardinality=0..1"`
 	// contained | referenced | bundled - how aggregated
-	Aggregation []string `json:"aggregation,omitempty" fhir:"cardinality=0..*,summary"`
+	Aggregation []AggregationMode `json:"aggregation,omitempty" fhir:"cardinality=0..*,binding=required:http://hl7.org/fhir/ValueSet/resource-aggregation-mode|4.0.1,summary,type=code"`
 	// Extension for Aggregation
 	AggregationExt *primitives.PrimitiveExtension `json:"_aggregation,omitempty" fhir:"cardinality=0..1"`
 	// either | independent | specific
-	Versioning *string `json:"versioning,omitempty" fhir:"cardinality=0..1,summary"`
+	Versioning *ReferenceVersionRules `json:"versioning,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/reference-version-rules|4.0.1,summary,type=code"`
 	// Extension for Versioning
 	VersioningExt *primitives.PrimitiveExtension `json:"_versioning,omitempty" fhir:"cardinality=0..1"`
 }
@@ -116,143 +116,750 @@ type ElementDefinitionExample struct {
 	// Extension for Label
 	LabelExt *primitives.PrimitiveExtension `json:"_label,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - base64Binary option
-	ValueBase64Binary string `json:"valueBase64Binary" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - canonical option
-	ValueCanonical string `json:"valueCanonical" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCanonical *primitives.Canonical `json:"valueCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueCanonical
 	ValueCanonicalExt *primitives.PrimitiveExtension `json:"_valueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=code"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - id option
-	ValueID string `json:"valueID" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=id"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - instant option
-	ValueInstant primitives.Instant `json:"valueInstant" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueInstant *primitives.Instant `json:"valueInstant,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueInstant
 	ValueInstantExt *primitives.PrimitiveExtension `json:"_valueInstant,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=integer"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - markdown option
-	ValueMarkdown string `json:"valueMarkdown" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueMarkdown *string `json:"valueMarkdown,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=markdown"`
 	// Extension for ValueMarkdown
 	ValueMarkdownExt *primitives.PrimitiveExtension `json:"_valueMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - oid option
-	ValueOid string `json:"valueOid" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueOid *string `json:"valueOid,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=oid"`
 	// Extension for ValueOid
 	ValueOidExt *primitives.PrimitiveExtension `json:"_valueOid,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - positiveInt option
-	ValuePositiveInt int `json:"valuePositiveInt" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValuePositiveInt *int `json:"valuePositiveInt,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=positiveInt"`
 	// Extension for ValuePositiveInt
 	ValuePositiveIntExt *primitives.PrimitiveExtension `json:"_valuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - unsignedInt option
-	ValueUnsignedInt uint `json:"valueUnsignedInt" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueUnsignedInt *uint `json:"valueUnsignedInt,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=unsignedInt"`
 	// Extension for ValueUnsignedInt
 	ValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_valueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=uri"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - url option
-	ValueURL string `json:"valueURL" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueURL *string `json:"valueUrl,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=url"`
 	// Extension for ValueURL
-	ValueURLExt *primitives.PrimitiveExtension `json:"_valueURL,omitempty" fhir:"cardinality=0..1"`
+	ValueURLExt *primitives.PrimitiveExtension `json:"_valueUrl,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - uuid option
-	ValueUUID string `json:"valueUUID" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueUUID *string `json:"valueUuid,omitempty" fhir:"cardinality=1..1,required,summary,choice=value,type=uuid"`
 	// Extension for ValueUUID
-	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUUID,omitempty" fhir:"cardinality=0..1"`
+	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUuid,omitempty" fhir:"cardinality=0..1"`
 	// Value of Example (one of allowed types) - Address option
-	ValueAddress Address `json:"valueAddress" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueAddress *Address `json:"valueAddress,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Age option
-	ValueAge Age `json:"valueAge" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueAge *Age `json:"valueAge,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Annotation option
-	ValueAnnotation Annotation `json:"valueAnnotation" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - ContactPoint option
-	ValueContactPoint ContactPoint `json:"valueContactPoint" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Count option
-	ValueCount Count `json:"valueCount" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCount *Count `json:"valueCount,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Distance option
-	ValueDistance Distance `json:"valueDistance" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDistance *Distance `json:"valueDistance,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Duration option
-	ValueDuration Duration `json:"valueDuration" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDuration *Duration `json:"valueDuration,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - HumanName option
-	ValueHumanName HumanName `json:"valueHumanName" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueHumanName *HumanName `json:"valueHumanName,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Identifier option
-	ValueIdentifier Identifier `json:"valueIdentifier" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueIdentifier *Identifier `json:"valueIdentifier,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Money option
-	ValueMoney Money `json:"valueMoney" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueMoney *Money `json:"valueMoney,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Period option
-	ValuePeriod Period `json:"valuePeriod" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValuePeriod *Period `json:"valuePeriod,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - SampledData option
-	ValueSampledData SampledData `json:"valueSampledData" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueSampledData *SampledData `json:"valueSampledData,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Signature option
-	ValueSignature Signature `json:"valueSignature" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueSignature *Signature `json:"valueSignature,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Timing option
-	ValueTiming Timing `json:"valueTiming" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueTiming *Timing `json:"valueTiming,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - ContactDetail option
-	ValueContactDetail ContactDetail `json:"valueContactDetail" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Contributor option
-	ValueContributor Contributor `json:"valueContributor" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueContributor *Contributor `json:"valueContributor,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - DataRequirement option
-	ValueDataRequirement DataRequirement `json:"valueDataRequirement" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDataRequirement *DataRequirement `json:"valueDataRequirement,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Expression option
-	ValueExpression Expression `json:"valueExpression" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueExpression *Expression `json:"valueExpression,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - ParameterDefinition option
-	ValueParameterDefinition ParameterDefinition `json:"valueParameterDefinition" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueParameterDefinition *ParameterDefinition `json:"valueParameterDefinition,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - RelatedArtifact option
-	ValueRelatedArtifact RelatedArtifact `json:"valueRelatedArtifact" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRelatedArtifact *RelatedArtifact `json:"valueRelatedArtifact,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - TriggerDefinition option
-	ValueTriggerDefinition TriggerDefinition `json:"valueTriggerDefinition" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueTriggerDefinition *TriggerDefinition `json:"valueTriggerDefinition,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - UsageContext option
-	ValueUsageContext UsageContext `json:"valueUsageContext" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueUsageContext *UsageContext `json:"valueUsageContext,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Dosage option
-	ValueDosage Dosage `json:"valueDosage" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDosage *Dosage `json:"valueDosage,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value of Example (one of allowed types) - Meta option
-	ValueMeta Meta `json:"valueMeta" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueMeta *Meta `json:"valueMeta,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (e *ElementDefinitionExample) Value() any {
+	switch {
+	case e.ValueBase64Binary != nil:
+		return e.ValueBase64Binary
+	case e.ValueBoolean != nil:
+		return e.ValueBoolean
+	case e.ValueCanonical != nil:
+		return e.ValueCanonical
+	case e.ValueCode != nil:
+		return e.ValueCode
+	case e.ValueDate != nil:
+		return e.ValueDate
+	case e.ValueDateTime != nil:
+		return e.ValueDateTime
+	case e.ValueDecimal != nil:
+		return e.ValueDecimal
+	case e.ValueID != nil:
+		return e.ValueID
+	case e.ValueInstant != nil:
+		return e.ValueInstant
+	case e.ValueInteger != nil:
+		return e.ValueInteger
+	case e.ValueMarkdown != nil:
+		return e.ValueMarkdown
+	case e.ValueOid != nil:
+		return e.ValueOid
+	case e.ValuePositiveInt != nil:
+		return e.ValuePositiveInt
+	case e.ValueString != nil:
+		return e.ValueString
+	case e.ValueTime != nil:
+		return e.ValueTime
+	case e.ValueUnsignedInt != nil:
+		return e.ValueUnsignedInt
+	case e.ValueURI != nil:
+		return e.ValueURI
+	case e.ValueURL != nil:
+		return e.ValueURL
+	case e.ValueUUID != nil:
+		return e.ValueUUID
+	case e.ValueAddress != nil:
+		return e.ValueAddress
+	case e.ValueAge != nil:
+		return e.ValueAge
+	case e.ValueAnnotation != nil:
+		return e.ValueAnnotation
+	case e.ValueAttachment != nil:
+		return e.ValueAttachment
+	case e.ValueCodeableConcept != nil:
+		return e.ValueCodeableConcept
+	case e.ValueCoding != nil:
+		return e.ValueCoding
+	case e.ValueContactPoint != nil:
+		return e.ValueContactPoint
+	case e.ValueCount != nil:
+		return e.ValueCount
+	case e.ValueDistance != nil:
+		return e.ValueDistance
+	case e.ValueDuration != nil:
+		return e.ValueDuration
+	case e.ValueHumanName != nil:
+		return e.ValueHumanName
+	case e.ValueIdentifier != nil:
+		return e.ValueIdentifier
+	case e.ValueMoney != nil:
+		return e.ValueMoney
+	case e.ValuePeriod != nil:
+		return e.ValuePeriod
+	case e.ValueQuantity != nil:
+		return e.ValueQuantity
+	case e.ValueRange != nil:
+		return e.ValueRange
+	case e.ValueRatio != nil:
+		return e.ValueRatio
+	case e.ValueReference != nil:
+		return e.ValueReference
+	case e.ValueSampledData != nil:
+		return e.ValueSampledData
+	case e.ValueSignature != nil:
+		return e.ValueSignature
+	case e.ValueTiming != nil:
+		return e.ValueTiming
+	case e.ValueContactDetail != nil:
+		return e.ValueContactDetail
+	case e.ValueContributor != nil:
+		return e.ValueContributor
+	case e.ValueDataRequirement != nil:
+		return e.ValueDataRequirement
+	case e.ValueExpression != nil:
+		return e.ValueExpression
+	case e.ValueParameterDefinition != nil:
+		return e.ValueParameterDefinition
+	case e.ValueRelatedArtifact != nil:
+		return e.ValueRelatedArtifact
+	case e.ValueTriggerDefinition != nil:
+		return e.ValueTriggerDefinition
+	case e.ValueUsageContext != nil:
+		return e.ValueUsageContext
+	case e.ValueDosage != nil:
+		return e.ValueDosage
+	case e.ValueMeta != nil:
+		return e.ValueMeta
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (e *ElementDefinitionExample) ValueType() string {
+	switch {
+	case e.ValueBase64Binary != nil:
+		return "base64Binary"
+	case e.ValueBoolean != nil:
+		return "boolean"
+	case e.ValueCanonical != nil:
+		return "canonical"
+	case e.ValueCode != nil:
+		return "code"
+	case e.ValueDate != nil:
+		return "date"
+	case e.ValueDateTime != nil:
+		return "dateTime"
+	case e.ValueDecimal != nil:
+		return "decimal"
+	case e.ValueID != nil:
+		return "id"
+	case e.ValueInstant != nil:
+		return "instant"
+	case e.ValueInteger != nil:
+		return "integer"
+	case e.ValueMarkdown != nil:
+		return "markdown"
+	case e.ValueOid != nil:
+		return "oid"
+	case e.ValuePositiveInt != nil:
+		return "positiveInt"
+	case e.ValueString != nil:
+		return "string"
+	case e.ValueTime != nil:
+		return "time"
+	case e.ValueUnsignedInt != nil:
+		return "unsignedInt"
+	case e.ValueURI != nil:
+		return "uri"
+	case e.ValueURL != nil:
+		return "url"
+	case e.ValueUUID != nil:
+		return "uuid"
+	case e.ValueAddress != nil:
+		return "Address"
+	case e.ValueAge != nil:
+		return "Age"
+	case e.ValueAnnotation != nil:
+		return "Annotation"
+	case e.ValueAttachment != nil:
+		return "Attachment"
+	case e.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.ValueCoding != nil:
+		return "Coding"
+	case e.ValueContactPoint != nil:
+		return "ContactPoint"
+	case e.ValueCount != nil:
+		return "Count"
+	case e.ValueDistance != nil:
+		return "Distance"
+	case e.ValueDuration != nil:
+		return "Duration"
+	case e.ValueHumanName != nil:
+		return "HumanName"
+	case e.ValueIdentifier != nil:
+		return "Identifier"
+	case e.ValueMoney != nil:
+		return "Money"
+	case e.ValuePeriod != nil:
+		return "Period"
+	case e.ValueQuantity != nil:
+		return "Quantity"
+	case e.ValueRange != nil:
+		return "Range"
+	case e.ValueRatio != nil:
+		return "Ratio"
+	case e.ValueReference != nil:
+		return "Reference"
+	case e.ValueSampledData != nil:
+		return "SampledData"
+	case e.ValueSignature != nil:
+		return "Signature"
+	case e.ValueTiming != nil:
+		return "Timing"
+	case e.ValueContactDetail != nil:
+		return "ContactDetail"
+	case e.ValueContributor != nil:
+		return "Contributor"
+	case e.ValueDataRequirement != nil:
+		return "DataRequirement"
+	case e.ValueExpression != nil:
+		return "Expression"
+	case e.ValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case e.ValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case e.ValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case e.ValueUsageContext != nil:
+		return "UsageContext"
+	case e.ValueDosage != nil:
+		return "Dosage"
+	case e.ValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (e *ElementDefinitionExample) ClearValue() {
+	e.ValueBase64Binary = nil
+	e.ValueBase64BinaryExt = nil
+	e.ValueBoolean = nil
+	e.ValueBooleanExt = nil
+	e.ValueCanonical = nil
+	e.ValueCanonicalExt = nil
+	e.ValueCode = nil
+	e.ValueCodeExt = nil
+	e.ValueDate = nil
+	e.ValueDateExt = nil
+	e.ValueDateTime = nil
+	e.ValueDateTimeExt = nil
+	e.ValueDecimal = nil
+	e.ValueDecimalExt = nil
+	e.ValueID = nil
+	e.ValueIDExt = nil
+	e.ValueInstant = nil
+	e.ValueInstantExt = nil
+	e.ValueInteger = nil
+	e.ValueIntegerExt = nil
+	e.ValueMarkdown = nil
+	e.ValueMarkdownExt = nil
+	e.ValueOid = nil
+	e.ValueOidExt = nil
+	e.ValuePositiveInt = nil
+	e.ValuePositiveIntExt = nil
+	e.ValueString = nil
+	e.ValueStringExt = nil
+	e.ValueTime = nil
+	e.ValueTimeExt = nil
+	e.ValueUnsignedInt = nil
+	e.ValueUnsignedIntExt = nil
+	e.ValueURI = nil
+	e.ValueURIExt = nil
+	e.ValueURL = nil
+	e.ValueURLExt = nil
+	e.ValueUUID = nil
+	e.ValueUUIDExt = nil
+	e.ValueAddress = nil
+	e.ValueAge = nil
+	e.ValueAnnotation = nil
+	e.ValueAttachment = nil
+	e.ValueCodeableConcept = nil
+	e.ValueCoding = nil
+	e.ValueContactPoint = nil
+	e.ValueCount = nil
+	e.ValueDistance = nil
+	e.ValueDuration = nil
+	e.ValueHumanName = nil
+	e.ValueIdentifier = nil
+	e.ValueMoney = nil
+	e.ValuePeriod = nil
+	e.ValueQuantity = nil
+	e.ValueRange = nil
+	e.ValueRatio = nil
+	e.ValueReference = nil
+	e.ValueSampledData = nil
+	e.ValueSignature = nil
+	e.ValueTiming = nil
+	e.ValueContactDetail = nil
+	e.ValueContributor = nil
+	e.ValueDataRequirement = nil
+	e.ValueExpression = nil
+	e.ValueParameterDefinition = nil
+	e.ValueRelatedArtifact = nil
+	e.ValueTriggerDefinition = nil
+	e.ValueUsageContext = nil
+	e.ValueDosage = nil
+	e.ValueMeta = nil
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := e.ValueBase64BinaryExt
+	e.ClearValue()
+	e.ValueBase64Binary, e.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueBoolean(value bool) {
+	ext := e.ValueBooleanExt
+	e.ClearValue()
+	e.ValueBoolean, e.ValueBooleanExt = &value, ext
+}
+
+// SetValueCanonical sets the canonical option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueCanonical(value primitives.Canonical) {
+	ext := e.ValueCanonicalExt
+	e.ClearValue()
+	e.ValueCanonical, e.ValueCanonicalExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueCode(value string) {
+	ext := e.ValueCodeExt
+	e.ClearValue()
+	e.ValueCode, e.ValueCodeExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDate(value primitives.Date) {
+	ext := e.ValueDateExt
+	e.ClearValue()
+	e.ValueDate, e.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDateTime(value primitives.DateTime) {
+	ext := e.ValueDateTimeExt
+	e.ClearValue()
+	e.ValueDateTime, e.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDecimal(value primitives.Decimal) {
+	ext := e.ValueDecimalExt
+	e.ClearValue()
+	e.ValueDecimal, e.ValueDecimalExt = &value, ext
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueID(value string) {
+	ext := e.ValueIDExt
+	e.ClearValue()
+	e.ValueID, e.ValueIDExt = &value, ext
+}
+
+// SetValueInstant sets the instant option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueInstant(value primitives.Instant) {
+	ext := e.ValueInstantExt
+	e.ClearValue()
+	e.ValueInstant, e.ValueInstantExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueInteger(value int) {
+	ext := e.ValueIntegerExt
+	e.ClearValue()
+	e.ValueInteger, e.ValueIntegerExt = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueMarkdown(value string) {
+	ext := e.ValueMarkdownExt
+	e.ClearValue()
+	e.ValueMarkdown, e.ValueMarkdownExt = &value, ext
+}
+
+// SetValueOid sets the oid option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueOid(value string) {
+	ext := e.ValueOidExt
+	e.ClearValue()
+	e.ValueOid, e.ValueOidExt = &value, ext
+}
+
+// SetValuePositiveInt sets the positiveInt option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValuePositiveInt(value int) {
+	ext := e.ValuePositiveIntExt
+	e.ClearValue()
+	e.ValuePositiveInt, e.ValuePositiveIntExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueString(value string) {
+	ext := e.ValueStringExt
+	e.ClearValue()
+	e.ValueString, e.ValueStringExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueTime(value primitives.Time) {
+	ext := e.ValueTimeExt
+	e.ClearValue()
+	e.ValueTime, e.ValueTimeExt = &value, ext
+}
+
+// SetValueUnsignedInt sets the unsignedInt option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueUnsignedInt(value uint) {
+	ext := e.ValueUnsignedIntExt
+	e.ClearValue()
+	e.ValueUnsignedInt, e.ValueUnsignedIntExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueURI(value string) {
+	ext := e.ValueURIExt
+	e.ClearValue()
+	e.ValueURI, e.ValueURIExt = &value, ext
+}
+
+// SetValueURL sets the url option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueURL(value string) {
+	ext := e.ValueURLExt
+	e.ClearValue()
+	e.ValueURL, e.ValueURLExt = &value, ext
+}
+
+// SetValueUUID sets the uuid option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueUUID(value string) {
+	ext := e.ValueUUIDExt
+	e.ClearValue()
+	e.ValueUUID, e.ValueUUIDExt = &value, ext
+}
+
+// SetValueAddress sets the Address option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueAddress(value Address) {
+	e.ClearValue()
+	e.ValueAddress = &value
+}
+
+// SetValueAge sets the Age option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueAge(value Age) {
+	e.ClearValue()
+	e.ValueAge = &value
+}
+
+// SetValueAnnotation sets the Annotation option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueAnnotation(value Annotation) {
+	e.ClearValue()
+	e.ValueAnnotation = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueAttachment(value Attachment) {
+	e.ClearValue()
+	e.ValueAttachment = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueCodeableConcept(value CodeableConcept) {
+	e.ClearValue()
+	e.ValueCodeableConcept = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueCoding(value Coding) {
+	e.ClearValue()
+	e.ValueCoding = &value
+}
+
+// SetValueContactPoint sets the ContactPoint option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueContactPoint(value ContactPoint) {
+	e.ClearValue()
+	e.ValueContactPoint = &value
+}
+
+// SetValueCount sets the Count option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueCount(value Count) {
+	e.ClearValue()
+	e.ValueCount = &value
+}
+
+// SetValueDistance sets the Distance option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDistance(value Distance) {
+	e.ClearValue()
+	e.ValueDistance = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDuration(value Duration) {
+	e.ClearValue()
+	e.ValueDuration = &value
+}
+
+// SetValueHumanName sets the HumanName option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueHumanName(value HumanName) {
+	e.ClearValue()
+	e.ValueHumanName = &value
+}
+
+// SetValueIdentifier sets the Identifier option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueIdentifier(value Identifier) {
+	e.ClearValue()
+	e.ValueIdentifier = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueMoney(value Money) {
+	e.ClearValue()
+	e.ValueMoney = &value
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValuePeriod(value Period) {
+	e.ClearValue()
+	e.ValuePeriod = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueQuantity(value Quantity) {
+	e.ClearValue()
+	e.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueRange(value Range) {
+	e.ClearValue()
+	e.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueRatio(value Ratio) {
+	e.ClearValue()
+	e.ValueRatio = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueReference(value Reference) {
+	e.ClearValue()
+	e.ValueReference = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueSampledData(value SampledData) {
+	e.ClearValue()
+	e.ValueSampledData = &value
+}
+
+// SetValueSignature sets the Signature option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueSignature(value Signature) {
+	e.ClearValue()
+	e.ValueSignature = &value
+}
+
+// SetValueTiming sets the Timing option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueTiming(value Timing) {
+	e.ClearValue()
+	e.ValueTiming = &value
+}
+
+// SetValueContactDetail sets the ContactDetail option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueContactDetail(value ContactDetail) {
+	e.ClearValue()
+	e.ValueContactDetail = &value
+}
+
+// SetValueContributor sets the Contributor option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueContributor(value Contributor) {
+	e.ClearValue()
+	e.ValueContributor = &value
+}
+
+// SetValueDataRequirement sets the DataRequirement option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDataRequirement(value DataRequirement) {
+	e.ClearValue()
+	e.ValueDataRequirement = &value
+}
+
+// SetValueExpression sets the Expression option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueExpression(value Expression) {
+	e.ClearValue()
+	e.ValueExpression = &value
+}
+
+// SetValueParameterDefinition sets the ParameterDefinition option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueParameterDefinition(value ParameterDefinition) {
+	e.ClearValue()
+	e.ValueParameterDefinition = &value
+}
+
+// SetValueRelatedArtifact sets the RelatedArtifact option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueRelatedArtifact(value RelatedArtifact) {
+	e.ClearValue()
+	e.ValueRelatedArtifact = &value
+}
+
+// SetValueTriggerDefinition sets the TriggerDefinition option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueTriggerDefinition(value TriggerDefinition) {
+	e.ClearValue()
+	e.ValueTriggerDefinition = &value
+}
+
+// SetValueUsageContext sets the UsageContext option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueUsageContext(value UsageContext) {
+	e.ClearValue()
+	e.ValueUsageContext = &value
+}
+
+// SetValueDosage sets the Dosage option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueDosage(value Dosage) {
+	e.ClearValue()
+	e.ValueDosage = &value
+}
+
+// SetValueMeta sets the Meta option of value[x], clearing the others.
+func (e *ElementDefinitionExample) SetValueMeta(value Meta) {
+	e.ClearValue()
+	e.ValueMeta = &value
 }
 
 // ElementDefinitionConstraint represents a FHIR BackboneElement for ElementDefinition.constraint.
@@ -264,7 +871,7 @@ type ElementDefinitionConstraint struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Target of 'condition' reference above
-	Key string `json:"key" fhir:"cardinality=1..1,required,summary"`
+	Key string `json:"key" fhir:"cardinality=1..1,required,summary,type=id"`
 	// Extension for Key
 	KeyExt *primitives.PrimitiveExtension `json:"_key,omitempty" fhir:"cardinality=0..1"`
 	// Why this constraint is necessary or appropriate
@@ -272,7 +879,7 @@ type ElementDefinitionConstraint struct {
 	// Extension for Requirements
 	RequirementsExt *primitives.PrimitiveExtension `json:"_requirements,omitempty" fhir:"cardinality=0..1"`
 	// error | warning
-	Severity string `json:"severity" fhir:"cardinality=1..1,required,summary"`
+	Severity ConstraintSeverity `json:"severity" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/constraint-severity|4.0.1,summary,type=code"`
 	// Extension for Severity
 	SeverityExt *primitives.PrimitiveExtension `json:"_severity,omitempty" fhir:"cardinality=0..1"`
 	// Human description of constraint
@@ -288,7 +895,7 @@ type ElementDefinitionConstraint struct {
 	// Extension for Xpath
 	XpathExt *primitives.PrimitiveExtension `json:"_xpath,omitempty" fhir:"cardinality=0..1"`
 	// Reference to original source of constraint
-	Source *string `json:"source,omitempty" fhir:"cardinality=0..1,summary"`
+	Source *primitives.Canonical `json:"source,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Source
 	SourceExt *primitives.PrimitiveExtension `json:"_source,omitempty" fhir:"cardinality=0..1"`
 }
@@ -302,7 +909,7 @@ type ElementDefinitionBinding struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// required | extensible | preferred | example
-	Strength string `json:"strength" fhir:"cardinality=1..1,required,summary"`
+	Strength BindingStrength `json:"strength" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/binding-strength|4.0.1,summary,type=code"`
 	// Extension for Strength
 	StrengthExt *primitives.PrimitiveExtension `json:"_strength,omitempty" fhir:"cardinality=0..1"`
 	// Human explanation of the value set
@@ -310,7 +917,7 @@ type ElementDefinitionBinding struct {
 	// Extension for Description
 	DescriptionExt *primitives.PrimitiveExtension `json:"_description,omitempty" fhir:"cardinality=0..1"`
 	// Source of value set
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1,summary"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 }
@@ -324,11 +931,11 @@ type ElementDefinitionMapping struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Reference to mapping declaration
-	Identity string `json:"identity" fhir:"cardinality=1..1,required,summary"`
+	Identity string `json:"identity" fhir:"cardinality=1..1,required,summary,type=id"`
 	// Extension for Identity
 	IdentityExt *primitives.PrimitiveExtension `json:"_identity,omitempty" fhir:"cardinality=0..1"`
 	// Computable language of mapping
-	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,summary"`
+	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/mimetypes|4.0.1,summary,type=code"`
 	// Extension for Language
 	LanguageExt *primitives.PrimitiveExtension `json:"_language,omitempty" fhir:"cardinality=0..1"`
 	// Details of the mapping
@@ -356,7 +963,7 @@ type ElementDefinition struct {
 	// Extension for Path
 	PathExt *primitives.PrimitiveExtension `json:"_path,omitempty" fhir:"cardinality=0..1"`
 	// xmlAttr | xmlText | typeAttr | cdaText | xhtml
-	Representation []string `json:"representation,omitempty" fhir:"cardinality=0..*,summary"`
+	Representation []PropertyRepresentation `json:"representation,omitempty" fhir:"cardinality=0..*,binding=required:http://hl7.org/fhir/ValueSet/property-representation|4.0.1,summary,type=code"`
 	// Extension for Representation
 	RepresentationExt *primitives.PrimitiveExtension `json:"_representation,omitempty" fhir:"cardinality=0..1"`
 	// Name for this particular element (in a set of slices)
@@ -380,15 +987,15 @@ type ElementDefinition struct {
 	// Extension for Short
 	ShortExt *primitives.PrimitiveExtension `json:"_short,omitempty" fhir:"cardinality=0..1"`
 	// Full formal definition as narrative text
-	Definition *string `json:"definition,omitempty" fhir:"cardinality=0..1,summary"`
+	Definition *string `json:"definition,omitempty" fhir:"cardinality=0..1,summary,type=markdown"`
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 	// Comments about the use of this element
-	Comment *string `json:"comment,omitempty" fhir:"cardinality=0..1,summary"`
+	Comment *string `json:"comment,omitempty" fhir:"cardinality=0..1,summary,type=markdown"`
 	// Extension for Comment
 	CommentExt *primitives.PrimitiveExtension `json:"_comment,omitempty" fhir:"cardinality=0..1"`
 	// Why this resource has been created
-	Requirements *string `json:"requirements,omitempty" fhir:"cardinality=0..1,summary"`
+	Requirements *string `json:"requirements,omitempty" fhir:"cardinality=0..1,summary,type=markdown"`
 	// Extension for Requirements
 	RequirementsExt *primitives.PrimitiveExtension `json:"_requirements,omitempty" fhir:"cardinality=0..1"`
 	// Other names
@@ -396,7 +1003,7 @@ type ElementDefinition struct {
 	// Extension for Alias
 	AliasExt *primitives.PrimitiveExtension `json:"_alias,omitempty" fhir:"cardinality=0..1"`
 	// Minimum Cardinality
-	Min *uint `json:"min,omitempty" fhir:"cardinality=0..1,summary"`
+	Min *uint `json:"min,omitempty" fhir:"cardinality=0..1,summary,type=unsignedInt"`
 	// Extension for Min
 	MinExt *primitives.PrimitiveExtension `json:"_min,omitempty" fhir:"cardinality=0..1"`
 	// Maximum Cardinality (a number or *)
@@ -406,13 +1013,13 @@ type ElementDefinition struct {
 	// Base definition information for tools
 	Base *ElementDefinitionBase `json:"base,omitempty" fhir:"cardinality=0..1,summary"`
 	// Reference to definition of content for the element
-	ContentReference *string `json:"contentReference,omitempty" fhir:"cardinality=0..1,summary"`
+	ContentReference *string `json:"contentReference,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	// Extension for ContentReference
 	ContentReferenceExt *primitives.PrimitiveExtension `json:"_contentReference,omitempty" fhir:"cardinality=0..1"`
 	// Data type and Profile for this element
 	Type []ElementDefinitionType `json:"type,omitempty" fhir:"cardinality=0..*,summary"`
 	// Specified value if missing from instance - base64Binary option
-	DefaultValueBase64Binary *string `json:"defaultValueBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueBase64Binary *primitives.Base64Binary `json:"defaultValueBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueBase64Binary
 	DefaultValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_defaultValueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - boolean option
@@ -420,11 +1027,11 @@ type ElementDefinition struct {
 	// Extension for DefaultValueBoolean
 	DefaultValueBooleanExt *primitives.PrimitiveExtension `json:"_defaultValueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - canonical option
-	DefaultValueCanonical *string `json:"defaultValueCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueCanonical *primitives.Canonical `json:"defaultValueCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueCanonical
 	DefaultValueCanonicalExt *primitives.PrimitiveExtension `json:"_defaultValueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - code option
-	DefaultValueCode *string `json:"defaultValueCode,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueCode *string `json:"defaultValueCode,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=code"`
 	// Extension for DefaultValueCode
 	DefaultValueCodeExt *primitives.PrimitiveExtension `json:"_defaultValueCode,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - date option
@@ -436,31 +1043,31 @@ type ElementDefinition struct {
 	// Extension for DefaultValueDateTime
 	DefaultValueDateTimeExt *primitives.PrimitiveExtension `json:"_defaultValueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - decimal option
-	DefaultValueDecimal *float64 `json:"defaultValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueDecimal *primitives.Decimal `json:"defaultValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueDecimal
 	DefaultValueDecimalExt *primitives.PrimitiveExtension `json:"_defaultValueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - id option
-	DefaultValueID *string `json:"defaultValueID,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueID *string `json:"defaultValueId,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=id"`
 	// Extension for DefaultValueID
-	DefaultValueIDExt *primitives.PrimitiveExtension `json:"_defaultValueID,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueIDExt *primitives.PrimitiveExtension `json:"_defaultValueId,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - instant option
 	DefaultValueInstant *primitives.Instant `json:"defaultValueInstant,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueInstant
 	DefaultValueInstantExt *primitives.PrimitiveExtension `json:"_defaultValueInstant,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - integer option
-	DefaultValueInteger *int `json:"defaultValueInteger,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueInteger *int `json:"defaultValueInteger,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=integer"`
 	// Extension for DefaultValueInteger
 	DefaultValueIntegerExt *primitives.PrimitiveExtension `json:"_defaultValueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - markdown option
-	DefaultValueMarkdown *string `json:"defaultValueMarkdown,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueMarkdown *string `json:"defaultValueMarkdown,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=markdown"`
 	// Extension for DefaultValueMarkdown
 	DefaultValueMarkdownExt *primitives.PrimitiveExtension `json:"_defaultValueMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - oid option
-	DefaultValueOid *string `json:"defaultValueOid,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueOid *string `json:"defaultValueOid,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=oid"`
 	// Extension for DefaultValueOid
 	DefaultValueOidExt *primitives.PrimitiveExtension `json:"_defaultValueOid,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - positiveInt option
-	DefaultValuePositiveInt *int `json:"defaultValuePositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValuePositiveInt *int `json:"defaultValuePositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=positiveInt"`
 	// Extension for DefaultValuePositiveInt
 	DefaultValuePositiveIntExt *primitives.PrimitiveExtension `json:"_defaultValuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - string option
@@ -472,21 +1079,21 @@ type ElementDefinition struct {
 	// Extension for DefaultValueTime
 	DefaultValueTimeExt *primitives.PrimitiveExtension `json:"_defaultValueTime,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - unsignedInt option
-	DefaultValueUnsignedInt *uint `json:"defaultValueUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueUnsignedInt *uint `json:"defaultValueUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=unsignedInt"`
 	// Extension for DefaultValueUnsignedInt
 	DefaultValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_defaultValueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - uri option
-	DefaultValueURI *string `json:"defaultValueURI,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueURI *string `json:"defaultValueUri,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=uri"`
 	// Extension for DefaultValueURI
-	DefaultValueURIExt *primitives.PrimitiveExtension `json:"_defaultValueURI,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueURIExt *primitives.PrimitiveExtension `json:"_defaultValueUri,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - url option
-	DefaultValueURL *string `json:"defaultValueURL,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueURL *string `json:"defaultValueUrl,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=url"`
 	// Extension for DefaultValueURL
-	DefaultValueURLExt *primitives.PrimitiveExtension `json:"_defaultValueURL,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueURLExt *primitives.PrimitiveExtension `json:"_defaultValueUrl,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - uuid option
-	DefaultValueUUID *string `json:"defaultValueUUID,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueUUID *string `json:"defaultValueUuid,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue,type=uuid"`
 	// Extension for DefaultValueUUID
-	DefaultValueUUIDExt *primitives.PrimitiveExtension `json:"_defaultValueUUID,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueUUIDExt *primitives.PrimitiveExtension `json:"_defaultValueUuid,omitempty" fhir:"cardinality=0..1"`
 	// Specified value if missing from instance - Address option
 	DefaultValueAddress *Address `json:"defaultValueAddress,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Specified value if missing from instance - Age option
@@ -550,7 +1157,7 @@ type ElementDefinition struct {
 	// Specified value if missing from instance - Meta option
 	DefaultValueMeta *Meta `json:"defaultValueMeta,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Implicit meaning when this element is missing
-	MeaningWhenMissing *string `json:"meaningWhenMissing,omitempty" fhir:"cardinality=0..1,summary"`
+	MeaningWhenMissing *string `json:"meaningWhenMissing,omitempty" fhir:"cardinality=0..1,summary,type=markdown"`
 	// Extension for MeaningWhenMissing
 	MeaningWhenMissingExt *primitives.PrimitiveExtension `json:"_meaningWhenMissing,omitempty" fhir:"cardinality=0..1"`
 	// What the order of the elements means
@@ -558,7 +1165,7 @@ type ElementDefinition struct {
 	// Extension for OrderMeaning
 	OrderMeaningExt *primitives.PrimitiveExtension `json:"_orderMeaning,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - base64Binary option
-	FixedBase64Binary *string `json:"fixedBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedBase64Binary *primitives.Base64Binary `json:"fixedBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
 	// Extension for FixedBase64Binary
 	FixedBase64BinaryExt *primitives.PrimitiveExtension `json:"_fixedBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - boolean option
@@ -566,11 +1173,11 @@ type ElementDefinition struct {
 	// Extension for FixedBoolean
 	FixedBooleanExt *primitives.PrimitiveExtension `json:"_fixedBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - canonical option
-	FixedCanonical *string `json:"fixedCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedCanonical *primitives.Canonical `json:"fixedCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
 	// Extension for FixedCanonical
 	FixedCanonicalExt *primitives.PrimitiveExtension `json:"_fixedCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - code option
-	FixedCode *string `json:"fixedCode,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedCode *string `json:"fixedCode,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=code"`
 	// Extension for FixedCode
 	FixedCodeExt *primitives.PrimitiveExtension `json:"_fixedCode,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - date option
@@ -582,31 +1189,31 @@ type ElementDefinition struct {
 	// Extension for FixedDateTime
 	FixedDateTimeExt *primitives.PrimitiveExtension `json:"_fixedDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - decimal option
-	FixedDecimal *float64 `json:"fixedDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedDecimal *primitives.Decimal `json:"fixedDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
 	// Extension for FixedDecimal
 	FixedDecimalExt *primitives.PrimitiveExtension `json:"_fixedDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - id option
-	FixedID *string `json:"fixedID,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedID *string `json:"fixedId,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=id"`
 	// Extension for FixedID
-	FixedIDExt *primitives.PrimitiveExtension `json:"_fixedID,omitempty" fhir:"cardinality=0..1"`
+	FixedIDExt *primitives.PrimitiveExtension `json:"_fixedId,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - instant option
 	FixedInstant *primitives.Instant `json:"fixedInstant,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
 	// Extension for FixedInstant
 	FixedInstantExt *primitives.PrimitiveExtension `json:"_fixedInstant,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - integer option
-	FixedInteger *int `json:"fixedInteger,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedInteger *int `json:"fixedInteger,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=integer"`
 	// Extension for FixedInteger
 	FixedIntegerExt *primitives.PrimitiveExtension `json:"_fixedInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - markdown option
-	FixedMarkdown *string `json:"fixedMarkdown,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedMarkdown *string `json:"fixedMarkdown,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=markdown"`
 	// Extension for FixedMarkdown
 	FixedMarkdownExt *primitives.PrimitiveExtension `json:"_fixedMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - oid option
-	FixedOid *string `json:"fixedOid,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedOid *string `json:"fixedOid,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=oid"`
 	// Extension for FixedOid
 	FixedOidExt *primitives.PrimitiveExtension `json:"_fixedOid,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - positiveInt option
-	FixedPositiveInt *int `json:"fixedPositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedPositiveInt *int `json:"fixedPositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=positiveInt"`
 	// Extension for FixedPositiveInt
 	FixedPositiveIntExt *primitives.PrimitiveExtension `json:"_fixedPositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - string option
@@ -618,21 +1225,21 @@ type ElementDefinition struct {
 	// Extension for FixedTime
 	FixedTimeExt *primitives.PrimitiveExtension `json:"_fixedTime,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - unsignedInt option
-	FixedUnsignedInt *uint `json:"fixedUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedUnsignedInt *uint `json:"fixedUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=unsignedInt"`
 	// Extension for FixedUnsignedInt
 	FixedUnsignedIntExt *primitives.PrimitiveExtension `json:"_fixedUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - uri option
-	FixedURI *string `json:"fixedURI,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedURI *string `json:"fixedUri,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=uri"`
 	// Extension for FixedURI
-	FixedURIExt *primitives.PrimitiveExtension `json:"_fixedURI,omitempty" fhir:"cardinality=0..1"`
+	FixedURIExt *primitives.PrimitiveExtension `json:"_fixedUri,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - url option
-	FixedURL *string `json:"fixedURL,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedURL *string `json:"fixedUrl,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=url"`
 	// Extension for FixedURL
-	FixedURLExt *primitives.PrimitiveExtension `json:"_fixedURL,omitempty" fhir:"cardinality=0..1"`
+	FixedURLExt *primitives.PrimitiveExtension `json:"_fixedUrl,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - uuid option
-	FixedUUID *string `json:"fixedUUID,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
+	FixedUUID *string `json:"fixedUuid,omitempty" fhir:"cardinality=0..1,summary,choice=fixed,type=uuid"`
 	// Extension for FixedUUID
-	FixedUUIDExt *primitives.PrimitiveExtension `json:"_fixedUUID,omitempty" fhir:"cardinality=0..1"`
+	FixedUUIDExt *primitives.PrimitiveExtension `json:"_fixedUuid,omitempty" fhir:"cardinality=0..1"`
 	// Value must be exactly this - Address option
 	FixedAddress *Address `json:"fixedAddress,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
 	// Value must be exactly this - Age option
@@ -696,7 +1303,7 @@ type ElementDefinition struct {
 	// Value must be exactly this - Meta option
 	FixedMeta *Meta `json:"fixedMeta,omitempty" fhir:"cardinality=0..1,summary,choice=fixed"`
 	// Value must have at least these property values - base64Binary option
-	PatternBase64Binary *string `json:"patternBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternBase64Binary *primitives.Base64Binary `json:"patternBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
 	// Extension for PatternBase64Binary
 	PatternBase64BinaryExt *primitives.PrimitiveExtension `json:"_patternBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - boolean option
@@ -704,11 +1311,11 @@ type ElementDefinition struct {
 	// Extension for PatternBoolean
 	PatternBooleanExt *primitives.PrimitiveExtension `json:"_patternBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - canonical option
-	PatternCanonical *string `json:"patternCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternCanonical *primitives.Canonical `json:"patternCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
 	// Extension for PatternCanonical
 	PatternCanonicalExt *primitives.PrimitiveExtension `json:"_patternCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - code option
-	PatternCode *string `json:"patternCode,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternCode *string `json:"patternCode,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=code"`
 	// Extension for PatternCode
 	PatternCodeExt *primitives.PrimitiveExtension `json:"_patternCode,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - date option
@@ -720,31 +1327,31 @@ type ElementDefinition struct {
 	// Extension for PatternDateTime
 	PatternDateTimeExt *primitives.PrimitiveExtension `json:"_patternDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - decimal option
-	PatternDecimal *float64 `json:"patternDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternDecimal *primitives.Decimal `json:"patternDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
 	// Extension for PatternDecimal
 	PatternDecimalExt *primitives.PrimitiveExtension `json:"_patternDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - id option
-	PatternID *string `json:"patternID,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternID *string `json:"patternId,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=id"`
 	// Extension for PatternID
-	PatternIDExt *primitives.PrimitiveExtension `json:"_patternID,omitempty" fhir:"cardinality=0..1"`
+	PatternIDExt *primitives.PrimitiveExtension `json:"_patternId,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - instant option
 	PatternInstant *primitives.Instant `json:"patternInstant,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
 	// Extension for PatternInstant
 	PatternInstantExt *primitives.PrimitiveExtension `json:"_patternInstant,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - integer option
-	PatternInteger *int `json:"patternInteger,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternInteger *int `json:"patternInteger,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=integer"`
 	// Extension for PatternInteger
 	PatternIntegerExt *primitives.PrimitiveExtension `json:"_patternInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - markdown option
-	PatternMarkdown *string `json:"patternMarkdown,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternMarkdown *string `json:"patternMarkdown,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=markdown"`
 	// Extension for PatternMarkdown
 	PatternMarkdownExt *primitives.PrimitiveExtension `json:"_patternMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - oid option
-	PatternOid *string `json:"patternOid,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternOid *string `json:"patternOid,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=oid"`
 	// Extension for PatternOid
 	PatternOidExt *primitives.PrimitiveExtension `json:"_patternOid,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - positiveInt option
-	PatternPositiveInt *int `json:"patternPositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternPositiveInt *int `json:"patternPositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=positiveInt"`
 	// Extension for PatternPositiveInt
 	PatternPositiveIntExt *primitives.PrimitiveExtension `json:"_patternPositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - string option
@@ -756,21 +1363,21 @@ type ElementDefinition struct {
 	// Extension for PatternTime
 	PatternTimeExt *primitives.PrimitiveExtension `json:"_patternTime,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - unsignedInt option
-	PatternUnsignedInt *uint `json:"patternUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternUnsignedInt *uint `json:"patternUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=unsignedInt"`
 	// Extension for PatternUnsignedInt
 	PatternUnsignedIntExt *primitives.PrimitiveExtension `json:"_patternUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - uri option
-	PatternURI *string `json:"patternURI,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternURI *string `json:"patternUri,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=uri"`
 	// Extension for PatternURI
-	PatternURIExt *primitives.PrimitiveExtension `json:"_patternURI,omitempty" fhir:"cardinality=0..1"`
+	PatternURIExt *primitives.PrimitiveExtension `json:"_patternUri,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - url option
-	PatternURL *string `json:"patternURL,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternURL *string `json:"patternUrl,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=url"`
 	// Extension for PatternURL
-	PatternURLExt *primitives.PrimitiveExtension `json:"_patternURL,omitempty" fhir:"cardinality=0..1"`
+	PatternURLExt *primitives.PrimitiveExtension `json:"_patternUrl,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - uuid option
-	PatternUUID *string `json:"patternUUID,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
+	PatternUUID *string `json:"patternUuid,omitempty" fhir:"cardinality=0..1,summary,choice=pattern,type=uuid"`
 	// Extension for PatternUUID
-	PatternUUIDExt *primitives.PrimitiveExtension `json:"_patternUUID,omitempty" fhir:"cardinality=0..1"`
+	PatternUUIDExt *primitives.PrimitiveExtension `json:"_patternUuid,omitempty" fhir:"cardinality=0..1"`
 	// Value must have at least these property values - Address option
 	PatternAddress *Address `json:"patternAddress,omitempty" fhir:"cardinality=0..1,summary,choice=pattern"`
 	// Value must have at least these property values - Age option
@@ -852,19 +1459,19 @@ type ElementDefinition struct {
 	// Extension for MinValueTime
 	MinValueTimeExt *primitives.PrimitiveExtension `json:"_minValueTime,omitempty" fhir:"cardinality=0..1"`
 	// Minimum Allowed Value (for some types) - decimal option
-	MinValueDecimal *float64 `json:"minValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=minValue"`
+	MinValueDecimal *primitives.Decimal `json:"minValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=minValue"`
 	// Extension for MinValueDecimal
 	MinValueDecimalExt *primitives.PrimitiveExtension `json:"_minValueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Minimum Allowed Value (for some types) - integer option
-	MinValueInteger *int `json:"minValueInteger,omitempty" fhir:"cardinality=0..1,summary,choice=minValue"`
+	MinValueInteger *int `json:"minValueInteger,omitempty" fhir:"cardinality=0..1,summary,choice=minValue,type=integer"`
 	// Extension for MinValueInteger
 	MinValueIntegerExt *primitives.PrimitiveExtension `json:"_minValueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Minimum Allowed Value (for some types) - positiveInt option
-	MinValuePositiveInt *int `json:"minValuePositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=minValue"`
+	MinValuePositiveInt *int `json:"minValuePositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=minValue,type=positiveInt"`
 	// Extension for MinValuePositiveInt
 	MinValuePositiveIntExt *primitives.PrimitiveExtension `json:"_minValuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Minimum Allowed Value (for some types) - unsignedInt option
-	MinValueUnsignedInt *uint `json:"minValueUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=minValue"`
+	MinValueUnsignedInt *uint `json:"minValueUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=minValue,type=unsignedInt"`
 	// Extension for MinValueUnsignedInt
 	MinValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_minValueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Minimum Allowed Value (for some types) - Quantity option
@@ -886,29 +1493,29 @@ type ElementDefinition struct {
 	// Extension for MaxValueTime
 	MaxValueTimeExt *primitives.PrimitiveExtension `json:"_maxValueTime,omitempty" fhir:"cardinality=0..1"`
 	// Maximum Allowed Value (for some types) - decimal option
-	MaxValueDecimal *float64 `json:"maxValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue"`
+	MaxValueDecimal *primitives.Decimal `json:"maxValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue"`
 	// Extension for MaxValueDecimal
 	MaxValueDecimalExt *primitives.PrimitiveExtension `json:"_maxValueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Maximum Allowed Value (for some types) - integer option
-	MaxValueInteger *int `json:"maxValueInteger,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue"`
+	MaxValueInteger *int `json:"maxValueInteger,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue,type=integer"`
 	// Extension for MaxValueInteger
 	MaxValueIntegerExt *primitives.PrimitiveExtension `json:"_maxValueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Maximum Allowed Value (for some types) - positiveInt option
-	MaxValuePositiveInt *int `json:"maxValuePositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue"`
+	MaxValuePositiveInt *int `json:"maxValuePositiveInt,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue,type=positiveInt"`
 	// Extension for MaxValuePositiveInt
 	MaxValuePositiveIntExt *primitives.PrimitiveExtension `json:"_maxValuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Maximum Allowed Value (for some types) - unsignedInt option
-	MaxValueUnsignedInt *uint `json:"maxValueUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue"`
+	MaxValueUnsignedInt *uint `json:"maxValueUnsignedInt,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue,type=unsignedInt"`
 	// Extension for MaxValueUnsignedInt
 	MaxValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_maxValueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Maximum Allowed Value (for some types) - Quantity option
 	MaxValueQuantity *Quantity `json:"maxValueQuantity,omitempty" fhir:"cardinality=0..1,summary,choice=maxValue"`
 	// Max length for strings
-	MaxLength *int `json:"maxLength,omitempty" fhir:"cardinality=0..1,summary"`
+	MaxLength *int `json:"maxLength,omitempty" fhir:"cardinality=0..1,summary,type=integer"`
 	// Extension for MaxLength
 	MaxLengthExt *primitives.PrimitiveExtension `json:"_maxLength,omitempty" fhir:"cardinality=0..1"`
 	// Reference to invariant about presence
-	Condition []string `json:"condition,omitempty" fhir:"cardinality=0..*,summary"`
+	Condition []string `json:"condition,omitempty" fhir:"cardinality=0..*,summary,type=id"`
 	// Extension for Condition
 	ConditionExt *primitives.PrimitiveExtension `json:"_condition,omitempty" fhir:"cardinality=0..1"`
 	// Condition that must evaluate to true
@@ -934,3 +1541,2092 @@ type ElementDefinition struct {
 	// Map element to another set of definitions
 	Mapping []ElementDefinitionMapping `json:"mapping,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// DefaultValue returns the option of defaultValue[x] that is set, or nil if none is.
+func (e *ElementDefinition) DefaultValue() any {
+	switch {
+	case e.DefaultValueBase64Binary != nil:
+		return e.DefaultValueBase64Binary
+	case e.DefaultValueBoolean != nil:
+		return e.DefaultValueBoolean
+	case e.DefaultValueCanonical != nil:
+		return e.DefaultValueCanonical
+	case e.DefaultValueCode != nil:
+		return e.DefaultValueCode
+	case e.DefaultValueDate != nil:
+		return e.DefaultValueDate
+	case e.DefaultValueDateTime != nil:
+		return e.DefaultValueDateTime
+	case e.DefaultValueDecimal != nil:
+		return e.DefaultValueDecimal
+	case e.DefaultValueID != nil:
+		return e.DefaultValueID
+	case e.DefaultValueInstant != nil:
+		return e.DefaultValueInstant
+	case e.DefaultValueInteger != nil:
+		return e.DefaultValueInteger
+	case e.DefaultValueMarkdown != nil:
+		return e.DefaultValueMarkdown
+	case e.DefaultValueOid != nil:
+		return e.DefaultValueOid
+	case e.DefaultValuePositiveInt != nil:
+		return e.DefaultValuePositiveInt
+	case e.DefaultValueString != nil:
+		return e.DefaultValueString
+	case e.DefaultValueTime != nil:
+		return e.DefaultValueTime
+	case e.DefaultValueUnsignedInt != nil:
+		return e.DefaultValueUnsignedInt
+	case e.DefaultValueURI != nil:
+		return e.DefaultValueURI
+	case e.DefaultValueURL != nil:
+		return e.DefaultValueURL
+	case e.DefaultValueUUID != nil:
+		return e.DefaultValueUUID
+	case e.DefaultValueAddress != nil:
+		return e.DefaultValueAddress
+	case e.DefaultValueAge != nil:
+		return e.DefaultValueAge
+	case e.DefaultValueAnnotation != nil:
+		return e.DefaultValueAnnotation
+	case e.DefaultValueAttachment != nil:
+		return e.DefaultValueAttachment
+	case e.DefaultValueCodeableConcept != nil:
+		return e.DefaultValueCodeableConcept
+	case e.DefaultValueCoding != nil:
+		return e.DefaultValueCoding
+	case e.DefaultValueContactPoint != nil:
+		return e.DefaultValueContactPoint
+	case e.DefaultValueCount != nil:
+		return e.DefaultValueCount
+	case e.DefaultValueDistance != nil:
+		return e.DefaultValueDistance
+	case e.DefaultValueDuration != nil:
+		return e.DefaultValueDuration
+	case e.DefaultValueHumanName != nil:
+		return e.DefaultValueHumanName
+	case e.DefaultValueIdentifier != nil:
+		return e.DefaultValueIdentifier
+	case e.DefaultValueMoney != nil:
+		return e.DefaultValueMoney
+	case e.DefaultValuePeriod != nil:
+		return e.DefaultValuePeriod
+	case e.DefaultValueQuantity != nil:
+		return e.DefaultValueQuantity
+	case e.DefaultValueRange != nil:
+		return e.DefaultValueRange
+	case e.DefaultValueRatio != nil:
+		return e.DefaultValueRatio
+	case e.DefaultValueReference != nil:
+		return e.DefaultValueReference
+	case e.DefaultValueSampledData != nil:
+		return e.DefaultValueSampledData
+	case e.DefaultValueSignature != nil:
+		return e.DefaultValueSignature
+	case e.DefaultValueTiming != nil:
+		return e.DefaultValueTiming
+	case e.DefaultValueContactDetail != nil:
+		return e.DefaultValueContactDetail
+	case e.DefaultValueContributor != nil:
+		return e.DefaultValueContributor
+	case e.DefaultValueDataRequirement != nil:
+		return e.DefaultValueDataRequirement
+	case e.DefaultValueExpression != nil:
+		return e.DefaultValueExpression
+	case e.DefaultValueParameterDefinition != nil:
+		return e.DefaultValueParameterDefinition
+	case e.DefaultValueRelatedArtifact != nil:
+		return e.DefaultValueRelatedArtifact
+	case e.DefaultValueTriggerDefinition != nil:
+		return e.DefaultValueTriggerDefinition
+	case e.DefaultValueUsageContext != nil:
+		return e.DefaultValueUsageContext
+	case e.DefaultValueDosage != nil:
+		return e.DefaultValueDosage
+	case e.DefaultValueMeta != nil:
+		return e.DefaultValueMeta
+	}
+	return nil
+}
+
+// DefaultValueType returns the FHIR type of the option of defaultValue[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (e *ElementDefinition) DefaultValueType() string {
+	switch {
+	case e.DefaultValueBase64Binary != nil:
+		return "base64Binary"
+	case e.DefaultValueBoolean != nil:
+		return "boolean"
+	case e.DefaultValueCanonical != nil:
+		return "canonical"
+	case e.DefaultValueCode != nil:
+		return "code"
+	case e.DefaultValueDate != nil:
+		return "date"
+	case e.DefaultValueDateTime != nil:
+		return "dateTime"
+	case e.DefaultValueDecimal != nil:
+		return "decimal"
+	case e.DefaultValueID != nil:
+		return "id"
+	case e.DefaultValueInstant != nil:
+		return "instant"
+	case e.DefaultValueInteger != nil:
+		return "integer"
+	case e.DefaultValueMarkdown != nil:
+		return "markdown"
+	case e.DefaultValueOid != nil:
+		return "oid"
+	case e.DefaultValuePositiveInt != nil:
+		return "positiveInt"
+	case e.DefaultValueString != nil:
+		return "string"
+	case e.DefaultValueTime != nil:
+		return "time"
+	case e.DefaultValueUnsignedInt != nil:
+		return "unsignedInt"
+	case e.DefaultValueURI != nil:
+		return "uri"
+	case e.DefaultValueURL != nil:
+		return "url"
+	case e.DefaultValueUUID != nil:
+		return "uuid"
+	case e.DefaultValueAddress != nil:
+		return "Address"
+	case e.DefaultValueAge != nil:
+		return "Age"
+	case e.DefaultValueAnnotation != nil:
+		return "Annotation"
+	case e.DefaultValueAttachment != nil:
+		return "Attachment"
+	case e.DefaultValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.DefaultValueCoding != nil:
+		return "Coding"
+	case e.DefaultValueContactPoint != nil:
+		return "ContactPoint"
+	case e.DefaultValueCount != nil:
+		return "Count"
+	case e.DefaultValueDistance != nil:
+		return "Distance"
+	case e.DefaultValueDuration != nil:
+		return "Duration"
+	case e.DefaultValueHumanName != nil:
+		return "HumanName"
+	case e.DefaultValueIdentifier != nil:
+		return "Identifier"
+	case e.DefaultValueMoney != nil:
+		return "Money"
+	case e.DefaultValuePeriod != nil:
+		return "Period"
+	case e.DefaultValueQuantity != nil:
+		return "Quantity"
+	case e.DefaultValueRange != nil:
+		return "Range"
+	case e.DefaultValueRatio != nil:
+		return "Ratio"
+	case e.DefaultValueReference != nil:
+		return "Reference"
+	case e.DefaultValueSampledData != nil:
+		return "SampledData"
+	case e.DefaultValueSignature != nil:
+		return "Signature"
+	case e.DefaultValueTiming != nil:
+		return "Timing"
+	case e.DefaultValueContactDetail != nil:
+		return "ContactDetail"
+	case e.DefaultValueContributor != nil:
+		return "Contributor"
+	case e.DefaultValueDataRequirement != nil:
+		return "DataRequirement"
+	case e.DefaultValueExpression != nil:
+		return "Expression"
+	case e.DefaultValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case e.DefaultValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case e.DefaultValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case e.DefaultValueUsageContext != nil:
+		return "UsageContext"
+	case e.DefaultValueDosage != nil:
+		return "Dosage"
+	case e.DefaultValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearDefaultValue unsets every option of defaultValue[x], and their extensions.
+func (e *ElementDefinition) ClearDefaultValue() {
+	e.DefaultValueBase64Binary = nil
+	e.DefaultValueBase64BinaryExt = nil
+	e.DefaultValueBoolean = nil
+	e.DefaultValueBooleanExt = nil
+	e.DefaultValueCanonical = nil
+	e.DefaultValueCanonicalExt = nil
+	e.DefaultValueCode = nil
+	e.DefaultValueCodeExt = nil
+	e.DefaultValueDate = nil
+	e.DefaultValueDateExt = nil
+	e.DefaultValueDateTime = nil
+	e.DefaultValueDateTimeExt = nil
+	e.DefaultValueDecimal = nil
+	e.DefaultValueDecimalExt = nil
+	e.DefaultValueID = nil
+	e.DefaultValueIDExt = nil
+	e.DefaultValueInstant = nil
+	e.DefaultValueInstantExt = nil
+	e.DefaultValueInteger = nil
+	e.DefaultValueIntegerExt = nil
+	e.DefaultValueMarkdown = nil
+	e.DefaultValueMarkdownExt = nil
+	e.DefaultValueOid = nil
+	e.DefaultValueOidExt = nil
+	e.DefaultValuePositiveInt = nil
+	e.DefaultValuePositiveIntExt = nil
+	e.DefaultValueString = nil
+	e.DefaultValueStringExt = nil
+	e.DefaultValueTime = nil
+	e.DefaultValueTimeExt = nil
+	e.DefaultValueUnsignedInt = nil
+	e.DefaultValueUnsignedIntExt = nil
+	e.DefaultValueURI = nil
+	e.DefaultValueURIExt = nil
+	e.DefaultValueURL = nil
+	e.DefaultValueURLExt = nil
+	e.DefaultValueUUID = nil
+	e.DefaultValueUUIDExt = nil
+	e.DefaultValueAddress = nil
+	e.DefaultValueAge = nil
+	e.DefaultValueAnnotation = nil
+	e.DefaultValueAttachment = nil
+	e.DefaultValueCodeableConcept = nil
+	e.DefaultValueCoding = nil
+	e.DefaultValueContactPoint = nil
+	e.DefaultValueCount = nil
+	e.DefaultValueDistance = nil
+	e.DefaultValueDuration = nil
+	e.DefaultValueHumanName = nil
+	e.DefaultValueIdentifier = nil
+	e.DefaultValueMoney = nil
+	e.DefaultValuePeriod = nil
+	e.DefaultValueQuantity = nil
+	e.DefaultValueRange = nil
+	e.DefaultValueRatio = nil
+	e.DefaultValueReference = nil
+	e.DefaultValueSampledData = nil
+	e.DefaultValueSignature = nil
+	e.DefaultValueTiming = nil
+	e.DefaultValueContactDetail = nil
+	e.DefaultValueContributor = nil
+	e.DefaultValueDataRequirement = nil
+	e.DefaultValueExpression = nil
+	e.DefaultValueParameterDefinition = nil
+	e.DefaultValueRelatedArtifact = nil
+	e.DefaultValueTriggerDefinition = nil
+	e.DefaultValueUsageContext = nil
+	e.DefaultValueDosage = nil
+	e.DefaultValueMeta = nil
+}
+
+// SetDefaultValueBase64Binary sets the base64Binary option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueBase64Binary(value primitives.Base64Binary) {
+	ext := e.DefaultValueBase64BinaryExt
+	e.ClearDefaultValue()
+	e.DefaultValueBase64Binary, e.DefaultValueBase64BinaryExt = &value, ext
+}
+
+// SetDefaultValueBoolean sets the boolean option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueBoolean(value bool) {
+	ext := e.DefaultValueBooleanExt
+	e.ClearDefaultValue()
+	e.DefaultValueBoolean, e.DefaultValueBooleanExt = &value, ext
+}
+
+// SetDefaultValueCanonical sets the canonical option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueCanonical(value primitives.Canonical) {
+	ext := e.DefaultValueCanonicalExt
+	e.ClearDefaultValue()
+	e.DefaultValueCanonical, e.DefaultValueCanonicalExt = &value, ext
+}
+
+// SetDefaultValueCode sets the code option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueCode(value string) {
+	ext := e.DefaultValueCodeExt
+	e.ClearDefaultValue()
+	e.DefaultValueCode, e.DefaultValueCodeExt = &value, ext
+}
+
+// SetDefaultValueDate sets the date option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDate(value primitives.Date) {
+	ext := e.DefaultValueDateExt
+	e.ClearDefaultValue()
+	e.DefaultValueDate, e.DefaultValueDateExt = &value, ext
+}
+
+// SetDefaultValueDateTime sets the dateTime option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDateTime(value primitives.DateTime) {
+	ext := e.DefaultValueDateTimeExt
+	e.ClearDefaultValue()
+	e.DefaultValueDateTime, e.DefaultValueDateTimeExt = &value, ext
+}
+
+// SetDefaultValueDecimal sets the decimal option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDecimal(value primitives.Decimal) {
+	ext := e.DefaultValueDecimalExt
+	e.ClearDefaultValue()
+	e.DefaultValueDecimal, e.DefaultValueDecimalExt = &value, ext
+}
+
+// SetDefaultValueID sets the id option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueID(value string) {
+	ext := e.DefaultValueIDExt
+	e.ClearDefaultValue()
+	e.DefaultValueID, e.DefaultValueIDExt = &value, ext
+}
+
+// SetDefaultValueInstant sets the instant option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueInstant(value primitives.Instant) {
+	ext := e.DefaultValueInstantExt
+	e.ClearDefaultValue()
+	e.DefaultValueInstant, e.DefaultValueInstantExt = &value, ext
+}
+
+// SetDefaultValueInteger sets the integer option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueInteger(value int) {
+	ext := e.DefaultValueIntegerExt
+	e.ClearDefaultValue()
+	e.DefaultValueInteger, e.DefaultValueIntegerExt = &value, ext
+}
+
+// SetDefaultValueMarkdown sets the markdown option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueMarkdown(value string) {
+	ext := e.DefaultValueMarkdownExt
+	e.ClearDefaultValue()
+	e.DefaultValueMarkdown, e.DefaultValueMarkdownExt = &value, ext
+}
+
+// SetDefaultValueOid sets the oid option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueOid(value string) {
+	ext := e.DefaultValueOidExt
+	e.ClearDefaultValue()
+	e.DefaultValueOid, e.DefaultValueOidExt = &value, ext
+}
+
+// SetDefaultValuePositiveInt sets the positiveInt option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValuePositiveInt(value int) {
+	ext := e.DefaultValuePositiveIntExt
+	e.ClearDefaultValue()
+	e.DefaultValuePositiveInt, e.DefaultValuePositiveIntExt = &value, ext
+}
+
+// SetDefaultValueString sets the string option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueString(value string) {
+	ext := e.DefaultValueStringExt
+	e.ClearDefaultValue()
+	e.DefaultValueString, e.DefaultValueStringExt = &value, ext
+}
+
+// SetDefaultValueTime sets the time option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueTime(value primitives.Time) {
+	ext := e.DefaultValueTimeExt
+	e.ClearDefaultValue()
+	e.DefaultValueTime, e.DefaultValueTimeExt = &value, ext
+}
+
+// SetDefaultValueUnsignedInt sets the unsignedInt option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueUnsignedInt(value uint) {
+	ext := e.DefaultValueUnsignedIntExt
+	e.ClearDefaultValue()
+	e.DefaultValueUnsignedInt, e.DefaultValueUnsignedIntExt = &value, ext
+}
+
+// SetDefaultValueURI sets the uri option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueURI(value string) {
+	ext := e.DefaultValueURIExt
+	e.ClearDefaultValue()
+	e.DefaultValueURI, e.DefaultValueURIExt = &value, ext
+}
+
+// SetDefaultValueURL sets the url option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueURL(value string) {
+	ext := e.DefaultValueURLExt
+	e.ClearDefaultValue()
+	e.DefaultValueURL, e.DefaultValueURLExt = &value, ext
+}
+
+// SetDefaultValueUUID sets the uuid option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueUUID(value string) {
+	ext := e.DefaultValueUUIDExt
+	e.ClearDefaultValue()
+	e.DefaultValueUUID, e.DefaultValueUUIDExt = &value, ext
+}
+
+// SetDefaultValueAddress sets the Address option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueAddress(value Address) {
+	e.ClearDefaultValue()
+	e.DefaultValueAddress = &value
+}
+
+// SetDefaultValueAge sets the Age option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueAge(value Age) {
+	e.ClearDefaultValue()
+	e.DefaultValueAge = &value
+}
+
+// SetDefaultValueAnnotation sets the Annotation option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueAnnotation(value Annotation) {
+	e.ClearDefaultValue()
+	e.DefaultValueAnnotation = &value
+}
+
+// SetDefaultValueAttachment sets the Attachment option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueAttachment(value Attachment) {
+	e.ClearDefaultValue()
+	e.DefaultValueAttachment = &value
+}
+
+// SetDefaultValueCodeableConcept sets the CodeableConcept option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueCodeableConcept(value CodeableConcept) {
+	e.ClearDefaultValue()
+	e.DefaultValueCodeableConcept = &value
+}
+
+// SetDefaultValueCoding sets the Coding option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueCoding(value Coding) {
+	e.ClearDefaultValue()
+	e.DefaultValueCoding = &value
+}
+
+// SetDefaultValueContactPoint sets the ContactPoint option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueContactPoint(value ContactPoint) {
+	e.ClearDefaultValue()
+	e.DefaultValueContactPoint = &value
+}
+
+// SetDefaultValueCount sets the Count option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueCount(value Count) {
+	e.ClearDefaultValue()
+	e.DefaultValueCount = &value
+}
+
+// SetDefaultValueDistance sets the Distance option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDistance(value Distance) {
+	e.ClearDefaultValue()
+	e.DefaultValueDistance = &value
+}
+
+// SetDefaultValueDuration sets the Duration option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDuration(value Duration) {
+	e.ClearDefaultValue()
+	e.DefaultValueDuration = &value
+}
+
+// SetDefaultValueHumanName sets the HumanName option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueHumanName(value HumanName) {
+	e.ClearDefaultValue()
+	e.DefaultValueHumanName = &value
+}
+
+// SetDefaultValueIdentifier sets the Identifier option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueIdentifier(value Identifier) {
+	e.ClearDefaultValue()
+	e.DefaultValueIdentifier = &value
+}
+
+// SetDefaultValueMoney sets the Money option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueMoney(value Money) {
+	e.ClearDefaultValue()
+	e.DefaultValueMoney = &value
+}
+
+// SetDefaultValuePeriod sets the Period option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValuePeriod(value Period) {
+	e.ClearDefaultValue()
+	e.DefaultValuePeriod = &value
+}
+
+// SetDefaultValueQuantity sets the Quantity option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueQuantity(value Quantity) {
+	e.ClearDefaultValue()
+	e.DefaultValueQuantity = &value
+}
+
+// SetDefaultValueRange sets the Range option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueRange(value Range) {
+	e.ClearDefaultValue()
+	e.DefaultValueRange = &value
+}
+
+// SetDefaultValueRatio sets the Ratio option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueRatio(value Ratio) {
+	e.ClearDefaultValue()
+	e.DefaultValueRatio = &value
+}
+
+// SetDefaultValueReference sets the Reference option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueReference(value Reference) {
+	e.ClearDefaultValue()
+	e.DefaultValueReference = &value
+}
+
+// SetDefaultValueSampledData sets the SampledData option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueSampledData(value SampledData) {
+	e.ClearDefaultValue()
+	e.DefaultValueSampledData = &value
+}
+
+// SetDefaultValueSignature sets the Signature option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueSignature(value Signature) {
+	e.ClearDefaultValue()
+	e.DefaultValueSignature = &value
+}
+
+// SetDefaultValueTiming sets the Timing option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueTiming(value Timing) {
+	e.ClearDefaultValue()
+	e.DefaultValueTiming = &value
+}
+
+// SetDefaultValueContactDetail sets the ContactDetail option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueContactDetail(value ContactDetail) {
+	e.ClearDefaultValue()
+	e.DefaultValueContactDetail = &value
+}
+
+// SetDefaultValueContributor sets the Contributor option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueContributor(value Contributor) {
+	e.ClearDefaultValue()
+	e.DefaultValueContributor = &value
+}
+
+// SetDefaultValueDataRequirement sets the DataRequirement option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDataRequirement(value DataRequirement) {
+	e.ClearDefaultValue()
+	e.DefaultValueDataRequirement = &value
+}
+
+// SetDefaultValueExpression sets the Expression option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueExpression(value Expression) {
+	e.ClearDefaultValue()
+	e.DefaultValueExpression = &value
+}
+
+// SetDefaultValueParameterDefinition sets the ParameterDefinition option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueParameterDefinition(value ParameterDefinition) {
+	e.ClearDefaultValue()
+	e.DefaultValueParameterDefinition = &value
+}
+
+// SetDefaultValueRelatedArtifact sets the RelatedArtifact option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueRelatedArtifact(value RelatedArtifact) {
+	e.ClearDefaultValue()
+	e.DefaultValueRelatedArtifact = &value
+}
+
+// SetDefaultValueTriggerDefinition sets the TriggerDefinition option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueTriggerDefinition(value TriggerDefinition) {
+	e.ClearDefaultValue()
+	e.DefaultValueTriggerDefinition = &value
+}
+
+// SetDefaultValueUsageContext sets the UsageContext option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueUsageContext(value UsageContext) {
+	e.ClearDefaultValue()
+	e.DefaultValueUsageContext = &value
+}
+
+// SetDefaultValueDosage sets the Dosage option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueDosage(value Dosage) {
+	e.ClearDefaultValue()
+	e.DefaultValueDosage = &value
+}
+
+// SetDefaultValueMeta sets the Meta option of defaultValue[x], clearing the others.
+func (e *ElementDefinition) SetDefaultValueMeta(value Meta) {
+	e.ClearDefaultValue()
+	e.DefaultValueMeta = &value
+}
+
+// Fixed returns the option of fixed[x] that is set, or nil if none is.
+func (e *ElementDefinition) Fixed() any {
+	switch {
+	case e.FixedBase64Binary != nil:
+		return e.FixedBase64Binary
+	case e.FixedBoolean != nil:
+		return e.FixedBoolean
+	case e.FixedCanonical != nil:
+		return e.FixedCanonical
+	case e.FixedCode != nil:
+		return e.FixedCode
+	case e.FixedDate != nil:
+		return e.FixedDate
+	case e.FixedDateTime != nil:
+		return e.FixedDateTime
+	case e.FixedDecimal != nil:
+		return e.FixedDecimal
+	case e.FixedID != nil:
+		return e.FixedID
+	case e.FixedInstant != nil:
+		return e.FixedInstant
+	case e.FixedInteger != nil:
+		return e.FixedInteger
+	case e.FixedMarkdown != nil:
+		return e.FixedMarkdown
+	case e.FixedOid != nil:
+		return e.FixedOid
+	case e.FixedPositiveInt != nil:
+		return e.FixedPositiveInt
+	case e.FixedString != nil:
+		return e.FixedString
+	case e.FixedTime != nil:
+		return e.FixedTime
+	case e.FixedUnsignedInt != nil:
+		return e.FixedUnsignedInt
+	case e.FixedURI != nil:
+		return e.FixedURI
+	case e.FixedURL != nil:
+		return e.FixedURL
+	case e.FixedUUID != nil:
+		return e.FixedUUID
+	case e.FixedAddress != nil:
+		return e.FixedAddress
+	case e.FixedAge != nil:
+		return e.FixedAge
+	case e.FixedAnnotation != nil:
+		return e.FixedAnnotation
+	case e.FixedAttachment != nil:
+		return e.FixedAttachment
+	case e.FixedCodeableConcept != nil:
+		return e.FixedCodeableConcept
+	case e.FixedCoding != nil:
+		return e.FixedCoding
+	case e.FixedContactPoint != nil:
+		return e.FixedContactPoint
+	case e.FixedCount != nil:
+		return e.FixedCount
+	case e.FixedDistance != nil:
+		return e.FixedDistance
+	case e.FixedDuration != nil:
+		return e.FixedDuration
+	case e.FixedHumanName != nil:
+		return e.FixedHumanName
+	case e.FixedIdentifier != nil:
+		return e.FixedIdentifier
+	case e.FixedMoney != nil:
+		return e.FixedMoney
+	case e.FixedPeriod != nil:
+		return e.FixedPeriod
+	case e.FixedQuantity != nil:
+		return e.FixedQuantity
+	case e.FixedRange != nil:
+		return e.FixedRange
+	case e.FixedRatio != nil:
+		return e.FixedRatio
+	case e.FixedReference != nil:
+		return e.FixedReference
+	case e.FixedSampledData != nil:
+		return e.FixedSampledData
+	case e.FixedSignature != nil:
+		return e.FixedSignature
+	case e.FixedTiming != nil:
+		return e.FixedTiming
+	case e.FixedContactDetail != nil:
+		return e.FixedContactDetail
+	case e.FixedContributor != nil:
+		return e.FixedContributor
+	case e.FixedDataRequirement != nil:
+		return e.FixedDataRequirement
+	case e.FixedExpression != nil:
+		return e.FixedExpression
+	case e.FixedParameterDefinition != nil:
+		return e.FixedParameterDefinition
+	case e.FixedRelatedArtifact != nil:
+		return e.FixedRelatedArtifact
+	case e.FixedTriggerDefinition != nil:
+		return e.FixedTriggerDefinition
+	case e.FixedUsageContext != nil:
+		return e.FixedUsageContext
+	case e.FixedDosage != nil:
+		return e.FixedDosage
+	case e.FixedMeta != nil:
+		return e.FixedMeta
+	}
+	return nil
+}
+
+// FixedType returns the FHIR type of the option of fixed[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (e *ElementDefinition) FixedType() string {
+	switch {
+	case e.FixedBase64Binary != nil:
+		return "base64Binary"
+	case e.FixedBoolean != nil:
+		return "boolean"
+	case e.FixedCanonical != nil:
+		return "canonical"
+	case e.FixedCode != nil:
+		return "code"
+	case e.FixedDate != nil:
+		return "date"
+	case e.FixedDateTime != nil:
+		return "dateTime"
+	case e.FixedDecimal != nil:
+		return "decimal"
+	case e.FixedID != nil:
+		return "id"
+	case e.FixedInstant != nil:
+		return "instant"
+	case e.FixedInteger != nil:
+		return "integer"
+	case e.FixedMarkdown != nil:
+		return "markdown"
+	case e.FixedOid != nil:
+		return "oid"
+	case e.FixedPositiveInt != nil:
+		return "positiveInt"
+	case e.FixedString != nil:
+		return "string"
+	case e.FixedTime != nil:
+		return "time"
+	case e.FixedUnsignedInt != nil:
+		return "unsignedInt"
+	case e.FixedURI != nil:
+		return "uri"
+	case e.FixedURL != nil:
+		return "url"
+	case e.FixedUUID != nil:
+		return "uuid"
+	case e.FixedAddress != nil:
+		return "Address"
+	case e.FixedAge != nil:
+		return "Age"
+	case e.FixedAnnotation != nil:
+		return "Annotation"
+	case e.FixedAttachment != nil:
+		return "Attachment"
+	case e.FixedCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.FixedCoding != nil:
+		return "Coding"
+	case e.FixedContactPoint != nil:
+		return "ContactPoint"
+	case e.FixedCount != nil:
+		return "Count"
+	case e.FixedDistance != nil:
+		return "Distance"
+	case e.FixedDuration != nil:
+		return "Duration"
+	case e.FixedHumanName != nil:
+		return "HumanName"
+	case e.FixedIdentifier != nil:
+		return "Identifier"
+	case e.FixedMoney != nil:
+		return "Money"
+	case e.FixedPeriod != nil:
+		return "Period"
+	case e.FixedQuantity != nil:
+		return "Quantity"
+	case e.FixedRange != nil:
+		return "Range"
+	case e.FixedRatio != nil:
+		return "Ratio"
+	case e.FixedReference != nil:
+		return "Reference"
+	case e.FixedSampledData != nil:
+		return "SampledData"
+	case e.FixedSignature != nil:
+		return "Signature"
+	case e.FixedTiming != nil:
+		return "Timing"
+	case e.FixedContactDetail != nil:
+		return "ContactDetail"
+	case e.FixedContributor != nil:
+		return "Contributor"
+	case e.FixedDataRequirement != nil:
+		return "DataRequirement"
+	case e.FixedExpression != nil:
+		return "Expression"
+	case e.FixedParameterDefinition != nil:
+		return "ParameterDefinition"
+	case e.FixedRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case e.FixedTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case e.FixedUsageContext != nil:
+		return "UsageContext"
+	case e.FixedDosage != nil:
+		return "Dosage"
+	case e.FixedMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearFixed unsets every option of fixed[x], and their extensions.
+func (e *ElementDefinition) ClearFixed() {
+	e.FixedBase64Binary = nil
+	e.FixedBase64BinaryExt = nil
+	e.FixedBoolean = nil
+	e.FixedBooleanExt = nil
+	e.FixedCanonical = nil
+	e.FixedCanonicalExt = nil
+	e.FixedCode = nil
+	e.FixedCodeExt = nil
+	e.FixedDate = nil
+	e.FixedDateExt = nil
+	e.FixedDateTime = nil
+	e.FixedDateTimeExt = nil
+	e.FixedDecimal = nil
+	e.FixedDecimalExt = nil
+	e.FixedID = nil
+	e.FixedIDExt = nil
+	e.FixedInstant = nil
+	e.FixedInstantExt = nil
+	e.FixedInteger = nil
+	e.FixedIntegerExt = nil
+	e.FixedMarkdown = nil
+	e.FixedMarkdownExt = nil
+	e.FixedOid = nil
+	e.FixedOidExt = nil
+	e.FixedPositiveInt = nil
+	e.FixedPositiveIntExt = nil
+	e.FixedString = nil
+	e.FixedStringExt = nil
+	e.FixedTime = nil
+	e.FixedTimeExt = nil
+	e.FixedUnsignedInt = nil
+	e.FixedUnsignedIntExt = nil
+	e.FixedURI = nil
+	e.FixedURIExt = nil
+	e.FixedURL = nil
+	e.FixedURLExt = nil
+	e.FixedUUID = nil
+	e.FixedUUIDExt = nil
+	e.FixedAddress = nil
+	e.FixedAge = nil
+	e.FixedAnnotation = nil
+	e.FixedAttachment = nil
+	e.FixedCodeableConcept = nil
+	e.FixedCoding = nil
+	e.FixedContactPoint = nil
+	e.FixedCount = nil
+	e.FixedDistance = nil
+	e.FixedDuration = nil
+	e.FixedHumanName = nil
+	e.FixedIdentifier = nil
+	e.FixedMoney = nil
+	e.FixedPeriod = nil
+	e.FixedQuantity = nil
+	e.FixedRange = nil
+	e.FixedRatio = nil
+	e.FixedReference = nil
+	e.FixedSampledData = nil
+	e.FixedSignature = nil
+	e.FixedTiming = nil
+	e.FixedContactDetail = nil
+	e.FixedContributor = nil
+	e.FixedDataRequirement = nil
+	e.FixedExpression = nil
+	e.FixedParameterDefinition = nil
+	e.FixedRelatedArtifact = nil
+	e.FixedTriggerDefinition = nil
+	e.FixedUsageContext = nil
+	e.FixedDosage = nil
+	e.FixedMeta = nil
+}
+
+// SetFixedBase64Binary sets the base64Binary option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedBase64Binary(value primitives.Base64Binary) {
+	ext := e.FixedBase64BinaryExt
+	e.ClearFixed()
+	e.FixedBase64Binary, e.FixedBase64BinaryExt = &value, ext
+}
+
+// SetFixedBoolean sets the boolean option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedBoolean(value bool) {
+	ext := e.FixedBooleanExt
+	e.ClearFixed()
+	e.FixedBoolean, e.FixedBooleanExt = &value, ext
+}
+
+// SetFixedCanonical sets the canonical option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedCanonical(value primitives.Canonical) {
+	ext := e.FixedCanonicalExt
+	e.ClearFixed()
+	e.FixedCanonical, e.FixedCanonicalExt = &value, ext
+}
+
+// SetFixedCode sets the code option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedCode(value string) {
+	ext := e.FixedCodeExt
+	e.ClearFixed()
+	e.FixedCode, e.FixedCodeExt = &value, ext
+}
+
+// SetFixedDate sets the date option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDate(value primitives.Date) {
+	ext := e.FixedDateExt
+	e.ClearFixed()
+	e.FixedDate, e.FixedDateExt = &value, ext
+}
+
+// SetFixedDateTime sets the dateTime option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDateTime(value primitives.DateTime) {
+	ext := e.FixedDateTimeExt
+	e.ClearFixed()
+	e.FixedDateTime, e.FixedDateTimeExt = &value, ext
+}
+
+// SetFixedDecimal sets the decimal option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDecimal(value primitives.Decimal) {
+	ext := e.FixedDecimalExt
+	e.ClearFixed()
+	e.FixedDecimal, e.FixedDecimalExt = &value, ext
+}
+
+// SetFixedID sets the id option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedID(value string) {
+	ext := e.FixedIDExt
+	e.ClearFixed()
+	e.FixedID, e.FixedIDExt = &value, ext
+}
+
+// SetFixedInstant sets the instant option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedInstant(value primitives.Instant) {
+	ext := e.FixedInstantExt
+	e.ClearFixed()
+	e.FixedInstant, e.FixedInstantExt = &value, ext
+}
+
+// SetFixedInteger sets the integer option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedInteger(value int) {
+	ext := e.FixedIntegerExt
+	e.ClearFixed()
+	e.FixedInteger, e.FixedIntegerExt = &value, ext
+}
+
+// SetFixedMarkdown sets the markdown option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedMarkdown(value string) {
+	ext := e.FixedMarkdownExt
+	e.ClearFixed()
+	e.FixedMarkdown, e.FixedMarkdownExt = &value, ext
+}
+
+// SetFixedOid sets the oid option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedOid(value string) {
+	ext := e.FixedOidExt
+	e.ClearFixed()
+	e.FixedOid, e.FixedOidExt = &value, ext
+}
+
+// SetFixedPositiveInt sets the positiveInt option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedPositiveInt(value int) {
+	ext := e.FixedPositiveIntExt
+	e.ClearFixed()
+	e.FixedPositiveInt, e.FixedPositiveIntExt = &value, ext
+}
+
+// SetFixedString sets the string option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedString(value string) {
+	ext := e.FixedStringExt
+	e.ClearFixed()
+	e.FixedString, e.FixedStringExt = &value, ext
+}
+
+// SetFixedTime sets the time option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedTime(value primitives.Time) {
+	ext := e.FixedTimeExt
+	e.ClearFixed()
+	e.FixedTime, e.FixedTimeExt = &value, ext
+}
+
+// SetFixedUnsignedInt sets the unsignedInt option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedUnsignedInt(value uint) {
+	ext := e.FixedUnsignedIntExt
+	e.ClearFixed()
+	e.FixedUnsignedInt, e.FixedUnsignedIntExt = &value, ext
+}
+
+// SetFixedURI sets the uri option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedURI(value string) {
+	ext := e.FixedURIExt
+	e.ClearFixed()
+	e.FixedURI, e.FixedURIExt = &value, ext
+}
+
+// SetFixedURL sets the url option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedURL(value string) {
+	ext := e.FixedURLExt
+	e.ClearFixed()
+	e.FixedURL, e.FixedURLExt = &value, ext
+}
+
+// SetFixedUUID sets the uuid option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedUUID(value string) {
+	ext := e.FixedUUIDExt
+	e.ClearFixed()
+	e.FixedUUID, e.FixedUUIDExt = &value, ext
+}
+
+// SetFixedAddress sets the Address option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedAddress(value Address) {
+	e.ClearFixed()
+	e.FixedAddress = &value
+}
+
+// SetFixedAge sets the Age option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedAge(value Age) {
+	e.ClearFixed()
+	e.FixedAge = &value
+}
+
+// SetFixedAnnotation sets the Annotation option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedAnnotation(value Annotation) {
+	e.ClearFixed()
+	e.FixedAnnotation = &value
+}
+
+// SetFixedAttachment sets the Attachment option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedAttachment(value Attachment) {
+	e.ClearFixed()
+	e.FixedAttachment = &value
+}
+
+// SetFixedCodeableConcept sets the CodeableConcept option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedCodeableConcept(value CodeableConcept) {
+	e.ClearFixed()
+	e.FixedCodeableConcept = &value
+}
+
+// SetFixedCoding sets the Coding option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedCoding(value Coding) {
+	e.ClearFixed()
+	e.FixedCoding = &value
+}
+
+// SetFixedContactPoint sets the ContactPoint option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedContactPoint(value ContactPoint) {
+	e.ClearFixed()
+	e.FixedContactPoint = &value
+}
+
+// SetFixedCount sets the Count option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedCount(value Count) {
+	e.ClearFixed()
+	e.FixedCount = &value
+}
+
+// SetFixedDistance sets the Distance option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDistance(value Distance) {
+	e.ClearFixed()
+	e.FixedDistance = &value
+}
+
+// SetFixedDuration sets the Duration option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDuration(value Duration) {
+	e.ClearFixed()
+	e.FixedDuration = &value
+}
+
+// SetFixedHumanName sets the HumanName option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedHumanName(value HumanName) {
+	e.ClearFixed()
+	e.FixedHumanName = &value
+}
+
+// SetFixedIdentifier sets the Identifier option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedIdentifier(value Identifier) {
+	e.ClearFixed()
+	e.FixedIdentifier = &value
+}
+
+// SetFixedMoney sets the Money option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedMoney(value Money) {
+	e.ClearFixed()
+	e.FixedMoney = &value
+}
+
+// SetFixedPeriod sets the Period option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedPeriod(value Period) {
+	e.ClearFixed()
+	e.FixedPeriod = &value
+}
+
+// SetFixedQuantity sets the Quantity option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedQuantity(value Quantity) {
+	e.ClearFixed()
+	e.FixedQuantity = &value
+}
+
+// SetFixedRange sets the Range option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedRange(value Range) {
+	e.ClearFixed()
+	e.FixedRange = &value
+}
+
+// SetFixedRatio sets the Ratio option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedRatio(value Ratio) {
+	e.ClearFixed()
+	e.FixedRatio = &value
+}
+
+// SetFixedReference sets the Reference option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedReference(value Reference) {
+	e.ClearFixed()
+	e.FixedReference = &value
+}
+
+// SetFixedSampledData sets the SampledData option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedSampledData(value SampledData) {
+	e.ClearFixed()
+	e.FixedSampledData = &value
+}
+
+// SetFixedSignature sets the Signature option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedSignature(value Signature) {
+	e.ClearFixed()
+	e.FixedSignature = &value
+}
+
+// SetFixedTiming sets the Timing option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedTiming(value Timing) {
+	e.ClearFixed()
+	e.FixedTiming = &value
+}
+
+// SetFixedContactDetail sets the ContactDetail option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedContactDetail(value ContactDetail) {
+	e.ClearFixed()
+	e.FixedContactDetail = &value
+}
+
+// SetFixedContributor sets the Contributor option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedContributor(value Contributor) {
+	e.ClearFixed()
+	e.FixedContributor = &value
+}
+
+// SetFixedDataRequirement sets the DataRequirement option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDataRequirement(value DataRequirement) {
+	e.ClearFixed()
+	e.FixedDataRequirement = &value
+}
+
+// SetFixedExpression sets the Expression option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedExpression(value Expression) {
+	e.ClearFixed()
+	e.FixedExpression = &value
+}
+
+// SetFixedParameterDefinition sets the ParameterDefinition option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedParameterDefinition(value ParameterDefinition) {
+	e.ClearFixed()
+	e.FixedParameterDefinition = &value
+}
+
+// SetFixedRelatedArtifact sets the RelatedArtifact option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedRelatedArtifact(value RelatedArtifact) {
+	e.ClearFixed()
+	e.FixedRelatedArtifact = &value
+}
+
+// SetFixedTriggerDefinition sets the TriggerDefinition option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedTriggerDefinition(value TriggerDefinition) {
+	e.ClearFixed()
+	e.FixedTriggerDefinition = &value
+}
+
+// SetFixedUsageContext sets the UsageContext option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedUsageContext(value UsageContext) {
+	e.ClearFixed()
+	e.FixedUsageContext = &value
+}
+
+// SetFixedDosage sets the Dosage option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedDosage(value Dosage) {
+	e.ClearFixed()
+	e.FixedDosage = &value
+}
+
+// SetFixedMeta sets the Meta option of fixed[x], clearing the others.
+func (e *ElementDefinition) SetFixedMeta(value Meta) {
+	e.ClearFixed()
+	e.FixedMeta = &value
+}
+
+// Pattern returns the option of pattern[x] that is set, or nil if none is.
+func (e *ElementDefinition) Pattern() any {
+	switch {
+	case e.PatternBase64Binary != nil:
+		return e.PatternBase64Binary
+	case e.PatternBoolean != nil:
+		return e.PatternBoolean
+	case e.PatternCanonical != nil:
+		return e.PatternCanonical
+	case e.PatternCode != nil:
+		return e.PatternCode
+	case e.PatternDate != nil:
+		return e.PatternDate
+	case e.PatternDateTime != nil:
+		return e.PatternDateTime
+	case e.PatternDecimal != nil:
+		return e.PatternDecimal
+	case e.PatternID != nil:
+		return e.PatternID
+	case e.PatternInstant != nil:
+		return e.PatternInstant
+	case e.PatternInteger != nil:
+		return e.PatternInteger
+	case e.PatternMarkdown != nil:
+		return e.PatternMarkdown
+	case e.PatternOid != nil:
+		return e.PatternOid
+	case e.PatternPositiveInt != nil:
+		return e.PatternPositiveInt
+	case e.PatternString != nil:
+		return e.PatternString
+	case e.PatternTime != nil:
+		return e.PatternTime
+	case e.PatternUnsignedInt != nil:
+		return e.PatternUnsignedInt
+	case e.PatternURI != nil:
+		return e.PatternURI
+	case e.PatternURL != nil:
+		return e.PatternURL
+	case e.PatternUUID != nil:
+		return e.PatternUUID
+	case e.PatternAddress != nil:
+		return e.PatternAddress
+	case e.PatternAge != nil:
+		return e.PatternAge
+	case e.PatternAnnotation != nil:
+		return e.PatternAnnotation
+	case e.PatternAttachment != nil:
+		return e.PatternAttachment
+	case e.PatternCodeableConcept != nil:
+		return e.PatternCodeableConcept
+	case e.PatternCoding != nil:
+		return e.PatternCoding
+	case e.PatternContactPoint != nil:
+		return e.PatternContactPoint
+	case e.PatternCount != nil:
+		return e.PatternCount
+	case e.PatternDistance != nil:
+		return e.PatternDistance
+	case e.PatternDuration != nil:
+		return e.PatternDuration
+	case e.PatternHumanName != nil:
+		return e.PatternHumanName
+	case e.PatternIdentifier != nil:
+		return e.PatternIdentifier
+	case e.PatternMoney != nil:
+		return e.PatternMoney
+	case e.PatternPeriod != nil:
+		return e.PatternPeriod
+	case e.PatternQuantity != nil:
+		return e.PatternQuantity
+	case e.PatternRange != nil:
+		return e.PatternRange
+	case e.PatternRatio != nil:
+		return e.PatternRatio
+	case e.PatternReference != nil:
+		return e.PatternReference
+	case e.PatternSampledData != nil:
+		return e.PatternSampledData
+	case e.PatternSignature != nil:
+		return e.PatternSignature
+	case e.PatternTiming != nil:
+		return e.PatternTiming
+	case e.PatternContactDetail != nil:
+		return e.PatternContactDetail
+	case e.PatternContributor != nil:
+		return e.PatternContributor
+	case e.PatternDataRequirement != nil:
+		return e.PatternDataRequirement
+	case e.PatternExpression != nil:
+		return e.PatternExpression
+	case e.PatternParameterDefinition != nil:
+		return e.PatternParameterDefinition
+	case e.PatternRelatedArtifact != nil:
+		return e.PatternRelatedArtifact
+	case e.PatternTriggerDefinition != nil:
+		return e.PatternTriggerDefinition
+	case e.PatternUsageContext != nil:
+		return e.PatternUsageContext
+	case e.PatternDosage != nil:
+		return e.PatternDosage
+	case e.PatternMeta != nil:
+		return e.PatternMeta
+	}
+	return nil
+}
+
+// PatternType returns the FHIR type of the option of pattern[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (e *ElementDefinition) PatternType() string {
+	switch {
+	case e.PatternBase64Binary != nil:
+		return "base64Binary"
+	case e.PatternBoolean != nil:
+		return "boolean"
+	case e.PatternCanonical != nil:
+		return "canonical"
+	case e.PatternCode != nil:
+		return "code"
+	case e.PatternDate != nil:
+		return "date"
+	case e.PatternDateTime != nil:
+		return "dateTime"
+	case e.PatternDecimal != nil:
+		return "decimal"
+	case e.PatternID != nil:
+		return "id"
+	case e.PatternInstant != nil:
+		return "instant"
+	case e.PatternInteger != nil:
+		return "integer"
+	case e.PatternMarkdown != nil:
+		return "markdown"
+	case e.PatternOid != nil:
+		return "oid"
+	case e.PatternPositiveInt != nil:
+		return "positiveInt"
+	case e.PatternString != nil:
+		return "string"
+	case e.PatternTime != nil:
+		return "time"
+	case e.PatternUnsignedInt != nil:
+		return "unsignedInt"
+	case e.PatternURI != nil:
+		return "uri"
+	case e.PatternURL != nil:
+		return "url"
+	case e.PatternUUID != nil:
+		return "uuid"
+	case e.PatternAddress != nil:
+		return "Address"
+	case e.PatternAge != nil:
+		return "Age"
+	case e.PatternAnnotation != nil:
+		return "Annotation"
+	case e.PatternAttachment != nil:
+		return "Attachment"
+	case e.PatternCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.PatternCoding != nil:
+		return "Coding"
+	case e.PatternContactPoint != nil:
+		return "ContactPoint"
+	case e.PatternCount != nil:
+		return "Count"
+	case e.PatternDistance != nil:
+		return "Distance"
+	case e.PatternDuration != nil:
+		return "Duration"
+	case e.PatternHumanName != nil:
+		return "HumanName"
+	case e.PatternIdentifier != nil:
+		return "Identifier"
+	case e.PatternMoney != nil:
+		return "Money"
+	case e.PatternPeriod != nil:
+		return "Period"
+	case e.PatternQuantity != nil:
+		return "Quantity"
+	case e.PatternRange != nil:
+		return "Range"
+	case e.PatternRatio != nil:
+		return "Ratio"
+	case e.PatternReference != nil:
+		return "Reference"
+	case e.PatternSampledData != nil:
+		return "SampledData"
+	case e.PatternSignature != nil:
+		return "Signature"
+	case e.PatternTiming != nil:
+		return "Timing"
+	case e.PatternContactDetail != nil:
+		return "ContactDetail"
+	case e.PatternContributor != nil:
+		return "Contributor"
+	case e.PatternDataRequirement != nil:
+		return "DataRequirement"
+	case e.PatternExpression != nil:
+		return "Expression"
+	case e.PatternParameterDefinition != nil:
+		return "ParameterDefinition"
+	case e.PatternRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case e.PatternTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case e.PatternUsageContext != nil:
+		return "UsageContext"
+	case e.PatternDosage != nil:
+		return "Dosage"
+	case e.PatternMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearPattern unsets every option of pattern[x], and their extensions.
+func (e *ElementDefinition) ClearPattern() {
+	e.PatternBase64Binary = nil
+	e.PatternBase64BinaryExt = nil
+	e.PatternBoolean = nil
+	e.PatternBooleanExt = nil
+	e.PatternCanonical = nil
+	e.PatternCanonicalExt = nil
+	e.PatternCode = nil
+	e.PatternCodeExt = nil
+	e.PatternDate = nil
+	e.PatternDateExt = nil
+	e.PatternDateTime = nil
+	e.PatternDateTimeExt = nil
+	e.PatternDecimal = nil
+	e.PatternDecimalExt = nil
+	e.PatternID = nil
+	e.PatternIDExt = nil
+	e.PatternInstant = nil
+	e.PatternInstantExt = nil
+	e.PatternInteger = nil
+	e.PatternIntegerExt = nil
+	e.PatternMarkdown = nil
+	e.PatternMarkdownExt = nil
+	e.PatternOid = nil
+	e.PatternOidExt = nil
+	e.PatternPositiveInt = nil
+	e.PatternPositiveIntExt = nil
+	e.PatternString = nil
+	e.PatternStringExt = nil
+	e.PatternTime = nil
+	e.PatternTimeExt = nil
+	e.PatternUnsignedInt = nil
+	e.PatternUnsignedIntExt = nil
+	e.PatternURI = nil
+	e.PatternURIExt = nil
+	e.PatternURL = nil
+	e.PatternURLExt = nil
+	e.PatternUUID = nil
+	e.PatternUUIDExt = nil
+	e.PatternAddress = nil
+	e.PatternAge = nil
+	e.PatternAnnotation = nil
+	e.PatternAttachment = nil
+	e.PatternCodeableConcept = nil
+	e.PatternCoding = nil
+	e.PatternContactPoint = nil
+	e.PatternCount = nil
+	e.PatternDistance = nil
+	e.PatternDuration = nil
+	e.PatternHumanName = nil
+	e.PatternIdentifier = nil
+	e.PatternMoney = nil
+	e.PatternPeriod = nil
+	e.PatternQuantity = nil
+	e.PatternRange = nil
+	e.PatternRatio = nil
+	e.PatternReference = nil
+	e.PatternSampledData = nil
+	e.PatternSignature = nil
+	e.PatternTiming = nil
+	e.PatternContactDetail = nil
+	e.PatternContributor = nil
+	e.PatternDataRequirement = nil
+	e.PatternExpression = nil
+	e.PatternParameterDefinition = nil
+	e.PatternRelatedArtifact = nil
+	e.PatternTriggerDefinition = nil
+	e.PatternUsageContext = nil
+	e.PatternDosage = nil
+	e.PatternMeta = nil
+}
+
+// SetPatternBase64Binary sets the base64Binary option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternBase64Binary(value primitives.Base64Binary) {
+	ext := e.PatternBase64BinaryExt
+	e.ClearPattern()
+	e.PatternBase64Binary, e.PatternBase64BinaryExt = &value, ext
+}
+
+// SetPatternBoolean sets the boolean option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternBoolean(value bool) {
+	ext := e.PatternBooleanExt
+	e.ClearPattern()
+	e.PatternBoolean, e.PatternBooleanExt = &value, ext
+}
+
+// SetPatternCanonical sets the canonical option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternCanonical(value primitives.Canonical) {
+	ext := e.PatternCanonicalExt
+	e.ClearPattern()
+	e.PatternCanonical, e.PatternCanonicalExt = &value, ext
+}
+
+// SetPatternCode sets the code option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternCode(value string) {
+	ext := e.PatternCodeExt
+	e.ClearPattern()
+	e.PatternCode, e.PatternCodeExt = &value, ext
+}
+
+// SetPatternDate sets the date option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDate(value primitives.Date) {
+	ext := e.PatternDateExt
+	e.ClearPattern()
+	e.PatternDate, e.PatternDateExt = &value, ext
+}
+
+// SetPatternDateTime sets the dateTime option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDateTime(value primitives.DateTime) {
+	ext := e.PatternDateTimeExt
+	e.ClearPattern()
+	e.PatternDateTime, e.PatternDateTimeExt = &value, ext
+}
+
+// SetPatternDecimal sets the decimal option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDecimal(value primitives.Decimal) {
+	ext := e.PatternDecimalExt
+	e.ClearPattern()
+	e.PatternDecimal, e.PatternDecimalExt = &value, ext
+}
+
+// SetPatternID sets the id option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternID(value string) {
+	ext := e.PatternIDExt
+	e.ClearPattern()
+	e.PatternID, e.PatternIDExt = &value, ext
+}
+
+// SetPatternInstant sets the instant option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternInstant(value primitives.Instant) {
+	ext := e.PatternInstantExt
+	e.ClearPattern()
+	e.PatternInstant, e.PatternInstantExt = &value, ext
+}
+
+// SetPatternInteger sets the integer option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternInteger(value int) {
+	ext := e.PatternIntegerExt
+	e.ClearPattern()
+	e.PatternInteger, e.PatternIntegerExt = &value, ext
+}
+
+// SetPatternMarkdown sets the markdown option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternMarkdown(value string) {
+	ext := e.PatternMarkdownExt
+	e.ClearPattern()
+	e.PatternMarkdown, e.PatternMarkdownExt = &value, ext
+}
+
+// SetPatternOid sets the oid option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternOid(value string) {
+	ext := e.PatternOidExt
+	e.ClearPattern()
+	e.PatternOid, e.PatternOidExt = &value, ext
+}
+
+// SetPatternPositiveInt sets the positiveInt option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternPositiveInt(value int) {
+	ext := e.PatternPositiveIntExt
+	e.ClearPattern()
+	e.PatternPositiveInt, e.PatternPositiveIntExt = &value, ext
+}
+
+// SetPatternString sets the string option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternString(value string) {
+	ext := e.PatternStringExt
+	e.ClearPattern()
+	e.PatternString, e.PatternStringExt = &value, ext
+}
+
+// SetPatternTime sets the time option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternTime(value primitives.Time) {
+	ext := e.PatternTimeExt
+	e.ClearPattern()
+	e.PatternTime, e.PatternTimeExt = &value, ext
+}
+
+// SetPatternUnsignedInt sets the unsignedInt option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternUnsignedInt(value uint) {
+	ext := e.PatternUnsignedIntExt
+	e.ClearPattern()
+	e.PatternUnsignedInt, e.PatternUnsignedIntExt = &value, ext
+}
+
+// SetPatternURI sets the uri option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternURI(value string) {
+	ext := e.PatternURIExt
+	e.ClearPattern()
+	e.PatternURI, e.PatternURIExt = &value, ext
+}
+
+// SetPatternURL sets the url option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternURL(value string) {
+	ext := e.PatternURLExt
+	e.ClearPattern()
+	e.PatternURL, e.PatternURLExt = &value, ext
+}
+
+// SetPatternUUID sets the uuid option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternUUID(value string) {
+	ext := e.PatternUUIDExt
+	e.ClearPattern()
+	e.PatternUUID, e.PatternUUIDExt = &value, ext
+}
+
+// SetPatternAddress sets the Address option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternAddress(value Address) {
+	e.ClearPattern()
+	e.PatternAddress = &value
+}
+
+// SetPatternAge sets the Age option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternAge(value Age) {
+	e.ClearPattern()
+	e.PatternAge = &value
+}
+
+// SetPatternAnnotation sets the Annotation option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternAnnotation(value Annotation) {
+	e.ClearPattern()
+	e.PatternAnnotation = &value
+}
+
+// SetPatternAttachment sets the Attachment option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternAttachment(value Attachment) {
+	e.ClearPattern()
+	e.PatternAttachment = &value
+}
+
+// SetPatternCodeableConcept sets the CodeableConcept option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternCodeableConcept(value CodeableConcept) {
+	e.ClearPattern()
+	e.PatternCodeableConcept = &value
+}
+
+// SetPatternCoding sets the Coding option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternCoding(value Coding) {
+	e.ClearPattern()
+	e.PatternCoding = &value
+}
+
+// SetPatternContactPoint sets the ContactPoint option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternContactPoint(value ContactPoint) {
+	e.ClearPattern()
+	e.PatternContactPoint = &value
+}
+
+// SetPatternCount sets the Count option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternCount(value Count) {
+	e.ClearPattern()
+	e.PatternCount = &value
+}
+
+// SetPatternDistance sets the Distance option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDistance(value Distance) {
+	e.ClearPattern()
+	e.PatternDistance = &value
+}
+
+// SetPatternDuration sets the Duration option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDuration(value Duration) {
+	e.ClearPattern()
+	e.PatternDuration = &value
+}
+
+// SetPatternHumanName sets the HumanName option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternHumanName(value HumanName) {
+	e.ClearPattern()
+	e.PatternHumanName = &value
+}
+
+// SetPatternIdentifier sets the Identifier option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternIdentifier(value Identifier) {
+	e.ClearPattern()
+	e.PatternIdentifier = &value
+}
+
+// SetPatternMoney sets the Money option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternMoney(value Money) {
+	e.ClearPattern()
+	e.PatternMoney = &value
+}
+
+// SetPatternPeriod sets the Period option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternPeriod(value Period) {
+	e.ClearPattern()
+	e.PatternPeriod = &value
+}
+
+// SetPatternQuantity sets the Quantity option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternQuantity(value Quantity) {
+	e.ClearPattern()
+	e.PatternQuantity = &value
+}
+
+// SetPatternRange sets the Range option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternRange(value Range) {
+	e.ClearPattern()
+	e.PatternRange = &value
+}
+
+// SetPatternRatio sets the Ratio option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternRatio(value Ratio) {
+	e.ClearPattern()
+	e.PatternRatio = &value
+}
+
+// SetPatternReference sets the Reference option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternReference(value Reference) {
+	e.ClearPattern()
+	e.PatternReference = &value
+}
+
+// SetPatternSampledData sets the SampledData option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternSampledData(value SampledData) {
+	e.ClearPattern()
+	e.PatternSampledData = &value
+}
+
+// SetPatternSignature sets the Signature option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternSignature(value Signature) {
+	e.ClearPattern()
+	e.PatternSignature = &value
+}
+
+// SetPatternTiming sets the Timing option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternTiming(value Timing) {
+	e.ClearPattern()
+	e.PatternTiming = &value
+}
+
+// SetPatternContactDetail sets the ContactDetail option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternContactDetail(value ContactDetail) {
+	e.ClearPattern()
+	e.PatternContactDetail = &value
+}
+
+// SetPatternContributor sets the Contributor option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternContributor(value Contributor) {
+	e.ClearPattern()
+	e.PatternContributor = &value
+}
+
+// SetPatternDataRequirement sets the DataRequirement option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDataRequirement(value DataRequirement) {
+	e.ClearPattern()
+	e.PatternDataRequirement = &value
+}
+
+// SetPatternExpression sets the Expression option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternExpression(value Expression) {
+	e.ClearPattern()
+	e.PatternExpression = &value
+}
+
+// SetPatternParameterDefinition sets the ParameterDefinition option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternParameterDefinition(value ParameterDefinition) {
+	e.ClearPattern()
+	e.PatternParameterDefinition = &value
+}
+
+// SetPatternRelatedArtifact sets the RelatedArtifact option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternRelatedArtifact(value RelatedArtifact) {
+	e.ClearPattern()
+	e.PatternRelatedArtifact = &value
+}
+
+// SetPatternTriggerDefinition sets the TriggerDefinition option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternTriggerDefinition(value TriggerDefinition) {
+	e.ClearPattern()
+	e.PatternTriggerDefinition = &value
+}
+
+// SetPatternUsageContext sets the UsageContext option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternUsageContext(value UsageContext) {
+	e.ClearPattern()
+	e.PatternUsageContext = &value
+}
+
+// SetPatternDosage sets the Dosage option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternDosage(value Dosage) {
+	e.ClearPattern()
+	e.PatternDosage = &value
+}
+
+// SetPatternMeta sets the Meta option of pattern[x], clearing the others.
+func (e *ElementDefinition) SetPatternMeta(value Meta) {
+	e.ClearPattern()
+	e.PatternMeta = &value
+}
+
+// MinValue returns the option of minValue[x] that is set, or nil if none is.
+func (e *ElementDefinition) MinValue() any {
+	switch {
+	case e.MinValueDate != nil:
+		return e.MinValueDate
+	case e.MinValueDateTime != nil:
+		return e.MinValueDateTime
+	case e.MinValueInstant != nil:
+		return e.MinValueInstant
+	case e.MinValueTime != nil:
+		return e.MinValueTime
+	case e.MinValueDecimal != nil:
+		return e.MinValueDecimal
+	case e.MinValueInteger != nil:
+		return e.MinValueInteger
+	case e.MinValuePositiveInt != nil:
+		return e.MinValuePositiveInt
+	case e.MinValueUnsignedInt != nil:
+		return e.MinValueUnsignedInt
+	case e.MinValueQuantity != nil:
+		return e.MinValueQuantity
+	}
+	return nil
+}
+
+// MinValueType returns the FHIR type of the option of minValue[x] that is
+// set, such as "date", or "" if none is.
+func (e *ElementDefinition) MinValueType() string {
+	switch {
+	case e.MinValueDate != nil:
+		return "date"
+	case e.MinValueDateTime != nil:
+		return "dateTime"
+	case e.MinValueInstant != nil:
+		return "instant"
+	case e.MinValueTime != nil:
+		return "time"
+	case e.MinValueDecimal != nil:
+		return "decimal"
+	case e.MinValueInteger != nil:
+		return "integer"
+	case e.MinValuePositiveInt != nil:
+		return "positiveInt"
+	case e.MinValueUnsignedInt != nil:
+		return "unsignedInt"
+	case e.MinValueQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearMinValue unsets every option of minValue[x], and their extensions.
+func (e *ElementDefinition) ClearMinValue() {
+	e.MinValueDate = nil
+	e.MinValueDateExt = nil
+	e.MinValueDateTime = nil
+	e.MinValueDateTimeExt = nil
+	e.MinValueInstant = nil
+	e.MinValueInstantExt = nil
+	e.MinValueTime = nil
+	e.MinValueTimeExt = nil
+	e.MinValueDecimal = nil
+	e.MinValueDecimalExt = nil
+	e.MinValueInteger = nil
+	e.MinValueIntegerExt = nil
+	e.MinValuePositiveInt = nil
+	e.MinValuePositiveIntExt = nil
+	e.MinValueUnsignedInt = nil
+	e.MinValueUnsignedIntExt = nil
+	e.MinValueQuantity = nil
+}
+
+// SetMinValueDate sets the date option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueDate(value primitives.Date) {
+	ext := e.MinValueDateExt
+	e.ClearMinValue()
+	e.MinValueDate, e.MinValueDateExt = &value, ext
+}
+
+// SetMinValueDateTime sets the dateTime option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueDateTime(value primitives.DateTime) {
+	ext := e.MinValueDateTimeExt
+	e.ClearMinValue()
+	e.MinValueDateTime, e.MinValueDateTimeExt = &value, ext
+}
+
+// SetMinValueInstant sets the instant option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueInstant(value primitives.Instant) {
+	ext := e.MinValueInstantExt
+	e.ClearMinValue()
+	e.MinValueInstant, e.MinValueInstantExt = &value, ext
+}
+
+// SetMinValueTime sets the time option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueTime(value primitives.Time) {
+	ext := e.MinValueTimeExt
+	e.ClearMinValue()
+	e.MinValueTime, e.MinValueTimeExt = &value, ext
+}
+
+// SetMinValueDecimal sets the decimal option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueDecimal(value primitives.Decimal) {
+	ext := e.MinValueDecimalExt
+	e.ClearMinValue()
+	e.MinValueDecimal, e.MinValueDecimalExt = &value, ext
+}
+
+// SetMinValueInteger sets the integer option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueInteger(value int) {
+	ext := e.MinValueIntegerExt
+	e.ClearMinValue()
+	e.MinValueInteger, e.MinValueIntegerExt = &value, ext
+}
+
+// SetMinValuePositiveInt sets the positiveInt option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValuePositiveInt(value int) {
+	ext := e.MinValuePositiveIntExt
+	e.ClearMinValue()
+	e.MinValuePositiveInt, e.MinValuePositiveIntExt = &value, ext
+}
+
+// SetMinValueUnsignedInt sets the unsignedInt option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueUnsignedInt(value uint) {
+	ext := e.MinValueUnsignedIntExt
+	e.ClearMinValue()
+	e.MinValueUnsignedInt, e.MinValueUnsignedIntExt = &value, ext
+}
+
+// SetMinValueQuantity sets the Quantity option of minValue[x], clearing the others.
+func (e *ElementDefinition) SetMinValueQuantity(value Quantity) {
+	e.ClearMinValue()
+	e.MinValueQuantity = &value
+}
+
+// MaxValue returns the option of maxValue[x] that is set, or nil if none is.
+func (e *ElementDefinition) MaxValue() any {
+	switch {
+	case e.MaxValueDate != nil:
+		return e.MaxValueDate
+	case e.MaxValueDateTime != nil:
+		return e.MaxValueDateTime
+	case e.MaxValueInstant != nil:
+		return e.MaxValueInstant
+	case e.MaxValueTime != nil:
+		return e.MaxValueTime
+	case e.MaxValueDecimal != nil:
+		return e.MaxValueDecimal
+	case e.MaxValueInteger != nil:
+		return e.MaxValueInteger
+	case e.MaxValuePositiveInt != nil:
+		return e.MaxValuePositiveInt
+	case e.MaxValueUnsignedInt != nil:
+		return e.MaxValueUnsignedInt
+	case e.MaxValueQuantity != nil:
+		return e.MaxValueQuantity
+	}
+	return nil
+}
+
+// MaxValueType returns the FHIR type of the option of maxValue[x] that is
+// set, such as "date", or "" if none is.
+func (e *ElementDefinition) MaxValueType() string {
+	switch {
+	case e.MaxValueDate != nil:
+		return "date"
+	case e.MaxValueDateTime != nil:
+		return "dateTime"
+	case e.MaxValueInstant != nil:
+		return "instant"
+	case e.MaxValueTime != nil:
+		return "time"
+	case e.MaxValueDecimal != nil:
+		return "decimal"
+	case e.MaxValueInteger != nil:
+		return "integer"
+	case e.MaxValuePositiveInt != nil:
+		return "positiveInt"
+	case e.MaxValueUnsignedInt != nil:
+		return "unsignedInt"
+	case e.MaxValueQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearMaxValue unsets every option of maxValue[x], and their extensions.
+func (e *ElementDefinition) ClearMaxValue() {
+	e.MaxValueDate = nil
+	e.MaxValueDateExt = nil
+	e.MaxValueDateTime = nil
+	e.MaxValueDateTimeExt = nil
+	e.MaxValueInstant = nil
+	e.MaxValueInstantExt = nil
+	e.MaxValueTime = nil
+	e.MaxValueTimeExt = nil
+	e.MaxValueDecimal = nil
+	e.MaxValueDecimalExt = nil
+	e.MaxValueInteger = nil
+	e.MaxValueIntegerExt = nil
+	e.MaxValuePositiveInt = nil
+	e.MaxValuePositiveIntExt = nil
+	e.MaxValueUnsignedInt = nil
+	e.MaxValueUnsignedIntExt = nil
+	e.MaxValueQuantity = nil
+}
+
+// SetMaxValueDate sets the date option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueDate(value primitives.Date) {
+	ext := e.MaxValueDateExt
+	e.ClearMaxValue()
+	e.MaxValueDate, e.MaxValueDateExt = &value, ext
+}
+
+// SetMaxValueDateTime sets the dateTime option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueDateTime(value primitives.DateTime) {
+	ext := e.MaxValueDateTimeExt
+	e.ClearMaxValue()
+	e.MaxValueDateTime, e.MaxValueDateTimeExt = &value, ext
+}
+
+// SetMaxValueInstant sets the instant option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueInstant(value primitives.Instant) {
+	ext := e.MaxValueInstantExt
+	e.ClearMaxValue()
+	e.MaxValueInstant, e.MaxValueInstantExt = &value, ext
+}
+
+// SetMaxValueTime sets the time option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueTime(value primitives.Time) {
+	ext := e.MaxValueTimeExt
+	e.ClearMaxValue()
+	e.MaxValueTime, e.MaxValueTimeExt = &value, ext
+}
+
+// SetMaxValueDecimal sets the decimal option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueDecimal(value primitives.Decimal) {
+	ext := e.MaxValueDecimalExt
+	e.ClearMaxValue()
+	e.MaxValueDecimal, e.MaxValueDecimalExt = &value, ext
+}
+
+// SetMaxValueInteger sets the integer option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueInteger(value int) {
+	ext := e.MaxValueIntegerExt
+	e.ClearMaxValue()
+	e.MaxValueInteger, e.MaxValueIntegerExt = &value, ext
+}
+
+// SetMaxValuePositiveInt sets the positiveInt option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValuePositiveInt(value int) {
+	ext := e.MaxValuePositiveIntExt
+	e.ClearMaxValue()
+	e.MaxValuePositiveInt, e.MaxValuePositiveIntExt = &value, ext
+}
+
+// SetMaxValueUnsignedInt sets the unsignedInt option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueUnsignedInt(value uint) {
+	ext := e.MaxValueUnsignedIntExt
+	e.ClearMaxValue()
+	e.MaxValueUnsignedInt, e.MaxValueUnsignedIntExt = &value, ext
+}
+
+// SetMaxValueQuantity sets the Quantity option of maxValue[x], clearing the others.
+func (e *ElementDefinition) SetMaxValueQuantity(value Quantity) {
+	e.ClearMaxValue()
+	e.MaxValueQuantity = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -139,7 +138,7 @@ type EncounterLocation struct {
 
 // Encounter represents a FHIR Encounter.
 type Encounter struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier(s) by which this encounter is known
@@ -191,3 +190,8 @@ type Encounter struct {
 	// Another Encounter this encounter is part of
 	PartOf *Reference `json:"partOf,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Encounter", the FHIR type of the resource.
+func (e *Encounter) ResourceType() string {
+	return ResourceTypeEncounter
+}
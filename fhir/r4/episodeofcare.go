@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type EpisodeOfCareDiagnosis struct {
 
 // EpisodeOfCare represents a FHIR EpisodeOfCare.
 type EpisodeOfCare struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier(s) relevant for this EpisodeOfCare
@@ -83,3 +82,8 @@ type EpisodeOfCare struct {
 	// The set of accounts that may be used for billing for this EpisodeOfCare
 	Account []Reference `json:"account,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "EpisodeOfCare", the FHIR type of the resource.
+func (e *EpisodeOfCare) ResourceType() string {
+	return ResourceTypeEpisodeOfCare
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeEventDefinition = "EventDefinition"
 
 // EventDefinition represents a FHIR EventDefinition.
 type EventDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this event definition, represented as a URI (globally unique)
@@ -107,3 +106,49 @@ type EventDefinition struct {
 	// "when" the event occurs (multiple = 'or')
 	Trigger []TriggerDefinition `json:"trigger,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "EventDefinition", the FHIR type of the resource.
+func (e *EventDefinition) ResourceType() string {
+	return ResourceTypeEventDefinition
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (e *EventDefinition) Subject() any {
+	switch {
+	case e.SubjectCodeableConcept != nil:
+		return e.SubjectCodeableConcept
+	case e.SubjectReference != nil:
+		return e.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *EventDefinition) SubjectType() string {
+	switch {
+	case e.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (e *EventDefinition) ClearSubject() {
+	e.SubjectCodeableConcept = nil
+	e.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (e *EventDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	e.ClearSubject()
+	e.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (e *EventDefinition) SetSubjectReference(value Reference) {
+	e.ClearSubject()
+	e.SubjectReference = &value
+}
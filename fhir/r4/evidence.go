@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeEvidence = "Evidence"
 
 // Evidence represents a FHIR Evidence.
 type Evidence struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this evidence, represented as a URI (globally unique)
@@ -101,3 +100,8 @@ type Evidence struct {
 	// What outcome?
 	Outcome []Reference `json:"outcome,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Evidence", the FHIR type of the resource.
+func (e *Evidence) ResourceType() string {
+	return ResourceTypeEvidence
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,19 +27,19 @@ type EvidenceVariableCharacteristic struct {
 	// Extension for Description
 	DescriptionExt *primitives.PrimitiveExtension `json:"_description,omitempty" fhir:"cardinality=0..1"`
 	// What code or expression defines members? - Reference option
-	DefinitionReference Reference `json:"definitionReference" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionReference *Reference `json:"definitionReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code or expression defines members? - canonical option
-	DefinitionCanonical string `json:"definitionCanonical" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionCanonical *primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// What code or expression defines members? - CodeableConcept option
-	DefinitionCodeableConcept CodeableConcept `json:"definitionCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionCodeableConcept *CodeableConcept `json:"definitionCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code or expression defines members? - Expression option
-	DefinitionExpression Expression `json:"definitionExpression" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionExpression *Expression `json:"definitionExpression,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code or expression defines members? - DataRequirement option
-	DefinitionDataRequirement DataRequirement `json:"definitionDataRequirement" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionDataRequirement *DataRequirement `json:"definitionDataRequirement,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code or expression defines members? - TriggerDefinition option
-	DefinitionTriggerDefinition TriggerDefinition `json:"definitionTriggerDefinition" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionTriggerDefinition *TriggerDefinition `json:"definitionTriggerDefinition,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code/value pairs define members?
 	UsageContext []UsageContext `json:"usageContext,omitempty" fhir:"cardinality=0..*"`
 	// Whether the characteristic includes or excludes members
@@ -65,9 +64,161 @@ type EvidenceVariableCharacteristic struct {
 	GroupMeasureExt *primitives.PrimitiveExtension `json:"_groupMeasure,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Definition returns the option of definition[x] that is set, or nil if none is.
+func (e *EvidenceVariableCharacteristic) Definition() any {
+	switch {
+	case e.DefinitionReference != nil:
+		return e.DefinitionReference
+	case e.DefinitionCanonical != nil:
+		return e.DefinitionCanonical
+	case e.DefinitionCodeableConcept != nil:
+		return e.DefinitionCodeableConcept
+	case e.DefinitionExpression != nil:
+		return e.DefinitionExpression
+	case e.DefinitionDataRequirement != nil:
+		return e.DefinitionDataRequirement
+	case e.DefinitionTriggerDefinition != nil:
+		return e.DefinitionTriggerDefinition
+	}
+	return nil
+}
+
+// DefinitionType returns the FHIR type of the option of definition[x] that is
+// set, such as "Reference", or "" if none is.
+func (e *EvidenceVariableCharacteristic) DefinitionType() string {
+	switch {
+	case e.DefinitionReference != nil:
+		return "Reference"
+	case e.DefinitionCanonical != nil:
+		return "canonical"
+	case e.DefinitionCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.DefinitionExpression != nil:
+		return "Expression"
+	case e.DefinitionDataRequirement != nil:
+		return "DataRequirement"
+	case e.DefinitionTriggerDefinition != nil:
+		return "TriggerDefinition"
+	}
+	return ""
+}
+
+// ClearDefinition unsets every option of definition[x], and their extensions.
+func (e *EvidenceVariableCharacteristic) ClearDefinition() {
+	e.DefinitionReference = nil
+	e.DefinitionCanonical = nil
+	e.DefinitionCanonicalExt = nil
+	e.DefinitionCodeableConcept = nil
+	e.DefinitionExpression = nil
+	e.DefinitionDataRequirement = nil
+	e.DefinitionTriggerDefinition = nil
+}
+
+// SetDefinitionReference sets the Reference option of definition[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDefinitionReference(value Reference) {
+	e.ClearDefinition()
+	e.DefinitionReference = &value
+}
+
+// SetDefinitionCanonical sets the canonical option of definition[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDefinitionCanonical(value primitives.Canonical) {
+	ext := e.DefinitionCanonicalExt
+	e.ClearDefinition()
+	e.DefinitionCanonical, e.DefinitionCanonicalExt = &value, ext
+}
+
+// SetDefinitionCodeableConcept sets the CodeableConcept option of definition[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDefinitionCodeableConcept(value CodeableConcept) {
+	e.ClearDefinition()
+	e.DefinitionCodeableConcept = &value
+}
+
+// SetDefinitionExpression sets the Expression option of definition[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDefinitionExpression(value Expression) {
+	e.ClearDefinition()
+	e.DefinitionExpression = &value
+}
+
+// SetDefinitionDataRequirement sets the DataRequirement option of definition[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDefinitionDataRequirement(value DataRequirement) {
+	e.ClearDefinition()
+	e.DefinitionDataRequirement = &value
+}
+
+// SetDefinitionTriggerDefinition sets the TriggerDefinition option of definition[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDefinitionTriggerDefinition(value TriggerDefinition) {
+	e.ClearDefinition()
+	e.DefinitionTriggerDefinition = &value
+}
+
+// ParticipantEffective returns the option of participantEffective[x] that is set, or nil if none is.
+func (e *EvidenceVariableCharacteristic) ParticipantEffective() any {
+	switch {
+	case e.ParticipantEffectiveDateTime != nil:
+		return e.ParticipantEffectiveDateTime
+	case e.ParticipantEffectivePeriod != nil:
+		return e.ParticipantEffectivePeriod
+	case e.ParticipantEffectiveDuration != nil:
+		return e.ParticipantEffectiveDuration
+	case e.ParticipantEffectiveTiming != nil:
+		return e.ParticipantEffectiveTiming
+	}
+	return nil
+}
+
+// ParticipantEffectiveType returns the FHIR type of the option of participantEffective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (e *EvidenceVariableCharacteristic) ParticipantEffectiveType() string {
+	switch {
+	case e.ParticipantEffectiveDateTime != nil:
+		return "dateTime"
+	case e.ParticipantEffectivePeriod != nil:
+		return "Period"
+	case e.ParticipantEffectiveDuration != nil:
+		return "Duration"
+	case e.ParticipantEffectiveTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearParticipantEffective unsets every option of participantEffective[x], and their extensions.
+func (e *EvidenceVariableCharacteristic) ClearParticipantEffective() {
+	e.ParticipantEffectiveDateTime = nil
+	e.ParticipantEffectiveDateTimeExt = nil
+	e.ParticipantEffectivePeriod = nil
+	e.ParticipantEffectiveDuration = nil
+	e.ParticipantEffectiveTiming = nil
+}
+
+// SetParticipantEffectiveDateTime sets the dateTime option of participantEffective[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetParticipantEffectiveDateTime(value primitives.DateTime) {
+	ext := e.ParticipantEffectiveDateTimeExt
+	e.ClearParticipantEffective()
+	e.ParticipantEffectiveDateTime, e.ParticipantEffectiveDateTimeExt = &value, ext
+}
+
+// SetParticipantEffectivePeriod sets the Period option of participantEffective[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetParticipantEffectivePeriod(value Period) {
+	e.ClearParticipantEffective()
+	e.ParticipantEffectivePeriod = &value
+}
+
+// SetParticipantEffectiveDuration sets the Duration option of participantEffective[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetParticipantEffectiveDuration(value Duration) {
+	e.ClearParticipantEffective()
+	e.ParticipantEffectiveDuration = &value
+}
+
+// SetParticipantEffectiveTiming sets the Timing option of participantEffective[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetParticipantEffectiveTiming(value Timing) {
+	e.ClearParticipantEffective()
+	e.ParticipantEffectiveTiming = &value
+}
+
 // EvidenceVariable represents a FHIR EvidenceVariable.
 type EvidenceVariable struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this evidence variable, represented as a URI (globally unique)
@@ -153,3 +304,8 @@ type EvidenceVariable struct {
 	// What defines the members of the evidence element
 	Characteristic []EvidenceVariableCharacteristic `json:"characteristic,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "EvidenceVariable", the FHIR type of the resource.
+func (e *EvidenceVariable) ResourceType() string {
+	return ResourceTypeEvidenceVariable
+}
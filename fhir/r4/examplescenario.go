@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -253,7 +252,7 @@ type ExampleScenarioProcess struct {
 
 // ExampleScenario represents a FHIR ExampleScenario.
 type ExampleScenario struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this example scenario, represented as a URI (globally unique)
@@ -311,3 +310,8 @@ type ExampleScenario struct {
 	// Extension for Workflow
 	WorkflowExt *primitives.PrimitiveExtension `json:"_workflow,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ExampleScenario", the FHIR type of the resource.
+func (e *ExampleScenario) ResourceType() string {
+	return ResourceTypeExampleScenario
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -115,6 +114,127 @@ type ExplanationOfBenefitSupportingInfo struct {
 	Reason *Coding `json:"reason,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitSupportingInfo) Timing() any {
+	switch {
+	case e.TimingDate != nil:
+		return e.TimingDate
+	case e.TimingPeriod != nil:
+		return e.TimingPeriod
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "date", or "" if none is.
+func (e *ExplanationOfBenefitSupportingInfo) TimingType() string {
+	switch {
+	case e.TimingDate != nil:
+		return "date"
+	case e.TimingPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (e *ExplanationOfBenefitSupportingInfo) ClearTiming() {
+	e.TimingDate = nil
+	e.TimingDateExt = nil
+	e.TimingPeriod = nil
+}
+
+// SetTimingDate sets the date option of timing[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetTimingDate(value primitives.Date) {
+	ext := e.TimingDateExt
+	e.ClearTiming()
+	e.TimingDate, e.TimingDateExt = &value, ext
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetTimingPeriod(value Period) {
+	e.ClearTiming()
+	e.TimingPeriod = &value
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitSupportingInfo) Value() any {
+	switch {
+	case e.ValueBoolean != nil:
+		return e.ValueBoolean
+	case e.ValueString != nil:
+		return e.ValueString
+	case e.ValueQuantity != nil:
+		return e.ValueQuantity
+	case e.ValueAttachment != nil:
+		return e.ValueAttachment
+	case e.ValueReference != nil:
+		return e.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (e *ExplanationOfBenefitSupportingInfo) ValueType() string {
+	switch {
+	case e.ValueBoolean != nil:
+		return "boolean"
+	case e.ValueString != nil:
+		return "string"
+	case e.ValueQuantity != nil:
+		return "Quantity"
+	case e.ValueAttachment != nil:
+		return "Attachment"
+	case e.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (e *ExplanationOfBenefitSupportingInfo) ClearValue() {
+	e.ValueBoolean = nil
+	e.ValueBooleanExt = nil
+	e.ValueString = nil
+	e.ValueStringExt = nil
+	e.ValueQuantity = nil
+	e.ValueAttachment = nil
+	e.ValueReference = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetValueBoolean(value bool) {
+	ext := e.ValueBooleanExt
+	e.ClearValue()
+	e.ValueBoolean, e.ValueBooleanExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetValueString(value string) {
+	ext := e.ValueStringExt
+	e.ClearValue()
+	e.ValueString, e.ValueStringExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetValueQuantity(value Quantity) {
+	e.ClearValue()
+	e.ValueQuantity = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetValueAttachment(value Attachment) {
+	e.ClearValue()
+	e.ValueAttachment = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (e *ExplanationOfBenefitSupportingInfo) SetValueReference(value Reference) {
+	e.ClearValue()
+	e.ValueReference = &value
+}
+
 // ExplanationOfBenefitDiagnosis represents a FHIR BackboneElement for ExplanationOfBenefit.diagnosis.
 type ExplanationOfBenefitDiagnosis struct {
 	// Unique id for inter-element referencing
@@ -130,9 +250,9 @@ type ExplanationOfBenefitDiagnosis struct {
 	// Extension for Sequence
 	SequenceExt *primitives.PrimitiveExtension `json:"_sequence,omitempty" fhir:"cardinality=0..1"`
 	// Nature of illness or problem - CodeableConcept option
-	DiagnosisCodeableConcept CodeableConcept `json:"diagnosisCodeableConcept" fhir:"cardinality=1..1,required,choice=diagnosis"`
+	DiagnosisCodeableConcept *CodeableConcept `json:"diagnosisCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=diagnosis"`
 	// Nature of illness or problem - Reference option
-	DiagnosisReference Reference `json:"diagnosisReference" fhir:"cardinality=1..1,required,choice=diagnosis"`
+	DiagnosisReference *Reference `json:"diagnosisReference,omitempty" fhir:"cardinality=1..1,required,choice=diagnosis"`
 	// Timing or nature of the diagnosis
 	Type []CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..*"`
 	// Present on admission
@@ -141,6 +261,47 @@ type ExplanationOfBenefitDiagnosis struct {
 	PackageCode *CodeableConcept `json:"packageCode,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Diagnosis returns the option of diagnosis[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitDiagnosis) Diagnosis() any {
+	switch {
+	case e.DiagnosisCodeableConcept != nil:
+		return e.DiagnosisCodeableConcept
+	case e.DiagnosisReference != nil:
+		return e.DiagnosisReference
+	}
+	return nil
+}
+
+// DiagnosisType returns the FHIR type of the option of diagnosis[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *ExplanationOfBenefitDiagnosis) DiagnosisType() string {
+	switch {
+	case e.DiagnosisCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.DiagnosisReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearDiagnosis unsets every option of diagnosis[x], and their extensions.
+func (e *ExplanationOfBenefitDiagnosis) ClearDiagnosis() {
+	e.DiagnosisCodeableConcept = nil
+	e.DiagnosisReference = nil
+}
+
+// SetDiagnosisCodeableConcept sets the CodeableConcept option of diagnosis[x], clearing the others.
+func (e *ExplanationOfBenefitDiagnosis) SetDiagnosisCodeableConcept(value CodeableConcept) {
+	e.ClearDiagnosis()
+	e.DiagnosisCodeableConcept = &value
+}
+
+// SetDiagnosisReference sets the Reference option of diagnosis[x], clearing the others.
+func (e *ExplanationOfBenefitDiagnosis) SetDiagnosisReference(value Reference) {
+	e.ClearDiagnosis()
+	e.DiagnosisReference = &value
+}
+
 // ExplanationOfBenefitProcedure represents a FHIR BackboneElement for ExplanationOfBenefit.procedure.
 type ExplanationOfBenefitProcedure struct {
 	// Unique id for inter-element referencing
@@ -162,13 +323,54 @@ type ExplanationOfBenefitProcedure struct {
 	// Extension for Date
 	DateExt *primitives.PrimitiveExtension `json:"_date,omitempty" fhir:"cardinality=0..1"`
 	// Specific clinical procedure - CodeableConcept option
-	ProcedureCodeableConcept CodeableConcept `json:"procedureCodeableConcept" fhir:"cardinality=1..1,required,choice=procedure"`
+	ProcedureCodeableConcept *CodeableConcept `json:"procedureCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=procedure"`
 	// Specific clinical procedure - Reference option
-	ProcedureReference Reference `json:"procedureReference" fhir:"cardinality=1..1,required,choice=procedure"`
+	ProcedureReference *Reference `json:"procedureReference,omitempty" fhir:"cardinality=1..1,required,choice=procedure"`
 	// Unique device identifier
 	Udi []Reference `json:"udi,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Procedure returns the option of procedure[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitProcedure) Procedure() any {
+	switch {
+	case e.ProcedureCodeableConcept != nil:
+		return e.ProcedureCodeableConcept
+	case e.ProcedureReference != nil:
+		return e.ProcedureReference
+	}
+	return nil
+}
+
+// ProcedureType returns the FHIR type of the option of procedure[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *ExplanationOfBenefitProcedure) ProcedureType() string {
+	switch {
+	case e.ProcedureCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.ProcedureReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearProcedure unsets every option of procedure[x], and their extensions.
+func (e *ExplanationOfBenefitProcedure) ClearProcedure() {
+	e.ProcedureCodeableConcept = nil
+	e.ProcedureReference = nil
+}
+
+// SetProcedureCodeableConcept sets the CodeableConcept option of procedure[x], clearing the others.
+func (e *ExplanationOfBenefitProcedure) SetProcedureCodeableConcept(value CodeableConcept) {
+	e.ClearProcedure()
+	e.ProcedureCodeableConcept = &value
+}
+
+// SetProcedureReference sets the Reference option of procedure[x], clearing the others.
+func (e *ExplanationOfBenefitProcedure) SetProcedureReference(value Reference) {
+	e.ClearProcedure()
+	e.ProcedureReference = &value
+}
+
 // ExplanationOfBenefitInsurance represents a FHIR BackboneElement for ExplanationOfBenefit.insurance.
 type ExplanationOfBenefitInsurance struct {
 	// Unique id for inter-element referencing
@@ -213,6 +415,47 @@ type ExplanationOfBenefitAccident struct {
 	LocationReference *Reference `json:"locationReference,omitempty" fhir:"cardinality=0..1,choice=location"`
 }
 
+// Location returns the option of location[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitAccident) Location() any {
+	switch {
+	case e.LocationAddress != nil:
+		return e.LocationAddress
+	case e.LocationReference != nil:
+		return e.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "Address", or "" if none is.
+func (e *ExplanationOfBenefitAccident) LocationType() string {
+	switch {
+	case e.LocationAddress != nil:
+		return "Address"
+	case e.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (e *ExplanationOfBenefitAccident) ClearLocation() {
+	e.LocationAddress = nil
+	e.LocationReference = nil
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (e *ExplanationOfBenefitAccident) SetLocationAddress(value Address) {
+	e.ClearLocation()
+	e.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (e *ExplanationOfBenefitAccident) SetLocationReference(value Reference) {
+	e.ClearLocation()
+	e.LocationReference = &value
+}
+
 // ExplanationOfBenefitItemAdjudication represents a FHIR BackboneElement for ExplanationOfBenefit.item.adjudication.
 type ExplanationOfBenefitItemAdjudication struct {
 	// Unique id for inter-element referencing
@@ -230,7 +473,7 @@ type ExplanationOfBenefitItemAdjudication struct {
 	// Monetary amount
 	Amount *Money `json:"amount,omitempty" fhir:"cardinality=0..1"`
 	// Non-monitary value
-	Value *float64 `json:"value,omitempty" fhir:"cardinality=0..1"`
+	Value *primitives.Decimal `json:"value,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 }
@@ -272,7 +515,7 @@ type ExplanationOfBenefitItemDetailSubDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -316,7 +559,7 @@ type ExplanationOfBenefitItemDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -390,7 +633,7 @@ type ExplanationOfBenefitItem struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -413,6 +656,101 @@ type ExplanationOfBenefitItem struct {
 	Detail []ExplanationOfBenefitItemDetail `json:"detail,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitItem) Serviced() any {
+	switch {
+	case e.ServicedDate != nil:
+		return e.ServicedDate
+	case e.ServicedPeriod != nil:
+		return e.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (e *ExplanationOfBenefitItem) ServicedType() string {
+	switch {
+	case e.ServicedDate != nil:
+		return "date"
+	case e.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (e *ExplanationOfBenefitItem) ClearServiced() {
+	e.ServicedDate = nil
+	e.ServicedDateExt = nil
+	e.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (e *ExplanationOfBenefitItem) SetServicedDate(value primitives.Date) {
+	ext := e.ServicedDateExt
+	e.ClearServiced()
+	e.ServicedDate, e.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (e *ExplanationOfBenefitItem) SetServicedPeriod(value Period) {
+	e.ClearServiced()
+	e.ServicedPeriod = &value
+}
+
+// Location returns the option of location[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitItem) Location() any {
+	switch {
+	case e.LocationCodeableConcept != nil:
+		return e.LocationCodeableConcept
+	case e.LocationAddress != nil:
+		return e.LocationAddress
+	case e.LocationReference != nil:
+		return e.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *ExplanationOfBenefitItem) LocationType() string {
+	switch {
+	case e.LocationCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.LocationAddress != nil:
+		return "Address"
+	case e.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (e *ExplanationOfBenefitItem) ClearLocation() {
+	e.LocationCodeableConcept = nil
+	e.LocationAddress = nil
+	e.LocationReference = nil
+}
+
+// SetLocationCodeableConcept sets the CodeableConcept option of location[x], clearing the others.
+func (e *ExplanationOfBenefitItem) SetLocationCodeableConcept(value CodeableConcept) {
+	e.ClearLocation()
+	e.LocationCodeableConcept = &value
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (e *ExplanationOfBenefitItem) SetLocationAddress(value Address) {
+	e.ClearLocation()
+	e.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (e *ExplanationOfBenefitItem) SetLocationReference(value Reference) {
+	e.ClearLocation()
+	e.LocationReference = &value
+}
+
 // ExplanationOfBenefitAddItemAdjudication represents a FHIR BackboneElement for ExplanationOfBenefit.addItem.adjudication.
 type ExplanationOfBenefitAddItemAdjudication struct {
 }
@@ -444,7 +782,7 @@ type ExplanationOfBenefitAddItemDetailSubDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -476,7 +814,7 @@ type ExplanationOfBenefitAddItemDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -538,7 +876,7 @@ type ExplanationOfBenefitAddItem struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total item cost
@@ -557,6 +895,101 @@ type ExplanationOfBenefitAddItem struct {
 	Detail []ExplanationOfBenefitAddItemDetail `json:"detail,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitAddItem) Serviced() any {
+	switch {
+	case e.ServicedDate != nil:
+		return e.ServicedDate
+	case e.ServicedPeriod != nil:
+		return e.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (e *ExplanationOfBenefitAddItem) ServicedType() string {
+	switch {
+	case e.ServicedDate != nil:
+		return "date"
+	case e.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (e *ExplanationOfBenefitAddItem) ClearServiced() {
+	e.ServicedDate = nil
+	e.ServicedDateExt = nil
+	e.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (e *ExplanationOfBenefitAddItem) SetServicedDate(value primitives.Date) {
+	ext := e.ServicedDateExt
+	e.ClearServiced()
+	e.ServicedDate, e.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (e *ExplanationOfBenefitAddItem) SetServicedPeriod(value Period) {
+	e.ClearServiced()
+	e.ServicedPeriod = &value
+}
+
+// Location returns the option of location[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitAddItem) Location() any {
+	switch {
+	case e.LocationCodeableConcept != nil:
+		return e.LocationCodeableConcept
+	case e.LocationAddress != nil:
+		return e.LocationAddress
+	case e.LocationReference != nil:
+		return e.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *ExplanationOfBenefitAddItem) LocationType() string {
+	switch {
+	case e.LocationCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.LocationAddress != nil:
+		return "Address"
+	case e.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (e *ExplanationOfBenefitAddItem) ClearLocation() {
+	e.LocationCodeableConcept = nil
+	e.LocationAddress = nil
+	e.LocationReference = nil
+}
+
+// SetLocationCodeableConcept sets the CodeableConcept option of location[x], clearing the others.
+func (e *ExplanationOfBenefitAddItem) SetLocationCodeableConcept(value CodeableConcept) {
+	e.ClearLocation()
+	e.LocationCodeableConcept = &value
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (e *ExplanationOfBenefitAddItem) SetLocationAddress(value Address) {
+	e.ClearLocation()
+	e.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (e *ExplanationOfBenefitAddItem) SetLocationReference(value Reference) {
+	e.ClearLocation()
+	e.LocationReference = &value
+}
+
 // ExplanationOfBenefitAdjudication represents a FHIR BackboneElement for ExplanationOfBenefit.adjudication.
 type ExplanationOfBenefitAdjudication struct {
 }
@@ -659,6 +1092,105 @@ type ExplanationOfBenefitBenefitBalanceFinancial struct {
 	UsedMoney *Money `json:"usedMoney,omitempty" fhir:"cardinality=0..1,choice=used"`
 }
 
+// Allowed returns the option of allowed[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) Allowed() any {
+	switch {
+	case e.AllowedUnsignedInt != nil:
+		return e.AllowedUnsignedInt
+	case e.AllowedString != nil:
+		return e.AllowedString
+	case e.AllowedMoney != nil:
+		return e.AllowedMoney
+	}
+	return nil
+}
+
+// AllowedType returns the FHIR type of the option of allowed[x] that is
+// set, such as "unsignedInt", or "" if none is.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) AllowedType() string {
+	switch {
+	case e.AllowedUnsignedInt != nil:
+		return "unsignedInt"
+	case e.AllowedString != nil:
+		return "string"
+	case e.AllowedMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearAllowed unsets every option of allowed[x], and their extensions.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) ClearAllowed() {
+	e.AllowedUnsignedInt = nil
+	e.AllowedUnsignedIntExt = nil
+	e.AllowedString = nil
+	e.AllowedStringExt = nil
+	e.AllowedMoney = nil
+}
+
+// SetAllowedUnsignedInt sets the unsignedInt option of allowed[x], clearing the others.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowedUnsignedInt(value uint) {
+	ext := e.AllowedUnsignedIntExt
+	e.ClearAllowed()
+	e.AllowedUnsignedInt, e.AllowedUnsignedIntExt = &value, ext
+}
+
+// SetAllowedString sets the string option of allowed[x], clearing the others.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowedString(value string) {
+	ext := e.AllowedStringExt
+	e.ClearAllowed()
+	e.AllowedString, e.AllowedStringExt = &value, ext
+}
+
+// SetAllowedMoney sets the Money option of allowed[x], clearing the others.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowedMoney(value Money) {
+	e.ClearAllowed()
+	e.AllowedMoney = &value
+}
+
+// Used returns the option of used[x] that is set, or nil if none is.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) Used() any {
+	switch {
+	case e.UsedUnsignedInt != nil:
+		return e.UsedUnsignedInt
+	case e.UsedMoney != nil:
+		return e.UsedMoney
+	}
+	return nil
+}
+
+// UsedType returns the FHIR type of the option of used[x] that is
+// set, such as "unsignedInt", or "" if none is.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) UsedType() string {
+	switch {
+	case e.UsedUnsignedInt != nil:
+		return "unsignedInt"
+	case e.UsedMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearUsed unsets every option of used[x], and their extensions.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) ClearUsed() {
+	e.UsedUnsignedInt = nil
+	e.UsedUnsignedIntExt = nil
+	e.UsedMoney = nil
+}
+
+// SetUsedUnsignedInt sets the unsignedInt option of used[x], clearing the others.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) SetUsedUnsignedInt(value uint) {
+	ext := e.UsedUnsignedIntExt
+	e.ClearUsed()
+	e.UsedUnsignedInt, e.UsedUnsignedIntExt = &value, ext
+}
+
+// SetUsedMoney sets the Money option of used[x], clearing the others.
+func (e *ExplanationOfBenefitBenefitBalanceFinancial) SetUsedMoney(value Money) {
+	e.ClearUsed()
+	e.UsedMoney = &value
+}
+
 // ExplanationOfBenefitBenefitBalance represents a FHIR BackboneElement for ExplanationOfBenefit.benefitBalance.
 type ExplanationOfBenefitBenefitBalance struct {
 	// Unique id for inter-element referencing
@@ -695,7 +1227,7 @@ type ExplanationOfBenefitBenefitBalance struct {
 
 // ExplanationOfBenefit represents a FHIR ExplanationOfBenefit.
 type ExplanationOfBenefit struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for the resource
@@ -799,3 +1331,8 @@ type ExplanationOfBenefit struct {
 	// Balance by Benefit Category
 	BenefitBalance []ExplanationOfBenefitBenefitBalance `json:"benefitBalance,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ExplanationOfBenefit", the FHIR type of the resource.
+func (e *ExplanationOfBenefit) ResourceType() string {
+	return ResourceTypeExplanationOfBenefit
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -45,9 +44,74 @@ type FamilyMemberHistoryCondition struct {
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Onset returns the option of onset[x] that is set, or nil if none is.
+func (f *FamilyMemberHistoryCondition) Onset() any {
+	switch {
+	case f.OnsetAge != nil:
+		return f.OnsetAge
+	case f.OnsetRange != nil:
+		return f.OnsetRange
+	case f.OnsetPeriod != nil:
+		return f.OnsetPeriod
+	case f.OnsetString != nil:
+		return f.OnsetString
+	}
+	return nil
+}
+
+// OnsetType returns the FHIR type of the option of onset[x] that is
+// set, such as "Age", or "" if none is.
+func (f *FamilyMemberHistoryCondition) OnsetType() string {
+	switch {
+	case f.OnsetAge != nil:
+		return "Age"
+	case f.OnsetRange != nil:
+		return "Range"
+	case f.OnsetPeriod != nil:
+		return "Period"
+	case f.OnsetString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearOnset unsets every option of onset[x], and their extensions.
+func (f *FamilyMemberHistoryCondition) ClearOnset() {
+	f.OnsetAge = nil
+	f.OnsetRange = nil
+	f.OnsetPeriod = nil
+	f.OnsetString = nil
+	f.OnsetStringExt = nil
+}
+
+// SetOnsetAge sets the Age option of onset[x], clearing the others.
+func (f *FamilyMemberHistoryCondition) SetOnsetAge(value Age) {
+	f.ClearOnset()
+	f.OnsetAge = &value
+}
+
+// SetOnsetRange sets the Range option of onset[x], clearing the others.
+func (f *FamilyMemberHistoryCondition) SetOnsetRange(value Range) {
+	f.ClearOnset()
+	f.OnsetRange = &value
+}
+
+// SetOnsetPeriod sets the Period option of onset[x], clearing the others.
+func (f *FamilyMemberHistoryCondition) SetOnsetPeriod(value Period) {
+	f.ClearOnset()
+	f.OnsetPeriod = &value
+}
+
+// SetOnsetString sets the string option of onset[x], clearing the others.
+func (f *FamilyMemberHistoryCondition) SetOnsetString(value string) {
+	ext := f.OnsetStringExt
+	f.ClearOnset()
+	f.OnsetString, f.OnsetStringExt = &value, ext
+}
+
 // FamilyMemberHistory represents a FHIR FamilyMemberHistory.
 type FamilyMemberHistory struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Id(s) for this record
@@ -127,3 +191,198 @@ type FamilyMemberHistory struct {
 	// Condition that the related person had
 	Condition []FamilyMemberHistoryCondition `json:"condition,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "FamilyMemberHistory", the FHIR type of the resource.
+func (f *FamilyMemberHistory) ResourceType() string {
+	return ResourceTypeFamilyMemberHistory
+}
+
+// Born returns the option of born[x] that is set, or nil if none is.
+func (f *FamilyMemberHistory) Born() any {
+	switch {
+	case f.BornPeriod != nil:
+		return f.BornPeriod
+	case f.BornDate != nil:
+		return f.BornDate
+	case f.BornString != nil:
+		return f.BornString
+	}
+	return nil
+}
+
+// BornType returns the FHIR type of the option of born[x] that is
+// set, such as "Period", or "" if none is.
+func (f *FamilyMemberHistory) BornType() string {
+	switch {
+	case f.BornPeriod != nil:
+		return "Period"
+	case f.BornDate != nil:
+		return "date"
+	case f.BornString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearBorn unsets every option of born[x], and their extensions.
+func (f *FamilyMemberHistory) ClearBorn() {
+	f.BornPeriod = nil
+	f.BornDate = nil
+	f.BornDateExt = nil
+	f.BornString = nil
+	f.BornStringExt = nil
+}
+
+// SetBornPeriod sets the Period option of born[x], clearing the others.
+func (f *FamilyMemberHistory) SetBornPeriod(value Period) {
+	f.ClearBorn()
+	f.BornPeriod = &value
+}
+
+// SetBornDate sets the date option of born[x], clearing the others.
+func (f *FamilyMemberHistory) SetBornDate(value primitives.Date) {
+	ext := f.BornDateExt
+	f.ClearBorn()
+	f.BornDate, f.BornDateExt = &value, ext
+}
+
+// SetBornString sets the string option of born[x], clearing the others.
+func (f *FamilyMemberHistory) SetBornString(value string) {
+	ext := f.BornStringExt
+	f.ClearBorn()
+	f.BornString, f.BornStringExt = &value, ext
+}
+
+// Age returns the option of age[x] that is set, or nil if none is.
+func (f *FamilyMemberHistory) Age() any {
+	switch {
+	case f.AgeAge != nil:
+		return f.AgeAge
+	case f.AgeRange != nil:
+		return f.AgeRange
+	case f.AgeString != nil:
+		return f.AgeString
+	}
+	return nil
+}
+
+// AgeType returns the FHIR type of the option of age[x] that is
+// set, such as "Age", or "" if none is.
+func (f *FamilyMemberHistory) AgeType() string {
+	switch {
+	case f.AgeAge != nil:
+		return "Age"
+	case f.AgeRange != nil:
+		return "Range"
+	case f.AgeString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAge unsets every option of age[x], and their extensions.
+func (f *FamilyMemberHistory) ClearAge() {
+	f.AgeAge = nil
+	f.AgeRange = nil
+	f.AgeString = nil
+	f.AgeStringExt = nil
+}
+
+// SetAgeAge sets the Age option of age[x], clearing the others.
+func (f *FamilyMemberHistory) SetAgeAge(value Age) {
+	f.ClearAge()
+	f.AgeAge = &value
+}
+
+// SetAgeRange sets the Range option of age[x], clearing the others.
+func (f *FamilyMemberHistory) SetAgeRange(value Range) {
+	f.ClearAge()
+	f.AgeRange = &value
+}
+
+// SetAgeString sets the string option of age[x], clearing the others.
+func (f *FamilyMemberHistory) SetAgeString(value string) {
+	ext := f.AgeStringExt
+	f.ClearAge()
+	f.AgeString, f.AgeStringExt = &value, ext
+}
+
+// Deceased returns the option of deceased[x] that is set, or nil if none is.
+func (f *FamilyMemberHistory) Deceased() any {
+	switch {
+	case f.DeceasedBoolean != nil:
+		return f.DeceasedBoolean
+	case f.DeceasedAge != nil:
+		return f.DeceasedAge
+	case f.DeceasedRange != nil:
+		return f.DeceasedRange
+	case f.DeceasedDate != nil:
+		return f.DeceasedDate
+	case f.DeceasedString != nil:
+		return f.DeceasedString
+	}
+	return nil
+}
+
+// DeceasedType returns the FHIR type of the option of deceased[x] that is
+// set, such as "boolean", or "" if none is.
+func (f *FamilyMemberHistory) DeceasedType() string {
+	switch {
+	case f.DeceasedBoolean != nil:
+		return "boolean"
+	case f.DeceasedAge != nil:
+		return "Age"
+	case f.DeceasedRange != nil:
+		return "Range"
+	case f.DeceasedDate != nil:
+		return "date"
+	case f.DeceasedString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearDeceased unsets every option of deceased[x], and their extensions.
+func (f *FamilyMemberHistory) ClearDeceased() {
+	f.DeceasedBoolean = nil
+	f.DeceasedBooleanExt = nil
+	f.DeceasedAge = nil
+	f.DeceasedRange = nil
+	f.DeceasedDate = nil
+	f.DeceasedDateExt = nil
+	f.DeceasedString = nil
+	f.DeceasedStringExt = nil
+}
+
+// SetDeceasedBoolean sets the boolean option of deceased[x], clearing the others.
+func (f *FamilyMemberHistory) SetDeceasedBoolean(value bool) {
+	ext := f.DeceasedBooleanExt
+	f.ClearDeceased()
+	f.DeceasedBoolean, f.DeceasedBooleanExt = &value, ext
+}
+
+// SetDeceasedAge sets the Age option of deceased[x], clearing the others.
+func (f *FamilyMemberHistory) SetDeceasedAge(value Age) {
+	f.ClearDeceased()
+	f.DeceasedAge = &value
+}
+
+// SetDeceasedRange sets the Range option of deceased[x], clearing the others.
+func (f *FamilyMemberHistory) SetDeceasedRange(value Range) {
+	f.ClearDeceased()
+	f.DeceasedRange = &value
+}
+
+// SetDeceasedDate sets the date option of deceased[x], clearing the others.
+func (f *FamilyMemberHistory) SetDeceasedDate(value primitives.Date) {
+	ext := f.DeceasedDateExt
+	f.ClearDeceased()
+	f.DeceasedDate, f.DeceasedDateExt = &value, ext
+}
+
+// SetDeceasedString sets the string option of deceased[x], clearing the others.
+func (f *FamilyMemberHistory) SetDeceasedString(value string) {
+	ext := f.DeceasedStringExt
+	f.ClearDeceased()
+	f.DeceasedString, f.DeceasedStringExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeFlag = "Flag"
 
 // Flag represents a FHIR Flag.
 type Flag struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -37,3 +36,8 @@ type Flag struct {
 	// Flag creator
 	Author *Reference `json:"author,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "Flag", the FHIR type of the resource.
+func (f *Flag) ResourceType() string {
+	return ResourceTypeFlag
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -113,7 +112,7 @@ type GraphDefinitionLink struct {
 
 // GraphDefinition represents a FHIR GraphDefinition.
 type GraphDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this graph definition, represented as a URI (globally unique)
@@ -169,3 +168,8 @@ type GraphDefinition struct {
 	// Links this graph makes rules about
 	Link []GraphDefinitionLink `json:"link,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "GraphDefinition", the FHIR type of the resource.
+func (g *GraphDefinition) ResourceType() string {
+	return ResourceTypeGraphDefinition
+}
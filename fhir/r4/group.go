@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,17 +25,17 @@ type GroupCharacteristic struct {
 	// Kind of characteristic
 	Code CodeableConcept `json:"code" fhir:"cardinality=1..1,required"`
 	// Value held by characteristic - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value held by characteristic - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value held by characteristic - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value held by characteristic - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value held by characteristic - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Group includes or excludes
 	Exclude bool `json:"exclude" fhir:"cardinality=1..1,required"`
 	// Extension for Exclude
@@ -45,6 +44,82 @@ type GroupCharacteristic struct {
 	Period *Period `json:"period,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (g *GroupCharacteristic) Value() any {
+	switch {
+	case g.ValueCodeableConcept != nil:
+		return g.ValueCodeableConcept
+	case g.ValueBoolean != nil:
+		return g.ValueBoolean
+	case g.ValueQuantity != nil:
+		return g.ValueQuantity
+	case g.ValueRange != nil:
+		return g.ValueRange
+	case g.ValueReference != nil:
+		return g.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (g *GroupCharacteristic) ValueType() string {
+	switch {
+	case g.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case g.ValueBoolean != nil:
+		return "boolean"
+	case g.ValueQuantity != nil:
+		return "Quantity"
+	case g.ValueRange != nil:
+		return "Range"
+	case g.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (g *GroupCharacteristic) ClearValue() {
+	g.ValueCodeableConcept = nil
+	g.ValueBoolean = nil
+	g.ValueBooleanExt = nil
+	g.ValueQuantity = nil
+	g.ValueRange = nil
+	g.ValueReference = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (g *GroupCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	g.ClearValue()
+	g.ValueCodeableConcept = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (g *GroupCharacteristic) SetValueBoolean(value bool) {
+	ext := g.ValueBooleanExt
+	g.ClearValue()
+	g.ValueBoolean, g.ValueBooleanExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (g *GroupCharacteristic) SetValueQuantity(value Quantity) {
+	g.ClearValue()
+	g.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (g *GroupCharacteristic) SetValueRange(value Range) {
+	g.ClearValue()
+	g.ValueRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (g *GroupCharacteristic) SetValueReference(value Reference) {
+	g.ClearValue()
+	g.ValueReference = &value
+}
+
 // GroupMember represents a FHIR BackboneElement for Group.member.
 type GroupMember struct {
 	// Unique id for inter-element referencing
@@ -67,7 +142,7 @@ type GroupMember struct {
 
 // Group represents a FHIR Group.
 type Group struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique id
@@ -101,3 +176,8 @@ type Group struct {
 	// Who or what is in group
 	Member []GroupMember `json:"member,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Group", the FHIR type of the resource.
+func (g *Group) ResourceType() string {
+	return ResourceTypeGroup
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -79,7 +78,7 @@ type HealthcareServiceNotAvailable struct {
 
 // HealthcareService represents a FHIR HealthcareService.
 type HealthcareService struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifiers for this item
@@ -143,3 +142,8 @@ type HealthcareService struct {
 	// Technical endpoints providing access to electronic services operated for the healthcare service
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "HealthcareService", the FHIR type of the resource.
+func (h *HealthcareService) ResourceType() string {
+	return ResourceTypeHealthcareService
+}
@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type HumanName struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// usual | official | temp | nickname | anonymous | old | maiden
-	Use *string `json:"use,omitempty" fhir:"cardinality=0..1,summary"`
+	Use *NameUse `json:"use,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/name-use|4.0.1,summary,type=code"`
 	// Extension for Use
 	UseExt *primitives.PrimitiveExtension `json:"_use,omitempty" fhir:"cardinality=0..1"`
 	// Text representation of the full name
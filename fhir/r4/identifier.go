@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,13 +18,13 @@ type Identifier struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// usual | official | temp | secondary | old (If known)
-	Use *string `json:"use,omitempty" fhir:"cardinality=0..1,summary"`
+	Use *IdentifierUse `json:"use,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/identifier-use|4.0.1,summary,type=code"`
 	// Extension for Use
 	UseExt *primitives.PrimitiveExtension `json:"_use,omitempty" fhir:"cardinality=0..1"`
 	// Description of identifier
-	Type *CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..1,summary"`
+	Type *CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..1,binding=extensible:http://hl7.org/fhir/ValueSet/identifier-type,summary"`
 	// The namespace for the identifier value
-	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
+	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	// Extension for System
 	SystemExt *primitives.PrimitiveExtension `json:"_system,omitempty" fhir:"cardinality=0..1"`
 	// The value that is unique
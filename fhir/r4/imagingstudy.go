@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -103,7 +102,7 @@ type ImagingStudySeries struct {
 
 // ImagingStudy represents a FHIR ImagingStudy.
 type ImagingStudy struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifiers for the whole study
@@ -157,3 +156,8 @@ type ImagingStudy struct {
 	// Each study has one or more series of instances
 	Series []ImagingStudySeries `json:"series,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "ImagingStudy", the FHIR type of the resource.
+func (i *ImagingStudy) ResourceType() string {
+	return ResourceTypeImagingStudy
+}
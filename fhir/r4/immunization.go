@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -98,11 +97,11 @@ type ImmunizationProtocolApplied struct {
 	// Vaccine preventatable disease being targetted
 	TargetDisease []CodeableConcept `json:"targetDisease,omitempty" fhir:"cardinality=0..*"`
 	// Dose number within series - positiveInt option
-	DoseNumberPositiveInt int `json:"doseNumberPositiveInt" fhir:"cardinality=1..1,required,choice=doseNumber"`
+	DoseNumberPositiveInt *int `json:"doseNumberPositiveInt,omitempty" fhir:"cardinality=1..1,required,choice=doseNumber"`
 	// Extension for DoseNumberPositiveInt
 	DoseNumberPositiveIntExt *primitives.PrimitiveExtension `json:"_doseNumberPositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Dose number within series - string option
-	DoseNumberString string `json:"doseNumberString" fhir:"cardinality=1..1,required,choice=doseNumber"`
+	DoseNumberString *string `json:"doseNumberString,omitempty" fhir:"cardinality=1..1,required,choice=doseNumber"`
 	// Extension for DoseNumberString
 	DoseNumberStringExt *primitives.PrimitiveExtension `json:"_doseNumberString,omitempty" fhir:"cardinality=0..1"`
 	// Recommended number of doses for immunity - positiveInt option
@@ -115,9 +114,99 @@ type ImmunizationProtocolApplied struct {
 	SeriesDosesStringExt *primitives.PrimitiveExtension `json:"_seriesDosesString,omitempty" fhir:"cardinality=0..1"`
 }
 
+// DoseNumber returns the option of doseNumber[x] that is set, or nil if none is.
+func (i *ImmunizationProtocolApplied) DoseNumber() any {
+	switch {
+	case i.DoseNumberPositiveInt != nil:
+		return i.DoseNumberPositiveInt
+	case i.DoseNumberString != nil:
+		return i.DoseNumberString
+	}
+	return nil
+}
+
+// DoseNumberType returns the FHIR type of the option of doseNumber[x] that is
+// set, such as "positiveInt", or "" if none is.
+func (i *ImmunizationProtocolApplied) DoseNumberType() string {
+	switch {
+	case i.DoseNumberPositiveInt != nil:
+		return "positiveInt"
+	case i.DoseNumberString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearDoseNumber unsets every option of doseNumber[x], and their extensions.
+func (i *ImmunizationProtocolApplied) ClearDoseNumber() {
+	i.DoseNumberPositiveInt = nil
+	i.DoseNumberPositiveIntExt = nil
+	i.DoseNumberString = nil
+	i.DoseNumberStringExt = nil
+}
+
+// SetDoseNumberPositiveInt sets the positiveInt option of doseNumber[x], clearing the others.
+func (i *ImmunizationProtocolApplied) SetDoseNumberPositiveInt(value int) {
+	ext := i.DoseNumberPositiveIntExt
+	i.ClearDoseNumber()
+	i.DoseNumberPositiveInt, i.DoseNumberPositiveIntExt = &value, ext
+}
+
+// SetDoseNumberString sets the string option of doseNumber[x], clearing the others.
+func (i *ImmunizationProtocolApplied) SetDoseNumberString(value string) {
+	ext := i.DoseNumberStringExt
+	i.ClearDoseNumber()
+	i.DoseNumberString, i.DoseNumberStringExt = &value, ext
+}
+
+// SeriesDoses returns the option of seriesDoses[x] that is set, or nil if none is.
+func (i *ImmunizationProtocolApplied) SeriesDoses() any {
+	switch {
+	case i.SeriesDosesPositiveInt != nil:
+		return i.SeriesDosesPositiveInt
+	case i.SeriesDosesString != nil:
+		return i.SeriesDosesString
+	}
+	return nil
+}
+
+// SeriesDosesType returns the FHIR type of the option of seriesDoses[x] that is
+// set, such as "positiveInt", or "" if none is.
+func (i *ImmunizationProtocolApplied) SeriesDosesType() string {
+	switch {
+	case i.SeriesDosesPositiveInt != nil:
+		return "positiveInt"
+	case i.SeriesDosesString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearSeriesDoses unsets every option of seriesDoses[x], and their extensions.
+func (i *ImmunizationProtocolApplied) ClearSeriesDoses() {
+	i.SeriesDosesPositiveInt = nil
+	i.SeriesDosesPositiveIntExt = nil
+	i.SeriesDosesString = nil
+	i.SeriesDosesStringExt = nil
+}
+
+// SetSeriesDosesPositiveInt sets the positiveInt option of seriesDoses[x], clearing the others.
+func (i *ImmunizationProtocolApplied) SetSeriesDosesPositiveInt(value int) {
+	ext := i.SeriesDosesPositiveIntExt
+	i.ClearSeriesDoses()
+	i.SeriesDosesPositiveInt, i.SeriesDosesPositiveIntExt = &value, ext
+}
+
+// SetSeriesDosesString sets the string option of seriesDoses[x], clearing the others.
+func (i *ImmunizationProtocolApplied) SetSeriesDosesString(value string) {
+	ext := i.SeriesDosesStringExt
+	i.ClearSeriesDoses()
+	i.SeriesDosesString, i.SeriesDosesStringExt = &value, ext
+}
+
 // Immunization represents a FHIR Immunization.
 type Immunization struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -135,11 +224,11 @@ type Immunization struct {
 	// Encounter immunization was part of
 	Encounter *Reference `json:"encounter,omitempty" fhir:"cardinality=0..1"`
 	// Vaccine administration date - dateTime option
-	OccurrenceDateTime primitives.DateTime `json:"occurrenceDateTime" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
+	OccurrenceDateTime *primitives.DateTime `json:"occurrenceDateTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
 	// Extension for OccurrenceDateTime
 	OccurrenceDateTimeExt *primitives.PrimitiveExtension `json:"_occurrenceDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Vaccine administration date - string option
-	OccurrenceString string `json:"occurrenceString" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
+	OccurrenceString *string `json:"occurrenceString,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
 	// Extension for OccurrenceString
 	OccurrenceStringExt *primitives.PrimitiveExtension `json:"_occurrenceString,omitempty" fhir:"cardinality=0..1"`
 	// When the immunization was first captured in the subject's record
@@ -195,3 +284,53 @@ type Immunization struct {
 	// Protocol followed by the provider
 	ProtocolApplied []ImmunizationProtocolApplied `json:"protocolApplied,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Immunization", the FHIR type of the resource.
+func (i *Immunization) ResourceType() string {
+	return ResourceTypeImmunization
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (i *Immunization) Occurrence() any {
+	switch {
+	case i.OccurrenceDateTime != nil:
+		return i.OccurrenceDateTime
+	case i.OccurrenceString != nil:
+		return i.OccurrenceString
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (i *Immunization) OccurrenceType() string {
+	switch {
+	case i.OccurrenceDateTime != nil:
+		return "dateTime"
+	case i.OccurrenceString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (i *Immunization) ClearOccurrence() {
+	i.OccurrenceDateTime = nil
+	i.OccurrenceDateTimeExt = nil
+	i.OccurrenceString = nil
+	i.OccurrenceStringExt = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (i *Immunization) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := i.OccurrenceDateTimeExt
+	i.ClearOccurrence()
+	i.OccurrenceDateTime, i.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrenceString sets the string option of occurrence[x], clearing the others.
+func (i *Immunization) SetOccurrenceString(value string) {
+	ext := i.OccurrenceStringExt
+	i.ClearOccurrence()
+	i.OccurrenceString, i.OccurrenceStringExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeImmunizationEvaluation = "ImmunizationEvaluation"
 
 // ImmunizationEvaluation represents a FHIR ImmunizationEvaluation.
 type ImmunizationEvaluation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -65,3 +64,98 @@ type ImmunizationEvaluation struct {
 	// Extension for SeriesDosesString
 	SeriesDosesStringExt *primitives.PrimitiveExtension `json:"_seriesDosesString,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ImmunizationEvaluation", the FHIR type of the resource.
+func (i *ImmunizationEvaluation) ResourceType() string {
+	return ResourceTypeImmunizationEvaluation
+}
+
+// DoseNumber returns the option of doseNumber[x] that is set, or nil if none is.
+func (i *ImmunizationEvaluation) DoseNumber() any {
+	switch {
+	case i.DoseNumberPositiveInt != nil:
+		return i.DoseNumberPositiveInt
+	case i.DoseNumberString != nil:
+		return i.DoseNumberString
+	}
+	return nil
+}
+
+// DoseNumberType returns the FHIR type of the option of doseNumber[x] that is
+// set, such as "positiveInt", or "" if none is.
+func (i *ImmunizationEvaluation) DoseNumberType() string {
+	switch {
+	case i.DoseNumberPositiveInt != nil:
+		return "positiveInt"
+	case i.DoseNumberString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearDoseNumber unsets every option of doseNumber[x], and their extensions.
+func (i *ImmunizationEvaluation) ClearDoseNumber() {
+	i.DoseNumberPositiveInt = nil
+	i.DoseNumberPositiveIntExt = nil
+	i.DoseNumberString = nil
+	i.DoseNumberStringExt = nil
+}
+
+// SetDoseNumberPositiveInt sets the positiveInt option of doseNumber[x], clearing the others.
+func (i *ImmunizationEvaluation) SetDoseNumberPositiveInt(value int) {
+	ext := i.DoseNumberPositiveIntExt
+	i.ClearDoseNumber()
+	i.DoseNumberPositiveInt, i.DoseNumberPositiveIntExt = &value, ext
+}
+
+// SetDoseNumberString sets the string option of doseNumber[x], clearing the others.
+func (i *ImmunizationEvaluation) SetDoseNumberString(value string) {
+	ext := i.DoseNumberStringExt
+	i.ClearDoseNumber()
+	i.DoseNumberString, i.DoseNumberStringExt = &value, ext
+}
+
+// SeriesDoses returns the option of seriesDoses[x] that is set, or nil if none is.
+func (i *ImmunizationEvaluation) SeriesDoses() any {
+	switch {
+	case i.SeriesDosesPositiveInt != nil:
+		return i.SeriesDosesPositiveInt
+	case i.SeriesDosesString != nil:
+		return i.SeriesDosesString
+	}
+	return nil
+}
+
+// SeriesDosesType returns the FHIR type of the option of seriesDoses[x] that is
+// set, such as "positiveInt", or "" if none is.
+func (i *ImmunizationEvaluation) SeriesDosesType() string {
+	switch {
+	case i.SeriesDosesPositiveInt != nil:
+		return "positiveInt"
+	case i.SeriesDosesString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearSeriesDoses unsets every option of seriesDoses[x], and their extensions.
+func (i *ImmunizationEvaluation) ClearSeriesDoses() {
+	i.SeriesDosesPositiveInt = nil
+	i.SeriesDosesPositiveIntExt = nil
+	i.SeriesDosesString = nil
+	i.SeriesDosesStringExt = nil
+}
+
+// SetSeriesDosesPositiveInt sets the positiveInt option of seriesDoses[x], clearing the others.
+func (i *ImmunizationEvaluation) SetSeriesDosesPositiveInt(value int) {
+	ext := i.SeriesDosesPositiveIntExt
+	i.ClearSeriesDoses()
+	i.SeriesDosesPositiveInt, i.SeriesDosesPositiveIntExt = &value, ext
+}
+
+// SetSeriesDosesString sets the string option of seriesDoses[x], clearing the others.
+func (i *ImmunizationEvaluation) SetSeriesDosesString(value string) {
+	ext := i.SeriesDosesStringExt
+	i.ClearSeriesDoses()
+	i.SeriesDosesString, i.SeriesDosesStringExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -83,9 +82,99 @@ type ImmunizationRecommendationRecommendation struct {
 	SupportingPatientInformation []Reference `json:"supportingPatientInformation,omitempty" fhir:"cardinality=0..*"`
 }
 
+// DoseNumber returns the option of doseNumber[x] that is set, or nil if none is.
+func (i *ImmunizationRecommendationRecommendation) DoseNumber() any {
+	switch {
+	case i.DoseNumberPositiveInt != nil:
+		return i.DoseNumberPositiveInt
+	case i.DoseNumberString != nil:
+		return i.DoseNumberString
+	}
+	return nil
+}
+
+// DoseNumberType returns the FHIR type of the option of doseNumber[x] that is
+// set, such as "positiveInt", or "" if none is.
+func (i *ImmunizationRecommendationRecommendation) DoseNumberType() string {
+	switch {
+	case i.DoseNumberPositiveInt != nil:
+		return "positiveInt"
+	case i.DoseNumberString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearDoseNumber unsets every option of doseNumber[x], and their extensions.
+func (i *ImmunizationRecommendationRecommendation) ClearDoseNumber() {
+	i.DoseNumberPositiveInt = nil
+	i.DoseNumberPositiveIntExt = nil
+	i.DoseNumberString = nil
+	i.DoseNumberStringExt = nil
+}
+
+// SetDoseNumberPositiveInt sets the positiveInt option of doseNumber[x], clearing the others.
+func (i *ImmunizationRecommendationRecommendation) SetDoseNumberPositiveInt(value int) {
+	ext := i.DoseNumberPositiveIntExt
+	i.ClearDoseNumber()
+	i.DoseNumberPositiveInt, i.DoseNumberPositiveIntExt = &value, ext
+}
+
+// SetDoseNumberString sets the string option of doseNumber[x], clearing the others.
+func (i *ImmunizationRecommendationRecommendation) SetDoseNumberString(value string) {
+	ext := i.DoseNumberStringExt
+	i.ClearDoseNumber()
+	i.DoseNumberString, i.DoseNumberStringExt = &value, ext
+}
+
+// SeriesDoses returns the option of seriesDoses[x] that is set, or nil if none is.
+func (i *ImmunizationRecommendationRecommendation) SeriesDoses() any {
+	switch {
+	case i.SeriesDosesPositiveInt != nil:
+		return i.SeriesDosesPositiveInt
+	case i.SeriesDosesString != nil:
+		return i.SeriesDosesString
+	}
+	return nil
+}
+
+// SeriesDosesType returns the FHIR type of the option of seriesDoses[x] that is
+// set, such as "positiveInt", or "" if none is.
+func (i *ImmunizationRecommendationRecommendation) SeriesDosesType() string {
+	switch {
+	case i.SeriesDosesPositiveInt != nil:
+		return "positiveInt"
+	case i.SeriesDosesString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearSeriesDoses unsets every option of seriesDoses[x], and their extensions.
+func (i *ImmunizationRecommendationRecommendation) ClearSeriesDoses() {
+	i.SeriesDosesPositiveInt = nil
+	i.SeriesDosesPositiveIntExt = nil
+	i.SeriesDosesString = nil
+	i.SeriesDosesStringExt = nil
+}
+
+// SetSeriesDosesPositiveInt sets the positiveInt option of seriesDoses[x], clearing the others.
+func (i *ImmunizationRecommendationRecommendation) SetSeriesDosesPositiveInt(value int) {
+	ext := i.SeriesDosesPositiveIntExt
+	i.ClearSeriesDoses()
+	i.SeriesDosesPositiveInt, i.SeriesDosesPositiveIntExt = &value, ext
+}
+
+// SetSeriesDosesString sets the string option of seriesDoses[x], clearing the others.
+func (i *ImmunizationRecommendationRecommendation) SetSeriesDosesString(value string) {
+	ext := i.SeriesDosesStringExt
+	i.ClearSeriesDoses()
+	i.SeriesDosesString, i.SeriesDosesStringExt = &value, ext
+}
+
 // ImmunizationRecommendation represents a FHIR ImmunizationRecommendation.
 type ImmunizationRecommendation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -101,3 +190,8 @@ type ImmunizationRecommendation struct {
 	// Vaccine administration recommendations
 	Recommendation []ImmunizationRecommendationRecommendation `json:"recommendation,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "ImmunizationRecommendation", the FHIR type of the resource.
+func (i *ImmunizationRecommendation) ResourceType() string {
+	return ResourceTypeImmunizationRecommendation
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -106,7 +105,7 @@ type ImplementationGuideDefinitionResource struct {
 	// Extension for ExampleBoolean
 	ExampleBooleanExt *primitives.PrimitiveExtension `json:"_exampleBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Is an example/What is this an example of? - canonical option
-	ExampleCanonical *string `json:"exampleCanonical,omitempty" fhir:"cardinality=0..1,choice=example"`
+	ExampleCanonical *primitives.Canonical `json:"exampleCanonical,omitempty" fhir:"cardinality=0..1,choice=example"`
 	// Extension for ExampleCanonical
 	ExampleCanonicalExt *primitives.PrimitiveExtension `json:"_exampleCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Grouping this is part of
@@ -115,6 +114,51 @@ type ImplementationGuideDefinitionResource struct {
 	GroupingIdExt *primitives.PrimitiveExtension `json:"_groupingId,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Example returns the option of example[x] that is set, or nil if none is.
+func (i *ImplementationGuideDefinitionResource) Example() any {
+	switch {
+	case i.ExampleBoolean != nil:
+		return i.ExampleBoolean
+	case i.ExampleCanonical != nil:
+		return i.ExampleCanonical
+	}
+	return nil
+}
+
+// ExampleType returns the FHIR type of the option of example[x] that is
+// set, such as "boolean", or "" if none is.
+func (i *ImplementationGuideDefinitionResource) ExampleType() string {
+	switch {
+	case i.ExampleBoolean != nil:
+		return "boolean"
+	case i.ExampleCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearExample unsets every option of example[x], and their extensions.
+func (i *ImplementationGuideDefinitionResource) ClearExample() {
+	i.ExampleBoolean = nil
+	i.ExampleBooleanExt = nil
+	i.ExampleCanonical = nil
+	i.ExampleCanonicalExt = nil
+}
+
+// SetExampleBoolean sets the boolean option of example[x], clearing the others.
+func (i *ImplementationGuideDefinitionResource) SetExampleBoolean(value bool) {
+	ext := i.ExampleBooleanExt
+	i.ClearExample()
+	i.ExampleBoolean, i.ExampleBooleanExt = &value, ext
+}
+
+// SetExampleCanonical sets the canonical option of example[x], clearing the others.
+func (i *ImplementationGuideDefinitionResource) SetExampleCanonical(value primitives.Canonical) {
+	ext := i.ExampleCanonicalExt
+	i.ClearExample()
+	i.ExampleCanonical, i.ExampleCanonicalExt = &value, ext
+}
+
 // ImplementationGuideDefinitionPagePage represents a FHIR BackboneElement for ImplementationGuide.definition.page.page.
 type ImplementationGuideDefinitionPagePage struct {
 }
@@ -130,11 +174,11 @@ type ImplementationGuideDefinitionPage struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Where to find that page - url option
-	NameURL string `json:"nameURL" fhir:"cardinality=1..1,required,choice=name"`
+	NameURL *string `json:"nameUrl,omitempty" fhir:"cardinality=1..1,required,choice=name"`
 	// Extension for NameURL
-	NameURLExt *primitives.PrimitiveExtension `json:"_nameURL,omitempty" fhir:"cardinality=0..1"`
+	NameURLExt *primitives.PrimitiveExtension `json:"_nameUrl,omitempty" fhir:"cardinality=0..1"`
 	// Where to find that page - Reference option
-	NameReference Reference `json:"nameReference" fhir:"cardinality=1..1,required,choice=name"`
+	NameReference *Reference `json:"nameReference,omitempty" fhir:"cardinality=1..1,required,choice=name"`
 	// Short title shown for navigational assistance
 	Title string `json:"title" fhir:"cardinality=1..1,required"`
 	// Extension for Title
@@ -147,6 +191,49 @@ type ImplementationGuideDefinitionPage struct {
 	Page []ImplementationGuideDefinitionPagePage `json:"page,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Name returns the option of name[x] that is set, or nil if none is.
+func (i *ImplementationGuideDefinitionPage) Name() any {
+	switch {
+	case i.NameURL != nil:
+		return i.NameURL
+	case i.NameReference != nil:
+		return i.NameReference
+	}
+	return nil
+}
+
+// NameType returns the FHIR type of the option of name[x] that is
+// set, such as "url", or "" if none is.
+func (i *ImplementationGuideDefinitionPage) NameType() string {
+	switch {
+	case i.NameURL != nil:
+		return "url"
+	case i.NameReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearName unsets every option of name[x], and their extensions.
+func (i *ImplementationGuideDefinitionPage) ClearName() {
+	i.NameURL = nil
+	i.NameURLExt = nil
+	i.NameReference = nil
+}
+
+// SetNameURL sets the url option of name[x], clearing the others.
+func (i *ImplementationGuideDefinitionPage) SetNameURL(value string) {
+	ext := i.NameURLExt
+	i.ClearName()
+	i.NameURL, i.NameURLExt = &value, ext
+}
+
+// SetNameReference sets the Reference option of name[x], clearing the others.
+func (i *ImplementationGuideDefinitionPage) SetNameReference(value Reference) {
+	i.ClearName()
+	i.NameReference = &value
+}
+
 // ImplementationGuideDefinitionParameter represents a FHIR BackboneElement for ImplementationGuide.definition.parameter.
 type ImplementationGuideDefinitionParameter struct {
 	// Unique id for inter-element referencing
@@ -230,7 +317,7 @@ type ImplementationGuideManifestResource struct {
 	// Extension for ExampleBoolean
 	ExampleBooleanExt *primitives.PrimitiveExtension `json:"_exampleBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Is an example/What is this an example of? - canonical option
-	ExampleCanonical *string `json:"exampleCanonical,omitempty" fhir:"cardinality=0..1,choice=example"`
+	ExampleCanonical *primitives.Canonical `json:"exampleCanonical,omitempty" fhir:"cardinality=0..1,choice=example"`
 	// Extension for ExampleCanonical
 	ExampleCanonicalExt *primitives.PrimitiveExtension `json:"_exampleCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Relative path for page in IG
@@ -239,6 +326,51 @@ type ImplementationGuideManifestResource struct {
 	RelativePathExt *primitives.PrimitiveExtension `json:"_relativePath,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Example returns the option of example[x] that is set, or nil if none is.
+func (i *ImplementationGuideManifestResource) Example() any {
+	switch {
+	case i.ExampleBoolean != nil:
+		return i.ExampleBoolean
+	case i.ExampleCanonical != nil:
+		return i.ExampleCanonical
+	}
+	return nil
+}
+
+// ExampleType returns the FHIR type of the option of example[x] that is
+// set, such as "boolean", or "" if none is.
+func (i *ImplementationGuideManifestResource) ExampleType() string {
+	switch {
+	case i.ExampleBoolean != nil:
+		return "boolean"
+	case i.ExampleCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearExample unsets every option of example[x], and their extensions.
+func (i *ImplementationGuideManifestResource) ClearExample() {
+	i.ExampleBoolean = nil
+	i.ExampleBooleanExt = nil
+	i.ExampleCanonical = nil
+	i.ExampleCanonicalExt = nil
+}
+
+// SetExampleBoolean sets the boolean option of example[x], clearing the others.
+func (i *ImplementationGuideManifestResource) SetExampleBoolean(value bool) {
+	ext := i.ExampleBooleanExt
+	i.ClearExample()
+	i.ExampleBoolean, i.ExampleBooleanExt = &value, ext
+}
+
+// SetExampleCanonical sets the canonical option of example[x], clearing the others.
+func (i *ImplementationGuideManifestResource) SetExampleCanonical(value primitives.Canonical) {
+	ext := i.ExampleCanonicalExt
+	i.ClearExample()
+	i.ExampleCanonical, i.ExampleCanonicalExt = &value, ext
+}
+
 // ImplementationGuideManifestPage represents a FHIR BackboneElement for ImplementationGuide.manifest.page.
 type ImplementationGuideManifestPage struct {
 	// Unique id for inter-element referencing
@@ -293,7 +425,7 @@ type ImplementationGuideManifest struct {
 
 // ImplementationGuide represents a FHIR ImplementationGuide.
 type ImplementationGuide struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this implementation guide, represented as a URI (globally unique)
@@ -363,3 +495,8 @@ type ImplementationGuide struct {
 	// Information about an assembled IG
 	Manifest *ImplementationGuideManifest `json:"manifest,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ImplementationGuide", the FHIR type of the resource.
+func (i *ImplementationGuide) ResourceType() string {
+	return ResourceTypeImplementationGuide
+}
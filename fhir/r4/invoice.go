@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -46,7 +45,7 @@ type InvoiceLineItemPriceComponent struct {
 	// Code identifying the specific component
 	Code *CodeableConcept `json:"code,omitempty" fhir:"cardinality=0..1"`
 	// Factor used for calculating this component
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Monetary amount associated with this component
@@ -68,20 +67,61 @@ type InvoiceLineItem struct {
 	// Extension for Sequence
 	SequenceExt *primitives.PrimitiveExtension `json:"_sequence,omitempty" fhir:"cardinality=0..1"`
 	// Reference to ChargeItem containing details of this line item or an inline billing code - Reference option
-	ChargeItemReference Reference `json:"chargeItemReference" fhir:"cardinality=1..1,required,choice=chargeItem"`
+	ChargeItemReference *Reference `json:"chargeItemReference,omitempty" fhir:"cardinality=1..1,required,choice=chargeItem"`
 	// Reference to ChargeItem containing details of this line item or an inline billing code - CodeableConcept option
-	ChargeItemCodeableConcept CodeableConcept `json:"chargeItemCodeableConcept" fhir:"cardinality=1..1,required,choice=chargeItem"`
+	ChargeItemCodeableConcept *CodeableConcept `json:"chargeItemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=chargeItem"`
 	// Components of total line item price
 	PriceComponent []InvoiceLineItemPriceComponent `json:"priceComponent,omitempty" fhir:"cardinality=0..*"`
 }
 
+// ChargeItem returns the option of chargeItem[x] that is set, or nil if none is.
+func (i *InvoiceLineItem) ChargeItem() any {
+	switch {
+	case i.ChargeItemReference != nil:
+		return i.ChargeItemReference
+	case i.ChargeItemCodeableConcept != nil:
+		return i.ChargeItemCodeableConcept
+	}
+	return nil
+}
+
+// ChargeItemType returns the FHIR type of the option of chargeItem[x] that is
+// set, such as "Reference", or "" if none is.
+func (i *InvoiceLineItem) ChargeItemType() string {
+	switch {
+	case i.ChargeItemReference != nil:
+		return "Reference"
+	case i.ChargeItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearChargeItem unsets every option of chargeItem[x], and their extensions.
+func (i *InvoiceLineItem) ClearChargeItem() {
+	i.ChargeItemReference = nil
+	i.ChargeItemCodeableConcept = nil
+}
+
+// SetChargeItemReference sets the Reference option of chargeItem[x], clearing the others.
+func (i *InvoiceLineItem) SetChargeItemReference(value Reference) {
+	i.ClearChargeItem()
+	i.ChargeItemReference = &value
+}
+
+// SetChargeItemCodeableConcept sets the CodeableConcept option of chargeItem[x], clearing the others.
+func (i *InvoiceLineItem) SetChargeItemCodeableConcept(value CodeableConcept) {
+	i.ClearChargeItem()
+	i.ChargeItemCodeableConcept = &value
+}
+
 // InvoiceTotalPriceComponent represents a FHIR BackboneElement for Invoice.totalPriceComponent.
 type InvoiceTotalPriceComponent struct {
 }
 
 // Invoice represents a FHIR Invoice.
 type Invoice struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for item
@@ -125,3 +165,8 @@ type Invoice struct {
 	// Comments made about the invoice
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Invoice", the FHIR type of the resource.
+func (i *Invoice) ResourceType() string {
+	return ResourceTypeInvoice
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type LinkageItem struct {
 
 // Linkage represents a FHIR Linkage.
 type Linkage struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Whether this linkage assertion is active or not
@@ -45,3 +44,8 @@ type Linkage struct {
 	// Item to be linked
 	Item []LinkageItem `json:"item,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "Linkage", the FHIR type of the resource.
+func (l *Linkage) ResourceType() string {
+	return ResourceTypeLinkage
+}
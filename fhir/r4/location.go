@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,15 +23,15 @@ type LocationPosition struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Longitude with WGS84 datum
-	Longitude float64 `json:"longitude" fhir:"cardinality=1..1,required"`
+	Longitude primitives.Decimal `json:"longitude" fhir:"cardinality=1..1,required"`
 	// Extension for Longitude
 	LongitudeExt *primitives.PrimitiveExtension `json:"_longitude,omitempty" fhir:"cardinality=0..1"`
 	// Latitude with WGS84 datum
-	Latitude float64 `json:"latitude" fhir:"cardinality=1..1,required"`
+	Latitude primitives.Decimal `json:"latitude" fhir:"cardinality=1..1,required"`
 	// Extension for Latitude
 	LatitudeExt *primitives.PrimitiveExtension `json:"_latitude,omitempty" fhir:"cardinality=0..1"`
 	// Altitude with WGS84 datum
-	Altitude *float64 `json:"altitude,omitempty" fhir:"cardinality=0..1"`
+	Altitude *primitives.Decimal `json:"altitude,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Altitude
 	AltitudeExt *primitives.PrimitiveExtension `json:"_altitude,omitempty" fhir:"cardinality=0..1"`
 }
@@ -67,7 +66,7 @@ type LocationHoursOfOperation struct {
 
 // Location represents a FHIR Location.
 type Location struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique code or number identifying the location to its users
@@ -117,3 +116,8 @@ type Location struct {
 	// Technical endpoints providing access to services operated for the location
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Location", the FHIR type of the resource.
+func (l *Location) ResourceType() string {
+	return ResourceTypeLocation
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -121,7 +120,7 @@ type MeasureSupplementalData struct {
 
 // Measure represents a FHIR Measure.
 type Measure struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this measure, represented as a URI (globally unique)
@@ -255,3 +254,49 @@ type Measure struct {
 	// What other data should be reported with the measure
 	SupplementalData []MeasureSupplementalData `json:"supplementalData,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Measure", the FHIR type of the resource.
+func (m *Measure) ResourceType() string {
+	return ResourceTypeMeasure
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (m *Measure) Subject() any {
+	switch {
+	case m.SubjectCodeableConcept != nil:
+		return m.SubjectCodeableConcept
+	case m.SubjectReference != nil:
+		return m.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *Measure) SubjectType() string {
+	switch {
+	case m.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (m *Measure) ClearSubject() {
+	m.SubjectCodeableConcept = nil
+	m.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (m *Measure) SetSubjectCodeableConcept(value CodeableConcept) {
+	m.ClearSubject()
+	m.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (m *Measure) SetSubjectReference(value Reference) {
+	m.ClearSubject()
+	m.SubjectReference = &value
+}
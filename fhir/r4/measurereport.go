@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -127,7 +126,7 @@ type MeasureReportGroup struct {
 
 // MeasureReport represents a FHIR MeasureReport.
 type MeasureReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Additional identifier for the MeasureReport
@@ -161,3 +160,8 @@ type MeasureReport struct {
 	// What data was used to calculate the measure score
 	EvaluatedResource []Reference `json:"evaluatedResource,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MeasureReport", the FHIR type of the resource.
+func (m *MeasureReport) ResourceType() string {
+	return ResourceTypeMeasureReport
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeMedia = "Media"
 
 // Media represents a FHIR Media.
 type Media struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier(s) for the image
@@ -73,7 +72,7 @@ type Media struct {
 	// Extension for Frames
 	FramesExt *primitives.PrimitiveExtension `json:"_frames,omitempty" fhir:"cardinality=0..1"`
 	// Length in seconds (audio / video)
-	Duration *float64 `json:"duration,omitempty" fhir:"cardinality=0..1,summary"`
+	Duration *primitives.Decimal `json:"duration,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Duration
 	DurationExt *primitives.PrimitiveExtension `json:"_duration,omitempty" fhir:"cardinality=0..1"`
 	// Actual Media - reference or data
@@ -81,3 +80,51 @@ type Media struct {
 	// Comments made about the media
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Media", the FHIR type of the resource.
+func (m *Media) ResourceType() string {
+	return ResourceTypeMedia
+}
+
+// Created returns the option of created[x] that is set, or nil if none is.
+func (m *Media) Created() any {
+	switch {
+	case m.CreatedDateTime != nil:
+		return m.CreatedDateTime
+	case m.CreatedPeriod != nil:
+		return m.CreatedPeriod
+	}
+	return nil
+}
+
+// CreatedType returns the FHIR type of the option of created[x] that is
+// set, such as "dateTime", or "" if none is.
+func (m *Media) CreatedType() string {
+	switch {
+	case m.CreatedDateTime != nil:
+		return "dateTime"
+	case m.CreatedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearCreated unsets every option of created[x], and their extensions.
+func (m *Media) ClearCreated() {
+	m.CreatedDateTime = nil
+	m.CreatedDateTimeExt = nil
+	m.CreatedPeriod = nil
+}
+
+// SetCreatedDateTime sets the dateTime option of created[x], clearing the others.
+func (m *Media) SetCreatedDateTime(value primitives.DateTime) {
+	ext := m.CreatedDateTimeExt
+	m.ClearCreated()
+	m.CreatedDateTime, m.CreatedDateTimeExt = &value, ext
+}
+
+// SetCreatedPeriod sets the Period option of created[x], clearing the others.
+func (m *Media) SetCreatedPeriod(value Period) {
+	m.ClearCreated()
+	m.CreatedPeriod = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,9 +23,9 @@ type MedicationIngredient struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// The actual ingredient or content - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=item"`
 	// The actual ingredient or content - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,choice=item"`
 	// Active ingredient indicator
 	IsActive *bool `json:"isActive,omitempty" fhir:"cardinality=0..1"`
 	// Extension for IsActive
@@ -35,6 +34,47 @@ type MedicationIngredient struct {
 	Strength *Ratio `json:"strength,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Item returns the option of item[x] that is set, or nil if none is.
+func (m *MedicationIngredient) Item() any {
+	switch {
+	case m.ItemCodeableConcept != nil:
+		return m.ItemCodeableConcept
+	case m.ItemReference != nil:
+		return m.ItemReference
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationIngredient) ItemType() string {
+	switch {
+	case m.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ItemReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (m *MedicationIngredient) ClearItem() {
+	m.ItemCodeableConcept = nil
+	m.ItemReference = nil
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (m *MedicationIngredient) SetItemCodeableConcept(value CodeableConcept) {
+	m.ClearItem()
+	m.ItemCodeableConcept = &value
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (m *MedicationIngredient) SetItemReference(value Reference) {
+	m.ClearItem()
+	m.ItemReference = &value
+}
+
 // MedicationBatch represents a FHIR BackboneElement for Medication.batch.
 type MedicationBatch struct {
 	// Unique id for inter-element referencing
@@ -57,7 +97,7 @@ type MedicationBatch struct {
 
 // Medication represents a FHIR Medication.
 type Medication struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this medication
@@ -79,3 +119,8 @@ type Medication struct {
 	// Details about packaged medications
 	Batch *MedicationBatch `json:"batch,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Medication", the FHIR type of the resource.
+func (m *Medication) ResourceType() string {
+	return ResourceTypeMedication
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -57,9 +56,50 @@ type MedicationAdministrationDosage struct {
 	RateQuantity *Quantity `json:"rateQuantity,omitempty" fhir:"cardinality=0..1,choice=rate"`
 }
 
+// Rate returns the option of rate[x] that is set, or nil if none is.
+func (m *MedicationAdministrationDosage) Rate() any {
+	switch {
+	case m.RateRatio != nil:
+		return m.RateRatio
+	case m.RateQuantity != nil:
+		return m.RateQuantity
+	}
+	return nil
+}
+
+// RateType returns the FHIR type of the option of rate[x] that is
+// set, such as "Ratio", or "" if none is.
+func (m *MedicationAdministrationDosage) RateType() string {
+	switch {
+	case m.RateRatio != nil:
+		return "Ratio"
+	case m.RateQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearRate unsets every option of rate[x], and their extensions.
+func (m *MedicationAdministrationDosage) ClearRate() {
+	m.RateRatio = nil
+	m.RateQuantity = nil
+}
+
+// SetRateRatio sets the Ratio option of rate[x], clearing the others.
+func (m *MedicationAdministrationDosage) SetRateRatio(value Ratio) {
+	m.ClearRate()
+	m.RateRatio = &value
+}
+
+// SetRateQuantity sets the Quantity option of rate[x], clearing the others.
+func (m *MedicationAdministrationDosage) SetRateQuantity(value Quantity) {
+	m.ClearRate()
+	m.RateQuantity = &value
+}
+
 // MedicationAdministration represents a FHIR MedicationAdministration.
 type MedicationAdministration struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -79,9 +119,9 @@ type MedicationAdministration struct {
 	// Type of medication usage
 	Category *CodeableConcept `json:"category,omitempty" fhir:"cardinality=0..1"`
 	// What was administered - CodeableConcept option
-	MedicationCodeableConcept CodeableConcept `json:"medicationCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// What was administered - Reference option
-	MedicationReference Reference `json:"medicationReference" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationReference *Reference `json:"medicationReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Who received medication
 	Subject Reference `json:"subject" fhir:"cardinality=1..1,required,summary"`
 	// Encounter or Episode of Care administered as part of
@@ -89,11 +129,11 @@ type MedicationAdministration struct {
 	// Additional information to support administration
 	SupportingInformation []Reference `json:"supportingInformation,omitempty" fhir:"cardinality=0..*"`
 	// Start and end time of administration - dateTime option
-	EffectiveDateTime primitives.DateTime `json:"effectiveDateTime" fhir:"cardinality=1..1,required,summary,choice=effective"`
+	EffectiveDateTime *primitives.DateTime `json:"effectiveDateTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=effective"`
 	// Extension for EffectiveDateTime
 	EffectiveDateTimeExt *primitives.PrimitiveExtension `json:"_effectiveDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Start and end time of administration - Period option
-	EffectivePeriod Period `json:"effectivePeriod" fhir:"cardinality=1..1,required,summary,choice=effective"`
+	EffectivePeriod *Period `json:"effectivePeriod,omitempty" fhir:"cardinality=1..1,required,summary,choice=effective"`
 	// Who performed the medication administration and what they did
 	Performer []MedicationAdministrationPerformer `json:"performer,omitempty" fhir:"cardinality=0..*,summary"`
 	// Reason administration performed
@@ -111,3 +151,92 @@ type MedicationAdministration struct {
 	// A list of events of interest in the lifecycle
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationAdministration", the FHIR type of the resource.
+func (m *MedicationAdministration) ResourceType() string {
+	return ResourceTypeMedicationAdministration
+}
+
+// Medication returns the option of medication[x] that is set, or nil if none is.
+func (m *MedicationAdministration) Medication() any {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return m.MedicationCodeableConcept
+	case m.MedicationReference != nil:
+		return m.MedicationReference
+	}
+	return nil
+}
+
+// MedicationType returns the FHIR type of the option of medication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationAdministration) MedicationType() string {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MedicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearMedication unsets every option of medication[x], and their extensions.
+func (m *MedicationAdministration) ClearMedication() {
+	m.MedicationCodeableConcept = nil
+	m.MedicationReference = nil
+}
+
+// SetMedicationCodeableConcept sets the CodeableConcept option of medication[x], clearing the others.
+func (m *MedicationAdministration) SetMedicationCodeableConcept(value CodeableConcept) {
+	m.ClearMedication()
+	m.MedicationCodeableConcept = &value
+}
+
+// SetMedicationReference sets the Reference option of medication[x], clearing the others.
+func (m *MedicationAdministration) SetMedicationReference(value Reference) {
+	m.ClearMedication()
+	m.MedicationReference = &value
+}
+
+// Effective returns the option of effective[x] that is set, or nil if none is.
+func (m *MedicationAdministration) Effective() any {
+	switch {
+	case m.EffectiveDateTime != nil:
+		return m.EffectiveDateTime
+	case m.EffectivePeriod != nil:
+		return m.EffectivePeriod
+	}
+	return nil
+}
+
+// EffectiveType returns the FHIR type of the option of effective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (m *MedicationAdministration) EffectiveType() string {
+	switch {
+	case m.EffectiveDateTime != nil:
+		return "dateTime"
+	case m.EffectivePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearEffective unsets every option of effective[x], and their extensions.
+func (m *MedicationAdministration) ClearEffective() {
+	m.EffectiveDateTime = nil
+	m.EffectiveDateTimeExt = nil
+	m.EffectivePeriod = nil
+}
+
+// SetEffectiveDateTime sets the dateTime option of effective[x], clearing the others.
+func (m *MedicationAdministration) SetEffectiveDateTime(value primitives.DateTime) {
+	ext := m.EffectiveDateTimeExt
+	m.ClearEffective()
+	m.EffectiveDateTime, m.EffectiveDateTimeExt = &value, ext
+}
+
+// SetEffectivePeriod sets the Period option of effective[x], clearing the others.
+func (m *MedicationAdministration) SetEffectivePeriod(value Period) {
+	m.ClearEffective()
+	m.EffectivePeriod = &value
+}
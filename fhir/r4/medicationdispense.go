@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type MedicationDispenseSubstitution struct {
 
 // MedicationDispense represents a FHIR MedicationDispense.
 type MedicationDispense struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -71,9 +70,9 @@ type MedicationDispense struct {
 	// Type of medication dispense
 	Category *CodeableConcept `json:"category,omitempty" fhir:"cardinality=0..1"`
 	// What medication was supplied - CodeableConcept option
-	MedicationCodeableConcept CodeableConcept `json:"medicationCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// What medication was supplied - Reference option
-	MedicationReference Reference `json:"medicationReference" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationReference *Reference `json:"medicationReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Who the dispense is for
 	Subject *Reference `json:"subject,omitempty" fhir:"cardinality=0..1,summary"`
 	// Encounter / Episode associated with event
@@ -115,3 +114,90 @@ type MedicationDispense struct {
 	// A list of relevant lifecycle events
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationDispense", the FHIR type of the resource.
+func (m *MedicationDispense) ResourceType() string {
+	return ResourceTypeMedicationDispense
+}
+
+// StatusReason returns the option of statusReason[x] that is set, or nil if none is.
+func (m *MedicationDispense) StatusReason() any {
+	switch {
+	case m.StatusReasonCodeableConcept != nil:
+		return m.StatusReasonCodeableConcept
+	case m.StatusReasonReference != nil:
+		return m.StatusReasonReference
+	}
+	return nil
+}
+
+// StatusReasonType returns the FHIR type of the option of statusReason[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationDispense) StatusReasonType() string {
+	switch {
+	case m.StatusReasonCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.StatusReasonReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearStatusReason unsets every option of statusReason[x], and their extensions.
+func (m *MedicationDispense) ClearStatusReason() {
+	m.StatusReasonCodeableConcept = nil
+	m.StatusReasonReference = nil
+}
+
+// SetStatusReasonCodeableConcept sets the CodeableConcept option of statusReason[x], clearing the others.
+func (m *MedicationDispense) SetStatusReasonCodeableConcept(value CodeableConcept) {
+	m.ClearStatusReason()
+	m.StatusReasonCodeableConcept = &value
+}
+
+// SetStatusReasonReference sets the Reference option of statusReason[x], clearing the others.
+func (m *MedicationDispense) SetStatusReasonReference(value Reference) {
+	m.ClearStatusReason()
+	m.StatusReasonReference = &value
+}
+
+// Medication returns the option of medication[x] that is set, or nil if none is.
+func (m *MedicationDispense) Medication() any {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return m.MedicationCodeableConcept
+	case m.MedicationReference != nil:
+		return m.MedicationReference
+	}
+	return nil
+}
+
+// MedicationType returns the FHIR type of the option of medication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationDispense) MedicationType() string {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MedicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearMedication unsets every option of medication[x], and their extensions.
+func (m *MedicationDispense) ClearMedication() {
+	m.MedicationCodeableConcept = nil
+	m.MedicationReference = nil
+}
+
+// SetMedicationCodeableConcept sets the CodeableConcept option of medication[x], clearing the others.
+func (m *MedicationDispense) SetMedicationCodeableConcept(value CodeableConcept) {
+	m.ClearMedication()
+	m.MedicationCodeableConcept = &value
+}
+
+// SetMedicationReference sets the Reference option of medication[x], clearing the others.
+func (m *MedicationDispense) SetMedicationReference(value Reference) {
+	m.ClearMedication()
+	m.MedicationReference = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -56,9 +55,9 @@ type MedicationKnowledgeIngredient struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Medication(s) or substance(s) contained in the medication - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=item"`
 	// Medication(s) or substance(s) contained in the medication - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,choice=item"`
 	// Active ingredient indicator
 	IsActive *bool `json:"isActive,omitempty" fhir:"cardinality=0..1"`
 	// Extension for IsActive
@@ -67,6 +66,47 @@ type MedicationKnowledgeIngredient struct {
 	Strength *Ratio `json:"strength,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Item returns the option of item[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeIngredient) Item() any {
+	switch {
+	case m.ItemCodeableConcept != nil:
+		return m.ItemCodeableConcept
+	case m.ItemReference != nil:
+		return m.ItemReference
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationKnowledgeIngredient) ItemType() string {
+	switch {
+	case m.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ItemReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (m *MedicationKnowledgeIngredient) ClearItem() {
+	m.ItemCodeableConcept = nil
+	m.ItemReference = nil
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (m *MedicationKnowledgeIngredient) SetItemCodeableConcept(value CodeableConcept) {
+	m.ClearItem()
+	m.ItemCodeableConcept = &value
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (m *MedicationKnowledgeIngredient) SetItemReference(value Reference) {
+	m.ClearItem()
+	m.ItemReference = &value
+}
+
 // MedicationKnowledgeCost represents a FHIR BackboneElement for MedicationKnowledge.cost.
 type MedicationKnowledgeCost struct {
 	// Unique id for inter-element referencing
@@ -132,15 +172,56 @@ type MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Specific characteristic that is relevant to the administration guideline - CodeableConcept option
-	CharacteristicCodeableConcept CodeableConcept `json:"characteristicCodeableConcept" fhir:"cardinality=1..1,required,choice=characteristic"`
+	CharacteristicCodeableConcept *CodeableConcept `json:"characteristicCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=characteristic"`
 	// Specific characteristic that is relevant to the administration guideline - Quantity option
-	CharacteristicQuantity Quantity `json:"characteristicQuantity" fhir:"cardinality=1..1,required,choice=characteristic"`
+	CharacteristicQuantity *Quantity `json:"characteristicQuantity,omitempty" fhir:"cardinality=1..1,required,choice=characteristic"`
 	// The specific characteristic
 	Value []string `json:"value,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Characteristic returns the option of characteristic[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) Characteristic() any {
+	switch {
+	case m.CharacteristicCodeableConcept != nil:
+		return m.CharacteristicCodeableConcept
+	case m.CharacteristicQuantity != nil:
+		return m.CharacteristicQuantity
+	}
+	return nil
+}
+
+// CharacteristicType returns the FHIR type of the option of characteristic[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) CharacteristicType() string {
+	switch {
+	case m.CharacteristicCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.CharacteristicQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearCharacteristic unsets every option of characteristic[x], and their extensions.
+func (m *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) ClearCharacteristic() {
+	m.CharacteristicCodeableConcept = nil
+	m.CharacteristicQuantity = nil
+}
+
+// SetCharacteristicCodeableConcept sets the CodeableConcept option of characteristic[x], clearing the others.
+func (m *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) SetCharacteristicCodeableConcept(value CodeableConcept) {
+	m.ClearCharacteristic()
+	m.CharacteristicCodeableConcept = &value
+}
+
+// SetCharacteristicQuantity sets the Quantity option of characteristic[x], clearing the others.
+func (m *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) SetCharacteristicQuantity(value Quantity) {
+	m.ClearCharacteristic()
+	m.CharacteristicQuantity = &value
+}
+
 // MedicationKnowledgeAdministrationGuidelines represents a FHIR BackboneElement for MedicationKnowledge.administrationGuidelines.
 type MedicationKnowledgeAdministrationGuidelines struct {
 	// Unique id for inter-element referencing
@@ -161,6 +242,47 @@ type MedicationKnowledgeAdministrationGuidelines struct {
 	PatientCharacteristics []MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics `json:"patientCharacteristics,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Indication returns the option of indication[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeAdministrationGuidelines) Indication() any {
+	switch {
+	case m.IndicationCodeableConcept != nil:
+		return m.IndicationCodeableConcept
+	case m.IndicationReference != nil:
+		return m.IndicationReference
+	}
+	return nil
+}
+
+// IndicationType returns the FHIR type of the option of indication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationKnowledgeAdministrationGuidelines) IndicationType() string {
+	switch {
+	case m.IndicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.IndicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearIndication unsets every option of indication[x], and their extensions.
+func (m *MedicationKnowledgeAdministrationGuidelines) ClearIndication() {
+	m.IndicationCodeableConcept = nil
+	m.IndicationReference = nil
+}
+
+// SetIndicationCodeableConcept sets the CodeableConcept option of indication[x], clearing the others.
+func (m *MedicationKnowledgeAdministrationGuidelines) SetIndicationCodeableConcept(value CodeableConcept) {
+	m.ClearIndication()
+	m.IndicationCodeableConcept = &value
+}
+
+// SetIndicationReference sets the Reference option of indication[x], clearing the others.
+func (m *MedicationKnowledgeAdministrationGuidelines) SetIndicationReference(value Reference) {
+	m.ClearIndication()
+	m.IndicationReference = &value
+}
+
 // MedicationKnowledgeMedicineClassification represents a FHIR BackboneElement for MedicationKnowledge.medicineClassification.
 type MedicationKnowledgeMedicineClassification struct {
 	// Unique id for inter-element referencing
@@ -214,11 +336,78 @@ type MedicationKnowledgeDrugCharacteristic struct {
 	// Description of the characteristic - Quantity option
 	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Description of the characteristic - base64Binary option
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeDrugCharacteristic) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueString != nil:
+		return m.ValueString
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueBase64Binary != nil:
+		return m.ValueBase64Binary
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationKnowledgeDrugCharacteristic) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueString != nil:
+		return "string"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueBase64Binary != nil:
+		return "base64Binary"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MedicationKnowledgeDrugCharacteristic) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueString = nil
+	m.ValueStringExt = nil
+	m.ValueQuantity = nil
+	m.ValueBase64Binary = nil
+	m.ValueBase64BinaryExt = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MedicationKnowledgeDrugCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (m *MedicationKnowledgeDrugCharacteristic) SetValueString(value string) {
+	ext := m.ValueStringExt
+	m.ClearValue()
+	m.ValueString, m.ValueStringExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MedicationKnowledgeDrugCharacteristic) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (m *MedicationKnowledgeDrugCharacteristic) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := m.ValueBase64BinaryExt
+	m.ClearValue()
+	m.ValueBase64Binary, m.ValueBase64BinaryExt = &value, ext
+}
+
 // MedicationKnowledgeRegulatorySubstitution represents a FHIR BackboneElement for MedicationKnowledge.regulatory.substitution.
 type MedicationKnowledgeRegulatorySubstitution struct {
 	// Unique id for inter-element referencing
@@ -307,7 +496,7 @@ type MedicationKnowledgeKinetics struct {
 
 // MedicationKnowledge represents a FHIR MedicationKnowledge.
 type MedicationKnowledge struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Code that identifies this medication
@@ -361,3 +550,8 @@ type MedicationKnowledge struct {
 	// The time course of drug absorption, distribution, metabolism and excretion of a medication from the body
 	Kinetics []MedicationKnowledgeKinetics `json:"kinetics,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationKnowledge", the FHIR type of the resource.
+func (m *MedicationKnowledge) ResourceType() string {
+	return ResourceTypeMedicationKnowledge
+}
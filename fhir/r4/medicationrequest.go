@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -68,18 +67,61 @@ type MedicationRequestSubstitution struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Whether substitution is allowed or not - boolean option
-	AllowedBoolean bool `json:"allowedBoolean" fhir:"cardinality=1..1,required,choice=allowed"`
+	AllowedBoolean *bool `json:"allowedBoolean,omitempty" fhir:"cardinality=1..1,required,choice=allowed"`
 	// Extension for AllowedBoolean
 	AllowedBooleanExt *primitives.PrimitiveExtension `json:"_allowedBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Whether substitution is allowed or not - CodeableConcept option
-	AllowedCodeableConcept CodeableConcept `json:"allowedCodeableConcept" fhir:"cardinality=1..1,required,choice=allowed"`
+	AllowedCodeableConcept *CodeableConcept `json:"allowedCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=allowed"`
 	// Why should (not) substitution be made
 	Reason *CodeableConcept `json:"reason,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Allowed returns the option of allowed[x] that is set, or nil if none is.
+func (m *MedicationRequestSubstitution) Allowed() any {
+	switch {
+	case m.AllowedBoolean != nil:
+		return m.AllowedBoolean
+	case m.AllowedCodeableConcept != nil:
+		return m.AllowedCodeableConcept
+	}
+	return nil
+}
+
+// AllowedType returns the FHIR type of the option of allowed[x] that is
+// set, such as "boolean", or "" if none is.
+func (m *MedicationRequestSubstitution) AllowedType() string {
+	switch {
+	case m.AllowedBoolean != nil:
+		return "boolean"
+	case m.AllowedCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAllowed unsets every option of allowed[x], and their extensions.
+func (m *MedicationRequestSubstitution) ClearAllowed() {
+	m.AllowedBoolean = nil
+	m.AllowedBooleanExt = nil
+	m.AllowedCodeableConcept = nil
+}
+
+// SetAllowedBoolean sets the boolean option of allowed[x], clearing the others.
+func (m *MedicationRequestSubstitution) SetAllowedBoolean(value bool) {
+	ext := m.AllowedBooleanExt
+	m.ClearAllowed()
+	m.AllowedBoolean, m.AllowedBooleanExt = &value, ext
+}
+
+// SetAllowedCodeableConcept sets the CodeableConcept option of allowed[x], clearing the others.
+func (m *MedicationRequestSubstitution) SetAllowedCodeableConcept(value CodeableConcept) {
+	m.ClearAllowed()
+	m.AllowedCodeableConcept = &value
+}
+
 // MedicationRequest represents a FHIR MedicationRequest.
 type MedicationRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External ids for this request
@@ -111,9 +153,9 @@ type MedicationRequest struct {
 	// Reported rather than primary record - Reference option
 	ReportedReference *Reference `json:"reportedReference,omitempty" fhir:"cardinality=0..1,summary,choice=reported"`
 	// Medication to be taken - CodeableConcept option
-	MedicationCodeableConcept CodeableConcept `json:"medicationCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Medication to be taken - Reference option
-	MedicationReference Reference `json:"medicationReference" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationReference *Reference `json:"medicationReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Who or group medication request is for
 	Subject Reference `json:"subject" fhir:"cardinality=1..1,required,summary"`
 	// Encounter created as part of encounter/admission/stay
@@ -167,3 +209,92 @@ type MedicationRequest struct {
 	// A list of events of interest in the lifecycle
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationRequest", the FHIR type of the resource.
+func (m *MedicationRequest) ResourceType() string {
+	return ResourceTypeMedicationRequest
+}
+
+// Reported returns the option of reported[x] that is set, or nil if none is.
+func (m *MedicationRequest) Reported() any {
+	switch {
+	case m.ReportedBoolean != nil:
+		return m.ReportedBoolean
+	case m.ReportedReference != nil:
+		return m.ReportedReference
+	}
+	return nil
+}
+
+// ReportedType returns the FHIR type of the option of reported[x] that is
+// set, such as "boolean", or "" if none is.
+func (m *MedicationRequest) ReportedType() string {
+	switch {
+	case m.ReportedBoolean != nil:
+		return "boolean"
+	case m.ReportedReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearReported unsets every option of reported[x], and their extensions.
+func (m *MedicationRequest) ClearReported() {
+	m.ReportedBoolean = nil
+	m.ReportedBooleanExt = nil
+	m.ReportedReference = nil
+}
+
+// SetReportedBoolean sets the boolean option of reported[x], clearing the others.
+func (m *MedicationRequest) SetReportedBoolean(value bool) {
+	ext := m.ReportedBooleanExt
+	m.ClearReported()
+	m.ReportedBoolean, m.ReportedBooleanExt = &value, ext
+}
+
+// SetReportedReference sets the Reference option of reported[x], clearing the others.
+func (m *MedicationRequest) SetReportedReference(value Reference) {
+	m.ClearReported()
+	m.ReportedReference = &value
+}
+
+// Medication returns the option of medication[x] that is set, or nil if none is.
+func (m *MedicationRequest) Medication() any {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return m.MedicationCodeableConcept
+	case m.MedicationReference != nil:
+		return m.MedicationReference
+	}
+	return nil
+}
+
+// MedicationType returns the FHIR type of the option of medication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationRequest) MedicationType() string {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MedicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearMedication unsets every option of medication[x], and their extensions.
+func (m *MedicationRequest) ClearMedication() {
+	m.MedicationCodeableConcept = nil
+	m.MedicationReference = nil
+}
+
+// SetMedicationCodeableConcept sets the CodeableConcept option of medication[x], clearing the others.
+func (m *MedicationRequest) SetMedicationCodeableConcept(value CodeableConcept) {
+	m.ClearMedication()
+	m.MedicationCodeableConcept = &value
+}
+
+// SetMedicationReference sets the Reference option of medication[x], clearing the others.
+func (m *MedicationRequest) SetMedicationReference(value Reference) {
+	m.ClearMedication()
+	m.MedicationReference = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeMedicationStatement = "MedicationStatement"
 
 // MedicationStatement represents a FHIR MedicationStatement.
 type MedicationStatement struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -33,9 +32,9 @@ type MedicationStatement struct {
 	// Type of medication usage
 	Category *CodeableConcept `json:"category,omitempty" fhir:"cardinality=0..1,summary"`
 	// What medication was taken - CodeableConcept option
-	MedicationCodeableConcept CodeableConcept `json:"medicationCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// What medication was taken - Reference option
-	MedicationReference Reference `json:"medicationReference" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationReference *Reference `json:"medicationReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Who is/was taking  the medication
 	Subject Reference `json:"subject" fhir:"cardinality=1..1,required,summary"`
 	// Encounter / Episode associated with MedicationStatement
@@ -63,3 +62,92 @@ type MedicationStatement struct {
 	// Details of how medication is/was taken or should be taken
 	Dosage []Dosage `json:"dosage,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationStatement", the FHIR type of the resource.
+func (m *MedicationStatement) ResourceType() string {
+	return ResourceTypeMedicationStatement
+}
+
+// Medication returns the option of medication[x] that is set, or nil if none is.
+func (m *MedicationStatement) Medication() any {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return m.MedicationCodeableConcept
+	case m.MedicationReference != nil:
+		return m.MedicationReference
+	}
+	return nil
+}
+
+// MedicationType returns the FHIR type of the option of medication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationStatement) MedicationType() string {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MedicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearMedication unsets every option of medication[x], and their extensions.
+func (m *MedicationStatement) ClearMedication() {
+	m.MedicationCodeableConcept = nil
+	m.MedicationReference = nil
+}
+
+// SetMedicationCodeableConcept sets the CodeableConcept option of medication[x], clearing the others.
+func (m *MedicationStatement) SetMedicationCodeableConcept(value CodeableConcept) {
+	m.ClearMedication()
+	m.MedicationCodeableConcept = &value
+}
+
+// SetMedicationReference sets the Reference option of medication[x], clearing the others.
+func (m *MedicationStatement) SetMedicationReference(value Reference) {
+	m.ClearMedication()
+	m.MedicationReference = &value
+}
+
+// Effective returns the option of effective[x] that is set, or nil if none is.
+func (m *MedicationStatement) Effective() any {
+	switch {
+	case m.EffectiveDateTime != nil:
+		return m.EffectiveDateTime
+	case m.EffectivePeriod != nil:
+		return m.EffectivePeriod
+	}
+	return nil
+}
+
+// EffectiveType returns the FHIR type of the option of effective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (m *MedicationStatement) EffectiveType() string {
+	switch {
+	case m.EffectiveDateTime != nil:
+		return "dateTime"
+	case m.EffectivePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearEffective unsets every option of effective[x], and their extensions.
+func (m *MedicationStatement) ClearEffective() {
+	m.EffectiveDateTime = nil
+	m.EffectiveDateTimeExt = nil
+	m.EffectivePeriod = nil
+}
+
+// SetEffectiveDateTime sets the dateTime option of effective[x], clearing the others.
+func (m *MedicationStatement) SetEffectiveDateTime(value primitives.DateTime) {
+	ext := m.EffectiveDateTimeExt
+	m.ClearEffective()
+	m.EffectiveDateTime, m.EffectiveDateTimeExt = &value, ext
+}
+
+// SetEffectivePeriod sets the Period option of effective[x], clearing the others.
+func (m *MedicationStatement) SetEffectivePeriod(value Period) {
+	m.ClearEffective()
+	m.EffectivePeriod = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -125,9 +124,50 @@ type MedicinalProductSpecialDesignation struct {
 	Species *CodeableConcept `json:"species,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
+// Indication returns the option of indication[x] that is set, or nil if none is.
+func (m *MedicinalProductSpecialDesignation) Indication() any {
+	switch {
+	case m.IndicationCodeableConcept != nil:
+		return m.IndicationCodeableConcept
+	case m.IndicationReference != nil:
+		return m.IndicationReference
+	}
+	return nil
+}
+
+// IndicationType returns the FHIR type of the option of indication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicinalProductSpecialDesignation) IndicationType() string {
+	switch {
+	case m.IndicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.IndicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearIndication unsets every option of indication[x], and their extensions.
+func (m *MedicinalProductSpecialDesignation) ClearIndication() {
+	m.IndicationCodeableConcept = nil
+	m.IndicationReference = nil
+}
+
+// SetIndicationCodeableConcept sets the CodeableConcept option of indication[x], clearing the others.
+func (m *MedicinalProductSpecialDesignation) SetIndicationCodeableConcept(value CodeableConcept) {
+	m.ClearIndication()
+	m.IndicationCodeableConcept = &value
+}
+
+// SetIndicationReference sets the Reference option of indication[x], clearing the others.
+func (m *MedicinalProductSpecialDesignation) SetIndicationReference(value Reference) {
+	m.ClearIndication()
+	m.IndicationReference = &value
+}
+
 // MedicinalProduct represents a FHIR MedicinalProduct.
 type MedicinalProduct struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this product. Could be an MPID
@@ -173,3 +213,8 @@ type MedicinalProduct struct {
 	// Indicates if the medicinal product has an orphan designation for the treatment of a rare disease
 	SpecialDesignation []MedicinalProductSpecialDesignation `json:"specialDesignation,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MedicinalProduct", the FHIR type of the resource.
+func (m *MedicinalProduct) ResourceType() string {
+	return ResourceTypeMedicinalProduct
+}
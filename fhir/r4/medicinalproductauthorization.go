@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -63,9 +62,52 @@ type MedicinalProductAuthorizationProcedure struct {
 	Application []MedicinalProductAuthorizationProcedureApplication `json:"application,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Date returns the option of date[x] that is set, or nil if none is.
+func (m *MedicinalProductAuthorizationProcedure) Date() any {
+	switch {
+	case m.DatePeriod != nil:
+		return m.DatePeriod
+	case m.DateDateTime != nil:
+		return m.DateDateTime
+	}
+	return nil
+}
+
+// DateType returns the FHIR type of the option of date[x] that is
+// set, such as "Period", or "" if none is.
+func (m *MedicinalProductAuthorizationProcedure) DateType() string {
+	switch {
+	case m.DatePeriod != nil:
+		return "Period"
+	case m.DateDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearDate unsets every option of date[x], and their extensions.
+func (m *MedicinalProductAuthorizationProcedure) ClearDate() {
+	m.DatePeriod = nil
+	m.DateDateTime = nil
+	m.DateDateTimeExt = nil
+}
+
+// SetDatePeriod sets the Period option of date[x], clearing the others.
+func (m *MedicinalProductAuthorizationProcedure) SetDatePeriod(value Period) {
+	m.ClearDate()
+	m.DatePeriod = &value
+}
+
+// SetDateDateTime sets the dateTime option of date[x], clearing the others.
+func (m *MedicinalProductAuthorizationProcedure) SetDateDateTime(value primitives.DateTime) {
+	ext := m.DateDateTimeExt
+	m.ClearDate()
+	m.DateDateTime, m.DateDateTimeExt = &value, ext
+}
+
 // MedicinalProductAuthorization represents a FHIR MedicinalProductAuthorization.
 type MedicinalProductAuthorization struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for the marketing authorization, as assigned by a regulator
@@ -109,3 +151,8 @@ type MedicinalProductAuthorization struct {
 	// The regulatory procedure for granting or amending a marketing authorization
 	Procedure *MedicinalProductAuthorizationProcedure `json:"procedure,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "MedicinalProductAuthorization", the FHIR type of the resource.
+func (m *MedicinalProductAuthorization) ResourceType() string {
+	return ResourceTypeMedicinalProductAuthorization
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,14 +25,55 @@ type MedicinalProductContraindicationOtherTherapy struct {
 	// The type of relationship between the medicinal product indication or contraindication and another therapy
 	TherapyRelationshipType CodeableConcept `json:"therapyRelationshipType" fhir:"cardinality=1..1,required,summary"`
 	// Reference to a specific medication (active substance, medicinal product or class of products) as part of an indication or contraindication - CodeableConcept option
-	MedicationCodeableConcept CodeableConcept `json:"medicationCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Reference to a specific medication (active substance, medicinal product or class of products) as part of an indication or contraindication - Reference option
-	MedicationReference Reference `json:"medicationReference" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationReference *Reference `json:"medicationReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
+}
+
+// Medication returns the option of medication[x] that is set, or nil if none is.
+func (m *MedicinalProductContraindicationOtherTherapy) Medication() any {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return m.MedicationCodeableConcept
+	case m.MedicationReference != nil:
+		return m.MedicationReference
+	}
+	return nil
+}
+
+// MedicationType returns the FHIR type of the option of medication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicinalProductContraindicationOtherTherapy) MedicationType() string {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MedicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearMedication unsets every option of medication[x], and their extensions.
+func (m *MedicinalProductContraindicationOtherTherapy) ClearMedication() {
+	m.MedicationCodeableConcept = nil
+	m.MedicationReference = nil
+}
+
+// SetMedicationCodeableConcept sets the CodeableConcept option of medication[x], clearing the others.
+func (m *MedicinalProductContraindicationOtherTherapy) SetMedicationCodeableConcept(value CodeableConcept) {
+	m.ClearMedication()
+	m.MedicationCodeableConcept = &value
+}
+
+// SetMedicationReference sets the Reference option of medication[x], clearing the others.
+func (m *MedicinalProductContraindicationOtherTherapy) SetMedicationReference(value Reference) {
+	m.ClearMedication()
+	m.MedicationReference = &value
 }
 
 // MedicinalProductContraindication represents a FHIR MedicinalProductContraindication.
 type MedicinalProductContraindication struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The medication for which this is an indication
@@ -51,3 +91,8 @@ type MedicinalProductContraindication struct {
 	// The population group to which this applies
 	Population []Population `json:"population,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MedicinalProductContraindication", the FHIR type of the resource.
+func (m *MedicinalProductContraindication) ResourceType() string {
+	return ResourceTypeMedicinalProductContraindication
+}
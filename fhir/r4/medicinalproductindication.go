@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,14 +25,55 @@ type MedicinalProductIndicationOtherTherapy struct {
 	// The type of relationship between the medicinal product indication or contraindication and another therapy
 	TherapyRelationshipType CodeableConcept `json:"therapyRelationshipType" fhir:"cardinality=1..1,required,summary"`
 	// Reference to a specific medication (active substance, medicinal product or class of products) as part of an indication or contraindication - CodeableConcept option
-	MedicationCodeableConcept CodeableConcept `json:"medicationCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
 	// Reference to a specific medication (active substance, medicinal product or class of products) as part of an indication or contraindication - Reference option
-	MedicationReference Reference `json:"medicationReference" fhir:"cardinality=1..1,required,summary,choice=medication"`
+	MedicationReference *Reference `json:"medicationReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=medication"`
+}
+
+// Medication returns the option of medication[x] that is set, or nil if none is.
+func (m *MedicinalProductIndicationOtherTherapy) Medication() any {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return m.MedicationCodeableConcept
+	case m.MedicationReference != nil:
+		return m.MedicationReference
+	}
+	return nil
+}
+
+// MedicationType returns the FHIR type of the option of medication[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicinalProductIndicationOtherTherapy) MedicationType() string {
+	switch {
+	case m.MedicationCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MedicationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearMedication unsets every option of medication[x], and their extensions.
+func (m *MedicinalProductIndicationOtherTherapy) ClearMedication() {
+	m.MedicationCodeableConcept = nil
+	m.MedicationReference = nil
+}
+
+// SetMedicationCodeableConcept sets the CodeableConcept option of medication[x], clearing the others.
+func (m *MedicinalProductIndicationOtherTherapy) SetMedicationCodeableConcept(value CodeableConcept) {
+	m.ClearMedication()
+	m.MedicationCodeableConcept = &value
+}
+
+// SetMedicationReference sets the Reference option of medication[x], clearing the others.
+func (m *MedicinalProductIndicationOtherTherapy) SetMedicationReference(value Reference) {
+	m.ClearMedication()
+	m.MedicationReference = &value
 }
 
 // MedicinalProductIndication represents a FHIR MedicinalProductIndication.
 type MedicinalProductIndication struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The medication for which this is an indication
@@ -55,3 +95,8 @@ type MedicinalProductIndication struct {
 	// The population group to which this applies
 	Population []Population `json:"population,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MedicinalProductIndication", the FHIR type of the resource.
+func (m *MedicinalProductIndication) ResourceType() string {
+	return ResourceTypeMedicinalProductIndication
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -107,7 +106,7 @@ type MedicinalProductIngredientSubstance struct {
 
 // MedicinalProductIngredient represents a FHIR MedicinalProductIngredient.
 type MedicinalProductIngredient struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier for the ingredient
@@ -125,3 +124,8 @@ type MedicinalProductIngredient struct {
 	// The ingredient substance
 	Substance *MedicinalProductIngredientSubstance `json:"substance,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "MedicinalProductIngredient", the FHIR type of the resource.
+func (m *MedicinalProductIngredient) ResourceType() string {
+	return ResourceTypeMedicinalProductIngredient
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,14 +23,55 @@ type MedicinalProductInteractionInteractant struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// The specific medication, food or laboratory test that interacts - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// The specific medication, food or laboratory test that interacts - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (m *MedicinalProductInteractionInteractant) Item() any {
+	switch {
+	case m.ItemReference != nil:
+		return m.ItemReference
+	case m.ItemCodeableConcept != nil:
+		return m.ItemCodeableConcept
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "Reference", or "" if none is.
+func (m *MedicinalProductInteractionInteractant) ItemType() string {
+	switch {
+	case m.ItemReference != nil:
+		return "Reference"
+	case m.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (m *MedicinalProductInteractionInteractant) ClearItem() {
+	m.ItemReference = nil
+	m.ItemCodeableConcept = nil
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (m *MedicinalProductInteractionInteractant) SetItemReference(value Reference) {
+	m.ClearItem()
+	m.ItemReference = &value
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (m *MedicinalProductInteractionInteractant) SetItemCodeableConcept(value CodeableConcept) {
+	m.ClearItem()
+	m.ItemCodeableConcept = &value
 }
 
 // MedicinalProductInteraction represents a FHIR MedicinalProductInteraction.
 type MedicinalProductInteraction struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The medication for which this is a described interaction
@@ -51,3 +91,8 @@ type MedicinalProductInteraction struct {
 	// Actions for managing the interaction
 	Management *CodeableConcept `json:"management,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "MedicinalProductInteraction", the FHIR type of the resource.
+func (m *MedicinalProductInteraction) ResourceType() string {
+	return ResourceTypeMedicinalProductInteraction
+}
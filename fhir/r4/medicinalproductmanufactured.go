@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeMedicinalProductManufactured = "MedicinalProductManufactured"
 
 // MedicinalProductManufactured represents a FHIR MedicinalProductManufactured.
 type MedicinalProductManufactured struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Dose form as manufactured and before any transformation into the pharmaceutical product
@@ -33,3 +32,8 @@ type MedicinalProductManufactured struct {
 	// Other codeable characteristics
 	OtherCharacteristics []CodeableConcept `json:"otherCharacteristics,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MedicinalProductManufactured", the FHIR type of the resource.
+func (m *MedicinalProductManufactured) ResourceType() string {
+	return ResourceTypeMedicinalProductManufactured
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -71,7 +70,7 @@ type MedicinalProductPackagedPackageItem struct {
 
 // MedicinalProductPackaged represents a FHIR MedicinalProductPackaged.
 type MedicinalProductPackaged struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier
@@ -95,3 +94,8 @@ type MedicinalProductPackaged struct {
 	// A packaging item, as a contained for medicine, possibly with other packaging items within
 	PackageItem []MedicinalProductPackagedPackageItem `json:"packageItem,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "MedicinalProductPackaged", the FHIR type of the resource.
+func (m *MedicinalProductPackaged) ResourceType() string {
+	return ResourceTypeMedicinalProductPackaged
+}
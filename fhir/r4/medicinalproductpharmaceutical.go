@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -93,7 +92,7 @@ type MedicinalProductPharmaceuticalRouteOfAdministration struct {
 
 // MedicinalProductPharmaceutical represents a FHIR MedicinalProductPharmaceutical.
 type MedicinalProductPharmaceutical struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// An identifier for the pharmaceutical medicinal product
@@ -111,3 +110,8 @@ type MedicinalProductPharmaceutical struct {
 	// The path by which the pharmaceutical product is taken into or makes contact with the body
 	RouteOfAdministration []MedicinalProductPharmaceuticalRouteOfAdministration `json:"routeOfAdministration,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "MedicinalProductPharmaceutical", the FHIR type of the resource.
+func (m *MedicinalProductPharmaceutical) ResourceType() string {
+	return ResourceTypeMedicinalProductPharmaceutical
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeMedicinalProductUndesirableEffect = "MedicinalProductUndesirab
 
 // MedicinalProductUndesirableEffect represents a FHIR MedicinalProductUndesirableEffect.
 type MedicinalProductUndesirableEffect struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The medication for which this is an indication
@@ -29,3 +28,8 @@ type MedicinalProductUndesirableEffect struct {
 	// The population group to which this applies
 	Population []Population `json:"population,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MedicinalProductUndesirableEffect", the FHIR type of the resource.
+func (m *MedicinalProductUndesirableEffect) ResourceType() string {
+	return ResourceTypeMedicinalProductUndesirableEffect
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -63,7 +62,7 @@ type MessageDefinitionAllowedResponse struct {
 
 // MessageDefinition represents a FHIR MessageDefinition.
 type MessageDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for a given MessageDefinition
@@ -131,11 +130,11 @@ type MessageDefinition struct {
 	// Extension for Parent
 	ParentExt *primitives.PrimitiveExtension `json:"_parent,omitempty" fhir:"cardinality=0..1"`
 	// Event code  or link to the EventDefinition - Coding option
-	EventCoding Coding `json:"eventCoding" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventCoding *Coding `json:"eventCoding,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Event code  or link to the EventDefinition - uri option
-	EventURI string `json:"eventURI" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventURI *string `json:"eventUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Extension for EventURI
-	EventURIExt *primitives.PrimitiveExtension `json:"_eventURI,omitempty" fhir:"cardinality=0..1"`
+	EventURIExt *primitives.PrimitiveExtension `json:"_eventUri,omitempty" fhir:"cardinality=0..1"`
 	// consequence | currency | notification
 	Category *string `json:"category,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Category
@@ -153,3 +152,51 @@ type MessageDefinition struct {
 	// Extension for Graph
 	GraphExt *primitives.PrimitiveExtension `json:"_graph,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "MessageDefinition", the FHIR type of the resource.
+func (m *MessageDefinition) ResourceType() string {
+	return ResourceTypeMessageDefinition
+}
+
+// Event returns the option of event[x] that is set, or nil if none is.
+func (m *MessageDefinition) Event() any {
+	switch {
+	case m.EventCoding != nil:
+		return m.EventCoding
+	case m.EventURI != nil:
+		return m.EventURI
+	}
+	return nil
+}
+
+// EventType returns the FHIR type of the option of event[x] that is
+// set, such as "Coding", or "" if none is.
+func (m *MessageDefinition) EventType() string {
+	switch {
+	case m.EventCoding != nil:
+		return "Coding"
+	case m.EventURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearEvent unsets every option of event[x], and their extensions.
+func (m *MessageDefinition) ClearEvent() {
+	m.EventCoding = nil
+	m.EventURI = nil
+	m.EventURIExt = nil
+}
+
+// SetEventCoding sets the Coding option of event[x], clearing the others.
+func (m *MessageDefinition) SetEventCoding(value Coding) {
+	m.ClearEvent()
+	m.EventCoding = &value
+}
+
+// SetEventURI sets the uri option of event[x], clearing the others.
+func (m *MessageDefinition) SetEventURI(value string) {
+	ext := m.EventURIExt
+	m.ClearEvent()
+	m.EventURI, m.EventURIExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -91,15 +90,15 @@ type MessageHeaderResponse struct {
 
 // MessageHeader represents a FHIR MessageHeader.
 type MessageHeader struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Code for the event this message represents or link to event definition - Coding option
-	EventCoding Coding `json:"eventCoding" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventCoding *Coding `json:"eventCoding,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Code for the event this message represents or link to event definition - uri option
-	EventURI string `json:"eventURI" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventURI *string `json:"eventUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Extension for EventURI
-	EventURIExt *primitives.PrimitiveExtension `json:"_eventURI,omitempty" fhir:"cardinality=0..1"`
+	EventURIExt *primitives.PrimitiveExtension `json:"_eventUri,omitempty" fhir:"cardinality=0..1"`
 	// Message destination application(s)
 	Destination []MessageHeaderDestination `json:"destination,omitempty" fhir:"cardinality=0..*,summary"`
 	// Real world sender of the message
@@ -123,3 +122,51 @@ type MessageHeader struct {
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "MessageHeader", the FHIR type of the resource.
+func (m *MessageHeader) ResourceType() string {
+	return ResourceTypeMessageHeader
+}
+
+// Event returns the option of event[x] that is set, or nil if none is.
+func (m *MessageHeader) Event() any {
+	switch {
+	case m.EventCoding != nil:
+		return m.EventCoding
+	case m.EventURI != nil:
+		return m.EventURI
+	}
+	return nil
+}
+
+// EventType returns the FHIR type of the option of event[x] that is
+// set, such as "Coding", or "" if none is.
+func (m *MessageHeader) EventType() string {
+	switch {
+	case m.EventCoding != nil:
+		return "Coding"
+	case m.EventURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearEvent unsets every option of event[x], and their extensions.
+func (m *MessageHeader) ClearEvent() {
+	m.EventCoding = nil
+	m.EventURI = nil
+	m.EventURIExt = nil
+}
+
+// SetEventCoding sets the Coding option of event[x], clearing the others.
+func (m *MessageHeader) SetEventCoding(value Coding) {
+	m.ClearEvent()
+	m.EventCoding = &value
+}
+
+// SetEventURI sets the uri option of event[x], clearing the others.
+func (m *MessageHeader) SetEventURI(value string) {
+	ext := m.EventURIExt
+	m.ClearEvent()
+	m.EventURI, m.EventURIExt = &value, ext
+}
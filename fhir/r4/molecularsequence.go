@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -116,15 +115,15 @@ type MolecularSequenceQualityRoc struct {
 	// Extension for NumFN
 	NumFNExt *primitives.PrimitiveExtension `json:"_numFN,omitempty" fhir:"cardinality=0..1"`
 	// Precision of the GQ score
-	Precision []float64 `json:"precision,omitempty" fhir:"cardinality=0..*,summary"`
+	Precision []primitives.Decimal `json:"precision,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Precision
 	PrecisionExt *primitives.PrimitiveExtension `json:"_precision,omitempty" fhir:"cardinality=0..1"`
 	// Sensitivity of the GQ score
-	Sensitivity []float64 `json:"sensitivity,omitempty" fhir:"cardinality=0..*,summary"`
+	Sensitivity []primitives.Decimal `json:"sensitivity,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Sensitivity
 	SensitivityExt *primitives.PrimitiveExtension `json:"_sensitivity,omitempty" fhir:"cardinality=0..1"`
 	// FScore of the GQ score
-	FMeasure []float64 `json:"fMeasure,omitempty" fhir:"cardinality=0..*,summary"`
+	FMeasure []primitives.Decimal `json:"fMeasure,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for FMeasure
 	FMeasureExt *primitives.PrimitiveExtension `json:"_fMeasure,omitempty" fhir:"cardinality=0..1"`
 }
@@ -158,35 +157,35 @@ type MolecularSequenceQuality struct {
 	// Method to get quality
 	Method *CodeableConcept `json:"method,omitempty" fhir:"cardinality=0..1,summary"`
 	// True positives from the perspective of the truth data
-	TruthTP *float64 `json:"truthTP,omitempty" fhir:"cardinality=0..1,summary"`
+	TruthTP *primitives.Decimal `json:"truthTP,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for TruthTP
 	TruthTPExt *primitives.PrimitiveExtension `json:"_truthTP,omitempty" fhir:"cardinality=0..1"`
 	// True positives from the perspective of the query data
-	QueryTP *float64 `json:"queryTP,omitempty" fhir:"cardinality=0..1,summary"`
+	QueryTP *primitives.Decimal `json:"queryTP,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for QueryTP
 	QueryTPExt *primitives.PrimitiveExtension `json:"_queryTP,omitempty" fhir:"cardinality=0..1"`
 	// False negatives
-	TruthFN *float64 `json:"truthFN,omitempty" fhir:"cardinality=0..1,summary"`
+	TruthFN *primitives.Decimal `json:"truthFN,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for TruthFN
 	TruthFNExt *primitives.PrimitiveExtension `json:"_truthFN,omitempty" fhir:"cardinality=0..1"`
 	// False positives
-	QueryFP *float64 `json:"queryFP,omitempty" fhir:"cardinality=0..1,summary"`
+	QueryFP *primitives.Decimal `json:"queryFP,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for QueryFP
 	QueryFPExt *primitives.PrimitiveExtension `json:"_queryFP,omitempty" fhir:"cardinality=0..1"`
 	// False positives where the non-REF alleles in the Truth and Query Call Sets match
-	GtFP *float64 `json:"gtFP,omitempty" fhir:"cardinality=0..1,summary"`
+	GtFP *primitives.Decimal `json:"gtFP,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for GtFP
 	GtFPExt *primitives.PrimitiveExtension `json:"_gtFP,omitempty" fhir:"cardinality=0..1"`
 	// Precision of comparison
-	Precision *float64 `json:"precision,omitempty" fhir:"cardinality=0..1,summary"`
+	Precision *primitives.Decimal `json:"precision,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Precision
 	PrecisionExt *primitives.PrimitiveExtension `json:"_precision,omitempty" fhir:"cardinality=0..1"`
 	// Recall of comparison
-	Recall *float64 `json:"recall,omitempty" fhir:"cardinality=0..1,summary"`
+	Recall *primitives.Decimal `json:"recall,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Recall
 	RecallExt *primitives.PrimitiveExtension `json:"_recall,omitempty" fhir:"cardinality=0..1"`
 	// F-score
-	FScore *float64 `json:"fScore,omitempty" fhir:"cardinality=0..1,summary"`
+	FScore *primitives.Decimal `json:"fScore,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for FScore
 	FScoreExt *primitives.PrimitiveExtension `json:"_fScore,omitempty" fhir:"cardinality=0..1"`
 	// Receiver Operator Characteristic (ROC) Curve
@@ -297,7 +296,7 @@ type MolecularSequenceStructureVariant struct {
 
 // MolecularSequence represents a FHIR MolecularSequence.
 type MolecularSequence struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique ID for this particular sequence. This is a FHIR-defined id
@@ -341,3 +340,8 @@ type MolecularSequence struct {
 	// Structural variant
 	StructureVariant []MolecularSequenceStructureVariant `json:"structureVariant,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MolecularSequence", the FHIR type of the resource.
+func (m *MolecularSequence) ResourceType() string {
+	return ResourceTypeMolecularSequence
+}
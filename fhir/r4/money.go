@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,11 +18,11 @@ type Money struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Numerical value (with implicit precision)
-	Value *float64 `json:"value,omitempty" fhir:"cardinality=0..1,summary"`
+	Value *primitives.Decimal `json:"value,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 	// ISO 4217 Currency Code
-	Currency *string `json:"currency,omitempty" fhir:"cardinality=0..1,summary"`
+	Currency *string `json:"currency,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/currencies|4.0.1,summary,type=code"`
 	// Extension for Currency
 	CurrencyExt *primitives.PrimitiveExtension `json:"_currency,omitempty" fhir:"cardinality=0..1"`
 }
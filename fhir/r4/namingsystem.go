@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -45,7 +44,7 @@ type NamingSystemUniqueId struct {
 
 // NamingSystem represents a FHIR NamingSystem.
 type NamingSystem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Name for this naming system (computer friendly)
@@ -91,3 +90,8 @@ type NamingSystem struct {
 	// Unique identifiers used for system
 	UniqueId []NamingSystemUniqueId `json:"uniqueId,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "NamingSystem", the FHIR type of the resource.
+func (n *NamingSystem) ResourceType() string {
+	return ResourceTypeNamingSystem
+}
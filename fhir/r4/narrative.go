@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type Narrative struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// generated | extensions | additional | empty
-	Status string `json:"status" fhir:"cardinality=1..1,required"`
+	Status NarrativeStatus `json:"status" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/narrative-status|4.0.1,type=code"`
 	// Extension for Status
 	StatusExt *primitives.PrimitiveExtension `json:"_status,omitempty" fhir:"cardinality=0..1"`
 	// Limited xhtml content
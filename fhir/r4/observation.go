@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -95,9 +94,159 @@ type ObservationComponent struct {
 	ReferenceRange []ObservationComponentReferenceRange `json:"referenceRange,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (o *ObservationComponent) Value() any {
+	switch {
+	case o.ValueQuantity != nil:
+		return o.ValueQuantity
+	case o.ValueCodeableConcept != nil:
+		return o.ValueCodeableConcept
+	case o.ValueString != nil:
+		return o.ValueString
+	case o.ValueBoolean != nil:
+		return o.ValueBoolean
+	case o.ValueInteger != nil:
+		return o.ValueInteger
+	case o.ValueRange != nil:
+		return o.ValueRange
+	case o.ValueRatio != nil:
+		return o.ValueRatio
+	case o.ValueSampledData != nil:
+		return o.ValueSampledData
+	case o.ValueTime != nil:
+		return o.ValueTime
+	case o.ValueDateTime != nil:
+		return o.ValueDateTime
+	case o.ValuePeriod != nil:
+		return o.ValuePeriod
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (o *ObservationComponent) ValueType() string {
+	switch {
+	case o.ValueQuantity != nil:
+		return "Quantity"
+	case o.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case o.ValueString != nil:
+		return "string"
+	case o.ValueBoolean != nil:
+		return "boolean"
+	case o.ValueInteger != nil:
+		return "integer"
+	case o.ValueRange != nil:
+		return "Range"
+	case o.ValueRatio != nil:
+		return "Ratio"
+	case o.ValueSampledData != nil:
+		return "SampledData"
+	case o.ValueTime != nil:
+		return "time"
+	case o.ValueDateTime != nil:
+		return "dateTime"
+	case o.ValuePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (o *ObservationComponent) ClearValue() {
+	o.ValueQuantity = nil
+	o.ValueCodeableConcept = nil
+	o.ValueString = nil
+	o.ValueStringExt = nil
+	o.ValueBoolean = nil
+	o.ValueBooleanExt = nil
+	o.ValueInteger = nil
+	o.ValueIntegerExt = nil
+	o.ValueRange = nil
+	o.ValueRatio = nil
+	o.ValueSampledData = nil
+	o.ValueTime = nil
+	o.ValueTimeExt = nil
+	o.ValueDateTime = nil
+	o.ValueDateTimeExt = nil
+	o.ValuePeriod = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueQuantity(value Quantity) {
+	o.ClearValue()
+	o.ValueQuantity = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueCodeableConcept(value CodeableConcept) {
+	o.ClearValue()
+	o.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueString(value string) {
+	ext := o.ValueStringExt
+	o.ClearValue()
+	o.ValueString, o.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueBoolean(value bool) {
+	ext := o.ValueBooleanExt
+	o.ClearValue()
+	o.ValueBoolean, o.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueInteger(value int) {
+	ext := o.ValueIntegerExt
+	o.ClearValue()
+	o.ValueInteger, o.ValueIntegerExt = &value, ext
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueRange(value Range) {
+	o.ClearValue()
+	o.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueRatio(value Ratio) {
+	o.ClearValue()
+	o.ValueRatio = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueSampledData(value SampledData) {
+	o.ClearValue()
+	o.ValueSampledData = &value
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueTime(value primitives.Time) {
+	ext := o.ValueTimeExt
+	o.ClearValue()
+	o.ValueTime, o.ValueTimeExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (o *ObservationComponent) SetValueDateTime(value primitives.DateTime) {
+	ext := o.ValueDateTimeExt
+	o.ClearValue()
+	o.ValueDateTime, o.ValueDateTimeExt = &value, ext
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (o *ObservationComponent) SetValuePeriod(value Period) {
+	o.ClearValue()
+	o.ValuePeriod = &value
+}
+
 // Observation represents a FHIR Observation.
 type Observation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for observation
@@ -193,3 +342,225 @@ type Observation struct {
 	// Component results
 	Component []ObservationComponent `json:"component,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Observation", the FHIR type of the resource.
+func (o *Observation) ResourceType() string {
+	return ResourceTypeObservation
+}
+
+// Effective returns the option of effective[x] that is set, or nil if none is.
+func (o *Observation) Effective() any {
+	switch {
+	case o.EffectiveDateTime != nil:
+		return o.EffectiveDateTime
+	case o.EffectivePeriod != nil:
+		return o.EffectivePeriod
+	case o.EffectiveTiming != nil:
+		return o.EffectiveTiming
+	case o.EffectiveInstant != nil:
+		return o.EffectiveInstant
+	}
+	return nil
+}
+
+// EffectiveType returns the FHIR type of the option of effective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (o *Observation) EffectiveType() string {
+	switch {
+	case o.EffectiveDateTime != nil:
+		return "dateTime"
+	case o.EffectivePeriod != nil:
+		return "Period"
+	case o.EffectiveTiming != nil:
+		return "Timing"
+	case o.EffectiveInstant != nil:
+		return "instant"
+	}
+	return ""
+}
+
+// ClearEffective unsets every option of effective[x], and their extensions.
+func (o *Observation) ClearEffective() {
+	o.EffectiveDateTime = nil
+	o.EffectiveDateTimeExt = nil
+	o.EffectivePeriod = nil
+	o.EffectiveTiming = nil
+	o.EffectiveInstant = nil
+	o.EffectiveInstantExt = nil
+}
+
+// SetEffectiveDateTime sets the dateTime option of effective[x], clearing the others.
+func (o *Observation) SetEffectiveDateTime(value primitives.DateTime) {
+	ext := o.EffectiveDateTimeExt
+	o.ClearEffective()
+	o.EffectiveDateTime, o.EffectiveDateTimeExt = &value, ext
+}
+
+// SetEffectivePeriod sets the Period option of effective[x], clearing the others.
+func (o *Observation) SetEffectivePeriod(value Period) {
+	o.ClearEffective()
+	o.EffectivePeriod = &value
+}
+
+// SetEffectiveTiming sets the Timing option of effective[x], clearing the others.
+func (o *Observation) SetEffectiveTiming(value Timing) {
+	o.ClearEffective()
+	o.EffectiveTiming = &value
+}
+
+// SetEffectiveInstant sets the instant option of effective[x], clearing the others.
+func (o *Observation) SetEffectiveInstant(value primitives.Instant) {
+	ext := o.EffectiveInstantExt
+	o.ClearEffective()
+	o.EffectiveInstant, o.EffectiveInstantExt = &value, ext
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (o *Observation) Value() any {
+	switch {
+	case o.ValueQuantity != nil:
+		return o.ValueQuantity
+	case o.ValueCodeableConcept != nil:
+		return o.ValueCodeableConcept
+	case o.ValueString != nil:
+		return o.ValueString
+	case o.ValueBoolean != nil:
+		return o.ValueBoolean
+	case o.ValueInteger != nil:
+		return o.ValueInteger
+	case o.ValueRange != nil:
+		return o.ValueRange
+	case o.ValueRatio != nil:
+		return o.ValueRatio
+	case o.ValueSampledData != nil:
+		return o.ValueSampledData
+	case o.ValueTime != nil:
+		return o.ValueTime
+	case o.ValueDateTime != nil:
+		return o.ValueDateTime
+	case o.ValuePeriod != nil:
+		return o.ValuePeriod
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (o *Observation) ValueType() string {
+	switch {
+	case o.ValueQuantity != nil:
+		return "Quantity"
+	case o.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case o.ValueString != nil:
+		return "string"
+	case o.ValueBoolean != nil:
+		return "boolean"
+	case o.ValueInteger != nil:
+		return "integer"
+	case o.ValueRange != nil:
+		return "Range"
+	case o.ValueRatio != nil:
+		return "Ratio"
+	case o.ValueSampledData != nil:
+		return "SampledData"
+	case o.ValueTime != nil:
+		return "time"
+	case o.ValueDateTime != nil:
+		return "dateTime"
+	case o.ValuePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (o *Observation) ClearValue() {
+	o.ValueQuantity = nil
+	o.ValueCodeableConcept = nil
+	o.ValueString = nil
+	o.ValueStringExt = nil
+	o.ValueBoolean = nil
+	o.ValueBooleanExt = nil
+	o.ValueInteger = nil
+	o.ValueIntegerExt = nil
+	o.ValueRange = nil
+	o.ValueRatio = nil
+	o.ValueSampledData = nil
+	o.ValueTime = nil
+	o.ValueTimeExt = nil
+	o.ValueDateTime = nil
+	o.ValueDateTimeExt = nil
+	o.ValuePeriod = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (o *Observation) SetValueQuantity(value Quantity) {
+	o.ClearValue()
+	o.ValueQuantity = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (o *Observation) SetValueCodeableConcept(value CodeableConcept) {
+	o.ClearValue()
+	o.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (o *Observation) SetValueString(value string) {
+	ext := o.ValueStringExt
+	o.ClearValue()
+	o.ValueString, o.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (o *Observation) SetValueBoolean(value bool) {
+	ext := o.ValueBooleanExt
+	o.ClearValue()
+	o.ValueBoolean, o.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (o *Observation) SetValueInteger(value int) {
+	ext := o.ValueIntegerExt
+	o.ClearValue()
+	o.ValueInteger, o.ValueIntegerExt = &value, ext
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (o *Observation) SetValueRange(value Range) {
+	o.ClearValue()
+	o.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (o *Observation) SetValueRatio(value Ratio) {
+	o.ClearValue()
+	o.ValueRatio = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (o *Observation) SetValueSampledData(value SampledData) {
+	o.ClearValue()
+	o.ValueSampledData = &value
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (o *Observation) SetValueTime(value primitives.Time) {
+	ext := o.ValueTimeExt
+	o.ClearValue()
+	o.ValueTime, o.ValueTimeExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (o *Observation) SetValueDateTime(value primitives.DateTime) {
+	ext := o.ValueDateTimeExt
+	o.ClearValue()
+	o.ValueDateTime, o.ValueDateTimeExt = &value, ext
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (o *Observation) SetValuePeriod(value Period) {
+	o.ClearValue()
+	o.ValuePeriod = &value
+}
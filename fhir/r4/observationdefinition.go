@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,7 +27,7 @@ type ObservationDefinitionQuantitativeDetails struct {
 	// SI unit for quantitative results
 	Unit *CodeableConcept `json:"unit,omitempty" fhir:"cardinality=0..1"`
 	// SI to Customary unit conversion factor
-	ConversionFactor *float64 `json:"conversionFactor,omitempty" fhir:"cardinality=0..1"`
+	ConversionFactor *primitives.Decimal `json:"conversionFactor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for ConversionFactor
 	ConversionFactorExt *primitives.PrimitiveExtension `json:"_conversionFactor,omitempty" fhir:"cardinality=0..1"`
 	// Decimal precision of observation quantitative results
@@ -58,7 +57,7 @@ type ObservationDefinitionQualifiedInterval struct {
 	// Targetted population of the range
 	AppliesTo []CodeableConcept `json:"appliesTo,omitempty" fhir:"cardinality=0..*"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// Applicable age range, if relevant
@@ -73,7 +72,7 @@ type ObservationDefinitionQualifiedInterval struct {
 
 // ObservationDefinition represents a FHIR ObservationDefinition.
 type ObservationDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Category of observation
@@ -109,3 +108,8 @@ type ObservationDefinition struct {
 	// Value set of critical coded values for the observations conforming to this ObservationDefinition
 	CriticalCodedValueSet *Reference `json:"criticalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ObservationDefinition", the FHIR type of the resource.
+func (o *ObservationDefinition) ResourceType() string {
+	return ResourceTypeObservationDefinition
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -129,7 +128,7 @@ type OperationDefinitionOverload struct {
 
 // OperationDefinition represents a FHIR OperationDefinition.
 type OperationDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this operation definition, represented as a URI (globally unique)
@@ -227,3 +226,8 @@ type OperationDefinition struct {
 	// Define overloaded variants for when  generating code
 	Overload []OperationDefinitionOverload `json:"overload,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "OperationDefinition", the FHIR type of the resource.
+func (o *OperationDefinition) ResourceType() string {
+	return ResourceTypeOperationDefinition
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,9 +48,14 @@ type OperationOutcomeIssue struct {
 
 // OperationOutcome represents a FHIR OperationOutcome.
 type OperationOutcome struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A single issue associated with the action
 	Issue []OperationOutcomeIssue `json:"issue,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "OperationOutcome", the FHIR type of the resource.
+func (o *OperationOutcome) ResourceType() string {
+	return ResourceTypeOperationOutcome
+}
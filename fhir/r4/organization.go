@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,7 +34,7 @@ type OrganizationContact struct {
 
 // Organization represents a FHIR Organization.
 type Organization struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifies this organization  across multiple systems
@@ -65,3 +64,8 @@ type Organization struct {
 	// Technical endpoints providing access to services operated for the organization
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Organization", the FHIR type of the resource.
+func (o *Organization) ResourceType() string {
+	return ResourceTypeOrganization
+}
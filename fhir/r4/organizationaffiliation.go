@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeOrganizationAffiliation = "OrganizationAffiliation"
 
 // OrganizationAffiliation represents a FHIR OrganizationAffiliation.
 type OrganizationAffiliation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifiers that are specific to this role
@@ -45,3 +44,8 @@ type OrganizationAffiliation struct {
 	// Technical endpoints providing access to services operated for this role
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "OrganizationAffiliation", the FHIR type of the resource.
+func (o *OrganizationAffiliation) ResourceType() string {
+	return ResourceTypeOrganizationAffiliation
+}
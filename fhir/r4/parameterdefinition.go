@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,15 +18,15 @@ type ParameterDefinition struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Name used to access the parameter value
-	Name *string `json:"name,omitempty" fhir:"cardinality=0..1,summary"`
+	Name *string `json:"name,omitempty" fhir:"cardinality=0..1,summary,type=code"`
 	// Extension for Name
 	NameExt *primitives.PrimitiveExtension `json:"_name,omitempty" fhir:"cardinality=0..1"`
 	// in | out
-	Use string `json:"use" fhir:"cardinality=1..1,required,summary"`
+	Use OperationParameterUse `json:"use" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/operation-parameter-use|4.0.1,summary,type=code"`
 	// Extension for Use
 	UseExt *primitives.PrimitiveExtension `json:"_use,omitempty" fhir:"cardinality=0..1"`
 	// Minimum cardinality
-	Min *int `json:"min,omitempty" fhir:"cardinality=0..1,summary"`
+	Min *int `json:"min,omitempty" fhir:"cardinality=0..1,summary,type=integer"`
 	// Extension for Min
 	MinExt *primitives.PrimitiveExtension `json:"_min,omitempty" fhir:"cardinality=0..1"`
 	// Maximum cardinality (a number of *)
@@ -38,11 +38,11 @@ type ParameterDefinition struct {
 	// Extension for Documentation
 	DocumentationExt *primitives.PrimitiveExtension `json:"_documentation,omitempty" fhir:"cardinality=0..1"`
 	// What type of value
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type string `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/all-types|4.0.1,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// What profile the value is expected to be
-	Profile *string `json:"profile,omitempty" fhir:"cardinality=0..1,summary"`
+	Profile *primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 }
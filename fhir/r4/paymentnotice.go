@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypePaymentNotice = "PaymentNotice"
 
 // PaymentNotice represents a FHIR PaymentNotice.
 type PaymentNotice struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for the payment noctice
@@ -49,3 +48,8 @@ type PaymentNotice struct {
 	// Issued or cleared Status of the payment
 	PaymentStatus *CodeableConcept `json:"paymentStatus,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "PaymentNotice", the FHIR type of the resource.
+func (p *PaymentNotice) ResourceType() string {
+	return ResourceTypePaymentNotice
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -69,7 +68,7 @@ type PaymentReconciliationProcessNote struct {
 
 // PaymentReconciliation represents a FHIR PaymentReconciliation.
 type PaymentReconciliation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for a payment reconciliation
@@ -113,3 +112,8 @@ type PaymentReconciliation struct {
 	// Note concerning processing
 	ProcessNote []PaymentReconciliationProcessNote `json:"processNote,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "PaymentReconciliation", the FHIR type of the resource.
+func (p *PaymentReconciliation) ResourceType() string {
+	return ResourceTypePaymentReconciliation
+}
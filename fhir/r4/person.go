@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type PersonLink struct {
 
 // Person represents a FHIR Person.
 type Person struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A human identifier for this person
@@ -43,7 +42,7 @@ type Person struct {
 	// A contact detail for the person
 	Telecom []ContactPoint `json:"telecom,omitempty" fhir:"cardinality=0..*,summary"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// The date on which the person was born
@@ -63,3 +62,8 @@ type Person struct {
 	// Link to a resource that concerns the same actual person
 	Link []PersonLink `json:"link,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Person", the FHIR type of the resource.
+func (p *Person) ResourceType() string {
+	return ResourceTypePerson
+}
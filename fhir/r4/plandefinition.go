@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,6 +34,58 @@ type PlanDefinitionGoalTarget struct {
 	Due *Duration `json:"due,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Detail returns the option of detail[x] that is set, or nil if none is.
+func (p *PlanDefinitionGoalTarget) Detail() any {
+	switch {
+	case p.DetailQuantity != nil:
+		return p.DetailQuantity
+	case p.DetailRange != nil:
+		return p.DetailRange
+	case p.DetailCodeableConcept != nil:
+		return p.DetailCodeableConcept
+	}
+	return nil
+}
+
+// DetailType returns the FHIR type of the option of detail[x] that is
+// set, such as "Quantity", or "" if none is.
+func (p *PlanDefinitionGoalTarget) DetailType() string {
+	switch {
+	case p.DetailQuantity != nil:
+		return "Quantity"
+	case p.DetailRange != nil:
+		return "Range"
+	case p.DetailCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearDetail unsets every option of detail[x], and their extensions.
+func (p *PlanDefinitionGoalTarget) ClearDetail() {
+	p.DetailQuantity = nil
+	p.DetailRange = nil
+	p.DetailCodeableConcept = nil
+}
+
+// SetDetailQuantity sets the Quantity option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailQuantity(value Quantity) {
+	p.ClearDetail()
+	p.DetailQuantity = &value
+}
+
+// SetDetailRange sets the Range option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailRange(value Range) {
+	p.ClearDetail()
+	p.DetailRange = &value
+}
+
+// SetDetailCodeableConcept sets the CodeableConcept option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailCodeableConcept(value CodeableConcept) {
+	p.ClearDetail()
+	p.DetailCodeableConcept = &value
+}
+
 // PlanDefinitionGoal represents a FHIR BackboneElement for PlanDefinition.goal.
 type PlanDefinitionGoal struct {
 	// Unique id for inter-element referencing
@@ -103,6 +154,47 @@ type PlanDefinitionActionRelatedAction struct {
 	OffsetRange *Range `json:"offsetRange,omitempty" fhir:"cardinality=0..1,choice=offset"`
 }
 
+// Offset returns the option of offset[x] that is set, or nil if none is.
+func (p *PlanDefinitionActionRelatedAction) Offset() any {
+	switch {
+	case p.OffsetDuration != nil:
+		return p.OffsetDuration
+	case p.OffsetRange != nil:
+		return p.OffsetRange
+	}
+	return nil
+}
+
+// OffsetType returns the FHIR type of the option of offset[x] that is
+// set, such as "Duration", or "" if none is.
+func (p *PlanDefinitionActionRelatedAction) OffsetType() string {
+	switch {
+	case p.OffsetDuration != nil:
+		return "Duration"
+	case p.OffsetRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearOffset unsets every option of offset[x], and their extensions.
+func (p *PlanDefinitionActionRelatedAction) ClearOffset() {
+	p.OffsetDuration = nil
+	p.OffsetRange = nil
+}
+
+// SetOffsetDuration sets the Duration option of offset[x], clearing the others.
+func (p *PlanDefinitionActionRelatedAction) SetOffsetDuration(value Duration) {
+	p.ClearOffset()
+	p.OffsetDuration = &value
+}
+
+// SetOffsetRange sets the Range option of offset[x], clearing the others.
+func (p *PlanDefinitionActionRelatedAction) SetOffsetRange(value Range) {
+	p.ClearOffset()
+	p.OffsetRange = &value
+}
+
 // PlanDefinitionActionParticipant represents a FHIR BackboneElement for PlanDefinition.action.participant.
 type PlanDefinitionActionParticipant struct {
 	// Unique id for inter-element referencing
@@ -236,13 +328,13 @@ type PlanDefinitionAction struct {
 	// Extension for CardinalityBehavior
 	CardinalityBehaviorExt *primitives.PrimitiveExtension `json:"_cardinalityBehavior,omitempty" fhir:"cardinality=0..1"`
 	// Description of the activity to be performed - canonical option
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,choice=definition"`
+	DefinitionCanonical *primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,choice=definition"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Description of the activity to be performed - uri option
-	DefinitionURI *string `json:"definitionURI,omitempty" fhir:"cardinality=0..1,choice=definition"`
+	DefinitionURI *string `json:"definitionUri,omitempty" fhir:"cardinality=0..1,choice=definition"`
 	// Extension for DefinitionURI
-	DefinitionURIExt *primitives.PrimitiveExtension `json:"_definitionURI,omitempty" fhir:"cardinality=0..1"`
+	DefinitionURIExt *primitives.PrimitiveExtension `json:"_definitionUri,omitempty" fhir:"cardinality=0..1"`
 	// Transform to apply the template
 	Transform *string `json:"transform,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Transform
@@ -253,9 +345,182 @@ type PlanDefinitionAction struct {
 	Action []PlanDefinitionActionAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (p *PlanDefinitionAction) Subject() any {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return p.SubjectCodeableConcept
+	case p.SubjectReference != nil:
+		return p.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (p *PlanDefinitionAction) SubjectType() string {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (p *PlanDefinitionAction) ClearSubject() {
+	p.SubjectCodeableConcept = nil
+	p.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (p *PlanDefinitionAction) SetSubjectCodeableConcept(value CodeableConcept) {
+	p.ClearSubject()
+	p.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (p *PlanDefinitionAction) SetSubjectReference(value Reference) {
+	p.ClearSubject()
+	p.SubjectReference = &value
+}
+
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (p *PlanDefinitionAction) Timing() any {
+	switch {
+	case p.TimingDateTime != nil:
+		return p.TimingDateTime
+	case p.TimingAge != nil:
+		return p.TimingAge
+	case p.TimingPeriod != nil:
+		return p.TimingPeriod
+	case p.TimingDuration != nil:
+		return p.TimingDuration
+	case p.TimingRange != nil:
+		return p.TimingRange
+	case p.TimingTiming != nil:
+		return p.TimingTiming
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "dateTime", or "" if none is.
+func (p *PlanDefinitionAction) TimingType() string {
+	switch {
+	case p.TimingDateTime != nil:
+		return "dateTime"
+	case p.TimingAge != nil:
+		return "Age"
+	case p.TimingPeriod != nil:
+		return "Period"
+	case p.TimingDuration != nil:
+		return "Duration"
+	case p.TimingRange != nil:
+		return "Range"
+	case p.TimingTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (p *PlanDefinitionAction) ClearTiming() {
+	p.TimingDateTime = nil
+	p.TimingDateTimeExt = nil
+	p.TimingAge = nil
+	p.TimingPeriod = nil
+	p.TimingDuration = nil
+	p.TimingRange = nil
+	p.TimingTiming = nil
+}
+
+// SetTimingDateTime sets the dateTime option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingDateTime(value primitives.DateTime) {
+	ext := p.TimingDateTimeExt
+	p.ClearTiming()
+	p.TimingDateTime, p.TimingDateTimeExt = &value, ext
+}
+
+// SetTimingAge sets the Age option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingAge(value Age) {
+	p.ClearTiming()
+	p.TimingAge = &value
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingPeriod(value Period) {
+	p.ClearTiming()
+	p.TimingPeriod = &value
+}
+
+// SetTimingDuration sets the Duration option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingDuration(value Duration) {
+	p.ClearTiming()
+	p.TimingDuration = &value
+}
+
+// SetTimingRange sets the Range option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingRange(value Range) {
+	p.ClearTiming()
+	p.TimingRange = &value
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingTiming(value Timing) {
+	p.ClearTiming()
+	p.TimingTiming = &value
+}
+
+// Definition returns the option of definition[x] that is set, or nil if none is.
+func (p *PlanDefinitionAction) Definition() any {
+	switch {
+	case p.DefinitionCanonical != nil:
+		return p.DefinitionCanonical
+	case p.DefinitionURI != nil:
+		return p.DefinitionURI
+	}
+	return nil
+}
+
+// DefinitionType returns the FHIR type of the option of definition[x] that is
+// set, such as "canonical", or "" if none is.
+func (p *PlanDefinitionAction) DefinitionType() string {
+	switch {
+	case p.DefinitionCanonical != nil:
+		return "canonical"
+	case p.DefinitionURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearDefinition unsets every option of definition[x], and their extensions.
+func (p *PlanDefinitionAction) ClearDefinition() {
+	p.DefinitionCanonical = nil
+	p.DefinitionCanonicalExt = nil
+	p.DefinitionURI = nil
+	p.DefinitionURIExt = nil
+}
+
+// SetDefinitionCanonical sets the canonical option of definition[x], clearing the others.
+func (p *PlanDefinitionAction) SetDefinitionCanonical(value primitives.Canonical) {
+	ext := p.DefinitionCanonicalExt
+	p.ClearDefinition()
+	p.DefinitionCanonical, p.DefinitionCanonicalExt = &value, ext
+}
+
+// SetDefinitionURI sets the uri option of definition[x], clearing the others.
+func (p *PlanDefinitionAction) SetDefinitionURI(value string) {
+	ext := p.DefinitionURIExt
+	p.ClearDefinition()
+	p.DefinitionURI, p.DefinitionURIExt = &value, ext
+}
+
 // PlanDefinition represents a FHIR PlanDefinition.
 type PlanDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this plan definition, represented as a URI (globally unique)
@@ -355,3 +620,49 @@ type PlanDefinition struct {
 	// Action defined by the plan
 	Action []PlanDefinitionAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "PlanDefinition", the FHIR type of the resource.
+func (p *PlanDefinition) ResourceType() string {
+	return ResourceTypePlanDefinition
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (p *PlanDefinition) Subject() any {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return p.SubjectCodeableConcept
+	case p.SubjectReference != nil:
+		return p.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (p *PlanDefinition) SubjectType() string {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (p *PlanDefinition) ClearSubject() {
+	p.SubjectCodeableConcept = nil
+	p.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (p *PlanDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	p.ClearSubject()
+	p.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (p *PlanDefinition) SetSubjectReference(value Reference) {
+	p.ClearSubject()
+	p.SubjectReference = &value
+}
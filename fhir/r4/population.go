@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -30,3 +30,44 @@ type Population struct {
 	// The existing physiological conditions of the specific population to which this applies
 	PhysiologicalCondition *CodeableConcept `json:"physiologicalCondition,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// Age returns the option of age[x] that is set, or nil if none is.
+func (p *Population) Age() any {
+	switch {
+	case p.AgeRange != nil:
+		return p.AgeRange
+	case p.AgeCodeableConcept != nil:
+		return p.AgeCodeableConcept
+	}
+	return nil
+}
+
+// AgeType returns the FHIR type of the option of age[x] that is
+// set, such as "Range", or "" if none is.
+func (p *Population) AgeType() string {
+	switch {
+	case p.AgeRange != nil:
+		return "Range"
+	case p.AgeCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAge unsets every option of age[x], and their extensions.
+func (p *Population) ClearAge() {
+	p.AgeRange = nil
+	p.AgeCodeableConcept = nil
+}
+
+// SetAgeRange sets the Range option of age[x], clearing the others.
+func (p *Population) SetAgeRange(value Range) {
+	p.ClearAge()
+	p.AgeRange = &value
+}
+
+// SetAgeCodeableConcept sets the CodeableConcept option of age[x], clearing the others.
+func (p *Population) SetAgeCodeableConcept(value CodeableConcept) {
+	p.ClearAge()
+	p.AgeCodeableConcept = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,7 +34,7 @@ type PractitionerQualification struct {
 
 // Practitioner represents a FHIR Practitioner.
 type Practitioner struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// An identifier for the person as this agent
@@ -51,7 +50,7 @@ type Practitioner struct {
 	// Address(es) of the practitioner that are not role specific (typically home address)
 	Address []Address `json:"address,omitempty" fhir:"cardinality=0..*,summary"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// The date  on which the practitioner was born
@@ -65,3 +64,8 @@ type Practitioner struct {
 	// A language the practitioner can use in patient communication
 	Communication []CodeableConcept `json:"communication,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Practitioner", the FHIR type of the resource.
+func (p *Practitioner) ResourceType() string {
+	return ResourceTypePractitioner
+}
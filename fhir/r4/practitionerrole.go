@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -61,7 +60,7 @@ type PractitionerRoleNotAvailable struct {
 
 // PractitionerRole represents a FHIR PractitionerRole.
 type PractitionerRole struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifiers that are specific to a role/location
@@ -97,3 +96,8 @@ type PractitionerRole struct {
 	// Technical endpoints providing access to services operated for the practitioner with this role
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "PractitionerRole", the FHIR type of the resource.
+func (p *PractitionerRole) ResourceType() string {
+	return ResourceTypePractitionerRole
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,7 +48,7 @@ type ProcedureFocalDevice struct {
 
 // Procedure represents a FHIR Procedure.
 type Procedure struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Identifiers for this procedure
@@ -127,3 +126,86 @@ type Procedure struct {
 	// Coded items used during the procedure
 	UsedCode []CodeableConcept `json:"usedCode,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Procedure", the FHIR type of the resource.
+func (p *Procedure) ResourceType() string {
+	return ResourceTypeProcedure
+}
+
+// Performed returns the option of performed[x] that is set, or nil if none is.
+func (p *Procedure) Performed() any {
+	switch {
+	case p.PerformedDateTime != nil:
+		return p.PerformedDateTime
+	case p.PerformedPeriod != nil:
+		return p.PerformedPeriod
+	case p.PerformedString != nil:
+		return p.PerformedString
+	case p.PerformedAge != nil:
+		return p.PerformedAge
+	case p.PerformedRange != nil:
+		return p.PerformedRange
+	}
+	return nil
+}
+
+// PerformedType returns the FHIR type of the option of performed[x] that is
+// set, such as "dateTime", or "" if none is.
+func (p *Procedure) PerformedType() string {
+	switch {
+	case p.PerformedDateTime != nil:
+		return "dateTime"
+	case p.PerformedPeriod != nil:
+		return "Period"
+	case p.PerformedString != nil:
+		return "string"
+	case p.PerformedAge != nil:
+		return "Age"
+	case p.PerformedRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearPerformed unsets every option of performed[x], and their extensions.
+func (p *Procedure) ClearPerformed() {
+	p.PerformedDateTime = nil
+	p.PerformedDateTimeExt = nil
+	p.PerformedPeriod = nil
+	p.PerformedString = nil
+	p.PerformedStringExt = nil
+	p.PerformedAge = nil
+	p.PerformedRange = nil
+}
+
+// SetPerformedDateTime sets the dateTime option of performed[x], clearing the others.
+func (p *Procedure) SetPerformedDateTime(value primitives.DateTime) {
+	ext := p.PerformedDateTimeExt
+	p.ClearPerformed()
+	p.PerformedDateTime, p.PerformedDateTimeExt = &value, ext
+}
+
+// SetPerformedPeriod sets the Period option of performed[x], clearing the others.
+func (p *Procedure) SetPerformedPeriod(value Period) {
+	p.ClearPerformed()
+	p.PerformedPeriod = &value
+}
+
+// SetPerformedString sets the string option of performed[x], clearing the others.
+func (p *Procedure) SetPerformedString(value string) {
+	ext := p.PerformedStringExt
+	p.ClearPerformed()
+	p.PerformedString, p.PerformedStringExt = &value, ext
+}
+
+// SetPerformedAge sets the Age option of performed[x], clearing the others.
+func (p *Procedure) SetPerformedAge(value Age) {
+	p.ClearPerformed()
+	p.PerformedAge = &value
+}
+
+// SetPerformedRange sets the Range option of performed[x], clearing the others.
+func (p *Procedure) SetPerformedRange(value Range) {
+	p.ClearPerformed()
+	p.PerformedRange = &value
+}
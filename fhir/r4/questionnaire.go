@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -32,39 +31,182 @@ type QuestionnaireItemEnableWhen struct {
 	// Extension for Operator
 	OperatorExt *primitives.PrimitiveExtension `json:"_operator,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - boolean option
-	AnswerBoolean bool `json:"answerBoolean" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerBoolean *bool `json:"answerBoolean,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerBoolean
 	AnswerBooleanExt *primitives.PrimitiveExtension `json:"_answerBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - decimal option
-	AnswerDecimal float64 `json:"answerDecimal" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerDecimal *primitives.Decimal `json:"answerDecimal,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerDecimal
 	AnswerDecimalExt *primitives.PrimitiveExtension `json:"_answerDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - integer option
-	AnswerInteger int `json:"answerInteger" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerInteger *int `json:"answerInteger,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerInteger
 	AnswerIntegerExt *primitives.PrimitiveExtension `json:"_answerInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - date option
-	AnswerDate primitives.Date `json:"answerDate" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerDate *primitives.Date `json:"answerDate,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerDate
 	AnswerDateExt *primitives.PrimitiveExtension `json:"_answerDate,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - dateTime option
-	AnswerDateTime primitives.DateTime `json:"answerDateTime" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerDateTime *primitives.DateTime `json:"answerDateTime,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerDateTime
 	AnswerDateTimeExt *primitives.PrimitiveExtension `json:"_answerDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - time option
-	AnswerTime primitives.Time `json:"answerTime" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerTime *primitives.Time `json:"answerTime,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerTime
 	AnswerTimeExt *primitives.PrimitiveExtension `json:"_answerTime,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - string option
-	AnswerString string `json:"answerString" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerString *string `json:"answerString,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Extension for AnswerString
 	AnswerStringExt *primitives.PrimitiveExtension `json:"_answerString,omitempty" fhir:"cardinality=0..1"`
 	// Value for question comparison based on operator - Coding option
-	AnswerCoding Coding `json:"answerCoding" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerCoding *Coding `json:"answerCoding,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Value for question comparison based on operator - Quantity option
-	AnswerQuantity Quantity `json:"answerQuantity" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerQuantity *Quantity `json:"answerQuantity,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
 	// Value for question comparison based on operator - Reference option
-	AnswerReference Reference `json:"answerReference" fhir:"cardinality=1..1,required,choice=answer"`
+	AnswerReference *Reference `json:"answerReference,omitempty" fhir:"cardinality=1..1,required,choice=answer"`
+}
+
+// Answer returns the option of answer[x] that is set, or nil if none is.
+func (q *QuestionnaireItemEnableWhen) Answer() any {
+	switch {
+	case q.AnswerBoolean != nil:
+		return q.AnswerBoolean
+	case q.AnswerDecimal != nil:
+		return q.AnswerDecimal
+	case q.AnswerInteger != nil:
+		return q.AnswerInteger
+	case q.AnswerDate != nil:
+		return q.AnswerDate
+	case q.AnswerDateTime != nil:
+		return q.AnswerDateTime
+	case q.AnswerTime != nil:
+		return q.AnswerTime
+	case q.AnswerString != nil:
+		return q.AnswerString
+	case q.AnswerCoding != nil:
+		return q.AnswerCoding
+	case q.AnswerQuantity != nil:
+		return q.AnswerQuantity
+	case q.AnswerReference != nil:
+		return q.AnswerReference
+	}
+	return nil
+}
+
+// AnswerType returns the FHIR type of the option of answer[x] that is
+// set, such as "boolean", or "" if none is.
+func (q *QuestionnaireItemEnableWhen) AnswerType() string {
+	switch {
+	case q.AnswerBoolean != nil:
+		return "boolean"
+	case q.AnswerDecimal != nil:
+		return "decimal"
+	case q.AnswerInteger != nil:
+		return "integer"
+	case q.AnswerDate != nil:
+		return "date"
+	case q.AnswerDateTime != nil:
+		return "dateTime"
+	case q.AnswerTime != nil:
+		return "time"
+	case q.AnswerString != nil:
+		return "string"
+	case q.AnswerCoding != nil:
+		return "Coding"
+	case q.AnswerQuantity != nil:
+		return "Quantity"
+	case q.AnswerReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearAnswer unsets every option of answer[x], and their extensions.
+func (q *QuestionnaireItemEnableWhen) ClearAnswer() {
+	q.AnswerBoolean = nil
+	q.AnswerBooleanExt = nil
+	q.AnswerDecimal = nil
+	q.AnswerDecimalExt = nil
+	q.AnswerInteger = nil
+	q.AnswerIntegerExt = nil
+	q.AnswerDate = nil
+	q.AnswerDateExt = nil
+	q.AnswerDateTime = nil
+	q.AnswerDateTimeExt = nil
+	q.AnswerTime = nil
+	q.AnswerTimeExt = nil
+	q.AnswerString = nil
+	q.AnswerStringExt = nil
+	q.AnswerCoding = nil
+	q.AnswerQuantity = nil
+	q.AnswerReference = nil
+}
+
+// SetAnswerBoolean sets the boolean option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerBoolean(value bool) {
+	ext := q.AnswerBooleanExt
+	q.ClearAnswer()
+	q.AnswerBoolean, q.AnswerBooleanExt = &value, ext
+}
+
+// SetAnswerDecimal sets the decimal option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerDecimal(value primitives.Decimal) {
+	ext := q.AnswerDecimalExt
+	q.ClearAnswer()
+	q.AnswerDecimal, q.AnswerDecimalExt = &value, ext
+}
+
+// SetAnswerInteger sets the integer option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerInteger(value int) {
+	ext := q.AnswerIntegerExt
+	q.ClearAnswer()
+	q.AnswerInteger, q.AnswerIntegerExt = &value, ext
+}
+
+// SetAnswerDate sets the date option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerDate(value primitives.Date) {
+	ext := q.AnswerDateExt
+	q.ClearAnswer()
+	q.AnswerDate, q.AnswerDateExt = &value, ext
+}
+
+// SetAnswerDateTime sets the dateTime option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerDateTime(value primitives.DateTime) {
+	ext := q.AnswerDateTimeExt
+	q.ClearAnswer()
+	q.AnswerDateTime, q.AnswerDateTimeExt = &value, ext
+}
+
+// SetAnswerTime sets the time option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerTime(value primitives.Time) {
+	ext := q.AnswerTimeExt
+	q.ClearAnswer()
+	q.AnswerTime, q.AnswerTimeExt = &value, ext
+}
+
+// SetAnswerString sets the string option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerString(value string) {
+	ext := q.AnswerStringExt
+	q.ClearAnswer()
+	q.AnswerString, q.AnswerStringExt = &value, ext
+}
+
+// SetAnswerCoding sets the Coding option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerCoding(value Coding) {
+	q.ClearAnswer()
+	q.AnswerCoding = &value
+}
+
+// SetAnswerQuantity sets the Quantity option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerQuantity(value Quantity) {
+	q.ClearAnswer()
+	q.AnswerQuantity = &value
+}
+
+// SetAnswerReference sets the Reference option of answer[x], clearing the others.
+func (q *QuestionnaireItemEnableWhen) SetAnswerReference(value Reference) {
+	q.ClearAnswer()
+	q.AnswerReference = &value
 }
 
 // QuestionnaireItemAnswerOption represents a FHIR BackboneElement for Questionnaire.item.answerOption.
@@ -78,31 +220,124 @@ type QuestionnaireItemAnswerOption struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Answer value - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Answer value - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Answer value - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Answer value - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Answer value - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Answer value - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Whether option is selected by default
 	InitialSelected *bool `json:"initialSelected,omitempty" fhir:"cardinality=0..1"`
 	// Extension for InitialSelected
 	InitialSelectedExt *primitives.PrimitiveExtension `json:"_initialSelected,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (q *QuestionnaireItemAnswerOption) Value() any {
+	switch {
+	case q.ValueInteger != nil:
+		return q.ValueInteger
+	case q.ValueDate != nil:
+		return q.ValueDate
+	case q.ValueTime != nil:
+		return q.ValueTime
+	case q.ValueString != nil:
+		return q.ValueString
+	case q.ValueCoding != nil:
+		return q.ValueCoding
+	case q.ValueReference != nil:
+		return q.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "integer", or "" if none is.
+func (q *QuestionnaireItemAnswerOption) ValueType() string {
+	switch {
+	case q.ValueInteger != nil:
+		return "integer"
+	case q.ValueDate != nil:
+		return "date"
+	case q.ValueTime != nil:
+		return "time"
+	case q.ValueString != nil:
+		return "string"
+	case q.ValueCoding != nil:
+		return "Coding"
+	case q.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (q *QuestionnaireItemAnswerOption) ClearValue() {
+	q.ValueInteger = nil
+	q.ValueIntegerExt = nil
+	q.ValueDate = nil
+	q.ValueDateExt = nil
+	q.ValueTime = nil
+	q.ValueTimeExt = nil
+	q.ValueString = nil
+	q.ValueStringExt = nil
+	q.ValueCoding = nil
+	q.ValueReference = nil
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (q *QuestionnaireItemAnswerOption) SetValueInteger(value int) {
+	ext := q.ValueIntegerExt
+	q.ClearValue()
+	q.ValueInteger, q.ValueIntegerExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (q *QuestionnaireItemAnswerOption) SetValueDate(value primitives.Date) {
+	ext := q.ValueDateExt
+	q.ClearValue()
+	q.ValueDate, q.ValueDateExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (q *QuestionnaireItemAnswerOption) SetValueTime(value primitives.Time) {
+	ext := q.ValueTimeExt
+	q.ClearValue()
+	q.ValueTime, q.ValueTimeExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (q *QuestionnaireItemAnswerOption) SetValueString(value string) {
+	ext := q.ValueStringExt
+	q.ClearValue()
+	q.ValueString, q.ValueStringExt = &value, ext
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (q *QuestionnaireItemAnswerOption) SetValueCoding(value Coding) {
+	q.ClearValue()
+	q.ValueCoding = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (q *QuestionnaireItemAnswerOption) SetValueReference(value Reference) {
+	q.ClearValue()
+	q.ValueReference = &value
+}
+
 // QuestionnaireItemInitial represents a FHIR BackboneElement for Questionnaire.item.initial.
 type QuestionnaireItemInitial struct {
 	// Unique id for inter-element referencing
@@ -114,45 +349,212 @@ type QuestionnaireItemInitial struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Actual value for initializing the question - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Actual value for initializing the question - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Actual value for initializing the question - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Actual value for initializing the question - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Actual value for initializing the question - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (q *QuestionnaireItemInitial) Value() any {
+	switch {
+	case q.ValueBoolean != nil:
+		return q.ValueBoolean
+	case q.ValueDecimal != nil:
+		return q.ValueDecimal
+	case q.ValueInteger != nil:
+		return q.ValueInteger
+	case q.ValueDate != nil:
+		return q.ValueDate
+	case q.ValueDateTime != nil:
+		return q.ValueDateTime
+	case q.ValueTime != nil:
+		return q.ValueTime
+	case q.ValueString != nil:
+		return q.ValueString
+	case q.ValueURI != nil:
+		return q.ValueURI
+	case q.ValueAttachment != nil:
+		return q.ValueAttachment
+	case q.ValueCoding != nil:
+		return q.ValueCoding
+	case q.ValueQuantity != nil:
+		return q.ValueQuantity
+	case q.ValueReference != nil:
+		return q.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (q *QuestionnaireItemInitial) ValueType() string {
+	switch {
+	case q.ValueBoolean != nil:
+		return "boolean"
+	case q.ValueDecimal != nil:
+		return "decimal"
+	case q.ValueInteger != nil:
+		return "integer"
+	case q.ValueDate != nil:
+		return "date"
+	case q.ValueDateTime != nil:
+		return "dateTime"
+	case q.ValueTime != nil:
+		return "time"
+	case q.ValueString != nil:
+		return "string"
+	case q.ValueURI != nil:
+		return "uri"
+	case q.ValueAttachment != nil:
+		return "Attachment"
+	case q.ValueCoding != nil:
+		return "Coding"
+	case q.ValueQuantity != nil:
+		return "Quantity"
+	case q.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (q *QuestionnaireItemInitial) ClearValue() {
+	q.ValueBoolean = nil
+	q.ValueBooleanExt = nil
+	q.ValueDecimal = nil
+	q.ValueDecimalExt = nil
+	q.ValueInteger = nil
+	q.ValueIntegerExt = nil
+	q.ValueDate = nil
+	q.ValueDateExt = nil
+	q.ValueDateTime = nil
+	q.ValueDateTimeExt = nil
+	q.ValueTime = nil
+	q.ValueTimeExt = nil
+	q.ValueString = nil
+	q.ValueStringExt = nil
+	q.ValueURI = nil
+	q.ValueURIExt = nil
+	q.ValueAttachment = nil
+	q.ValueCoding = nil
+	q.ValueQuantity = nil
+	q.ValueReference = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueBoolean(value bool) {
+	ext := q.ValueBooleanExt
+	q.ClearValue()
+	q.ValueBoolean, q.ValueBooleanExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueDecimal(value primitives.Decimal) {
+	ext := q.ValueDecimalExt
+	q.ClearValue()
+	q.ValueDecimal, q.ValueDecimalExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueInteger(value int) {
+	ext := q.ValueIntegerExt
+	q.ClearValue()
+	q.ValueInteger, q.ValueIntegerExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueDate(value primitives.Date) {
+	ext := q.ValueDateExt
+	q.ClearValue()
+	q.ValueDate, q.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueDateTime(value primitives.DateTime) {
+	ext := q.ValueDateTimeExt
+	q.ClearValue()
+	q.ValueDateTime, q.ValueDateTimeExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueTime(value primitives.Time) {
+	ext := q.ValueTimeExt
+	q.ClearValue()
+	q.ValueTime, q.ValueTimeExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueString(value string) {
+	ext := q.ValueStringExt
+	q.ClearValue()
+	q.ValueString, q.ValueStringExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueURI(value string) {
+	ext := q.ValueURIExt
+	q.ClearValue()
+	q.ValueURI, q.ValueURIExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueAttachment(value Attachment) {
+	q.ClearValue()
+	q.ValueAttachment = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueCoding(value Coding) {
+	q.ClearValue()
+	q.ValueCoding = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueQuantity(value Quantity) {
+	q.ClearValue()
+	q.ValueQuantity = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (q *QuestionnaireItemInitial) SetValueReference(value Reference) {
+	q.ClearValue()
+	q.ValueReference = &value
 }
 
 // QuestionnaireItemItem represents a FHIR BackboneElement for Questionnaire.item.item.
@@ -227,7 +629,7 @@ type QuestionnaireItem struct {
 
 // Questionnaire represents a FHIR Questionnaire.
 type Questionnaire struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this questionnaire, represented as a URI (globally unique)
@@ -305,3 +707,8 @@ type Questionnaire struct {
 	// Questions and sections within the Questionnaire
 	Item []QuestionnaireItem `json:"item,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Questionnaire", the FHIR type of the resource.
+func (q *Questionnaire) ResourceType() string {
+	return ResourceTypeQuestionnaire
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -32,7 +31,7 @@ type QuestionnaireResponseItemAnswer struct {
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - decimal option
-	ValueDecimal *float64 `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - integer option
@@ -56,9 +55,9 @@ type QuestionnaireResponseItemAnswer struct {
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - uri option
-	ValueURI *string `json:"valueURI,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - Attachment option
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Single-valued answer to the question - Coding option
@@ -71,6 +70,173 @@ type QuestionnaireResponseItemAnswer struct {
 	Item []QuestionnaireResponseItemAnswerItem `json:"item,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (q *QuestionnaireResponseItemAnswer) Value() any {
+	switch {
+	case q.ValueBoolean != nil:
+		return q.ValueBoolean
+	case q.ValueDecimal != nil:
+		return q.ValueDecimal
+	case q.ValueInteger != nil:
+		return q.ValueInteger
+	case q.ValueDate != nil:
+		return q.ValueDate
+	case q.ValueDateTime != nil:
+		return q.ValueDateTime
+	case q.ValueTime != nil:
+		return q.ValueTime
+	case q.ValueString != nil:
+		return q.ValueString
+	case q.ValueURI != nil:
+		return q.ValueURI
+	case q.ValueAttachment != nil:
+		return q.ValueAttachment
+	case q.ValueCoding != nil:
+		return q.ValueCoding
+	case q.ValueQuantity != nil:
+		return q.ValueQuantity
+	case q.ValueReference != nil:
+		return q.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (q *QuestionnaireResponseItemAnswer) ValueType() string {
+	switch {
+	case q.ValueBoolean != nil:
+		return "boolean"
+	case q.ValueDecimal != nil:
+		return "decimal"
+	case q.ValueInteger != nil:
+		return "integer"
+	case q.ValueDate != nil:
+		return "date"
+	case q.ValueDateTime != nil:
+		return "dateTime"
+	case q.ValueTime != nil:
+		return "time"
+	case q.ValueString != nil:
+		return "string"
+	case q.ValueURI != nil:
+		return "uri"
+	case q.ValueAttachment != nil:
+		return "Attachment"
+	case q.ValueCoding != nil:
+		return "Coding"
+	case q.ValueQuantity != nil:
+		return "Quantity"
+	case q.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (q *QuestionnaireResponseItemAnswer) ClearValue() {
+	q.ValueBoolean = nil
+	q.ValueBooleanExt = nil
+	q.ValueDecimal = nil
+	q.ValueDecimalExt = nil
+	q.ValueInteger = nil
+	q.ValueIntegerExt = nil
+	q.ValueDate = nil
+	q.ValueDateExt = nil
+	q.ValueDateTime = nil
+	q.ValueDateTimeExt = nil
+	q.ValueTime = nil
+	q.ValueTimeExt = nil
+	q.ValueString = nil
+	q.ValueStringExt = nil
+	q.ValueURI = nil
+	q.ValueURIExt = nil
+	q.ValueAttachment = nil
+	q.ValueCoding = nil
+	q.ValueQuantity = nil
+	q.ValueReference = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueBoolean(value bool) {
+	ext := q.ValueBooleanExt
+	q.ClearValue()
+	q.ValueBoolean, q.ValueBooleanExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueDecimal(value primitives.Decimal) {
+	ext := q.ValueDecimalExt
+	q.ClearValue()
+	q.ValueDecimal, q.ValueDecimalExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueInteger(value int) {
+	ext := q.ValueIntegerExt
+	q.ClearValue()
+	q.ValueInteger, q.ValueIntegerExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueDate(value primitives.Date) {
+	ext := q.ValueDateExt
+	q.ClearValue()
+	q.ValueDate, q.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueDateTime(value primitives.DateTime) {
+	ext := q.ValueDateTimeExt
+	q.ClearValue()
+	q.ValueDateTime, q.ValueDateTimeExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueTime(value primitives.Time) {
+	ext := q.ValueTimeExt
+	q.ClearValue()
+	q.ValueTime, q.ValueTimeExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueString(value string) {
+	ext := q.ValueStringExt
+	q.ClearValue()
+	q.ValueString, q.ValueStringExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueURI(value string) {
+	ext := q.ValueURIExt
+	q.ClearValue()
+	q.ValueURI, q.ValueURIExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueAttachment(value Attachment) {
+	q.ClearValue()
+	q.ValueAttachment = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueCoding(value Coding) {
+	q.ClearValue()
+	q.ValueCoding = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueQuantity(value Quantity) {
+	q.ClearValue()
+	q.ValueQuantity = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueReference(value Reference) {
+	q.ClearValue()
+	q.ValueReference = &value
+}
+
 // QuestionnaireResponseItemItem represents a FHIR BackboneElement for QuestionnaireResponse.item.item.
 type QuestionnaireResponseItemItem struct {
 }
@@ -105,7 +271,7 @@ type QuestionnaireResponseItem struct {
 
 // QuestionnaireResponse represents a FHIR QuestionnaireResponse.
 type QuestionnaireResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique id for this set of answers
@@ -137,3 +303,8 @@ type QuestionnaireResponse struct {
 	// Groups and questions
 	Item []QuestionnaireResponseItem `json:"item,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "QuestionnaireResponse", the FHIR type of the resource.
+func (q *QuestionnaireResponse) ResourceType() string {
+	return ResourceTypeQuestionnaireResponse
+}
@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -22,7 +22,7 @@ type Reference struct {
 	// Extension for Reference
 	ReferenceExt *primitives.PrimitiveExtension `json:"_reference,omitempty" fhir:"cardinality=0..1"`
 	// Type the reference refers to (e.g. "Patient")
-	Type *string `json:"type,omitempty" fhir:"cardinality=0..1,summary"`
+	Type *string `json:"type,omitempty" fhir:"cardinality=0..1,binding=extensible:http://hl7.org/fhir/ValueSet/resource-types,summary,type=uri"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Logical reference, when literal reference is not known
@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type RelatedArtifact struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type RelatedArtifactType `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/related-artifact-type|4.0.1,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Short label
@@ -30,17 +30,17 @@ type RelatedArtifact struct {
 	// Extension for Display
 	DisplayExt *primitives.PrimitiveExtension `json:"_display,omitempty" fhir:"cardinality=0..1"`
 	// Bibliographic citation for the artifact
-	Citation *string `json:"citation,omitempty" fhir:"cardinality=0..1,summary"`
+	Citation *string `json:"citation,omitempty" fhir:"cardinality=0..1,summary,type=markdown"`
 	// Extension for Citation
 	CitationExt *primitives.PrimitiveExtension `json:"_citation,omitempty" fhir:"cardinality=0..1"`
 	// Where the artifact can be accessed
-	URL *string `json:"url,omitempty" fhir:"cardinality=0..1,summary"`
+	URL *string `json:"url,omitempty" fhir:"cardinality=0..1,summary,type=url"`
 	// Extension for URL
 	URLExt *primitives.PrimitiveExtension `json:"_url,omitempty" fhir:"cardinality=0..1"`
 	// What document is being referenced
 	Document *Attachment `json:"document,omitempty" fhir:"cardinality=0..1,summary"`
 	// What resource is being referenced
-	Resource *string `json:"resource,omitempty" fhir:"cardinality=0..1,summary"`
+	Resource *primitives.Canonical `json:"resource,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Resource
 	ResourceExt *primitives.PrimitiveExtension `json:"_resource,omitempty" fhir:"cardinality=0..1"`
 }
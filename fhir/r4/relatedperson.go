@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type RelatedPersonCommunication struct {
 
 // RelatedPerson represents a FHIR RelatedPerson.
 type RelatedPerson struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A human identifier for this person
@@ -51,7 +50,7 @@ type RelatedPerson struct {
 	// A contact detail for the person
 	Telecom []ContactPoint `json:"telecom,omitempty" fhir:"cardinality=0..*,summary"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// The date on which the related person was born
@@ -67,3 +66,8 @@ type RelatedPerson struct {
 	// A language which may be used to communicate with about the patient's health
 	Communication []RelatedPersonCommunication `json:"communication,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "RelatedPerson", the FHIR type of the resource.
+func (r *RelatedPerson) ResourceType() string {
+	return ResourceTypeRelatedPerson
+}
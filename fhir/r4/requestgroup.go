@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -55,6 +54,47 @@ type RequestGroupActionRelatedAction struct {
 	OffsetRange *Range `json:"offsetRange,omitempty" fhir:"cardinality=0..1,choice=offset"`
 }
 
+// Offset returns the option of offset[x] that is set, or nil if none is.
+func (r *RequestGroupActionRelatedAction) Offset() any {
+	switch {
+	case r.OffsetDuration != nil:
+		return r.OffsetDuration
+	case r.OffsetRange != nil:
+		return r.OffsetRange
+	}
+	return nil
+}
+
+// OffsetType returns the FHIR type of the option of offset[x] that is
+// set, such as "Duration", or "" if none is.
+func (r *RequestGroupActionRelatedAction) OffsetType() string {
+	switch {
+	case r.OffsetDuration != nil:
+		return "Duration"
+	case r.OffsetRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearOffset unsets every option of offset[x], and their extensions.
+func (r *RequestGroupActionRelatedAction) ClearOffset() {
+	r.OffsetDuration = nil
+	r.OffsetRange = nil
+}
+
+// SetOffsetDuration sets the Duration option of offset[x], clearing the others.
+func (r *RequestGroupActionRelatedAction) SetOffsetDuration(value Duration) {
+	r.ClearOffset()
+	r.OffsetDuration = &value
+}
+
+// SetOffsetRange sets the Range option of offset[x], clearing the others.
+func (r *RequestGroupActionRelatedAction) SetOffsetRange(value Range) {
+	r.ClearOffset()
+	r.OffsetRange = &value
+}
+
 // RequestGroupActionAction represents a FHIR BackboneElement for RequestGroup.action.action.
 type RequestGroupActionAction struct {
 }
@@ -141,9 +181,96 @@ type RequestGroupAction struct {
 	Action []RequestGroupActionAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (r *RequestGroupAction) Timing() any {
+	switch {
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	case r.TimingAge != nil:
+		return r.TimingAge
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	case r.TimingDuration != nil:
+		return r.TimingDuration
+	case r.TimingRange != nil:
+		return r.TimingRange
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "dateTime", or "" if none is.
+func (r *RequestGroupAction) TimingType() string {
+	switch {
+	case r.TimingDateTime != nil:
+		return "dateTime"
+	case r.TimingAge != nil:
+		return "Age"
+	case r.TimingPeriod != nil:
+		return "Period"
+	case r.TimingDuration != nil:
+		return "Duration"
+	case r.TimingRange != nil:
+		return "Range"
+	case r.TimingTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (r *RequestGroupAction) ClearTiming() {
+	r.TimingDateTime = nil
+	r.TimingDateTimeExt = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingDuration = nil
+	r.TimingRange = nil
+	r.TimingTiming = nil
+}
+
+// SetTimingDateTime sets the dateTime option of timing[x], clearing the others.
+func (r *RequestGroupAction) SetTimingDateTime(value primitives.DateTime) {
+	ext := r.TimingDateTimeExt
+	r.ClearTiming()
+	r.TimingDateTime, r.TimingDateTimeExt = &value, ext
+}
+
+// SetTimingAge sets the Age option of timing[x], clearing the others.
+func (r *RequestGroupAction) SetTimingAge(value Age) {
+	r.ClearTiming()
+	r.TimingAge = &value
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (r *RequestGroupAction) SetTimingPeriod(value Period) {
+	r.ClearTiming()
+	r.TimingPeriod = &value
+}
+
+// SetTimingDuration sets the Duration option of timing[x], clearing the others.
+func (r *RequestGroupAction) SetTimingDuration(value Duration) {
+	r.ClearTiming()
+	r.TimingDuration = &value
+}
+
+// SetTimingRange sets the Range option of timing[x], clearing the others.
+func (r *RequestGroupAction) SetTimingRange(value Range) {
+	r.ClearTiming()
+	r.TimingRange = &value
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (r *RequestGroupAction) SetTimingTiming(value Timing) {
+	r.ClearTiming()
+	r.TimingTiming = &value
+}
+
 // RequestGroup represents a FHIR RequestGroup.
 type RequestGroup struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -195,3 +322,8 @@ type RequestGroup struct {
 	// Proposed actions, if any
 	Action []RequestGroupAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "RequestGroup", the FHIR type of the resource.
+func (r *RequestGroup) ResourceType() string {
+	return ResourceTypeRequestGroup
+}
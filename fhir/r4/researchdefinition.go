@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeResearchDefinition = "ResearchDefinition"
 
 // ResearchDefinition represents a FHIR ResearchDefinition.
 type ResearchDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this research definition, represented as a URI (globally unique)
@@ -125,3 +124,49 @@ type ResearchDefinition struct {
 	// What outcome?
 	Outcome *Reference `json:"outcome,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "ResearchDefinition", the FHIR type of the resource.
+func (r *ResearchDefinition) ResourceType() string {
+	return ResourceTypeResearchDefinition
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (r *ResearchDefinition) Subject() any {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (r *ResearchDefinition) SubjectType() string {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case r.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (r *ResearchDefinition) ClearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (r *ResearchDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	r.ClearSubject()
+	r.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (r *ResearchDefinition) SetSubjectReference(value Reference) {
+	r.ClearSubject()
+	r.SubjectReference = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,15 +23,15 @@ type ResearchElementDefinitionCharacteristic struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// What code or expression defines members? - CodeableConcept option
-	DefinitionCodeableConcept CodeableConcept `json:"definitionCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionCodeableConcept *CodeableConcept `json:"definitionCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code or expression defines members? - canonical option
-	DefinitionCanonical string `json:"definitionCanonical" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionCanonical *primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// What code or expression defines members? - Expression option
-	DefinitionExpression Expression `json:"definitionExpression" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionExpression *Expression `json:"definitionExpression,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code or expression defines members? - DataRequirement option
-	DefinitionDataRequirement DataRequirement `json:"definitionDataRequirement" fhir:"cardinality=1..1,required,summary,choice=definition"`
+	DefinitionDataRequirement *DataRequirement `json:"definitionDataRequirement,omitempty" fhir:"cardinality=1..1,required,summary,choice=definition"`
 	// What code/value pairs define members?
 	UsageContext []UsageContext `json:"usageContext,omitempty" fhir:"cardinality=0..*"`
 	// Whether the characteristic includes or excludes members
@@ -83,9 +82,204 @@ type ResearchElementDefinitionCharacteristic struct {
 	ParticipantEffectiveGroupMeasureExt *primitives.PrimitiveExtension `json:"_participantEffectiveGroupMeasure,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Definition returns the option of definition[x] that is set, or nil if none is.
+func (r *ResearchElementDefinitionCharacteristic) Definition() any {
+	switch {
+	case r.DefinitionCodeableConcept != nil:
+		return r.DefinitionCodeableConcept
+	case r.DefinitionCanonical != nil:
+		return r.DefinitionCanonical
+	case r.DefinitionExpression != nil:
+		return r.DefinitionExpression
+	case r.DefinitionDataRequirement != nil:
+		return r.DefinitionDataRequirement
+	}
+	return nil
+}
+
+// DefinitionType returns the FHIR type of the option of definition[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (r *ResearchElementDefinitionCharacteristic) DefinitionType() string {
+	switch {
+	case r.DefinitionCodeableConcept != nil:
+		return "CodeableConcept"
+	case r.DefinitionCanonical != nil:
+		return "canonical"
+	case r.DefinitionExpression != nil:
+		return "Expression"
+	case r.DefinitionDataRequirement != nil:
+		return "DataRequirement"
+	}
+	return ""
+}
+
+// ClearDefinition unsets every option of definition[x], and their extensions.
+func (r *ResearchElementDefinitionCharacteristic) ClearDefinition() {
+	r.DefinitionCodeableConcept = nil
+	r.DefinitionCanonical = nil
+	r.DefinitionCanonicalExt = nil
+	r.DefinitionExpression = nil
+	r.DefinitionDataRequirement = nil
+}
+
+// SetDefinitionCodeableConcept sets the CodeableConcept option of definition[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionCodeableConcept(value CodeableConcept) {
+	r.ClearDefinition()
+	r.DefinitionCodeableConcept = &value
+}
+
+// SetDefinitionCanonical sets the canonical option of definition[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionCanonical(value primitives.Canonical) {
+	ext := r.DefinitionCanonicalExt
+	r.ClearDefinition()
+	r.DefinitionCanonical, r.DefinitionCanonicalExt = &value, ext
+}
+
+// SetDefinitionExpression sets the Expression option of definition[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionExpression(value Expression) {
+	r.ClearDefinition()
+	r.DefinitionExpression = &value
+}
+
+// SetDefinitionDataRequirement sets the DataRequirement option of definition[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetDefinitionDataRequirement(value DataRequirement) {
+	r.ClearDefinition()
+	r.DefinitionDataRequirement = &value
+}
+
+// StudyEffective returns the option of studyEffective[x] that is set, or nil if none is.
+func (r *ResearchElementDefinitionCharacteristic) StudyEffective() any {
+	switch {
+	case r.StudyEffectiveDateTime != nil:
+		return r.StudyEffectiveDateTime
+	case r.StudyEffectivePeriod != nil:
+		return r.StudyEffectivePeriod
+	case r.StudyEffectiveDuration != nil:
+		return r.StudyEffectiveDuration
+	case r.StudyEffectiveTiming != nil:
+		return r.StudyEffectiveTiming
+	}
+	return nil
+}
+
+// StudyEffectiveType returns the FHIR type of the option of studyEffective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (r *ResearchElementDefinitionCharacteristic) StudyEffectiveType() string {
+	switch {
+	case r.StudyEffectiveDateTime != nil:
+		return "dateTime"
+	case r.StudyEffectivePeriod != nil:
+		return "Period"
+	case r.StudyEffectiveDuration != nil:
+		return "Duration"
+	case r.StudyEffectiveTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearStudyEffective unsets every option of studyEffective[x], and their extensions.
+func (r *ResearchElementDefinitionCharacteristic) ClearStudyEffective() {
+	r.StudyEffectiveDateTime = nil
+	r.StudyEffectiveDateTimeExt = nil
+	r.StudyEffectivePeriod = nil
+	r.StudyEffectiveDuration = nil
+	r.StudyEffectiveTiming = nil
+}
+
+// SetStudyEffectiveDateTime sets the dateTime option of studyEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectiveDateTime(value primitives.DateTime) {
+	ext := r.StudyEffectiveDateTimeExt
+	r.ClearStudyEffective()
+	r.StudyEffectiveDateTime, r.StudyEffectiveDateTimeExt = &value, ext
+}
+
+// SetStudyEffectivePeriod sets the Period option of studyEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectivePeriod(value Period) {
+	r.ClearStudyEffective()
+	r.StudyEffectivePeriod = &value
+}
+
+// SetStudyEffectiveDuration sets the Duration option of studyEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectiveDuration(value Duration) {
+	r.ClearStudyEffective()
+	r.StudyEffectiveDuration = &value
+}
+
+// SetStudyEffectiveTiming sets the Timing option of studyEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffectiveTiming(value Timing) {
+	r.ClearStudyEffective()
+	r.StudyEffectiveTiming = &value
+}
+
+// ParticipantEffective returns the option of participantEffective[x] that is set, or nil if none is.
+func (r *ResearchElementDefinitionCharacteristic) ParticipantEffective() any {
+	switch {
+	case r.ParticipantEffectiveDateTime != nil:
+		return r.ParticipantEffectiveDateTime
+	case r.ParticipantEffectivePeriod != nil:
+		return r.ParticipantEffectivePeriod
+	case r.ParticipantEffectiveDuration != nil:
+		return r.ParticipantEffectiveDuration
+	case r.ParticipantEffectiveTiming != nil:
+		return r.ParticipantEffectiveTiming
+	}
+	return nil
+}
+
+// ParticipantEffectiveType returns the FHIR type of the option of participantEffective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (r *ResearchElementDefinitionCharacteristic) ParticipantEffectiveType() string {
+	switch {
+	case r.ParticipantEffectiveDateTime != nil:
+		return "dateTime"
+	case r.ParticipantEffectivePeriod != nil:
+		return "Period"
+	case r.ParticipantEffectiveDuration != nil:
+		return "Duration"
+	case r.ParticipantEffectiveTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearParticipantEffective unsets every option of participantEffective[x], and their extensions.
+func (r *ResearchElementDefinitionCharacteristic) ClearParticipantEffective() {
+	r.ParticipantEffectiveDateTime = nil
+	r.ParticipantEffectiveDateTimeExt = nil
+	r.ParticipantEffectivePeriod = nil
+	r.ParticipantEffectiveDuration = nil
+	r.ParticipantEffectiveTiming = nil
+}
+
+// SetParticipantEffectiveDateTime sets the dateTime option of participantEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectiveDateTime(value primitives.DateTime) {
+	ext := r.ParticipantEffectiveDateTimeExt
+	r.ClearParticipantEffective()
+	r.ParticipantEffectiveDateTime, r.ParticipantEffectiveDateTimeExt = &value, ext
+}
+
+// SetParticipantEffectivePeriod sets the Period option of participantEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectivePeriod(value Period) {
+	r.ClearParticipantEffective()
+	r.ParticipantEffectivePeriod = &value
+}
+
+// SetParticipantEffectiveDuration sets the Duration option of participantEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectiveDuration(value Duration) {
+	r.ClearParticipantEffective()
+	r.ParticipantEffectiveDuration = &value
+}
+
+// SetParticipantEffectiveTiming sets the Timing option of participantEffective[x], clearing the others.
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffectiveTiming(value Timing) {
+	r.ClearParticipantEffective()
+	r.ParticipantEffectiveTiming = &value
+}
+
 // ResearchElementDefinition represents a FHIR ResearchElementDefinition.
 type ResearchElementDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this research element definition, represented as a URI (globally unique)
@@ -197,3 +391,49 @@ type ResearchElementDefinition struct {
 	// What defines the members of the research element
 	Characteristic []ResearchElementDefinitionCharacteristic `json:"characteristic,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "ResearchElementDefinition", the FHIR type of the resource.
+func (r *ResearchElementDefinition) ResourceType() string {
+	return ResourceTypeResearchElementDefinition
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (r *ResearchElementDefinition) Subject() any {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return r.SubjectCodeableConcept
+	case r.SubjectReference != nil:
+		return r.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (r *ResearchElementDefinition) SubjectType() string {
+	switch {
+	case r.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case r.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (r *ResearchElementDefinition) ClearSubject() {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (r *ResearchElementDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	r.ClearSubject()
+	r.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (r *ResearchElementDefinition) SetSubjectReference(value Reference) {
+	r.ClearSubject()
+	r.SubjectReference = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -55,7 +54,7 @@ type ResearchStudyObjective struct {
 
 // ResearchStudy represents a FHIR ResearchStudy.
 type ResearchStudy struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for study
@@ -113,3 +112,8 @@ type ResearchStudy struct {
 	// A goal for the study
 	Objective []ResearchStudyObjective `json:"objective,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ResearchStudy", the FHIR type of the resource.
+func (r *ResearchStudy) ResourceType() string {
+	return ResourceTypeResearchStudy
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -50,15 +49,15 @@ type RiskEvidenceSynthesisRiskEstimatePrecisionEstimate struct {
 	// Type of precision estimate
 	Type *CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..1"`
 	// Level of confidence interval
-	Level *float64 `json:"level,omitempty" fhir:"cardinality=0..1"`
+	Level *primitives.Decimal `json:"level,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Level
 	LevelExt *primitives.PrimitiveExtension `json:"_level,omitempty" fhir:"cardinality=0..1"`
 	// Lower bound
-	From *float64 `json:"from,omitempty" fhir:"cardinality=0..1"`
+	From *primitives.Decimal `json:"from,omitempty" fhir:"cardinality=0..1"`
 	// Extension for From
 	FromExt *primitives.PrimitiveExtension `json:"_from,omitempty" fhir:"cardinality=0..1"`
 	// Upper bound
-	To *float64 `json:"to,omitempty" fhir:"cardinality=0..1"`
+	To *primitives.Decimal `json:"to,omitempty" fhir:"cardinality=0..1"`
 	// Extension for To
 	ToExt *primitives.PrimitiveExtension `json:"_to,omitempty" fhir:"cardinality=0..1"`
 }
@@ -80,7 +79,7 @@ type RiskEvidenceSynthesisRiskEstimate struct {
 	// Type of risk estimate
 	Type *CodeableConcept `json:"type,omitempty" fhir:"cardinality=0..1"`
 	// Point estimate
-	Value *float64 `json:"value,omitempty" fhir:"cardinality=0..1"`
+	Value *primitives.Decimal `json:"value,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 	// What unit is the outcome described in?
@@ -135,7 +134,7 @@ type RiskEvidenceSynthesisCertainty struct {
 
 // RiskEvidenceSynthesis represents a FHIR RiskEvidenceSynthesis.
 type RiskEvidenceSynthesis struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this risk evidence synthesis, represented as a URI (globally unique)
@@ -223,3 +222,8 @@ type RiskEvidenceSynthesis struct {
 	// How certain is the risk
 	Certainty []RiskEvidenceSynthesisCertainty `json:"certainty,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "RiskEvidenceSynthesis", the FHIR type of the resource.
+func (r *RiskEvidenceSynthesis) ResourceType() string {
+	return ResourceTypeRiskEvidenceSynthesis
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeSchedule = "Schedule"
 
 // Schedule represents a FHIR Schedule.
 type Schedule struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this item
@@ -39,3 +38,8 @@ type Schedule struct {
 	// Extension for Comment
 	CommentExt *primitives.PrimitiveExtension `json:"_comment,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Schedule", the FHIR type of the resource.
+func (s *Schedule) ResourceType() string {
+	return ResourceTypeSchedule
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,7 +34,7 @@ type SearchParameterComponent struct {
 
 // SearchParameter represents a FHIR SearchParameter.
 type SearchParameter struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this search parameter, represented as a URI (globally unique)
@@ -135,3 +134,8 @@ type SearchParameter struct {
 	// For Composite resources to define the parts
 	Component []SearchParameterComponent `json:"component,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "SearchParameter", the FHIR type of the resource.
+func (s *SearchParameter) ResourceType() string {
+	return ResourceTypeSearchParameter
+}
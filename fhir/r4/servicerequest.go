@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeServiceRequest = "ServiceRequest"
 
 // ServiceRequest represents a FHIR ServiceRequest.
 type ServiceRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifiers assigned to this order
@@ -115,3 +114,157 @@ type ServiceRequest struct {
 	// Request provenance
 	RelevantHistory []Reference `json:"relevantHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ServiceRequest", the FHIR type of the resource.
+func (s *ServiceRequest) ResourceType() string {
+	return ResourceTypeServiceRequest
+}
+
+// Quantity returns the option of quantity[x] that is set, or nil if none is.
+func (s *ServiceRequest) Quantity() any {
+	switch {
+	case s.QuantityQuantity != nil:
+		return s.QuantityQuantity
+	case s.QuantityRatio != nil:
+		return s.QuantityRatio
+	case s.QuantityRange != nil:
+		return s.QuantityRange
+	}
+	return nil
+}
+
+// QuantityType returns the FHIR type of the option of quantity[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *ServiceRequest) QuantityType() string {
+	switch {
+	case s.QuantityQuantity != nil:
+		return "Quantity"
+	case s.QuantityRatio != nil:
+		return "Ratio"
+	case s.QuantityRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearQuantity unsets every option of quantity[x], and their extensions.
+func (s *ServiceRequest) ClearQuantity() {
+	s.QuantityQuantity = nil
+	s.QuantityRatio = nil
+	s.QuantityRange = nil
+}
+
+// SetQuantityQuantity sets the Quantity option of quantity[x], clearing the others.
+func (s *ServiceRequest) SetQuantityQuantity(value Quantity) {
+	s.ClearQuantity()
+	s.QuantityQuantity = &value
+}
+
+// SetQuantityRatio sets the Ratio option of quantity[x], clearing the others.
+func (s *ServiceRequest) SetQuantityRatio(value Ratio) {
+	s.ClearQuantity()
+	s.QuantityRatio = &value
+}
+
+// SetQuantityRange sets the Range option of quantity[x], clearing the others.
+func (s *ServiceRequest) SetQuantityRange(value Range) {
+	s.ClearQuantity()
+	s.QuantityRange = &value
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (s *ServiceRequest) Occurrence() any {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return s.OccurrenceDateTime
+	case s.OccurrencePeriod != nil:
+		return s.OccurrencePeriod
+	case s.OccurrenceTiming != nil:
+		return s.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (s *ServiceRequest) OccurrenceType() string {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return "dateTime"
+	case s.OccurrencePeriod != nil:
+		return "Period"
+	case s.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (s *ServiceRequest) ClearOccurrence() {
+	s.OccurrenceDateTime = nil
+	s.OccurrenceDateTimeExt = nil
+	s.OccurrencePeriod = nil
+	s.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (s *ServiceRequest) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := s.OccurrenceDateTimeExt
+	s.ClearOccurrence()
+	s.OccurrenceDateTime, s.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (s *ServiceRequest) SetOccurrencePeriod(value Period) {
+	s.ClearOccurrence()
+	s.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (s *ServiceRequest) SetOccurrenceTiming(value Timing) {
+	s.ClearOccurrence()
+	s.OccurrenceTiming = &value
+}
+
+// AsNeeded returns the option of asNeeded[x] that is set, or nil if none is.
+func (s *ServiceRequest) AsNeeded() any {
+	switch {
+	case s.AsNeededBoolean != nil:
+		return s.AsNeededBoolean
+	case s.AsNeededCodeableConcept != nil:
+		return s.AsNeededCodeableConcept
+	}
+	return nil
+}
+
+// AsNeededType returns the FHIR type of the option of asNeeded[x] that is
+// set, such as "boolean", or "" if none is.
+func (s *ServiceRequest) AsNeededType() string {
+	switch {
+	case s.AsNeededBoolean != nil:
+		return "boolean"
+	case s.AsNeededCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAsNeeded unsets every option of asNeeded[x], and their extensions.
+func (s *ServiceRequest) ClearAsNeeded() {
+	s.AsNeededBoolean = nil
+	s.AsNeededBooleanExt = nil
+	s.AsNeededCodeableConcept = nil
+}
+
+// SetAsNeededBoolean sets the boolean option of asNeeded[x], clearing the others.
+func (s *ServiceRequest) SetAsNeededBoolean(value bool) {
+	ext := s.AsNeededBooleanExt
+	s.ClearAsNeeded()
+	s.AsNeededBoolean, s.AsNeededBooleanExt = &value, ext
+}
+
+// SetAsNeededCodeableConcept sets the CodeableConcept option of asNeeded[x], clearing the others.
+func (s *ServiceRequest) SetAsNeededCodeableConcept(value CodeableConcept) {
+	s.ClearAsNeeded()
+	s.AsNeededCodeableConcept = &value
+}
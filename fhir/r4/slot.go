@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeSlot = "Slot"
 
 // Slot represents a FHIR Slot.
 type Slot struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this item
@@ -51,3 +50,8 @@ type Slot struct {
 	// Extension for Comment
 	CommentExt *primitives.PrimitiveExtension `json:"_comment,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Slot", the FHIR type of the resource.
+func (s *Slot) ResourceType() string {
+	return ResourceTypeSlot
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -45,6 +44,90 @@ type SpecimenCollection struct {
 	FastingStatusDuration *Duration `json:"fastingStatusDuration,omitempty" fhir:"cardinality=0..1,summary,choice=fastingStatus"`
 }
 
+// Collected returns the option of collected[x] that is set, or nil if none is.
+func (s *SpecimenCollection) Collected() any {
+	switch {
+	case s.CollectedDateTime != nil:
+		return s.CollectedDateTime
+	case s.CollectedPeriod != nil:
+		return s.CollectedPeriod
+	}
+	return nil
+}
+
+// CollectedType returns the FHIR type of the option of collected[x] that is
+// set, such as "dateTime", or "" if none is.
+func (s *SpecimenCollection) CollectedType() string {
+	switch {
+	case s.CollectedDateTime != nil:
+		return "dateTime"
+	case s.CollectedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearCollected unsets every option of collected[x], and their extensions.
+func (s *SpecimenCollection) ClearCollected() {
+	s.CollectedDateTime = nil
+	s.CollectedDateTimeExt = nil
+	s.CollectedPeriod = nil
+}
+
+// SetCollectedDateTime sets the dateTime option of collected[x], clearing the others.
+func (s *SpecimenCollection) SetCollectedDateTime(value primitives.DateTime) {
+	ext := s.CollectedDateTimeExt
+	s.ClearCollected()
+	s.CollectedDateTime, s.CollectedDateTimeExt = &value, ext
+}
+
+// SetCollectedPeriod sets the Period option of collected[x], clearing the others.
+func (s *SpecimenCollection) SetCollectedPeriod(value Period) {
+	s.ClearCollected()
+	s.CollectedPeriod = &value
+}
+
+// FastingStatus returns the option of fastingStatus[x] that is set, or nil if none is.
+func (s *SpecimenCollection) FastingStatus() any {
+	switch {
+	case s.FastingStatusCodeableConcept != nil:
+		return s.FastingStatusCodeableConcept
+	case s.FastingStatusDuration != nil:
+		return s.FastingStatusDuration
+	}
+	return nil
+}
+
+// FastingStatusType returns the FHIR type of the option of fastingStatus[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SpecimenCollection) FastingStatusType() string {
+	switch {
+	case s.FastingStatusCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.FastingStatusDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearFastingStatus unsets every option of fastingStatus[x], and their extensions.
+func (s *SpecimenCollection) ClearFastingStatus() {
+	s.FastingStatusCodeableConcept = nil
+	s.FastingStatusDuration = nil
+}
+
+// SetFastingStatusCodeableConcept sets the CodeableConcept option of fastingStatus[x], clearing the others.
+func (s *SpecimenCollection) SetFastingStatusCodeableConcept(value CodeableConcept) {
+	s.ClearFastingStatus()
+	s.FastingStatusCodeableConcept = &value
+}
+
+// SetFastingStatusDuration sets the Duration option of fastingStatus[x], clearing the others.
+func (s *SpecimenCollection) SetFastingStatusDuration(value Duration) {
+	s.ClearFastingStatus()
+	s.FastingStatusDuration = &value
+}
+
 // SpecimenProcessing represents a FHIR BackboneElement for Specimen.processing.
 type SpecimenProcessing struct {
 	// Unique id for inter-element referencing
@@ -71,6 +154,49 @@ type SpecimenProcessing struct {
 	TimePeriod *Period `json:"timePeriod,omitempty" fhir:"cardinality=0..1,choice=time"`
 }
 
+// Time returns the option of time[x] that is set, or nil if none is.
+func (s *SpecimenProcessing) Time() any {
+	switch {
+	case s.TimeDateTime != nil:
+		return s.TimeDateTime
+	case s.TimePeriod != nil:
+		return s.TimePeriod
+	}
+	return nil
+}
+
+// TimeType returns the FHIR type of the option of time[x] that is
+// set, such as "dateTime", or "" if none is.
+func (s *SpecimenProcessing) TimeType() string {
+	switch {
+	case s.TimeDateTime != nil:
+		return "dateTime"
+	case s.TimePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearTime unsets every option of time[x], and their extensions.
+func (s *SpecimenProcessing) ClearTime() {
+	s.TimeDateTime = nil
+	s.TimeDateTimeExt = nil
+	s.TimePeriod = nil
+}
+
+// SetTimeDateTime sets the dateTime option of time[x], clearing the others.
+func (s *SpecimenProcessing) SetTimeDateTime(value primitives.DateTime) {
+	ext := s.TimeDateTimeExt
+	s.ClearTime()
+	s.TimeDateTime, s.TimeDateTimeExt = &value, ext
+}
+
+// SetTimePeriod sets the Period option of time[x], clearing the others.
+func (s *SpecimenProcessing) SetTimePeriod(value Period) {
+	s.ClearTime()
+	s.TimePeriod = &value
+}
+
 // SpecimenContainer represents a FHIR BackboneElement for Specimen.container.
 type SpecimenContainer struct {
 	// Unique id for inter-element referencing
@@ -99,9 +225,50 @@ type SpecimenContainer struct {
 	AdditiveReference *Reference `json:"additiveReference,omitempty" fhir:"cardinality=0..1,choice=additive"`
 }
 
+// Additive returns the option of additive[x] that is set, or nil if none is.
+func (s *SpecimenContainer) Additive() any {
+	switch {
+	case s.AdditiveCodeableConcept != nil:
+		return s.AdditiveCodeableConcept
+	case s.AdditiveReference != nil:
+		return s.AdditiveReference
+	}
+	return nil
+}
+
+// AdditiveType returns the FHIR type of the option of additive[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SpecimenContainer) AdditiveType() string {
+	switch {
+	case s.AdditiveCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.AdditiveReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearAdditive unsets every option of additive[x], and their extensions.
+func (s *SpecimenContainer) ClearAdditive() {
+	s.AdditiveCodeableConcept = nil
+	s.AdditiveReference = nil
+}
+
+// SetAdditiveCodeableConcept sets the CodeableConcept option of additive[x], clearing the others.
+func (s *SpecimenContainer) SetAdditiveCodeableConcept(value CodeableConcept) {
+	s.ClearAdditive()
+	s.AdditiveCodeableConcept = &value
+}
+
+// SetAdditiveReference sets the Reference option of additive[x], clearing the others.
+func (s *SpecimenContainer) SetAdditiveReference(value Reference) {
+	s.ClearAdditive()
+	s.AdditiveReference = &value
+}
+
 // Specimen represents a FHIR Specimen.
 type Specimen struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Identifier
@@ -135,3 +302,8 @@ type Specimen struct {
 	// Comments
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Specimen", the FHIR type of the resource.
+func (s *Specimen) ResourceType() string {
+	return ResourceTypeSpecimen
+}
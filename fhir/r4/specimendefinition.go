@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,9 +23,50 @@ type SpecimenDefinitionTypeTestedContainerAdditive struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Additive associated with container - CodeableConcept option
-	AdditiveCodeableConcept CodeableConcept `json:"additiveCodeableConcept" fhir:"cardinality=1..1,required,choice=additive"`
+	AdditiveCodeableConcept *CodeableConcept `json:"additiveCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=additive"`
 	// Additive associated with container - Reference option
-	AdditiveReference Reference `json:"additiveReference" fhir:"cardinality=1..1,required,choice=additive"`
+	AdditiveReference *Reference `json:"additiveReference,omitempty" fhir:"cardinality=1..1,required,choice=additive"`
+}
+
+// Additive returns the option of additive[x] that is set, or nil if none is.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) Additive() any {
+	switch {
+	case s.AdditiveCodeableConcept != nil:
+		return s.AdditiveCodeableConcept
+	case s.AdditiveReference != nil:
+		return s.AdditiveReference
+	}
+	return nil
+}
+
+// AdditiveType returns the FHIR type of the option of additive[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) AdditiveType() string {
+	switch {
+	case s.AdditiveCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.AdditiveReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearAdditive unsets every option of additive[x], and their extensions.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) ClearAdditive() {
+	s.AdditiveCodeableConcept = nil
+	s.AdditiveReference = nil
+}
+
+// SetAdditiveCodeableConcept sets the CodeableConcept option of additive[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditiveCodeableConcept(value CodeableConcept) {
+	s.ClearAdditive()
+	s.AdditiveCodeableConcept = &value
+}
+
+// SetAdditiveReference sets the Reference option of additive[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditiveReference(value Reference) {
+	s.ClearAdditive()
+	s.AdditiveReference = &value
 }
 
 // SpecimenDefinitionTypeTestedContainer represents a FHIR BackboneElement for SpecimenDefinition.typeTested.container.
@@ -65,6 +105,49 @@ type SpecimenDefinitionTypeTestedContainer struct {
 	PreparationExt *primitives.PrimitiveExtension `json:"_preparation,omitempty" fhir:"cardinality=0..1"`
 }
 
+// MinimumVolume returns the option of minimumVolume[x] that is set, or nil if none is.
+func (s *SpecimenDefinitionTypeTestedContainer) MinimumVolume() any {
+	switch {
+	case s.MinimumVolumeQuantity != nil:
+		return s.MinimumVolumeQuantity
+	case s.MinimumVolumeString != nil:
+		return s.MinimumVolumeString
+	}
+	return nil
+}
+
+// MinimumVolumeType returns the FHIR type of the option of minimumVolume[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *SpecimenDefinitionTypeTestedContainer) MinimumVolumeType() string {
+	switch {
+	case s.MinimumVolumeQuantity != nil:
+		return "Quantity"
+	case s.MinimumVolumeString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearMinimumVolume unsets every option of minimumVolume[x], and their extensions.
+func (s *SpecimenDefinitionTypeTestedContainer) ClearMinimumVolume() {
+	s.MinimumVolumeQuantity = nil
+	s.MinimumVolumeString = nil
+	s.MinimumVolumeStringExt = nil
+}
+
+// SetMinimumVolumeQuantity sets the Quantity option of minimumVolume[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainer) SetMinimumVolumeQuantity(value Quantity) {
+	s.ClearMinimumVolume()
+	s.MinimumVolumeQuantity = &value
+}
+
+// SetMinimumVolumeString sets the string option of minimumVolume[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainer) SetMinimumVolumeString(value string) {
+	ext := s.MinimumVolumeStringExt
+	s.ClearMinimumVolume()
+	s.MinimumVolumeString, s.MinimumVolumeStringExt = &value, ext
+}
+
 // SpecimenDefinitionTypeTestedHandling represents a FHIR BackboneElement for SpecimenDefinition.typeTested.handling.
 type SpecimenDefinitionTypeTestedHandling struct {
 	// Unique id for inter-element referencing
@@ -123,7 +206,7 @@ type SpecimenDefinitionTypeTested struct {
 
 // SpecimenDefinition represents a FHIR SpecimenDefinition.
 type SpecimenDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier of a kind of specimen
@@ -141,3 +224,8 @@ type SpecimenDefinition struct {
 	// Specimen in container intended for testing by lab
 	TypeTested []SpecimenDefinitionTypeTested `json:"typeTested,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "SpecimenDefinition", the FHIR type of the resource.
+func (s *SpecimenDefinition) ResourceType() string {
+	return ResourceTypeSpecimenDefinition
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -91,7 +90,7 @@ type StructureDefinitionDifferential struct {
 
 // StructureDefinition represents a FHIR StructureDefinition.
 type StructureDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this structure definition, represented as a URI (globally unique)
@@ -185,3 +184,8 @@ type StructureDefinition struct {
 	// Differential view of the structure
 	Differential *StructureDefinitionDifferential `json:"differential,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "StructureDefinition", the FHIR type of the resource.
+func (s *StructureDefinition) ResourceType() string {
+	return ResourceTypeStructureDefinition
+}
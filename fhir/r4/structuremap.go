@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -96,7 +95,7 @@ type StructureMapGroupRuleSource struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - base64Binary option
-	DefaultValueBase64Binary *string `json:"defaultValueBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueBase64Binary *primitives.Base64Binary `json:"defaultValueBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueBase64Binary
 	DefaultValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_defaultValueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - boolean option
@@ -104,7 +103,7 @@ type StructureMapGroupRuleSource struct {
 	// Extension for DefaultValueBoolean
 	DefaultValueBooleanExt *primitives.PrimitiveExtension `json:"_defaultValueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - canonical option
-	DefaultValueCanonical *string `json:"defaultValueCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueCanonical *primitives.Canonical `json:"defaultValueCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueCanonical
 	DefaultValueCanonicalExt *primitives.PrimitiveExtension `json:"_defaultValueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - code option
@@ -120,13 +119,13 @@ type StructureMapGroupRuleSource struct {
 	// Extension for DefaultValueDateTime
 	DefaultValueDateTimeExt *primitives.PrimitiveExtension `json:"_defaultValueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - decimal option
-	DefaultValueDecimal *float64 `json:"defaultValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueDecimal *primitives.Decimal `json:"defaultValueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueDecimal
 	DefaultValueDecimalExt *primitives.PrimitiveExtension `json:"_defaultValueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - id option
-	DefaultValueID *string `json:"defaultValueID,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueID *string `json:"defaultValueId,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueID
-	DefaultValueIDExt *primitives.PrimitiveExtension `json:"_defaultValueID,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueIDExt *primitives.PrimitiveExtension `json:"_defaultValueId,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - instant option
 	DefaultValueInstant *primitives.Instant `json:"defaultValueInstant,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueInstant
@@ -160,17 +159,17 @@ type StructureMapGroupRuleSource struct {
 	// Extension for DefaultValueUnsignedInt
 	DefaultValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_defaultValueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - uri option
-	DefaultValueURI *string `json:"defaultValueURI,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueURI *string `json:"defaultValueUri,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueURI
-	DefaultValueURIExt *primitives.PrimitiveExtension `json:"_defaultValueURI,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueURIExt *primitives.PrimitiveExtension `json:"_defaultValueUri,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - url option
-	DefaultValueURL *string `json:"defaultValueURL,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueURL *string `json:"defaultValueUrl,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueURL
-	DefaultValueURLExt *primitives.PrimitiveExtension `json:"_defaultValueURL,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueURLExt *primitives.PrimitiveExtension `json:"_defaultValueUrl,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - uuid option
-	DefaultValueUUID *string `json:"defaultValueUUID,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
+	DefaultValueUUID *string `json:"defaultValueUuid,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Extension for DefaultValueUUID
-	DefaultValueUUIDExt *primitives.PrimitiveExtension `json:"_defaultValueUUID,omitempty" fhir:"cardinality=0..1"`
+	DefaultValueUUIDExt *primitives.PrimitiveExtension `json:"_defaultValueUuid,omitempty" fhir:"cardinality=0..1"`
 	// Default value if no value exists - Address option
 	DefaultValueAddress *Address `json:"defaultValueAddress,omitempty" fhir:"cardinality=0..1,summary,choice=defaultValue"`
 	// Default value if no value exists - Age option
@@ -259,6 +258,613 @@ type StructureMapGroupRuleSource struct {
 	LogMessageExt *primitives.PrimitiveExtension `json:"_logMessage,omitempty" fhir:"cardinality=0..1"`
 }
 
+// DefaultValue returns the option of defaultValue[x] that is set, or nil if none is.
+func (s *StructureMapGroupRuleSource) DefaultValue() any {
+	switch {
+	case s.DefaultValueBase64Binary != nil:
+		return s.DefaultValueBase64Binary
+	case s.DefaultValueBoolean != nil:
+		return s.DefaultValueBoolean
+	case s.DefaultValueCanonical != nil:
+		return s.DefaultValueCanonical
+	case s.DefaultValueCode != nil:
+		return s.DefaultValueCode
+	case s.DefaultValueDate != nil:
+		return s.DefaultValueDate
+	case s.DefaultValueDateTime != nil:
+		return s.DefaultValueDateTime
+	case s.DefaultValueDecimal != nil:
+		return s.DefaultValueDecimal
+	case s.DefaultValueID != nil:
+		return s.DefaultValueID
+	case s.DefaultValueInstant != nil:
+		return s.DefaultValueInstant
+	case s.DefaultValueInteger != nil:
+		return s.DefaultValueInteger
+	case s.DefaultValueMarkdown != nil:
+		return s.DefaultValueMarkdown
+	case s.DefaultValueOid != nil:
+		return s.DefaultValueOid
+	case s.DefaultValuePositiveInt != nil:
+		return s.DefaultValuePositiveInt
+	case s.DefaultValueString != nil:
+		return s.DefaultValueString
+	case s.DefaultValueTime != nil:
+		return s.DefaultValueTime
+	case s.DefaultValueUnsignedInt != nil:
+		return s.DefaultValueUnsignedInt
+	case s.DefaultValueURI != nil:
+		return s.DefaultValueURI
+	case s.DefaultValueURL != nil:
+		return s.DefaultValueURL
+	case s.DefaultValueUUID != nil:
+		return s.DefaultValueUUID
+	case s.DefaultValueAddress != nil:
+		return s.DefaultValueAddress
+	case s.DefaultValueAge != nil:
+		return s.DefaultValueAge
+	case s.DefaultValueAnnotation != nil:
+		return s.DefaultValueAnnotation
+	case s.DefaultValueAttachment != nil:
+		return s.DefaultValueAttachment
+	case s.DefaultValueCodeableConcept != nil:
+		return s.DefaultValueCodeableConcept
+	case s.DefaultValueCoding != nil:
+		return s.DefaultValueCoding
+	case s.DefaultValueContactPoint != nil:
+		return s.DefaultValueContactPoint
+	case s.DefaultValueCount != nil:
+		return s.DefaultValueCount
+	case s.DefaultValueDistance != nil:
+		return s.DefaultValueDistance
+	case s.DefaultValueDuration != nil:
+		return s.DefaultValueDuration
+	case s.DefaultValueHumanName != nil:
+		return s.DefaultValueHumanName
+	case s.DefaultValueIdentifier != nil:
+		return s.DefaultValueIdentifier
+	case s.DefaultValueMoney != nil:
+		return s.DefaultValueMoney
+	case s.DefaultValuePeriod != nil:
+		return s.DefaultValuePeriod
+	case s.DefaultValueQuantity != nil:
+		return s.DefaultValueQuantity
+	case s.DefaultValueRange != nil:
+		return s.DefaultValueRange
+	case s.DefaultValueRatio != nil:
+		return s.DefaultValueRatio
+	case s.DefaultValueReference != nil:
+		return s.DefaultValueReference
+	case s.DefaultValueSampledData != nil:
+		return s.DefaultValueSampledData
+	case s.DefaultValueSignature != nil:
+		return s.DefaultValueSignature
+	case s.DefaultValueTiming != nil:
+		return s.DefaultValueTiming
+	case s.DefaultValueContactDetail != nil:
+		return s.DefaultValueContactDetail
+	case s.DefaultValueContributor != nil:
+		return s.DefaultValueContributor
+	case s.DefaultValueDataRequirement != nil:
+		return s.DefaultValueDataRequirement
+	case s.DefaultValueExpression != nil:
+		return s.DefaultValueExpression
+	case s.DefaultValueParameterDefinition != nil:
+		return s.DefaultValueParameterDefinition
+	case s.DefaultValueRelatedArtifact != nil:
+		return s.DefaultValueRelatedArtifact
+	case s.DefaultValueTriggerDefinition != nil:
+		return s.DefaultValueTriggerDefinition
+	case s.DefaultValueUsageContext != nil:
+		return s.DefaultValueUsageContext
+	case s.DefaultValueDosage != nil:
+		return s.DefaultValueDosage
+	case s.DefaultValueMeta != nil:
+		return s.DefaultValueMeta
+	}
+	return nil
+}
+
+// DefaultValueType returns the FHIR type of the option of defaultValue[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (s *StructureMapGroupRuleSource) DefaultValueType() string {
+	switch {
+	case s.DefaultValueBase64Binary != nil:
+		return "base64Binary"
+	case s.DefaultValueBoolean != nil:
+		return "boolean"
+	case s.DefaultValueCanonical != nil:
+		return "canonical"
+	case s.DefaultValueCode != nil:
+		return "code"
+	case s.DefaultValueDate != nil:
+		return "date"
+	case s.DefaultValueDateTime != nil:
+		return "dateTime"
+	case s.DefaultValueDecimal != nil:
+		return "decimal"
+	case s.DefaultValueID != nil:
+		return "id"
+	case s.DefaultValueInstant != nil:
+		return "instant"
+	case s.DefaultValueInteger != nil:
+		return "integer"
+	case s.DefaultValueMarkdown != nil:
+		return "markdown"
+	case s.DefaultValueOid != nil:
+		return "oid"
+	case s.DefaultValuePositiveInt != nil:
+		return "positiveInt"
+	case s.DefaultValueString != nil:
+		return "string"
+	case s.DefaultValueTime != nil:
+		return "time"
+	case s.DefaultValueUnsignedInt != nil:
+		return "unsignedInt"
+	case s.DefaultValueURI != nil:
+		return "uri"
+	case s.DefaultValueURL != nil:
+		return "url"
+	case s.DefaultValueUUID != nil:
+		return "uuid"
+	case s.DefaultValueAddress != nil:
+		return "Address"
+	case s.DefaultValueAge != nil:
+		return "Age"
+	case s.DefaultValueAnnotation != nil:
+		return "Annotation"
+	case s.DefaultValueAttachment != nil:
+		return "Attachment"
+	case s.DefaultValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.DefaultValueCoding != nil:
+		return "Coding"
+	case s.DefaultValueContactPoint != nil:
+		return "ContactPoint"
+	case s.DefaultValueCount != nil:
+		return "Count"
+	case s.DefaultValueDistance != nil:
+		return "Distance"
+	case s.DefaultValueDuration != nil:
+		return "Duration"
+	case s.DefaultValueHumanName != nil:
+		return "HumanName"
+	case s.DefaultValueIdentifier != nil:
+		return "Identifier"
+	case s.DefaultValueMoney != nil:
+		return "Money"
+	case s.DefaultValuePeriod != nil:
+		return "Period"
+	case s.DefaultValueQuantity != nil:
+		return "Quantity"
+	case s.DefaultValueRange != nil:
+		return "Range"
+	case s.DefaultValueRatio != nil:
+		return "Ratio"
+	case s.DefaultValueReference != nil:
+		return "Reference"
+	case s.DefaultValueSampledData != nil:
+		return "SampledData"
+	case s.DefaultValueSignature != nil:
+		return "Signature"
+	case s.DefaultValueTiming != nil:
+		return "Timing"
+	case s.DefaultValueContactDetail != nil:
+		return "ContactDetail"
+	case s.DefaultValueContributor != nil:
+		return "Contributor"
+	case s.DefaultValueDataRequirement != nil:
+		return "DataRequirement"
+	case s.DefaultValueExpression != nil:
+		return "Expression"
+	case s.DefaultValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case s.DefaultValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case s.DefaultValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case s.DefaultValueUsageContext != nil:
+		return "UsageContext"
+	case s.DefaultValueDosage != nil:
+		return "Dosage"
+	case s.DefaultValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearDefaultValue unsets every option of defaultValue[x], and their extensions.
+func (s *StructureMapGroupRuleSource) ClearDefaultValue() {
+	s.DefaultValueBase64Binary = nil
+	s.DefaultValueBase64BinaryExt = nil
+	s.DefaultValueBoolean = nil
+	s.DefaultValueBooleanExt = nil
+	s.DefaultValueCanonical = nil
+	s.DefaultValueCanonicalExt = nil
+	s.DefaultValueCode = nil
+	s.DefaultValueCodeExt = nil
+	s.DefaultValueDate = nil
+	s.DefaultValueDateExt = nil
+	s.DefaultValueDateTime = nil
+	s.DefaultValueDateTimeExt = nil
+	s.DefaultValueDecimal = nil
+	s.DefaultValueDecimalExt = nil
+	s.DefaultValueID = nil
+	s.DefaultValueIDExt = nil
+	s.DefaultValueInstant = nil
+	s.DefaultValueInstantExt = nil
+	s.DefaultValueInteger = nil
+	s.DefaultValueIntegerExt = nil
+	s.DefaultValueMarkdown = nil
+	s.DefaultValueMarkdownExt = nil
+	s.DefaultValueOid = nil
+	s.DefaultValueOidExt = nil
+	s.DefaultValuePositiveInt = nil
+	s.DefaultValuePositiveIntExt = nil
+	s.DefaultValueString = nil
+	s.DefaultValueStringExt = nil
+	s.DefaultValueTime = nil
+	s.DefaultValueTimeExt = nil
+	s.DefaultValueUnsignedInt = nil
+	s.DefaultValueUnsignedIntExt = nil
+	s.DefaultValueURI = nil
+	s.DefaultValueURIExt = nil
+	s.DefaultValueURL = nil
+	s.DefaultValueURLExt = nil
+	s.DefaultValueUUID = nil
+	s.DefaultValueUUIDExt = nil
+	s.DefaultValueAddress = nil
+	s.DefaultValueAge = nil
+	s.DefaultValueAnnotation = nil
+	s.DefaultValueAttachment = nil
+	s.DefaultValueCodeableConcept = nil
+	s.DefaultValueCoding = nil
+	s.DefaultValueContactPoint = nil
+	s.DefaultValueCount = nil
+	s.DefaultValueDistance = nil
+	s.DefaultValueDuration = nil
+	s.DefaultValueHumanName = nil
+	s.DefaultValueIdentifier = nil
+	s.DefaultValueMoney = nil
+	s.DefaultValuePeriod = nil
+	s.DefaultValueQuantity = nil
+	s.DefaultValueRange = nil
+	s.DefaultValueRatio = nil
+	s.DefaultValueReference = nil
+	s.DefaultValueSampledData = nil
+	s.DefaultValueSignature = nil
+	s.DefaultValueTiming = nil
+	s.DefaultValueContactDetail = nil
+	s.DefaultValueContributor = nil
+	s.DefaultValueDataRequirement = nil
+	s.DefaultValueExpression = nil
+	s.DefaultValueParameterDefinition = nil
+	s.DefaultValueRelatedArtifact = nil
+	s.DefaultValueTriggerDefinition = nil
+	s.DefaultValueUsageContext = nil
+	s.DefaultValueDosage = nil
+	s.DefaultValueMeta = nil
+}
+
+// SetDefaultValueBase64Binary sets the base64Binary option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueBase64Binary(value primitives.Base64Binary) {
+	ext := s.DefaultValueBase64BinaryExt
+	s.ClearDefaultValue()
+	s.DefaultValueBase64Binary, s.DefaultValueBase64BinaryExt = &value, ext
+}
+
+// SetDefaultValueBoolean sets the boolean option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueBoolean(value bool) {
+	ext := s.DefaultValueBooleanExt
+	s.ClearDefaultValue()
+	s.DefaultValueBoolean, s.DefaultValueBooleanExt = &value, ext
+}
+
+// SetDefaultValueCanonical sets the canonical option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueCanonical(value primitives.Canonical) {
+	ext := s.DefaultValueCanonicalExt
+	s.ClearDefaultValue()
+	s.DefaultValueCanonical, s.DefaultValueCanonicalExt = &value, ext
+}
+
+// SetDefaultValueCode sets the code option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueCode(value string) {
+	ext := s.DefaultValueCodeExt
+	s.ClearDefaultValue()
+	s.DefaultValueCode, s.DefaultValueCodeExt = &value, ext
+}
+
+// SetDefaultValueDate sets the date option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDate(value primitives.Date) {
+	ext := s.DefaultValueDateExt
+	s.ClearDefaultValue()
+	s.DefaultValueDate, s.DefaultValueDateExt = &value, ext
+}
+
+// SetDefaultValueDateTime sets the dateTime option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDateTime(value primitives.DateTime) {
+	ext := s.DefaultValueDateTimeExt
+	s.ClearDefaultValue()
+	s.DefaultValueDateTime, s.DefaultValueDateTimeExt = &value, ext
+}
+
+// SetDefaultValueDecimal sets the decimal option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDecimal(value primitives.Decimal) {
+	ext := s.DefaultValueDecimalExt
+	s.ClearDefaultValue()
+	s.DefaultValueDecimal, s.DefaultValueDecimalExt = &value, ext
+}
+
+// SetDefaultValueID sets the id option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueID(value string) {
+	ext := s.DefaultValueIDExt
+	s.ClearDefaultValue()
+	s.DefaultValueID, s.DefaultValueIDExt = &value, ext
+}
+
+// SetDefaultValueInstant sets the instant option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueInstant(value primitives.Instant) {
+	ext := s.DefaultValueInstantExt
+	s.ClearDefaultValue()
+	s.DefaultValueInstant, s.DefaultValueInstantExt = &value, ext
+}
+
+// SetDefaultValueInteger sets the integer option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueInteger(value int) {
+	ext := s.DefaultValueIntegerExt
+	s.ClearDefaultValue()
+	s.DefaultValueInteger, s.DefaultValueIntegerExt = &value, ext
+}
+
+// SetDefaultValueMarkdown sets the markdown option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueMarkdown(value string) {
+	ext := s.DefaultValueMarkdownExt
+	s.ClearDefaultValue()
+	s.DefaultValueMarkdown, s.DefaultValueMarkdownExt = &value, ext
+}
+
+// SetDefaultValueOid sets the oid option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueOid(value string) {
+	ext := s.DefaultValueOidExt
+	s.ClearDefaultValue()
+	s.DefaultValueOid, s.DefaultValueOidExt = &value, ext
+}
+
+// SetDefaultValuePositiveInt sets the positiveInt option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValuePositiveInt(value int) {
+	ext := s.DefaultValuePositiveIntExt
+	s.ClearDefaultValue()
+	s.DefaultValuePositiveInt, s.DefaultValuePositiveIntExt = &value, ext
+}
+
+// SetDefaultValueString sets the string option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueString(value string) {
+	ext := s.DefaultValueStringExt
+	s.ClearDefaultValue()
+	s.DefaultValueString, s.DefaultValueStringExt = &value, ext
+}
+
+// SetDefaultValueTime sets the time option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueTime(value primitives.Time) {
+	ext := s.DefaultValueTimeExt
+	s.ClearDefaultValue()
+	s.DefaultValueTime, s.DefaultValueTimeExt = &value, ext
+}
+
+// SetDefaultValueUnsignedInt sets the unsignedInt option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueUnsignedInt(value uint) {
+	ext := s.DefaultValueUnsignedIntExt
+	s.ClearDefaultValue()
+	s.DefaultValueUnsignedInt, s.DefaultValueUnsignedIntExt = &value, ext
+}
+
+// SetDefaultValueURI sets the uri option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueURI(value string) {
+	ext := s.DefaultValueURIExt
+	s.ClearDefaultValue()
+	s.DefaultValueURI, s.DefaultValueURIExt = &value, ext
+}
+
+// SetDefaultValueURL sets the url option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueURL(value string) {
+	ext := s.DefaultValueURLExt
+	s.ClearDefaultValue()
+	s.DefaultValueURL, s.DefaultValueURLExt = &value, ext
+}
+
+// SetDefaultValueUUID sets the uuid option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueUUID(value string) {
+	ext := s.DefaultValueUUIDExt
+	s.ClearDefaultValue()
+	s.DefaultValueUUID, s.DefaultValueUUIDExt = &value, ext
+}
+
+// SetDefaultValueAddress sets the Address option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueAddress(value Address) {
+	s.ClearDefaultValue()
+	s.DefaultValueAddress = &value
+}
+
+// SetDefaultValueAge sets the Age option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueAge(value Age) {
+	s.ClearDefaultValue()
+	s.DefaultValueAge = &value
+}
+
+// SetDefaultValueAnnotation sets the Annotation option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueAnnotation(value Annotation) {
+	s.ClearDefaultValue()
+	s.DefaultValueAnnotation = &value
+}
+
+// SetDefaultValueAttachment sets the Attachment option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueAttachment(value Attachment) {
+	s.ClearDefaultValue()
+	s.DefaultValueAttachment = &value
+}
+
+// SetDefaultValueCodeableConcept sets the CodeableConcept option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueCodeableConcept(value CodeableConcept) {
+	s.ClearDefaultValue()
+	s.DefaultValueCodeableConcept = &value
+}
+
+// SetDefaultValueCoding sets the Coding option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueCoding(value Coding) {
+	s.ClearDefaultValue()
+	s.DefaultValueCoding = &value
+}
+
+// SetDefaultValueContactPoint sets the ContactPoint option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueContactPoint(value ContactPoint) {
+	s.ClearDefaultValue()
+	s.DefaultValueContactPoint = &value
+}
+
+// SetDefaultValueCount sets the Count option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueCount(value Count) {
+	s.ClearDefaultValue()
+	s.DefaultValueCount = &value
+}
+
+// SetDefaultValueDistance sets the Distance option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDistance(value Distance) {
+	s.ClearDefaultValue()
+	s.DefaultValueDistance = &value
+}
+
+// SetDefaultValueDuration sets the Duration option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDuration(value Duration) {
+	s.ClearDefaultValue()
+	s.DefaultValueDuration = &value
+}
+
+// SetDefaultValueHumanName sets the HumanName option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueHumanName(value HumanName) {
+	s.ClearDefaultValue()
+	s.DefaultValueHumanName = &value
+}
+
+// SetDefaultValueIdentifier sets the Identifier option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueIdentifier(value Identifier) {
+	s.ClearDefaultValue()
+	s.DefaultValueIdentifier = &value
+}
+
+// SetDefaultValueMoney sets the Money option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueMoney(value Money) {
+	s.ClearDefaultValue()
+	s.DefaultValueMoney = &value
+}
+
+// SetDefaultValuePeriod sets the Period option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValuePeriod(value Period) {
+	s.ClearDefaultValue()
+	s.DefaultValuePeriod = &value
+}
+
+// SetDefaultValueQuantity sets the Quantity option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueQuantity(value Quantity) {
+	s.ClearDefaultValue()
+	s.DefaultValueQuantity = &value
+}
+
+// SetDefaultValueRange sets the Range option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueRange(value Range) {
+	s.ClearDefaultValue()
+	s.DefaultValueRange = &value
+}
+
+// SetDefaultValueRatio sets the Ratio option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueRatio(value Ratio) {
+	s.ClearDefaultValue()
+	s.DefaultValueRatio = &value
+}
+
+// SetDefaultValueReference sets the Reference option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueReference(value Reference) {
+	s.ClearDefaultValue()
+	s.DefaultValueReference = &value
+}
+
+// SetDefaultValueSampledData sets the SampledData option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueSampledData(value SampledData) {
+	s.ClearDefaultValue()
+	s.DefaultValueSampledData = &value
+}
+
+// SetDefaultValueSignature sets the Signature option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueSignature(value Signature) {
+	s.ClearDefaultValue()
+	s.DefaultValueSignature = &value
+}
+
+// SetDefaultValueTiming sets the Timing option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueTiming(value Timing) {
+	s.ClearDefaultValue()
+	s.DefaultValueTiming = &value
+}
+
+// SetDefaultValueContactDetail sets the ContactDetail option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueContactDetail(value ContactDetail) {
+	s.ClearDefaultValue()
+	s.DefaultValueContactDetail = &value
+}
+
+// SetDefaultValueContributor sets the Contributor option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueContributor(value Contributor) {
+	s.ClearDefaultValue()
+	s.DefaultValueContributor = &value
+}
+
+// SetDefaultValueDataRequirement sets the DataRequirement option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDataRequirement(value DataRequirement) {
+	s.ClearDefaultValue()
+	s.DefaultValueDataRequirement = &value
+}
+
+// SetDefaultValueExpression sets the Expression option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueExpression(value Expression) {
+	s.ClearDefaultValue()
+	s.DefaultValueExpression = &value
+}
+
+// SetDefaultValueParameterDefinition sets the ParameterDefinition option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueParameterDefinition(value ParameterDefinition) {
+	s.ClearDefaultValue()
+	s.DefaultValueParameterDefinition = &value
+}
+
+// SetDefaultValueRelatedArtifact sets the RelatedArtifact option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueRelatedArtifact(value RelatedArtifact) {
+	s.ClearDefaultValue()
+	s.DefaultValueRelatedArtifact = &value
+}
+
+// SetDefaultValueTriggerDefinition sets the TriggerDefinition option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueTriggerDefinition(value TriggerDefinition) {
+	s.ClearDefaultValue()
+	s.DefaultValueTriggerDefinition = &value
+}
+
+// SetDefaultValueUsageContext sets the UsageContext option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueUsageContext(value UsageContext) {
+	s.ClearDefaultValue()
+	s.DefaultValueUsageContext = &value
+}
+
+// SetDefaultValueDosage sets the Dosage option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueDosage(value Dosage) {
+	s.ClearDefaultValue()
+	s.DefaultValueDosage = &value
+}
+
+// SetDefaultValueMeta sets the Meta option of defaultValue[x], clearing the others.
+func (s *StructureMapGroupRuleSource) SetDefaultValueMeta(value Meta) {
+	s.ClearDefaultValue()
+	s.DefaultValueMeta = &value
+}
+
 // StructureMapGroupRuleTargetParameter represents a FHIR BackboneElement for StructureMap.group.rule.target.parameter.
 type StructureMapGroupRuleTargetParameter struct {
 	// Unique id for inter-element referencing
@@ -270,27 +876,111 @@ type StructureMapGroupRuleTargetParameter struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Parameter value - variable or literal - id option
-	ValueID string `json:"valueID" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (s *StructureMapGroupRuleTargetParameter) Value() any {
+	switch {
+	case s.ValueID != nil:
+		return s.ValueID
+	case s.ValueString != nil:
+		return s.ValueString
+	case s.ValueBoolean != nil:
+		return s.ValueBoolean
+	case s.ValueInteger != nil:
+		return s.ValueInteger
+	case s.ValueDecimal != nil:
+		return s.ValueDecimal
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "id", or "" if none is.
+func (s *StructureMapGroupRuleTargetParameter) ValueType() string {
+	switch {
+	case s.ValueID != nil:
+		return "id"
+	case s.ValueString != nil:
+		return "string"
+	case s.ValueBoolean != nil:
+		return "boolean"
+	case s.ValueInteger != nil:
+		return "integer"
+	case s.ValueDecimal != nil:
+		return "decimal"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (s *StructureMapGroupRuleTargetParameter) ClearValue() {
+	s.ValueID = nil
+	s.ValueIDExt = nil
+	s.ValueString = nil
+	s.ValueStringExt = nil
+	s.ValueBoolean = nil
+	s.ValueBooleanExt = nil
+	s.ValueInteger = nil
+	s.ValueIntegerExt = nil
+	s.ValueDecimal = nil
+	s.ValueDecimalExt = nil
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueID(value string) {
+	ext := s.ValueIDExt
+	s.ClearValue()
+	s.ValueID, s.ValueIDExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueString(value string) {
+	ext := s.ValueStringExt
+	s.ClearValue()
+	s.ValueString, s.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueBoolean(value bool) {
+	ext := s.ValueBooleanExt
+	s.ClearValue()
+	s.ValueBoolean, s.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueInteger(value int) {
+	ext := s.ValueIntegerExt
+	s.ClearValue()
+	s.ValueInteger, s.ValueIntegerExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueDecimal(value primitives.Decimal) {
+	ext := s.ValueDecimalExt
+	s.ClearValue()
+	s.ValueDecimal, s.ValueDecimalExt = &value, ext
+}
+
 // StructureMapGroupRuleTarget represents a FHIR BackboneElement for StructureMap.group.rule.target.
 type StructureMapGroupRuleTarget struct {
 	// Unique id for inter-element referencing
@@ -419,7 +1109,7 @@ type StructureMapGroup struct {
 
 // StructureMap represents a FHIR StructureMap.
 type StructureMap struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this structure map, represented as a URI (globally unique)
@@ -483,3 +1173,8 @@ type StructureMap struct {
 	// Named sections for reader convenience
 	Group []StructureMapGroup `json:"group,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "StructureMap", the FHIR type of the resource.
+func (s *StructureMap) ResourceType() string {
+	return ResourceTypeStructureMap
+}
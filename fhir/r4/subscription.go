@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -43,7 +42,7 @@ type SubscriptionChannel struct {
 
 // Subscription represents a FHIR Subscription.
 type Subscription struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// requested | active | error | off
@@ -71,3 +70,8 @@ type Subscription struct {
 	// The channel on which to report matches to the criteria
 	Channel SubscriptionChannel `json:"channel" fhir:"cardinality=1..1,required,summary"`
 }
+
+// ResourceType returns "Subscription", the FHIR type of the resource.
+func (s *Subscription) ResourceType() string {
+	return ResourceTypeSubscription
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -137,7 +136,7 @@ type SubstancePolymerRepeat struct {
 
 // SubstancePolymer represents a FHIR SubstancePolymer.
 type SubstancePolymer struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Todo
@@ -155,3 +154,8 @@ type SubstancePolymer struct {
 	// Todo
 	Repeat []SubstancePolymerRepeat `json:"repeat,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubstancePolymer", the FHIR type of the resource.
+func (s *SubstancePolymer) ResourceType() string {
+	return ResourceTypeSubstancePolymer
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type SubstanceProteinSubunit struct {
 
 // SubstanceProtein represents a FHIR SubstanceProtein.
 type SubstanceProtein struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The SubstanceProtein descriptive elements will only be used when a complete or partial amino acid sequence is available or derivable from a nucleic acid sequence
@@ -69,3 +68,8 @@ type SubstanceProtein struct {
 	// This subclause refers to the description of each subunit constituting the SubstanceProtein. A subunit is a linear sequence of amino acids linked through peptide bonds. The Subunit information shall be provided when the finished SubstanceProtein is a complex of multiple sequences; subunits are not used to delineate domains within a single sequence. Subunits are listed in order of decreasing length; sequences of the same length will be ordered by decreasing molecular weight; subunits that have identical sequences will be repeated multiple times
 	Subunit []SubstanceProteinSubunit `json:"subunit,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubstanceProtein", the FHIR type of the resource.
+func (s *SubstanceProtein) ResourceType() string {
+	return ResourceTypeSubstanceProtein
+}
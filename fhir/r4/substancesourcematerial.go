@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -147,7 +146,7 @@ type SubstanceSourceMaterialPartDescription struct {
 
 // SubstanceSourceMaterial represents a FHIR SubstanceSourceMaterial.
 type SubstanceSourceMaterial struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// General high level classification of the source material specific to the origin of the material
@@ -183,3 +182,8 @@ type SubstanceSourceMaterial struct {
 	// To do
 	PartDescription []SubstanceSourceMaterialPartDescription `json:"partDescription,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubstanceSourceMaterial", the FHIR type of the resource.
+func (s *SubstanceSourceMaterial) ResourceType() string {
+	return ResourceTypeSubstanceSourceMaterial
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -47,6 +46,49 @@ type SubstanceSpecificationMoiety struct {
 	AmountStringExt *primitives.PrimitiveExtension `json:"_amountString,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Amount returns the option of amount[x] that is set, or nil if none is.
+func (s *SubstanceSpecificationMoiety) Amount() any {
+	switch {
+	case s.AmountQuantity != nil:
+		return s.AmountQuantity
+	case s.AmountString != nil:
+		return s.AmountString
+	}
+	return nil
+}
+
+// AmountType returns the FHIR type of the option of amount[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *SubstanceSpecificationMoiety) AmountType() string {
+	switch {
+	case s.AmountQuantity != nil:
+		return "Quantity"
+	case s.AmountString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAmount unsets every option of amount[x], and their extensions.
+func (s *SubstanceSpecificationMoiety) ClearAmount() {
+	s.AmountQuantity = nil
+	s.AmountString = nil
+	s.AmountStringExt = nil
+}
+
+// SetAmountQuantity sets the Quantity option of amount[x], clearing the others.
+func (s *SubstanceSpecificationMoiety) SetAmountQuantity(value Quantity) {
+	s.ClearAmount()
+	s.AmountQuantity = &value
+}
+
+// SetAmountString sets the string option of amount[x], clearing the others.
+func (s *SubstanceSpecificationMoiety) SetAmountString(value string) {
+	ext := s.AmountStringExt
+	s.ClearAmount()
+	s.AmountString, s.AmountStringExt = &value, ext
+}
+
 // SubstanceSpecificationProperty represents a FHIR BackboneElement for SubstanceSpecification.property.
 type SubstanceSpecificationProperty struct {
 	// Unique id for inter-element referencing
@@ -77,6 +119,90 @@ type SubstanceSpecificationProperty struct {
 	AmountStringExt *primitives.PrimitiveExtension `json:"_amountString,omitempty" fhir:"cardinality=0..1"`
 }
 
+// DefiningSubstance returns the option of definingSubstance[x] that is set, or nil if none is.
+func (s *SubstanceSpecificationProperty) DefiningSubstance() any {
+	switch {
+	case s.DefiningSubstanceReference != nil:
+		return s.DefiningSubstanceReference
+	case s.DefiningSubstanceCodeableConcept != nil:
+		return s.DefiningSubstanceCodeableConcept
+	}
+	return nil
+}
+
+// DefiningSubstanceType returns the FHIR type of the option of definingSubstance[x] that is
+// set, such as "Reference", or "" if none is.
+func (s *SubstanceSpecificationProperty) DefiningSubstanceType() string {
+	switch {
+	case s.DefiningSubstanceReference != nil:
+		return "Reference"
+	case s.DefiningSubstanceCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearDefiningSubstance unsets every option of definingSubstance[x], and their extensions.
+func (s *SubstanceSpecificationProperty) ClearDefiningSubstance() {
+	s.DefiningSubstanceReference = nil
+	s.DefiningSubstanceCodeableConcept = nil
+}
+
+// SetDefiningSubstanceReference sets the Reference option of definingSubstance[x], clearing the others.
+func (s *SubstanceSpecificationProperty) SetDefiningSubstanceReference(value Reference) {
+	s.ClearDefiningSubstance()
+	s.DefiningSubstanceReference = &value
+}
+
+// SetDefiningSubstanceCodeableConcept sets the CodeableConcept option of definingSubstance[x], clearing the others.
+func (s *SubstanceSpecificationProperty) SetDefiningSubstanceCodeableConcept(value CodeableConcept) {
+	s.ClearDefiningSubstance()
+	s.DefiningSubstanceCodeableConcept = &value
+}
+
+// Amount returns the option of amount[x] that is set, or nil if none is.
+func (s *SubstanceSpecificationProperty) Amount() any {
+	switch {
+	case s.AmountQuantity != nil:
+		return s.AmountQuantity
+	case s.AmountString != nil:
+		return s.AmountString
+	}
+	return nil
+}
+
+// AmountType returns the FHIR type of the option of amount[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *SubstanceSpecificationProperty) AmountType() string {
+	switch {
+	case s.AmountQuantity != nil:
+		return "Quantity"
+	case s.AmountString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAmount unsets every option of amount[x], and their extensions.
+func (s *SubstanceSpecificationProperty) ClearAmount() {
+	s.AmountQuantity = nil
+	s.AmountString = nil
+	s.AmountStringExt = nil
+}
+
+// SetAmountQuantity sets the Quantity option of amount[x], clearing the others.
+func (s *SubstanceSpecificationProperty) SetAmountQuantity(value Quantity) {
+	s.ClearAmount()
+	s.AmountQuantity = &value
+}
+
+// SetAmountString sets the string option of amount[x], clearing the others.
+func (s *SubstanceSpecificationProperty) SetAmountString(value string) {
+	ext := s.AmountStringExt
+	s.ClearAmount()
+	s.AmountString, s.AmountStringExt = &value, ext
+}
+
 // SubstanceSpecificationStructureIsotopeMolecularWeight represents a FHIR BackboneElement for SubstanceSpecification.structure.isotope.molecularWeight.
 type SubstanceSpecificationStructureIsotopeMolecularWeight struct {
 	// Unique id for inter-element referencing
@@ -307,9 +433,50 @@ type SubstanceSpecificationRelationship struct {
 	Source []Reference `json:"source,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Substance returns the option of substance[x] that is set, or nil if none is.
+func (s *SubstanceSpecificationRelationship) Substance() any {
+	switch {
+	case s.SubstanceReference != nil:
+		return s.SubstanceReference
+	case s.SubstanceCodeableConcept != nil:
+		return s.SubstanceCodeableConcept
+	}
+	return nil
+}
+
+// SubstanceType returns the FHIR type of the option of substance[x] that is
+// set, such as "Reference", or "" if none is.
+func (s *SubstanceSpecificationRelationship) SubstanceType() string {
+	switch {
+	case s.SubstanceReference != nil:
+		return "Reference"
+	case s.SubstanceCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearSubstance unsets every option of substance[x], and their extensions.
+func (s *SubstanceSpecificationRelationship) ClearSubstance() {
+	s.SubstanceReference = nil
+	s.SubstanceCodeableConcept = nil
+}
+
+// SetSubstanceReference sets the Reference option of substance[x], clearing the others.
+func (s *SubstanceSpecificationRelationship) SetSubstanceReference(value Reference) {
+	s.ClearSubstance()
+	s.SubstanceReference = &value
+}
+
+// SetSubstanceCodeableConcept sets the CodeableConcept option of substance[x], clearing the others.
+func (s *SubstanceSpecificationRelationship) SetSubstanceCodeableConcept(value CodeableConcept) {
+	s.ClearSubstance()
+	s.SubstanceCodeableConcept = &value
+}
+
 // SubstanceSpecification represents a FHIR SubstanceSpecification.
 type SubstanceSpecification struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier by which this substance is known
@@ -355,3 +522,8 @@ type SubstanceSpecification struct {
 	// Material or taxonomic/anatomical source for the substance
 	SourceMaterial *Reference `json:"sourceMaterial,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "SubstanceSpecification", the FHIR type of the resource.
+func (s *SubstanceSpecification) ResourceType() string {
+	return ResourceTypeSubstanceSpecification
+}
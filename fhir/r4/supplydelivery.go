@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,9 +30,50 @@ type SupplyDeliverySuppliedItem struct {
 	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=0..1,choice=item"`
 }
 
+// Item returns the option of item[x] that is set, or nil if none is.
+func (s *SupplyDeliverySuppliedItem) Item() any {
+	switch {
+	case s.ItemCodeableConcept != nil:
+		return s.ItemCodeableConcept
+	case s.ItemReference != nil:
+		return s.ItemReference
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SupplyDeliverySuppliedItem) ItemType() string {
+	switch {
+	case s.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.ItemReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (s *SupplyDeliverySuppliedItem) ClearItem() {
+	s.ItemCodeableConcept = nil
+	s.ItemReference = nil
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (s *SupplyDeliverySuppliedItem) SetItemCodeableConcept(value CodeableConcept) {
+	s.ClearItem()
+	s.ItemCodeableConcept = &value
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (s *SupplyDeliverySuppliedItem) SetItemReference(value Reference) {
+	s.ClearItem()
+	s.ItemReference = &value
+}
+
 // SupplyDelivery represents a FHIR SupplyDelivery.
 type SupplyDelivery struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -67,3 +107,62 @@ type SupplyDelivery struct {
 	// Who collected the Supply
 	Receiver []Reference `json:"receiver,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "SupplyDelivery", the FHIR type of the resource.
+func (s *SupplyDelivery) ResourceType() string {
+	return ResourceTypeSupplyDelivery
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (s *SupplyDelivery) Occurrence() any {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return s.OccurrenceDateTime
+	case s.OccurrencePeriod != nil:
+		return s.OccurrencePeriod
+	case s.OccurrenceTiming != nil:
+		return s.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (s *SupplyDelivery) OccurrenceType() string {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return "dateTime"
+	case s.OccurrencePeriod != nil:
+		return "Period"
+	case s.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (s *SupplyDelivery) ClearOccurrence() {
+	s.OccurrenceDateTime = nil
+	s.OccurrenceDateTimeExt = nil
+	s.OccurrencePeriod = nil
+	s.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (s *SupplyDelivery) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := s.OccurrenceDateTimeExt
+	s.ClearOccurrence()
+	s.OccurrenceDateTime, s.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (s *SupplyDelivery) SetOccurrencePeriod(value Period) {
+	s.ClearOccurrence()
+	s.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (s *SupplyDelivery) SetOccurrenceTiming(value Timing) {
+	s.ClearOccurrence()
+	s.OccurrenceTiming = &value
+}
@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -37,9 +36,74 @@ type SupplyRequestParameter struct {
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (s *SupplyRequestParameter) Value() any {
+	switch {
+	case s.ValueCodeableConcept != nil:
+		return s.ValueCodeableConcept
+	case s.ValueQuantity != nil:
+		return s.ValueQuantity
+	case s.ValueRange != nil:
+		return s.ValueRange
+	case s.ValueBoolean != nil:
+		return s.ValueBoolean
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SupplyRequestParameter) ValueType() string {
+	switch {
+	case s.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.ValueQuantity != nil:
+		return "Quantity"
+	case s.ValueRange != nil:
+		return "Range"
+	case s.ValueBoolean != nil:
+		return "boolean"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (s *SupplyRequestParameter) ClearValue() {
+	s.ValueCodeableConcept = nil
+	s.ValueQuantity = nil
+	s.ValueRange = nil
+	s.ValueBoolean = nil
+	s.ValueBooleanExt = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (s *SupplyRequestParameter) SetValueCodeableConcept(value CodeableConcept) {
+	s.ClearValue()
+	s.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (s *SupplyRequestParameter) SetValueQuantity(value Quantity) {
+	s.ClearValue()
+	s.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (s *SupplyRequestParameter) SetValueRange(value Range) {
+	s.ClearValue()
+	s.ValueRange = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (s *SupplyRequestParameter) SetValueBoolean(value bool) {
+	ext := s.ValueBooleanExt
+	s.ClearValue()
+	s.ValueBoolean, s.ValueBooleanExt = &value, ext
+}
+
 // SupplyRequest represents a FHIR SupplyRequest.
 type SupplyRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for SupplyRequest
@@ -55,9 +119,9 @@ type SupplyRequest struct {
 	// Extension for Priority
 	PriorityExt *primitives.PrimitiveExtension `json:"_priority,omitempty" fhir:"cardinality=0..1"`
 	// Medication, Substance, or Device requested to be supplied - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// Medication, Substance, or Device requested to be supplied - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// The requested amount of the item indicated
 	Quantity Quantity `json:"quantity" fhir:"cardinality=1..1,required,summary"`
 	// Ordered item details
@@ -87,3 +151,103 @@ type SupplyRequest struct {
 	// The destination of the supply
 	DeliverTo *Reference `json:"deliverTo,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "SupplyRequest", the FHIR type of the resource.
+func (s *SupplyRequest) ResourceType() string {
+	return ResourceTypeSupplyRequest
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (s *SupplyRequest) Item() any {
+	switch {
+	case s.ItemCodeableConcept != nil:
+		return s.ItemCodeableConcept
+	case s.ItemReference != nil:
+		return s.ItemReference
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SupplyRequest) ItemType() string {
+	switch {
+	case s.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.ItemReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (s *SupplyRequest) ClearItem() {
+	s.ItemCodeableConcept = nil
+	s.ItemReference = nil
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (s *SupplyRequest) SetItemCodeableConcept(value CodeableConcept) {
+	s.ClearItem()
+	s.ItemCodeableConcept = &value
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (s *SupplyRequest) SetItemReference(value Reference) {
+	s.ClearItem()
+	s.ItemReference = &value
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (s *SupplyRequest) Occurrence() any {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return s.OccurrenceDateTime
+	case s.OccurrencePeriod != nil:
+		return s.OccurrencePeriod
+	case s.OccurrenceTiming != nil:
+		return s.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (s *SupplyRequest) OccurrenceType() string {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return "dateTime"
+	case s.OccurrencePeriod != nil:
+		return "Period"
+	case s.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (s *SupplyRequest) ClearOccurrence() {
+	s.OccurrenceDateTime = nil
+	s.OccurrenceDateTimeExt = nil
+	s.OccurrencePeriod = nil
+	s.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (s *SupplyRequest) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := s.OccurrenceDateTimeExt
+	s.ClearOccurrence()
+	s.OccurrenceDateTime, s.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (s *SupplyRequest) SetOccurrencePeriod(value Period) {
+	s.ClearOccurrence()
+	s.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (s *SupplyRequest) SetOccurrenceTiming(value Timing) {
+	s.ClearOccurrence()
+	s.OccurrenceTiming = &value
+}
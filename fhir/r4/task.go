@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -46,143 +45,750 @@ type TaskInput struct {
 	// Label for the input
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Content to use in performing the task - base64Binary option
-	ValueBase64Binary string `json:"valueBase64Binary" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - canonical option
-	ValueCanonical string `json:"valueCanonical" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCanonical *primitives.Canonical `json:"valueCanonical,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCanonical
 	ValueCanonicalExt *primitives.PrimitiveExtension `json:"_valueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - id option
-	ValueID string `json:"valueID" fhir:"cardinality=1..1,required,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - instant option
-	ValueInstant primitives.Instant `json:"valueInstant" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInstant *primitives.Instant `json:"valueInstant,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInstant
 	ValueInstantExt *primitives.PrimitiveExtension `json:"_valueInstant,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - markdown option
-	ValueMarkdown string `json:"valueMarkdown" fhir:"cardinality=1..1,required,choice=value"`
+	ValueMarkdown *string `json:"valueMarkdown,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueMarkdown
 	ValueMarkdownExt *primitives.PrimitiveExtension `json:"_valueMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - oid option
-	ValueOid string `json:"valueOid" fhir:"cardinality=1..1,required,choice=value"`
+	ValueOid *string `json:"valueOid,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueOid
 	ValueOidExt *primitives.PrimitiveExtension `json:"_valueOid,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - positiveInt option
-	ValuePositiveInt int `json:"valuePositiveInt" fhir:"cardinality=1..1,required,choice=value"`
+	ValuePositiveInt *int `json:"valuePositiveInt,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValuePositiveInt
 	ValuePositiveIntExt *primitives.PrimitiveExtension `json:"_valuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - unsignedInt option
-	ValueUnsignedInt uint `json:"valueUnsignedInt" fhir:"cardinality=1..1,required,choice=value"`
+	ValueUnsignedInt *uint `json:"valueUnsignedInt,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueUnsignedInt
 	ValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_valueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - url option
-	ValueURL string `json:"valueURL" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURL *string `json:"valueUrl,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURL
-	ValueURLExt *primitives.PrimitiveExtension `json:"_valueURL,omitempty" fhir:"cardinality=0..1"`
+	ValueURLExt *primitives.PrimitiveExtension `json:"_valueUrl,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - uuid option
-	ValueUUID string `json:"valueUUID" fhir:"cardinality=1..1,required,choice=value"`
+	ValueUUID *string `json:"valueUuid,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueUUID
-	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUUID,omitempty" fhir:"cardinality=0..1"`
+	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUuid,omitempty" fhir:"cardinality=0..1"`
 	// Content to use in performing the task - Address option
-	ValueAddress Address `json:"valueAddress" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAddress *Address `json:"valueAddress,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Age option
-	ValueAge Age `json:"valueAge" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAge *Age `json:"valueAge,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Annotation option
-	ValueAnnotation Annotation `json:"valueAnnotation" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - ContactPoint option
-	ValueContactPoint ContactPoint `json:"valueContactPoint" fhir:"cardinality=1..1,required,choice=value"`
+	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Count option
-	ValueCount Count `json:"valueCount" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCount *Count `json:"valueCount,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Distance option
-	ValueDistance Distance `json:"valueDistance" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDistance *Distance `json:"valueDistance,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Duration option
-	ValueDuration Duration `json:"valueDuration" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDuration *Duration `json:"valueDuration,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - HumanName option
-	ValueHumanName HumanName `json:"valueHumanName" fhir:"cardinality=1..1,required,choice=value"`
+	ValueHumanName *HumanName `json:"valueHumanName,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Identifier option
-	ValueIdentifier Identifier `json:"valueIdentifier" fhir:"cardinality=1..1,required,choice=value"`
+	ValueIdentifier *Identifier `json:"valueIdentifier,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Money option
-	ValueMoney Money `json:"valueMoney" fhir:"cardinality=1..1,required,choice=value"`
+	ValueMoney *Money `json:"valueMoney,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Period option
-	ValuePeriod Period `json:"valuePeriod" fhir:"cardinality=1..1,required,choice=value"`
+	ValuePeriod *Period `json:"valuePeriod,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - SampledData option
-	ValueSampledData SampledData `json:"valueSampledData" fhir:"cardinality=1..1,required,choice=value"`
+	ValueSampledData *SampledData `json:"valueSampledData,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Signature option
-	ValueSignature Signature `json:"valueSignature" fhir:"cardinality=1..1,required,choice=value"`
+	ValueSignature *Signature `json:"valueSignature,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Timing option
-	ValueTiming Timing `json:"valueTiming" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTiming *Timing `json:"valueTiming,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - ContactDetail option
-	ValueContactDetail ContactDetail `json:"valueContactDetail" fhir:"cardinality=1..1,required,choice=value"`
+	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Contributor option
-	ValueContributor Contributor `json:"valueContributor" fhir:"cardinality=1..1,required,choice=value"`
+	ValueContributor *Contributor `json:"valueContributor,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - DataRequirement option
-	ValueDataRequirement DataRequirement `json:"valueDataRequirement" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDataRequirement *DataRequirement `json:"valueDataRequirement,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Expression option
-	ValueExpression Expression `json:"valueExpression" fhir:"cardinality=1..1,required,choice=value"`
+	ValueExpression *Expression `json:"valueExpression,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - ParameterDefinition option
-	ValueParameterDefinition ParameterDefinition `json:"valueParameterDefinition" fhir:"cardinality=1..1,required,choice=value"`
+	ValueParameterDefinition *ParameterDefinition `json:"valueParameterDefinition,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - RelatedArtifact option
-	ValueRelatedArtifact RelatedArtifact `json:"valueRelatedArtifact" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRelatedArtifact *RelatedArtifact `json:"valueRelatedArtifact,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - TriggerDefinition option
-	ValueTriggerDefinition TriggerDefinition `json:"valueTriggerDefinition" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTriggerDefinition *TriggerDefinition `json:"valueTriggerDefinition,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - UsageContext option
-	ValueUsageContext UsageContext `json:"valueUsageContext" fhir:"cardinality=1..1,required,choice=value"`
+	ValueUsageContext *UsageContext `json:"valueUsageContext,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Dosage option
-	ValueDosage Dosage `json:"valueDosage" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDosage *Dosage `json:"valueDosage,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Content to use in performing the task - Meta option
-	ValueMeta Meta `json:"valueMeta" fhir:"cardinality=1..1,required,choice=value"`
+	ValueMeta *Meta `json:"valueMeta,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (t *TaskInput) Value() any {
+	switch {
+	case t.ValueBase64Binary != nil:
+		return t.ValueBase64Binary
+	case t.ValueBoolean != nil:
+		return t.ValueBoolean
+	case t.ValueCanonical != nil:
+		return t.ValueCanonical
+	case t.ValueCode != nil:
+		return t.ValueCode
+	case t.ValueDate != nil:
+		return t.ValueDate
+	case t.ValueDateTime != nil:
+		return t.ValueDateTime
+	case t.ValueDecimal != nil:
+		return t.ValueDecimal
+	case t.ValueID != nil:
+		return t.ValueID
+	case t.ValueInstant != nil:
+		return t.ValueInstant
+	case t.ValueInteger != nil:
+		return t.ValueInteger
+	case t.ValueMarkdown != nil:
+		return t.ValueMarkdown
+	case t.ValueOid != nil:
+		return t.ValueOid
+	case t.ValuePositiveInt != nil:
+		return t.ValuePositiveInt
+	case t.ValueString != nil:
+		return t.ValueString
+	case t.ValueTime != nil:
+		return t.ValueTime
+	case t.ValueUnsignedInt != nil:
+		return t.ValueUnsignedInt
+	case t.ValueURI != nil:
+		return t.ValueURI
+	case t.ValueURL != nil:
+		return t.ValueURL
+	case t.ValueUUID != nil:
+		return t.ValueUUID
+	case t.ValueAddress != nil:
+		return t.ValueAddress
+	case t.ValueAge != nil:
+		return t.ValueAge
+	case t.ValueAnnotation != nil:
+		return t.ValueAnnotation
+	case t.ValueAttachment != nil:
+		return t.ValueAttachment
+	case t.ValueCodeableConcept != nil:
+		return t.ValueCodeableConcept
+	case t.ValueCoding != nil:
+		return t.ValueCoding
+	case t.ValueContactPoint != nil:
+		return t.ValueContactPoint
+	case t.ValueCount != nil:
+		return t.ValueCount
+	case t.ValueDistance != nil:
+		return t.ValueDistance
+	case t.ValueDuration != nil:
+		return t.ValueDuration
+	case t.ValueHumanName != nil:
+		return t.ValueHumanName
+	case t.ValueIdentifier != nil:
+		return t.ValueIdentifier
+	case t.ValueMoney != nil:
+		return t.ValueMoney
+	case t.ValuePeriod != nil:
+		return t.ValuePeriod
+	case t.ValueQuantity != nil:
+		return t.ValueQuantity
+	case t.ValueRange != nil:
+		return t.ValueRange
+	case t.ValueRatio != nil:
+		return t.ValueRatio
+	case t.ValueReference != nil:
+		return t.ValueReference
+	case t.ValueSampledData != nil:
+		return t.ValueSampledData
+	case t.ValueSignature != nil:
+		return t.ValueSignature
+	case t.ValueTiming != nil:
+		return t.ValueTiming
+	case t.ValueContactDetail != nil:
+		return t.ValueContactDetail
+	case t.ValueContributor != nil:
+		return t.ValueContributor
+	case t.ValueDataRequirement != nil:
+		return t.ValueDataRequirement
+	case t.ValueExpression != nil:
+		return t.ValueExpression
+	case t.ValueParameterDefinition != nil:
+		return t.ValueParameterDefinition
+	case t.ValueRelatedArtifact != nil:
+		return t.ValueRelatedArtifact
+	case t.ValueTriggerDefinition != nil:
+		return t.ValueTriggerDefinition
+	case t.ValueUsageContext != nil:
+		return t.ValueUsageContext
+	case t.ValueDosage != nil:
+		return t.ValueDosage
+	case t.ValueMeta != nil:
+		return t.ValueMeta
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (t *TaskInput) ValueType() string {
+	switch {
+	case t.ValueBase64Binary != nil:
+		return "base64Binary"
+	case t.ValueBoolean != nil:
+		return "boolean"
+	case t.ValueCanonical != nil:
+		return "canonical"
+	case t.ValueCode != nil:
+		return "code"
+	case t.ValueDate != nil:
+		return "date"
+	case t.ValueDateTime != nil:
+		return "dateTime"
+	case t.ValueDecimal != nil:
+		return "decimal"
+	case t.ValueID != nil:
+		return "id"
+	case t.ValueInstant != nil:
+		return "instant"
+	case t.ValueInteger != nil:
+		return "integer"
+	case t.ValueMarkdown != nil:
+		return "markdown"
+	case t.ValueOid != nil:
+		return "oid"
+	case t.ValuePositiveInt != nil:
+		return "positiveInt"
+	case t.ValueString != nil:
+		return "string"
+	case t.ValueTime != nil:
+		return "time"
+	case t.ValueUnsignedInt != nil:
+		return "unsignedInt"
+	case t.ValueURI != nil:
+		return "uri"
+	case t.ValueURL != nil:
+		return "url"
+	case t.ValueUUID != nil:
+		return "uuid"
+	case t.ValueAddress != nil:
+		return "Address"
+	case t.ValueAge != nil:
+		return "Age"
+	case t.ValueAnnotation != nil:
+		return "Annotation"
+	case t.ValueAttachment != nil:
+		return "Attachment"
+	case t.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case t.ValueCoding != nil:
+		return "Coding"
+	case t.ValueContactPoint != nil:
+		return "ContactPoint"
+	case t.ValueCount != nil:
+		return "Count"
+	case t.ValueDistance != nil:
+		return "Distance"
+	case t.ValueDuration != nil:
+		return "Duration"
+	case t.ValueHumanName != nil:
+		return "HumanName"
+	case t.ValueIdentifier != nil:
+		return "Identifier"
+	case t.ValueMoney != nil:
+		return "Money"
+	case t.ValuePeriod != nil:
+		return "Period"
+	case t.ValueQuantity != nil:
+		return "Quantity"
+	case t.ValueRange != nil:
+		return "Range"
+	case t.ValueRatio != nil:
+		return "Ratio"
+	case t.ValueReference != nil:
+		return "Reference"
+	case t.ValueSampledData != nil:
+		return "SampledData"
+	case t.ValueSignature != nil:
+		return "Signature"
+	case t.ValueTiming != nil:
+		return "Timing"
+	case t.ValueContactDetail != nil:
+		return "ContactDetail"
+	case t.ValueContributor != nil:
+		return "Contributor"
+	case t.ValueDataRequirement != nil:
+		return "DataRequirement"
+	case t.ValueExpression != nil:
+		return "Expression"
+	case t.ValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case t.ValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case t.ValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case t.ValueUsageContext != nil:
+		return "UsageContext"
+	case t.ValueDosage != nil:
+		return "Dosage"
+	case t.ValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (t *TaskInput) ClearValue() {
+	t.ValueBase64Binary = nil
+	t.ValueBase64BinaryExt = nil
+	t.ValueBoolean = nil
+	t.ValueBooleanExt = nil
+	t.ValueCanonical = nil
+	t.ValueCanonicalExt = nil
+	t.ValueCode = nil
+	t.ValueCodeExt = nil
+	t.ValueDate = nil
+	t.ValueDateExt = nil
+	t.ValueDateTime = nil
+	t.ValueDateTimeExt = nil
+	t.ValueDecimal = nil
+	t.ValueDecimalExt = nil
+	t.ValueID = nil
+	t.ValueIDExt = nil
+	t.ValueInstant = nil
+	t.ValueInstantExt = nil
+	t.ValueInteger = nil
+	t.ValueIntegerExt = nil
+	t.ValueMarkdown = nil
+	t.ValueMarkdownExt = nil
+	t.ValueOid = nil
+	t.ValueOidExt = nil
+	t.ValuePositiveInt = nil
+	t.ValuePositiveIntExt = nil
+	t.ValueString = nil
+	t.ValueStringExt = nil
+	t.ValueTime = nil
+	t.ValueTimeExt = nil
+	t.ValueUnsignedInt = nil
+	t.ValueUnsignedIntExt = nil
+	t.ValueURI = nil
+	t.ValueURIExt = nil
+	t.ValueURL = nil
+	t.ValueURLExt = nil
+	t.ValueUUID = nil
+	t.ValueUUIDExt = nil
+	t.ValueAddress = nil
+	t.ValueAge = nil
+	t.ValueAnnotation = nil
+	t.ValueAttachment = nil
+	t.ValueCodeableConcept = nil
+	t.ValueCoding = nil
+	t.ValueContactPoint = nil
+	t.ValueCount = nil
+	t.ValueDistance = nil
+	t.ValueDuration = nil
+	t.ValueHumanName = nil
+	t.ValueIdentifier = nil
+	t.ValueMoney = nil
+	t.ValuePeriod = nil
+	t.ValueQuantity = nil
+	t.ValueRange = nil
+	t.ValueRatio = nil
+	t.ValueReference = nil
+	t.ValueSampledData = nil
+	t.ValueSignature = nil
+	t.ValueTiming = nil
+	t.ValueContactDetail = nil
+	t.ValueContributor = nil
+	t.ValueDataRequirement = nil
+	t.ValueExpression = nil
+	t.ValueParameterDefinition = nil
+	t.ValueRelatedArtifact = nil
+	t.ValueTriggerDefinition = nil
+	t.ValueUsageContext = nil
+	t.ValueDosage = nil
+	t.ValueMeta = nil
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (t *TaskInput) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := t.ValueBase64BinaryExt
+	t.ClearValue()
+	t.ValueBase64Binary, t.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (t *TaskInput) SetValueBoolean(value bool) {
+	ext := t.ValueBooleanExt
+	t.ClearValue()
+	t.ValueBoolean, t.ValueBooleanExt = &value, ext
+}
+
+// SetValueCanonical sets the canonical option of value[x], clearing the others.
+func (t *TaskInput) SetValueCanonical(value primitives.Canonical) {
+	ext := t.ValueCanonicalExt
+	t.ClearValue()
+	t.ValueCanonical, t.ValueCanonicalExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (t *TaskInput) SetValueCode(value string) {
+	ext := t.ValueCodeExt
+	t.ClearValue()
+	t.ValueCode, t.ValueCodeExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (t *TaskInput) SetValueDate(value primitives.Date) {
+	ext := t.ValueDateExt
+	t.ClearValue()
+	t.ValueDate, t.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (t *TaskInput) SetValueDateTime(value primitives.DateTime) {
+	ext := t.ValueDateTimeExt
+	t.ClearValue()
+	t.ValueDateTime, t.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (t *TaskInput) SetValueDecimal(value primitives.Decimal) {
+	ext := t.ValueDecimalExt
+	t.ClearValue()
+	t.ValueDecimal, t.ValueDecimalExt = &value, ext
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (t *TaskInput) SetValueID(value string) {
+	ext := t.ValueIDExt
+	t.ClearValue()
+	t.ValueID, t.ValueIDExt = &value, ext
+}
+
+// SetValueInstant sets the instant option of value[x], clearing the others.
+func (t *TaskInput) SetValueInstant(value primitives.Instant) {
+	ext := t.ValueInstantExt
+	t.ClearValue()
+	t.ValueInstant, t.ValueInstantExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (t *TaskInput) SetValueInteger(value int) {
+	ext := t.ValueIntegerExt
+	t.ClearValue()
+	t.ValueInteger, t.ValueIntegerExt = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (t *TaskInput) SetValueMarkdown(value string) {
+	ext := t.ValueMarkdownExt
+	t.ClearValue()
+	t.ValueMarkdown, t.ValueMarkdownExt = &value, ext
+}
+
+// SetValueOid sets the oid option of value[x], clearing the others.
+func (t *TaskInput) SetValueOid(value string) {
+	ext := t.ValueOidExt
+	t.ClearValue()
+	t.ValueOid, t.ValueOidExt = &value, ext
+}
+
+// SetValuePositiveInt sets the positiveInt option of value[x], clearing the others.
+func (t *TaskInput) SetValuePositiveInt(value int) {
+	ext := t.ValuePositiveIntExt
+	t.ClearValue()
+	t.ValuePositiveInt, t.ValuePositiveIntExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (t *TaskInput) SetValueString(value string) {
+	ext := t.ValueStringExt
+	t.ClearValue()
+	t.ValueString, t.ValueStringExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (t *TaskInput) SetValueTime(value primitives.Time) {
+	ext := t.ValueTimeExt
+	t.ClearValue()
+	t.ValueTime, t.ValueTimeExt = &value, ext
+}
+
+// SetValueUnsignedInt sets the unsignedInt option of value[x], clearing the others.
+func (t *TaskInput) SetValueUnsignedInt(value uint) {
+	ext := t.ValueUnsignedIntExt
+	t.ClearValue()
+	t.ValueUnsignedInt, t.ValueUnsignedIntExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (t *TaskInput) SetValueURI(value string) {
+	ext := t.ValueURIExt
+	t.ClearValue()
+	t.ValueURI, t.ValueURIExt = &value, ext
+}
+
+// SetValueURL sets the url option of value[x], clearing the others.
+func (t *TaskInput) SetValueURL(value string) {
+	ext := t.ValueURLExt
+	t.ClearValue()
+	t.ValueURL, t.ValueURLExt = &value, ext
+}
+
+// SetValueUUID sets the uuid option of value[x], clearing the others.
+func (t *TaskInput) SetValueUUID(value string) {
+	ext := t.ValueUUIDExt
+	t.ClearValue()
+	t.ValueUUID, t.ValueUUIDExt = &value, ext
+}
+
+// SetValueAddress sets the Address option of value[x], clearing the others.
+func (t *TaskInput) SetValueAddress(value Address) {
+	t.ClearValue()
+	t.ValueAddress = &value
+}
+
+// SetValueAge sets the Age option of value[x], clearing the others.
+func (t *TaskInput) SetValueAge(value Age) {
+	t.ClearValue()
+	t.ValueAge = &value
+}
+
+// SetValueAnnotation sets the Annotation option of value[x], clearing the others.
+func (t *TaskInput) SetValueAnnotation(value Annotation) {
+	t.ClearValue()
+	t.ValueAnnotation = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (t *TaskInput) SetValueAttachment(value Attachment) {
+	t.ClearValue()
+	t.ValueAttachment = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (t *TaskInput) SetValueCodeableConcept(value CodeableConcept) {
+	t.ClearValue()
+	t.ValueCodeableConcept = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (t *TaskInput) SetValueCoding(value Coding) {
+	t.ClearValue()
+	t.ValueCoding = &value
+}
+
+// SetValueContactPoint sets the ContactPoint option of value[x], clearing the others.
+func (t *TaskInput) SetValueContactPoint(value ContactPoint) {
+	t.ClearValue()
+	t.ValueContactPoint = &value
+}
+
+// SetValueCount sets the Count option of value[x], clearing the others.
+func (t *TaskInput) SetValueCount(value Count) {
+	t.ClearValue()
+	t.ValueCount = &value
+}
+
+// SetValueDistance sets the Distance option of value[x], clearing the others.
+func (t *TaskInput) SetValueDistance(value Distance) {
+	t.ClearValue()
+	t.ValueDistance = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (t *TaskInput) SetValueDuration(value Duration) {
+	t.ClearValue()
+	t.ValueDuration = &value
+}
+
+// SetValueHumanName sets the HumanName option of value[x], clearing the others.
+func (t *TaskInput) SetValueHumanName(value HumanName) {
+	t.ClearValue()
+	t.ValueHumanName = &value
+}
+
+// SetValueIdentifier sets the Identifier option of value[x], clearing the others.
+func (t *TaskInput) SetValueIdentifier(value Identifier) {
+	t.ClearValue()
+	t.ValueIdentifier = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (t *TaskInput) SetValueMoney(value Money) {
+	t.ClearValue()
+	t.ValueMoney = &value
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (t *TaskInput) SetValuePeriod(value Period) {
+	t.ClearValue()
+	t.ValuePeriod = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (t *TaskInput) SetValueQuantity(value Quantity) {
+	t.ClearValue()
+	t.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (t *TaskInput) SetValueRange(value Range) {
+	t.ClearValue()
+	t.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (t *TaskInput) SetValueRatio(value Ratio) {
+	t.ClearValue()
+	t.ValueRatio = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (t *TaskInput) SetValueReference(value Reference) {
+	t.ClearValue()
+	t.ValueReference = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (t *TaskInput) SetValueSampledData(value SampledData) {
+	t.ClearValue()
+	t.ValueSampledData = &value
+}
+
+// SetValueSignature sets the Signature option of value[x], clearing the others.
+func (t *TaskInput) SetValueSignature(value Signature) {
+	t.ClearValue()
+	t.ValueSignature = &value
+}
+
+// SetValueTiming sets the Timing option of value[x], clearing the others.
+func (t *TaskInput) SetValueTiming(value Timing) {
+	t.ClearValue()
+	t.ValueTiming = &value
+}
+
+// SetValueContactDetail sets the ContactDetail option of value[x], clearing the others.
+func (t *TaskInput) SetValueContactDetail(value ContactDetail) {
+	t.ClearValue()
+	t.ValueContactDetail = &value
+}
+
+// SetValueContributor sets the Contributor option of value[x], clearing the others.
+func (t *TaskInput) SetValueContributor(value Contributor) {
+	t.ClearValue()
+	t.ValueContributor = &value
+}
+
+// SetValueDataRequirement sets the DataRequirement option of value[x], clearing the others.
+func (t *TaskInput) SetValueDataRequirement(value DataRequirement) {
+	t.ClearValue()
+	t.ValueDataRequirement = &value
+}
+
+// SetValueExpression sets the Expression option of value[x], clearing the others.
+func (t *TaskInput) SetValueExpression(value Expression) {
+	t.ClearValue()
+	t.ValueExpression = &value
+}
+
+// SetValueParameterDefinition sets the ParameterDefinition option of value[x], clearing the others.
+func (t *TaskInput) SetValueParameterDefinition(value ParameterDefinition) {
+	t.ClearValue()
+	t.ValueParameterDefinition = &value
+}
+
+// SetValueRelatedArtifact sets the RelatedArtifact option of value[x], clearing the others.
+func (t *TaskInput) SetValueRelatedArtifact(value RelatedArtifact) {
+	t.ClearValue()
+	t.ValueRelatedArtifact = &value
+}
+
+// SetValueTriggerDefinition sets the TriggerDefinition option of value[x], clearing the others.
+func (t *TaskInput) SetValueTriggerDefinition(value TriggerDefinition) {
+	t.ClearValue()
+	t.ValueTriggerDefinition = &value
+}
+
+// SetValueUsageContext sets the UsageContext option of value[x], clearing the others.
+func (t *TaskInput) SetValueUsageContext(value UsageContext) {
+	t.ClearValue()
+	t.ValueUsageContext = &value
+}
+
+// SetValueDosage sets the Dosage option of value[x], clearing the others.
+func (t *TaskInput) SetValueDosage(value Dosage) {
+	t.ClearValue()
+	t.ValueDosage = &value
+}
+
+// SetValueMeta sets the Meta option of value[x], clearing the others.
+func (t *TaskInput) SetValueMeta(value Meta) {
+	t.ClearValue()
+	t.ValueMeta = &value
 }
 
 // TaskOutput represents a FHIR BackboneElement for Task.output.
@@ -198,148 +804,755 @@ type TaskOutput struct {
 	// Label for output
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Result of output - base64Binary option
-	ValueBase64Binary string `json:"valueBase64Binary" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - canonical option
-	ValueCanonical string `json:"valueCanonical" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCanonical *primitives.Canonical `json:"valueCanonical,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCanonical
 	ValueCanonicalExt *primitives.PrimitiveExtension `json:"_valueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - id option
-	ValueID string `json:"valueID" fhir:"cardinality=1..1,required,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - instant option
-	ValueInstant primitives.Instant `json:"valueInstant" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInstant *primitives.Instant `json:"valueInstant,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInstant
 	ValueInstantExt *primitives.PrimitiveExtension `json:"_valueInstant,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - markdown option
-	ValueMarkdown string `json:"valueMarkdown" fhir:"cardinality=1..1,required,choice=value"`
+	ValueMarkdown *string `json:"valueMarkdown,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueMarkdown
 	ValueMarkdownExt *primitives.PrimitiveExtension `json:"_valueMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - oid option
-	ValueOid string `json:"valueOid" fhir:"cardinality=1..1,required,choice=value"`
+	ValueOid *string `json:"valueOid,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueOid
 	ValueOidExt *primitives.PrimitiveExtension `json:"_valueOid,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - positiveInt option
-	ValuePositiveInt int `json:"valuePositiveInt" fhir:"cardinality=1..1,required,choice=value"`
+	ValuePositiveInt *int `json:"valuePositiveInt,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValuePositiveInt
 	ValuePositiveIntExt *primitives.PrimitiveExtension `json:"_valuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - unsignedInt option
-	ValueUnsignedInt uint `json:"valueUnsignedInt" fhir:"cardinality=1..1,required,choice=value"`
+	ValueUnsignedInt *uint `json:"valueUnsignedInt,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueUnsignedInt
 	ValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_valueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - url option
-	ValueURL string `json:"valueURL" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURL *string `json:"valueUrl,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURL
-	ValueURLExt *primitives.PrimitiveExtension `json:"_valueURL,omitempty" fhir:"cardinality=0..1"`
+	ValueURLExt *primitives.PrimitiveExtension `json:"_valueUrl,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - uuid option
-	ValueUUID string `json:"valueUUID" fhir:"cardinality=1..1,required,choice=value"`
+	ValueUUID *string `json:"valueUuid,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueUUID
-	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUUID,omitempty" fhir:"cardinality=0..1"`
+	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUuid,omitempty" fhir:"cardinality=0..1"`
 	// Result of output - Address option
-	ValueAddress Address `json:"valueAddress" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAddress *Address `json:"valueAddress,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Age option
-	ValueAge Age `json:"valueAge" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAge *Age `json:"valueAge,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Annotation option
-	ValueAnnotation Annotation `json:"valueAnnotation" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - ContactPoint option
-	ValueContactPoint ContactPoint `json:"valueContactPoint" fhir:"cardinality=1..1,required,choice=value"`
+	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Count option
-	ValueCount Count `json:"valueCount" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCount *Count `json:"valueCount,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Distance option
-	ValueDistance Distance `json:"valueDistance" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDistance *Distance `json:"valueDistance,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Duration option
-	ValueDuration Duration `json:"valueDuration" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDuration *Duration `json:"valueDuration,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - HumanName option
-	ValueHumanName HumanName `json:"valueHumanName" fhir:"cardinality=1..1,required,choice=value"`
+	ValueHumanName *HumanName `json:"valueHumanName,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Identifier option
-	ValueIdentifier Identifier `json:"valueIdentifier" fhir:"cardinality=1..1,required,choice=value"`
+	ValueIdentifier *Identifier `json:"valueIdentifier,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Money option
-	ValueMoney Money `json:"valueMoney" fhir:"cardinality=1..1,required,choice=value"`
+	ValueMoney *Money `json:"valueMoney,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Period option
-	ValuePeriod Period `json:"valuePeriod" fhir:"cardinality=1..1,required,choice=value"`
+	ValuePeriod *Period `json:"valuePeriod,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - SampledData option
-	ValueSampledData SampledData `json:"valueSampledData" fhir:"cardinality=1..1,required,choice=value"`
+	ValueSampledData *SampledData `json:"valueSampledData,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Signature option
-	ValueSignature Signature `json:"valueSignature" fhir:"cardinality=1..1,required,choice=value"`
+	ValueSignature *Signature `json:"valueSignature,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Timing option
-	ValueTiming Timing `json:"valueTiming" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTiming *Timing `json:"valueTiming,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - ContactDetail option
-	ValueContactDetail ContactDetail `json:"valueContactDetail" fhir:"cardinality=1..1,required,choice=value"`
+	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Contributor option
-	ValueContributor Contributor `json:"valueContributor" fhir:"cardinality=1..1,required,choice=value"`
+	ValueContributor *Contributor `json:"valueContributor,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - DataRequirement option
-	ValueDataRequirement DataRequirement `json:"valueDataRequirement" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDataRequirement *DataRequirement `json:"valueDataRequirement,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Expression option
-	ValueExpression Expression `json:"valueExpression" fhir:"cardinality=1..1,required,choice=value"`
+	ValueExpression *Expression `json:"valueExpression,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - ParameterDefinition option
-	ValueParameterDefinition ParameterDefinition `json:"valueParameterDefinition" fhir:"cardinality=1..1,required,choice=value"`
+	ValueParameterDefinition *ParameterDefinition `json:"valueParameterDefinition,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - RelatedArtifact option
-	ValueRelatedArtifact RelatedArtifact `json:"valueRelatedArtifact" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRelatedArtifact *RelatedArtifact `json:"valueRelatedArtifact,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - TriggerDefinition option
-	ValueTriggerDefinition TriggerDefinition `json:"valueTriggerDefinition" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTriggerDefinition *TriggerDefinition `json:"valueTriggerDefinition,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - UsageContext option
-	ValueUsageContext UsageContext `json:"valueUsageContext" fhir:"cardinality=1..1,required,choice=value"`
+	ValueUsageContext *UsageContext `json:"valueUsageContext,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Dosage option
-	ValueDosage Dosage `json:"valueDosage" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDosage *Dosage `json:"valueDosage,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Result of output - Meta option
-	ValueMeta Meta `json:"valueMeta" fhir:"cardinality=1..1,required,choice=value"`
+	ValueMeta *Meta `json:"valueMeta,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (t *TaskOutput) Value() any {
+	switch {
+	case t.ValueBase64Binary != nil:
+		return t.ValueBase64Binary
+	case t.ValueBoolean != nil:
+		return t.ValueBoolean
+	case t.ValueCanonical != nil:
+		return t.ValueCanonical
+	case t.ValueCode != nil:
+		return t.ValueCode
+	case t.ValueDate != nil:
+		return t.ValueDate
+	case t.ValueDateTime != nil:
+		return t.ValueDateTime
+	case t.ValueDecimal != nil:
+		return t.ValueDecimal
+	case t.ValueID != nil:
+		return t.ValueID
+	case t.ValueInstant != nil:
+		return t.ValueInstant
+	case t.ValueInteger != nil:
+		return t.ValueInteger
+	case t.ValueMarkdown != nil:
+		return t.ValueMarkdown
+	case t.ValueOid != nil:
+		return t.ValueOid
+	case t.ValuePositiveInt != nil:
+		return t.ValuePositiveInt
+	case t.ValueString != nil:
+		return t.ValueString
+	case t.ValueTime != nil:
+		return t.ValueTime
+	case t.ValueUnsignedInt != nil:
+		return t.ValueUnsignedInt
+	case t.ValueURI != nil:
+		return t.ValueURI
+	case t.ValueURL != nil:
+		return t.ValueURL
+	case t.ValueUUID != nil:
+		return t.ValueUUID
+	case t.ValueAddress != nil:
+		return t.ValueAddress
+	case t.ValueAge != nil:
+		return t.ValueAge
+	case t.ValueAnnotation != nil:
+		return t.ValueAnnotation
+	case t.ValueAttachment != nil:
+		return t.ValueAttachment
+	case t.ValueCodeableConcept != nil:
+		return t.ValueCodeableConcept
+	case t.ValueCoding != nil:
+		return t.ValueCoding
+	case t.ValueContactPoint != nil:
+		return t.ValueContactPoint
+	case t.ValueCount != nil:
+		return t.ValueCount
+	case t.ValueDistance != nil:
+		return t.ValueDistance
+	case t.ValueDuration != nil:
+		return t.ValueDuration
+	case t.ValueHumanName != nil:
+		return t.ValueHumanName
+	case t.ValueIdentifier != nil:
+		return t.ValueIdentifier
+	case t.ValueMoney != nil:
+		return t.ValueMoney
+	case t.ValuePeriod != nil:
+		return t.ValuePeriod
+	case t.ValueQuantity != nil:
+		return t.ValueQuantity
+	case t.ValueRange != nil:
+		return t.ValueRange
+	case t.ValueRatio != nil:
+		return t.ValueRatio
+	case t.ValueReference != nil:
+		return t.ValueReference
+	case t.ValueSampledData != nil:
+		return t.ValueSampledData
+	case t.ValueSignature != nil:
+		return t.ValueSignature
+	case t.ValueTiming != nil:
+		return t.ValueTiming
+	case t.ValueContactDetail != nil:
+		return t.ValueContactDetail
+	case t.ValueContributor != nil:
+		return t.ValueContributor
+	case t.ValueDataRequirement != nil:
+		return t.ValueDataRequirement
+	case t.ValueExpression != nil:
+		return t.ValueExpression
+	case t.ValueParameterDefinition != nil:
+		return t.ValueParameterDefinition
+	case t.ValueRelatedArtifact != nil:
+		return t.ValueRelatedArtifact
+	case t.ValueTriggerDefinition != nil:
+		return t.ValueTriggerDefinition
+	case t.ValueUsageContext != nil:
+		return t.ValueUsageContext
+	case t.ValueDosage != nil:
+		return t.ValueDosage
+	case t.ValueMeta != nil:
+		return t.ValueMeta
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (t *TaskOutput) ValueType() string {
+	switch {
+	case t.ValueBase64Binary != nil:
+		return "base64Binary"
+	case t.ValueBoolean != nil:
+		return "boolean"
+	case t.ValueCanonical != nil:
+		return "canonical"
+	case t.ValueCode != nil:
+		return "code"
+	case t.ValueDate != nil:
+		return "date"
+	case t.ValueDateTime != nil:
+		return "dateTime"
+	case t.ValueDecimal != nil:
+		return "decimal"
+	case t.ValueID != nil:
+		return "id"
+	case t.ValueInstant != nil:
+		return "instant"
+	case t.ValueInteger != nil:
+		return "integer"
+	case t.ValueMarkdown != nil:
+		return "markdown"
+	case t.ValueOid != nil:
+		return "oid"
+	case t.ValuePositiveInt != nil:
+		return "positiveInt"
+	case t.ValueString != nil:
+		return "string"
+	case t.ValueTime != nil:
+		return "time"
+	case t.ValueUnsignedInt != nil:
+		return "unsignedInt"
+	case t.ValueURI != nil:
+		return "uri"
+	case t.ValueURL != nil:
+		return "url"
+	case t.ValueUUID != nil:
+		return "uuid"
+	case t.ValueAddress != nil:
+		return "Address"
+	case t.ValueAge != nil:
+		return "Age"
+	case t.ValueAnnotation != nil:
+		return "Annotation"
+	case t.ValueAttachment != nil:
+		return "Attachment"
+	case t.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case t.ValueCoding != nil:
+		return "Coding"
+	case t.ValueContactPoint != nil:
+		return "ContactPoint"
+	case t.ValueCount != nil:
+		return "Count"
+	case t.ValueDistance != nil:
+		return "Distance"
+	case t.ValueDuration != nil:
+		return "Duration"
+	case t.ValueHumanName != nil:
+		return "HumanName"
+	case t.ValueIdentifier != nil:
+		return "Identifier"
+	case t.ValueMoney != nil:
+		return "Money"
+	case t.ValuePeriod != nil:
+		return "Period"
+	case t.ValueQuantity != nil:
+		return "Quantity"
+	case t.ValueRange != nil:
+		return "Range"
+	case t.ValueRatio != nil:
+		return "Ratio"
+	case t.ValueReference != nil:
+		return "Reference"
+	case t.ValueSampledData != nil:
+		return "SampledData"
+	case t.ValueSignature != nil:
+		return "Signature"
+	case t.ValueTiming != nil:
+		return "Timing"
+	case t.ValueContactDetail != nil:
+		return "ContactDetail"
+	case t.ValueContributor != nil:
+		return "Contributor"
+	case t.ValueDataRequirement != nil:
+		return "DataRequirement"
+	case t.ValueExpression != nil:
+		return "Expression"
+	case t.ValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case t.ValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case t.ValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case t.ValueUsageContext != nil:
+		return "UsageContext"
+	case t.ValueDosage != nil:
+		return "Dosage"
+	case t.ValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (t *TaskOutput) ClearValue() {
+	t.ValueBase64Binary = nil
+	t.ValueBase64BinaryExt = nil
+	t.ValueBoolean = nil
+	t.ValueBooleanExt = nil
+	t.ValueCanonical = nil
+	t.ValueCanonicalExt = nil
+	t.ValueCode = nil
+	t.ValueCodeExt = nil
+	t.ValueDate = nil
+	t.ValueDateExt = nil
+	t.ValueDateTime = nil
+	t.ValueDateTimeExt = nil
+	t.ValueDecimal = nil
+	t.ValueDecimalExt = nil
+	t.ValueID = nil
+	t.ValueIDExt = nil
+	t.ValueInstant = nil
+	t.ValueInstantExt = nil
+	t.ValueInteger = nil
+	t.ValueIntegerExt = nil
+	t.ValueMarkdown = nil
+	t.ValueMarkdownExt = nil
+	t.ValueOid = nil
+	t.ValueOidExt = nil
+	t.ValuePositiveInt = nil
+	t.ValuePositiveIntExt = nil
+	t.ValueString = nil
+	t.ValueStringExt = nil
+	t.ValueTime = nil
+	t.ValueTimeExt = nil
+	t.ValueUnsignedInt = nil
+	t.ValueUnsignedIntExt = nil
+	t.ValueURI = nil
+	t.ValueURIExt = nil
+	t.ValueURL = nil
+	t.ValueURLExt = nil
+	t.ValueUUID = nil
+	t.ValueUUIDExt = nil
+	t.ValueAddress = nil
+	t.ValueAge = nil
+	t.ValueAnnotation = nil
+	t.ValueAttachment = nil
+	t.ValueCodeableConcept = nil
+	t.ValueCoding = nil
+	t.ValueContactPoint = nil
+	t.ValueCount = nil
+	t.ValueDistance = nil
+	t.ValueDuration = nil
+	t.ValueHumanName = nil
+	t.ValueIdentifier = nil
+	t.ValueMoney = nil
+	t.ValuePeriod = nil
+	t.ValueQuantity = nil
+	t.ValueRange = nil
+	t.ValueRatio = nil
+	t.ValueReference = nil
+	t.ValueSampledData = nil
+	t.ValueSignature = nil
+	t.ValueTiming = nil
+	t.ValueContactDetail = nil
+	t.ValueContributor = nil
+	t.ValueDataRequirement = nil
+	t.ValueExpression = nil
+	t.ValueParameterDefinition = nil
+	t.ValueRelatedArtifact = nil
+	t.ValueTriggerDefinition = nil
+	t.ValueUsageContext = nil
+	t.ValueDosage = nil
+	t.ValueMeta = nil
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (t *TaskOutput) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := t.ValueBase64BinaryExt
+	t.ClearValue()
+	t.ValueBase64Binary, t.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (t *TaskOutput) SetValueBoolean(value bool) {
+	ext := t.ValueBooleanExt
+	t.ClearValue()
+	t.ValueBoolean, t.ValueBooleanExt = &value, ext
+}
+
+// SetValueCanonical sets the canonical option of value[x], clearing the others.
+func (t *TaskOutput) SetValueCanonical(value primitives.Canonical) {
+	ext := t.ValueCanonicalExt
+	t.ClearValue()
+	t.ValueCanonical, t.ValueCanonicalExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (t *TaskOutput) SetValueCode(value string) {
+	ext := t.ValueCodeExt
+	t.ClearValue()
+	t.ValueCode, t.ValueCodeExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDate(value primitives.Date) {
+	ext := t.ValueDateExt
+	t.ClearValue()
+	t.ValueDate, t.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDateTime(value primitives.DateTime) {
+	ext := t.ValueDateTimeExt
+	t.ClearValue()
+	t.ValueDateTime, t.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDecimal(value primitives.Decimal) {
+	ext := t.ValueDecimalExt
+	t.ClearValue()
+	t.ValueDecimal, t.ValueDecimalExt = &value, ext
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (t *TaskOutput) SetValueID(value string) {
+	ext := t.ValueIDExt
+	t.ClearValue()
+	t.ValueID, t.ValueIDExt = &value, ext
+}
+
+// SetValueInstant sets the instant option of value[x], clearing the others.
+func (t *TaskOutput) SetValueInstant(value primitives.Instant) {
+	ext := t.ValueInstantExt
+	t.ClearValue()
+	t.ValueInstant, t.ValueInstantExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (t *TaskOutput) SetValueInteger(value int) {
+	ext := t.ValueIntegerExt
+	t.ClearValue()
+	t.ValueInteger, t.ValueIntegerExt = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (t *TaskOutput) SetValueMarkdown(value string) {
+	ext := t.ValueMarkdownExt
+	t.ClearValue()
+	t.ValueMarkdown, t.ValueMarkdownExt = &value, ext
+}
+
+// SetValueOid sets the oid option of value[x], clearing the others.
+func (t *TaskOutput) SetValueOid(value string) {
+	ext := t.ValueOidExt
+	t.ClearValue()
+	t.ValueOid, t.ValueOidExt = &value, ext
+}
+
+// SetValuePositiveInt sets the positiveInt option of value[x], clearing the others.
+func (t *TaskOutput) SetValuePositiveInt(value int) {
+	ext := t.ValuePositiveIntExt
+	t.ClearValue()
+	t.ValuePositiveInt, t.ValuePositiveIntExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (t *TaskOutput) SetValueString(value string) {
+	ext := t.ValueStringExt
+	t.ClearValue()
+	t.ValueString, t.ValueStringExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (t *TaskOutput) SetValueTime(value primitives.Time) {
+	ext := t.ValueTimeExt
+	t.ClearValue()
+	t.ValueTime, t.ValueTimeExt = &value, ext
+}
+
+// SetValueUnsignedInt sets the unsignedInt option of value[x], clearing the others.
+func (t *TaskOutput) SetValueUnsignedInt(value uint) {
+	ext := t.ValueUnsignedIntExt
+	t.ClearValue()
+	t.ValueUnsignedInt, t.ValueUnsignedIntExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (t *TaskOutput) SetValueURI(value string) {
+	ext := t.ValueURIExt
+	t.ClearValue()
+	t.ValueURI, t.ValueURIExt = &value, ext
+}
+
+// SetValueURL sets the url option of value[x], clearing the others.
+func (t *TaskOutput) SetValueURL(value string) {
+	ext := t.ValueURLExt
+	t.ClearValue()
+	t.ValueURL, t.ValueURLExt = &value, ext
+}
+
+// SetValueUUID sets the uuid option of value[x], clearing the others.
+func (t *TaskOutput) SetValueUUID(value string) {
+	ext := t.ValueUUIDExt
+	t.ClearValue()
+	t.ValueUUID, t.ValueUUIDExt = &value, ext
+}
+
+// SetValueAddress sets the Address option of value[x], clearing the others.
+func (t *TaskOutput) SetValueAddress(value Address) {
+	t.ClearValue()
+	t.ValueAddress = &value
+}
+
+// SetValueAge sets the Age option of value[x], clearing the others.
+func (t *TaskOutput) SetValueAge(value Age) {
+	t.ClearValue()
+	t.ValueAge = &value
+}
+
+// SetValueAnnotation sets the Annotation option of value[x], clearing the others.
+func (t *TaskOutput) SetValueAnnotation(value Annotation) {
+	t.ClearValue()
+	t.ValueAnnotation = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (t *TaskOutput) SetValueAttachment(value Attachment) {
+	t.ClearValue()
+	t.ValueAttachment = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (t *TaskOutput) SetValueCodeableConcept(value CodeableConcept) {
+	t.ClearValue()
+	t.ValueCodeableConcept = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (t *TaskOutput) SetValueCoding(value Coding) {
+	t.ClearValue()
+	t.ValueCoding = &value
+}
+
+// SetValueContactPoint sets the ContactPoint option of value[x], clearing the others.
+func (t *TaskOutput) SetValueContactPoint(value ContactPoint) {
+	t.ClearValue()
+	t.ValueContactPoint = &value
+}
+
+// SetValueCount sets the Count option of value[x], clearing the others.
+func (t *TaskOutput) SetValueCount(value Count) {
+	t.ClearValue()
+	t.ValueCount = &value
+}
+
+// SetValueDistance sets the Distance option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDistance(value Distance) {
+	t.ClearValue()
+	t.ValueDistance = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDuration(value Duration) {
+	t.ClearValue()
+	t.ValueDuration = &value
+}
+
+// SetValueHumanName sets the HumanName option of value[x], clearing the others.
+func (t *TaskOutput) SetValueHumanName(value HumanName) {
+	t.ClearValue()
+	t.ValueHumanName = &value
+}
+
+// SetValueIdentifier sets the Identifier option of value[x], clearing the others.
+func (t *TaskOutput) SetValueIdentifier(value Identifier) {
+	t.ClearValue()
+	t.ValueIdentifier = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (t *TaskOutput) SetValueMoney(value Money) {
+	t.ClearValue()
+	t.ValueMoney = &value
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (t *TaskOutput) SetValuePeriod(value Period) {
+	t.ClearValue()
+	t.ValuePeriod = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (t *TaskOutput) SetValueQuantity(value Quantity) {
+	t.ClearValue()
+	t.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (t *TaskOutput) SetValueRange(value Range) {
+	t.ClearValue()
+	t.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (t *TaskOutput) SetValueRatio(value Ratio) {
+	t.ClearValue()
+	t.ValueRatio = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (t *TaskOutput) SetValueReference(value Reference) {
+	t.ClearValue()
+	t.ValueReference = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (t *TaskOutput) SetValueSampledData(value SampledData) {
+	t.ClearValue()
+	t.ValueSampledData = &value
+}
+
+// SetValueSignature sets the Signature option of value[x], clearing the others.
+func (t *TaskOutput) SetValueSignature(value Signature) {
+	t.ClearValue()
+	t.ValueSignature = &value
+}
+
+// SetValueTiming sets the Timing option of value[x], clearing the others.
+func (t *TaskOutput) SetValueTiming(value Timing) {
+	t.ClearValue()
+	t.ValueTiming = &value
+}
+
+// SetValueContactDetail sets the ContactDetail option of value[x], clearing the others.
+func (t *TaskOutput) SetValueContactDetail(value ContactDetail) {
+	t.ClearValue()
+	t.ValueContactDetail = &value
+}
+
+// SetValueContributor sets the Contributor option of value[x], clearing the others.
+func (t *TaskOutput) SetValueContributor(value Contributor) {
+	t.ClearValue()
+	t.ValueContributor = &value
+}
+
+// SetValueDataRequirement sets the DataRequirement option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDataRequirement(value DataRequirement) {
+	t.ClearValue()
+	t.ValueDataRequirement = &value
+}
+
+// SetValueExpression sets the Expression option of value[x], clearing the others.
+func (t *TaskOutput) SetValueExpression(value Expression) {
+	t.ClearValue()
+	t.ValueExpression = &value
+}
+
+// SetValueParameterDefinition sets the ParameterDefinition option of value[x], clearing the others.
+func (t *TaskOutput) SetValueParameterDefinition(value ParameterDefinition) {
+	t.ClearValue()
+	t.ValueParameterDefinition = &value
+}
+
+// SetValueRelatedArtifact sets the RelatedArtifact option of value[x], clearing the others.
+func (t *TaskOutput) SetValueRelatedArtifact(value RelatedArtifact) {
+	t.ClearValue()
+	t.ValueRelatedArtifact = &value
+}
+
+// SetValueTriggerDefinition sets the TriggerDefinition option of value[x], clearing the others.
+func (t *TaskOutput) SetValueTriggerDefinition(value TriggerDefinition) {
+	t.ClearValue()
+	t.ValueTriggerDefinition = &value
+}
+
+// SetValueUsageContext sets the UsageContext option of value[x], clearing the others.
+func (t *TaskOutput) SetValueUsageContext(value UsageContext) {
+	t.ClearValue()
+	t.ValueUsageContext = &value
+}
+
+// SetValueDosage sets the Dosage option of value[x], clearing the others.
+func (t *TaskOutput) SetValueDosage(value Dosage) {
+	t.ClearValue()
+	t.ValueDosage = &value
+}
+
+// SetValueMeta sets the Meta option of value[x], clearing the others.
+func (t *TaskOutput) SetValueMeta(value Meta) {
+	t.ClearValue()
+	t.ValueMeta = &value
 }
 
 // Task represents a FHIR Task.
 type Task struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Task Instance Identifier
@@ -421,3 +1634,8 @@ type Task struct {
 	// Information produced as part of task
 	Output []TaskOutput `json:"output,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Task", the FHIR type of the resource.
+func (t *Task) ResourceType() string {
+	return ResourceTypeTask
+}
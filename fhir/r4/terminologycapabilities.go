@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -229,7 +228,7 @@ type TerminologyCapabilitiesClosure struct {
 
 // TerminologyCapabilities represents a FHIR TerminologyCapabilities.
 type TerminologyCapabilities struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this terminology capabilities, represented as a URI (globally unique)
@@ -309,3 +308,8 @@ type TerminologyCapabilities struct {
 	// Information about the [ConceptMap/$closure](conceptmap-operation-closure.html) operation
 	Closure *TerminologyCapabilitiesClosure `json:"closure,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "TerminologyCapabilities", the FHIR type of the resource.
+func (t *TerminologyCapabilities) ResourceType() string {
+	return ResourceTypeTerminologyCapabilities
+}
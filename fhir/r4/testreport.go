@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -195,7 +194,7 @@ type TestReportTeardown struct {
 
 // TestReport represents a FHIR TestReport.
 type TestReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -215,7 +214,7 @@ type TestReport struct {
 	// Extension for Result
 	ResultExt *primitives.PrimitiveExtension `json:"_result,omitempty" fhir:"cardinality=0..1"`
 	// The final score (percentage of tests passed) resulting from the execution of the TestScript
-	Score *float64 `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
+	Score *primitives.Decimal `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Score
 	ScoreExt *primitives.PrimitiveExtension `json:"_score,omitempty" fhir:"cardinality=0..1"`
 	// Name of the tester producing this report (Organization or individual)
@@ -235,3 +234,8 @@ type TestReport struct {
 	// The results of running the series of required clean up steps
 	Teardown *TestReportTeardown `json:"teardown,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "TestReport", the FHIR type of the resource.
+func (t *TestReport) ResourceType() string {
+	return ResourceTypeTestReport
+}
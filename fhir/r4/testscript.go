@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -497,7 +496,7 @@ type TestScriptTeardown struct {
 
 // TestScript represents a FHIR TestScript.
 type TestScript struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this test script, represented as a URI (globally unique)
@@ -571,3 +570,8 @@ type TestScript struct {
 	// A series of required clean up steps
 	Teardown *TestScriptTeardown `json:"teardown,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "TestScript", the FHIR type of the resource.
+func (t *TestScript) ResourceType() string {
+	return ResourceTypeTestScript
+}
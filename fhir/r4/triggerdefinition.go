@@ -1,5 +1,5 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T12:54:49Z
+// Generated at: 2026-10-18T22:01:23Z
 // FHIR Version: R4
 // Source: FHIR StructureDefinitions from https://hl7.org/fhir/R4/
 
@@ -18,7 +18,7 @@ type TriggerDefinition struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// named-event | periodic | data-changed | data-added | data-modified | data-removed | data-accessed | data-access-ended
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type TriggerType `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/trigger-type|4.0.1,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Name or URI that identifies the event
@@ -42,3 +42,70 @@ type TriggerDefinition struct {
 	// Whether the event triggers (boolean expression)
 	Condition *Expression `json:"condition,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (t *TriggerDefinition) Timing() any {
+	switch {
+	case t.TimingTiming != nil:
+		return t.TimingTiming
+	case t.TimingReference != nil:
+		return t.TimingReference
+	case t.TimingDate != nil:
+		return t.TimingDate
+	case t.TimingDateTime != nil:
+		return t.TimingDateTime
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "Timing", or "" if none is.
+func (t *TriggerDefinition) TimingType() string {
+	switch {
+	case t.TimingTiming != nil:
+		return "Timing"
+	case t.TimingReference != nil:
+		return "Reference"
+	case t.TimingDate != nil:
+		return "date"
+	case t.TimingDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (t *TriggerDefinition) ClearTiming() {
+	t.TimingTiming = nil
+	t.TimingReference = nil
+	t.TimingDate = nil
+	t.TimingDateExt = nil
+	t.TimingDateTime = nil
+	t.TimingDateTimeExt = nil
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (t *TriggerDefinition) SetTimingTiming(value Timing) {
+	t.ClearTiming()
+	t.TimingTiming = &value
+}
+
+// SetTimingReference sets the Reference option of timing[x], clearing the others.
+func (t *TriggerDefinition) SetTimingReference(value Reference) {
+	t.ClearTiming()
+	t.TimingReference = &value
+}
+
+// SetTimingDate sets the date option of timing[x], clearing the others.
+func (t *TriggerDefinition) SetTimingDate(value primitives.Date) {
+	ext := t.TimingDateExt
+	t.ClearTiming()
+	t.TimingDate, t.TimingDateExt = &value, ext
+}
+
+// SetTimingDateTime sets the dateTime option of timing[x], clearing the others.
+func (t *TriggerDefinition) SetTimingDateTime(value primitives.DateTime) {
+	ext := t.TimingDateTimeExt
+	t.ClearTiming()
+	t.TimingDateTime, t.TimingDateTimeExt = &value, ext
+}
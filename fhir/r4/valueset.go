@@ -6,7 +6,6 @@
 package r4
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -164,13 +163,13 @@ type ValueSetExpansionParameter struct {
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the named parameter - decimal option
-	ValueDecimal *float64 `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value of the named parameter - uri option
-	ValueURI *string `json:"valueURI,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Value of the named parameter - code option
 	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueCode
@@ -181,6 +180,116 @@ type ValueSetExpansionParameter struct {
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (v *ValueSetExpansionParameter) Value() any {
+	switch {
+	case v.ValueString != nil:
+		return v.ValueString
+	case v.ValueBoolean != nil:
+		return v.ValueBoolean
+	case v.ValueInteger != nil:
+		return v.ValueInteger
+	case v.ValueDecimal != nil:
+		return v.ValueDecimal
+	case v.ValueURI != nil:
+		return v.ValueURI
+	case v.ValueCode != nil:
+		return v.ValueCode
+	case v.ValueDateTime != nil:
+		return v.ValueDateTime
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "string", or "" if none is.
+func (v *ValueSetExpansionParameter) ValueType() string {
+	switch {
+	case v.ValueString != nil:
+		return "string"
+	case v.ValueBoolean != nil:
+		return "boolean"
+	case v.ValueInteger != nil:
+		return "integer"
+	case v.ValueDecimal != nil:
+		return "decimal"
+	case v.ValueURI != nil:
+		return "uri"
+	case v.ValueCode != nil:
+		return "code"
+	case v.ValueDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (v *ValueSetExpansionParameter) ClearValue() {
+	v.ValueString = nil
+	v.ValueStringExt = nil
+	v.ValueBoolean = nil
+	v.ValueBooleanExt = nil
+	v.ValueInteger = nil
+	v.ValueIntegerExt = nil
+	v.ValueDecimal = nil
+	v.ValueDecimalExt = nil
+	v.ValueURI = nil
+	v.ValueURIExt = nil
+	v.ValueCode = nil
+	v.ValueCodeExt = nil
+	v.ValueDateTime = nil
+	v.ValueDateTimeExt = nil
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueString(value string) {
+	ext := v.ValueStringExt
+	v.ClearValue()
+	v.ValueString, v.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueBoolean(value bool) {
+	ext := v.ValueBooleanExt
+	v.ClearValue()
+	v.ValueBoolean, v.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueInteger(value int) {
+	ext := v.ValueIntegerExt
+	v.ClearValue()
+	v.ValueInteger, v.ValueIntegerExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueDecimal(value primitives.Decimal) {
+	ext := v.ValueDecimalExt
+	v.ClearValue()
+	v.ValueDecimal, v.ValueDecimalExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueURI(value string) {
+	ext := v.ValueURIExt
+	v.ClearValue()
+	v.ValueURI, v.ValueURIExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueCode(value string) {
+	ext := v.ValueCodeExt
+	v.ClearValue()
+	v.ValueCode, v.ValueCodeExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueDateTime(value primitives.DateTime) {
+	ext := v.ValueDateTimeExt
+	v.ClearValue()
+	v.ValueDateTime, v.ValueDateTimeExt = &value, ext
+}
+
 // ValueSetExpansionContainsDesignation represents a FHIR BackboneElement for ValueSet.expansion.contains.designation.
 type ValueSetExpansionContainsDesignation struct {
 }
@@ -263,7 +372,7 @@ type ValueSetExpansion struct {
 
 // ValueSet represents a FHIR ValueSet.
 type ValueSet struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this value set, represented as a URI (globally unique)
@@ -327,3 +436,8 @@ type ValueSet struct {
 	// Used when the value set is "expanded"
 	Expansion *ValueSetExpansion `json:"expansion,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ValueSet", the FHIR type of the resource.
+func (v *ValueSet) ResourceType() string {
+	return ResourceTypeValueSet
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -149,7 +148,7 @@ type AccountBalance struct {
 
 // Account represents a FHIR Account.
 type Account struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Account number
@@ -195,3 +194,8 @@ type Account struct {
 	// Extension for CalculatedAt
 	CalculatedAtExt *primitives.PrimitiveExtension `json:"_calculatedAt,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Account", the FHIR type of the resource.
+func (a *Account) ResourceType() string {
+	return ResourceTypeAccount
+}
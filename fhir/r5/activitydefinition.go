@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,7 +27,7 @@ type ActivityDefinitionParticipant struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
-	TypeCanonical *string `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
+	TypeCanonical *primitives.Canonical `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Extension for TypeCanonical
 	TypeCanonicalExt *primitives.PrimitiveExtension `json:"_typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
@@ -59,7 +58,7 @@ type ActivityDefinitionDynamicValue struct {
 
 // ActivityDefinition represents a FHIR ActivityDefinition.
 type ActivityDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this activity definition, represented as a URI (globally unique)
@@ -103,7 +102,7 @@ type ActivityDefinition struct {
 	// Type of individual the activity definition is intended for - Reference option
 	SubjectReference *Reference `json:"subjectReference,omitempty" fhir:"cardinality=0..1,choice=subject"`
 	// Type of individual the activity definition is intended for - canonical option
-	SubjectCanonical *string `json:"subjectCanonical,omitempty" fhir:"cardinality=0..1,choice=subject"`
+	SubjectCanonical *primitives.Canonical `json:"subjectCanonical,omitempty" fhir:"cardinality=0..1,choice=subject"`
 	// Extension for SubjectCanonical
 	SubjectCanonicalExt *primitives.PrimitiveExtension `json:"_subjectCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Date last changed
@@ -163,7 +162,7 @@ type ActivityDefinition struct {
 	// Additional documentation, citations, etc
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty" fhir:"cardinality=0..*"`
 	// Logic used by the activity definition
-	Library []string `json:"library,omitempty" fhir:"cardinality=0..*"`
+	Library []primitives.Canonical `json:"library,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Library
 	LibraryExt *primitives.PrimitiveExtension `json:"_library,omitempty" fhir:"cardinality=0..1"`
 	// Kind of resource
@@ -171,7 +170,7 @@ type ActivityDefinition struct {
 	// Extension for Kind
 	KindExt *primitives.PrimitiveExtension `json:"_kind,omitempty" fhir:"cardinality=0..1"`
 	// What profile the resource needs to conform to
-	Profile *string `json:"profile,omitempty" fhir:"cardinality=0..1"`
+	Profile *primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Detail type of activity
@@ -217,21 +216,270 @@ type ActivityDefinition struct {
 	// What part of body to perform on
 	BodySite []CodeableConcept `json:"bodySite,omitempty" fhir:"cardinality=0..*"`
 	// What specimens are required to perform this action
-	SpecimenRequirement []string `json:"specimenRequirement,omitempty" fhir:"cardinality=0..*"`
+	SpecimenRequirement []primitives.Canonical `json:"specimenRequirement,omitempty" fhir:"cardinality=0..*"`
 	// Extension for SpecimenRequirement
 	SpecimenRequirementExt *primitives.PrimitiveExtension `json:"_specimenRequirement,omitempty" fhir:"cardinality=0..1"`
 	// What observations are required to perform this action
-	ObservationRequirement []string `json:"observationRequirement,omitempty" fhir:"cardinality=0..*"`
+	ObservationRequirement []primitives.Canonical `json:"observationRequirement,omitempty" fhir:"cardinality=0..*"`
 	// Extension for ObservationRequirement
 	ObservationRequirementExt *primitives.PrimitiveExtension `json:"_observationRequirement,omitempty" fhir:"cardinality=0..1"`
 	// What observations must be produced by this action
-	ObservationResultRequirement []string `json:"observationResultRequirement,omitempty" fhir:"cardinality=0..*"`
+	ObservationResultRequirement []primitives.Canonical `json:"observationResultRequirement,omitempty" fhir:"cardinality=0..*"`
 	// Extension for ObservationResultRequirement
 	ObservationResultRequirementExt *primitives.PrimitiveExtension `json:"_observationResultRequirement,omitempty" fhir:"cardinality=0..1"`
 	// Transform to apply the template
-	Transform *string `json:"transform,omitempty" fhir:"cardinality=0..1"`
+	Transform *primitives.Canonical `json:"transform,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Transform
 	TransformExt *primitives.PrimitiveExtension `json:"_transform,omitempty" fhir:"cardinality=0..1"`
 	// Dynamic aspects of the definition
 	DynamicValue []ActivityDefinitionDynamicValue `json:"dynamicValue,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ActivityDefinition", the FHIR type of the resource.
+func (a *ActivityDefinition) ResourceType() string {
+	return ResourceTypeActivityDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (a *ActivityDefinition) VersionAlgorithm() any {
+	switch {
+	case a.VersionAlgorithmString != nil:
+		return a.VersionAlgorithmString
+	case a.VersionAlgorithmCoding != nil:
+		return a.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (a *ActivityDefinition) VersionAlgorithmType() string {
+	switch {
+	case a.VersionAlgorithmString != nil:
+		return "string"
+	case a.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (a *ActivityDefinition) ClearVersionAlgorithm() {
+	a.VersionAlgorithmString = nil
+	a.VersionAlgorithmStringExt = nil
+	a.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (a *ActivityDefinition) SetVersionAlgorithmString(value string) {
+	ext := a.VersionAlgorithmStringExt
+	a.ClearVersionAlgorithm()
+	a.VersionAlgorithmString, a.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (a *ActivityDefinition) SetVersionAlgorithmCoding(value Coding) {
+	a.ClearVersionAlgorithm()
+	a.VersionAlgorithmCoding = &value
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (a *ActivityDefinition) Subject() any {
+	switch {
+	case a.SubjectCodeableConcept != nil:
+		return a.SubjectCodeableConcept
+	case a.SubjectReference != nil:
+		return a.SubjectReference
+	case a.SubjectCanonical != nil:
+		return a.SubjectCanonical
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (a *ActivityDefinition) SubjectType() string {
+	switch {
+	case a.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case a.SubjectReference != nil:
+		return "Reference"
+	case a.SubjectCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (a *ActivityDefinition) ClearSubject() {
+	a.SubjectCodeableConcept = nil
+	a.SubjectReference = nil
+	a.SubjectCanonical = nil
+	a.SubjectCanonicalExt = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (a *ActivityDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	a.ClearSubject()
+	a.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (a *ActivityDefinition) SetSubjectReference(value Reference) {
+	a.ClearSubject()
+	a.SubjectReference = &value
+}
+
+// SetSubjectCanonical sets the canonical option of subject[x], clearing the others.
+func (a *ActivityDefinition) SetSubjectCanonical(value primitives.Canonical) {
+	ext := a.SubjectCanonicalExt
+	a.ClearSubject()
+	a.SubjectCanonical, a.SubjectCanonicalExt = &value, ext
+}
+
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (a *ActivityDefinition) Timing() any {
+	switch {
+	case a.TimingTiming != nil:
+		return a.TimingTiming
+	case a.TimingAge != nil:
+		return a.TimingAge
+	case a.TimingRange != nil:
+		return a.TimingRange
+	case a.TimingDuration != nil:
+		return a.TimingDuration
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "Timing", or "" if none is.
+func (a *ActivityDefinition) TimingType() string {
+	switch {
+	case a.TimingTiming != nil:
+		return "Timing"
+	case a.TimingAge != nil:
+		return "Age"
+	case a.TimingRange != nil:
+		return "Range"
+	case a.TimingDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (a *ActivityDefinition) ClearTiming() {
+	a.TimingTiming = nil
+	a.TimingAge = nil
+	a.TimingRange = nil
+	a.TimingDuration = nil
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingTiming(value Timing) {
+	a.ClearTiming()
+	a.TimingTiming = &value
+}
+
+// SetTimingAge sets the Age option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingAge(value Age) {
+	a.ClearTiming()
+	a.TimingAge = &value
+}
+
+// SetTimingRange sets the Range option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingRange(value Range) {
+	a.ClearTiming()
+	a.TimingRange = &value
+}
+
+// SetTimingDuration sets the Duration option of timing[x], clearing the others.
+func (a *ActivityDefinition) SetTimingDuration(value Duration) {
+	a.ClearTiming()
+	a.TimingDuration = &value
+}
+
+// AsNeeded returns the option of asNeeded[x] that is set, or nil if none is.
+func (a *ActivityDefinition) AsNeeded() any {
+	switch {
+	case a.AsNeededBoolean != nil:
+		return a.AsNeededBoolean
+	case a.AsNeededCodeableConcept != nil:
+		return a.AsNeededCodeableConcept
+	}
+	return nil
+}
+
+// AsNeededType returns the FHIR type of the option of asNeeded[x] that is
+// set, such as "boolean", or "" if none is.
+func (a *ActivityDefinition) AsNeededType() string {
+	switch {
+	case a.AsNeededBoolean != nil:
+		return "boolean"
+	case a.AsNeededCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAsNeeded unsets every option of asNeeded[x], and their extensions.
+func (a *ActivityDefinition) ClearAsNeeded() {
+	a.AsNeededBoolean = nil
+	a.AsNeededBooleanExt = nil
+	a.AsNeededCodeableConcept = nil
+}
+
+// SetAsNeededBoolean sets the boolean option of asNeeded[x], clearing the others.
+func (a *ActivityDefinition) SetAsNeededBoolean(value bool) {
+	ext := a.AsNeededBooleanExt
+	a.ClearAsNeeded()
+	a.AsNeededBoolean, a.AsNeededBooleanExt = &value, ext
+}
+
+// SetAsNeededCodeableConcept sets the CodeableConcept option of asNeeded[x], clearing the others.
+func (a *ActivityDefinition) SetAsNeededCodeableConcept(value CodeableConcept) {
+	a.ClearAsNeeded()
+	a.AsNeededCodeableConcept = &value
+}
+
+// Product returns the option of product[x] that is set, or nil if none is.
+func (a *ActivityDefinition) Product() any {
+	switch {
+	case a.ProductReference != nil:
+		return a.ProductReference
+	case a.ProductCodeableConcept != nil:
+		return a.ProductCodeableConcept
+	}
+	return nil
+}
+
+// ProductType returns the FHIR type of the option of product[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *ActivityDefinition) ProductType() string {
+	switch {
+	case a.ProductReference != nil:
+		return "Reference"
+	case a.ProductCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearProduct unsets every option of product[x], and their extensions.
+func (a *ActivityDefinition) ClearProduct() {
+	a.ProductReference = nil
+	a.ProductCodeableConcept = nil
+}
+
+// SetProductReference sets the Reference option of product[x], clearing the others.
+func (a *ActivityDefinition) SetProductReference(value Reference) {
+	a.ClearProduct()
+	a.ProductReference = &value
+}
+
+// SetProductCodeableConcept sets the CodeableConcept option of product[x], clearing the others.
+func (a *ActivityDefinition) SetProductCodeableConcept(value CodeableConcept) {
+	a.ClearProduct()
+	a.ProductCodeableConcept = &value
+}
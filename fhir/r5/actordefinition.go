@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeActorDefinition = "ActorDefinition"
 
 // ActorDefinition represents a FHIR ActorDefinition.
 type ActorDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this actor definition, represented as a URI (globally unique)
@@ -93,11 +92,59 @@ type ActorDefinition struct {
 	// Extension for Reference
 	ReferenceExt *primitives.PrimitiveExtension `json:"_reference,omitempty" fhir:"cardinality=0..1"`
 	// CapabilityStatement for the actor (if applicable)
-	Capabilities *string `json:"capabilities,omitempty" fhir:"cardinality=0..1"`
+	Capabilities *primitives.Canonical `json:"capabilities,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Capabilities
 	CapabilitiesExt *primitives.PrimitiveExtension `json:"_capabilities,omitempty" fhir:"cardinality=0..1"`
 	// Definition of this actor in another context / IG
-	DerivedFrom []string `json:"derivedFrom,omitempty" fhir:"cardinality=0..*"`
+	DerivedFrom []primitives.Canonical `json:"derivedFrom,omitempty" fhir:"cardinality=0..*"`
 	// Extension for DerivedFrom
 	DerivedFromExt *primitives.PrimitiveExtension `json:"_derivedFrom,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ActorDefinition", the FHIR type of the resource.
+func (a *ActorDefinition) ResourceType() string {
+	return ResourceTypeActorDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (a *ActorDefinition) VersionAlgorithm() any {
+	switch {
+	case a.VersionAlgorithmString != nil:
+		return a.VersionAlgorithmString
+	case a.VersionAlgorithmCoding != nil:
+		return a.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (a *ActorDefinition) VersionAlgorithmType() string {
+	switch {
+	case a.VersionAlgorithmString != nil:
+		return "string"
+	case a.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (a *ActorDefinition) ClearVersionAlgorithm() {
+	a.VersionAlgorithmString = nil
+	a.VersionAlgorithmStringExt = nil
+	a.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (a *ActorDefinition) SetVersionAlgorithmString(value string) {
+	ext := a.VersionAlgorithmStringExt
+	a.ClearVersionAlgorithm()
+	a.VersionAlgorithmString, a.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (a *ActorDefinition) SetVersionAlgorithmCoding(value Coding) {
+	a.ClearVersionAlgorithm()
+	a.VersionAlgorithmCoding = &value
+}
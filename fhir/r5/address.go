@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,11 +18,11 @@ type Address struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// home | work | temp | old | billing - purpose of this address
-	Use *string `json:"use,omitempty" fhir:"cardinality=0..1,summary"`
+	Use *AddressUse `json:"use,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/address-use|5.0.0,summary,type=code"`
 	// Extension for Use
 	UseExt *primitives.PrimitiveExtension `json:"_use,omitempty" fhir:"cardinality=0..1"`
 	// postal | physical | both
-	Type *string `json:"type,omitempty" fhir:"cardinality=0..1,summary"`
+	Type *AddressType `json:"type,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/address-type|5.0.0,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Text representation of the address
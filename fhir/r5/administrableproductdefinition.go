@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,6 +48,108 @@ type AdministrableProductDefinitionProperty struct {
 	Status *CodeableConcept `json:"status,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (a *AdministrableProductDefinitionProperty) Value() any {
+	switch {
+	case a.ValueCodeableConcept != nil:
+		return a.ValueCodeableConcept
+	case a.ValueQuantity != nil:
+		return a.ValueQuantity
+	case a.ValueDate != nil:
+		return a.ValueDate
+	case a.ValueBoolean != nil:
+		return a.ValueBoolean
+	case a.ValueMarkdown != nil:
+		return a.ValueMarkdown
+	case a.ValueAttachment != nil:
+		return a.ValueAttachment
+	case a.ValueReference != nil:
+		return a.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (a *AdministrableProductDefinitionProperty) ValueType() string {
+	switch {
+	case a.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case a.ValueQuantity != nil:
+		return "Quantity"
+	case a.ValueDate != nil:
+		return "date"
+	case a.ValueBoolean != nil:
+		return "boolean"
+	case a.ValueMarkdown != nil:
+		return "markdown"
+	case a.ValueAttachment != nil:
+		return "Attachment"
+	case a.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (a *AdministrableProductDefinitionProperty) ClearValue() {
+	a.ValueCodeableConcept = nil
+	a.ValueQuantity = nil
+	a.ValueDate = nil
+	a.ValueDateExt = nil
+	a.ValueBoolean = nil
+	a.ValueBooleanExt = nil
+	a.ValueMarkdown = nil
+	a.ValueMarkdownExt = nil
+	a.ValueAttachment = nil
+	a.ValueReference = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueCodeableConcept(value CodeableConcept) {
+	a.ClearValue()
+	a.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueQuantity(value Quantity) {
+	a.ClearValue()
+	a.ValueQuantity = &value
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueDate(value primitives.Date) {
+	ext := a.ValueDateExt
+	a.ClearValue()
+	a.ValueDate, a.ValueDateExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueBoolean(value bool) {
+	ext := a.ValueBooleanExt
+	a.ClearValue()
+	a.ValueBoolean, a.ValueBooleanExt = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueMarkdown(value string) {
+	ext := a.ValueMarkdownExt
+	a.ClearValue()
+	a.ValueMarkdown, a.ValueMarkdownExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueAttachment(value Attachment) {
+	a.ClearValue()
+	a.ValueAttachment = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (a *AdministrableProductDefinitionProperty) SetValueReference(value Reference) {
+	a.ClearValue()
+	a.ValueReference = &value
+}
+
 // AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod represents a FHIR BackboneElement for AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.
 type AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod struct {
 	// Unique id for inter-element referencing
@@ -113,7 +214,7 @@ type AdministrableProductDefinitionRouteOfAdministration struct {
 
 // AdministrableProductDefinition represents a FHIR AdministrableProductDefinition.
 type AdministrableProductDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// An identifier for the administrable product
@@ -143,3 +244,8 @@ type AdministrableProductDefinition struct {
 	// The path by which the product is taken into or makes contact with the body
 	RouteOfAdministration []AdministrableProductDefinitionRouteOfAdministration `json:"routeOfAdministration,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "AdministrableProductDefinition", the FHIR type of the resource.
+func (a *AdministrableProductDefinition) ResourceType() string {
+	return ResourceTypeAdministrableProductDefinition
+}
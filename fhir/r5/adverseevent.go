@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -58,13 +57,54 @@ type AdverseEventSuspectEntity struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Refers to the specific entity that caused the adverse event - CodeableConcept option
-	InstanceCodeableConcept CodeableConcept `json:"instanceCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=instance"`
+	InstanceCodeableConcept *CodeableConcept `json:"instanceCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=instance"`
 	// Refers to the specific entity that caused the adverse event - Reference option
-	InstanceReference Reference `json:"instanceReference" fhir:"cardinality=1..1,required,summary,choice=instance"`
+	InstanceReference *Reference `json:"instanceReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=instance"`
 	// Information on the possible cause of the event
 	Causality *AdverseEventSuspectEntityCausality `json:"causality,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
+// Instance returns the option of instance[x] that is set, or nil if none is.
+func (a *AdverseEventSuspectEntity) Instance() any {
+	switch {
+	case a.InstanceCodeableConcept != nil:
+		return a.InstanceCodeableConcept
+	case a.InstanceReference != nil:
+		return a.InstanceReference
+	}
+	return nil
+}
+
+// InstanceType returns the FHIR type of the option of instance[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (a *AdverseEventSuspectEntity) InstanceType() string {
+	switch {
+	case a.InstanceCodeableConcept != nil:
+		return "CodeableConcept"
+	case a.InstanceReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearInstance unsets every option of instance[x], and their extensions.
+func (a *AdverseEventSuspectEntity) ClearInstance() {
+	a.InstanceCodeableConcept = nil
+	a.InstanceReference = nil
+}
+
+// SetInstanceCodeableConcept sets the CodeableConcept option of instance[x], clearing the others.
+func (a *AdverseEventSuspectEntity) SetInstanceCodeableConcept(value CodeableConcept) {
+	a.ClearInstance()
+	a.InstanceCodeableConcept = &value
+}
+
+// SetInstanceReference sets the Reference option of instance[x], clearing the others.
+func (a *AdverseEventSuspectEntity) SetInstanceReference(value Reference) {
+	a.ClearInstance()
+	a.InstanceReference = &value
+}
+
 // AdverseEventContributingFactor represents a FHIR BackboneElement for AdverseEvent.contributingFactor.
 type AdverseEventContributingFactor struct {
 	// Unique id for inter-element referencing
@@ -76,9 +116,50 @@ type AdverseEventContributingFactor struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Item suspected to have increased the probability or severity of the adverse event - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// Item suspected to have increased the probability or severity of the adverse event - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (a *AdverseEventContributingFactor) Item() any {
+	switch {
+	case a.ItemReference != nil:
+		return a.ItemReference
+	case a.ItemCodeableConcept != nil:
+		return a.ItemCodeableConcept
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *AdverseEventContributingFactor) ItemType() string {
+	switch {
+	case a.ItemReference != nil:
+		return "Reference"
+	case a.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (a *AdverseEventContributingFactor) ClearItem() {
+	a.ItemReference = nil
+	a.ItemCodeableConcept = nil
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (a *AdverseEventContributingFactor) SetItemReference(value Reference) {
+	a.ClearItem()
+	a.ItemReference = &value
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (a *AdverseEventContributingFactor) SetItemCodeableConcept(value CodeableConcept) {
+	a.ClearItem()
+	a.ItemCodeableConcept = &value
 }
 
 // AdverseEventPreventiveAction represents a FHIR BackboneElement for AdverseEvent.preventiveAction.
@@ -92,9 +173,50 @@ type AdverseEventPreventiveAction struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Action that contributed to avoiding the adverse event - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// Action that contributed to avoiding the adverse event - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (a *AdverseEventPreventiveAction) Item() any {
+	switch {
+	case a.ItemReference != nil:
+		return a.ItemReference
+	case a.ItemCodeableConcept != nil:
+		return a.ItemCodeableConcept
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *AdverseEventPreventiveAction) ItemType() string {
+	switch {
+	case a.ItemReference != nil:
+		return "Reference"
+	case a.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (a *AdverseEventPreventiveAction) ClearItem() {
+	a.ItemReference = nil
+	a.ItemCodeableConcept = nil
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (a *AdverseEventPreventiveAction) SetItemReference(value Reference) {
+	a.ClearItem()
+	a.ItemReference = &value
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (a *AdverseEventPreventiveAction) SetItemCodeableConcept(value CodeableConcept) {
+	a.ClearItem()
+	a.ItemCodeableConcept = &value
 }
 
 // AdverseEventMitigatingAction represents a FHIR BackboneElement for AdverseEvent.mitigatingAction.
@@ -108,9 +230,50 @@ type AdverseEventMitigatingAction struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Ameliorating action taken after the adverse event occured in order to reduce the extent of harm - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// Ameliorating action taken after the adverse event occured in order to reduce the extent of harm - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (a *AdverseEventMitigatingAction) Item() any {
+	switch {
+	case a.ItemReference != nil:
+		return a.ItemReference
+	case a.ItemCodeableConcept != nil:
+		return a.ItemCodeableConcept
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *AdverseEventMitigatingAction) ItemType() string {
+	switch {
+	case a.ItemReference != nil:
+		return "Reference"
+	case a.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (a *AdverseEventMitigatingAction) ClearItem() {
+	a.ItemReference = nil
+	a.ItemCodeableConcept = nil
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (a *AdverseEventMitigatingAction) SetItemReference(value Reference) {
+	a.ClearItem()
+	a.ItemReference = &value
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (a *AdverseEventMitigatingAction) SetItemCodeableConcept(value CodeableConcept) {
+	a.ClearItem()
+	a.ItemCodeableConcept = &value
 }
 
 // AdverseEventSupportingInfo represents a FHIR BackboneElement for AdverseEvent.supportingInfo.
@@ -124,14 +287,55 @@ type AdverseEventSupportingInfo struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Subject medical history or document relevant to this adverse event - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// Subject medical history or document relevant to this adverse event - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (a *AdverseEventSupportingInfo) Item() any {
+	switch {
+	case a.ItemReference != nil:
+		return a.ItemReference
+	case a.ItemCodeableConcept != nil:
+		return a.ItemCodeableConcept
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *AdverseEventSupportingInfo) ItemType() string {
+	switch {
+	case a.ItemReference != nil:
+		return "Reference"
+	case a.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (a *AdverseEventSupportingInfo) ClearItem() {
+	a.ItemReference = nil
+	a.ItemCodeableConcept = nil
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (a *AdverseEventSupportingInfo) SetItemReference(value Reference) {
+	a.ClearItem()
+	a.ItemReference = &value
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (a *AdverseEventSupportingInfo) SetItemCodeableConcept(value CodeableConcept) {
+	a.ClearItem()
+	a.ItemCodeableConcept = &value
 }
 
 // AdverseEvent represents a FHIR AdverseEvent.
 type AdverseEvent struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for the event
@@ -199,3 +403,62 @@ type AdverseEvent struct {
 	// Comment on adverse event
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "AdverseEvent", the FHIR type of the resource.
+func (a *AdverseEvent) ResourceType() string {
+	return ResourceTypeAdverseEvent
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (a *AdverseEvent) Occurrence() any {
+	switch {
+	case a.OccurrenceDateTime != nil:
+		return a.OccurrenceDateTime
+	case a.OccurrencePeriod != nil:
+		return a.OccurrencePeriod
+	case a.OccurrenceTiming != nil:
+		return a.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (a *AdverseEvent) OccurrenceType() string {
+	switch {
+	case a.OccurrenceDateTime != nil:
+		return "dateTime"
+	case a.OccurrencePeriod != nil:
+		return "Period"
+	case a.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (a *AdverseEvent) ClearOccurrence() {
+	a.OccurrenceDateTime = nil
+	a.OccurrenceDateTimeExt = nil
+	a.OccurrencePeriod = nil
+	a.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (a *AdverseEvent) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := a.OccurrenceDateTimeExt
+	a.ClearOccurrence()
+	a.OccurrenceDateTime, a.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (a *AdverseEvent) SetOccurrencePeriod(value Period) {
+	a.ClearOccurrence()
+	a.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (a *AdverseEvent) SetOccurrenceTiming(value Timing) {
+	a.ClearOccurrence()
+	a.OccurrenceTiming = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -63,7 +62,7 @@ type AllergyIntoleranceReaction struct {
 
 // AllergyIntolerance represents a FHIR AllergyIntolerance.
 type AllergyIntolerance struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External ids for this item
@@ -117,3 +116,86 @@ type AllergyIntolerance struct {
 	// Adverse Reaction Events linked to exposure to substance
 	Reaction []AllergyIntoleranceReaction `json:"reaction,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "AllergyIntolerance", the FHIR type of the resource.
+func (a *AllergyIntolerance) ResourceType() string {
+	return ResourceTypeAllergyIntolerance
+}
+
+// Onset returns the option of onset[x] that is set, or nil if none is.
+func (a *AllergyIntolerance) Onset() any {
+	switch {
+	case a.OnsetDateTime != nil:
+		return a.OnsetDateTime
+	case a.OnsetAge != nil:
+		return a.OnsetAge
+	case a.OnsetPeriod != nil:
+		return a.OnsetPeriod
+	case a.OnsetRange != nil:
+		return a.OnsetRange
+	case a.OnsetString != nil:
+		return a.OnsetString
+	}
+	return nil
+}
+
+// OnsetType returns the FHIR type of the option of onset[x] that is
+// set, such as "dateTime", or "" if none is.
+func (a *AllergyIntolerance) OnsetType() string {
+	switch {
+	case a.OnsetDateTime != nil:
+		return "dateTime"
+	case a.OnsetAge != nil:
+		return "Age"
+	case a.OnsetPeriod != nil:
+		return "Period"
+	case a.OnsetRange != nil:
+		return "Range"
+	case a.OnsetString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearOnset unsets every option of onset[x], and their extensions.
+func (a *AllergyIntolerance) ClearOnset() {
+	a.OnsetDateTime = nil
+	a.OnsetDateTimeExt = nil
+	a.OnsetAge = nil
+	a.OnsetPeriod = nil
+	a.OnsetRange = nil
+	a.OnsetString = nil
+	a.OnsetStringExt = nil
+}
+
+// SetOnsetDateTime sets the dateTime option of onset[x], clearing the others.
+func (a *AllergyIntolerance) SetOnsetDateTime(value primitives.DateTime) {
+	ext := a.OnsetDateTimeExt
+	a.ClearOnset()
+	a.OnsetDateTime, a.OnsetDateTimeExt = &value, ext
+}
+
+// SetOnsetAge sets the Age option of onset[x], clearing the others.
+func (a *AllergyIntolerance) SetOnsetAge(value Age) {
+	a.ClearOnset()
+	a.OnsetAge = &value
+}
+
+// SetOnsetPeriod sets the Period option of onset[x], clearing the others.
+func (a *AllergyIntolerance) SetOnsetPeriod(value Period) {
+	a.ClearOnset()
+	a.OnsetPeriod = &value
+}
+
+// SetOnsetRange sets the Range option of onset[x], clearing the others.
+func (a *AllergyIntolerance) SetOnsetRange(value Range) {
+	a.ClearOnset()
+	a.OnsetRange = &value
+}
+
+// SetOnsetString sets the string option of onset[x], clearing the others.
+func (a *AllergyIntolerance) SetOnsetString(value string) {
+	ext := a.OnsetStringExt
+	a.ClearOnset()
+	a.OnsetString, a.OnsetStringExt = &value, ext
+}
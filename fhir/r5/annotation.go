@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -28,7 +28,50 @@ type Annotation struct {
 	// Extension for Time
 	TimeExt *primitives.PrimitiveExtension `json:"_time,omitempty" fhir:"cardinality=0..1"`
 	// The annotation  - text content (as markdown)
-	Text string `json:"text" fhir:"cardinality=1..1,required,summary"`
+	Text string `json:"text" fhir:"cardinality=1..1,required,summary,type=markdown"`
 	// Extension for Text
 	TextExt *primitives.PrimitiveExtension `json:"_text,omitempty" fhir:"cardinality=0..1"`
 }
+
+// Author returns the option of author[x] that is set, or nil if none is.
+func (a *Annotation) Author() any {
+	switch {
+	case a.AuthorReference != nil:
+		return a.AuthorReference
+	case a.AuthorString != nil:
+		return a.AuthorString
+	}
+	return nil
+}
+
+// AuthorType returns the FHIR type of the option of author[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *Annotation) AuthorType() string {
+	switch {
+	case a.AuthorReference != nil:
+		return "Reference"
+	case a.AuthorString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAuthor unsets every option of author[x], and their extensions.
+func (a *Annotation) ClearAuthor() {
+	a.AuthorReference = nil
+	a.AuthorString = nil
+	a.AuthorStringExt = nil
+}
+
+// SetAuthorReference sets the Reference option of author[x], clearing the others.
+func (a *Annotation) SetAuthorReference(value Reference) {
+	a.ClearAuthor()
+	a.AuthorReference = &value
+}
+
+// SetAuthorString sets the string option of author[x], clearing the others.
+func (a *Annotation) SetAuthorString(value string) {
+	ext := a.AuthorStringExt
+	a.ClearAuthor()
+	a.AuthorString, a.AuthorStringExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -167,7 +166,7 @@ type AppointmentRecurrenceTemplate struct {
 
 // Appointment represents a FHIR Appointment.
 type Appointment struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this item
@@ -253,3 +252,8 @@ type Appointment struct {
 	// Details of the recurrence pattern/template used to generate occurrences
 	RecurrenceTemplate []AppointmentRecurrenceTemplate `json:"recurrenceTemplate,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Appointment", the FHIR type of the resource.
+func (a *Appointment) ResourceType() string {
+	return ResourceTypeAppointment
+}
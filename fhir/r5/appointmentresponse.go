@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeAppointmentResponse = "AppointmentResponse"
 
 // AppointmentResponse represents a FHIR AppointmentResponse.
 type AppointmentResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this item
@@ -59,3 +58,8 @@ type AppointmentResponse struct {
 	// Extension for RecurrenceId
 	RecurrenceIdExt *primitives.PrimitiveExtension `json:"_recurrenceId,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "AppointmentResponse", the FHIR type of the resource.
+func (a *AppointmentResponse) ResourceType() string {
+	return ResourceTypeAppointmentResponse
+}
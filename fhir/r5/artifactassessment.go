@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -59,7 +58,7 @@ type ArtifactAssessmentContent struct {
 
 // ArtifactAssessment represents a FHIR ArtifactAssessment.
 type ArtifactAssessment struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Additional identifier for the artifact assessment
@@ -91,15 +90,15 @@ type ArtifactAssessment struct {
 	// Extension for LastReviewDate
 	LastReviewDateExt *primitives.PrimitiveExtension `json:"_lastReviewDate,omitempty" fhir:"cardinality=0..1"`
 	// The artifact assessed, commented upon or rated - Reference option
-	ArtifactReference Reference `json:"artifactReference" fhir:"cardinality=1..1,required,summary,choice=artifact"`
+	ArtifactReference *Reference `json:"artifactReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=artifact"`
 	// The artifact assessed, commented upon or rated - canonical option
-	ArtifactCanonical string `json:"artifactCanonical" fhir:"cardinality=1..1,required,summary,choice=artifact"`
+	ArtifactCanonical *primitives.Canonical `json:"artifactCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=artifact"`
 	// Extension for ArtifactCanonical
 	ArtifactCanonicalExt *primitives.PrimitiveExtension `json:"_artifactCanonical,omitempty" fhir:"cardinality=0..1"`
 	// The artifact assessed, commented upon or rated - uri option
-	ArtifactURI string `json:"artifactURI" fhir:"cardinality=1..1,required,summary,choice=artifact"`
+	ArtifactURI *string `json:"artifactUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=artifact"`
 	// Extension for ArtifactURI
-	ArtifactURIExt *primitives.PrimitiveExtension `json:"_artifactURI,omitempty" fhir:"cardinality=0..1"`
+	ArtifactURIExt *primitives.PrimitiveExtension `json:"_artifactUri,omitempty" fhir:"cardinality=0..1"`
 	// Comment, classifier, or rating content
 	Content []ArtifactAssessmentContent `json:"content,omitempty" fhir:"cardinality=0..*"`
 	// submitted | triaged | waiting-for-input | resolved-no-change | resolved-change-required | deferred | duplicate | applied | published | entered-in-error
@@ -111,3 +110,107 @@ type ArtifactAssessment struct {
 	// Extension for Disposition
 	DispositionExt *primitives.PrimitiveExtension `json:"_disposition,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ArtifactAssessment", the FHIR type of the resource.
+func (a *ArtifactAssessment) ResourceType() string {
+	return ResourceTypeArtifactAssessment
+}
+
+// CiteAs returns the option of citeAs[x] that is set, or nil if none is.
+func (a *ArtifactAssessment) CiteAs() any {
+	switch {
+	case a.CiteAsReference != nil:
+		return a.CiteAsReference
+	case a.CiteAsMarkdown != nil:
+		return a.CiteAsMarkdown
+	}
+	return nil
+}
+
+// CiteAsType returns the FHIR type of the option of citeAs[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *ArtifactAssessment) CiteAsType() string {
+	switch {
+	case a.CiteAsReference != nil:
+		return "Reference"
+	case a.CiteAsMarkdown != nil:
+		return "markdown"
+	}
+	return ""
+}
+
+// ClearCiteAs unsets every option of citeAs[x], and their extensions.
+func (a *ArtifactAssessment) ClearCiteAs() {
+	a.CiteAsReference = nil
+	a.CiteAsMarkdown = nil
+	a.CiteAsMarkdownExt = nil
+}
+
+// SetCiteAsReference sets the Reference option of citeAs[x], clearing the others.
+func (a *ArtifactAssessment) SetCiteAsReference(value Reference) {
+	a.ClearCiteAs()
+	a.CiteAsReference = &value
+}
+
+// SetCiteAsMarkdown sets the markdown option of citeAs[x], clearing the others.
+func (a *ArtifactAssessment) SetCiteAsMarkdown(value string) {
+	ext := a.CiteAsMarkdownExt
+	a.ClearCiteAs()
+	a.CiteAsMarkdown, a.CiteAsMarkdownExt = &value, ext
+}
+
+// Artifact returns the option of artifact[x] that is set, or nil if none is.
+func (a *ArtifactAssessment) Artifact() any {
+	switch {
+	case a.ArtifactReference != nil:
+		return a.ArtifactReference
+	case a.ArtifactCanonical != nil:
+		return a.ArtifactCanonical
+	case a.ArtifactURI != nil:
+		return a.ArtifactURI
+	}
+	return nil
+}
+
+// ArtifactType returns the FHIR type of the option of artifact[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *ArtifactAssessment) ArtifactType() string {
+	switch {
+	case a.ArtifactReference != nil:
+		return "Reference"
+	case a.ArtifactCanonical != nil:
+		return "canonical"
+	case a.ArtifactURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearArtifact unsets every option of artifact[x], and their extensions.
+func (a *ArtifactAssessment) ClearArtifact() {
+	a.ArtifactReference = nil
+	a.ArtifactCanonical = nil
+	a.ArtifactCanonicalExt = nil
+	a.ArtifactURI = nil
+	a.ArtifactURIExt = nil
+}
+
+// SetArtifactReference sets the Reference option of artifact[x], clearing the others.
+func (a *ArtifactAssessment) SetArtifactReference(value Reference) {
+	a.ClearArtifact()
+	a.ArtifactReference = &value
+}
+
+// SetArtifactCanonical sets the canonical option of artifact[x], clearing the others.
+func (a *ArtifactAssessment) SetArtifactCanonical(value primitives.Canonical) {
+	ext := a.ArtifactCanonicalExt
+	a.ClearArtifact()
+	a.ArtifactCanonical, a.ArtifactCanonicalExt = &value, ext
+}
+
+// SetArtifactURI sets the uri option of artifact[x], clearing the others.
+func (a *ArtifactAssessment) SetArtifactURI(value string) {
+	ext := a.ArtifactURIExt
+	a.ClearArtifact()
+	a.ArtifactURI, a.ArtifactURIExt = &value, ext
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,27 +18,27 @@ type Attachment struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Mime type of the content, with charset etc.
-	ContentType *string `json:"contentType,omitempty" fhir:"cardinality=0..1,summary"`
+	ContentType *string `json:"contentType,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/mimetypes|5.0.0,summary,type=code"`
 	// Extension for ContentType
 	ContentTypeExt *primitives.PrimitiveExtension `json:"_contentType,omitempty" fhir:"cardinality=0..1"`
 	// Human language of the content (BCP-47)
-	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,summary"`
+	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/all-languages|5.0.0,summary,type=code"`
 	// Extension for Language
 	LanguageExt *primitives.PrimitiveExtension `json:"_language,omitempty" fhir:"cardinality=0..1"`
 	// Data inline, base64ed
-	Data *string `json:"data,omitempty" fhir:"cardinality=0..1"`
+	Data *primitives.Base64Binary `json:"data,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Data
 	DataExt *primitives.PrimitiveExtension `json:"_data,omitempty" fhir:"cardinality=0..1"`
 	// Uri where the data can be found
-	URL *string `json:"url,omitempty" fhir:"cardinality=0..1,summary"`
+	URL *string `json:"url,omitempty" fhir:"cardinality=0..1,summary,type=url"`
 	// Extension for URL
 	URLExt *primitives.PrimitiveExtension `json:"_url,omitempty" fhir:"cardinality=0..1"`
 	// Number of bytes of content (if url provided)
-	Size *int64 `json:"size,omitempty" fhir:"cardinality=0..1,summary"`
+	Size *primitives.Integer64 `json:"size,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Size
 	SizeExt *primitives.PrimitiveExtension `json:"_size,omitempty" fhir:"cardinality=0..1"`
 	// Hash of the data (sha-1, base64ed)
-	Hash *string `json:"hash,omitempty" fhir:"cardinality=0..1,summary"`
+	Hash *primitives.Base64Binary `json:"hash,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Hash
 	HashExt *primitives.PrimitiveExtension `json:"_hash,omitempty" fhir:"cardinality=0..1"`
 	// Label to display in place of the data
@@ -50,23 +50,23 @@ type Attachment struct {
 	// Extension for Creation
 	CreationExt *primitives.PrimitiveExtension `json:"_creation,omitempty" fhir:"cardinality=0..1"`
 	// Height of the image in pixels (photo/video)
-	Height *int `json:"height,omitempty" fhir:"cardinality=0..1"`
+	Height *int `json:"height,omitempty" fhir:"cardinality=0..1,type=positiveInt"`
 	// Extension for Height
 	HeightExt *primitives.PrimitiveExtension `json:"_height,omitempty" fhir:"cardinality=0..1"`
 	// Width of the image in pixels (photo/video)
-	Width *int `json:"width,omitempty" fhir:"cardinality=0..1"`
+	Width *int `json:"width,omitempty" fhir:"cardinality=0..1,type=positiveInt"`
 	// Extension for Width
 	WidthExt *primitives.PrimitiveExtension `json:"_width,omitempty" fhir:"cardinality=0..1"`
 	// Number of frames if > 1 (photo)
-	Frames *int `json:"frames,omitempty" fhir:"cardinality=0..1"`
+	Frames *int `json:"frames,omitempty" fhir:"cardinality=0..1,type=positiveInt"`
 	// Extension for Frames
 	FramesExt *primitives.PrimitiveExtension `json:"_frames,omitempty" fhir:"cardinality=0..1"`
 	// Length in seconds (audio / video)
-	Duration *float64 `json:"duration,omitempty" fhir:"cardinality=0..1"`
+	Duration *primitives.Decimal `json:"duration,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Duration
 	DurationExt *primitives.PrimitiveExtension `json:"_duration,omitempty" fhir:"cardinality=0..1"`
 	// Number of printed pages
-	Pages *int `json:"pages,omitempty" fhir:"cardinality=0..1"`
+	Pages *int `json:"pages,omitempty" fhir:"cardinality=0..1,type=positiveInt"`
 	// Extension for Pages
 	PagesExt *primitives.PrimitiveExtension `json:"_pages,omitempty" fhir:"cardinality=0..1"`
 }
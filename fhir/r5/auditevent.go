@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -58,9 +57,9 @@ type AuditEventAgent struct {
 	// This agent network location for the activity - Reference option
 	NetworkReference *Reference `json:"networkReference,omitempty" fhir:"cardinality=0..1,choice=network"`
 	// This agent network location for the activity - uri option
-	NetworkURI *string `json:"networkURI,omitempty" fhir:"cardinality=0..1,choice=network"`
+	NetworkURI *string `json:"networkUri,omitempty" fhir:"cardinality=0..1,choice=network"`
 	// Extension for NetworkURI
-	NetworkURIExt *primitives.PrimitiveExtension `json:"_networkURI,omitempty" fhir:"cardinality=0..1"`
+	NetworkURIExt *primitives.PrimitiveExtension `json:"_networkUri,omitempty" fhir:"cardinality=0..1"`
 	// This agent network location for the activity - string option
 	NetworkString *string `json:"networkString,omitempty" fhir:"cardinality=0..1,choice=network"`
 	// Extension for NetworkString
@@ -69,6 +68,62 @@ type AuditEventAgent struct {
 	Authorization []CodeableConcept `json:"authorization,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Network returns the option of network[x] that is set, or nil if none is.
+func (a *AuditEventAgent) Network() any {
+	switch {
+	case a.NetworkReference != nil:
+		return a.NetworkReference
+	case a.NetworkURI != nil:
+		return a.NetworkURI
+	case a.NetworkString != nil:
+		return a.NetworkString
+	}
+	return nil
+}
+
+// NetworkType returns the FHIR type of the option of network[x] that is
+// set, such as "Reference", or "" if none is.
+func (a *AuditEventAgent) NetworkType() string {
+	switch {
+	case a.NetworkReference != nil:
+		return "Reference"
+	case a.NetworkURI != nil:
+		return "uri"
+	case a.NetworkString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearNetwork unsets every option of network[x], and their extensions.
+func (a *AuditEventAgent) ClearNetwork() {
+	a.NetworkReference = nil
+	a.NetworkURI = nil
+	a.NetworkURIExt = nil
+	a.NetworkString = nil
+	a.NetworkStringExt = nil
+}
+
+// SetNetworkReference sets the Reference option of network[x], clearing the others.
+func (a *AuditEventAgent) SetNetworkReference(value Reference) {
+	a.ClearNetwork()
+	a.NetworkReference = &value
+}
+
+// SetNetworkURI sets the uri option of network[x], clearing the others.
+func (a *AuditEventAgent) SetNetworkURI(value string) {
+	ext := a.NetworkURIExt
+	a.ClearNetwork()
+	a.NetworkURI, a.NetworkURIExt = &value, ext
+}
+
+// SetNetworkString sets the string option of network[x], clearing the others.
+func (a *AuditEventAgent) SetNetworkString(value string) {
+	ext := a.NetworkStringExt
+	a.ClearNetwork()
+	a.NetworkString, a.NetworkStringExt = &value, ext
+}
+
 // AuditEventSource represents a FHIR BackboneElement for AuditEvent.source.
 type AuditEventSource struct {
 	// Unique id for inter-element referencing
@@ -100,41 +155,193 @@ type AuditEventEntityDetail struct {
 	// Name of the property
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Property value - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property value - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property value - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Property value - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Property value - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Property value - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property value - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property value - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Property value - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Property value - Period option
-	ValuePeriod Period `json:"valuePeriod" fhir:"cardinality=1..1,required,choice=value"`
+	ValuePeriod *Period `json:"valuePeriod,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property value - base64Binary option
-	ValueBase64Binary string `json:"valueBase64Binary" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (a *AuditEventEntityDetail) Value() any {
+	switch {
+	case a.ValueQuantity != nil:
+		return a.ValueQuantity
+	case a.ValueCodeableConcept != nil:
+		return a.ValueCodeableConcept
+	case a.ValueString != nil:
+		return a.ValueString
+	case a.ValueBoolean != nil:
+		return a.ValueBoolean
+	case a.ValueInteger != nil:
+		return a.ValueInteger
+	case a.ValueRange != nil:
+		return a.ValueRange
+	case a.ValueRatio != nil:
+		return a.ValueRatio
+	case a.ValueTime != nil:
+		return a.ValueTime
+	case a.ValueDateTime != nil:
+		return a.ValueDateTime
+	case a.ValuePeriod != nil:
+		return a.ValuePeriod
+	case a.ValueBase64Binary != nil:
+		return a.ValueBase64Binary
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (a *AuditEventEntityDetail) ValueType() string {
+	switch {
+	case a.ValueQuantity != nil:
+		return "Quantity"
+	case a.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case a.ValueString != nil:
+		return "string"
+	case a.ValueBoolean != nil:
+		return "boolean"
+	case a.ValueInteger != nil:
+		return "integer"
+	case a.ValueRange != nil:
+		return "Range"
+	case a.ValueRatio != nil:
+		return "Ratio"
+	case a.ValueTime != nil:
+		return "time"
+	case a.ValueDateTime != nil:
+		return "dateTime"
+	case a.ValuePeriod != nil:
+		return "Period"
+	case a.ValueBase64Binary != nil:
+		return "base64Binary"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (a *AuditEventEntityDetail) ClearValue() {
+	a.ValueQuantity = nil
+	a.ValueCodeableConcept = nil
+	a.ValueString = nil
+	a.ValueStringExt = nil
+	a.ValueBoolean = nil
+	a.ValueBooleanExt = nil
+	a.ValueInteger = nil
+	a.ValueIntegerExt = nil
+	a.ValueRange = nil
+	a.ValueRatio = nil
+	a.ValueTime = nil
+	a.ValueTimeExt = nil
+	a.ValueDateTime = nil
+	a.ValueDateTimeExt = nil
+	a.ValuePeriod = nil
+	a.ValueBase64Binary = nil
+	a.ValueBase64BinaryExt = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueQuantity(value Quantity) {
+	a.ClearValue()
+	a.ValueQuantity = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueCodeableConcept(value CodeableConcept) {
+	a.ClearValue()
+	a.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueString(value string) {
+	ext := a.ValueStringExt
+	a.ClearValue()
+	a.ValueString, a.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueBoolean(value bool) {
+	ext := a.ValueBooleanExt
+	a.ClearValue()
+	a.ValueBoolean, a.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueInteger(value int) {
+	ext := a.ValueIntegerExt
+	a.ClearValue()
+	a.ValueInteger, a.ValueIntegerExt = &value, ext
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueRange(value Range) {
+	a.ClearValue()
+	a.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueRatio(value Ratio) {
+	a.ClearValue()
+	a.ValueRatio = &value
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueTime(value primitives.Time) {
+	ext := a.ValueTimeExt
+	a.ClearValue()
+	a.ValueTime, a.ValueTimeExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueDateTime(value primitives.DateTime) {
+	ext := a.ValueDateTimeExt
+	a.ClearValue()
+	a.ValueDateTime, a.ValueDateTimeExt = &value, ext
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValuePeriod(value Period) {
+	a.ClearValue()
+	a.ValuePeriod = &value
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (a *AuditEventEntityDetail) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := a.ValueBase64BinaryExt
+	a.ClearValue()
+	a.ValueBase64Binary, a.ValueBase64BinaryExt = &value, ext
+}
+
 // AuditEventEntityAgent represents a FHIR BackboneElement for AuditEvent.entity.agent.
 type AuditEventEntityAgent struct {
 }
@@ -156,7 +363,7 @@ type AuditEventEntity struct {
 	// Security labels on the entity
 	SecurityLabel []CodeableConcept `json:"securityLabel,omitempty" fhir:"cardinality=0..*"`
 	// Query parameters
-	Query *string `json:"query,omitempty" fhir:"cardinality=0..1,summary"`
+	Query *primitives.Base64Binary `json:"query,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Query
 	QueryExt *primitives.PrimitiveExtension `json:"_query,omitempty" fhir:"cardinality=0..1"`
 	// Additional Information about the entity
@@ -167,7 +374,7 @@ type AuditEventEntity struct {
 
 // AuditEvent represents a FHIR AuditEvent.
 type AuditEvent struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Type/identifier of event
@@ -209,3 +416,51 @@ type AuditEvent struct {
 	// Data or objects used
 	Entity []AuditEventEntity `json:"entity,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "AuditEvent", the FHIR type of the resource.
+func (a *AuditEvent) ResourceType() string {
+	return ResourceTypeAuditEvent
+}
+
+// Occurred returns the option of occurred[x] that is set, or nil if none is.
+func (a *AuditEvent) Occurred() any {
+	switch {
+	case a.OccurredPeriod != nil:
+		return a.OccurredPeriod
+	case a.OccurredDateTime != nil:
+		return a.OccurredDateTime
+	}
+	return nil
+}
+
+// OccurredType returns the FHIR type of the option of occurred[x] that is
+// set, such as "Period", or "" if none is.
+func (a *AuditEvent) OccurredType() string {
+	switch {
+	case a.OccurredPeriod != nil:
+		return "Period"
+	case a.OccurredDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearOccurred unsets every option of occurred[x], and their extensions.
+func (a *AuditEvent) ClearOccurred() {
+	a.OccurredPeriod = nil
+	a.OccurredDateTime = nil
+	a.OccurredDateTimeExt = nil
+}
+
+// SetOccurredPeriod sets the Period option of occurred[x], clearing the others.
+func (a *AuditEvent) SetOccurredPeriod(value Period) {
+	a.ClearOccurred()
+	a.OccurredPeriod = &value
+}
+
+// SetOccurredDateTime sets the dateTime option of occurred[x], clearing the others.
+func (a *AuditEvent) SetOccurredDateTime(value primitives.DateTime) {
+	ext := a.OccurredDateTimeExt
+	a.ClearOccurred()
+	a.OccurredDateTime, a.OccurredDateTimeExt = &value, ext
+}
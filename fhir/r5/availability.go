@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,7 +18,7 @@ type AvailabilityAvailableTime struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// mon | tue | wed | thu | fri | sat | sun
-	DaysOfWeek []string `json:"daysOfWeek,omitempty" fhir:"cardinality=0..*,summary"`
+	DaysOfWeek []DaysOfWeek `json:"daysOfWeek,omitempty" fhir:"cardinality=0..*,binding=required:http://hl7.org/fhir/ValueSet/days-of-week|5.0.0,summary,type=code"`
 	// Extension for DaysOfWeek
 	DaysOfWeekExt *primitives.PrimitiveExtension `json:"_daysOfWeek,omitempty" fhir:"cardinality=0..1"`
 	// Always available? i.e. 24 hour service
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeBinary = "Binary"
 
 // Binary represents a FHIR Binary.
 type Binary struct {
-	fhir.Resource
+	BaseResource
 	// MimeType of the binary content
 	ContentType string `json:"contentType" fhir:"cardinality=1..1,required,summary"`
 	// Extension for ContentType
@@ -23,7 +22,12 @@ type Binary struct {
 	// Identifies another resource to use as proxy when enforcing access control
 	SecurityContext *Reference `json:"securityContext,omitempty" fhir:"cardinality=0..1,summary"`
 	// The actual content
-	Data *string `json:"data,omitempty" fhir:"cardinality=0..1"`
+	Data *primitives.Base64Binary `json:"data,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Data
 	DataExt *primitives.PrimitiveExtension `json:"_data,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Binary", the FHIR type of the resource.
+func (b *Binary) ResourceType() string {
+	return ResourceTypeBinary
+}
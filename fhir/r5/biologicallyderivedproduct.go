@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,6 +34,49 @@ type BiologicallyDerivedProductCollection struct {
 	CollectedPeriod *Period `json:"collectedPeriod,omitempty" fhir:"cardinality=0..1,choice=collected"`
 }
 
+// Collected returns the option of collected[x] that is set, or nil if none is.
+func (b *BiologicallyDerivedProductCollection) Collected() any {
+	switch {
+	case b.CollectedDateTime != nil:
+		return b.CollectedDateTime
+	case b.CollectedPeriod != nil:
+		return b.CollectedPeriod
+	}
+	return nil
+}
+
+// CollectedType returns the FHIR type of the option of collected[x] that is
+// set, such as "dateTime", or "" if none is.
+func (b *BiologicallyDerivedProductCollection) CollectedType() string {
+	switch {
+	case b.CollectedDateTime != nil:
+		return "dateTime"
+	case b.CollectedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearCollected unsets every option of collected[x], and their extensions.
+func (b *BiologicallyDerivedProductCollection) ClearCollected() {
+	b.CollectedDateTime = nil
+	b.CollectedDateTimeExt = nil
+	b.CollectedPeriod = nil
+}
+
+// SetCollectedDateTime sets the dateTime option of collected[x], clearing the others.
+func (b *BiologicallyDerivedProductCollection) SetCollectedDateTime(value primitives.DateTime) {
+	ext := b.CollectedDateTimeExt
+	b.ClearCollected()
+	b.CollectedDateTime, b.CollectedDateTimeExt = &value, ext
+}
+
+// SetCollectedPeriod sets the Period option of collected[x], clearing the others.
+func (b *BiologicallyDerivedProductCollection) SetCollectedPeriod(value Period) {
+	b.ClearCollected()
+	b.CollectedPeriod = &value
+}
+
 // BiologicallyDerivedProductProperty represents a FHIR BackboneElement for BiologicallyDerivedProduct.property.
 type BiologicallyDerivedProductProperty struct {
 	// Unique id for inter-element referencing
@@ -48,34 +90,158 @@ type BiologicallyDerivedProductProperty struct {
 	// Code that specifies the property
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Property values - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Property values - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Property values - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property values - Period option
-	ValuePeriod Period `json:"valuePeriod" fhir:"cardinality=1..1,required,choice=value"`
+	ValuePeriod *Period `json:"valuePeriod,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property values - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property values - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property values - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Property values - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Property values - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (b *BiologicallyDerivedProductProperty) Value() any {
+	switch {
+	case b.ValueBoolean != nil:
+		return b.ValueBoolean
+	case b.ValueInteger != nil:
+		return b.ValueInteger
+	case b.ValueCodeableConcept != nil:
+		return b.ValueCodeableConcept
+	case b.ValuePeriod != nil:
+		return b.ValuePeriod
+	case b.ValueQuantity != nil:
+		return b.ValueQuantity
+	case b.ValueRange != nil:
+		return b.ValueRange
+	case b.ValueRatio != nil:
+		return b.ValueRatio
+	case b.ValueString != nil:
+		return b.ValueString
+	case b.ValueAttachment != nil:
+		return b.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (b *BiologicallyDerivedProductProperty) ValueType() string {
+	switch {
+	case b.ValueBoolean != nil:
+		return "boolean"
+	case b.ValueInteger != nil:
+		return "integer"
+	case b.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case b.ValuePeriod != nil:
+		return "Period"
+	case b.ValueQuantity != nil:
+		return "Quantity"
+	case b.ValueRange != nil:
+		return "Range"
+	case b.ValueRatio != nil:
+		return "Ratio"
+	case b.ValueString != nil:
+		return "string"
+	case b.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (b *BiologicallyDerivedProductProperty) ClearValue() {
+	b.ValueBoolean = nil
+	b.ValueBooleanExt = nil
+	b.ValueInteger = nil
+	b.ValueIntegerExt = nil
+	b.ValueCodeableConcept = nil
+	b.ValuePeriod = nil
+	b.ValueQuantity = nil
+	b.ValueRange = nil
+	b.ValueRatio = nil
+	b.ValueString = nil
+	b.ValueStringExt = nil
+	b.ValueAttachment = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueBoolean(value bool) {
+	ext := b.ValueBooleanExt
+	b.ClearValue()
+	b.ValueBoolean, b.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueInteger(value int) {
+	ext := b.ValueIntegerExt
+	b.ClearValue()
+	b.ValueInteger, b.ValueIntegerExt = &value, ext
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueCodeableConcept(value CodeableConcept) {
+	b.ClearValue()
+	b.ValueCodeableConcept = &value
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValuePeriod(value Period) {
+	b.ClearValue()
+	b.ValuePeriod = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueQuantity(value Quantity) {
+	b.ClearValue()
+	b.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueRange(value Range) {
+	b.ClearValue()
+	b.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueRatio(value Ratio) {
+	b.ClearValue()
+	b.ValueRatio = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueString(value string) {
+	ext := b.ValueStringExt
+	b.ClearValue()
+	b.ValueString, b.ValueStringExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (b *BiologicallyDerivedProductProperty) SetValueAttachment(value Attachment) {
+	b.ClearValue()
+	b.ValueAttachment = &value
 }
 
 // BiologicallyDerivedProduct represents a FHIR BiologicallyDerivedProduct.
 type BiologicallyDerivedProduct struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// organ | tissue | fluid | cells | biologicalAgent
@@ -109,3 +275,8 @@ type BiologicallyDerivedProduct struct {
 	// A property that is specific to this BiologicallyDerviedProduct instance
 	Property []BiologicallyDerivedProductProperty `json:"property,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "BiologicallyDerivedProduct", the FHIR type of the resource.
+func (b *BiologicallyDerivedProduct) ResourceType() string {
+	return ResourceTypeBiologicallyDerivedProduct
+}
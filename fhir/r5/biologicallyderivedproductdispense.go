@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type BiologicallyDerivedProductDispensePerformer struct {
 
 // BiologicallyDerivedProductDispense represents a FHIR BiologicallyDerivedProductDispense.
 type BiologicallyDerivedProductDispense struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this dispense
@@ -75,3 +74,8 @@ type BiologicallyDerivedProductDispense struct {
 	// Extension for UsageInstruction
 	UsageInstructionExt *primitives.PrimitiveExtension `json:"_usageInstruction,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "BiologicallyDerivedProductDispense", the FHIR type of the resource.
+func (b *BiologicallyDerivedProductDispense) ResourceType() string {
+	return ResourceTypeBiologicallyDerivedProductDispense
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -77,7 +76,7 @@ type BodyStructureExcludedStructure struct {
 
 // BodyStructure represents a FHIR BodyStructure.
 type BodyStructure struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Bodystructure identifier
@@ -101,3 +100,8 @@ type BodyStructure struct {
 	// Who this is about
 	Patient Reference `json:"patient" fhir:"cardinality=1..1,required,summary"`
 }
+
+// ResourceType returns "BodyStructure", the FHIR type of the resource.
+func (b *BodyStructure) ResourceType() string {
+	return ResourceTypeBodyStructure
+}
@@ -8,7 +8,6 @@ package r5
 import (
 	"encoding/json"
 
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -54,7 +53,7 @@ type BundleEntrySearch struct {
 	// Extension for Mode
 	ModeExt *primitives.PrimitiveExtension `json:"_mode,omitempty" fhir:"cardinality=0..1"`
 	// Search ranking (between 0 and 1)
-	Score *float64 `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
+	Score *primitives.Decimal `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Score
 	ScoreExt *primitives.PrimitiveExtension `json:"_score,omitempty" fhir:"cardinality=0..1"`
 }
@@ -157,11 +156,11 @@ type BundleEntry struct {
 
 // Bundle represents a FHIR Bundle.
 type Bundle struct {
-	fhir.Resource
+	BaseResource
 	// Persistent identifier for the bundle
 	Identifier *Identifier `json:"identifier,omitempty" fhir:"cardinality=0..1,summary"`
 	// document | message | transaction | transaction-response | batch | batch-response | history | searchset | collection | subscription-notification
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type BundleType `json:"type" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// When the bundle was assembled
@@ -183,3 +182,8 @@ type Bundle struct {
 	// Extension for Issues
 	IssuesExt *primitives.PrimitiveExtension `json:"_issues,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Bundle", the FHIR type of the resource.
+func (b *Bundle) ResourceType() string {
+	return ResourceTypeBundle
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -116,7 +115,7 @@ type CapabilityStatementRestResourceSearchParam struct {
 	// Extension for Name
 	NameExt *primitives.PrimitiveExtension `json:"_name,omitempty" fhir:"cardinality=0..1"`
 	// Source of definition for parameter
-	Definition *string `json:"definition,omitempty" fhir:"cardinality=0..1"`
+	Definition *primitives.Canonical `json:"definition,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 	// number | date | string | token | reference | composite | quantity | uri | special
@@ -144,7 +143,7 @@ type CapabilityStatementRestResourceOperation struct {
 	// Extension for Name
 	NameExt *primitives.PrimitiveExtension `json:"_name,omitempty" fhir:"cardinality=0..1"`
 	// The defined operation/query
-	Definition string `json:"definition" fhir:"cardinality=1..1,required,summary"`
+	Definition primitives.Canonical `json:"definition" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 	// Specific details about operation behavior
@@ -168,11 +167,11 @@ type CapabilityStatementRestResource struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// System-wide profile
-	Profile *string `json:"profile,omitempty" fhir:"cardinality=0..1,summary"`
+	Profile *primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Use-case specific profiles
-	SupportedProfile []string `json:"supportedProfile,omitempty" fhir:"cardinality=0..*,summary"`
+	SupportedProfile []primitives.Canonical `json:"supportedProfile,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for SupportedProfile
 	SupportedProfileExt *primitives.PrimitiveExtension `json:"_supportedProfile,omitempty" fhir:"cardinality=0..1"`
 	// Additional information about the use of the resource type
@@ -288,7 +287,7 @@ type CapabilityStatementRest struct {
 	// Definition of a system level operation
 	Operation []CapabilityStatementRestOperation `json:"operation,omitempty" fhir:"cardinality=0..*,summary"`
 	// Compartments served/used by system
-	Compartment []string `json:"compartment,omitempty" fhir:"cardinality=0..*"`
+	Compartment []primitives.Canonical `json:"compartment,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Compartment
 	CompartmentExt *primitives.PrimitiveExtension `json:"_compartment,omitempty" fhir:"cardinality=0..1"`
 }
@@ -326,7 +325,7 @@ type CapabilityStatementMessagingSupportedMessage struct {
 	// Extension for Mode
 	ModeExt *primitives.PrimitiveExtension `json:"_mode,omitempty" fhir:"cardinality=0..1"`
 	// Message supported by this system
-	Definition string `json:"definition" fhir:"cardinality=1..1,required,summary"`
+	Definition primitives.Canonical `json:"definition" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 }
@@ -374,14 +373,14 @@ type CapabilityStatementDocument struct {
 	// Extension for Documentation
 	DocumentationExt *primitives.PrimitiveExtension `json:"_documentation,omitempty" fhir:"cardinality=0..1"`
 	// Constraint on the resources used in the document
-	Profile string `json:"profile" fhir:"cardinality=1..1,required,summary"`
+	Profile primitives.Canonical `json:"profile" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 }
 
 // CapabilityStatement represents a FHIR CapabilityStatement.
 type CapabilityStatement struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this capability statement, represented as a URI (globally unique)
@@ -451,11 +450,11 @@ type CapabilityStatement struct {
 	// Extension for Kind
 	KindExt *primitives.PrimitiveExtension `json:"_kind,omitempty" fhir:"cardinality=0..1"`
 	// Canonical URL of another capability statement this implements
-	Instantiates []string `json:"instantiates,omitempty" fhir:"cardinality=0..*,summary"`
+	Instantiates []primitives.Canonical `json:"instantiates,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Instantiates
 	InstantiatesExt *primitives.PrimitiveExtension `json:"_instantiates,omitempty" fhir:"cardinality=0..1"`
 	// Canonical URL of another capability statement this adds to
-	Imports []string `json:"imports,omitempty" fhir:"cardinality=0..*,summary"`
+	Imports []primitives.Canonical `json:"imports,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Imports
 	ImportsExt *primitives.PrimitiveExtension `json:"_imports,omitempty" fhir:"cardinality=0..1"`
 	// Software that is covered by this capability statement
@@ -479,7 +478,7 @@ type CapabilityStatement struct {
 	// Extension for AcceptLanguage
 	AcceptLanguageExt *primitives.PrimitiveExtension `json:"_acceptLanguage,omitempty" fhir:"cardinality=0..1"`
 	// Implementation guides supported
-	ImplementationGuide []string `json:"implementationGuide,omitempty" fhir:"cardinality=0..*,summary"`
+	ImplementationGuide []primitives.Canonical `json:"implementationGuide,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for ImplementationGuide
 	ImplementationGuideExt *primitives.PrimitiveExtension `json:"_implementationGuide,omitempty" fhir:"cardinality=0..1"`
 	// If the endpoint is a RESTful one
@@ -489,3 +488,51 @@ type CapabilityStatement struct {
 	// Document definition
 	Document []CapabilityStatementDocument `json:"document,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "CapabilityStatement", the FHIR type of the resource.
+func (c *CapabilityStatement) ResourceType() string {
+	return ResourceTypeCapabilityStatement
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *CapabilityStatement) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *CapabilityStatement) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *CapabilityStatement) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *CapabilityStatement) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *CapabilityStatement) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
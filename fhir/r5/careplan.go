@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,13 +32,13 @@ type CarePlanActivity struct {
 
 // CarePlan represents a FHIR CarePlan.
 type CarePlan struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this plan
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -97,3 +96,8 @@ type CarePlan struct {
 	// Comments about the plan
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CarePlan", the FHIR type of the resource.
+func (c *CarePlan) ResourceType() string {
+	return ResourceTypeCarePlan
+}
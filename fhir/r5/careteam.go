@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,9 +34,50 @@ type CareTeamParticipant struct {
 	CoverageTiming *Timing `json:"coverageTiming,omitempty" fhir:"cardinality=0..1,choice=coverage"`
 }
 
+// Coverage returns the option of coverage[x] that is set, or nil if none is.
+func (c *CareTeamParticipant) Coverage() any {
+	switch {
+	case c.CoveragePeriod != nil:
+		return c.CoveragePeriod
+	case c.CoverageTiming != nil:
+		return c.CoverageTiming
+	}
+	return nil
+}
+
+// CoverageType returns the FHIR type of the option of coverage[x] that is
+// set, such as "Period", or "" if none is.
+func (c *CareTeamParticipant) CoverageType() string {
+	switch {
+	case c.CoveragePeriod != nil:
+		return "Period"
+	case c.CoverageTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearCoverage unsets every option of coverage[x], and their extensions.
+func (c *CareTeamParticipant) ClearCoverage() {
+	c.CoveragePeriod = nil
+	c.CoverageTiming = nil
+}
+
+// SetCoveragePeriod sets the Period option of coverage[x], clearing the others.
+func (c *CareTeamParticipant) SetCoveragePeriod(value Period) {
+	c.ClearCoverage()
+	c.CoveragePeriod = &value
+}
+
+// SetCoverageTiming sets the Timing option of coverage[x], clearing the others.
+func (c *CareTeamParticipant) SetCoverageTiming(value Timing) {
+	c.ClearCoverage()
+	c.CoverageTiming = &value
+}
+
 // CareTeam represents a FHIR CareTeam.
 type CareTeam struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this team
@@ -67,3 +107,8 @@ type CareTeam struct {
 	// Comments made about the CareTeam
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CareTeam", the FHIR type of the resource.
+func (c *CareTeam) ResourceType() string {
+	return ResourceTypeCareTeam
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type ChargeItemPerformer struct {
 
 // ChargeItem represents a FHIR ChargeItem.
 type ChargeItem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for item
@@ -41,7 +40,7 @@ type ChargeItem struct {
 	// Extension for DefinitionUri
 	DefinitionUriExt *primitives.PrimitiveExtension `json:"_definitionUri,omitempty" fhir:"cardinality=0..1"`
 	// Resource defining the code of this ChargeItem
-	DefinitionCanonical []string `json:"definitionCanonical,omitempty" fhir:"cardinality=0..*"`
+	DefinitionCanonical []primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=0..*"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// planned | billable | not-billable | aborted | billed | entered-in-error | unknown
@@ -101,3 +100,62 @@ type ChargeItem struct {
 	// Further information supporting this charge
 	SupportingInformation []Reference `json:"supportingInformation,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ChargeItem", the FHIR type of the resource.
+func (c *ChargeItem) ResourceType() string {
+	return ResourceTypeChargeItem
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (c *ChargeItem) Occurrence() any {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return c.OccurrenceDateTime
+	case c.OccurrencePeriod != nil:
+		return c.OccurrencePeriod
+	case c.OccurrenceTiming != nil:
+		return c.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *ChargeItem) OccurrenceType() string {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return "dateTime"
+	case c.OccurrencePeriod != nil:
+		return "Period"
+	case c.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (c *ChargeItem) ClearOccurrence() {
+	c.OccurrenceDateTime = nil
+	c.OccurrenceDateTimeExt = nil
+	c.OccurrencePeriod = nil
+	c.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (c *ChargeItem) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := c.OccurrenceDateTimeExt
+	c.ClearOccurrence()
+	c.OccurrenceDateTime, c.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (c *ChargeItem) SetOccurrencePeriod(value Period) {
+	c.ClearOccurrence()
+	c.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (c *ChargeItem) SetOccurrenceTiming(value Timing) {
+	c.ClearOccurrence()
+	c.OccurrenceTiming = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type ChargeItemDefinitionPropertyGroup struct {
 
 // ChargeItemDefinition represents a FHIR ChargeItemDefinition.
 type ChargeItemDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this charge item definition, represented as a URI (globally unique)
@@ -85,11 +84,11 @@ type ChargeItemDefinition struct {
 	// Extension for DerivedFromUri
 	DerivedFromUriExt *primitives.PrimitiveExtension `json:"_derivedFromUri,omitempty" fhir:"cardinality=0..1"`
 	// A larger definition of which this particular definition is a component or step
-	PartOf []string `json:"partOf,omitempty" fhir:"cardinality=0..*,summary"`
+	PartOf []primitives.Canonical `json:"partOf,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for PartOf
 	PartOfExt *primitives.PrimitiveExtension `json:"_partOf,omitempty" fhir:"cardinality=0..1"`
 	// Completed or terminated request(s) whose function is taken by this new request
-	Replaces []string `json:"replaces,omitempty" fhir:"cardinality=0..*,summary"`
+	Replaces []primitives.Canonical `json:"replaces,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Replaces
 	ReplacesExt *primitives.PrimitiveExtension `json:"_replaces,omitempty" fhir:"cardinality=0..1"`
 	// draft | active | retired | unknown
@@ -147,3 +146,51 @@ type ChargeItemDefinition struct {
 	// Group of properties which are applicable under the same conditions
 	PropertyGroup []ChargeItemDefinitionPropertyGroup `json:"propertyGroup,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ChargeItemDefinition", the FHIR type of the resource.
+func (c *ChargeItemDefinition) ResourceType() string {
+	return ResourceTypeChargeItemDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *ChargeItemDefinition) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *ChargeItemDefinition) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *ChargeItemDefinition) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *ChargeItemDefinition) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *ChargeItemDefinition) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -200,7 +199,7 @@ type CitationCitedArtifactRelatesTo struct {
 	// What document is being referenced
 	Document *Attachment `json:"document,omitempty" fhir:"cardinality=0..1"`
 	// What artifact is being referenced
-	Resource *string `json:"resource,omitempty" fhir:"cardinality=0..1"`
+	Resource *primitives.Canonical `json:"resource,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Resource
 	ResourceExt *primitives.PrimitiveExtension `json:"_resource,omitempty" fhir:"cardinality=0..1"`
 	// What artifact, if not a conformance resource
@@ -475,7 +474,7 @@ type CitationCitedArtifact struct {
 
 // Citation represents a FHIR Citation.
 type Citation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this citation record, represented as a globally unique URI
@@ -573,3 +572,51 @@ type Citation struct {
 	// The article or artifact being described
 	CitedArtifact *CitationCitedArtifact `json:"citedArtifact,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Citation", the FHIR type of the resource.
+func (c *Citation) ResourceType() string {
+	return ResourceTypeCitation
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *Citation) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *Citation) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *Citation) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *Citation) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *Citation) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,11 +25,54 @@ type ClaimResponseEvent struct {
 	// Specific event
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Occurance date or period - dateTime option
-	WhenDateTime primitives.DateTime `json:"whenDateTime" fhir:"cardinality=1..1,required,choice=when"`
+	WhenDateTime *primitives.DateTime `json:"whenDateTime,omitempty" fhir:"cardinality=1..1,required,choice=when"`
 	// Extension for WhenDateTime
 	WhenDateTimeExt *primitives.PrimitiveExtension `json:"_whenDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Occurance date or period - Period option
-	WhenPeriod Period `json:"whenPeriod" fhir:"cardinality=1..1,required,choice=when"`
+	WhenPeriod *Period `json:"whenPeriod,omitempty" fhir:"cardinality=1..1,required,choice=when"`
+}
+
+// When returns the option of when[x] that is set, or nil if none is.
+func (c *ClaimResponseEvent) When() any {
+	switch {
+	case c.WhenDateTime != nil:
+		return c.WhenDateTime
+	case c.WhenPeriod != nil:
+		return c.WhenPeriod
+	}
+	return nil
+}
+
+// WhenType returns the FHIR type of the option of when[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *ClaimResponseEvent) WhenType() string {
+	switch {
+	case c.WhenDateTime != nil:
+		return "dateTime"
+	case c.WhenPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearWhen unsets every option of when[x], and their extensions.
+func (c *ClaimResponseEvent) ClearWhen() {
+	c.WhenDateTime = nil
+	c.WhenDateTimeExt = nil
+	c.WhenPeriod = nil
+}
+
+// SetWhenDateTime sets the dateTime option of when[x], clearing the others.
+func (c *ClaimResponseEvent) SetWhenDateTime(value primitives.DateTime) {
+	ext := c.WhenDateTimeExt
+	c.ClearWhen()
+	c.WhenDateTime, c.WhenDateTimeExt = &value, ext
+}
+
+// SetWhenPeriod sets the Period option of when[x], clearing the others.
+func (c *ClaimResponseEvent) SetWhenPeriod(value Period) {
+	c.ClearWhen()
+	c.WhenPeriod = &value
 }
 
 // ClaimResponseItemReviewOutcome represents a FHIR BackboneElement for ClaimResponse.item.reviewOutcome.
@@ -238,7 +280,7 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total tax
@@ -280,7 +322,7 @@ type ClaimResponseAddItemDetail struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total tax
@@ -354,7 +396,7 @@ type ClaimResponseAddItem struct {
 	// Fee, charge or cost per item
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Price scaling factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Total tax
@@ -375,6 +417,101 @@ type ClaimResponseAddItem struct {
 	Detail []ClaimResponseAddItemDetail `json:"detail,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (c *ClaimResponseAddItem) Serviced() any {
+	switch {
+	case c.ServicedDate != nil:
+		return c.ServicedDate
+	case c.ServicedPeriod != nil:
+		return c.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (c *ClaimResponseAddItem) ServicedType() string {
+	switch {
+	case c.ServicedDate != nil:
+		return "date"
+	case c.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (c *ClaimResponseAddItem) ClearServiced() {
+	c.ServicedDate = nil
+	c.ServicedDateExt = nil
+	c.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (c *ClaimResponseAddItem) SetServicedDate(value primitives.Date) {
+	ext := c.ServicedDateExt
+	c.ClearServiced()
+	c.ServicedDate, c.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (c *ClaimResponseAddItem) SetServicedPeriod(value Period) {
+	c.ClearServiced()
+	c.ServicedPeriod = &value
+}
+
+// Location returns the option of location[x] that is set, or nil if none is.
+func (c *ClaimResponseAddItem) Location() any {
+	switch {
+	case c.LocationCodeableConcept != nil:
+		return c.LocationCodeableConcept
+	case c.LocationAddress != nil:
+		return c.LocationAddress
+	case c.LocationReference != nil:
+		return c.LocationReference
+	}
+	return nil
+}
+
+// LocationType returns the FHIR type of the option of location[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ClaimResponseAddItem) LocationType() string {
+	switch {
+	case c.LocationCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.LocationAddress != nil:
+		return "Address"
+	case c.LocationReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLocation unsets every option of location[x], and their extensions.
+func (c *ClaimResponseAddItem) ClearLocation() {
+	c.LocationCodeableConcept = nil
+	c.LocationAddress = nil
+	c.LocationReference = nil
+}
+
+// SetLocationCodeableConcept sets the CodeableConcept option of location[x], clearing the others.
+func (c *ClaimResponseAddItem) SetLocationCodeableConcept(value CodeableConcept) {
+	c.ClearLocation()
+	c.LocationCodeableConcept = &value
+}
+
+// SetLocationAddress sets the Address option of location[x], clearing the others.
+func (c *ClaimResponseAddItem) SetLocationAddress(value Address) {
+	c.ClearLocation()
+	c.LocationAddress = &value
+}
+
+// SetLocationReference sets the Reference option of location[x], clearing the others.
+func (c *ClaimResponseAddItem) SetLocationReference(value Reference) {
+	c.ClearLocation()
+	c.LocationReference = &value
+}
+
 // ClaimResponseAdjudication represents a FHIR BackboneElement for ClaimResponse.adjudication.
 type ClaimResponseAdjudication struct {
 }
@@ -505,7 +642,7 @@ type ClaimResponseError struct {
 
 // ClaimResponse represents a FHIR ClaimResponse.
 type ClaimResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for a claim response
@@ -585,3 +722,8 @@ type ClaimResponse struct {
 	// Processing errors
 	Error []ClaimResponseError `json:"error,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ClaimResponse", the FHIR type of the resource.
+func (c *ClaimResponse) ResourceType() string {
+	return ResourceTypeClaimResponse
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -89,6 +88,49 @@ type ClinicalUseDefinitionIndication struct {
 	OtherTherapy []ClinicalUseDefinitionIndicationOtherTherapy `json:"otherTherapy,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Duration returns the option of duration[x] that is set, or nil if none is.
+func (c *ClinicalUseDefinitionIndication) Duration() any {
+	switch {
+	case c.DurationRange != nil:
+		return c.DurationRange
+	case c.DurationString != nil:
+		return c.DurationString
+	}
+	return nil
+}
+
+// DurationType returns the FHIR type of the option of duration[x] that is
+// set, such as "Range", or "" if none is.
+func (c *ClinicalUseDefinitionIndication) DurationType() string {
+	switch {
+	case c.DurationRange != nil:
+		return "Range"
+	case c.DurationString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearDuration unsets every option of duration[x], and their extensions.
+func (c *ClinicalUseDefinitionIndication) ClearDuration() {
+	c.DurationRange = nil
+	c.DurationString = nil
+	c.DurationStringExt = nil
+}
+
+// SetDurationRange sets the Range option of duration[x], clearing the others.
+func (c *ClinicalUseDefinitionIndication) SetDurationRange(value Range) {
+	c.ClearDuration()
+	c.DurationRange = &value
+}
+
+// SetDurationString sets the string option of duration[x], clearing the others.
+func (c *ClinicalUseDefinitionIndication) SetDurationString(value string) {
+	ext := c.DurationStringExt
+	c.ClearDuration()
+	c.DurationString, c.DurationStringExt = &value, ext
+}
+
 // ClinicalUseDefinitionInteractionInteractant represents a FHIR BackboneElement for ClinicalUseDefinition.interaction.interactant.
 type ClinicalUseDefinitionInteractionInteractant struct {
 	// Unique id for inter-element referencing
@@ -100,9 +142,50 @@ type ClinicalUseDefinitionInteractionInteractant struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// The specific medication, product, food etc. or laboratory test that interacts - Reference option
-	ItemReference Reference `json:"itemReference" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemReference *Reference `json:"itemReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
 	// The specific medication, product, food etc. or laboratory test that interacts - CodeableConcept option
-	ItemCodeableConcept CodeableConcept `json:"itemCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=item"`
+	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=item"`
+}
+
+// Item returns the option of item[x] that is set, or nil if none is.
+func (c *ClinicalUseDefinitionInteractionInteractant) Item() any {
+	switch {
+	case c.ItemReference != nil:
+		return c.ItemReference
+	case c.ItemCodeableConcept != nil:
+		return c.ItemCodeableConcept
+	}
+	return nil
+}
+
+// ItemType returns the FHIR type of the option of item[x] that is
+// set, such as "Reference", or "" if none is.
+func (c *ClinicalUseDefinitionInteractionInteractant) ItemType() string {
+	switch {
+	case c.ItemReference != nil:
+		return "Reference"
+	case c.ItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearItem unsets every option of item[x], and their extensions.
+func (c *ClinicalUseDefinitionInteractionInteractant) ClearItem() {
+	c.ItemReference = nil
+	c.ItemCodeableConcept = nil
+}
+
+// SetItemReference sets the Reference option of item[x], clearing the others.
+func (c *ClinicalUseDefinitionInteractionInteractant) SetItemReference(value Reference) {
+	c.ClearItem()
+	c.ItemReference = &value
+}
+
+// SetItemCodeableConcept sets the CodeableConcept option of item[x], clearing the others.
+func (c *ClinicalUseDefinitionInteractionInteractant) SetItemCodeableConcept(value CodeableConcept) {
+	c.ClearItem()
+	c.ItemCodeableConcept = &value
 }
 
 // ClinicalUseDefinitionInteraction represents a FHIR BackboneElement for ClinicalUseDefinition.interaction.
@@ -165,7 +248,7 @@ type ClinicalUseDefinitionWarning struct {
 
 // ClinicalUseDefinition represents a FHIR ClinicalUseDefinition.
 type ClinicalUseDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this issue
@@ -189,7 +272,7 @@ type ClinicalUseDefinition struct {
 	// The population group to which this applies
 	Population []Reference `json:"population,omitempty" fhir:"cardinality=0..*,summary"`
 	// Logic used by the clinical use definition
-	Library []string `json:"library,omitempty" fhir:"cardinality=0..*,summary"`
+	Library []primitives.Canonical `json:"library,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Library
 	LibraryExt *primitives.PrimitiveExtension `json:"_library,omitempty" fhir:"cardinality=0..1"`
 	// A possible negative outcome from the use of this treatment
@@ -197,3 +280,8 @@ type ClinicalUseDefinition struct {
 	// Critical environmental, health or physical risks or hazards. For example 'Do not operate heavy machinery', 'May cause drowsiness'
 	Warning *ClinicalUseDefinitionWarning `json:"warning,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "ClinicalUseDefinition", the FHIR type of the resource.
+func (c *ClinicalUseDefinition) ResourceType() string {
+	return ResourceTypeClinicalUseDefinition
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -108,33 +107,141 @@ type CodeSystemConceptProperty struct {
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property for this concept - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *CodeSystemConceptProperty) Value() any {
+	switch {
+	case c.ValueCode != nil:
+		return c.ValueCode
+	case c.ValueCoding != nil:
+		return c.ValueCoding
+	case c.ValueString != nil:
+		return c.ValueString
+	case c.ValueInteger != nil:
+		return c.ValueInteger
+	case c.ValueBoolean != nil:
+		return c.ValueBoolean
+	case c.ValueDateTime != nil:
+		return c.ValueDateTime
+	case c.ValueDecimal != nil:
+		return c.ValueDecimal
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "code", or "" if none is.
+func (c *CodeSystemConceptProperty) ValueType() string {
+	switch {
+	case c.ValueCode != nil:
+		return "code"
+	case c.ValueCoding != nil:
+		return "Coding"
+	case c.ValueString != nil:
+		return "string"
+	case c.ValueInteger != nil:
+		return "integer"
+	case c.ValueBoolean != nil:
+		return "boolean"
+	case c.ValueDateTime != nil:
+		return "dateTime"
+	case c.ValueDecimal != nil:
+		return "decimal"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *CodeSystemConceptProperty) ClearValue() {
+	c.ValueCode = nil
+	c.ValueCodeExt = nil
+	c.ValueCoding = nil
+	c.ValueString = nil
+	c.ValueStringExt = nil
+	c.ValueInteger = nil
+	c.ValueIntegerExt = nil
+	c.ValueBoolean = nil
+	c.ValueBooleanExt = nil
+	c.ValueDateTime = nil
+	c.ValueDateTimeExt = nil
+	c.ValueDecimal = nil
+	c.ValueDecimalExt = nil
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueCode(value string) {
+	ext := c.ValueCodeExt
+	c.ClearValue()
+	c.ValueCode, c.ValueCodeExt = &value, ext
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueCoding(value Coding) {
+	c.ClearValue()
+	c.ValueCoding = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueString(value string) {
+	ext := c.ValueStringExt
+	c.ClearValue()
+	c.ValueString, c.ValueStringExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueInteger(value int) {
+	ext := c.ValueIntegerExt
+	c.ClearValue()
+	c.ValueInteger, c.ValueIntegerExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueBoolean(value bool) {
+	ext := c.ValueBooleanExt
+	c.ClearValue()
+	c.ValueBoolean, c.ValueBooleanExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueDateTime(value primitives.DateTime) {
+	ext := c.ValueDateTimeExt
+	c.ClearValue()
+	c.ValueDateTime, c.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (c *CodeSystemConceptProperty) SetValueDecimal(value primitives.Decimal) {
+	ext := c.ValueDecimalExt
+	c.ClearValue()
+	c.ValueDecimal, c.ValueDecimalExt = &value, ext
+}
+
 // CodeSystemConceptConcept represents a FHIR BackboneElement for CodeSystem.concept.concept.
 type CodeSystemConceptConcept struct {
 }
@@ -171,7 +278,7 @@ type CodeSystemConcept struct {
 
 // CodeSystem represents a FHIR CodeSystem.
 type CodeSystem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this code system, represented as a URI (globally unique) (Coding.system)
@@ -263,7 +370,7 @@ type CodeSystem struct {
 	// Extension for CaseSensitive
 	CaseSensitiveExt *primitives.PrimitiveExtension `json:"_caseSensitive,omitempty" fhir:"cardinality=0..1"`
 	// Canonical reference to the value set with entire code system
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1,summary"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 	// grouped-by | is-a | part-of | classified-with
@@ -283,7 +390,7 @@ type CodeSystem struct {
 	// Extension for Content
 	ContentExt *primitives.PrimitiveExtension `json:"_content,omitempty" fhir:"cardinality=0..1"`
 	// Canonical URL of Code System this adds designations and properties to
-	Supplements *string `json:"supplements,omitempty" fhir:"cardinality=0..1,summary"`
+	Supplements *primitives.Canonical `json:"supplements,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Supplements
 	SupplementsExt *primitives.PrimitiveExtension `json:"_supplements,omitempty" fhir:"cardinality=0..1"`
 	// Total concepts in the code system
@@ -297,3 +404,51 @@ type CodeSystem struct {
 	// Concepts in the code system
 	Concept []CodeSystemConcept `json:"concept,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CodeSystem", the FHIR type of the resource.
+func (c *CodeSystem) ResourceType() string {
+	return ResourceTypeCodeSystem
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *CodeSystem) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *CodeSystem) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *CodeSystem) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *CodeSystem) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *CodeSystem) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
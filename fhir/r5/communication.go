@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,22 +23,74 @@ type CommunicationPayload struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Message part content - Attachment option
-	ContentAttachment Attachment `json:"contentAttachment" fhir:"cardinality=1..1,required,choice=content"`
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Message part content - Reference option
-	ContentReference Reference `json:"contentReference" fhir:"cardinality=1..1,required,choice=content"`
+	ContentReference *Reference `json:"contentReference,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Message part content - CodeableConcept option
-	ContentCodeableConcept CodeableConcept `json:"contentCodeableConcept" fhir:"cardinality=1..1,required,choice=content"`
+	ContentCodeableConcept *CodeableConcept `json:"contentCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=content"`
+}
+
+// Content returns the option of content[x] that is set, or nil if none is.
+func (c *CommunicationPayload) Content() any {
+	switch {
+	case c.ContentAttachment != nil:
+		return c.ContentAttachment
+	case c.ContentReference != nil:
+		return c.ContentReference
+	case c.ContentCodeableConcept != nil:
+		return c.ContentCodeableConcept
+	}
+	return nil
+}
+
+// ContentType returns the FHIR type of the option of content[x] that is
+// set, such as "Attachment", or "" if none is.
+func (c *CommunicationPayload) ContentType() string {
+	switch {
+	case c.ContentAttachment != nil:
+		return "Attachment"
+	case c.ContentReference != nil:
+		return "Reference"
+	case c.ContentCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearContent unsets every option of content[x], and their extensions.
+func (c *CommunicationPayload) ClearContent() {
+	c.ContentAttachment = nil
+	c.ContentReference = nil
+	c.ContentCodeableConcept = nil
+}
+
+// SetContentAttachment sets the Attachment option of content[x], clearing the others.
+func (c *CommunicationPayload) SetContentAttachment(value Attachment) {
+	c.ClearContent()
+	c.ContentAttachment = &value
+}
+
+// SetContentReference sets the Reference option of content[x], clearing the others.
+func (c *CommunicationPayload) SetContentReference(value Reference) {
+	c.ClearContent()
+	c.ContentReference = &value
+}
+
+// SetContentCodeableConcept sets the CodeableConcept option of content[x], clearing the others.
+func (c *CommunicationPayload) SetContentCodeableConcept(value CodeableConcept) {
+	c.ClearContent()
+	c.ContentCodeableConcept = &value
 }
 
 // Communication represents a FHIR Communication.
 type Communication struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -93,3 +144,8 @@ type Communication struct {
 	// Comments made about the communication
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Communication", the FHIR type of the resource.
+func (c *Communication) ResourceType() string {
+	return ResourceTypeCommunication
+}
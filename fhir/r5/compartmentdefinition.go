@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -47,7 +46,7 @@ type CompartmentDefinitionResource struct {
 
 // CompartmentDefinition represents a FHIR CompartmentDefinition.
 type CompartmentDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this compartment definition, represented as a URI (globally unique)
@@ -111,3 +110,51 @@ type CompartmentDefinition struct {
 	// How a resource is related to the compartment
 	Resource []CompartmentDefinitionResource `json:"resource,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "CompartmentDefinition", the FHIR type of the resource.
+func (c *CompartmentDefinition) ResourceType() string {
+	return ResourceTypeCompartmentDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *CompartmentDefinition) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *CompartmentDefinition) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *CompartmentDefinition) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *CompartmentDefinition) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *CompartmentDefinition) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -87,7 +86,7 @@ type CompositionSection struct {
 
 // Composition represents a FHIR Composition.
 type Composition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this Composition, represented as a URI (globally unique)
@@ -141,3 +140,8 @@ type Composition struct {
 	// Composition is broken into sections
 	Section []CompositionSection `json:"section,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Composition", the FHIR type of the resource.
+func (c *Composition) ResourceType() string {
+	return ResourceTypeComposition
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -40,7 +39,7 @@ type ConceptMapProperty struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// The CodeSystem from which code values come
-	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
+	System *primitives.Canonical `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for System
 	SystemExt *primitives.PrimitiveExtension `json:"_system,omitempty" fhir:"cardinality=0..1"`
 }
@@ -88,33 +87,141 @@ type ConceptMapGroupElementTargetProperty struct {
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property for this concept - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *ConceptMapGroupElementTargetProperty) Value() any {
+	switch {
+	case c.ValueCoding != nil:
+		return c.ValueCoding
+	case c.ValueString != nil:
+		return c.ValueString
+	case c.ValueInteger != nil:
+		return c.ValueInteger
+	case c.ValueBoolean != nil:
+		return c.ValueBoolean
+	case c.ValueDateTime != nil:
+		return c.ValueDateTime
+	case c.ValueDecimal != nil:
+		return c.ValueDecimal
+	case c.ValueCode != nil:
+		return c.ValueCode
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Coding", or "" if none is.
+func (c *ConceptMapGroupElementTargetProperty) ValueType() string {
+	switch {
+	case c.ValueCoding != nil:
+		return "Coding"
+	case c.ValueString != nil:
+		return "string"
+	case c.ValueInteger != nil:
+		return "integer"
+	case c.ValueBoolean != nil:
+		return "boolean"
+	case c.ValueDateTime != nil:
+		return "dateTime"
+	case c.ValueDecimal != nil:
+		return "decimal"
+	case c.ValueCode != nil:
+		return "code"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *ConceptMapGroupElementTargetProperty) ClearValue() {
+	c.ValueCoding = nil
+	c.ValueString = nil
+	c.ValueStringExt = nil
+	c.ValueInteger = nil
+	c.ValueIntegerExt = nil
+	c.ValueBoolean = nil
+	c.ValueBooleanExt = nil
+	c.ValueDateTime = nil
+	c.ValueDateTimeExt = nil
+	c.ValueDecimal = nil
+	c.ValueDecimalExt = nil
+	c.ValueCode = nil
+	c.ValueCodeExt = nil
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueCoding(value Coding) {
+	c.ClearValue()
+	c.ValueCoding = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueString(value string) {
+	ext := c.ValueStringExt
+	c.ClearValue()
+	c.ValueString, c.ValueStringExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueInteger(value int) {
+	ext := c.ValueIntegerExt
+	c.ClearValue()
+	c.ValueInteger, c.ValueIntegerExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueBoolean(value bool) {
+	ext := c.ValueBooleanExt
+	c.ClearValue()
+	c.ValueBoolean, c.ValueBooleanExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueDateTime(value primitives.DateTime) {
+	ext := c.ValueDateTimeExt
+	c.ClearValue()
+	c.ValueDateTime, c.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueDecimal(value primitives.Decimal) {
+	ext := c.ValueDecimalExt
+	c.ClearValue()
+	c.ValueDecimal, c.ValueDecimalExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetProperty) SetValueCode(value string) {
+	ext := c.ValueCodeExt
+	c.ClearValue()
+	c.ValueCode, c.ValueCodeExt = &value, ext
+}
+
 // ConceptMapGroupElementTargetDependsOn represents a FHIR BackboneElement for ConceptMap.group.element.target.dependsOn.
 type ConceptMapGroupElementTargetDependsOn struct {
 	// Unique id for inter-element referencing
@@ -146,11 +253,91 @@ type ConceptMapGroupElementTargetDependsOn struct {
 	// Value of the referenced data element - Quantity option
 	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// The mapping depends on a data element with a value from this value set
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *ConceptMapGroupElementTargetDependsOn) Value() any {
+	switch {
+	case c.ValueCode != nil:
+		return c.ValueCode
+	case c.ValueCoding != nil:
+		return c.ValueCoding
+	case c.ValueString != nil:
+		return c.ValueString
+	case c.ValueBoolean != nil:
+		return c.ValueBoolean
+	case c.ValueQuantity != nil:
+		return c.ValueQuantity
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "code", or "" if none is.
+func (c *ConceptMapGroupElementTargetDependsOn) ValueType() string {
+	switch {
+	case c.ValueCode != nil:
+		return "code"
+	case c.ValueCoding != nil:
+		return "Coding"
+	case c.ValueString != nil:
+		return "string"
+	case c.ValueBoolean != nil:
+		return "boolean"
+	case c.ValueQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *ConceptMapGroupElementTargetDependsOn) ClearValue() {
+	c.ValueCode = nil
+	c.ValueCodeExt = nil
+	c.ValueCoding = nil
+	c.ValueString = nil
+	c.ValueStringExt = nil
+	c.ValueBoolean = nil
+	c.ValueBooleanExt = nil
+	c.ValueQuantity = nil
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetDependsOn) SetValueCode(value string) {
+	ext := c.ValueCodeExt
+	c.ClearValue()
+	c.ValueCode, c.ValueCodeExt = &value, ext
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetDependsOn) SetValueCoding(value Coding) {
+	c.ClearValue()
+	c.ValueCoding = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetDependsOn) SetValueString(value string) {
+	ext := c.ValueStringExt
+	c.ClearValue()
+	c.ValueString, c.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetDependsOn) SetValueBoolean(value bool) {
+	ext := c.ValueBooleanExt
+	c.ClearValue()
+	c.ValueBoolean, c.ValueBooleanExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (c *ConceptMapGroupElementTargetDependsOn) SetValueQuantity(value Quantity) {
+	c.ClearValue()
+	c.ValueQuantity = &value
+}
+
 // ConceptMapGroupElementTargetProduct represents a FHIR BackboneElement for ConceptMap.group.element.target.product.
 type ConceptMapGroupElementTargetProduct struct {
 }
@@ -174,7 +361,7 @@ type ConceptMapGroupElementTarget struct {
 	// Extension for Display
 	DisplayExt *primitives.PrimitiveExtension `json:"_display,omitempty" fhir:"cardinality=0..1"`
 	// Identifies the set of target concepts
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 	// related-to | equivalent | source-is-narrower-than-target | source-is-broader-than-target | not-related-to
@@ -212,7 +399,7 @@ type ConceptMapGroupElement struct {
 	// Extension for Display
 	DisplayExt *primitives.PrimitiveExtension `json:"_display,omitempty" fhir:"cardinality=0..1"`
 	// Identifies the set of concepts being mapped
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 	// No mapping to a target concept for this source concept
@@ -246,7 +433,7 @@ type ConceptMapGroupUnmapped struct {
 	// Extension for Display
 	DisplayExt *primitives.PrimitiveExtension `json:"_display,omitempty" fhir:"cardinality=0..1"`
 	// Fixed code set when mode = fixed
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 	// related-to | equivalent | source-is-narrower-than-target | source-is-broader-than-target | not-related-to
@@ -254,7 +441,7 @@ type ConceptMapGroupUnmapped struct {
 	// Extension for Relationship
 	RelationshipExt *primitives.PrimitiveExtension `json:"_relationship,omitempty" fhir:"cardinality=0..1"`
 	// canonical reference to an additional ConceptMap to use for mapping if the source concept is unmapped
-	OtherMap *string `json:"otherMap,omitempty" fhir:"cardinality=0..1"`
+	OtherMap *primitives.Canonical `json:"otherMap,omitempty" fhir:"cardinality=0..1"`
 	// Extension for OtherMap
 	OtherMapExt *primitives.PrimitiveExtension `json:"_otherMap,omitempty" fhir:"cardinality=0..1"`
 }
@@ -270,11 +457,11 @@ type ConceptMapGroup struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Source system where concepts to be mapped are defined
-	Source *string `json:"source,omitempty" fhir:"cardinality=0..1"`
+	Source *primitives.Canonical `json:"source,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Source
 	SourceExt *primitives.PrimitiveExtension `json:"_source,omitempty" fhir:"cardinality=0..1"`
 	// Target system that the concepts are to be mapped to
-	Target *string `json:"target,omitempty" fhir:"cardinality=0..1"`
+	Target *primitives.Canonical `json:"target,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Target
 	TargetExt *primitives.PrimitiveExtension `json:"_target,omitempty" fhir:"cardinality=0..1"`
 	// Mappings for a concept from the source set
@@ -285,7 +472,7 @@ type ConceptMapGroup struct {
 
 // ConceptMap represents a FHIR ConceptMap.
 type ConceptMap struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this concept map, represented as a URI (globally unique)
@@ -377,21 +564,159 @@ type ConceptMap struct {
 	// Definition of an additional attribute to act as a data source or target
 	AdditionalAttribute []ConceptMapAdditionalAttribute `json:"additionalAttribute,omitempty" fhir:"cardinality=0..*,summary"`
 	// The source value set that contains the concepts that are being mapped - uri option
-	SourceScopeURI *string `json:"sourceScopeURI,omitempty" fhir:"cardinality=0..1,summary,choice=sourceScope"`
+	SourceScopeURI *string `json:"sourceScopeUri,omitempty" fhir:"cardinality=0..1,summary,choice=sourceScope"`
 	// Extension for SourceScopeURI
-	SourceScopeURIExt *primitives.PrimitiveExtension `json:"_sourceScopeURI,omitempty" fhir:"cardinality=0..1"`
+	SourceScopeURIExt *primitives.PrimitiveExtension `json:"_sourceScopeUri,omitempty" fhir:"cardinality=0..1"`
 	// The source value set that contains the concepts that are being mapped - canonical option
-	SourceScopeCanonical *string `json:"sourceScopeCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=sourceScope"`
+	SourceScopeCanonical *primitives.Canonical `json:"sourceScopeCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=sourceScope"`
 	// Extension for SourceScopeCanonical
 	SourceScopeCanonicalExt *primitives.PrimitiveExtension `json:"_sourceScopeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// The target value set which provides context for the mappings - uri option
-	TargetScopeURI *string `json:"targetScopeURI,omitempty" fhir:"cardinality=0..1,summary,choice=targetScope"`
+	TargetScopeURI *string `json:"targetScopeUri,omitempty" fhir:"cardinality=0..1,summary,choice=targetScope"`
 	// Extension for TargetScopeURI
-	TargetScopeURIExt *primitives.PrimitiveExtension `json:"_targetScopeURI,omitempty" fhir:"cardinality=0..1"`
+	TargetScopeURIExt *primitives.PrimitiveExtension `json:"_targetScopeUri,omitempty" fhir:"cardinality=0..1"`
 	// The target value set which provides context for the mappings - canonical option
-	TargetScopeCanonical *string `json:"targetScopeCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=targetScope"`
+	TargetScopeCanonical *primitives.Canonical `json:"targetScopeCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=targetScope"`
 	// Extension for TargetScopeCanonical
 	TargetScopeCanonicalExt *primitives.PrimitiveExtension `json:"_targetScopeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Same source and target systems
 	Group []ConceptMapGroup `json:"group,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ConceptMap", the FHIR type of the resource.
+func (c *ConceptMap) ResourceType() string {
+	return ResourceTypeConceptMap
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *ConceptMap) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *ConceptMap) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *ConceptMap) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *ConceptMap) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *ConceptMap) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
+
+// SourceScope returns the option of sourceScope[x] that is set, or nil if none is.
+func (c *ConceptMap) SourceScope() any {
+	switch {
+	case c.SourceScopeURI != nil:
+		return c.SourceScopeURI
+	case c.SourceScopeCanonical != nil:
+		return c.SourceScopeCanonical
+	}
+	return nil
+}
+
+// SourceScopeType returns the FHIR type of the option of sourceScope[x] that is
+// set, such as "uri", or "" if none is.
+func (c *ConceptMap) SourceScopeType() string {
+	switch {
+	case c.SourceScopeURI != nil:
+		return "uri"
+	case c.SourceScopeCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearSourceScope unsets every option of sourceScope[x], and their extensions.
+func (c *ConceptMap) ClearSourceScope() {
+	c.SourceScopeURI = nil
+	c.SourceScopeURIExt = nil
+	c.SourceScopeCanonical = nil
+	c.SourceScopeCanonicalExt = nil
+}
+
+// SetSourceScopeURI sets the uri option of sourceScope[x], clearing the others.
+func (c *ConceptMap) SetSourceScopeURI(value string) {
+	ext := c.SourceScopeURIExt
+	c.ClearSourceScope()
+	c.SourceScopeURI, c.SourceScopeURIExt = &value, ext
+}
+
+// SetSourceScopeCanonical sets the canonical option of sourceScope[x], clearing the others.
+func (c *ConceptMap) SetSourceScopeCanonical(value primitives.Canonical) {
+	ext := c.SourceScopeCanonicalExt
+	c.ClearSourceScope()
+	c.SourceScopeCanonical, c.SourceScopeCanonicalExt = &value, ext
+}
+
+// TargetScope returns the option of targetScope[x] that is set, or nil if none is.
+func (c *ConceptMap) TargetScope() any {
+	switch {
+	case c.TargetScopeURI != nil:
+		return c.TargetScopeURI
+	case c.TargetScopeCanonical != nil:
+		return c.TargetScopeCanonical
+	}
+	return nil
+}
+
+// TargetScopeType returns the FHIR type of the option of targetScope[x] that is
+// set, such as "uri", or "" if none is.
+func (c *ConceptMap) TargetScopeType() string {
+	switch {
+	case c.TargetScopeURI != nil:
+		return "uri"
+	case c.TargetScopeCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearTargetScope unsets every option of targetScope[x], and their extensions.
+func (c *ConceptMap) ClearTargetScope() {
+	c.TargetScopeURI = nil
+	c.TargetScopeURIExt = nil
+	c.TargetScopeCanonical = nil
+	c.TargetScopeCanonicalExt = nil
+}
+
+// SetTargetScopeURI sets the uri option of targetScope[x], clearing the others.
+func (c *ConceptMap) SetTargetScopeURI(value string) {
+	ext := c.TargetScopeURIExt
+	c.ClearTargetScope()
+	c.TargetScopeURI, c.TargetScopeURIExt = &value, ext
+}
+
+// SetTargetScopeCanonical sets the canonical option of targetScope[x], clearing the others.
+func (c *ConceptMap) SetTargetScopeCanonical(value primitives.Canonical) {
+	ext := c.TargetScopeCanonicalExt
+	c.ClearTargetScope()
+	c.TargetScopeCanonical, c.TargetScopeCanonicalExt = &value, ext
+}
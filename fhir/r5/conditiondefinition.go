@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -67,6 +66,47 @@ type ConditionDefinitionPrecondition struct {
 	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=0..1,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *ConditionDefinitionPrecondition) Value() any {
+	switch {
+	case c.ValueCodeableConcept != nil:
+		return c.ValueCodeableConcept
+	case c.ValueQuantity != nil:
+		return c.ValueQuantity
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ConditionDefinitionPrecondition) ValueType() string {
+	switch {
+	case c.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.ValueQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *ConditionDefinitionPrecondition) ClearValue() {
+	c.ValueCodeableConcept = nil
+	c.ValueQuantity = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (c *ConditionDefinitionPrecondition) SetValueCodeableConcept(value CodeableConcept) {
+	c.ClearValue()
+	c.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (c *ConditionDefinitionPrecondition) SetValueQuantity(value Quantity) {
+	c.ClearValue()
+	c.ValueQuantity = &value
+}
+
 // ConditionDefinitionQuestionnaire represents a FHIR BackboneElement for ConditionDefinition.questionnaire.
 type ConditionDefinitionQuestionnaire struct {
 	// Unique id for inter-element referencing
@@ -103,7 +143,7 @@ type ConditionDefinitionPlan struct {
 
 // ConditionDefinition represents a FHIR ConditionDefinition.
 type ConditionDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this condition definition, represented as a URI (globally unique)
@@ -197,3 +237,51 @@ type ConditionDefinition struct {
 	// Plan that is appropriate
 	Plan []ConditionDefinitionPlan `json:"plan,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ConditionDefinition", the FHIR type of the resource.
+func (c *ConditionDefinition) ResourceType() string {
+	return ResourceTypeConditionDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (c *ConditionDefinition) VersionAlgorithm() any {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return c.VersionAlgorithmString
+	case c.VersionAlgorithmCoding != nil:
+		return c.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (c *ConditionDefinition) VersionAlgorithmType() string {
+	switch {
+	case c.VersionAlgorithmString != nil:
+		return "string"
+	case c.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (c *ConditionDefinition) ClearVersionAlgorithm() {
+	c.VersionAlgorithmString = nil
+	c.VersionAlgorithmStringExt = nil
+	c.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (c *ConditionDefinition) SetVersionAlgorithmString(value string) {
+	ext := c.VersionAlgorithmStringExt
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmString, c.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (c *ConditionDefinition) SetVersionAlgorithmCoding(value Coding) {
+	c.ClearVersionAlgorithm()
+	c.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -133,7 +132,7 @@ type ConsentProvision struct {
 
 // Consent represents a FHIR Consent.
 type Consent struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier for this record (external references)
@@ -179,3 +178,8 @@ type Consent struct {
 	// Constraints to the base Consent.policyRule/Consent.policy
 	Provision []ConsentProvision `json:"provision,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Consent", the FHIR type of the resource.
+func (c *Consent) ResourceType() string {
+	return ResourceTypeConsent
+}
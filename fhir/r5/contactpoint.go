@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,7 +18,7 @@ type ContactPoint struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// phone | fax | email | pager | url | sms | other
-	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
+	System *ContactPointSystem `json:"system,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/contact-point-system|5.0.0,summary,type=code"`
 	// Extension for System
 	SystemExt *primitives.PrimitiveExtension `json:"_system,omitempty" fhir:"cardinality=0..1"`
 	// The actual contact point details
@@ -26,11 +26,11 @@ type ContactPoint struct {
 	// Extension for Value
 	ValueExt *primitives.PrimitiveExtension `json:"_value,omitempty" fhir:"cardinality=0..1"`
 	// home | work | temp | old | mobile - purpose of this contact point
-	Use *string `json:"use,omitempty" fhir:"cardinality=0..1,summary"`
+	Use *ContactPointUse `json:"use,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/contact-point-use|5.0.0,summary,type=code"`
 	// Extension for Use
 	UseExt *primitives.PrimitiveExtension `json:"_use,omitempty" fhir:"cardinality=0..1"`
 	// Specify preferred order of use (1 = highest)
-	Rank *int `json:"rank,omitempty" fhir:"cardinality=0..1,summary"`
+	Rank *int `json:"rank,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for Rank
 	RankExt *primitives.PrimitiveExtension `json:"_rank,omitempty" fhir:"cardinality=0..1"`
 	// Time period when the contact point was/is in use
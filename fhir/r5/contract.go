@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -92,45 +91,212 @@ type ContractTermOfferAnswer struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// The actual answer response - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// The actual answer response - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The actual answer response - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The actual answer response - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The actual answer response - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *ContractTermOfferAnswer) Value() any {
+	switch {
+	case c.ValueBoolean != nil:
+		return c.ValueBoolean
+	case c.ValueDecimal != nil:
+		return c.ValueDecimal
+	case c.ValueInteger != nil:
+		return c.ValueInteger
+	case c.ValueDate != nil:
+		return c.ValueDate
+	case c.ValueDateTime != nil:
+		return c.ValueDateTime
+	case c.ValueTime != nil:
+		return c.ValueTime
+	case c.ValueString != nil:
+		return c.ValueString
+	case c.ValueURI != nil:
+		return c.ValueURI
+	case c.ValueAttachment != nil:
+		return c.ValueAttachment
+	case c.ValueCoding != nil:
+		return c.ValueCoding
+	case c.ValueQuantity != nil:
+		return c.ValueQuantity
+	case c.ValueReference != nil:
+		return c.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (c *ContractTermOfferAnswer) ValueType() string {
+	switch {
+	case c.ValueBoolean != nil:
+		return "boolean"
+	case c.ValueDecimal != nil:
+		return "decimal"
+	case c.ValueInteger != nil:
+		return "integer"
+	case c.ValueDate != nil:
+		return "date"
+	case c.ValueDateTime != nil:
+		return "dateTime"
+	case c.ValueTime != nil:
+		return "time"
+	case c.ValueString != nil:
+		return "string"
+	case c.ValueURI != nil:
+		return "uri"
+	case c.ValueAttachment != nil:
+		return "Attachment"
+	case c.ValueCoding != nil:
+		return "Coding"
+	case c.ValueQuantity != nil:
+		return "Quantity"
+	case c.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *ContractTermOfferAnswer) ClearValue() {
+	c.ValueBoolean = nil
+	c.ValueBooleanExt = nil
+	c.ValueDecimal = nil
+	c.ValueDecimalExt = nil
+	c.ValueInteger = nil
+	c.ValueIntegerExt = nil
+	c.ValueDate = nil
+	c.ValueDateExt = nil
+	c.ValueDateTime = nil
+	c.ValueDateTimeExt = nil
+	c.ValueTime = nil
+	c.ValueTimeExt = nil
+	c.ValueString = nil
+	c.ValueStringExt = nil
+	c.ValueURI = nil
+	c.ValueURIExt = nil
+	c.ValueAttachment = nil
+	c.ValueCoding = nil
+	c.ValueQuantity = nil
+	c.ValueReference = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueBoolean(value bool) {
+	ext := c.ValueBooleanExt
+	c.ClearValue()
+	c.ValueBoolean, c.ValueBooleanExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueDecimal(value primitives.Decimal) {
+	ext := c.ValueDecimalExt
+	c.ClearValue()
+	c.ValueDecimal, c.ValueDecimalExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueInteger(value int) {
+	ext := c.ValueIntegerExt
+	c.ClearValue()
+	c.ValueInteger, c.ValueIntegerExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueDate(value primitives.Date) {
+	ext := c.ValueDateExt
+	c.ClearValue()
+	c.ValueDate, c.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueDateTime(value primitives.DateTime) {
+	ext := c.ValueDateTimeExt
+	c.ClearValue()
+	c.ValueDateTime, c.ValueDateTimeExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueTime(value primitives.Time) {
+	ext := c.ValueTimeExt
+	c.ClearValue()
+	c.ValueTime, c.ValueTimeExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueString(value string) {
+	ext := c.ValueStringExt
+	c.ClearValue()
+	c.ValueString, c.ValueStringExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueURI(value string) {
+	ext := c.ValueURIExt
+	c.ClearValue()
+	c.ValueURI, c.ValueURIExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueAttachment(value Attachment) {
+	c.ClearValue()
+	c.ValueAttachment = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueCoding(value Coding) {
+	c.ClearValue()
+	c.ValueCoding = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueQuantity(value Quantity) {
+	c.ClearValue()
+	c.ValueQuantity = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (c *ContractTermOfferAnswer) SetValueReference(value Reference) {
+	c.ClearValue()
+	c.ValueReference = &value
 }
 
 // ContractTermOffer represents a FHIR BackboneElement for Contract.term.offer.
@@ -220,11 +386,11 @@ type ContractTermAssetValuedItem struct {
 	// Contract Valued Item fee, charge, or cost
 	UnitPrice *Money `json:"unitPrice,omitempty" fhir:"cardinality=0..1"`
 	// Contract Valued Item Price Scaling Factor
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Contract Valued Item Difficulty Scaling Factor
-	Points *float64 `json:"points,omitempty" fhir:"cardinality=0..1"`
+	Points *primitives.Decimal `json:"points,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Points
 	PointsExt *primitives.PrimitiveExtension `json:"_points,omitempty" fhir:"cardinality=0..1"`
 	// Total Contract Valued Item Value
@@ -251,6 +417,47 @@ type ContractTermAssetValuedItem struct {
 	SecurityLabelNumberExt *primitives.PrimitiveExtension `json:"_securityLabelNumber,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Entity returns the option of entity[x] that is set, or nil if none is.
+func (c *ContractTermAssetValuedItem) Entity() any {
+	switch {
+	case c.EntityCodeableConcept != nil:
+		return c.EntityCodeableConcept
+	case c.EntityReference != nil:
+		return c.EntityReference
+	}
+	return nil
+}
+
+// EntityType returns the FHIR type of the option of entity[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ContractTermAssetValuedItem) EntityType() string {
+	switch {
+	case c.EntityCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.EntityReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearEntity unsets every option of entity[x], and their extensions.
+func (c *ContractTermAssetValuedItem) ClearEntity() {
+	c.EntityCodeableConcept = nil
+	c.EntityReference = nil
+}
+
+// SetEntityCodeableConcept sets the CodeableConcept option of entity[x], clearing the others.
+func (c *ContractTermAssetValuedItem) SetEntityCodeableConcept(value CodeableConcept) {
+	c.ClearEntity()
+	c.EntityCodeableConcept = &value
+}
+
+// SetEntityReference sets the Reference option of entity[x], clearing the others.
+func (c *ContractTermAssetValuedItem) SetEntityReference(value Reference) {
+	c.ClearEntity()
+	c.EntityReference = &value
+}
+
 // ContractTermAsset represents a FHIR BackboneElement for Contract.term.asset.
 type ContractTermAsset struct {
 	// Unique id for inter-element referencing
@@ -387,6 +594,60 @@ type ContractTermAction struct {
 	SecurityLabelNumberExt *primitives.PrimitiveExtension `json:"_securityLabelNumber,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (c *ContractTermAction) Occurrence() any {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return c.OccurrenceDateTime
+	case c.OccurrencePeriod != nil:
+		return c.OccurrencePeriod
+	case c.OccurrenceTiming != nil:
+		return c.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *ContractTermAction) OccurrenceType() string {
+	switch {
+	case c.OccurrenceDateTime != nil:
+		return "dateTime"
+	case c.OccurrencePeriod != nil:
+		return "Period"
+	case c.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (c *ContractTermAction) ClearOccurrence() {
+	c.OccurrenceDateTime = nil
+	c.OccurrenceDateTimeExt = nil
+	c.OccurrencePeriod = nil
+	c.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (c *ContractTermAction) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := c.OccurrenceDateTimeExt
+	c.ClearOccurrence()
+	c.OccurrenceDateTime, c.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (c *ContractTermAction) SetOccurrencePeriod(value Period) {
+	c.ClearOccurrence()
+	c.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (c *ContractTermAction) SetOccurrenceTiming(value Timing) {
+	c.ClearOccurrence()
+	c.OccurrenceTiming = &value
+}
+
 // ContractTermGroup represents a FHIR BackboneElement for Contract.term.group.
 type ContractTermGroup struct {
 }
@@ -433,6 +694,47 @@ type ContractTerm struct {
 	Group []ContractTermGroup `json:"group,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Topic returns the option of topic[x] that is set, or nil if none is.
+func (c *ContractTerm) Topic() any {
+	switch {
+	case c.TopicCodeableConcept != nil:
+		return c.TopicCodeableConcept
+	case c.TopicReference != nil:
+		return c.TopicReference
+	}
+	return nil
+}
+
+// TopicType returns the FHIR type of the option of topic[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *ContractTerm) TopicType() string {
+	switch {
+	case c.TopicCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.TopicReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearTopic unsets every option of topic[x], and their extensions.
+func (c *ContractTerm) ClearTopic() {
+	c.TopicCodeableConcept = nil
+	c.TopicReference = nil
+}
+
+// SetTopicCodeableConcept sets the CodeableConcept option of topic[x], clearing the others.
+func (c *ContractTerm) SetTopicCodeableConcept(value CodeableConcept) {
+	c.ClearTopic()
+	c.TopicCodeableConcept = &value
+}
+
+// SetTopicReference sets the Reference option of topic[x], clearing the others.
+func (c *ContractTerm) SetTopicReference(value Reference) {
+	c.ClearTopic()
+	c.TopicReference = &value
+}
+
 // ContractSigner represents a FHIR BackboneElement for Contract.signer.
 type ContractSigner struct {
 	// Unique id for inter-element referencing
@@ -462,9 +764,50 @@ type ContractFriendly struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Easily comprehended representation of this Contract - Attachment option
-	ContentAttachment Attachment `json:"contentAttachment" fhir:"cardinality=1..1,required,choice=content"`
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Easily comprehended representation of this Contract - Reference option
-	ContentReference Reference `json:"contentReference" fhir:"cardinality=1..1,required,choice=content"`
+	ContentReference *Reference `json:"contentReference,omitempty" fhir:"cardinality=1..1,required,choice=content"`
+}
+
+// Content returns the option of content[x] that is set, or nil if none is.
+func (c *ContractFriendly) Content() any {
+	switch {
+	case c.ContentAttachment != nil:
+		return c.ContentAttachment
+	case c.ContentReference != nil:
+		return c.ContentReference
+	}
+	return nil
+}
+
+// ContentType returns the FHIR type of the option of content[x] that is
+// set, such as "Attachment", or "" if none is.
+func (c *ContractFriendly) ContentType() string {
+	switch {
+	case c.ContentAttachment != nil:
+		return "Attachment"
+	case c.ContentReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearContent unsets every option of content[x], and their extensions.
+func (c *ContractFriendly) ClearContent() {
+	c.ContentAttachment = nil
+	c.ContentReference = nil
+}
+
+// SetContentAttachment sets the Attachment option of content[x], clearing the others.
+func (c *ContractFriendly) SetContentAttachment(value Attachment) {
+	c.ClearContent()
+	c.ContentAttachment = &value
+}
+
+// SetContentReference sets the Reference option of content[x], clearing the others.
+func (c *ContractFriendly) SetContentReference(value Reference) {
+	c.ClearContent()
+	c.ContentReference = &value
 }
 
 // ContractLegal represents a FHIR BackboneElement for Contract.legal.
@@ -478,9 +821,50 @@ type ContractLegal struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Contract Legal Text - Attachment option
-	ContentAttachment Attachment `json:"contentAttachment" fhir:"cardinality=1..1,required,choice=content"`
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Contract Legal Text - Reference option
-	ContentReference Reference `json:"contentReference" fhir:"cardinality=1..1,required,choice=content"`
+	ContentReference *Reference `json:"contentReference,omitempty" fhir:"cardinality=1..1,required,choice=content"`
+}
+
+// Content returns the option of content[x] that is set, or nil if none is.
+func (c *ContractLegal) Content() any {
+	switch {
+	case c.ContentAttachment != nil:
+		return c.ContentAttachment
+	case c.ContentReference != nil:
+		return c.ContentReference
+	}
+	return nil
+}
+
+// ContentType returns the FHIR type of the option of content[x] that is
+// set, such as "Attachment", or "" if none is.
+func (c *ContractLegal) ContentType() string {
+	switch {
+	case c.ContentAttachment != nil:
+		return "Attachment"
+	case c.ContentReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearContent unsets every option of content[x], and their extensions.
+func (c *ContractLegal) ClearContent() {
+	c.ContentAttachment = nil
+	c.ContentReference = nil
+}
+
+// SetContentAttachment sets the Attachment option of content[x], clearing the others.
+func (c *ContractLegal) SetContentAttachment(value Attachment) {
+	c.ClearContent()
+	c.ContentAttachment = &value
+}
+
+// SetContentReference sets the Reference option of content[x], clearing the others.
+func (c *ContractLegal) SetContentReference(value Reference) {
+	c.ClearContent()
+	c.ContentReference = &value
 }
 
 // ContractRule represents a FHIR BackboneElement for Contract.rule.
@@ -494,14 +878,55 @@ type ContractRule struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Computable Contract Rules - Attachment option
-	ContentAttachment Attachment `json:"contentAttachment" fhir:"cardinality=1..1,required,choice=content"`
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty" fhir:"cardinality=1..1,required,choice=content"`
 	// Computable Contract Rules - Reference option
-	ContentReference Reference `json:"contentReference" fhir:"cardinality=1..1,required,choice=content"`
+	ContentReference *Reference `json:"contentReference,omitempty" fhir:"cardinality=1..1,required,choice=content"`
+}
+
+// Content returns the option of content[x] that is set, or nil if none is.
+func (c *ContractRule) Content() any {
+	switch {
+	case c.ContentAttachment != nil:
+		return c.ContentAttachment
+	case c.ContentReference != nil:
+		return c.ContentReference
+	}
+	return nil
+}
+
+// ContentType returns the FHIR type of the option of content[x] that is
+// set, such as "Attachment", or "" if none is.
+func (c *ContractRule) ContentType() string {
+	switch {
+	case c.ContentAttachment != nil:
+		return "Attachment"
+	case c.ContentReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearContent unsets every option of content[x], and their extensions.
+func (c *ContractRule) ClearContent() {
+	c.ContentAttachment = nil
+	c.ContentReference = nil
+}
+
+// SetContentAttachment sets the Attachment option of content[x], clearing the others.
+func (c *ContractRule) SetContentAttachment(value Attachment) {
+	c.ClearContent()
+	c.ContentAttachment = &value
+}
+
+// SetContentReference sets the Reference option of content[x], clearing the others.
+func (c *ContractRule) SetContentReference(value Reference) {
+	c.ClearContent()
+	c.ContentReference = &value
 }
 
 // Contract represents a FHIR Contract.
 type Contract struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Contract number
@@ -593,3 +1018,90 @@ type Contract struct {
 	// Binding Contract - Reference option
 	LegallyBindingReference *Reference `json:"legallyBindingReference,omitempty" fhir:"cardinality=0..1,choice=legallyBinding"`
 }
+
+// ResourceType returns "Contract", the FHIR type of the resource.
+func (c *Contract) ResourceType() string {
+	return ResourceTypeContract
+}
+
+// Topic returns the option of topic[x] that is set, or nil if none is.
+func (c *Contract) Topic() any {
+	switch {
+	case c.TopicCodeableConcept != nil:
+		return c.TopicCodeableConcept
+	case c.TopicReference != nil:
+		return c.TopicReference
+	}
+	return nil
+}
+
+// TopicType returns the FHIR type of the option of topic[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *Contract) TopicType() string {
+	switch {
+	case c.TopicCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.TopicReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearTopic unsets every option of topic[x], and their extensions.
+func (c *Contract) ClearTopic() {
+	c.TopicCodeableConcept = nil
+	c.TopicReference = nil
+}
+
+// SetTopicCodeableConcept sets the CodeableConcept option of topic[x], clearing the others.
+func (c *Contract) SetTopicCodeableConcept(value CodeableConcept) {
+	c.ClearTopic()
+	c.TopicCodeableConcept = &value
+}
+
+// SetTopicReference sets the Reference option of topic[x], clearing the others.
+func (c *Contract) SetTopicReference(value Reference) {
+	c.ClearTopic()
+	c.TopicReference = &value
+}
+
+// LegallyBinding returns the option of legallyBinding[x] that is set, or nil if none is.
+func (c *Contract) LegallyBinding() any {
+	switch {
+	case c.LegallyBindingAttachment != nil:
+		return c.LegallyBindingAttachment
+	case c.LegallyBindingReference != nil:
+		return c.LegallyBindingReference
+	}
+	return nil
+}
+
+// LegallyBindingType returns the FHIR type of the option of legallyBinding[x] that is
+// set, such as "Attachment", or "" if none is.
+func (c *Contract) LegallyBindingType() string {
+	switch {
+	case c.LegallyBindingAttachment != nil:
+		return "Attachment"
+	case c.LegallyBindingReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearLegallyBinding unsets every option of legallyBinding[x], and their extensions.
+func (c *Contract) ClearLegallyBinding() {
+	c.LegallyBindingAttachment = nil
+	c.LegallyBindingReference = nil
+}
+
+// SetLegallyBindingAttachment sets the Attachment option of legallyBinding[x], clearing the others.
+func (c *Contract) SetLegallyBindingAttachment(value Attachment) {
+	c.ClearLegallyBinding()
+	c.LegallyBindingAttachment = &value
+}
+
+// SetLegallyBindingReference sets the Reference option of legallyBinding[x], clearing the others.
+func (c *Contract) SetLegallyBindingReference(value Reference) {
+	c.ClearLegallyBinding()
+	c.LegallyBindingReference = &value
+}
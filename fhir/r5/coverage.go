@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -95,9 +94,50 @@ type CoverageCostToBeneficiary struct {
 	Exception []CoverageCostToBeneficiaryException `json:"exception,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (c *CoverageCostToBeneficiary) Value() any {
+	switch {
+	case c.ValueQuantity != nil:
+		return c.ValueQuantity
+	case c.ValueMoney != nil:
+		return c.ValueMoney
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (c *CoverageCostToBeneficiary) ValueType() string {
+	switch {
+	case c.ValueQuantity != nil:
+		return "Quantity"
+	case c.ValueMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (c *CoverageCostToBeneficiary) ClearValue() {
+	c.ValueQuantity = nil
+	c.ValueMoney = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (c *CoverageCostToBeneficiary) SetValueQuantity(value Quantity) {
+	c.ClearValue()
+	c.ValueQuantity = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (c *CoverageCostToBeneficiary) SetValueMoney(value Money) {
+	c.ClearValue()
+	c.ValueMoney = &value
+}
+
 // Coverage represents a FHIR Coverage.
 type Coverage struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier(s) for this coverage
@@ -153,3 +193,8 @@ type Coverage struct {
 	// Insurance plan details
 	InsurancePlan *Reference `json:"insurancePlan,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Coverage", the FHIR type of the resource.
+func (c *Coverage) ResourceType() string {
+	return ResourceTypeCoverage
+}
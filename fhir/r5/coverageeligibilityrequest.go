@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,11 +25,54 @@ type CoverageEligibilityRequestEvent struct {
 	// Specific event
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Occurance date or period - dateTime option
-	WhenDateTime primitives.DateTime `json:"whenDateTime" fhir:"cardinality=1..1,required,choice=when"`
+	WhenDateTime *primitives.DateTime `json:"whenDateTime,omitempty" fhir:"cardinality=1..1,required,choice=when"`
 	// Extension for WhenDateTime
 	WhenDateTimeExt *primitives.PrimitiveExtension `json:"_whenDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Occurance date or period - Period option
-	WhenPeriod Period `json:"whenPeriod" fhir:"cardinality=1..1,required,choice=when"`
+	WhenPeriod *Period `json:"whenPeriod,omitempty" fhir:"cardinality=1..1,required,choice=when"`
+}
+
+// When returns the option of when[x] that is set, or nil if none is.
+func (c *CoverageEligibilityRequestEvent) When() any {
+	switch {
+	case c.WhenDateTime != nil:
+		return c.WhenDateTime
+	case c.WhenPeriod != nil:
+		return c.WhenPeriod
+	}
+	return nil
+}
+
+// WhenType returns the FHIR type of the option of when[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *CoverageEligibilityRequestEvent) WhenType() string {
+	switch {
+	case c.WhenDateTime != nil:
+		return "dateTime"
+	case c.WhenPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearWhen unsets every option of when[x], and their extensions.
+func (c *CoverageEligibilityRequestEvent) ClearWhen() {
+	c.WhenDateTime = nil
+	c.WhenDateTimeExt = nil
+	c.WhenPeriod = nil
+}
+
+// SetWhenDateTime sets the dateTime option of when[x], clearing the others.
+func (c *CoverageEligibilityRequestEvent) SetWhenDateTime(value primitives.DateTime) {
+	ext := c.WhenDateTimeExt
+	c.ClearWhen()
+	c.WhenDateTime, c.WhenDateTimeExt = &value, ext
+}
+
+// SetWhenPeriod sets the Period option of when[x], clearing the others.
+func (c *CoverageEligibilityRequestEvent) SetWhenPeriod(value Period) {
+	c.ClearWhen()
+	c.WhenPeriod = &value
 }
 
 // CoverageEligibilityRequestSupportingInfo represents a FHIR BackboneElement for CoverageEligibilityRequest.supportingInfo.
@@ -93,6 +135,47 @@ type CoverageEligibilityRequestItemDiagnosis struct {
 	DiagnosisReference *Reference `json:"diagnosisReference,omitempty" fhir:"cardinality=0..1,choice=diagnosis"`
 }
 
+// Diagnosis returns the option of diagnosis[x] that is set, or nil if none is.
+func (c *CoverageEligibilityRequestItemDiagnosis) Diagnosis() any {
+	switch {
+	case c.DiagnosisCodeableConcept != nil:
+		return c.DiagnosisCodeableConcept
+	case c.DiagnosisReference != nil:
+		return c.DiagnosisReference
+	}
+	return nil
+}
+
+// DiagnosisType returns the FHIR type of the option of diagnosis[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (c *CoverageEligibilityRequestItemDiagnosis) DiagnosisType() string {
+	switch {
+	case c.DiagnosisCodeableConcept != nil:
+		return "CodeableConcept"
+	case c.DiagnosisReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearDiagnosis unsets every option of diagnosis[x], and their extensions.
+func (c *CoverageEligibilityRequestItemDiagnosis) ClearDiagnosis() {
+	c.DiagnosisCodeableConcept = nil
+	c.DiagnosisReference = nil
+}
+
+// SetDiagnosisCodeableConcept sets the CodeableConcept option of diagnosis[x], clearing the others.
+func (c *CoverageEligibilityRequestItemDiagnosis) SetDiagnosisCodeableConcept(value CodeableConcept) {
+	c.ClearDiagnosis()
+	c.DiagnosisCodeableConcept = &value
+}
+
+// SetDiagnosisReference sets the Reference option of diagnosis[x], clearing the others.
+func (c *CoverageEligibilityRequestItemDiagnosis) SetDiagnosisReference(value Reference) {
+	c.ClearDiagnosis()
+	c.DiagnosisReference = &value
+}
+
 // CoverageEligibilityRequestItem represents a FHIR BackboneElement for CoverageEligibilityRequest.item.
 type CoverageEligibilityRequestItem struct {
 	// Unique id for inter-element referencing
@@ -129,7 +212,7 @@ type CoverageEligibilityRequestItem struct {
 
 // CoverageEligibilityRequest represents a FHIR CoverageEligibilityRequest.
 type CoverageEligibilityRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for coverage eligiblity request
@@ -173,3 +256,51 @@ type CoverageEligibilityRequest struct {
 	// Item to be evaluated for eligibiity
 	Item []CoverageEligibilityRequestItem `json:"item,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CoverageEligibilityRequest", the FHIR type of the resource.
+func (c *CoverageEligibilityRequest) ResourceType() string {
+	return ResourceTypeCoverageEligibilityRequest
+}
+
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (c *CoverageEligibilityRequest) Serviced() any {
+	switch {
+	case c.ServicedDate != nil:
+		return c.ServicedDate
+	case c.ServicedPeriod != nil:
+		return c.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (c *CoverageEligibilityRequest) ServicedType() string {
+	switch {
+	case c.ServicedDate != nil:
+		return "date"
+	case c.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (c *CoverageEligibilityRequest) ClearServiced() {
+	c.ServicedDate = nil
+	c.ServicedDateExt = nil
+	c.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (c *CoverageEligibilityRequest) SetServicedDate(value primitives.Date) {
+	ext := c.ServicedDateExt
+	c.ClearServiced()
+	c.ServicedDate, c.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (c *CoverageEligibilityRequest) SetServicedPeriod(value Period) {
+	c.ClearServiced()
+	c.ServicedPeriod = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,11 +25,54 @@ type CoverageEligibilityResponseEvent struct {
 	// Specific event
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Occurance date or period - dateTime option
-	WhenDateTime primitives.DateTime `json:"whenDateTime" fhir:"cardinality=1..1,required,choice=when"`
+	WhenDateTime *primitives.DateTime `json:"whenDateTime,omitempty" fhir:"cardinality=1..1,required,choice=when"`
 	// Extension for WhenDateTime
 	WhenDateTimeExt *primitives.PrimitiveExtension `json:"_whenDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Occurance date or period - Period option
-	WhenPeriod Period `json:"whenPeriod" fhir:"cardinality=1..1,required,choice=when"`
+	WhenPeriod *Period `json:"whenPeriod,omitempty" fhir:"cardinality=1..1,required,choice=when"`
+}
+
+// When returns the option of when[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponseEvent) When() any {
+	switch {
+	case c.WhenDateTime != nil:
+		return c.WhenDateTime
+	case c.WhenPeriod != nil:
+		return c.WhenPeriod
+	}
+	return nil
+}
+
+// WhenType returns the FHIR type of the option of when[x] that is
+// set, such as "dateTime", or "" if none is.
+func (c *CoverageEligibilityResponseEvent) WhenType() string {
+	switch {
+	case c.WhenDateTime != nil:
+		return "dateTime"
+	case c.WhenPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearWhen unsets every option of when[x], and their extensions.
+func (c *CoverageEligibilityResponseEvent) ClearWhen() {
+	c.WhenDateTime = nil
+	c.WhenDateTimeExt = nil
+	c.WhenPeriod = nil
+}
+
+// SetWhenDateTime sets the dateTime option of when[x], clearing the others.
+func (c *CoverageEligibilityResponseEvent) SetWhenDateTime(value primitives.DateTime) {
+	ext := c.WhenDateTimeExt
+	c.ClearWhen()
+	c.WhenDateTime, c.WhenDateTimeExt = &value, ext
+}
+
+// SetWhenPeriod sets the Period option of when[x], clearing the others.
+func (c *CoverageEligibilityResponseEvent) SetWhenPeriod(value Period) {
+	c.ClearWhen()
+	c.WhenPeriod = &value
 }
 
 // CoverageEligibilityResponseInsuranceItemBenefit represents a FHIR BackboneElement for CoverageEligibilityResponse.insurance.item.benefit.
@@ -67,6 +109,118 @@ type CoverageEligibilityResponseInsuranceItemBenefit struct {
 	UsedMoney *Money `json:"usedMoney,omitempty" fhir:"cardinality=0..1,choice=used"`
 }
 
+// Allowed returns the option of allowed[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) Allowed() any {
+	switch {
+	case c.AllowedUnsignedInt != nil:
+		return c.AllowedUnsignedInt
+	case c.AllowedString != nil:
+		return c.AllowedString
+	case c.AllowedMoney != nil:
+		return c.AllowedMoney
+	}
+	return nil
+}
+
+// AllowedType returns the FHIR type of the option of allowed[x] that is
+// set, such as "unsignedInt", or "" if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) AllowedType() string {
+	switch {
+	case c.AllowedUnsignedInt != nil:
+		return "unsignedInt"
+	case c.AllowedString != nil:
+		return "string"
+	case c.AllowedMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearAllowed unsets every option of allowed[x], and their extensions.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) ClearAllowed() {
+	c.AllowedUnsignedInt = nil
+	c.AllowedUnsignedIntExt = nil
+	c.AllowedString = nil
+	c.AllowedStringExt = nil
+	c.AllowedMoney = nil
+}
+
+// SetAllowedUnsignedInt sets the unsignedInt option of allowed[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedUnsignedInt(value uint) {
+	ext := c.AllowedUnsignedIntExt
+	c.ClearAllowed()
+	c.AllowedUnsignedInt, c.AllowedUnsignedIntExt = &value, ext
+}
+
+// SetAllowedString sets the string option of allowed[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedString(value string) {
+	ext := c.AllowedStringExt
+	c.ClearAllowed()
+	c.AllowedString, c.AllowedStringExt = &value, ext
+}
+
+// SetAllowedMoney sets the Money option of allowed[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowedMoney(value Money) {
+	c.ClearAllowed()
+	c.AllowedMoney = &value
+}
+
+// Used returns the option of used[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) Used() any {
+	switch {
+	case c.UsedUnsignedInt != nil:
+		return c.UsedUnsignedInt
+	case c.UsedString != nil:
+		return c.UsedString
+	case c.UsedMoney != nil:
+		return c.UsedMoney
+	}
+	return nil
+}
+
+// UsedType returns the FHIR type of the option of used[x] that is
+// set, such as "unsignedInt", or "" if none is.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) UsedType() string {
+	switch {
+	case c.UsedUnsignedInt != nil:
+		return "unsignedInt"
+	case c.UsedString != nil:
+		return "string"
+	case c.UsedMoney != nil:
+		return "Money"
+	}
+	return ""
+}
+
+// ClearUsed unsets every option of used[x], and their extensions.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) ClearUsed() {
+	c.UsedUnsignedInt = nil
+	c.UsedUnsignedIntExt = nil
+	c.UsedString = nil
+	c.UsedStringExt = nil
+	c.UsedMoney = nil
+}
+
+// SetUsedUnsignedInt sets the unsignedInt option of used[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedUnsignedInt(value uint) {
+	ext := c.UsedUnsignedIntExt
+	c.ClearUsed()
+	c.UsedUnsignedInt, c.UsedUnsignedIntExt = &value, ext
+}
+
+// SetUsedString sets the string option of used[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedString(value string) {
+	ext := c.UsedStringExt
+	c.ClearUsed()
+	c.UsedString, c.UsedStringExt = &value, ext
+}
+
+// SetUsedMoney sets the Money option of used[x], clearing the others.
+func (c *CoverageEligibilityResponseInsuranceItemBenefit) SetUsedMoney(value Money) {
+	c.ClearUsed()
+	c.UsedMoney = &value
+}
+
 // CoverageEligibilityResponseInsuranceItem represents a FHIR BackboneElement for CoverageEligibilityResponse.insurance.item.
 type CoverageEligibilityResponseInsuranceItem struct {
 	// Unique id for inter-element referencing
@@ -159,7 +313,7 @@ type CoverageEligibilityResponseError struct {
 
 // CoverageEligibilityResponse represents a FHIR CoverageEligibilityResponse.
 type CoverageEligibilityResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for coverage eligiblity request
@@ -211,3 +365,51 @@ type CoverageEligibilityResponse struct {
 	// Processing errors
 	Error []CoverageEligibilityResponseError `json:"error,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "CoverageEligibilityResponse", the FHIR type of the resource.
+func (c *CoverageEligibilityResponse) ResourceType() string {
+	return ResourceTypeCoverageEligibilityResponse
+}
+
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (c *CoverageEligibilityResponse) Serviced() any {
+	switch {
+	case c.ServicedDate != nil:
+		return c.ServicedDate
+	case c.ServicedPeriod != nil:
+		return c.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (c *CoverageEligibilityResponse) ServicedType() string {
+	switch {
+	case c.ServicedDate != nil:
+		return "date"
+	case c.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (c *CoverageEligibilityResponse) ClearServiced() {
+	c.ServicedDate = nil
+	c.ServicedDateExt = nil
+	c.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (c *CoverageEligibilityResponse) SetServicedDate(value primitives.Date) {
+	ext := c.ServicedDateExt
+	c.ClearServiced()
+	c.ServicedDate, c.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (c *CoverageEligibilityResponse) SetServicedPeriod(value Period) {
+	c.ClearServiced()
+	c.ServicedPeriod = &value
+}
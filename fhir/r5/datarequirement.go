@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -26,7 +26,7 @@ type DataRequirementCodeFilter struct {
 	// Extension for SearchParam
 	SearchParamExt *primitives.PrimitiveExtension `json:"_searchParam,omitempty" fhir:"cardinality=0..1"`
 	// ValueSet for the filter
-	ValueSet *string `json:"valueSet,omitempty" fhir:"cardinality=0..1,summary"`
+	ValueSet *primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 	// What code is expected
@@ -59,6 +59,60 @@ type DataRequirementDateFilter struct {
 	ValueDuration *Duration `json:"valueDuration,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DataRequirementDateFilter) Value() any {
+	switch {
+	case d.ValueDateTime != nil:
+		return d.ValueDateTime
+	case d.ValuePeriod != nil:
+		return d.ValuePeriod
+	case d.ValueDuration != nil:
+		return d.ValueDuration
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "dateTime", or "" if none is.
+func (d *DataRequirementDateFilter) ValueType() string {
+	switch {
+	case d.ValueDateTime != nil:
+		return "dateTime"
+	case d.ValuePeriod != nil:
+		return "Period"
+	case d.ValueDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DataRequirementDateFilter) ClearValue() {
+	d.ValueDateTime = nil
+	d.ValueDateTimeExt = nil
+	d.ValuePeriod = nil
+	d.ValueDuration = nil
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (d *DataRequirementDateFilter) SetValueDateTime(value primitives.DateTime) {
+	ext := d.ValueDateTimeExt
+	d.ClearValue()
+	d.ValueDateTime, d.ValueDateTimeExt = &value, ext
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (d *DataRequirementDateFilter) SetValuePeriod(value Period) {
+	d.ClearValue()
+	d.ValuePeriod = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (d *DataRequirementDateFilter) SetValueDuration(value Duration) {
+	d.ClearValue()
+	d.ValueDuration = &value
+}
+
 // DataRequirementValueFilter represents a FHIR BackboneElement for DataRequirement.valueFilter.
 type DataRequirementValueFilter struct {
 	// Unique id for inter-element referencing
@@ -76,7 +130,7 @@ type DataRequirementValueFilter struct {
 	// Extension for SearchParam
 	SearchParamExt *primitives.PrimitiveExtension `json:"_searchParam,omitempty" fhir:"cardinality=0..1"`
 	// eq | gt | lt | ge | le | sa | eb
-	Comparator *string `json:"comparator,omitempty" fhir:"cardinality=0..1,summary"`
+	Comparator *string `json:"comparator,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/value-filter-comparator|5.0.0,summary,type=code"`
 	// Extension for Comparator
 	ComparatorExt *primitives.PrimitiveExtension `json:"_comparator,omitempty" fhir:"cardinality=0..1"`
 	// The value of the filter, as a Period, DateTime, or Duration value - dateTime option
@@ -89,6 +143,60 @@ type DataRequirementValueFilter struct {
 	ValueDuration *Duration `json:"valueDuration,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DataRequirementValueFilter) Value() any {
+	switch {
+	case d.ValueDateTime != nil:
+		return d.ValueDateTime
+	case d.ValuePeriod != nil:
+		return d.ValuePeriod
+	case d.ValueDuration != nil:
+		return d.ValueDuration
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "dateTime", or "" if none is.
+func (d *DataRequirementValueFilter) ValueType() string {
+	switch {
+	case d.ValueDateTime != nil:
+		return "dateTime"
+	case d.ValuePeriod != nil:
+		return "Period"
+	case d.ValueDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DataRequirementValueFilter) ClearValue() {
+	d.ValueDateTime = nil
+	d.ValueDateTimeExt = nil
+	d.ValuePeriod = nil
+	d.ValueDuration = nil
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (d *DataRequirementValueFilter) SetValueDateTime(value primitives.DateTime) {
+	ext := d.ValueDateTimeExt
+	d.ClearValue()
+	d.ValueDateTime, d.ValueDateTimeExt = &value, ext
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (d *DataRequirementValueFilter) SetValuePeriod(value Period) {
+	d.ClearValue()
+	d.ValuePeriod = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (d *DataRequirementValueFilter) SetValueDuration(value Duration) {
+	d.ClearValue()
+	d.ValueDuration = &value
+}
+
 // DataRequirementSort represents a FHIR BackboneElement for DataRequirement.sort.
 type DataRequirementSort struct {
 	// Unique id for inter-element referencing
@@ -102,7 +210,7 @@ type DataRequirementSort struct {
 	// Extension for Path
 	PathExt *primitives.PrimitiveExtension `json:"_path,omitempty" fhir:"cardinality=0..1"`
 	// ascending | descending
-	Direction string `json:"direction" fhir:"cardinality=1..1,required,summary"`
+	Direction SortDirection `json:"direction" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/sort-direction|5.0.0,summary,type=code"`
 	// Extension for Direction
 	DirectionExt *primitives.PrimitiveExtension `json:"_direction,omitempty" fhir:"cardinality=0..1"`
 }
@@ -116,17 +224,17 @@ type DataRequirement struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// The type of the required data
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type string `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/fhir-types|5.0.0,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// The profile of the required data
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
+	Profile []primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device - CodeableConcept option
-	SubjectCodeableConcept *CodeableConcept `json:"subjectCodeableConcept,omitempty" fhir:"cardinality=0..1,summary,choice=subject"`
+	SubjectCodeableConcept *CodeableConcept `json:"subjectCodeableConcept,omitempty" fhir:"cardinality=0..1,binding=extensible:http://hl7.org/fhir/ValueSet/participant-resource-types,summary,choice=subject"`
 	// E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device - Reference option
-	SubjectReference *Reference `json:"subjectReference,omitempty" fhir:"cardinality=0..1,summary,choice=subject"`
+	SubjectReference *Reference `json:"subjectReference,omitempty" fhir:"cardinality=0..1,binding=extensible:http://hl7.org/fhir/ValueSet/participant-resource-types,summary,choice=subject"`
 	// Indicates specific structure elements that are referenced by the knowledge module
 	MustSupport []string `json:"mustSupport,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for MustSupport
@@ -138,9 +246,50 @@ type DataRequirement struct {
 	// What values are expected
 	ValueFilter []DataRequirementValueFilter `json:"valueFilter,omitempty" fhir:"cardinality=0..*,summary"`
 	// Number of results
-	Limit *int `json:"limit,omitempty" fhir:"cardinality=0..1,summary"`
+	Limit *int `json:"limit,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for Limit
 	LimitExt *primitives.PrimitiveExtension `json:"_limit,omitempty" fhir:"cardinality=0..1"`
 	// Order of the results
 	Sort []DataRequirementSort `json:"sort,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (d *DataRequirement) Subject() any {
+	switch {
+	case d.SubjectCodeableConcept != nil:
+		return d.SubjectCodeableConcept
+	case d.SubjectReference != nil:
+		return d.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (d *DataRequirement) SubjectType() string {
+	switch {
+	case d.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case d.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (d *DataRequirement) ClearSubject() {
+	d.SubjectCodeableConcept = nil
+	d.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (d *DataRequirement) SetSubjectCodeableConcept(value CodeableConcept) {
+	d.ClearSubject()
+	d.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (d *DataRequirement) SetSubjectReference(value Reference) {
+	d.ClearSubject()
+	d.SubjectReference = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type DetectedIssueMitigation struct {
 
 // DetectedIssue represents a FHIR DetectedIssue.
 type DetectedIssue struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique id for the detected issue
@@ -97,3 +96,51 @@ type DetectedIssue struct {
 	// Step taken to address
 	Mitigation []DetectedIssueMitigation `json:"mitigation,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DetectedIssue", the FHIR type of the resource.
+func (d *DetectedIssue) ResourceType() string {
+	return ResourceTypeDetectedIssue
+}
+
+// Identified returns the option of identified[x] that is set, or nil if none is.
+func (d *DetectedIssue) Identified() any {
+	switch {
+	case d.IdentifiedDateTime != nil:
+		return d.IdentifiedDateTime
+	case d.IdentifiedPeriod != nil:
+		return d.IdentifiedPeriod
+	}
+	return nil
+}
+
+// IdentifiedType returns the FHIR type of the option of identified[x] that is
+// set, such as "dateTime", or "" if none is.
+func (d *DetectedIssue) IdentifiedType() string {
+	switch {
+	case d.IdentifiedDateTime != nil:
+		return "dateTime"
+	case d.IdentifiedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearIdentified unsets every option of identified[x], and their extensions.
+func (d *DetectedIssue) ClearIdentified() {
+	d.IdentifiedDateTime = nil
+	d.IdentifiedDateTimeExt = nil
+	d.IdentifiedPeriod = nil
+}
+
+// SetIdentifiedDateTime sets the dateTime option of identified[x], clearing the others.
+func (d *DetectedIssue) SetIdentifiedDateTime(value primitives.DateTime) {
+	ext := d.IdentifiedDateTimeExt
+	d.ClearIdentified()
+	d.IdentifiedDateTime, d.IdentifiedDateTimeExt = &value, ext
+}
+
+// SetIdentifiedPeriod sets the Period option of identified[x], clearing the others.
+func (d *DetectedIssue) SetIdentifiedPeriod(value Period) {
+	d.ClearIdentified()
+	d.IdentifiedPeriod = &value
+}
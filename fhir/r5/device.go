@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -36,7 +35,7 @@ type DeviceUdiCarrier struct {
 	// Extension for Jurisdiction
 	JurisdictionExt *primitives.PrimitiveExtension `json:"_jurisdiction,omitempty" fhir:"cardinality=0..1"`
 	// UDI Machine Readable Barcode String
-	CarrierAIDC *string `json:"carrierAIDC,omitempty" fhir:"cardinality=0..1,summary"`
+	CarrierAIDC *primitives.Base64Binary `json:"carrierAIDC,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for CarrierAIDC
 	CarrierAIDCExt *primitives.PrimitiveExtension `json:"_carrierAIDC,omitempty" fhir:"cardinality=0..1"`
 	// UDI Human Readable Barcode String
@@ -130,30 +129,132 @@ type DeviceProperty struct {
 	// Code that specifies the property being represented
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Value of the property - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DeviceProperty) Value() any {
+	switch {
+	case d.ValueQuantity != nil:
+		return d.ValueQuantity
+	case d.ValueCodeableConcept != nil:
+		return d.ValueCodeableConcept
+	case d.ValueString != nil:
+		return d.ValueString
+	case d.ValueBoolean != nil:
+		return d.ValueBoolean
+	case d.ValueInteger != nil:
+		return d.ValueInteger
+	case d.ValueRange != nil:
+		return d.ValueRange
+	case d.ValueAttachment != nil:
+		return d.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (d *DeviceProperty) ValueType() string {
+	switch {
+	case d.ValueQuantity != nil:
+		return "Quantity"
+	case d.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case d.ValueString != nil:
+		return "string"
+	case d.ValueBoolean != nil:
+		return "boolean"
+	case d.ValueInteger != nil:
+		return "integer"
+	case d.ValueRange != nil:
+		return "Range"
+	case d.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DeviceProperty) ClearValue() {
+	d.ValueQuantity = nil
+	d.ValueCodeableConcept = nil
+	d.ValueString = nil
+	d.ValueStringExt = nil
+	d.ValueBoolean = nil
+	d.ValueBooleanExt = nil
+	d.ValueInteger = nil
+	d.ValueIntegerExt = nil
+	d.ValueRange = nil
+	d.ValueAttachment = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueQuantity(value Quantity) {
+	d.ClearValue()
+	d.ValueQuantity = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueCodeableConcept(value CodeableConcept) {
+	d.ClearValue()
+	d.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueString(value string) {
+	ext := d.ValueStringExt
+	d.ClearValue()
+	d.ValueString, d.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueBoolean(value bool) {
+	ext := d.ValueBooleanExt
+	d.ClearValue()
+	d.ValueBoolean, d.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueInteger(value int) {
+	ext := d.ValueIntegerExt
+	d.ClearValue()
+	d.ValueInteger, d.ValueIntegerExt = &value, ext
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueRange(value Range) {
+	d.ClearValue()
+	d.ValueRange = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (d *DeviceProperty) SetValueAttachment(value Attachment) {
+	d.ClearValue()
+	d.ValueAttachment = &value
 }
 
 // Device represents a FHIR Device.
 type Device struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Instance identifier
@@ -241,3 +342,8 @@ type Device struct {
 	// The higher level or encompassing device that this device is a logical part of
 	Parent *Reference `json:"parent,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Device", the FHIR type of the resource.
+func (d *Device) ResourceType() string {
+	return ResourceTypeDevice
+}
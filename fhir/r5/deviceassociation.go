@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type DeviceAssociationOperation struct {
 
 // DeviceAssociation represents a FHIR DeviceAssociation.
 type DeviceAssociation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Instance identifier
@@ -55,3 +54,8 @@ type DeviceAssociation struct {
 	// The details about the device when it is in use to describe its operation
 	Operation []DeviceAssociationOperation `json:"operation,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "DeviceAssociation", the FHIR type of the resource.
+func (d *DeviceAssociation) ResourceType() string {
+	return ResourceTypeDeviceAssociation
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -246,25 +245,127 @@ type DeviceDefinitionProperty struct {
 	// Code that specifies the property being represented
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Value of the property - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DeviceDefinitionProperty) Value() any {
+	switch {
+	case d.ValueQuantity != nil:
+		return d.ValueQuantity
+	case d.ValueCodeableConcept != nil:
+		return d.ValueCodeableConcept
+	case d.ValueString != nil:
+		return d.ValueString
+	case d.ValueBoolean != nil:
+		return d.ValueBoolean
+	case d.ValueInteger != nil:
+		return d.ValueInteger
+	case d.ValueRange != nil:
+		return d.ValueRange
+	case d.ValueAttachment != nil:
+		return d.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (d *DeviceDefinitionProperty) ValueType() string {
+	switch {
+	case d.ValueQuantity != nil:
+		return "Quantity"
+	case d.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case d.ValueString != nil:
+		return "string"
+	case d.ValueBoolean != nil:
+		return "boolean"
+	case d.ValueInteger != nil:
+		return "integer"
+	case d.ValueRange != nil:
+		return "Range"
+	case d.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DeviceDefinitionProperty) ClearValue() {
+	d.ValueQuantity = nil
+	d.ValueCodeableConcept = nil
+	d.ValueString = nil
+	d.ValueStringExt = nil
+	d.ValueBoolean = nil
+	d.ValueBooleanExt = nil
+	d.ValueInteger = nil
+	d.ValueIntegerExt = nil
+	d.ValueRange = nil
+	d.ValueAttachment = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueQuantity(value Quantity) {
+	d.ClearValue()
+	d.ValueQuantity = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueCodeableConcept(value CodeableConcept) {
+	d.ClearValue()
+	d.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueString(value string) {
+	ext := d.ValueStringExt
+	d.ClearValue()
+	d.ValueString, d.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueBoolean(value bool) {
+	ext := d.ValueBooleanExt
+	d.ClearValue()
+	d.ValueBoolean, d.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueInteger(value int) {
+	ext := d.ValueIntegerExt
+	d.ClearValue()
+	d.ValueInteger, d.ValueIntegerExt = &value, ext
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueRange(value Range) {
+	d.ClearValue()
+	d.ValueRange = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (d *DeviceDefinitionProperty) SetValueAttachment(value Attachment) {
+	d.ClearValue()
+	d.ValueAttachment = &value
 }
 
 // DeviceDefinitionLink represents a FHIR BackboneElement for DeviceDefinition.link.
@@ -379,7 +480,7 @@ type DeviceDefinitionChargeItem struct {
 
 // DeviceDefinition represents a FHIR DeviceDefinition.
 type DeviceDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Additional information to describe the device
@@ -443,3 +544,8 @@ type DeviceDefinition struct {
 	// Billing code or reference associated with the device
 	ChargeItem []DeviceDefinitionChargeItem `json:"chargeItem,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DeviceDefinition", the FHIR type of the resource.
+func (d *DeviceDefinition) ResourceType() string {
+	return ResourceTypeDeviceDefinition
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type DeviceDispensePerformer struct {
 
 // DeviceDispense represents a FHIR DeviceDispense.
 type DeviceDispense struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this dispensation
@@ -85,3 +84,8 @@ type DeviceDispense struct {
 	// A list of relevant lifecycle events
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DeviceDispense", the FHIR type of the resource.
+func (d *DeviceDispense) ResourceType() string {
+	return ResourceTypeDeviceDispense
+}
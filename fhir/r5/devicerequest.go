@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -37,15 +36,80 @@ type DeviceRequestParameter struct {
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DeviceRequestParameter) Value() any {
+	switch {
+	case d.ValueCodeableConcept != nil:
+		return d.ValueCodeableConcept
+	case d.ValueQuantity != nil:
+		return d.ValueQuantity
+	case d.ValueRange != nil:
+		return d.ValueRange
+	case d.ValueBoolean != nil:
+		return d.ValueBoolean
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (d *DeviceRequestParameter) ValueType() string {
+	switch {
+	case d.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case d.ValueQuantity != nil:
+		return "Quantity"
+	case d.ValueRange != nil:
+		return "Range"
+	case d.ValueBoolean != nil:
+		return "boolean"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DeviceRequestParameter) ClearValue() {
+	d.ValueCodeableConcept = nil
+	d.ValueQuantity = nil
+	d.ValueRange = nil
+	d.ValueBoolean = nil
+	d.ValueBooleanExt = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueCodeableConcept(value CodeableConcept) {
+	d.ClearValue()
+	d.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueQuantity(value Quantity) {
+	d.ClearValue()
+	d.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueRange(value Range) {
+	d.ClearValue()
+	d.ValueRange = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (d *DeviceRequestParameter) SetValueBoolean(value bool) {
+	ext := d.ValueBooleanExt
+	d.ClearValue()
+	d.ValueBoolean, d.ValueBooleanExt = &value, ext
+}
+
 // DeviceRequest represents a FHIR DeviceRequest.
 type DeviceRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Request identifier
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -119,3 +183,62 @@ type DeviceRequest struct {
 	// Request provenance
 	RelevantHistory []Reference `json:"relevantHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DeviceRequest", the FHIR type of the resource.
+func (d *DeviceRequest) ResourceType() string {
+	return ResourceTypeDeviceRequest
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (d *DeviceRequest) Occurrence() any {
+	switch {
+	case d.OccurrenceDateTime != nil:
+		return d.OccurrenceDateTime
+	case d.OccurrencePeriod != nil:
+		return d.OccurrencePeriod
+	case d.OccurrenceTiming != nil:
+		return d.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (d *DeviceRequest) OccurrenceType() string {
+	switch {
+	case d.OccurrenceDateTime != nil:
+		return "dateTime"
+	case d.OccurrencePeriod != nil:
+		return "Period"
+	case d.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (d *DeviceRequest) ClearOccurrence() {
+	d.OccurrenceDateTime = nil
+	d.OccurrenceDateTimeExt = nil
+	d.OccurrencePeriod = nil
+	d.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (d *DeviceRequest) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := d.OccurrenceDateTimeExt
+	d.ClearOccurrence()
+	d.OccurrenceDateTime, d.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (d *DeviceRequest) SetOccurrencePeriod(value Period) {
+	d.ClearOccurrence()
+	d.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (d *DeviceRequest) SetOccurrenceTiming(value Timing) {
+	d.ClearOccurrence()
+	d.OccurrenceTiming = &value
+}
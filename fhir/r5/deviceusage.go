@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type DeviceUsageAdherence struct {
 
 // DeviceUsage represents a FHIR DeviceUsage.
 type DeviceUsage struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier for this record
@@ -79,3 +78,62 @@ type DeviceUsage struct {
 	// Addition details (comments, instructions)
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DeviceUsage", the FHIR type of the resource.
+func (d *DeviceUsage) ResourceType() string {
+	return ResourceTypeDeviceUsage
+}
+
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (d *DeviceUsage) Timing() any {
+	switch {
+	case d.TimingTiming != nil:
+		return d.TimingTiming
+	case d.TimingPeriod != nil:
+		return d.TimingPeriod
+	case d.TimingDateTime != nil:
+		return d.TimingDateTime
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "Timing", or "" if none is.
+func (d *DeviceUsage) TimingType() string {
+	switch {
+	case d.TimingTiming != nil:
+		return "Timing"
+	case d.TimingPeriod != nil:
+		return "Period"
+	case d.TimingDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (d *DeviceUsage) ClearTiming() {
+	d.TimingTiming = nil
+	d.TimingPeriod = nil
+	d.TimingDateTime = nil
+	d.TimingDateTimeExt = nil
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (d *DeviceUsage) SetTimingTiming(value Timing) {
+	d.ClearTiming()
+	d.TimingTiming = &value
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (d *DeviceUsage) SetTimingPeriod(value Period) {
+	d.ClearTiming()
+	d.TimingPeriod = &value
+}
+
+// SetTimingDateTime sets the dateTime option of timing[x], clearing the others.
+func (d *DeviceUsage) SetTimingDateTime(value primitives.DateTime) {
+	ext := d.TimingDateTimeExt
+	d.ClearTiming()
+	d.TimingDateTime, d.TimingDateTimeExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,7 +48,7 @@ type DiagnosticReportMedia struct {
 
 // DiagnosticReport represents a FHIR DiagnosticReport.
 type DiagnosticReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for report
@@ -105,3 +104,51 @@ type DiagnosticReport struct {
 	// Entire report as issued
 	PresentedForm []Attachment `json:"presentedForm,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "DiagnosticReport", the FHIR type of the resource.
+func (d *DiagnosticReport) ResourceType() string {
+	return ResourceTypeDiagnosticReport
+}
+
+// Effective returns the option of effective[x] that is set, or nil if none is.
+func (d *DiagnosticReport) Effective() any {
+	switch {
+	case d.EffectiveDateTime != nil:
+		return d.EffectiveDateTime
+	case d.EffectivePeriod != nil:
+		return d.EffectivePeriod
+	}
+	return nil
+}
+
+// EffectiveType returns the FHIR type of the option of effective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (d *DiagnosticReport) EffectiveType() string {
+	switch {
+	case d.EffectiveDateTime != nil:
+		return "dateTime"
+	case d.EffectivePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearEffective unsets every option of effective[x], and their extensions.
+func (d *DiagnosticReport) ClearEffective() {
+	d.EffectiveDateTime = nil
+	d.EffectiveDateTimeExt = nil
+	d.EffectivePeriod = nil
+}
+
+// SetEffectiveDateTime sets the dateTime option of effective[x], clearing the others.
+func (d *DiagnosticReport) SetEffectiveDateTime(value primitives.DateTime) {
+	ext := d.EffectiveDateTimeExt
+	d.ClearEffective()
+	d.EffectiveDateTime, d.EffectiveDateTimeExt = &value, ext
+}
+
+// SetEffectivePeriod sets the Period option of effective[x], clearing the others.
+func (d *DiagnosticReport) SetEffectivePeriod(value Period) {
+	d.ClearEffective()
+	d.EffectivePeriod = &value
+}
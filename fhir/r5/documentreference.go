@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -60,17 +59,73 @@ type DocumentReferenceContentProfile struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Code|uri|canonical - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Code|uri|canonical - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Code|uri|canonical - canonical option
-	ValueCanonical string `json:"valueCanonical" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCanonical *primitives.Canonical `json:"valueCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueCanonical
 	ValueCanonicalExt *primitives.PrimitiveExtension `json:"_valueCanonical,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (d *DocumentReferenceContentProfile) Value() any {
+	switch {
+	case d.ValueCoding != nil:
+		return d.ValueCoding
+	case d.ValueURI != nil:
+		return d.ValueURI
+	case d.ValueCanonical != nil:
+		return d.ValueCanonical
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Coding", or "" if none is.
+func (d *DocumentReferenceContentProfile) ValueType() string {
+	switch {
+	case d.ValueCoding != nil:
+		return "Coding"
+	case d.ValueURI != nil:
+		return "uri"
+	case d.ValueCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (d *DocumentReferenceContentProfile) ClearValue() {
+	d.ValueCoding = nil
+	d.ValueURI = nil
+	d.ValueURIExt = nil
+	d.ValueCanonical = nil
+	d.ValueCanonicalExt = nil
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (d *DocumentReferenceContentProfile) SetValueCoding(value Coding) {
+	d.ClearValue()
+	d.ValueCoding = &value
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (d *DocumentReferenceContentProfile) SetValueURI(value string) {
+	ext := d.ValueURIExt
+	d.ClearValue()
+	d.ValueURI, d.ValueURIExt = &value, ext
+}
+
+// SetValueCanonical sets the canonical option of value[x], clearing the others.
+func (d *DocumentReferenceContentProfile) SetValueCanonical(value primitives.Canonical) {
+	ext := d.ValueCanonicalExt
+	d.ClearValue()
+	d.ValueCanonical, d.ValueCanonicalExt = &value, ext
+}
+
 // DocumentReferenceContent represents a FHIR BackboneElement for DocumentReference.content.
 type DocumentReferenceContent struct {
 	// Unique id for inter-element referencing
@@ -89,7 +144,7 @@ type DocumentReferenceContent struct {
 
 // DocumentReference represents a FHIR DocumentReference.
 type DocumentReference struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifiers for the document
@@ -149,3 +204,8 @@ type DocumentReference struct {
 	// Document referenced
 	Content []DocumentReferenceContent `json:"content,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "DocumentReference", the FHIR type of the resource.
+func (d *DocumentReference) ResourceType() string {
+	return ResourceTypeDocumentReference
+}
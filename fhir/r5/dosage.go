@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -31,6 +31,99 @@ type DosageDoseAndRate struct {
 	RateQuantity *Quantity `json:"rateQuantity,omitempty" fhir:"cardinality=0..1,summary,choice=rate"`
 }
 
+// Dose returns the option of dose[x] that is set, or nil if none is.
+func (d *DosageDoseAndRate) Dose() any {
+	switch {
+	case d.DoseRange != nil:
+		return d.DoseRange
+	case d.DoseQuantity != nil:
+		return d.DoseQuantity
+	}
+	return nil
+}
+
+// DoseType returns the FHIR type of the option of dose[x] that is
+// set, such as "Range", or "" if none is.
+func (d *DosageDoseAndRate) DoseType() string {
+	switch {
+	case d.DoseRange != nil:
+		return "Range"
+	case d.DoseQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearDose unsets every option of dose[x], and their extensions.
+func (d *DosageDoseAndRate) ClearDose() {
+	d.DoseRange = nil
+	d.DoseQuantity = nil
+}
+
+// SetDoseRange sets the Range option of dose[x], clearing the others.
+func (d *DosageDoseAndRate) SetDoseRange(value Range) {
+	d.ClearDose()
+	d.DoseRange = &value
+}
+
+// SetDoseQuantity sets the Quantity option of dose[x], clearing the others.
+func (d *DosageDoseAndRate) SetDoseQuantity(value Quantity) {
+	d.ClearDose()
+	d.DoseQuantity = &value
+}
+
+// Rate returns the option of rate[x] that is set, or nil if none is.
+func (d *DosageDoseAndRate) Rate() any {
+	switch {
+	case d.RateRatio != nil:
+		return d.RateRatio
+	case d.RateRange != nil:
+		return d.RateRange
+	case d.RateQuantity != nil:
+		return d.RateQuantity
+	}
+	return nil
+}
+
+// RateType returns the FHIR type of the option of rate[x] that is
+// set, such as "Ratio", or "" if none is.
+func (d *DosageDoseAndRate) RateType() string {
+	switch {
+	case d.RateRatio != nil:
+		return "Ratio"
+	case d.RateRange != nil:
+		return "Range"
+	case d.RateQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearRate unsets every option of rate[x], and their extensions.
+func (d *DosageDoseAndRate) ClearRate() {
+	d.RateRatio = nil
+	d.RateRange = nil
+	d.RateQuantity = nil
+}
+
+// SetRateRatio sets the Ratio option of rate[x], clearing the others.
+func (d *DosageDoseAndRate) SetRateRatio(value Ratio) {
+	d.ClearRate()
+	d.RateRatio = &value
+}
+
+// SetRateRange sets the Range option of rate[x], clearing the others.
+func (d *DosageDoseAndRate) SetRateRange(value Range) {
+	d.ClearRate()
+	d.RateRange = &value
+}
+
+// SetRateQuantity sets the Quantity option of rate[x], clearing the others.
+func (d *DosageDoseAndRate) SetRateQuantity(value Quantity) {
+	d.ClearRate()
+	d.RateQuantity = &value
+}
+
 // Dosage represents a FHIR Dosage.
 type Dosage struct {
 	// Unique id for inter-element referencing
@@ -42,7 +135,7 @@ type Dosage struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// The order of the dosage instructions
-	Sequence *int `json:"sequence,omitempty" fhir:"cardinality=0..1,summary"`
+	Sequence *int `json:"sequence,omitempty" fhir:"cardinality=0..1,summary,type=integer"`
 	// Extension for Sequence
 	SequenceExt *primitives.PrimitiveExtension `json:"_sequence,omitempty" fhir:"cardinality=0..1"`
 	// Free text dosage instructions e.g. SIG
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -111,7 +110,7 @@ type EncounterLocation struct {
 
 // Encounter represents a FHIR Encounter.
 type Encounter struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier(s) by which this encounter is known
@@ -177,3 +176,8 @@ type Encounter struct {
 	// List of locations where the patient has been
 	Location []EncounterLocation `json:"location,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Encounter", the FHIR type of the resource.
+func (e *Encounter) ResourceType() string {
+	return ResourceTypeEncounter
+}
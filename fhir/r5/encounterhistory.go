@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type EncounterHistoryLocation struct {
 
 // EncounterHistory represents a FHIR EncounterHistory.
 type EncounterHistory struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The Encounter associated with this set of historic values
@@ -67,3 +66,8 @@ type EncounterHistory struct {
 	// Location of the patient at this point in the encounter
 	Location []EncounterHistoryLocation `json:"location,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "EncounterHistory", the FHIR type of the resource.
+func (e *EncounterHistory) ResourceType() string {
+	return ResourceTypeEncounterHistory
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type EndpointPayload struct {
 
 // Endpoint represents a FHIR Endpoint.
 type Endpoint struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifies this endpoint across multiple systems
@@ -71,3 +70,8 @@ type Endpoint struct {
 	// Extension for Header
 	HeaderExt *primitives.PrimitiveExtension `json:"_header,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Endpoint", the FHIR type of the resource.
+func (e *Endpoint) ResourceType() string {
+	return ResourceTypeEndpoint
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeEnrollmentRequest = "EnrollmentRequest"
 
 // EnrollmentRequest represents a FHIR EnrollmentRequest.
 type EnrollmentRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier
@@ -37,3 +36,8 @@ type EnrollmentRequest struct {
 	// Insurance information
 	Coverage *Reference `json:"coverage,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "EnrollmentRequest", the FHIR type of the resource.
+func (e *EnrollmentRequest) ResourceType() string {
+	return ResourceTypeEnrollmentRequest
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeEnrollmentResponse = "EnrollmentResponse"
 
 // EnrollmentResponse represents a FHIR EnrollmentResponse.
 type EnrollmentResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier
@@ -43,3 +42,8 @@ type EnrollmentResponse struct {
 	// Responsible practitioner
 	RequestProvider *Reference `json:"requestProvider,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "EnrollmentResponse", the FHIR type of the resource.
+func (e *EnrollmentResponse) ResourceType() string {
+	return ResourceTypeEnrollmentResponse
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -94,7 +93,7 @@ type EvidenceStatisticAttributeEstimate struct {
 	// The singular quantity of the attribute estimate, for attribute estimates represented as single values; also used to report unit of measure
 	Quantity *Quantity `json:"quantity,omitempty" fhir:"cardinality=0..1"`
 	// Level of confidence interval, e.g., 0.95 for 95% confidence interval
-	Level *float64 `json:"level,omitempty" fhir:"cardinality=0..1"`
+	Level *primitives.Decimal `json:"level,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Level
 	LevelExt *primitives.PrimitiveExtension `json:"_level,omitempty" fhir:"cardinality=0..1"`
 	// Lower and upper bound values of the attribute estimate
@@ -223,7 +222,7 @@ type EvidenceCertainty struct {
 
 // Evidence represents a FHIR Evidence.
 type Evidence struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this evidence, represented as a globally unique URI
@@ -327,3 +326,94 @@ type Evidence struct {
 	// Certainty or quality of the evidence
 	Certainty []EvidenceCertainty `json:"certainty,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Evidence", the FHIR type of the resource.
+func (e *Evidence) ResourceType() string {
+	return ResourceTypeEvidence
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (e *Evidence) VersionAlgorithm() any {
+	switch {
+	case e.VersionAlgorithmString != nil:
+		return e.VersionAlgorithmString
+	case e.VersionAlgorithmCoding != nil:
+		return e.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (e *Evidence) VersionAlgorithmType() string {
+	switch {
+	case e.VersionAlgorithmString != nil:
+		return "string"
+	case e.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (e *Evidence) ClearVersionAlgorithm() {
+	e.VersionAlgorithmString = nil
+	e.VersionAlgorithmStringExt = nil
+	e.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (e *Evidence) SetVersionAlgorithmString(value string) {
+	ext := e.VersionAlgorithmStringExt
+	e.ClearVersionAlgorithm()
+	e.VersionAlgorithmString, e.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (e *Evidence) SetVersionAlgorithmCoding(value Coding) {
+	e.ClearVersionAlgorithm()
+	e.VersionAlgorithmCoding = &value
+}
+
+// CiteAs returns the option of citeAs[x] that is set, or nil if none is.
+func (e *Evidence) CiteAs() any {
+	switch {
+	case e.CiteAsReference != nil:
+		return e.CiteAsReference
+	case e.CiteAsMarkdown != nil:
+		return e.CiteAsMarkdown
+	}
+	return nil
+}
+
+// CiteAsType returns the FHIR type of the option of citeAs[x] that is
+// set, such as "Reference", or "" if none is.
+func (e *Evidence) CiteAsType() string {
+	switch {
+	case e.CiteAsReference != nil:
+		return "Reference"
+	case e.CiteAsMarkdown != nil:
+		return "markdown"
+	}
+	return ""
+}
+
+// ClearCiteAs unsets every option of citeAs[x], and their extensions.
+func (e *Evidence) ClearCiteAs() {
+	e.CiteAsReference = nil
+	e.CiteAsMarkdown = nil
+	e.CiteAsMarkdownExt = nil
+}
+
+// SetCiteAsReference sets the Reference option of citeAs[x], clearing the others.
+func (e *Evidence) SetCiteAsReference(value Reference) {
+	e.ClearCiteAs()
+	e.CiteAsReference = &value
+}
+
+// SetCiteAsMarkdown sets the markdown option of citeAs[x], clearing the others.
+func (e *Evidence) SetCiteAsMarkdown(value string) {
+	ext := e.CiteAsMarkdownExt
+	e.ClearCiteAs()
+	e.CiteAsMarkdown, e.CiteAsMarkdownExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,17 +25,17 @@ type EvidenceReportSubjectCharacteristic struct {
 	// Characteristic code
 	Code CodeableConcept `json:"code" fhir:"cardinality=1..1,required"`
 	// Characteristic value - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Characteristic value - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Characteristic value - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Characteristic value - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Characteristic value - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Is used to express not the characteristic
 	Exclude *bool `json:"exclude,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Exclude
@@ -45,6 +44,82 @@ type EvidenceReportSubjectCharacteristic struct {
 	Period *Period `json:"period,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (e *EvidenceReportSubjectCharacteristic) Value() any {
+	switch {
+	case e.ValueReference != nil:
+		return e.ValueReference
+	case e.ValueCodeableConcept != nil:
+		return e.ValueCodeableConcept
+	case e.ValueBoolean != nil:
+		return e.ValueBoolean
+	case e.ValueQuantity != nil:
+		return e.ValueQuantity
+	case e.ValueRange != nil:
+		return e.ValueRange
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Reference", or "" if none is.
+func (e *EvidenceReportSubjectCharacteristic) ValueType() string {
+	switch {
+	case e.ValueReference != nil:
+		return "Reference"
+	case e.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.ValueBoolean != nil:
+		return "boolean"
+	case e.ValueQuantity != nil:
+		return "Quantity"
+	case e.ValueRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (e *EvidenceReportSubjectCharacteristic) ClearValue() {
+	e.ValueReference = nil
+	e.ValueCodeableConcept = nil
+	e.ValueBoolean = nil
+	e.ValueBooleanExt = nil
+	e.ValueQuantity = nil
+	e.ValueRange = nil
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (e *EvidenceReportSubjectCharacteristic) SetValueReference(value Reference) {
+	e.ClearValue()
+	e.ValueReference = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (e *EvidenceReportSubjectCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	e.ClearValue()
+	e.ValueCodeableConcept = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (e *EvidenceReportSubjectCharacteristic) SetValueBoolean(value bool) {
+	ext := e.ValueBooleanExt
+	e.ClearValue()
+	e.ValueBoolean, e.ValueBooleanExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (e *EvidenceReportSubjectCharacteristic) SetValueQuantity(value Quantity) {
+	e.ClearValue()
+	e.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (e *EvidenceReportSubjectCharacteristic) SetValueRange(value Range) {
+	e.ClearValue()
+	e.ValueRange = &value
+}
+
 // EvidenceReportSubject represents a FHIR BackboneElement for EvidenceReport.subject.
 type EvidenceReportSubject struct {
 	// Unique id for inter-element referencing
@@ -149,7 +224,7 @@ type EvidenceReportSection struct {
 
 // EvidenceReport represents a FHIR EvidenceReport.
 type EvidenceReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this EvidenceReport, represented as a globally unique URI
@@ -199,3 +274,51 @@ type EvidenceReport struct {
 	// Composition is broken into sections
 	Section []EvidenceReportSection `json:"section,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "EvidenceReport", the FHIR type of the resource.
+func (e *EvidenceReport) ResourceType() string {
+	return ResourceTypeEvidenceReport
+}
+
+// CiteAs returns the option of citeAs[x] that is set, or nil if none is.
+func (e *EvidenceReport) CiteAs() any {
+	switch {
+	case e.CiteAsReference != nil:
+		return e.CiteAsReference
+	case e.CiteAsMarkdown != nil:
+		return e.CiteAsMarkdown
+	}
+	return nil
+}
+
+// CiteAsType returns the FHIR type of the option of citeAs[x] that is
+// set, such as "Reference", or "" if none is.
+func (e *EvidenceReport) CiteAsType() string {
+	switch {
+	case e.CiteAsReference != nil:
+		return "Reference"
+	case e.CiteAsMarkdown != nil:
+		return "markdown"
+	}
+	return ""
+}
+
+// ClearCiteAs unsets every option of citeAs[x], and their extensions.
+func (e *EvidenceReport) ClearCiteAs() {
+	e.CiteAsReference = nil
+	e.CiteAsMarkdown = nil
+	e.CiteAsMarkdownExt = nil
+}
+
+// SetCiteAsReference sets the Reference option of citeAs[x], clearing the others.
+func (e *EvidenceReport) SetCiteAsReference(value Reference) {
+	e.ClearCiteAs()
+	e.CiteAsReference = &value
+}
+
+// SetCiteAsMarkdown sets the markdown option of citeAs[x], clearing the others.
+func (e *EvidenceReport) SetCiteAsMarkdown(value string) {
+	ext := e.CiteAsMarkdownExt
+	e.ClearCiteAs()
+	e.CiteAsMarkdown, e.CiteAsMarkdownExt = &value, ext
+}
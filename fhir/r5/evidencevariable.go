@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -30,25 +29,114 @@ type EvidenceVariableCharacteristicDefinitionByTypeAndValue struct {
 	// Device used for determining characteristic
 	Device *Reference `json:"device,omitempty" fhir:"cardinality=0..1"`
 	// Defines the characteristic when coupled with characteristic.type - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Defines the characteristic when coupled with characteristic.type - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Defines the characteristic when coupled with characteristic.type - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Defines the characteristic when coupled with characteristic.type - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Defines the characteristic when coupled with characteristic.type - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Defines the characteristic when coupled with characteristic.type - id option
-	ValueID string `json:"valueID" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Reference point for valueQuantity or valueRange
 	Offset *CodeableConcept `json:"offset,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) Value() any {
+	switch {
+	case e.ValueCodeableConcept != nil:
+		return e.ValueCodeableConcept
+	case e.ValueBoolean != nil:
+		return e.ValueBoolean
+	case e.ValueQuantity != nil:
+		return e.ValueQuantity
+	case e.ValueRange != nil:
+		return e.ValueRange
+	case e.ValueReference != nil:
+		return e.ValueReference
+	case e.ValueID != nil:
+		return e.ValueID
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) ValueType() string {
+	switch {
+	case e.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.ValueBoolean != nil:
+		return "boolean"
+	case e.ValueQuantity != nil:
+		return "Quantity"
+	case e.ValueRange != nil:
+		return "Range"
+	case e.ValueReference != nil:
+		return "Reference"
+	case e.ValueID != nil:
+		return "id"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) ClearValue() {
+	e.ValueCodeableConcept = nil
+	e.ValueBoolean = nil
+	e.ValueBooleanExt = nil
+	e.ValueQuantity = nil
+	e.ValueRange = nil
+	e.ValueReference = nil
+	e.ValueID = nil
+	e.ValueIDExt = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) SetValueCodeableConcept(value CodeableConcept) {
+	e.ClearValue()
+	e.ValueCodeableConcept = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) SetValueBoolean(value bool) {
+	ext := e.ValueBooleanExt
+	e.ClearValue()
+	e.ValueBoolean, e.ValueBooleanExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) SetValueQuantity(value Quantity) {
+	e.ClearValue()
+	e.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) SetValueRange(value Range) {
+	e.ClearValue()
+	e.ValueRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) SetValueReference(value Reference) {
+	e.ClearValue()
+	e.ValueReference = &value
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (e *EvidenceVariableCharacteristicDefinitionByTypeAndValue) SetValueID(value string) {
+	ext := e.ValueIDExt
+	e.ClearValue()
+	e.ValueID, e.ValueIDExt = &value, ext
+}
+
 // EvidenceVariableCharacteristicDefinitionByCombinationCharacteristic represents a FHIR BackboneElement for EvidenceVariable.characteristic.definitionByCombination.characteristic.
 type EvidenceVariableCharacteristicDefinitionByCombinationCharacteristic struct {
 }
@@ -100,15 +188,82 @@ type EvidenceVariableCharacteristicTimeFromEvent struct {
 	// Extension for EventDateTime
 	EventDateTimeExt *primitives.PrimitiveExtension `json:"_eventDateTime,omitempty" fhir:"cardinality=0..1"`
 	// The event used as a base point (reference point) in time - id option
-	EventID *string `json:"eventID,omitempty" fhir:"cardinality=0..1,choice=event"`
+	EventID *string `json:"eventId,omitempty" fhir:"cardinality=0..1,choice=event"`
 	// Extension for EventID
-	EventIDExt *primitives.PrimitiveExtension `json:"_eventID,omitempty" fhir:"cardinality=0..1"`
+	EventIDExt *primitives.PrimitiveExtension `json:"_eventId,omitempty" fhir:"cardinality=0..1"`
 	// Used to express the observation at a defined amount of time before or after the event
 	Quantity *Quantity `json:"quantity,omitempty" fhir:"cardinality=0..1"`
 	// Used to express the observation within a period before and/or after the event
 	Range *Range `json:"range,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Event returns the option of event[x] that is set, or nil if none is.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) Event() any {
+	switch {
+	case e.EventCodeableConcept != nil:
+		return e.EventCodeableConcept
+	case e.EventReference != nil:
+		return e.EventReference
+	case e.EventDateTime != nil:
+		return e.EventDateTime
+	case e.EventID != nil:
+		return e.EventID
+	}
+	return nil
+}
+
+// EventType returns the FHIR type of the option of event[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) EventType() string {
+	switch {
+	case e.EventCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.EventReference != nil:
+		return "Reference"
+	case e.EventDateTime != nil:
+		return "dateTime"
+	case e.EventID != nil:
+		return "id"
+	}
+	return ""
+}
+
+// ClearEvent unsets every option of event[x], and their extensions.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) ClearEvent() {
+	e.EventCodeableConcept = nil
+	e.EventReference = nil
+	e.EventDateTime = nil
+	e.EventDateTimeExt = nil
+	e.EventID = nil
+	e.EventIDExt = nil
+}
+
+// SetEventCodeableConcept sets the CodeableConcept option of event[x], clearing the others.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) SetEventCodeableConcept(value CodeableConcept) {
+	e.ClearEvent()
+	e.EventCodeableConcept = &value
+}
+
+// SetEventReference sets the Reference option of event[x], clearing the others.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) SetEventReference(value Reference) {
+	e.ClearEvent()
+	e.EventReference = &value
+}
+
+// SetEventDateTime sets the dateTime option of event[x], clearing the others.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) SetEventDateTime(value primitives.DateTime) {
+	ext := e.EventDateTimeExt
+	e.ClearEvent()
+	e.EventDateTime, e.EventDateTimeExt = &value, ext
+}
+
+// SetEventID sets the id option of event[x], clearing the others.
+func (e *EvidenceVariableCharacteristicTimeFromEvent) SetEventID(value string) {
+	ext := e.EventIDExt
+	e.ClearEvent()
+	e.EventID, e.EventIDExt = &value, ext
+}
+
 // EvidenceVariableCharacteristic represents a FHIR BackboneElement for EvidenceVariable.characteristic.
 type EvidenceVariableCharacteristic struct {
 	// Unique id for inter-element referencing
@@ -136,7 +291,7 @@ type EvidenceVariableCharacteristic struct {
 	// Defines the characteristic (without using type and value) by a Reference
 	DefinitionReference *Reference `json:"definitionReference,omitempty" fhir:"cardinality=0..1,summary"`
 	// Defines the characteristic (without using type and value) by a Canonical
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,summary"`
+	DefinitionCanonical *primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Defines the characteristic (without using type and value) by a CodeableConcept
@@ -163,6 +318,88 @@ type EvidenceVariableCharacteristic struct {
 	TimeFromEvent []EvidenceVariableCharacteristicTimeFromEvent `json:"timeFromEvent,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Instances returns the option of instances[x] that is set, or nil if none is.
+func (e *EvidenceVariableCharacteristic) Instances() any {
+	switch {
+	case e.InstancesQuantity != nil:
+		return e.InstancesQuantity
+	case e.InstancesRange != nil:
+		return e.InstancesRange
+	}
+	return nil
+}
+
+// InstancesType returns the FHIR type of the option of instances[x] that is
+// set, such as "Quantity", or "" if none is.
+func (e *EvidenceVariableCharacteristic) InstancesType() string {
+	switch {
+	case e.InstancesQuantity != nil:
+		return "Quantity"
+	case e.InstancesRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearInstances unsets every option of instances[x], and their extensions.
+func (e *EvidenceVariableCharacteristic) ClearInstances() {
+	e.InstancesQuantity = nil
+	e.InstancesRange = nil
+}
+
+// SetInstancesQuantity sets the Quantity option of instances[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetInstancesQuantity(value Quantity) {
+	e.ClearInstances()
+	e.InstancesQuantity = &value
+}
+
+// SetInstancesRange sets the Range option of instances[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetInstancesRange(value Range) {
+	e.ClearInstances()
+	e.InstancesRange = &value
+}
+
+// Duration returns the option of duration[x] that is set, or nil if none is.
+func (e *EvidenceVariableCharacteristic) Duration() any {
+	switch {
+	case e.DurationQuantity != nil:
+		return e.DurationQuantity
+	case e.DurationRange != nil:
+		return e.DurationRange
+	}
+	return nil
+}
+
+// DurationType returns the FHIR type of the option of duration[x] that is
+// set, such as "Quantity", or "" if none is.
+func (e *EvidenceVariableCharacteristic) DurationType() string {
+	switch {
+	case e.DurationQuantity != nil:
+		return "Quantity"
+	case e.DurationRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearDuration unsets every option of duration[x], and their extensions.
+func (e *EvidenceVariableCharacteristic) ClearDuration() {
+	e.DurationQuantity = nil
+	e.DurationRange = nil
+}
+
+// SetDurationQuantity sets the Quantity option of duration[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDurationQuantity(value Quantity) {
+	e.ClearDuration()
+	e.DurationQuantity = &value
+}
+
+// SetDurationRange sets the Range option of duration[x], clearing the others.
+func (e *EvidenceVariableCharacteristic) SetDurationRange(value Range) {
+	e.ClearDuration()
+	e.DurationRange = &value
+}
+
 // EvidenceVariableCategory represents a FHIR BackboneElement for EvidenceVariable.category.
 type EvidenceVariableCategory struct {
 	// Unique id for inter-element referencing
@@ -185,9 +422,61 @@ type EvidenceVariableCategory struct {
 	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=0..1,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (e *EvidenceVariableCategory) Value() any {
+	switch {
+	case e.ValueCodeableConcept != nil:
+		return e.ValueCodeableConcept
+	case e.ValueQuantity != nil:
+		return e.ValueQuantity
+	case e.ValueRange != nil:
+		return e.ValueRange
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (e *EvidenceVariableCategory) ValueType() string {
+	switch {
+	case e.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.ValueQuantity != nil:
+		return "Quantity"
+	case e.ValueRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (e *EvidenceVariableCategory) ClearValue() {
+	e.ValueCodeableConcept = nil
+	e.ValueQuantity = nil
+	e.ValueRange = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (e *EvidenceVariableCategory) SetValueCodeableConcept(value CodeableConcept) {
+	e.ClearValue()
+	e.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (e *EvidenceVariableCategory) SetValueQuantity(value Quantity) {
+	e.ClearValue()
+	e.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (e *EvidenceVariableCategory) SetValueRange(value Range) {
+	e.ClearValue()
+	e.ValueRange = &value
+}
+
 // EvidenceVariable represents a FHIR EvidenceVariable.
 type EvidenceVariable struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this evidence variable, represented as a URI (globally unique)
@@ -289,3 +578,51 @@ type EvidenceVariable struct {
 	// A grouping for ordinal or polychotomous variables
 	Category []EvidenceVariableCategory `json:"category,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "EvidenceVariable", the FHIR type of the resource.
+func (e *EvidenceVariable) ResourceType() string {
+	return ResourceTypeEvidenceVariable
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (e *EvidenceVariable) VersionAlgorithm() any {
+	switch {
+	case e.VersionAlgorithmString != nil:
+		return e.VersionAlgorithmString
+	case e.VersionAlgorithmCoding != nil:
+		return e.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (e *EvidenceVariable) VersionAlgorithmType() string {
+	switch {
+	case e.VersionAlgorithmString != nil:
+		return "string"
+	case e.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (e *EvidenceVariable) ClearVersionAlgorithm() {
+	e.VersionAlgorithmString = nil
+	e.VersionAlgorithmStringExt = nil
+	e.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (e *EvidenceVariable) SetVersionAlgorithmString(value string) {
+	ext := e.VersionAlgorithmStringExt
+	e.ClearVersionAlgorithm()
+	e.VersionAlgorithmString, e.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (e *EvidenceVariable) SetVersionAlgorithmCoding(value Coding) {
+	e.ClearVersionAlgorithm()
+	e.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -108,13 +107,13 @@ type ExampleScenarioInstance struct {
 	// Extension for StructureVersion
 	StructureVersionExt *primitives.PrimitiveExtension `json:"_structureVersion,omitempty" fhir:"cardinality=0..1"`
 	// Rules instance adheres to - canonical option
-	StructureProfileCanonical *string `json:"structureProfileCanonical,omitempty" fhir:"cardinality=0..1,choice=structureProfile"`
+	StructureProfileCanonical *primitives.Canonical `json:"structureProfileCanonical,omitempty" fhir:"cardinality=0..1,choice=structureProfile"`
 	// Extension for StructureProfileCanonical
 	StructureProfileCanonicalExt *primitives.PrimitiveExtension `json:"_structureProfileCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Rules instance adheres to - uri option
-	StructureProfileURI *string `json:"structureProfileURI,omitempty" fhir:"cardinality=0..1,choice=structureProfile"`
+	StructureProfileURI *string `json:"structureProfileUri,omitempty" fhir:"cardinality=0..1,choice=structureProfile"`
 	// Extension for StructureProfileURI
-	StructureProfileURIExt *primitives.PrimitiveExtension `json:"_structureProfileURI,omitempty" fhir:"cardinality=0..1"`
+	StructureProfileURIExt *primitives.PrimitiveExtension `json:"_structureProfileUri,omitempty" fhir:"cardinality=0..1"`
 	// Label for instance
 	Title string `json:"title" fhir:"cardinality=1..1,required"`
 	// Extension for Title
@@ -131,6 +130,51 @@ type ExampleScenarioInstance struct {
 	ContainedInstance []ExampleScenarioInstanceContainedInstance `json:"containedInstance,omitempty" fhir:"cardinality=0..*"`
 }
 
+// StructureProfile returns the option of structureProfile[x] that is set, or nil if none is.
+func (e *ExampleScenarioInstance) StructureProfile() any {
+	switch {
+	case e.StructureProfileCanonical != nil:
+		return e.StructureProfileCanonical
+	case e.StructureProfileURI != nil:
+		return e.StructureProfileURI
+	}
+	return nil
+}
+
+// StructureProfileType returns the FHIR type of the option of structureProfile[x] that is
+// set, such as "canonical", or "" if none is.
+func (e *ExampleScenarioInstance) StructureProfileType() string {
+	switch {
+	case e.StructureProfileCanonical != nil:
+		return "canonical"
+	case e.StructureProfileURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearStructureProfile unsets every option of structureProfile[x], and their extensions.
+func (e *ExampleScenarioInstance) ClearStructureProfile() {
+	e.StructureProfileCanonical = nil
+	e.StructureProfileCanonicalExt = nil
+	e.StructureProfileURI = nil
+	e.StructureProfileURIExt = nil
+}
+
+// SetStructureProfileCanonical sets the canonical option of structureProfile[x], clearing the others.
+func (e *ExampleScenarioInstance) SetStructureProfileCanonical(value primitives.Canonical) {
+	ext := e.StructureProfileCanonicalExt
+	e.ClearStructureProfile()
+	e.StructureProfileCanonical, e.StructureProfileCanonicalExt = &value, ext
+}
+
+// SetStructureProfileURI sets the uri option of structureProfile[x], clearing the others.
+func (e *ExampleScenarioInstance) SetStructureProfileURI(value string) {
+	ext := e.StructureProfileURIExt
+	e.ClearStructureProfile()
+	e.StructureProfileURI, e.StructureProfileURIExt = &value, ext
+}
+
 // ExampleScenarioProcessStepProcess represents a FHIR BackboneElement for ExampleScenario.process.step.process.
 type ExampleScenarioProcessStepProcess struct {
 }
@@ -228,7 +272,7 @@ type ExampleScenarioProcessStep struct {
 	// Step is nested process
 	Process *ExampleScenarioProcessStepProcess `json:"process,omitempty" fhir:"cardinality=0..1"`
 	// Step is nested workflow
-	Workflow *string `json:"workflow,omitempty" fhir:"cardinality=0..1"`
+	Workflow *primitives.Canonical `json:"workflow,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Workflow
 	WorkflowExt *primitives.PrimitiveExtension `json:"_workflow,omitempty" fhir:"cardinality=0..1"`
 	// Step is simple action
@@ -273,7 +317,7 @@ type ExampleScenarioProcess struct {
 
 // ExampleScenario represents a FHIR ExampleScenario.
 type ExampleScenario struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this example scenario, represented as a URI (globally unique)
@@ -345,3 +389,51 @@ type ExampleScenario struct {
 	// Major process within scenario
 	Process []ExampleScenarioProcess `json:"process,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ExampleScenario", the FHIR type of the resource.
+func (e *ExampleScenario) ResourceType() string {
+	return ResourceTypeExampleScenario
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (e *ExampleScenario) VersionAlgorithm() any {
+	switch {
+	case e.VersionAlgorithmString != nil:
+		return e.VersionAlgorithmString
+	case e.VersionAlgorithmCoding != nil:
+		return e.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (e *ExampleScenario) VersionAlgorithmType() string {
+	switch {
+	case e.VersionAlgorithmString != nil:
+		return "string"
+	case e.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (e *ExampleScenario) ClearVersionAlgorithm() {
+	e.VersionAlgorithmString = nil
+	e.VersionAlgorithmStringExt = nil
+	e.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (e *ExampleScenario) SetVersionAlgorithmString(value string) {
+	ext := e.VersionAlgorithmStringExt
+	e.ClearVersionAlgorithm()
+	e.VersionAlgorithmString, e.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (e *ExampleScenario) SetVersionAlgorithmCoding(value Coding) {
+	e.ClearVersionAlgorithm()
+	e.VersionAlgorithmCoding = &value
+}
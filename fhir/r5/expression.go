@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -22,11 +22,11 @@ type Expression struct {
 	// Extension for Description
 	DescriptionExt *primitives.PrimitiveExtension `json:"_description,omitempty" fhir:"cardinality=0..1"`
 	// Short name assigned to expression for reuse
-	Name *string `json:"name,omitempty" fhir:"cardinality=0..1,summary"`
+	Name *string `json:"name,omitempty" fhir:"cardinality=0..1,summary,type=code"`
 	// Extension for Name
 	NameExt *primitives.PrimitiveExtension `json:"_name,omitempty" fhir:"cardinality=0..1"`
 	// text/cql | text/fhirpath | application/x-fhir-query | etc.
-	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,summary"`
+	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,binding=extensible:http://hl7.org/fhir/ValueSet/expression-language,summary,type=code"`
 	// Extension for Language
 	LanguageExt *primitives.PrimitiveExtension `json:"_language,omitempty" fhir:"cardinality=0..1"`
 	// Expression in specified language
@@ -34,7 +34,7 @@ type Expression struct {
 	// Extension for Expression
 	ExpressionExt *primitives.PrimitiveExtension `json:"_expression,omitempty" fhir:"cardinality=0..1"`
 	// Where the expression is found
-	Reference *string `json:"reference,omitempty" fhir:"cardinality=0..1,summary"`
+	Reference *string `json:"reference,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	// Extension for Reference
 	ReferenceExt *primitives.PrimitiveExtension `json:"_reference,omitempty" fhir:"cardinality=0..1"`
 }
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -22,7 +22,7 @@ type Extension struct {
 	// Extension for URL
 	URLExt *primitives.PrimitiveExtension `json:"_url,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - base64Binary option
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - boolean option
@@ -30,11 +30,11 @@ type Extension struct {
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - canonical option
-	ValueCanonical *string `json:"valueCanonical,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueCanonical *primitives.Canonical `json:"valueCanonical,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueCanonical
 	ValueCanonicalExt *primitives.PrimitiveExtension `json:"_valueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - code option
-	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=0..1,choice=value,type=code"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - date option
@@ -46,35 +46,35 @@ type Extension struct {
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - decimal option
-	ValueDecimal *float64 `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - id option
-	ValueID *string `json:"valueID,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=0..1,choice=value,type=id"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - instant option
 	ValueInstant *primitives.Instant `json:"valueInstant,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueInstant
 	ValueInstantExt *primitives.PrimitiveExtension `json:"_valueInstant,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - integer option
-	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=0..1,choice=value,type=integer"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - integer64 option
-	ValueInteger64 *int64 `json:"valueInteger64,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueInteger64 *primitives.Integer64 `json:"valueInteger64,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueInteger64
 	ValueInteger64Ext *primitives.PrimitiveExtension `json:"_valueInteger64,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - markdown option
-	ValueMarkdown *string `json:"valueMarkdown,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueMarkdown *string `json:"valueMarkdown,omitempty" fhir:"cardinality=0..1,choice=value,type=markdown"`
 	// Extension for ValueMarkdown
 	ValueMarkdownExt *primitives.PrimitiveExtension `json:"_valueMarkdown,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - oid option
-	ValueOid *string `json:"valueOid,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueOid *string `json:"valueOid,omitempty" fhir:"cardinality=0..1,choice=value,type=oid"`
 	// Extension for ValueOid
 	ValueOidExt *primitives.PrimitiveExtension `json:"_valueOid,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - positiveInt option
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValuePositiveInt *int `json:"valuePositiveInt,omitempty" fhir:"cardinality=0..1,choice=value,type=positiveInt"`
 	// Extension for ValuePositiveInt
 	ValuePositiveIntExt *primitives.PrimitiveExtension `json:"_valuePositiveInt,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - string option
@@ -86,21 +86,21 @@ type Extension struct {
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - unsignedInt option
-	ValueUnsignedInt *uint `json:"valueUnsignedInt,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueUnsignedInt *uint `json:"valueUnsignedInt,omitempty" fhir:"cardinality=0..1,choice=value,type=unsignedInt"`
 	// Extension for ValueUnsignedInt
 	ValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_valueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - uri option
-	ValueURI *string `json:"valueURI,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=0..1,choice=value,type=uri"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - url option
-	ValueURL *string `json:"valueURL,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueURL *string `json:"valueUrl,omitempty" fhir:"cardinality=0..1,choice=value,type=url"`
 	// Extension for ValueURL
-	ValueURLExt *primitives.PrimitiveExtension `json:"_valueURL,omitempty" fhir:"cardinality=0..1"`
+	ValueURLExt *primitives.PrimitiveExtension `json:"_valueUrl,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - uuid option
-	ValueUUID *string `json:"valueUUID,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueUUID *string `json:"valueUuid,omitempty" fhir:"cardinality=0..1,choice=value,type=uuid"`
 	// Extension for ValueUUID
-	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUUID,omitempty" fhir:"cardinality=0..1"`
+	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUuid,omitempty" fhir:"cardinality=0..1"`
 	// Value of extension - Address option
 	ValueAddress *Address `json:"valueAddress,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Value of extension - Age option
@@ -170,3 +170,656 @@ type Extension struct {
 	// Value of extension - Meta option
 	ValueMeta *Meta `json:"valueMeta,omitempty" fhir:"cardinality=0..1,choice=value"`
 }
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (e *Extension) Value() any {
+	switch {
+	case e.ValueBase64Binary != nil:
+		return e.ValueBase64Binary
+	case e.ValueBoolean != nil:
+		return e.ValueBoolean
+	case e.ValueCanonical != nil:
+		return e.ValueCanonical
+	case e.ValueCode != nil:
+		return e.ValueCode
+	case e.ValueDate != nil:
+		return e.ValueDate
+	case e.ValueDateTime != nil:
+		return e.ValueDateTime
+	case e.ValueDecimal != nil:
+		return e.ValueDecimal
+	case e.ValueID != nil:
+		return e.ValueID
+	case e.ValueInstant != nil:
+		return e.ValueInstant
+	case e.ValueInteger != nil:
+		return e.ValueInteger
+	case e.ValueInteger64 != nil:
+		return e.ValueInteger64
+	case e.ValueMarkdown != nil:
+		return e.ValueMarkdown
+	case e.ValueOid != nil:
+		return e.ValueOid
+	case e.ValuePositiveInt != nil:
+		return e.ValuePositiveInt
+	case e.ValueString != nil:
+		return e.ValueString
+	case e.ValueTime != nil:
+		return e.ValueTime
+	case e.ValueUnsignedInt != nil:
+		return e.ValueUnsignedInt
+	case e.ValueURI != nil:
+		return e.ValueURI
+	case e.ValueURL != nil:
+		return e.ValueURL
+	case e.ValueUUID != nil:
+		return e.ValueUUID
+	case e.ValueAddress != nil:
+		return e.ValueAddress
+	case e.ValueAge != nil:
+		return e.ValueAge
+	case e.ValueAnnotation != nil:
+		return e.ValueAnnotation
+	case e.ValueAttachment != nil:
+		return e.ValueAttachment
+	case e.ValueCodeableConcept != nil:
+		return e.ValueCodeableConcept
+	case e.ValueCodeableReference != nil:
+		return e.ValueCodeableReference
+	case e.ValueCoding != nil:
+		return e.ValueCoding
+	case e.ValueContactPoint != nil:
+		return e.ValueContactPoint
+	case e.ValueCount != nil:
+		return e.ValueCount
+	case e.ValueDistance != nil:
+		return e.ValueDistance
+	case e.ValueDuration != nil:
+		return e.ValueDuration
+	case e.ValueHumanName != nil:
+		return e.ValueHumanName
+	case e.ValueIdentifier != nil:
+		return e.ValueIdentifier
+	case e.ValueMoney != nil:
+		return e.ValueMoney
+	case e.ValuePeriod != nil:
+		return e.ValuePeriod
+	case e.ValueQuantity != nil:
+		return e.ValueQuantity
+	case e.ValueRange != nil:
+		return e.ValueRange
+	case e.ValueRatio != nil:
+		return e.ValueRatio
+	case e.ValueRatioRange != nil:
+		return e.ValueRatioRange
+	case e.ValueReference != nil:
+		return e.ValueReference
+	case e.ValueSampledData != nil:
+		return e.ValueSampledData
+	case e.ValueSignature != nil:
+		return e.ValueSignature
+	case e.ValueTiming != nil:
+		return e.ValueTiming
+	case e.ValueContactDetail != nil:
+		return e.ValueContactDetail
+	case e.ValueDataRequirement != nil:
+		return e.ValueDataRequirement
+	case e.ValueExpression != nil:
+		return e.ValueExpression
+	case e.ValueParameterDefinition != nil:
+		return e.ValueParameterDefinition
+	case e.ValueRelatedArtifact != nil:
+		return e.ValueRelatedArtifact
+	case e.ValueTriggerDefinition != nil:
+		return e.ValueTriggerDefinition
+	case e.ValueUsageContext != nil:
+		return e.ValueUsageContext
+	case e.ValueAvailability != nil:
+		return e.ValueAvailability
+	case e.ValueExtendedContactDetail != nil:
+		return e.ValueExtendedContactDetail
+	case e.ValueDosage != nil:
+		return e.ValueDosage
+	case e.ValueMeta != nil:
+		return e.ValueMeta
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (e *Extension) ValueType() string {
+	switch {
+	case e.ValueBase64Binary != nil:
+		return "base64Binary"
+	case e.ValueBoolean != nil:
+		return "boolean"
+	case e.ValueCanonical != nil:
+		return "canonical"
+	case e.ValueCode != nil:
+		return "code"
+	case e.ValueDate != nil:
+		return "date"
+	case e.ValueDateTime != nil:
+		return "dateTime"
+	case e.ValueDecimal != nil:
+		return "decimal"
+	case e.ValueID != nil:
+		return "id"
+	case e.ValueInstant != nil:
+		return "instant"
+	case e.ValueInteger != nil:
+		return "integer"
+	case e.ValueInteger64 != nil:
+		return "integer64"
+	case e.ValueMarkdown != nil:
+		return "markdown"
+	case e.ValueOid != nil:
+		return "oid"
+	case e.ValuePositiveInt != nil:
+		return "positiveInt"
+	case e.ValueString != nil:
+		return "string"
+	case e.ValueTime != nil:
+		return "time"
+	case e.ValueUnsignedInt != nil:
+		return "unsignedInt"
+	case e.ValueURI != nil:
+		return "uri"
+	case e.ValueURL != nil:
+		return "url"
+	case e.ValueUUID != nil:
+		return "uuid"
+	case e.ValueAddress != nil:
+		return "Address"
+	case e.ValueAge != nil:
+		return "Age"
+	case e.ValueAnnotation != nil:
+		return "Annotation"
+	case e.ValueAttachment != nil:
+		return "Attachment"
+	case e.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case e.ValueCodeableReference != nil:
+		return "CodeableReference"
+	case e.ValueCoding != nil:
+		return "Coding"
+	case e.ValueContactPoint != nil:
+		return "ContactPoint"
+	case e.ValueCount != nil:
+		return "Count"
+	case e.ValueDistance != nil:
+		return "Distance"
+	case e.ValueDuration != nil:
+		return "Duration"
+	case e.ValueHumanName != nil:
+		return "HumanName"
+	case e.ValueIdentifier != nil:
+		return "Identifier"
+	case e.ValueMoney != nil:
+		return "Money"
+	case e.ValuePeriod != nil:
+		return "Period"
+	case e.ValueQuantity != nil:
+		return "Quantity"
+	case e.ValueRange != nil:
+		return "Range"
+	case e.ValueRatio != nil:
+		return "Ratio"
+	case e.ValueRatioRange != nil:
+		return "RatioRange"
+	case e.ValueReference != nil:
+		return "Reference"
+	case e.ValueSampledData != nil:
+		return "SampledData"
+	case e.ValueSignature != nil:
+		return "Signature"
+	case e.ValueTiming != nil:
+		return "Timing"
+	case e.ValueContactDetail != nil:
+		return "ContactDetail"
+	case e.ValueDataRequirement != nil:
+		return "DataRequirement"
+	case e.ValueExpression != nil:
+		return "Expression"
+	case e.ValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case e.ValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case e.ValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case e.ValueUsageContext != nil:
+		return "UsageContext"
+	case e.ValueAvailability != nil:
+		return "Availability"
+	case e.ValueExtendedContactDetail != nil:
+		return "ExtendedContactDetail"
+	case e.ValueDosage != nil:
+		return "Dosage"
+	case e.ValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (e *Extension) ClearValue() {
+	e.ValueBase64Binary = nil
+	e.ValueBase64BinaryExt = nil
+	e.ValueBoolean = nil
+	e.ValueBooleanExt = nil
+	e.ValueCanonical = nil
+	e.ValueCanonicalExt = nil
+	e.ValueCode = nil
+	e.ValueCodeExt = nil
+	e.ValueDate = nil
+	e.ValueDateExt = nil
+	e.ValueDateTime = nil
+	e.ValueDateTimeExt = nil
+	e.ValueDecimal = nil
+	e.ValueDecimalExt = nil
+	e.ValueID = nil
+	e.ValueIDExt = nil
+	e.ValueInstant = nil
+	e.ValueInstantExt = nil
+	e.ValueInteger = nil
+	e.ValueIntegerExt = nil
+	e.ValueInteger64 = nil
+	e.ValueInteger64Ext = nil
+	e.ValueMarkdown = nil
+	e.ValueMarkdownExt = nil
+	e.ValueOid = nil
+	e.ValueOidExt = nil
+	e.ValuePositiveInt = nil
+	e.ValuePositiveIntExt = nil
+	e.ValueString = nil
+	e.ValueStringExt = nil
+	e.ValueTime = nil
+	e.ValueTimeExt = nil
+	e.ValueUnsignedInt = nil
+	e.ValueUnsignedIntExt = nil
+	e.ValueURI = nil
+	e.ValueURIExt = nil
+	e.ValueURL = nil
+	e.ValueURLExt = nil
+	e.ValueUUID = nil
+	e.ValueUUIDExt = nil
+	e.ValueAddress = nil
+	e.ValueAge = nil
+	e.ValueAnnotation = nil
+	e.ValueAttachment = nil
+	e.ValueCodeableConcept = nil
+	e.ValueCodeableReference = nil
+	e.ValueCoding = nil
+	e.ValueContactPoint = nil
+	e.ValueCount = nil
+	e.ValueDistance = nil
+	e.ValueDuration = nil
+	e.ValueHumanName = nil
+	e.ValueIdentifier = nil
+	e.ValueMoney = nil
+	e.ValuePeriod = nil
+	e.ValueQuantity = nil
+	e.ValueRange = nil
+	e.ValueRatio = nil
+	e.ValueRatioRange = nil
+	e.ValueReference = nil
+	e.ValueSampledData = nil
+	e.ValueSignature = nil
+	e.ValueTiming = nil
+	e.ValueContactDetail = nil
+	e.ValueDataRequirement = nil
+	e.ValueExpression = nil
+	e.ValueParameterDefinition = nil
+	e.ValueRelatedArtifact = nil
+	e.ValueTriggerDefinition = nil
+	e.ValueUsageContext = nil
+	e.ValueAvailability = nil
+	e.ValueExtendedContactDetail = nil
+	e.ValueDosage = nil
+	e.ValueMeta = nil
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (e *Extension) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := e.ValueBase64BinaryExt
+	e.ClearValue()
+	e.ValueBase64Binary, e.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (e *Extension) SetValueBoolean(value bool) {
+	ext := e.ValueBooleanExt
+	e.ClearValue()
+	e.ValueBoolean, e.ValueBooleanExt = &value, ext
+}
+
+// SetValueCanonical sets the canonical option of value[x], clearing the others.
+func (e *Extension) SetValueCanonical(value primitives.Canonical) {
+	ext := e.ValueCanonicalExt
+	e.ClearValue()
+	e.ValueCanonical, e.ValueCanonicalExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (e *Extension) SetValueCode(value string) {
+	ext := e.ValueCodeExt
+	e.ClearValue()
+	e.ValueCode, e.ValueCodeExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (e *Extension) SetValueDate(value primitives.Date) {
+	ext := e.ValueDateExt
+	e.ClearValue()
+	e.ValueDate, e.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (e *Extension) SetValueDateTime(value primitives.DateTime) {
+	ext := e.ValueDateTimeExt
+	e.ClearValue()
+	e.ValueDateTime, e.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (e *Extension) SetValueDecimal(value primitives.Decimal) {
+	ext := e.ValueDecimalExt
+	e.ClearValue()
+	e.ValueDecimal, e.ValueDecimalExt = &value, ext
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (e *Extension) SetValueID(value string) {
+	ext := e.ValueIDExt
+	e.ClearValue()
+	e.ValueID, e.ValueIDExt = &value, ext
+}
+
+// SetValueInstant sets the instant option of value[x], clearing the others.
+func (e *Extension) SetValueInstant(value primitives.Instant) {
+	ext := e.ValueInstantExt
+	e.ClearValue()
+	e.ValueInstant, e.ValueInstantExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (e *Extension) SetValueInteger(value int) {
+	ext := e.ValueIntegerExt
+	e.ClearValue()
+	e.ValueInteger, e.ValueIntegerExt = &value, ext
+}
+
+// SetValueInteger64 sets the integer64 option of value[x], clearing the others.
+func (e *Extension) SetValueInteger64(value primitives.Integer64) {
+	ext := e.ValueInteger64Ext
+	e.ClearValue()
+	e.ValueInteger64, e.ValueInteger64Ext = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (e *Extension) SetValueMarkdown(value string) {
+	ext := e.ValueMarkdownExt
+	e.ClearValue()
+	e.ValueMarkdown, e.ValueMarkdownExt = &value, ext
+}
+
+// SetValueOid sets the oid option of value[x], clearing the others.
+func (e *Extension) SetValueOid(value string) {
+	ext := e.ValueOidExt
+	e.ClearValue()
+	e.ValueOid, e.ValueOidExt = &value, ext
+}
+
+// SetValuePositiveInt sets the positiveInt option of value[x], clearing the others.
+func (e *Extension) SetValuePositiveInt(value int) {
+	ext := e.ValuePositiveIntExt
+	e.ClearValue()
+	e.ValuePositiveInt, e.ValuePositiveIntExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (e *Extension) SetValueString(value string) {
+	ext := e.ValueStringExt
+	e.ClearValue()
+	e.ValueString, e.ValueStringExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (e *Extension) SetValueTime(value primitives.Time) {
+	ext := e.ValueTimeExt
+	e.ClearValue()
+	e.ValueTime, e.ValueTimeExt = &value, ext
+}
+
+// SetValueUnsignedInt sets the unsignedInt option of value[x], clearing the others.
+func (e *Extension) SetValueUnsignedInt(value uint) {
+	ext := e.ValueUnsignedIntExt
+	e.ClearValue()
+	e.ValueUnsignedInt, e.ValueUnsignedIntExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (e *Extension) SetValueURI(value string) {
+	ext := e.ValueURIExt
+	e.ClearValue()
+	e.ValueURI, e.ValueURIExt = &value, ext
+}
+
+// SetValueURL sets the url option of value[x], clearing the others.
+func (e *Extension) SetValueURL(value string) {
+	ext := e.ValueURLExt
+	e.ClearValue()
+	e.ValueURL, e.ValueURLExt = &value, ext
+}
+
+// SetValueUUID sets the uuid option of value[x], clearing the others.
+func (e *Extension) SetValueUUID(value string) {
+	ext := e.ValueUUIDExt
+	e.ClearValue()
+	e.ValueUUID, e.ValueUUIDExt = &value, ext
+}
+
+// SetValueAddress sets the Address option of value[x], clearing the others.
+func (e *Extension) SetValueAddress(value Address) {
+	e.ClearValue()
+	e.ValueAddress = &value
+}
+
+// SetValueAge sets the Age option of value[x], clearing the others.
+func (e *Extension) SetValueAge(value Age) {
+	e.ClearValue()
+	e.ValueAge = &value
+}
+
+// SetValueAnnotation sets the Annotation option of value[x], clearing the others.
+func (e *Extension) SetValueAnnotation(value Annotation) {
+	e.ClearValue()
+	e.ValueAnnotation = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (e *Extension) SetValueAttachment(value Attachment) {
+	e.ClearValue()
+	e.ValueAttachment = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (e *Extension) SetValueCodeableConcept(value CodeableConcept) {
+	e.ClearValue()
+	e.ValueCodeableConcept = &value
+}
+
+// SetValueCodeableReference sets the CodeableReference option of value[x], clearing the others.
+func (e *Extension) SetValueCodeableReference(value CodeableReference) {
+	e.ClearValue()
+	e.ValueCodeableReference = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (e *Extension) SetValueCoding(value Coding) {
+	e.ClearValue()
+	e.ValueCoding = &value
+}
+
+// SetValueContactPoint sets the ContactPoint option of value[x], clearing the others.
+func (e *Extension) SetValueContactPoint(value ContactPoint) {
+	e.ClearValue()
+	e.ValueContactPoint = &value
+}
+
+// SetValueCount sets the Count option of value[x], clearing the others.
+func (e *Extension) SetValueCount(value Count) {
+	e.ClearValue()
+	e.ValueCount = &value
+}
+
+// SetValueDistance sets the Distance option of value[x], clearing the others.
+func (e *Extension) SetValueDistance(value Distance) {
+	e.ClearValue()
+	e.ValueDistance = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (e *Extension) SetValueDuration(value Duration) {
+	e.ClearValue()
+	e.ValueDuration = &value
+}
+
+// SetValueHumanName sets the HumanName option of value[x], clearing the others.
+func (e *Extension) SetValueHumanName(value HumanName) {
+	e.ClearValue()
+	e.ValueHumanName = &value
+}
+
+// SetValueIdentifier sets the Identifier option of value[x], clearing the others.
+func (e *Extension) SetValueIdentifier(value Identifier) {
+	e.ClearValue()
+	e.ValueIdentifier = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (e *Extension) SetValueMoney(value Money) {
+	e.ClearValue()
+	e.ValueMoney = &value
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (e *Extension) SetValuePeriod(value Period) {
+	e.ClearValue()
+	e.ValuePeriod = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (e *Extension) SetValueQuantity(value Quantity) {
+	e.ClearValue()
+	e.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (e *Extension) SetValueRange(value Range) {
+	e.ClearValue()
+	e.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (e *Extension) SetValueRatio(value Ratio) {
+	e.ClearValue()
+	e.ValueRatio = &value
+}
+
+// SetValueRatioRange sets the RatioRange option of value[x], clearing the others.
+func (e *Extension) SetValueRatioRange(value RatioRange) {
+	e.ClearValue()
+	e.ValueRatioRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (e *Extension) SetValueReference(value Reference) {
+	e.ClearValue()
+	e.ValueReference = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (e *Extension) SetValueSampledData(value SampledData) {
+	e.ClearValue()
+	e.ValueSampledData = &value
+}
+
+// SetValueSignature sets the Signature option of value[x], clearing the others.
+func (e *Extension) SetValueSignature(value Signature) {
+	e.ClearValue()
+	e.ValueSignature = &value
+}
+
+// SetValueTiming sets the Timing option of value[x], clearing the others.
+func (e *Extension) SetValueTiming(value Timing) {
+	e.ClearValue()
+	e.ValueTiming = &value
+}
+
+// SetValueContactDetail sets the ContactDetail option of value[x], clearing the others.
+func (e *Extension) SetValueContactDetail(value ContactDetail) {
+	e.ClearValue()
+	e.ValueContactDetail = &value
+}
+
+// SetValueDataRequirement sets the DataRequirement option of value[x], clearing the others.
+func (e *Extension) SetValueDataRequirement(value DataRequirement) {
+	e.ClearValue()
+	e.ValueDataRequirement = &value
+}
+
+// SetValueExpression sets the Expression option of value[x], clearing the others.
+func (e *Extension) SetValueExpression(value Expression) {
+	e.ClearValue()
+	e.ValueExpression = &value
+}
+
+// SetValueParameterDefinition sets the ParameterDefinition option of value[x], clearing the others.
+func (e *Extension) SetValueParameterDefinition(value ParameterDefinition) {
+	e.ClearValue()
+	e.ValueParameterDefinition = &value
+}
+
+// SetValueRelatedArtifact sets the RelatedArtifact option of value[x], clearing the others.
+func (e *Extension) SetValueRelatedArtifact(value RelatedArtifact) {
+	e.ClearValue()
+	e.ValueRelatedArtifact = &value
+}
+
+// SetValueTriggerDefinition sets the TriggerDefinition option of value[x], clearing the others.
+func (e *Extension) SetValueTriggerDefinition(value TriggerDefinition) {
+	e.ClearValue()
+	e.ValueTriggerDefinition = &value
+}
+
+// SetValueUsageContext sets the UsageContext option of value[x], clearing the others.
+func (e *Extension) SetValueUsageContext(value UsageContext) {
+	e.ClearValue()
+	e.ValueUsageContext = &value
+}
+
+// SetValueAvailability sets the Availability option of value[x], clearing the others.
+func (e *Extension) SetValueAvailability(value Availability) {
+	e.ClearValue()
+	e.ValueAvailability = &value
+}
+
+// SetValueExtendedContactDetail sets the ExtendedContactDetail option of value[x], clearing the others.
+func (e *Extension) SetValueExtendedContactDetail(value ExtendedContactDetail) {
+	e.ClearValue()
+	e.ValueExtendedContactDetail = &value
+}
+
+// SetValueDosage sets the Dosage option of value[x], clearing the others.
+func (e *Extension) SetValueDosage(value Dosage) {
+	e.ClearValue()
+	e.ValueDosage = &value
+}
+
+// SetValueMeta sets the Meta option of value[x], clearing the others.
+func (e *Extension) SetValueMeta(value Meta) {
+	e.ClearValue()
+	e.ValueMeta = &value
+}
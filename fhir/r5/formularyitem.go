@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeFormularyItem = "FormularyItem"
 
 // FormularyItem represents a FHIR FormularyItem.
 type FormularyItem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this formulary item
@@ -27,3 +26,8 @@ type FormularyItem struct {
 	// Extension for Status
 	StatusExt *primitives.PrimitiveExtension `json:"_status,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "FormularyItem", the FHIR type of the resource.
+func (f *FormularyItem) ResourceType() string {
+	return ResourceTypeFormularyItem
+}
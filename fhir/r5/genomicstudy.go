@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,6 +32,47 @@ type GenomicStudyAnalysisInput struct {
 	GeneratedByReference *Reference `json:"generatedByReference,omitempty" fhir:"cardinality=0..1,choice=generatedBy"`
 }
 
+// GeneratedBy returns the option of generatedBy[x] that is set, or nil if none is.
+func (g *GenomicStudyAnalysisInput) GeneratedBy() any {
+	switch {
+	case g.GeneratedByIdentifier != nil:
+		return g.GeneratedByIdentifier
+	case g.GeneratedByReference != nil:
+		return g.GeneratedByReference
+	}
+	return nil
+}
+
+// GeneratedByType returns the FHIR type of the option of generatedBy[x] that is
+// set, such as "Identifier", or "" if none is.
+func (g *GenomicStudyAnalysisInput) GeneratedByType() string {
+	switch {
+	case g.GeneratedByIdentifier != nil:
+		return "Identifier"
+	case g.GeneratedByReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearGeneratedBy unsets every option of generatedBy[x], and their extensions.
+func (g *GenomicStudyAnalysisInput) ClearGeneratedBy() {
+	g.GeneratedByIdentifier = nil
+	g.GeneratedByReference = nil
+}
+
+// SetGeneratedByIdentifier sets the Identifier option of generatedBy[x], clearing the others.
+func (g *GenomicStudyAnalysisInput) SetGeneratedByIdentifier(value Identifier) {
+	g.ClearGeneratedBy()
+	g.GeneratedByIdentifier = &value
+}
+
+// SetGeneratedByReference sets the Reference option of generatedBy[x], clearing the others.
+func (g *GenomicStudyAnalysisInput) SetGeneratedByReference(value Reference) {
+	g.ClearGeneratedBy()
+	g.GeneratedByReference = &value
+}
+
 // GenomicStudyAnalysisOutput represents a FHIR BackboneElement for GenomicStudy.analysis.output.
 type GenomicStudyAnalysisOutput struct {
 	// Unique id for inter-element referencing
@@ -100,7 +140,7 @@ type GenomicStudyAnalysis struct {
 	// Genome build that is used in this analysis
 	GenomeBuild *CodeableConcept `json:"genomeBuild,omitempty" fhir:"cardinality=0..1"`
 	// The defined protocol that describes the analysis
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
+	InstantiatesCanonical *primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// The URL pointing to an externally maintained protocol that describes the analysis
@@ -139,7 +179,7 @@ type GenomicStudyAnalysis struct {
 
 // GenomicStudy represents a FHIR GenomicStudy.
 type GenomicStudy struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifiers for this genomic study
@@ -167,7 +207,7 @@ type GenomicStudy struct {
 	// Why the genomic study was performed
 	Reason []CodeableReference `json:"reason,omitempty" fhir:"cardinality=0..*"`
 	// The defined protocol that describes the study
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
+	InstantiatesCanonical *primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// The URL pointing to an externally maintained protocol that describes the study
@@ -183,3 +223,8 @@ type GenomicStudy struct {
 	// Genomic Analysis Event
 	Analysis []GenomicStudyAnalysis `json:"analysis,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "GenomicStudy", the FHIR type of the resource.
+func (g *GenomicStudy) ResourceType() string {
+	return ResourceTypeGenomicStudy
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,9 +52,154 @@ type GoalTarget struct {
 	DueDuration *Duration `json:"dueDuration,omitempty" fhir:"cardinality=0..1,summary,choice=due"`
 }
 
+// Detail returns the option of detail[x] that is set, or nil if none is.
+func (g *GoalTarget) Detail() any {
+	switch {
+	case g.DetailQuantity != nil:
+		return g.DetailQuantity
+	case g.DetailRange != nil:
+		return g.DetailRange
+	case g.DetailCodeableConcept != nil:
+		return g.DetailCodeableConcept
+	case g.DetailString != nil:
+		return g.DetailString
+	case g.DetailBoolean != nil:
+		return g.DetailBoolean
+	case g.DetailInteger != nil:
+		return g.DetailInteger
+	case g.DetailRatio != nil:
+		return g.DetailRatio
+	}
+	return nil
+}
+
+// DetailType returns the FHIR type of the option of detail[x] that is
+// set, such as "Quantity", or "" if none is.
+func (g *GoalTarget) DetailType() string {
+	switch {
+	case g.DetailQuantity != nil:
+		return "Quantity"
+	case g.DetailRange != nil:
+		return "Range"
+	case g.DetailCodeableConcept != nil:
+		return "CodeableConcept"
+	case g.DetailString != nil:
+		return "string"
+	case g.DetailBoolean != nil:
+		return "boolean"
+	case g.DetailInteger != nil:
+		return "integer"
+	case g.DetailRatio != nil:
+		return "Ratio"
+	}
+	return ""
+}
+
+// ClearDetail unsets every option of detail[x], and their extensions.
+func (g *GoalTarget) ClearDetail() {
+	g.DetailQuantity = nil
+	g.DetailRange = nil
+	g.DetailCodeableConcept = nil
+	g.DetailString = nil
+	g.DetailStringExt = nil
+	g.DetailBoolean = nil
+	g.DetailBooleanExt = nil
+	g.DetailInteger = nil
+	g.DetailIntegerExt = nil
+	g.DetailRatio = nil
+}
+
+// SetDetailQuantity sets the Quantity option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailQuantity(value Quantity) {
+	g.ClearDetail()
+	g.DetailQuantity = &value
+}
+
+// SetDetailRange sets the Range option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailRange(value Range) {
+	g.ClearDetail()
+	g.DetailRange = &value
+}
+
+// SetDetailCodeableConcept sets the CodeableConcept option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailCodeableConcept(value CodeableConcept) {
+	g.ClearDetail()
+	g.DetailCodeableConcept = &value
+}
+
+// SetDetailString sets the string option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailString(value string) {
+	ext := g.DetailStringExt
+	g.ClearDetail()
+	g.DetailString, g.DetailStringExt = &value, ext
+}
+
+// SetDetailBoolean sets the boolean option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailBoolean(value bool) {
+	ext := g.DetailBooleanExt
+	g.ClearDetail()
+	g.DetailBoolean, g.DetailBooleanExt = &value, ext
+}
+
+// SetDetailInteger sets the integer option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailInteger(value int) {
+	ext := g.DetailIntegerExt
+	g.ClearDetail()
+	g.DetailInteger, g.DetailIntegerExt = &value, ext
+}
+
+// SetDetailRatio sets the Ratio option of detail[x], clearing the others.
+func (g *GoalTarget) SetDetailRatio(value Ratio) {
+	g.ClearDetail()
+	g.DetailRatio = &value
+}
+
+// Due returns the option of due[x] that is set, or nil if none is.
+func (g *GoalTarget) Due() any {
+	switch {
+	case g.DueDate != nil:
+		return g.DueDate
+	case g.DueDuration != nil:
+		return g.DueDuration
+	}
+	return nil
+}
+
+// DueType returns the FHIR type of the option of due[x] that is
+// set, such as "date", or "" if none is.
+func (g *GoalTarget) DueType() string {
+	switch {
+	case g.DueDate != nil:
+		return "date"
+	case g.DueDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearDue unsets every option of due[x], and their extensions.
+func (g *GoalTarget) ClearDue() {
+	g.DueDate = nil
+	g.DueDateExt = nil
+	g.DueDuration = nil
+}
+
+// SetDueDate sets the date option of due[x], clearing the others.
+func (g *GoalTarget) SetDueDate(value primitives.Date) {
+	ext := g.DueDateExt
+	g.ClearDue()
+	g.DueDate, g.DueDateExt = &value, ext
+}
+
+// SetDueDuration sets the Duration option of due[x], clearing the others.
+func (g *GoalTarget) SetDueDuration(value Duration) {
+	g.ClearDue()
+	g.DueDuration = &value
+}
+
 // Goal represents a FHIR Goal.
 type Goal struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Ids for this goal
@@ -103,3 +247,51 @@ type Goal struct {
 	// What result was achieved regarding the goal?
 	Outcome []CodeableReference `json:"outcome,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Goal", the FHIR type of the resource.
+func (g *Goal) ResourceType() string {
+	return ResourceTypeGoal
+}
+
+// Start returns the option of start[x] that is set, or nil if none is.
+func (g *Goal) Start() any {
+	switch {
+	case g.StartDate != nil:
+		return g.StartDate
+	case g.StartCodeableConcept != nil:
+		return g.StartCodeableConcept
+	}
+	return nil
+}
+
+// StartType returns the FHIR type of the option of start[x] that is
+// set, such as "date", or "" if none is.
+func (g *Goal) StartType() string {
+	switch {
+	case g.StartDate != nil:
+		return "date"
+	case g.StartCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearStart unsets every option of start[x], and their extensions.
+func (g *Goal) ClearStart() {
+	g.StartDate = nil
+	g.StartDateExt = nil
+	g.StartCodeableConcept = nil
+}
+
+// SetStartDate sets the date option of start[x], clearing the others.
+func (g *Goal) SetStartDate(value primitives.Date) {
+	ext := g.StartDateExt
+	g.ClearStart()
+	g.StartDate, g.StartDateExt = &value, ext
+}
+
+// SetStartCodeableConcept sets the CodeableConcept option of start[x], clearing the others.
+func (g *Goal) SetStartCodeableConcept(value CodeableConcept) {
+	g.ClearStart()
+	g.StartCodeableConcept = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -36,7 +35,7 @@ type GraphDefinitionNode struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Profile for the target resource
-	Profile *string `json:"profile,omitempty" fhir:"cardinality=0..1"`
+	Profile *primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 }
@@ -121,7 +120,7 @@ type GraphDefinitionLink struct {
 
 // GraphDefinition represents a FHIR GraphDefinition.
 type GraphDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this graph definition, represented as a URI (globally unique)
@@ -195,3 +194,51 @@ type GraphDefinition struct {
 	// Links this graph makes rules about
 	Link []GraphDefinitionLink `json:"link,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "GraphDefinition", the FHIR type of the resource.
+func (g *GraphDefinition) ResourceType() string {
+	return ResourceTypeGraphDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (g *GraphDefinition) VersionAlgorithm() any {
+	switch {
+	case g.VersionAlgorithmString != nil:
+		return g.VersionAlgorithmString
+	case g.VersionAlgorithmCoding != nil:
+		return g.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (g *GraphDefinition) VersionAlgorithmType() string {
+	switch {
+	case g.VersionAlgorithmString != nil:
+		return "string"
+	case g.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (g *GraphDefinition) ClearVersionAlgorithm() {
+	g.VersionAlgorithmString = nil
+	g.VersionAlgorithmStringExt = nil
+	g.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (g *GraphDefinition) SetVersionAlgorithmString(value string) {
+	ext := g.VersionAlgorithmStringExt
+	g.ClearVersionAlgorithm()
+	g.VersionAlgorithmString, g.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (g *GraphDefinition) SetVersionAlgorithmCoding(value Coding) {
+	g.ClearVersionAlgorithm()
+	g.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeGuidanceResponse = "GuidanceResponse"
 
 // GuidanceResponse represents a FHIR GuidanceResponse.
 type GuidanceResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The identifier of the request associated with this response, if any
@@ -23,15 +22,15 @@ type GuidanceResponse struct {
 	// Business identifier
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// What guidance was requested - uri option
-	ModuleURI string `json:"moduleURI" fhir:"cardinality=1..1,required,summary,choice=module"`
+	ModuleURI *string `json:"moduleUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=module"`
 	// Extension for ModuleURI
-	ModuleURIExt *primitives.PrimitiveExtension `json:"_moduleURI,omitempty" fhir:"cardinality=0..1"`
+	ModuleURIExt *primitives.PrimitiveExtension `json:"_moduleUri,omitempty" fhir:"cardinality=0..1"`
 	// What guidance was requested - canonical option
-	ModuleCanonical string `json:"moduleCanonical" fhir:"cardinality=1..1,required,summary,choice=module"`
+	ModuleCanonical *primitives.Canonical `json:"moduleCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=module"`
 	// Extension for ModuleCanonical
 	ModuleCanonicalExt *primitives.PrimitiveExtension `json:"_moduleCanonical,omitempty" fhir:"cardinality=0..1"`
 	// What guidance was requested - CodeableConcept option
-	ModuleCodeableConcept CodeableConcept `json:"moduleCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=module"`
+	ModuleCodeableConcept *CodeableConcept `json:"moduleCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=module"`
 	// success | data-requested | data-required | in-progress | failure | entered-in-error
 	Status string `json:"status" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Status
@@ -59,3 +58,64 @@ type GuidanceResponse struct {
 	// Additional required data
 	DataRequirement []DataRequirement `json:"dataRequirement,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "GuidanceResponse", the FHIR type of the resource.
+func (g *GuidanceResponse) ResourceType() string {
+	return ResourceTypeGuidanceResponse
+}
+
+// Module returns the option of module[x] that is set, or nil if none is.
+func (g *GuidanceResponse) Module() any {
+	switch {
+	case g.ModuleURI != nil:
+		return g.ModuleURI
+	case g.ModuleCanonical != nil:
+		return g.ModuleCanonical
+	case g.ModuleCodeableConcept != nil:
+		return g.ModuleCodeableConcept
+	}
+	return nil
+}
+
+// ModuleType returns the FHIR type of the option of module[x] that is
+// set, such as "uri", or "" if none is.
+func (g *GuidanceResponse) ModuleType() string {
+	switch {
+	case g.ModuleURI != nil:
+		return "uri"
+	case g.ModuleCanonical != nil:
+		return "canonical"
+	case g.ModuleCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearModule unsets every option of module[x], and their extensions.
+func (g *GuidanceResponse) ClearModule() {
+	g.ModuleURI = nil
+	g.ModuleURIExt = nil
+	g.ModuleCanonical = nil
+	g.ModuleCanonicalExt = nil
+	g.ModuleCodeableConcept = nil
+}
+
+// SetModuleURI sets the uri option of module[x], clearing the others.
+func (g *GuidanceResponse) SetModuleURI(value string) {
+	ext := g.ModuleURIExt
+	g.ClearModule()
+	g.ModuleURI, g.ModuleURIExt = &value, ext
+}
+
+// SetModuleCanonical sets the canonical option of module[x], clearing the others.
+func (g *GuidanceResponse) SetModuleCanonical(value primitives.Canonical) {
+	ext := g.ModuleCanonicalExt
+	g.ClearModule()
+	g.ModuleCanonical, g.ModuleCanonicalExt = &value, ext
+}
+
+// SetModuleCodeableConcept sets the CodeableConcept option of module[x], clearing the others.
+func (g *GuidanceResponse) SetModuleCodeableConcept(value CodeableConcept) {
+	g.ClearModule()
+	g.ModuleCodeableConcept = &value
+}
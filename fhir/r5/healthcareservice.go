@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -33,7 +32,7 @@ type HealthcareServiceEligibility struct {
 
 // HealthcareService represents a FHIR HealthcareService.
 type HealthcareService struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifiers for this item
@@ -93,3 +92,8 @@ type HealthcareService struct {
 	// Technical endpoints providing access to electronic services operated for the healthcare service
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "HealthcareService", the FHIR type of the resource.
+func (h *HealthcareService) ResourceType() string {
+	return ResourceTypeHealthcareService
+}
@@ -0,0 +1,268 @@
+// Package bd validates Bangladesh national identifiers: the National ID (NID),
+// the Birth Registration Number (BRN) and the Unique Health ID (UHID).
+package bd
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	bdvs "github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
+)
+
+// Canonical identifier systems issued by the Directorate General of Health Services.
+const (
+	SystemNID  = "http://dghs.gov.bd/identifier/nid"
+	SystemBRN  = "http://dghs.gov.bd/identifier/brn"
+	SystemUHID = "http://dghs.gov.bd/identifier/uhid"
+)
+
+// legacySystems maps identifier systems that appeared in earlier documentation
+// to their canonical form.
+var legacySystems = map[string]string{
+	"http://zdhhs.gov.bd/identifier/nid":                SystemNID,
+	"http://zdhhs.gov.bd/identifier/brn":                SystemBRN,
+	"http://zdhhs.gov.bd/identifier/uhid":               SystemUHID,
+	"http://zdhhs.gov.bd/fhir/StructureDefinition/NID":  SystemNID,
+	"http://zdhhs.gov.bd/fhir/StructureDefinition/BRN":  SystemBRN,
+	"http://zdhhs.gov.bd/fhir/StructureDefinition/UHID": SystemUHID,
+}
+
+// CanonicalSystem returns the canonical form of a Bangladesh identifier system,
+// or the input unchanged if it is not one.
+func CanonicalSystem(system string) string {
+	if c, ok := legacySystems[system]; ok {
+		return c
+	}
+	return system
+}
+
+// IsKnownSystem reports whether the system (canonical or legacy) has a validator.
+func IsKnownSystem(system string) bool {
+	switch CanonicalSystem(system) {
+	case SystemNID, SystemBRN, SystemUHID:
+		return true
+	}
+	return false
+}
+
+var (
+	// ErrLength is returned when the value has a length the scheme does not allow.
+	ErrLength = errors.New("invalid length")
+
+	// ErrCharacters is returned when the value contains characters outside the scheme.
+	ErrCharacters = errors.New("invalid characters")
+
+	// ErrYear is returned when an embedded birth or issue year is implausible.
+	ErrYear = errors.New("invalid embedded year")
+
+	// ErrGeoCode is returned when an embedded district code does not exist.
+	ErrGeoCode = errors.New("invalid embedded district code")
+
+	// ErrFormat is returned when the value does not match the scheme's layout.
+	ErrFormat = errors.New("invalid format")
+)
+
+// Error describes why an identifier value was rejected.
+// It wraps one of the Err* sentinels so callers can use errors.Is.
+type Error struct {
+	System string // Canonical identifier system
+	Value  string // Rejected value
+	Reason error  // One of the Err* sentinels
+	Detail string // Human-readable explanation
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %q: %v: %s", schemeName(e.System), e.Value, e.Reason, e.Detail)
+}
+
+// Unwrap returns the sentinel reason.
+func (e *Error) Unwrap() error {
+	return e.Reason
+}
+
+func schemeName(system string) string {
+	switch system {
+	case SystemNID:
+		return "NID"
+	case SystemBRN:
+		return "BRN"
+	case SystemUHID:
+		return "UHID"
+	default:
+		return system
+	}
+}
+
+func newError(system, value string, reason error, format string, args ...any) *Error {
+	return &Error{System: system, Value: value, Reason: reason, Detail: fmt.Sprintf(format, args...)}
+}
+
+// Validate checks value against the scheme for system. Systems without a
+// validator are accepted.
+func Validate(system, value string) error {
+	switch CanonicalSystem(system) {
+	case SystemNID:
+		return ValidateNID(value)
+	case SystemBRN:
+		return ValidateBRN(value)
+	case SystemUHID:
+		return ValidateUHID(value)
+	default:
+		return nil
+	}
+}
+
+// NIDKind is the generation of a National ID number.
+type NIDKind int
+
+const (
+	NIDSmartCard NIDKind = 10 // 10-digit smart card number
+	NIDLegacy    NIDKind = 13 // 13-digit laminated card number
+	NIDWithYear  NIDKind = 17 // 13-digit number prefixed with the birth year
+)
+
+// NID is a parsed National ID number.
+type NID struct {
+	Value        string
+	Kind         NIDKind
+	BirthYear    int    // Only for NIDWithYear
+	DistrictCode string // Two-digit BBS district code, for legacy and 17-digit numbers
+}
+
+// ParseNID parses a 10, 13 or 17-digit National ID.
+//
+// 13-digit numbers begin with the BBS district code of registration; 17-digit
+// numbers prepend the four-digit birth year to a 13-digit number. The 10-digit
+// smart card numbers carry no public structure beyond being numeric.
+func ParseNID(value string) (NID, error) {
+	if !isDigits(value) {
+		return NID{}, newError(SystemNID, value, ErrCharacters, "must contain digits only")
+	}
+
+	nid := NID{Value: value, Kind: NIDKind(len(value))}
+	switch nid.Kind {
+	case NIDSmartCard:
+		if value[0] == '0' {
+			return NID{}, newError(SystemNID, value, ErrFormat, "smart card numbers do not start with 0")
+		}
+	case NIDLegacy:
+		nid.DistrictCode = value[:2]
+	case NIDWithYear:
+		year, err := birthYear(SystemNID, value)
+		if err != nil {
+			return NID{}, err
+		}
+		nid.BirthYear = year
+		nid.DistrictCode = value[4:6]
+	default:
+		return NID{}, newError(SystemNID, value, ErrLength, "must be 10, 13 or 17 digits, got %d", len(value))
+	}
+
+	if nid.DistrictCode != "" && !isDistrictCode(nid.DistrictCode) {
+		return NID{}, newError(SystemNID, value, ErrGeoCode, "%s is not a BBS district code", nid.DistrictCode)
+	}
+	return nid, nil
+}
+
+// ValidateNID checks a National ID number.
+func ValidateNID(value string) error {
+	_, err := ParseNID(value)
+	return err
+}
+
+// BRN is a parsed Birth Registration Number.
+type BRN struct {
+	Value        string
+	BirthYear    int
+	DistrictCode string // Two-digit BBS district code of the registering office
+	Serial       string // Remaining digits identifying the registration
+}
+
+// ParseBRN parses a 17-digit Birth Registration Number laid out as
+// YYYY (birth year) + DD (BBS district code) + 11 digits of office and serial.
+func ParseBRN(value string) (BRN, error) {
+	if !isDigits(value) {
+		return BRN{}, newError(SystemBRN, value, ErrCharacters, "must contain digits only")
+	}
+	if len(value) != 17 {
+		return BRN{}, newError(SystemBRN, value, ErrLength, "must be 17 digits, got %d", len(value))
+	}
+
+	year, err := birthYear(SystemBRN, value)
+	if err != nil {
+		return BRN{}, err
+	}
+	brn := BRN{
+		Value:        value,
+		BirthYear:    year,
+		DistrictCode: value[4:6],
+		Serial:       value[6:],
+	}
+	if !isDistrictCode(brn.DistrictCode) {
+		return BRN{}, newError(SystemBRN, value, ErrGeoCode, "%s is not a BBS district code", brn.DistrictCode)
+	}
+	return brn, nil
+}
+
+// ValidateBRN checks a Birth Registration Number.
+func ValidateBRN(value string) error {
+	_, err := ParseBRN(value)
+	return err
+}
+
+var uhidPattern = regexp.MustCompile(`^UH-(\d{4})-(\d{6})$`)
+
+// ValidateUHID checks a Unique Health ID of the form UH-YYYY-NNNNNN, where
+// YYYY is the year of issue.
+func ValidateUHID(value string) error {
+	m := uhidPattern.FindStringSubmatch(value)
+	if m == nil {
+		if strings.HasPrefix(value, "UH-") && len(value) != len("UH-0000-000000") {
+			return newError(SystemUHID, value, ErrLength, "must be %d characters, got %d", len("UH-0000-000000"), len(value))
+		}
+		return newError(SystemUHID, value, ErrFormat, "must match UH-YYYY-NNNNNN")
+	}
+	year, _ := strconv.Atoi(m[1])
+	if year < 2000 || year > time.Now().Year() {
+		return newError(SystemUHID, value, ErrYear, "issue year %d is out of range", year)
+	}
+	return nil
+}
+
+// minBirthYear is the earliest birth year accepted in NID and BRN numbers.
+const minBirthYear = 1900
+
+func birthYear(system, value string) (int, error) {
+	year, _ := strconv.Atoi(value[:4])
+	if year < minBirthYear || year > time.Now().Year() {
+		return 0, newError(system, value, ErrYear, "%d is outside %d-%d", year, minBirthYear, time.Now().Year())
+	}
+	return year, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDistrictCode reports whether code is the two-digit BBS code of a district.
+func isDistrictCode(code string) bool {
+	for _, div := range bdvs.AllDivisions() {
+		if _, ok := bdvs.LookupGeo(div.Code + code); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package bd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr error
+	}{
+		{name: "smart card", value: "1234567890"},
+		{name: "legacy 13 digit", value: "2612345678901"},
+		{name: "17 digit with year", value: "19902612345678901"},
+		{name: "smart card leading zero", value: "0234567890", wantErr: ErrFormat},
+		{name: "wrong length", value: "12345", wantErr: ErrLength},
+		{name: "letters", value: "12345ABCDE", wantErr: ErrCharacters},
+		{name: "empty", value: "", wantErr: ErrCharacters},
+		{name: "unknown district", value: "9912345678901", wantErr: ErrGeoCode},
+		{name: "future birth year", value: "29902612345678901", wantErr: ErrYear},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNID(tt.value)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var idErr *Error
+			require.True(t, errors.As(err, &idErr))
+			assert.Equal(t, SystemNID, idErr.System)
+			assert.Equal(t, tt.value, idErr.Value)
+		})
+	}
+}
+
+func TestParseNID(t *testing.T) {
+	nid, err := ParseNID("19902212345678901")
+	require.NoError(t, err)
+	assert.Equal(t, NIDWithYear, nid.Kind)
+	assert.Equal(t, 1990, nid.BirthYear)
+	assert.Equal(t, "22", nid.DistrictCode)
+}
+
+func TestParseBRN(t *testing.T) {
+	brn, err := ParseBRN("20152212345678901")
+	require.NoError(t, err)
+	assert.Equal(t, 2015, brn.BirthYear)
+	assert.Equal(t, "22", brn.DistrictCode)
+	assert.Equal(t, "12345678901", brn.Serial)
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr error
+	}{
+		{name: "too short", value: "2015221234567", wantErr: ErrLength},
+		{name: "non digit", value: "2015-2212345678901", wantErr: ErrCharacters},
+		{name: "year before 1900", value: "18992212345678901", wantErr: ErrYear},
+		{name: "unknown district", value: "20150012345678901", wantErr: ErrGeoCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateBRN(tt.value), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateUHID(t *testing.T) {
+	assert.NoError(t, ValidateUHID("UH-2024-001234"))
+	assert.ErrorIs(t, ValidateUHID("UH-2024-1234"), ErrLength)
+	assert.ErrorIs(t, ValidateUHID("XX-2024-001234"), ErrFormat)
+	assert.ErrorIs(t, ValidateUHID("UH-1999-001234"), ErrYear)
+}
+
+func TestCanonicalSystem(t *testing.T) {
+	assert.Equal(t, SystemNID, CanonicalSystem("http://zdhhs.gov.bd/identifier/nid"))
+	assert.Equal(t, SystemUHID, CanonicalSystem("http://zdhhs.gov.bd/fhir/StructureDefinition/UHID"))
+	assert.Equal(t, "http://example.org/mrn", CanonicalSystem("http://example.org/mrn"))
+
+	assert.True(t, IsKnownSystem("http://zdhhs.gov.bd/identifier/brn"))
+	assert.False(t, IsKnownSystem("http://example.org/mrn"))
+}
+
+func TestValidate_DispatchesBySystem(t *testing.T) {
+	assert.NoError(t, Validate("http://example.org/mrn", "anything"))
+	assert.ErrorIs(t, Validate("http://zdhhs.gov.bd/identifier/nid", "123"), ErrLength)
+	assert.ErrorIs(t, Validate(SystemBRN, "123"), ErrLength)
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -44,7 +43,7 @@ type ImagingSelectionInstanceImageRegion2D struct {
 	// Extension for RegionType
 	RegionTypeExt *primitives.PrimitiveExtension `json:"_regionType,omitempty" fhir:"cardinality=0..1"`
 	// Specifies the coordinates that define the image region
-	Coordinate []float64 `json:"coordinate,omitempty" fhir:"cardinality=1..*,required"`
+	Coordinate []primitives.Decimal `json:"coordinate,omitempty" fhir:"cardinality=1..*,required"`
 	// Extension for Coordinate
 	CoordinateExt *primitives.PrimitiveExtension `json:"_coordinate,omitempty" fhir:"cardinality=0..1"`
 }
@@ -64,7 +63,7 @@ type ImagingSelectionInstanceImageRegion3D struct {
 	// Extension for RegionType
 	RegionTypeExt *primitives.PrimitiveExtension `json:"_regionType,omitempty" fhir:"cardinality=0..1"`
 	// Specifies the coordinates that define the image region
-	Coordinate []float64 `json:"coordinate,omitempty" fhir:"cardinality=1..*,required"`
+	Coordinate []primitives.Decimal `json:"coordinate,omitempty" fhir:"cardinality=1..*,required"`
 	// Extension for Coordinate
 	CoordinateExt *primitives.PrimitiveExtension `json:"_coordinate,omitempty" fhir:"cardinality=0..1"`
 }
@@ -101,7 +100,7 @@ type ImagingSelectionInstance struct {
 
 // ImagingSelection represents a FHIR ImagingSelection.
 type ImagingSelection struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for Imaging Selection
@@ -151,3 +150,8 @@ type ImagingSelection struct {
 	// The selected instances
 	Instance []ImagingSelectionInstance `json:"instance,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "ImagingSelection", the FHIR type of the resource.
+func (i *ImagingSelection) ResourceType() string {
+	return ResourceTypeImagingSelection
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -97,7 +96,7 @@ type ImmunizationProtocolApplied struct {
 
 // Immunization represents a FHIR Immunization.
 type Immunization struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -131,11 +130,11 @@ type Immunization struct {
 	// Additional information in support of the immunization
 	SupportingInformation []Reference `json:"supportingInformation,omitempty" fhir:"cardinality=0..*"`
 	// Vaccine administration date - dateTime option
-	OccurrenceDateTime primitives.DateTime `json:"occurrenceDateTime" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
+	OccurrenceDateTime *primitives.DateTime `json:"occurrenceDateTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
 	// Extension for OccurrenceDateTime
 	OccurrenceDateTimeExt *primitives.PrimitiveExtension `json:"_occurrenceDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Vaccine administration date - string option
-	OccurrenceString string `json:"occurrenceString" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
+	OccurrenceString *string `json:"occurrenceString,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurrence"`
 	// Extension for OccurrenceString
 	OccurrenceStringExt *primitives.PrimitiveExtension `json:"_occurrenceString,omitempty" fhir:"cardinality=0..1"`
 	// Indicates context the data was captured in
@@ -173,3 +172,53 @@ type Immunization struct {
 	// Protocol followed by the provider
 	ProtocolApplied []ImmunizationProtocolApplied `json:"protocolApplied,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Immunization", the FHIR type of the resource.
+func (i *Immunization) ResourceType() string {
+	return ResourceTypeImmunization
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (i *Immunization) Occurrence() any {
+	switch {
+	case i.OccurrenceDateTime != nil:
+		return i.OccurrenceDateTime
+	case i.OccurrenceString != nil:
+		return i.OccurrenceString
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (i *Immunization) OccurrenceType() string {
+	switch {
+	case i.OccurrenceDateTime != nil:
+		return "dateTime"
+	case i.OccurrenceString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (i *Immunization) ClearOccurrence() {
+	i.OccurrenceDateTime = nil
+	i.OccurrenceDateTimeExt = nil
+	i.OccurrenceString = nil
+	i.OccurrenceStringExt = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (i *Immunization) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := i.OccurrenceDateTimeExt
+	i.ClearOccurrence()
+	i.OccurrenceDateTime, i.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrenceString sets the string option of occurrence[x], clearing the others.
+func (i *Immunization) SetOccurrenceString(value string) {
+	ext := i.OccurrenceStringExt
+	i.ClearOccurrence()
+	i.OccurrenceString, i.OccurrenceStringExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeImmunizationEvaluation = "ImmunizationEvaluation"
 
 // ImmunizationEvaluation represents a FHIR ImmunizationEvaluation.
 type ImmunizationEvaluation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -57,3 +56,8 @@ type ImmunizationEvaluation struct {
 	// Extension for SeriesDoses
 	SeriesDosesExt *primitives.PrimitiveExtension `json:"_seriesDoses,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ImmunizationEvaluation", the FHIR type of the resource.
+func (i *ImmunizationEvaluation) ResourceType() string {
+	return ResourceTypeImmunizationEvaluation
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -77,7 +76,7 @@ type ImmunizationRecommendationRecommendation struct {
 
 // ImmunizationRecommendation represents a FHIR ImmunizationRecommendation.
 type ImmunizationRecommendation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -93,3 +92,8 @@ type ImmunizationRecommendation struct {
 	// Vaccine administration recommendations
 	Recommendation []ImmunizationRecommendationRecommendation `json:"recommendation,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "ImmunizationRecommendation", the FHIR type of the resource.
+func (i *ImmunizationRecommendation) ResourceType() string {
+	return ResourceTypeImmunizationRecommendation
+}
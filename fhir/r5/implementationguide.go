@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,7 +23,7 @@ type ImplementationGuideDependsOn struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Identity of the IG that this depends on
-	URI string `json:"uri" fhir:"cardinality=1..1,required,summary"`
+	URI primitives.Canonical `json:"uri" fhir:"cardinality=1..1,required,summary"`
 	// Extension for URI
 	URIExt *primitives.PrimitiveExtension `json:"_uri,omitempty" fhir:"cardinality=0..1"`
 	// NPM Package name for IG this depends on
@@ -56,7 +55,7 @@ type ImplementationGuideGlobal struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Profile that all resources must conform to
-	Profile string `json:"profile" fhir:"cardinality=1..1,required,summary"`
+	Profile primitives.Canonical `json:"profile" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 }
@@ -110,7 +109,7 @@ type ImplementationGuideDefinitionResource struct {
 	// Extension for IsExample
 	IsExampleExt *primitives.PrimitiveExtension `json:"_isExample,omitempty" fhir:"cardinality=0..1"`
 	// Profile(s) this is an example of
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*"`
+	Profile []primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Grouping this is part of
@@ -134,9 +133,9 @@ type ImplementationGuideDefinitionPage struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Source for page - url option
-	SourceURL *string `json:"sourceURL,omitempty" fhir:"cardinality=0..1,choice=source"`
+	SourceURL *string `json:"sourceUrl,omitempty" fhir:"cardinality=0..1,choice=source"`
 	// Extension for SourceURL
-	SourceURLExt *primitives.PrimitiveExtension `json:"_sourceURL,omitempty" fhir:"cardinality=0..1"`
+	SourceURLExt *primitives.PrimitiveExtension `json:"_sourceUrl,omitempty" fhir:"cardinality=0..1"`
 	// Source for page - string option
 	SourceString *string `json:"sourceString,omitempty" fhir:"cardinality=0..1,choice=source"`
 	// Extension for SourceString
@@ -161,6 +160,64 @@ type ImplementationGuideDefinitionPage struct {
 	Page []ImplementationGuideDefinitionPagePage `json:"page,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Source returns the option of source[x] that is set, or nil if none is.
+func (i *ImplementationGuideDefinitionPage) Source() any {
+	switch {
+	case i.SourceURL != nil:
+		return i.SourceURL
+	case i.SourceString != nil:
+		return i.SourceString
+	case i.SourceMarkdown != nil:
+		return i.SourceMarkdown
+	}
+	return nil
+}
+
+// SourceType returns the FHIR type of the option of source[x] that is
+// set, such as "url", or "" if none is.
+func (i *ImplementationGuideDefinitionPage) SourceType() string {
+	switch {
+	case i.SourceURL != nil:
+		return "url"
+	case i.SourceString != nil:
+		return "string"
+	case i.SourceMarkdown != nil:
+		return "markdown"
+	}
+	return ""
+}
+
+// ClearSource unsets every option of source[x], and their extensions.
+func (i *ImplementationGuideDefinitionPage) ClearSource() {
+	i.SourceURL = nil
+	i.SourceURLExt = nil
+	i.SourceString = nil
+	i.SourceStringExt = nil
+	i.SourceMarkdown = nil
+	i.SourceMarkdownExt = nil
+}
+
+// SetSourceURL sets the url option of source[x], clearing the others.
+func (i *ImplementationGuideDefinitionPage) SetSourceURL(value string) {
+	ext := i.SourceURLExt
+	i.ClearSource()
+	i.SourceURL, i.SourceURLExt = &value, ext
+}
+
+// SetSourceString sets the string option of source[x], clearing the others.
+func (i *ImplementationGuideDefinitionPage) SetSourceString(value string) {
+	ext := i.SourceStringExt
+	i.ClearSource()
+	i.SourceString, i.SourceStringExt = &value, ext
+}
+
+// SetSourceMarkdown sets the markdown option of source[x], clearing the others.
+func (i *ImplementationGuideDefinitionPage) SetSourceMarkdown(value string) {
+	ext := i.SourceMarkdownExt
+	i.ClearSource()
+	i.SourceMarkdown, i.SourceMarkdownExt = &value, ext
+}
+
 // ImplementationGuideDefinitionParameter represents a FHIR BackboneElement for ImplementationGuide.definition.parameter.
 type ImplementationGuideDefinitionParameter struct {
 	// Unique id for inter-element referencing
@@ -242,7 +299,7 @@ type ImplementationGuideManifestResource struct {
 	// Extension for IsExample
 	IsExampleExt *primitives.PrimitiveExtension `json:"_isExample,omitempty" fhir:"cardinality=0..1"`
 	// Profile(s) this is an example of
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*"`
+	Profile []primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Relative path for page in IG
@@ -305,7 +362,7 @@ type ImplementationGuideManifest struct {
 
 // ImplementationGuide represents a FHIR ImplementationGuide.
 type ImplementationGuide struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this implementation guide, represented as a URI (globally unique)
@@ -391,3 +448,51 @@ type ImplementationGuide struct {
 	// Information about an assembled IG
 	Manifest *ImplementationGuideManifest `json:"manifest,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ImplementationGuide", the FHIR type of the resource.
+func (i *ImplementationGuide) ResourceType() string {
+	return ResourceTypeImplementationGuide
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (i *ImplementationGuide) VersionAlgorithm() any {
+	switch {
+	case i.VersionAlgorithmString != nil:
+		return i.VersionAlgorithmString
+	case i.VersionAlgorithmCoding != nil:
+		return i.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (i *ImplementationGuide) VersionAlgorithmType() string {
+	switch {
+	case i.VersionAlgorithmString != nil:
+		return "string"
+	case i.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (i *ImplementationGuide) ClearVersionAlgorithm() {
+	i.VersionAlgorithmString = nil
+	i.VersionAlgorithmStringExt = nil
+	i.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (i *ImplementationGuide) SetVersionAlgorithmString(value string) {
+	ext := i.VersionAlgorithmStringExt
+	i.ClearVersionAlgorithm()
+	i.VersionAlgorithmString, i.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (i *ImplementationGuide) SetVersionAlgorithmCoding(value Coding) {
+	i.ClearVersionAlgorithm()
+	i.VersionAlgorithmCoding = &value
+}
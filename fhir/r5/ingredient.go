@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -44,11 +43,11 @@ type IngredientSubstanceStrengthReferenceStrength struct {
 	// Relevant reference substance
 	Substance CodeableReference `json:"substance" fhir:"cardinality=1..1,required,summary"`
 	// Strength expressed in terms of a reference substance - Ratio option
-	StrengthRatio Ratio `json:"strengthRatio" fhir:"cardinality=1..1,required,summary,choice=strength"`
+	StrengthRatio *Ratio `json:"strengthRatio,omitempty" fhir:"cardinality=1..1,required,summary,choice=strength"`
 	// Strength expressed in terms of a reference substance - RatioRange option
-	StrengthRatioRange RatioRange `json:"strengthRatioRange" fhir:"cardinality=1..1,required,summary,choice=strength"`
+	StrengthRatioRange *RatioRange `json:"strengthRatioRange,omitempty" fhir:"cardinality=1..1,required,summary,choice=strength"`
 	// Strength expressed in terms of a reference substance - Quantity option
-	StrengthQuantity Quantity `json:"strengthQuantity" fhir:"cardinality=1..1,required,summary,choice=strength"`
+	StrengthQuantity *Quantity `json:"strengthQuantity,omitempty" fhir:"cardinality=1..1,required,summary,choice=strength"`
 	// When strength is measured at a particular point or distance
 	MeasurementPoint *string `json:"measurementPoint,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for MeasurementPoint
@@ -57,6 +56,58 @@ type IngredientSubstanceStrengthReferenceStrength struct {
 	Country []CodeableConcept `json:"country,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Strength returns the option of strength[x] that is set, or nil if none is.
+func (i *IngredientSubstanceStrengthReferenceStrength) Strength() any {
+	switch {
+	case i.StrengthRatio != nil:
+		return i.StrengthRatio
+	case i.StrengthRatioRange != nil:
+		return i.StrengthRatioRange
+	case i.StrengthQuantity != nil:
+		return i.StrengthQuantity
+	}
+	return nil
+}
+
+// StrengthType returns the FHIR type of the option of strength[x] that is
+// set, such as "Ratio", or "" if none is.
+func (i *IngredientSubstanceStrengthReferenceStrength) StrengthType() string {
+	switch {
+	case i.StrengthRatio != nil:
+		return "Ratio"
+	case i.StrengthRatioRange != nil:
+		return "RatioRange"
+	case i.StrengthQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearStrength unsets every option of strength[x], and their extensions.
+func (i *IngredientSubstanceStrengthReferenceStrength) ClearStrength() {
+	i.StrengthRatio = nil
+	i.StrengthRatioRange = nil
+	i.StrengthQuantity = nil
+}
+
+// SetStrengthRatio sets the Ratio option of strength[x], clearing the others.
+func (i *IngredientSubstanceStrengthReferenceStrength) SetStrengthRatio(value Ratio) {
+	i.ClearStrength()
+	i.StrengthRatio = &value
+}
+
+// SetStrengthRatioRange sets the RatioRange option of strength[x], clearing the others.
+func (i *IngredientSubstanceStrengthReferenceStrength) SetStrengthRatioRange(value RatioRange) {
+	i.ClearStrength()
+	i.StrengthRatioRange = &value
+}
+
+// SetStrengthQuantity sets the Quantity option of strength[x], clearing the others.
+func (i *IngredientSubstanceStrengthReferenceStrength) SetStrengthQuantity(value Quantity) {
+	i.ClearStrength()
+	i.StrengthQuantity = &value
+}
+
 // IngredientSubstanceStrength represents a FHIR BackboneElement for Ingredient.substance.strength.
 type IngredientSubstanceStrength struct {
 	// Unique id for inter-element referencing
@@ -103,6 +154,132 @@ type IngredientSubstanceStrength struct {
 	ReferenceStrength []IngredientSubstanceStrengthReferenceStrength `json:"referenceStrength,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Presentation returns the option of presentation[x] that is set, or nil if none is.
+func (i *IngredientSubstanceStrength) Presentation() any {
+	switch {
+	case i.PresentationRatio != nil:
+		return i.PresentationRatio
+	case i.PresentationRatioRange != nil:
+		return i.PresentationRatioRange
+	case i.PresentationCodeableConcept != nil:
+		return i.PresentationCodeableConcept
+	case i.PresentationQuantity != nil:
+		return i.PresentationQuantity
+	}
+	return nil
+}
+
+// PresentationType returns the FHIR type of the option of presentation[x] that is
+// set, such as "Ratio", or "" if none is.
+func (i *IngredientSubstanceStrength) PresentationType() string {
+	switch {
+	case i.PresentationRatio != nil:
+		return "Ratio"
+	case i.PresentationRatioRange != nil:
+		return "RatioRange"
+	case i.PresentationCodeableConcept != nil:
+		return "CodeableConcept"
+	case i.PresentationQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearPresentation unsets every option of presentation[x], and their extensions.
+func (i *IngredientSubstanceStrength) ClearPresentation() {
+	i.PresentationRatio = nil
+	i.PresentationRatioRange = nil
+	i.PresentationCodeableConcept = nil
+	i.PresentationQuantity = nil
+}
+
+// SetPresentationRatio sets the Ratio option of presentation[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetPresentationRatio(value Ratio) {
+	i.ClearPresentation()
+	i.PresentationRatio = &value
+}
+
+// SetPresentationRatioRange sets the RatioRange option of presentation[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetPresentationRatioRange(value RatioRange) {
+	i.ClearPresentation()
+	i.PresentationRatioRange = &value
+}
+
+// SetPresentationCodeableConcept sets the CodeableConcept option of presentation[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetPresentationCodeableConcept(value CodeableConcept) {
+	i.ClearPresentation()
+	i.PresentationCodeableConcept = &value
+}
+
+// SetPresentationQuantity sets the Quantity option of presentation[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetPresentationQuantity(value Quantity) {
+	i.ClearPresentation()
+	i.PresentationQuantity = &value
+}
+
+// Concentration returns the option of concentration[x] that is set, or nil if none is.
+func (i *IngredientSubstanceStrength) Concentration() any {
+	switch {
+	case i.ConcentrationRatio != nil:
+		return i.ConcentrationRatio
+	case i.ConcentrationRatioRange != nil:
+		return i.ConcentrationRatioRange
+	case i.ConcentrationCodeableConcept != nil:
+		return i.ConcentrationCodeableConcept
+	case i.ConcentrationQuantity != nil:
+		return i.ConcentrationQuantity
+	}
+	return nil
+}
+
+// ConcentrationType returns the FHIR type of the option of concentration[x] that is
+// set, such as "Ratio", or "" if none is.
+func (i *IngredientSubstanceStrength) ConcentrationType() string {
+	switch {
+	case i.ConcentrationRatio != nil:
+		return "Ratio"
+	case i.ConcentrationRatioRange != nil:
+		return "RatioRange"
+	case i.ConcentrationCodeableConcept != nil:
+		return "CodeableConcept"
+	case i.ConcentrationQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearConcentration unsets every option of concentration[x], and their extensions.
+func (i *IngredientSubstanceStrength) ClearConcentration() {
+	i.ConcentrationRatio = nil
+	i.ConcentrationRatioRange = nil
+	i.ConcentrationCodeableConcept = nil
+	i.ConcentrationQuantity = nil
+}
+
+// SetConcentrationRatio sets the Ratio option of concentration[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetConcentrationRatio(value Ratio) {
+	i.ClearConcentration()
+	i.ConcentrationRatio = &value
+}
+
+// SetConcentrationRatioRange sets the RatioRange option of concentration[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetConcentrationRatioRange(value RatioRange) {
+	i.ClearConcentration()
+	i.ConcentrationRatioRange = &value
+}
+
+// SetConcentrationCodeableConcept sets the CodeableConcept option of concentration[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetConcentrationCodeableConcept(value CodeableConcept) {
+	i.ClearConcentration()
+	i.ConcentrationCodeableConcept = &value
+}
+
+// SetConcentrationQuantity sets the Quantity option of concentration[x], clearing the others.
+func (i *IngredientSubstanceStrength) SetConcentrationQuantity(value Quantity) {
+	i.ClearConcentration()
+	i.ConcentrationQuantity = &value
+}
+
 // IngredientSubstance represents a FHIR BackboneElement for Ingredient.substance.
 type IngredientSubstance struct {
 	// Unique id for inter-element referencing
@@ -121,7 +298,7 @@ type IngredientSubstance struct {
 
 // Ingredient represents a FHIR Ingredient.
 type Ingredient struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// An identifier or code by which the ingredient can be referenced
@@ -151,3 +328,8 @@ type Ingredient struct {
 	// The substance that comprises this ingredient
 	Substance IngredientSubstance `json:"substance" fhir:"cardinality=1..1,required,summary"`
 }
+
+// ResourceType returns "Ingredient", the FHIR type of the resource.
+func (i *Ingredient) ResourceType() string {
+	return ResourceTypeIngredient
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -169,7 +168,7 @@ type InsurancePlanPlan struct {
 
 // InsurancePlan represents a FHIR InsurancePlan.
 type InsurancePlan struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for Product
@@ -207,3 +206,8 @@ type InsurancePlan struct {
 	// Plan details
 	Plan []InsurancePlanPlan `json:"plan,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "InsurancePlan", the FHIR type of the resource.
+func (i *InsurancePlan) ResourceType() string {
+	return ResourceTypeInsurancePlan
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -102,43 +101,217 @@ type InventoryItemCharacteristic struct {
 	// The characteristic that is being defined
 	CharacteristicType CodeableConcept `json:"characteristicType" fhir:"cardinality=1..1,required"`
 	// The value of the attribute - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// The value of the attribute - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// The value of the attribute - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// The value of the attribute - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// The value of the attribute - url option
-	ValueURL string `json:"valueURL" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURL *string `json:"valueUrl,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURL
-	ValueURLExt *primitives.PrimitiveExtension `json:"_valueURL,omitempty" fhir:"cardinality=0..1"`
+	ValueURLExt *primitives.PrimitiveExtension `json:"_valueUrl,omitempty" fhir:"cardinality=0..1"`
 	// The value of the attribute - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// The value of the attribute - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the attribute - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the attribute - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the attribute - Annotation option
-	ValueAnnotation Annotation `json:"valueAnnotation" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the attribute - Address option
-	ValueAddress Address `json:"valueAddress" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAddress *Address `json:"valueAddress,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the attribute - Duration option
-	ValueDuration Duration `json:"valueDuration" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDuration *Duration `json:"valueDuration,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the attribute - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (i *InventoryItemCharacteristic) Value() any {
+	switch {
+	case i.ValueString != nil:
+		return i.ValueString
+	case i.ValueInteger != nil:
+		return i.ValueInteger
+	case i.ValueDecimal != nil:
+		return i.ValueDecimal
+	case i.ValueBoolean != nil:
+		return i.ValueBoolean
+	case i.ValueURL != nil:
+		return i.ValueURL
+	case i.ValueDateTime != nil:
+		return i.ValueDateTime
+	case i.ValueQuantity != nil:
+		return i.ValueQuantity
+	case i.ValueRange != nil:
+		return i.ValueRange
+	case i.ValueRatio != nil:
+		return i.ValueRatio
+	case i.ValueAnnotation != nil:
+		return i.ValueAnnotation
+	case i.ValueAddress != nil:
+		return i.ValueAddress
+	case i.ValueDuration != nil:
+		return i.ValueDuration
+	case i.ValueCodeableConcept != nil:
+		return i.ValueCodeableConcept
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "string", or "" if none is.
+func (i *InventoryItemCharacteristic) ValueType() string {
+	switch {
+	case i.ValueString != nil:
+		return "string"
+	case i.ValueInteger != nil:
+		return "integer"
+	case i.ValueDecimal != nil:
+		return "decimal"
+	case i.ValueBoolean != nil:
+		return "boolean"
+	case i.ValueURL != nil:
+		return "url"
+	case i.ValueDateTime != nil:
+		return "dateTime"
+	case i.ValueQuantity != nil:
+		return "Quantity"
+	case i.ValueRange != nil:
+		return "Range"
+	case i.ValueRatio != nil:
+		return "Ratio"
+	case i.ValueAnnotation != nil:
+		return "Annotation"
+	case i.ValueAddress != nil:
+		return "Address"
+	case i.ValueDuration != nil:
+		return "Duration"
+	case i.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (i *InventoryItemCharacteristic) ClearValue() {
+	i.ValueString = nil
+	i.ValueStringExt = nil
+	i.ValueInteger = nil
+	i.ValueIntegerExt = nil
+	i.ValueDecimal = nil
+	i.ValueDecimalExt = nil
+	i.ValueBoolean = nil
+	i.ValueBooleanExt = nil
+	i.ValueURL = nil
+	i.ValueURLExt = nil
+	i.ValueDateTime = nil
+	i.ValueDateTimeExt = nil
+	i.ValueQuantity = nil
+	i.ValueRange = nil
+	i.ValueRatio = nil
+	i.ValueAnnotation = nil
+	i.ValueAddress = nil
+	i.ValueDuration = nil
+	i.ValueCodeableConcept = nil
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueString(value string) {
+	ext := i.ValueStringExt
+	i.ClearValue()
+	i.ValueString, i.ValueStringExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueInteger(value int) {
+	ext := i.ValueIntegerExt
+	i.ClearValue()
+	i.ValueInteger, i.ValueIntegerExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueDecimal(value primitives.Decimal) {
+	ext := i.ValueDecimalExt
+	i.ClearValue()
+	i.ValueDecimal, i.ValueDecimalExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueBoolean(value bool) {
+	ext := i.ValueBooleanExt
+	i.ClearValue()
+	i.ValueBoolean, i.ValueBooleanExt = &value, ext
+}
+
+// SetValueURL sets the url option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueURL(value string) {
+	ext := i.ValueURLExt
+	i.ClearValue()
+	i.ValueURL, i.ValueURLExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueDateTime(value primitives.DateTime) {
+	ext := i.ValueDateTimeExt
+	i.ClearValue()
+	i.ValueDateTime, i.ValueDateTimeExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueQuantity(value Quantity) {
+	i.ClearValue()
+	i.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueRange(value Range) {
+	i.ClearValue()
+	i.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueRatio(value Ratio) {
+	i.ClearValue()
+	i.ValueRatio = &value
+}
+
+// SetValueAnnotation sets the Annotation option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueAnnotation(value Annotation) {
+	i.ClearValue()
+	i.ValueAnnotation = &value
+}
+
+// SetValueAddress sets the Address option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueAddress(value Address) {
+	i.ClearValue()
+	i.ValueAddress = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueDuration(value Duration) {
+	i.ClearValue()
+	i.ValueDuration = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (i *InventoryItemCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	i.ClearValue()
+	i.ValueCodeableConcept = &value
 }
 
 // InventoryItemInstance represents a FHIR BackboneElement for InventoryItem.instance.
@@ -169,7 +342,7 @@ type InventoryItemInstance struct {
 
 // InventoryItem represents a FHIR InventoryItem.
 type InventoryItem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for the inventory item
@@ -203,3 +376,8 @@ type InventoryItem struct {
 	// Link to a product resource used in clinical workflows
 	ProductReference *Reference `json:"productReference,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "InventoryItem", the FHIR type of the resource.
+func (i *InventoryItem) ResourceType() string {
+	return ResourceTypeInventoryItem
+}
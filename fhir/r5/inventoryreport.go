@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -55,7 +54,7 @@ type InventoryReportInventoryListing struct {
 
 // InventoryReport represents a FHIR InventoryReport.
 type InventoryReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for the report
@@ -85,3 +84,8 @@ type InventoryReport struct {
 	// A note associated with the InventoryReport
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "InventoryReport", the FHIR type of the resource.
+func (i *InventoryReport) ResourceType() string {
+	return ResourceTypeInventoryReport
+}
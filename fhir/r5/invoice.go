@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -50,16 +49,100 @@ type InvoiceLineItem struct {
 	// Service data or period - Period option
 	ServicedPeriod *Period `json:"servicedPeriod,omitempty" fhir:"cardinality=0..1,choice=serviced"`
 	// Reference to ChargeItem containing details of this line item or an inline billing code - Reference option
-	ChargeItemReference Reference `json:"chargeItemReference" fhir:"cardinality=1..1,required,choice=chargeItem"`
+	ChargeItemReference *Reference `json:"chargeItemReference,omitempty" fhir:"cardinality=1..1,required,choice=chargeItem"`
 	// Reference to ChargeItem containing details of this line item or an inline billing code - CodeableConcept option
-	ChargeItemCodeableConcept CodeableConcept `json:"chargeItemCodeableConcept" fhir:"cardinality=1..1,required,choice=chargeItem"`
+	ChargeItemCodeableConcept *CodeableConcept `json:"chargeItemCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=chargeItem"`
 	// Components of total line item price
 	PriceComponent []MonetaryComponent `json:"priceComponent,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Serviced returns the option of serviced[x] that is set, or nil if none is.
+func (i *InvoiceLineItem) Serviced() any {
+	switch {
+	case i.ServicedDate != nil:
+		return i.ServicedDate
+	case i.ServicedPeriod != nil:
+		return i.ServicedPeriod
+	}
+	return nil
+}
+
+// ServicedType returns the FHIR type of the option of serviced[x] that is
+// set, such as "date", or "" if none is.
+func (i *InvoiceLineItem) ServicedType() string {
+	switch {
+	case i.ServicedDate != nil:
+		return "date"
+	case i.ServicedPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearServiced unsets every option of serviced[x], and their extensions.
+func (i *InvoiceLineItem) ClearServiced() {
+	i.ServicedDate = nil
+	i.ServicedDateExt = nil
+	i.ServicedPeriod = nil
+}
+
+// SetServicedDate sets the date option of serviced[x], clearing the others.
+func (i *InvoiceLineItem) SetServicedDate(value primitives.Date) {
+	ext := i.ServicedDateExt
+	i.ClearServiced()
+	i.ServicedDate, i.ServicedDateExt = &value, ext
+}
+
+// SetServicedPeriod sets the Period option of serviced[x], clearing the others.
+func (i *InvoiceLineItem) SetServicedPeriod(value Period) {
+	i.ClearServiced()
+	i.ServicedPeriod = &value
+}
+
+// ChargeItem returns the option of chargeItem[x] that is set, or nil if none is.
+func (i *InvoiceLineItem) ChargeItem() any {
+	switch {
+	case i.ChargeItemReference != nil:
+		return i.ChargeItemReference
+	case i.ChargeItemCodeableConcept != nil:
+		return i.ChargeItemCodeableConcept
+	}
+	return nil
+}
+
+// ChargeItemType returns the FHIR type of the option of chargeItem[x] that is
+// set, such as "Reference", or "" if none is.
+func (i *InvoiceLineItem) ChargeItemType() string {
+	switch {
+	case i.ChargeItemReference != nil:
+		return "Reference"
+	case i.ChargeItemCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearChargeItem unsets every option of chargeItem[x], and their extensions.
+func (i *InvoiceLineItem) ClearChargeItem() {
+	i.ChargeItemReference = nil
+	i.ChargeItemCodeableConcept = nil
+}
+
+// SetChargeItemReference sets the Reference option of chargeItem[x], clearing the others.
+func (i *InvoiceLineItem) SetChargeItemReference(value Reference) {
+	i.ClearChargeItem()
+	i.ChargeItemReference = &value
+}
+
+// SetChargeItemCodeableConcept sets the CodeableConcept option of chargeItem[x], clearing the others.
+func (i *InvoiceLineItem) SetChargeItemCodeableConcept(value CodeableConcept) {
+	i.ClearChargeItem()
+	i.ChargeItemCodeableConcept = &value
+}
+
 // Invoice represents a FHIR Invoice.
 type Invoice struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for item
@@ -113,3 +196,51 @@ type Invoice struct {
 	// Comments made about the invoice
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Invoice", the FHIR type of the resource.
+func (i *Invoice) ResourceType() string {
+	return ResourceTypeInvoice
+}
+
+// Period returns the option of period[x] that is set, or nil if none is.
+func (i *Invoice) Period() any {
+	switch {
+	case i.PeriodDate != nil:
+		return i.PeriodDate
+	case i.PeriodPeriod != nil:
+		return i.PeriodPeriod
+	}
+	return nil
+}
+
+// PeriodType returns the FHIR type of the option of period[x] that is
+// set, such as "date", or "" if none is.
+func (i *Invoice) PeriodType() string {
+	switch {
+	case i.PeriodDate != nil:
+		return "date"
+	case i.PeriodPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearPeriod unsets every option of period[x], and their extensions.
+func (i *Invoice) ClearPeriod() {
+	i.PeriodDate = nil
+	i.PeriodDateExt = nil
+	i.PeriodPeriod = nil
+}
+
+// SetPeriodDate sets the date option of period[x], clearing the others.
+func (i *Invoice) SetPeriodDate(value primitives.Date) {
+	ext := i.PeriodDateExt
+	i.ClearPeriod()
+	i.PeriodDate, i.PeriodDateExt = &value, ext
+}
+
+// SetPeriodPeriod sets the Period option of period[x], clearing the others.
+func (i *Invoice) SetPeriodPeriod(value Period) {
+	i.ClearPeriod()
+	i.PeriodPeriod = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypeLibrary = "Library"
 
 // Library represents a FHIR Library.
 type Library struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this library, represented as a URI (globally unique)
@@ -123,3 +122,92 @@ type Library struct {
 	// Contents of the library, either embedded or referenced
 	Content []Attachment `json:"content,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Library", the FHIR type of the resource.
+func (l *Library) ResourceType() string {
+	return ResourceTypeLibrary
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (l *Library) VersionAlgorithm() any {
+	switch {
+	case l.VersionAlgorithmString != nil:
+		return l.VersionAlgorithmString
+	case l.VersionAlgorithmCoding != nil:
+		return l.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (l *Library) VersionAlgorithmType() string {
+	switch {
+	case l.VersionAlgorithmString != nil:
+		return "string"
+	case l.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (l *Library) ClearVersionAlgorithm() {
+	l.VersionAlgorithmString = nil
+	l.VersionAlgorithmStringExt = nil
+	l.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (l *Library) SetVersionAlgorithmString(value string) {
+	ext := l.VersionAlgorithmStringExt
+	l.ClearVersionAlgorithm()
+	l.VersionAlgorithmString, l.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (l *Library) SetVersionAlgorithmCoding(value Coding) {
+	l.ClearVersionAlgorithm()
+	l.VersionAlgorithmCoding = &value
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (l *Library) Subject() any {
+	switch {
+	case l.SubjectCodeableConcept != nil:
+		return l.SubjectCodeableConcept
+	case l.SubjectReference != nil:
+		return l.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (l *Library) SubjectType() string {
+	switch {
+	case l.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case l.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (l *Library) ClearSubject() {
+	l.SubjectCodeableConcept = nil
+	l.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (l *Library) SetSubjectCodeableConcept(value CodeableConcept) {
+	l.ClearSubject()
+	l.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (l *Library) SetSubjectReference(value Reference) {
+	l.ClearSubject()
+	l.SubjectReference = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -39,7 +38,7 @@ type ListEntry struct {
 
 // List represents a FHIR List.
 type List struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
@@ -77,3 +76,8 @@ type List struct {
 	// Why list is empty
 	EmptyReason *CodeableConcept `json:"emptyReason,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "List", the FHIR type of the resource.
+func (l *List) ResourceType() string {
+	return ResourceTypeList
+}
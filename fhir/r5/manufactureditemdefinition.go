@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -47,6 +46,108 @@ type ManufacturedItemDefinitionProperty struct {
 	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *ManufacturedItemDefinitionProperty) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueDate != nil:
+		return m.ValueDate
+	case m.ValueBoolean != nil:
+		return m.ValueBoolean
+	case m.ValueMarkdown != nil:
+		return m.ValueMarkdown
+	case m.ValueAttachment != nil:
+		return m.ValueAttachment
+	case m.ValueReference != nil:
+		return m.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *ManufacturedItemDefinitionProperty) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueDate != nil:
+		return "date"
+	case m.ValueBoolean != nil:
+		return "boolean"
+	case m.ValueMarkdown != nil:
+		return "markdown"
+	case m.ValueAttachment != nil:
+		return "Attachment"
+	case m.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *ManufacturedItemDefinitionProperty) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueQuantity = nil
+	m.ValueDate = nil
+	m.ValueDateExt = nil
+	m.ValueBoolean = nil
+	m.ValueBooleanExt = nil
+	m.ValueMarkdown = nil
+	m.ValueMarkdownExt = nil
+	m.ValueAttachment = nil
+	m.ValueReference = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueDate(value primitives.Date) {
+	ext := m.ValueDateExt
+	m.ClearValue()
+	m.ValueDate, m.ValueDateExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueBoolean(value bool) {
+	ext := m.ValueBooleanExt
+	m.ClearValue()
+	m.ValueBoolean, m.ValueBooleanExt = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueMarkdown(value string) {
+	ext := m.ValueMarkdownExt
+	m.ClearValue()
+	m.ValueMarkdown, m.ValueMarkdownExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueAttachment(value Attachment) {
+	m.ClearValue()
+	m.ValueAttachment = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (m *ManufacturedItemDefinitionProperty) SetValueReference(value Reference) {
+	m.ClearValue()
+	m.ValueReference = &value
+}
+
 // ManufacturedItemDefinitionComponentConstituent represents a FHIR BackboneElement for ManufacturedItemDefinition.component.constituent.
 type ManufacturedItemDefinitionComponentConstituent struct {
 	// Unique id for inter-element referencing
@@ -101,7 +202,7 @@ type ManufacturedItemDefinitionComponent struct {
 
 // ManufacturedItemDefinition represents a FHIR ManufacturedItemDefinition.
 type ManufacturedItemDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier
@@ -129,3 +230,8 @@ type ManufacturedItemDefinition struct {
 	// Physical parts of the manufactured item, that it is intrisically made from. This is distinct from the ingredients that are part of its chemical makeup
 	Component []ManufacturedItemDefinitionComponent `json:"component,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "ManufacturedItemDefinition", the FHIR type of the resource.
+func (m *ManufacturedItemDefinition) ResourceType() string {
+	return ResourceTypeManufacturedItemDefinition
+}
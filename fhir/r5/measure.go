@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -158,7 +157,7 @@ type MeasureGroup struct {
 	// increase | decrease
 	ImprovementNotation *CodeableConcept `json:"improvementNotation,omitempty" fhir:"cardinality=0..1,summary"`
 	// Logic used by the measure group
-	Library []string `json:"library,omitempty" fhir:"cardinality=0..*"`
+	Library []primitives.Canonical `json:"library,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Library
 	LibraryExt *primitives.PrimitiveExtension `json:"_library,omitempty" fhir:"cardinality=0..1"`
 	// Population criteria
@@ -167,6 +166,47 @@ type MeasureGroup struct {
 	Stratifier []MeasureGroupStratifier `json:"stratifier,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (m *MeasureGroup) Subject() any {
+	switch {
+	case m.SubjectCodeableConcept != nil:
+		return m.SubjectCodeableConcept
+	case m.SubjectReference != nil:
+		return m.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MeasureGroup) SubjectType() string {
+	switch {
+	case m.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (m *MeasureGroup) ClearSubject() {
+	m.SubjectCodeableConcept = nil
+	m.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (m *MeasureGroup) SetSubjectCodeableConcept(value CodeableConcept) {
+	m.ClearSubject()
+	m.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (m *MeasureGroup) SetSubjectReference(value Reference) {
+	m.ClearSubject()
+	m.SubjectReference = &value
+}
+
 // MeasureSupplementalData represents a FHIR BackboneElement for Measure.supplementalData.
 type MeasureSupplementalData struct {
 	// Unique id for inter-element referencing
@@ -195,7 +235,7 @@ type MeasureSupplementalData struct {
 
 // Measure represents a FHIR Measure.
 type Measure struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this measure, represented as a URI (globally unique)
@@ -299,7 +339,7 @@ type Measure struct {
 	// Additional documentation, citations, etc
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty" fhir:"cardinality=0..*"`
 	// Logic used by the measure
-	Library []string `json:"library,omitempty" fhir:"cardinality=0..*"`
+	Library []primitives.Canonical `json:"library,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Library
 	LibraryExt *primitives.PrimitiveExtension `json:"_library,omitempty" fhir:"cardinality=0..1"`
 	// Disclaimer for use of the measure or its referenced content
@@ -343,3 +383,92 @@ type Measure struct {
 	// What other data should be reported with the measure
 	SupplementalData []MeasureSupplementalData `json:"supplementalData,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Measure", the FHIR type of the resource.
+func (m *Measure) ResourceType() string {
+	return ResourceTypeMeasure
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (m *Measure) VersionAlgorithm() any {
+	switch {
+	case m.VersionAlgorithmString != nil:
+		return m.VersionAlgorithmString
+	case m.VersionAlgorithmCoding != nil:
+		return m.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (m *Measure) VersionAlgorithmType() string {
+	switch {
+	case m.VersionAlgorithmString != nil:
+		return "string"
+	case m.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (m *Measure) ClearVersionAlgorithm() {
+	m.VersionAlgorithmString = nil
+	m.VersionAlgorithmStringExt = nil
+	m.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (m *Measure) SetVersionAlgorithmString(value string) {
+	ext := m.VersionAlgorithmStringExt
+	m.ClearVersionAlgorithm()
+	m.VersionAlgorithmString, m.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (m *Measure) SetVersionAlgorithmCoding(value Coding) {
+	m.ClearVersionAlgorithm()
+	m.VersionAlgorithmCoding = &value
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (m *Measure) Subject() any {
+	switch {
+	case m.SubjectCodeableConcept != nil:
+		return m.SubjectCodeableConcept
+	case m.SubjectReference != nil:
+		return m.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *Measure) SubjectType() string {
+	switch {
+	case m.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (m *Measure) ClearSubject() {
+	m.SubjectCodeableConcept = nil
+	m.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (m *Measure) SetSubjectCodeableConcept(value CodeableConcept) {
+	m.ClearSubject()
+	m.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (m *Measure) SetSubjectReference(value Reference) {
+	m.ClearSubject()
+	m.SubjectReference = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -58,17 +57,93 @@ type MeasureReportGroupStratifierStratumComponent struct {
 	// What stratifier component of the group
 	Code CodeableConcept `json:"code" fhir:"cardinality=1..1,required"`
 	// The stratum component value, e.g. male - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The stratum component value, e.g. male - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// The stratum component value, e.g. male - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The stratum component value, e.g. male - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The stratum component value, e.g. male - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MeasureReportGroupStratifierStratumComponent) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueBoolean != nil:
+		return m.ValueBoolean
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueRange != nil:
+		return m.ValueRange
+	case m.ValueReference != nil:
+		return m.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MeasureReportGroupStratifierStratumComponent) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueBoolean != nil:
+		return "boolean"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueRange != nil:
+		return "Range"
+	case m.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MeasureReportGroupStratifierStratumComponent) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueBoolean = nil
+	m.ValueBooleanExt = nil
+	m.ValueQuantity = nil
+	m.ValueRange = nil
+	m.ValueReference = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratumComponent) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratumComponent) SetValueBoolean(value bool) {
+	ext := m.ValueBooleanExt
+	m.ClearValue()
+	m.ValueBoolean, m.ValueBooleanExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratumComponent) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratumComponent) SetValueRange(value Range) {
+	m.ClearValue()
+	m.ValueRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratumComponent) SetValueReference(value Reference) {
+	m.ClearValue()
+	m.ValueReference = &value
 }
 
 // MeasureReportGroupStratifierStratumPopulation represents a FHIR BackboneElement for MeasureReport.group.stratifier.stratum.population.
@@ -141,6 +216,169 @@ type MeasureReportGroupStratifierStratum struct {
 	MeasureScoreDuration *Duration `json:"measureScoreDuration,omitempty" fhir:"cardinality=0..1,choice=measureScore"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MeasureReportGroupStratifierStratum) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueBoolean != nil:
+		return m.ValueBoolean
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueRange != nil:
+		return m.ValueRange
+	case m.ValueReference != nil:
+		return m.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MeasureReportGroupStratifierStratum) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueBoolean != nil:
+		return "boolean"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueRange != nil:
+		return "Range"
+	case m.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MeasureReportGroupStratifierStratum) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueBoolean = nil
+	m.ValueBooleanExt = nil
+	m.ValueQuantity = nil
+	m.ValueRange = nil
+	m.ValueReference = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetValueBoolean(value bool) {
+	ext := m.ValueBooleanExt
+	m.ClearValue()
+	m.ValueBoolean, m.ValueBooleanExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetValueRange(value Range) {
+	m.ClearValue()
+	m.ValueRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetValueReference(value Reference) {
+	m.ClearValue()
+	m.ValueReference = &value
+}
+
+// MeasureScore returns the option of measureScore[x] that is set, or nil if none is.
+func (m *MeasureReportGroupStratifierStratum) MeasureScore() any {
+	switch {
+	case m.MeasureScoreQuantity != nil:
+		return m.MeasureScoreQuantity
+	case m.MeasureScoreDateTime != nil:
+		return m.MeasureScoreDateTime
+	case m.MeasureScoreCodeableConcept != nil:
+		return m.MeasureScoreCodeableConcept
+	case m.MeasureScorePeriod != nil:
+		return m.MeasureScorePeriod
+	case m.MeasureScoreRange != nil:
+		return m.MeasureScoreRange
+	case m.MeasureScoreDuration != nil:
+		return m.MeasureScoreDuration
+	}
+	return nil
+}
+
+// MeasureScoreType returns the FHIR type of the option of measureScore[x] that is
+// set, such as "Quantity", or "" if none is.
+func (m *MeasureReportGroupStratifierStratum) MeasureScoreType() string {
+	switch {
+	case m.MeasureScoreQuantity != nil:
+		return "Quantity"
+	case m.MeasureScoreDateTime != nil:
+		return "dateTime"
+	case m.MeasureScoreCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MeasureScorePeriod != nil:
+		return "Period"
+	case m.MeasureScoreRange != nil:
+		return "Range"
+	case m.MeasureScoreDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearMeasureScore unsets every option of measureScore[x], and their extensions.
+func (m *MeasureReportGroupStratifierStratum) ClearMeasureScore() {
+	m.MeasureScoreQuantity = nil
+	m.MeasureScoreDateTime = nil
+	m.MeasureScoreDateTimeExt = nil
+	m.MeasureScoreCodeableConcept = nil
+	m.MeasureScorePeriod = nil
+	m.MeasureScoreRange = nil
+	m.MeasureScoreDuration = nil
+}
+
+// SetMeasureScoreQuantity sets the Quantity option of measureScore[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetMeasureScoreQuantity(value Quantity) {
+	m.ClearMeasureScore()
+	m.MeasureScoreQuantity = &value
+}
+
+// SetMeasureScoreDateTime sets the dateTime option of measureScore[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetMeasureScoreDateTime(value primitives.DateTime) {
+	ext := m.MeasureScoreDateTimeExt
+	m.ClearMeasureScore()
+	m.MeasureScoreDateTime, m.MeasureScoreDateTimeExt = &value, ext
+}
+
+// SetMeasureScoreCodeableConcept sets the CodeableConcept option of measureScore[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetMeasureScoreCodeableConcept(value CodeableConcept) {
+	m.ClearMeasureScore()
+	m.MeasureScoreCodeableConcept = &value
+}
+
+// SetMeasureScorePeriod sets the Period option of measureScore[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetMeasureScorePeriod(value Period) {
+	m.ClearMeasureScore()
+	m.MeasureScorePeriod = &value
+}
+
+// SetMeasureScoreRange sets the Range option of measureScore[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetMeasureScoreRange(value Range) {
+	m.ClearMeasureScore()
+	m.MeasureScoreRange = &value
+}
+
+// SetMeasureScoreDuration sets the Duration option of measureScore[x], clearing the others.
+func (m *MeasureReportGroupStratifierStratum) SetMeasureScoreDuration(value Duration) {
+	m.ClearMeasureScore()
+	m.MeasureScoreDuration = &value
+}
+
 // MeasureReportGroupStratifier represents a FHIR BackboneElement for MeasureReport.group.stratifier.
 type MeasureReportGroupStratifier struct {
 	// Unique id for inter-element referencing
@@ -199,9 +437,96 @@ type MeasureReportGroup struct {
 	Stratifier []MeasureReportGroupStratifier `json:"stratifier,omitempty" fhir:"cardinality=0..*"`
 }
 
+// MeasureScore returns the option of measureScore[x] that is set, or nil if none is.
+func (m *MeasureReportGroup) MeasureScore() any {
+	switch {
+	case m.MeasureScoreQuantity != nil:
+		return m.MeasureScoreQuantity
+	case m.MeasureScoreDateTime != nil:
+		return m.MeasureScoreDateTime
+	case m.MeasureScoreCodeableConcept != nil:
+		return m.MeasureScoreCodeableConcept
+	case m.MeasureScorePeriod != nil:
+		return m.MeasureScorePeriod
+	case m.MeasureScoreRange != nil:
+		return m.MeasureScoreRange
+	case m.MeasureScoreDuration != nil:
+		return m.MeasureScoreDuration
+	}
+	return nil
+}
+
+// MeasureScoreType returns the FHIR type of the option of measureScore[x] that is
+// set, such as "Quantity", or "" if none is.
+func (m *MeasureReportGroup) MeasureScoreType() string {
+	switch {
+	case m.MeasureScoreQuantity != nil:
+		return "Quantity"
+	case m.MeasureScoreDateTime != nil:
+		return "dateTime"
+	case m.MeasureScoreCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.MeasureScorePeriod != nil:
+		return "Period"
+	case m.MeasureScoreRange != nil:
+		return "Range"
+	case m.MeasureScoreDuration != nil:
+		return "Duration"
+	}
+	return ""
+}
+
+// ClearMeasureScore unsets every option of measureScore[x], and their extensions.
+func (m *MeasureReportGroup) ClearMeasureScore() {
+	m.MeasureScoreQuantity = nil
+	m.MeasureScoreDateTime = nil
+	m.MeasureScoreDateTimeExt = nil
+	m.MeasureScoreCodeableConcept = nil
+	m.MeasureScorePeriod = nil
+	m.MeasureScoreRange = nil
+	m.MeasureScoreDuration = nil
+}
+
+// SetMeasureScoreQuantity sets the Quantity option of measureScore[x], clearing the others.
+func (m *MeasureReportGroup) SetMeasureScoreQuantity(value Quantity) {
+	m.ClearMeasureScore()
+	m.MeasureScoreQuantity = &value
+}
+
+// SetMeasureScoreDateTime sets the dateTime option of measureScore[x], clearing the others.
+func (m *MeasureReportGroup) SetMeasureScoreDateTime(value primitives.DateTime) {
+	ext := m.MeasureScoreDateTimeExt
+	m.ClearMeasureScore()
+	m.MeasureScoreDateTime, m.MeasureScoreDateTimeExt = &value, ext
+}
+
+// SetMeasureScoreCodeableConcept sets the CodeableConcept option of measureScore[x], clearing the others.
+func (m *MeasureReportGroup) SetMeasureScoreCodeableConcept(value CodeableConcept) {
+	m.ClearMeasureScore()
+	m.MeasureScoreCodeableConcept = &value
+}
+
+// SetMeasureScorePeriod sets the Period option of measureScore[x], clearing the others.
+func (m *MeasureReportGroup) SetMeasureScorePeriod(value Period) {
+	m.ClearMeasureScore()
+	m.MeasureScorePeriod = &value
+}
+
+// SetMeasureScoreRange sets the Range option of measureScore[x], clearing the others.
+func (m *MeasureReportGroup) SetMeasureScoreRange(value Range) {
+	m.ClearMeasureScore()
+	m.MeasureScoreRange = &value
+}
+
+// SetMeasureScoreDuration sets the Duration option of measureScore[x], clearing the others.
+func (m *MeasureReportGroup) SetMeasureScoreDuration(value Duration) {
+	m.ClearMeasureScore()
+	m.MeasureScoreDuration = &value
+}
+
 // MeasureReport represents a FHIR MeasureReport.
 type MeasureReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Additional identifier for the MeasureReport
@@ -219,7 +544,7 @@ type MeasureReport struct {
 	// Extension for DataUpdateType
 	DataUpdateTypeExt *primitives.PrimitiveExtension `json:"_dataUpdateType,omitempty" fhir:"cardinality=0..1"`
 	// What measure was calculated
-	Measure *string `json:"measure,omitempty" fhir:"cardinality=0..1,summary"`
+	Measure *primitives.Canonical `json:"measure,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Measure
 	MeasureExt *primitives.PrimitiveExtension `json:"_measure,omitempty" fhir:"cardinality=0..1"`
 	// What individual(s) the report is for
@@ -249,3 +574,8 @@ type MeasureReport struct {
 	// What data was used to calculate the measure score
 	EvaluatedResource []Reference `json:"evaluatedResource,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MeasureReport", the FHIR type of the resource.
+func (m *MeasureReport) ResourceType() string {
+	return ResourceTypeMeasureReport
+}
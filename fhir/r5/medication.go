@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -37,6 +36,58 @@ type MedicationIngredient struct {
 	StrengthQuantity *Quantity `json:"strengthQuantity,omitempty" fhir:"cardinality=0..1,choice=strength"`
 }
 
+// Strength returns the option of strength[x] that is set, or nil if none is.
+func (m *MedicationIngredient) Strength() any {
+	switch {
+	case m.StrengthRatio != nil:
+		return m.StrengthRatio
+	case m.StrengthCodeableConcept != nil:
+		return m.StrengthCodeableConcept
+	case m.StrengthQuantity != nil:
+		return m.StrengthQuantity
+	}
+	return nil
+}
+
+// StrengthType returns the FHIR type of the option of strength[x] that is
+// set, such as "Ratio", or "" if none is.
+func (m *MedicationIngredient) StrengthType() string {
+	switch {
+	case m.StrengthRatio != nil:
+		return "Ratio"
+	case m.StrengthCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.StrengthQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearStrength unsets every option of strength[x], and their extensions.
+func (m *MedicationIngredient) ClearStrength() {
+	m.StrengthRatio = nil
+	m.StrengthCodeableConcept = nil
+	m.StrengthQuantity = nil
+}
+
+// SetStrengthRatio sets the Ratio option of strength[x], clearing the others.
+func (m *MedicationIngredient) SetStrengthRatio(value Ratio) {
+	m.ClearStrength()
+	m.StrengthRatio = &value
+}
+
+// SetStrengthCodeableConcept sets the CodeableConcept option of strength[x], clearing the others.
+func (m *MedicationIngredient) SetStrengthCodeableConcept(value CodeableConcept) {
+	m.ClearStrength()
+	m.StrengthCodeableConcept = &value
+}
+
+// SetStrengthQuantity sets the Quantity option of strength[x], clearing the others.
+func (m *MedicationIngredient) SetStrengthQuantity(value Quantity) {
+	m.ClearStrength()
+	m.StrengthQuantity = &value
+}
+
 // MedicationBatch represents a FHIR BackboneElement for Medication.batch.
 type MedicationBatch struct {
 	// Unique id for inter-element referencing
@@ -59,7 +110,7 @@ type MedicationBatch struct {
 
 // Medication represents a FHIR Medication.
 type Medication struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this medication
@@ -83,3 +134,8 @@ type Medication struct {
 	// Knowledge about this medication
 	Definition *Reference `json:"definition,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Medication", the FHIR type of the resource.
+func (m *Medication) ResourceType() string {
+	return ResourceTypeMedication
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -57,9 +56,50 @@ type MedicationAdministrationDosage struct {
 	RateQuantity *Quantity `json:"rateQuantity,omitempty" fhir:"cardinality=0..1,choice=rate"`
 }
 
+// Rate returns the option of rate[x] that is set, or nil if none is.
+func (m *MedicationAdministrationDosage) Rate() any {
+	switch {
+	case m.RateRatio != nil:
+		return m.RateRatio
+	case m.RateQuantity != nil:
+		return m.RateQuantity
+	}
+	return nil
+}
+
+// RateType returns the FHIR type of the option of rate[x] that is
+// set, such as "Ratio", or "" if none is.
+func (m *MedicationAdministrationDosage) RateType() string {
+	switch {
+	case m.RateRatio != nil:
+		return "Ratio"
+	case m.RateQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearRate unsets every option of rate[x], and their extensions.
+func (m *MedicationAdministrationDosage) ClearRate() {
+	m.RateRatio = nil
+	m.RateQuantity = nil
+}
+
+// SetRateRatio sets the Ratio option of rate[x], clearing the others.
+func (m *MedicationAdministrationDosage) SetRateRatio(value Ratio) {
+	m.ClearRate()
+	m.RateRatio = &value
+}
+
+// SetRateQuantity sets the Quantity option of rate[x], clearing the others.
+func (m *MedicationAdministrationDosage) SetRateQuantity(value Quantity) {
+	m.ClearRate()
+	m.RateQuantity = &value
+}
+
 // MedicationAdministration represents a FHIR MedicationAdministration.
 type MedicationAdministration struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -85,13 +125,13 @@ type MedicationAdministration struct {
 	// Additional information to support administration
 	SupportingInformation []Reference `json:"supportingInformation,omitempty" fhir:"cardinality=0..*"`
 	// Specific date/time or interval of time during which the administration took place (or did not take place) - dateTime option
-	OccurenceDateTime primitives.DateTime `json:"occurenceDateTime" fhir:"cardinality=1..1,required,summary,choice=occurence"`
+	OccurenceDateTime *primitives.DateTime `json:"occurenceDateTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurence"`
 	// Extension for OccurenceDateTime
 	OccurenceDateTimeExt *primitives.PrimitiveExtension `json:"_occurenceDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Specific date/time or interval of time during which the administration took place (or did not take place) - Period option
-	OccurencePeriod Period `json:"occurencePeriod" fhir:"cardinality=1..1,required,summary,choice=occurence"`
+	OccurencePeriod *Period `json:"occurencePeriod,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurence"`
 	// Specific date/time or interval of time during which the administration took place (or did not take place) - Timing option
-	OccurenceTiming Timing `json:"occurenceTiming" fhir:"cardinality=1..1,required,summary,choice=occurence"`
+	OccurenceTiming *Timing `json:"occurenceTiming,omitempty" fhir:"cardinality=1..1,required,summary,choice=occurence"`
 	// When the MedicationAdministration was first captured in the subject's record
 	Recorded *primitives.DateTime `json:"recorded,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Recorded
@@ -117,3 +157,62 @@ type MedicationAdministration struct {
 	// A list of events of interest in the lifecycle
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationAdministration", the FHIR type of the resource.
+func (m *MedicationAdministration) ResourceType() string {
+	return ResourceTypeMedicationAdministration
+}
+
+// Occurence returns the option of occurence[x] that is set, or nil if none is.
+func (m *MedicationAdministration) Occurence() any {
+	switch {
+	case m.OccurenceDateTime != nil:
+		return m.OccurenceDateTime
+	case m.OccurencePeriod != nil:
+		return m.OccurencePeriod
+	case m.OccurenceTiming != nil:
+		return m.OccurenceTiming
+	}
+	return nil
+}
+
+// OccurenceType returns the FHIR type of the option of occurence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (m *MedicationAdministration) OccurenceType() string {
+	switch {
+	case m.OccurenceDateTime != nil:
+		return "dateTime"
+	case m.OccurencePeriod != nil:
+		return "Period"
+	case m.OccurenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurence unsets every option of occurence[x], and their extensions.
+func (m *MedicationAdministration) ClearOccurence() {
+	m.OccurenceDateTime = nil
+	m.OccurenceDateTimeExt = nil
+	m.OccurencePeriod = nil
+	m.OccurenceTiming = nil
+}
+
+// SetOccurenceDateTime sets the dateTime option of occurence[x], clearing the others.
+func (m *MedicationAdministration) SetOccurenceDateTime(value primitives.DateTime) {
+	ext := m.OccurenceDateTimeExt
+	m.ClearOccurence()
+	m.OccurenceDateTime, m.OccurenceDateTimeExt = &value, ext
+}
+
+// SetOccurencePeriod sets the Period option of occurence[x], clearing the others.
+func (m *MedicationAdministration) SetOccurencePeriod(value Period) {
+	m.ClearOccurence()
+	m.OccurencePeriod = &value
+}
+
+// SetOccurenceTiming sets the Timing option of occurence[x], clearing the others.
+func (m *MedicationAdministration) SetOccurenceTiming(value Timing) {
+	m.ClearOccurence()
+	m.OccurenceTiming = &value
+}
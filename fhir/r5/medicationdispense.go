@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type MedicationDispenseSubstitution struct {
 
 // MedicationDispense represents a FHIR MedicationDispense.
 type MedicationDispense struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -123,3 +122,8 @@ type MedicationDispense struct {
 	// A list of relevant lifecycle events
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationDispense", the FHIR type of the resource.
+func (m *MedicationDispense) ResourceType() string {
+	return ResourceTypeMedicationDispense
+}
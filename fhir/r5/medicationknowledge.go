@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -64,9 +63,50 @@ type MedicationKnowledgeCost struct {
 	// Extension for Source
 	SourceExt *primitives.PrimitiveExtension `json:"_source,omitempty" fhir:"cardinality=0..1"`
 	// The price or category of the cost of the medication - Money option
-	CostMoney Money `json:"costMoney" fhir:"cardinality=1..1,required,choice=cost"`
+	CostMoney *Money `json:"costMoney,omitempty" fhir:"cardinality=1..1,required,choice=cost"`
 	// The price or category of the cost of the medication - CodeableConcept option
-	CostCodeableConcept CodeableConcept `json:"costCodeableConcept" fhir:"cardinality=1..1,required,choice=cost"`
+	CostCodeableConcept *CodeableConcept `json:"costCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=cost"`
+}
+
+// Cost returns the option of cost[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeCost) Cost() any {
+	switch {
+	case m.CostMoney != nil:
+		return m.CostMoney
+	case m.CostCodeableConcept != nil:
+		return m.CostCodeableConcept
+	}
+	return nil
+}
+
+// CostType returns the FHIR type of the option of cost[x] that is
+// set, such as "Money", or "" if none is.
+func (m *MedicationKnowledgeCost) CostType() string {
+	switch {
+	case m.CostMoney != nil:
+		return "Money"
+	case m.CostCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearCost unsets every option of cost[x], and their extensions.
+func (m *MedicationKnowledgeCost) ClearCost() {
+	m.CostMoney = nil
+	m.CostCodeableConcept = nil
+}
+
+// SetCostMoney sets the Money option of cost[x], clearing the others.
+func (m *MedicationKnowledgeCost) SetCostMoney(value Money) {
+	m.ClearCost()
+	m.CostMoney = &value
+}
+
+// SetCostCodeableConcept sets the CodeableConcept option of cost[x], clearing the others.
+func (m *MedicationKnowledgeCost) SetCostCodeableConcept(value CodeableConcept) {
+	m.ClearCost()
+	m.CostCodeableConcept = &value
 }
 
 // MedicationKnowledgeMonitoringProgram represents a FHIR BackboneElement for MedicationKnowledge.monitoringProgram.
@@ -123,6 +163,58 @@ type MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic
 	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=0..1,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueRange != nil:
+		return m.ValueRange
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueQuantity = nil
+	m.ValueRange = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (m *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) SetValueRange(value Range) {
+	m.ClearValue()
+	m.ValueRange = &value
+}
+
 // MedicationKnowledgeIndicationGuidelineDosingGuideline represents a FHIR BackboneElement for MedicationKnowledge.indicationGuideline.dosingGuideline.
 type MedicationKnowledgeIndicationGuidelineDosingGuideline struct {
 	// Unique id for inter-element referencing
@@ -176,13 +268,58 @@ type MedicationKnowledgeMedicineClassification struct {
 	// Extension for SourceString
 	SourceStringExt *primitives.PrimitiveExtension `json:"_sourceString,omitempty" fhir:"cardinality=0..1"`
 	// The source of the classification - uri option
-	SourceURI *string `json:"sourceURI,omitempty" fhir:"cardinality=0..1,choice=source"`
+	SourceURI *string `json:"sourceUri,omitempty" fhir:"cardinality=0..1,choice=source"`
 	// Extension for SourceURI
-	SourceURIExt *primitives.PrimitiveExtension `json:"_sourceURI,omitempty" fhir:"cardinality=0..1"`
+	SourceURIExt *primitives.PrimitiveExtension `json:"_sourceUri,omitempty" fhir:"cardinality=0..1"`
 	// Specific category assigned to the medication
 	Classification []CodeableConcept `json:"classification,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Source returns the option of source[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeMedicineClassification) Source() any {
+	switch {
+	case m.SourceString != nil:
+		return m.SourceString
+	case m.SourceURI != nil:
+		return m.SourceURI
+	}
+	return nil
+}
+
+// SourceType returns the FHIR type of the option of source[x] that is
+// set, such as "string", or "" if none is.
+func (m *MedicationKnowledgeMedicineClassification) SourceType() string {
+	switch {
+	case m.SourceString != nil:
+		return "string"
+	case m.SourceURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearSource unsets every option of source[x], and their extensions.
+func (m *MedicationKnowledgeMedicineClassification) ClearSource() {
+	m.SourceString = nil
+	m.SourceStringExt = nil
+	m.SourceURI = nil
+	m.SourceURIExt = nil
+}
+
+// SetSourceString sets the string option of source[x], clearing the others.
+func (m *MedicationKnowledgeMedicineClassification) SetSourceString(value string) {
+	ext := m.SourceStringExt
+	m.ClearSource()
+	m.SourceString, m.SourceStringExt = &value, ext
+}
+
+// SetSourceURI sets the uri option of source[x], clearing the others.
+func (m *MedicationKnowledgeMedicineClassification) SetSourceURI(value string) {
+	ext := m.SourceURIExt
+	m.ClearSource()
+	m.SourceURI, m.SourceURIExt = &value, ext
+}
+
 // MedicationKnowledgePackagingCost represents a FHIR BackboneElement for MedicationKnowledge.packaging.cost.
 type MedicationKnowledgePackagingCost struct {
 }
@@ -216,11 +353,63 @@ type MedicationKnowledgeStorageGuidelineEnvironmentalSetting struct {
 	// Categorization of the setting
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// Value of the setting - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the setting - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the setting - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) Value() any {
+	switch {
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueRange != nil:
+		return m.ValueRange
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (m *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) ValueType() string {
+	switch {
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueRange != nil:
+		return "Range"
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) ClearValue() {
+	m.ValueQuantity = nil
+	m.ValueRange = nil
+	m.ValueCodeableConcept = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (m *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) SetValueRange(value Range) {
+	m.ClearValue()
+	m.ValueRange = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
 }
 
 // MedicationKnowledgeStorageGuideline represents a FHIR BackboneElement for MedicationKnowledge.storageGuideline.
@@ -321,6 +510,58 @@ type MedicationKnowledgeDefinitionalIngredient struct {
 	StrengthQuantity *Quantity `json:"strengthQuantity,omitempty" fhir:"cardinality=0..1,choice=strength"`
 }
 
+// Strength returns the option of strength[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeDefinitionalIngredient) Strength() any {
+	switch {
+	case m.StrengthRatio != nil:
+		return m.StrengthRatio
+	case m.StrengthCodeableConcept != nil:
+		return m.StrengthCodeableConcept
+	case m.StrengthQuantity != nil:
+		return m.StrengthQuantity
+	}
+	return nil
+}
+
+// StrengthType returns the FHIR type of the option of strength[x] that is
+// set, such as "Ratio", or "" if none is.
+func (m *MedicationKnowledgeDefinitionalIngredient) StrengthType() string {
+	switch {
+	case m.StrengthRatio != nil:
+		return "Ratio"
+	case m.StrengthCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.StrengthQuantity != nil:
+		return "Quantity"
+	}
+	return ""
+}
+
+// ClearStrength unsets every option of strength[x], and their extensions.
+func (m *MedicationKnowledgeDefinitionalIngredient) ClearStrength() {
+	m.StrengthRatio = nil
+	m.StrengthCodeableConcept = nil
+	m.StrengthQuantity = nil
+}
+
+// SetStrengthRatio sets the Ratio option of strength[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalIngredient) SetStrengthRatio(value Ratio) {
+	m.ClearStrength()
+	m.StrengthRatio = &value
+}
+
+// SetStrengthCodeableConcept sets the CodeableConcept option of strength[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalIngredient) SetStrengthCodeableConcept(value CodeableConcept) {
+	m.ClearStrength()
+	m.StrengthCodeableConcept = &value
+}
+
+// SetStrengthQuantity sets the Quantity option of strength[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalIngredient) SetStrengthQuantity(value Quantity) {
+	m.ClearStrength()
+	m.StrengthQuantity = &value
+}
+
 // MedicationKnowledgeDefinitionalDrugCharacteristic represents a FHIR BackboneElement for MedicationKnowledge.definitional.drugCharacteristic.
 type MedicationKnowledgeDefinitionalDrugCharacteristic struct {
 	// Unique id for inter-element referencing
@@ -342,13 +583,91 @@ type MedicationKnowledgeDefinitionalDrugCharacteristic struct {
 	// Description of the characteristic - Quantity option
 	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Description of the characteristic - base64Binary option
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// Description of the characteristic - Attachment option
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=0..1,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueString != nil:
+		return m.ValueString
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueBase64Binary != nil:
+		return m.ValueBase64Binary
+	case m.ValueAttachment != nil:
+		return m.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueString != nil:
+		return "string"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueBase64Binary != nil:
+		return "base64Binary"
+	case m.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueString = nil
+	m.ValueStringExt = nil
+	m.ValueQuantity = nil
+	m.ValueBase64Binary = nil
+	m.ValueBase64BinaryExt = nil
+	m.ValueAttachment = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) SetValueString(value string) {
+	ext := m.ValueStringExt
+	m.ClearValue()
+	m.ValueString, m.ValueStringExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := m.ValueBase64BinaryExt
+	m.ClearValue()
+	m.ValueBase64Binary, m.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (m *MedicationKnowledgeDefinitionalDrugCharacteristic) SetValueAttachment(value Attachment) {
+	m.ClearValue()
+	m.ValueAttachment = &value
+}
+
 // MedicationKnowledgeDefinitional represents a FHIR BackboneElement for MedicationKnowledge.definitional.
 type MedicationKnowledgeDefinitional struct {
 	// Unique id for inter-element referencing
@@ -373,7 +692,7 @@ type MedicationKnowledgeDefinitional struct {
 
 // MedicationKnowledge represents a FHIR MedicationKnowledge.
 type MedicationKnowledge struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this medication
@@ -423,3 +742,8 @@ type MedicationKnowledge struct {
 	// Minimal definition information about the medication
 	Definitional *MedicationKnowledgeDefinitional `json:"definitional,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "MedicationKnowledge", the FHIR type of the resource.
+func (m *MedicationKnowledge) ResourceType() string {
+	return ResourceTypeMedicationKnowledge
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -72,18 +71,61 @@ type MedicationRequestSubstitution struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Whether substitution is allowed or not - boolean option
-	AllowedBoolean bool `json:"allowedBoolean" fhir:"cardinality=1..1,required,choice=allowed"`
+	AllowedBoolean *bool `json:"allowedBoolean,omitempty" fhir:"cardinality=1..1,required,choice=allowed"`
 	// Extension for AllowedBoolean
 	AllowedBooleanExt *primitives.PrimitiveExtension `json:"_allowedBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Whether substitution is allowed or not - CodeableConcept option
-	AllowedCodeableConcept CodeableConcept `json:"allowedCodeableConcept" fhir:"cardinality=1..1,required,choice=allowed"`
+	AllowedCodeableConcept *CodeableConcept `json:"allowedCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=allowed"`
 	// Why should (not) substitution be made
 	Reason *CodeableConcept `json:"reason,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Allowed returns the option of allowed[x] that is set, or nil if none is.
+func (m *MedicationRequestSubstitution) Allowed() any {
+	switch {
+	case m.AllowedBoolean != nil:
+		return m.AllowedBoolean
+	case m.AllowedCodeableConcept != nil:
+		return m.AllowedCodeableConcept
+	}
+	return nil
+}
+
+// AllowedType returns the FHIR type of the option of allowed[x] that is
+// set, such as "boolean", or "" if none is.
+func (m *MedicationRequestSubstitution) AllowedType() string {
+	switch {
+	case m.AllowedBoolean != nil:
+		return "boolean"
+	case m.AllowedCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAllowed unsets every option of allowed[x], and their extensions.
+func (m *MedicationRequestSubstitution) ClearAllowed() {
+	m.AllowedBoolean = nil
+	m.AllowedBooleanExt = nil
+	m.AllowedCodeableConcept = nil
+}
+
+// SetAllowedBoolean sets the boolean option of allowed[x], clearing the others.
+func (m *MedicationRequestSubstitution) SetAllowedBoolean(value bool) {
+	ext := m.AllowedBooleanExt
+	m.ClearAllowed()
+	m.AllowedBoolean, m.AllowedBooleanExt = &value, ext
+}
+
+// SetAllowedCodeableConcept sets the CodeableConcept option of allowed[x], clearing the others.
+func (m *MedicationRequestSubstitution) SetAllowedCodeableConcept(value CodeableConcept) {
+	m.ClearAllowed()
+	m.AllowedCodeableConcept = &value
+}
+
 // MedicationRequest represents a FHIR MedicationRequest.
 type MedicationRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External ids for this request
@@ -169,3 +211,8 @@ type MedicationRequest struct {
 	// A list of events of interest in the lifecycle
 	EventHistory []Reference `json:"eventHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "MedicationRequest", the FHIR type of the resource.
+func (m *MedicationRequest) ResourceType() string {
+	return ResourceTypeMedicationRequest
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -31,7 +30,7 @@ type MedicationStatementAdherence struct {
 
 // MedicationStatement represents a FHIR MedicationStatement.
 type MedicationStatement struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -81,3 +80,62 @@ type MedicationStatement struct {
 	// Indicates whether the medication is or is not being consumed or administered
 	Adherence *MedicationStatementAdherence `json:"adherence,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "MedicationStatement", the FHIR type of the resource.
+func (m *MedicationStatement) ResourceType() string {
+	return ResourceTypeMedicationStatement
+}
+
+// Effective returns the option of effective[x] that is set, or nil if none is.
+func (m *MedicationStatement) Effective() any {
+	switch {
+	case m.EffectiveDateTime != nil:
+		return m.EffectiveDateTime
+	case m.EffectivePeriod != nil:
+		return m.EffectivePeriod
+	case m.EffectiveTiming != nil:
+		return m.EffectiveTiming
+	}
+	return nil
+}
+
+// EffectiveType returns the FHIR type of the option of effective[x] that is
+// set, such as "dateTime", or "" if none is.
+func (m *MedicationStatement) EffectiveType() string {
+	switch {
+	case m.EffectiveDateTime != nil:
+		return "dateTime"
+	case m.EffectivePeriod != nil:
+		return "Period"
+	case m.EffectiveTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearEffective unsets every option of effective[x], and their extensions.
+func (m *MedicationStatement) ClearEffective() {
+	m.EffectiveDateTime = nil
+	m.EffectiveDateTimeExt = nil
+	m.EffectivePeriod = nil
+	m.EffectiveTiming = nil
+}
+
+// SetEffectiveDateTime sets the dateTime option of effective[x], clearing the others.
+func (m *MedicationStatement) SetEffectiveDateTime(value primitives.DateTime) {
+	ext := m.EffectiveDateTimeExt
+	m.ClearEffective()
+	m.EffectiveDateTime, m.EffectiveDateTimeExt = &value, ext
+}
+
+// SetEffectivePeriod sets the Period option of effective[x], clearing the others.
+func (m *MedicationStatement) SetEffectivePeriod(value Period) {
+	m.ClearEffective()
+	m.EffectivePeriod = &value
+}
+
+// SetEffectiveTiming sets the Timing option of effective[x], clearing the others.
+func (m *MedicationStatement) SetEffectiveTiming(value Timing) {
+	m.ClearEffective()
+	m.EffectiveTiming = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -159,9 +158,113 @@ type MedicinalProductDefinitionCharacteristic struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (m *MedicinalProductDefinitionCharacteristic) Value() any {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return m.ValueCodeableConcept
+	case m.ValueMarkdown != nil:
+		return m.ValueMarkdown
+	case m.ValueQuantity != nil:
+		return m.ValueQuantity
+	case m.ValueInteger != nil:
+		return m.ValueInteger
+	case m.ValueDate != nil:
+		return m.ValueDate
+	case m.ValueBoolean != nil:
+		return m.ValueBoolean
+	case m.ValueAttachment != nil:
+		return m.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MedicinalProductDefinitionCharacteristic) ValueType() string {
+	switch {
+	case m.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.ValueMarkdown != nil:
+		return "markdown"
+	case m.ValueQuantity != nil:
+		return "Quantity"
+	case m.ValueInteger != nil:
+		return "integer"
+	case m.ValueDate != nil:
+		return "date"
+	case m.ValueBoolean != nil:
+		return "boolean"
+	case m.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (m *MedicinalProductDefinitionCharacteristic) ClearValue() {
+	m.ValueCodeableConcept = nil
+	m.ValueMarkdown = nil
+	m.ValueMarkdownExt = nil
+	m.ValueQuantity = nil
+	m.ValueInteger = nil
+	m.ValueIntegerExt = nil
+	m.ValueDate = nil
+	m.ValueDateExt = nil
+	m.ValueBoolean = nil
+	m.ValueBooleanExt = nil
+	m.ValueAttachment = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	m.ClearValue()
+	m.ValueCodeableConcept = &value
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueMarkdown(value string) {
+	ext := m.ValueMarkdownExt
+	m.ClearValue()
+	m.ValueMarkdown, m.ValueMarkdownExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueQuantity(value Quantity) {
+	m.ClearValue()
+	m.ValueQuantity = &value
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueInteger(value int) {
+	ext := m.ValueIntegerExt
+	m.ClearValue()
+	m.ValueInteger, m.ValueIntegerExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueDate(value primitives.Date) {
+	ext := m.ValueDateExt
+	m.ClearValue()
+	m.ValueDate, m.ValueDateExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueBoolean(value bool) {
+	ext := m.ValueBooleanExt
+	m.ClearValue()
+	m.ValueBoolean, m.ValueBooleanExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (m *MedicinalProductDefinitionCharacteristic) SetValueAttachment(value Attachment) {
+	m.ClearValue()
+	m.ValueAttachment = &value
+}
+
 // MedicinalProductDefinition represents a FHIR MedicinalProductDefinition.
 type MedicinalProductDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this product. Could be an MPID
@@ -231,3 +334,8 @@ type MedicinalProductDefinition struct {
 	// Key product features such as "sugar free", "modified release"
 	Characteristic []MedicinalProductDefinitionCharacteristic `json:"characteristic,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MedicinalProductDefinition", the FHIR type of the resource.
+func (m *MedicinalProductDefinition) ResourceType() string {
+	return ResourceTypeMedicinalProductDefinition
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,7 +27,7 @@ type MessageDefinitionFocus struct {
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Profile that must be adhered to by focus
-	Profile *string `json:"profile,omitempty" fhir:"cardinality=0..1"`
+	Profile *primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Minimum number of focuses of this type
@@ -52,7 +51,7 @@ type MessageDefinitionAllowedResponse struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Reference to allowed message definition response
-	Message string `json:"message" fhir:"cardinality=1..1,required"`
+	Message primitives.Canonical `json:"message" fhir:"cardinality=1..1,required"`
 	// Extension for Message
 	MessageExt *primitives.PrimitiveExtension `json:"_message,omitempty" fhir:"cardinality=0..1"`
 	// When should this response be used
@@ -63,7 +62,7 @@ type MessageDefinitionAllowedResponse struct {
 
 // MessageDefinition represents a FHIR MessageDefinition.
 type MessageDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The cannonical URL for a given MessageDefinition
@@ -91,7 +90,7 @@ type MessageDefinition struct {
 	// Extension for Title
 	TitleExt *primitives.PrimitiveExtension `json:"_title,omitempty" fhir:"cardinality=0..1"`
 	// Takes the place of
-	Replaces []string `json:"replaces,omitempty" fhir:"cardinality=0..*,summary"`
+	Replaces []primitives.Canonical `json:"replaces,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Replaces
 	ReplacesExt *primitives.PrimitiveExtension `json:"_replaces,omitempty" fhir:"cardinality=0..1"`
 	// draft | active | retired | unknown
@@ -133,19 +132,19 @@ type MessageDefinition struct {
 	// Extension for CopyrightLabel
 	CopyrightLabelExt *primitives.PrimitiveExtension `json:"_copyrightLabel,omitempty" fhir:"cardinality=0..1"`
 	// Definition this one is based on
-	Base *string `json:"base,omitempty" fhir:"cardinality=0..1,summary"`
+	Base *primitives.Canonical `json:"base,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Base
 	BaseExt *primitives.PrimitiveExtension `json:"_base,omitempty" fhir:"cardinality=0..1"`
 	// Protocol/workflow this is part of
-	Parent []string `json:"parent,omitempty" fhir:"cardinality=0..*,summary"`
+	Parent []primitives.Canonical `json:"parent,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Parent
 	ParentExt *primitives.PrimitiveExtension `json:"_parent,omitempty" fhir:"cardinality=0..1"`
 	// Event code  or link to the EventDefinition - Coding option
-	EventCoding Coding `json:"eventCoding" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventCoding *Coding `json:"eventCoding,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Event code  or link to the EventDefinition - uri option
-	EventURI string `json:"eventURI" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventURI *string `json:"eventUri,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Extension for EventURI
-	EventURIExt *primitives.PrimitiveExtension `json:"_eventURI,omitempty" fhir:"cardinality=0..1"`
+	EventURIExt *primitives.PrimitiveExtension `json:"_eventUri,omitempty" fhir:"cardinality=0..1"`
 	// consequence | currency | notification
 	Category *string `json:"category,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Category
@@ -159,7 +158,98 @@ type MessageDefinition struct {
 	// Responses to this message
 	AllowedResponse []MessageDefinitionAllowedResponse `json:"allowedResponse,omitempty" fhir:"cardinality=0..*"`
 	// Canonical reference to a GraphDefinition
-	Graph *string `json:"graph,omitempty" fhir:"cardinality=0..1"`
+	Graph *primitives.Canonical `json:"graph,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Graph
 	GraphExt *primitives.PrimitiveExtension `json:"_graph,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "MessageDefinition", the FHIR type of the resource.
+func (m *MessageDefinition) ResourceType() string {
+	return ResourceTypeMessageDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (m *MessageDefinition) VersionAlgorithm() any {
+	switch {
+	case m.VersionAlgorithmString != nil:
+		return m.VersionAlgorithmString
+	case m.VersionAlgorithmCoding != nil:
+		return m.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (m *MessageDefinition) VersionAlgorithmType() string {
+	switch {
+	case m.VersionAlgorithmString != nil:
+		return "string"
+	case m.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (m *MessageDefinition) ClearVersionAlgorithm() {
+	m.VersionAlgorithmString = nil
+	m.VersionAlgorithmStringExt = nil
+	m.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (m *MessageDefinition) SetVersionAlgorithmString(value string) {
+	ext := m.VersionAlgorithmStringExt
+	m.ClearVersionAlgorithm()
+	m.VersionAlgorithmString, m.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (m *MessageDefinition) SetVersionAlgorithmCoding(value Coding) {
+	m.ClearVersionAlgorithm()
+	m.VersionAlgorithmCoding = &value
+}
+
+// Event returns the option of event[x] that is set, or nil if none is.
+func (m *MessageDefinition) Event() any {
+	switch {
+	case m.EventCoding != nil:
+		return m.EventCoding
+	case m.EventURI != nil:
+		return m.EventURI
+	}
+	return nil
+}
+
+// EventType returns the FHIR type of the option of event[x] that is
+// set, such as "Coding", or "" if none is.
+func (m *MessageDefinition) EventType() string {
+	switch {
+	case m.EventCoding != nil:
+		return "Coding"
+	case m.EventURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearEvent unsets every option of event[x], and their extensions.
+func (m *MessageDefinition) ClearEvent() {
+	m.EventCoding = nil
+	m.EventURI = nil
+	m.EventURIExt = nil
+}
+
+// SetEventCoding sets the Coding option of event[x], clearing the others.
+func (m *MessageDefinition) SetEventCoding(value Coding) {
+	m.ClearEvent()
+	m.EventCoding = &value
+}
+
+// SetEventURI sets the uri option of event[x], clearing the others.
+func (m *MessageDefinition) SetEventURI(value string) {
+	ext := m.EventURIExt
+	m.ClearEvent()
+	m.EventURI, m.EventURIExt = &value, ext
+}
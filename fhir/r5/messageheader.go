@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,9 +23,9 @@ type MessageHeaderDestination struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Actual destination address or Endpoint resource - url option
-	EndpointURL *string `json:"endpointURL,omitempty" fhir:"cardinality=0..1,summary,choice=endpoint"`
+	EndpointURL *string `json:"endpointUrl,omitempty" fhir:"cardinality=0..1,summary,choice=endpoint"`
 	// Extension for EndpointURL
-	EndpointURLExt *primitives.PrimitiveExtension `json:"_endpointURL,omitempty" fhir:"cardinality=0..1"`
+	EndpointURLExt *primitives.PrimitiveExtension `json:"_endpointUrl,omitempty" fhir:"cardinality=0..1"`
 	// Actual destination address or Endpoint resource - Reference option
 	EndpointReference *Reference `json:"endpointReference,omitempty" fhir:"cardinality=0..1,summary,choice=endpoint"`
 	// Name of system
@@ -39,6 +38,49 @@ type MessageHeaderDestination struct {
 	Receiver *Reference `json:"receiver,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
+// Endpoint returns the option of endpoint[x] that is set, or nil if none is.
+func (m *MessageHeaderDestination) Endpoint() any {
+	switch {
+	case m.EndpointURL != nil:
+		return m.EndpointURL
+	case m.EndpointReference != nil:
+		return m.EndpointReference
+	}
+	return nil
+}
+
+// EndpointType returns the FHIR type of the option of endpoint[x] that is
+// set, such as "url", or "" if none is.
+func (m *MessageHeaderDestination) EndpointType() string {
+	switch {
+	case m.EndpointURL != nil:
+		return "url"
+	case m.EndpointReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearEndpoint unsets every option of endpoint[x], and their extensions.
+func (m *MessageHeaderDestination) ClearEndpoint() {
+	m.EndpointURL = nil
+	m.EndpointURLExt = nil
+	m.EndpointReference = nil
+}
+
+// SetEndpointURL sets the url option of endpoint[x], clearing the others.
+func (m *MessageHeaderDestination) SetEndpointURL(value string) {
+	ext := m.EndpointURLExt
+	m.ClearEndpoint()
+	m.EndpointURL, m.EndpointURLExt = &value, ext
+}
+
+// SetEndpointReference sets the Reference option of endpoint[x], clearing the others.
+func (m *MessageHeaderDestination) SetEndpointReference(value Reference) {
+	m.ClearEndpoint()
+	m.EndpointReference = &value
+}
+
 // MessageHeaderSource represents a FHIR BackboneElement for MessageHeader.source.
 type MessageHeaderSource struct {
 	// Unique id for inter-element referencing
@@ -50,9 +92,9 @@ type MessageHeaderSource struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Actual source address or Endpoint resource - url option
-	EndpointURL *string `json:"endpointURL,omitempty" fhir:"cardinality=0..1,summary,choice=endpoint"`
+	EndpointURL *string `json:"endpointUrl,omitempty" fhir:"cardinality=0..1,summary,choice=endpoint"`
 	// Extension for EndpointURL
-	EndpointURLExt *primitives.PrimitiveExtension `json:"_endpointURL,omitempty" fhir:"cardinality=0..1"`
+	EndpointURLExt *primitives.PrimitiveExtension `json:"_endpointUrl,omitempty" fhir:"cardinality=0..1"`
 	// Actual source address or Endpoint resource - Reference option
 	EndpointReference *Reference `json:"endpointReference,omitempty" fhir:"cardinality=0..1,summary,choice=endpoint"`
 	// Name of system
@@ -71,6 +113,49 @@ type MessageHeaderSource struct {
 	Contact *ContactPoint `json:"contact,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
+// Endpoint returns the option of endpoint[x] that is set, or nil if none is.
+func (m *MessageHeaderSource) Endpoint() any {
+	switch {
+	case m.EndpointURL != nil:
+		return m.EndpointURL
+	case m.EndpointReference != nil:
+		return m.EndpointReference
+	}
+	return nil
+}
+
+// EndpointType returns the FHIR type of the option of endpoint[x] that is
+// set, such as "url", or "" if none is.
+func (m *MessageHeaderSource) EndpointType() string {
+	switch {
+	case m.EndpointURL != nil:
+		return "url"
+	case m.EndpointReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearEndpoint unsets every option of endpoint[x], and their extensions.
+func (m *MessageHeaderSource) ClearEndpoint() {
+	m.EndpointURL = nil
+	m.EndpointURLExt = nil
+	m.EndpointReference = nil
+}
+
+// SetEndpointURL sets the url option of endpoint[x], clearing the others.
+func (m *MessageHeaderSource) SetEndpointURL(value string) {
+	ext := m.EndpointURLExt
+	m.ClearEndpoint()
+	m.EndpointURL, m.EndpointURLExt = &value, ext
+}
+
+// SetEndpointReference sets the Reference option of endpoint[x], clearing the others.
+func (m *MessageHeaderSource) SetEndpointReference(value Reference) {
+	m.ClearEndpoint()
+	m.EndpointReference = &value
+}
+
 // MessageHeaderResponse represents a FHIR BackboneElement for MessageHeader.response.
 type MessageHeaderResponse struct {
 	// Unique id for inter-element referencing
@@ -93,13 +178,13 @@ type MessageHeaderResponse struct {
 
 // MessageHeader represents a FHIR MessageHeader.
 type MessageHeader struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Event code or link to EventDefinition - Coding option
-	EventCoding Coding `json:"eventCoding" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventCoding *Coding `json:"eventCoding,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Event code or link to EventDefinition - canonical option
-	EventCanonical string `json:"eventCanonical" fhir:"cardinality=1..1,required,summary,choice=event"`
+	EventCanonical *primitives.Canonical `json:"eventCanonical,omitempty" fhir:"cardinality=1..1,required,summary,choice=event"`
 	// Extension for EventCanonical
 	EventCanonicalExt *primitives.PrimitiveExtension `json:"_eventCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Message destination application(s)
@@ -119,7 +204,55 @@ type MessageHeader struct {
 	// The actual content of the message
 	Focus []Reference `json:"focus,omitempty" fhir:"cardinality=0..*,summary"`
 	// Link to the definition for this message
-	Definition *string `json:"definition,omitempty" fhir:"cardinality=0..1,summary"`
+	Definition *primitives.Canonical `json:"definition,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "MessageHeader", the FHIR type of the resource.
+func (m *MessageHeader) ResourceType() string {
+	return ResourceTypeMessageHeader
+}
+
+// Event returns the option of event[x] that is set, or nil if none is.
+func (m *MessageHeader) Event() any {
+	switch {
+	case m.EventCoding != nil:
+		return m.EventCoding
+	case m.EventCanonical != nil:
+		return m.EventCanonical
+	}
+	return nil
+}
+
+// EventType returns the FHIR type of the option of event[x] that is
+// set, such as "Coding", or "" if none is.
+func (m *MessageHeader) EventType() string {
+	switch {
+	case m.EventCoding != nil:
+		return "Coding"
+	case m.EventCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearEvent unsets every option of event[x], and their extensions.
+func (m *MessageHeader) ClearEvent() {
+	m.EventCoding = nil
+	m.EventCanonical = nil
+	m.EventCanonicalExt = nil
+}
+
+// SetEventCoding sets the Coding option of event[x], clearing the others.
+func (m *MessageHeader) SetEventCoding(value Coding) {
+	m.ClearEvent()
+	m.EventCoding = &value
+}
+
+// SetEventCanonical sets the canonical option of event[x], clearing the others.
+func (m *MessageHeader) SetEventCanonical(value primitives.Canonical) {
+	ext := m.EventCanonicalExt
+	m.ClearEvent()
+	m.EventCanonical, m.EventCanonicalExt = &value, ext
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,7 +18,7 @@ type Meta struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Version specific identifier
-	VersionId *string `json:"versionId,omitempty" fhir:"cardinality=0..1,summary"`
+	VersionId *string `json:"versionId,omitempty" fhir:"cardinality=0..1,summary,type=id"`
 	// Extension for VersionId
 	VersionIdExt *primitives.PrimitiveExtension `json:"_versionId,omitempty" fhir:"cardinality=0..1"`
 	// When the resource version last changed
@@ -26,15 +26,15 @@ type Meta struct {
 	// Extension for LastUpdated
 	LastUpdatedExt *primitives.PrimitiveExtension `json:"_lastUpdated,omitempty" fhir:"cardinality=0..1"`
 	// Identifies where the resource comes from
-	Source *string `json:"source,omitempty" fhir:"cardinality=0..1,summary"`
+	Source *string `json:"source,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 	// Extension for Source
 	SourceExt *primitives.PrimitiveExtension `json:"_source,omitempty" fhir:"cardinality=0..1"`
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
+	Profile []primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Security Labels applied to this resource
-	Security []Coding `json:"security,omitempty" fhir:"cardinality=0..*,summary"`
+	Security []Coding `json:"security,omitempty" fhir:"cardinality=0..*,binding=extensible:http://hl7.org/fhir/ValueSet/security-labels,summary"`
 	// Tags applied to this resource
 	Tag []Coding `json:"tag,omitempty" fhir:"cardinality=0..*,summary"`
 }
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,6 +52,60 @@ type MolecularSequenceRelativeStartingSequence struct {
 	StrandExt *primitives.PrimitiveExtension `json:"_strand,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Sequence returns the option of sequence[x] that is set, or nil if none is.
+func (m *MolecularSequenceRelativeStartingSequence) Sequence() any {
+	switch {
+	case m.SequenceCodeableConcept != nil:
+		return m.SequenceCodeableConcept
+	case m.SequenceString != nil:
+		return m.SequenceString
+	case m.SequenceReference != nil:
+		return m.SequenceReference
+	}
+	return nil
+}
+
+// SequenceType returns the FHIR type of the option of sequence[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (m *MolecularSequenceRelativeStartingSequence) SequenceType() string {
+	switch {
+	case m.SequenceCodeableConcept != nil:
+		return "CodeableConcept"
+	case m.SequenceString != nil:
+		return "string"
+	case m.SequenceReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSequence unsets every option of sequence[x], and their extensions.
+func (m *MolecularSequenceRelativeStartingSequence) ClearSequence() {
+	m.SequenceCodeableConcept = nil
+	m.SequenceString = nil
+	m.SequenceStringExt = nil
+	m.SequenceReference = nil
+}
+
+// SetSequenceCodeableConcept sets the CodeableConcept option of sequence[x], clearing the others.
+func (m *MolecularSequenceRelativeStartingSequence) SetSequenceCodeableConcept(value CodeableConcept) {
+	m.ClearSequence()
+	m.SequenceCodeableConcept = &value
+}
+
+// SetSequenceString sets the string option of sequence[x], clearing the others.
+func (m *MolecularSequenceRelativeStartingSequence) SetSequenceString(value string) {
+	ext := m.SequenceStringExt
+	m.ClearSequence()
+	m.SequenceString, m.SequenceStringExt = &value, ext
+}
+
+// SetSequenceReference sets the Reference option of sequence[x], clearing the others.
+func (m *MolecularSequenceRelativeStartingSequence) SetSequenceReference(value Reference) {
+	m.ClearSequence()
+	m.SequenceReference = &value
+}
+
 // MolecularSequenceRelativeEdit represents a FHIR BackboneElement for MolecularSequence.relative.edit.
 type MolecularSequenceRelativeEdit struct {
 	// Unique id for inter-element referencing
@@ -107,7 +160,7 @@ type MolecularSequenceRelative struct {
 
 // MolecularSequence represents a FHIR MolecularSequence.
 type MolecularSequence struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique ID for this particular sequence
@@ -135,3 +188,8 @@ type MolecularSequence struct {
 	// A sequence defined relative to another sequence
 	Relative []MolecularSequenceRelative `json:"relative,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "MolecularSequence", the FHIR type of the resource.
+func (m *MolecularSequence) ResourceType() string {
+	return ResourceTypeMolecularSequence
+}
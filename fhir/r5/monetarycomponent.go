@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,13 +18,13 @@ type MonetaryComponent struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// base | surcharge | deduction | discount | tax | informational
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type PriceComponentType `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/price-component-type|5.0.0,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Codes may be used to differentiate between kinds of taxes, surcharges, discounts etc.
 	Code *CodeableConcept `json:"code,omitempty" fhir:"cardinality=0..1,summary"`
 	// Factor used for calculating this component
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1,summary"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Explicit value amount to be used
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,7 +48,7 @@ type NamingSystemUniqueId struct {
 
 // NamingSystem represents a FHIR NamingSystem.
 type NamingSystem struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this naming system, represented as a URI (globally unique)
@@ -153,3 +152,51 @@ type NamingSystem struct {
 	// Unique identifiers used for system
 	UniqueId []NamingSystemUniqueId `json:"uniqueId,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "NamingSystem", the FHIR type of the resource.
+func (n *NamingSystem) ResourceType() string {
+	return ResourceTypeNamingSystem
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (n *NamingSystem) VersionAlgorithm() any {
+	switch {
+	case n.VersionAlgorithmString != nil:
+		return n.VersionAlgorithmString
+	case n.VersionAlgorithmCoding != nil:
+		return n.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (n *NamingSystem) VersionAlgorithmType() string {
+	switch {
+	case n.VersionAlgorithmString != nil:
+		return "string"
+	case n.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (n *NamingSystem) ClearVersionAlgorithm() {
+	n.VersionAlgorithmString = nil
+	n.VersionAlgorithmStringExt = nil
+	n.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (n *NamingSystem) SetVersionAlgorithmString(value string) {
+	ext := n.VersionAlgorithmStringExt
+	n.ClearVersionAlgorithm()
+	n.VersionAlgorithmString, n.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (n *NamingSystem) SetVersionAlgorithmCoding(value Coding) {
+	n.ClearVersionAlgorithm()
+	n.VersionAlgorithmCoding = &value
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,7 +18,7 @@ type Narrative struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// generated | extensions | additional | empty
-	Status string `json:"status" fhir:"cardinality=1..1,required"`
+	Status NarrativeStatus `json:"status" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/narrative-status|5.0.0,type=code"`
 	// Extension for Status
 	StatusExt *primitives.PrimitiveExtension `json:"_status,omitempty" fhir:"cardinality=0..1"`
 	// Limited xhtml content
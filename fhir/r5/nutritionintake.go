@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -75,13 +74,13 @@ type NutritionIntakePerformer struct {
 
 // NutritionIntake represents a FHIR NutritionIntake.
 type NutritionIntake struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -135,3 +134,94 @@ type NutritionIntake struct {
 	// Further information about the consumption
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "NutritionIntake", the FHIR type of the resource.
+func (n *NutritionIntake) ResourceType() string {
+	return ResourceTypeNutritionIntake
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (n *NutritionIntake) Occurrence() any {
+	switch {
+	case n.OccurrenceDateTime != nil:
+		return n.OccurrenceDateTime
+	case n.OccurrencePeriod != nil:
+		return n.OccurrencePeriod
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (n *NutritionIntake) OccurrenceType() string {
+	switch {
+	case n.OccurrenceDateTime != nil:
+		return "dateTime"
+	case n.OccurrencePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (n *NutritionIntake) ClearOccurrence() {
+	n.OccurrenceDateTime = nil
+	n.OccurrenceDateTimeExt = nil
+	n.OccurrencePeriod = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (n *NutritionIntake) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := n.OccurrenceDateTimeExt
+	n.ClearOccurrence()
+	n.OccurrenceDateTime, n.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (n *NutritionIntake) SetOccurrencePeriod(value Period) {
+	n.ClearOccurrence()
+	n.OccurrencePeriod = &value
+}
+
+// Reported returns the option of reported[x] that is set, or nil if none is.
+func (n *NutritionIntake) Reported() any {
+	switch {
+	case n.ReportedBoolean != nil:
+		return n.ReportedBoolean
+	case n.ReportedReference != nil:
+		return n.ReportedReference
+	}
+	return nil
+}
+
+// ReportedType returns the FHIR type of the option of reported[x] that is
+// set, such as "boolean", or "" if none is.
+func (n *NutritionIntake) ReportedType() string {
+	switch {
+	case n.ReportedBoolean != nil:
+		return "boolean"
+	case n.ReportedReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearReported unsets every option of reported[x], and their extensions.
+func (n *NutritionIntake) ClearReported() {
+	n.ReportedBoolean = nil
+	n.ReportedBooleanExt = nil
+	n.ReportedReference = nil
+}
+
+// SetReportedBoolean sets the boolean option of reported[x], clearing the others.
+func (n *NutritionIntake) SetReportedBoolean(value bool) {
+	ext := n.ReportedBooleanExt
+	n.ClearReported()
+	n.ReportedBoolean, n.ReportedBooleanExt = &value, ext
+}
+
+// SetReportedReference sets the Reference option of reported[x], clearing the others.
+func (n *NutritionIntake) SetReportedReference(value Reference) {
+	n.ClearReported()
+	n.ReportedReference = &value
+}
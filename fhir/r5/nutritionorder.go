@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -197,6 +196,47 @@ type NutritionOrderEnteralFormulaAdministration struct {
 	RateRatio *Ratio `json:"rateRatio,omitempty" fhir:"cardinality=0..1,choice=rate"`
 }
 
+// Rate returns the option of rate[x] that is set, or nil if none is.
+func (n *NutritionOrderEnteralFormulaAdministration) Rate() any {
+	switch {
+	case n.RateQuantity != nil:
+		return n.RateQuantity
+	case n.RateRatio != nil:
+		return n.RateRatio
+	}
+	return nil
+}
+
+// RateType returns the FHIR type of the option of rate[x] that is
+// set, such as "Quantity", or "" if none is.
+func (n *NutritionOrderEnteralFormulaAdministration) RateType() string {
+	switch {
+	case n.RateQuantity != nil:
+		return "Quantity"
+	case n.RateRatio != nil:
+		return "Ratio"
+	}
+	return ""
+}
+
+// ClearRate unsets every option of rate[x], and their extensions.
+func (n *NutritionOrderEnteralFormulaAdministration) ClearRate() {
+	n.RateQuantity = nil
+	n.RateRatio = nil
+}
+
+// SetRateQuantity sets the Quantity option of rate[x], clearing the others.
+func (n *NutritionOrderEnteralFormulaAdministration) SetRateQuantity(value Quantity) {
+	n.ClearRate()
+	n.RateQuantity = &value
+}
+
+// SetRateRatio sets the Ratio option of rate[x], clearing the others.
+func (n *NutritionOrderEnteralFormulaAdministration) SetRateRatio(value Ratio) {
+	n.ClearRate()
+	n.RateRatio = &value
+}
+
 // NutritionOrderEnteralFormula represents a FHIR BackboneElement for NutritionOrder.enteralFormula.
 type NutritionOrderEnteralFormula struct {
 	// Unique id for inter-element referencing
@@ -233,13 +273,13 @@ type NutritionOrderEnteralFormula struct {
 
 // NutritionOrder represents a FHIR NutritionOrder.
 type NutritionOrder struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifiers assigned to this order
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -299,3 +339,8 @@ type NutritionOrder struct {
 	// Comments
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "NutritionOrder", the FHIR type of the resource.
+func (n *NutritionOrder) ResourceType() string {
+	return ResourceTypeNutritionOrder
+}
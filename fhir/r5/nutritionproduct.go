@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -58,25 +57,116 @@ type NutritionProductCharacteristic struct {
 	// Code specifying the type of characteristic
 	Type CodeableConcept `json:"type" fhir:"cardinality=1..1,required"`
 	// The value of the characteristic - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the characteristic - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// The value of the characteristic - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the characteristic - base64Binary option
-	ValueBase64Binary string `json:"valueBase64Binary" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// The value of the characteristic - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// The value of the characteristic - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (n *NutritionProductCharacteristic) Value() any {
+	switch {
+	case n.ValueCodeableConcept != nil:
+		return n.ValueCodeableConcept
+	case n.ValueString != nil:
+		return n.ValueString
+	case n.ValueQuantity != nil:
+		return n.ValueQuantity
+	case n.ValueBase64Binary != nil:
+		return n.ValueBase64Binary
+	case n.ValueAttachment != nil:
+		return n.ValueAttachment
+	case n.ValueBoolean != nil:
+		return n.ValueBoolean
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (n *NutritionProductCharacteristic) ValueType() string {
+	switch {
+	case n.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case n.ValueString != nil:
+		return "string"
+	case n.ValueQuantity != nil:
+		return "Quantity"
+	case n.ValueBase64Binary != nil:
+		return "base64Binary"
+	case n.ValueAttachment != nil:
+		return "Attachment"
+	case n.ValueBoolean != nil:
+		return "boolean"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (n *NutritionProductCharacteristic) ClearValue() {
+	n.ValueCodeableConcept = nil
+	n.ValueString = nil
+	n.ValueStringExt = nil
+	n.ValueQuantity = nil
+	n.ValueBase64Binary = nil
+	n.ValueBase64BinaryExt = nil
+	n.ValueAttachment = nil
+	n.ValueBoolean = nil
+	n.ValueBooleanExt = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (n *NutritionProductCharacteristic) SetValueCodeableConcept(value CodeableConcept) {
+	n.ClearValue()
+	n.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (n *NutritionProductCharacteristic) SetValueString(value string) {
+	ext := n.ValueStringExt
+	n.ClearValue()
+	n.ValueString, n.ValueStringExt = &value, ext
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (n *NutritionProductCharacteristic) SetValueQuantity(value Quantity) {
+	n.ClearValue()
+	n.ValueQuantity = &value
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (n *NutritionProductCharacteristic) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := n.ValueBase64BinaryExt
+	n.ClearValue()
+	n.ValueBase64Binary, n.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (n *NutritionProductCharacteristic) SetValueAttachment(value Attachment) {
+	n.ClearValue()
+	n.ValueAttachment = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (n *NutritionProductCharacteristic) SetValueBoolean(value bool) {
+	ext := n.ValueBooleanExt
+	n.ClearValue()
+	n.ValueBoolean, n.ValueBooleanExt = &value, ext
+}
+
 // NutritionProductInstance represents a FHIR BackboneElement for NutritionProduct.instance.
 type NutritionProductInstance struct {
 	// Unique id for inter-element referencing
@@ -113,7 +203,7 @@ type NutritionProductInstance struct {
 
 // NutritionProduct represents a FHIR NutritionProduct.
 type NutritionProduct struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A code that can identify the detailed nutrients and ingredients in a specific food product
@@ -139,3 +229,8 @@ type NutritionProduct struct {
 	// Comments made about the product
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "NutritionProduct", the FHIR type of the resource.
+func (n *NutritionProduct) ResourceType() string {
+	return ResourceTypeNutritionProduct
+}
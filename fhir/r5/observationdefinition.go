@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,7 +27,7 @@ type ObservationDefinitionQualifiedValue struct {
 	// Targetted population for the set of qualified values
 	AppliesTo []CodeableConcept `json:"appliesTo,omitempty" fhir:"cardinality=0..*"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// Applicable age range for the set of qualified values
@@ -46,19 +45,19 @@ type ObservationDefinitionQualifiedValue struct {
 	// The range for continuous or ordinal observations
 	Range *Range `json:"range,omitempty" fhir:"cardinality=0..1"`
 	// Value set of valid coded values as part of this set of qualified values
-	ValidCodedValueSet *string `json:"validCodedValueSet,omitempty" fhir:"cardinality=0..1"`
+	ValidCodedValueSet *primitives.Canonical `json:"validCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for ValidCodedValueSet
 	ValidCodedValueSetExt *primitives.PrimitiveExtension `json:"_validCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Value set of normal coded values as part of this set of qualified values
-	NormalCodedValueSet *string `json:"normalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
+	NormalCodedValueSet *primitives.Canonical `json:"normalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for NormalCodedValueSet
 	NormalCodedValueSetExt *primitives.PrimitiveExtension `json:"_normalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Value set of abnormal coded values as part of this set of qualified values
-	AbnormalCodedValueSet *string `json:"abnormalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
+	AbnormalCodedValueSet *primitives.Canonical `json:"abnormalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for AbnormalCodedValueSet
 	AbnormalCodedValueSetExt *primitives.PrimitiveExtension `json:"_abnormalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Value set of critical coded values as part of this set of qualified values
-	CriticalCodedValueSet *string `json:"criticalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
+	CriticalCodedValueSet *primitives.Canonical `json:"criticalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 	// Extension for CriticalCodedValueSet
 	CriticalCodedValueSetExt *primitives.PrimitiveExtension `json:"_criticalCodedValueSet,omitempty" fhir:"cardinality=0..1"`
 }
@@ -91,7 +90,7 @@ type ObservationDefinitionComponent struct {
 
 // ObservationDefinition represents a FHIR ObservationDefinition.
 type ObservationDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Logical canonical URL to reference this ObservationDefinition (globally unique)
@@ -167,7 +166,7 @@ type ObservationDefinition struct {
 	// The effective date range for the ObservationDefinition
 	EffectivePeriod *Period `json:"effectivePeriod,omitempty" fhir:"cardinality=0..1,summary"`
 	// Based on FHIR definition of another observation
-	DerivedFromCanonical []string `json:"derivedFromCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	DerivedFromCanonical []primitives.Canonical `json:"derivedFromCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for DerivedFromCanonical
 	DerivedFromCanonicalExt *primitives.PrimitiveExtension `json:"_derivedFromCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Based on external definition
@@ -211,3 +210,51 @@ type ObservationDefinition struct {
 	// Component results
 	Component []ObservationDefinitionComponent `json:"component,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ObservationDefinition", the FHIR type of the resource.
+func (o *ObservationDefinition) ResourceType() string {
+	return ResourceTypeObservationDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (o *ObservationDefinition) VersionAlgorithm() any {
+	switch {
+	case o.VersionAlgorithmString != nil:
+		return o.VersionAlgorithmString
+	case o.VersionAlgorithmCoding != nil:
+		return o.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (o *ObservationDefinition) VersionAlgorithmType() string {
+	switch {
+	case o.VersionAlgorithmString != nil:
+		return "string"
+	case o.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (o *ObservationDefinition) ClearVersionAlgorithm() {
+	o.VersionAlgorithmString = nil
+	o.VersionAlgorithmStringExt = nil
+	o.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (o *ObservationDefinition) SetVersionAlgorithmString(value string) {
+	ext := o.VersionAlgorithmStringExt
+	o.ClearVersionAlgorithm()
+	o.VersionAlgorithmString, o.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (o *ObservationDefinition) SetVersionAlgorithmCoding(value Coding) {
+	o.ClearVersionAlgorithm()
+	o.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,7 +27,7 @@ type OperationDefinitionParameterBinding struct {
 	// Extension for Strength
 	StrengthExt *primitives.PrimitiveExtension `json:"_strength,omitempty" fhir:"cardinality=0..1"`
 	// Source of value set
-	ValueSet string `json:"valueSet" fhir:"cardinality=1..1,required"`
+	ValueSet primitives.Canonical `json:"valueSet" fhir:"cardinality=1..1,required"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 }
@@ -100,7 +99,7 @@ type OperationDefinitionParameter struct {
 	// Extension for AllowedType
 	AllowedTypeExt *primitives.PrimitiveExtension `json:"_allowedType,omitempty" fhir:"cardinality=0..1"`
 	// If type is Reference | canonical, allowed targets. If type is 'Resource', then this constrains the allowed resource types
-	TargetProfile []string `json:"targetProfile,omitempty" fhir:"cardinality=0..*"`
+	TargetProfile []primitives.Canonical `json:"targetProfile,omitempty" fhir:"cardinality=0..*"`
 	// Extension for TargetProfile
 	TargetProfileExt *primitives.PrimitiveExtension `json:"_targetProfile,omitempty" fhir:"cardinality=0..1"`
 	// number | date | string | token | reference | composite | quantity | uri | special
@@ -137,7 +136,7 @@ type OperationDefinitionOverload struct {
 
 // OperationDefinition represents a FHIR OperationDefinition.
 type OperationDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this operation definition, represented as an absolute URI (globally unique)
@@ -219,7 +218,7 @@ type OperationDefinition struct {
 	// Extension for Comment
 	CommentExt *primitives.PrimitiveExtension `json:"_comment,omitempty" fhir:"cardinality=0..1"`
 	// Marks this as a profile of the base
-	Base *string `json:"base,omitempty" fhir:"cardinality=0..1,summary"`
+	Base *primitives.Canonical `json:"base,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Base
 	BaseExt *primitives.PrimitiveExtension `json:"_base,omitempty" fhir:"cardinality=0..1"`
 	// Types this operation applies to
@@ -239,11 +238,11 @@ type OperationDefinition struct {
 	// Extension for Instance
 	InstanceExt *primitives.PrimitiveExtension `json:"_instance,omitempty" fhir:"cardinality=0..1"`
 	// Validation information for in parameters
-	InputProfile *string `json:"inputProfile,omitempty" fhir:"cardinality=0..1"`
+	InputProfile *primitives.Canonical `json:"inputProfile,omitempty" fhir:"cardinality=0..1"`
 	// Extension for InputProfile
 	InputProfileExt *primitives.PrimitiveExtension `json:"_inputProfile,omitempty" fhir:"cardinality=0..1"`
 	// Validation information for out parameters
-	OutputProfile *string `json:"outputProfile,omitempty" fhir:"cardinality=0..1"`
+	OutputProfile *primitives.Canonical `json:"outputProfile,omitempty" fhir:"cardinality=0..1"`
 	// Extension for OutputProfile
 	OutputProfileExt *primitives.PrimitiveExtension `json:"_outputProfile,omitempty" fhir:"cardinality=0..1"`
 	// Parameters for the operation/query
@@ -251,3 +250,51 @@ type OperationDefinition struct {
 	// Define overloaded variants for when  generating code
 	Overload []OperationDefinitionOverload `json:"overload,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "OperationDefinition", the FHIR type of the resource.
+func (o *OperationDefinition) ResourceType() string {
+	return ResourceTypeOperationDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (o *OperationDefinition) VersionAlgorithm() any {
+	switch {
+	case o.VersionAlgorithmString != nil:
+		return o.VersionAlgorithmString
+	case o.VersionAlgorithmCoding != nil:
+		return o.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (o *OperationDefinition) VersionAlgorithmType() string {
+	switch {
+	case o.VersionAlgorithmString != nil:
+		return "string"
+	case o.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (o *OperationDefinition) ClearVersionAlgorithm() {
+	o.VersionAlgorithmString = nil
+	o.VersionAlgorithmStringExt = nil
+	o.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (o *OperationDefinition) SetVersionAlgorithmString(value string) {
+	ext := o.VersionAlgorithmStringExt
+	o.ClearVersionAlgorithm()
+	o.VersionAlgorithmString, o.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (o *OperationDefinition) SetVersionAlgorithmCoding(value Coding) {
+	o.ClearVersionAlgorithm()
+	o.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -35,7 +34,7 @@ type OrganizationQualification struct {
 
 // Organization represents a FHIR Organization.
 type Organization struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifies this organization  across multiple systems
@@ -67,3 +66,8 @@ type Organization struct {
 	// Qualifications, certifications, accreditations, licenses, training, etc. pertaining to the provision of care
 	Qualification []OrganizationQualification `json:"qualification,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Organization", the FHIR type of the resource.
+func (o *Organization) ResourceType() string {
+	return ResourceTypeOrganization
+}
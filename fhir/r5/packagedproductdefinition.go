@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -57,6 +56,84 @@ type PackagedProductDefinitionPackagingProperty struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (p *PackagedProductDefinitionPackagingProperty) Value() any {
+	switch {
+	case p.ValueCodeableConcept != nil:
+		return p.ValueCodeableConcept
+	case p.ValueQuantity != nil:
+		return p.ValueQuantity
+	case p.ValueDate != nil:
+		return p.ValueDate
+	case p.ValueBoolean != nil:
+		return p.ValueBoolean
+	case p.ValueAttachment != nil:
+		return p.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (p *PackagedProductDefinitionPackagingProperty) ValueType() string {
+	switch {
+	case p.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.ValueQuantity != nil:
+		return "Quantity"
+	case p.ValueDate != nil:
+		return "date"
+	case p.ValueBoolean != nil:
+		return "boolean"
+	case p.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (p *PackagedProductDefinitionPackagingProperty) ClearValue() {
+	p.ValueCodeableConcept = nil
+	p.ValueQuantity = nil
+	p.ValueDate = nil
+	p.ValueDateExt = nil
+	p.ValueBoolean = nil
+	p.ValueBooleanExt = nil
+	p.ValueAttachment = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (p *PackagedProductDefinitionPackagingProperty) SetValueCodeableConcept(value CodeableConcept) {
+	p.ClearValue()
+	p.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (p *PackagedProductDefinitionPackagingProperty) SetValueQuantity(value Quantity) {
+	p.ClearValue()
+	p.ValueQuantity = &value
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (p *PackagedProductDefinitionPackagingProperty) SetValueDate(value primitives.Date) {
+	ext := p.ValueDateExt
+	p.ClearValue()
+	p.ValueDate, p.ValueDateExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (p *PackagedProductDefinitionPackagingProperty) SetValueBoolean(value bool) {
+	ext := p.ValueBooleanExt
+	p.ClearValue()
+	p.ValueBoolean, p.ValueBooleanExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (p *PackagedProductDefinitionPackagingProperty) SetValueAttachment(value Attachment) {
+	p.ClearValue()
+	p.ValueAttachment = &value
+}
+
 // PackagedProductDefinitionPackagingContainedItem represents a FHIR BackboneElement for PackagedProductDefinition.packaging.containedItem.
 type PackagedProductDefinitionPackagingContainedItem struct {
 	// Unique id for inter-element referencing
@@ -121,7 +198,7 @@ type PackagedProductDefinitionCharacteristic struct {
 
 // PackagedProductDefinition represents a FHIR PackagedProductDefinition.
 type PackagedProductDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A unique identifier for this package as whole - not for the content of the package
@@ -163,3 +240,8 @@ type PackagedProductDefinition struct {
 	// Allows the key features to be recorded, such as "hospital pack", "nurse prescribable"
 	Characteristic []PackagedProductDefinitionCharacteristic `json:"characteristic,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "PackagedProductDefinition", the FHIR type of the resource.
+func (p *PackagedProductDefinition) ResourceType() string {
+	return ResourceTypePackagedProductDefinition
+}
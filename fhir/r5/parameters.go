@@ -8,7 +8,6 @@ package r5
 import (
 	"encoding/json"
 
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -34,7 +33,7 @@ type ParametersParameter struct {
 	// Extension for Name
 	NameExt *primitives.PrimitiveExtension `json:"_name,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - base64Binary option
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueBase64Binary *primitives.Base64Binary `json:"valueBase64Binary,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueBase64Binary
 	ValueBase64BinaryExt *primitives.PrimitiveExtension `json:"_valueBase64Binary,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - boolean option
@@ -42,7 +41,7 @@ type ParametersParameter struct {
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - canonical option
-	ValueCanonical *string `json:"valueCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueCanonical *primitives.Canonical `json:"valueCanonical,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueCanonical
 	ValueCanonicalExt *primitives.PrimitiveExtension `json:"_valueCanonical,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - code option
@@ -58,13 +57,13 @@ type ParametersParameter struct {
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - decimal option
-	ValueDecimal *float64 `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - id option
-	ValueID *string `json:"valueID,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - instant option
 	ValueInstant *primitives.Instant `json:"valueInstant,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueInstant
@@ -74,7 +73,7 @@ type ParametersParameter struct {
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - integer64 option
-	ValueInteger64 *int64 `json:"valueInteger64,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueInteger64 *primitives.Integer64 `json:"valueInteger64,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueInteger64
 	ValueInteger64Ext *primitives.PrimitiveExtension `json:"_valueInteger64,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - markdown option
@@ -102,17 +101,17 @@ type ParametersParameter struct {
 	// Extension for ValueUnsignedInt
 	ValueUnsignedIntExt *primitives.PrimitiveExtension `json:"_valueUnsignedInt,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - uri option
-	ValueURI *string `json:"valueURI,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - url option
-	ValueURL *string `json:"valueURL,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueURL *string `json:"valueUrl,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueURL
-	ValueURLExt *primitives.PrimitiveExtension `json:"_valueURL,omitempty" fhir:"cardinality=0..1"`
+	ValueURLExt *primitives.PrimitiveExtension `json:"_valueUrl,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - uuid option
-	ValueUUID *string `json:"valueUUID,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
+	ValueUUID *string `json:"valueUuid,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// Extension for ValueUUID
-	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUUID,omitempty" fhir:"cardinality=0..1"`
+	ValueUUIDExt *primitives.PrimitiveExtension `json:"_valueUuid,omitempty" fhir:"cardinality=0..1"`
 	// If parameter is a data type - Address option
 	ValueAddress *Address `json:"valueAddress,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 	// If parameter is a data type - Age option
@@ -189,9 +188,667 @@ type ParametersParameter struct {
 	Part []ParametersParameterPart `json:"part,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (p *ParametersParameter) Value() any {
+	switch {
+	case p.ValueBase64Binary != nil:
+		return p.ValueBase64Binary
+	case p.ValueBoolean != nil:
+		return p.ValueBoolean
+	case p.ValueCanonical != nil:
+		return p.ValueCanonical
+	case p.ValueCode != nil:
+		return p.ValueCode
+	case p.ValueDate != nil:
+		return p.ValueDate
+	case p.ValueDateTime != nil:
+		return p.ValueDateTime
+	case p.ValueDecimal != nil:
+		return p.ValueDecimal
+	case p.ValueID != nil:
+		return p.ValueID
+	case p.ValueInstant != nil:
+		return p.ValueInstant
+	case p.ValueInteger != nil:
+		return p.ValueInteger
+	case p.ValueInteger64 != nil:
+		return p.ValueInteger64
+	case p.ValueMarkdown != nil:
+		return p.ValueMarkdown
+	case p.ValueOid != nil:
+		return p.ValueOid
+	case p.ValuePositiveInt != nil:
+		return p.ValuePositiveInt
+	case p.ValueString != nil:
+		return p.ValueString
+	case p.ValueTime != nil:
+		return p.ValueTime
+	case p.ValueUnsignedInt != nil:
+		return p.ValueUnsignedInt
+	case p.ValueURI != nil:
+		return p.ValueURI
+	case p.ValueURL != nil:
+		return p.ValueURL
+	case p.ValueUUID != nil:
+		return p.ValueUUID
+	case p.ValueAddress != nil:
+		return p.ValueAddress
+	case p.ValueAge != nil:
+		return p.ValueAge
+	case p.ValueAnnotation != nil:
+		return p.ValueAnnotation
+	case p.ValueAttachment != nil:
+		return p.ValueAttachment
+	case p.ValueCodeableConcept != nil:
+		return p.ValueCodeableConcept
+	case p.ValueCodeableReference != nil:
+		return p.ValueCodeableReference
+	case p.ValueCoding != nil:
+		return p.ValueCoding
+	case p.ValueContactPoint != nil:
+		return p.ValueContactPoint
+	case p.ValueCount != nil:
+		return p.ValueCount
+	case p.ValueDistance != nil:
+		return p.ValueDistance
+	case p.ValueDuration != nil:
+		return p.ValueDuration
+	case p.ValueHumanName != nil:
+		return p.ValueHumanName
+	case p.ValueIdentifier != nil:
+		return p.ValueIdentifier
+	case p.ValueMoney != nil:
+		return p.ValueMoney
+	case p.ValuePeriod != nil:
+		return p.ValuePeriod
+	case p.ValueQuantity != nil:
+		return p.ValueQuantity
+	case p.ValueRange != nil:
+		return p.ValueRange
+	case p.ValueRatio != nil:
+		return p.ValueRatio
+	case p.ValueRatioRange != nil:
+		return p.ValueRatioRange
+	case p.ValueReference != nil:
+		return p.ValueReference
+	case p.ValueSampledData != nil:
+		return p.ValueSampledData
+	case p.ValueSignature != nil:
+		return p.ValueSignature
+	case p.ValueTiming != nil:
+		return p.ValueTiming
+	case p.ValueContactDetail != nil:
+		return p.ValueContactDetail
+	case p.ValueDataRequirement != nil:
+		return p.ValueDataRequirement
+	case p.ValueExpression != nil:
+		return p.ValueExpression
+	case p.ValueParameterDefinition != nil:
+		return p.ValueParameterDefinition
+	case p.ValueRelatedArtifact != nil:
+		return p.ValueRelatedArtifact
+	case p.ValueTriggerDefinition != nil:
+		return p.ValueTriggerDefinition
+	case p.ValueUsageContext != nil:
+		return p.ValueUsageContext
+	case p.ValueAvailability != nil:
+		return p.ValueAvailability
+	case p.ValueExtendedContactDetail != nil:
+		return p.ValueExtendedContactDetail
+	case p.ValueDosage != nil:
+		return p.ValueDosage
+	case p.ValueMeta != nil:
+		return p.ValueMeta
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "base64Binary", or "" if none is.
+func (p *ParametersParameter) ValueType() string {
+	switch {
+	case p.ValueBase64Binary != nil:
+		return "base64Binary"
+	case p.ValueBoolean != nil:
+		return "boolean"
+	case p.ValueCanonical != nil:
+		return "canonical"
+	case p.ValueCode != nil:
+		return "code"
+	case p.ValueDate != nil:
+		return "date"
+	case p.ValueDateTime != nil:
+		return "dateTime"
+	case p.ValueDecimal != nil:
+		return "decimal"
+	case p.ValueID != nil:
+		return "id"
+	case p.ValueInstant != nil:
+		return "instant"
+	case p.ValueInteger != nil:
+		return "integer"
+	case p.ValueInteger64 != nil:
+		return "integer64"
+	case p.ValueMarkdown != nil:
+		return "markdown"
+	case p.ValueOid != nil:
+		return "oid"
+	case p.ValuePositiveInt != nil:
+		return "positiveInt"
+	case p.ValueString != nil:
+		return "string"
+	case p.ValueTime != nil:
+		return "time"
+	case p.ValueUnsignedInt != nil:
+		return "unsignedInt"
+	case p.ValueURI != nil:
+		return "uri"
+	case p.ValueURL != nil:
+		return "url"
+	case p.ValueUUID != nil:
+		return "uuid"
+	case p.ValueAddress != nil:
+		return "Address"
+	case p.ValueAge != nil:
+		return "Age"
+	case p.ValueAnnotation != nil:
+		return "Annotation"
+	case p.ValueAttachment != nil:
+		return "Attachment"
+	case p.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.ValueCodeableReference != nil:
+		return "CodeableReference"
+	case p.ValueCoding != nil:
+		return "Coding"
+	case p.ValueContactPoint != nil:
+		return "ContactPoint"
+	case p.ValueCount != nil:
+		return "Count"
+	case p.ValueDistance != nil:
+		return "Distance"
+	case p.ValueDuration != nil:
+		return "Duration"
+	case p.ValueHumanName != nil:
+		return "HumanName"
+	case p.ValueIdentifier != nil:
+		return "Identifier"
+	case p.ValueMoney != nil:
+		return "Money"
+	case p.ValuePeriod != nil:
+		return "Period"
+	case p.ValueQuantity != nil:
+		return "Quantity"
+	case p.ValueRange != nil:
+		return "Range"
+	case p.ValueRatio != nil:
+		return "Ratio"
+	case p.ValueRatioRange != nil:
+		return "RatioRange"
+	case p.ValueReference != nil:
+		return "Reference"
+	case p.ValueSampledData != nil:
+		return "SampledData"
+	case p.ValueSignature != nil:
+		return "Signature"
+	case p.ValueTiming != nil:
+		return "Timing"
+	case p.ValueContactDetail != nil:
+		return "ContactDetail"
+	case p.ValueDataRequirement != nil:
+		return "DataRequirement"
+	case p.ValueExpression != nil:
+		return "Expression"
+	case p.ValueParameterDefinition != nil:
+		return "ParameterDefinition"
+	case p.ValueRelatedArtifact != nil:
+		return "RelatedArtifact"
+	case p.ValueTriggerDefinition != nil:
+		return "TriggerDefinition"
+	case p.ValueUsageContext != nil:
+		return "UsageContext"
+	case p.ValueAvailability != nil:
+		return "Availability"
+	case p.ValueExtendedContactDetail != nil:
+		return "ExtendedContactDetail"
+	case p.ValueDosage != nil:
+		return "Dosage"
+	case p.ValueMeta != nil:
+		return "Meta"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (p *ParametersParameter) ClearValue() {
+	p.ValueBase64Binary = nil
+	p.ValueBase64BinaryExt = nil
+	p.ValueBoolean = nil
+	p.ValueBooleanExt = nil
+	p.ValueCanonical = nil
+	p.ValueCanonicalExt = nil
+	p.ValueCode = nil
+	p.ValueCodeExt = nil
+	p.ValueDate = nil
+	p.ValueDateExt = nil
+	p.ValueDateTime = nil
+	p.ValueDateTimeExt = nil
+	p.ValueDecimal = nil
+	p.ValueDecimalExt = nil
+	p.ValueID = nil
+	p.ValueIDExt = nil
+	p.ValueInstant = nil
+	p.ValueInstantExt = nil
+	p.ValueInteger = nil
+	p.ValueIntegerExt = nil
+	p.ValueInteger64 = nil
+	p.ValueInteger64Ext = nil
+	p.ValueMarkdown = nil
+	p.ValueMarkdownExt = nil
+	p.ValueOid = nil
+	p.ValueOidExt = nil
+	p.ValuePositiveInt = nil
+	p.ValuePositiveIntExt = nil
+	p.ValueString = nil
+	p.ValueStringExt = nil
+	p.ValueTime = nil
+	p.ValueTimeExt = nil
+	p.ValueUnsignedInt = nil
+	p.ValueUnsignedIntExt = nil
+	p.ValueURI = nil
+	p.ValueURIExt = nil
+	p.ValueURL = nil
+	p.ValueURLExt = nil
+	p.ValueUUID = nil
+	p.ValueUUIDExt = nil
+	p.ValueAddress = nil
+	p.ValueAge = nil
+	p.ValueAnnotation = nil
+	p.ValueAttachment = nil
+	p.ValueCodeableConcept = nil
+	p.ValueCodeableReference = nil
+	p.ValueCoding = nil
+	p.ValueContactPoint = nil
+	p.ValueCount = nil
+	p.ValueDistance = nil
+	p.ValueDuration = nil
+	p.ValueHumanName = nil
+	p.ValueIdentifier = nil
+	p.ValueMoney = nil
+	p.ValuePeriod = nil
+	p.ValueQuantity = nil
+	p.ValueRange = nil
+	p.ValueRatio = nil
+	p.ValueRatioRange = nil
+	p.ValueReference = nil
+	p.ValueSampledData = nil
+	p.ValueSignature = nil
+	p.ValueTiming = nil
+	p.ValueContactDetail = nil
+	p.ValueDataRequirement = nil
+	p.ValueExpression = nil
+	p.ValueParameterDefinition = nil
+	p.ValueRelatedArtifact = nil
+	p.ValueTriggerDefinition = nil
+	p.ValueUsageContext = nil
+	p.ValueAvailability = nil
+	p.ValueExtendedContactDetail = nil
+	p.ValueDosage = nil
+	p.ValueMeta = nil
+}
+
+// SetValueBase64Binary sets the base64Binary option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueBase64Binary(value primitives.Base64Binary) {
+	ext := p.ValueBase64BinaryExt
+	p.ClearValue()
+	p.ValueBase64Binary, p.ValueBase64BinaryExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueBoolean(value bool) {
+	ext := p.ValueBooleanExt
+	p.ClearValue()
+	p.ValueBoolean, p.ValueBooleanExt = &value, ext
+}
+
+// SetValueCanonical sets the canonical option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueCanonical(value primitives.Canonical) {
+	ext := p.ValueCanonicalExt
+	p.ClearValue()
+	p.ValueCanonical, p.ValueCanonicalExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueCode(value string) {
+	ext := p.ValueCodeExt
+	p.ClearValue()
+	p.ValueCode, p.ValueCodeExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDate(value primitives.Date) {
+	ext := p.ValueDateExt
+	p.ClearValue()
+	p.ValueDate, p.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDateTime(value primitives.DateTime) {
+	ext := p.ValueDateTimeExt
+	p.ClearValue()
+	p.ValueDateTime, p.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDecimal(value primitives.Decimal) {
+	ext := p.ValueDecimalExt
+	p.ClearValue()
+	p.ValueDecimal, p.ValueDecimalExt = &value, ext
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueID(value string) {
+	ext := p.ValueIDExt
+	p.ClearValue()
+	p.ValueID, p.ValueIDExt = &value, ext
+}
+
+// SetValueInstant sets the instant option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueInstant(value primitives.Instant) {
+	ext := p.ValueInstantExt
+	p.ClearValue()
+	p.ValueInstant, p.ValueInstantExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueInteger(value int) {
+	ext := p.ValueIntegerExt
+	p.ClearValue()
+	p.ValueInteger, p.ValueIntegerExt = &value, ext
+}
+
+// SetValueInteger64 sets the integer64 option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueInteger64(value primitives.Integer64) {
+	ext := p.ValueInteger64Ext
+	p.ClearValue()
+	p.ValueInteger64, p.ValueInteger64Ext = &value, ext
+}
+
+// SetValueMarkdown sets the markdown option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueMarkdown(value string) {
+	ext := p.ValueMarkdownExt
+	p.ClearValue()
+	p.ValueMarkdown, p.ValueMarkdownExt = &value, ext
+}
+
+// SetValueOid sets the oid option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueOid(value string) {
+	ext := p.ValueOidExt
+	p.ClearValue()
+	p.ValueOid, p.ValueOidExt = &value, ext
+}
+
+// SetValuePositiveInt sets the positiveInt option of value[x], clearing the others.
+func (p *ParametersParameter) SetValuePositiveInt(value int) {
+	ext := p.ValuePositiveIntExt
+	p.ClearValue()
+	p.ValuePositiveInt, p.ValuePositiveIntExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueString(value string) {
+	ext := p.ValueStringExt
+	p.ClearValue()
+	p.ValueString, p.ValueStringExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueTime(value primitives.Time) {
+	ext := p.ValueTimeExt
+	p.ClearValue()
+	p.ValueTime, p.ValueTimeExt = &value, ext
+}
+
+// SetValueUnsignedInt sets the unsignedInt option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueUnsignedInt(value uint) {
+	ext := p.ValueUnsignedIntExt
+	p.ClearValue()
+	p.ValueUnsignedInt, p.ValueUnsignedIntExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueURI(value string) {
+	ext := p.ValueURIExt
+	p.ClearValue()
+	p.ValueURI, p.ValueURIExt = &value, ext
+}
+
+// SetValueURL sets the url option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueURL(value string) {
+	ext := p.ValueURLExt
+	p.ClearValue()
+	p.ValueURL, p.ValueURLExt = &value, ext
+}
+
+// SetValueUUID sets the uuid option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueUUID(value string) {
+	ext := p.ValueUUIDExt
+	p.ClearValue()
+	p.ValueUUID, p.ValueUUIDExt = &value, ext
+}
+
+// SetValueAddress sets the Address option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueAddress(value Address) {
+	p.ClearValue()
+	p.ValueAddress = &value
+}
+
+// SetValueAge sets the Age option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueAge(value Age) {
+	p.ClearValue()
+	p.ValueAge = &value
+}
+
+// SetValueAnnotation sets the Annotation option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueAnnotation(value Annotation) {
+	p.ClearValue()
+	p.ValueAnnotation = &value
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueAttachment(value Attachment) {
+	p.ClearValue()
+	p.ValueAttachment = &value
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueCodeableConcept(value CodeableConcept) {
+	p.ClearValue()
+	p.ValueCodeableConcept = &value
+}
+
+// SetValueCodeableReference sets the CodeableReference option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueCodeableReference(value CodeableReference) {
+	p.ClearValue()
+	p.ValueCodeableReference = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueCoding(value Coding) {
+	p.ClearValue()
+	p.ValueCoding = &value
+}
+
+// SetValueContactPoint sets the ContactPoint option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueContactPoint(value ContactPoint) {
+	p.ClearValue()
+	p.ValueContactPoint = &value
+}
+
+// SetValueCount sets the Count option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueCount(value Count) {
+	p.ClearValue()
+	p.ValueCount = &value
+}
+
+// SetValueDistance sets the Distance option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDistance(value Distance) {
+	p.ClearValue()
+	p.ValueDistance = &value
+}
+
+// SetValueDuration sets the Duration option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDuration(value Duration) {
+	p.ClearValue()
+	p.ValueDuration = &value
+}
+
+// SetValueHumanName sets the HumanName option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueHumanName(value HumanName) {
+	p.ClearValue()
+	p.ValueHumanName = &value
+}
+
+// SetValueIdentifier sets the Identifier option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueIdentifier(value Identifier) {
+	p.ClearValue()
+	p.ValueIdentifier = &value
+}
+
+// SetValueMoney sets the Money option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueMoney(value Money) {
+	p.ClearValue()
+	p.ValueMoney = &value
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (p *ParametersParameter) SetValuePeriod(value Period) {
+	p.ClearValue()
+	p.ValuePeriod = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueQuantity(value Quantity) {
+	p.ClearValue()
+	p.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueRange(value Range) {
+	p.ClearValue()
+	p.ValueRange = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueRatio(value Ratio) {
+	p.ClearValue()
+	p.ValueRatio = &value
+}
+
+// SetValueRatioRange sets the RatioRange option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueRatioRange(value RatioRange) {
+	p.ClearValue()
+	p.ValueRatioRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueReference(value Reference) {
+	p.ClearValue()
+	p.ValueReference = &value
+}
+
+// SetValueSampledData sets the SampledData option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueSampledData(value SampledData) {
+	p.ClearValue()
+	p.ValueSampledData = &value
+}
+
+// SetValueSignature sets the Signature option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueSignature(value Signature) {
+	p.ClearValue()
+	p.ValueSignature = &value
+}
+
+// SetValueTiming sets the Timing option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueTiming(value Timing) {
+	p.ClearValue()
+	p.ValueTiming = &value
+}
+
+// SetValueContactDetail sets the ContactDetail option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueContactDetail(value ContactDetail) {
+	p.ClearValue()
+	p.ValueContactDetail = &value
+}
+
+// SetValueDataRequirement sets the DataRequirement option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDataRequirement(value DataRequirement) {
+	p.ClearValue()
+	p.ValueDataRequirement = &value
+}
+
+// SetValueExpression sets the Expression option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueExpression(value Expression) {
+	p.ClearValue()
+	p.ValueExpression = &value
+}
+
+// SetValueParameterDefinition sets the ParameterDefinition option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueParameterDefinition(value ParameterDefinition) {
+	p.ClearValue()
+	p.ValueParameterDefinition = &value
+}
+
+// SetValueRelatedArtifact sets the RelatedArtifact option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueRelatedArtifact(value RelatedArtifact) {
+	p.ClearValue()
+	p.ValueRelatedArtifact = &value
+}
+
+// SetValueTriggerDefinition sets the TriggerDefinition option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueTriggerDefinition(value TriggerDefinition) {
+	p.ClearValue()
+	p.ValueTriggerDefinition = &value
+}
+
+// SetValueUsageContext sets the UsageContext option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueUsageContext(value UsageContext) {
+	p.ClearValue()
+	p.ValueUsageContext = &value
+}
+
+// SetValueAvailability sets the Availability option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueAvailability(value Availability) {
+	p.ClearValue()
+	p.ValueAvailability = &value
+}
+
+// SetValueExtendedContactDetail sets the ExtendedContactDetail option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueExtendedContactDetail(value ExtendedContactDetail) {
+	p.ClearValue()
+	p.ValueExtendedContactDetail = &value
+}
+
+// SetValueDosage sets the Dosage option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueDosage(value Dosage) {
+	p.ClearValue()
+	p.ValueDosage = &value
+}
+
+// SetValueMeta sets the Meta option of value[x], clearing the others.
+func (p *ParametersParameter) SetValueMeta(value Meta) {
+	p.ClearValue()
+	p.ValueMeta = &value
+}
+
 // Parameters represents a FHIR Parameters.
 type Parameters struct {
-	fhir.Resource
+	BaseResource
 	// Operation Parameter
 	Parameter []ParametersParameter `json:"parameter,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Parameters", the FHIR type of the resource.
+func (p *Parameters) ResourceType() string {
+	return ResourceTypeParameters
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -32,7 +31,7 @@ type PatientContact struct {
 	// Address for the contact person
 	Address *Address `json:"address,omitempty" fhir:"cardinality=0..1"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// Organization that is associated with the contact
@@ -79,7 +78,7 @@ type PatientLink struct {
 
 // Patient represents a FHIR Patient.
 type Patient struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// An identifier for this patient
@@ -93,7 +92,7 @@ type Patient struct {
 	// A contact detail for the individual
 	Telecom []ContactPoint `json:"telecom,omitempty" fhir:"cardinality=0..*,summary"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// The date of birth for the individual
@@ -133,3 +132,98 @@ type Patient struct {
 	// Link to a Patient or RelatedPerson resource that concerns the same actual individual
 	Link []PatientLink `json:"link,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Patient", the FHIR type of the resource.
+func (p *Patient) ResourceType() string {
+	return ResourceTypePatient
+}
+
+// Deceased returns the option of deceased[x] that is set, or nil if none is.
+func (p *Patient) Deceased() any {
+	switch {
+	case p.DeceasedBoolean != nil:
+		return p.DeceasedBoolean
+	case p.DeceasedDateTime != nil:
+		return p.DeceasedDateTime
+	}
+	return nil
+}
+
+// DeceasedType returns the FHIR type of the option of deceased[x] that is
+// set, such as "boolean", or "" if none is.
+func (p *Patient) DeceasedType() string {
+	switch {
+	case p.DeceasedBoolean != nil:
+		return "boolean"
+	case p.DeceasedDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearDeceased unsets every option of deceased[x], and their extensions.
+func (p *Patient) ClearDeceased() {
+	p.DeceasedBoolean = nil
+	p.DeceasedBooleanExt = nil
+	p.DeceasedDateTime = nil
+	p.DeceasedDateTimeExt = nil
+}
+
+// SetDeceasedBoolean sets the boolean option of deceased[x], clearing the others.
+func (p *Patient) SetDeceasedBoolean(value bool) {
+	ext := p.DeceasedBooleanExt
+	p.ClearDeceased()
+	p.DeceasedBoolean, p.DeceasedBooleanExt = &value, ext
+}
+
+// SetDeceasedDateTime sets the dateTime option of deceased[x], clearing the others.
+func (p *Patient) SetDeceasedDateTime(value primitives.DateTime) {
+	ext := p.DeceasedDateTimeExt
+	p.ClearDeceased()
+	p.DeceasedDateTime, p.DeceasedDateTimeExt = &value, ext
+}
+
+// MultipleBirth returns the option of multipleBirth[x] that is set, or nil if none is.
+func (p *Patient) MultipleBirth() any {
+	switch {
+	case p.MultipleBirthBoolean != nil:
+		return p.MultipleBirthBoolean
+	case p.MultipleBirthInteger != nil:
+		return p.MultipleBirthInteger
+	}
+	return nil
+}
+
+// MultipleBirthType returns the FHIR type of the option of multipleBirth[x] that is
+// set, such as "boolean", or "" if none is.
+func (p *Patient) MultipleBirthType() string {
+	switch {
+	case p.MultipleBirthBoolean != nil:
+		return "boolean"
+	case p.MultipleBirthInteger != nil:
+		return "integer"
+	}
+	return ""
+}
+
+// ClearMultipleBirth unsets every option of multipleBirth[x], and their extensions.
+func (p *Patient) ClearMultipleBirth() {
+	p.MultipleBirthBoolean = nil
+	p.MultipleBirthBooleanExt = nil
+	p.MultipleBirthInteger = nil
+	p.MultipleBirthIntegerExt = nil
+}
+
+// SetMultipleBirthBoolean sets the boolean option of multipleBirth[x], clearing the others.
+func (p *Patient) SetMultipleBirthBoolean(value bool) {
+	ext := p.MultipleBirthBooleanExt
+	p.ClearMultipleBirth()
+	p.MultipleBirthBoolean, p.MultipleBirthBooleanExt = &value, ext
+}
+
+// SetMultipleBirthInteger sets the integer option of multipleBirth[x], clearing the others.
+func (p *Patient) SetMultipleBirthInteger(value int) {
+	ext := p.MultipleBirthIntegerExt
+	p.ClearMultipleBirth()
+	p.MultipleBirthInteger, p.MultipleBirthIntegerExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -61,6 +60,62 @@ type PaymentReconciliationAllocation struct {
 	Amount *Money `json:"amount,omitempty" fhir:"cardinality=0..1"`
 }
 
+// TargetItem returns the option of targetItem[x] that is set, or nil if none is.
+func (p *PaymentReconciliationAllocation) TargetItem() any {
+	switch {
+	case p.TargetItemString != nil:
+		return p.TargetItemString
+	case p.TargetItemIdentifier != nil:
+		return p.TargetItemIdentifier
+	case p.TargetItemPositiveInt != nil:
+		return p.TargetItemPositiveInt
+	}
+	return nil
+}
+
+// TargetItemType returns the FHIR type of the option of targetItem[x] that is
+// set, such as "string", or "" if none is.
+func (p *PaymentReconciliationAllocation) TargetItemType() string {
+	switch {
+	case p.TargetItemString != nil:
+		return "string"
+	case p.TargetItemIdentifier != nil:
+		return "Identifier"
+	case p.TargetItemPositiveInt != nil:
+		return "positiveInt"
+	}
+	return ""
+}
+
+// ClearTargetItem unsets every option of targetItem[x], and their extensions.
+func (p *PaymentReconciliationAllocation) ClearTargetItem() {
+	p.TargetItemString = nil
+	p.TargetItemStringExt = nil
+	p.TargetItemIdentifier = nil
+	p.TargetItemPositiveInt = nil
+	p.TargetItemPositiveIntExt = nil
+}
+
+// SetTargetItemString sets the string option of targetItem[x], clearing the others.
+func (p *PaymentReconciliationAllocation) SetTargetItemString(value string) {
+	ext := p.TargetItemStringExt
+	p.ClearTargetItem()
+	p.TargetItemString, p.TargetItemStringExt = &value, ext
+}
+
+// SetTargetItemIdentifier sets the Identifier option of targetItem[x], clearing the others.
+func (p *PaymentReconciliationAllocation) SetTargetItemIdentifier(value Identifier) {
+	p.ClearTargetItem()
+	p.TargetItemIdentifier = &value
+}
+
+// SetTargetItemPositiveInt sets the positiveInt option of targetItem[x], clearing the others.
+func (p *PaymentReconciliationAllocation) SetTargetItemPositiveInt(value int) {
+	ext := p.TargetItemPositiveIntExt
+	p.ClearTargetItem()
+	p.TargetItemPositiveInt, p.TargetItemPositiveIntExt = &value, ext
+}
+
 // PaymentReconciliationProcessNote represents a FHIR BackboneElement for PaymentReconciliation.processNote.
 type PaymentReconciliationProcessNote struct {
 	// Unique id for inter-element referencing
@@ -83,7 +138,7 @@ type PaymentReconciliationProcessNote struct {
 
 // PaymentReconciliation represents a FHIR PaymentReconciliation.
 type PaymentReconciliation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for a payment reconciliation
@@ -167,3 +222,8 @@ type PaymentReconciliation struct {
 	// Note concerning processing
 	ProcessNote []PaymentReconciliationProcessNote `json:"processNote,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "PaymentReconciliation", the FHIR type of the resource.
+func (p *PaymentReconciliation) ResourceType() string {
+	return ResourceTypePaymentReconciliation
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -109,7 +108,7 @@ type PermissionRule struct {
 
 // Permission represents a FHIR Permission.
 type Permission struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// active | entered-in-error | draft | rejected
@@ -133,3 +132,8 @@ type Permission struct {
 	// Constraints to the Permission
 	Rule []PermissionRule `json:"rule,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Permission", the FHIR type of the resource.
+func (p *Permission) ResourceType() string {
+	return ResourceTypePermission
+}
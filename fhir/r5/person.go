@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -51,7 +50,7 @@ type PersonLink struct {
 
 // Person represents a FHIR Person.
 type Person struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A human identifier for this person
@@ -65,7 +64,7 @@ type Person struct {
 	// A contact detail for the person
 	Telecom []ContactPoint `json:"telecom,omitempty" fhir:"cardinality=0..*,summary"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// The date on which the person was born
@@ -93,3 +92,53 @@ type Person struct {
 	// Link to a resource that concerns the same actual person
 	Link []PersonLink `json:"link,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Person", the FHIR type of the resource.
+func (p *Person) ResourceType() string {
+	return ResourceTypePerson
+}
+
+// Deceased returns the option of deceased[x] that is set, or nil if none is.
+func (p *Person) Deceased() any {
+	switch {
+	case p.DeceasedBoolean != nil:
+		return p.DeceasedBoolean
+	case p.DeceasedDateTime != nil:
+		return p.DeceasedDateTime
+	}
+	return nil
+}
+
+// DeceasedType returns the FHIR type of the option of deceased[x] that is
+// set, such as "boolean", or "" if none is.
+func (p *Person) DeceasedType() string {
+	switch {
+	case p.DeceasedBoolean != nil:
+		return "boolean"
+	case p.DeceasedDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearDeceased unsets every option of deceased[x], and their extensions.
+func (p *Person) ClearDeceased() {
+	p.DeceasedBoolean = nil
+	p.DeceasedBooleanExt = nil
+	p.DeceasedDateTime = nil
+	p.DeceasedDateTimeExt = nil
+}
+
+// SetDeceasedBoolean sets the boolean option of deceased[x], clearing the others.
+func (p *Person) SetDeceasedBoolean(value bool) {
+	ext := p.DeceasedBooleanExt
+	p.ClearDeceased()
+	p.DeceasedBoolean, p.DeceasedBooleanExt = &value, ext
+}
+
+// SetDeceasedDateTime sets the dateTime option of deceased[x], clearing the others.
+func (p *Person) SetDeceasedDateTime(value primitives.DateTime) {
+	ext := p.DeceasedDateTimeExt
+	p.ClearDeceased()
+	p.DeceasedDateTime, p.DeceasedDateTimeExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,6 +48,108 @@ type PlanDefinitionGoalTarget struct {
 	Due *Duration `json:"due,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Detail returns the option of detail[x] that is set, or nil if none is.
+func (p *PlanDefinitionGoalTarget) Detail() any {
+	switch {
+	case p.DetailQuantity != nil:
+		return p.DetailQuantity
+	case p.DetailRange != nil:
+		return p.DetailRange
+	case p.DetailCodeableConcept != nil:
+		return p.DetailCodeableConcept
+	case p.DetailString != nil:
+		return p.DetailString
+	case p.DetailBoolean != nil:
+		return p.DetailBoolean
+	case p.DetailInteger != nil:
+		return p.DetailInteger
+	case p.DetailRatio != nil:
+		return p.DetailRatio
+	}
+	return nil
+}
+
+// DetailType returns the FHIR type of the option of detail[x] that is
+// set, such as "Quantity", or "" if none is.
+func (p *PlanDefinitionGoalTarget) DetailType() string {
+	switch {
+	case p.DetailQuantity != nil:
+		return "Quantity"
+	case p.DetailRange != nil:
+		return "Range"
+	case p.DetailCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.DetailString != nil:
+		return "string"
+	case p.DetailBoolean != nil:
+		return "boolean"
+	case p.DetailInteger != nil:
+		return "integer"
+	case p.DetailRatio != nil:
+		return "Ratio"
+	}
+	return ""
+}
+
+// ClearDetail unsets every option of detail[x], and their extensions.
+func (p *PlanDefinitionGoalTarget) ClearDetail() {
+	p.DetailQuantity = nil
+	p.DetailRange = nil
+	p.DetailCodeableConcept = nil
+	p.DetailString = nil
+	p.DetailStringExt = nil
+	p.DetailBoolean = nil
+	p.DetailBooleanExt = nil
+	p.DetailInteger = nil
+	p.DetailIntegerExt = nil
+	p.DetailRatio = nil
+}
+
+// SetDetailQuantity sets the Quantity option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailQuantity(value Quantity) {
+	p.ClearDetail()
+	p.DetailQuantity = &value
+}
+
+// SetDetailRange sets the Range option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailRange(value Range) {
+	p.ClearDetail()
+	p.DetailRange = &value
+}
+
+// SetDetailCodeableConcept sets the CodeableConcept option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailCodeableConcept(value CodeableConcept) {
+	p.ClearDetail()
+	p.DetailCodeableConcept = &value
+}
+
+// SetDetailString sets the string option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailString(value string) {
+	ext := p.DetailStringExt
+	p.ClearDetail()
+	p.DetailString, p.DetailStringExt = &value, ext
+}
+
+// SetDetailBoolean sets the boolean option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailBoolean(value bool) {
+	ext := p.DetailBooleanExt
+	p.ClearDetail()
+	p.DetailBoolean, p.DetailBooleanExt = &value, ext
+}
+
+// SetDetailInteger sets the integer option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailInteger(value int) {
+	ext := p.DetailIntegerExt
+	p.ClearDetail()
+	p.DetailInteger, p.DetailIntegerExt = &value, ext
+}
+
+// SetDetailRatio sets the Ratio option of detail[x], clearing the others.
+func (p *PlanDefinitionGoalTarget) SetDetailRatio(value Ratio) {
+	p.ClearDetail()
+	p.DetailRatio = &value
+}
+
 // PlanDefinitionGoal represents a FHIR BackboneElement for PlanDefinition.goal.
 type PlanDefinitionGoal struct {
 	// Unique id for inter-element referencing
@@ -90,7 +191,7 @@ type PlanDefinitionActorOption struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
-	TypeCanonical *string `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
+	TypeCanonical *primitives.Canonical `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Extension for TypeCanonical
 	TypeCanonicalExt *primitives.PrimitiveExtension `json:"_typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
@@ -211,6 +312,47 @@ type PlanDefinitionActionRelatedAction struct {
 	OffsetRange *Range `json:"offsetRange,omitempty" fhir:"cardinality=0..1,choice=offset"`
 }
 
+// Offset returns the option of offset[x] that is set, or nil if none is.
+func (p *PlanDefinitionActionRelatedAction) Offset() any {
+	switch {
+	case p.OffsetDuration != nil:
+		return p.OffsetDuration
+	case p.OffsetRange != nil:
+		return p.OffsetRange
+	}
+	return nil
+}
+
+// OffsetType returns the FHIR type of the option of offset[x] that is
+// set, such as "Duration", or "" if none is.
+func (p *PlanDefinitionActionRelatedAction) OffsetType() string {
+	switch {
+	case p.OffsetDuration != nil:
+		return "Duration"
+	case p.OffsetRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearOffset unsets every option of offset[x], and their extensions.
+func (p *PlanDefinitionActionRelatedAction) ClearOffset() {
+	p.OffsetDuration = nil
+	p.OffsetRange = nil
+}
+
+// SetOffsetDuration sets the Duration option of offset[x], clearing the others.
+func (p *PlanDefinitionActionRelatedAction) SetOffsetDuration(value Duration) {
+	p.ClearOffset()
+	p.OffsetDuration = &value
+}
+
+// SetOffsetRange sets the Range option of offset[x], clearing the others.
+func (p *PlanDefinitionActionRelatedAction) SetOffsetRange(value Range) {
+	p.ClearOffset()
+	p.OffsetRange = &value
+}
+
 // PlanDefinitionActionParticipant represents a FHIR BackboneElement for PlanDefinition.action.participant.
 type PlanDefinitionActionParticipant struct {
 	// Unique id for inter-element referencing
@@ -230,7 +372,7 @@ type PlanDefinitionActionParticipant struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
-	TypeCanonical *string `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
+	TypeCanonical *primitives.Canonical `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Extension for TypeCanonical
 	TypeCanonicalExt *primitives.PrimitiveExtension `json:"_typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
@@ -312,7 +454,7 @@ type PlanDefinitionAction struct {
 	// Type of individual the action is focused on - Reference option
 	SubjectReference *Reference `json:"subjectReference,omitempty" fhir:"cardinality=0..1,choice=subject"`
 	// Type of individual the action is focused on - canonical option
-	SubjectCanonical *string `json:"subjectCanonical,omitempty" fhir:"cardinality=0..1,choice=subject"`
+	SubjectCanonical *primitives.Canonical `json:"subjectCanonical,omitempty" fhir:"cardinality=0..1,choice=subject"`
 	// Extension for SubjectCanonical
 	SubjectCanonicalExt *primitives.PrimitiveExtension `json:"_subjectCanonical,omitempty" fhir:"cardinality=0..1"`
 	// When the action should be triggered
@@ -360,15 +502,15 @@ type PlanDefinitionAction struct {
 	// Extension for CardinalityBehavior
 	CardinalityBehaviorExt *primitives.PrimitiveExtension `json:"_cardinalityBehavior,omitempty" fhir:"cardinality=0..1"`
 	// Description of the activity to be performed - canonical option
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,choice=definition"`
+	DefinitionCanonical *primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,choice=definition"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Description of the activity to be performed - uri option
-	DefinitionURI *string `json:"definitionURI,omitempty" fhir:"cardinality=0..1,choice=definition"`
+	DefinitionURI *string `json:"definitionUri,omitempty" fhir:"cardinality=0..1,choice=definition"`
 	// Extension for DefinitionURI
-	DefinitionURIExt *primitives.PrimitiveExtension `json:"_definitionURI,omitempty" fhir:"cardinality=0..1"`
+	DefinitionURIExt *primitives.PrimitiveExtension `json:"_definitionUri,omitempty" fhir:"cardinality=0..1"`
 	// Transform to apply the template
-	Transform *string `json:"transform,omitempty" fhir:"cardinality=0..1"`
+	Transform *primitives.Canonical `json:"transform,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Transform
 	TransformExt *primitives.PrimitiveExtension `json:"_transform,omitempty" fhir:"cardinality=0..1"`
 	// Dynamic aspects of the definition
@@ -377,9 +519,171 @@ type PlanDefinitionAction struct {
 	Action []PlanDefinitionActionAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (p *PlanDefinitionAction) Subject() any {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return p.SubjectCodeableConcept
+	case p.SubjectReference != nil:
+		return p.SubjectReference
+	case p.SubjectCanonical != nil:
+		return p.SubjectCanonical
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (p *PlanDefinitionAction) SubjectType() string {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.SubjectReference != nil:
+		return "Reference"
+	case p.SubjectCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (p *PlanDefinitionAction) ClearSubject() {
+	p.SubjectCodeableConcept = nil
+	p.SubjectReference = nil
+	p.SubjectCanonical = nil
+	p.SubjectCanonicalExt = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (p *PlanDefinitionAction) SetSubjectCodeableConcept(value CodeableConcept) {
+	p.ClearSubject()
+	p.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (p *PlanDefinitionAction) SetSubjectReference(value Reference) {
+	p.ClearSubject()
+	p.SubjectReference = &value
+}
+
+// SetSubjectCanonical sets the canonical option of subject[x], clearing the others.
+func (p *PlanDefinitionAction) SetSubjectCanonical(value primitives.Canonical) {
+	ext := p.SubjectCanonicalExt
+	p.ClearSubject()
+	p.SubjectCanonical, p.SubjectCanonicalExt = &value, ext
+}
+
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (p *PlanDefinitionAction) Timing() any {
+	switch {
+	case p.TimingAge != nil:
+		return p.TimingAge
+	case p.TimingDuration != nil:
+		return p.TimingDuration
+	case p.TimingRange != nil:
+		return p.TimingRange
+	case p.TimingTiming != nil:
+		return p.TimingTiming
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "Age", or "" if none is.
+func (p *PlanDefinitionAction) TimingType() string {
+	switch {
+	case p.TimingAge != nil:
+		return "Age"
+	case p.TimingDuration != nil:
+		return "Duration"
+	case p.TimingRange != nil:
+		return "Range"
+	case p.TimingTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (p *PlanDefinitionAction) ClearTiming() {
+	p.TimingAge = nil
+	p.TimingDuration = nil
+	p.TimingRange = nil
+	p.TimingTiming = nil
+}
+
+// SetTimingAge sets the Age option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingAge(value Age) {
+	p.ClearTiming()
+	p.TimingAge = &value
+}
+
+// SetTimingDuration sets the Duration option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingDuration(value Duration) {
+	p.ClearTiming()
+	p.TimingDuration = &value
+}
+
+// SetTimingRange sets the Range option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingRange(value Range) {
+	p.ClearTiming()
+	p.TimingRange = &value
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (p *PlanDefinitionAction) SetTimingTiming(value Timing) {
+	p.ClearTiming()
+	p.TimingTiming = &value
+}
+
+// Definition returns the option of definition[x] that is set, or nil if none is.
+func (p *PlanDefinitionAction) Definition() any {
+	switch {
+	case p.DefinitionCanonical != nil:
+		return p.DefinitionCanonical
+	case p.DefinitionURI != nil:
+		return p.DefinitionURI
+	}
+	return nil
+}
+
+// DefinitionType returns the FHIR type of the option of definition[x] that is
+// set, such as "canonical", or "" if none is.
+func (p *PlanDefinitionAction) DefinitionType() string {
+	switch {
+	case p.DefinitionCanonical != nil:
+		return "canonical"
+	case p.DefinitionURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearDefinition unsets every option of definition[x], and their extensions.
+func (p *PlanDefinitionAction) ClearDefinition() {
+	p.DefinitionCanonical = nil
+	p.DefinitionCanonicalExt = nil
+	p.DefinitionURI = nil
+	p.DefinitionURIExt = nil
+}
+
+// SetDefinitionCanonical sets the canonical option of definition[x], clearing the others.
+func (p *PlanDefinitionAction) SetDefinitionCanonical(value primitives.Canonical) {
+	ext := p.DefinitionCanonicalExt
+	p.ClearDefinition()
+	p.DefinitionCanonical, p.DefinitionCanonicalExt = &value, ext
+}
+
+// SetDefinitionURI sets the uri option of definition[x], clearing the others.
+func (p *PlanDefinitionAction) SetDefinitionURI(value string) {
+	ext := p.DefinitionURIExt
+	p.ClearDefinition()
+	p.DefinitionURI, p.DefinitionURIExt = &value, ext
+}
+
 // PlanDefinition represents a FHIR PlanDefinition.
 type PlanDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this plan definition, represented as a URI (globally unique)
@@ -425,7 +729,7 @@ type PlanDefinition struct {
 	// Type of individual the plan definition is focused on - Reference option
 	SubjectReference *Reference `json:"subjectReference,omitempty" fhir:"cardinality=0..1,choice=subject"`
 	// Type of individual the plan definition is focused on - canonical option
-	SubjectCanonical *string `json:"subjectCanonical,omitempty" fhir:"cardinality=0..1,choice=subject"`
+	SubjectCanonical *primitives.Canonical `json:"subjectCanonical,omitempty" fhir:"cardinality=0..1,choice=subject"`
 	// Extension for SubjectCanonical
 	SubjectCanonicalExt *primitives.PrimitiveExtension `json:"_subjectCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Date last changed
@@ -485,7 +789,7 @@ type PlanDefinition struct {
 	// Additional documentation, citations
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty" fhir:"cardinality=0..*"`
 	// Logic used by the plan definition
-	Library []string `json:"library,omitempty" fhir:"cardinality=0..*"`
+	Library []primitives.Canonical `json:"library,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Library
 	LibraryExt *primitives.PrimitiveExtension `json:"_library,omitempty" fhir:"cardinality=0..1"`
 	// What the plan is trying to accomplish
@@ -501,3 +805,148 @@ type PlanDefinition struct {
 	// Preconditions for service - CodeableConcept option
 	AsNeededCodeableConcept *CodeableConcept `json:"asNeededCodeableConcept,omitempty" fhir:"cardinality=0..1,summary,choice=asNeeded"`
 }
+
+// ResourceType returns "PlanDefinition", the FHIR type of the resource.
+func (p *PlanDefinition) ResourceType() string {
+	return ResourceTypePlanDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (p *PlanDefinition) VersionAlgorithm() any {
+	switch {
+	case p.VersionAlgorithmString != nil:
+		return p.VersionAlgorithmString
+	case p.VersionAlgorithmCoding != nil:
+		return p.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (p *PlanDefinition) VersionAlgorithmType() string {
+	switch {
+	case p.VersionAlgorithmString != nil:
+		return "string"
+	case p.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (p *PlanDefinition) ClearVersionAlgorithm() {
+	p.VersionAlgorithmString = nil
+	p.VersionAlgorithmStringExt = nil
+	p.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (p *PlanDefinition) SetVersionAlgorithmString(value string) {
+	ext := p.VersionAlgorithmStringExt
+	p.ClearVersionAlgorithm()
+	p.VersionAlgorithmString, p.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (p *PlanDefinition) SetVersionAlgorithmCoding(value Coding) {
+	p.ClearVersionAlgorithm()
+	p.VersionAlgorithmCoding = &value
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (p *PlanDefinition) Subject() any {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return p.SubjectCodeableConcept
+	case p.SubjectReference != nil:
+		return p.SubjectReference
+	case p.SubjectCanonical != nil:
+		return p.SubjectCanonical
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (p *PlanDefinition) SubjectType() string {
+	switch {
+	case p.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case p.SubjectReference != nil:
+		return "Reference"
+	case p.SubjectCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (p *PlanDefinition) ClearSubject() {
+	p.SubjectCodeableConcept = nil
+	p.SubjectReference = nil
+	p.SubjectCanonical = nil
+	p.SubjectCanonicalExt = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (p *PlanDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	p.ClearSubject()
+	p.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (p *PlanDefinition) SetSubjectReference(value Reference) {
+	p.ClearSubject()
+	p.SubjectReference = &value
+}
+
+// SetSubjectCanonical sets the canonical option of subject[x], clearing the others.
+func (p *PlanDefinition) SetSubjectCanonical(value primitives.Canonical) {
+	ext := p.SubjectCanonicalExt
+	p.ClearSubject()
+	p.SubjectCanonical, p.SubjectCanonicalExt = &value, ext
+}
+
+// AsNeeded returns the option of asNeeded[x] that is set, or nil if none is.
+func (p *PlanDefinition) AsNeeded() any {
+	switch {
+	case p.AsNeededBoolean != nil:
+		return p.AsNeededBoolean
+	case p.AsNeededCodeableConcept != nil:
+		return p.AsNeededCodeableConcept
+	}
+	return nil
+}
+
+// AsNeededType returns the FHIR type of the option of asNeeded[x] that is
+// set, such as "boolean", or "" if none is.
+func (p *PlanDefinition) AsNeededType() string {
+	switch {
+	case p.AsNeededBoolean != nil:
+		return "boolean"
+	case p.AsNeededCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAsNeeded unsets every option of asNeeded[x], and their extensions.
+func (p *PlanDefinition) ClearAsNeeded() {
+	p.AsNeededBoolean = nil
+	p.AsNeededBooleanExt = nil
+	p.AsNeededCodeableConcept = nil
+}
+
+// SetAsNeededBoolean sets the boolean option of asNeeded[x], clearing the others.
+func (p *PlanDefinition) SetAsNeededBoolean(value bool) {
+	ext := p.AsNeededBooleanExt
+	p.ClearAsNeeded()
+	p.AsNeededBoolean, p.AsNeededBooleanExt = &value, ext
+}
+
+// SetAsNeededCodeableConcept sets the CodeableConcept option of asNeeded[x], clearing the others.
+func (p *PlanDefinition) SetAsNeededCodeableConcept(value CodeableConcept) {
+	p.ClearAsNeeded()
+	p.AsNeededCodeableConcept = &value
+}
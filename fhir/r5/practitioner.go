@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -53,7 +52,7 @@ type PractitionerCommunication struct {
 
 // Practitioner represents a FHIR Practitioner.
 type Practitioner struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// An identifier for the person as this agent
@@ -67,7 +66,7 @@ type Practitioner struct {
 	// A contact detail for the practitioner (that apply to all roles)
 	Telecom []ContactPoint `json:"telecom,omitempty" fhir:"cardinality=0..*,summary"`
 	// male | female | other | unknown
-	Gender *string `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
+	Gender *AdministrativeGender `json:"gender,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Gender
 	GenderExt *primitives.PrimitiveExtension `json:"_gender,omitempty" fhir:"cardinality=0..1"`
 	// The date  on which the practitioner was born
@@ -91,3 +90,53 @@ type Practitioner struct {
 	// A language which may be used to communicate with the practitioner
 	Communication []PractitionerCommunication `json:"communication,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Practitioner", the FHIR type of the resource.
+func (p *Practitioner) ResourceType() string {
+	return ResourceTypePractitioner
+}
+
+// Deceased returns the option of deceased[x] that is set, or nil if none is.
+func (p *Practitioner) Deceased() any {
+	switch {
+	case p.DeceasedBoolean != nil:
+		return p.DeceasedBoolean
+	case p.DeceasedDateTime != nil:
+		return p.DeceasedDateTime
+	}
+	return nil
+}
+
+// DeceasedType returns the FHIR type of the option of deceased[x] that is
+// set, such as "boolean", or "" if none is.
+func (p *Practitioner) DeceasedType() string {
+	switch {
+	case p.DeceasedBoolean != nil:
+		return "boolean"
+	case p.DeceasedDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearDeceased unsets every option of deceased[x], and their extensions.
+func (p *Practitioner) ClearDeceased() {
+	p.DeceasedBoolean = nil
+	p.DeceasedBooleanExt = nil
+	p.DeceasedDateTime = nil
+	p.DeceasedDateTimeExt = nil
+}
+
+// SetDeceasedBoolean sets the boolean option of deceased[x], clearing the others.
+func (p *Practitioner) SetDeceasedBoolean(value bool) {
+	ext := p.DeceasedBooleanExt
+	p.ClearDeceased()
+	p.DeceasedBoolean, p.DeceasedBooleanExt = &value, ext
+}
+
+// SetDeceasedDateTime sets the dateTime option of deceased[x], clearing the others.
+func (p *Practitioner) SetDeceasedDateTime(value primitives.DateTime) {
+	ext := p.DeceasedDateTimeExt
+	p.ClearDeceased()
+	p.DeceasedDateTime, p.DeceasedDateTimeExt = &value, ext
+}
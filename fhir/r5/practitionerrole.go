@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -15,7 +14,7 @@ const ResourceTypePractitionerRole = "PractitionerRole"
 
 // PractitionerRole represents a FHIR PractitionerRole.
 type PractitionerRole struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifiers for a role/location
@@ -49,3 +48,8 @@ type PractitionerRole struct {
 	// Endpoints for interacting with the practitioner in this role
 	Endpoint []Reference `json:"endpoint,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "PractitionerRole", the FHIR type of the resource.
+func (p *PractitionerRole) ResourceType() string {
+	return ResourceTypePractitionerRole
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -51,13 +50,13 @@ type ProcedureFocalDevice struct {
 
 // Procedure represents a FHIR Procedure.
 type Procedure struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External Identifiers for this procedure
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -137,3 +136,140 @@ type Procedure struct {
 	// Extra information relevant to the procedure
 	SupportingInfo []Reference `json:"supportingInfo,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Procedure", the FHIR type of the resource.
+func (p *Procedure) ResourceType() string {
+	return ResourceTypeProcedure
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (p *Procedure) Occurrence() any {
+	switch {
+	case p.OccurrenceDateTime != nil:
+		return p.OccurrenceDateTime
+	case p.OccurrencePeriod != nil:
+		return p.OccurrencePeriod
+	case p.OccurrenceString != nil:
+		return p.OccurrenceString
+	case p.OccurrenceAge != nil:
+		return p.OccurrenceAge
+	case p.OccurrenceRange != nil:
+		return p.OccurrenceRange
+	case p.OccurrenceTiming != nil:
+		return p.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (p *Procedure) OccurrenceType() string {
+	switch {
+	case p.OccurrenceDateTime != nil:
+		return "dateTime"
+	case p.OccurrencePeriod != nil:
+		return "Period"
+	case p.OccurrenceString != nil:
+		return "string"
+	case p.OccurrenceAge != nil:
+		return "Age"
+	case p.OccurrenceRange != nil:
+		return "Range"
+	case p.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (p *Procedure) ClearOccurrence() {
+	p.OccurrenceDateTime = nil
+	p.OccurrenceDateTimeExt = nil
+	p.OccurrencePeriod = nil
+	p.OccurrenceString = nil
+	p.OccurrenceStringExt = nil
+	p.OccurrenceAge = nil
+	p.OccurrenceRange = nil
+	p.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (p *Procedure) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := p.OccurrenceDateTimeExt
+	p.ClearOccurrence()
+	p.OccurrenceDateTime, p.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (p *Procedure) SetOccurrencePeriod(value Period) {
+	p.ClearOccurrence()
+	p.OccurrencePeriod = &value
+}
+
+// SetOccurrenceString sets the string option of occurrence[x], clearing the others.
+func (p *Procedure) SetOccurrenceString(value string) {
+	ext := p.OccurrenceStringExt
+	p.ClearOccurrence()
+	p.OccurrenceString, p.OccurrenceStringExt = &value, ext
+}
+
+// SetOccurrenceAge sets the Age option of occurrence[x], clearing the others.
+func (p *Procedure) SetOccurrenceAge(value Age) {
+	p.ClearOccurrence()
+	p.OccurrenceAge = &value
+}
+
+// SetOccurrenceRange sets the Range option of occurrence[x], clearing the others.
+func (p *Procedure) SetOccurrenceRange(value Range) {
+	p.ClearOccurrence()
+	p.OccurrenceRange = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (p *Procedure) SetOccurrenceTiming(value Timing) {
+	p.ClearOccurrence()
+	p.OccurrenceTiming = &value
+}
+
+// Reported returns the option of reported[x] that is set, or nil if none is.
+func (p *Procedure) Reported() any {
+	switch {
+	case p.ReportedBoolean != nil:
+		return p.ReportedBoolean
+	case p.ReportedReference != nil:
+		return p.ReportedReference
+	}
+	return nil
+}
+
+// ReportedType returns the FHIR type of the option of reported[x] that is
+// set, such as "boolean", or "" if none is.
+func (p *Procedure) ReportedType() string {
+	switch {
+	case p.ReportedBoolean != nil:
+		return "boolean"
+	case p.ReportedReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearReported unsets every option of reported[x], and their extensions.
+func (p *Procedure) ClearReported() {
+	p.ReportedBoolean = nil
+	p.ReportedBooleanExt = nil
+	p.ReportedReference = nil
+}
+
+// SetReportedBoolean sets the boolean option of reported[x], clearing the others.
+func (p *Procedure) SetReportedBoolean(value bool) {
+	ext := p.ReportedBooleanExt
+	p.ClearReported()
+	p.ReportedBoolean, p.ReportedBooleanExt = &value, ext
+}
+
+// SetReportedReference sets the Reference option of reported[x], clearing the others.
+func (p *Procedure) SetReportedReference(value Reference) {
+	p.ClearReported()
+	p.ReportedReference = &value
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -30,3 +30,46 @@ type ProductShelfLife struct {
 	// Special precautions for storage, if any, can be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
 	SpecialPrecautionsForStorage []CodeableConcept `json:"specialPrecautionsForStorage,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// Period returns the option of period[x] that is set, or nil if none is.
+func (p *ProductShelfLife) Period() any {
+	switch {
+	case p.PeriodDuration != nil:
+		return p.PeriodDuration
+	case p.PeriodString != nil:
+		return p.PeriodString
+	}
+	return nil
+}
+
+// PeriodType returns the FHIR type of the option of period[x] that is
+// set, such as "Duration", or "" if none is.
+func (p *ProductShelfLife) PeriodType() string {
+	switch {
+	case p.PeriodDuration != nil:
+		return "Duration"
+	case p.PeriodString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearPeriod unsets every option of period[x], and their extensions.
+func (p *ProductShelfLife) ClearPeriod() {
+	p.PeriodDuration = nil
+	p.PeriodString = nil
+	p.PeriodStringExt = nil
+}
+
+// SetPeriodDuration sets the Duration option of period[x], clearing the others.
+func (p *ProductShelfLife) SetPeriodDuration(value Duration) {
+	p.ClearPeriod()
+	p.PeriodDuration = &value
+}
+
+// SetPeriodString sets the string option of period[x], clearing the others.
+func (p *ProductShelfLife) SetPeriodString(value string) {
+	ext := p.PeriodStringExt
+	p.ClearPeriod()
+	p.PeriodString, p.PeriodStringExt = &value, ext
+}
@@ -0,0 +1,298 @@
+package bd
+
+import (
+	"fmt"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	bdvs "github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
+)
+
+const (
+	ProfileBDAddress = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address"
+
+	// Extension URLs carrying the BBS geocode of each administrative level (valueCoding)
+	ExtensionAddressDivision = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address-division"
+	ExtensionAddressDistrict = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address-district"
+	ExtensionAddressUpazila  = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address-upazila"
+	ExtensionAddressUnion    = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address-union"
+
+	// Extension URLs for free-text parts below the union (valueString)
+	ExtensionAddressWard    = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address-ward"
+	ExtensionAddressVillage = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-address-village"
+
+	// CountryBD is the ISO 3166 code written to Address.country
+	CountryBD = "BD"
+)
+
+// BDAddress represents a r5.Address localized for Bangladesh.
+//
+// Each administrative level is stored twice: its English name in the standard
+// field the IG assigns (state = division, district = district, city = upazila)
+// and its BBS geocode in a valueCoding extension. Union, ward and village only
+// exist as extensions.
+type BDAddress struct {
+	r5.Address
+}
+
+// NewBDAddress creates a new localized r5.Address with the country set to Bangladesh
+func NewBDAddress() *BDAddress {
+	country := CountryBD
+	return &BDAddress{Address: r5.Address{Country: &country}}
+}
+
+// AsBDAddress wraps a copy of an existing address. Setters on the result do
+// not affect the original.
+func AsBDAddress(addr r5.Address) *BDAddress {
+	addr.Extension = append([]r5.Extension(nil), addr.Extension...)
+	return &BDAddress{Address: addr}
+}
+
+// SetDivision sets the division by geocode, short code or name
+func (a *BDAddress) SetDivision(division string) error {
+	u, err := a.setLevel(bdvs.LevelDivision, "", division, ExtensionAddressDivision)
+	if err != nil {
+		return err
+	}
+	a.State = &u.Name
+	return nil
+}
+
+// SetDistrict sets the district by geocode or name. If a division is already
+// set the district is resolved within it.
+func (a *BDAddress) SetDistrict(district string) error {
+	u, err := a.setLevel(bdvs.LevelDistrict, a.codeOf(ExtensionAddressDivision), district, ExtensionAddressDistrict)
+	if err != nil {
+		return err
+	}
+	a.District = &u.Name
+	return nil
+}
+
+// SetUpazila sets the upazila by geocode or name. If a district is already
+// set the upazila is resolved within it.
+func (a *BDAddress) SetUpazila(upazila string) error {
+	u, err := a.setLevel(bdvs.LevelUpazila, a.codeOf(ExtensionAddressDistrict), upazila, ExtensionAddressUpazila)
+	if err != nil {
+		return err
+	}
+	a.City = &u.Name
+	return nil
+}
+
+// SetUnion sets the union parishad or municipal ward by geocode or name. If an
+// upazila is already set the union is resolved within it.
+func (a *BDAddress) SetUnion(union string) error {
+	_, err := a.setLevel(bdvs.LevelUnion, a.codeOf(ExtensionAddressUpazila), union, ExtensionAddressUnion)
+	return err
+}
+
+// SetWard sets the ward number within the union
+func (a *BDAddress) SetWard(ward string) {
+	a.setString(ExtensionAddressWard, ward)
+}
+
+// SetVillage sets the village or para name
+func (a *BDAddress) SetVillage(village string) {
+	a.setString(ExtensionAddressVillage, village)
+}
+
+// DivisionUnit returns the division recorded on the address
+func (a *BDAddress) DivisionUnit() (bdvs.GeoUnit, bool) {
+	return a.unit(ExtensionAddressDivision, bdvs.LevelDivision, a.State)
+}
+
+// DistrictUnit returns the district recorded on the address
+func (a *BDAddress) DistrictUnit() (bdvs.GeoUnit, bool) {
+	return a.unit(ExtensionAddressDistrict, bdvs.LevelDistrict, a.District)
+}
+
+// UpazilaUnit returns the upazila recorded on the address
+func (a *BDAddress) UpazilaUnit() (bdvs.GeoUnit, bool) {
+	return a.unit(ExtensionAddressUpazila, bdvs.LevelUpazila, a.City)
+}
+
+// UnionUnit returns the union or municipal ward recorded on the address
+func (a *BDAddress) UnionUnit() (bdvs.GeoUnit, bool) {
+	return a.unit(ExtensionAddressUnion, bdvs.LevelUnion, nil)
+}
+
+// Ward returns the ward number, or "" if not set
+func (a *BDAddress) Ward() string {
+	return a.stringOf(ExtensionAddressWard)
+}
+
+// Village returns the village name, or "" if not set
+func (a *BDAddress) Village() string {
+	return a.stringOf(ExtensionAddressVillage)
+}
+
+// Validate checks that every coded level exists, that each level lies inside
+// the level above it, and that the standard name fields agree with the codes.
+func (a *BDAddress) Validate() error {
+	division, _ := a.DivisionUnit()
+	district, _ := a.DistrictUnit()
+	upazila, _ := a.UpazilaUnit()
+	union, _ := a.UnionUnit()
+
+	levels := []struct {
+		ext   string
+		level bdvs.AdminLevel
+		unit  bdvs.GeoUnit
+	}{
+		{ExtensionAddressDivision, bdvs.LevelDivision, division},
+		{ExtensionAddressDistrict, bdvs.LevelDistrict, district},
+		{ExtensionAddressUpazila, bdvs.LevelUpazila, upazila},
+		{ExtensionAddressUnion, bdvs.LevelUnion, union},
+	}
+	for _, l := range levels {
+		if code := a.codeOf(l.ext); code != "" && l.unit.Code != code {
+			return fmt.Errorf("%w: %s %q", bdvs.ErrUnknownGeoCode, l.level, code)
+		}
+	}
+
+	if err := bdvs.ValidateGeoHierarchy(division.Code, district.Code, upazila.Code, union.Code); err != nil {
+		return err
+	}
+
+	names := []struct {
+		field string
+		level bdvs.AdminLevel
+		value *string
+		unit  bdvs.GeoUnit
+	}{
+		{"state", bdvs.LevelDivision, a.State, division},
+		{"district", bdvs.LevelDistrict, a.District, district},
+		{"city", bdvs.LevelUpazila, a.City, upazila},
+	}
+	for _, n := range names {
+		if n.value == nil || *n.value == "" {
+			continue
+		}
+		if n.unit.Code == "" {
+			// Divisions and districts are complete, so an unresolved name is
+			// wrong; city may hold a place the upazila data does not list yet.
+			if n.level == bdvs.LevelUpazila {
+				continue
+			}
+			if _, exists := bdvs.FindGeo(n.level, "", *n.value); exists {
+				return fmt.Errorf("%w: address.%s %q is not in %s",
+					bdvs.ErrGeoHierarchy, n.field, *n.value, division.Name)
+			}
+			return fmt.Errorf("%w: address.%s %q", bdvs.ErrUnknownGeoCode, n.field, *n.value)
+		}
+		if u, ok := bdvs.FindGeo(n.unit.Level, n.unit.ParentCode(), *n.value); !ok || u.Code != n.unit.Code {
+			return fmt.Errorf("%w: address.%s %q does not match %s code %s (%s)",
+				bdvs.ErrGeoHierarchy, n.field, *n.value, n.unit.Level, n.unit.Code, n.unit.Name)
+		}
+	}
+	return nil
+}
+
+func (a *BDAddress) setLevel(level bdvs.AdminLevel, parent, value, url string) (bdvs.GeoUnit, error) {
+	u, ok := bdvs.FindGeo(level, parent, value)
+	if !ok {
+		if parent != "" {
+			if _, exists := bdvs.FindGeo(level, "", value); exists {
+				p, _ := bdvs.LookupGeo(parent)
+				return bdvs.GeoUnit{}, fmt.Errorf("%w: %s %q is not in %s %s",
+					bdvs.ErrGeoHierarchy, level, value, p.Level, p.Name)
+			}
+		}
+		return bdvs.GeoUnit{}, fmt.Errorf("%w: %s %q", bdvs.ErrUnknownGeoCode, level, value)
+	}
+	ext := a.extension(url)
+	*ext = r5.Extension{URL: url, ValueCoding: u.Coding()}
+	return u, nil
+}
+
+func (a *BDAddress) setString(url, value string) {
+	if value == "" {
+		a.Extension = removeExtension(a.Extension, url)
+		return
+	}
+	ext := a.extension(url)
+	*ext = r5.Extension{URL: url, ValueString: &value}
+}
+
+// extension returns the extension with the given URL, appending one if absent
+func (a *BDAddress) extension(url string) *r5.Extension {
+	for i := range a.Extension {
+		if a.Extension[i].URL == url {
+			return &a.Extension[i]
+		}
+	}
+	a.Extension = append(a.Extension, r5.Extension{URL: url})
+	return &a.Extension[len(a.Extension)-1]
+}
+
+func (a *BDAddress) codeOf(url string) string {
+	for _, ext := range a.Extension {
+		if ext.URL == url && ext.ValueCoding != nil && ext.ValueCoding.Code != nil {
+			return *ext.ValueCoding.Code
+		}
+	}
+	return ""
+}
+
+func (a *BDAddress) stringOf(url string) string {
+	for _, ext := range a.Extension {
+		if ext.URL == url && ext.ValueString != nil {
+			return *ext.ValueString
+		}
+	}
+	return ""
+}
+
+// unit resolves a level from its extension code, falling back to the standard
+// name field for addresses that were written without extensions
+func (a *BDAddress) unit(url string, level bdvs.AdminLevel, name *string) (bdvs.GeoUnit, bool) {
+	if code := a.codeOf(url); code != "" {
+		u, ok := bdvs.LookupGeo(code)
+		if !ok || u.Level != level {
+			return bdvs.GeoUnit{}, false
+		}
+		return u, true
+	}
+	if name == nil || *name == "" {
+		return bdvs.GeoUnit{}, false
+	}
+	var parent bdvs.GeoUnit
+	switch level {
+	case bdvs.LevelDistrict:
+		parent, _ = a.DivisionUnit()
+	case bdvs.LevelUpazila:
+		parent, _ = a.DistrictUnit()
+	}
+	return bdvs.FindGeo(level, parent.Code, *name)
+}
+
+// isBDAddress reports whether an address carries any BD geography extension
+func isBDAddress(addr *r5.Address) bool {
+	for _, ext := range addr.Extension {
+		switch ext.URL {
+		case ExtensionAddressDivision, ExtensionAddressDistrict, ExtensionAddressUpazila,
+			ExtensionAddressUnion, ExtensionAddressWard, ExtensionAddressVillage:
+			return true
+		}
+	}
+	return false
+}
+
+// findBDAddress returns the index of the first BD address, or -1
+func findBDAddress(addrs []r5.Address) int {
+	for i := range addrs {
+		if isBDAddress(&addrs[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// setBDAddress replaces the first BD address in place, or appends one
+func setBDAddress(addrs []r5.Address, addr *BDAddress) []r5.Address {
+	if i := findBDAddress(addrs); i >= 0 {
+		addrs[i] = addr.Address
+		return addrs
+	}
+	return append(addrs, addr.Address)
+}
@@ -0,0 +1,159 @@
+package bd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	bdvs "github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
+)
+
+func TestBDAddress_Builder(t *testing.T) {
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetDivision("CTG"))
+	require.NoError(t, addr.SetDistrict("Cox's Bazar"))
+	require.NoError(t, addr.SetUpazila("Ukhiya"))
+	require.NoError(t, addr.SetUnion("Raja Palong"))
+	addr.SetWard("05")
+	addr.SetVillage("Kutupalong")
+
+	assert.Equal(t, "Chattogram", *addr.State)
+	assert.Equal(t, "Cox's Bazar", *addr.District)
+	assert.Equal(t, "Ukhiya", *addr.City)
+	assert.Equal(t, CountryBD, *addr.Country)
+
+	union, ok := addr.UnionUnit()
+	require.True(t, ok)
+	assert.Equal(t, "20229463", union.Code)
+	assert.Equal(t, "05", addr.Ward())
+	assert.Equal(t, "Kutupalong", addr.Village())
+	assert.NoError(t, addr.Validate())
+}
+
+func TestBDAddress_SettersAreIdempotent(t *testing.T) {
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetDivision("20"))
+	require.NoError(t, addr.SetDivision("30"))
+	addr.SetVillage("A")
+	addr.SetVillage("B")
+
+	assert.Len(t, addr.Extension, 2)
+	assert.Equal(t, "Dhaka", *addr.State)
+	assert.Equal(t, "B", addr.Village())
+
+	addr.SetVillage("")
+	assert.Len(t, addr.Extension, 1)
+}
+
+func TestBDAddress_RejectsDistrictOutsideDivision(t *testing.T) {
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetDivision("Dhaka"))
+
+	err := addr.SetDistrict("Cox's Bazar")
+	assert.ErrorIs(t, err, bdvs.ErrGeoHierarchy)
+
+	err = addr.SetDistrict("Nowhere")
+	assert.ErrorIs(t, err, bdvs.ErrUnknownGeoCode)
+}
+
+func TestBDAddress_Validate(t *testing.T) {
+	coded := func(url, code string) r5.Extension {
+		return r5.Extension{URL: url, ValueCoding: bdvs.GetGeoCoding(code)}
+	}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		addr    r5.Address
+		wantErr error
+	}{
+		{
+			name: "upazila outside district",
+			addr: r5.Address{Extension: []r5.Extension{
+				coded(ExtensionAddressDistrict, "3026"),
+				coded(ExtensionAddressUpazila, "202294"),
+			}},
+			wantErr: bdvs.ErrGeoHierarchy,
+		},
+		{
+			name: "name disagrees with code",
+			addr: r5.Address{
+				District:  str("Dhaka"),
+				Extension: []r5.Extension{coded(ExtensionAddressDistrict, "2022")},
+			},
+			wantErr: bdvs.ErrGeoHierarchy,
+		},
+		{
+			name: "unknown code",
+			addr: r5.Address{Extension: []r5.Extension{{
+				URL:         ExtensionAddressDivision,
+				ValueCoding: &r5.Coding{Code: str("99")},
+			}}},
+			wantErr: bdvs.ErrUnknownGeoCode,
+		},
+		{
+			name: "names only",
+			addr: r5.Address{State: str("Chattogram"), District: str("Cox's Bazar"), City: str("Teknaf")},
+		},
+		{
+			name:    "names only, inconsistent",
+			addr:    r5.Address{State: str("Sylhet"), District: str("Cox's Bazar")},
+			wantErr: bdvs.ErrGeoHierarchy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AsBDAddress(tt.addr).Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBDPatient_Address(t *testing.T) {
+	p := NewBDPatient()
+	_, ok := p.BDAddress()
+	assert.False(t, ok)
+
+	home := r5.AddressUseHome
+	p.Address = []r5.Address{{Use: &home, Text: str("abroad")}}
+
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetDistrict("2022"))
+	p.SetBDAddress(addr)
+	require.Len(t, p.Address, 2)
+
+	require.NoError(t, addr.SetUpazila("Teknaf"))
+	p.SetBDAddress(addr)
+	require.Len(t, p.Address, 2, "SetBDAddress replaces the existing BD address")
+
+	got, ok := p.BDAddress()
+	require.True(t, ok)
+	upazila, ok := got.UpazilaUnit()
+	require.True(t, ok)
+	assert.Equal(t, "202290", upazila.Code)
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), ExtensionAddressUpazila)
+}
+
+func TestRohingyaPatient_Address(t *testing.T) {
+	p := NewRohingyaPatient()
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetUpazila("Ukhiya"))
+	p.SetBDAddress(addr)
+
+	got, ok := p.BDAddress()
+	require.True(t, ok)
+	assert.Equal(t, "Ukhiya", *got.City)
+}
+
+func str(s string) *string { return &s }
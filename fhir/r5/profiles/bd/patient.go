@@ -1,15 +1,24 @@
 package bd
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	ids "github.com/zs-health/zh-fhir-go/fhir/r5/identifiers/bd"
 )
 
 const (
 	ProfileBDPatient = "https://health.zarishsphere.com/fhir/StructureDefinition/bd-patient"
-	ExtensionNID     = "http://dghs.gov.bd/identifier/nid"
-	ExtensionBRN     = "http://dghs.gov.bd/identifier/brn"
-	ExtensionUHID    = "http://dghs.gov.bd/identifier/uhid"
+
+	// Identifier systems issued by DGHS
+	SystemNID  = ids.SystemNID
+	SystemBRN  = ids.SystemBRN
+	SystemUHID = ids.SystemUHID
+
+	// Deprecated: these are identifier systems, not extensions. Use SystemNID,
+	// SystemBRN and SystemUHID.
+	ExtensionNID  = SystemNID
+	ExtensionBRN  = SystemBRN
+	ExtensionUHID = SystemUHID
 )
 
 // BDPatient represents a r5.Patient resource localized for Bangladesh
@@ -20,25 +29,31 @@ type BDPatient struct {
 // NewBDPatient creates a new localized r5.Patient
 func NewBDPatient() *BDPatient {
 	p := &BDPatient{}
-	profile := ProfileBDPatient
 	if p.Meta == nil {
-		p.Meta = &fhir.Meta{}
+		p.Meta = &r5.Meta{}
 	}
-	p.Meta.Profile = []string{profile}
+	p.Meta.Profile = []primitives.Canonical{ProfileBDPatient}
 	return p
 }
 
-// AddIdentifier adds a DGHS standard identifier
-func (p *BDPatient) AddIdentifier(system, value string) {
+// AddIdentifier adds a DGHS standard identifier. Legacy system URLs are
+// rewritten to their canonical form, and NID, BRN and UHID values are checked
+// against their formats; a malformed value is not added.
+func (p *BDPatient) AddIdentifier(system, value string) error {
+	system = ids.CanonicalSystem(system)
+	if err := ids.Validate(system, value); err != nil {
+		return err
+	}
 	p.Identifier = append(p.Identifier, r5.Identifier{
 		System: &system,
 		Value:  &value,
 	})
+	return nil
 }
 
 // SetNames sets both English and Bangla names as per DGHS requirements
 func (p *BDPatient) SetNames(englishName, banglaName string) {
-	official := "official"
+	official := r5.NameUseOfficial
 	p.Name = []r5.HumanName{
 		{
 			Use:  &official,
@@ -46,3 +61,16 @@ func (p *BDPatient) SetNames(englishName, banglaName string) {
 		},
 	}
 }
+
+// BDAddress returns the patient's Bangladesh address, if one is recorded
+func (p *BDPatient) BDAddress() (*BDAddress, bool) {
+	if i := findBDAddress(p.Address); i >= 0 {
+		return AsBDAddress(p.Address[i]), true
+	}
+	return nil, false
+}
+
+// SetBDAddress replaces the patient's Bangladesh address, or adds it if none is recorded
+func (p *BDPatient) SetBDAddress(addr *BDAddress) {
+	p.Address = setBDAddress(p.Address, addr)
+}
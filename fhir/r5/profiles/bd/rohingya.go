@@ -1,8 +1,13 @@
 package bd
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	bdvs "github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
 )
 
 const (
@@ -14,13 +19,27 @@ const (
 	ExtensionProgressID = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-progress-id"
 	ExtensionMRN        = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-mrn"
 
-	// Extension URLs for Location/Shelter
+	// ExtensionShelterLocation is the complex extension on Patient.address that
+	// holds the camp, block, sub-block and shelter as sub-extensions.
+	ExtensionShelterLocation = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-shelter-location"
+
+	// Sub-extension URLs of ExtensionShelterLocation
+	ShelterPartCamp     = "camp"
+	ShelterPartBlock    = "block"
+	ShelterPartSubBlock = "subBlock"
+	ShelterPartShelter  = "shelter"
+
+	// Deprecated: flat Patient extensions written by earlier versions. They are
+	// still read and are migrated into ExtensionShelterLocation by the setters.
 	ExtensionShelterNumber = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-shelter"
 	ExtensionCamp          = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-camp"
 	ExtensionBlock         = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-block"
 	ExtensionSubBlock      = "https://health.zarishsphere.com/fhir/StructureDefinition/rohingya-sub-block"
 )
 
+// ErrUnknownCamp is returned when a camp is not in bd.RohingyaCamps.
+var ErrUnknownCamp = errors.New("unknown Rohingya camp")
+
 // RohingyaPatient represents a r5.Patient resource localized for the Rohingya Response
 type RohingyaPatient struct {
 	r5.Patient
@@ -29,52 +48,285 @@ type RohingyaPatient struct {
 // NewRohingyaPatient creates a new localized r5.Patient for the Rohingya response
 func NewRohingyaPatient() *RohingyaPatient {
 	p := &RohingyaPatient{}
-	profile := ProfileRohingyaPatient
 	if p.Meta == nil {
-		p.Meta = &fhir.Meta{}
+		p.Meta = &r5.Meta{}
 	}
-	p.Meta.Profile = []string{profile}
+	p.Meta.Profile = []primitives.Canonical{ProfileRohingyaPatient}
 	return p
 }
 
-// AddRohingyaIdentifiers adds FCN, Progress ID, and MRN to the patient
+// FromPatient wraps a copy of a generic r5.Patient as a RohingyaPatient. The
+// Rohingya profile is added to meta.profile if missing, shelter data written
+// as legacy flat extensions is moved into the Address complex extension, and
+// the camp is checked against bd.RohingyaCamps.
+func FromPatient(patient *r5.Patient) (*RohingyaPatient, error) {
+	if patient == nil {
+		return nil, fmt.Errorf("nil patient")
+	}
+	p := &RohingyaPatient{Patient: *patient}
+	if p.Meta == nil {
+		p.Meta = &r5.Meta{}
+	} else {
+		meta := *p.Meta
+		p.Meta = &meta
+	}
+	p.Meta.Profile = append([]primitives.Canonical(nil), p.Meta.Profile...)
+	if !slices.Contains(p.Meta.Profile, ProfileRohingyaPatient) {
+		p.Meta.Profile = append(p.Meta.Profile, ProfileRohingyaPatient)
+	}
+	p.Address = append([]r5.Address(nil), p.Address...)
+
+	if camp, block, subBlock, shelter, ok := p.legacyShelter(); ok {
+		if p.shelterPart(ShelterPartCamp) == nil && p.shelterPart(ShelterPartShelter) == nil {
+			if err := p.SetShelterLocation(camp, block, subBlock, shelter); err != nil {
+				return nil, err
+			}
+		}
+		p.removeLegacyShelter()
+	}
+
+	if camp := p.Camp(); camp != "" {
+		if _, ok := bdvs.FindCamp(camp); !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownCamp, camp)
+		}
+	}
+	return p, nil
+}
+
+// AddRohingyaIdentifiers sets FCN, Progress ID, and MRN on the patient. Calling
+// it again replaces the previous values; an empty argument removes that value.
 func (p *RohingyaPatient) AddRohingyaIdentifiers(fcn, progressID, mrn string) {
-	// Add FCN
-	urlFCN := ExtensionFCN
-	p.Extension = append(p.Extension, fhir.Extension{
-		URL:         urlFCN,
-		ValueString: &fcn,
-	})
-
-	// Add Progress ID
-	urlPID := ExtensionProgressID
-	p.Extension = append(p.Extension, fhir.Extension{
-		URL:         urlPID,
-		ValueString: &progressID,
-	})
-
-	// Add MRN
-	urlMRN := ExtensionMRN
-	p.Extension = append(p.Extension, fhir.Extension{
-		URL:         urlMRN,
-		ValueString: &mrn,
-	})
-}
-
-// SetShelterLocation sets the detailed camp and shelter information
-func (p *RohingyaPatient) SetShelterLocation(camp, block, subBlock, shelter string) {
-	// We add these as extensions to the r5.Address or directly to the r5.Patient
-	// For simplicity and direct access, we add them to the r5.Patient extensions
-
-	urlCamp := ExtensionCamp
-	p.Extension = append(p.Extension, fhir.Extension{URL: urlCamp, ValueString: &camp})
-
-	urlBlock := ExtensionBlock
-	p.Extension = append(p.Extension, fhir.Extension{URL: urlBlock, ValueString: &block})
-
-	urlSubBlock := ExtensionSubBlock
-	p.Extension = append(p.Extension, fhir.Extension{URL: urlSubBlock, ValueString: &subBlock})
-
-	urlShelter := ExtensionShelterNumber
-	p.Extension = append(p.Extension, fhir.Extension{URL: urlShelter, ValueString: &shelter})
+	p.SetFCN(fcn)
+	p.SetProgressID(progressID)
+	p.SetMRN(mrn)
+}
+
+// SetFCN sets the Family Counting Number
+func (p *RohingyaPatient) SetFCN(fcn string) {
+	p.setString(ExtensionFCN, fcn)
+}
+
+// SetProgressID sets the UNHCR proGres ID
+func (p *RohingyaPatient) SetProgressID(progressID string) {
+	p.setString(ExtensionProgressID, progressID)
+}
+
+// SetMRN sets the medical record number
+func (p *RohingyaPatient) SetMRN(mrn string) {
+	p.setString(ExtensionMRN, mrn)
+}
+
+// FCN returns the Family Counting Number, or "" if not set
+func (p *RohingyaPatient) FCN() string {
+	return p.stringOf(ExtensionFCN)
+}
+
+// ProgressID returns the UNHCR proGres ID, or "" if not set
+func (p *RohingyaPatient) ProgressID() string {
+	return p.stringOf(ExtensionProgressID)
+}
+
+// MRN returns the medical record number, or "" if not set
+func (p *RohingyaPatient) MRN() string {
+	return p.stringOf(ExtensionMRN)
+}
+
+// SetShelterLocation sets the detailed camp and shelter information as a
+// complex extension on the patient's address. The camp may be given by code
+// ("C1E") or name ("Camp 1E") and must be listed in bd.RohingyaCamps. Calling
+// it again replaces the previous location.
+func (p *RohingyaPatient) SetShelterLocation(camp, block, subBlock, shelter string) error {
+	var parts []r5.Extension
+	if camp != "" {
+		code, ok := bdvs.FindCamp(camp)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownCamp, camp)
+		}
+		parts = append(parts, r5.Extension{URL: ShelterPartCamp, ValueCoding: bdvs.GetCampCoding(code)})
+	}
+	for _, part := range []struct{ url, value string }{
+		{ShelterPartBlock, block},
+		{ShelterPartSubBlock, subBlock},
+		{ShelterPartShelter, shelter},
+	} {
+		if part.value != "" {
+			value := part.value
+			parts = append(parts, r5.Extension{URL: part.url, ValueString: &value})
+		}
+	}
+
+	p.removeLegacyShelter()
+
+	i := p.shelterAddress()
+	if i < 0 {
+		if len(parts) == 0 {
+			return nil
+		}
+		country := CountryBD
+		p.Address = append(p.Address, r5.Address{Country: &country})
+		i = len(p.Address) - 1
+	}
+
+	addr := &p.Address[i]
+	addr.Extension = removeExtension(addr.Extension, ExtensionShelterLocation)
+	if len(parts) > 0 {
+		addr.Extension = append(addr.Extension, r5.Extension{URL: ExtensionShelterLocation, Extension: parts})
+	}
+	return nil
+}
+
+// Camp returns the camp code, or "" if not set
+func (p *RohingyaPatient) Camp() string {
+	if ext := p.shelterPart(ShelterPartCamp); ext != nil && ext.ValueCoding != nil && ext.ValueCoding.Code != nil {
+		return *ext.ValueCoding.Code
+	}
+	if v := p.stringOf(ExtensionCamp); v != "" {
+		if code, ok := bdvs.FindCamp(v); ok {
+			return code
+		}
+		return v
+	}
+	return ""
+}
+
+// CampCoding returns the camp as an r5.Coding, or nil if not set or unknown
+func (p *RohingyaPatient) CampCoding() *r5.Coding {
+	return bdvs.GetCampCoding(p.Camp())
+}
+
+// Block returns the camp block, or "" if not set
+func (p *RohingyaPatient) Block() string {
+	return p.shelterString(ShelterPartBlock, ExtensionBlock)
+}
+
+// SubBlock returns the camp sub-block, or "" if not set
+func (p *RohingyaPatient) SubBlock() string {
+	return p.shelterString(ShelterPartSubBlock, ExtensionSubBlock)
+}
+
+// Shelter returns the shelter number, or "" if not set
+func (p *RohingyaPatient) Shelter() string {
+	return p.shelterString(ShelterPartShelter, ExtensionShelterNumber)
+}
+
+// BDAddress returns the patient's Bangladesh address, if one is recorded
+func (p *RohingyaPatient) BDAddress() (*BDAddress, bool) {
+	if i := findBDAddress(p.Address); i >= 0 {
+		return AsBDAddress(p.Address[i]), true
+	}
+	return nil, false
+}
+
+// SetBDAddress replaces the patient's Bangladesh address, or adds it if none is
+// recorded. An address holding only the shelter location, as added by
+// SetShelterLocation, is replaced too, and the shelter location is kept.
+func (p *RohingyaPatient) SetBDAddress(addr *BDAddress) {
+	i := p.shelterAddress()
+	if i < 0 {
+		p.Address = append(p.Address, addr.Address)
+		return
+	}
+	a := addr.Address
+	if !hasExtension(a.Extension, ExtensionShelterLocation) {
+		// Clone so the caller's extensions are left as they were
+		a.Extension = slices.Clone(a.Extension)
+		for _, ext := range p.Address[i].Extension {
+			if ext.URL == ExtensionShelterLocation {
+				a.Extension = append(a.Extension, ext)
+			}
+		}
+	}
+	p.Address[i] = a
+}
+
+func (p *RohingyaPatient) setString(url, value string) {
+	kept := make([]r5.Extension, 0, len(p.Extension)+1)
+	for _, ext := range p.Extension {
+		if ext.URL != url {
+			kept = append(kept, ext)
+		}
+	}
+	p.Extension = kept
+	if value != "" {
+		p.Extension = append(p.Extension, r5.Extension{URL: url, ValueString: &value})
+	}
+}
+
+func (p *RohingyaPatient) stringOf(url string) string {
+	for _, ext := range p.Extension {
+		if ext.URL == url && ext.ValueString != nil {
+			return *ext.ValueString
+		}
+	}
+	return ""
+}
+
+// shelterAddress returns the index of the address holding the shelter
+// location, else of the BD address, else -1
+func (p *RohingyaPatient) shelterAddress() int {
+	for i := range p.Address {
+		if hasExtension(p.Address[i].Extension, ExtensionShelterLocation) {
+			return i
+		}
+	}
+	return findBDAddress(p.Address)
+}
+
+func (p *RohingyaPatient) shelterPart(url string) *r5.Extension {
+	i := p.shelterAddress()
+	if i < 0 {
+		return nil
+	}
+	for _, ext := range p.Address[i].Extension {
+		if ext.URL != ExtensionShelterLocation {
+			continue
+		}
+		for j := range ext.Extension {
+			if ext.Extension[j].URL == url {
+				return &ext.Extension[j]
+			}
+		}
+	}
+	return nil
+}
+
+// shelterString reads a sub-extension, falling back to the legacy flat extension
+func (p *RohingyaPatient) shelterString(part, legacyURL string) string {
+	if ext := p.shelterPart(part); ext != nil && ext.ValueString != nil {
+		return *ext.ValueString
+	}
+	return p.stringOf(legacyURL)
+}
+
+func (p *RohingyaPatient) legacyShelter() (camp, block, subBlock, shelter string, ok bool) {
+	camp = p.stringOf(ExtensionCamp)
+	block = p.stringOf(ExtensionBlock)
+	subBlock = p.stringOf(ExtensionSubBlock)
+	shelter = p.stringOf(ExtensionShelterNumber)
+	return camp, block, subBlock, shelter, camp != "" || block != "" || subBlock != "" || shelter != ""
+}
+
+func (p *RohingyaPatient) removeLegacyShelter() {
+	for _, url := range []string{ExtensionCamp, ExtensionBlock, ExtensionSubBlock, ExtensionShelterNumber} {
+		p.setString(url, "")
+	}
+}
+
+func hasExtension(exts []r5.Extension, url string) bool {
+	for _, ext := range exts {
+		if ext.URL == url {
+			return true
+		}
+	}
+	return false
+}
+
+func removeExtension(exts []r5.Extension, url string) []r5.Extension {
+	kept := make([]r5.Extension, 0, len(exts))
+	for _, ext := range exts {
+		if ext.URL != url {
+			kept = append(kept, ext)
+		}
+	}
+	return kept
 }
@@ -0,0 +1,147 @@
+package bd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	"github.com/zs-health/zh-fhir-go/fhir/validation"
+)
+
+func TestRohingyaPatient_IdentifiersAreIdempotent(t *testing.T) {
+	p := NewRohingyaPatient()
+	p.AddRohingyaIdentifiers("FCN-1", "PID-1", "MRN-1")
+	p.AddRohingyaIdentifiers("FCN-2", "PID-2", "MRN-2")
+
+	assert.Len(t, p.Extension, 3)
+	assert.Equal(t, "FCN-2", p.FCN())
+	assert.Equal(t, "PID-2", p.ProgressID())
+	assert.Equal(t, "MRN-2", p.MRN())
+
+	p.SetMRN("")
+	assert.Len(t, p.Extension, 2)
+	assert.Empty(t, p.MRN())
+}
+
+func TestRohingyaPatient_ShelterLocation(t *testing.T) {
+	p := NewRohingyaPatient()
+	require.NoError(t, p.SetShelterLocation("Camp 1E", "B1", "B1-S3", "S-1234"))
+	require.NoError(t, p.SetShelterLocation("C2W", "C2", "", "S-99"))
+
+	require.Len(t, p.Address, 1)
+	require.Len(t, p.Address[0].Extension, 1, "setter replaces the complex extension")
+	assert.Equal(t, ExtensionShelterLocation, p.Address[0].Extension[0].URL)
+
+	assert.Equal(t, "C2W", p.Camp())
+	assert.Equal(t, "Camp 2W", *p.CampCoding().Display)
+	assert.Equal(t, "C2", p.Block())
+	assert.Empty(t, p.SubBlock())
+	assert.Equal(t, "S-99", p.Shelter())
+	assert.Empty(t, p.Extension, "shelter data is not stored on the patient")
+}
+
+func TestRohingyaPatient_ShelterLocationRejectsUnknownCamp(t *testing.T) {
+	p := NewRohingyaPatient()
+	err := p.SetShelterLocation("Camp 99", "B1", "", "")
+	assert.ErrorIs(t, err, ErrUnknownCamp)
+	assert.Empty(t, p.Address)
+}
+
+func TestRohingyaPatient_ShelterSharesBDAddress(t *testing.T) {
+	p := NewRohingyaPatient()
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetUpazila("Ukhiya"))
+	p.SetBDAddress(addr)
+	require.NoError(t, p.SetShelterLocation("KTP", "A", "", "12"))
+	require.Len(t, p.Address, 1)
+
+	// Replacing the BD address keeps the shelter location
+	addr = NewBDAddress()
+	require.NoError(t, addr.SetUpazila("Teknaf"))
+	before := len(addr.Extension)
+	p.SetBDAddress(addr)
+	require.Len(t, p.Address, 1)
+	assert.Equal(t, "KTP", p.Camp())
+	assert.Equal(t, "Teknaf", *p.Address[0].City)
+	assert.Len(t, addr.Extension, before, "the caller's address is not modified")
+}
+
+func TestRohingyaPatient_BDAddressAfterShelterLocation(t *testing.T) {
+	p := NewRohingyaPatient()
+	require.NoError(t, p.SetShelterLocation("C1E", "B", "", "7"))
+	require.Len(t, p.Address, 1)
+
+	// The address added for the shelter becomes the BD address
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetUpazila("Ukhiya"))
+	p.SetBDAddress(addr)
+	require.Len(t, p.Address, 1)
+	assert.Equal(t, "C1E", p.Camp())
+	assert.Equal(t, "7", p.Shelter())
+
+	got, ok := p.BDAddress()
+	require.True(t, ok)
+	assert.Equal(t, "Ukhiya", *got.City)
+}
+
+func TestFromPatient(t *testing.T) {
+	legacy := func(url, v string) r5.Extension { return r5.Extension{URL: url, ValueString: &v} }
+	src := &r5.Patient{
+		DomainResource: r5.DomainResource{
+			BaseResource: r5.BaseResource{ResourceType: "Patient"},
+			Extension: []r5.Extension{
+				legacy(ExtensionFCN, "FCN-9"),
+				legacy(ExtensionCamp, "Camp 1W"),
+				legacy(ExtensionBlock, "D"),
+				legacy(ExtensionShelterNumber, "77"),
+			},
+		},
+	}
+
+	p, err := FromPatient(src)
+	require.NoError(t, err)
+	assert.Contains(t, p.Meta.Profile, primitives.Canonical(ProfileRohingyaPatient))
+	assert.Equal(t, "FCN-9", p.FCN())
+	assert.Equal(t, "C1W", p.Camp())
+	assert.Equal(t, "D", p.Block())
+	assert.Equal(t, "77", p.Shelter())
+	assert.Len(t, p.Extension, 1, "legacy shelter extensions are migrated to the address")
+
+	assert.Nil(t, src.Meta, "source patient is not modified")
+	assert.Len(t, src.Extension, 4)
+	assert.Empty(t, src.Address)
+
+	// Round trip through JSON keeps the values readable
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	var back r5.Patient
+	require.NoError(t, json.Unmarshal(data, &back))
+	again, err := FromPatient(&back)
+	require.NoError(t, err)
+	assert.Equal(t, "C1W", again.Camp())
+	assert.Equal(t, "77", again.Shelter())
+}
+
+func TestFromPatient_UnknownCamp(t *testing.T) {
+	v := "Camp 404"
+	_, err := FromPatient(&r5.Patient{DomainResource: r5.DomainResource{
+		Extension: []r5.Extension{{URL: ExtensionCamp, ValueString: &v}},
+	}})
+	assert.ErrorIs(t, err, ErrUnknownCamp)
+}
+
+func TestValidationRules_Camp(t *testing.T) {
+	fv := validation.NewFHIRValidator()
+
+	p := NewRohingyaPatient()
+	require.NoError(t, p.SetShelterLocation("C3", "", "", ""))
+	assert.NoError(t, fv.Validate(p))
+
+	code := "C404"
+	p.Address[0].Extension[0].Extension[0].ValueCoding.Code = &code
+	assert.Error(t, fv.Validate(p))
+}
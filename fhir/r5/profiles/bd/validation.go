@@ -0,0 +1,83 @@
+package bd
+
+import (
+	"fmt"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	ids "github.com/zs-health/zh-fhir-go/fhir/r5/identifiers/bd"
+	bdvs "github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
+	"github.com/zs-health/zh-fhir-go/fhir/validation"
+)
+
+func init() {
+	validation.RegisterDefaultProfileRule(ProfileBDPatient, validatePatient)
+	validation.RegisterDefaultProfileRule(ProfileRohingyaPatient, validatePatient)
+	validation.RegisterDefaultProfileRule(ProfileRohingyaPatient, validateRohingyaPatient)
+}
+
+// RegisterValidationRules used to add the Bangladesh profile rules to a
+// validator. Every validator from validation.NewFHIRValidator now has them
+// once this package is imported: a Patient claiming the BD or Rohingya
+// profile fails validation when an identifier is malformed or a BD address
+// breaks the geography hierarchy.
+//
+// Deprecated: the rules are registered by default; this does nothing.
+func RegisterValidationRules(fv *validation.FHIRValidator) {}
+
+func validatePatient(resource any, errs *validation.Errors) {
+	p := patientOf(resource)
+	if p == nil {
+		return
+	}
+
+	for i, id := range p.Identifier {
+		if id.System == nil || id.Value == nil || !ids.IsKnownSystem(*id.System) {
+			continue
+		}
+		if err := ids.Validate(*id.System, *id.Value); err != nil {
+			errs.Add(fmt.Sprintf("Identifier[%d].Value", i), err.Error())
+		}
+	}
+
+	for i := range p.Address {
+		if !isBDAddress(&p.Address[i]) {
+			continue
+		}
+		if err := AsBDAddress(p.Address[i]).Validate(); err != nil {
+			errs.Add(fmt.Sprintf("Address[%d]", i), err.Error())
+		}
+	}
+}
+
+func validateRohingyaPatient(resource any, errs *validation.Errors) {
+	p := patientOf(resource)
+	if p == nil {
+		return
+	}
+	rp := &RohingyaPatient{Patient: *p}
+	if camp := rp.Camp(); camp != "" {
+		if _, ok := bdvs.FindCamp(camp); !ok {
+			errs.Addf("Address", "%v: %q", ErrUnknownCamp, camp)
+		}
+	}
+}
+
+// patientOf returns the underlying r5.Patient of the supported patient types
+func patientOf(resource any) *r5.Patient {
+	switch p := resource.(type) {
+	case *r5.Patient:
+		return p
+	case r5.Patient:
+		return &p
+	case *BDPatient:
+		return &p.Patient
+	case BDPatient:
+		return &p.Patient
+	case *RohingyaPatient:
+		return &p.Patient
+	case RohingyaPatient:
+		return &p.Patient
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package bd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	ids "github.com/zs-health/zh-fhir-go/fhir/r5/identifiers/bd"
+	"github.com/zs-health/zh-fhir-go/fhir/validation"
+)
+
+func TestBDPatient_AddIdentifier(t *testing.T) {
+	p := NewBDPatient()
+	require.NoError(t, p.AddIdentifier("http://zdhhs.gov.bd/identifier/nid", "1234567890"))
+	assert.Equal(t, SystemNID, *p.Identifier[0].System, "legacy system is canonicalized")
+
+	err := p.AddIdentifier(SystemBRN, "12345")
+	assert.ErrorIs(t, err, ids.ErrLength)
+	assert.Len(t, p.Identifier, 1, "malformed identifier is not added")
+
+	require.NoError(t, p.AddIdentifier("http://example.org/mrn", "free-form"))
+}
+
+func TestValidationRules(t *testing.T) {
+	// The rules apply to a plain validator
+	fv := validation.NewFHIRValidator()
+
+	newPatient := func(profile string, nid string) *r5.Patient {
+		system := SystemNID
+		return &r5.Patient{
+			DomainResource: r5.DomainResource{BaseResource: r5.BaseResource{
+				ResourceType: "Patient",
+				Meta:         &r5.Meta{Profile: []primitives.Canonical{primitives.Canonical(profile)}},
+			}},
+			Identifier: []r5.Identifier{{System: &system, Value: &nid}},
+		}
+	}
+
+	assert.NoError(t, fv.Validate(newPatient(ProfileBDPatient, "1234567890")))
+
+	err := fv.Validate(newPatient(ProfileBDPatient, "12AB"))
+	require.Error(t, err)
+	var errs *validation.Errors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs.List(), 1)
+	assert.Equal(t, "Identifier[0].Value", errs.List()[0].Field)
+
+	assert.Error(t, fv.Validate(newPatient(ProfileBDPatient+"|1.0.0", "12AB")), "versioned profile claims are matched")
+	assert.Error(t, fv.Validate(newPatient(ProfileRohingyaPatient, "12AB")))
+	assert.NoError(t, fv.Validate(newPatient("http://example.org/other", "12AB")), "rules only run for claimed profiles")
+
+	// The deprecated registration doesn't add the rules twice
+	RegisterValidationRules(fv)
+	err = fv.Validate(newPatient(ProfileBDPatient, "12AB"))
+	require.True(t, errors.As(err, &errs))
+	assert.Len(t, errs.List(), 1)
+}
+
+func TestValidationRules_Address(t *testing.T) {
+	fv := validation.NewFHIRValidator()
+
+	p := NewBDPatient()
+	addr := NewBDAddress()
+	require.NoError(t, addr.SetDistrict("3026"))
+	addr.Extension = append(addr.Extension, r5.Extension{
+		URL:         ExtensionAddressUpazila,
+		ValueCoding: &r5.Coding{Code: str("202294")},
+	})
+	p.SetBDAddress(addr)
+
+	err := fv.Validate(p)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Address[0]")
+}
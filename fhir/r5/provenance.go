@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -59,7 +58,7 @@ type ProvenanceEntity struct {
 
 // Provenance represents a FHIR Provenance.
 type Provenance struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Target Reference(s) (usually version specific)
@@ -97,3 +96,51 @@ type Provenance struct {
 	// Signature on target
 	Signature []Signature `json:"signature,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Provenance", the FHIR type of the resource.
+func (p *Provenance) ResourceType() string {
+	return ResourceTypeProvenance
+}
+
+// Occurred returns the option of occurred[x] that is set, or nil if none is.
+func (p *Provenance) Occurred() any {
+	switch {
+	case p.OccurredPeriod != nil:
+		return p.OccurredPeriod
+	case p.OccurredDateTime != nil:
+		return p.OccurredDateTime
+	}
+	return nil
+}
+
+// OccurredType returns the FHIR type of the option of occurred[x] that is
+// set, such as "Period", or "" if none is.
+func (p *Provenance) OccurredType() string {
+	switch {
+	case p.OccurredPeriod != nil:
+		return "Period"
+	case p.OccurredDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearOccurred unsets every option of occurred[x], and their extensions.
+func (p *Provenance) ClearOccurred() {
+	p.OccurredPeriod = nil
+	p.OccurredDateTime = nil
+	p.OccurredDateTimeExt = nil
+}
+
+// SetOccurredPeriod sets the Period option of occurred[x], clearing the others.
+func (p *Provenance) SetOccurredPeriod(value Period) {
+	p.ClearOccurred()
+	p.OccurredPeriod = &value
+}
+
+// SetOccurredDateTime sets the dateTime option of occurred[x], clearing the others.
+func (p *Provenance) SetOccurredDateTime(value primitives.DateTime) {
+	ext := p.OccurredDateTimeExt
+	p.ClearOccurred()
+	p.OccurredDateTime, p.OccurredDateTimeExt = &value, ext
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -28,49 +27,216 @@ type QuestionnaireResponseItemAnswer struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Single-valued answer to the question - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - uri option
-	ValueURI string `json:"valueURI" fhir:"cardinality=1..1,required,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Single-valued answer to the question - Attachment option
-	ValueAttachment Attachment `json:"valueAttachment" fhir:"cardinality=1..1,required,choice=value"`
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Single-valued answer to the question - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Single-valued answer to the question - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Single-valued answer to the question - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Child items of question
 	Item []QuestionnaireResponseItemAnswerItem `json:"item,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (q *QuestionnaireResponseItemAnswer) Value() any {
+	switch {
+	case q.ValueBoolean != nil:
+		return q.ValueBoolean
+	case q.ValueDecimal != nil:
+		return q.ValueDecimal
+	case q.ValueInteger != nil:
+		return q.ValueInteger
+	case q.ValueDate != nil:
+		return q.ValueDate
+	case q.ValueDateTime != nil:
+		return q.ValueDateTime
+	case q.ValueTime != nil:
+		return q.ValueTime
+	case q.ValueString != nil:
+		return q.ValueString
+	case q.ValueURI != nil:
+		return q.ValueURI
+	case q.ValueAttachment != nil:
+		return q.ValueAttachment
+	case q.ValueCoding != nil:
+		return q.ValueCoding
+	case q.ValueQuantity != nil:
+		return q.ValueQuantity
+	case q.ValueReference != nil:
+		return q.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "boolean", or "" if none is.
+func (q *QuestionnaireResponseItemAnswer) ValueType() string {
+	switch {
+	case q.ValueBoolean != nil:
+		return "boolean"
+	case q.ValueDecimal != nil:
+		return "decimal"
+	case q.ValueInteger != nil:
+		return "integer"
+	case q.ValueDate != nil:
+		return "date"
+	case q.ValueDateTime != nil:
+		return "dateTime"
+	case q.ValueTime != nil:
+		return "time"
+	case q.ValueString != nil:
+		return "string"
+	case q.ValueURI != nil:
+		return "uri"
+	case q.ValueAttachment != nil:
+		return "Attachment"
+	case q.ValueCoding != nil:
+		return "Coding"
+	case q.ValueQuantity != nil:
+		return "Quantity"
+	case q.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (q *QuestionnaireResponseItemAnswer) ClearValue() {
+	q.ValueBoolean = nil
+	q.ValueBooleanExt = nil
+	q.ValueDecimal = nil
+	q.ValueDecimalExt = nil
+	q.ValueInteger = nil
+	q.ValueIntegerExt = nil
+	q.ValueDate = nil
+	q.ValueDateExt = nil
+	q.ValueDateTime = nil
+	q.ValueDateTimeExt = nil
+	q.ValueTime = nil
+	q.ValueTimeExt = nil
+	q.ValueString = nil
+	q.ValueStringExt = nil
+	q.ValueURI = nil
+	q.ValueURIExt = nil
+	q.ValueAttachment = nil
+	q.ValueCoding = nil
+	q.ValueQuantity = nil
+	q.ValueReference = nil
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueBoolean(value bool) {
+	ext := q.ValueBooleanExt
+	q.ClearValue()
+	q.ValueBoolean, q.ValueBooleanExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueDecimal(value primitives.Decimal) {
+	ext := q.ValueDecimalExt
+	q.ClearValue()
+	q.ValueDecimal, q.ValueDecimalExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueInteger(value int) {
+	ext := q.ValueIntegerExt
+	q.ClearValue()
+	q.ValueInteger, q.ValueIntegerExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueDate(value primitives.Date) {
+	ext := q.ValueDateExt
+	q.ClearValue()
+	q.ValueDate, q.ValueDateExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueDateTime(value primitives.DateTime) {
+	ext := q.ValueDateTimeExt
+	q.ClearValue()
+	q.ValueDateTime, q.ValueDateTimeExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueTime(value primitives.Time) {
+	ext := q.ValueTimeExt
+	q.ClearValue()
+	q.ValueTime, q.ValueTimeExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueString(value string) {
+	ext := q.ValueStringExt
+	q.ClearValue()
+	q.ValueString, q.ValueStringExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueURI(value string) {
+	ext := q.ValueURIExt
+	q.ClearValue()
+	q.ValueURI, q.ValueURIExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueAttachment(value Attachment) {
+	q.ClearValue()
+	q.ValueAttachment = &value
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueCoding(value Coding) {
+	q.ClearValue()
+	q.ValueCoding = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueQuantity(value Quantity) {
+	q.ClearValue()
+	q.ValueQuantity = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (q *QuestionnaireResponseItemAnswer) SetValueReference(value Reference) {
+	q.ClearValue()
+	q.ValueReference = &value
+}
+
 // QuestionnaireResponseItemItem represents a FHIR BackboneElement for QuestionnaireResponse.item.item.
 type QuestionnaireResponseItemItem struct {
 }
@@ -105,7 +271,7 @@ type QuestionnaireResponseItem struct {
 
 // QuestionnaireResponse represents a FHIR QuestionnaireResponse.
 type QuestionnaireResponse struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for this set of answers
@@ -115,7 +281,7 @@ type QuestionnaireResponse struct {
 	// Part of referenced event
 	PartOf []Reference `json:"partOf,omitempty" fhir:"cardinality=0..*,summary"`
 	// Canonical URL of Questionnaire being answered
-	Questionnaire string `json:"questionnaire" fhir:"cardinality=1..1,required,summary"`
+	Questionnaire primitives.Canonical `json:"questionnaire" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Questionnaire
 	QuestionnaireExt *primitives.PrimitiveExtension `json:"_questionnaire,omitempty" fhir:"cardinality=0..1"`
 	// in-progress | completed | amended | entered-in-error | stopped
@@ -137,3 +303,8 @@ type QuestionnaireResponse struct {
 	// Groups and questions
 	Item []QuestionnaireResponseItem `json:"item,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "QuestionnaireResponse", the FHIR type of the resource.
+func (q *QuestionnaireResponse) ResourceType() string {
+	return ResourceTypeQuestionnaireResponse
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -43,9 +42,52 @@ type RegulatedAuthorizationCase struct {
 	Application []RegulatedAuthorizationCaseApplication `json:"application,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// Date returns the option of date[x] that is set, or nil if none is.
+func (r *RegulatedAuthorizationCase) Date() any {
+	switch {
+	case r.DatePeriod != nil:
+		return r.DatePeriod
+	case r.DateDateTime != nil:
+		return r.DateDateTime
+	}
+	return nil
+}
+
+// DateType returns the FHIR type of the option of date[x] that is
+// set, such as "Period", or "" if none is.
+func (r *RegulatedAuthorizationCase) DateType() string {
+	switch {
+	case r.DatePeriod != nil:
+		return "Period"
+	case r.DateDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearDate unsets every option of date[x], and their extensions.
+func (r *RegulatedAuthorizationCase) ClearDate() {
+	r.DatePeriod = nil
+	r.DateDateTime = nil
+	r.DateDateTimeExt = nil
+}
+
+// SetDatePeriod sets the Period option of date[x], clearing the others.
+func (r *RegulatedAuthorizationCase) SetDatePeriod(value Period) {
+	r.ClearDate()
+	r.DatePeriod = &value
+}
+
+// SetDateDateTime sets the dateTime option of date[x], clearing the others.
+func (r *RegulatedAuthorizationCase) SetDateDateTime(value primitives.DateTime) {
+	ext := r.DateDateTimeExt
+	r.ClearDate()
+	r.DateDateTime, r.DateDateTimeExt = &value, ext
+}
+
 // RegulatedAuthorization represents a FHIR RegulatedAuthorization.
 type RegulatedAuthorization struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier for the authorization, typically assigned by the authorizing body
@@ -83,3 +125,8 @@ type RegulatedAuthorization struct {
 	// The case or regulatory procedure for granting or amending a regulated authorization. Note: This area is subject to ongoing review and the workgroup is seeking implementer feedback on its use (see link at bottom of page)
 	Case *RegulatedAuthorizationCase `json:"case,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "RegulatedAuthorization", the FHIR type of the resource.
+func (r *RegulatedAuthorization) ResourceType() string {
+	return ResourceTypeRegulatedAuthorization
+}
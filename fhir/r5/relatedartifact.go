@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,7 +18,7 @@ type RelatedArtifact struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as
-	Type string `json:"type" fhir:"cardinality=1..1,required,summary"`
+	Type string `json:"type" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/related-artifact-type|5.0.0,summary,type=code"`
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Additional classifiers
@@ -32,19 +32,19 @@ type RelatedArtifact struct {
 	// Extension for Display
 	DisplayExt *primitives.PrimitiveExtension `json:"_display,omitempty" fhir:"cardinality=0..1"`
 	// Bibliographic citation for the artifact
-	Citation *string `json:"citation,omitempty" fhir:"cardinality=0..1,summary"`
+	Citation *string `json:"citation,omitempty" fhir:"cardinality=0..1,summary,type=markdown"`
 	// Extension for Citation
 	CitationExt *primitives.PrimitiveExtension `json:"_citation,omitempty" fhir:"cardinality=0..1"`
 	// What document is being referenced
 	Document *Attachment `json:"document,omitempty" fhir:"cardinality=0..1,summary"`
 	// What artifact is being referenced
-	Resource *string `json:"resource,omitempty" fhir:"cardinality=0..1,summary"`
+	Resource *primitives.Canonical `json:"resource,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Resource
 	ResourceExt *primitives.PrimitiveExtension `json:"_resource,omitempty" fhir:"cardinality=0..1"`
 	// What artifact, if not a conformance resource
 	ResourceReference *Reference `json:"resourceReference,omitempty" fhir:"cardinality=0..1,summary"`
 	// draft | active | retired | unknown
-	PublicationStatus *string `json:"publicationStatus,omitempty" fhir:"cardinality=0..1,summary"`
+	PublicationStatus *PublicationStatus `json:"publicationStatus,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/publication-status|5.0.0,summary,type=code"`
 	// Extension for PublicationStatus
 	PublicationStatusExt *primitives.PrimitiveExtension `json:"_publicationStatus,omitempty" fhir:"cardinality=0..1"`
 	// Date of publication of the artifact being referred to
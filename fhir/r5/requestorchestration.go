@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -103,6 +102,47 @@ type RequestOrchestrationActionRelatedAction struct {
 	OffsetRange *Range `json:"offsetRange,omitempty" fhir:"cardinality=0..1,choice=offset"`
 }
 
+// Offset returns the option of offset[x] that is set, or nil if none is.
+func (r *RequestOrchestrationActionRelatedAction) Offset() any {
+	switch {
+	case r.OffsetDuration != nil:
+		return r.OffsetDuration
+	case r.OffsetRange != nil:
+		return r.OffsetRange
+	}
+	return nil
+}
+
+// OffsetType returns the FHIR type of the option of offset[x] that is
+// set, such as "Duration", or "" if none is.
+func (r *RequestOrchestrationActionRelatedAction) OffsetType() string {
+	switch {
+	case r.OffsetDuration != nil:
+		return "Duration"
+	case r.OffsetRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearOffset unsets every option of offset[x], and their extensions.
+func (r *RequestOrchestrationActionRelatedAction) ClearOffset() {
+	r.OffsetDuration = nil
+	r.OffsetRange = nil
+}
+
+// SetOffsetDuration sets the Duration option of offset[x], clearing the others.
+func (r *RequestOrchestrationActionRelatedAction) SetOffsetDuration(value Duration) {
+	r.ClearOffset()
+	r.OffsetDuration = &value
+}
+
+// SetOffsetRange sets the Range option of offset[x], clearing the others.
+func (r *RequestOrchestrationActionRelatedAction) SetOffsetRange(value Range) {
+	r.ClearOffset()
+	r.OffsetRange = &value
+}
+
 // RequestOrchestrationActionParticipant represents a FHIR BackboneElement for RequestOrchestration.action.participant.
 type RequestOrchestrationActionParticipant struct {
 	// Unique id for inter-element referencing
@@ -118,7 +158,7 @@ type RequestOrchestrationActionParticipant struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
-	TypeCanonical *string `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
+	TypeCanonical *primitives.Canonical `json:"typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Extension for TypeCanonical
 	TypeCanonicalExt *primitives.PrimitiveExtension `json:"_typeCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Who or what can participate
@@ -128,13 +168,56 @@ type RequestOrchestrationActionParticipant struct {
 	// E.g. Author, Reviewer, Witness, etc
 	Function *CodeableConcept `json:"function,omitempty" fhir:"cardinality=0..1"`
 	// Who/what is participating? - canonical option
-	ActorCanonical *string `json:"actorCanonical,omitempty" fhir:"cardinality=0..1,choice=actor"`
+	ActorCanonical *primitives.Canonical `json:"actorCanonical,omitempty" fhir:"cardinality=0..1,choice=actor"`
 	// Extension for ActorCanonical
 	ActorCanonicalExt *primitives.PrimitiveExtension `json:"_actorCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Who/what is participating? - Reference option
 	ActorReference *Reference `json:"actorReference,omitempty" fhir:"cardinality=0..1,choice=actor"`
 }
 
+// Actor returns the option of actor[x] that is set, or nil if none is.
+func (r *RequestOrchestrationActionParticipant) Actor() any {
+	switch {
+	case r.ActorCanonical != nil:
+		return r.ActorCanonical
+	case r.ActorReference != nil:
+		return r.ActorReference
+	}
+	return nil
+}
+
+// ActorType returns the FHIR type of the option of actor[x] that is
+// set, such as "canonical", or "" if none is.
+func (r *RequestOrchestrationActionParticipant) ActorType() string {
+	switch {
+	case r.ActorCanonical != nil:
+		return "canonical"
+	case r.ActorReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearActor unsets every option of actor[x], and their extensions.
+func (r *RequestOrchestrationActionParticipant) ClearActor() {
+	r.ActorCanonical = nil
+	r.ActorCanonicalExt = nil
+	r.ActorReference = nil
+}
+
+// SetActorCanonical sets the canonical option of actor[x], clearing the others.
+func (r *RequestOrchestrationActionParticipant) SetActorCanonical(value primitives.Canonical) {
+	ext := r.ActorCanonicalExt
+	r.ClearActor()
+	r.ActorCanonical, r.ActorCanonicalExt = &value, ext
+}
+
+// SetActorReference sets the Reference option of actor[x], clearing the others.
+func (r *RequestOrchestrationActionParticipant) SetActorReference(value Reference) {
+	r.ClearActor()
+	r.ActorReference = &value
+}
+
 // RequestOrchestrationActionDynamicValue represents a FHIR BackboneElement for RequestOrchestration.action.dynamicValue.
 type RequestOrchestrationActionDynamicValue struct {
 	// Unique id for inter-element referencing
@@ -248,15 +331,15 @@ type RequestOrchestrationAction struct {
 	// The target of the action
 	Resource *Reference `json:"resource,omitempty" fhir:"cardinality=0..1"`
 	// Description of the activity to be performed - canonical option
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,choice=definition"`
+	DefinitionCanonical *primitives.Canonical `json:"definitionCanonical,omitempty" fhir:"cardinality=0..1,choice=definition"`
 	// Extension for DefinitionCanonical
 	DefinitionCanonicalExt *primitives.PrimitiveExtension `json:"_definitionCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Description of the activity to be performed - uri option
-	DefinitionURI *string `json:"definitionURI,omitempty" fhir:"cardinality=0..1,choice=definition"`
+	DefinitionURI *string `json:"definitionUri,omitempty" fhir:"cardinality=0..1,choice=definition"`
 	// Extension for DefinitionURI
-	DefinitionURIExt *primitives.PrimitiveExtension `json:"_definitionURI,omitempty" fhir:"cardinality=0..1"`
+	DefinitionURIExt *primitives.PrimitiveExtension `json:"_definitionUri,omitempty" fhir:"cardinality=0..1"`
 	// Transform to apply the template
-	Transform *string `json:"transform,omitempty" fhir:"cardinality=0..1"`
+	Transform *primitives.Canonical `json:"transform,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Transform
 	TransformExt *primitives.PrimitiveExtension `json:"_transform,omitempty" fhir:"cardinality=0..1"`
 	// Dynamic aspects of the definition
@@ -265,15 +348,147 @@ type RequestOrchestrationAction struct {
 	Action []RequestOrchestrationActionAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Timing returns the option of timing[x] that is set, or nil if none is.
+func (r *RequestOrchestrationAction) Timing() any {
+	switch {
+	case r.TimingDateTime != nil:
+		return r.TimingDateTime
+	case r.TimingAge != nil:
+		return r.TimingAge
+	case r.TimingPeriod != nil:
+		return r.TimingPeriod
+	case r.TimingDuration != nil:
+		return r.TimingDuration
+	case r.TimingRange != nil:
+		return r.TimingRange
+	case r.TimingTiming != nil:
+		return r.TimingTiming
+	}
+	return nil
+}
+
+// TimingType returns the FHIR type of the option of timing[x] that is
+// set, such as "dateTime", or "" if none is.
+func (r *RequestOrchestrationAction) TimingType() string {
+	switch {
+	case r.TimingDateTime != nil:
+		return "dateTime"
+	case r.TimingAge != nil:
+		return "Age"
+	case r.TimingPeriod != nil:
+		return "Period"
+	case r.TimingDuration != nil:
+		return "Duration"
+	case r.TimingRange != nil:
+		return "Range"
+	case r.TimingTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearTiming unsets every option of timing[x], and their extensions.
+func (r *RequestOrchestrationAction) ClearTiming() {
+	r.TimingDateTime = nil
+	r.TimingDateTimeExt = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingDuration = nil
+	r.TimingRange = nil
+	r.TimingTiming = nil
+}
+
+// SetTimingDateTime sets the dateTime option of timing[x], clearing the others.
+func (r *RequestOrchestrationAction) SetTimingDateTime(value primitives.DateTime) {
+	ext := r.TimingDateTimeExt
+	r.ClearTiming()
+	r.TimingDateTime, r.TimingDateTimeExt = &value, ext
+}
+
+// SetTimingAge sets the Age option of timing[x], clearing the others.
+func (r *RequestOrchestrationAction) SetTimingAge(value Age) {
+	r.ClearTiming()
+	r.TimingAge = &value
+}
+
+// SetTimingPeriod sets the Period option of timing[x], clearing the others.
+func (r *RequestOrchestrationAction) SetTimingPeriod(value Period) {
+	r.ClearTiming()
+	r.TimingPeriod = &value
+}
+
+// SetTimingDuration sets the Duration option of timing[x], clearing the others.
+func (r *RequestOrchestrationAction) SetTimingDuration(value Duration) {
+	r.ClearTiming()
+	r.TimingDuration = &value
+}
+
+// SetTimingRange sets the Range option of timing[x], clearing the others.
+func (r *RequestOrchestrationAction) SetTimingRange(value Range) {
+	r.ClearTiming()
+	r.TimingRange = &value
+}
+
+// SetTimingTiming sets the Timing option of timing[x], clearing the others.
+func (r *RequestOrchestrationAction) SetTimingTiming(value Timing) {
+	r.ClearTiming()
+	r.TimingTiming = &value
+}
+
+// Definition returns the option of definition[x] that is set, or nil if none is.
+func (r *RequestOrchestrationAction) Definition() any {
+	switch {
+	case r.DefinitionCanonical != nil:
+		return r.DefinitionCanonical
+	case r.DefinitionURI != nil:
+		return r.DefinitionURI
+	}
+	return nil
+}
+
+// DefinitionType returns the FHIR type of the option of definition[x] that is
+// set, such as "canonical", or "" if none is.
+func (r *RequestOrchestrationAction) DefinitionType() string {
+	switch {
+	case r.DefinitionCanonical != nil:
+		return "canonical"
+	case r.DefinitionURI != nil:
+		return "uri"
+	}
+	return ""
+}
+
+// ClearDefinition unsets every option of definition[x], and their extensions.
+func (r *RequestOrchestrationAction) ClearDefinition() {
+	r.DefinitionCanonical = nil
+	r.DefinitionCanonicalExt = nil
+	r.DefinitionURI = nil
+	r.DefinitionURIExt = nil
+}
+
+// SetDefinitionCanonical sets the canonical option of definition[x], clearing the others.
+func (r *RequestOrchestrationAction) SetDefinitionCanonical(value primitives.Canonical) {
+	ext := r.DefinitionCanonicalExt
+	r.ClearDefinition()
+	r.DefinitionCanonical, r.DefinitionCanonicalExt = &value, ext
+}
+
+// SetDefinitionURI sets the uri option of definition[x], clearing the others.
+func (r *RequestOrchestrationAction) SetDefinitionURI(value string) {
+	ext := r.DefinitionURIExt
+	r.ClearDefinition()
+	r.DefinitionURI, r.DefinitionURIExt = &value, ext
+}
+
 // RequestOrchestration represents a FHIR RequestOrchestration.
 type RequestOrchestration struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business identifier
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -319,3 +534,8 @@ type RequestOrchestration struct {
 	// Proposed actions, if any
 	Action []RequestOrchestrationAction `json:"action,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "RequestOrchestration", the FHIR type of the resource.
+func (r *RequestOrchestration) ResourceType() string {
+	return ResourceTypeRequestOrchestration
+}
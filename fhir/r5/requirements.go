@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -65,7 +64,7 @@ type RequirementsStatement struct {
 
 // Requirements represents a FHIR Requirements.
 type Requirements struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this Requirements, represented as a URI (globally unique)
@@ -131,7 +130,7 @@ type Requirements struct {
 	// Extension for CopyrightLabel
 	CopyrightLabelExt *primitives.PrimitiveExtension `json:"_copyrightLabel,omitempty" fhir:"cardinality=0..1"`
 	// Other set of Requirements this builds on
-	DerivedFrom []string `json:"derivedFrom,omitempty" fhir:"cardinality=0..*,summary"`
+	DerivedFrom []primitives.Canonical `json:"derivedFrom,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for DerivedFrom
 	DerivedFromExt *primitives.PrimitiveExtension `json:"_derivedFrom,omitempty" fhir:"cardinality=0..1"`
 	// External artifact (rule/document etc. that) created this set of requirements
@@ -139,9 +138,57 @@ type Requirements struct {
 	// Extension for Reference
 	ReferenceExt *primitives.PrimitiveExtension `json:"_reference,omitempty" fhir:"cardinality=0..1"`
 	// Actor for these requirements
-	Actor []string `json:"actor,omitempty" fhir:"cardinality=0..*"`
+	Actor []primitives.Canonical `json:"actor,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Actor
 	ActorExt *primitives.PrimitiveExtension `json:"_actor,omitempty" fhir:"cardinality=0..1"`
 	// Actual statement as markdown
 	Statement []RequirementsStatement `json:"statement,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "Requirements", the FHIR type of the resource.
+func (r *Requirements) ResourceType() string {
+	return ResourceTypeRequirements
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (r *Requirements) VersionAlgorithm() any {
+	switch {
+	case r.VersionAlgorithmString != nil:
+		return r.VersionAlgorithmString
+	case r.VersionAlgorithmCoding != nil:
+		return r.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (r *Requirements) VersionAlgorithmType() string {
+	switch {
+	case r.VersionAlgorithmString != nil:
+		return "string"
+	case r.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (r *Requirements) ClearVersionAlgorithm() {
+	r.VersionAlgorithmString = nil
+	r.VersionAlgorithmStringExt = nil
+	r.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (r *Requirements) SetVersionAlgorithmString(value string) {
+	ext := r.VersionAlgorithmStringExt
+	r.ClearVersionAlgorithm()
+	r.VersionAlgorithmString, r.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (r *Requirements) SetVersionAlgorithmCoding(value Coding) {
+	r.ClearVersionAlgorithm()
+	r.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -177,7 +176,7 @@ type ResearchStudyOutcomeMeasure struct {
 
 // ResearchStudy represents a FHIR ResearchStudy.
 type ResearchStudy struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this study resource
@@ -261,3 +260,8 @@ type ResearchStudy struct {
 	// Link to results generated during the study
 	Result []Reference `json:"result,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "ResearchStudy", the FHIR type of the resource.
+func (r *ResearchStudy) ResourceType() string {
+	return ResourceTypeResearchStudy
+}
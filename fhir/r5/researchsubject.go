@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -43,7 +42,7 @@ type ResearchSubjectProgress struct {
 
 // ResearchSubject represents a FHIR ResearchSubject.
 type ResearchSubject struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for research subject in a study
@@ -71,3 +70,8 @@ type ResearchSubject struct {
 	// Agreement to participate in study
 	Consent []Reference `json:"consent,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ResearchSubject", the FHIR type of the resource.
+func (r *ResearchSubject) ResourceType() string {
+	return ResourceTypeResearchSubject
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,7 +25,7 @@ type RiskAssessmentPrediction struct {
 	// Possible outcome for the subject
 	Outcome *CodeableConcept `json:"outcome,omitempty" fhir:"cardinality=0..1"`
 	// Likelihood of specified outcome - decimal option
-	ProbabilityDecimal *float64 `json:"probabilityDecimal,omitempty" fhir:"cardinality=0..1,choice=probability"`
+	ProbabilityDecimal *primitives.Decimal `json:"probabilityDecimal,omitempty" fhir:"cardinality=0..1,choice=probability"`
 	// Extension for ProbabilityDecimal
 	ProbabilityDecimalExt *primitives.PrimitiveExtension `json:"_probabilityDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Likelihood of specified outcome - Range option
@@ -34,7 +33,7 @@ type RiskAssessmentPrediction struct {
 	// Likelihood of specified outcome as a qualitative value
 	QualitativeRisk *CodeableConcept `json:"qualitativeRisk,omitempty" fhir:"cardinality=0..1"`
 	// Relative likelihood
-	RelativeRisk *float64 `json:"relativeRisk,omitempty" fhir:"cardinality=0..1"`
+	RelativeRisk *primitives.Decimal `json:"relativeRisk,omitempty" fhir:"cardinality=0..1"`
 	// Extension for RelativeRisk
 	RelativeRiskExt *primitives.PrimitiveExtension `json:"_relativeRisk,omitempty" fhir:"cardinality=0..1"`
 	// Timeframe or age range - Period option
@@ -47,9 +46,93 @@ type RiskAssessmentPrediction struct {
 	RationaleExt *primitives.PrimitiveExtension `json:"_rationale,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Probability returns the option of probability[x] that is set, or nil if none is.
+func (r *RiskAssessmentPrediction) Probability() any {
+	switch {
+	case r.ProbabilityDecimal != nil:
+		return r.ProbabilityDecimal
+	case r.ProbabilityRange != nil:
+		return r.ProbabilityRange
+	}
+	return nil
+}
+
+// ProbabilityType returns the FHIR type of the option of probability[x] that is
+// set, such as "decimal", or "" if none is.
+func (r *RiskAssessmentPrediction) ProbabilityType() string {
+	switch {
+	case r.ProbabilityDecimal != nil:
+		return "decimal"
+	case r.ProbabilityRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearProbability unsets every option of probability[x], and their extensions.
+func (r *RiskAssessmentPrediction) ClearProbability() {
+	r.ProbabilityDecimal = nil
+	r.ProbabilityDecimalExt = nil
+	r.ProbabilityRange = nil
+}
+
+// SetProbabilityDecimal sets the decimal option of probability[x], clearing the others.
+func (r *RiskAssessmentPrediction) SetProbabilityDecimal(value primitives.Decimal) {
+	ext := r.ProbabilityDecimalExt
+	r.ClearProbability()
+	r.ProbabilityDecimal, r.ProbabilityDecimalExt = &value, ext
+}
+
+// SetProbabilityRange sets the Range option of probability[x], clearing the others.
+func (r *RiskAssessmentPrediction) SetProbabilityRange(value Range) {
+	r.ClearProbability()
+	r.ProbabilityRange = &value
+}
+
+// When returns the option of when[x] that is set, or nil if none is.
+func (r *RiskAssessmentPrediction) When() any {
+	switch {
+	case r.WhenPeriod != nil:
+		return r.WhenPeriod
+	case r.WhenRange != nil:
+		return r.WhenRange
+	}
+	return nil
+}
+
+// WhenType returns the FHIR type of the option of when[x] that is
+// set, such as "Period", or "" if none is.
+func (r *RiskAssessmentPrediction) WhenType() string {
+	switch {
+	case r.WhenPeriod != nil:
+		return "Period"
+	case r.WhenRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearWhen unsets every option of when[x], and their extensions.
+func (r *RiskAssessmentPrediction) ClearWhen() {
+	r.WhenPeriod = nil
+	r.WhenRange = nil
+}
+
+// SetWhenPeriod sets the Period option of when[x], clearing the others.
+func (r *RiskAssessmentPrediction) SetWhenPeriod(value Period) {
+	r.ClearWhen()
+	r.WhenPeriod = &value
+}
+
+// SetWhenRange sets the Range option of when[x], clearing the others.
+func (r *RiskAssessmentPrediction) SetWhenRange(value Range) {
+	r.ClearWhen()
+	r.WhenRange = &value
+}
+
 // RiskAssessment represents a FHIR RiskAssessment.
 type RiskAssessment struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier for the assessment
@@ -93,3 +176,51 @@ type RiskAssessment struct {
 	// Comments on the risk assessment
 	Note []Annotation `json:"note,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "RiskAssessment", the FHIR type of the resource.
+func (r *RiskAssessment) ResourceType() string {
+	return ResourceTypeRiskAssessment
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (r *RiskAssessment) Occurrence() any {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return r.OccurrenceDateTime
+	case r.OccurrencePeriod != nil:
+		return r.OccurrencePeriod
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (r *RiskAssessment) OccurrenceType() string {
+	switch {
+	case r.OccurrenceDateTime != nil:
+		return "dateTime"
+	case r.OccurrencePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (r *RiskAssessment) ClearOccurrence() {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceDateTimeExt = nil
+	r.OccurrencePeriod = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (r *RiskAssessment) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := r.OccurrenceDateTimeExt
+	r.ClearOccurrence()
+	r.OccurrenceDateTime, r.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (r *RiskAssessment) SetOccurrencePeriod(value Period) {
+	r.ClearOccurrence()
+	r.OccurrencePeriod = &value
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -20,31 +20,31 @@ type SampledData struct {
 	// Zero value and units
 	Origin Quantity `json:"origin" fhir:"cardinality=1..1,required,summary"`
 	// Number of intervalUnits between samples
-	Interval *float64 `json:"interval,omitempty" fhir:"cardinality=0..1,summary"`
+	Interval *primitives.Decimal `json:"interval,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Interval
 	IntervalExt *primitives.PrimitiveExtension `json:"_interval,omitempty" fhir:"cardinality=0..1"`
 	// The measurement unit of the interval between samples
-	IntervalUnit string `json:"intervalUnit" fhir:"cardinality=1..1,required,summary"`
+	IntervalUnit string `json:"intervalUnit" fhir:"cardinality=1..1,required,binding=required:http://hl7.org/fhir/ValueSet/ucum-units|5.0.0,summary,type=code"`
 	// Extension for IntervalUnit
 	IntervalUnitExt *primitives.PrimitiveExtension `json:"_intervalUnit,omitempty" fhir:"cardinality=0..1"`
 	// Multiply data by this before adding to origin
-	Factor *float64 `json:"factor,omitempty" fhir:"cardinality=0..1,summary"`
+	Factor *primitives.Decimal `json:"factor,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Factor
 	FactorExt *primitives.PrimitiveExtension `json:"_factor,omitempty" fhir:"cardinality=0..1"`
 	// Lower limit of detection
-	LowerLimit *float64 `json:"lowerLimit,omitempty" fhir:"cardinality=0..1,summary"`
+	LowerLimit *primitives.Decimal `json:"lowerLimit,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for LowerLimit
 	LowerLimitExt *primitives.PrimitiveExtension `json:"_lowerLimit,omitempty" fhir:"cardinality=0..1"`
 	// Upper limit of detection
-	UpperLimit *float64 `json:"upperLimit,omitempty" fhir:"cardinality=0..1,summary"`
+	UpperLimit *primitives.Decimal `json:"upperLimit,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for UpperLimit
 	UpperLimitExt *primitives.PrimitiveExtension `json:"_upperLimit,omitempty" fhir:"cardinality=0..1"`
 	// Number of sample points at each time point
-	Dimensions int `json:"dimensions" fhir:"cardinality=1..1,required,summary"`
+	Dimensions int `json:"dimensions" fhir:"cardinality=1..1,required,summary,type=positiveInt"`
 	// Extension for Dimensions
 	DimensionsExt *primitives.PrimitiveExtension `json:"_dimensions,omitempty" fhir:"cardinality=0..1"`
 	// Defines the codes used in the data
-	CodeMap *string `json:"codeMap,omitempty" fhir:"cardinality=0..1"`
+	CodeMap *primitives.Canonical `json:"codeMap,omitempty" fhir:"cardinality=0..1"`
 	// Extension for CodeMap
 	CodeMapExt *primitives.PrimitiveExtension `json:"_codeMap,omitempty" fhir:"cardinality=0..1"`
 	// Offsets, typically in time, at which data values were taken
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,7 +23,7 @@ type SearchParameterComponent struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Defines how the part works
-	Definition string `json:"definition" fhir:"cardinality=1..1,required"`
+	Definition primitives.Canonical `json:"definition" fhir:"cardinality=1..1,required"`
 	// Extension for Definition
 	DefinitionExt *primitives.PrimitiveExtension `json:"_definition,omitempty" fhir:"cardinality=0..1"`
 	// Subexpression relative to main expression
@@ -35,7 +34,7 @@ type SearchParameterComponent struct {
 
 // SearchParameter represents a FHIR SearchParameter.
 type SearchParameter struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this search parameter, represented as a URI (globally unique)
@@ -63,7 +62,7 @@ type SearchParameter struct {
 	// Extension for Title
 	TitleExt *primitives.PrimitiveExtension `json:"_title,omitempty" fhir:"cardinality=0..1"`
 	// Original definition for the search parameter
-	DerivedFrom *string `json:"derivedFrom,omitempty" fhir:"cardinality=0..1"`
+	DerivedFrom *primitives.Canonical `json:"derivedFrom,omitempty" fhir:"cardinality=0..1"`
 	// Extension for DerivedFrom
 	DerivedFromExt *primitives.PrimitiveExtension `json:"_derivedFrom,omitempty" fhir:"cardinality=0..1"`
 	// draft | active | retired | unknown
@@ -155,3 +154,51 @@ type SearchParameter struct {
 	// For Composite resources to define the parts
 	Component []SearchParameterComponent `json:"component,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "SearchParameter", the FHIR type of the resource.
+func (s *SearchParameter) ResourceType() string {
+	return ResourceTypeSearchParameter
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (s *SearchParameter) VersionAlgorithm() any {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return s.VersionAlgorithmString
+	case s.VersionAlgorithmCoding != nil:
+		return s.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (s *SearchParameter) VersionAlgorithmType() string {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return "string"
+	case s.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (s *SearchParameter) ClearVersionAlgorithm() {
+	s.VersionAlgorithmString = nil
+	s.VersionAlgorithmStringExt = nil
+	s.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (s *SearchParameter) SetVersionAlgorithmString(value string) {
+	ext := s.VersionAlgorithmStringExt
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmString, s.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (s *SearchParameter) SetVersionAlgorithmCoding(value Coding) {
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,23 +25,123 @@ type ServiceRequestOrderDetailParameter struct {
 	// The detail of the order being requested
 	Code CodeableConcept `json:"code" fhir:"cardinality=1..1,required,summary"`
 	// The value for the order detail - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// The value for the order detail - Ratio option
-	ValueRatio Ratio `json:"valueRatio" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRatio *Ratio `json:"valueRatio,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// The value for the order detail - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// The value for the order detail - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// The value for the order detail - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// The value for the order detail - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// The value for the order detail - Period option
-	ValuePeriod Period `json:"valuePeriod" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValuePeriod *Period `json:"valuePeriod,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (s *ServiceRequestOrderDetailParameter) Value() any {
+	switch {
+	case s.ValueQuantity != nil:
+		return s.ValueQuantity
+	case s.ValueRatio != nil:
+		return s.ValueRatio
+	case s.ValueRange != nil:
+		return s.ValueRange
+	case s.ValueBoolean != nil:
+		return s.ValueBoolean
+	case s.ValueCodeableConcept != nil:
+		return s.ValueCodeableConcept
+	case s.ValueString != nil:
+		return s.ValueString
+	case s.ValuePeriod != nil:
+		return s.ValuePeriod
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *ServiceRequestOrderDetailParameter) ValueType() string {
+	switch {
+	case s.ValueQuantity != nil:
+		return "Quantity"
+	case s.ValueRatio != nil:
+		return "Ratio"
+	case s.ValueRange != nil:
+		return "Range"
+	case s.ValueBoolean != nil:
+		return "boolean"
+	case s.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.ValueString != nil:
+		return "string"
+	case s.ValuePeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (s *ServiceRequestOrderDetailParameter) ClearValue() {
+	s.ValueQuantity = nil
+	s.ValueRatio = nil
+	s.ValueRange = nil
+	s.ValueBoolean = nil
+	s.ValueBooleanExt = nil
+	s.ValueCodeableConcept = nil
+	s.ValueString = nil
+	s.ValueStringExt = nil
+	s.ValuePeriod = nil
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValueQuantity(value Quantity) {
+	s.ClearValue()
+	s.ValueQuantity = &value
+}
+
+// SetValueRatio sets the Ratio option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValueRatio(value Ratio) {
+	s.ClearValue()
+	s.ValueRatio = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValueRange(value Range) {
+	s.ClearValue()
+	s.ValueRange = &value
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValueBoolean(value bool) {
+	ext := s.ValueBooleanExt
+	s.ClearValue()
+	s.ValueBoolean, s.ValueBooleanExt = &value, ext
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValueCodeableConcept(value CodeableConcept) {
+	s.ClearValue()
+	s.ValueCodeableConcept = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValueString(value string) {
+	ext := s.ValueStringExt
+	s.ClearValue()
+	s.ValueString, s.ValueStringExt = &value, ext
+}
+
+// SetValuePeriod sets the Period option of value[x], clearing the others.
+func (s *ServiceRequestOrderDetailParameter) SetValuePeriod(value Period) {
+	s.ClearValue()
+	s.ValuePeriod = &value
 }
 
 // ServiceRequestOrderDetail represents a FHIR BackboneElement for ServiceRequest.orderDetail.
@@ -79,15 +178,58 @@ type ServiceRequestPatientInstruction struct {
 	InstructionReference *Reference `json:"instructionReference,omitempty" fhir:"cardinality=0..1,summary,choice=instruction"`
 }
 
+// Instruction returns the option of instruction[x] that is set, or nil if none is.
+func (s *ServiceRequestPatientInstruction) Instruction() any {
+	switch {
+	case s.InstructionMarkdown != nil:
+		return s.InstructionMarkdown
+	case s.InstructionReference != nil:
+		return s.InstructionReference
+	}
+	return nil
+}
+
+// InstructionType returns the FHIR type of the option of instruction[x] that is
+// set, such as "markdown", or "" if none is.
+func (s *ServiceRequestPatientInstruction) InstructionType() string {
+	switch {
+	case s.InstructionMarkdown != nil:
+		return "markdown"
+	case s.InstructionReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearInstruction unsets every option of instruction[x], and their extensions.
+func (s *ServiceRequestPatientInstruction) ClearInstruction() {
+	s.InstructionMarkdown = nil
+	s.InstructionMarkdownExt = nil
+	s.InstructionReference = nil
+}
+
+// SetInstructionMarkdown sets the markdown option of instruction[x], clearing the others.
+func (s *ServiceRequestPatientInstruction) SetInstructionMarkdown(value string) {
+	ext := s.InstructionMarkdownExt
+	s.ClearInstruction()
+	s.InstructionMarkdown, s.InstructionMarkdownExt = &value, ext
+}
+
+// SetInstructionReference sets the Reference option of instruction[x], clearing the others.
+func (s *ServiceRequestPatientInstruction) SetInstructionReference(value Reference) {
+	s.ClearInstruction()
+	s.InstructionReference = &value
+}
+
 // ServiceRequest represents a FHIR ServiceRequest.
 type ServiceRequest struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifiers assigned to this order
 	Identifier []Identifier `json:"identifier,omitempty" fhir:"cardinality=0..*,summary"`
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	InstantiatesCanonical []primitives.Canonical `json:"instantiatesCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for InstantiatesCanonical
 	InstantiatesCanonicalExt *primitives.PrimitiveExtension `json:"_instantiatesCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Instantiates external protocol or definition
@@ -179,3 +321,157 @@ type ServiceRequest struct {
 	// Request provenance
 	RelevantHistory []Reference `json:"relevantHistory,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "ServiceRequest", the FHIR type of the resource.
+func (s *ServiceRequest) ResourceType() string {
+	return ResourceTypeServiceRequest
+}
+
+// Quantity returns the option of quantity[x] that is set, or nil if none is.
+func (s *ServiceRequest) Quantity() any {
+	switch {
+	case s.QuantityQuantity != nil:
+		return s.QuantityQuantity
+	case s.QuantityRatio != nil:
+		return s.QuantityRatio
+	case s.QuantityRange != nil:
+		return s.QuantityRange
+	}
+	return nil
+}
+
+// QuantityType returns the FHIR type of the option of quantity[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *ServiceRequest) QuantityType() string {
+	switch {
+	case s.QuantityQuantity != nil:
+		return "Quantity"
+	case s.QuantityRatio != nil:
+		return "Ratio"
+	case s.QuantityRange != nil:
+		return "Range"
+	}
+	return ""
+}
+
+// ClearQuantity unsets every option of quantity[x], and their extensions.
+func (s *ServiceRequest) ClearQuantity() {
+	s.QuantityQuantity = nil
+	s.QuantityRatio = nil
+	s.QuantityRange = nil
+}
+
+// SetQuantityQuantity sets the Quantity option of quantity[x], clearing the others.
+func (s *ServiceRequest) SetQuantityQuantity(value Quantity) {
+	s.ClearQuantity()
+	s.QuantityQuantity = &value
+}
+
+// SetQuantityRatio sets the Ratio option of quantity[x], clearing the others.
+func (s *ServiceRequest) SetQuantityRatio(value Ratio) {
+	s.ClearQuantity()
+	s.QuantityRatio = &value
+}
+
+// SetQuantityRange sets the Range option of quantity[x], clearing the others.
+func (s *ServiceRequest) SetQuantityRange(value Range) {
+	s.ClearQuantity()
+	s.QuantityRange = &value
+}
+
+// Occurrence returns the option of occurrence[x] that is set, or nil if none is.
+func (s *ServiceRequest) Occurrence() any {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return s.OccurrenceDateTime
+	case s.OccurrencePeriod != nil:
+		return s.OccurrencePeriod
+	case s.OccurrenceTiming != nil:
+		return s.OccurrenceTiming
+	}
+	return nil
+}
+
+// OccurrenceType returns the FHIR type of the option of occurrence[x] that is
+// set, such as "dateTime", or "" if none is.
+func (s *ServiceRequest) OccurrenceType() string {
+	switch {
+	case s.OccurrenceDateTime != nil:
+		return "dateTime"
+	case s.OccurrencePeriod != nil:
+		return "Period"
+	case s.OccurrenceTiming != nil:
+		return "Timing"
+	}
+	return ""
+}
+
+// ClearOccurrence unsets every option of occurrence[x], and their extensions.
+func (s *ServiceRequest) ClearOccurrence() {
+	s.OccurrenceDateTime = nil
+	s.OccurrenceDateTimeExt = nil
+	s.OccurrencePeriod = nil
+	s.OccurrenceTiming = nil
+}
+
+// SetOccurrenceDateTime sets the dateTime option of occurrence[x], clearing the others.
+func (s *ServiceRequest) SetOccurrenceDateTime(value primitives.DateTime) {
+	ext := s.OccurrenceDateTimeExt
+	s.ClearOccurrence()
+	s.OccurrenceDateTime, s.OccurrenceDateTimeExt = &value, ext
+}
+
+// SetOccurrencePeriod sets the Period option of occurrence[x], clearing the others.
+func (s *ServiceRequest) SetOccurrencePeriod(value Period) {
+	s.ClearOccurrence()
+	s.OccurrencePeriod = &value
+}
+
+// SetOccurrenceTiming sets the Timing option of occurrence[x], clearing the others.
+func (s *ServiceRequest) SetOccurrenceTiming(value Timing) {
+	s.ClearOccurrence()
+	s.OccurrenceTiming = &value
+}
+
+// AsNeeded returns the option of asNeeded[x] that is set, or nil if none is.
+func (s *ServiceRequest) AsNeeded() any {
+	switch {
+	case s.AsNeededBoolean != nil:
+		return s.AsNeededBoolean
+	case s.AsNeededCodeableConcept != nil:
+		return s.AsNeededCodeableConcept
+	}
+	return nil
+}
+
+// AsNeededType returns the FHIR type of the option of asNeeded[x] that is
+// set, such as "boolean", or "" if none is.
+func (s *ServiceRequest) AsNeededType() string {
+	switch {
+	case s.AsNeededBoolean != nil:
+		return "boolean"
+	case s.AsNeededCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearAsNeeded unsets every option of asNeeded[x], and their extensions.
+func (s *ServiceRequest) ClearAsNeeded() {
+	s.AsNeededBoolean = nil
+	s.AsNeededBooleanExt = nil
+	s.AsNeededCodeableConcept = nil
+}
+
+// SetAsNeededBoolean sets the boolean option of asNeeded[x], clearing the others.
+func (s *ServiceRequest) SetAsNeededBoolean(value bool) {
+	ext := s.AsNeededBooleanExt
+	s.ClearAsNeeded()
+	s.AsNeededBoolean, s.AsNeededBooleanExt = &value, ext
+}
+
+// SetAsNeededCodeableConcept sets the CodeableConcept option of asNeeded[x], clearing the others.
+func (s *ServiceRequest) SetAsNeededCodeableConcept(value CodeableConcept) {
+	s.ClearAsNeeded()
+	s.AsNeededCodeableConcept = &value
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -28,15 +28,15 @@ type Signature struct {
 	// The party represented
 	OnBehalfOf *Reference `json:"onBehalfOf,omitempty" fhir:"cardinality=0..1,summary"`
 	// The technical format of the signed resources
-	TargetFormat *string `json:"targetFormat,omitempty" fhir:"cardinality=0..1"`
+	TargetFormat *string `json:"targetFormat,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/mimetypes|5.0.0,type=code"`
 	// Extension for TargetFormat
 	TargetFormatExt *primitives.PrimitiveExtension `json:"_targetFormat,omitempty" fhir:"cardinality=0..1"`
 	// The technical format of the signature
-	SigFormat *string `json:"sigFormat,omitempty" fhir:"cardinality=0..1"`
+	SigFormat *string `json:"sigFormat,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/mimetypes|5.0.0,type=code"`
 	// Extension for SigFormat
 	SigFormatExt *primitives.PrimitiveExtension `json:"_sigFormat,omitempty" fhir:"cardinality=0..1"`
 	// The actual signature content (XML DigSig. JWS, picture, etc.)
-	Data *string `json:"data,omitempty" fhir:"cardinality=0..1"`
+	Data *primitives.Base64Binary `json:"data,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Data
 	DataExt *primitives.PrimitiveExtension `json:"_data,omitempty" fhir:"cardinality=0..1"`
 }
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,9 +23,50 @@ type SpecimenDefinitionTypeTestedContainerAdditive struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Additive associated with container - CodeableConcept option
-	AdditiveCodeableConcept CodeableConcept `json:"additiveCodeableConcept" fhir:"cardinality=1..1,required,choice=additive"`
+	AdditiveCodeableConcept *CodeableConcept `json:"additiveCodeableConcept,omitempty" fhir:"cardinality=1..1,required,choice=additive"`
 	// Additive associated with container - Reference option
-	AdditiveReference Reference `json:"additiveReference" fhir:"cardinality=1..1,required,choice=additive"`
+	AdditiveReference *Reference `json:"additiveReference,omitempty" fhir:"cardinality=1..1,required,choice=additive"`
+}
+
+// Additive returns the option of additive[x] that is set, or nil if none is.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) Additive() any {
+	switch {
+	case s.AdditiveCodeableConcept != nil:
+		return s.AdditiveCodeableConcept
+	case s.AdditiveReference != nil:
+		return s.AdditiveReference
+	}
+	return nil
+}
+
+// AdditiveType returns the FHIR type of the option of additive[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) AdditiveType() string {
+	switch {
+	case s.AdditiveCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.AdditiveReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearAdditive unsets every option of additive[x], and their extensions.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) ClearAdditive() {
+	s.AdditiveCodeableConcept = nil
+	s.AdditiveReference = nil
+}
+
+// SetAdditiveCodeableConcept sets the CodeableConcept option of additive[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditiveCodeableConcept(value CodeableConcept) {
+	s.ClearAdditive()
+	s.AdditiveCodeableConcept = &value
+}
+
+// SetAdditiveReference sets the Reference option of additive[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditiveReference(value Reference) {
+	s.ClearAdditive()
+	s.AdditiveReference = &value
 }
 
 // SpecimenDefinitionTypeTestedContainer represents a FHIR BackboneElement for SpecimenDefinition.typeTested.container.
@@ -65,6 +105,49 @@ type SpecimenDefinitionTypeTestedContainer struct {
 	PreparationExt *primitives.PrimitiveExtension `json:"_preparation,omitempty" fhir:"cardinality=0..1"`
 }
 
+// MinimumVolume returns the option of minimumVolume[x] that is set, or nil if none is.
+func (s *SpecimenDefinitionTypeTestedContainer) MinimumVolume() any {
+	switch {
+	case s.MinimumVolumeQuantity != nil:
+		return s.MinimumVolumeQuantity
+	case s.MinimumVolumeString != nil:
+		return s.MinimumVolumeString
+	}
+	return nil
+}
+
+// MinimumVolumeType returns the FHIR type of the option of minimumVolume[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *SpecimenDefinitionTypeTestedContainer) MinimumVolumeType() string {
+	switch {
+	case s.MinimumVolumeQuantity != nil:
+		return "Quantity"
+	case s.MinimumVolumeString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearMinimumVolume unsets every option of minimumVolume[x], and their extensions.
+func (s *SpecimenDefinitionTypeTestedContainer) ClearMinimumVolume() {
+	s.MinimumVolumeQuantity = nil
+	s.MinimumVolumeString = nil
+	s.MinimumVolumeStringExt = nil
+}
+
+// SetMinimumVolumeQuantity sets the Quantity option of minimumVolume[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainer) SetMinimumVolumeQuantity(value Quantity) {
+	s.ClearMinimumVolume()
+	s.MinimumVolumeQuantity = &value
+}
+
+// SetMinimumVolumeString sets the string option of minimumVolume[x], clearing the others.
+func (s *SpecimenDefinitionTypeTestedContainer) SetMinimumVolumeString(value string) {
+	ext := s.MinimumVolumeStringExt
+	s.ClearMinimumVolume()
+	s.MinimumVolumeString, s.MinimumVolumeStringExt = &value, ext
+}
+
 // SpecimenDefinitionTypeTestedHandling represents a FHIR BackboneElement for SpecimenDefinition.typeTested.handling.
 type SpecimenDefinitionTypeTestedHandling struct {
 	// Unique id for inter-element referencing
@@ -129,7 +212,7 @@ type SpecimenDefinitionTypeTested struct {
 
 // SpecimenDefinition represents a FHIR SpecimenDefinition.
 type SpecimenDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Logical canonical URL to reference this SpecimenDefinition (globally unique)
@@ -157,7 +240,7 @@ type SpecimenDefinition struct {
 	// Extension for Title
 	TitleExt *primitives.PrimitiveExtension `json:"_title,omitempty" fhir:"cardinality=0..1"`
 	// Based on FHIR definition of another SpecimenDefinition
-	DerivedFromCanonical []string `json:"derivedFromCanonical,omitempty" fhir:"cardinality=0..*,summary"`
+	DerivedFromCanonical []primitives.Canonical `json:"derivedFromCanonical,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for DerivedFromCanonical
 	DerivedFromCanonicalExt *primitives.PrimitiveExtension `json:"_derivedFromCanonical,omitempty" fhir:"cardinality=0..1"`
 	// Based on external definition
@@ -229,3 +312,92 @@ type SpecimenDefinition struct {
 	// Specimen in container intended for testing by lab
 	TypeTested []SpecimenDefinitionTypeTested `json:"typeTested,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "SpecimenDefinition", the FHIR type of the resource.
+func (s *SpecimenDefinition) ResourceType() string {
+	return ResourceTypeSpecimenDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (s *SpecimenDefinition) VersionAlgorithm() any {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return s.VersionAlgorithmString
+	case s.VersionAlgorithmCoding != nil:
+		return s.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (s *SpecimenDefinition) VersionAlgorithmType() string {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return "string"
+	case s.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (s *SpecimenDefinition) ClearVersionAlgorithm() {
+	s.VersionAlgorithmString = nil
+	s.VersionAlgorithmStringExt = nil
+	s.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (s *SpecimenDefinition) SetVersionAlgorithmString(value string) {
+	ext := s.VersionAlgorithmStringExt
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmString, s.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (s *SpecimenDefinition) SetVersionAlgorithmCoding(value Coding) {
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmCoding = &value
+}
+
+// Subject returns the option of subject[x] that is set, or nil if none is.
+func (s *SpecimenDefinition) Subject() any {
+	switch {
+	case s.SubjectCodeableConcept != nil:
+		return s.SubjectCodeableConcept
+	case s.SubjectReference != nil:
+		return s.SubjectReference
+	}
+	return nil
+}
+
+// SubjectType returns the FHIR type of the option of subject[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SpecimenDefinition) SubjectType() string {
+	switch {
+	case s.SubjectCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.SubjectReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubject unsets every option of subject[x], and their extensions.
+func (s *SpecimenDefinition) ClearSubject() {
+	s.SubjectCodeableConcept = nil
+	s.SubjectReference = nil
+}
+
+// SetSubjectCodeableConcept sets the CodeableConcept option of subject[x], clearing the others.
+func (s *SpecimenDefinition) SetSubjectCodeableConcept(value CodeableConcept) {
+	s.ClearSubject()
+	s.SubjectCodeableConcept = &value
+}
+
+// SetSubjectReference sets the Reference option of subject[x], clearing the others.
+func (s *SpecimenDefinition) SetSubjectReference(value Reference) {
+	s.ClearSubject()
+	s.SubjectReference = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -91,7 +90,7 @@ type StructureDefinitionDifferential struct {
 
 // StructureDefinition represents a FHIR StructureDefinition.
 type StructureDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this structure definition, represented as a URI (globally unique)
@@ -183,7 +182,7 @@ type StructureDefinition struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Definition that this type is constrained/specialized from
-	BaseDefinition *string `json:"baseDefinition,omitempty" fhir:"cardinality=0..1,summary"`
+	BaseDefinition *primitives.Canonical `json:"baseDefinition,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for BaseDefinition
 	BaseDefinitionExt *primitives.PrimitiveExtension `json:"_baseDefinition,omitempty" fhir:"cardinality=0..1"`
 	// specialization | constraint - How relates to base definition
@@ -195,3 +194,51 @@ type StructureDefinition struct {
 	// Differential view of the structure
 	Differential *StructureDefinitionDifferential `json:"differential,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "StructureDefinition", the FHIR type of the resource.
+func (s *StructureDefinition) ResourceType() string {
+	return ResourceTypeStructureDefinition
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (s *StructureDefinition) VersionAlgorithm() any {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return s.VersionAlgorithmString
+	case s.VersionAlgorithmCoding != nil:
+		return s.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (s *StructureDefinition) VersionAlgorithmType() string {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return "string"
+	case s.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (s *StructureDefinition) ClearVersionAlgorithm() {
+	s.VersionAlgorithmString = nil
+	s.VersionAlgorithmStringExt = nil
+	s.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (s *StructureDefinition) SetVersionAlgorithmString(value string) {
+	ext := s.VersionAlgorithmStringExt
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmString, s.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (s *StructureDefinition) SetVersionAlgorithmCoding(value Coding) {
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,7 +23,7 @@ type StructureMapStructure struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Canonical reference to structure definition
-	URL string `json:"url" fhir:"cardinality=1..1,required,summary"`
+	URL primitives.Canonical `json:"url" fhir:"cardinality=1..1,required,summary"`
 	// Extension for URL
 	URLExt *primitives.PrimitiveExtension `json:"_url,omitempty" fhir:"cardinality=0..1"`
 	// source | queried | target | produced
@@ -156,39 +155,162 @@ type StructureMapGroupRuleTargetParameter struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Parameter value - variable or literal - id option
-	ValueID string `json:"valueID" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueID *string `json:"valueId,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueID
-	ValueIDExt *primitives.PrimitiveExtension `json:"_valueID,omitempty" fhir:"cardinality=0..1"`
+	ValueIDExt *primitives.PrimitiveExtension `json:"_valueId,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - date option
-	ValueDate primitives.Date `json:"valueDate" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDate *primitives.Date `json:"valueDate,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDate
 	ValueDateExt *primitives.PrimitiveExtension `json:"_valueDate,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - time option
-	ValueTime primitives.Time `json:"valueTime" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueTime *primitives.Time `json:"valueTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueTime
 	ValueTimeExt *primitives.PrimitiveExtension `json:"_valueTime,omitempty" fhir:"cardinality=0..1"`
 	// Parameter value - variable or literal - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (s *StructureMapGroupRuleTargetParameter) Value() any {
+	switch {
+	case s.ValueID != nil:
+		return s.ValueID
+	case s.ValueString != nil:
+		return s.ValueString
+	case s.ValueBoolean != nil:
+		return s.ValueBoolean
+	case s.ValueInteger != nil:
+		return s.ValueInteger
+	case s.ValueDecimal != nil:
+		return s.ValueDecimal
+	case s.ValueDate != nil:
+		return s.ValueDate
+	case s.ValueTime != nil:
+		return s.ValueTime
+	case s.ValueDateTime != nil:
+		return s.ValueDateTime
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "id", or "" if none is.
+func (s *StructureMapGroupRuleTargetParameter) ValueType() string {
+	switch {
+	case s.ValueID != nil:
+		return "id"
+	case s.ValueString != nil:
+		return "string"
+	case s.ValueBoolean != nil:
+		return "boolean"
+	case s.ValueInteger != nil:
+		return "integer"
+	case s.ValueDecimal != nil:
+		return "decimal"
+	case s.ValueDate != nil:
+		return "date"
+	case s.ValueTime != nil:
+		return "time"
+	case s.ValueDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (s *StructureMapGroupRuleTargetParameter) ClearValue() {
+	s.ValueID = nil
+	s.ValueIDExt = nil
+	s.ValueString = nil
+	s.ValueStringExt = nil
+	s.ValueBoolean = nil
+	s.ValueBooleanExt = nil
+	s.ValueInteger = nil
+	s.ValueIntegerExt = nil
+	s.ValueDecimal = nil
+	s.ValueDecimalExt = nil
+	s.ValueDate = nil
+	s.ValueDateExt = nil
+	s.ValueTime = nil
+	s.ValueTimeExt = nil
+	s.ValueDateTime = nil
+	s.ValueDateTimeExt = nil
+}
+
+// SetValueID sets the id option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueID(value string) {
+	ext := s.ValueIDExt
+	s.ClearValue()
+	s.ValueID, s.ValueIDExt = &value, ext
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueString(value string) {
+	ext := s.ValueStringExt
+	s.ClearValue()
+	s.ValueString, s.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueBoolean(value bool) {
+	ext := s.ValueBooleanExt
+	s.ClearValue()
+	s.ValueBoolean, s.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueInteger(value int) {
+	ext := s.ValueIntegerExt
+	s.ClearValue()
+	s.ValueInteger, s.ValueIntegerExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueDecimal(value primitives.Decimal) {
+	ext := s.ValueDecimalExt
+	s.ClearValue()
+	s.ValueDecimal, s.ValueDecimalExt = &value, ext
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueDate(value primitives.Date) {
+	ext := s.ValueDateExt
+	s.ClearValue()
+	s.ValueDate, s.ValueDateExt = &value, ext
+}
+
+// SetValueTime sets the time option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueTime(value primitives.Time) {
+	ext := s.ValueTimeExt
+	s.ClearValue()
+	s.ValueTime, s.ValueTimeExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (s *StructureMapGroupRuleTargetParameter) SetValueDateTime(value primitives.DateTime) {
+	ext := s.ValueDateTimeExt
+	s.ClearValue()
+	s.ValueDateTime, s.ValueDateTimeExt = &value, ext
+}
+
 // StructureMapGroupRuleTarget represents a FHIR BackboneElement for StructureMap.group.rule.target.
 type StructureMapGroupRuleTarget struct {
 	// Unique id for inter-element referencing
@@ -315,7 +437,7 @@ type StructureMapGroup struct {
 
 // StructureMap represents a FHIR StructureMap.
 type StructureMap struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this structure map, represented as a URI (globally unique)
@@ -383,7 +505,7 @@ type StructureMap struct {
 	// Structure Definition used by this map
 	Structure []StructureMapStructure `json:"structure,omitempty" fhir:"cardinality=0..*,summary"`
 	// Other maps used by this map (canonical URLs)
-	Import []string `json:"import,omitempty" fhir:"cardinality=0..*,summary"`
+	Import []primitives.Canonical `json:"import,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for Import
 	ImportExt *primitives.PrimitiveExtension `json:"_import,omitempty" fhir:"cardinality=0..1"`
 	// Definition of the constant value used in the map rules
@@ -391,3 +513,51 @@ type StructureMap struct {
 	// Named sections for reader convenience
 	Group []StructureMapGroup `json:"group,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "StructureMap", the FHIR type of the resource.
+func (s *StructureMap) ResourceType() string {
+	return ResourceTypeStructureMap
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (s *StructureMap) VersionAlgorithm() any {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return s.VersionAlgorithmString
+	case s.VersionAlgorithmCoding != nil:
+		return s.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (s *StructureMap) VersionAlgorithmType() string {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return "string"
+	case s.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (s *StructureMap) ClearVersionAlgorithm() {
+	s.VersionAlgorithmString = nil
+	s.VersionAlgorithmStringExt = nil
+	s.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (s *StructureMap) SetVersionAlgorithmString(value string) {
+	ext := s.VersionAlgorithmStringExt
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmString, s.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (s *StructureMap) SetVersionAlgorithmCoding(value Coding) {
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmCoding = &value
+}
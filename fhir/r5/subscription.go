@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -67,7 +66,7 @@ type SubscriptionParameter struct {
 
 // Subscription represents a FHIR Subscription.
 type Subscription struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Additional identifiers (business identifier)
@@ -81,7 +80,7 @@ type Subscription struct {
 	// Extension for Status
 	StatusExt *primitives.PrimitiveExtension `json:"_status,omitempty" fhir:"cardinality=0..1"`
 	// Reference to the subscription topic being subscribed to
-	Topic string `json:"topic" fhir:"cardinality=1..1,required,summary"`
+	Topic primitives.Canonical `json:"topic" fhir:"cardinality=1..1,required,summary"`
 	// Extension for Topic
 	TopicExt *primitives.PrimitiveExtension `json:"_topic,omitempty" fhir:"cardinality=0..1"`
 	// Contact details for source (e.g. troubleshooting)
@@ -127,3 +126,8 @@ type Subscription struct {
 	// Extension for MaxCount
 	MaxCountExt *primitives.PrimitiveExtension `json:"_maxCount,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "Subscription", the FHIR type of the resource.
+func (s *Subscription) ResourceType() string {
+	return ResourceTypeSubscription
+}
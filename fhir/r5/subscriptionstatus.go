@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,7 +23,7 @@ type SubscriptionStatusNotificationEvent struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Sequencing index of this event
-	EventNumber int64 `json:"eventNumber" fhir:"cardinality=1..1,required"`
+	EventNumber primitives.Integer64 `json:"eventNumber" fhir:"cardinality=1..1,required"`
 	// Extension for EventNumber
 	EventNumberExt *primitives.PrimitiveExtension `json:"_eventNumber,omitempty" fhir:"cardinality=0..1"`
 	// The instant this event occurred
@@ -39,7 +38,7 @@ type SubscriptionStatusNotificationEvent struct {
 
 // SubscriptionStatus represents a FHIR SubscriptionStatus.
 type SubscriptionStatus struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// requested | active | error | off | entered-in-error
@@ -51,7 +50,7 @@ type SubscriptionStatus struct {
 	// Extension for Type
 	TypeExt *primitives.PrimitiveExtension `json:"_type,omitempty" fhir:"cardinality=0..1"`
 	// Events since the Subscription was created
-	EventsSinceSubscriptionStart *int64 `json:"eventsSinceSubscriptionStart,omitempty" fhir:"cardinality=0..1,summary"`
+	EventsSinceSubscriptionStart *primitives.Integer64 `json:"eventsSinceSubscriptionStart,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for EventsSinceSubscriptionStart
 	EventsSinceSubscriptionStartExt *primitives.PrimitiveExtension `json:"_eventsSinceSubscriptionStart,omitempty" fhir:"cardinality=0..1"`
 	// Detailed information about any events relevant to this notification
@@ -59,9 +58,14 @@ type SubscriptionStatus struct {
 	// Reference to the Subscription responsible for this notification
 	Subscription Reference `json:"subscription" fhir:"cardinality=1..1,required,summary"`
 	// Reference to the SubscriptionTopic this notification relates to
-	Topic *string `json:"topic,omitempty" fhir:"cardinality=0..1,summary"`
+	Topic *primitives.Canonical `json:"topic,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Topic
 	TopicExt *primitives.PrimitiveExtension `json:"_topic,omitempty" fhir:"cardinality=0..1"`
 	// List of errors on the subscription
 	Error []CodeableConcept `json:"error,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubscriptionStatus", the FHIR type of the resource.
+func (s *SubscriptionStatus) ResourceType() string {
+	return ResourceTypeSubscriptionStatus
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -159,7 +158,7 @@ type SubscriptionTopicNotificationShape struct {
 
 // SubscriptionTopic represents a FHIR SubscriptionTopic.
 type SubscriptionTopic struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this subscription topic, represented as an absolute URI (globally unique)
@@ -187,7 +186,7 @@ type SubscriptionTopic struct {
 	// Extension for Title
 	TitleExt *primitives.PrimitiveExtension `json:"_title,omitempty" fhir:"cardinality=0..1"`
 	// Based on FHIR protocol or definition
-	DerivedFrom []string `json:"derivedFrom,omitempty" fhir:"cardinality=0..*,summary"`
+	DerivedFrom []primitives.Canonical `json:"derivedFrom,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for DerivedFrom
 	DerivedFromExt *primitives.PrimitiveExtension `json:"_derivedFrom,omitempty" fhir:"cardinality=0..1"`
 	// draft | active | retired | unknown
@@ -247,3 +246,51 @@ type SubscriptionTopic struct {
 	// Properties for describing the shape of notifications generated by this topic
 	NotificationShape []SubscriptionTopicNotificationShape `json:"notificationShape,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubscriptionTopic", the FHIR type of the resource.
+func (s *SubscriptionTopic) ResourceType() string {
+	return ResourceTypeSubscriptionTopic
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (s *SubscriptionTopic) VersionAlgorithm() any {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return s.VersionAlgorithmString
+	case s.VersionAlgorithmCoding != nil:
+		return s.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (s *SubscriptionTopic) VersionAlgorithmType() string {
+	switch {
+	case s.VersionAlgorithmString != nil:
+		return "string"
+	case s.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (s *SubscriptionTopic) ClearVersionAlgorithm() {
+	s.VersionAlgorithmString = nil
+	s.VersionAlgorithmStringExt = nil
+	s.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (s *SubscriptionTopic) SetVersionAlgorithmString(value string) {
+	ext := s.VersionAlgorithmStringExt
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmString, s.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (s *SubscriptionTopic) SetVersionAlgorithmCoding(value Coding) {
+	s.ClearVersionAlgorithm()
+	s.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -26,14 +25,55 @@ type SubstanceIngredient struct {
 	// Optional amount (concentration)
 	Quantity *Ratio `json:"quantity,omitempty" fhir:"cardinality=0..1,summary"`
 	// A component of the substance - CodeableConcept option
-	SubstanceCodeableConcept CodeableConcept `json:"substanceCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=substance"`
+	SubstanceCodeableConcept *CodeableConcept `json:"substanceCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=substance"`
 	// A component of the substance - Reference option
-	SubstanceReference Reference `json:"substanceReference" fhir:"cardinality=1..1,required,summary,choice=substance"`
+	SubstanceReference *Reference `json:"substanceReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=substance"`
+}
+
+// Substance returns the option of substance[x] that is set, or nil if none is.
+func (s *SubstanceIngredient) Substance() any {
+	switch {
+	case s.SubstanceCodeableConcept != nil:
+		return s.SubstanceCodeableConcept
+	case s.SubstanceReference != nil:
+		return s.SubstanceReference
+	}
+	return nil
+}
+
+// SubstanceType returns the FHIR type of the option of substance[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SubstanceIngredient) SubstanceType() string {
+	switch {
+	case s.SubstanceCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.SubstanceReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSubstance unsets every option of substance[x], and their extensions.
+func (s *SubstanceIngredient) ClearSubstance() {
+	s.SubstanceCodeableConcept = nil
+	s.SubstanceReference = nil
+}
+
+// SetSubstanceCodeableConcept sets the CodeableConcept option of substance[x], clearing the others.
+func (s *SubstanceIngredient) SetSubstanceCodeableConcept(value CodeableConcept) {
+	s.ClearSubstance()
+	s.SubstanceCodeableConcept = &value
+}
+
+// SetSubstanceReference sets the Reference option of substance[x], clearing the others.
+func (s *SubstanceIngredient) SetSubstanceReference(value Reference) {
+	s.ClearSubstance()
+	s.SubstanceReference = &value
 }
 
 // Substance represents a FHIR Substance.
 type Substance struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Unique identifier
@@ -63,3 +103,8 @@ type Substance struct {
 	// Composition information about the substance
 	Ingredient []SubstanceIngredient `json:"ingredient,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "Substance", the FHIR type of the resource.
+func (s *Substance) ResourceType() string {
+	return ResourceTypeSubstance
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -49,6 +48,49 @@ type SubstanceDefinitionMoiety struct {
 	MeasurementType *CodeableConcept `json:"measurementType,omitempty" fhir:"cardinality=0..1,summary"`
 }
 
+// Amount returns the option of amount[x] that is set, or nil if none is.
+func (s *SubstanceDefinitionMoiety) Amount() any {
+	switch {
+	case s.AmountQuantity != nil:
+		return s.AmountQuantity
+	case s.AmountString != nil:
+		return s.AmountString
+	}
+	return nil
+}
+
+// AmountType returns the FHIR type of the option of amount[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *SubstanceDefinitionMoiety) AmountType() string {
+	switch {
+	case s.AmountQuantity != nil:
+		return "Quantity"
+	case s.AmountString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAmount unsets every option of amount[x], and their extensions.
+func (s *SubstanceDefinitionMoiety) ClearAmount() {
+	s.AmountQuantity = nil
+	s.AmountString = nil
+	s.AmountStringExt = nil
+}
+
+// SetAmountQuantity sets the Quantity option of amount[x], clearing the others.
+func (s *SubstanceDefinitionMoiety) SetAmountQuantity(value Quantity) {
+	s.ClearAmount()
+	s.AmountQuantity = &value
+}
+
+// SetAmountString sets the string option of amount[x], clearing the others.
+func (s *SubstanceDefinitionMoiety) SetAmountString(value string) {
+	ext := s.AmountStringExt
+	s.ClearAmount()
+	s.AmountString, s.AmountStringExt = &value, ext
+}
+
 // SubstanceDefinitionCharacterization represents a FHIR BackboneElement for SubstanceDefinition.characterization.
 type SubstanceDefinitionCharacterization struct {
 	// Unique id for inter-element referencing
@@ -99,6 +141,84 @@ type SubstanceDefinitionProperty struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty" fhir:"cardinality=0..1,summary,choice=value"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (s *SubstanceDefinitionProperty) Value() any {
+	switch {
+	case s.ValueCodeableConcept != nil:
+		return s.ValueCodeableConcept
+	case s.ValueQuantity != nil:
+		return s.ValueQuantity
+	case s.ValueDate != nil:
+		return s.ValueDate
+	case s.ValueBoolean != nil:
+		return s.ValueBoolean
+	case s.ValueAttachment != nil:
+		return s.ValueAttachment
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (s *SubstanceDefinitionProperty) ValueType() string {
+	switch {
+	case s.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case s.ValueQuantity != nil:
+		return "Quantity"
+	case s.ValueDate != nil:
+		return "date"
+	case s.ValueBoolean != nil:
+		return "boolean"
+	case s.ValueAttachment != nil:
+		return "Attachment"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (s *SubstanceDefinitionProperty) ClearValue() {
+	s.ValueCodeableConcept = nil
+	s.ValueQuantity = nil
+	s.ValueDate = nil
+	s.ValueDateExt = nil
+	s.ValueBoolean = nil
+	s.ValueBooleanExt = nil
+	s.ValueAttachment = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (s *SubstanceDefinitionProperty) SetValueCodeableConcept(value CodeableConcept) {
+	s.ClearValue()
+	s.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (s *SubstanceDefinitionProperty) SetValueQuantity(value Quantity) {
+	s.ClearValue()
+	s.ValueQuantity = &value
+}
+
+// SetValueDate sets the date option of value[x], clearing the others.
+func (s *SubstanceDefinitionProperty) SetValueDate(value primitives.Date) {
+	ext := s.ValueDateExt
+	s.ClearValue()
+	s.ValueDate, s.ValueDateExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (s *SubstanceDefinitionProperty) SetValueBoolean(value bool) {
+	ext := s.ValueBooleanExt
+	s.ClearValue()
+	s.ValueBoolean, s.ValueBooleanExt = &value, ext
+}
+
+// SetValueAttachment sets the Attachment option of value[x], clearing the others.
+func (s *SubstanceDefinitionProperty) SetValueAttachment(value Attachment) {
+	s.ClearValue()
+	s.ValueAttachment = &value
+}
+
 // SubstanceDefinitionMolecularWeight represents a FHIR BackboneElement for SubstanceDefinition.molecularWeight.
 type SubstanceDefinitionMolecularWeight struct {
 	// Unique id for inter-element referencing
@@ -301,6 +421,101 @@ type SubstanceDefinitionRelationship struct {
 	Source []Reference `json:"source,omitempty" fhir:"cardinality=0..*,summary"`
 }
 
+// SubstanceDefinition returns the option of substanceDefinition[x] that is set, or nil if none is.
+func (s *SubstanceDefinitionRelationship) SubstanceDefinition() any {
+	switch {
+	case s.SubstanceDefinitionReference != nil:
+		return s.SubstanceDefinitionReference
+	case s.SubstanceDefinitionCodeableConcept != nil:
+		return s.SubstanceDefinitionCodeableConcept
+	}
+	return nil
+}
+
+// SubstanceDefinitionType returns the FHIR type of the option of substanceDefinition[x] that is
+// set, such as "Reference", or "" if none is.
+func (s *SubstanceDefinitionRelationship) SubstanceDefinitionType() string {
+	switch {
+	case s.SubstanceDefinitionReference != nil:
+		return "Reference"
+	case s.SubstanceDefinitionCodeableConcept != nil:
+		return "CodeableConcept"
+	}
+	return ""
+}
+
+// ClearSubstanceDefinition unsets every option of substanceDefinition[x], and their extensions.
+func (s *SubstanceDefinitionRelationship) ClearSubstanceDefinition() {
+	s.SubstanceDefinitionReference = nil
+	s.SubstanceDefinitionCodeableConcept = nil
+}
+
+// SetSubstanceDefinitionReference sets the Reference option of substanceDefinition[x], clearing the others.
+func (s *SubstanceDefinitionRelationship) SetSubstanceDefinitionReference(value Reference) {
+	s.ClearSubstanceDefinition()
+	s.SubstanceDefinitionReference = &value
+}
+
+// SetSubstanceDefinitionCodeableConcept sets the CodeableConcept option of substanceDefinition[x], clearing the others.
+func (s *SubstanceDefinitionRelationship) SetSubstanceDefinitionCodeableConcept(value CodeableConcept) {
+	s.ClearSubstanceDefinition()
+	s.SubstanceDefinitionCodeableConcept = &value
+}
+
+// Amount returns the option of amount[x] that is set, or nil if none is.
+func (s *SubstanceDefinitionRelationship) Amount() any {
+	switch {
+	case s.AmountQuantity != nil:
+		return s.AmountQuantity
+	case s.AmountRatio != nil:
+		return s.AmountRatio
+	case s.AmountString != nil:
+		return s.AmountString
+	}
+	return nil
+}
+
+// AmountType returns the FHIR type of the option of amount[x] that is
+// set, such as "Quantity", or "" if none is.
+func (s *SubstanceDefinitionRelationship) AmountType() string {
+	switch {
+	case s.AmountQuantity != nil:
+		return "Quantity"
+	case s.AmountRatio != nil:
+		return "Ratio"
+	case s.AmountString != nil:
+		return "string"
+	}
+	return ""
+}
+
+// ClearAmount unsets every option of amount[x], and their extensions.
+func (s *SubstanceDefinitionRelationship) ClearAmount() {
+	s.AmountQuantity = nil
+	s.AmountRatio = nil
+	s.AmountString = nil
+	s.AmountStringExt = nil
+}
+
+// SetAmountQuantity sets the Quantity option of amount[x], clearing the others.
+func (s *SubstanceDefinitionRelationship) SetAmountQuantity(value Quantity) {
+	s.ClearAmount()
+	s.AmountQuantity = &value
+}
+
+// SetAmountRatio sets the Ratio option of amount[x], clearing the others.
+func (s *SubstanceDefinitionRelationship) SetAmountRatio(value Ratio) {
+	s.ClearAmount()
+	s.AmountRatio = &value
+}
+
+// SetAmountString sets the string option of amount[x], clearing the others.
+func (s *SubstanceDefinitionRelationship) SetAmountString(value string) {
+	ext := s.AmountStringExt
+	s.ClearAmount()
+	s.AmountString, s.AmountStringExt = &value, ext
+}
+
 // SubstanceDefinitionSourceMaterial represents a FHIR BackboneElement for SubstanceDefinition.sourceMaterial.
 type SubstanceDefinitionSourceMaterial struct {
 	// Unique id for inter-element referencing
@@ -325,7 +540,7 @@ type SubstanceDefinitionSourceMaterial struct {
 
 // SubstanceDefinition represents a FHIR SubstanceDefinition.
 type SubstanceDefinition struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Identifier by which this substance is known
@@ -381,3 +596,8 @@ type SubstanceDefinition struct {
 	// Material or taxonomic/anatomical source
 	SourceMaterial *SubstanceDefinitionSourceMaterial `json:"sourceMaterial,omitempty" fhir:"cardinality=0..1,summary"`
 }
+
+// ResourceType returns "SubstanceDefinition", the FHIR type of the resource.
+func (s *SubstanceDefinition) ResourceType() string {
+	return ResourceTypeSubstanceDefinition
+}
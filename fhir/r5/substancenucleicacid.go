@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -97,7 +96,7 @@ type SubstanceNucleicAcidSubunit struct {
 
 // SubstanceNucleicAcid represents a FHIR SubstanceNucleicAcid.
 type SubstanceNucleicAcid struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// The type of the sequence shall be specified based on a controlled vocabulary
@@ -115,3 +114,8 @@ type SubstanceNucleicAcid struct {
 	// Subunits are listed in order of decreasing length; sequences of the same length will be ordered by molecular weight; subunits that have identical sequences will be repeated multiple times
 	Subunit []SubstanceNucleicAcidSubunit `json:"subunit,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubstanceNucleicAcid", the FHIR type of the resource.
+func (s *SubstanceNucleicAcid) ResourceType() string {
+	return ResourceTypeSubstanceNucleicAcid
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -147,7 +146,7 @@ type SubstancePolymerRepeat struct {
 
 // SubstancePolymer represents a FHIR SubstancePolymer.
 type SubstancePolymer struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A business idenfier for this polymer, but typically this is handled by a SubstanceDefinition identifier
@@ -167,3 +166,8 @@ type SubstancePolymer struct {
 	// Specifies and quantifies the repeated units and their configuration
 	Repeat []SubstancePolymerRepeat `json:"repeat,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubstancePolymer", the FHIR type of the resource.
+func (s *SubstancePolymer) ResourceType() string {
+	return ResourceTypeSubstancePolymer
+}
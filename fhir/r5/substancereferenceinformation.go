@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -85,7 +84,7 @@ type SubstanceReferenceInformationTarget struct {
 
 // SubstanceReferenceInformation represents a FHIR SubstanceReferenceInformation.
 type SubstanceReferenceInformation struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Todo
@@ -99,3 +98,8 @@ type SubstanceReferenceInformation struct {
 	// Todo
 	Target []SubstanceReferenceInformationTarget `json:"target,omitempty" fhir:"cardinality=0..*,summary"`
 }
+
+// ResourceType returns "SubstanceReferenceInformation", the FHIR type of the resource.
+func (s *SubstanceReferenceInformation) ResourceType() string {
+	return ResourceTypeSubstanceReferenceInformation
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -118,7 +117,7 @@ type TerminologyCapabilitiesCodeSystem struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Canonical identifier for the code system, represented as a URI
-	URI *string `json:"uri,omitempty" fhir:"cardinality=0..1"`
+	URI *primitives.Canonical `json:"uri,omitempty" fhir:"cardinality=0..1"`
 	// Extension for URI
 	URIExt *primitives.PrimitiveExtension `json:"_uri,omitempty" fhir:"cardinality=0..1"`
 	// Version of Code System supported
@@ -233,7 +232,7 @@ type TerminologyCapabilitiesClosure struct {
 
 // TerminologyCapabilities represents a FHIR TerminologyCapabilities.
 type TerminologyCapabilities struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this terminology capabilities, represented as a URI (globally unique)
@@ -325,3 +324,51 @@ type TerminologyCapabilities struct {
 	// Information about the [ConceptMap/$closure](conceptmap-operation-closure.html) operation
 	Closure *TerminologyCapabilitiesClosure `json:"closure,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "TerminologyCapabilities", the FHIR type of the resource.
+func (t *TerminologyCapabilities) ResourceType() string {
+	return ResourceTypeTerminologyCapabilities
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (t *TerminologyCapabilities) VersionAlgorithm() any {
+	switch {
+	case t.VersionAlgorithmString != nil:
+		return t.VersionAlgorithmString
+	case t.VersionAlgorithmCoding != nil:
+		return t.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (t *TerminologyCapabilities) VersionAlgorithmType() string {
+	switch {
+	case t.VersionAlgorithmString != nil:
+		return "string"
+	case t.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (t *TerminologyCapabilities) ClearVersionAlgorithm() {
+	t.VersionAlgorithmString = nil
+	t.VersionAlgorithmStringExt = nil
+	t.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (t *TerminologyCapabilities) SetVersionAlgorithmString(value string) {
+	ext := t.VersionAlgorithmStringExt
+	t.ClearVersionAlgorithm()
+	t.VersionAlgorithmString, t.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (t *TerminologyCapabilities) SetVersionAlgorithmCoding(value Coding) {
+	t.ClearVersionAlgorithm()
+	t.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -69,6 +68,49 @@ type TestPlanTestCaseTestRunScript struct {
 	SourceReference *Reference `json:"sourceReference,omitempty" fhir:"cardinality=0..1,choice=source"`
 }
 
+// Source returns the option of source[x] that is set, or nil if none is.
+func (t *TestPlanTestCaseTestRunScript) Source() any {
+	switch {
+	case t.SourceString != nil:
+		return t.SourceString
+	case t.SourceReference != nil:
+		return t.SourceReference
+	}
+	return nil
+}
+
+// SourceType returns the FHIR type of the option of source[x] that is
+// set, such as "string", or "" if none is.
+func (t *TestPlanTestCaseTestRunScript) SourceType() string {
+	switch {
+	case t.SourceString != nil:
+		return "string"
+	case t.SourceReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSource unsets every option of source[x], and their extensions.
+func (t *TestPlanTestCaseTestRunScript) ClearSource() {
+	t.SourceString = nil
+	t.SourceStringExt = nil
+	t.SourceReference = nil
+}
+
+// SetSourceString sets the string option of source[x], clearing the others.
+func (t *TestPlanTestCaseTestRunScript) SetSourceString(value string) {
+	ext := t.SourceStringExt
+	t.ClearSource()
+	t.SourceString, t.SourceStringExt = &value, ext
+}
+
+// SetSourceReference sets the Reference option of source[x], clearing the others.
+func (t *TestPlanTestCaseTestRunScript) SetSourceReference(value Reference) {
+	t.ClearSource()
+	t.SourceReference = &value
+}
+
 // TestPlanTestCaseTestRun represents a FHIR BackboneElement for TestPlan.testCase.testRun.
 type TestPlanTestCaseTestRun struct {
 	// Unique id for inter-element referencing
@@ -109,6 +151,49 @@ type TestPlanTestCaseTestData struct {
 	SourceReference *Reference `json:"sourceReference,omitempty" fhir:"cardinality=0..1,choice=source"`
 }
 
+// Source returns the option of source[x] that is set, or nil if none is.
+func (t *TestPlanTestCaseTestData) Source() any {
+	switch {
+	case t.SourceString != nil:
+		return t.SourceString
+	case t.SourceReference != nil:
+		return t.SourceReference
+	}
+	return nil
+}
+
+// SourceType returns the FHIR type of the option of source[x] that is
+// set, such as "string", or "" if none is.
+func (t *TestPlanTestCaseTestData) SourceType() string {
+	switch {
+	case t.SourceString != nil:
+		return "string"
+	case t.SourceReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearSource unsets every option of source[x], and their extensions.
+func (t *TestPlanTestCaseTestData) ClearSource() {
+	t.SourceString = nil
+	t.SourceStringExt = nil
+	t.SourceReference = nil
+}
+
+// SetSourceString sets the string option of source[x], clearing the others.
+func (t *TestPlanTestCaseTestData) SetSourceString(value string) {
+	ext := t.SourceStringExt
+	t.ClearSource()
+	t.SourceString, t.SourceStringExt = &value, ext
+}
+
+// SetSourceReference sets the Reference option of source[x], clearing the others.
+func (t *TestPlanTestCaseTestData) SetSourceReference(value Reference) {
+	t.ClearSource()
+	t.SourceReference = &value
+}
+
 // TestPlanTestCaseAssertion represents a FHIR BackboneElement for TestPlan.testCase.assertion.
 type TestPlanTestCaseAssertion struct {
 	// Unique id for inter-element referencing
@@ -155,7 +240,7 @@ type TestPlanTestCase struct {
 
 // TestPlan represents a FHIR TestPlan.
 type TestPlan struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this test plan, represented as a URI (globally unique)
@@ -237,3 +322,51 @@ type TestPlan struct {
 	// The test cases that constitute this plan
 	TestCase []TestPlanTestCase `json:"testCase,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "TestPlan", the FHIR type of the resource.
+func (t *TestPlan) ResourceType() string {
+	return ResourceTypeTestPlan
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (t *TestPlan) VersionAlgorithm() any {
+	switch {
+	case t.VersionAlgorithmString != nil:
+		return t.VersionAlgorithmString
+	case t.VersionAlgorithmCoding != nil:
+		return t.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (t *TestPlan) VersionAlgorithmType() string {
+	switch {
+	case t.VersionAlgorithmString != nil:
+		return "string"
+	case t.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (t *TestPlan) ClearVersionAlgorithm() {
+	t.VersionAlgorithmString = nil
+	t.VersionAlgorithmStringExt = nil
+	t.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (t *TestPlan) SetVersionAlgorithmString(value string) {
+	ext := t.VersionAlgorithmStringExt
+	t.ClearVersionAlgorithm()
+	t.VersionAlgorithmString, t.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (t *TestPlan) SetVersionAlgorithmCoding(value Coding) {
+	t.ClearVersionAlgorithm()
+	t.VersionAlgorithmCoding = &value
+}
@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -72,15 +71,60 @@ type TestReportSetupActionAssertRequirement struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Link or reference to the testing requirement - uri option
-	LinkURI *string `json:"linkURI,omitempty" fhir:"cardinality=0..1,choice=link"`
+	LinkURI *string `json:"linkUri,omitempty" fhir:"cardinality=0..1,choice=link"`
 	// Extension for LinkURI
-	LinkURIExt *primitives.PrimitiveExtension `json:"_linkURI,omitempty" fhir:"cardinality=0..1"`
+	LinkURIExt *primitives.PrimitiveExtension `json:"_linkUri,omitempty" fhir:"cardinality=0..1"`
 	// Link or reference to the testing requirement - canonical option
-	LinkCanonical *string `json:"linkCanonical,omitempty" fhir:"cardinality=0..1,choice=link"`
+	LinkCanonical *primitives.Canonical `json:"linkCanonical,omitempty" fhir:"cardinality=0..1,choice=link"`
 	// Extension for LinkCanonical
 	LinkCanonicalExt *primitives.PrimitiveExtension `json:"_linkCanonical,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Link returns the option of link[x] that is set, or nil if none is.
+func (t *TestReportSetupActionAssertRequirement) Link() any {
+	switch {
+	case t.LinkURI != nil:
+		return t.LinkURI
+	case t.LinkCanonical != nil:
+		return t.LinkCanonical
+	}
+	return nil
+}
+
+// LinkType returns the FHIR type of the option of link[x] that is
+// set, such as "uri", or "" if none is.
+func (t *TestReportSetupActionAssertRequirement) LinkType() string {
+	switch {
+	case t.LinkURI != nil:
+		return "uri"
+	case t.LinkCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearLink unsets every option of link[x], and their extensions.
+func (t *TestReportSetupActionAssertRequirement) ClearLink() {
+	t.LinkURI = nil
+	t.LinkURIExt = nil
+	t.LinkCanonical = nil
+	t.LinkCanonicalExt = nil
+}
+
+// SetLinkURI sets the uri option of link[x], clearing the others.
+func (t *TestReportSetupActionAssertRequirement) SetLinkURI(value string) {
+	ext := t.LinkURIExt
+	t.ClearLink()
+	t.LinkURI, t.LinkURIExt = &value, ext
+}
+
+// SetLinkCanonical sets the canonical option of link[x], clearing the others.
+func (t *TestReportSetupActionAssertRequirement) SetLinkCanonical(value primitives.Canonical) {
+	ext := t.LinkCanonicalExt
+	t.ClearLink()
+	t.LinkCanonical, t.LinkCanonicalExt = &value, ext
+}
+
 // TestReportSetupActionAssert represents a FHIR BackboneElement for TestReport.setup.action.assert.
 type TestReportSetupActionAssert struct {
 	// Unique id for inter-element referencing
@@ -217,7 +261,7 @@ type TestReportTeardown struct {
 
 // TestReport represents a FHIR TestReport.
 type TestReport struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// External identifier
@@ -231,7 +275,7 @@ type TestReport struct {
 	// Extension for Status
 	StatusExt *primitives.PrimitiveExtension `json:"_status,omitempty" fhir:"cardinality=0..1"`
 	// Canonical URL to the  version-specific TestScript that was executed to produce this TestReport
-	TestScript string `json:"testScript" fhir:"cardinality=1..1,required,summary"`
+	TestScript primitives.Canonical `json:"testScript" fhir:"cardinality=1..1,required,summary"`
 	// Extension for TestScript
 	TestScriptExt *primitives.PrimitiveExtension `json:"_testScript,omitempty" fhir:"cardinality=0..1"`
 	// pass | fail | pending
@@ -239,7 +283,7 @@ type TestReport struct {
 	// Extension for Result
 	ResultExt *primitives.PrimitiveExtension `json:"_result,omitempty" fhir:"cardinality=0..1"`
 	// The final score (percentage of tests passed) resulting from the execution of the TestScript
-	Score *float64 `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
+	Score *primitives.Decimal `json:"score,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Score
 	ScoreExt *primitives.PrimitiveExtension `json:"_score,omitempty" fhir:"cardinality=0..1"`
 	// Name of the tester producing this report (Organization or individual)
@@ -259,3 +303,8 @@ type TestReport struct {
 	// The results of running the series of required clean up steps
 	Teardown *TestReportTeardown `json:"teardown,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "TestReport", the FHIR type of the resource.
+func (t *TestReport) ResourceType() string {
+	return ResourceTypeTestReport
+}
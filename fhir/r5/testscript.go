@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -112,7 +111,7 @@ type TestScriptMetadataCapability struct {
 	// Extension for Link
 	LinkExt *primitives.PrimitiveExtension `json:"_link,omitempty" fhir:"cardinality=0..1"`
 	// Required Capability Statement
-	Capabilities string `json:"capabilities" fhir:"cardinality=1..1,required"`
+	Capabilities primitives.Canonical `json:"capabilities" fhir:"cardinality=1..1,required"`
 	// Extension for Capabilities
 	CapabilitiesExt *primitives.PrimitiveExtension `json:"_capabilities,omitempty" fhir:"cardinality=0..1"`
 }
@@ -144,7 +143,7 @@ type TestScriptScope struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// The specific conformance artifact being tested
-	Artifact string `json:"artifact" fhir:"cardinality=1..1,required"`
+	Artifact primitives.Canonical `json:"artifact" fhir:"cardinality=1..1,required"`
 	// Extension for Artifact
 	ArtifactExt *primitives.PrimitiveExtension `json:"_artifact,omitempty" fhir:"cardinality=0..1"`
 	// required | optional | strict
@@ -326,15 +325,60 @@ type TestScriptSetupActionAssertRequirement struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Link or reference to the testing requirement - uri option
-	LinkURI *string `json:"linkURI,omitempty" fhir:"cardinality=0..1,choice=link"`
+	LinkURI *string `json:"linkUri,omitempty" fhir:"cardinality=0..1,choice=link"`
 	// Extension for LinkURI
-	LinkURIExt *primitives.PrimitiveExtension `json:"_linkURI,omitempty" fhir:"cardinality=0..1"`
+	LinkURIExt *primitives.PrimitiveExtension `json:"_linkUri,omitempty" fhir:"cardinality=0..1"`
 	// Link or reference to the testing requirement - canonical option
-	LinkCanonical *string `json:"linkCanonical,omitempty" fhir:"cardinality=0..1,choice=link"`
+	LinkCanonical *primitives.Canonical `json:"linkCanonical,omitempty" fhir:"cardinality=0..1,choice=link"`
 	// Extension for LinkCanonical
 	LinkCanonicalExt *primitives.PrimitiveExtension `json:"_linkCanonical,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Link returns the option of link[x] that is set, or nil if none is.
+func (t *TestScriptSetupActionAssertRequirement) Link() any {
+	switch {
+	case t.LinkURI != nil:
+		return t.LinkURI
+	case t.LinkCanonical != nil:
+		return t.LinkCanonical
+	}
+	return nil
+}
+
+// LinkType returns the FHIR type of the option of link[x] that is
+// set, such as "uri", or "" if none is.
+func (t *TestScriptSetupActionAssertRequirement) LinkType() string {
+	switch {
+	case t.LinkURI != nil:
+		return "uri"
+	case t.LinkCanonical != nil:
+		return "canonical"
+	}
+	return ""
+}
+
+// ClearLink unsets every option of link[x], and their extensions.
+func (t *TestScriptSetupActionAssertRequirement) ClearLink() {
+	t.LinkURI = nil
+	t.LinkURIExt = nil
+	t.LinkCanonical = nil
+	t.LinkCanonicalExt = nil
+}
+
+// SetLinkURI sets the uri option of link[x], clearing the others.
+func (t *TestScriptSetupActionAssertRequirement) SetLinkURI(value string) {
+	ext := t.LinkURIExt
+	t.ClearLink()
+	t.LinkURI, t.LinkURIExt = &value, ext
+}
+
+// SetLinkCanonical sets the canonical option of link[x], clearing the others.
+func (t *TestScriptSetupActionAssertRequirement) SetLinkCanonical(value primitives.Canonical) {
+	ext := t.LinkCanonicalExt
+	t.ClearLink()
+	t.LinkCanonical, t.LinkCanonicalExt = &value, ext
+}
+
 // TestScriptSetupActionAssert represents a FHIR BackboneElement for TestScript.setup.action.assert.
 type TestScriptSetupActionAssert struct {
 	// Unique id for inter-element referencing
@@ -555,7 +599,7 @@ type TestScriptTeardown struct {
 
 // TestScript represents a FHIR TestScript.
 type TestScript struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this test script, represented as a URI (globally unique)
@@ -631,7 +675,7 @@ type TestScript struct {
 	// Fixture in the test script - by reference (uri)
 	Fixture []TestScriptFixture `json:"fixture,omitempty" fhir:"cardinality=0..*"`
 	// Reference of the validation profile
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*"`
+	Profile []primitives.Canonical `json:"profile,omitempty" fhir:"cardinality=0..*"`
 	// Extension for Profile
 	ProfileExt *primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..1"`
 	// Placeholder for evaluated elements
@@ -643,3 +687,51 @@ type TestScript struct {
 	// A series of required clean up steps
 	Teardown *TestScriptTeardown `json:"teardown,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "TestScript", the FHIR type of the resource.
+func (t *TestScript) ResourceType() string {
+	return ResourceTypeTestScript
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (t *TestScript) VersionAlgorithm() any {
+	switch {
+	case t.VersionAlgorithmString != nil:
+		return t.VersionAlgorithmString
+	case t.VersionAlgorithmCoding != nil:
+		return t.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (t *TestScript) VersionAlgorithmType() string {
+	switch {
+	case t.VersionAlgorithmString != nil:
+		return "string"
+	case t.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (t *TestScript) ClearVersionAlgorithm() {
+	t.VersionAlgorithmString = nil
+	t.VersionAlgorithmStringExt = nil
+	t.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (t *TestScript) SetVersionAlgorithmString(value string) {
+	ext := t.VersionAlgorithmStringExt
+	t.ClearVersionAlgorithm()
+	t.VersionAlgorithmString, t.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (t *TestScript) SetVersionAlgorithmCoding(value Coding) {
+	t.ClearVersionAlgorithm()
+	t.VersionAlgorithmCoding = &value
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -24,47 +24,47 @@ type TimingRepeat struct {
 	// Length/Range of lengths, or (Start and/or end) limits - Period option
 	BoundsPeriod *Period `json:"boundsPeriod,omitempty" fhir:"cardinality=0..1,summary,choice=bounds"`
 	// Number of times to repeat
-	Count *int `json:"count,omitempty" fhir:"cardinality=0..1,summary"`
+	Count *int `json:"count,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for Count
 	CountExt *primitives.PrimitiveExtension `json:"_count,omitempty" fhir:"cardinality=0..1"`
 	// Maximum number of times to repeat
-	CountMax *int `json:"countMax,omitempty" fhir:"cardinality=0..1,summary"`
+	CountMax *int `json:"countMax,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for CountMax
 	CountMaxExt *primitives.PrimitiveExtension `json:"_countMax,omitempty" fhir:"cardinality=0..1"`
 	// How long when it happens
-	Duration *float64 `json:"duration,omitempty" fhir:"cardinality=0..1,summary"`
+	Duration *primitives.Decimal `json:"duration,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Duration
 	DurationExt *primitives.PrimitiveExtension `json:"_duration,omitempty" fhir:"cardinality=0..1"`
 	// How long when it happens (Max)
-	DurationMax *float64 `json:"durationMax,omitempty" fhir:"cardinality=0..1,summary"`
+	DurationMax *primitives.Decimal `json:"durationMax,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for DurationMax
 	DurationMaxExt *primitives.PrimitiveExtension `json:"_durationMax,omitempty" fhir:"cardinality=0..1"`
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	DurationUnit *string `json:"durationUnit,omitempty" fhir:"cardinality=0..1,summary"`
+	DurationUnit *UnitsOfTime `json:"durationUnit,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/units-of-time|5.0.0,summary,type=code"`
 	// Extension for DurationUnit
 	DurationUnitExt *primitives.PrimitiveExtension `json:"_durationUnit,omitempty" fhir:"cardinality=0..1"`
 	// Indicates the number of repetitions that should occur within a period. I.e. Event occurs frequency times per period
-	Frequency *int `json:"frequency,omitempty" fhir:"cardinality=0..1,summary"`
+	Frequency *int `json:"frequency,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for Frequency
 	FrequencyExt *primitives.PrimitiveExtension `json:"_frequency,omitempty" fhir:"cardinality=0..1"`
 	// Event occurs up to frequencyMax times per period
-	FrequencyMax *int `json:"frequencyMax,omitempty" fhir:"cardinality=0..1,summary"`
+	FrequencyMax *int `json:"frequencyMax,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for FrequencyMax
 	FrequencyMaxExt *primitives.PrimitiveExtension `json:"_frequencyMax,omitempty" fhir:"cardinality=0..1"`
 	// The duration to which the frequency applies. I.e. Event occurs frequency times per period
-	Period *float64 `json:"period,omitempty" fhir:"cardinality=0..1,summary"`
+	Period *primitives.Decimal `json:"period,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for Period
 	PeriodExt *primitives.PrimitiveExtension `json:"_period,omitempty" fhir:"cardinality=0..1"`
 	// Upper limit of period (3-4 hours)
-	PeriodMax *float64 `json:"periodMax,omitempty" fhir:"cardinality=0..1,summary"`
+	PeriodMax *primitives.Decimal `json:"periodMax,omitempty" fhir:"cardinality=0..1,summary"`
 	// Extension for PeriodMax
 	PeriodMaxExt *primitives.PrimitiveExtension `json:"_periodMax,omitempty" fhir:"cardinality=0..1"`
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	PeriodUnit *string `json:"periodUnit,omitempty" fhir:"cardinality=0..1,summary"`
+	PeriodUnit *UnitsOfTime `json:"periodUnit,omitempty" fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/units-of-time|5.0.0,summary,type=code"`
 	// Extension for PeriodUnit
 	PeriodUnitExt *primitives.PrimitiveExtension `json:"_periodUnit,omitempty" fhir:"cardinality=0..1"`
 	// mon | tue | wed | thu | fri | sat | sun
-	DayOfWeek []string `json:"dayOfWeek,omitempty" fhir:"cardinality=0..*,summary"`
+	DayOfWeek []DaysOfWeek `json:"dayOfWeek,omitempty" fhir:"cardinality=0..*,binding=required:http://hl7.org/fhir/ValueSet/days-of-week|5.0.0,summary,type=code"`
 	// Extension for DayOfWeek
 	DayOfWeekExt *primitives.PrimitiveExtension `json:"_dayOfWeek,omitempty" fhir:"cardinality=0..1"`
 	// Time of day for action
@@ -72,15 +72,67 @@ type TimingRepeat struct {
 	// Extension for TimeOfDay
 	TimeOfDayExt *primitives.PrimitiveExtension `json:"_timeOfDay,omitempty" fhir:"cardinality=0..1"`
 	// Code for time period of occurrence
-	When []string `json:"when,omitempty" fhir:"cardinality=0..*,summary"`
+	When []string `json:"when,omitempty" fhir:"cardinality=0..*,binding=required:http://hl7.org/fhir/ValueSet/event-timing|5.0.0,summary,type=code"`
 	// Extension for When
 	WhenExt *primitives.PrimitiveExtension `json:"_when,omitempty" fhir:"cardinality=0..1"`
 	// Minutes from event (before or after)
-	Offset *uint `json:"offset,omitempty" fhir:"cardinality=0..1,summary"`
+	Offset *uint `json:"offset,omitempty" fhir:"cardinality=0..1,summary,type=unsignedInt"`
 	// Extension for Offset
 	OffsetExt *primitives.PrimitiveExtension `json:"_offset,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Bounds returns the option of bounds[x] that is set, or nil if none is.
+func (t *TimingRepeat) Bounds() any {
+	switch {
+	case t.BoundsDuration != nil:
+		return t.BoundsDuration
+	case t.BoundsRange != nil:
+		return t.BoundsRange
+	case t.BoundsPeriod != nil:
+		return t.BoundsPeriod
+	}
+	return nil
+}
+
+// BoundsType returns the FHIR type of the option of bounds[x] that is
+// set, such as "Duration", or "" if none is.
+func (t *TimingRepeat) BoundsType() string {
+	switch {
+	case t.BoundsDuration != nil:
+		return "Duration"
+	case t.BoundsRange != nil:
+		return "Range"
+	case t.BoundsPeriod != nil:
+		return "Period"
+	}
+	return ""
+}
+
+// ClearBounds unsets every option of bounds[x], and their extensions.
+func (t *TimingRepeat) ClearBounds() {
+	t.BoundsDuration = nil
+	t.BoundsRange = nil
+	t.BoundsPeriod = nil
+}
+
+// SetBoundsDuration sets the Duration option of bounds[x], clearing the others.
+func (t *TimingRepeat) SetBoundsDuration(value Duration) {
+	t.ClearBounds()
+	t.BoundsDuration = &value
+}
+
+// SetBoundsRange sets the Range option of bounds[x], clearing the others.
+func (t *TimingRepeat) SetBoundsRange(value Range) {
+	t.ClearBounds()
+	t.BoundsRange = &value
+}
+
+// SetBoundsPeriod sets the Period option of bounds[x], clearing the others.
+func (t *TimingRepeat) SetBoundsPeriod(value Period) {
+	t.ClearBounds()
+	t.BoundsPeriod = &value
+}
+
 // Timing represents a FHIR Timing.
 type Timing struct {
 	// Unique id for inter-element referencing
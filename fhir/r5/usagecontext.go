@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -18,13 +18,76 @@ type UsageContext struct {
 	// Additional content defined by implementations
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 	// Type of context being specified
-	Code Coding `json:"code" fhir:"cardinality=1..1,required,summary"`
+	Code Coding `json:"code" fhir:"cardinality=1..1,required,binding=extensible:http://terminology.hl7.org/ValueSet/usage-context-type,summary"`
 	// Value that defines the context - CodeableConcept option
-	ValueCodeableConcept CodeableConcept `json:"valueCodeableConcept" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value that defines the context - Quantity option
-	ValueQuantity Quantity `json:"valueQuantity" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueQuantity *Quantity `json:"valueQuantity,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value that defines the context - Range option
-	ValueRange Range `json:"valueRange" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueRange *Range `json:"valueRange,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
 	// Value that defines the context - Reference option
-	ValueReference Reference `json:"valueReference" fhir:"cardinality=1..1,required,summary,choice=value"`
+	ValueReference *Reference `json:"valueReference,omitempty" fhir:"cardinality=1..1,required,summary,choice=value"`
+}
+
+// Value returns the option of value[x] that is set, or nil if none is.
+func (u *UsageContext) Value() any {
+	switch {
+	case u.ValueCodeableConcept != nil:
+		return u.ValueCodeableConcept
+	case u.ValueQuantity != nil:
+		return u.ValueQuantity
+	case u.ValueRange != nil:
+		return u.ValueRange
+	case u.ValueReference != nil:
+		return u.ValueReference
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "CodeableConcept", or "" if none is.
+func (u *UsageContext) ValueType() string {
+	switch {
+	case u.ValueCodeableConcept != nil:
+		return "CodeableConcept"
+	case u.ValueQuantity != nil:
+		return "Quantity"
+	case u.ValueRange != nil:
+		return "Range"
+	case u.ValueReference != nil:
+		return "Reference"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (u *UsageContext) ClearValue() {
+	u.ValueCodeableConcept = nil
+	u.ValueQuantity = nil
+	u.ValueRange = nil
+	u.ValueReference = nil
+}
+
+// SetValueCodeableConcept sets the CodeableConcept option of value[x], clearing the others.
+func (u *UsageContext) SetValueCodeableConcept(value CodeableConcept) {
+	u.ClearValue()
+	u.ValueCodeableConcept = &value
+}
+
+// SetValueQuantity sets the Quantity option of value[x], clearing the others.
+func (u *UsageContext) SetValueQuantity(value Quantity) {
+	u.ClearValue()
+	u.ValueQuantity = &value
+}
+
+// SetValueRange sets the Range option of value[x], clearing the others.
+func (u *UsageContext) SetValueRange(value Range) {
+	u.ClearValue()
+	u.ValueRange = &value
+}
+
+// SetValueReference sets the Reference option of value[x], clearing the others.
+func (u *UsageContext) SetValueReference(value Reference) {
+	u.ClearValue()
+	u.ValueReference = &value
 }
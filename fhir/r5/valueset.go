@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -106,7 +105,7 @@ type ValueSetComposeInclude struct {
 	// Select codes/concepts by their properties (including relationships)
 	Filter []ValueSetComposeIncludeFilter `json:"filter,omitempty" fhir:"cardinality=0..*,summary"`
 	// Select the contents included in this value set
-	ValueSet []string `json:"valueSet,omitempty" fhir:"cardinality=0..*,summary"`
+	ValueSet []primitives.Canonical `json:"valueSet,omitempty" fhir:"cardinality=0..*,summary"`
 	// Extension for ValueSet
 	ValueSetExt *primitives.PrimitiveExtension `json:"_valueSet,omitempty" fhir:"cardinality=0..1"`
 	// A copyright statement for the specific code system included in the value set
@@ -174,13 +173,13 @@ type ValueSetExpansionParameter struct {
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the named parameter - decimal option
-	ValueDecimal *float64 `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// Value of the named parameter - uri option
-	ValueURI *string `json:"valueURI,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueURI *string `json:"valueUri,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueURI
-	ValueURIExt *primitives.PrimitiveExtension `json:"_valueURI,omitempty" fhir:"cardinality=0..1"`
+	ValueURIExt *primitives.PrimitiveExtension `json:"_valueUri,omitempty" fhir:"cardinality=0..1"`
 	// Value of the named parameter - code option
 	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=0..1,choice=value"`
 	// Extension for ValueCode
@@ -191,6 +190,116 @@ type ValueSetExpansionParameter struct {
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (v *ValueSetExpansionParameter) Value() any {
+	switch {
+	case v.ValueString != nil:
+		return v.ValueString
+	case v.ValueBoolean != nil:
+		return v.ValueBoolean
+	case v.ValueInteger != nil:
+		return v.ValueInteger
+	case v.ValueDecimal != nil:
+		return v.ValueDecimal
+	case v.ValueURI != nil:
+		return v.ValueURI
+	case v.ValueCode != nil:
+		return v.ValueCode
+	case v.ValueDateTime != nil:
+		return v.ValueDateTime
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "string", or "" if none is.
+func (v *ValueSetExpansionParameter) ValueType() string {
+	switch {
+	case v.ValueString != nil:
+		return "string"
+	case v.ValueBoolean != nil:
+		return "boolean"
+	case v.ValueInteger != nil:
+		return "integer"
+	case v.ValueDecimal != nil:
+		return "decimal"
+	case v.ValueURI != nil:
+		return "uri"
+	case v.ValueCode != nil:
+		return "code"
+	case v.ValueDateTime != nil:
+		return "dateTime"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (v *ValueSetExpansionParameter) ClearValue() {
+	v.ValueString = nil
+	v.ValueStringExt = nil
+	v.ValueBoolean = nil
+	v.ValueBooleanExt = nil
+	v.ValueInteger = nil
+	v.ValueIntegerExt = nil
+	v.ValueDecimal = nil
+	v.ValueDecimalExt = nil
+	v.ValueURI = nil
+	v.ValueURIExt = nil
+	v.ValueCode = nil
+	v.ValueCodeExt = nil
+	v.ValueDateTime = nil
+	v.ValueDateTimeExt = nil
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueString(value string) {
+	ext := v.ValueStringExt
+	v.ClearValue()
+	v.ValueString, v.ValueStringExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueBoolean(value bool) {
+	ext := v.ValueBooleanExt
+	v.ClearValue()
+	v.ValueBoolean, v.ValueBooleanExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueInteger(value int) {
+	ext := v.ValueIntegerExt
+	v.ClearValue()
+	v.ValueInteger, v.ValueIntegerExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueDecimal(value primitives.Decimal) {
+	ext := v.ValueDecimalExt
+	v.ClearValue()
+	v.ValueDecimal, v.ValueDecimalExt = &value, ext
+}
+
+// SetValueURI sets the uri option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueURI(value string) {
+	ext := v.ValueURIExt
+	v.ClearValue()
+	v.ValueURI, v.ValueURIExt = &value, ext
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueCode(value string) {
+	ext := v.ValueCodeExt
+	v.ClearValue()
+	v.ValueCode, v.ValueCodeExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (v *ValueSetExpansionParameter) SetValueDateTime(value primitives.DateTime) {
+	ext := v.ValueDateTimeExt
+	v.ClearValue()
+	v.ValueDateTime, v.ValueDateTimeExt = &value, ext
+}
+
 // ValueSetExpansionProperty represents a FHIR BackboneElement for ValueSet.expansion.property.
 type ValueSetExpansionProperty struct {
 	// Unique id for inter-element referencing
@@ -230,33 +339,141 @@ type ValueSetExpansionContainsPropertySubProperty struct {
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Value of the subproperty for this concept - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Value of the subproperty for this concept - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the subproperty for this concept - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of the subproperty for this concept - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the subproperty for this concept - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of the subproperty for this concept - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of the subproperty for this concept - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (v *ValueSetExpansionContainsPropertySubProperty) Value() any {
+	switch {
+	case v.ValueCode != nil:
+		return v.ValueCode
+	case v.ValueCoding != nil:
+		return v.ValueCoding
+	case v.ValueString != nil:
+		return v.ValueString
+	case v.ValueInteger != nil:
+		return v.ValueInteger
+	case v.ValueBoolean != nil:
+		return v.ValueBoolean
+	case v.ValueDateTime != nil:
+		return v.ValueDateTime
+	case v.ValueDecimal != nil:
+		return v.ValueDecimal
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "code", or "" if none is.
+func (v *ValueSetExpansionContainsPropertySubProperty) ValueType() string {
+	switch {
+	case v.ValueCode != nil:
+		return "code"
+	case v.ValueCoding != nil:
+		return "Coding"
+	case v.ValueString != nil:
+		return "string"
+	case v.ValueInteger != nil:
+		return "integer"
+	case v.ValueBoolean != nil:
+		return "boolean"
+	case v.ValueDateTime != nil:
+		return "dateTime"
+	case v.ValueDecimal != nil:
+		return "decimal"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (v *ValueSetExpansionContainsPropertySubProperty) ClearValue() {
+	v.ValueCode = nil
+	v.ValueCodeExt = nil
+	v.ValueCoding = nil
+	v.ValueString = nil
+	v.ValueStringExt = nil
+	v.ValueInteger = nil
+	v.ValueIntegerExt = nil
+	v.ValueBoolean = nil
+	v.ValueBooleanExt = nil
+	v.ValueDateTime = nil
+	v.ValueDateTimeExt = nil
+	v.ValueDecimal = nil
+	v.ValueDecimalExt = nil
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueCode(value string) {
+	ext := v.ValueCodeExt
+	v.ClearValue()
+	v.ValueCode, v.ValueCodeExt = &value, ext
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueCoding(value Coding) {
+	v.ClearValue()
+	v.ValueCoding = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueString(value string) {
+	ext := v.ValueStringExt
+	v.ClearValue()
+	v.ValueString, v.ValueStringExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueInteger(value int) {
+	ext := v.ValueIntegerExt
+	v.ClearValue()
+	v.ValueInteger, v.ValueIntegerExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueBoolean(value bool) {
+	ext := v.ValueBooleanExt
+	v.ClearValue()
+	v.ValueBoolean, v.ValueBooleanExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueDateTime(value primitives.DateTime) {
+	ext := v.ValueDateTimeExt
+	v.ClearValue()
+	v.ValueDateTime, v.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsPropertySubProperty) SetValueDecimal(value primitives.Decimal) {
+	ext := v.ValueDecimalExt
+	v.ClearValue()
+	v.ValueDecimal, v.ValueDecimalExt = &value, ext
+}
+
 // ValueSetExpansionContainsProperty represents a FHIR BackboneElement for ValueSet.expansion.contains.property.
 type ValueSetExpansionContainsProperty struct {
 	// Unique id for inter-element referencing
@@ -272,35 +489,143 @@ type ValueSetExpansionContainsProperty struct {
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - code option
-	ValueCode string `json:"valueCode" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCode *string `json:"valueCode,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueCode
 	ValueCodeExt *primitives.PrimitiveExtension `json:"_valueCode,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - Coding option
-	ValueCoding Coding `json:"valueCoding" fhir:"cardinality=1..1,required,choice=value"`
+	ValueCoding *Coding `json:"valueCoding,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Value of the property for this concept - string option
-	ValueString string `json:"valueString" fhir:"cardinality=1..1,required,choice=value"`
+	ValueString *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueString
 	ValueStringExt *primitives.PrimitiveExtension `json:"_valueString,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - integer option
-	ValueInteger int `json:"valueInteger" fhir:"cardinality=1..1,required,choice=value"`
+	ValueInteger *int `json:"valueInteger,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueInteger
 	ValueIntegerExt *primitives.PrimitiveExtension `json:"_valueInteger,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - boolean option
-	ValueBoolean bool `json:"valueBoolean" fhir:"cardinality=1..1,required,choice=value"`
+	ValueBoolean *bool `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueBoolean
 	ValueBooleanExt *primitives.PrimitiveExtension `json:"_valueBoolean,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - dateTime option
-	ValueDateTime primitives.DateTime `json:"valueDateTime" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDateTime *primitives.DateTime `json:"valueDateTime,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDateTime
 	ValueDateTimeExt *primitives.PrimitiveExtension `json:"_valueDateTime,omitempty" fhir:"cardinality=0..1"`
 	// Value of the property for this concept - decimal option
-	ValueDecimal float64 `json:"valueDecimal" fhir:"cardinality=1..1,required,choice=value"`
+	ValueDecimal *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=1..1,required,choice=value"`
 	// Extension for ValueDecimal
 	ValueDecimalExt *primitives.PrimitiveExtension `json:"_valueDecimal,omitempty" fhir:"cardinality=0..1"`
 	// SubProperty value for the concept
 	SubProperty []ValueSetExpansionContainsPropertySubProperty `json:"subProperty,omitempty" fhir:"cardinality=0..*"`
 }
 
+// Value returns the option of value[x] that is set, or nil if none is.
+func (v *ValueSetExpansionContainsProperty) Value() any {
+	switch {
+	case v.ValueCode != nil:
+		return v.ValueCode
+	case v.ValueCoding != nil:
+		return v.ValueCoding
+	case v.ValueString != nil:
+		return v.ValueString
+	case v.ValueInteger != nil:
+		return v.ValueInteger
+	case v.ValueBoolean != nil:
+		return v.ValueBoolean
+	case v.ValueDateTime != nil:
+		return v.ValueDateTime
+	case v.ValueDecimal != nil:
+		return v.ValueDecimal
+	}
+	return nil
+}
+
+// ValueType returns the FHIR type of the option of value[x] that is
+// set, such as "code", or "" if none is.
+func (v *ValueSetExpansionContainsProperty) ValueType() string {
+	switch {
+	case v.ValueCode != nil:
+		return "code"
+	case v.ValueCoding != nil:
+		return "Coding"
+	case v.ValueString != nil:
+		return "string"
+	case v.ValueInteger != nil:
+		return "integer"
+	case v.ValueBoolean != nil:
+		return "boolean"
+	case v.ValueDateTime != nil:
+		return "dateTime"
+	case v.ValueDecimal != nil:
+		return "decimal"
+	}
+	return ""
+}
+
+// ClearValue unsets every option of value[x], and their extensions.
+func (v *ValueSetExpansionContainsProperty) ClearValue() {
+	v.ValueCode = nil
+	v.ValueCodeExt = nil
+	v.ValueCoding = nil
+	v.ValueString = nil
+	v.ValueStringExt = nil
+	v.ValueInteger = nil
+	v.ValueIntegerExt = nil
+	v.ValueBoolean = nil
+	v.ValueBooleanExt = nil
+	v.ValueDateTime = nil
+	v.ValueDateTimeExt = nil
+	v.ValueDecimal = nil
+	v.ValueDecimalExt = nil
+}
+
+// SetValueCode sets the code option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueCode(value string) {
+	ext := v.ValueCodeExt
+	v.ClearValue()
+	v.ValueCode, v.ValueCodeExt = &value, ext
+}
+
+// SetValueCoding sets the Coding option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueCoding(value Coding) {
+	v.ClearValue()
+	v.ValueCoding = &value
+}
+
+// SetValueString sets the string option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueString(value string) {
+	ext := v.ValueStringExt
+	v.ClearValue()
+	v.ValueString, v.ValueStringExt = &value, ext
+}
+
+// SetValueInteger sets the integer option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueInteger(value int) {
+	ext := v.ValueIntegerExt
+	v.ClearValue()
+	v.ValueInteger, v.ValueIntegerExt = &value, ext
+}
+
+// SetValueBoolean sets the boolean option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueBoolean(value bool) {
+	ext := v.ValueBooleanExt
+	v.ClearValue()
+	v.ValueBoolean, v.ValueBooleanExt = &value, ext
+}
+
+// SetValueDateTime sets the dateTime option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueDateTime(value primitives.DateTime) {
+	ext := v.ValueDateTimeExt
+	v.ClearValue()
+	v.ValueDateTime, v.ValueDateTimeExt = &value, ext
+}
+
+// SetValueDecimal sets the decimal option of value[x], clearing the others.
+func (v *ValueSetExpansionContainsProperty) SetValueDecimal(value primitives.Decimal) {
+	ext := v.ValueDecimalExt
+	v.ClearValue()
+	v.ValueDecimal, v.ValueDecimalExt = &value, ext
+}
+
 // ValueSetExpansionContainsContains represents a FHIR BackboneElement for ValueSet.expansion.contains.contains.
 type ValueSetExpansionContainsContains struct {
 }
@@ -407,7 +732,7 @@ type ValueSetScope struct {
 
 // ValueSet represents a FHIR ValueSet.
 type ValueSet struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Canonical identifier for this value set, represented as a URI (globally unique)
@@ -505,3 +830,51 @@ type ValueSet struct {
 	// Description of the semantic space the Value Set Expansion is intended to cover and should further clarify the text in ValueSet.description
 	Scope *ValueSetScope `json:"scope,omitempty" fhir:"cardinality=0..1"`
 }
+
+// ResourceType returns "ValueSet", the FHIR type of the resource.
+func (v *ValueSet) ResourceType() string {
+	return ResourceTypeValueSet
+}
+
+// VersionAlgorithm returns the option of versionAlgorithm[x] that is set, or nil if none is.
+func (v *ValueSet) VersionAlgorithm() any {
+	switch {
+	case v.VersionAlgorithmString != nil:
+		return v.VersionAlgorithmString
+	case v.VersionAlgorithmCoding != nil:
+		return v.VersionAlgorithmCoding
+	}
+	return nil
+}
+
+// VersionAlgorithmType returns the FHIR type of the option of versionAlgorithm[x] that is
+// set, such as "string", or "" if none is.
+func (v *ValueSet) VersionAlgorithmType() string {
+	switch {
+	case v.VersionAlgorithmString != nil:
+		return "string"
+	case v.VersionAlgorithmCoding != nil:
+		return "Coding"
+	}
+	return ""
+}
+
+// ClearVersionAlgorithm unsets every option of versionAlgorithm[x], and their extensions.
+func (v *ValueSet) ClearVersionAlgorithm() {
+	v.VersionAlgorithmString = nil
+	v.VersionAlgorithmStringExt = nil
+	v.VersionAlgorithmCoding = nil
+}
+
+// SetVersionAlgorithmString sets the string option of versionAlgorithm[x], clearing the others.
+func (v *ValueSet) SetVersionAlgorithmString(value string) {
+	ext := v.VersionAlgorithmStringExt
+	v.ClearVersionAlgorithm()
+	v.VersionAlgorithmString, v.VersionAlgorithmStringExt = &value, ext
+}
+
+// SetVersionAlgorithmCoding sets the Coding option of versionAlgorithm[x], clearing the others.
+func (v *ValueSet) SetVersionAlgorithmCoding(value Coding) {
+	v.ClearVersionAlgorithm()
+	v.VersionAlgorithmCoding = &value
+}
@@ -0,0 +1,573 @@
+package bd
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+const (
+	// SystemBDGeocode is the CodeSystem holding every administrative unit keyed by BBS geocode.
+	SystemBDGeocode = "https://health.zarishsphere.com/fhir/CodeSystem/bd-geocode"
+
+	// SystemBDUpazilas is the canonical URL of the ValueSet of the upazilas
+	// in the geocode CodeSystem. It lists only the upazilas in the embedded
+	// geography (see GeographyCodeSystem).
+	SystemBDUpazilas = "https://health.zarishsphere.com/fhir/ValueSet/bd-upazilas"
+	// SystemBDUnions is the canonical URL of the ValueSet of the unions and
+	// municipal wards in the geocode CodeSystem. It lists only the unions in
+	// the embedded geography.
+	SystemBDUnions = "https://health.zarishsphere.com/fhir/ValueSet/bd-unions"
+
+	// LanguageBangla is the BCP-47 tag used for Bangla designations.
+	LanguageBangla = "bn"
+)
+
+var (
+	// ErrUnknownGeoCode is returned when a code or name is not in the geography.
+	ErrUnknownGeoCode = errors.New("unknown Bangladesh geography code")
+
+	// ErrGeoHierarchy is returned when a unit does not belong to its stated parent.
+	ErrGeoHierarchy = errors.New("inconsistent Bangladesh geography hierarchy")
+)
+
+// AdminLevel is a tier of the Bangladesh administrative hierarchy.
+type AdminLevel int
+
+const (
+	LevelDivision AdminLevel = iota + 1
+	LevelDistrict
+	LevelUpazila
+	LevelUnion // union parishad or municipal ward
+)
+
+// String returns the lower-case name of the level.
+func (l AdminLevel) String() string {
+	switch l {
+	case LevelDivision:
+		return "division"
+	case LevelDistrict:
+		return "district"
+	case LevelUpazila:
+		return "upazila"
+	case LevelUnion:
+		return "union"
+	default:
+		return fmt.Sprintf("AdminLevel(%d)", int(l))
+	}
+}
+
+// ValueSetURL returns the canonical URL of the ValueSet listing every unit at this level.
+func (l AdminLevel) ValueSetURL() string {
+	switch l {
+	case LevelDivision:
+		return SystemBDDivisions
+	case LevelDistrict:
+		return SystemBDDistricts
+	case LevelUpazila:
+		return SystemBDUpazilas
+	case LevelUnion:
+		return SystemBDUnions
+	default:
+		return ""
+	}
+}
+
+// levelOf derives the level from the length of a BBS geocode.
+func levelOf(code string) AdminLevel {
+	switch len(code) {
+	case 2:
+		return LevelDivision
+	case 4:
+		return LevelDistrict
+	case 6:
+		return LevelUpazila
+	case 8:
+		return LevelUnion
+	default:
+		return 0
+	}
+}
+
+// GeoUnit is a single administrative area identified by its BBS geocode.
+type GeoUnit struct {
+	Code    string     // Concatenated BBS geocode, e.g. "202294" for Ukhiya
+	Level   AdminLevel // Tier derived from the code length
+	Name    string     // English name
+	NameBN  string     // Bangla name
+	Aliases []string   // Alternate spellings and legacy short codes
+}
+
+// ParentCode returns the geocode of the enclosing unit, or "" for a division.
+func (g GeoUnit) ParentCode() string {
+	if g.Level <= LevelDivision {
+		return ""
+	}
+	return g.Code[:len(g.Code)-2]
+}
+
+// Coding returns an r5.Coding for the unit in the geocode CodeSystem.
+func (g GeoUnit) Coding() *r5.Coding {
+	system := SystemBDGeocode
+	code := g.Code
+	display := g.Name
+	return &r5.Coding{
+		System:  &system,
+		Code:    &code,
+		Display: &display,
+	}
+}
+
+// CodeableConcept returns an r5.CodeableConcept carrying the unit's coding and English name.
+func (g GeoUnit) CodeableConcept() *r5.CodeableConcept {
+	text := g.Name
+	return &r5.CodeableConcept{
+		Coding: []r5.Coding{*g.Coding()},
+		Text:   &text,
+	}
+}
+
+// matches reports whether s is the unit's code, name, Bangla name or an alias.
+func (g GeoUnit) matches(s string) bool {
+	if s == g.Code || s == g.NameBN || strings.EqualFold(s, g.Name) {
+		return true
+	}
+	for _, a := range g.Aliases {
+		if strings.EqualFold(s, a) {
+			return true
+		}
+	}
+	return false
+}
+
+//go:embed geography.csv
+var geographyCSV []byte
+
+type geography struct {
+	units    map[string]GeoUnit
+	children map[string][]string // parent code ("" for the root) -> child codes
+}
+
+var loadGeography = sync.OnceValue(func() *geography {
+	g, err := parseGeography(bytes.NewReader(geographyCSV))
+	if err != nil {
+		panic(fmt.Sprintf("bd: invalid embedded geography: %v", err))
+	}
+	return g
+})
+
+func parseGeography(r io.Reader) (*geography, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = 4
+
+	g := &geography{
+		units:    make(map[string]GeoUnit),
+		children: make(map[string][]string),
+	}
+
+	header := true
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header {
+			header = false
+			continue
+		}
+
+		u := GeoUnit{
+			Code:   strings.TrimSpace(rec[0]),
+			Name:   strings.TrimSpace(rec[1]),
+			NameBN: strings.TrimSpace(rec[2]),
+		}
+		u.Level = levelOf(u.Code)
+		if u.Level == 0 {
+			return nil, fmt.Errorf("code %q has no valid geocode length", u.Code)
+		}
+		if aliases := strings.TrimSpace(rec[3]); aliases != "" {
+			u.Aliases = strings.Split(aliases, "|")
+		}
+		if _, dup := g.units[u.Code]; dup {
+			return nil, fmt.Errorf("duplicate code %q", u.Code)
+		}
+
+		parent := u.ParentCode()
+		if parent != "" {
+			if _, ok := g.units[parent]; !ok {
+				return nil, fmt.Errorf("code %q listed before its parent %q", u.Code, parent)
+			}
+		}
+
+		g.units[u.Code] = u
+		g.children[parent] = append(g.children[parent], u.Code)
+	}
+
+	for _, codes := range g.children {
+		sort.Strings(codes)
+	}
+	return g, nil
+}
+
+func (g *geography) list(codes []string) []GeoUnit {
+	out := make([]GeoUnit, 0, len(codes))
+	for _, c := range codes {
+		out = append(out, g.units[c])
+	}
+	return out
+}
+
+// LookupGeo returns the unit with the given BBS geocode.
+// Division short codes such as "DH" or "CTG" are also accepted.
+func LookupGeo(code string) (GeoUnit, bool) {
+	g := loadGeography()
+	if u, ok := g.units[code]; ok {
+		return u, true
+	}
+	for _, c := range g.children[""] {
+		if g.units[c].matches(code) {
+			return g.units[c], true
+		}
+	}
+	return GeoUnit{}, false
+}
+
+// FindGeo resolves a code, English name, Bangla name or alias at the given level.
+// When parent is non-empty the search is restricted to its direct children,
+// which disambiguates names shared across districts (e.g. "Nawabganj").
+func FindGeo(level AdminLevel, parent, nameOrCode string) (GeoUnit, bool) {
+	g := loadGeography()
+	nameOrCode = strings.TrimSpace(nameOrCode)
+	if nameOrCode == "" {
+		return GeoUnit{}, false
+	}
+
+	var candidates []string
+	if parent != "" {
+		p, ok := LookupGeo(parent)
+		if !ok || p.Level != level-1 {
+			return GeoUnit{}, false
+		}
+		candidates = g.children[p.Code]
+	} else {
+		for code, u := range g.units {
+			if u.Level == level {
+				candidates = append(candidates, code)
+			}
+		}
+		sort.Strings(candidates)
+	}
+
+	for _, c := range candidates {
+		if u := g.units[c]; u.matches(nameOrCode) {
+			return u, true
+		}
+	}
+	return GeoUnit{}, false
+}
+
+// ParentOf returns the unit enclosing the given code.
+// It returns false for divisions and unknown codes.
+func ParentOf(code string) (GeoUnit, bool) {
+	u, ok := LookupGeo(code)
+	if !ok || u.Level == LevelDivision {
+		return GeoUnit{}, false
+	}
+	return LookupGeo(u.ParentCode())
+}
+
+// ChildrenOf returns the units directly below the given code, sorted by geocode.
+func ChildrenOf(code string) []GeoUnit {
+	u, ok := LookupGeo(code)
+	if !ok {
+		return nil
+	}
+	g := loadGeography()
+	return g.list(g.children[u.Code])
+}
+
+// AllDivisions returns the eight divisions sorted by geocode.
+func AllDivisions() []GeoUnit {
+	g := loadGeography()
+	return g.list(g.children[""])
+}
+
+// DistrictsOf returns the districts of a division.
+func DistrictsOf(division string) []GeoUnit {
+	return childrenAt(LevelDivision, division)
+}
+
+// UpazilasOf returns the upazilas of a district.
+func UpazilasOf(district string) []GeoUnit {
+	return childrenAt(LevelDistrict, district)
+}
+
+// UnionsOf returns the unions and wards of an upazila.
+func UnionsOf(upazila string) []GeoUnit {
+	return childrenAt(LevelUpazila, upazila)
+}
+
+func childrenAt(level AdminLevel, code string) []GeoUnit {
+	u, ok := LookupGeo(code)
+	if !ok || u.Level != level {
+		return nil
+	}
+	return ChildrenOf(u.Code)
+}
+
+// IsWithin reports whether code lies inside ancestor at any depth.
+func IsWithin(code, ancestor string) bool {
+	u, ok := LookupGeo(code)
+	if !ok {
+		return false
+	}
+	a, ok := LookupGeo(ancestor)
+	if !ok || a.Level >= u.Level {
+		return false
+	}
+	return strings.HasPrefix(u.Code, a.Code)
+}
+
+// GetGeoCoding returns an r5.Coding for a geocode, or nil if the code is unknown.
+func GetGeoCoding(code string) *r5.Coding {
+	if u, ok := LookupGeo(code); ok {
+		return u.Coding()
+	}
+	return nil
+}
+
+// ValidateGeoHierarchy checks that each non-empty code exists at its level and
+// lies inside the next higher non-empty code. Any argument may be empty.
+func ValidateGeoHierarchy(division, district, upazila, union string) error {
+	levels := []struct {
+		level AdminLevel
+		code  string
+	}{
+		{LevelDivision, division},
+		{LevelDistrict, district},
+		{LevelUpazila, upazila},
+		{LevelUnion, union},
+	}
+
+	var parent GeoUnit
+	for _, l := range levels {
+		if l.code == "" {
+			continue
+		}
+		u, ok := LookupGeo(l.code)
+		if !ok || u.Level != l.level {
+			return fmt.Errorf("%w: %s %q", ErrUnknownGeoCode, l.level, l.code)
+		}
+		if parent.Code != "" && !strings.HasPrefix(u.Code, parent.Code) {
+			return fmt.Errorf("%w: %s %s (%s) is not in %s %s (%s)",
+				ErrGeoHierarchy, u.Level, u.Name, u.Code, parent.Level, parent.Name, parent.Code)
+		}
+		parent = u
+	}
+	return nil
+}
+
+// ValidateAddress checks a plain r5.Address whose State holds the division and
+// District holds the district, by name or geocode. Addresses outside Bangladesh
+// and addresses without either part are accepted.
+func ValidateAddress(addr *r5.Address) error {
+	if addr == nil {
+		return nil
+	}
+	if addr.Country != nil && !isBangladesh(*addr.Country) {
+		return nil
+	}
+
+	var division, district GeoUnit
+	if addr.State != nil && *addr.State != "" {
+		u, ok := FindGeo(LevelDivision, "", *addr.State)
+		if !ok {
+			return fmt.Errorf("%w: division %q", ErrUnknownGeoCode, *addr.State)
+		}
+		division = u
+	}
+	if addr.District != nil && *addr.District != "" {
+		u, ok := FindGeo(LevelDistrict, division.Code, *addr.District)
+		if !ok {
+			if division.Code != "" {
+				if _, exists := FindGeo(LevelDistrict, "", *addr.District); exists {
+					return fmt.Errorf("%w: district %q is not in division %s",
+						ErrGeoHierarchy, *addr.District, division.Name)
+				}
+			}
+			return fmt.Errorf("%w: district %q", ErrUnknownGeoCode, *addr.District)
+		}
+		district = u
+	}
+	return ValidateGeoHierarchy(division.Code, district.Code, "", "")
+}
+
+func isBangladesh(country string) bool {
+	switch strings.ToUpper(strings.TrimSpace(country)) {
+	case "BD", "BGD", "BANGLADESH", "বাংলাদেশ":
+		return true
+	}
+	return false
+}
+
+// GeographyCodeSystem returns the geocode CodeSystem with Bangla designations.
+// Each unit below a division carries a "parent" property holding the geocode
+// of the enclosing unit. The embedded geography lists every division and
+// district, but only the upazilas of Dhaka and Cox's Bazar districts and the
+// unions of Teknaf and Ukhiya, so the CodeSystem is published as a fragment.
+func GeographyCodeSystem() *r5.CodeSystem {
+	g := loadGeography()
+	codes := make([]string, 0, len(g.units))
+	for c := range g.units {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	concepts := make([]r5.CodeSystemConcept, 0, len(codes))
+	for _, c := range codes {
+		u := g.units[c]
+		name := u.Name
+		definition := u.Level.String()
+		concept := r5.CodeSystemConcept{
+			Code:       u.Code,
+			Display:    &name,
+			Definition: &definition,
+		}
+		if p, ok := g.units[u.ParentCode()]; ok {
+			definition = fmt.Sprintf("%s in %s %s", u.Level, p.Level, p.Name)
+			parent := p.Code
+			concept.Property = []r5.CodeSystemConceptProperty{{Code: "parent", ValueCode: &parent}}
+		}
+		if u.NameBN != "" {
+			lang := LanguageBangla
+			concept.Designation = []r5.CodeSystemConceptDesignation{{Language: &lang, Value: u.NameBN}}
+		}
+		concepts = append(concepts, concept)
+	}
+
+	url := SystemBDGeocode
+	id := "bd-geocode"
+	name := "BDGeocode"
+	title := "Bangladesh Administrative Geocodes"
+	description := "Divisions, districts, upazilas and unions of Bangladesh keyed by BBS geocode. " +
+		"Every division and district is listed; upazilas only for Dhaka and Cox's Bazar districts, " +
+		"and unions only for Teknaf and Ukhiya upazilas."
+	hierarchy := "part-of"
+	caseSensitive := true
+	parentURI := "http://hl7.org/fhir/concept-properties#parent"
+	parentDescription := "The geocode of the enclosing administrative unit"
+	return &r5.CodeSystem{
+		DomainResource:   r5.DomainResource{BaseResource: r5.BaseResource{ResourceType: "CodeSystem", ID: &id}},
+		URL:              &url,
+		Name:             &name,
+		Title:            &title,
+		Status:           "active",
+		Description:      &description,
+		CaseSensitive:    &caseSensitive,
+		HierarchyMeaning: &hierarchy,
+		Content:          "fragment",
+		Property: []r5.CodeSystemProperty{{
+			Code:        "parent",
+			URI:         &parentURI,
+			Description: &parentDescription,
+			Type:        "code",
+		}},
+		Concept: concepts,
+	}
+}
+
+// GeographyValueSet returns the ValueSet of every unit at a level.
+func GeographyValueSet(level AdminLevel) *r5.ValueSet {
+	g := loadGeography()
+	var units []GeoUnit
+	for _, u := range g.units {
+		if u.Level == level {
+			units = append(units, u)
+		}
+	}
+	sort.Slice(units, func(i, j int) bool { return units[i].Code < units[j].Code })
+
+	url := level.ValueSetURL()
+	id := "bd-" + level.String() + "s"
+	title := "Bangladesh " + strings.ToUpper(level.String()[:1]) + level.String()[1:] + "s"
+	return geographyValueSet(id, url, title, units)
+}
+
+// GeographyValueSetOf returns a ValueSet of the direct children of a unit,
+// e.g. the districts of a division. It returns nil for unknown codes.
+func GeographyValueSetOf(code string) *r5.ValueSet {
+	u, ok := LookupGeo(code)
+	if !ok {
+		return nil
+	}
+	children := ChildrenOf(u.Code)
+	if len(children) == 0 {
+		return nil
+	}
+	childLevel := u.Level + 1
+	id := fmt.Sprintf("bd-%ss-%s", childLevel, u.Code)
+	url := fmt.Sprintf("%s-%s", childLevel.ValueSetURL(), u.Code)
+	title := fmt.Sprintf("%ss of %s", strings.ToUpper(childLevel.String()[:1])+childLevel.String()[1:], u.Name)
+	return geographyValueSet(id, url, title, children)
+}
+
+// GeographyValueSets returns the per-level ValueSets followed by one ValueSet
+// per parent unit, ready to register with a terminology server.
+func GeographyValueSets() []*r5.ValueSet {
+	sets := []*r5.ValueSet{
+		GeographyValueSet(LevelDivision),
+		GeographyValueSet(LevelDistrict),
+		GeographyValueSet(LevelUpazila),
+		GeographyValueSet(LevelUnion),
+	}
+	g := loadGeography()
+	parents := make([]string, 0, len(g.children))
+	for p := range g.children {
+		if p != "" {
+			parents = append(parents, p)
+		}
+	}
+	sort.Strings(parents)
+	for _, p := range parents {
+		if vs := GeographyValueSetOf(p); vs != nil {
+			sets = append(sets, vs)
+		}
+	}
+	return sets
+}
+
+func geographyValueSet(id, url, title string, units []GeoUnit) *r5.ValueSet {
+	concepts := make([]r5.ValueSetComposeIncludeConcept, 0, len(units))
+	for _, u := range units {
+		display := u.Name
+		concept := r5.ValueSetComposeIncludeConcept{Code: u.Code, Display: &display}
+		if u.NameBN != "" {
+			lang := LanguageBangla
+			concept.Designation = []r5.ValueSetComposeIncludeConceptDesignation{{Language: &lang, Value: u.NameBN}}
+		}
+		concepts = append(concepts, concept)
+	}
+
+	system := SystemBDGeocode
+	return &r5.ValueSet{
+		DomainResource: r5.DomainResource{BaseResource: r5.BaseResource{ResourceType: "ValueSet", ID: &id}},
+		URL:            &url,
+		Title:          &title,
+		Status:         "active",
+		Compose: &r5.ValueSetCompose{
+			Include: []r5.ValueSetComposeInclude{{System: &system, Concept: concepts}},
+		},
+	}
+}
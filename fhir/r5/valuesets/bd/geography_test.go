@@ -0,0 +1,241 @@
+package bd
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+func TestGeography_Counts(t *testing.T) {
+	assert.Len(t, AllDivisions(), 8)
+
+	districts := 0
+	for _, d := range AllDivisions() {
+		districts += len(DistrictsOf(d.Code))
+	}
+	assert.Equal(t, 64, districts)
+}
+
+// TestGeography_Scope pins the districts with upazilas and the upazilas with
+// unions in the embedded geography, which doesn't hold the whole BBS
+// gazetteer yet.
+func TestGeography_Scope(t *testing.T) {
+	var withUpazilas, withUnions []string
+	for _, u := range loadGeography().units {
+		switch {
+		case u.Level == LevelDistrict && len(UpazilasOf(u.Code)) > 0:
+			withUpazilas = append(withUpazilas, u.Name)
+		case u.Level == LevelUpazila && len(UnionsOf(u.Code)) > 0:
+			withUnions = append(withUnions, u.Name)
+		}
+	}
+	assert.ElementsMatch(t, []string{"Cox's Bazar", "Dhaka"}, withUpazilas)
+	assert.ElementsMatch(t, []string{"Teknaf", "Ukhiya"}, withUnions)
+}
+
+func TestGeography_EveryUnitHasBanglaName(t *testing.T) {
+	for _, u := range loadGeography().units {
+		assert.NotEmpty(t, u.NameBN, "unit %s (%s) has no Bangla name", u.Code, u.Name)
+	}
+}
+
+func TestLookupGeo(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		wantCode string
+		wantOK   bool
+	}{
+		{name: "division geocode", code: "20", wantCode: "20", wantOK: true},
+		{name: "documented short code", code: "CTG", wantCode: "20", wantOK: true},
+		{name: "legacy short code", code: "RJ", wantCode: "50", wantOK: true},
+		{name: "upazila geocode", code: "202294", wantCode: "202294", wantOK: true},
+		{name: "unknown", code: "99", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, ok := LookupGeo(tt.code)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantCode, u.Code)
+		})
+	}
+}
+
+func TestGetDivisionCoding(t *testing.T) {
+	for _, code := range []string{"CTG", "CH", "20"} {
+		c := GetDivisionCoding(code)
+		require.NotNil(t, c, code)
+		assert.Equal(t, SystemBDGeocode, *c.System, code)
+		assert.Equal(t, "20", *c.Code, code)
+		assert.Equal(t, "Chattogram", *c.Display, code)
+	}
+	for legacy, current := range legacyDivisionCodes {
+		c := GetDivisionCoding(legacy)
+		require.NotNil(t, c, legacy)
+		assert.Equal(t, Divisions[current], *c.Display)
+	}
+	assert.Nil(t, GetDivisionCoding("XX"))
+	assert.Nil(t, GetDivisionCoding("2022"), "a district is not a division")
+}
+
+func TestDivisionsMapMatchesGeography(t *testing.T) {
+	for short, name := range Divisions {
+		u, ok := LookupGeo(short)
+		require.True(t, ok, "short code %s", short)
+		assert.Equal(t, LevelDivision, u.Level)
+		assert.Equal(t, name, u.Name)
+	}
+}
+
+func TestHierarchyNavigation(t *testing.T) {
+	districts := DistrictsOf("CTG")
+	require.NotEmpty(t, districts)
+	assert.Contains(t, districts, mustGeo(t, "2022"))
+
+	upazilas := UpazilasOf("2022")
+	names := make([]string, 0, len(upazilas))
+	for _, u := range upazilas {
+		names = append(names, u.Name)
+	}
+	assert.Contains(t, names, "Ukhiya")
+	assert.Contains(t, names, "Teknaf")
+
+	unions := UnionsOf("202294")
+	assert.Len(t, unions, 5)
+
+	parent, ok := ParentOf("20229463")
+	require.True(t, ok)
+	assert.Equal(t, "Ukhiya", parent.Name)
+
+	_, ok = ParentOf("20")
+	assert.False(t, ok)
+
+	assert.Nil(t, DistrictsOf("2022"), "DistrictsOf requires a division")
+	assert.True(t, IsWithin("20229463", "20"))
+	assert.False(t, IsWithin("20229463", "30"))
+}
+
+func TestFindGeo(t *testing.T) {
+	u, ok := FindGeo(LevelDivision, "", "Chittagong")
+	require.True(t, ok)
+	assert.Equal(t, "20", u.Code)
+
+	u, ok = FindGeo(LevelDistrict, "", "কক্সবাজার")
+	require.True(t, ok)
+	assert.Equal(t, "2022", u.Code)
+
+	u, ok = FindGeo(LevelDistrict, "", "Dhaka")
+	require.True(t, ok)
+	assert.Equal(t, "3026", u.Code, "level disambiguates division and district names")
+
+	_, ok = FindGeo(LevelDistrict, "30", "Cox's Bazar")
+	assert.False(t, ok, "parent restricts the search")
+}
+
+func TestValidateGeoHierarchy(t *testing.T) {
+	tests := []struct {
+		name                               string
+		division, district, upazila, union string
+		wantErr                            error
+	}{
+		{name: "complete chain", division: "20", district: "2022", upazila: "202294", union: "20229463"},
+		{name: "gaps allowed", division: "CTG", upazila: "202290"},
+		{name: "empty", wantErr: nil},
+		{name: "district outside division", division: "30", district: "2022", wantErr: ErrGeoHierarchy},
+		{name: "union outside upazila", upazila: "202290", union: "20229463", wantErr: ErrGeoHierarchy},
+		{name: "wrong level", division: "2022", wantErr: ErrUnknownGeoCode},
+		{name: "unknown code", district: "9999", wantErr: ErrUnknownGeoCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGeoHierarchy(tt.division, tt.district, tt.upazila, tt.union)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	assert.NoError(t, ValidateAddress(&r5.Address{State: str("Chattogram"), District: str("Cox's Bazar"), Country: str("BD")}))
+	assert.NoError(t, ValidateAddress(&r5.Address{State: str("Bavaria"), Country: str("DE")}))
+	assert.NoError(t, ValidateAddress(nil))
+
+	err := ValidateAddress(&r5.Address{State: str("Dhaka"), District: str("Cox's Bazar")})
+	assert.ErrorIs(t, err, ErrGeoHierarchy)
+
+	err = ValidateAddress(&r5.Address{District: str("Atlantis")})
+	assert.ErrorIs(t, err, ErrUnknownGeoCode)
+}
+
+func TestGeographyCodeSystem(t *testing.T) {
+	cs := GeographyCodeSystem()
+	require.NotNil(t, cs.URL)
+	assert.Equal(t, SystemBDGeocode, *cs.URL)
+	assert.Equal(t, "fragment", cs.Content)
+	assert.Nil(t, cs.Count)
+
+	require.Len(t, cs.Property, 1)
+	assert.Equal(t, "parent", cs.Property[0].Code)
+
+	// Divisions have no parent; every other unit names the enclosing unit.
+	require.Len(t, cs.Concept, len(loadGeography().units))
+	for _, c := range cs.Concept {
+		u, ok := LookupGeo(c.Code)
+		require.True(t, ok, c.Code)
+		if u.Level == LevelDivision {
+			assert.Empty(t, c.Property, c.Code)
+			continue
+		}
+		require.Len(t, c.Property, 1, c.Code)
+		assert.Equal(t, "parent", c.Property[0].Code)
+		assert.Equal(t, u.ParentCode(), *c.Property[0].ValueCode, c.Code)
+	}
+
+	data, err := json.Marshal(cs)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"resourceType":"CodeSystem"`)
+	assert.Contains(t, string(data), `"language":"bn"`)
+}
+
+func TestGeographyValueSets(t *testing.T) {
+	vs := GeographyValueSet(LevelDivision)
+	require.NotNil(t, vs.Compose)
+	assert.Equal(t, SystemBDDivisions, *vs.URL)
+	assert.Len(t, vs.Compose.Include[0].Concept, 8)
+
+	of := GeographyValueSetOf("202294")
+	require.NotNil(t, of)
+	assert.True(t, strings.HasSuffix(*of.URL, "-202294"))
+	assert.Len(t, of.Compose.Include[0].Concept, 5)
+
+	assert.Nil(t, GeographyValueSetOf("20229463"), "unions have no children")
+	assert.Greater(t, len(GeographyValueSets()), 4)
+}
+
+func TestGetGeoCoding(t *testing.T) {
+	c := GetGeoCoding("202294")
+	require.NotNil(t, c)
+	assert.Equal(t, SystemBDGeocode, *c.System)
+	assert.Equal(t, "Ukhiya", *c.Display)
+	assert.Nil(t, GetGeoCoding("nope"))
+}
+
+func mustGeo(t *testing.T, code string) GeoUnit {
+	t.Helper()
+	u, ok := LookupGeo(code)
+	require.True(t, ok, "code %s", code)
+	return u
+}
@@ -1,37 +1,63 @@
 package bd
 
 import (
+	"strings"
+
 	"github.com/zs-health/zh-fhir-go/fhir/r5"
 )
 
 const (
+	// SystemBDDivisions is the canonical URL of the ValueSet of the divisions
+	// in the geocode CodeSystem (SystemBDGeocode).
 	SystemBDDivisions = "https://health.zarishsphere.com/fhir/ValueSet/bd-divisions"
+	// SystemBDDistricts is the canonical URL of the ValueSet of the districts
+	// in the geocode CodeSystem (SystemBDGeocode).
 	SystemBDDistricts = "https://health.zarishsphere.com/fhir/ValueSet/bd-districts"
 )
 
-// Bangladesh Divisions
+// Divisions holds the names of the Bangladesh divisions keyed by the short
+// codes used in the documentation. LookupGeo resolves these to their BBS
+// geocodes.
+//
+// The keys used to be two-letter codes ("CH", "RJ", ...), so a caller that
+// indexes Divisions with one of those now gets "". GetDivisionCoding and
+// LookupGeo still accept them.
 var Divisions = map[string]string{
-	"DH": "Dhaka",
-	"CH": "Chattogram",
-	"RJ": "Rajshahi",
-	"KH": "Khulna",
-	"BR": "Barishal",
-	"SY": "Sylhet",
-	"RG": "Rangpur",
-	"MY": "Mymensingh",
+	"DH":  "Dhaka",
+	"CTG": "Chattogram",
+	"RAJ": "Rajshahi",
+	"KHU": "Khulna",
+	"BAR": "Barishal",
+	"SYL": "Sylhet",
+	"RAN": "Rangpur",
+	"MYM": "Mymensingh",
+}
+
+// legacyDivisionCodes maps the two-letter keys Divisions used before the
+// documented short codes to their current key.
+var legacyDivisionCodes = map[string]string{
+	"CH": "CTG",
+	"RJ": "RAJ",
+	"KH": "KHU",
+	"BR": "BAR",
+	"SY": "SYL",
+	"RG": "RAN",
+	"MY": "MYM",
 }
 
-// GetDivisionCoding returns a FHIR r5.Coding for a Bangladesh division
+// GetDivisionCoding returns a FHIR r5.Coding for a Bangladesh division, with
+// its BBS geocode in the geocode CodeSystem, such as "20" for Chattogram. The
+// division may be given by geocode, short code ("CTG") or one of the legacy
+// two-letter codes ("CH"). It returns nil for anything else.
 func GetDivisionCoding(code string) *r5.Coding {
-	if display, ok := Divisions[code]; ok {
-		system := SystemBDDivisions
-		return &r5.Coding{
-			System:  &system,
-			Code:    &code,
-			Display: &display,
-		}
+	if current, ok := legacyDivisionCodes[code]; ok {
+		code = current
 	}
-	return nil
+	u, ok := LookupGeo(code)
+	if !ok || u.Level != LevelDivision {
+		return nil
+	}
+	return u.Coding()
 }
 
 const (
@@ -62,3 +88,15 @@ func GetCampCoding(code string) *r5.Coding {
 	}
 	return nil
 }
+
+// FindCamp resolves a Rohingya camp code or display name (case-insensitive)
+// to its code in RohingyaCamps
+func FindCamp(codeOrName string) (string, bool) {
+	codeOrName = strings.TrimSpace(codeOrName)
+	for code, display := range RohingyaCamps {
+		if strings.EqualFold(codeOrName, code) || strings.EqualFold(codeOrName, display) {
+			return code, true
+		}
+	}
+	return "", false
+}
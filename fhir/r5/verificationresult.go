@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -97,7 +96,7 @@ type VerificationResultValidator struct {
 
 // VerificationResult represents a FHIR VerificationResult.
 type VerificationResult struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// A resource that was validated
@@ -139,3 +138,8 @@ type VerificationResult struct {
 	// Information about the entity validating information
 	Validator []VerificationResultValidator `json:"validator,omitempty" fhir:"cardinality=0..*"`
 }
+
+// ResourceType returns "VerificationResult", the FHIR type of the resource.
+func (v *VerificationResult) ResourceType() string {
+	return ResourceTypeVerificationResult
+}
@@ -1,7 +1,7 @@
 // Code generated by fhirgen v0.2.0. DO NOT EDIT.
-// Generated at: 2026-02-15T13:05:01Z
-// FHIR Version: RESOURCES
-// Source: FHIR StructureDefinitions from https://hl7.org/fhir/RESOURCES/
+// Generated at: 2026-10-18T22:01:23Z
+// FHIR Version: R5
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/R5/
 
 package r5
 
@@ -20,9 +20,9 @@ type VirtualServiceDetail struct {
 	// Channel Type
 	ChannelType *Coding `json:"channelType,omitempty" fhir:"cardinality=0..1,summary"`
 	// Contact address/number - url option
-	AddressURL *string `json:"addressURL,omitempty" fhir:"cardinality=0..1,summary,choice=address"`
+	AddressURL *string `json:"addressUrl,omitempty" fhir:"cardinality=0..1,summary,choice=address,type=url"`
 	// Extension for AddressURL
-	AddressURLExt *primitives.PrimitiveExtension `json:"_addressURL,omitempty" fhir:"cardinality=0..1"`
+	AddressURLExt *primitives.PrimitiveExtension `json:"_addressUrl,omitempty" fhir:"cardinality=0..1"`
 	// Contact address/number - string option
 	AddressString *string `json:"addressString,omitempty" fhir:"cardinality=0..1,summary,choice=address"`
 	// Extension for AddressString
@@ -32,11 +32,11 @@ type VirtualServiceDetail struct {
 	// Contact address/number - ExtendedContactDetail option
 	AddressExtendedContactDetail *ExtendedContactDetail `json:"addressExtendedContactDetail,omitempty" fhir:"cardinality=0..1,summary,choice=address"`
 	// Address to see alternative connection details
-	AdditionalInfo []string `json:"additionalInfo,omitempty" fhir:"cardinality=0..*,summary"`
+	AdditionalInfo []string `json:"additionalInfo,omitempty" fhir:"cardinality=0..*,summary,type=url"`
 	// Extension for AdditionalInfo
 	AdditionalInfoExt *primitives.PrimitiveExtension `json:"_additionalInfo,omitempty" fhir:"cardinality=0..1"`
 	// Maximum number of participants supported by the virtual service
-	MaxParticipants *int `json:"maxParticipants,omitempty" fhir:"cardinality=0..1,summary"`
+	MaxParticipants *int `json:"maxParticipants,omitempty" fhir:"cardinality=0..1,summary,type=positiveInt"`
 	// Extension for MaxParticipants
 	MaxParticipantsExt *primitives.PrimitiveExtension `json:"_maxParticipants,omitempty" fhir:"cardinality=0..1"`
 	// Session Key required by the virtual service
@@ -44,3 +44,70 @@ type VirtualServiceDetail struct {
 	// Extension for SessionKey
 	SessionKeyExt *primitives.PrimitiveExtension `json:"_sessionKey,omitempty" fhir:"cardinality=0..1"`
 }
+
+// Address returns the option of address[x] that is set, or nil if none is.
+func (v *VirtualServiceDetail) Address() any {
+	switch {
+	case v.AddressURL != nil:
+		return v.AddressURL
+	case v.AddressString != nil:
+		return v.AddressString
+	case v.AddressContactPoint != nil:
+		return v.AddressContactPoint
+	case v.AddressExtendedContactDetail != nil:
+		return v.AddressExtendedContactDetail
+	}
+	return nil
+}
+
+// AddressType returns the FHIR type of the option of address[x] that is
+// set, such as "url", or "" if none is.
+func (v *VirtualServiceDetail) AddressType() string {
+	switch {
+	case v.AddressURL != nil:
+		return "url"
+	case v.AddressString != nil:
+		return "string"
+	case v.AddressContactPoint != nil:
+		return "ContactPoint"
+	case v.AddressExtendedContactDetail != nil:
+		return "ExtendedContactDetail"
+	}
+	return ""
+}
+
+// ClearAddress unsets every option of address[x], and their extensions.
+func (v *VirtualServiceDetail) ClearAddress() {
+	v.AddressURL = nil
+	v.AddressURLExt = nil
+	v.AddressString = nil
+	v.AddressStringExt = nil
+	v.AddressContactPoint = nil
+	v.AddressExtendedContactDetail = nil
+}
+
+// SetAddressURL sets the url option of address[x], clearing the others.
+func (v *VirtualServiceDetail) SetAddressURL(value string) {
+	ext := v.AddressURLExt
+	v.ClearAddress()
+	v.AddressURL, v.AddressURLExt = &value, ext
+}
+
+// SetAddressString sets the string option of address[x], clearing the others.
+func (v *VirtualServiceDetail) SetAddressString(value string) {
+	ext := v.AddressStringExt
+	v.ClearAddress()
+	v.AddressString, v.AddressStringExt = &value, ext
+}
+
+// SetAddressContactPoint sets the ContactPoint option of address[x], clearing the others.
+func (v *VirtualServiceDetail) SetAddressContactPoint(value ContactPoint) {
+	v.ClearAddress()
+	v.AddressContactPoint = &value
+}
+
+// SetAddressExtendedContactDetail sets the ExtendedContactDetail option of address[x], clearing the others.
+func (v *VirtualServiceDetail) SetAddressExtendedContactDetail(value ExtendedContactDetail) {
+	v.ClearAddress()
+	v.AddressExtendedContactDetail = &value
+}
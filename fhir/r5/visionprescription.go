@@ -6,7 +6,6 @@
 package r5
 
 import (
-	"github.com/zs-health/zh-fhir-go/fhir"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
@@ -24,7 +23,7 @@ type VisionPrescriptionLensSpecificationPrism struct {
 	// Extensions that cannot be ignored even if unrecognized
 	ModifierExtension []Extension `json:"modifierExtension,omitempty" fhir:"cardinality=0..*,summary"`
 	// Amount of adjustment
-	Amount float64 `json:"amount" fhir:"cardinality=1..1,required"`
+	Amount primitives.Decimal `json:"amount" fhir:"cardinality=1..1,required"`
 	// Extension for Amount
 	AmountExt *primitives.PrimitiveExtension `json:"_amount,omitempty" fhir:"cardinality=0..1"`
 	// up | down | in | out
@@ -50,11 +49,11 @@ type VisionPrescriptionLensSpecification struct {
 	// Extension for Eye
 	EyeExt *primitives.PrimitiveExtension `json:"_eye,omitempty" fhir:"cardinality=0..1"`
 	// Power of the lens
-	Sphere *float64 `json:"sphere,omitempty" fhir:"cardinality=0..1"`
+	Sphere *primitives.Decimal `json:"sphere,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Sphere
 	SphereExt *primitives.PrimitiveExtension `json:"_sphere,omitempty" fhir:"cardinality=0..1"`
 	// Lens power for astigmatism
-	Cylinder *float64 `json:"cylinder,omitempty" fhir:"cardinality=0..1"`
+	Cylinder *primitives.Decimal `json:"cylinder,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Cylinder
 	CylinderExt *primitives.PrimitiveExtension `json:"_cylinder,omitempty" fhir:"cardinality=0..1"`
 	// Lens meridian which contain no power for astigmatism
@@ -64,19 +63,19 @@ type VisionPrescriptionLensSpecification struct {
 	// Eye alignment compensation
 	Prism []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty" fhir:"cardinality=0..*"`
 	// Added power for multifocal levels
-	Add *float64 `json:"add,omitempty" fhir:"cardinality=0..1"`
+	Add *primitives.Decimal `json:"add,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Add
 	AddExt *primitives.PrimitiveExtension `json:"_add,omitempty" fhir:"cardinality=0..1"`
 	// Contact lens power
-	Power *float64 `json:"power,omitempty" fhir:"cardinality=0..1"`
+	Power *primitives.Decimal `json:"power,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Power
 	PowerExt *primitives.PrimitiveExtension `json:"_power,omitempty" fhir:"cardinality=0..1"`
 	// Contact lens back curvature
-	BackCurve *float64 `json:"backCurve,omitempty" fhir:"cardinality=0..1"`
+	BackCurve *primitives.Decimal `json:"backCurve,omitempty" fhir:"cardinality=0..1"`
 	// Extension for BackCurve
 	BackCurveExt *primitives.PrimitiveExtension `json:"_backCurve,omitempty" fhir:"cardinality=0..1"`
 	// Contact lens diameter
-	Diameter *float64 `json:"diameter,omitempty" fhir:"cardinality=0..1"`
+	Diameter *primitives.Decimal `json:"diameter,omitempty" fhir:"cardinality=0..1"`
 	// Extension for Diameter
 	DiameterExt *primitives.PrimitiveExtension `json:"_diameter,omitempty" fhir:"cardinality=0..1"`
 	// Lens wear duration
@@ -95,7 +94,7 @@ type VisionPrescriptionLensSpecification struct {
 
 // VisionPrescription represents a FHIR VisionPrescription.
 type VisionPrescription struct {
-	fhir.DomainResource
+	DomainResource
 	// Extension for Contained
 	ContainedExt *primitives.PrimitiveExtension `json:"_contained,omitempty" fhir:"cardinality=0..1"`
 	// Business Identifier for vision prescription
@@ -121,3 +120,8 @@ type VisionPrescription struct {
 	// Vision lens authorization
 	LensSpecification []VisionPrescriptionLensSpecification `json:"lensSpecification,omitempty" fhir:"cardinality=1..*,required,summary"`
 }
+
+// ResourceType returns "VisionPrescription", the FHIR type of the resource.
+func (v *VisionPrescription) ResourceType() string {
+	return ResourceTypeVisionPrescription
+}
@@ -7,8 +7,9 @@ import (
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
-// Resource is the base type for all FHIR resources.
-// All FHIR resources inherit these common fields.
+// Resource is the base type for the resources of this package, such as
+// Bundle. The resources of a version package embed its own BaseResource
+// and DomainResource, whose fields use the types of that version.
 type Resource struct {
 	// Extension for ID
 	IDExt *primitives.PrimitiveExtension `json:"_id,omitempty" fhir:"cardinality=0..1"`
@@ -26,16 +27,37 @@ type Resource struct {
 	ResourceType string `json:"resourceType"`
 
 	// Logical id of this artifact
-	ID *string `json:"id,omitempty" fhir:"cardinality=0..1,summary"`
+	ID *string `json:"id,omitempty" fhir:"cardinality=0..1,summary,type=id"`
 
 	// A set of rules under which this content was created
-	ImplicitRules *string `json:"implicitRules,omitempty" fhir:"cardinality=0..1,summary"`
+	ImplicitRules *string `json:"implicitRules,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 
 	// Language of the resource content
-	Language *string `json:"language,omitempty" fhir:"cardinality=0..1"`
+	Language *string `json:"language,omitempty" fhir:"cardinality=0..1,type=code"`
 }
 
-// DomainResource is the base type for all FHIR domain resources.
+// GetID returns the logical id of the resource, or nil if it has none.
+func (r *Resource) GetID() *string {
+	return r.ID
+}
+
+// GetMeta returns the metadata of the resource, or nil if it has none.
+func (r *Resource) GetMeta() *Meta {
+	return r.Meta
+}
+
+// AnyResource is a resource of any type and FHIR version, such as an
+// *r5.Patient or an *r4.Observation. The version packages each have a
+// Resource interface that extends it.
+type AnyResource interface {
+	// ResourceType returns the FHIR type of the resource, such as "Patient".
+	ResourceType() string
+
+	// GetID returns the logical id of the resource, or nil if it has none.
+	GetID() *string
+}
+
+// DomainResource is the base type for the domain resources of this package.
 // DomainResources are resources that include human-readable narrative.
 type DomainResource struct {
 	Resource
@@ -115,7 +137,7 @@ type Meta struct {
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*,summary"`
+	Profile []string `json:"profile,omitempty" fhir:"cardinality=0..*,summary,type=canonical"`
 
 	// Extension for Profile
 	ProfileExt []*primitives.PrimitiveExtension `json:"_profile,omitempty" fhir:"cardinality=0..*"`
@@ -142,10 +164,10 @@ type Meta struct {
 	ID *string `json:"id,omitempty" fhir:"cardinality=0..1"`
 
 	// Version specific identifier
-	VersionID *string `json:"versionId,omitempty" fhir:"cardinality=0..1,summary"`
+	VersionID *string `json:"versionId,omitempty" fhir:"cardinality=0..1,summary,type=id"`
 
 	// Identifies where the resource comes from
-	Source *string `json:"source,omitempty" fhir:"cardinality=0..1,summary"`
+	Source *string `json:"source,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 }
 
 // Narrative contains human-readable text for a resource.
@@ -169,7 +191,9 @@ type Narrative struct {
 	DivExt *primitives.PrimitiveExtension `json:"_div,omitempty" fhir:"cardinality=0..1"`
 }
 
-// Extension represents a FHIR extension.
+// Extension represents a FHIR extension on the types of this package. It has
+// the common value types only; the Extension of a version package has them
+// all.
 type Extension struct {
 	// Additional extensions
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
@@ -201,17 +225,17 @@ type Extension struct {
 	ID *string `json:"id,omitempty" fhir:"cardinality=0..1"`
 
 	// Identifies the meaning of the extension
-	URL string `json:"url" fhir:"cardinality=1..1,required"`
+	URL string `json:"url" fhir:"cardinality=1..1,required,type=uri"`
 
 	// Value of extension - primitive types
-	ValueBoolean   *bool    `json:"valueBoolean,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueInteger   *int     `json:"valueInteger,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueString    *string  `json:"valueString,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueDecimal   *float64 `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueUri       *string  `json:"valueUri,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueUrl       *string  `json:"valueUrl,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueCanonical *string  `json:"valueCanonical,omitempty" fhir:"cardinality=0..1,choice=value"`
-	ValueCode      *string  `json:"valueCode,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueBoolean   *bool               `json:"valueBoolean,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueInteger   *int                `json:"valueInteger,omitempty" fhir:"cardinality=0..1,choice=value,type=integer"`
+	ValueString    *string             `json:"valueString,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueDecimal   *primitives.Decimal `json:"valueDecimal,omitempty" fhir:"cardinality=0..1,choice=value"`
+	ValueUri       *string             `json:"valueUri,omitempty" fhir:"cardinality=0..1,choice=value,type=uri"`
+	ValueUrl       *string             `json:"valueUrl,omitempty" fhir:"cardinality=0..1,choice=value,type=url"`
+	ValueCanonical *string             `json:"valueCanonical,omitempty" fhir:"cardinality=0..1,choice=value,type=canonical"`
+	ValueCode      *string             `json:"valueCode,omitempty" fhir:"cardinality=0..1,choice=value,type=code"`
 
 	// More complex value types can be added as needed
 	// ValueCoding, ValueCodeableConcept, ValueReference, etc.
@@ -242,7 +266,7 @@ type Coding struct {
 	Extension []Extension `json:"extension,omitempty" fhir:"cardinality=0..*"`
 
 	// Identity of the terminology system
-	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary"`
+	System *string `json:"system,omitempty" fhir:"cardinality=0..1,summary,type=uri"`
 
 	// Extension for System
 	SystemExt *primitives.PrimitiveExtension `json:"_system,omitempty" fhir:"cardinality=0..1"`
@@ -254,7 +278,7 @@ type Coding struct {
 	VersionExt *primitives.PrimitiveExtension `json:"_version,omitempty" fhir:"cardinality=0..1"`
 
 	// Symbol in syntax defined by the system
-	Code *string `json:"code,omitempty" fhir:"cardinality=0..1,summary"`
+	Code *string `json:"code,omitempty" fhir:"cardinality=0..1,summary,type=code"`
 
 	// Extension for Code
 	CodeExt *primitives.PrimitiveExtension `json:"_code,omitempty" fhir:"cardinality=0..1"`
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/zs-health/zh-fhir-go/fhir/fhirpath"
 	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/model"
 	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/parser"
 )
@@ -16,18 +17,37 @@ type Builder struct {
 	generator      *Generator
 	version        string
 	verbose        bool
-	resourceFilter map[string]bool // Set of resource names to generate (nil = all)
+	resourceFilter map[string]bool                                 // Set of resource names to generate (nil = all)
+	enums          map[string]*model.ValueSet                      // Typed enums used by the generated fields, by Go name
+	invariants     map[string]map[string][]model.ElementConstraint // Invariants of the generated types, by file and Go name
 }
 
 // NewBuilder creates a new type builder.
 func NewBuilder(p *parser.Parser, version string, packageName string, verbose bool) *Builder {
-	return &Builder{
+	b := &Builder{
 		parser:     p,
 		typeMapper: parser.NewTypeMapper(),
 		generator:  New(packageName, version),
 		version:    version,
 		verbose:    verbose,
+		enums:      make(map[string]*model.ValueSet),
+		invariants: make(map[string]map[string][]model.ElementConstraint),
 	}
+
+	// Codes with a required binding to a ValueSet the parser could expand
+	// get a typed enum, named after the ValueSet unless a structure has
+	// that name
+	for _, vs := range p.GetValueSets() {
+		vs.GoName = parser.ToGoIdentifier(vs.Name)
+		if _, ok := p.GetDefinition(vs.GoName); ok {
+			vs.GoName += "Code"
+		}
+		if !b.typeMapper.AddValueSet(vs) {
+			b.logf("  No enum for ValueSet %s", vs.URL)
+		}
+	}
+
+	return b
 }
 
 // SetResourceFilter sets a filter for which resources to generate.
@@ -84,13 +104,15 @@ func (b *Builder) BuildResource(def *model.StructureDefinition) (string, error)
 
 		// Add embedding field at the beginning
 		embeddingField := model.Field{
-			Name:       embeddedType,
-			GoType:     "fhir." + embeddedType,
+			Name:       baseResourceNames[embeddedType],
+			GoType:     baseResourceNames[embeddedType],
 			IsEmbedded: true,
 		}
 		fields = append([]model.Field{embeddingField}, fields...)
 	}
 
+	b.addInvariants(def, def.Snapshot.Elements[0], def.Name, "")
+
 	// Build main type
 	mainType := model.TypeDefinition{
 		Name:       def.Name,
@@ -107,6 +129,67 @@ func (b *Builder) BuildResource(def *model.StructureDefinition) (string, error)
 	return b.generator.GenerateFile(nestedTypes)
 }
 
+// baseResourceNames are the Go names of the abstract base resources, which
+// the resources of a package embed. Resource becomes BaseResource, as
+// Resource is the interface that every resource implements.
+var baseResourceNames = map[string]string{
+	"Resource":       "BaseResource",
+	"DomainResource": "DomainResource",
+}
+
+// BuildBaseResource generates Go code for Resource or DomainResource, the
+// abstract base resources. Each version package has its own, so that their
+// fields use the Meta, Extension and Narrative of the version.
+func (b *Builder) BuildBaseResource(def *model.StructureDefinition) (string, error) {
+	name, ok := baseResourceNames[def.Name]
+	if !ok {
+		return "", fmt.Errorf("definition %s is not a base resource", def.ID)
+	}
+
+	if def.Snapshot == nil || len(def.Snapshot.Elements) == 0 {
+		return "", fmt.Errorf("definition %s has no snapshot elements", def.ID)
+	}
+
+	fields, nestedTypes, err := b.extractFieldsAndTypes(def, def.Type, def.Name)
+	if err != nil {
+		return "", fmt.Errorf("extract fields: %w", err)
+	}
+
+	if embeddedType := b.getEmbeddedBaseType(def); embeddedType != "" {
+		fields = b.filterBaseFields(fields, embeddedType)
+		embeddingField := model.Field{
+			Name:       baseResourceNames[embeddedType],
+			GoType:     baseResourceNames[embeddedType],
+			IsEmbedded: true,
+		}
+		fields = append([]model.Field{embeddingField}, fields...)
+	} else {
+		// The type of a resource is only an element in JSON, so the root of
+		// the hierarchy holds it
+		resourceTypeField := model.Field{
+			Name:     "ResourceType",
+			GoType:   "string",
+			JSONName: "resourceType",
+			Comment:  "The type of resource",
+		}
+		fields = append([]model.Field{resourceTypeField}, fields...)
+	}
+
+	b.addInvariants(def, def.Snapshot.Elements[0], name, "")
+
+	mainType := model.TypeDefinition{
+		Name:       name,
+		Kind:       "base",
+		Comment:    def.Type,
+		Fields:     fields,
+		IsAbstract: true,
+	}
+
+	nestedTypes = append(nestedTypes, mainType)
+
+	return b.generator.GenerateFile(nestedTypes)
+}
+
 // getEmbeddedBaseType returns the base type to embed, or empty string if no embedding.
 func (b *Builder) getEmbeddedBaseType(def *model.StructureDefinition) string {
 	if def.BaseDefinition == "" {
@@ -185,6 +268,8 @@ func (b *Builder) BuildComplexType(def *model.StructureDefinition) (string, erro
 		return "", fmt.Errorf("extract fields: %w", err)
 	}
 
+	b.addInvariants(def, def.Snapshot.Elements[0], def.Name, "")
+
 	// Build main type
 	mainType := model.TypeDefinition{
 		Name:       def.Name,
@@ -217,9 +302,12 @@ func (b *Builder) extractFieldsAndTypes(def *model.StructureDefinition, parentPa
 				return nil, nil, fmt.Errorf("map choice element %s: %w", elem.Path, err)
 			}
 
+			b.addInvariants(def, elem, prefix, parser.GetChoiceBaseName(elem.Path))
+
 			// Add all choice fields
 			for _, choiceField := range choiceFields {
 				fields = append(fields, *choiceField)
+				b.useEnum(choiceField)
 
 				// Add primitive extension field if needed
 				if len(elem.Types) > 0 {
@@ -279,13 +367,18 @@ func (b *Builder) extractFieldsAndTypes(def *model.StructureDefinition, parentPa
 
 			// Update field to use the nested type name
 			field.GoType = nestedTypeName
+			b.addInvariants(def, elem, nestedTypeName, "")
+		} else {
+			b.addInvariants(def, elem, prefix, field.JSONName)
 		}
 
 		// Add the main field
 		fields = append(fields, *field)
+		b.useEnum(field)
 
-		// For primitive types, add parallel extension field
-		if len(elem.Types) == 1 && b.typeMapper.IsPrimitiveType(elem.Types[0].Code) && !parser.IsBackboneElement(elem) {
+		// For primitive types, add parallel extension field. Resources are
+		// mapped like primitives, but have no extension.
+		if len(elem.Types) == 1 && b.typeMapper.IsPrimitiveType(elem.Types[0].Code) && elem.Types[0].Code != "Resource" && !parser.IsBackboneElement(elem) {
 			extField := model.Field{
 				Name:      field.Name + "Ext",
 				GoType:    "primitives.PrimitiveExtension",
@@ -303,6 +396,57 @@ func (b *Builder) extractFieldsAndTypes(def *model.StructureDefinition, parentPa
 	return fields, nestedTypes, nil
 }
 
+// elementURL is the canonical of Element, whose invariants, such as ele-1,
+// hold for every element and are left to the JSON checks of the validator.
+const elementURL = "http://hl7.org/fhir/StructureDefinition/Element"
+
+// addInvariants records the constraints of elem, an element of def, as
+// invariants of the generated type typeName. The constraints of an element
+// without a type of its own, named element in typeName, are checked over
+// each of its values. Constraints whose expression the FHIRPath engine
+// can't parse are left out, as the validator couldn't check them.
+func (b *Builder) addInvariants(def *model.StructureDefinition, elem model.ElementDefinition, typeName, element string) {
+	file := "typeinvariants.go"
+	if def.Kind == "resource" {
+		file = "resourceinvariants.go"
+	}
+	for _, c := range elem.Constraints {
+		if c.Source == elementURL || inheritedFromType(c, elem) {
+			continue
+		}
+		if _, err := fhirpath.Parse(c.Expression); err != nil {
+			b.logf("  Leaving out constraint %s of %s: %v", c.Key, elem.Path, err)
+			continue
+		}
+		if element != "" {
+			c.Expression = element + ".all(" + c.Expression + ")"
+		}
+		if b.invariants[file] == nil {
+			b.invariants[file] = make(map[string][]model.ElementConstraint)
+		}
+		b.invariants[file][typeName] = append(b.invariants[file][typeName], c)
+	}
+}
+
+// inheritedFromType reports whether a constraint of an element comes from
+// the definition of the element's type, such as ext-1 on an extension, and
+// so is checked on the generated type.
+func inheritedFromType(c model.ElementConstraint, elem model.ElementDefinition) bool {
+	for _, t := range elem.Types {
+		if c.Source == "http://hl7.org/fhir/StructureDefinition/"+t.Code {
+			return true
+		}
+	}
+	return false
+}
+
+// useEnum records the typed enum a field uses, so BuildAll generates it.
+func (b *Builder) useEnum(field *model.Field) {
+	if field.Enum != nil {
+		b.enums[field.Enum.GoName] = field.Enum
+	}
+}
+
 // BuildAll generates Go code for all resources and complex types.
 func (b *Builder) BuildAll() (map[string]string, error) {
 	result := make(map[string]string)
@@ -314,7 +458,20 @@ func (b *Builder) BuildAll() (map[string]string, error) {
 	resourceCount := 0
 	skippedResources := 0
 	filteredOut := 0
+	var resourceNames []string
 	for _, def := range resources {
+		if name, ok := baseResourceNames[def.Name]; ok {
+			// The base resources are generated whatever the filter, as
+			// every resource embeds them
+			b.logf("  Building base resource: %s", def.Name)
+			code, err := b.BuildBaseResource(def)
+			if err != nil {
+				return nil, fmt.Errorf("build base resource %s: %w", def.Name, err)
+			}
+			result[strings.ToLower(name)+".go"] = code
+			continue
+		}
+
 		if def.Abstract {
 			// Skip abstract resources (can't be instantiated)
 			b.logf("  Skipping abstract resource: %s", def.Name)
@@ -337,10 +494,21 @@ func (b *Builder) BuildAll() (map[string]string, error) {
 
 		filename := strings.ToLower(def.Name) + ".go"
 		result[filename] = code
+		resourceNames = append(resourceNames, def.Name)
 		resourceCount++
 	}
 	b.logf("Generated %d resources (%d skipped, %d filtered)", resourceCount, skippedResources, filteredOut)
 
+	// Generate the registry of the resources, for decoding resources whose
+	// type is only known from their JSON
+	if len(resourceNames) > 0 {
+		code, err := b.generator.GenerateRegistry(resourceNames)
+		if err != nil {
+			return nil, fmt.Errorf("build registry: %w", err)
+		}
+		result["registry.go"] = code
+	}
+
 	// Generate complex types
 	complexTypes := b.parser.GetComplexTypes()
 	b.logf("Generating %d complex types...", len(complexTypes))
@@ -366,6 +534,26 @@ func (b *Builder) BuildAll() (map[string]string, error) {
 		complexCount++
 	}
 	b.logf("Generated %d complex types (%d skipped)", complexCount, skippedComplex)
+
+	// Generate the typed enums the fields use, one file each so that the
+	// runs for resources and for data types can share an output directory
+	b.logf("Generating %d enums...", len(b.enums))
+	for name, vs := range b.enums {
+		code, err := b.generator.GenerateValueSet(vs)
+		if err != nil {
+			return nil, fmt.Errorf("build enum %s: %w", name, err)
+		}
+		result[strings.ToLower(name)+".go"] = code
+	}
+	// Generate the Invariants methods of the types, one file for resources
+	// and one for data types, as they are generated by separate runs
+	for file, types := range b.invariants {
+		code, err := b.generator.GenerateInvariants(types)
+		if err != nil {
+			return nil, fmt.Errorf("build %s: %w", file, err)
+		}
+		result[file] = code
+	}
 	b.logf("Total files generated: %d", len(result))
 
 	return result, nil
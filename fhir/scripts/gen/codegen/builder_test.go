@@ -0,0 +1,127 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/parser"
+)
+
+const invariantDefinitions = `{"resourceType":"Bundle","entry":[{"resource":{
+  "resourceType":"StructureDefinition","id":"Observation","url":"http://hl7.org/fhir/StructureDefinition/Observation",
+  "name":"Observation","kind":"resource","type":"Observation","baseDefinition":"http://hl7.org/fhir/StructureDefinition/DomainResource",
+  "snapshot":{"element":[
+    {"path":"Observation","min":0,"max":"*","constraint":[
+      {"key":"ele-1","severity":"error","expression":"hasValue() or (children().count() > id.count())","source":"http://hl7.org/fhir/StructureDefinition/Element"},
+      {"key":"dom-2","severity":"error","expression":"contained.contained.empty()","source":"http://hl7.org/fhir/StructureDefinition/DomainResource"},
+      {"key":"obs-6","severity":"error","expression":"dataAbsentReason.empty() or value.empty()"},
+      {"key":"obs-x","severity":"error","expression":"value.lowBoundary().exists()"}]},
+    {"path":"Observation.extension","min":0,"max":"*","type":[{"code":"Extension"}],"constraint":[
+      {"key":"ext-1","severity":"error","expression":"extension.exists() != value.exists()","source":"http://hl7.org/fhir/StructureDefinition/Extension"}]},
+    {"path":"Observation.status","min":1,"max":"1","type":[{"code":"code"}],"constraint":[
+      {"key":"obs-s","severity":"warning","expression":"$this != 'unknown'"}]},
+    {"path":"Observation.referenceRange","min":0,"max":"*","type":[{"code":"BackboneElement"}],"constraint":[
+      {"key":"obs-3","severity":"error","expression":"low.exists() or high.exists() or text.exists()"}]},
+    {"path":"Observation.referenceRange.text","min":0,"max":"1","type":[{"code":"string"}]}
+  ]}}}]}`
+
+func TestBuilder_Invariants(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles-resources.json")
+	if err := os.WriteFile(path, []byte(invariantDefinitions), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p := parser.New()
+	if err := p.ParseFile(path); err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	files, err := NewBuilder(p, "r4", "r4", false).BuildAll()
+	if err != nil {
+		t.Fatalf("BuildAll() error = %v", err)
+	}
+	code, ok := files["resourceinvariants.go"]
+	if !ok {
+		t.Fatal("BuildAll() should generate resourceinvariants.go")
+	}
+
+	for _, want := range []string{
+		"func (*Observation) Invariants() []fhir.Invariant {",
+		`Key: "dom-2"`,
+		`Key: "obs-6"`,
+		// The constraints of a primitive element are checked from its parent
+		`Key: "obs-s", Severity: "warning", Human: "", Expression: "status.all($this != 'unknown')"`,
+		"func (*ObservationReferenceRange) Invariants() []fhir.Invariant {",
+		`Key: "obs-3"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+	// ele-1 holds for every element, ext-1 is checked on Extension, and
+	// obs-x uses a function the FHIRPath engine lacks
+	for _, key := range []string{"ele-1", "ext-1", "obs-x"} {
+		if strings.Contains(code, `"`+key+`"`) {
+			t.Errorf("generated code should leave out %s:\n%s", key, code)
+		}
+	}
+}
+
+// TestBuilder_CheckedIn tests that the checked-in data types and invariants
+// of fhir/r4 and fhir/r5 are what the generator makes from fhir_schemas, so
+// that a change to either is regenerated rather than edited by hand. The
+// resources aren't covered until profiles-resources.json is checked in.
+func TestBuilder_CheckedIn(t *testing.T) {
+	root := filepath.Join("..", "..", "..", "..")
+	for _, version := range []string{"r4", "r5"} {
+		t.Run(version, func(t *testing.T) {
+			schemas := filepath.Join(root, "fhir_schemas", version)
+			types := parser.New()
+			if err := types.ParseFile(filepath.Join(schemas, "profiles-types.json")); err != nil {
+				t.Fatal(err)
+			}
+			if err := types.ParseValueSetFile(filepath.Join(schemas, "valuesets-required.json")); err != nil {
+				t.Fatal(err)
+			}
+			files, err := NewBuilder(types, version, version, false).BuildAll()
+			if err != nil {
+				t.Fatalf("BuildAll() error = %v", err)
+			}
+
+			// resourceinvariants.go comes from the excerpt of the resource
+			// definitions, as -invariants-only writes it
+			excerpt := parser.New()
+			if err := excerpt.ParseFile(filepath.Join(schemas, "invariants-resources.json")); err != nil {
+				t.Fatal(err)
+			}
+			invariants, err := NewBuilder(excerpt, version, version, false).BuildAll()
+			if err != nil {
+				t.Fatalf("BuildAll() error = %v", err)
+			}
+			files["resourceinvariants.go"] = invariants["resourceinvariants.go"]
+
+			for name, want := range files {
+				got, err := os.ReadFile(filepath.Join(root, "fhir", version, name))
+				if err != nil {
+					t.Errorf("%s is not checked in: %v", name, err)
+					continue
+				}
+				if withoutTimestamp(string(got)) != withoutTimestamp(want) {
+					t.Errorf("fhir/%s/%s differs from the generator output; regenerate it", version, name)
+				}
+			}
+		})
+	}
+}
+
+// withoutTimestamp drops the Generated at line of a generated file.
+func withoutTimestamp(code string) string {
+	var lines []string
+	for _, line := range strings.Split(code, "\n") {
+		if !strings.HasPrefix(line, "// Generated at: ") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/model"
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/parser"
 )
 
 const (
@@ -204,10 +205,12 @@ func (g *Generator) GenerateFile(types []model.TypeDefinition) (string, error) {
 
 	// Convert types to include pre-computed FHIR tags
 	type TypeWithTags struct {
-		Name    string
-		Comment string
-		Kind    string
-		Fields  []FieldWithTags
+		Name     string
+		Comment  string
+		Kind     string
+		Fields   []FieldWithTags
+		Receiver string
+		Choices  []ChoiceGroup
 	}
 
 	typesWithTags := make([]TypeWithTags, len(types))
@@ -220,14 +223,17 @@ func (g *Generator) GenerateFile(types []model.TypeDefinition) (string, error) {
 			}
 		}
 		typesWithTags[i] = TypeWithTags{
-			Name:    t.Name,
-			Comment: t.Comment,
-			Kind:    t.Kind,
-			Fields:  fieldsWithTags,
+			Name:     t.Name,
+			Comment:  t.Comment,
+			Kind:     t.Kind,
+			Fields:   fieldsWithTags,
+			Receiver: strings.ToLower(t.Name[:1]),
+			Choices:  choiceGroups(t.Fields),
 		}
 	}
 
 	tmpl := template.Must(template.New("file").Parse(fileTemplate))
+	template.Must(tmpl.New("choices").Parse(choiceTemplate))
 
 	data := struct {
 		Package          string
@@ -265,6 +271,235 @@ func (g *Generator) GenerateFile(types []model.TypeDefinition) (string, error) {
 	return string(formatted), nil
 }
 
+// ChoiceGroup is a choice element, such as Observation.value[x], for which
+// accessors are generated over the fields of its options.
+type ChoiceGroup struct {
+	Name    string // Go name of the element, e.g., "Value"
+	Element string // e.g., "value[x]"
+	Options []ChoiceOption
+}
+
+// ChoiceOption is one of the typed fields of a choice element.
+type ChoiceOption struct {
+	Field  string // e.g., "ValueQuantity"
+	GoType string // e.g., "Quantity"
+	Type   string // FHIR type code, e.g., "Quantity" or "dateTime"
+	Ext    string // Field holding the primitive extension, if any
+}
+
+// choiceGroups returns the choice elements among fields, in the order of
+// their first option. Options must be pointers so that the accessors can
+// tell which one is set; a group with any other option, or whose methods
+// would clash with a field, is left out.
+func choiceGroups(fields []model.Field) []ChoiceGroup {
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		names[f.Name] = true
+	}
+
+	var groups []ChoiceGroup
+	index := make(map[string]int)
+	skip := make(map[string]bool)
+	for _, f := range fields {
+		if f.ChoiceGroup == "" || skip[f.ChoiceGroup] {
+			continue
+		}
+		if !f.IsPointer || f.IsArray {
+			skip[f.ChoiceGroup] = true
+			continue
+		}
+
+		i, ok := index[f.ChoiceGroup]
+		if !ok {
+			i = len(groups)
+			index[f.ChoiceGroup] = i
+			groups = append(groups, ChoiceGroup{
+				Name:    parser.ToPascalCase(f.ChoiceGroup),
+				Element: f.ChoiceGroup + "[x]",
+			})
+		}
+
+		opt := ChoiceOption{Field: f.Name, GoType: f.GoType, Type: f.ChoiceType}
+		if opt.Type == "" {
+			opt.Type = f.ChoiceSuffix
+		}
+		if names[f.Name+"Ext"] {
+			opt.Ext = f.Name + "Ext"
+		}
+		groups[i].Options = append(groups[i].Options, opt)
+	}
+
+	result := groups[:0]
+	for _, g := range groups {
+		if !skip[strings.TrimSuffix(g.Element, "[x]")] && !clashes(g, names) {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// clashes reports whether a method generated for a choice group would have
+// the name of a field.
+func clashes(g ChoiceGroup, names map[string]bool) bool {
+	if names[g.Name] || names[g.Name+"Type"] || names["Clear"+g.Name] {
+		return true
+	}
+	for _, opt := range g.Options {
+		if names["Set"+opt.Field] {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateValueSet generates a typed string enum for the codes of a
+// ValueSet, with a constant per code and IsValid and Display methods.
+func (g *Generator) GenerateValueSet(vs *model.ValueSet) (string, error) {
+	names, ok := parser.EnumConstants(vs)
+	if !ok {
+		return "", fmt.Errorf("ValueSet %s has codes without distinct constant names", vs.URL)
+	}
+
+	type code struct {
+		Const   string
+		Code    string
+		Display string
+	}
+	codes := make([]code, len(vs.Concepts))
+	for i, c := range vs.Concepts {
+		codes[i] = code{Const: names[i], Code: c.Code, Display: c.Display}
+	}
+
+	canonical := vs.URL
+	if vs.Version != "" {
+		canonical += "|" + vs.Version
+	}
+
+	tmpl := template.Must(template.New("valueset").Parse(valueSetTemplate))
+
+	data := struct {
+		Package          string
+		Name             string
+		ValueSetName     string
+		Canonical        string
+		Codes            []code
+		Consts           string
+		FHIRVersion      string
+		GeneratorVersion string
+		GeneratedAt      string
+	}{
+		Package:          g.packageName,
+		Name:             vs.GoName,
+		ValueSetName:     vs.Name,
+		Canonical:        canonical,
+		Codes:            codes,
+		Consts:           strings.Join(names, ", "),
+		FHIRVersion:      strings.ToUpper(g.version),
+		GeneratorVersion: GeneratorVersion,
+		GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.String(), fmt.Errorf("format code: %w", err)
+	}
+
+	return string(formatted), nil
+}
+
+// GenerateRegistry generates the Resource interface of the package, and the
+// registry of its resources that NewResource and UnmarshalAny use to
+// create a resource from its type name.
+func (g *Generator) GenerateRegistry(resources []string) (string, error) {
+	sorted := make([]string, len(resources))
+	copy(sorted, resources)
+	sort.Strings(sorted)
+
+	tmpl := template.Must(template.New("registry").Parse(registryTemplate))
+
+	data := struct {
+		Package          string
+		Resources        []string
+		FHIRVersion      string
+		GeneratorVersion string
+		GeneratedAt      string
+	}{
+		Package:          g.packageName,
+		Resources:        sorted,
+		FHIRVersion:      strings.ToUpper(g.version),
+		GeneratorVersion: GeneratorVersion,
+		GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.String(), fmt.Errorf("format code: %w", err)
+	}
+
+	return string(formatted), nil
+}
+
+// GenerateInvariants generates the Invariants methods of the types whose
+// StructureDefinitions have constraints, given by Go type name.
+func (g *Generator) GenerateInvariants(types map[string][]model.ElementConstraint) (string, error) {
+	type typeInvariants struct {
+		Name       string
+		Var        string
+		Invariants []model.ElementConstraint
+	}
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sorted := make([]typeInvariants, len(names))
+	for i, name := range names {
+		sorted[i] = typeInvariants{
+			Name:       name,
+			Var:        strings.ToLower(name[:1]) + name[1:] + "Invariants",
+			Invariants: types[name],
+		}
+	}
+
+	tmpl := template.Must(template.New("invariants").Parse(invariantsTemplate))
+
+	data := struct {
+		Package          string
+		Types            []typeInvariants
+		FHIRVersion      string
+		GeneratorVersion string
+		GeneratedAt      string
+	}{
+		Package:          g.packageName,
+		Types:            sorted,
+		FHIRVersion:      strings.ToUpper(g.version),
+		GeneratorVersion: GeneratorVersion,
+		GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.String(), fmt.Errorf("format code: %w", err)
+	}
+
+	return string(formatted), nil
+}
+
 // needsPrimitivesImport checks if any field uses primitives types.
 func needsPrimitivesImport(fields []model.Field) bool {
 	for _, field := range fields {
@@ -285,7 +520,7 @@ func needsJSONImport(fields []model.Field) bool {
 	return false
 }
 
-// needsFHIRImport checks if any field uses fhir package types (e.g., fhir.DomainResource).
+// needsFHIRImport checks if any field uses fhir package types (e.g., fhir.Bundle).
 func needsFHIRImport(fields []model.Field) bool {
 	for _, field := range fields {
 		if strings.HasPrefix(field.GoType, "fhir.") {
@@ -332,5 +567,205 @@ type {{.Name}} struct {
 {{- end}}
 {{- end}}
 }
-{{end}}
+{{- if eq .Kind "resource"}}
+
+// ResourceType returns "{{.Name}}", the FHIR type of the resource.
+func ({{.Receiver}} *{{.Name}}) ResourceType() string {
+	return ResourceType{{.Name}}
+}
+{{- end}}
+{{template "choices" .}}
+{{- end}}
+`
+
+// choiceTemplate generates the accessors of the choice elements of a type.
+const choiceTemplate = `
+{{- $t := .}}
+{{- range .Choices}}
+{{- $g := .}}
+
+// {{.Name}} returns the option of {{.Element}} that is set, or nil if none is.
+func ({{$t.Receiver}} *{{$t.Name}}) {{.Name}}() any {
+	switch {
+{{- range .Options}}
+	case {{$t.Receiver}}.{{.Field}} != nil:
+		return {{$t.Receiver}}.{{.Field}}
+{{- end}}
+	}
+	return nil
+}
+
+// {{.Name}}Type returns the FHIR type of the option of {{.Element}} that is
+// set, such as "{{(index .Options 0).Type}}", or "" if none is.
+func ({{$t.Receiver}} *{{$t.Name}}) {{.Name}}Type() string {
+	switch {
+{{- range .Options}}
+	case {{$t.Receiver}}.{{.Field}} != nil:
+		return "{{.Type}}"
+{{- end}}
+	}
+	return ""
+}
+
+// Clear{{.Name}} unsets every option of {{.Element}}, and their extensions.
+func ({{$t.Receiver}} *{{$t.Name}}) Clear{{.Name}}() {
+{{- range .Options}}
+	{{$t.Receiver}}.{{.Field}} = nil
+{{- if .Ext}}
+	{{$t.Receiver}}.{{.Ext}} = nil
+{{- end}}
+{{- end}}
+}
+{{- range .Options}}
+
+// Set{{.Field}} sets the {{.Type}} option of {{$g.Element}}, clearing the others.
+func ({{$t.Receiver}} *{{$t.Name}}) Set{{.Field}}(value {{.GoType}}) {
+{{- if .Ext}}
+	ext := {{$t.Receiver}}.{{.Ext}}
+	{{$t.Receiver}}.Clear{{$g.Name}}()
+	{{$t.Receiver}}.{{.Field}}, {{$t.Receiver}}.{{.Ext}} = &value, ext
+{{- else}}
+	{{$t.Receiver}}.Clear{{$g.Name}}()
+	{{$t.Receiver}}.{{.Field}} = &value
+{{- end}}
+}
+{{- end}}
+{{- end}}
+`
+
+const valueSetTemplate = `// Code generated by fhirgen {{.GeneratorVersion}}. DO NOT EDIT.
+// Generated at: {{.GeneratedAt}}
+// FHIR Version: {{.FHIRVersion}}
+// Source: FHIR ValueSet {{.Canonical}}
+
+package {{.Package}}
+
+// {{.Name}} is a code from the FHIR ValueSet {{.ValueSetName}}. Coded
+// elements with a required binding to the ValueSet have it as their type,
+// and it is a string in JSON.
+//
+// ValueSet: {{.Canonical}}
+type {{.Name}} string
+
+// {{.Name}} codes.
+const (
+{{- range .Codes}}
+	{{.Const}} {{$.Name}} = {{printf "%q" .Code}}
+{{- end}}
+)
+
+// IsValid reports whether c is one of the codes in the ValueSet.
+func (c {{.Name}}) IsValid() bool {
+	switch c {
+	case {{.Consts}}:
+		return true
+	}
+	return false
+}
+
+// Display returns the display text of the code, or "" if it isn't one of
+// the codes in the ValueSet.
+func (c {{.Name}}) Display() string {
+	switch c {
+{{- range .Codes}}
+	case {{.Const}}:
+		return {{printf "%q" .Display}}
+{{- end}}
+	}
+	return ""
+}
+`
+
+const invariantsTemplate = `// Code generated by fhirgen {{.GeneratorVersion}}. DO NOT EDIT.
+// Generated at: {{.GeneratedAt}}
+// FHIR Version: {{.FHIRVersion}}
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/{{.FHIRVersion}}/
+
+package {{.Package}}
+
+import "github.com/zs-health/zh-fhir-go/fhir"
+{{range .Types}}
+// Invariants returns the rules every {{.Name}} must meet.
+func (*{{.Name}}) Invariants() []fhir.Invariant {
+	return {{.Var}}
+}
+
+var {{.Var}} = []fhir.Invariant{
+{{- range .Invariants}}
+	{Key: {{printf "%q" .Key}}, Severity: {{printf "%q" .Severity}}, Human: {{printf "%q" .Human}}, Expression: {{printf "%q" .Expression}}},
+{{- end}}
+}
+{{end}}`
+
+const registryTemplate = `// Code generated by fhirgen {{.GeneratorVersion}}. DO NOT EDIT.
+// Generated at: {{.GeneratedAt}}
+// FHIR Version: {{.FHIRVersion}}
+// Source: FHIR StructureDefinitions from https://hl7.org/fhir/{{.FHIRVersion}}/
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/zs-health/zh-fhir-go/fhir"
+)
+
+// Resource is implemented by every {{.FHIRVersion}} resource, such as *Patient.
+type Resource interface {
+	fhir.AnyResource
+
+	// GetMeta returns the metadata of the resource, or nil if it has none.
+	GetMeta() *Meta
+}
+
+// GetID returns the logical id of the resource, or nil if it has none.
+func (r *BaseResource) GetID() *string {
+	return r.ID
+}
+
+// GetMeta returns the metadata of the resource, or nil if it has none.
+func (r *BaseResource) GetMeta() *Meta {
+	return r.Meta
+}
+
+// resources creates an empty resource of each type, by type name.
+var resources = map[string]func() Resource{
+{{- range .Resources}}
+	ResourceType{{.}}: func() Resource { return new({{.}}) },
+{{- end}}
+}
+
+// NewResource returns a new, empty resource of the given type, such as a
+// *Patient for "Patient".
+func NewResource(resourceType string) (Resource, error) {
+	newResource, ok := resources[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+	return newResource(), nil
+}
+
+// UnmarshalAny unmarshals a resource of any type, such as a Bundle entry or
+// a contained resource, into the type named by its resourceType.
+func UnmarshalAny(raw json.RawMessage) (Resource, error) {
+	var header struct {
+		ResourceType string ` + "`json:\"resourceType\"`" + `
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, fmt.Errorf("unmarshal resource type: %w", err)
+	}
+	if header.ResourceType == "" {
+		return nil, fmt.Errorf("resource has no resourceType")
+	}
+
+	resource, err := NewResource(header.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, resource); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", header.ResourceType, err)
+	}
+	return resource, nil
+}
 `
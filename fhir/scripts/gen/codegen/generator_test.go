@@ -152,6 +152,15 @@ func TestField_FHIRTag(t *testing.T) {
 			},
 			want: `fhir:"cardinality=0..1,enum=male|female"`,
 		},
+		{
+			name: "with binding",
+			field: model.Field{
+				Min:     0,
+				Max:     "1",
+				Binding: &model.ElementBinding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender|4.0.1"},
+			},
+			want: `fhir:"cardinality=0..1,binding=required:http://hl7.org/fhir/ValueSet/administrative-gender|4.0.1"`,
+		},
 		{
 			name: "with summary",
 			field: model.Field{
@@ -170,6 +179,15 @@ func TestField_FHIRTag(t *testing.T) {
 			},
 			want: `fhir:"cardinality=0..1,choice=deceased"`,
 		},
+		{
+			name: "with primitive type",
+			field: model.Field{
+				Min:      0,
+				Max:      "1",
+				FHIRType: "id",
+			},
+			want: `fhir:"cardinality=0..1,type=id"`,
+		},
 		{
 			name: "all features",
 			field: model.Field{
@@ -391,6 +409,115 @@ func TestGenerator_ChoiceTypeGeneration(t *testing.T) {
 	}
 }
 
+func TestGenerator_ChoiceAccessors(t *testing.T) {
+	gen := New("resources", "R5")
+
+	option := func(suffix, code, goType string) model.Field {
+		return model.Field{
+			Name:         "Value" + suffix,
+			GoType:       goType,
+			JSONName:     "value" + suffix,
+			Min:          0,
+			Max:          "1",
+			IsPointer:    true,
+			IsChoice:     true,
+			ChoiceGroup:  "value",
+			ChoiceSuffix: suffix,
+			ChoiceType:   code,
+		}
+	}
+
+	types := []model.TypeDefinition{
+		{
+			Name: "Observation",
+			Kind: "resource",
+			Fields: []model.Field{
+				option("Quantity", "Quantity", "Quantity"),
+				option("String", "string", "string"),
+				{
+					Name:      "ValueStringExt",
+					GoType:    "primitives.PrimitiveExtension",
+					JSONName:  "_valueString",
+					IsPointer: true,
+				},
+				option("DateTime", "dateTime", "primitives.DateTime"),
+			},
+		},
+	}
+
+	code, err := gen.GenerateFile(types)
+	if err != nil {
+		t.Fatalf("GenerateFile() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"func (o *Observation) Value() any {",
+		"case o.ValueQuantity != nil:\n\t\treturn o.ValueQuantity",
+		"func (o *Observation) ValueType() string {",
+		"case o.ValueDateTime != nil:\n\t\treturn \"dateTime\"",
+		"func (o *Observation) ClearValue() {\n\to.ValueQuantity = nil\n\to.ValueString = nil\n\to.ValueStringExt = nil\n\to.ValueDateTime = nil\n}",
+		"func (o *Observation) SetValueQuantity(value Quantity) {\n\to.ClearValue()\n\to.ValueQuantity = &value\n}",
+		// Setting an option keeps its own extension
+		"func (o *Observation) SetValueString(value string) {\n\text := o.ValueStringExt\n\to.ClearValue()\n\to.ValueString, o.ValueStringExt = &value, ext\n}",
+		"func (o *Observation) SetValueDateTime(value primitives.DateTime) {",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Generated file should contain %q", want)
+		}
+	}
+
+	// A group whose methods would clash with a field gets no accessors
+	clash := types[0]
+	clash.Fields = append(clash.Fields, model.Field{Name: "ValueType", GoType: "string", JSONName: "valueType", IsPointer: true})
+	code, err = gen.GenerateFile([]model.TypeDefinition{clash})
+	if err != nil {
+		t.Fatalf("GenerateFile() error = %v", err)
+	}
+	if strings.Contains(code, "SetValueQuantity") {
+		t.Error("Generated file should not have accessors for a group that clashes with a field")
+	}
+}
+
+func TestGenerator_GenerateRegistry(t *testing.T) {
+	gen := New("r5", "R5")
+
+	code, err := gen.GenerateRegistry([]string{"Patient", "Account"})
+	if err != nil {
+		t.Fatalf("GenerateRegistry() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"package r5",
+		"type Resource interface {\n\tfhir.AnyResource",
+		"GetMeta() *Meta",
+		"func (r *BaseResource) GetID() *string {",
+		"ResourceTypeAccount: func() Resource { return new(Account) },\n\tResourceTypePatient: func() Resource { return new(Patient) },",
+		"func NewResource(resourceType string) (Resource, error) {",
+		"func UnmarshalAny(raw json.RawMessage) (Resource, error) {",
+		"`json:\"resourceType\"`",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Generated registry should contain %q", want)
+		}
+	}
+
+	// Resources get the ResourceType method of the interface
+	code, err = gen.GenerateFile([]model.TypeDefinition{{Name: "Patient", Kind: "resource"}})
+	if err != nil {
+		t.Fatalf("GenerateFile() error = %v", err)
+	}
+	if !strings.Contains(code, "func (p *Patient) ResourceType() string {\n\treturn ResourceTypePatient\n}") {
+		t.Error("Generated resource should have a ResourceType method")
+	}
+	code, err = gen.GenerateFile([]model.TypeDefinition{{Name: "Address", Kind: "complex"}})
+	if err != nil {
+		t.Fatalf("GenerateFile() error = %v", err)
+	}
+	if strings.Contains(code, "ResourceType()") {
+		t.Error("Generated complex type should not have a ResourceType method")
+	}
+}
+
 func TestGenerator_JSONRawMessageField(t *testing.T) {
 	gen := New("resources", "R5")
 
@@ -437,7 +564,7 @@ func TestGenerator_StructEmbedding(t *testing.T) {
 			Kind: "resource",
 			Fields: []model.Field{
 				{
-					GoType:     "fhir.DomainResource",
+					GoType:     "DomainResource",
 					IsEmbedded: true,
 					Comment:    "Base resource fields",
 				},
@@ -459,19 +586,19 @@ func TestGenerator_StructEmbedding(t *testing.T) {
 	}
 
 	// Check embedded field is generated (no field name, no JSON tag)
-	if !strings.Contains(code, "fhir.DomainResource") {
-		t.Error("Generated file should contain embedded fhir.DomainResource")
+	if !strings.Contains(code, "\tDomainResource\n") {
+		t.Error("Generated file should contain embedded DomainResource")
 	}
 
-	// Check fhir import is present
-	if !strings.Contains(code, `"github.com/zs-health/zh-fhir-go/fhir"`) {
-		t.Error("Generated file should import fhir package for struct embedding")
+	// The base resources are in the package itself
+	if strings.Contains(code, `"github.com/zs-health/zh-fhir-go/fhir"`) {
+		t.Error("Generated file should not import fhir package for struct embedding")
 	}
 
 	// Embedded field should not have JSON tag
 	lines := strings.Split(code, "\n")
 	for i, line := range lines {
-		if strings.Contains(line, "fhir.DomainResource") {
+		if strings.TrimSpace(line) == "DomainResource" {
 			// Embedded fields don't have JSON tags
 			if strings.Contains(line, "json:") {
 				t.Error("Embedded field should not have JSON tag")
@@ -485,3 +612,98 @@ func TestGenerator_StructEmbedding(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerator_GenerateValueSet(t *testing.T) {
+	gen := New("r5", "R5")
+
+	vs := &model.ValueSet{
+		URL:     "http://hl7.org/fhir/ValueSet/quantity-comparator",
+		Version: "5.0.0",
+		Name:    "QuantityComparator",
+		GoName:  "QuantityComparator",
+		Concepts: []model.Concept{
+			{Code: "<", Display: "Less than"},
+			{Code: "<=", Display: "Less or Equal to"},
+			{Code: "ad", Display: "Sufficient to achieve this total quantity"},
+		},
+	}
+
+	code, err := gen.GenerateValueSet(vs)
+	if err != nil {
+		t.Fatalf("GenerateValueSet() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"package r5",
+		"Source: FHIR ValueSet http://hl7.org/fhir/ValueSet/quantity-comparator|5.0.0",
+		"type QuantityComparator string",
+		`QuantityComparatorLessThan      QuantityComparator = "<"`,
+		`QuantityComparatorAd            QuantityComparator = "ad"`,
+		"func (c QuantityComparator) IsValid() bool",
+		"case QuantityComparatorLessThan, QuantityComparatorLessOrEqualTo, QuantityComparatorAd:",
+		"func (c QuantityComparator) Display() string",
+		`return "Less or Equal to"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+
+	vs.Concepts = append(vs.Concepts, model.Concept{Code: "ad!"})
+	if _, err := gen.GenerateValueSet(vs); err == nil {
+		t.Error("GenerateValueSet() error = nil for codes with the same constant name")
+	}
+}
+
+func TestGenerator_EnumField(t *testing.T) {
+	gen := New("r5", "R5")
+
+	types := []model.TypeDefinition{{
+		Name: "Patient",
+		Kind: "resource",
+		Fields: []model.Field{{
+			Name:      "Gender",
+			GoType:    "AdministrativeGender",
+			JSONName:  "gender",
+			Max:       "1",
+			IsPointer: true,
+			Enum:      &model.ValueSet{GoName: "AdministrativeGender"},
+		}},
+	}}
+
+	code, err := gen.GenerateFile(types)
+	if err != nil {
+		t.Fatalf("GenerateFile() error = %v", err)
+	}
+	if !strings.Contains(code, "Gender *AdministrativeGender `json:\"gender,omitempty\"") {
+		t.Errorf("Gender should be a *AdministrativeGender:\n%s", code)
+	}
+}
+
+func TestGenerator_GenerateInvariants(t *testing.T) {
+	gen := New("r4", "R4")
+
+	code, err := gen.GenerateInvariants(map[string][]model.ElementConstraint{
+		"Period":                    {{Key: "per-1", Severity: "error", Human: "If present, start SHALL have a lower value than end", Expression: "start.hasValue().not() or end.hasValue().not() or (start <= end)"}},
+		"ObservationReferenceRange": {{Key: "obs-3", Severity: "error", Human: "Must have at least a low or a high or text", Expression: "low.exists() or high.exists() or text.exists()"}},
+	})
+	if err != nil {
+		t.Fatalf("GenerateInvariants() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"package r4",
+		`import "github.com/zs-health/zh-fhir-go/fhir"`,
+		"func (*ObservationReferenceRange) Invariants() []fhir.Invariant {\n\treturn observationReferenceRangeInvariants\n}",
+		`{Key: "obs-3", Severity: "error", Human: "Must have at least a low or a high or text", Expression: "low.exists() or high.exists() or text.exists()"},`,
+		"func (*Period) Invariants() []fhir.Invariant {",
+		`Expression: "start.hasValue().not() or end.hasValue().not() or (start <= end)"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+	if strings.Index(code, "ObservationReferenceRange") > strings.Index(code, "Period") {
+		t.Error("types should be sorted by name")
+	}
+}
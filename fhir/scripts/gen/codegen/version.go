@@ -0,0 +1,50 @@
+package codegen
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Version describes a FHIR release the generator can target.
+type Version struct {
+	// Name is the release as given on the command line, such as "r5".
+	Name string
+	// Package is the name of the generated Go package.
+	Package string
+	// FHIRVersion is the version of the StructureDefinitions, such as "5.0.0".
+	FHIRVersion string
+}
+
+// versions holds the supported releases by name. Adding a release needs an
+// entry here and its schemas under fhir_schemas/<name>.
+var versions = map[string]Version{
+	"r4": {Name: "r4", Package: "r4", FHIRVersion: "4.0.1"},
+	"r5": {Name: "r5", Package: "r5", FHIRVersion: "5.0.0"},
+}
+
+// LookupVersion returns the release with the given name, such as "r5".
+func LookupVersion(name string) (Version, error) {
+	v, ok := versions[strings.ToLower(name)]
+	if !ok {
+		return Version{}, fmt.Errorf("invalid version: %s (must be one of %s)", name, strings.Join(VersionNames(), ", "))
+	}
+	return v, nil
+}
+
+// VersionNames returns the names of the supported releases, sorted.
+func VersionNames() []string {
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SchemaPath returns the default location of a schema file of the release,
+// such as fhir_schemas/r5/profiles-resources.json.
+func (v Version) SchemaPath(file string) string {
+	return filepath.Join("fhir_schemas", v.Name, file)
+}
@@ -0,0 +1,45 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookupVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		wantPackage string
+		wantFHIR    string
+		wantErr     bool
+	}{
+		{name: "r4", wantPackage: "r4", wantFHIR: "4.0.1"},
+		{name: "R4", wantPackage: "r4", wantFHIR: "4.0.1"},
+		{name: "r5", wantPackage: "r5", wantFHIR: "5.0.0"},
+		{name: "r6", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := LookupVersion(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LookupVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), "r4, r5") {
+					t.Errorf("error should list the versions, got %v", err)
+				}
+				return
+			}
+			if v.Package != tt.wantPackage || v.FHIRVersion != tt.wantFHIR {
+				t.Errorf("LookupVersion() = %+v", v)
+			}
+		})
+	}
+}
+
+func TestVersion_SchemaPath(t *testing.T) {
+	v, _ := LookupVersion("r5")
+	if got := v.SchemaPath("profiles-resources.json"); got != "fhir_schemas/r5/profiles-resources.json" {
+		t.Errorf("SchemaPath() = %q", got)
+	}
+}
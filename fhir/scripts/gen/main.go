@@ -9,11 +9,8 @@ import (
 
 	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/codegen"
 	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/parser"
-)
-
-const (
-	versionR4 = "r4"
-	versionR5 = "r5"
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/profile"
+	"github.com/zs-health/zh-fhir-go/internal/ig"
 )
 
 func main() {
@@ -26,32 +23,41 @@ func main() {
 func run() error {
 	// Parse command line flags
 	var (
-		version   = flag.String("version", versionR4, "FHIR version (r4 or r5)")
+		version   = flag.String("version", "r4", "FHIR version ("+strings.Join(codegen.VersionNames(), ", ")+")")
 		outputDir = flag.String("output", "", "Output directory for generated code")
 		inputFile = flag.String("input", "", "Input StructureDefinitions file (profiles-resources.json)")
+		valueSets = flag.String("valuesets", "", "ValueSets and CodeSystems file (valuesets.json) for typed enums of required code bindings. If empty, codes are plain strings.")
 		resources = flag.String("resources", "", "Comma-separated list of specific resources to generate (e.g., 'Patient,Observation'). If empty, generates all resources.")
+		profiles  = flag.String("profiles", "", "Comma-separated list of profile sources (IG packages, SUSHI projects, StructureDefinition files or folders) to generate profile types for instead of base resources")
+		pkgName   = flag.String("package", "", "Go package name (default: the version's package, or the output directory's name for -profiles)")
+		onlyInv   = flag.Bool("invariants-only", false, "Only write the Invariants methods of the types (resourceinvariants.go, typeinvariants.go), such as from an excerpt of the definitions with just their constraints")
 		verbose   = flag.Bool("verbose", false, "Enable verbose output")
 	)
 	flag.Parse()
 
 	// Validate flags
-	if *version != versionR4 && *version != versionR5 {
-		return fmt.Errorf("invalid version: %s (must be r4 or r5)", *version)
+	v, err := codegen.LookupVersion(*version)
+	if err != nil {
+		return err
 	}
 
 	if *outputDir == "" {
 		return fmt.Errorf("output directory is required")
 	}
 
+	if *profiles != "" {
+		return generateProfiles(v, strings.Split(*profiles, ","), *outputDir, *pkgName, *verbose)
+	}
+
 	// Determine input file path
 	inputPath := *inputFile
 	if inputPath == "" {
-		// Default to profiles-resources.json in project root
-		inputPath = filepath.Join("fhir_schemas", "profiles-resources.json")
+		// Default to the version's profiles-resources.json
+		inputPath = v.SchemaPath("profiles-resources.json")
 	}
 
 	if *verbose {
-		fmt.Printf("FHIR version: %s\n", *version)
+		fmt.Printf("FHIR version: %s (%s)\n", v.Name, v.FHIRVersion)
 		fmt.Printf("Input file: %s\n", inputPath)
 		fmt.Printf("Output directory: %s\n", *outputDir)
 	}
@@ -62,6 +68,15 @@ func run() error {
 		return fmt.Errorf("parse file: %w", err)
 	}
 
+	if *valueSets != "" {
+		if err := p.ParseValueSetFile(*valueSets); err != nil {
+			return fmt.Errorf("parse value sets: %w", err)
+		}
+		if *verbose {
+			fmt.Printf("Loaded %d expandable ValueSets\n", len(p.GetValueSets()))
+		}
+	}
+
 	if *verbose {
 		resources := p.GetResources()
 		complexTypes := p.GetComplexTypes()
@@ -81,12 +96,11 @@ func run() error {
 		}
 	}
 
-	// Create builder with appropriate package name
-	packageName := "resources"
-	if *version == versionR4 {
-		packageName = "r4"
+	packageName := v.Package
+	if *pkgName != "" {
+		packageName = *pkgName
 	}
-	builder := codegen.NewBuilder(p, packageName, *version, *verbose)
+	builder := codegen.NewBuilder(p, v.Name, packageName, *verbose)
 
 	// Set resource filter if provided
 	if len(resourceFilter) > 0 {
@@ -106,6 +120,10 @@ func run() error {
 
 	// Write generated files
 	for filename, content := range files {
+		if *onlyInv && !strings.HasSuffix(filename, "invariants.go") {
+			delete(files, filename)
+			continue
+		}
 		outputPath := filepath.Join(*outputDir, filename)
 		if *verbose {
 			fmt.Printf("Writing %s\n", outputPath)
@@ -119,3 +137,72 @@ func run() error {
 	fmt.Printf("Successfully generated %d files in %s\n", len(files), *outputDir)
 	return nil
 }
+
+// generateProfiles generates the Go types of the resource profiles in
+// sources, along with the core definitions of the version they build on.
+func generateProfiles(v codegen.Version, sources []string, outputDir, pkgName string, verbose bool) error {
+	for i := range sources {
+		sources[i] = strings.TrimSpace(sources[i])
+	}
+	if pkgName == "" {
+		abs, err := filepath.Abs(outputDir)
+		if err != nil {
+			return err
+		}
+		pkgName = filepath.Base(abs)
+	}
+
+	// The core definitions, when downloaded, resolve base types and data
+	// type profiles
+	var core []string
+	for _, file := range []string{"profiles-types.json", "profiles-resources.json"} {
+		if path := v.SchemaPath(file); fileExists(path) {
+			core = append(core, path)
+		}
+	}
+
+	l := ig.NewLoader()
+	if _, err := profile.Load(l, core, v.FHIRVersion); err != nil {
+		return fmt.Errorf("load core definitions: %w", err)
+	}
+	urls, err := profile.Load(l, sources, v.FHIRVersion)
+	if err != nil {
+		return fmt.Errorf("load profiles: %w", err)
+	}
+	if len(urls) == 0 {
+		return fmt.Errorf("no resource profiles in %s", strings.Join(sources, ", "))
+	}
+	if verbose {
+		fmt.Printf("Generating %d profiles into package %s\n", len(urls), pkgName)
+	}
+
+	g, err := profile.New(l.Registry, v, pkgName, verbose)
+	if err != nil {
+		return err
+	}
+	files, err := g.Generate(urls)
+	if err != nil {
+		return fmt.Errorf("generate profiles: %w", err)
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return fmt.Errorf("create output directory: %w", err)
+	}
+	for filename, content := range files {
+		outputPath := filepath.Join(outputDir, filename)
+		if verbose {
+			fmt.Printf("Writing %s\n", outputPath)
+		}
+		if err := os.WriteFile(outputPath, content, 0o644); err != nil {
+			return fmt.Errorf("write file %s: %w", outputPath, err)
+		}
+	}
+
+	fmt.Printf("Successfully generated %d files in %s\n", len(files), outputDir)
+	return nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
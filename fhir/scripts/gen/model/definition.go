@@ -34,6 +34,7 @@ type ElementDefinition struct {
 	IsModifier   bool
 	IsSummary    bool
 	Binding      *ElementBinding
+	Constraints  []ElementConstraint
 	FixedValue   any
 	DefaultValue any
 }
@@ -52,6 +53,33 @@ type ElementBinding struct {
 	ValueSetURI string
 }
 
+// ElementConstraint is an invariant that instances of an element must meet,
+// such as ele-1 or obs-6.
+type ElementConstraint struct {
+	Key        string // e.g., "ele-1"
+	Severity   string // error or warning
+	Human      string
+	Expression string // FHIRPath
+	Source     string // URL of the StructureDefinition that defines it, if inherited
+}
+
+// ValueSet is a FHIR ValueSet expanded to its codes, from which a typed
+// string enum is generated for the coded elements with a required binding
+// to it.
+type ValueSet struct {
+	URL      string
+	Version  string
+	Name     string // e.g., "AdministrativeGender"
+	GoName   string // Name of the generated Go type
+	Concepts []Concept
+}
+
+// Concept is a code in a ValueSet.
+type Concept struct {
+	Code    string
+	Display string
+}
+
 // Field represents a Go struct field to be generated.
 type Field struct {
 	Name         string
@@ -62,19 +90,23 @@ type Field struct {
 	Comment      string
 	IsPointer    bool
 	IsArray      bool
-	IsChoice     bool     // for polymorphic fields like deceased[x]
-	ChoiceSuffix string   // e.g., "Boolean", "DateTime"
-	IsSummary    bool     // FHIR summary element flag
-	IsRequired   bool     // Field is required (min >= 1)
-	IsEmbedded   bool     // For struct embedding (e.g., DomainResource)
-	EnumValues   []string // For coded fields with enum binding
-	ChoiceGroup  string   // Choice group name for mutual exclusion (e.g., "deceased")
+	IsChoice     bool            // for polymorphic fields like deceased[x]
+	ChoiceSuffix string          // e.g., "Boolean", "DateTime"
+	ChoiceType   string          // FHIR type code of a choice option (e.g., "dateTime")
+	IsSummary    bool            // FHIR summary element flag
+	IsRequired   bool            // Field is required (min >= 1)
+	IsEmbedded   bool            // For struct embedding (e.g., DomainResource)
+	EnumValues   []string        // For coded fields with enum binding
+	Binding      *ElementBinding // ValueSet binding checked by the validator
+	ChoiceGroup  string          // Choice group name for mutual exclusion (e.g., "deceased")
+	FHIRType     string          // Primitive type whose format the validator checks (e.g., "id")
+	Enum         *ValueSet       // ValueSet of the typed enum used as GoType, for required code bindings
 }
 
 // TypeDefinition represents a Go type to be generated.
 type TypeDefinition struct {
 	Name          string
-	Kind          string // "primitive", "complex", "resource", "backbone"
+	Kind          string // "primitive", "complex", "resource", "backbone", "base"
 	Comment       string
 	BaseType      string
 	Fields        []Field
@@ -83,7 +115,7 @@ type TypeDefinition struct {
 }
 
 // FHIRTag generates a FHIR struct tag for validation metadata.
-// Format: fhir:"cardinality=0..1,required,enum=male|female,summary,choice=deceased"
+// Format: fhir:"cardinality=0..1,required,enum=male|female,binding=required:http://hl7.org/fhir/ValueSet/administrative-gender|4.0.1,summary,choice=deceased,type=code"
 func (f *Field) FHIRTag() string {
 	if f == nil {
 		return ""
@@ -110,6 +142,11 @@ func (f *Field) FHIRTag() string {
 		parts = append(parts, fmt.Sprintf("enum=%s", strings.Join(f.EnumValues, "|")))
 	}
 
+	// Add binding; the valueSet canonical may carry a |version
+	if f.Binding != nil && f.Binding.ValueSet != "" {
+		parts = append(parts, fmt.Sprintf("binding=%s:%s", f.Binding.Strength, f.Binding.ValueSet))
+	}
+
 	// Add summary flag
 	if f.IsSummary {
 		parts = append(parts, "summary")
@@ -120,6 +157,11 @@ func (f *Field) FHIRTag() string {
 		parts = append(parts, fmt.Sprintf("choice=%s", f.ChoiceGroup))
 	}
 
+	// Add the primitive type whose format is checked
+	if f.FHIRType != "" {
+		parts = append(parts, fmt.Sprintf("type=%s", f.FHIRType))
+	}
+
 	if len(parts) == 0 {
 		return ""
 	}
@@ -51,6 +51,7 @@ type RawElement struct {
 	IsModifier   bool            `json:"isModifier"`
 	IsSummary    bool            `json:"isSummary"`
 	Binding      *RawBinding     `json:"binding"`
+	Constraint   []RawConstraint `json:"constraint"`
 	FixedValue   json.RawMessage `json:"fixedValue"`
 	DefaultValue json.RawMessage `json:"defaultValue"`
 }
@@ -68,15 +69,28 @@ type RawBinding struct {
 	ValueSet string `json:"valueSet"`
 }
 
+// RawConstraint is an invariant of an element.
+type RawConstraint struct {
+	Key        string `json:"key"`
+	Severity   string `json:"severity"`
+	Human      string `json:"human"`
+	Expression string `json:"expression"`
+	Source     string `json:"source"`
+}
+
 // Parser parses FHIR StructureDefinitions.
 type Parser struct {
 	definitions map[string]*model.StructureDefinition
+	valueSets   map[string]*RawValueSet
+	codeSystems map[string]*RawCodeSystem
 }
 
 // New creates a new parser.
 func New() *Parser {
 	return &Parser{
 		definitions: make(map[string]*model.StructureDefinition),
+		valueSets:   make(map[string]*RawValueSet),
+		codeSystems: make(map[string]*RawCodeSystem),
 	}
 }
 
@@ -160,6 +174,17 @@ func (p *Parser) parseStructDef(raw *RawStructDef) (*model.StructureDefinition,
 				}
 			}
 
+			// Parse constraints
+			for _, c := range elem.Constraint {
+				elemDef.Constraints = append(elemDef.Constraints, model.ElementConstraint{
+					Key:        c.Key,
+					Severity:   c.Severity,
+					Human:      c.Human,
+					Expression: c.Expression,
+					Source:     c.Source,
+				})
+			}
+
 			def.Snapshot.Elements = append(def.Snapshot.Elements, elemDef)
 		}
 	}
@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseFileConstraints tests that element constraints survive parsing.
+func TestParseFileConstraints(t *testing.T) {
+	bundle := `{
+  "resourceType": "Bundle",
+  "type": "collection",
+  "entry": [{
+    "fullUrl": "http://hl7.org/fhir/StructureDefinition/Observation",
+    "resource": {
+      "resourceType": "StructureDefinition",
+      "id": "Observation",
+      "name": "Observation",
+      "kind": "resource",
+      "type": "Observation",
+      "snapshot": {"element": [{
+        "path": "Observation",
+        "min": 0,
+        "max": "*",
+        "constraint": [
+          {"key": "obs-6", "severity": "error", "human": "dataAbsentReason SHALL only be present if Observation.value[x] is not present", "expression": "dataAbsentReason.empty() or value.empty()"},
+          {"key": "dom-6", "severity": "warning", "human": "A resource should have narrative for robust management", "expression": "text.div.exists()"}
+        ]
+      }]}
+    }
+  }]
+}`
+	file := filepath.Join(t.TempDir(), "profiles-resources.json")
+	if err := os.WriteFile(file, []byte(bundle), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := New()
+	if err := p.ParseFile(file); err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+	def, ok := p.GetDefinition("Observation")
+	if !ok {
+		t.Fatal("Observation not parsed")
+	}
+	constraints := def.Snapshot.Elements[0].Constraints
+	if len(constraints) != 2 {
+		t.Fatalf("got %d constraints, want 2", len(constraints))
+	}
+	c := constraints[0]
+	if c.Key != "obs-6" || c.Severity != "error" || c.Expression != "dataAbsentReason.empty() or value.empty()" || c.Human == "" {
+		t.Errorf("constraint = %+v", c)
+	}
+	if constraints[1].Severity != "warning" {
+		t.Errorf("dom-6 severity = %q, want warning", constraints[1].Severity)
+	}
+}
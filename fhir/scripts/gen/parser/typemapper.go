@@ -11,6 +11,9 @@ import (
 type TypeMapper struct {
 	// Map of FHIR primitive type codes to Go types
 	primitiveMap map[string]string
+
+	// ValueSets with a typed enum, by URL without a version
+	enums map[string]*model.ValueSet
 }
 
 // NewTypeMapper creates a new type mapper.
@@ -18,17 +21,17 @@ func NewTypeMapper() *TypeMapper {
 	return &TypeMapper{
 		primitiveMap: map[string]string{
 			// FHIR primitive types
-			"base64Binary": "string",
+			"base64Binary": "primitives.Base64Binary",
 			"boolean":      "bool",
-			"canonical":    "string",
+			"canonical":    "primitives.Canonical",
 			"code":         "string",
 			"date":         "primitives.Date",
 			"dateTime":     "primitives.DateTime",
-			"decimal":      "float64",
+			"decimal":      "primitives.Decimal",
 			"id":           "string",
 			"instant":      "primitives.Instant",
 			"integer":      "int",
-			"integer64":    "int64", // R5 new type
+			"integer64":    "primitives.Integer64", // R5 new type
 			"markdown":     "string",
 			"oid":          "string",
 			"positiveInt":  "int",
@@ -43,9 +46,43 @@ func NewTypeMapper() *TypeMapper {
 			"http://hl7.org/fhirpath/System.String": "string",
 			"Resource":                              "json.RawMessage", // polymorphic - use lazy deserialization
 		},
+		enums: make(map[string]*model.ValueSet),
 	}
 }
 
+// AddValueSet makes codes with a required binding to vs use a typed enum
+// named vs.GoName, or vs.Name if that is empty. It returns false, and the
+// codes stay strings, if the codes can't all be given constant names or
+// another ValueSet has the name.
+func (tm *TypeMapper) AddValueSet(vs *model.ValueSet) bool {
+	if vs.GoName == "" {
+		vs.GoName = ToGoIdentifier(vs.Name)
+	}
+	if vs.GoName == "" || vs.GoName[0] < 'A' {
+		return false
+	}
+	if _, ok := EnumConstants(vs); !ok {
+		return false
+	}
+	for _, other := range tm.enums {
+		if other.GoName == vs.GoName {
+			return false
+		}
+	}
+	tm.enums[vs.URL] = vs
+	return true
+}
+
+// enumFor returns the ValueSet whose typed enum an element of type code
+// with binding b uses, or nil if it stays a string.
+func (tm *TypeMapper) enumFor(code string, b *model.ElementBinding) *model.ValueSet {
+	if code != "code" || b == nil || b.Strength != "required" {
+		return nil
+	}
+	url, _, _ := strings.Cut(b.ValueSet, "|")
+	return tm.enums[url]
+}
+
 // MapType converts a FHIR type to a Go type.
 func (tm *TypeMapper) MapType(fhirType string) string {
 	// Check if it's a primitive type
@@ -115,22 +152,27 @@ func (tm *TypeMapper) MapElementToField(elem model.ElementDefinition, parentPath
 
 	// Determine if field is optional (pointer)
 	// json.RawMessage should not use pointers since it's already a reference type ([]byte)
-	field.IsPointer = elem.Min == 0 && elem.Max == "1" && goType != "json.RawMessage"
-
-	// Extract enum values from binding if present
-	if elem.Binding != nil && elem.Binding.Strength == "required" {
-		// For required bindings, we could extract enum values
-		// This would require parsing the ValueSet, which is complex
-		// For now, we'll mark the field but not populate EnumValues
-		// TODO: Implement ValueSet parsing for enum validation
-	}
+	// Choice options are always pointers, as only one of them can be set
+	field.IsPointer = (elem.Min == 0 || field.IsChoice) && elem.Max == "1" && goType != "json.RawMessage"
+
+	// Keep the bindings that can make an instance invalid; the validator
+	// checks them against the ValueSet through a terminology service
+	field.Binding = validationBinding(elem.Binding)
 
 	// Set the Go type that we already determined above
 	field.GoType = goType
+	if len(elem.Types) == 1 {
+		field.FHIRType = tm.formatType(elem.Types[0].Code)
+		if vs := tm.enumFor(elem.Types[0].Code, elem.Binding); vs != nil {
+			field.GoType = vs.GoName
+			field.Enum = vs
+		}
+	}
 
 	// For choice types, set the choice suffix
 	if field.IsChoice {
 		field.ChoiceSuffix = ToPascalCase(elem.Types[0].Code)
+		field.ChoiceType = elem.Types[0].Code
 	}
 
 	return field, nil
@@ -149,7 +191,7 @@ func (tm *TypeMapper) MapElementToChoiceFields(elem model.ElementDefinition, par
 
 	for _, typeInfo := range elem.Types {
 		field := &model.Field{
-			JSONName:     baseName + ToPascalCase(typeInfo.Code),
+			JSONName:     baseName + strings.ToUpper(typeInfo.Code[:1]) + typeInfo.Code[1:],
 			Min:          elem.Min,
 			Max:          elem.Max,
 			Comment:      elem.Short + " - " + typeInfo.Code + " option",
@@ -158,10 +200,17 @@ func (tm *TypeMapper) MapElementToChoiceFields(elem model.ElementDefinition, par
 			IsChoice:     true,
 			ChoiceGroup:  choiceGroup,
 			ChoiceSuffix: ToPascalCase(typeInfo.Code),
+			ChoiceType:   typeInfo.Code,
 			Name:         ToPascalCase(baseName) + ToPascalCase(typeInfo.Code),
 			GoType:       tm.MapType(typeInfo.Code),
-			IsPointer:    elem.Min == 0,
+			IsPointer:    elem.Max == "1", // only one option of a choice can be set
 			IsArray:      elem.Max == "*",
+			Binding:      validationBinding(elem.Binding),
+			FHIRType:     tm.formatType(typeInfo.Code),
+		}
+		if vs := tm.enumFor(typeInfo.Code, elem.Binding); vs != nil {
+			field.GoType = vs.GoName
+			field.Enum = vs
 		}
 
 		fields = append(fields, field)
@@ -170,6 +219,33 @@ func (tm *TypeMapper) MapElementToChoiceFields(elem model.ElementDefinition, par
 	return fields, nil
 }
 
+// formatType returns the primitive type code if the validator should check
+// the format of its values, which a Go string or number alone doesn't
+// constrain, and "" otherwise. Go's bool matches boolean, the primitives
+// package checks its own types, such as dates and decimals, and string and
+// xhtml have no format worth a tag.
+func (tm *TypeMapper) formatType(code string) string {
+	switch tm.primitiveMap[code] {
+	case "string", "int", "uint":
+	default:
+		return ""
+	}
+	switch code {
+	case "string", "xhtml", "http://hl7.org/fhirpath/System.String":
+		return ""
+	}
+	return code
+}
+
+// validationBinding returns b if it is required or extensible, and nil
+// otherwise. Preferred and example bindings are left out of the struct tags.
+func validationBinding(b *model.ElementBinding) *model.ElementBinding {
+	if b == nil || (b.Strength != "required" && b.Strength != "extensible") {
+		return nil
+	}
+	return b
+}
+
 // extractFieldName extracts the field name from a path.
 // e.g., "Patient.name" with parentPath "Patient" returns "name"
 func extractFieldName(path, parentPath string) string {
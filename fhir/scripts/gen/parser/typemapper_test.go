@@ -19,8 +19,8 @@ func TestMapType(t *testing.T) {
 		{"boolean", "boolean", "bool"},
 		{"string", "string", "string"},
 		{"integer", "integer", "int"},
-		{"integer64", "integer64", "int64"},
-		{"decimal", "decimal", "float64"},
+		{"integer64", "integer64", "primitives.Integer64"},
+		{"decimal", "decimal", "primitives.Decimal"},
 		{"unsignedInt", "unsignedInt", "uint"},
 		{"positiveInt", "positiveInt", "int"},
 
@@ -29,11 +29,11 @@ func TestMapType(t *testing.T) {
 		{"id", "id", "string"},
 		{"uri", "uri", "string"},
 		{"url", "url", "string"},
-		{"canonical", "canonical", "string"},
+		{"canonical", "canonical", "primitives.Canonical"},
 		{"uuid", "uuid", "string"},
 		{"oid", "oid", "string"},
 		{"markdown", "markdown", "string"},
-		{"base64Binary", "base64Binary", "string"},
+		{"base64Binary", "base64Binary", "primitives.Base64Binary"},
 		{"xhtml", "xhtml", "string"},
 
 		// Date/time primitives
@@ -196,6 +196,38 @@ func TestMapElementToField_RequiredField(t *testing.T) {
 	}
 }
 
+// TestMapElementToField_Binding tests that only required and extensible
+// bindings reach the field.
+func TestMapElementToField_Binding(t *testing.T) {
+	tm := NewTypeMapper()
+
+	elem := model.ElementDefinition{
+		Path:    "Observation.status",
+		Min:     1,
+		Max:     "1",
+		Types:   []model.ElementType{{Code: "code"}},
+		Binding: &model.ElementBinding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status|4.0.1"},
+	}
+	field, err := tm.MapElementToField(elem, "Observation")
+	if err != nil {
+		t.Fatalf("MapElementToField() error = %v", err)
+	}
+	if field.Binding == nil || field.Binding.ValueSet != elem.Binding.ValueSet {
+		t.Errorf("field.Binding = %+v, want %+v", field.Binding, elem.Binding)
+	}
+
+	elem.Path = "Observation.method"
+	elem.Types = []model.ElementType{{Code: "CodeableConcept"}}
+	elem.Binding = &model.ElementBinding{Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-methods"}
+	field, err = tm.MapElementToField(elem, "Observation")
+	if err != nil {
+		t.Fatalf("MapElementToField() error = %v", err)
+	}
+	if field.Binding != nil {
+		t.Errorf("field.Binding = %+v, want nil for an example binding", field.Binding)
+	}
+}
+
 // TestMapElementToField_ResourceType tests json.RawMessage for Resource type.
 func TestMapElementToField_ResourceType(t *testing.T) {
 	tm := NewTypeMapper()
@@ -451,3 +483,158 @@ func TestIsBackboneElement(t *testing.T) {
 		})
 	}
 }
+
+// TestMapElementToField_FHIRType tests which primitive types get a format check.
+func TestMapElementToField_FHIRType(t *testing.T) {
+	tm := NewTypeMapper()
+
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"id", "id"},
+		{"uri", "uri"},
+		{"positiveInt", "positiveInt"},
+		{"decimal", ""},
+		{"canonical", ""},
+		{"string", ""},
+		{"boolean", ""},
+		{"dateTime", ""},
+		{"HumanName", ""},
+	}
+
+	for _, tt := range tests {
+		elem := model.ElementDefinition{
+			Path:  "Patient.x",
+			Max:   "1",
+			Types: []model.ElementType{{Code: tt.code}},
+		}
+		field, err := tm.MapElementToField(elem, "Patient")
+		if err != nil {
+			t.Fatalf("MapElementToField() error = %v", err)
+		}
+		if field.FHIRType != tt.want {
+			t.Errorf("%s: field.FHIRType = %q, want %q", tt.code, field.FHIRType, tt.want)
+		}
+	}
+
+	choice := model.ElementDefinition{
+		Path:  "Observation.value[x]",
+		Max:   "1",
+		Types: []model.ElementType{{Code: "integer"}, {Code: "string"}},
+	}
+	fields, err := tm.MapElementToChoiceFields(choice, "Observation")
+	if err != nil {
+		t.Fatalf("MapElementToChoiceFields() error = %v", err)
+	}
+	if fields[0].FHIRType != "integer" || fields[1].FHIRType != "" {
+		t.Errorf("choice FHIRTypes = %q, %q, want integer and none", fields[0].FHIRType, fields[1].FHIRType)
+	}
+}
+
+// TestMapElementToField_Enum tests that codes with a required binding to a
+// ValueSet with an enum use the enum type.
+func TestMapElementToField_Enum(t *testing.T) {
+	tm := NewTypeMapper()
+	gender := &model.ValueSet{
+		URL:      "http://hl7.org/fhir/ValueSet/administrative-gender",
+		Name:     "AdministrativeGender",
+		Concepts: []model.Concept{{Code: "male", Display: "Male"}, {Code: "female", Display: "Female"}},
+	}
+	if !tm.AddValueSet(gender) {
+		t.Fatal("AddValueSet() = false")
+	}
+	if tm.AddValueSet(&model.ValueSet{URL: "http://example.org/ValueSet/other", Name: "AdministrativeGender", Concepts: gender.Concepts}) {
+		t.Error("AddValueSet() = true for a second ValueSet with the same name")
+	}
+
+	elem := model.ElementDefinition{
+		Path:    "Patient.gender",
+		Max:     "1",
+		Types:   []model.ElementType{{Code: "code"}},
+		Binding: &model.ElementBinding{Strength: "required", ValueSet: gender.URL + "|5.0.0"},
+	}
+	field, err := tm.MapElementToField(elem, "Patient")
+	if err != nil {
+		t.Fatalf("MapElementToField() error = %v", err)
+	}
+	if field.GoType != "AdministrativeGender" || field.Enum != gender || !field.IsPointer {
+		t.Errorf("field = %+v, want a pointer to AdministrativeGender", field)
+	}
+
+	// Extensible bindings allow other codes, so stay strings
+	elem.Binding.Strength = "extensible"
+	field, err = tm.MapElementToField(elem, "Patient")
+	if err != nil {
+		t.Fatalf("MapElementToField() error = %v", err)
+	}
+	if field.GoType != "string" || field.Enum != nil {
+		t.Errorf("field.GoType = %q, want string for an extensible binding", field.GoType)
+	}
+}
+
+// TestMapElementToChoiceFields_AcronymTypes tests that the JSON names of
+// choice options keep the case of the FHIR type, unlike the Go names.
+func TestMapElementToChoiceFields_AcronymTypes(t *testing.T) {
+	tm := NewTypeMapper()
+
+	elem := model.ElementDefinition{
+		Path: "Extension.value[x]",
+		Min:  0,
+		Max:  "1",
+		Types: []model.ElementType{
+			{Code: "id"},
+			{Code: "uri"},
+			{Code: "url"},
+			{Code: "uuid"},
+		},
+	}
+
+	fields, err := tm.MapElementToChoiceFields(elem, "Extension")
+	if err != nil {
+		t.Fatalf("MapElementToChoiceFields() error = %v", err)
+	}
+
+	want := []struct{ name, jsonName string }{
+		{"ValueID", "valueId"},
+		{"ValueURI", "valueUri"},
+		{"ValueURL", "valueUrl"},
+		{"ValueUUID", "valueUuid"},
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("len(fields) = %d, want %d", len(fields), len(want))
+	}
+	for i, w := range want {
+		if fields[i].Name != w.name || fields[i].JSONName != w.jsonName {
+			t.Errorf("fields[%d] = %s %q, want %s %q", i, fields[i].Name, fields[i].JSONName, w.name, w.jsonName)
+		}
+	}
+}
+
+// TestMapElementToChoiceFields_Required tests that the options of a required
+// choice are pointers, as only one of them can be set.
+func TestMapElementToChoiceFields_Required(t *testing.T) {
+	tm := NewTypeMapper()
+
+	elem := model.ElementDefinition{
+		Path:  "Claim.diagnosis.diagnosis[x]",
+		Min:   1,
+		Max:   "1",
+		Types: []model.ElementType{{Code: "CodeableConcept"}, {Code: "dateTime"}},
+	}
+	fields, err := tm.MapElementToChoiceFields(elem, "Claim.diagnosis")
+	if err != nil {
+		t.Fatalf("MapElementToChoiceFields() error = %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("len(fields) = %d, want 2", len(fields))
+	}
+	for _, f := range fields {
+		if !f.IsPointer || !f.IsRequired {
+			t.Errorf("%s: IsPointer = %v, IsRequired = %v, want both true", f.Name, f.IsPointer, f.IsRequired)
+		}
+	}
+	if fields[1].ChoiceType != "dateTime" {
+		t.Errorf("ChoiceType = %q, want %q", fields[1].ChoiceType, "dateTime")
+	}
+}
@@ -0,0 +1,238 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/model"
+)
+
+// RawValueSet is a ValueSet from the FHIR specification (valuesets.json).
+type RawValueSet struct {
+	ResourceType string `json:"resourceType"`
+	URL          string `json:"url"`
+	Version      string `json:"version"`
+	Name         string `json:"name"`
+	Compose      *struct {
+		Include []RawValueSetInclude `json:"include"`
+		Exclude []RawValueSetInclude `json:"exclude"`
+	} `json:"compose"`
+}
+
+// RawValueSetInclude is a compose.include or compose.exclude of a ValueSet.
+type RawValueSetInclude struct {
+	System   string            `json:"system"`
+	Concept  []RawConcept      `json:"concept"`
+	Filter   []json.RawMessage `json:"filter"`
+	ValueSet []string          `json:"valueSet"`
+}
+
+// RawCodeSystem is a CodeSystem from the FHIR specification.
+type RawCodeSystem struct {
+	ResourceType string       `json:"resourceType"`
+	URL          string       `json:"url"`
+	Content      string       `json:"content"`
+	Concept      []RawConcept `json:"concept"`
+}
+
+// RawConcept is a concept of a CodeSystem, or one listed by a ValueSet.
+type RawConcept struct {
+	Code    string       `json:"code"`
+	Display string       `json:"display"`
+	Concept []RawConcept `json:"concept"`
+}
+
+// ParseValueSetFile parses a FHIR valuesets.json file, which holds the
+// ValueSets and CodeSystems of the specification. The ValueSets that can be
+// expanded from it are then available from GetValueSets.
+func (p *Parser) ParseValueSetFile(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+
+	var bundle StructDefBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return fmt.Errorf("unmarshal bundle: %w", err)
+	}
+
+	for _, entry := range bundle.Entry {
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(entry.Resource, &header); err != nil {
+			continue
+		}
+
+		switch header.ResourceType {
+		case "ValueSet":
+			var vs RawValueSet
+			if err := json.Unmarshal(entry.Resource, &vs); err != nil {
+				return fmt.Errorf("unmarshal ValueSet: %w", err)
+			}
+			p.valueSets[vs.URL] = &vs
+		case "CodeSystem":
+			var cs RawCodeSystem
+			if err := json.Unmarshal(entry.Resource, &cs); err != nil {
+				return fmt.Errorf("unmarshal CodeSystem: %w", err)
+			}
+			p.codeSystems[cs.URL] = &cs
+		}
+	}
+
+	return nil
+}
+
+// GetValueSets returns the ValueSets that can be expanded from the parsed
+// definitions, sorted by URL. A ValueSet is left out if it uses filters or
+// other ValueSets, includes a CodeSystem that isn't complete, or has the
+// same code from two systems, as none of those make a simple enum.
+func (p *Parser) GetValueSets() []*model.ValueSet {
+	var result []*model.ValueSet
+	for _, raw := range p.valueSets {
+		if vs, ok := p.expand(raw); ok {
+			result = append(result, vs)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].URL < result[j].URL
+	})
+	return result
+}
+
+// expand lists the codes of a ValueSet.
+func (p *Parser) expand(raw *RawValueSet) (*model.ValueSet, bool) {
+	if raw.Compose == nil || len(raw.Compose.Include) == 0 || raw.Name == "" {
+		return nil, false
+	}
+
+	excluded := make(map[string]bool)
+	for _, exc := range raw.Compose.Exclude {
+		if len(exc.Filter) > 0 || len(exc.ValueSet) > 0 || len(exc.Concept) == 0 {
+			return nil, false
+		}
+		for _, c := range exc.Concept {
+			excluded[exc.System+"|"+c.Code] = true
+		}
+	}
+
+	vs := &model.ValueSet{URL: raw.URL, Version: raw.Version, Name: raw.Name}
+	seen := make(map[string]bool)
+	add := func(system string, c RawConcept) bool {
+		if excluded[system+"|"+c.Code] {
+			return true
+		}
+		if seen[c.Code] {
+			return false
+		}
+		seen[c.Code] = true
+		vs.Concepts = append(vs.Concepts, model.Concept{Code: c.Code, Display: c.Display})
+		return true
+	}
+
+	for _, inc := range raw.Compose.Include {
+		if inc.System == "" || len(inc.Filter) > 0 || len(inc.ValueSet) > 0 {
+			return nil, false
+		}
+		cs := p.codeSystems[inc.System]
+
+		if len(inc.Concept) > 0 {
+			for _, c := range inc.Concept {
+				if c.Display == "" && cs != nil {
+					c.Display = findDisplay(cs.Concept, c.Code)
+				}
+				if !add(inc.System, c) {
+					return nil, false
+				}
+			}
+			continue
+		}
+
+		if cs == nil || cs.Content != "complete" {
+			return nil, false
+		}
+		for _, c := range flattenConcepts(cs.Concept) {
+			if !add(inc.System, c) {
+				return nil, false
+			}
+		}
+	}
+
+	if len(vs.Concepts) == 0 {
+		return nil, false
+	}
+	return vs, true
+}
+
+// flattenConcepts lists a concept hierarchy depth first.
+func flattenConcepts(concepts []RawConcept) []RawConcept {
+	var result []RawConcept
+	for _, c := range concepts {
+		result = append(result, c)
+		result = append(result, flattenConcepts(c.Concept)...)
+	}
+	return result
+}
+
+// findDisplay returns the display of code in a concept hierarchy.
+func findDisplay(concepts []RawConcept, code string) string {
+	for _, c := range flattenConcepts(concepts) {
+		if c.Code == code {
+			return c.Display
+		}
+	}
+	return ""
+}
+
+// ToGoIdentifier converts a FHIR name to a Go identifier, dropping the
+// characters Go doesn't allow and capitalizing what follows them.
+// "transaction-response" becomes "TransactionResponse", and digits either
+// side of a dropped character are kept apart: "4.0.1" becomes "4_0_1".
+func ToGoIdentifier(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		isLetter := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+		isDigit := r >= '0' && r <= '9'
+		if !isLetter && !isDigit {
+			upper = true
+			continue
+		}
+		if upper && isDigit && b.Len() > 0 {
+			last := b.String()[b.Len()-1]
+			if last >= '0' && last <= '9' {
+				b.WriteByte('_')
+			}
+		}
+		if upper {
+			r = []rune(strings.ToUpper(string(r)))[0]
+		}
+		b.WriteRune(r)
+		upper = false
+	}
+	return b.String()
+}
+
+// EnumConstants returns the names of the Go constants for the codes of a
+// ValueSet, in order: the type name followed by the code as an identifier,
+// or by the display for codes such as "<=" that have no letters or digits.
+// It returns false if two codes would share a name.
+func EnumConstants(vs *model.ValueSet) ([]string, bool) {
+	names := make([]string, len(vs.Concepts))
+	seen := make(map[string]bool)
+	for i, c := range vs.Concepts {
+		ident := ToGoIdentifier(c.Code)
+		if ident == "" {
+			ident = ToGoIdentifier(c.Display)
+		}
+		if ident == "" || seen[ident] {
+			return nil, false
+		}
+		seen[ident] = true
+		names[i] = vs.GoName + ident
+	}
+	return names, true
+}
@@ -0,0 +1,185 @@
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/model"
+)
+
+// TestParseValueSetFile tests that ValueSets are expanded from their
+// CodeSystems, and left out when they can't be listed as an enum.
+func TestParseValueSetFile(t *testing.T) {
+	bundle := `{
+  "resourceType": "Bundle",
+  "type": "collection",
+  "entry": [
+    {"resource": {
+      "resourceType": "CodeSystem",
+      "url": "http://hl7.org/fhir/administrative-gender",
+      "content": "complete",
+      "concept": [
+        {"code": "male", "display": "Male"},
+        {"code": "female", "display": "Female"},
+        {"code": "other", "display": "Other"},
+        {"code": "unknown", "display": "Unknown"}
+      ]
+    }},
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://hl7.org/fhir/ValueSet/administrative-gender",
+      "version": "5.0.0",
+      "name": "AdministrativeGender",
+      "compose": {"include": [{"system": "http://hl7.org/fhir/administrative-gender"}]}
+    }},
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://example.org/ValueSet/binary-gender",
+      "name": "BinaryGender",
+      "compose": {
+        "include": [{"system": "http://hl7.org/fhir/administrative-gender"}],
+        "exclude": [{"system": "http://hl7.org/fhir/administrative-gender", "concept": [{"code": "other"}, {"code": "unknown"}]}]
+      }
+    }},
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://example.org/ValueSet/listed",
+      "name": "Listed",
+      "compose": {"include": [{"system": "http://hl7.org/fhir/administrative-gender", "concept": [{"code": "female"}]}]}
+    }},
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://hl7.org/fhir/ValueSet/mimetypes",
+      "name": "MimeType",
+      "compose": {"include": [{"system": "urn:ietf:bcp:13"}]}
+    }},
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://example.org/ValueSet/filtered",
+      "name": "Filtered",
+      "compose": {"include": [{"system": "http://hl7.org/fhir/administrative-gender", "filter": [{"property": "concept", "op": "is-a", "value": "male"}]}]}
+    }}
+  ]
+}`
+	file := filepath.Join(t.TempDir(), "valuesets.json")
+	if err := os.WriteFile(file, []byte(bundle), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := New()
+	if err := p.ParseValueSetFile(file); err != nil {
+		t.Fatalf("ParseValueSetFile() error = %v", err)
+	}
+
+	got := make(map[string][]model.Concept)
+	for _, vs := range p.GetValueSets() {
+		got[vs.Name] = vs.Concepts
+	}
+	if len(got) != 3 {
+		t.Fatalf("GetValueSets() = %v, want AdministrativeGender, BinaryGender and Listed", got)
+	}
+	if gender := got["AdministrativeGender"]; len(gender) != 4 || gender[0] != (model.Concept{Code: "male", Display: "Male"}) {
+		t.Errorf("AdministrativeGender = %v", gender)
+	}
+	if binary := got["BinaryGender"]; len(binary) != 2 || binary[1].Code != "female" {
+		t.Errorf("BinaryGender = %v, want male and female", binary)
+	}
+	if listed := got["Listed"]; len(listed) != 1 || listed[0].Display != "Female" {
+		t.Errorf("Listed = %v, want female with the CodeSystem display", listed)
+	}
+}
+
+// TestParseValueSetFile_Schemas tests that every ValueSet of the checked-in
+// valuesets-required.json files expands to an enum.
+func TestParseValueSetFile_Schemas(t *testing.T) {
+	for _, version := range []string{"r4", "r5"} {
+		t.Run(version, func(t *testing.T) {
+			file := filepath.Join("..", "..", "..", "..", "fhir_schemas", version, "valuesets-required.json")
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var bundle StructDefBundle
+			if err := json.Unmarshal(data, &bundle); err != nil {
+				t.Fatal(err)
+			}
+			want := 0
+			for _, entry := range bundle.Entry {
+				var header struct {
+					ResourceType string `json:"resourceType"`
+				}
+				if err := json.Unmarshal(entry.Resource, &header); err != nil {
+					t.Fatal(err)
+				}
+				if header.ResourceType == "ValueSet" {
+					want++
+				}
+			}
+
+			p := New()
+			if err := p.ParseValueSetFile(file); err != nil {
+				t.Fatalf("ParseValueSetFile() error = %v", err)
+			}
+			valueSets := p.GetValueSets()
+			if len(valueSets) != want {
+				t.Errorf("GetValueSets() = %d ValueSets, want %d", len(valueSets), want)
+			}
+			for _, vs := range valueSets {
+				if _, ok := EnumConstants(vs); !ok {
+					t.Errorf("EnumConstants(%s) ok = false", vs.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestToGoIdentifier(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"male", "Male"},
+		{"transaction-response", "TransactionResponse"},
+		{"openAtEnd", "OpenAtEnd"},
+		{"entered-in-error", "EnteredInError"},
+		{"4.0.1", "4_0_1"},
+		{"Less or Equal to", "LessOrEqualTo"},
+		{"<=", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ToGoIdentifier(tt.input); got != tt.want {
+				t.Errorf("ToGoIdentifier(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumConstants(t *testing.T) {
+	vs := &model.ValueSet{
+		GoName: "QuantityComparator",
+		Concepts: []model.Concept{
+			{Code: "<", Display: "Less than"},
+			{Code: "<=", Display: "Less or Equal to"},
+			{Code: "ad", Display: "Sufficient to achieve this total quantity"},
+		},
+	}
+	names, ok := EnumConstants(vs)
+	if !ok {
+		t.Fatal("EnumConstants() ok = false")
+	}
+	want := []string{"QuantityComparatorLessThan", "QuantityComparatorLessOrEqualTo", "QuantityComparatorAd"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("names[%d] = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	vs.Concepts = []model.Concept{{Code: "a-b"}, {Code: "a.b"}}
+	if _, ok := EnumConstants(vs); ok {
+		t.Error("EnumConstants() ok = true for codes with the same name")
+	}
+}
@@ -0,0 +1,139 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/internal/ig"
+)
+
+// Load loads the conformance resources of sources into l and returns the
+// canonical URLs of the resource profiles they define, ordered by URL. A
+// source is one of:
+//
+//   - a FHIR NPM package, as a package.tgz or an extracted folder;
+//   - a SUSHI project, a folder with a sushi-config.yaml, whose FSH is
+//     exported;
+//   - a JSON file holding a resource, or a Bundle of them, such as
+//     fhir_schemas/r4/profiles-types.json;
+//   - a folder of such JSON files.
+//
+// Dependencies of packages and SUSHI projects are loaded from the package
+// cache. JSON resources without a fhirVersion are taken to be of
+// fhirVersion. Profiles loaded as dependencies are not returned.
+func Load(l *ig.Loader, sources []string, fhirVersion string) ([]string, error) {
+	own := make(map[string]bool)
+	var errs []error
+	for _, source := range sources {
+		ids, err := load(l, source, fhirVersion)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source, err))
+		}
+		for _, id := range ids {
+			own[id] = true
+		}
+	}
+
+	var urls []string
+	for _, e := range l.Registry.List("StructureDefinition") {
+		if !own[e.Source] {
+			continue
+		}
+		sd, err := toObject(e.Resource)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if str(sd, "kind") == "resource" && str(sd, "derivation") == "constraint" {
+			urls = append(urls, e.URL)
+		}
+	}
+	return urls, errors.Join(errs...)
+}
+
+// load loads one source and returns the names its resources are registered
+// under.
+func load(l *ig.Loader, source, fhirVersion string) ([]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !info.IsDir() && strings.HasSuffix(source, ".tgz"):
+		return loadPackage(l, source)
+	case !info.IsDir():
+		return []string{source}, loadJSON(l, source, fhirVersion)
+	case exists(filepath.Join(source, "sushi-config.yaml")):
+		return []string{source}, l.LoadFromIG(source)
+	case exists(filepath.Join(source, "package.json")) || exists(filepath.Join(source, "package", "package.json")):
+		return loadPackage(l, source)
+	}
+
+	files, err := filepath.Glob(filepath.Join(source, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, file := range files {
+		if err := loadJSON(l, file, fhirVersion); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return files, errors.Join(errs...)
+}
+
+func loadPackage(l *ig.Loader, path string) ([]string, error) {
+	pkg, err := ig.ReadPackage(path)
+	if err != nil {
+		return nil, err
+	}
+	return []string{pkg.Manifest.ID()}, l.LoadPackage(path)
+}
+
+// loadJSON registers the resource in a JSON file, or the entries of a Bundle.
+func loadJSON(l *ig.Loader, file, fhirVersion string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var head struct {
+		ResourceType string `json:"resourceType"`
+		Entry        []struct {
+			Resource json.RawMessage `json:"resource"`
+		} `json:"entry"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	resources := []json.RawMessage{data}
+	if head.ResourceType == "Bundle" {
+		resources = resources[:0]
+		for _, e := range head.Entry {
+			resources = append(resources, e.Resource)
+		}
+	}
+
+	var errs []error
+	for _, r := range resources {
+		var v struct {
+			FHIRVersion string `json:"fhirVersion"`
+		}
+		_ = json.Unmarshal(r, &v)
+		if v.FHIRVersion == "" {
+			v.FHIRVersion = fhirVersion
+		}
+		if err := l.AddResource(r, v.FHIRVersion, file); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
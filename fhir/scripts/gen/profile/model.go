@@ -0,0 +1,255 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// object is a decoded JSON object.
+type object = map[string]any
+
+// fileHeader is what every generated file starts with.
+type fileHeader struct {
+	Package          string
+	FHIRVersion      string
+	GeneratorVersion string
+	GeneratedAt      string
+	Base             string // the version package, such as r4
+	BaseImport       string
+}
+
+// packageModel is what the generated package holds.
+type packageModel struct {
+	fileHeader
+	Profiles   []*profileModel
+	Extensions []extensionURL
+
+	extensions  map[string]*extensionURL // by URL
+	definitions map[string]object        // embedded StructureDefinitions by id
+}
+
+// extensionURL is the constant for the URL of an extension the profiles use.
+type extensionURL struct {
+	Const string
+	URL   string
+	Title string
+}
+
+// profileModel is the Go type of a profile.
+type profileModel struct {
+	fileHeader
+	Imports      []string
+	Name         string // e.g., BDPatient
+	URL          string
+	Title        string
+	ResourceType string // e.g., Patient
+	Receiver     string
+	Fixed        string // the JSON of the fixed values, as a Go string literal
+	Slices       []sliceModel
+	Extensions   []extensionModel
+
+	imports importSet
+}
+
+// sliceModel is a slice of a top-level element, such as Patient.identifier:NID.
+type sliceModel struct {
+	Name           string // e.g., IdentifierNID
+	Field          string // e.g., Identifier
+	Item           string // e.g., r4.Identifier
+	Element        string // e.g., Patient.identifier:NID
+	Single         bool
+	Discriminators []string
+	Match          string   // condition on item that selects the slice
+	Apply          []string // statements that give item the discriminator values
+}
+
+// extensionModel is an extension slice, such as Patient.extension:shelter.
+type extensionModel struct {
+	Name       string // e.g., Shelter
+	Field      string // Extension or ModifierExtension
+	Const      string // e.g., ExtensionShelter
+	URL        string
+	Element    string // e.g., Patient.extension:shelter
+	Single     bool
+	Extension  string // e.g., r4.Extension
+	ValueField string // e.g., ValueString; empty for a complex extension
+	ValueType  string // e.g., string
+	Pointer    bool   // whether ValueField is a pointer
+}
+
+// importSet collects the packages generated code refers to.
+type importSet map[string]bool
+
+func (s importSet) add(path string) {
+	s[path] = true
+}
+
+// sorted returns the imports, standard library first.
+func (s importSet) sorted() []string {
+	var std, other []string
+	for path := range s {
+		if strings.Contains(path, ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+	return append(std, other...)
+}
+
+// typeName returns the Go name of t, such as r4.Identifier, importing its
+// package.
+func (s importSet) typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + s.typeName(t.Elem())
+	case reflect.Slice:
+		if t.Name() == "" {
+			return "[]" + s.typeName(t.Elem())
+		}
+	}
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+	s.add(t.PkgPath())
+	return t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:] + "." + t.Name()
+}
+
+// discriminator returns the conditions under which an item of type t has
+// value at path, and the statements that set it. The elements on the path
+// must not repeat, and the value must be a string, boolean or integer.
+// used holds the names of the variables taken so far.
+func (s importSet) discriminator(t reflect.Type, path []string, value any, used map[string]bool) ([]string, []string, error) {
+	var conds, apply []string
+	expr := "item"
+	for i, segment := range path {
+		f, ok := fieldByJSONName(t, segment)
+		if !ok {
+			return nil, nil, fmt.Errorf("%s has no element %s", t.Name(), segment)
+		}
+		if isSlice(f.Type) {
+			return nil, nil, fmt.Errorf("%s repeats", segment)
+		}
+		expr += "." + f.Name
+		ft := f.Type
+		pointer := ft.Kind() == reflect.Pointer
+		if pointer {
+			ft = ft.Elem()
+			conds = append(conds, expr+" != nil")
+		}
+		if i < len(path)-1 {
+			if ft.Kind() != reflect.Struct {
+				return nil, nil, fmt.Errorf("%s is not an element with children", segment)
+			}
+			if pointer {
+				apply = append(apply, fmt.Sprintf("if %s == nil {\n%s = &%s{}\n}", expr, expr, s.typeName(ft)))
+			}
+			t = ft
+			continue
+		}
+
+		literal, err := s.literal(ft, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !pointer {
+			conds = append(conds, expr+" == "+literal)
+			apply = append(apply, expr+" = "+literal)
+			break
+		}
+		conds = append(conds, "*"+expr+" == "+literal)
+		name := segment + "Value"
+		for n := 2; used[name]; n++ {
+			name = segment + "Value" + strconv.Itoa(n)
+		}
+		used[name] = true
+		apply = append(apply, name+" := "+literal, expr+" = &"+name)
+	}
+	return conds, apply, nil
+}
+
+// literal returns a Go expression of type t for a JSON value.
+func (s importSet) literal(t reflect.Type, value any) (string, error) {
+	switch t.Kind() {
+	case reflect.String:
+		v, ok := value.(string)
+		if !ok {
+			break
+		}
+		if t.PkgPath() == "" {
+			return strconv.Quote(v), nil
+		}
+		return s.typeName(t) + "(" + strconv.Quote(v) + ")", nil
+	case reflect.Bool:
+		if v, ok := value.(bool); ok {
+			return strconv.FormatBool(v), nil
+		}
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		if v, ok := value.(float64); ok {
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	default:
+		return "", fmt.Errorf("can't compare a %s", t)
+	}
+	return "", fmt.Errorf("value %v is not a %s", value, t)
+}
+
+// toObject returns a copy of a resource, such as a generated struct, as
+// decoded JSON.
+func toObject(resource any) (object, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var m object
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// elements returns the snapshot elements of a StructureDefinition.
+func elements(sd object) []object {
+	return objects(obj(sd, "snapshot"), "element")
+}
+
+func obj(m object, key string) object {
+	v, _ := m[key].(map[string]any)
+	return v
+}
+
+func objects(m object, key string) []object {
+	list, _ := m[key].([]any)
+	var out []object
+	for _, v := range list {
+		if o, ok := v.(map[string]any); ok {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+func str(m object, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func stringList(m object, key string) []string {
+	list, _ := m[key].([]any)
+	var out []string
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,495 @@
+// Package profile generates Go types for profiles: StructureDefinitions that
+// constrain a resource, such as the Patient profile of an implementation
+// guide.
+//
+// The type of a profile wraps the generated base resource of its FHIR
+// version and adds:
+//
+//   - a constructor that claims the profile in meta.profile and fills in the
+//     fixed values of its top-level elements, and the patterns of required
+//     ones;
+//   - accessors for the slices of top-level elements that are told apart by
+//     a fixed value, such as IdentifierNID for Patient.identifier:NID
+//     discriminated by system;
+//   - typed getters and setters for the extensions the profile slices in,
+//     from the value[x] type of each extension's definition;
+//   - a Validate method that checks the resource against the profile.
+//
+// Validate works without a registry: the profiles, and the extensions and
+// data type profiles they use, are embedded in the generated package as
+// StructureDefinitions with snapshots.
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/codegen"
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/parser"
+	"github.com/zs-health/zh-fhir-go/fhir/validation"
+)
+
+// modulePath is the import path of the version packages, such as .../fhir/r4.
+const modulePath = "github.com/zs-health/zh-fhir-go/fhir/"
+
+// coreCanonical is the canonical URL prefix of the FHIR core definitions.
+const coreCanonical = "http://hl7.org/fhir/StructureDefinition/"
+
+// release gives the Go types of a FHIR version package.
+type release struct {
+	newResource func(resourceType string) (any, error)
+	extension   reflect.Type
+}
+
+// releases holds the version packages profiles can be generated for.
+var releases = map[string]release{
+	"r4": {
+		newResource: func(t string) (any, error) { return r4.NewResource(t) },
+		extension:   reflect.TypeOf(r4.Extension{}),
+	},
+	"r5": {
+		newResource: func(t string) (any, error) { return r5.NewResource(t) },
+		extension:   reflect.TypeOf(r5.Extension{}),
+	},
+}
+
+// Generator generates the Go types of profiles.
+type Generator struct {
+	reg         *conformance.Registry
+	version     codegen.Version
+	release     release
+	packageName string
+	verbose     bool
+}
+
+// New creates a generator for profiles of version, resolving
+// StructureDefinitions in reg. The generated code is in package packageName.
+func New(reg *conformance.Registry, version codegen.Version, packageName string, verbose bool) (*Generator, error) {
+	rel, ok := releases[version.Name]
+	if !ok {
+		return nil, fmt.Errorf("no Go package for FHIR %s to base profiles on", version.Name)
+	}
+	return &Generator{
+		reg:         reg,
+		version:     version,
+		release:     rel,
+		packageName: packageName,
+		verbose:     verbose,
+	}, nil
+}
+
+// Generate generates the Go types of the profiles with the given canonical
+// URLs. It returns the files of the package by name: a Go file per profile,
+// profiles.go with what they share, and a StructureDefinition-<id>.json for
+// each embedded definition.
+func (g *Generator) Generate(urls []string) (map[string][]byte, error) {
+	pkg := &packageModel{
+		fileHeader: fileHeader{
+			Package:          g.packageName,
+			FHIRVersion:      strings.ToUpper(g.version.Name),
+			GeneratorVersion: codegen.GeneratorVersion,
+			GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+			Base:             g.version.Package,
+			BaseImport:       modulePath + g.version.Package,
+		},
+		extensions:  make(map[string]*extensionURL),
+		definitions: make(map[string]object),
+	}
+	files := make(map[string][]byte)
+	for _, url := range urls {
+		sd, err := g.definition(url)
+		if err != nil {
+			return nil, err
+		}
+		p, err := g.buildProfile(sd, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", url, err)
+		}
+		pkg.Profiles = append(pkg.Profiles, p)
+		if err := g.embed(sd, pkg); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range pkg.Profiles {
+		p.fileHeader = pkg.fileHeader
+		p.imports.add("encoding/json")
+		p.imports.add("fmt")
+		p.imports.add(pkg.BaseImport)
+		if len(p.Extensions) > 0 {
+			p.imports.add("slices")
+		}
+		p.Imports = p.imports.sorted()
+		code, err := render(profileTemplate, p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.URL, err)
+		}
+		files[strings.ToLower(p.Name)+".go"] = code
+	}
+
+	for _, e := range pkg.extensions {
+		pkg.Extensions = append(pkg.Extensions, *e)
+	}
+	sort.Slice(pkg.Extensions, func(i, j int) bool { return pkg.Extensions[i].Const < pkg.Extensions[j].Const })
+	code, err := render(packageTemplate, pkg)
+	if err != nil {
+		return nil, err
+	}
+	files["profiles.go"] = code
+
+	for id, sd := range pkg.definitions {
+		data, err := json.MarshalIndent(sd, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files["StructureDefinition-"+id+".json"] = append(data, '\n')
+	}
+	return files, nil
+}
+
+// definition returns the StructureDefinition a canonical resolves to, as
+// decoded JSON with a snapshot.
+func (g *Generator) definition(url string) (object, error) {
+	e, ok := g.reg.ResolveFHIR("StructureDefinition", url, g.version.FHIRVersion)
+	if !ok {
+		return nil, fmt.Errorf("StructureDefinition %s not found", url)
+	}
+	sd, err := toObject(e.Resource)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	if len(elements(sd)) == 0 {
+		if err := validation.GenerateSnapshot(sd, g.reg); err != nil {
+			return nil, fmt.Errorf("%s: snapshot: %w", url, err)
+		}
+	}
+	return sd, nil
+}
+
+// embed adds sd to the definitions of the package, along with the
+// extensions and data type profiles its elements use, so that Validate can
+// resolve them. Core definitions are left out.
+func (g *Generator) embed(sd object, pkg *packageModel) error {
+	id := str(sd, "id")
+	if id == "" {
+		id = parser.ToGoIdentifier(str(sd, "name"))
+	}
+	if _, ok := pkg.definitions[id]; ok {
+		return nil
+	}
+	pkg.definitions[id] = sd
+	for _, e := range elements(sd) {
+		for _, t := range objects(e, "type") {
+			for _, url := range stringList(t, "profile") {
+				if strings.HasPrefix(url, coreCanonical) {
+					continue
+				}
+				if _, ok := g.reg.ResolveFHIR("StructureDefinition", url, g.version.FHIRVersion); !ok {
+					g.logf("  %s: %s not found, not embedded", str(sd, "url"), url)
+					continue
+				}
+				used, err := g.definition(url)
+				if err != nil {
+					return err
+				}
+				if err := g.embed(used, pkg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// buildProfile builds the model of a resource profile.
+func (g *Generator) buildProfile(sd object, pkg *packageModel) (*profileModel, error) {
+	if str(sd, "kind") != "resource" || str(sd, "derivation") != "constraint" {
+		return nil, fmt.Errorf("not a resource profile")
+	}
+	resourceType := str(sd, "type")
+	base, err := g.release.newResource(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	baseType := reflect.TypeOf(base).Elem()
+
+	name := parser.ToGoIdentifier(str(sd, "name"))
+	if name == "" || name == resourceType {
+		return nil, fmt.Errorf("profile name %q can't name a Go type", str(sd, "name"))
+	}
+	p := &profileModel{
+		Name:         name,
+		URL:          str(sd, "url"),
+		Title:        str(sd, "title"),
+		ResourceType: resourceType,
+		Receiver:     strings.ToLower(resourceType[:1]),
+		imports:      importSet{},
+	}
+	if p.Title == "" {
+		p.Title = str(sd, "name")
+	}
+
+	byID := make(map[string]object)
+	for _, e := range elements(sd) {
+		byID[str(e, "id")] = e
+	}
+
+	fixed := object{
+		"resourceType": resourceType,
+		"meta":         object{"profile": []any{p.URL}},
+	}
+	for _, e := range elements(sd) {
+		id := str(e, "id")
+		element, ok := strings.CutPrefix(id, resourceType+".")
+		if !ok || strings.ContainsAny(element, ".:") {
+			continue
+		}
+		if sliceName := str(e, "sliceName"); sliceName != "" {
+			continue
+		}
+		if key, value, ok := fixedValue(e); ok {
+			field, ok := fieldByJSONName(baseType, jsonName(element, key))
+			if !ok {
+				g.logf("  %s: no Go field for %s, fixed value skipped", p.URL, id)
+				continue
+			}
+			if isSlice(field.Type) {
+				value = []any{value}
+			}
+			fixed[jsonName(element, key)] = value
+		}
+	}
+	if err := checkFixed(fixed, baseType); err != nil {
+		return nil, fmt.Errorf("fixed values: %w", err)
+	}
+	data, err := json.Marshal(fixed)
+	if err != nil {
+		return nil, err
+	}
+	p.Fixed = strconv.Quote(string(data))
+
+	for _, e := range elements(sd) {
+		sliceName := str(e, "sliceName")
+		element, ok := strings.CutPrefix(str(e, "path"), resourceType+".")
+		if sliceName == "" || !ok || strings.Contains(element, ".") || str(e, "max") == "0" {
+			continue
+		}
+		if str(e, "id") != str(e, "path")+":"+sliceName {
+			continue // a reslice
+		}
+		field, ok := fieldByJSONName(baseType, element)
+		if !ok || !isSlice(field.Type) {
+			continue
+		}
+		single := str(e, "max") == "1"
+		if element == "extension" || element == "modifierExtension" {
+			ext, err := g.buildExtension(e, field, single, baseType, pkg, p)
+			if err != nil {
+				return nil, err
+			}
+			if ext != nil {
+				p.Extensions = append(p.Extensions, *ext)
+			}
+			continue
+		}
+		s, err := g.buildSlice(e, byID, field, single, p)
+		if err != nil {
+			g.logf("  %s: slice %s skipped: %v", p.URL, str(e, "id"), err)
+			continue
+		}
+		p.Slices = append(p.Slices, *s)
+	}
+	return p, nil
+}
+
+// buildSlice builds the accessors of a slice told apart by value or pattern
+// discriminators on elements of the item that aren't repeated.
+func (g *Generator) buildSlice(e object, byID map[string]object, field reflect.StructField, single bool, p *profileModel) (*sliceModel, error) {
+	sliced := byID[str(e, "path")]
+	discriminators := objects(obj(sliced, "slicing"), "discriminator")
+	if len(discriminators) == 0 {
+		return nil, fmt.Errorf("no discriminator")
+	}
+
+	itemType := field.Type.Elem()
+	s := &sliceModel{
+		Name:    field.Name + parser.ToGoIdentifier(str(e, "sliceName")),
+		Field:   field.Name,
+		Item:    p.imports.typeName(itemType),
+		Element: str(e, "id"),
+		Single:  single,
+	}
+	var conds []string
+	used := make(map[string]bool)
+	for _, d := range discriminators {
+		kind, path := str(d, "type"), str(d, "path")
+		if kind != "value" && kind != "pattern" {
+			return nil, fmt.Errorf("%s discriminator", kind)
+		}
+		if path == "$this" {
+			return nil, fmt.Errorf("discriminator on the item itself")
+		}
+		child := byID[str(e, "id")+"."+path]
+		_, value, ok := fixedValue(child)
+		if !ok {
+			return nil, fmt.Errorf("no fixed value for %s", path)
+		}
+		c, apply, err := p.imports.discriminator(itemType, strings.Split(path, "."), value, used)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		conds = append(conds, c...)
+		s.Apply = append(s.Apply, apply...)
+		s.Discriminators = append(s.Discriminators, path)
+	}
+	s.Match = strings.Join(conds, " && ")
+	return s, nil
+}
+
+// buildExtension builds the accessors of an extension slice. It returns nil
+// for a slice without an extension definition to go by.
+func (g *Generator) buildExtension(e object, field reflect.StructField, single bool, baseType reflect.Type, pkg *packageModel, p *profileModel) (*extensionModel, error) {
+	var url string
+	for _, t := range objects(e, "type") {
+		if profiles := stringList(t, "profile"); len(profiles) > 0 {
+			url = profiles[0]
+		}
+	}
+	if url == "" {
+		g.logf("  %s: extension slice %s has no profile, skipped", p.URL, str(e, "id"))
+		return nil, nil
+	}
+	def, err := g.definition(url)
+	if err != nil {
+		return nil, err
+	}
+
+	constName := "Extension" + parser.ToGoIdentifier(str(def, "name"))
+	if known, ok := pkg.extensions[url]; ok {
+		constName = known.Const
+	} else {
+		for _, other := range pkg.extensions {
+			if other.Const == constName {
+				return nil, fmt.Errorf("extensions %s and %s would both be %s", other.URL, url, constName)
+			}
+		}
+		pkg.extensions[url] = &extensionURL{Const: constName, URL: url, Title: str(def, "title")}
+	}
+
+	name := parser.ToGoIdentifier(str(e, "sliceName"))
+	if _, clash := baseType.FieldByName(name); clash {
+		name += "Extension"
+	} else if _, clash := reflect.PointerTo(baseType).MethodByName(name); clash {
+		name += "Extension"
+	}
+	ext := &extensionModel{
+		Name:      name,
+		Field:     field.Name,
+		Const:     constName,
+		URL:       url,
+		Element:   str(e, "id"),
+		Single:    single,
+		Extension: p.imports.typeName(g.release.extension),
+	}
+
+	// A simple extension with one value[x] type gets typed accessors
+	for _, ve := range elements(def) {
+		if str(ve, "id") != "Extension.value[x]" || str(ve, "max") == "0" {
+			continue
+		}
+		types := objects(ve, "type")
+		if len(types) != 1 {
+			break
+		}
+		code := str(types[0], "code")
+		valueField, ok := fieldByJSONName(g.release.extension, "value"+strings.ToUpper(code[:1])+code[1:])
+		if !ok {
+			break
+		}
+		ext.ValueField = valueField.Name
+		ext.Pointer = valueField.Type.Kind() == reflect.Pointer
+		t := valueField.Type
+		if ext.Pointer {
+			t = t.Elem()
+		}
+		ext.ValueType = p.imports.typeName(t)
+	}
+	return ext, nil
+}
+
+func (g *Generator) logf(format string, args ...any) {
+	if g.verbose {
+		log.Printf(format, args...)
+	}
+}
+
+// fixedValue returns the fixed[x] value of an element, or its pattern[x] if
+// the element is required, with the key it was found under.
+func fixedValue(e object) (string, any, bool) {
+	var pattern string
+	for key := range e {
+		if strings.HasPrefix(key, "fixed") {
+			return key, e[key], true
+		}
+		if strings.HasPrefix(key, "pattern") {
+			pattern = key
+		}
+	}
+	if min, _ := e["min"].(float64); pattern != "" && min >= 1 {
+		return pattern, e[pattern], true
+	}
+	return "", nil, false
+}
+
+// jsonName returns the JSON name of a top-level element, such as
+// deceasedBoolean for deceased[x] with a fixedBoolean.
+func jsonName(element, key string) string {
+	if choice, ok := strings.CutSuffix(element, "[x]"); ok {
+		suffix := strings.TrimPrefix(strings.TrimPrefix(key, "fixed"), "pattern")
+		return choice + suffix
+	}
+	return element
+}
+
+// checkFixed checks that the fixed values decode into the base resource.
+func checkFixed(fixed object, baseType reflect.Type) error {
+	data, err := json.Marshal(fixed)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	return dec.Decode(reflect.New(baseType).Interface())
+}
+
+// fieldByJSONName returns the field of a struct type with the given JSON
+// name, including those of embedded structs.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if ef, ok := fieldByJSONName(f.Type, name); ok {
+				ef.Index = append([]int{i}, ef.Index...)
+				return ef, true
+			}
+			continue
+		}
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func isSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8
+}
@@ -0,0 +1,230 @@
+package profile
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/scripts/gen/codegen"
+	"github.com/zs-health/zh-fhir-go/internal/ig"
+)
+
+// coreTypes holds the core data type definitions profiles are built on.
+const coreTypes = "../../../../fhir_schemas/r4/profiles-types.json"
+
+// generate generates the profiles in testdata.
+func generate(t *testing.T) map[string][]byte {
+	t.Helper()
+	l := ig.NewLoader()
+	urls, err := Load(l, []string{coreTypes, "testdata"}, "4.0.1")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	v, err := codegen.LookupVersion("r4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := New(l.Registry, v, "example", false)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	files, err := g.Generate(urls)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	return files
+}
+
+func TestLoad(t *testing.T) {
+	l := ig.NewLoader()
+	urls, err := Load(l, []string{coreTypes, "testdata"}, "4.0.1")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []string{
+		"https://fhir.example.org/StructureDefinition/bd-patient",
+		"https://fhir.example.org/StructureDefinition/registered-patient",
+		"https://fhir.example.org/StructureDefinition/rohingya-patient",
+	}
+	if !slices.Equal(urls, want) {
+		t.Errorf("Load() = %v, want %v", urls, want)
+	}
+}
+
+func TestNew_UnsupportedVersion(t *testing.T) {
+	v := codegen.Version{Name: "r6", Package: "r6", FHIRVersion: "6.0.0"}
+	if _, err := New(ig.NewLoader().Registry, v, "example", false); err == nil {
+		t.Error("New() should fail for a version without a Go package")
+	}
+}
+
+func TestGenerate_Files(t *testing.T) {
+	files := generate(t)
+	for _, name := range []string{
+		"profiles.go",
+		"bdpatient.go",
+		"registeredpatient.go",
+		"rohingyapatient.go",
+		"StructureDefinition-bd-patient.json",
+		"StructureDefinition-bd-address.json",
+		"StructureDefinition-shelter.json",
+		"StructureDefinition-religion.json",
+		"StructureDefinition-household.json",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Generate() has no %s", name)
+		}
+	}
+	if _, ok := files["StructureDefinition-Patient.json"]; ok {
+		t.Error("core definitions should not be embedded")
+	}
+	if !strings.Contains(string(files["StructureDefinition-religion.json"]), `"snapshot"`) {
+		t.Error("embedded definitions should have a snapshot")
+	}
+}
+
+func TestGenerate_Code(t *testing.T) {
+	files := generate(t)
+
+	tests := []struct {
+		file string
+		want []string
+	}{
+		{
+			file: "profiles.go",
+			want: []string{
+				"package example",
+				"DO NOT EDIT",
+				`ProfileBDPatient = "https://fhir.example.org/StructureDefinition/bd-patient"`,
+				`ExtensionReligion = "https://fhir.example.org/StructureDefinition/religion"`,
+				"//go:embed StructureDefinition-*.json",
+			},
+		},
+		{
+			file: "bdpatient.go",
+			want: []string{
+				"type BDPatient struct {\n\tr4.Patient\n}",
+				"func (p *BDPatient) IdentifierNID() (*r4.Identifier, bool)",
+				"func (p *BDPatient) SetIdentifierNID(item r4.Identifier)",
+				"func (p *BDPatient) Shelter() (string, bool)",
+				"func (p *BDPatient) SetShelter(value string)",
+			},
+		},
+		{
+			file: "registeredpatient.go",
+			want: []string{
+				`\"active\":true`,
+				`\"maritalStatus\":{\"coding\":[{\"code\":\"UNK\"`,
+				"*item.System == r4.ContactPointSystem(\"phone\")",
+				"func (p *RegisteredPatient) AddTelecomPhone(item r4.ContactPoint)",
+				"func (p *RegisteredPatient) Religion() []r4.CodeableConcept",
+				"func (p *RegisteredPatient) AddReligion(value r4.CodeableConcept)",
+				"func (p *RegisteredPatient) Household() (*r4.Extension, bool)",
+				"func (p *RegisteredPatient) SetHousehold(ext r4.Extension)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			code := string(files[tt.file])
+			for _, want := range tt.want {
+				if !strings.Contains(code, want) {
+					t.Errorf("%s should contain %q", tt.file, want)
+				}
+			}
+		})
+	}
+
+	// The Rohingya profile forbids the NID slice
+	if strings.Contains(string(files["rohingyapatient.go"]), "IdentifierNID") {
+		t.Error("rohingyapatient.go should have no accessors for a slice with max 0")
+	}
+}
+
+// generatedTest exercises the generated package.
+const generatedTest = `package example
+
+import (
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+func TestGenerated(t *testing.T) {
+	p := NewRegisteredPatient()
+	if p.Active == nil || !*p.Active {
+		t.Error("active should be fixed to true")
+	}
+	if len(p.Meta.Profile) != 1 || p.Meta.Profile[0] != ProfileRegisteredPatient {
+		t.Errorf("meta.profile = %v", p.Meta.Profile)
+	}
+
+	value := "+8801700000000"
+	p.AddTelecomPhone(r4.ContactPoint{Value: &value})
+	if phones := p.TelecomPhone(); len(phones) != 1 || *phones[0].Value != value {
+		t.Errorf("TelecomPhone() = %v", phones)
+	}
+
+	text := "Islam"
+	p.AddReligion(r4.CodeableConcept{Text: &text})
+	if religions := p.Religion(); len(religions) != 1 || *religions[0].Text != text {
+		t.Errorf("Religion() = %v", religions)
+	}
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	bd := NewBDPatient()
+	nid := "1234567890"
+	bd.SetIdentifierNID(r4.Identifier{Value: &nid})
+	bd.SetShelter("Camp 4")
+	if err := bd.Validate(); err == nil {
+		t.Error("Validate() should fail without a name")
+	}
+	family := "Rahman"
+	bd.Name = []r4.HumanName{{Family: &family}}
+	if err := bd.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if id, ok := bd.IdentifierNID(); !ok || id.System == nil {
+		t.Errorf("IdentifierNID() = %v, %v", id, ok)
+	}
+	if shelter, ok := bd.Shelter(); !ok || shelter != "Camp 4" {
+		t.Errorf("Shelter() = %q, %v", shelter, ok)
+	}
+}
+`
+
+// TestGenerate_Compiles builds the generated package and runs a test of it.
+func TestGenerate_Compiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated package")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	// Inside the module, so that the package can import it; the underscore
+	// keeps ./... from matching it.
+	dir, err := os.MkdirTemp(".", "_generated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	files := generate(t)
+	files["generated_test.go"] = []byte(generatedTest)
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out, err := exec.Command("go", "test", "./"+dir).CombinedOutput()
+	if err != nil {
+		t.Fatalf("go test: %v\n%s", err, out)
+	}
+}
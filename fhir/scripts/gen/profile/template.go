@@ -0,0 +1,271 @@
+package profile
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"text/template"
+)
+
+// render executes a template and formats the result as Go source.
+func render(text string, data any) ([]byte, error) {
+	tmpl := template.Must(template.New("profile").Parse(text))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("execute template: %w", err)
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("format code: %w", err)
+	}
+	return formatted, nil
+}
+
+const header = `// Code generated by fhirgen {{.GeneratorVersion}}. DO NOT EDIT.
+// Generated at: {{.GeneratedAt}}
+// FHIR Version: {{.FHIRVersion}}
+// Source: profile StructureDefinitions
+
+package {{.Package}}
+`
+
+// packageTemplate generates what the profiles of a package share: the
+// profile and extension URLs, and the validator over the embedded
+// definitions.
+const packageTemplate = header + `
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sync"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"{{.BaseImport}}"
+	"github.com/zs-health/zh-fhir-go/fhir/validation"
+)
+
+// Canonical URLs of the profiles
+const (
+{{- range .Profiles}}
+	// Profile{{.Name}} is the {{.Title}} profile of {{.ResourceType}}.
+	Profile{{.Name}} = "{{.URL}}"
+{{- end}}
+)
+{{- if .Extensions}}
+
+// URLs of the extensions the profiles use
+const (
+{{- range .Extensions}}
+	{{if .Title}}// {{.Const}} is the {{.Title}} extension.
+	{{end}}{{.Const}} = "{{.URL}}"
+{{- end}}
+)
+{{- end}}
+
+// definitions holds the profiles, and the extensions and data type profiles
+// they use, with their snapshots.
+//
+//go:embed StructureDefinition-*.json
+var definitions embed.FS
+
+// validator returns the validator the profiles' Validate methods use, which
+// resolves the definitions of the package.
+var validator = sync.OnceValues(func() (*validation.FHIRValidator, error) {
+	names, err := fs.Glob(definitions, "*.json")
+	if err != nil {
+		return nil, err
+	}
+	reg := conformance.NewRegistry()
+	for _, name := range names {
+		data, err := definitions.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		var sd {{.Base}}.StructureDefinition
+		if err := json.Unmarshal(data, &sd); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		var fhirVersion string
+		if sd.FhirVersion != nil {
+			fhirVersion = *sd.FhirVersion
+		}
+		if err := reg.Register(&sd, fhirVersion, name); err != nil {
+			return nil, err
+		}
+	}
+	fv := validation.NewFHIRValidator()
+	fv.SetRegistry(reg)
+	return fv, nil
+})
+`
+
+// profileTemplate generates the type of a profile.
+const profileTemplate = header + `
+import (
+{{- range .Imports}}
+	{{if .}}"{{.}}"{{end}}
+{{- end}}
+)
+{{$p := .}}{{$r := .Receiver}}
+// fixed{{.Name}} is the JSON a new {{.Name}} starts from.
+const fixed{{.Name}} = {{.Fixed}}
+
+// {{.Name}} is a {{.ResourceType}} that conforms to the {{.Title}} profile
+// ({{.URL}}).
+type {{.Name}} struct {
+	{{.Base}}.{{.ResourceType}}
+}
+
+// New{{.Name}} returns a {{.ResourceType}} that claims the profile, with
+// the profile's fixed values filled in.
+func New{{.Name}}() *{{.Name}} {
+	{{$r}} := &{{.Name}}{}
+	if err := json.Unmarshal([]byte(fixed{{.Name}}), &{{$r}}.{{.ResourceType}}); err != nil {
+		panic(fmt.Sprintf("fixed values of %s: %v", Profile{{.Name}}, err))
+	}
+	return {{$r}}
+}
+
+// Validate checks the {{.ResourceType}} against the {{.Title}} profile: its
+// cardinalities, fixed values, slices, extensions and invariants.
+func ({{$r}} *{{.Name}}) Validate() error {
+	fv, err := validator()
+	if err != nil {
+		return err
+	}
+	return fv.ValidateProfile(&{{$r}}.{{.ResourceType}}, Profile{{.Name}})
+}
+{{- range .Slices}}
+{{- if .Single}}
+
+// {{.Name}} returns the {{.Element}} slice, if the {{$p.ResourceType}}
+// has one. Changes through the pointer are kept.
+func ({{$r}} *{{$p.Name}}) {{.Name}}() (*{{.Item}}, bool) {
+	for i := range {{$r}}.{{.Field}} {
+		if item := &{{$r}}.{{.Field}}[i]; {{.Match}} {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// Set{{.Name}} sets the {{.Element}} slice, replacing the one the
+// {{$p.ResourceType}} has. The item is given the slice's {{range $i, $d := .Discriminators}}{{if $i}}, {{end}}{{$d}}{{end}}.
+func ({{$r}} *{{$p.Name}}) Set{{.Name}}(item {{.Item}}) {
+{{- range .Apply}}
+	{{.}}
+{{- end}}
+	if existing, ok := {{$r}}.{{.Name}}(); ok {
+		*existing = item
+		return
+	}
+	{{$r}}.{{.Field}} = append({{$r}}.{{.Field}}, item)
+}
+{{- else}}
+
+// {{.Name}} returns the items of the {{.Element}} slice.
+func ({{$r}} *{{$p.Name}}) {{.Name}}() []{{.Item}} {
+	var items []{{.Item}}
+	for _, item := range {{$r}}.{{.Field}} {
+		if {{.Match}} {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Add{{.Name}} adds an item to the {{.Element}} slice. The item
+// is given the slice's {{range $i, $d := .Discriminators}}{{if $i}}, {{end}}{{$d}}{{end}}.
+func ({{$r}} *{{$p.Name}}) Add{{.Name}}(item {{.Item}}) {
+{{- range .Apply}}
+	{{.}}
+{{- end}}
+	{{$r}}.{{.Field}} = append({{$r}}.{{.Field}}, item)
+}
+{{- end}}
+{{- end}}
+{{- range .Extensions}}
+{{- if .ValueField}}
+{{- if .Single}}
+
+// {{.Name}} returns the value of the {{.Element}} extension, if the
+// {{$p.ResourceType}} has one.
+func ({{$r}} *{{$p.Name}}) {{.Name}}() ({{.ValueType}}, bool) {
+	for _, ext := range {{$r}}.{{.Field}} {
+		if ext.URL == {{.Const}} && ext.{{.ValueField}} != nil {
+			return {{if .Pointer}}*{{end}}ext.{{.ValueField}}, true
+		}
+	}
+	var zero {{.ValueType}}
+	return zero, false
+}
+
+// Set{{.Name}} sets the {{.Element}} extension, replacing any
+// earlier one.
+func ({{$r}} *{{$p.Name}}) Set{{.Name}}(value {{.ValueType}}) {
+	{{$r}}.{{.Field}} = slices.DeleteFunc({{$r}}.{{.Field}}, func(x {{.Extension}}) bool { return x.URL == {{.Const}} })
+	{{$r}}.{{.Field}} = append({{$r}}.{{.Field}}, {{.Extension}}{URL: {{.Const}}, {{.ValueField}}: {{if .Pointer}}&{{end}}value})
+}
+{{- else}}
+
+// {{.Name}} returns the values of the {{.Element}} extensions.
+func ({{$r}} *{{$p.Name}}) {{.Name}}() []{{.ValueType}} {
+	var values []{{.ValueType}}
+	for _, ext := range {{$r}}.{{.Field}} {
+		if ext.URL == {{.Const}} && ext.{{.ValueField}} != nil {
+			values = append(values, {{if .Pointer}}*{{end}}ext.{{.ValueField}})
+		}
+	}
+	return values
+}
+
+// Add{{.Name}} adds an {{.Element}} extension.
+func ({{$r}} *{{$p.Name}}) Add{{.Name}}(value {{.ValueType}}) {
+	{{$r}}.{{.Field}} = append({{$r}}.{{.Field}}, {{.Extension}}{URL: {{.Const}}, {{.ValueField}}: {{if .Pointer}}&{{end}}value})
+}
+{{- end}}
+{{- else}}
+{{- if .Single}}
+
+// {{.Name}} returns the {{.Element}} extension, if the
+// {{$p.ResourceType}} has one. Changes through the pointer are kept.
+func ({{$r}} *{{$p.Name}}) {{.Name}}() (*{{.Extension}}, bool) {
+	for i := range {{$r}}.{{.Field}} {
+		if {{$r}}.{{.Field}}[i].URL == {{.Const}} {
+			return &{{$r}}.{{.Field}}[i], true
+		}
+	}
+	return nil, false
+}
+
+// Set{{.Name}} sets the {{.Element}} extension, replacing any
+// earlier one. The extension's URL is filled in.
+func ({{$r}} *{{$p.Name}}) Set{{.Name}}(ext {{.Extension}}) {
+	ext.URL = {{.Const}}
+	{{$r}}.{{.Field}} = slices.DeleteFunc({{$r}}.{{.Field}}, func(x {{.Extension}}) bool { return x.URL == {{.Const}} })
+	{{$r}}.{{.Field}} = append({{$r}}.{{.Field}}, ext)
+}
+{{- else}}
+
+// {{.Name}} returns the {{.Element}} extensions.
+func ({{$r}} *{{$p.Name}}) {{.Name}}() []{{.Extension}} {
+	var exts []{{.Extension}}
+	for _, ext := range {{$r}}.{{.Field}} {
+		if ext.URL == {{.Const}} {
+			exts = append(exts, ext)
+		}
+	}
+	return exts
+}
+
+// Add{{.Name}} adds an {{.Element}} extension. The extension's URL is
+// filled in.
+func ({{$r}} *{{$p.Name}}) Add{{.Name}}(ext {{.Extension}}) {
+	ext.URL = {{.Const}}
+	{{$r}}.{{.Field}} = append({{$r}}.{{.Field}}, ext)
+}
+{{- end}}
+{{- end}}
+{{- end}}
+`
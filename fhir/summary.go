@@ -33,6 +33,9 @@ func MarshalSummaryJSON(resource interface{}) ([]byte, error) {
 }
 
 // MarshalWithSummaryMode marshals a resource to JSON with the specified summary mode.
+// Mandatory elements are kept in every mode, and the primitive extensions of
+// an element (such as _birthDate) are kept along with it. SummaryModeText and
+// SummaryModeData only filter the top-level elements.
 func MarshalWithSummaryMode(resource interface{}, mode SummaryMode) ([]byte, error) {
 	if mode == SummaryModeAll {
 		// No filtering needed
@@ -44,6 +47,68 @@ func MarshalWithSummaryMode(resource interface{}, mode SummaryMode) ([]byte, err
 	return json.Marshal(filtered)
 }
 
+// FilterSummary filters a resource held as decoded JSON, such as one a server
+// stores, like MarshalWithSummaryMode. t is the Go type of the resource, which
+// says which of its elements are summary or mandatory elements; elements t
+// doesn't have are neither, and are kept only where no summary element is
+// asked for. The result shares its values with resource.
+func FilterSummary(resource map[string]interface{}, t reflect.Type, mode SummaryMode) map[string]interface{} {
+	filtered, _ := filterJSONBySummary(resource, t, mode).(map[string]interface{})
+	return filtered
+}
+
+// filterJSONBySummary filters decoded JSON v, of Go type t, based on summary
+// mode.
+func filterJSONBySummary(v interface{}, t reflect.Type, mode SummaryMode) interface{} {
+	t = jsonStructType(t)
+	if mode == SummaryModeAll || t == nil {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		nested := mode
+		if mode == SummaryModeText || mode == SummaryModeData {
+			nested = SummaryModeAll
+		}
+		result := make(map[string]interface{})
+		for key, value := range v {
+			// A primitive extension goes with its element
+			field, ok := fieldByJSONName(t, strings.TrimPrefix(key, "_"))
+			if !ok {
+				if mode == SummaryModeFalse || mode == SummaryModeData {
+					result[key] = value
+				}
+				continue
+			}
+			if shouldIncludeField(field, mode) {
+				result[key] = filterJSONBySummary(value, field.Type, nested)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = filterJSONBySummary(item, t, mode)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// jsonStructType returns the struct type of the elements of t, a field type,
+// or nil if they aren't structs or have their own JSON encoding.
+func jsonStructType(t reflect.Type) reflect.Type {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType) {
+		return nil
+	}
+	return t
+}
+
 // filterBySummary recursively filters a struct based on summary mode.
 func filterBySummary(v reflect.Value, mode SummaryMode) interface{} {
 	// Dereference pointers
@@ -54,6 +119,12 @@ func filterBySummary(v reflect.Value, mode SummaryMode) interface{} {
 		v = v.Elem()
 	}
 
+	// Values with their own JSON encoding, such as primitives.Date or
+	// json.RawMessage, are kept as they are
+	if mode == SummaryModeAll || v.Type().Implements(marshalerType) {
+		return v.Interface()
+	}
+
 	switch v.Kind() {
 	case reflect.Struct:
 		return filterStruct(v, mode)
@@ -71,6 +142,13 @@ func filterStruct(v reflect.Value, mode SummaryMode) map[string]interface{} {
 	result := make(map[string]interface{})
 	t := v.Type()
 
+	// Text and data only concern the top-level elements; what they keep is
+	// kept whole
+	nested := mode
+	if mode == SummaryModeText || mode == SummaryModeData {
+		nested = SummaryModeAll
+	}
+
 	for i := 0; i < v.NumField(); i++ {
 		field := t.Field(i)
 		fieldValue := v.Field(i)
@@ -104,13 +182,20 @@ func filterStruct(v reflect.Value, mode SummaryMode) map[string]interface{} {
 			jsonName = field.Name
 		}
 
-		// Check if field should be included based on summary mode
-		if !shouldIncludeField(field, mode) {
+		// Check if field should be included based on summary mode. A
+		// primitive extension goes with its element.
+		include := field
+		if strings.HasPrefix(jsonName, "_") {
+			if element, ok := fieldByJSONName(t, jsonName[1:]); ok {
+				include = element
+			}
+		}
+		if !shouldIncludeField(include, mode) {
 			continue
 		}
 
 		// Get field value, recursively filter if needed
-		filteredValue := filterBySummary(fieldValue, mode)
+		filteredValue := filterBySummary(fieldValue, nested)
 
 		// Skip zero values with omitempty
 		if strings.Contains(jsonTag, "omitempty") && isZeroValue(fieldValue) {
@@ -158,20 +243,21 @@ func filterMap(v reflect.Value, mode SummaryMode) map[string]interface{} {
 func shouldIncludeField(field reflect.StructField, mode SummaryMode) bool {
 	fhirTag := field.Tag.Get("fhir")
 	isSummary := strings.Contains(fhirTag, "summary")
+	isRequired := strings.Contains(fhirTag, "required")
 	isText := field.Name == "Text"
 
 	switch mode {
 	case SummaryModeTrue:
-		// Include only summary fields + always include resourceType and id
-		return isSummary || field.Name == "ResourceType" || field.Name == "ID" || field.Name == "Meta"
+		// Include only summary and mandatory fields + always include resourceType and id
+		return isSummary || isRequired || field.Name == "ResourceType" || field.Name == "ID" || field.Name == "Meta"
 
 	case SummaryModeFalse:
 		// Include everything except summary fields
-		return !isSummary
+		return !isSummary || isRequired
 
 	case SummaryModeText:
-		// Include only text + minimal metadata (resourceType, id, meta)
-		return isText || field.Name == "ResourceType" || field.Name == "ID" || field.Name == "Meta"
+		// Include only text + minimal metadata (resourceType, id, meta) and mandatory fields
+		return isText || isRequired || field.Name == "ResourceType" || field.Name == "ID" || field.Name == "Meta"
 
 	case SummaryModeData:
 		// Include everything except text
@@ -182,6 +268,27 @@ func shouldIncludeField(field reflect.StructField, mode SummaryMode) bool {
 	}
 }
 
+// marshalerType is the type of json.Marshaler.
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// fieldByJSONName returns the field of struct type t, or of the structs it
+// embeds, with the given JSON name.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if f, ok := fieldByJSONName(field.Type, name); ok {
+				return f, true
+			}
+			continue
+		}
+		if strings.Split(field.Tag.Get("json"), ",")[0] == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
 // isZeroValue checks if a reflect.Value is a zero value.
 func isZeroValue(v reflect.Value) bool {
 	switch v.Kind() {
@@ -2,9 +2,12 @@ package fhir
 
 import (
 	"encoding/json"
-	"github.com/zs-health/zh-fhir-go/fhir/internal/testutil"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/internal/testutil"
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
 // TestPatient is a simplified patient structure for testing
@@ -593,3 +596,140 @@ func TestIsZeroValue(t *testing.T) {
 		}
 	}
 }
+
+func TestMarshalWithSummaryMode_GeneratedTypes(t *testing.T) {
+	type TestContact struct {
+		Name         *string `json:"name,omitempty" fhir:"cardinality=0..1"`
+		Relationship string  `json:"relationship" fhir:"cardinality=1..1,required"`
+	}
+	type TestResource struct {
+		DomainResource
+		Text         *Narrative                     `json:"text,omitempty" fhir:"cardinality=0..1"`
+		BirthDate    *primitives.Date               `json:"birthDate,omitempty" fhir:"cardinality=0..1,summary"`
+		BirthDateExt *primitives.PrimitiveExtension `json:"_birthDate,omitempty" fhir:"cardinality=0..1"`
+		Status       string                         `json:"status" fhir:"cardinality=1..1,required"`
+		Contact      []TestContact                  `json:"contact,omitempty" fhir:"cardinality=0..*"`
+	}
+
+	birthDate := primitives.MustDate("1990-01-02")
+	resource := &TestResource{
+		DomainResource: DomainResource{
+			Resource:  Resource{ResourceType: "Patient", ID: testutil.StringPtr("example")},
+			Contained: []json.RawMessage{json.RawMessage(`{"resourceType":"Organization","id":"org"}`)},
+		},
+		Text:         &Narrative{Status: "generated", Div: "<div>Test</div>"},
+		BirthDate:    &birthDate,
+		BirthDateExt: &primitives.PrimitiveExtension{ID: testutil.StringPtr("bd")},
+		Status:       "active",
+		Contact:      []TestContact{{Name: testutil.StringPtr("Rahim"), Relationship: "N"}},
+	}
+
+	tests := []struct {
+		mode    SummaryMode
+		want    []string
+		notWant []string
+	}{
+		{
+			mode:    SummaryModeTrue,
+			want:    []string{`"birthDate":"1990-01-02"`, `"_birthDate":{"id":"bd"}`, `"status":"active"`},
+			notWant: []string{`"contact"`, `"text"`},
+		},
+		{
+			mode:    SummaryModeText,
+			want:    []string{`"text":{"status":"generated"`, `"status":"active"`},
+			notWant: []string{`"birthDate"`, `"contact"`},
+		},
+		{
+			mode:    SummaryModeData,
+			want:    []string{`"contained":[{"resourceType":"Organization","id":"org"}]`, `"name":"Rahim"`},
+			notWant: []string{`"text"`},
+		},
+	}
+	for _, tt := range tests {
+		data, err := MarshalWithSummaryMode(resource, tt.mode)
+		if err != nil {
+			t.Fatalf("MarshalWithSummaryMode(%d) error = %v", tt.mode, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("MarshalWithSummaryMode(%d) = %s, want %s", tt.mode, data, want)
+			}
+		}
+		for _, notWant := range tt.notWant {
+			if strings.Contains(string(data), notWant) {
+				t.Errorf("MarshalWithSummaryMode(%d) = %s, should not contain %s", tt.mode, data, notWant)
+			}
+		}
+	}
+}
+
+func TestFilterSummary(t *testing.T) {
+	type TestContact struct {
+		Name         *string `json:"name,omitempty" fhir:"cardinality=0..1"`
+		Relationship string  `json:"relationship" fhir:"cardinality=1..1,required"`
+	}
+	type TestResource struct {
+		DomainResource
+		Text         *Narrative                     `json:"text,omitempty" fhir:"cardinality=0..1"`
+		BirthDate    *primitives.Date               `json:"birthDate,omitempty" fhir:"cardinality=0..1,summary"`
+		BirthDateExt *primitives.PrimitiveExtension `json:"_birthDate,omitempty" fhir:"cardinality=0..1"`
+		Status       string                         `json:"status" fhir:"cardinality=1..1,required"`
+		Contact      []TestContact                  `json:"contact,omitempty" fhir:"cardinality=0..*,summary"`
+	}
+
+	var resource map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"resourceType": "Patient",
+		"id": "example",
+		"text": {"status": "generated", "div": "<div>Test</div>"},
+		"birthDate": "1990-01-02",
+		"_birthDate": {"id": "bd"},
+		"status": "active",
+		"contact": [{"name": "Rahim", "relationship": "N"}],
+		"unknownElement": "x"
+	}`), &resource); err != nil {
+		t.Fatal(err)
+	}
+	typ := reflect.TypeOf(&TestResource{})
+
+	tests := []struct {
+		mode    SummaryMode
+		want    []string
+		notWant []string
+	}{
+		{
+			mode:    SummaryModeTrue,
+			want:    []string{"resourceType", "id", "birthDate", "_birthDate", "status", "contact"},
+			notWant: []string{"text", "unknownElement"},
+		},
+		{
+			mode:    SummaryModeText,
+			want:    []string{"resourceType", "id", "text", "status"},
+			notWant: []string{"birthDate", "contact", "unknownElement"},
+		},
+		{
+			mode:    SummaryModeData,
+			want:    []string{"birthDate", "contact", "unknownElement"},
+			notWant: []string{"text"},
+		},
+	}
+	for _, tt := range tests {
+		got := FilterSummary(resource, typ, tt.mode)
+		for _, key := range tt.want {
+			if _, ok := got[key]; !ok {
+				t.Errorf("FilterSummary(%d) = %v, want %s", tt.mode, got, key)
+			}
+		}
+		for _, key := range tt.notWant {
+			if _, ok := got[key]; ok {
+				t.Errorf("FilterSummary(%d) = %v, should not contain %s", tt.mode, got, key)
+			}
+		}
+	}
+
+	// Nested elements are filtered in SummaryModeTrue
+	contact := FilterSummary(resource, typ, SummaryModeTrue)["contact"].([]interface{})[0].(map[string]interface{})
+	if _, ok := contact["name"]; ok || contact["relationship"] != "N" {
+		t.Errorf("contact = %v, want only its mandatory relationship", contact)
+	}
+}
@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+)
+
+// checkBinding checks a bound coded value, of type code, Coding,
+// CodeableConcept or a Quantity type, against the ValueSet of its element's
+// binding.
+func (iv *instanceValidator) checkBinding(p *profile, def *elementDefinition, it item) {
+	if def.Binding == nil || iv.terminology == nil {
+		return
+	}
+	checkBinding(iv.terminology, def.Binding, valueType(p, def, it), it.value, it.path, iv.errs)
+}
+
+// boundCoding is a code found in a bound value.
+type boundCoding struct {
+	system, code string
+}
+
+func (c boundCoding) String() string {
+	if c.system == "" {
+		return c.code
+	}
+	return c.system + "|" + c.code
+}
+
+// codings returns the codes of a value of type typ, in its JSON form. ok is
+// false for types that bindings don't apply to.
+func codings(typ string, value any) (out []boundCoding, ok bool) {
+	o, _ := value.(map[string]any)
+	switch typ {
+	case "code", "string", "uri":
+		if s, _ := value.(string); s != "" {
+			out = append(out, boundCoding{code: s})
+		}
+	case "Coding":
+		if code := str(o, "code"); code != "" {
+			out = append(out, boundCoding{system: str(o, "system"), code: code})
+		}
+	case "CodeableConcept":
+		for _, c := range objects(o, "coding") {
+			if code := str(c, "code"); code != "" {
+				out = append(out, boundCoding{system: str(c, "system"), code: code})
+			}
+		}
+	case "Quantity", "SimpleQuantity", "MoneyQuantity", "Age", "Count", "Distance", "Duration":
+		if code := str(o, "code"); code != "" {
+			out = append(out, boundCoding{system: str(o, "system"), code: code})
+		}
+	default:
+		return nil, false
+	}
+	return out, true
+}
+
+// checkBinding checks the codes of a value against a binding. A required
+// binding needs a code from the ValueSet. An extensible binding needs one
+// when the value has a code from a system the ValueSet takes codes from,
+// since a code from the set could apply; other codes, and a
+// CodeableConcept with only text, are allowed. Codes outside a preferred or
+// example ValueSet are information. A binding whose ValueSet can't be
+// checked is a warning when it is required or extensible.
+func checkBinding(ts TerminologyService, b *binding, typ string, value any, path string, errs *Errors) {
+	codes, ok := codings(typ, value)
+	if !ok {
+		return
+	}
+	if len(codes) == 0 {
+		if typ == "CodeableConcept" && b.Strength == "required" && value != nil {
+			errs.issuef(SeverityError, IssueCodeInvalid, ruleBinding, path, "no code from the required ValueSet %s", b.ValueSet)
+		}
+		return
+	}
+
+	var (
+		checked        []boundCoding
+		systemIncluded bool
+		failure        error
+	)
+	for _, c := range codes {
+		res, err := ts.ValidateCode(b.ValueSet, c.system, c.code)
+		if err != nil {
+			failure = err
+			continue
+		}
+		if res.Valid {
+			return
+		}
+		checked = append(checked, c)
+		systemIncluded = systemIncluded || res.SystemIncluded
+	}
+
+	if len(checked) == 0 {
+		if b.Strength == "required" || b.Strength == "extensible" {
+			code := IssueProcessing
+			if errors.Is(failure, ErrValueSetNotFound) {
+				code = IssueNotFound
+			}
+			errs.issuef(SeverityWarning, code, ruleBinding, path, "%s binding to %s not checked: %v", b.Strength, b.ValueSet, failure)
+		}
+		return
+	}
+	code := describeCodes(checked)
+	switch b.Strength {
+	case "required":
+		errs.issuef(SeverityError, IssueCodeInvalid, ruleBinding, path, "%s is not in the required ValueSet %s", code, b.ValueSet)
+	case "extensible":
+		if systemIncluded {
+			errs.issuef(SeverityError, IssueCodeInvalid, ruleBinding, path, "%s is not in the extensible ValueSet %s, which has codes from the same system", code, b.ValueSet)
+		} else {
+			errs.issuef(SeverityInformation, IssueCodeInvalid, ruleBinding, path, "%s is not in the extensible ValueSet %s", code, b.ValueSet)
+		}
+	default:
+		errs.issuef(SeverityInformation, IssueCodeInvalid, ruleBinding, path, "%s is not in the %s ValueSet %s", code, b.Strength, b.ValueSet)
+	}
+}
+
+func describeCodes(codes []boundCoding) string {
+	parts := make([]string, len(codes))
+	for i, c := range codes {
+		parts[i] = c.String()
+	}
+	if len(parts) == 1 {
+		return "code " + parts[0]
+	}
+	return "none of the codes " + strings.Join(parts, ", ")
+}
@@ -0,0 +1,176 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+func TestValidateProfileBindings(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	tests := []struct {
+		name string
+		json string
+		want []string
+	}{
+		{
+			name: "codes in their ValueSets",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+				"category":[{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/observation-category","code":"vital-signs"}]}]}`,
+		},
+		{
+			name: "code outside a required binding",
+			json: `{"resourceType":"Observation","status":"done","code":{"text":"weight"}}`,
+			want: []string{"Observation.status: code done is not in the required ValueSet http://hl7.org/fhir/ValueSet/observation-status|4.0.1"},
+		},
+		{
+			name: "nested code of a hierarchy",
+			json: `{"resourceType":"Observation","status":"corrected","code":{"text":"weight"}}`,
+		},
+		{
+			name: "extensible binding with a code from another system",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+				"dataAbsentReason":{"coding":[{"system":"http://example.org/reasons","code":"scale-broken"}]}}`,
+		},
+		{
+			name: "extensible binding with only text",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},"dataAbsentReason":{"text":"scale broken"}}`,
+		},
+		{
+			name: "extensible binding with an unknown code of the ValueSet's system",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+				"dataAbsentReason":{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/data-absent-reason","code":"broken"}]}}`,
+			want: []string{"Observation.dataAbsentReason: code http://terminology.hl7.org/CodeSystem/data-absent-reason|broken is not in the extensible ValueSet"},
+		},
+		{
+			name: "one matching coding is enough",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+				"dataAbsentReason":{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/data-absent-reason","code":"broken"},
+					{"system":"http://terminology.hl7.org/CodeSystem/data-absent-reason","code":"error"}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fv.ValidateProfile([]byte(tt.json), coreObservation)
+			checkErrors(t, errorList(t, err), tt.want)
+		})
+	}
+}
+
+func TestCheckProfileBindingInformation(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	errs, err := fv.CheckProfile([]byte(`{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+		"text":{"status":"generated","div":"<div xmlns=\"http://www.w3.org/1999/xhtml\">72 kg</div>"},
+		"category":[{"coding":[{"system":"http://example.org/categories","code":"anthropometry"}]}],
+		"dataAbsentReason":{"coding":[{"system":"http://example.org/reasons","code":"scale-broken"}]}}`), coreObservation)
+	if err != nil {
+		t.Fatalf("CheckProfile() error = %v", err)
+	}
+	if errs.HasErrors() {
+		t.Fatalf("HasErrors() = true: %v", errs)
+	}
+	var got []string
+	for _, e := range errs.Information() {
+		got = append(got, e.Error())
+	}
+	checkErrors(t, got, []string{
+		"Observation.category[0]: code http://example.org/categories|anthropometry is not in the preferred ValueSet",
+		"Observation.dataAbsentReason: code http://example.org/reasons|scale-broken is not in the extensible ValueSet",
+	})
+}
+
+// fakeTerminology accepts the codes it lists and knows no other ValueSet.
+type fakeTerminology map[string][]string
+
+func (f fakeTerminology) ValidateCode(valueSet, system, code string) (*CodeValidation, error) {
+	codes, ok := f[valueSet]
+	if !ok {
+		return nil, ErrValueSetNotFound
+	}
+	for _, c := range codes {
+		if c == code {
+			return &CodeValidation{Valid: true, SystemIncluded: true}, nil
+		}
+	}
+	return &CodeValidation{SystemIncluded: true}, nil
+}
+
+func TestValidateTagBindings(t *testing.T) {
+	type Coding struct {
+		System *string `json:"system,omitempty"`
+		Code   *string `json:"code,omitempty"`
+	}
+	type CodeableConcept struct {
+		Coding []Coding `json:"coding,omitempty"`
+		Text   *string  `json:"text,omitempty"`
+	}
+	type Encounter struct {
+		Status   string            `json:"status" fhir:"cardinality=1..1,required,binding=required:http://example.org/vs/status"`
+		Class    *Coding           `json:"class,omitempty" fhir:"cardinality=0..1,binding=extensible:http://example.org/vs/class"`
+		Priority []CodeableConcept `json:"priority,omitempty" fhir:"cardinality=0..*,binding=required:http://example.org/vs/unknown"`
+	}
+
+	fv := NewFHIRValidator()
+	fv.SetTerminology(fakeTerminology{
+		"http://example.org/vs/status": {"planned", "finished"},
+		"http://example.org/vs/class":  {"AMB"},
+	})
+
+	system, amb, home := "http://example.org/class", "AMB", "HH"
+	enc := &Encounter{Status: "finished", Class: &Coding{System: &system, Code: &amb}}
+	if err := fv.Validate(enc); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	enc.Status = "done"
+	enc.Class.Code = &home
+	err := fv.Validate(enc)
+	checkErrors(t, errorList(t, err), []string{
+		"Status: code done is not in the required ValueSet http://example.org/vs/status",
+		"Class: code http://example.org/class|HH is not in the extensible ValueSet http://example.org/vs/class",
+	})
+
+	// A ValueSet the service doesn't know leaves a warning
+	enc.Status, enc.Class = "finished", nil
+	enc.Priority = []CodeableConcept{{Coding: []Coding{{Code: &amb}}}}
+	if err := fv.Validate(enc); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	errs := &Errors{}
+	fv.validateBindings(reflect.ValueOf(enc), "", errs)
+	if w := errs.Warnings(); len(w) != 1 || !strings.Contains(w[0].Error(), "Priority[0]: required binding to http://example.org/vs/unknown not checked") {
+		t.Errorf("Warnings() = %v", w)
+	}
+}
+
+func TestValidateTagBindings_GeneratedResource(t *testing.T) {
+	fv := NewFHIRValidator()
+	fv.SetTerminology(fakeTerminology{
+		"http://hl7.org/fhir/ValueSet/quantity-comparator|5.0.0": {"<", "<=", ">=", ">", "ad"},
+		"http://hl7.org/fhir/ValueSet/narrative-status|5.0.0":    {"generated", "extensions", "additional", "empty"},
+	})
+
+	text, value := "Glucose", primitives.MustDecimal("5.5")
+	obs := &r5.Observation{Status: "final", Code: r5.CodeableConcept{Text: &text}}
+	obs.BaseResource.ResourceType = "Observation"
+	comparator := r5.QuantityComparatorLessThan
+	obs.SetValueQuantity(r5.Quantity{Value: &value, Comparator: &comparator})
+	if err := fv.Validate(obs); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	comparator = "<<"
+	obs.Text = &r5.Narrative{Status: "bogus", Div: `<div xmlns="http://www.w3.org/1999/xhtml">Glucose</div>`}
+	checkErrors(t, errorList(t, fv.Validate(obs)), []string{
+		"DomainResource.Text.Status: invalid NarrativeStatus code 'bogus'",
+		"ValueQuantity.Comparator: invalid QuantityComparator code '<<'",
+		"DomainResource.Text.Status: code bogus is not in the required ValueSet http://hl7.org/fhir/ValueSet/narrative-status|5.0.0",
+		"ValueQuantity.Comparator: code << is not in the required ValueSet http://hl7.org/fhir/ValueSet/quantity-comparator|5.0.0",
+	})
+}
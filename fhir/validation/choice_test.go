@@ -264,3 +264,24 @@ func TestChoiceTypeValidation_ZeroValues(t *testing.T) {
 		})
 	}
 }
+
+func TestChoiceTypeValidation_Required(t *testing.T) {
+	validator := NewFHIRValidator()
+
+	type TestResource struct {
+		ValueBoolean *bool   `json:"valueBoolean,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+		ValueString  *string `json:"valueString,omitempty" fhir:"cardinality=1..1,required,choice=value"`
+	}
+
+	if err := validator.Validate(&TestResource{ValueString: testutil.StringPtr("a")}); err != nil {
+		t.Errorf("one option set: unexpected validation error: %v", err)
+	}
+
+	err := validator.Validate(&TestResource{})
+	if err == nil {
+		t.Fatal("no option set: expected validation error but got none")
+	}
+	if !strings.Contains(err.Error(), "Value[x]") || strings.Contains(err.Error(), "ValueBoolean") {
+		t.Errorf("expected a single error for Value[x], got %q", err.Error())
+	}
+}
@@ -0,0 +1,175 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/fhirpath"
+)
+
+// checkConstraints evaluates the invariants of an element, such as ele-1
+// or obs-6, against one value. A constraint that fails is an error, or a
+// warning when its severity is warning. A constraint that can't be
+// evaluated, because its expression uses a function the engine lacks, is
+// reported as a warning so it is not silently skipped.
+func (iv *instanceValidator) checkConstraints(p *profile, def *elementDefinition, it item) {
+	if len(def.Constraints) == 0 {
+		return
+	}
+	opts := []fhirpath.Option{
+		fhirpath.WithType(valueType(p, def, it)),
+		fhirpath.WithModel(iv.set.model(p.sd.FHIRVersion)),
+	}
+	if iv.root != nil {
+		opts = append(opts, fhirpath.WithResource(iv.root))
+	}
+	for _, c := range def.Constraints {
+		if c.Expression == "" {
+			continue
+		}
+		expr, err := iv.set.expression(c.Expression)
+		if err != nil {
+			iv.errs.issuef(SeverityWarning, IssueProcessing, c.Key, it.path, "constraint %s could not be checked: %v", c.Key, err)
+			continue
+		}
+		ok, err := expr.EvaluateBool(it.value, opts...)
+		switch {
+		case err != nil:
+			iv.errs.issuef(SeverityWarning, IssueProcessing, c.Key, it.path, "constraint %s could not be checked: %v", c.Key, err)
+		case ok:
+		case c.Severity == "warning":
+			iv.errs.issuef(SeverityWarning, IssueInvariant, c.Key, it.path, "%s", constraintMessage(c))
+		default:
+			iv.errs.issuef(SeverityError, IssueInvariant, c.Key, it.path, "%s", constraintMessage(c))
+		}
+	}
+}
+
+// valueType returns the type of a value of an element: the type its choice
+// property names, the type a profile's root constrains, or the element's
+// only type.
+func valueType(p *profile, def *elementDefinition, it item) string {
+	switch {
+	case it.typ != "":
+		return it.typ
+	case def == p.root.def:
+		return p.sd.Type
+	case len(def.Types) == 1:
+		return def.Types[0].Code
+	}
+	return ""
+}
+
+func constraintMessage(c constraint) string {
+	if c.Human == "" {
+		return "constraint " + c.Key + " failed: " + c.Expression
+	}
+	return "constraint " + c.Key + " failed: " + c.Human
+}
+
+// invariantHolder is implemented by the generated types that have
+// invariants, such as *r4.Observation and *r5.Period.
+type invariantHolder interface {
+	Invariants() []fhir.Invariant
+}
+
+// invariantExpressions holds the parsed expressions of the invariants of
+// the generated types, by source.
+var invariantExpressions sync.Map
+
+// checkInvariants evaluates the invariants of the generated types, such as
+// obs-6 or per-1, on v and on each element it holds. root is the resource,
+// the %resource of the expressions. An embedded type, such as
+// DomainResource, is part of the struct that embeds it, whose Invariants
+// method includes its invariants.
+func checkInvariants(root any, v reflect.Value, path string, errs *Errors) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if !v.CanAddr() {
+			p := reflect.New(v.Type())
+			p.Elem().Set(v)
+			v = p.Elem()
+		}
+		if h, ok := v.Addr().Interface().(invariantHolder); ok {
+			evaluateInvariants(root, h, path, errs)
+		}
+		checkFieldInvariants(root, v, path, errs)
+	case reflect.Slice, reflect.Array:
+		if k := v.Type().Elem().Kind(); k != reflect.Struct && k != reflect.Ptr {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			checkInvariants(root, v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+}
+
+// checkFieldInvariants checks the invariants of the fields of a struct,
+// and of the structs it embeds.
+func checkFieldInvariants(root any, v reflect.Value, path string, errs *Errors) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			checkFieldInvariants(root, v.Field(i), fieldPath, errs)
+			continue
+		}
+		checkInvariants(root, v.Field(i), fieldPath, errs)
+	}
+}
+
+// evaluateInvariants reports the invariants of h that fail, as
+// checkConstraints does for those of a StructureDefinition.
+func evaluateInvariants(root any, h invariantHolder, path string, errs *Errors) {
+	for _, inv := range h.Invariants() {
+		expr, err := invariantExpression(inv.Expression)
+		ok := false
+		if err == nil {
+			ok, err = expr.EvaluateBool(h, fhirpath.WithResource(root))
+		}
+		c := constraint{Key: inv.Key, Severity: inv.Severity, Human: inv.Human, Expression: inv.Expression}
+		var issue *Error
+		switch {
+		case err != nil:
+			issue = errs.issuef(SeverityWarning, IssueProcessing, c.Key, path, "constraint %s could not be checked: %v", c.Key, err)
+		case ok:
+			continue
+		case c.Severity == "warning":
+			issue = errs.issuef(SeverityWarning, IssueInvariant, c.Key, path, "%s", constraintMessage(c))
+		default:
+			issue = errs.issuef(SeverityError, IssueInvariant, c.Key, path, "%s", constraintMessage(c))
+		}
+		if path == "" {
+			// The resource itself, which has no field to locate it by
+			issue.Expression = reflect.TypeOf(h).Elem().Name()
+		}
+	}
+}
+
+func invariantExpression(src string) (*fhirpath.Expression, error) {
+	if e, ok := invariantExpressions.Load(src); ok {
+		return e.(*fhirpath.Expression), nil
+	}
+	e, err := fhirpath.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	invariantExpressions.Store(src, e)
+	return e, nil
+}
@@ -0,0 +1,190 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+const coreObservation = coreCanonical + "Observation"
+
+func TestValidateProfileInvariants(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	tests := []struct {
+		name string
+		json string
+		want []string
+	}{
+		{
+			name: "valid",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},"valueQuantity":{"value":72}}`,
+		},
+		{
+			name: "obs-6 value and dataAbsentReason",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+				"valueQuantity":{"value":72},"dataAbsentReason":{"text":"not asked"}}`,
+			want: []string{"Observation: constraint obs-6 failed: dataAbsentReason SHALL only be present"},
+		},
+		{
+			name: "obs-7 value repeated in a component",
+			json: `{"resourceType":"Observation","status":"final",
+				"code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]},"valueQuantity":{"value":120},
+				"component":[{"code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]},"valueQuantity":{"value":120}}]}`,
+			want: []string{"Observation: constraint obs-7 failed"},
+		},
+		{
+			name: "ele-1 empty element",
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"weight"},"category":[{}]}`,
+			want: []string{"Observation.category[0]: constraint ele-1 failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fv.ValidateProfile([]byte(tt.json), coreObservation)
+			checkErrors(t, errorList(t, err), tt.want)
+		})
+	}
+}
+
+func TestCheckProfileWarnings(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	errs, err := fv.CheckProfile([]byte(`{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+		"valueQuantity":{"value":72},"dataAbsentReason":{"text":"not asked"}}`), coreObservation)
+	if err != nil {
+		t.Fatalf("CheckProfile() error = %v", err)
+	}
+	if !errs.HasErrors() {
+		t.Error("HasErrors() = false, want true")
+	}
+	var keys []string
+	for _, e := range errs.List() {
+		keys = append(keys, e.Key)
+	}
+	if len(keys) != 2 || keys[0] != "dom-6" || keys[1] != "obs-6" {
+		t.Fatalf("keys = %v, want [dom-6 obs-6]", keys)
+	}
+	warnings := errs.Warnings()
+	if len(warnings) != 1 || warnings[0].Key != "dom-6" || warnings[0].Field != "Observation" {
+		t.Errorf("Warnings() = %v, want dom-6 on Observation", warnings)
+	}
+
+	// A resource with narrative and nothing else wrong has no issues
+	errs, err = fv.CheckProfile([]byte(`{"resourceType":"Observation","status":"final","code":{"text":"weight"},
+		"text":{"status":"generated","div":"<div xmlns=\"http://www.w3.org/1999/xhtml\">72 kg</div>"}}`), coreObservation)
+	if err != nil {
+		t.Fatalf("CheckProfile() error = %v", err)
+	}
+	if len(errs.List()) != 0 {
+		t.Errorf("CheckProfile() = %v, want no issues", errs)
+	}
+}
+
+func TestValidateCoreInvariants(t *testing.T) {
+	fv := newProfileValidator(t)
+	weight, reason, value := "weight", "not asked", primitives.MustDecimal("72.0")
+	obs := &r4.Observation{
+		Status:           "final",
+		Code:             r4.CodeableConcept{Text: &weight},
+		ValueQuantity:    &r4.Quantity{Value: &value},
+		DataAbsentReason: &r4.CodeableConcept{Text: &reason},
+	}
+	err := fv.Validate(obs)
+	checkErrors(t, errorList(t, err), []string{"Observation: constraint obs-6 failed"})
+
+	obs.DataAbsentReason = nil
+	if err := fv.Validate(obs); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestValidateGeneratedInvariants(t *testing.T) {
+	fv := NewFHIRValidator()
+	weight, reason, value := "weight", "not asked", primitives.MustDecimal("72.0")
+
+	tests := []struct {
+		name     string
+		resource any
+		want     []string
+	}{
+		{
+			name: "r4 obs-6",
+			resource: &r4.Observation{
+				Status:           "final",
+				Code:             r4.CodeableConcept{Text: &weight},
+				ValueQuantity:    &r4.Quantity{Value: &value},
+				DataAbsentReason: &r4.CodeableConcept{Text: &reason},
+			},
+			want: []string{"constraint obs-6 failed"},
+		},
+		{
+			name: "r5 obs-6",
+			resource: &r5.Observation{
+				Status:           "final",
+				Code:             r5.CodeableConcept{Text: &weight},
+				ValueString:      &weight,
+				DataAbsentReason: &r5.CodeableConcept{Text: &reason},
+			},
+			want: []string{"constraint obs-6 failed"},
+		},
+		{
+			name: "r4 valid",
+			resource: &r4.Observation{
+				Status:        "final",
+				Code:          r4.CodeableConcept{Text: &weight},
+				ValueQuantity: &r4.Quantity{Value: &value},
+			},
+		},
+		{
+			name: "data type and backbone invariants",
+			resource: &r4.Observation{
+				Status:         "final",
+				Code:           r4.CodeableConcept{Text: &weight},
+				ValueQuantity:  &r4.Quantity{Value: &value, Code: &weight},
+				ReferenceRange: []r4.ObservationReferenceRange{{}},
+			},
+			want: []string{"ValueQuantity: constraint qty-3 failed", "ReferenceRange[0]: constraint obs-3 failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkErrors(t, errorList(t, fv.Validate(tt.resource)), tt.want)
+		})
+	}
+}
+
+func TestCheckGeneratedInvariantLocations(t *testing.T) {
+	weight, reason := "weight", "not asked"
+	errs, err := NewFHIRValidator().Check(&r4.Observation{
+		Status:           "final",
+		Code:             r4.CodeableConcept{Text: &weight},
+		ValueString:      &weight,
+		DataAbsentReason: &r4.CodeableConcept{Text: &reason},
+		ReferenceRange:   []r4.ObservationReferenceRange{{}},
+	})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	got := map[string]string{}
+	for _, e := range errs.List() {
+		got[e.Key] = e.Expression
+	}
+	want := map[string]string{
+		"dom-6": "Observation",
+		"obs-6": "Observation",
+		"obs-3": "Observation.referenceRange[0]",
+	}
+	for key, expr := range want {
+		if got[key] != expr {
+			t.Errorf("%s at %q, want %q (issues: %v)", key, got[key], expr, errs)
+		}
+	}
+	if w := errs.Warnings(); len(w) != 1 || w[0].Key != "dom-6" {
+		t.Errorf("Warnings() = %v, want dom-6", w)
+	}
+}
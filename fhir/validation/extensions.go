@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// checkExtensions walks a value and checks every extension whose
+// definition is in the registry: it must be used in one of the contexts
+// of the definition and conform to it. elemPath is the path of the value
+// without indexes, such as Patient.address, and parentExt the url of the
+// extension the value is inside, if any.
+func (iv *instanceValidator) checkExtensions(p *profile, o map[string]any, path, elemPath, parentExt string) {
+	keys := make([]string, 0, len(o))
+	for k := range o {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		switch {
+		case k == "extension" || k == "modifierExtension":
+			for _, it := range listItems(o[k], path+"."+k, "") {
+				ext, ok := it.value.(map[string]any)
+				if !ok {
+					continue
+				}
+				url := str(ext, "url")
+				iv.checkExtension(p, ext, url, it.path, elemPath, parentExt)
+				iv.checkExtensions(p, ext, it.path, "Extension", url)
+			}
+		case k == "contained":
+			for _, it := range listItems(o[k], path+"."+k, "") {
+				if r, ok := it.value.(map[string]any); ok {
+					iv.checkExtensions(p, r, it.path, str(r, "resourceType"), "")
+				}
+			}
+		case k == "resourceType":
+		default:
+			// _birthDate holds the extensions of birthDate
+			name := strings.TrimPrefix(k, "_")
+			for _, it := range listItems(o[k], path+"."+k, "") {
+				if c, ok := it.value.(map[string]any); ok {
+					if rt := str(c, "resourceType"); rt != "" {
+						iv.checkExtensions(p, c, it.path, rt, "")
+					} else {
+						iv.checkExtensions(p, c, it.path, elemPath+"."+name, parentExt)
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkExtension checks one extension against its definition, if known.
+func (iv *instanceValidator) checkExtension(p *profile, ext map[string]any, url, path, elemPath, parentExt string) {
+	if !strings.Contains(url, ":") {
+		// A relative url names a part of a complex extension
+		return
+	}
+	def, err := iv.set.get(url, p.sd.FHIRVersion)
+	if errors.Is(err, errProfileNotFound) {
+		return
+	}
+	if err != nil {
+		iv.errs.issuef(SeverityError, IssueProcessing, ruleExtension, path, "extension %s: %v", url, err)
+		return
+	}
+	if def.sd.Type != "Extension" {
+		iv.errs.issuef(SeverityError, IssueStructure, ruleExtension, path, "%s is a %s definition, not an extension", url, def.sd.Type)
+		return
+	}
+	if !iv.contextAllows(p, def.sd.Context, elemPath, parentExt) {
+		iv.errs.issuef(SeverityError, IssueExtension, ruleExtension, path, "extension %s is not allowed on %s; its context is %s", url, elemPath, contextString(def.sd.Context))
+	}
+	if iv.depth < maxProfileDepth {
+		sub := &instanceValidator{set: iv.set, errs: iv.errs, root: iv.root, depth: iv.depth + 1}
+		sub.validateNode(def, def.root, item{value: ext, path: path})
+	}
+}
+
+// contextAllows reports whether an extension with the given contexts may
+// be used on the element at elemPath. Contexts that can't be decided, such
+// as FHIRPath expressions or data types of elements the profile doesn't
+// describe, allow it.
+func (iv *instanceValidator) contextAllows(p *profile, contexts []extensionContext, elemPath, parentExt string) bool {
+	if len(contexts) == 0 {
+		return true
+	}
+	var types []string
+	if elemPath == "Extension" {
+		types = []string{"Extension"}
+	} else if !strings.Contains(elemPath, ".") {
+		// A resource, which may be a contained one
+		types = []string{elemPath, "DomainResource", "Resource"}
+	} else if strings.SplitN(elemPath, ".", 2)[0] == p.root.def.Path {
+		types = p.typesAt(elemPath)
+	}
+	root := strings.SplitN(elemPath, ".", 2)[0]
+
+	for _, c := range contexts {
+		switch c.Type {
+		case "extension":
+			if c.Expression == parentExt {
+				return true
+			}
+		case "element":
+			e := c.Expression
+			if e == "Element" || e == elemPath {
+				return true
+			}
+			for _, t := range types {
+				if e == t {
+					return true
+				}
+			}
+			if len(types) == 0 && strings.SplitN(e, ".", 2)[0] != root {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func contextString(contexts []extensionContext) string {
+	parts := make([]string, len(contexts))
+	for i, c := range contexts {
+		parts[i] = c.Expression
+		if c.Type == "extension" {
+			parts[i] = fmt.Sprintf("extension %s", c.Expression)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
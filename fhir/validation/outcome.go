@@ -0,0 +1,250 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// IssueType says what kind of problem an issue is. The values are the codes
+// of the FHIR IssueType value set.
+type IssueType string
+
+const (
+	IssueInvalid         IssueType = "invalid"
+	IssueStructure       IssueType = "structure"
+	IssueRequired        IssueType = "required"
+	IssueValue           IssueType = "value"
+	IssueInvariant       IssueType = "invariant"
+	IssueSecurity        IssueType = "security"
+	IssueLogin           IssueType = "login"
+	IssueUnknown         IssueType = "unknown"
+	IssueExpired         IssueType = "expired"
+	IssueForbidden       IssueType = "forbidden"
+	IssueSuppressed      IssueType = "suppressed"
+	IssueProcessing      IssueType = "processing"
+	IssueNotSupported    IssueType = "not-supported"
+	IssueDuplicate       IssueType = "duplicate"
+	IssueMultipleMatches IssueType = "multiple-matches"
+	IssueNotFound        IssueType = "not-found"
+	IssueDeleted         IssueType = "deleted"
+	IssueTooLong         IssueType = "too-long"
+	IssueCodeInvalid     IssueType = "code-invalid"
+	IssueExtension       IssueType = "extension"
+	IssueTooCostly       IssueType = "too-costly"
+	IssueBusinessRule    IssueType = "business-rule"
+	IssueConflict        IssueType = "conflict"
+	IssueTransient       IssueType = "transient"
+	IssueLockError       IssueType = "lock-error"
+	IssueNoStore         IssueType = "no-store"
+	IssueException       IssueType = "exception"
+	IssueTimeout         IssueType = "timeout"
+	IssueIncomplete      IssueType = "incomplete"
+	IssueThrottled       IssueType = "throttled"
+	IssueInformational   IssueType = "informational"
+)
+
+// Names of the built-in checks, used as Error.Key. Failed constraints use
+// their own keys, such as obs-6.
+const (
+	ruleCardinality = "cardinality"
+	ruleEnum        = "enum"
+	ruleChoice      = "choice"
+	ruleFixed       = "fixed"
+	rulePattern     = "pattern"
+	ruleType        = "type"
+	ruleReference   = "reference"
+	ruleProfile     = "profile"
+	ruleSlicing     = "slicing"
+	ruleExtension   = "extension"
+	ruleBinding     = "binding"
+	rulePrimitive   = "primitive"
+	// ruleJSON covers the rules of the JSON format, such as no null
+	// values; ruleUnknownElement, properties that are not elements.
+	ruleJSON           = "json"
+	ruleUnknownElement = "unknown-element"
+)
+
+// Extensions an OperationOutcome issue carries the position and rule of a
+// validation issue in.
+const (
+	extIssueLine = "http://hl7.org/fhir/StructureDefinition/operationoutcome-issue-line"
+	extIssueCol  = "http://hl7.org/fhir/StructureDefinition/operationoutcome-issue-col"
+	extMessageID = "http://hl7.org/fhir/StructureDefinition/operationoutcome-message-id"
+)
+
+// AtLeast returns the issues of severity min or worse, where fatal is worse
+// than error, then warning, then information.
+func (e *Errors) AtLeast(min Severity) *Errors {
+	return e.Filter(func(err *Error) bool { return err.Severity.rank() >= min.rank() })
+}
+
+// Filter returns the issues keep returns true for.
+func (e *Errors) Filter(keep func(*Error) bool) *Errors {
+	out := &Errors{}
+	for _, err := range e.errors {
+		if keep(err) {
+			out.errors = append(out.errors, err)
+		}
+	}
+	return out
+}
+
+// Merge adds the issues of other, found in a resource that sits at
+// location in the resource e is for, such as Bundle.entry[2].resource. The
+// resource type that starts their expressions gives way to location. Lines
+// and columns are kept, so they must be of the same document.
+func (e *Errors) Merge(location string, other *Errors) {
+	for _, err := range other.errors {
+		c := *err
+		exprs := strings.Split(c.Expression, " | ")
+		for i, expr := range exprs {
+			exprs[i] = relocate(location, expr)
+		}
+		c.Expression = strings.Join(exprs, " | ")
+		c.Field = c.Expression
+		e.errors = append(e.errors, &c)
+	}
+}
+
+// relocate replaces the first step of a FHIRPath location, the resource
+// type, with location.
+func relocate(location, expr string) string {
+	if expr == "" {
+		return location
+	}
+	_, rest, ok := strings.Cut(expr, ".")
+	if !ok {
+		return location
+	}
+	return location + "." + rest
+}
+
+// fieldExpressions sets the Expression of the issues from index from on that
+// have none. Their fields are FHIRPath-like locations, whose slice names,
+// as in Patient.identifier:NID, are dropped; or, when t is not nil, paths
+// of the Go fields of a struct of type t, which are turned into FHIRPath
+// by the fields' JSON names.
+func (e *Errors) fieldExpressions(from int, t reflect.Type) {
+	for _, err := range e.errors[from:] {
+		if err.Expression != "" || err.Field == "" {
+			continue
+		}
+		if t == nil {
+			err.Expression = dropSliceNames(err.Field)
+			continue
+		}
+		// Choice errors name every field set
+		var exprs []string
+		for _, f := range strings.Split(err.Field, ", ") {
+			if expr := goExpression(t, f); expr != "" {
+				exprs = append(exprs, expr)
+			}
+		}
+		err.Expression = strings.Join(exprs, " | ")
+	}
+}
+
+func dropSliceNames(path string) string {
+	if !strings.Contains(path, ":") {
+		return path
+	}
+	steps := strings.Split(path, ".")
+	for i, s := range steps {
+		if name, _, ok := strings.Cut(s, ":"); ok {
+			steps[i] = name
+		}
+	}
+	return strings.Join(steps, ".")
+}
+
+// goExpression turns a path of Go fields in a struct of type t, such as
+// DomainResource.Text.Status or Name[0].Family, into FHIRPath, such as
+// Patient.text.status or Patient.name[0].family. Embedded structs add no
+// step. It returns "" if the path doesn't fit t.
+func goExpression(t reflect.Type, path string) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	expr := t.Name()
+	if path == "" {
+		return expr
+	}
+	for _, step := range strings.Split(path, ".") {
+		name, index, indexed := strings.Cut(step, "[")
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return ""
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return ""
+		}
+		t = f.Type
+		if !f.Anonymous {
+			jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if jsonName == "" || jsonName == "-" {
+				jsonName = f.Name
+			}
+			expr += "." + jsonName
+		}
+		if indexed {
+			expr += "[" + index
+		}
+	}
+	return expr
+}
+
+// OperationOutcome converts the issues to an R5 OperationOutcome. Rule
+// keys, lines and columns go in the standard operationoutcome extensions.
+// Without issues, the outcome holds a single information issue saying so,
+// since an OperationOutcome needs at least one.
+func (e *Errors) OperationOutcome() *r5.OperationOutcome {
+	oo := &r5.OperationOutcome{}
+	oo.BaseResource.ResourceType = r5.ResourceTypeOperationOutcome
+	for _, err := range e.errors {
+		oo.Issue = append(oo.Issue, err.issue())
+	}
+	if len(oo.Issue) == 0 {
+		ok := "No issues found"
+		oo.Issue = []r5.OperationOutcomeIssue{{
+			Severity: string(SeverityInformation),
+			Code:     string(IssueInformational),
+			Details:  &r5.CodeableConcept{Text: &ok},
+		}}
+	}
+	return oo
+}
+
+func (e *Error) issue() r5.OperationOutcomeIssue {
+	severity, code := e.Severity, e.Code
+	if severity == "" {
+		severity = SeverityError
+	}
+	if code == "" {
+		code = IssueInvalid
+	}
+	message := e.Message
+	issue := r5.OperationOutcomeIssue{
+		Severity: string(severity),
+		Code:     string(code),
+		Details:  &r5.CodeableConcept{Text: &message},
+	}
+	if e.Expression != "" {
+		issue.Expression = []string{e.Expression}
+	}
+	if e.Line > 0 {
+		line, col := e.Line, e.Column
+		issue.Extension = append(issue.Extension,
+			r5.Extension{URL: extIssueLine, ValueInteger: &line},
+			r5.Extension{URL: extIssueCol, ValueInteger: &col})
+	}
+	if e.Key != "" {
+		key := e.Key
+		issue.Extension = append(issue.Extension, r5.Extension{URL: extMessageID, ValueString: &key})
+	}
+	return issue
+}
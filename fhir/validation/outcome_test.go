@@ -0,0 +1,317 @@
+package validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+// findIssue returns the issue with the rule key, failing the test if there
+// is none.
+func findIssue(t *testing.T, errs *Errors, key string) *Error {
+	t.Helper()
+	for _, e := range errs.List() {
+		if e.Key == key {
+			return e
+		}
+	}
+	t.Fatalf("no %s issue in %v", key, errs.List())
+	return nil
+}
+
+func TestCheckJSONIssues(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	errs, err := fv.Check([]byte(`{
+  "resourceType": "Observation",
+  "status": "done",
+  "code": {"text": "weight"},
+  "valueQuantity": {"value": 72},
+  "dataAbsentReason": {"text": "not asked"}
+}`))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	tests := []struct {
+		key          string
+		severity     Severity
+		code         IssueType
+		expression   string
+		line, column int
+	}{
+		{ruleBinding, SeverityError, IssueCodeInvalid, "Observation.status", 3, 3},
+		{"obs-6", SeverityError, IssueInvariant, "Observation", 1, 1},
+		{"dom-6", SeverityWarning, IssueInvariant, "Observation", 1, 1},
+	}
+	for _, tt := range tests {
+		e := findIssue(t, errs, tt.key)
+		if e.Severity != tt.severity || e.Code != tt.code || e.Expression != tt.expression || e.Line != tt.line || e.Column != tt.column {
+			t.Errorf("%s issue = %+v, want %s %s at %s, %d:%d", tt.key, e, tt.severity, tt.code, tt.expression, tt.line, tt.column)
+		}
+	}
+
+	errs, err = fv.Check([]byte("{\n  \"resourceType\": \"Observation\",\n  \"status\": }"))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if l := errs.List(); len(l) != 1 || l[0].Severity != SeverityFatal || l[0].Line != 3 || l[0].Column != 13 {
+		t.Errorf("invalid JSON: issues = %+v, want one fatal issue at 3:13", l[0])
+	}
+}
+
+func TestCheckProfileLocatesMissingElements(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	errs, err := fv.CheckProfile([]byte(`{"resourceType":"Observation",
+	"status":"final","category":[{
+		"coding":[{"code":"vital-signs"}]}]}`), coreObservation)
+	if err != nil {
+		t.Fatalf("CheckProfile() error = %v", err)
+	}
+	e := findIssue(t, errs, ruleCardinality)
+	if e.Code != IssueRequired || e.Expression != "Observation.code" || e.Line != 1 || e.Column != 1 {
+		t.Errorf("cardinality issue = %+v, want required at Observation.code, 1:1", e)
+	}
+}
+
+func TestCheckStructExpressions(t *testing.T) {
+	fv := NewFHIRValidator()
+
+	type HumanName struct {
+		Family *string `json:"family,omitempty" fhir:"cardinality=1..1,required"`
+	}
+	type Base struct {
+		ID *string `json:"id,omitempty"`
+	}
+	type Person struct {
+		Base
+		Name      []HumanName `json:"name,omitempty"`
+		Gender    *string     `json:"gender,omitempty" fhir:"cardinality=0..1,enum=male|female|other|unknown"`
+		DeceasedA *bool       `json:"deceasedBoolean,omitempty" fhir:"choice=deceased"`
+		DeceasedB *string     `json:"deceasedDateTime,omitempty" fhir:"choice=deceased"`
+	}
+	gender, deceased, when := "unknown-gender", true, "2024-01-01"
+	errs, err := fv.Check(&Person{Name: []HumanName{{}}, Gender: &gender, DeceasedA: &deceased, DeceasedB: &when})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if e := findIssue(t, errs, ruleEnum); e.Code != IssueCodeInvalid || e.Expression != "Person.gender" || e.Field != "Gender" {
+		t.Errorf("enum issue = %+v, want code-invalid at Person.gender", e)
+	}
+	if e := findIssue(t, errs, ruleCardinality); e.Expression != "Person.name[0].family" {
+		t.Errorf("Expression = %q, want Person.name[0].family", e.Expression)
+	}
+	if e := findIssue(t, errs, ruleChoice); e.Expression != "Person.deceasedBoolean | Person.deceasedDateTime" {
+		t.Errorf("Expression = %q", e.Expression)
+	}
+
+	if got := goExpression(reflect.TypeOf(Person{}), "Base.ID"); got != "Person.id" {
+		t.Errorf("goExpression(Base.ID) = %q, want Person.id", got)
+	}
+	if got := goExpression(reflect.TypeOf(Person{}), "Missing"); got != "" {
+		t.Errorf("goExpression(Missing) = %q, want empty", got)
+	}
+}
+
+func TestCheckPrimitiveFormats(t *testing.T) {
+	fv := NewFHIRValidator()
+
+	id, url, total := "has spaces!", "http://example.org/a b", -1
+	patient := &r4.Patient{}
+	patient.ID = &id
+	patient.ImplicitRules = &url
+	errs, err := fv.Check(patient)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	var got []string
+	for _, e := range errs.List() {
+		if e.Key == rulePrimitive {
+			got = append(got, e.Expression)
+		}
+	}
+	if strings.Join(got, ", ") != "Patient.id, Patient.implicitRules" {
+		t.Errorf("primitive issues at %v, want Patient.id and Patient.implicitRules", got)
+	}
+	if e := findIssue(t, errs, rulePrimitive); e.Code != IssueValue || !strings.Contains(e.Message, `invalid FHIR id: "has spaces!"`) {
+		t.Errorf("issue = %+v, want an invalid id", e)
+	}
+
+	errs, err = fv.Check(&fhir.Bundle{Type: "searchset", Total: &total})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if e := findIssue(t, errs, rulePrimitive); e.Expression != "Bundle.total" {
+		t.Errorf("Expression = %q, want Bundle.total", e.Expression)
+	}
+
+	// Values of the primitives package check their own FHIR type
+	canonical := primitives.Canonical("http://example.org/a b")
+	errs, err = fv.Check(&r4.RelatedArtifact{Type: "citation", Resource: &canonical})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if e := findIssue(t, errs, rulePrimitive); e.Expression != "RelatedArtifact.resource" || !strings.Contains(e.Message, "invalid FHIR canonical") {
+		t.Errorf("issue = %+v, want an invalid canonical at RelatedArtifact.resource", e)
+	}
+}
+
+func TestCheckCodes(t *testing.T) {
+	fv := NewFHIRValidator()
+
+	gender, phone := r4.AdministrativeGender("M"), r4.ContactPointSystemPhone
+	patient := &r4.Patient{Gender: &gender, Telecom: []r4.ContactPoint{{System: &phone}}}
+	patient.Text = &r4.Narrative{Status: "done", Div: `<div xmlns="http://www.w3.org/1999/xhtml">M</div>`}
+	errs, err := fv.Check(patient)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	var got []string
+	for _, e := range errs.List() {
+		if e.Key == ruleEnum {
+			got = append(got, e.Expression+": "+e.Message)
+		}
+	}
+	want := "Patient.text.status: invalid NarrativeStatus code 'done', Patient.gender: invalid AdministrativeGender code 'M'"
+	if strings.Join(got, ", ") != want {
+		t.Errorf("code issues = %v, want %s", got, want)
+	}
+}
+
+func TestCheckBundle(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	errs, err := fv.CheckBundle([]byte(`{"resourceType":"Bundle","type":"collection","entry":[
+  {"resource":{"resourceType":"Observation","status":"final","code":{"text":"weight"}}},
+  {"resource":{"resourceType":"Observation",
+    "status":"done","code":{"text":"height"}}}
+]}`))
+	if err != nil {
+		t.Fatalf("CheckBundle() error = %v", err)
+	}
+	e := findIssue(t, errs, ruleBinding)
+	if e.Expression != "Bundle.entry[1].resource.status" || e.Line != 4 || e.Column != 5 {
+		t.Errorf("binding issue = %+v, want Bundle.entry[1].resource.status at 4:5", e)
+	}
+
+	bundle := &r4.Bundle{Entry: []r4.BundleEntry{
+		{Resource: json.RawMessage(`{"resourceType":"Observation","status":"final","code":{"text":"weight"}}`)},
+		{Resource: json.RawMessage(`{"resourceType":"Observation","status":"done","code":{"text":"height"}}`)},
+	}}
+	errs, err = fv.CheckBundle(bundle)
+	if err != nil {
+		t.Fatalf("CheckBundle() error = %v", err)
+	}
+	if e := findIssue(t, errs, ruleBinding); e.Expression != "Bundle.entry[1].resource.status" || e.Line != 0 {
+		t.Errorf("binding issue = %+v, want Bundle.entry[1].resource.status without a position", e)
+	}
+}
+
+func TestErrorsFilters(t *testing.T) {
+	errs := &Errors{}
+	errs.issuef(SeverityInformation, IssueCodeInvalid, ruleBinding, "Patient.maritalStatus", "not in the preferred ValueSet")
+	errs.issuef(SeverityWarning, IssueInvariant, "dom-6", "Patient", "no narrative")
+	errs.issuef(SeverityError, IssueRequired, ruleCardinality, "Patient.name", "required field is missing")
+	errs.issuef(SeverityFatal, IssueStructure, "", "", "unexpected end of JSON input")
+
+	tests := []struct {
+		min  Severity
+		want int
+	}{
+		{SeverityInformation, 4},
+		{SeverityWarning, 3},
+		{SeverityError, 2},
+		{SeverityFatal, 1},
+	}
+	for _, tt := range tests {
+		if got := len(errs.AtLeast(tt.min).List()); got != tt.want {
+			t.Errorf("AtLeast(%s) has %d issues, want %d", tt.min, got, tt.want)
+		}
+	}
+
+	invariants := errs.Filter(func(e *Error) bool { return e.Code == IssueInvariant })
+	if l := invariants.List(); len(l) != 1 || l[0].Key != "dom-6" {
+		t.Errorf("Filter() = %v", l)
+	}
+	if errs.AtLeast(SeverityWarning).AtLeast(SeverityError).HasErrors() != true {
+		t.Error("HasErrors() = false for errors and fatal issues")
+	}
+
+	if msg := errs.Error(); !strings.HasPrefix(msg, "2 validation error(s), 2 other issue(s):\n") {
+		t.Errorf("Error() = %q, should count errors apart from other issues", msg)
+	}
+	if msg := errs.AtLeast(SeverityError).Error(); !strings.HasPrefix(msg, "2 validation error(s):\n") {
+		t.Errorf("Error() = %q", msg)
+	}
+}
+
+func TestOperationOutcome(t *testing.T) {
+	errs := &Errors{}
+	errs.add(&Error{
+		Field:      "Observation.status",
+		Message:    "code done is not in the required ValueSet",
+		Severity:   SeverityError,
+		Code:       IssueCodeInvalid,
+		Key:        ruleBinding,
+		Expression: "Observation.status",
+		Line:       3,
+		Column:     5,
+	})
+	errs.Add("Status", "required field is missing")
+
+	oo := errs.OperationOutcome()
+	if oo.ResourceType() != "OperationOutcome" || len(oo.Issue) != 2 {
+		t.Fatalf("OperationOutcome() = %+v", oo)
+	}
+	issue := oo.Issue[0]
+	if issue.Severity != "error" || issue.Code != "code-invalid" || issue.Details == nil || *issue.Details.Text != "code done is not in the required ValueSet" {
+		t.Errorf("issue = %+v", issue)
+	}
+	if len(issue.Expression) != 1 || issue.Expression[0] != "Observation.status" {
+		t.Errorf("Expression = %v", issue.Expression)
+	}
+	ext := make(map[string]any)
+	for _, e := range issue.Extension {
+		switch {
+		case e.ValueInteger != nil:
+			ext[e.URL] = *e.ValueInteger
+		case e.ValueString != nil:
+			ext[e.URL] = *e.ValueString
+		}
+	}
+	if ext[extIssueLine] != 3 || ext[extIssueCol] != 5 || ext[extMessageID] != ruleBinding {
+		t.Errorf("extensions = %v", ext)
+	}
+	if issue := oo.Issue[1]; issue.Code != "invalid" || len(issue.Expression) != 0 || len(issue.Extension) != 0 {
+		t.Errorf("issue without a rule or location = %+v", issue)
+	}
+
+	oo = (&Errors{}).OperationOutcome()
+	if len(oo.Issue) != 1 || oo.Issue[0].Severity != "information" || oo.Issue[0].Code != "informational" {
+		t.Errorf("empty OperationOutcome() = %+v", oo.Issue)
+	}
+}
+
+func TestJSONPositions(t *testing.T) {
+	data := []byte("{\"name\":[{\"given\":[\"Ayesha\", \"Begum\"]}],\n \"resourceType\":\"Patient\",\n \"text\":{\"div\":\"ক\"}, \"id\":\"x\"}")
+	positions := jsonPositions(data)
+	tests := map[string]position{
+		"Patient":                  {1, 1},
+		"Patient.name":             {1, 2},
+		"Patient.name[0]":          {1, 10},
+		"Patient.name[0].given[1]": {1, 30},
+		"Patient.resourceType":     {2, 2},
+		"Patient.id":               {3, 22},
+	}
+	for path, want := range tests {
+		if got := positions[path]; got != want {
+			t.Errorf("position of %s = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// position is a place in a JSON document, counting lines and columns from 1.
+type position struct {
+	line, column int
+}
+
+// jsonKind is the kind of a JSON value.
+type jsonKind int
+
+const (
+	kindNull jsonKind = iota
+	kindBool
+	kindNumber
+	kindString
+	kindArray
+	kindObject
+)
+
+func (k jsonKind) String() string {
+	return [...]string{"null", "boolean", "number", "string", "array", "object"}[k]
+}
+
+// jsonValue is a JSON value as written, with where it starts. Unlike a
+// decoded map, an object keeps its properties in order, duplicates
+// included.
+type jsonValue struct {
+	kind    jsonKind
+	pos     position
+	members []jsonMember // of an object
+	items   []*jsonValue // of an array
+	scalar  any          // string, json.Number or bool
+}
+
+// jsonMember is a property of an object; pos is where its name starts.
+type jsonMember struct {
+	name  string
+	pos   position
+	value *jsonValue
+}
+
+// member returns the first value of the property name, or nil.
+func (v *jsonValue) member(name string) *jsonValue {
+	for _, m := range v.members {
+		if m.name == name {
+			return m.value
+		}
+	}
+	return nil
+}
+
+// jsonSyntaxError is invalid JSON, at the position it was found.
+type jsonSyntaxError struct {
+	msg string
+	pos position
+}
+
+func (e *jsonSyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.pos.line, e.pos.column, e.msg)
+}
+
+// parseJSON reads a JSON document, which must hold a single value. Errors
+// are *jsonSyntaxError.
+func parseJSON(data []byte) (*jsonValue, error) {
+	p := &jsonParser{data: data, dec: json.NewDecoder(bytes.NewReader(data))}
+	p.dec.UseNumber()
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if off := p.next(); off < len(data) {
+		return nil, &jsonSyntaxError{msg: "unexpected data after the top-level value", pos: p.at(off)}
+	}
+	return v, nil
+}
+
+type jsonParser struct {
+	data []byte
+	dec  *json.Decoder
+}
+
+// next returns the offset of the next token, past whitespace and the
+// separators the decoder consumes on its own.
+func (p *jsonParser) next() int {
+	off := int(p.dec.InputOffset())
+	for off < len(p.data) && strings.IndexByte(" \t\r\n,:", p.data[off]) >= 0 {
+		off++
+	}
+	return off
+}
+
+func (p *jsonParser) at(offset int) position {
+	line, col := lineColumn(p.data, offset)
+	return position{line, col}
+}
+
+func (p *jsonParser) syntaxError(err error) error {
+	offset := len(p.data)
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) {
+		offset = int(syntax.Offset)
+		if !strings.HasPrefix(syntax.Error(), "unexpected end") {
+			// The offset is past the byte that was wrong
+			offset = max(offset-1, 0)
+		}
+	}
+	msg := err.Error()
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		msg = "unexpected end of JSON input"
+	}
+	return &jsonSyntaxError{msg: msg, pos: p.at(offset)}
+}
+
+func (p *jsonParser) value() (*jsonValue, error) {
+	start := p.next()
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, p.syntaxError(err)
+	}
+	v := &jsonValue{pos: p.at(start)}
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '{' {
+			v.kind = kindObject
+			for p.dec.More() {
+				start := p.next()
+				tok, err := p.dec.Token()
+				if err != nil {
+					return nil, p.syntaxError(err)
+				}
+				name, _ := tok.(string)
+				value, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				v.members = append(v.members, jsonMember{name: name, pos: p.at(start), value: value})
+			}
+		} else {
+			v.kind = kindArray
+			for p.dec.More() {
+				item, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				v.items = append(v.items, item)
+			}
+		}
+		// The closing brace or bracket
+		if _, err := p.dec.Token(); err != nil {
+			return nil, p.syntaxError(err)
+		}
+	case string:
+		v.kind, v.scalar = kindString, t
+	case json.Number:
+		v.kind, v.scalar = kindNumber, t
+	case bool:
+		v.kind, v.scalar = kindBool, t
+	default:
+		v.kind = kindNull
+	}
+	return v, nil
+}
+
+// jsonPositions maps the FHIRPath location of every value in the JSON of a
+// resource, such as Observation.code.coding[0], to where it starts: its
+// property name, or the value itself for array items. The root object is
+// named by its resourceType. Invalid JSON gives no positions.
+func jsonPositions(data []byte) map[string]position {
+	v, err := parseJSON(data)
+	if err != nil {
+		return nil
+	}
+	return v.positions()
+}
+
+// positions is jsonPositions for a parsed document.
+func (v *jsonValue) positions() map[string]position {
+	rt := ""
+	if t := v.member("resourceType"); t != nil && t.kind == kindString {
+		rt = t.scalar.(string)
+	}
+	out := make(map[string]position)
+	out[rt] = v.pos
+	v.addPositions(rt, out)
+	return out
+}
+
+func (v *jsonValue) addPositions(path string, out map[string]position) {
+	for _, m := range v.members {
+		child := path + "." + m.name
+		if _, dup := out[child]; !dup {
+			out[child] = m.pos
+			m.value.addPositions(child, out)
+		}
+	}
+	for i, item := range v.items {
+		child := fmt.Sprintf("%s[%d]", path, i)
+		out[child] = item.pos
+		item.addPositions(child, out)
+	}
+}
+
+// lineColumn converts a byte offset in data to a line and column counted
+// from 1. Columns count characters, not bytes.
+func lineColumn(data []byte, offset int) (line, column int) {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	before := data[:offset]
+	line = bytes.Count(before, []byte{'\n'}) + 1
+	if i := bytes.LastIndexByte(before, '\n'); i >= 0 {
+		before = before[i+1:]
+	}
+	return line, len(bytes.Runes(before)) + 1
+}
+
+// locate sets the line and column of the issues from index from on, by
+// their location in positions. An issue about a missing element takes the
+// position of the nearest element above it that is present.
+func (e *Errors) locate(from int, positions map[string]position) {
+	for _, err := range e.errors[from:] {
+		if err.Line > 0 {
+			continue
+		}
+		expr, _, _ := strings.Cut(err.Expression, " | ")
+		for expr != "" {
+			if pos, ok := positions[expr]; ok {
+				err.Line, err.Column = pos.line, pos.column
+				break
+			}
+			expr = parentPath(expr)
+		}
+	}
+}
+
+// parentPath drops the last step of a path: an index, or else a name.
+func parentPath(path string) string {
+	if strings.HasSuffix(path, "]") {
+		if i := strings.LastIndexByte(path, '['); i >= 0 {
+			return path[:i]
+		}
+	}
+	if i := strings.LastIndexByte(path, '.'); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
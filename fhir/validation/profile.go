@@ -0,0 +1,616 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/fhir/fhirpath"
+)
+
+// errProfileNotFound reports a profile missing from the registry.
+var errProfileNotFound = errors.New("profile not found")
+
+// profile is a StructureDefinition prepared for validating instances: its
+// snapshot, generated when the definition has none, arranged as a tree.
+type profile struct {
+	sd     *structureDefinition
+	root   *elementNode
+	byID   map[string]*elementNode
+	byPath map[string]*elementNode
+}
+
+// elementNode is an element of a snapshot with its children and slices.
+type elementNode struct {
+	def      *elementDefinition
+	children []*elementNode
+	slices   []*elementNode
+	sliceOf  *elementNode
+}
+
+func compileProfile(sd any, reg *conformance.Registry) (*profile, error) {
+	m, err := jsonObject(sd)
+	if err != nil {
+		return nil, err
+	}
+	s, err := parseStructureDefinition(m)
+	if err != nil {
+		return nil, err
+	}
+	elems := s.Snapshot
+	if len(elems) == 0 {
+		if elems, err = newSnapshotGenerator(reg, s.FHIRVersion).generate(m); err != nil {
+			return nil, err
+		}
+	}
+	p := &profile{sd: s, byID: make(map[string]*elementNode), byPath: make(map[string]*elementNode)}
+	if err := p.build(elems); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.URL, err)
+	}
+	return p, nil
+}
+
+// build arranges the snapshot elements as a tree. Elements follow their
+// parent, and slices follow the element they slice and its children.
+func (p *profile) build(elems []map[string]any) error {
+	if len(elems) == 0 {
+		return errors.New("no elements")
+	}
+	p.root = &elementNode{def: parseElement(elems[0])}
+	p.index(p.root)
+	stack := []*elementNode{p.root}
+	for _, m := range elems[1:] {
+		n := &elementNode{def: parseElement(m)}
+		e := n.def
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if e.SliceName != "" && top.def.Path == e.Path || strings.HasPrefix(e.Path, top.def.Path+".") {
+				break
+			}
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			return fmt.Errorf("%s is not inside %s", e.ID, p.root.def.Path)
+		}
+		top := stack[len(stack)-1]
+		if e.SliceName != "" && top.def.Path == e.Path {
+			// top is the sliced element or an earlier slice of it; a
+			// reslice a/b belongs to slice a
+			base := top
+			for base.sliceOf != nil && !strings.HasPrefix(e.SliceName, base.def.SliceName+"/") {
+				base = base.sliceOf
+			}
+			n.sliceOf = base
+			base.slices = append(base.slices, n)
+			stack[len(stack)-1] = n
+		} else {
+			top.children = append(top.children, n)
+			stack = append(stack, n)
+		}
+		p.index(n)
+	}
+	return nil
+}
+
+func (p *profile) index(n *elementNode) {
+	p.byID[n.def.ID] = n
+	if _, ok := p.byPath[n.def.Path]; !ok && !strings.Contains(n.def.ID, ":") {
+		p.byPath[n.def.Path] = n
+	}
+}
+
+// children returns the child elements of n. Elements with a content
+// reference share the children of the referenced element, and slices listed
+// without children share those of the sliced element.
+func (p *profile) children(n *elementNode) []*elementNode {
+	if len(n.children) > 0 {
+		return n.children
+	}
+	if ref := n.def.ContentReference; ref != "" {
+		if target := p.byID[ref[strings.Index(ref, "#")+1:]]; target != nil && target != n {
+			return p.children(target)
+		}
+	}
+	if n.sliceOf != nil {
+		return p.children(n.sliceOf)
+	}
+	return nil
+}
+
+// child returns the child element of n with the given name, matching the
+// choice element value[x] for value.
+func (p *profile) child(n *elementNode, name string) *elementNode {
+	for _, c := range p.children(n) {
+		if c.def.name() == name || c.def.name() == name+"[x]" {
+			return c
+		}
+	}
+	return nil
+}
+
+// typesAt returns the types of the element at a path without slices or
+// indexes, such as Patient.address, or nil if the profile doesn't say.
+func (p *profile) typesAt(path string) []string {
+	if path == p.root.def.Path {
+		if p.sd.Kind == "resource" {
+			return []string{p.sd.Type}
+		}
+		return p.root.def.typeCodes()
+	}
+	if n, ok := p.byPath[path]; ok {
+		return n.def.typeCodes()
+	}
+	// A typed choice such as Observation.valueQuantity
+	parent, last := splitID(path)
+	if n, ok := p.byPath[parent]; ok {
+		for _, c := range p.children(n) {
+			if _, typ, ok := cutChoice(last, strings.TrimSuffix(c.def.name(), "[x]")); ok && c.def.isChoice() {
+				return []string{typ}
+			}
+		}
+	}
+	return nil
+}
+
+// profileSet compiles the profiles of a registry on first use, along with
+// the FHIRPath expressions of their constraints.
+type profileSet struct {
+	reg         *conformance.Registry
+	mu          sync.Mutex
+	compiled    map[string]*profile
+	failed      map[string]error
+	expressions map[string]*fhirpath.Expression
+	exprErrs    map[string]error
+	models      map[string]fhirpath.Model
+}
+
+func newProfileSet(reg *conformance.Registry) *profileSet {
+	return &profileSet{
+		reg:         reg,
+		compiled:    make(map[string]*profile),
+		failed:      make(map[string]error),
+		expressions: make(map[string]*fhirpath.Expression),
+		exprErrs:    make(map[string]error),
+		models:      make(map[string]fhirpath.Model),
+	}
+}
+
+// expression returns a constraint's expression, parsed.
+func (ps *profileSet) expression(src string) (*fhirpath.Expression, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if e, ok := ps.expressions[src]; ok {
+		return e, nil
+	}
+	if err, ok := ps.exprErrs[src]; ok {
+		return nil, err
+	}
+	e, err := fhirpath.Parse(src)
+	if err != nil {
+		ps.exprErrs[src] = err
+		return nil, err
+	}
+	ps.expressions[src] = e
+	return e, nil
+}
+
+// model returns the FHIRPath model of the core types of a FHIR release.
+func (ps *profileSet) model(fhirVersion string) fhirpath.Model {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	m, ok := ps.models[fhirVersion]
+	if !ok {
+		m = fhirpath.NewModel(ps.reg, fhirVersion)
+		ps.models[fhirVersion] = m
+	}
+	return m
+}
+
+// get returns the profile a canonical resolves to among the definitions
+// for the FHIR release of fhirVersion, or any release if it is empty.
+func (ps *profileSet) get(canonical, fhirVersion string) (*profile, error) {
+	key := canonical + " " + fhirVersion
+	ps.mu.Lock()
+	p, ok := ps.compiled[key]
+	err := ps.failed[key]
+	ps.mu.Unlock()
+	if ok || err != nil {
+		return p, err
+	}
+
+	e, ok := ps.reg.ResolveFHIR("StructureDefinition", canonical, fhirVersion)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errProfileNotFound, canonical)
+	}
+	p, err = compileProfile(e.Resource, ps.reg)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if err != nil {
+		ps.failed[key] = err
+		return nil, err
+	}
+	ps.compiled[key] = p
+	return p, nil
+}
+
+// resourceType returns the type a StructureDefinition canonical
+// constrains, such as Patient for a Patient profile.
+func (ps *profileSet) resourceType(canonical, fhirVersion string) string {
+	if e, ok := ps.reg.ResolveFHIR("StructureDefinition", canonical, fhirVersion); ok {
+		if m, err := jsonObject(e.Resource); err == nil {
+			return str(m, "type")
+		}
+	}
+	if strings.HasPrefix(canonical, coreCanonical) {
+		t, _, _ := strings.Cut(strings.TrimPrefix(canonical, coreCanonical), "|")
+		return t
+	}
+	return ""
+}
+
+// maxProfileDepth bounds the nesting of profiles checked inside profiles,
+// such as the profile of a contained resource that refers back.
+const maxProfileDepth = 16
+
+// instanceValidator checks an instance against a profile.
+type instanceValidator struct {
+	set  *profileSet
+	errs *Errors
+	// root is the resource being validated, for resolving contained
+	// references.
+	root map[string]any
+	// mustSupport collects mustSupport elements without a value when not nil.
+	mustSupport *[]string
+	// terminology checks bound codes; bindings are skipped when it is nil.
+	terminology TerminologyService
+	// invariantsOnly limits the checks to constraints and bindings, for
+	// checking a resource against the core definition of its type.
+	invariantsOnly bool
+	depth          int
+}
+
+// item is a value found in an instance.
+type item struct {
+	value any
+	path  string // location, such as Patient.identifier[0]
+	typ   string // the type named by a choice property, such as Quantity
+}
+
+// validate checks a resource, or a data type value, against p.
+func (iv *instanceValidator) validate(p *profile, value map[string]any, path string) {
+	if p.sd.Kind == "resource" {
+		if rt := str(value, "resourceType"); rt != p.sd.Type {
+			iv.errs.issuef(SeverityError, IssueStructure, ruleProfile, path, "is a %s, but profile %s is for %s", rt, p.sd.URL, p.sd.Type)
+			return
+		}
+	}
+	iv.validateNode(p, p.root, item{value: value, path: path})
+	if !iv.invariantsOnly {
+		iv.checkExtensions(p, value, path, p.root.def.Path, "")
+	}
+}
+
+// validateNode checks one value against an element and its children.
+func (iv *instanceValidator) validateNode(p *profile, n *elementNode, it item) {
+	if !iv.invariantsOnly {
+		iv.checkValue(p, n.def, it)
+	}
+	iv.checkConstraints(p, n.def, it)
+	iv.checkBinding(p, n.def, it)
+	o, ok := it.value.(map[string]any)
+	if !ok {
+		return
+	}
+	children := p.children(n)
+	names := make(map[string]bool, len(children))
+	for _, c := range children {
+		names[c.def.name()] = true
+	}
+	for _, c := range children {
+		iv.validateCollection(p, c, childItems(o, c.def, it.path, names), it.path+"."+c.def.name())
+	}
+}
+
+// childItems returns the values of the child element def in o. A choice
+// element collects every typed property, such as valueQuantity.
+func childItems(o map[string]any, def *elementDefinition, path string, siblings map[string]bool) []item {
+	name := def.name()
+	if !def.isChoice() {
+		return listItems(o[name], path+"."+name, "")
+	}
+	base := strings.TrimSuffix(name, "[x]")
+	var keys []string
+	for k := range o {
+		if _, _, ok := cutChoice(k, base); ok && !siblings[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var items []item
+	for _, k := range keys {
+		_, typ, _ := cutChoice(k, base)
+		items = append(items, listItems(o[k], path+"."+k, typ)...)
+	}
+	return items
+}
+
+func listItems(v any, path, typ string) []item {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		items := make([]item, 0, len(v))
+		for i, x := range v {
+			items = append(items, item{value: x, path: fmt.Sprintf("%s[%d]", path, i), typ: typ})
+		}
+		return items
+	}
+	return []item{{value: v, path: path, typ: typ}}
+}
+
+// validateCollection checks the values of an element: their number, the
+// slices they fall into, and each value. loc names the element in messages.
+func (iv *instanceValidator) validateCollection(p *profile, n *elementNode, items []item, loc string) {
+	def := n.def
+	if iv.invariantsOnly {
+		for _, it := range items {
+			iv.validateNode(p, n, it)
+		}
+		return
+	}
+	if def.SliceName != "" {
+		loc += ":" + def.SliceName
+	}
+	if len(items) < def.Min {
+		if def.Min == 1 {
+			iv.errs.issuef(SeverityError, IssueRequired, ruleCardinality, loc, "required field is missing")
+		} else {
+			iv.errs.issuef(SeverityError, IssueRequired, ruleCardinality, loc, "requires at least %d element(s), got %d", def.Min, len(items))
+		}
+	}
+	if limit := def.maxCount(); limit >= 0 && len(items) > limit {
+		iv.errs.issuef(SeverityError, IssueStructure, ruleCardinality, loc, "requires at most %d element(s), got %d", limit, len(items))
+	}
+	if len(items) == 0 {
+		iv.missingMustSupport(n, loc)
+		return
+	}
+	if len(n.slices) == 0 {
+		for _, it := range items {
+			iv.validateNode(p, n, it)
+		}
+		return
+	}
+	iv.validateSlices(p, n, items, strings.TrimSuffix(loc, ":"+def.SliceName))
+}
+
+// missingMustSupport records n and its slices when they are mustSupport
+// and being collected.
+func (iv *instanceValidator) missingMustSupport(n *elementNode, loc string) {
+	if iv.mustSupport == nil {
+		return
+	}
+	if n.def.MustSupport {
+		*iv.mustSupport = append(*iv.mustSupport, loc)
+	}
+	base := strings.TrimSuffix(loc, ":"+n.def.SliceName)
+	for _, s := range n.slices {
+		iv.missingMustSupport(s, base+":"+s.def.SliceName)
+	}
+}
+
+// validateSlices assigns values to the slices of n, checks the slicing
+// rules and validates each value against its slice, or against n when it
+// is in none.
+func (iv *instanceValidator) validateSlices(p *profile, n *elementNode, items []item, loc string) {
+	rules, ordered := "open", false
+	if s := slicingOf(n); s != nil {
+		if s.Rules != "" {
+			rules = s.Rules
+		}
+		ordered = s.Ordered
+	}
+
+	groups := make([][]item, len(n.slices))
+	last, unmatched := -1, false
+	for _, it := range items {
+		si := -1
+		for i, s := range n.slices {
+			if iv.inSlice(p, n, s, it) {
+				si = i
+				break
+			}
+		}
+		switch {
+		case si < 0 && rules == "closed":
+			iv.errs.issuef(SeverityError, IssueStructure, ruleSlicing, it.path, "matches no slice of %s, and the slicing is closed", loc)
+		case si >= 0 && rules == "openAtEnd" && unmatched:
+			iv.errs.issuef(SeverityError, IssueStructure, ruleSlicing, it.path, "matches slice %s after values that match no slice, but only the end of %s is open", n.slices[si].def.SliceName, loc)
+		case si >= 0 && ordered && si < last:
+			iv.errs.issuef(SeverityError, IssueStructure, ruleSlicing, it.path, "slice %s is out of order", n.slices[si].def.SliceName)
+		}
+		if si < 0 {
+			unmatched = true
+			iv.validateNode(p, n, it)
+			continue
+		}
+		last = max(last, si)
+		groups[si] = append(groups[si], it)
+	}
+	for i, s := range n.slices {
+		iv.validateCollection(p, s, groups[i], loc)
+	}
+}
+
+// slicingOf returns the slicing of n; a reslice without its own slicing
+// shares that of the element it slices.
+func slicingOf(n *elementNode) *slicing {
+	for ; n != nil; n = n.sliceOf {
+		if n.def.Slicing != nil {
+			return n.def.Slicing
+		}
+	}
+	return nil
+}
+
+// checkValue checks the fixed value, pattern, type and type profiles of an
+// element against one value.
+func (iv *instanceValidator) checkValue(p *profile, def *elementDefinition, it item) {
+	if def.Fixed != nil && !jsonEqual(def.Fixed, it.value) {
+		iv.errs.issuef(SeverityError, IssueValue, ruleFixed, it.path, "value must be exactly %s", jsonString(def.Fixed))
+	}
+	if def.Pattern != nil && !matchesPattern(def.Pattern, it.value) {
+		iv.errs.issuef(SeverityError, IssueValue, rulePattern, it.path, "value does not match the pattern %s", jsonString(def.Pattern))
+	}
+
+	var t elementType
+	switch {
+	case it.typ != "" && len(def.Types) > 0:
+		var ok bool
+		if t, ok = def.typeFor(it.typ); !ok {
+			iv.errs.issuef(SeverityError, IssueStructure, ruleType, it.path, "type %s is not allowed here; expected %s", it.typ, strings.Join(def.typeCodes(), " or "))
+			return
+		}
+	case len(def.Types) == 1:
+		t = def.Types[0]
+	}
+	switch {
+	case t.Code == "Extension":
+		// Extensions are checked against their own definitions by
+		// checkExtensions
+	case t.Code == "Reference" && len(t.TargetProfile) > 0:
+		iv.checkReference(p, t, it)
+	case len(t.Profile) > 0:
+		iv.checkProfiles(p, t.Profile, it, str(asMap(it.value), "resourceType") != "")
+	}
+}
+
+// checkReference checks that a reference points to one of the target
+// types. A contained target must also conform to one of the target
+// profiles for its type.
+func (iv *instanceValidator) checkReference(p *profile, t elementType, it item) {
+	o, _ := it.value.(map[string]any)
+	ref := str(o, "reference")
+	typ := str(o, "type")
+	var target map[string]any
+	if id, ok := strings.CutPrefix(ref, "#"); ok && ref != "" {
+		target = iv.contained(id)
+		if target == nil {
+			iv.errs.issuef(SeverityError, IssueNotFound, ruleReference, it.path, "contained resource %s not found", ref)
+			return
+		}
+		typ = str(target, "resourceType")
+	} else if typ == "" {
+		typ = referenceType(ref)
+	}
+	if typ == "" {
+		return
+	}
+
+	var allowed, profiles []string
+	for _, tp := range t.TargetProfile {
+		rt := iv.set.resourceType(tp, p.sd.FHIRVersion)
+		allowed = append(allowed, rt)
+		if rt == typ && !strings.HasPrefix(tp, coreCanonical) {
+			profiles = append(profiles, tp)
+		}
+		if rt == typ || rt == "Resource" {
+			typ = ""
+		}
+	}
+	if typ != "" {
+		iv.errs.issuef(SeverityError, IssueStructure, ruleReference, it.path, "reference to a %s is not allowed here; expected %s", typ, strings.Join(allowed, " or "))
+		return
+	}
+	if target != nil && len(profiles) > 0 {
+		iv.checkProfiles(p, profiles, item{value: target, path: it.path + ".resolve()"}, true)
+	}
+}
+
+// contained returns the contained resource of the root with the given id.
+func (iv *instanceValidator) contained(id string) map[string]any {
+	for _, r := range objects(iv.root, "contained") {
+		if str(r, "id") == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// referenceType returns the resource type of a literal reference such as
+// Patient/123 or https://example.org/fhir/Patient/123/_history/2.
+func referenceType(ref string) string {
+	ref, _, _ = strings.Cut(ref, "/_history/")
+	parts := strings.Split(ref, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	typ := parts[len(parts)-2]
+	if typ == "" || typ[0] < 'A' || typ[0] > 'Z' {
+		return ""
+	}
+	return typ
+}
+
+// checkProfiles checks that a value conforms to at least one of profiles.
+// Profiles missing from the registry are skipped. When none conforms, the
+// errors found against the first are reported.
+func (iv *instanceValidator) checkProfiles(p *profile, profiles []string, it item, resource bool) {
+	var first *Errors
+	for _, url := range profiles {
+		tp, err := iv.set.get(url, p.sd.FHIRVersion)
+		if errors.Is(err, errProfileNotFound) {
+			continue
+		}
+		if err != nil {
+			iv.errs.issuef(SeverityError, IssueProcessing, ruleProfile, it.path, "profile %s: %v", url, err)
+			return
+		}
+		errs := iv.check(tp, it, resource)
+		if !errs.HasErrors() {
+			return
+		}
+		if first == nil {
+			first = errs
+		}
+	}
+	if first != nil {
+		iv.errs.errors = append(iv.errs.errors, first.errors...)
+	}
+}
+
+// check validates a value against a profile without touching the
+// validator's own errors.
+func (iv *instanceValidator) check(p *profile, it item, resource bool) *Errors {
+	errs := &Errors{}
+	if iv.depth >= maxProfileDepth {
+		return errs
+	}
+	sub := &instanceValidator{set: iv.set, errs: errs, root: iv.root, terminology: iv.terminology, depth: iv.depth + 1}
+	o, ok := it.value.(map[string]any)
+	switch {
+	case resource && ok:
+		sub.root = o
+		sub.validate(p, o, it.path)
+	case ok:
+		sub.validateNode(p, p.root, it)
+		sub.checkExtensions(p, o, it.path, p.root.def.Path, "")
+	default:
+		sub.validateNode(p, p.root, it)
+	}
+	return errs
+}
+
+// conforms reports whether a value meets an element of p, slices included.
+func (iv *instanceValidator) conforms(p *profile, n *elementNode, it item) bool {
+	if iv.depth >= maxProfileDepth {
+		return false
+	}
+	sub := &instanceValidator{set: iv.set, errs: &Errors{}, root: iv.root, terminology: iv.terminology, depth: iv.depth + 1}
+	sub.validateNode(p, n, it)
+	return !sub.errs.HasErrors()
+}
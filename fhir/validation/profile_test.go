@@ -0,0 +1,341 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+const (
+	bdPatient       = "https://fhir.example.org/StructureDefinition/bd-patient"
+	rohingyaPatient = "https://fhir.example.org/StructureDefinition/rohingya-patient"
+	bpObservation   = "https://fhir.example.org/StructureDefinition/bp"
+	panel           = "https://fhir.example.org/StructureDefinition/panel"
+)
+
+func newProfileValidator(t *testing.T) *FHIRValidator {
+	t.Helper()
+	fv := NewFHIRValidator()
+	fv.SetRegistry(testRegistry(t))
+	return fv
+}
+
+// errorList renders the errors of a validation as "field: message".
+func errorList(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var errs *Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is %T, want *Errors: %v", err, err)
+	}
+	var out []string
+	for _, e := range errs.List() {
+		out = append(out, e.Error())
+	}
+	return out
+}
+
+// checkErrors compares errors against wanted substrings, one per error.
+func checkErrors(t *testing.T, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d error(s), want %d:\n  %s", len(got), len(want), strings.Join(got, "\n  "))
+	}
+	for i := range want {
+		if !strings.Contains(got[i], want[i]) {
+			t.Errorf("error %d = %q, want it to contain %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestValidateProfilePatient(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	tests := []struct {
+		name    string
+		profile string
+		json    string
+		want    []string
+	}{
+		{
+			name:    "valid",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient",
+				"extension":[{"url":"https://fhir.example.org/StructureDefinition/shelter","valueString":"Camp 4"}],
+				"identifier":[{"system":"http://example.org/nid","value":"1234567890"},{"system":"http://example.org/other","value":"x"}],
+				"name":[{"family":"Rahman"}],
+				"deceasedBoolean":false,
+				"maritalStatus":{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/v3-MaritalStatus","code":"M"}]},
+				"generalPractitioner":[{"reference":"Practitioner/1"}]}`,
+		},
+		{
+			name:    "missing required elements",
+			profile: bdPatient,
+			json:    `{"resourceType":"Patient"}`,
+			want: []string{
+				"Patient.identifier: required field is missing",
+				"Patient.name: required field is missing",
+			},
+		},
+		{
+			name:    "slice cardinality and content",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],
+				"identifier":[{"system":"http://example.org/nid","value":"1"},{"system":"http://example.org/nid"}]}`,
+			want: []string{
+				"Patient.identifier:NID: requires at most 1 element(s), got 2",
+				"Patient.identifier[1].value: required field is missing",
+			},
+		},
+		{
+			name:    "prohibited element",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"managingOrganization":{"reference":"Organization/1"}}`,
+			want: []string{"Patient.managingOrganization: requires at most 0 element(s), got 1"},
+		},
+		{
+			name:    "choice type not allowed",
+			profile: bdPatient,
+			json:    `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],"deceasedDateTime":"2020-01-01"}`,
+			want:    []string{"Patient.deceasedDateTime: type DateTime is not allowed here; expected boolean"},
+		},
+		{
+			name:    "pattern",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"maritalStatus":{"coding":[{"system":"http://example.org/status","code":"M"}]}}`,
+			want: []string{"Patient.maritalStatus: value does not match the pattern"},
+		},
+		{
+			name:    "reference target",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"generalPractitioner":[{"reference":"Organization/1"}]}`,
+			want: []string{"Patient.generalPractitioner[0]: reference to a Organization is not allowed here; expected Practitioner"},
+		},
+		{
+			name:    "contained reference not found",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"generalPractitioner":[{"reference":"#gp"}]}`,
+			want: []string{"Patient.generalPractitioner[0]: contained resource #gp not found"},
+		},
+		{
+			name:    "wrong resource type",
+			profile: bdPatient,
+			json:    `{"resourceType":"Practitioner"}`,
+			want:    []string{"is a Practitioner, but profile " + bdPatient + " is for Patient"},
+		},
+		{
+			name:    "extension value type",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"extension":[{"url":"https://fhir.example.org/StructureDefinition/shelter","valueInteger":4}]}`,
+			want: []string{"Patient.extension[0].valueInteger: type Integer is not allowed here; expected string"},
+		},
+		{
+			name:    "extension context",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"extension":[{"url":"https://fhir.example.org/StructureDefinition/block","valueString":"B"}]}`,
+			want: []string{"Patient.extension[0]: extension https://fhir.example.org/StructureDefinition/block is not allowed on Patient; its context is Address"},
+		},
+		{
+			name:    "extension in its context",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"address":[{"extension":[{"url":"https://fhir.example.org/StructureDefinition/block","valueString":"B"}]}]}`,
+		},
+		{
+			name:    "complex extension",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"extension":[{"url":"https://fhir.example.org/StructureDefinition/household",
+					"extension":[{"url":"head","valueString":"Karim"},{"url":"members","valueInteger":5}]}]}`,
+			want: []string{
+				"Patient.extension[0].extension[1]: matches no slice of Patient.extension[0].extension, and the slicing is closed",
+				"Patient.extension[0].extension:size: required field is missing",
+			},
+		},
+		{
+			name:    "unknown extensions are skipped",
+			profile: bdPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}],
+				"extension":[{"url":"https://example.org/unknown","valueCode":"x"}]}`,
+		},
+		{
+			name:    "profile chain",
+			profile: rohingyaPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Begum"}],
+				"identifier":[{"system":"http://example.org/nid","value":"1"}],
+				"address":[{"district":"Cox's Bazar","country":"MM"}]}`,
+			want: []string{
+				"Patient.identifier:NID: requires at most 0 element(s), got 1",
+				"Patient.identifier:FCN: required field is missing",
+				`Patient.address[0].country: value must be exactly "BD"`,
+			},
+		},
+		{
+			name:    "data type profile",
+			profile: rohingyaPatient,
+			json: `{"resourceType":"Patient","name":[{"family":"Begum"}],
+				"identifier":[{"system":"http://example.org/fcn","value":"1"}],
+				"address":[{"country":"BD"}]}`,
+			want: []string{"Patient.address[0].district: required field is missing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fv.ValidateProfile([]byte(tt.json), tt.profile)
+			checkErrors(t, errorList(t, err), tt.want)
+		})
+	}
+}
+
+func TestValidateProfileObservation(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	const (
+		vitalSigns = `"category":[{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/observation-category","code":"vital-signs"}]}]`
+		bpCode     = `"code":{"coding":[{"system":"http://loinc.org","code":"85354-9"}]}`
+		systolic   = `{"code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]},"valueQuantity":{"value":120,"system":"http://unitsofmeasure.org","code":"mm[Hg]"}}`
+		diastolic  = `{"code":{"coding":[{"system":"http://loinc.org","code":"8462-4"}]},"valueQuantity":{"value":80,"system":"http://unitsofmeasure.org","code":"mm[Hg]"}}`
+	)
+
+	tests := []struct {
+		name    string
+		profile string
+		json    string
+		want    []string
+	}{
+		{
+			name:    "valid",
+			profile: bpObservation,
+			json: `{"resourceType":"Observation","status":"final",` + vitalSigns + `,` + bpCode + `,
+				"subject":{"reference":"Patient/1"},"component":[` + systolic + `,` + diastolic + `]}`,
+		},
+		{
+			name:    "slices out of order",
+			profile: bpObservation,
+			json: `{"resourceType":"Observation","status":"final",` + vitalSigns + `,` + bpCode + `,
+				"subject":{"reference":"Patient/1"},"component":[` + diastolic + `,` + systolic + `]}`,
+			want: []string{"Observation.component[1]: slice systolic is out of order"},
+		},
+		{
+			name:    "closed slicing",
+			profile: bpObservation,
+			json: `{"resourceType":"Observation","status":"final",` + vitalSigns + `,` + bpCode + `,
+				"subject":{"reference":"Patient/1"},"component":[` + systolic + `,` + diastolic + `,
+				{"code":{"coding":[{"system":"http://loinc.org","code":"8867-4"}]},"valueQuantity":{"value":70}}]}`,
+			want: []string{"Observation.component[2]: matches no slice of Observation.component, and the slicing is closed"},
+		},
+		{
+			name:    "missing slices and pattern",
+			profile: bpObservation,
+			json: `{"resourceType":"Observation","status":"final","category":[{"text":"vitals"}],
+				"code":{"coding":[{"system":"http://loinc.org","code":"1234-5"}]},
+				"subject":{"reference":"Patient/1"},"valueString":"high",
+				"component":[{"code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]},"valueQuantity":{"value":120,"unit":"mmHg"}}]}`,
+			want: []string{
+				"Observation.category:VSCat: required field is missing",
+				"Observation.code: value does not match the pattern",
+				"Observation.value[x]: requires at most 0 element(s), got 1",
+				"Observation.component: requires at least 2 element(s), got 1",
+				"Observation.component[0].valueQuantity: value does not match the pattern",
+				"Observation.component:diastolic: required field is missing",
+			},
+		},
+		{
+			name:    "choice slices by type",
+			profile: panel,
+			json:    `{"resourceType":"Observation","status":"final","code":{"text":"x"},"valueBoolean":true}`,
+			want:    []string{"Observation.valueBoolean: matches no slice of Observation.value[x], and the slicing is closed"},
+		},
+		{
+			name:    "openAtEnd",
+			profile: panel,
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"x"},
+				"component":[{"code":{"text":"a"},"valueString":"1"},{"code":{"text":"b"}},{"code":{"text":"c"},"valueString":"3"}]}`,
+			want: []string{"Observation.component[2]: matches slice measured after values that match no slice, but only the end of Observation.component is open"},
+		},
+		{
+			name:    "profile discriminator and contained target",
+			profile: panel,
+			json: `{"resourceType":"Observation","status":"final","code":{"text":"x"},
+				"contained":[{"resourceType":"Patient","id":"p","name":[{"family":"Rahman"}],"identifier":[{"value":"1"}]}],
+				"subject":{"reference":"#p"},"valueQuantity":{"value":1}}`,
+		},
+		{
+			name:    "contained resource checked against its target profile",
+			profile: bpObservation,
+			json: `{"resourceType":"Observation","status":"final",` + vitalSigns + `,` + bpCode + `,
+				"contained":[{"resourceType":"Patient","id":"p"}],
+				"subject":{"reference":"#p"},"component":[` + systolic + `,` + diastolic + `]}`,
+			want: []string{
+				"Observation.subject.resolve().identifier: required field is missing",
+				"Observation.subject.resolve().name: required field is missing",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fv.ValidateProfile([]byte(tt.json), tt.profile)
+			checkErrors(t, errorList(t, err), tt.want)
+		})
+	}
+}
+
+func TestMissingMustSupport(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	missing, err := fv.MissingMustSupport([]byte(`{"resourceType":"Patient","name":[{"family":"Rahman"}],"gender":"female",
+		"identifier":[{"system":"http://example.org/brn","value":"1"}]}`), bdPatient)
+	if err != nil {
+		t.Fatalf("MissingMustSupport() error = %v", err)
+	}
+	want := []string{"Patient.extension:shelter", "Patient.identifier:NID", "Patient.birthDate"}
+	if strings.Join(missing, ",") != strings.Join(want, ",") {
+		t.Errorf("MissingMustSupport() = %v, want %v", missing, want)
+	}
+}
+
+func TestValidateProfileErrors(t *testing.T) {
+	if err := NewFHIRValidator().ValidateProfile(&r4.Patient{}, bdPatient); err == nil {
+		t.Errorf("ValidateProfile() without a registry: error = nil")
+	}
+	fv := newProfileValidator(t)
+	if err := fv.ValidateProfile(&r4.Patient{}, "https://example.org/StructureDefinition/missing"); !errors.Is(err, errProfileNotFound) {
+		t.Errorf("ValidateProfile() with an unknown profile: error = %v", err)
+	}
+}
+
+func TestValidateClaimedProfile(t *testing.T) {
+	fv := newProfileValidator(t)
+	family := "Rahman"
+
+	patient := &r4.Patient{Name: []r4.HumanName{{Family: &family}}}
+	patient.Meta = &r4.Meta{Profile: []primitives.Canonical{bdPatient}}
+	err := fv.Validate(patient)
+	checkErrors(t, errorList(t, err), []string{"Patient.identifier: required field is missing"})
+
+	system, value := "http://example.org/nid", "1234567890"
+	patient.Identifier = []r4.Identifier{{System: &system, Value: &value}}
+	if err := fv.Validate(patient); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	// Profiles the registry doesn't hold are not checked
+	patient.Meta.Profile = []primitives.Canonical{"https://example.org/StructureDefinition/unknown"}
+	patient.Identifier = nil
+	if err := fv.Validate(patient); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
@@ -0,0 +1,519 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// jsonChecker checks the JSON of a resource as written, before it is
+// decoded, against the core StructureDefinitions of the registry: the
+// properties that aren't elements, which decoding into a struct drops, and
+// the rules of the JSON format that decoding can't see.
+type jsonChecker struct {
+	fv   *FHIRValidator
+	errs *Errors
+	// fhirVersion is the release of the definitions, taken from the
+	// definition of the root resource.
+	fhirVersion string
+}
+
+// ValidateJSON validates the JSON of a resource before it is unmarshalled,
+// and then its content against the profiles it claims and the core
+// definition of its type, when the registry holds them. It doesn't run the
+// struct tag checks of Validate, which need a decoded resource. It reports
+// properties that are not elements of their type, such as misspelled ones,
+// and what the FHIR JSON format rules out: duplicate properties, null
+// values, empty strings and arrays, values of the wrong JSON type, arrays
+// where an element repeats at most once and the reverse, and _element
+// properties whose arrays don't line up with the values they extend.
+// Properties are checked against the StructureDefinition of their type when
+// the registry holds it, and otherwise against the JSON names of the
+// generated R5 type, or the R4 one for a resource R5 doesn't have. Every
+// issue carries its line and column; see Check for all of them.
+func (fv *FHIRValidator) ValidateJSON(data []byte) error {
+	errs := fv.checkJSON(data)
+	if errs.HasErrors() {
+		return errs.errorsOnly()
+	}
+	return nil
+}
+
+// checkJSON checks the JSON of a resource as written, and then its content
+// against the definitions in the registry, and locates the issues found.
+func (fv *FHIRValidator) checkJSON(data []byte) *Errors {
+	errs := &Errors{}
+	doc, err := parseJSON(data)
+	if err != nil {
+		var syntax *jsonSyntaxError
+		if errors.As(err, &syntax) {
+			e := errs.issuef(SeverityFatal, IssueStructure, ruleJSON, "", "%s", syntax.msg)
+			e.Line, e.Column = syntax.pos.line, syntax.pos.column
+		}
+		return errs
+	}
+	c := &jsonChecker{fv: fv, errs: errs}
+	if !c.document(doc) {
+		return errs
+	}
+
+	m, err := decodeObject(data)
+	if err != nil {
+		errs.issuef(SeverityFatal, IssueStructure, ruleJSON, "", "%v", err)
+		return errs
+	}
+	from := len(errs.errors)
+	errs.errors = append(errs.errors, fv.checkObject(m).errors...)
+	errs.locate(from, doc.positions())
+	return errs
+}
+
+// document checks the root of a document, which must be a resource. It
+// reports whether the resource could be read.
+func (c *jsonChecker) document(doc *jsonValue) bool {
+	if doc.kind != kindObject {
+		c.issue(SeverityFatal, IssueStructure, ruleJSON, "", doc.pos, "a resource must be a JSON object, not %s", doc.kind)
+		return false
+	}
+	rt := doc.member("resourceType")
+	if rt == nil || rt.kind != kindString || rt.scalar == "" {
+		c.issue(SeverityFatal, IssueRequired, ruleJSON, "", doc.pos, "resourceType is missing")
+		return false
+	}
+	c.resource(doc, rt.scalar.(string))
+	return true
+}
+
+func (c *jsonChecker) issue(severity Severity, code IssueType, key, path string, pos position, format string, args ...any) {
+	e := c.errs.issuef(severity, code, key, path, format, args...)
+	e.Expression = path
+	e.Line, e.Column = pos.line, pos.column
+}
+
+// definition returns the core definition of a type, or nil if the
+// registry doesn't hold it.
+func (c *jsonChecker) definition(typ string) *profile {
+	if c.fv.profiles == nil {
+		return nil
+	}
+	p, err := c.fv.profiles.get(coreCanonical+typ, c.fhirVersion)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+// resource checks a resource, at path; the root is at its type name.
+func (c *jsonChecker) resource(o *jsonValue, path string) {
+	rt := o.member("resourceType")
+	if rt == nil || rt.kind != kindString || rt.scalar == "" {
+		c.issue(SeverityError, IssueRequired, ruleJSON, path, o.pos, "resourceType is missing")
+		c.object(nil, nil, o, path)
+		return
+	}
+	typ := rt.scalar.(string)
+	p := c.definition(typ)
+	if p == nil {
+		t := goResourceType(typ, c.fhirVersion)
+		if c.fv.profiles != nil {
+			checked := "its elements are not checked"
+			if t != nil {
+				checked = "its properties are checked against its Go type"
+			}
+			c.issue(SeverityWarning, IssueNotFound, ruleUnknownElement, path+".resourceType", o.pos,
+				"no StructureDefinition for %s; %s", typ, checked)
+		}
+		if t != nil {
+			c.goObject(t, o, path)
+			return
+		}
+	}
+	if p != nil && c.fhirVersion == "" {
+		c.fhirVersion = p.sd.FHIRVersion
+	}
+	if p != nil && p.sd.Kind != "resource" {
+		c.issue(SeverityError, IssueStructure, ruleJSON, path+".resourceType", o.pos, "%s is not a resource type", typ)
+		p = nil
+	}
+	if p == nil {
+		c.object(nil, nil, o, path)
+		return
+	}
+	c.object(p, p.root, o, path)
+}
+
+// object checks the properties of an object against the children of
+// element n of p. When p is nil the object's type is unknown, and only the
+// rules of the JSON format are checked.
+func (c *jsonChecker) object(p *profile, n *elementNode, o *jsonValue, path string) {
+	seen := make(map[string]bool, len(o.members))
+	for _, m := range o.members {
+		child := path + "." + m.name
+		if seen[m.name] {
+			c.issue(SeverityError, IssueStructure, ruleJSON, child, m.pos, "property %s appears more than once", m.name)
+			continue
+		}
+		seen[m.name] = true
+		if m.name == "resourceType" && p != nil && n == p.root && p.sd.Kind == "resource" {
+			continue
+		}
+
+		name, extension := strings.CutPrefix(m.name, "_")
+		// The values a property's nulls pair up with
+		partner := o.member("_" + m.name)
+		if extension {
+			partner = o.member(name)
+		}
+
+		if p == nil {
+			c.values(nil, nil, "", m.value, child, m.pos, partner)
+			continue
+		}
+		def, typ, ok := c.element(p, n, name)
+		if !ok {
+			c.issue(SeverityError, IssueStructure, ruleUnknownElement, child, m.pos, "is not a known element of %s", n.def.Path)
+			continue
+		}
+		if extension {
+			if !isPrimitiveType(typ) {
+				c.issue(SeverityError, IssueStructure, ruleUnknownElement, child, m.pos, "%s is a %s, and only primitive elements have a _%s", name, typ, name)
+				continue
+			}
+			c.primitiveExtensions(repeats(def), m, partner, child)
+			continue
+		}
+		c.values(p, def, typ, m.value, child, m.pos, partner)
+	}
+}
+
+// element returns the child element of n that a property name stands for,
+// and its type. A choice element, such as value[x], stands for a property
+// per type, such as valueQuantity.
+func (c *jsonChecker) element(p *profile, n *elementNode, name string) (*elementNode, string, bool) {
+	for _, child := range p.children(n) {
+		def := child.def
+		if !def.isChoice() {
+			if def.name() != name {
+				continue
+			}
+			typ := ""
+			if len(def.Types) > 0 {
+				typ = def.Types[0].fhirCode()
+			}
+			// The id of a resource is an id, though its definition may give
+			// the system type of Element.id
+			if name == "id" && n == p.root && p.sd.Kind == "resource" {
+				typ = "id"
+			}
+			return child, typ, true
+		}
+		if _, suffix, ok := cutChoice(name, strings.TrimSuffix(def.name(), "[x]")); ok {
+			if t, ok := def.typeFor(suffix); ok {
+				return child, t.fhirCode(), true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// values checks the value of a property: an array if the element repeats,
+// else a single value. partner is the property with the other half of
+// primitive values, given and _given, whose nulls line up with its values.
+func (c *jsonChecker) values(p *profile, n *elementNode, typ string, v *jsonValue, path string, pos position, partner *jsonValue) {
+	if n != nil && !c.shape(repeats(n), v, path, pos) {
+		return
+	}
+	c.each(v, path, pos, partner, func(item *jsonValue, path string) {
+		c.value(p, n, typ, item, path)
+	})
+}
+
+// repeats reports whether element n may have more than one value.
+func repeats(n *elementNode) bool {
+	return n.def.Max != "0" && n.def.Max != "1"
+}
+
+// shape checks that v is an array if the element repeats, and isn't one if
+// it doesn't.
+func (c *jsonChecker) shape(repeats bool, v *jsonValue, path string, pos position) bool {
+	switch {
+	case repeats && v.kind != kindArray:
+		c.issue(SeverityError, IssueStructure, ruleJSON, path, pos, "must be an array, as the element repeats")
+		return false
+	case !repeats && v.kind == kindArray:
+		c.issue(SeverityError, IssueStructure, ruleJSON, path, pos, "must not be an array, as the element doesn't repeat")
+		return false
+	}
+	return true
+}
+
+// each calls check with v, or with each item of v if it is an array, after
+// reporting nulls and empty arrays. A null item is allowed where partner
+// has a value at the same index.
+func (c *jsonChecker) each(v *jsonValue, path string, pos position, partner *jsonValue, check func(v *jsonValue, path string)) {
+	if v.kind != kindArray {
+		if v.kind == kindNull {
+			c.issue(SeverityError, IssueStructure, ruleJSON, path, pos, "null values are not allowed")
+			return
+		}
+		check(v, path)
+		return
+	}
+	if len(v.items) == 0 {
+		c.issue(SeverityError, IssueStructure, ruleJSON, path, pos, "empty arrays are not allowed")
+		return
+	}
+	for i, item := range v.items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if item.kind == kindNull {
+			if !partner.hasItem(i) {
+				c.issue(SeverityError, IssueStructure, ruleJSON, itemPath, item.pos, "null is only allowed where %s[%d] has a value", partnerName(path), i)
+			}
+			continue
+		}
+		check(item, itemPath)
+	}
+}
+
+// partnerName returns the property that the values of the property at path
+// line up with: _given for given, and given for _given.
+func partnerName(path string) string {
+	name := path[strings.LastIndex(path, ".")+1:]
+	if base, ok := strings.CutPrefix(name, "_"); ok {
+		return base
+	}
+	return "_" + name
+}
+
+// hasItem reports whether v is an array with a value other than null at
+// index i.
+func (v *jsonValue) hasItem(i int) bool {
+	return v != nil && v.kind == kindArray && i < len(v.items) && v.items[i].kind != kindNull
+}
+
+// value checks one value of element n, of type typ.
+func (c *jsonChecker) value(p *profile, n *elementNode, typ string, v *jsonValue, path string) {
+	if v.kind == kindString && v.scalar == "" {
+		c.issue(SeverityError, IssueValue, ruleJSON, path, v.pos, "empty strings are not allowed")
+		return
+	}
+	if n == nil {
+		if v.kind == kindObject {
+			c.object(nil, nil, v, path)
+		} else if v.kind == kindArray {
+			c.values(nil, nil, "", v, path, v.pos, nil)
+		}
+		return
+	}
+
+	if isPrimitiveType(typ) {
+		if want := primitiveKind(typ); v.kind != want {
+			c.issue(SeverityError, IssueStructure, ruleJSON, path, v.pos, "a %s must be a JSON %s, not %s", typ, want, v.kind)
+			return
+		}
+		if err := primitives.Validate(typ, fmt.Sprint(v.scalar)); err != nil {
+			c.issue(SeverityError, IssueValue, rulePrimitive, path, v.pos, "%v", err)
+		}
+		return
+	}
+	if v.kind != kindObject {
+		c.issue(SeverityError, IssueStructure, ruleJSON, path, v.pos, "a %s must be a JSON object, not %s", typ, v.kind)
+		return
+	}
+	if typ == "Resource" || typ == "DomainResource" {
+		c.resource(v, path)
+		return
+	}
+	if len(p.children(n)) > 0 {
+		c.object(p, n, v, path)
+		return
+	}
+	if dt := c.definition(typ); dt != nil {
+		if dt.sd.Kind == "resource" {
+			c.resource(v, path)
+		} else {
+			c.object(dt, dt.root, v, path)
+		}
+		return
+	}
+	c.object(nil, nil, v, path)
+}
+
+// primitiveExtensions checks the _element property m that holds the id and
+// extensions of the primitive values of an element, which repeats or not. Its values line up with
+// the element's, given as partner: an array of the same length if it
+// repeats, with null where a value has no id or extensions, or else a
+// single object.
+func (c *jsonChecker) primitiveExtensions(repeats bool, m jsonMember, partner *jsonValue, path string) {
+	v := m.value
+	if !c.shape(repeats, v, path, m.pos) {
+		return
+	}
+	if repeats && partner != nil && partner.kind == kindArray && len(partner.items) != len(v.items) {
+		c.issue(SeverityError, IssueStructure, ruleJSON, path, m.pos, "has %d item(s), but %s has %d", len(v.items), strings.TrimPrefix(m.name, "_"), len(partner.items))
+	}
+	c.values(nil, nil, "", v, path, m.pos, partner)
+}
+
+// isPrimitiveType reports whether a type code names a primitive type, such
+// as dateTime, or a FHIRPath system type, such as that of Element.id.
+func isPrimitiveType(typ string) bool {
+	if strings.HasPrefix(typ, "http://hl7.org/fhirpath/System.") {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(typ)
+	return unicode.IsLower(r)
+}
+
+// primitiveKind returns the JSON kind that values of a primitive type are
+// written as. integer64 is a string so that it keeps its precision.
+func primitiveKind(typ string) jsonKind {
+	switch strings.TrimPrefix(typ, "http://hl7.org/fhirpath/System.") {
+	case "boolean", "Boolean":
+		return kindBool
+	case "integer", "positiveInt", "unsignedInt", "decimal", "Integer", "Decimal":
+		return kindNumber
+	}
+	return kindString
+}
+
+// rawMessageType is the type of the fields of the generated types that hold
+// a resource of any type, such as DomainResource.contained.
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// goResourceType returns the generated struct type of a resource type: the
+// R4 one for definitions of FHIR 4, else the R5 one, or else the R4 one for
+// a resource R5 doesn't have. It returns nil for an unknown resource type.
+func goResourceType(name, fhirVersion string) reflect.Type {
+	r4Type := func() reflect.Type {
+		if r, err := r4.NewResource(name); err == nil {
+			return reflect.TypeOf(r).Elem()
+		}
+		return nil
+	}
+	if strings.HasPrefix(fhirVersion, "4.") {
+		return r4Type()
+	}
+	if r, err := r5.NewResource(name); err == nil {
+		return reflect.TypeOf(r).Elem()
+	}
+	return r4Type()
+}
+
+// goObject checks the properties of an object against the JSON names of the
+// fields of t, a generated type, when the registry has no definition for
+// it. Fields that are structs of their own, such as HumanName, are checked
+// the same way.
+func (c *jsonChecker) goObject(t reflect.Type, o *jsonValue, path string) {
+	seen := make(map[string]bool, len(o.members))
+	for _, m := range o.members {
+		child := path + "." + m.name
+		if seen[m.name] {
+			c.issue(SeverityError, IssueStructure, ruleJSON, child, m.pos, "property %s appears more than once", m.name)
+			continue
+		}
+		seen[m.name] = true
+
+		name, extension := strings.CutPrefix(m.name, "_")
+		partner := o.member("_" + m.name)
+		if extension {
+			partner = o.member(name)
+		}
+
+		field, ok := goField(t, name)
+		if !ok || (name == "resourceType" && extension) {
+			c.issue(SeverityError, IssueStructure, ruleUnknownElement, child, m.pos, "is not a known element of %s", t.Name())
+			continue
+		}
+		repeats, elem := goElem(field.Type)
+		if extension {
+			if goComplex(elem) || elem == rawMessageType {
+				c.issue(SeverityError, IssueStructure, ruleUnknownElement, child, m.pos, "%s is a %s, and only primitive elements have a _%s", name, elem.Name(), name)
+				continue
+			}
+			c.primitiveExtensions(repeats, m, partner, child)
+			continue
+		}
+		if name == "resourceType" {
+			continue
+		}
+		if !c.shape(repeats, m.value, child, m.pos) {
+			continue
+		}
+		c.each(m.value, child, m.pos, partner, func(v *jsonValue, path string) {
+			c.goValue(elem, v, path)
+		})
+	}
+}
+
+// goValue checks one value of a field whose values are of type t.
+func (c *jsonChecker) goValue(t reflect.Type, v *jsonValue, path string) {
+	if v.kind == kindString && v.scalar == "" {
+		c.issue(SeverityError, IssueValue, ruleJSON, path, v.pos, "empty strings are not allowed")
+		return
+	}
+	switch {
+	case t == rawMessageType:
+		if v.kind != kindObject {
+			c.issue(SeverityError, IssueStructure, ruleJSON, path, v.pos, "a Resource must be a JSON object, not %s", v.kind)
+			return
+		}
+		c.resource(v, path)
+	case goComplex(t):
+		if v.kind != kindObject {
+			c.issue(SeverityError, IssueStructure, ruleJSON, path, v.pos, "a %s must be a JSON object, not %s", t.Name(), v.kind)
+			return
+		}
+		c.goObject(t, v, path)
+	default:
+		c.value(nil, nil, "", v, path)
+	}
+}
+
+// goField returns the field of t, or of a struct it embeds, whose JSON name
+// is name.
+func goField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if f, ok := goField(field.Type, name); ok {
+				return f, true
+			}
+			continue
+		}
+		if jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ","); jsonName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// goElem returns whether a field of type t repeats, and the type of its
+// values.
+func goElem(t reflect.Type) (bool, reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	repeats := t.Kind() == reflect.Slice && t != rawMessageType
+	if repeats {
+		t = t.Elem()
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	return repeats, t
+}
+
+// goComplex reports whether values of type t are JSON objects with elements
+// of their own, rather than primitives such as string and primitives.Date.
+func goComplex(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() != reflect.TypeOf(primitives.Date{}).PkgPath()
+}
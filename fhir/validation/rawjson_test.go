@@ -0,0 +1,190 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateJSON(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	tests := []struct {
+		name string
+		json string
+		want []string // Expression: message, at line:column
+	}{
+		{
+			name: "valid",
+			json: `{"resourceType":"Patient","active":true,"name":[{"family":"Rahman","given":["Ayesha","Begum"]}],
+				"deceasedBoolean":false,"contained":[{"resourceType":"Observation","status":"final","code":{"text":"weight"}}]}`,
+		},
+		{
+			name: "unknown elements",
+			json: `{"resourceType":"Patient",
+"gendr":"female",
+"name":[{"family":"Rahman","giver":["Ayesha"]}],
+"deceasedString":"yes"}`,
+			want: []string{
+				"Patient.gendr: is not a known element of Patient at 2:1",
+				"Patient.name[0].giver: is not a known element of HumanName at 3:28",
+				"Patient.deceasedString: is not a known element of Patient at 4:1",
+			},
+		},
+		{
+			name: "unknown element of a contained resource",
+			json: `{"resourceType":"Patient","contained":[{"resourceType":"Observation","status":"final","statuss":"final"}]}`,
+			want: []string{"Patient.contained[0].statuss: is not a known element of Observation at 1:87"},
+		},
+		{
+			name: "duplicate property",
+			json: `{"resourceType":"Patient","gender":"female","gender":"male"}`,
+			want: []string{"Patient.gender: property gender appears more than once at 1:45"},
+		},
+		{
+			name: "null, empty string and empty array",
+			json: `{"resourceType":"Patient","gender":null,"birthDate":"","telecom":[]}`,
+			want: []string{
+				"Patient.gender: null values are not allowed at 1:27",
+				"Patient.birthDate: empty strings are not allowed at 1:53",
+				"Patient.telecom: empty arrays are not allowed at 1:56",
+			},
+		},
+		{
+			name: "arrays and single values",
+			json: `{"resourceType":"Patient","name":{"family":"Rahman"},"gender":["female"]}`,
+			want: []string{
+				"Patient.name: must be an array, as the element repeats at 1:27",
+				"Patient.gender: must not be an array, as the element doesn't repeat at 1:54",
+			},
+		},
+		{
+			name: "JSON types of primitives",
+			json: `{"resourceType":"Patient","active":"true","name":[{"family":42}]}`,
+			want: []string{
+				"Patient.active: a boolean must be a JSON boolean, not string at 1:36",
+				"Patient.name[0].family: a string must be a JSON string, not number at 1:61",
+			},
+		},
+		{
+			name: "primitive extensions",
+			json: `{"resourceType":"Patient",
+"name":[{"given":["Ayesha",null],"_given":[null,{"extension":[{"url":"http://example.org/x","valueString":"y"}]}]}],
+"_birthDate":{"id":"b1"}}`,
+		},
+		{
+			name: "mismatched primitive extensions",
+			json: `{"resourceType":"Patient",
+"name":[{"given":["Ayesha",null],"_given":[null]},{"given":["Begum"],"_given":{"id":"g"}}],
+"_name":[{"id":"n"}],
+"_gender":[{"id":"g"}]}`,
+			want: []string{
+				"Patient.name[0].given[1]: null is only allowed where _given[1] has a value at 2:28",
+				"Patient.name[0]._given: has 1 item(s), but given has 2 at 2:34",
+				"Patient.name[1]._given: must be an array, as the element repeats at 2:70",
+				"Patient._name: name is a HumanName, and only primitive elements have a _name at 3:1",
+				"Patient._gender: must not be an array, as the element doesn't repeat at 4:1",
+			},
+		},
+		{
+			name: "primitive formats",
+			json: `{"resourceType":"Patient","id":"has spaces!","birthDate":"1990-13-01",
+"extension":[{"url":"http://example.org/a b","valueString":"x"}],
+"name":[{"family":"Rahman","period":{"start":"2020-01-01T10:00"}}]}`,
+			want: []string{
+				`Patient.id: invalid FHIR id: "has spaces!" (expected 1 to 64 letters, digits, - and .) at 1:32`,
+				`Patient.birthDate: invalid FHIR date: "1990-13-01" (expected YYYY, YYYY-MM or YYYY-MM-DD) at 1:58`,
+				`Patient.extension[0].url: invalid FHIR uri: "http://example.org/a b" (expected a URI without whitespace) at 2:21`,
+				`Patient.name[0].period.start: invalid FHIR dateTime: "2020-01-01T10:00" (expected YYYY, YYYY-MM, YYYY-MM-DD or YYYY-MM-DDThh:mm:ss[.sss] with a time zone) at 3:46`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := fv.Check([]byte(tt.json))
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			var got []string
+			for _, e := range errs.List() {
+				if e.Key == ruleJSON || e.Key == ruleUnknownElement || e.Key == rulePrimitive {
+					got = append(got, fmt.Sprintf("%s: %s at %d:%d", e.Expression, e.Message, e.Line, e.Column))
+				}
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("issues:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+			if err := fv.ValidateJSON([]byte(tt.json)); (err != nil) != (len(tt.want) > 0) {
+				t.Errorf("ValidateJSON() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateJSONSyntax(t *testing.T) {
+	fv := newProfileValidator(t)
+
+	tests := []struct {
+		json         string
+		message      string
+		line, column int
+	}{
+		{"{\"resourceType\":\"Patient\",\n\"active\":tru}", "invalid character", 2, 13},
+		{`{"resourceType":"Patient"`, "unexpected end of JSON input", 1, 26},
+		{`{"resourceType":"Patient"} {}`, "unexpected data after the top-level value", 1, 28},
+		{`["Patient"]`, "a resource must be a JSON object, not array", 1, 1},
+		{`{"active":true}`, "resourceType is missing", 1, 1},
+	}
+	for _, tt := range tests {
+		errs, err := fv.Check([]byte(tt.json))
+		if err != nil {
+			t.Fatalf("Check(%s) error = %v", tt.json, err)
+		}
+		l := errs.List()
+		if len(l) != 1 || l[0].Severity != SeverityFatal || !strings.Contains(l[0].Message, tt.message) || l[0].Line != tt.line || l[0].Column != tt.column {
+			t.Errorf("Check(%s) = %v at %d:%d, want fatal %q at %d:%d", tt.json, l, l[0].Line, l[0].Column, tt.message, tt.line, tt.column)
+		}
+	}
+}
+
+func TestValidateJSONWithoutDefinition(t *testing.T) {
+	// Without a registry properties are checked against the Go types
+	fv := NewFHIRValidator()
+	errs, err := fv.Check([]byte(`{"resourceType":"Patient","gendr":"female",
+"name":[{"given":[null],"giver":["Ayesha"]}],
+"contained":[{"resourceType":"Observation","status":"final","statuss":"final"}],
+"_name":[{"id":"n"}]}`))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	var got []string
+	for _, e := range errs.List() {
+		got = append(got, fmt.Sprintf("%s: %s at %d:%d", e.Expression, e.Message, e.Line, e.Column))
+	}
+	want := []string{
+		"Patient.gendr: is not a known element of Patient at 1:27",
+		"Patient.name[0].given[0]: null is only allowed where _given[0] has a value at 2:19",
+		"Patient.name[0].giver: is not a known element of HumanName at 2:25",
+		"Patient.contained[0].statuss: is not a known element of Observation at 3:61",
+		"Patient._name: name is a HumanName, and only primitive elements have a _name at 4:1",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("issues:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// Elements only R5 has are known
+	if err := fv.ValidateJSON([]byte(`{"resourceType":"Observation","status":"final","code":{"text":"x"},"instantiatesCanonical":"http://example.org/a"}`)); err != nil {
+		t.Errorf("ValidateJSON() error = %v", err)
+	}
+
+	// A registry without the type's definition leaves a warning
+	fv = newProfileValidator(t)
+	errs, err = fv.Check([]byte(`{"resourceType":"Encounter","status":"finished"}`))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if w := errs.Warnings(); len(w) != 1 || !strings.Contains(w[0].Message, "no StructureDefinition for Encounter; its properties are checked against its Go type") || errs.HasErrors() {
+		t.Errorf("Check() = %v, want one warning", errs.List())
+	}
+}
@@ -0,0 +1,243 @@
+package validation
+
+import (
+	"strings"
+)
+
+// inSlice reports whether a value of the sliced element base belongs to
+// slice s. Each discriminator of the slicing must match; without
+// discriminators, a value belongs to the first slice it fully conforms to.
+func (iv *instanceValidator) inSlice(p *profile, base, s *elementNode, it item) bool {
+	sl := slicingOf(base)
+	if sl == nil || len(sl.Discriminators) == 0 {
+		return iv.conforms(p, s, it)
+	}
+	for _, d := range sl.Discriminators {
+		if !iv.discriminate(p, s, d, it) {
+			return false
+		}
+	}
+	return true
+}
+
+// discriminate evaluates one discriminator of slice s against a value.
+func (iv *instanceValidator) discriminate(p *profile, s *elementNode, d discriminator, it item) bool {
+	values := iv.evalPath(it, d.Path)
+	target, resolved := p.nodeAt(s, d.Path)
+
+	switch d.Type {
+	case "value", "pattern":
+		want, isPattern := discriminatorValue(target, d.Path, s)
+		if want == nil {
+			return false
+		}
+		for _, v := range values {
+			if isPattern && matchesPattern(want, v.value) || !isPattern && jsonEqual(want, v.value) {
+				return true
+			}
+		}
+		return false
+
+	case "exists":
+		if target == nil {
+			return false
+		}
+		return (len(values) > 0) == (target.def.Min > 0)
+
+	case "type":
+		if target == nil {
+			return false
+		}
+		var types []string
+		if resolved {
+			for _, t := range target.def.Types {
+				for _, tp := range t.TargetProfile {
+					types = append(types, iv.set.resourceType(tp, p.sd.FHIRVersion))
+				}
+			}
+		} else {
+			types = target.def.typeCodes()
+		}
+		for _, v := range values {
+			typ := v.typ
+			if typ == "" {
+				typ = str(asMap(v.value), "resourceType")
+			}
+			for _, t := range types {
+				if strings.EqualFold(typ, t) {
+					return true
+				}
+			}
+		}
+		return false
+
+	case "profile":
+		if target == nil {
+			return false
+		}
+		var profiles []string
+		for _, t := range target.def.Types {
+			if resolved {
+				profiles = append(profiles, t.TargetProfile...)
+			} else {
+				profiles = append(profiles, t.Profile...)
+			}
+		}
+		for _, v := range values {
+			for _, url := range profiles {
+				tp, err := iv.set.get(url, p.sd.FHIRVersion)
+				if err == nil && !iv.check(tp, v, resolved || str(asMap(v.value), "resourceType") != "").HasErrors() {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// discriminatorValue returns the fixed value or pattern a slice sets at
+// the discriminator path, and whether it is a pattern. An extension slice
+// without a fixed url is identified by the url of its extension profile.
+func discriminatorValue(target *elementNode, path string, s *elementNode) (any, bool) {
+	if target != nil {
+		if target.def.Fixed != nil {
+			return target.def.Fixed, false
+		}
+		if target.def.Pattern != nil {
+			return target.def.Pattern, true
+		}
+	}
+	if path == "url" {
+		for _, t := range s.def.Types {
+			if t.Code == "Extension" && len(t.Profile) > 0 {
+				url, _, _ := strings.Cut(t.Profile[0], "|")
+				return url, false
+			}
+		}
+	}
+	return nil, false
+}
+
+// nodeAt returns the element of a slice that a discriminator path points
+// to, and whether the path ends by resolving a reference, in which case the
+// element is the Reference.
+func (p *profile) nodeAt(s *elementNode, path string) (*elementNode, bool) {
+	n := s
+	for _, step := range splitPath(path) {
+		switch {
+		case step == "$this":
+		case step == "resolve()":
+			return n, true
+		case strings.HasPrefix(step, "ofType("):
+		case strings.HasPrefix(step, "extension("):
+			n = extensionSlice(p.child(n, "extension"), pathArg(step))
+		default:
+			n = p.child(n, step)
+		}
+		if n == nil {
+			return nil, false
+		}
+	}
+	return n, false
+}
+
+// extensionSlice returns the slice of an extension element for the
+// extension with the given url.
+func extensionSlice(ext *elementNode, url string) *elementNode {
+	if ext == nil {
+		return nil
+	}
+	for _, s := range ext.slices {
+		if v, _ := discriminatorValue(nil, "url", s); v == url {
+			return s
+		}
+		for _, c := range s.children {
+			if c.def.name() == "url" && c.def.Fixed == url {
+				return s
+			}
+		}
+	}
+	return nil
+}
+
+// evalPath returns the values a discriminator path selects from a value.
+// It supports the FHIRPath subset discriminators use: element names,
+// $this, extension(url), ofType(type) and resolve().
+func (iv *instanceValidator) evalPath(it item, path string) []item {
+	items := []item{it}
+	for _, step := range splitPath(path) {
+		var next []item
+		for _, v := range items {
+			o, _ := v.value.(map[string]any)
+			switch {
+			case step == "$this":
+				next = append(next, v)
+			case step == "resolve()":
+				ref := str(o, "reference")
+				if id, ok := strings.CutPrefix(ref, "#"); ok {
+					if r := iv.contained(id); r != nil {
+						next = append(next, item{value: r, path: v.path, typ: str(r, "resourceType")})
+					}
+				}
+			case strings.HasPrefix(step, "ofType("):
+				if strings.EqualFold(v.typ, pathArg(step)) {
+					next = append(next, v)
+				}
+			case strings.HasPrefix(step, "extension("):
+				url := pathArg(step)
+				for _, e := range objects(o, "extension") {
+					if str(e, "url") == url {
+						next = append(next, item{value: e, path: v.path + ".extension", typ: "Extension"})
+					}
+				}
+			case o != nil:
+				if x, ok := o[step]; ok {
+					for _, c := range listItems(x, v.path+"."+step, "") {
+						if r, ok := c.value.(map[string]any); ok {
+							c.typ = str(r, "resourceType")
+						}
+						next = append(next, c)
+					}
+					continue
+				}
+				for k, x := range o {
+					if _, typ, ok := cutChoice(k, step); ok {
+						next = append(next, listItems(x, v.path+"."+k, typ)...)
+					}
+				}
+			}
+		}
+		items = next
+	}
+	return items
+}
+
+// splitPath splits a discriminator path at the dots outside parentheses
+// and quotes, so extension('http://example.org/x') stays one step.
+func splitPath(path string) []string {
+	var steps []string
+	depth, quoted, start := 0, false, 0
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; {
+		case c == '\'':
+			quoted = !quoted
+		case quoted:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '.' && depth == 0:
+			steps = append(steps, path[start:i])
+			start = i + 1
+		}
+	}
+	return append(steps, path[start:])
+}
+
+// pathArg returns the argument of a step such as extension('url').
+func pathArg(step string) string {
+	arg := step[strings.IndexByte(step, '(')+1:]
+	arg = strings.TrimSuffix(arg, ")")
+	return strings.Trim(arg, "'")
+}
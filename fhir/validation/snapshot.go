@@ -0,0 +1,448 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+)
+
+// coreCanonical is the canonical URL prefix of the FHIR core definitions.
+const coreCanonical = "http://hl7.org/fhir/StructureDefinition/"
+
+// GenerateSnapshot fills in the snapshot of a StructureDefinition from its
+// differential and the snapshot of its base definition. The base, and the
+// data types whose children the differential constrains, are resolved in
+// reg; their snapshots are generated too when they have none. sd is a
+// pointer to a generated StructureDefinition, such as
+// *r4.StructureDefinition, or its decoded JSON, which is updated in place.
+func GenerateSnapshot(sd any, reg *conformance.Registry) error {
+	m, err := jsonObject(sd)
+	if err != nil {
+		return err
+	}
+	g := newSnapshotGenerator(reg, str(m, "fhirVersion"))
+	elems, err := g.generate(m)
+	if err != nil {
+		return err
+	}
+	list := make([]any, len(elems))
+	for i, e := range elems {
+		list[i] = e
+	}
+	m["snapshot"] = map[string]any{"element": list}
+	if _, ok := sd.(map[string]any); ok {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, sd)
+}
+
+// snapshotGenerator generates snapshots, resolving definitions in a
+// registry. Generated snapshots are kept so each definition is expanded once.
+type snapshotGenerator struct {
+	reg         *conformance.Registry
+	fhirVersion string
+	generated   map[string][]map[string]any
+	active      map[string]bool
+}
+
+func newSnapshotGenerator(reg *conformance.Registry, fhirVersion string) *snapshotGenerator {
+	return &snapshotGenerator{
+		reg:         reg,
+		fhirVersion: fhirVersion,
+		generated:   make(map[string][]map[string]any),
+		active:      make(map[string]bool),
+	}
+}
+
+// resolve returns the JSON of the StructureDefinition with the given
+// canonical URL.
+func (g *snapshotGenerator) resolve(url string) (map[string]any, error) {
+	if g.reg == nil {
+		return nil, fmt.Errorf("StructureDefinition %s not found: no registry", url)
+	}
+	e, ok := g.reg.ResolveFHIR("StructureDefinition", url, g.fhirVersion)
+	if !ok {
+		return nil, fmt.Errorf("StructureDefinition %s not found", url)
+	}
+	return jsonObject(e.Resource)
+}
+
+// snapshot returns the snapshot of sd, generating it if it has none.
+func (g *snapshotGenerator) snapshot(sd map[string]any) ([]map[string]any, error) {
+	if elems := objects(obj(sd, "snapshot"), "element"); len(elems) > 0 {
+		return elems, nil
+	}
+	return g.generate(sd)
+}
+
+// generate applies the differential of sd to the snapshot of its base.
+func (g *snapshotGenerator) generate(sd map[string]any) ([]map[string]any, error) {
+	url := str(sd, "url")
+	if elems, ok := g.generated[url]; ok && url != "" {
+		return elems, nil
+	}
+	if g.active[url] {
+		return nil, fmt.Errorf("%s is its own base", url)
+	}
+	g.active[url] = true
+	defer delete(g.active, url)
+
+	baseURL := str(sd, "baseDefinition")
+	if baseURL == "" {
+		return nil, fmt.Errorf("%s: no snapshot and no baseDefinition", url)
+	}
+	base, err := g.resolve(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: base: %w", url, err)
+	}
+	baseElems, err := g.snapshot(base)
+	if err != nil {
+		return nil, err
+	}
+	if len(baseElems) == 0 {
+		return nil, fmt.Errorf("%s: base %s has no elements", url, baseURL)
+	}
+
+	s := &snapshot{g: g}
+	for _, e := range baseElems {
+		e = deepCopy(e).(map[string]any)
+		if str(e, "id") == "" {
+			e["id"] = str(e, "path")
+		}
+		s.elems = append(s.elems, e)
+	}
+	// A specialization, such as a logical model, renames the base's elements
+	if typ, baseType := str(sd, "type"), str(s.elems[0], "path"); str(sd, "derivation") == "specialization" && typ != baseType {
+		for _, e := range s.elems {
+			e["path"] = typ + strings.TrimPrefix(str(e, "path"), baseType)
+			e["id"] = typ + strings.TrimPrefix(str(e, "id"), baseType)
+		}
+	}
+
+	var errs []error
+	for _, d := range objects(obj(sd, "differential"), "element") {
+		if err := s.apply(d); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if url != "" {
+		g.generated[url] = s.elems
+	}
+	return s.elems, nil
+}
+
+// snapshot is a snapshot being built.
+type snapshot struct {
+	g     *snapshotGenerator
+	elems []map[string]any
+}
+
+func (s *snapshot) find(id string) int {
+	for i, e := range s.elems {
+		if str(e, "id") == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// apply merges a differential element into the snapshot, adding it first
+// when it is a new slice or a child of a data type not yet expanded.
+func (s *snapshot) apply(d map[string]any) error {
+	id := str(d, "id")
+	if id == "" {
+		id = elementID(str(d, "path"), str(d, "sliceName"))
+	}
+	if i := s.find(id); i >= 0 {
+		merge(s.elems[i], d)
+		return nil
+	}
+
+	parent, last := splitID(id)
+	name, sliceName, isSlice := strings.Cut(last, ":")
+	if parent != "" {
+		if err := s.expand(parent); err != nil {
+			return err
+		}
+	}
+	// An element such as Observation.valueQuantity is the Quantity slice of
+	// Observation.value[x]
+	if !isSlice && s.find(parent+"."+name) < 0 {
+		if choice := s.choiceOf(parent, name); choice != "" {
+			d = deepCopy(d).(map[string]any)
+			d["path"] = str(s.elems[s.find(parent+"."+choice)], "path")
+			d["sliceName"] = name
+			id = parent + "." + choice + ":" + name
+			name, sliceName, isSlice = choice, name, true
+		}
+	}
+	if isSlice && s.find(id) < 0 {
+		baseID := parent + "." + name
+		if i := strings.LastIndex(sliceName, "/"); i >= 0 {
+			baseID += ":" + sliceName[:i]
+		}
+		if err := s.addSlice(baseID, id, sliceName); err != nil {
+			return err
+		}
+	}
+	i := s.find(id)
+	if i < 0 {
+		return fmt.Errorf("%s: no such element in the base definition", id)
+	}
+	merge(s.elems[i], d)
+	return nil
+}
+
+// splitID splits an element id into the id of its parent and its last
+// segment. Slice names cannot contain dots.
+func splitID(id string) (string, string) {
+	i := strings.LastIndexByte(id, '.')
+	if i < 0 {
+		return "", id
+	}
+	return id[:i], id[i+1:]
+}
+
+// choiceOf returns the name of the choice element of parent that name is
+// a typed form of, such as value[x] for valueQuantity, or "".
+func (s *snapshot) choiceOf(parent, name string) string {
+	prefix := parent + "."
+	for _, e := range s.elems {
+		id := str(e, "id")
+		if !strings.HasPrefix(id, prefix) || !strings.HasSuffix(id, "[x]") || strings.ContainsAny(id[len(prefix):], ".:") {
+			continue
+		}
+		choice := id[len(prefix):]
+		if _, _, ok := cutChoice(name, strings.TrimSuffix(choice, "[x]")); ok {
+			return choice
+		}
+	}
+	return ""
+}
+
+// expand makes sure the element with the given id exists and lists its
+// children, copying them from the definition of its type when needed.
+func (s *snapshot) expand(id string) error {
+	i := s.find(id)
+	if i < 0 {
+		parent, _ := splitID(id)
+		if parent == "" {
+			return fmt.Errorf("%s: no such element in the base definition", id)
+		}
+		if err := s.expand(parent); err != nil {
+			return err
+		}
+		if i = s.find(id); i < 0 {
+			return fmt.Errorf("%s: no such element in the base definition", id)
+		}
+	}
+	if i+1 < len(s.elems) && strings.HasPrefix(str(s.elems[i+1], "id"), id+".") {
+		return nil
+	}
+
+	e := s.elems[i]
+	var children []map[string]any
+	if ref := str(e, "contentReference"); ref != "" {
+		// The children of the referenced element, such as Questionnaire.item
+		refID := ref[strings.Index(ref, "#")+1:]
+		for _, c := range s.elems {
+			if strings.HasPrefix(str(c, "id"), refID+".") {
+				children = append(children, rebase(c, refID, id, str(e, "path")))
+			}
+		}
+	} else {
+		types := objects(e, "type")
+		if len(types) != 1 {
+			return fmt.Errorf("%s: children of an element with %d types cannot be constrained", id, len(types))
+		}
+		url := str(types[0], "code")
+		if profiles := strs(types[0], "profile"); len(profiles) > 0 {
+			url = profiles[0]
+		} else if !strings.Contains(url, ":") {
+			url = coreCanonical + url
+		}
+		sd, err := s.g.resolve(url)
+		if err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		typeElems, err := s.g.snapshot(sd)
+		if err != nil {
+			return err
+		}
+		if len(typeElems) == 0 {
+			return fmt.Errorf("%s: %s has no elements", id, url)
+		}
+		root := str(typeElems[0], "path")
+		for _, c := range typeElems[1:] {
+			children = append(children, rebase(c, root, id, str(e, "path")))
+		}
+	}
+	s.insert(i+1, children...)
+	return nil
+}
+
+// rebase copies an element from under the element fromID to under the
+// element with id toID and path toPath.
+func rebase(e map[string]any, fromID, toID, toPath string) map[string]any {
+	c := deepCopy(e).(map[string]any)
+	id := str(c, "id")
+	if id == "" {
+		id = str(c, "path")
+	}
+	fromPath := strings.Split(fromID, ":")[0]
+	c["id"] = toID + strings.TrimPrefix(id, fromID)
+	c["path"] = toPath + strings.TrimPrefix(str(c, "path"), stripSlices(fromPath))
+	return c
+}
+
+// stripSlices removes the slice names from an element id, leaving its path.
+func stripSlices(id string) string {
+	var sb strings.Builder
+	for {
+		i := strings.IndexByte(id, ':')
+		if i < 0 {
+			sb.WriteString(id)
+			return sb.String()
+		}
+		sb.WriteString(id[:i])
+		id = id[i:]
+		j := strings.IndexByte(id, '.')
+		if j < 0 {
+			return sb.String()
+		}
+		id = id[j:]
+	}
+}
+
+// addSlice adds a slice of the element baseID, copying the base and the
+// children it lists; other children are unfolded from the slice's type when
+// constrained. The slice is added after the base's existing slices.
+func (s *snapshot) addSlice(baseID, id, sliceName string) error {
+	bi := s.find(baseID)
+	if bi < 0 {
+		parent, _ := splitID(baseID)
+		if err := s.expand(parent); err != nil {
+			return err
+		}
+		if bi = s.find(baseID); bi < 0 {
+			return fmt.Errorf("%s: no such element in the base definition", baseID)
+		}
+	}
+	base := s.elems[bi]
+	// Extensions are sliced by url and choice elements by type unless the
+	// profile says otherwise
+	if obj(base, "slicing") == nil {
+		switch _, name := splitID(baseID); {
+		case name == "extension" || name == "modifierExtension":
+			base["slicing"] = defaultSlicing("value", "url")
+		case strings.HasSuffix(name, "[x]"):
+			base["slicing"] = defaultSlicing("type", "$this")
+		}
+	}
+	end := bi + 1
+	for end < len(s.elems) {
+		eid := str(s.elems[end], "id")
+		if !strings.HasPrefix(eid, baseID+".") && !strings.HasPrefix(eid, baseID+":") && !strings.HasPrefix(eid, baseID+"/") {
+			break
+		}
+		end++
+	}
+
+	slice := deepCopy(base).(map[string]any)
+	slice["id"] = id
+	slice["sliceName"] = sliceName
+	// Slices define their own cardinality and are not sliced themselves
+	slice["min"] = json.Number("0")
+	delete(slice, "slicing")
+	// A type slice such as value[x]:valueQuantity has only that type
+	if _, name := splitID(baseID); strings.HasSuffix(name, "[x]") {
+		if _, typ, ok := cutChoice(sliceName, strings.TrimSuffix(name, "[x]")); ok {
+			for _, t := range objects(base, "type") {
+				if strings.EqualFold(str(t, "code"), typ) {
+					slice["type"] = []any{deepCopy(t)}
+				}
+			}
+		}
+	}
+	copies := []map[string]any{slice}
+	for _, c := range s.elems[bi+1 : end] {
+		if strings.HasPrefix(str(c, "id"), baseID+".") {
+			copies = append(copies, rebase(c, baseID, id, str(base, "path")))
+		}
+	}
+	s.insert(end, copies...)
+	return nil
+}
+
+func defaultSlicing(typ, path string) map[string]any {
+	return map[string]any{
+		"discriminator": []any{map[string]any{"type": typ, "path": path}},
+		"ordered":       false,
+		"rules":         "open",
+	}
+}
+
+func (s *snapshot) insert(at int, elems ...map[string]any) {
+	s.elems = append(s.elems[:at], append(elems, s.elems[at:]...)...)
+}
+
+// merge applies the properties of a differential element to a snapshot
+// element. Constraints and mappings add to those of the base; anything else
+// replaces it.
+func merge(into, d map[string]any) {
+	for k, v := range d {
+		switch k {
+		case "id", "path":
+		case "constraint":
+			into[k] = appendConstraints(asList(into[k]), asList(v))
+		case "mapping":
+			into[k] = append(asList(into[k]), asList(deepCopy(v))...)
+		default:
+			// A fixed or pattern value replaces one of another type
+			for _, prefix := range []string{"fixed", "pattern"} {
+				if _, _, ok := cutChoice(k, prefix); ok {
+					for old := range into {
+						if _, _, ok := cutChoice(old, prefix); ok {
+							delete(into, old)
+						}
+					}
+				}
+			}
+			into[k] = deepCopy(v)
+		}
+	}
+}
+
+// appendConstraints adds constraints, replacing those with the same key.
+func appendConstraints(base, add []any) []any {
+	out := append([]any(nil), base...)
+	for _, a := range add {
+		key := str(asMap(a), "key")
+		replaced := false
+		for i, b := range out {
+			if key != "" && str(asMap(b), "key") == key {
+				out[i] = deepCopy(a)
+				replaced = true
+			}
+		}
+		if !replaced {
+			out = append(out, deepCopy(a))
+		}
+	}
+	return out
+}
+
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
@@ -0,0 +1,243 @@
+package validation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+)
+
+var (
+	coreTypesOnce sync.Once
+	coreTypes     []map[string]any
+	coreTypesErr  error
+)
+
+// testRegistry returns a registry holding the core R4 data type definitions
+// and the StructureDefinitions, ValueSets and CodeSystems in testdata.
+func testRegistry(t *testing.T) *conformance.Registry {
+	t.Helper()
+	coreTypesOnce.Do(func() {
+		data, err := os.ReadFile("../../fhir_schemas/r4/profiles-types.json")
+		if err != nil {
+			coreTypesErr = err
+			return
+		}
+		bundle, err := decodeObject(data)
+		if err != nil {
+			coreTypesErr = err
+			return
+		}
+		for _, e := range objects(bundle, "entry") {
+			if r := obj(e, "resource"); str(r, "resourceType") == "StructureDefinition" {
+				coreTypes = append(coreTypes, r)
+			}
+		}
+	})
+	if coreTypesErr != nil {
+		t.Fatalf("loading core types: %v", coreTypesErr)
+	}
+
+	reg := conformance.NewRegistry()
+	add := func(r map[string]any, source string) {
+		t.Helper()
+		err := reg.Add(&conformance.Entry{
+			ResourceType: str(r, "resourceType"),
+			URL:          str(r, "url"),
+			Version:      str(r, "version"),
+			FHIRVersion:  "4.0.1",
+			Source:       source,
+			Resource:     r,
+		})
+		if err != nil {
+			t.Fatalf("adding %s: %v", str(r, "url"), err)
+		}
+	}
+	for _, r := range coreTypes {
+		add(r, "profiles-types.json")
+	}
+	files, err := filepath.Glob("testdata/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := decodeObject(data)
+		if err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+		add(r, f)
+	}
+	return reg
+}
+
+// snapshotOf generates the snapshot of a testdata profile and indexes its
+// elements by id.
+func snapshotOf(t *testing.T, reg *conformance.Registry, url string) map[string]map[string]any {
+	t.Helper()
+	e, ok := reg.Resolve("StructureDefinition", url)
+	if !ok {
+		t.Fatalf("%s not found", url)
+	}
+	sd := deepCopy(e.Resource).(map[string]any)
+	if err := GenerateSnapshot(sd, reg); err != nil {
+		t.Fatalf("GenerateSnapshot(%s): %v", url, err)
+	}
+	elems := make(map[string]map[string]any)
+	for _, m := range objects(obj(sd, "snapshot"), "element") {
+		elems[str(m, "id")] = m
+	}
+	return elems
+}
+
+func TestGenerateSnapshot(t *testing.T) {
+	reg := testRegistry(t)
+	elems := snapshotOf(t, reg, "https://fhir.example.org/StructureDefinition/bd-patient")
+
+	tests := []struct {
+		id   string
+		key  string
+		want string
+	}{
+		{"Patient.identifier", "min", "1"},
+		{"Patient.identifier", "max", `"*"`},
+		{"Patient.identifier:NID", "max", `"1"`},
+		{"Patient.identifier:NID", "min", "0"},
+		{"Patient.identifier:NID.system", "fixedUri", `"http://example.org/nid"`},
+		{"Patient.identifier:NID.system", "min", "1"},
+		{"Patient.identifier:NID.value", "min", "1"},
+		{"Patient.identifier:BRN.system", "fixedUri", `"http://example.org/brn"`},
+		{"Patient.extension:shelter", "max", `"1"`},
+		{"Patient.managingOrganization", "max", `"0"`},
+		{"Patient.deceased[x]", "type", `[{"code":"boolean"}]`},
+	}
+	for _, tt := range tests {
+		e, ok := elems[tt.id]
+		if !ok {
+			t.Errorf("snapshot has no element %s", tt.id)
+			continue
+		}
+		got, _ := json.Marshal(e[tt.key])
+		if string(got) != tt.want {
+			t.Errorf("%s.%s = %s, want %s", tt.id, tt.key, got, tt.want)
+		}
+	}
+
+	// The slices get the children of Identifier
+	for _, id := range []string{"Patient.identifier:NID.use", "Patient.identifier:BRN.period"} {
+		if _, ok := elems[id]; !ok {
+			t.Errorf("snapshot has no element %s", id)
+		}
+	}
+	if _, ok := mustResolve(t, reg, "https://fhir.example.org/StructureDefinition/bd-patient")["snapshot"]; ok {
+		t.Errorf("GenerateSnapshot changed the registry's copy")
+	}
+}
+
+func TestGenerateSnapshotChain(t *testing.T) {
+	reg := testRegistry(t)
+	elems := snapshotOf(t, reg, "https://fhir.example.org/StructureDefinition/rohingya-patient")
+
+	for id, want := range map[string]string{
+		"Patient.identifier:NID":        `"0"`,
+		"Patient.identifier:FCN":        `"1"`,
+		"Patient.identifier:BRN":        `"1"`,
+		"Patient.identifier:FCN.system": `"1"`,
+	} {
+		e, ok := elems[id]
+		if !ok {
+			t.Errorf("snapshot has no element %s", id)
+			continue
+		}
+		got, _ := json.Marshal(e["max"])
+		if string(got) != want {
+			t.Errorf("%s.max = %s, want %s", id, got, want)
+		}
+	}
+	if _, ok := elems["Patient.identifier"]["slicing"]; !ok {
+		t.Errorf("Patient.identifier lost the slicing of the base profile")
+	}
+	if got := str(elems["Patient.identifier:FCN.system"], "fixedUri"); got != "http://example.org/fcn" {
+		t.Errorf("Patient.identifier:FCN.system fixedUri = %q", got)
+	}
+}
+
+func TestGenerateSnapshotChoiceRename(t *testing.T) {
+	reg := testRegistry(t)
+	elems := snapshotOf(t, reg, "https://fhir.example.org/StructureDefinition/bp")
+
+	slice, ok := elems["Observation.component:systolic.value[x]:valueQuantity"]
+	if !ok {
+		t.Fatalf("valueQuantity was not turned into a slice of value[x]")
+	}
+	if str(slice, "sliceName") != "valueQuantity" {
+		t.Errorf("sliceName = %q, want valueQuantity", str(slice, "sliceName"))
+	}
+	if _, ok := slice["patternQuantity"]; !ok {
+		t.Errorf("valueQuantity slice lost its pattern")
+	}
+	if _, ok := elems["Observation.component:systolic.value[x]"]["slicing"]; !ok {
+		t.Errorf("value[x] has no default type slicing")
+	}
+	if _, ok := elems["Observation.component:diastolic.value[x]"]; !ok {
+		t.Errorf("snapshot has no element Observation.component:diastolic.value[x]")
+	}
+}
+
+func TestGenerateSnapshotErrors(t *testing.T) {
+	reg := testRegistry(t)
+	tests := []struct {
+		name string
+		sd   map[string]any
+	}{
+		{
+			name: "unknown base",
+			sd: map[string]any{
+				"resourceType":   "StructureDefinition",
+				"url":            "https://fhir.example.org/StructureDefinition/x",
+				"type":           "Patient",
+				"baseDefinition": "https://fhir.example.org/StructureDefinition/missing",
+				"derivation":     "constraint",
+			},
+		},
+		{
+			name: "unknown element",
+			sd: map[string]any{
+				"resourceType":   "StructureDefinition",
+				"url":            "https://fhir.example.org/StructureDefinition/y",
+				"type":           "Address",
+				"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Address",
+				"derivation":     "constraint",
+				"differential": map[string]any{"element": []any{
+					map[string]any{"id": "Address.planet", "path": "Address.planet", "min": json.Number("1")},
+				}},
+			},
+		},
+		{
+			name: "not a StructureDefinition",
+			sd:   map[string]any{"resourceType": "ValueSet"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := GenerateSnapshot(tt.sd, reg); err == nil {
+				t.Errorf("GenerateSnapshot() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func mustResolve(t *testing.T, reg *conformance.Registry, url string) map[string]any {
+	t.Helper()
+	e, ok := reg.Resolve("StructureDefinition", url)
+	if !ok {
+		t.Fatalf("%s not found", url)
+	}
+	return e.Resource.(map[string]any)
+}
@@ -0,0 +1,426 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// structureDefinition holds the parts of a StructureDefinition that profile
+// validation uses. It is read from the JSON form of the resource, so R4 and
+// R5 StructureDefinitions are handled alike.
+type structureDefinition struct {
+	URL            string
+	Type           string
+	Kind           string
+	Derivation     string
+	BaseDefinition string
+	FHIRVersion    string
+	Context        []extensionContext
+	Snapshot       []map[string]any
+	Differential   []map[string]any
+}
+
+// extensionContext is where an extension may be used.
+type extensionContext struct {
+	Type       string // element, extension or fhirpath
+	Expression string
+}
+
+func parseStructureDefinition(sd any) (*structureDefinition, error) {
+	m, err := jsonObject(sd)
+	if err != nil {
+		return nil, err
+	}
+	if rt := str(m, "resourceType"); rt != "" && rt != "StructureDefinition" {
+		return nil, fmt.Errorf("%s is not a StructureDefinition", rt)
+	}
+	s := &structureDefinition{
+		URL:            str(m, "url"),
+		Type:           str(m, "type"),
+		Kind:           str(m, "kind"),
+		Derivation:     str(m, "derivation"),
+		BaseDefinition: str(m, "baseDefinition"),
+		FHIRVersion:    str(m, "fhirVersion"),
+		Snapshot:       objects(obj(m, "snapshot"), "element"),
+		Differential:   objects(obj(m, "differential"), "element"),
+	}
+	for _, c := range objects(m, "context") {
+		s.Context = append(s.Context, extensionContext{Type: str(c, "type"), Expression: str(c, "expression")})
+	}
+	return s, nil
+}
+
+// elementDefinition is an ElementDefinition of a snapshot.
+type elementDefinition struct {
+	ID               string
+	Path             string
+	SliceName        string
+	Min              int
+	Max              string // a number or *
+	Types            []elementType
+	Slicing          *slicing
+	MustSupport      bool
+	Fixed            any
+	Pattern          any
+	ContentReference string
+	Constraints      []constraint
+	Binding          *binding
+}
+
+// constraint is an invariant of an element, such as ele-1.
+type constraint struct {
+	Key        string
+	Severity   string // error or warning
+	Human      string
+	Expression string // FHIRPath
+}
+
+// binding is ElementDefinition.binding.
+type binding struct {
+	Strength string // required, extensible, preferred or example
+	ValueSet string
+}
+
+// elementType is an entry of ElementDefinition.type.
+type elementType struct {
+	Code string
+	// FHIRType is the FHIR type of a FHIRPath system type, such as uri for
+	// Extension.url, from the structuredefinition-fhir-type extension.
+	FHIRType      string
+	Profile       []string
+	TargetProfile []string
+}
+
+// slicing is ElementDefinition.slicing.
+type slicing struct {
+	Discriminators []discriminator
+	Ordered        bool
+	Rules          string // closed, open or openAtEnd
+}
+
+type discriminator struct {
+	Type string // value, exists, pattern, type or profile
+	Path string
+}
+
+func parseElement(m map[string]any) *elementDefinition {
+	e := &elementDefinition{
+		ID:               str(m, "id"),
+		Path:             str(m, "path"),
+		SliceName:        str(m, "sliceName"),
+		Max:              str(m, "max"),
+		ContentReference: str(m, "contentReference"),
+	}
+	if e.ID == "" {
+		e.ID = elementID(e.Path, e.SliceName)
+	}
+	if n, ok := m["min"].(json.Number); ok {
+		if v, err := n.Int64(); err == nil {
+			e.Min = int(v)
+		}
+	}
+	e.MustSupport, _ = m["mustSupport"].(bool)
+	for _, t := range objects(m, "type") {
+		e.Types = append(e.Types, elementType{
+			Code:          str(t, "code"),
+			FHIRType:      fhirType(t),
+			Profile:       strs(t, "profile"),
+			TargetProfile: strs(t, "targetProfile"),
+		})
+	}
+	for _, c := range objects(m, "constraint") {
+		e.Constraints = append(e.Constraints, constraint{
+			Key:        str(c, "key"),
+			Severity:   str(c, "severity"),
+			Human:      str(c, "human"),
+			Expression: str(c, "expression"),
+		})
+	}
+	if b := obj(m, "binding"); b != nil && str(b, "valueSet") != "" {
+		e.Binding = &binding{Strength: str(b, "strength"), ValueSet: str(b, "valueSet")}
+	}
+	if s := obj(m, "slicing"); s != nil {
+		e.Slicing = &slicing{Rules: str(s, "rules")}
+		e.Slicing.Ordered, _ = s["ordered"].(bool)
+		for _, d := range objects(s, "discriminator") {
+			e.Slicing.Discriminators = append(e.Slicing.Discriminators, discriminator{Type: str(d, "type"), Path: str(d, "path")})
+		}
+	}
+	for k, v := range m {
+		// fixed[x] and pattern[x]; the type suffix starts with a capital
+		if _, t, ok := cutChoice(k, "fixed"); ok && t != "" {
+			e.Fixed = v
+		} else if _, t, ok := cutChoice(k, "pattern"); ok && t != "" {
+			e.Pattern = v
+		}
+	}
+	return e
+}
+
+// elementID makes the id of an element that has none, assuming no slices
+// above it.
+func elementID(path, sliceName string) string {
+	if sliceName == "" {
+		return path
+	}
+	return path + ":" + sliceName
+}
+
+// name returns the last segment of the element path, such as value[x].
+func (e *elementDefinition) name() string {
+	return e.Path[strings.LastIndex(e.Path, ".")+1:]
+}
+
+// isChoice reports whether the element is a choice element such as value[x].
+func (e *elementDefinition) isChoice() bool {
+	return strings.HasSuffix(e.Path, "[x]")
+}
+
+// maxCount returns the maximum cardinality, or -1 if unbounded.
+func (e *elementDefinition) maxCount() int {
+	n, err := strconv.Atoi(e.Max)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// typeFor returns the type entry with the given code, matched ignoring the
+// case of its first letter since choice suffixes capitalize primitive type
+// names (valueString for string).
+func (e *elementDefinition) typeFor(code string) (elementType, bool) {
+	for _, t := range e.Types {
+		if strings.EqualFold(t.Code, code) {
+			return t, true
+		}
+	}
+	return elementType{}, false
+}
+
+// fhirType returns the FHIR type of a system type from the
+// structuredefinition-fhir-type extension of an ElementDefinition.type.
+func fhirType(t map[string]any) string {
+	for _, ext := range objects(t, "extension") {
+		if str(ext, "url") == coreCanonical+"structuredefinition-fhir-type" {
+			if v := str(ext, "valueUrl"); v != "" {
+				return v
+			}
+			return str(ext, "valueUri")
+		}
+	}
+	return ""
+}
+
+// fhirCode returns the FHIR type of t: its code, or for a system type such
+// as that of Extension.url, the FHIR type it stands for.
+func (t elementType) fhirCode() string {
+	if t.FHIRType != "" && strings.HasPrefix(t.Code, "http://hl7.org/fhirpath/System.") {
+		return t.FHIRType
+	}
+	return t.Code
+}
+
+// typeCodes lists the codes of the element's types.
+func (e *elementDefinition) typeCodes() []string {
+	codes := make([]string, len(e.Types))
+	for i, t := range e.Types {
+		codes[i] = t.Code
+	}
+	return codes
+}
+
+// cutChoice splits a JSON property such as valueQuantity into the choice
+// name and the type suffix when it starts with name followed by a capital.
+func cutChoice(key, name string) (string, string, bool) {
+	if !strings.HasPrefix(key, name) || len(key) == len(name) {
+		return "", "", false
+	}
+	suffix := key[len(name):]
+	r, _ := utf8.DecodeRuneInString(suffix)
+	if !unicode.IsUpper(r) {
+		return "", "", false
+	}
+	return name, suffix, true
+}
+
+// jsonObject returns the JSON object form of v: v itself if it is already
+// a decoded object, the decoded bytes if it is JSON, and otherwise the
+// result of marshaling v. Numbers are kept as json.Number.
+func jsonObject(v any) (map[string]any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		return v, nil
+	case []byte:
+		return decodeObject(v)
+	case json.RawMessage:
+		return decodeObject(v)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeObject(data)
+}
+
+func decodeObject(data []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var m map[string]any
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+	return m, nil
+}
+
+func str(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func obj(m map[string]any, key string) map[string]any {
+	o, _ := m[key].(map[string]any)
+	return o
+}
+
+func objects(m map[string]any, key string) []map[string]any {
+	var out []map[string]any
+	for _, v := range asList(m[key]) {
+		if o, ok := v.(map[string]any); ok {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+func strs(m map[string]any, key string) []string {
+	var out []string
+	for _, v := range asList(m[key]) {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// asList returns a JSON array as is and wraps any other value in a list.
+func asList(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	}
+	return []any{v}
+}
+
+// deepCopy copies a decoded JSON value.
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, x := range v {
+			m[k] = deepCopy(x)
+		}
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, x := range v {
+			l[i] = deepCopy(x)
+		}
+		return l
+	}
+	return v
+}
+
+// jsonEqual compares two decoded JSON values. Numbers are equal when they
+// have the same value, whatever their spelling.
+func jsonEqual(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if !jsonEqual(v, b[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if a == b {
+			return true
+		}
+		x, errx := strconv.ParseFloat(string(a), 64)
+		y, erry := strconv.ParseFloat(string(b), 64)
+		return errx == nil && erry == nil && x == y
+	}
+	return a == b
+}
+
+// matchesPattern reports whether value has at least the content of pattern:
+// every property of a pattern object must match, and every item of a
+// pattern array must match some item of the value.
+func matchesPattern(pattern, value any) bool {
+	switch p := pattern.(type) {
+	case map[string]any:
+		v, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, pv := range p {
+			if !matchesPattern(pv, v[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		values := asList(value)
+		for _, pv := range p {
+			found := false
+			for _, v := range values {
+				if matchesPattern(pv, v) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return jsonEqual(pattern, value)
+}
+
+// jsonString renders a decoded JSON value for messages.
+func jsonString(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,397 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+)
+
+// TerminologyService checks codes against ValueSets. The validator uses it
+// for the bindings of coded elements; RegistryTerminology is the in-process
+// implementation, and a client of a remote terminology server can take its
+// place.
+type TerminologyService interface {
+	// ValidateCode checks system|code against the ValueSet a canonical,
+	// url or url|version, resolves to. An empty system matches the code in
+	// any system of the ValueSet, as for a code element. An error means the
+	// code could not be checked, such as when the ValueSet is unknown.
+	ValidateCode(valueSet, system, code string) (*CodeValidation, error)
+}
+
+// CodeValidation is the outcome of checking a code against a ValueSet.
+type CodeValidation struct {
+	// Valid is true when the code is in the ValueSet.
+	Valid bool
+	// SystemIncluded is true when the ValueSet has codes from the code's
+	// system, so that a code from the set could have been used instead.
+	SystemIncluded bool
+	// Display is the display of the code, if the ValueSet gives one.
+	Display string
+}
+
+var (
+	// ErrValueSetNotFound is returned for a ValueSet the service doesn't
+	// know.
+	ErrValueSetNotFound = errors.New("ValueSet not found")
+	// ErrNotExpandable is returned for a ValueSet whose codes can't be
+	// listed, such as one including all of a CodeSystem that isn't loaded.
+	ErrNotExpandable = errors.New("ValueSet can't be expanded")
+)
+
+// maxValueSetDepth bounds the ValueSets imported by ValueSets.
+const maxValueSetDepth = 16
+
+// RegistryTerminology is a TerminologyService over the ValueSets and
+// CodeSystems of a registry. ValueSets are expanded on first use from their
+// expansion, or from their compose: concepts listed by code, whole
+// CodeSystems, imported ValueSets, and is-a, descendent-of, is-not-a, =, in
+// and regex filters. It is safe for concurrent use.
+type RegistryTerminology struct {
+	reg        *conformance.Registry
+	mu         sync.Mutex
+	expansions map[string]*expansion
+	failed     map[string]error
+}
+
+// NewRegistryTerminology creates a terminology service over reg.
+func NewRegistryTerminology(reg *conformance.Registry) *RegistryTerminology {
+	return &RegistryTerminology{
+		reg:        reg,
+		expansions: make(map[string]*expansion),
+		failed:     make(map[string]error),
+	}
+}
+
+// ValidateCode implements TerminologyService.
+func (t *RegistryTerminology) ValidateCode(valueSet, system, code string) (*CodeValidation, error) {
+	x, err := t.expansion(valueSet)
+	if err != nil {
+		return nil, err
+	}
+	res := &CodeValidation{SystemIncluded: x.systems[system]}
+	if system == "" {
+		res.SystemIncluded = len(x.systems) > 0
+		for _, c := range x.codes {
+			if c.code == code {
+				res.Valid, res.Display = true, c.display
+				break
+			}
+		}
+		return res, nil
+	}
+	if c, ok := x.codes[system+"|"+code]; ok {
+		res.Valid, res.Display = true, c.display
+	}
+	return res, nil
+}
+
+// expansion is the set of codes of a ValueSet, by system|code.
+type expansion struct {
+	codes   map[string]expandedCode
+	systems map[string]bool
+}
+
+type expandedCode struct {
+	system, code, display string
+}
+
+func newExpansion() *expansion {
+	return &expansion{codes: make(map[string]expandedCode), systems: make(map[string]bool)}
+}
+
+func (x *expansion) add(c expandedCode) {
+	x.codes[c.system+"|"+c.code] = c
+	x.systems[c.system] = true
+}
+
+func (t *RegistryTerminology) expansion(canonical string) (*expansion, error) {
+	t.mu.Lock()
+	x, ok := t.expansions[canonical]
+	err := t.failed[canonical]
+	t.mu.Unlock()
+	if ok || err != nil {
+		return x, err
+	}
+
+	x, err = t.expand(canonical, 0)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		t.failed[canonical] = err
+		return nil, err
+	}
+	t.expansions[canonical] = x
+	return x, nil
+}
+
+// resolve returns the JSON form of a ValueSet or CodeSystem. A canonical
+// with a version the registry lacks falls back to the latest version, as
+// core bindings name versions packages don't always carry.
+func (t *RegistryTerminology) resolve(resourceType, canonical string) (map[string]any, bool) {
+	e, ok := t.reg.Resolve(resourceType, canonical)
+	if !ok {
+		url, _, versioned := strings.Cut(canonical, "|")
+		if !versioned {
+			return nil, false
+		}
+		if e, ok = t.reg.Resolve(resourceType, url); !ok {
+			return nil, false
+		}
+	}
+	m, err := jsonObject(e.Resource)
+	if err != nil {
+		return nil, false
+	}
+	return m, true
+}
+
+func (t *RegistryTerminology) expand(canonical string, depth int) (*expansion, error) {
+	if depth > maxValueSetDepth {
+		return nil, fmt.Errorf("%w: %s imports ValueSets too deeply", ErrNotExpandable, canonical)
+	}
+	vs, ok := t.resolve("ValueSet", canonical)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrValueSetNotFound, canonical)
+	}
+
+	compose := obj(vs, "compose")
+	if compose == nil {
+		x := newExpansion()
+		addContains(x, objects(obj(vs, "expansion"), "contains"))
+		return x, nil
+	}
+	x := newExpansion()
+	for _, inc := range objects(compose, "include") {
+		part, err := t.expandInclude(inc, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", canonical, err)
+		}
+		for _, c := range part.codes {
+			x.add(c)
+		}
+		for s := range part.systems {
+			x.systems[s] = true
+		}
+	}
+	for _, exc := range objects(compose, "exclude") {
+		part, err := t.expandInclude(exc, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", canonical, err)
+		}
+		for key := range part.codes {
+			delete(x.codes, key)
+		}
+	}
+	return x, nil
+}
+
+// addContains adds the codes of expansion.contains, nested ones included.
+func addContains(x *expansion, contains []map[string]any) {
+	for _, c := range contains {
+		if code := str(c, "code"); code != "" {
+			x.add(expandedCode{system: str(c, "system"), code: code, display: str(c, "display")})
+		}
+		addContains(x, objects(c, "contains"))
+	}
+}
+
+// expandInclude expands one compose.include or compose.exclude: the codes
+// it selects from its system, if any, that are also in every ValueSet it
+// imports.
+func (t *RegistryTerminology) expandInclude(inc map[string]any, depth int) (*expansion, error) {
+	var x *expansion
+	if system := str(inc, "system"); system != "" {
+		var err error
+		if x, err = t.expandSystem(inc, system); err != nil {
+			return nil, err
+		}
+	}
+	for _, vs := range strs(inc, "valueSet") {
+		imported, err := t.expand(vs, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if x == nil {
+			x = imported
+			continue
+		}
+		for key := range x.codes {
+			if _, ok := imported.codes[key]; !ok {
+				delete(x.codes, key)
+			}
+		}
+	}
+	if x == nil {
+		x = newExpansion()
+	}
+	return x, nil
+}
+
+// expandSystem expands the part of an include that selects codes of a
+// CodeSystem.
+func (t *RegistryTerminology) expandSystem(inc map[string]any, system string) (*expansion, error) {
+	canonical := system
+	if v := str(inc, "version"); v != "" {
+		canonical += "|" + v
+	}
+	cs, haveCS := t.resolve("CodeSystem", canonical)
+	if haveCS && str(cs, "content") == "not-present" {
+		haveCS = false
+	}
+	var concepts []*csConcept
+	if haveCS {
+		concepts = flattenConcepts(objects(cs, "concept"), nil)
+	}
+
+	x := newExpansion()
+	x.systems[system] = true
+	if listed := objects(inc, "concept"); len(listed) > 0 {
+		for _, c := range listed {
+			code := str(c, "code")
+			display := str(c, "display")
+			if display == "" {
+				for _, cc := range concepts {
+					if cc.code == code {
+						display = cc.display
+						break
+					}
+				}
+			}
+			x.add(expandedCode{system: system, code: code, display: display})
+		}
+		return x, nil
+	}
+
+	if !haveCS {
+		return nil, fmt.Errorf("%w: CodeSystem %s is not loaded", ErrNotExpandable, canonical)
+	}
+	filters := objects(inc, "filter")
+	for _, c := range concepts {
+		ok := true
+		for _, f := range filters {
+			match, err := c.matches(f)
+			if err != nil {
+				return nil, fmt.Errorf("%w: CodeSystem %s: %v", ErrNotExpandable, canonical, err)
+			}
+			if !match {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			x.add(expandedCode{system: system, code: c.code, display: c.display})
+		}
+	}
+	return x, nil
+}
+
+// csConcept is a concept of a CodeSystem, with the concepts above it in the
+// hierarchy.
+type csConcept struct {
+	code, display string
+	ancestors     []string
+	properties    map[string][]string
+}
+
+func flattenConcepts(concepts []map[string]any, ancestors []string) []*csConcept {
+	var out []*csConcept
+	for _, c := range concepts {
+		cc := &csConcept{
+			code:       str(c, "code"),
+			display:    str(c, "display"),
+			ancestors:  ancestors,
+			properties: make(map[string][]string),
+		}
+		for _, p := range objects(c, "property") {
+			for k, v := range p {
+				if strings.HasPrefix(k, "value") {
+					cc.properties[str(p, "code")] = append(cc.properties[str(p, "code")], fmt.Sprint(v))
+				}
+			}
+		}
+		out = append(out, cc)
+		below := append(append([]string(nil), ancestors...), cc.code)
+		out = append(out, flattenConcepts(objects(c, "concept"), below)...)
+	}
+	return out
+}
+
+func (c *csConcept) descends(code string) bool {
+	for _, a := range c.ancestors {
+		if a == code {
+			return true
+		}
+	}
+	return false
+}
+
+// matches applies a compose filter to the concept.
+func (c *csConcept) matches(f map[string]any) (bool, error) {
+	property, op, value := str(f, "property"), str(f, "op"), str(f, "value")
+	if property == "concept" || property == "code" {
+		switch op {
+		case "is-a":
+			return c.code == value || c.descends(value), nil
+		case "descendent-of":
+			return c.descends(value), nil
+		case "is-not-a":
+			return c.code != value && !c.descends(value), nil
+		case "=":
+			return c.code == value, nil
+		case "in":
+			for _, v := range strings.Split(value, ",") {
+				if strings.TrimSpace(v) == c.code {
+					return true, nil
+				}
+			}
+			return false, nil
+		case "regex":
+			return regexMatch(value, c.code)
+		}
+	}
+	values := c.properties[property]
+	if property == "display" {
+		values = []string{c.display}
+	}
+	switch op {
+	case "=":
+		for _, v := range values {
+			if v == value {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "in":
+		for _, want := range strings.Split(value, ",") {
+			for _, v := range values {
+				if v == strings.TrimSpace(want) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	case "regex":
+		for _, v := range values {
+			if ok, err := regexMatch(value, v); ok || err != nil {
+				return ok, err
+			}
+		}
+		return false, nil
+	case "exists":
+		return (len(values) > 0) == (value == "true"), nil
+	}
+	return false, fmt.Errorf("filter %s %s is not supported", property, op)
+}
+
+func regexMatch(pattern, s string) (bool, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(s), nil
+}
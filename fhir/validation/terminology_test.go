@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+)
+
+// terminologyRegistry returns a registry holding a small CodeSystem with a
+// hierarchy, and ValueSets selecting from it in different ways.
+func terminologyRegistry(t *testing.T) *conformance.Registry {
+	t.Helper()
+	reg := conformance.NewRegistry()
+	for _, r := range []string{
+		`{"resourceType":"CodeSystem","url":"http://example.org/cs/triage","version":"1.0.0","content":"complete","concept":[
+			{"code":"urgent","display":"Urgent","concept":[
+				{"code":"red","display":"Immediate","property":[{"code":"colour","valueCode":"red"}]},
+				{"code":"orange","display":"Very urgent","property":[{"code":"colour","valueCode":"orange"}]}]},
+			{"code":"standard","display":"Standard","concept":[{"code":"green","display":"Standard"}]},
+			{"code":"blue","display":"Non-urgent"}]}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/all","version":"1.0.0",
+			"compose":{"include":[{"system":"http://example.org/cs/triage"}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/urgent",
+			"compose":{"include":[{"system":"http://example.org/cs/triage","filter":[{"property":"concept","op":"is-a","value":"urgent"}]}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/below-urgent",
+			"compose":{"include":[{"system":"http://example.org/cs/triage","filter":[{"property":"concept","op":"descendent-of","value":"urgent"}]}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/red",
+			"compose":{"include":[{"system":"http://example.org/cs/triage","filter":[{"property":"colour","op":"=","value":"red"}]}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/listed",
+			"compose":{"include":[{"system":"http://example.org/cs/triage","concept":[{"code":"red"},{"code":"blue","display":"Blue"}]}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/not-urgent",
+			"compose":{"include":[{"valueSet":["http://example.org/vs/all"]}],
+				"exclude":[{"valueSet":["http://example.org/vs/urgent"]}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/expanded",
+			"expansion":{"contains":[{"system":"http://example.org/cs/other","code":"a","display":"A",
+				"contains":[{"system":"http://example.org/cs/other","code":"b"}]}]}}`,
+		`{"resourceType":"ValueSet","url":"http://example.org/vs/loinc",
+			"compose":{"include":[{"system":"http://loinc.org"}]}}`,
+	} {
+		m, err := decodeObject([]byte(r))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = reg.Add(&conformance.Entry{
+			ResourceType: str(m, "resourceType"),
+			URL:          str(m, "url"),
+			Version:      str(m, "version"),
+			Resource:     m,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return reg
+}
+
+func TestRegistryTerminology(t *testing.T) {
+	ts := NewRegistryTerminology(terminologyRegistry(t))
+	const triage = "http://example.org/cs/triage"
+
+	tests := []struct {
+		valueSet, system, code string
+		valid                  bool
+		display                string
+	}{
+		{"http://example.org/vs/all", triage, "green", true, "Standard"},
+		{"http://example.org/vs/all", triage, "purple", false, ""},
+		{"http://example.org/vs/all", "http://example.org/cs/other", "green", false, ""},
+		{"http://example.org/vs/all|1.0.0", triage, "blue", true, "Non-urgent"},
+		// A version the registry lacks falls back to the latest
+		{"http://example.org/vs/all|9.9", triage, "blue", true, "Non-urgent"},
+		{"http://example.org/vs/urgent", triage, "urgent", true, "Urgent"},
+		{"http://example.org/vs/urgent", triage, "orange", true, "Very urgent"},
+		{"http://example.org/vs/urgent", triage, "green", false, ""},
+		{"http://example.org/vs/below-urgent", triage, "urgent", false, ""},
+		{"http://example.org/vs/below-urgent", triage, "red", true, "Immediate"},
+		{"http://example.org/vs/red", triage, "red", true, "Immediate"},
+		{"http://example.org/vs/red", triage, "orange", false, ""},
+		{"http://example.org/vs/listed", triage, "red", true, "Immediate"},
+		{"http://example.org/vs/listed", triage, "blue", true, "Blue"},
+		{"http://example.org/vs/listed", triage, "green", false, ""},
+		{"http://example.org/vs/not-urgent", triage, "green", true, "Standard"},
+		{"http://example.org/vs/not-urgent", triage, "red", false, ""},
+		{"http://example.org/vs/expanded", "http://example.org/cs/other", "b", true, ""},
+		// A bare code matches in any system
+		{"http://example.org/vs/listed", "", "blue", true, "Blue"},
+		{"http://example.org/vs/listed", "", "green", false, ""},
+	}
+	for _, tt := range tests {
+		res, err := ts.ValidateCode(tt.valueSet, tt.system, tt.code)
+		if err != nil {
+			t.Errorf("ValidateCode(%s, %s, %s) error = %v", tt.valueSet, tt.system, tt.code, err)
+			continue
+		}
+		if res.Valid != tt.valid || res.Display != tt.display {
+			t.Errorf("ValidateCode(%s, %s, %s) = %+v, want valid %v, display %q", tt.valueSet, tt.system, tt.code, res, tt.valid, tt.display)
+		}
+	}
+
+	res, err := ts.ValidateCode("http://example.org/vs/urgent", triage, "purple")
+	if err != nil || !res.SystemIncluded {
+		t.Errorf("ValidateCode() = %+v, %v; want SystemIncluded", res, err)
+	}
+	res, err = ts.ValidateCode("http://example.org/vs/urgent", "http://example.org/cs/other", "red")
+	if err != nil || res.SystemIncluded {
+		t.Errorf("ValidateCode() = %+v, %v; want SystemIncluded false", res, err)
+	}
+
+	if _, err := ts.ValidateCode("http://example.org/vs/missing", triage, "red"); !errors.Is(err, ErrValueSetNotFound) {
+		t.Errorf("unknown ValueSet: error = %v, want ErrValueSetNotFound", err)
+	}
+	if _, err := ts.ValidateCode("http://example.org/vs/loinc", "http://loinc.org", "8480-6"); !errors.Is(err, ErrNotExpandable) {
+		t.Errorf("ValueSet over a missing CodeSystem: error = %v, want ErrNotExpandable", err)
+	}
+}
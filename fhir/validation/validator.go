@@ -1,18 +1,61 @@
 package validation
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 )
 
+// Severity tells whether an issue makes a resource invalid. The values are
+// the codes of the FHIR IssueSeverity value set.
+type Severity string
+
+const (
+	// SeverityFatal is an issue that stopped the validation, such as JSON
+	// that doesn't parse.
+	SeverityFatal       Severity = "fatal"
+	SeverityError       Severity = "error"
+	SeverityWarning     Severity = "warning"
+	SeverityInformation Severity = "information"
+)
+
+// rank orders severities from information (0) to fatal (3).
+func (s Severity) rank() int {
+	switch s {
+	case SeverityInformation:
+		return 0
+	case SeverityWarning:
+		return 1
+	case SeverityFatal:
+		return 3
+	}
+	return 2
+}
+
 // Error represents a validation error with context about where it occurred.
 type Error struct {
-	Field   string // Field path (e.g., "Patient.name[0].family")
-	Message string // Human-readable error message
+	Field    string    // Field path (e.g., "Patient.name[0].family")
+	Message  string    // Human-readable error message
+	Severity Severity  // SeverityError unless set otherwise
+	Code     IssueType // Kind of issue; IssueInvalid unless set otherwise
+	// Key is the id of the rule that failed: the key of a constraint, such
+	// as obs-6, or the name of a built-in check, such as cardinality.
+	Key string
+	// Expression is the FHIRPath location of the issue, such as
+	// Patient.name[0].family, when known.
+	Expression string
+	// Line and Column locate the issue in the JSON it was found in,
+	// counting from 1, or are 0 when unknown.
+	Line, Column int
 }
 
 // Error implements the error interface.
@@ -23,7 +66,18 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
-// Errors represents a collection of validation errors.
+// IsWarning reports whether the issue is a warning rather than an error.
+func (e *Error) IsWarning() bool {
+	return e.Severity == SeverityWarning
+}
+
+// isError reports whether the issue makes the resource invalid.
+func (e *Error) isError() bool {
+	return e.Severity.rank() >= SeverityError.rank()
+}
+
+// Errors represents a collection of validation errors, and the warnings
+// and information found alongside them.
 type Errors struct {
 	errors []*Error
 }
@@ -31,8 +85,10 @@ type Errors struct {
 // Add adds a validation error.
 func (e *Errors) Add(field, message string) {
 	e.errors = append(e.errors, &Error{
-		Field:   field,
-		Message: message,
+		Field:    field,
+		Message:  message,
+		Severity: SeverityError,
+		Code:     IssueInvalid,
 	})
 }
 
@@ -41,30 +97,120 @@ func (e *Errors) Addf(field, format string, args ...any) {
 	e.Add(field, fmt.Sprintf(format, args...))
 }
 
+// Warnf adds a formatted warning. Warnings don't make HasErrors true.
+func (e *Errors) Warnf(field, format string, args ...any) {
+	e.errors = append(e.errors, &Error{
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+		Severity: SeverityWarning,
+		Code:     IssueInvalid,
+	})
+}
+
+// Infof adds a formatted information message, such as a code outside a
+// preferred ValueSet. Information doesn't make HasErrors true.
+func (e *Errors) Infof(field, format string, args ...any) {
+	e.errors = append(e.errors, &Error{
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+		Severity: SeverityInformation,
+		Code:     IssueInformational,
+	})
+}
+
+func (e *Errors) add(err *Error) {
+	e.errors = append(e.errors, err)
+}
+
+// issuef adds an issue found by the rule named key, and returns it.
+func (e *Errors) issuef(severity Severity, code IssueType, key, field, format string, args ...any) *Error {
+	err := &Error{
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+		Severity: severity,
+		Code:     code,
+		Key:      key,
+	}
+	e.errors = append(e.errors, err)
+	return err
+}
+
+// errorsOnly returns the errors alone.
+func (e *Errors) errorsOnly() *Errors {
+	out := &Errors{}
+	for _, err := range e.errors {
+		if err.isError() {
+			out.errors = append(out.errors, err)
+		}
+	}
+	return out
+}
+
 // HasErrors returns true if there are any validation errors.
 func (e *Errors) HasErrors() bool {
-	return len(e.errors) > 0
+	for _, err := range e.errors {
+		if err.isError() {
+			return true
+		}
+	}
+	return false
 }
 
-// Error implements the error interface.
+// Error implements the error interface. The count in the first line is of
+// errors and fatal issues; warnings and information are counted apart.
 func (e *Errors) Error() string {
 	if len(e.errors) == 0 {
 		return "no validation errors"
 	}
 
+	failed := 0
+	for _, err := range e.errors {
+		if err.isError() {
+			failed++
+		}
+	}
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("%d validation error(s):\n", len(e.errors)))
+	if other := len(e.errors) - failed; other > 0 {
+		sb.WriteString(fmt.Sprintf("%d validation error(s), %d other issue(s):\n", failed, other))
+	} else {
+		sb.WriteString(fmt.Sprintf("%d validation error(s):\n", failed))
+	}
 	for i, err := range e.errors {
+		if !err.isError() {
+			sb.WriteString(fmt.Sprintf("  %d. %s: %s\n", i+1, err.Severity, err.Error()))
+			continue
+		}
 		sb.WriteString(fmt.Sprintf("  %d. %s\n", i+1, err.Error()))
 	}
 	return sb.String()
 }
 
-// List returns the list of validation errors.
+// List returns the list of validation errors, warnings and information
+// included.
 func (e *Errors) List() []*Error {
 	return e.errors
 }
 
+// Warnings returns the warnings.
+func (e *Errors) Warnings() []*Error {
+	return e.withSeverity(SeverityWarning)
+}
+
+// Information returns the information messages.
+func (e *Errors) Information() []*Error {
+	return e.withSeverity(SeverityInformation)
+}
+
+func (e *Errors) withSeverity(severity Severity) []*Error {
+	var out []*Error
+	for _, err := range e.errors {
+		if err.Severity == severity {
+			out = append(out, err)
+		}
+	}
+	return out
+}
+
 // Validator is an interface for types that can validate themselves.
 type Validator interface {
 	Validate() error
@@ -150,16 +296,54 @@ func ValidateReference(field, ref string) error {
 	return nil
 }
 
+// ProfileRule checks the additional constraints a profile places on a resource.
+// It reports violations by adding them to errs.
+// resource is the struct being validated, or the JSON object, as a
+// map[string]any, of a resource checked in its JSON form.
+type ProfileRule func(resource any, errs *Errors)
+
+// defaultProfileRules holds the rules every new validator starts with, by
+// profile URL.
+var (
+	defaultProfileRulesMu sync.RWMutex
+	defaultProfileRules   = make(map[string][]ProfileRule)
+)
+
+// RegisterDefaultProfileRule adds a rule that every validator made by
+// NewFHIRValidator afterwards runs, like RegisterProfileRule. Packages that
+// define a profile call it from init, so that importing them is enough for
+// resources claiming the profile to be checked.
+func RegisterDefaultProfileRule(profileURL string, rule ProfileRule) {
+	defaultProfileRulesMu.Lock()
+	defer defaultProfileRulesMu.Unlock()
+	defaultProfileRules[profileURL] = append(defaultProfileRules[profileURL], rule)
+}
+
 // FHIRValidator provides comprehensive FHIR resource validation using struct tags.
 type FHIRValidator struct {
-	validate *validator.Validate
+	validate     *validator.Validate
+	profileRules map[string][]ProfileRule
+	registry     *conformance.Registry
+	profiles     *profileSet
+	terminology  TerminologyService
+	// local is the terminology service over the registry, used when none
+	// is set.
+	local *RegistryTerminology
 }
 
 // NewFHIRValidator creates a new FHIR validator with custom validation rules.
 func NewFHIRValidator() *FHIRValidator {
 	v := validator.New()
 
-	fv := &FHIRValidator{validate: v}
+	fv := &FHIRValidator{
+		validate:     v,
+		profileRules: make(map[string][]ProfileRule),
+	}
+	defaultProfileRulesMu.RLock()
+	for url, rules := range defaultProfileRules {
+		fv.profileRules[url] = slices.Clone(rules)
+	}
+	defaultProfileRulesMu.RUnlock()
 
 	// Register custom validators
 	if err := v.RegisterValidation("fhir_cardinality", fv.validateCardinality); err != nil {
@@ -175,28 +359,357 @@ func NewFHIRValidator() *FHIRValidator {
 	return fv
 }
 
-// Validate validates a FHIR resource using struct tags.
+// Validate validates a FHIR resource using struct tags. Warnings and
+// information are left out; Check returns them.
 func (fv *FHIRValidator) Validate(resource any) error {
+	errs, err := fv.Check(resource)
+	if err != nil {
+		return err
+	}
+	if errs.HasErrors() {
+		return errs.errorsOnly()
+	}
+	return nil
+}
+
+// Check validates a resource and returns every issue found, with its
+// severity, issue type, rule and FHIRPath location. resource is a generated
+// resource struct, or its JSON as a []byte, whose issues also carry the line
+// and column they were found at. JSON is first checked as written, as
+// described for ValidateJSON, then against the profiles it claims and the
+// core definition of its type, when the registry holds them. err is only
+// set when the check could not run.
+func (fv *FHIRValidator) Check(resource any) (*Errors, error) {
 	if resource == nil {
-		return fmt.Errorf("cannot validate nil resource")
+		return nil, fmt.Errorf("cannot validate nil resource")
+	}
+	if data, ok := resource.([]byte); ok {
+		return fv.checkJSON(data), nil
 	}
 
 	errs := &Errors{}
 	val := reflect.ValueOf(resource)
+	typeName := ""
+	if t := fv.dereferenceValue(val); t.Kind() == reflect.Struct {
+		typeName = t.Type().Name()
+	}
 
 	// Validate struct fields
 	fv.validateStruct(val, "", errs)
 
 	// Validate choice type constraints
 	fv.validateChoiceTypes(val, "", errs)
+	errs.fieldExpressions(0, val.Type())
+
+	// Validate rules of the profiles the resource claims; when none is in
+	// the registry, check the invariants and bindings of the core
+	// definition, or else the invariants of the generated types and the
+	// bindings in the struct tags
+	if !fv.validateProfiles(resource, typeName, fv.claimedProfiles(val), errs) && !fv.validateInvariants(resource, typeName, errs) {
+		from := len(errs.errors)
+		checkInvariants(resource, val, "", errs)
+		fv.validateBindings(val, "", errs)
+		errs.fieldExpressions(from, val.Type())
+	}
+	return errs, nil
+}
 
-	if errs.HasErrors() {
+// checkObject checks the JSON form of a resource against the definitions in
+// the registry.
+func (fv *FHIRValidator) checkObject(m map[string]any) *Errors {
+	errs := &Errors{}
+	rt := str(m, "resourceType")
+	if rt == "" {
+		errs.issuef(SeverityFatal, IssueStructure, "", "", "resourceType is missing")
 		return errs
 	}
+	if !fv.validateProfiles(m, rt, strs(obj(m, "meta"), "profile"), errs) {
+		fv.validateInvariants(m, rt, errs)
+	}
+	return errs
+}
+
+// CheckBundle checks a Bundle and the resource of each of its entries, and
+// returns the issues of all of them. The issues of an entry's resource are
+// located in the Bundle, as in Bundle.entry[2].resource.status. bundle is a
+// generated Bundle struct or its JSON, as for Check.
+func (fv *FHIRValidator) CheckBundle(bundle any) (*Errors, error) {
+	errs, err := fv.Check(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, ok := bundle.([]byte); ok {
+		m, err := decodeObject(data)
+		if err != nil {
+			return errs, nil
+		}
+		from := len(errs.errors)
+		for i, entry := range objects(m, "entry") {
+			if r := obj(entry, "resource"); r != nil {
+				errs.Merge(fmt.Sprintf("Bundle.entry[%d].resource", i), fv.checkObject(r))
+			}
+		}
+		errs.locate(from, jsonPositions(data))
+		return errs, nil
+	}
 
+	entries := fv.dereferenceValue(reflect.ValueOf(bundle))
+	if entries.Kind() == reflect.Struct {
+		entries = entries.FieldByName("Entry")
+	}
+	if entries.Kind() != reflect.Slice {
+		return errs, nil
+	}
+	for i := 0; i < entries.Len(); i++ {
+		entry := fv.dereferenceValue(entries.Index(i))
+		if entry.Kind() != reflect.Struct {
+			continue
+		}
+		raw, _ := entry.FieldByName("Resource").Interface().(json.RawMessage)
+		if len(raw) == 0 {
+			continue
+		}
+		location := fmt.Sprintf("Bundle.entry[%d].resource", i)
+		r, err := decodeObject(raw)
+		if err != nil {
+			errs.issuef(SeverityFatal, IssueStructure, "", location, "%v", err).Expression = location
+			continue
+		}
+		errs.Merge(location, fv.checkObject(r))
+	}
+	return errs, nil
+}
+
+// SetRegistry sets the conformance resources, such as StructureDefinitions
+// and ValueSets, the validator resolves canonical references against. It is
+// usually the registry the server loaded its packages into. Once set, a
+// resource is also checked against the StructureDefinition of each profile
+// in its meta.profile that the registry holds.
+func (fv *FHIRValidator) SetRegistry(reg *conformance.Registry) {
+	fv.registry = reg
+	fv.profiles = nil
+	fv.local = nil
+	if reg != nil {
+		fv.profiles = newProfileSet(reg)
+		fv.local = NewRegistryTerminology(reg)
+	}
+}
+
+// SetTerminology sets the service that bound codes are checked with, such
+// as a client of a terminology server. Without one, codes are checked
+// against the ValueSets and CodeSystems of the registry. Bindings come from
+// the StructureDefinitions a resource is checked against, and from the
+// binding= part of fhir struct tags.
+func (fv *FHIRValidator) SetTerminology(ts TerminologyService) {
+	fv.terminology = ts
+}
+
+// terminologyService returns the service set with SetTerminology, or the
+// one over the registry, or nil if there is neither.
+func (fv *FHIRValidator) terminologyService() TerminologyService {
+	if fv.terminology != nil {
+		return fv.terminology
+	}
+	if fv.local != nil {
+		return fv.local
+	}
 	return nil
 }
 
+// Registry returns the registry set with SetRegistry, or nil.
+func (fv *FHIRValidator) Registry() *conformance.Registry {
+	return fv.registry
+}
+
+// RegisterProfileRule adds a rule that runs for every resource whose
+// meta.profile lists profileURL, with or without a |version suffix.
+// Several rules may be registered per profile.
+func (fv *FHIRValidator) RegisterProfileRule(profileURL string, rule ProfileRule) {
+	fv.profileRules[profileURL] = append(fv.profileRules[profileURL], rule)
+}
+
+// validateProfiles runs the registered rules for each profile a resource of
+// type resourceType claims, and checks the resource against the profiles'
+// definitions found in the registry. It reports whether any definition was
+// found.
+func (fv *FHIRValidator) validateProfiles(resource any, resourceType string, claimed []string, errs *Errors) bool {
+	if len(fv.profileRules) == 0 && fv.profiles == nil {
+		return false
+	}
+	checked := false
+	for i, profile := range claimed {
+		from := len(errs.errors)
+		url, _, _ := strings.Cut(profile, "|")
+		for _, rule := range fv.profileRules[url] {
+			rule(resource, errs)
+		}
+		if fv.profiles == nil {
+			continue
+		}
+		p, err := fv.profiles.get(profile, "")
+		if errors.Is(err, errProfileNotFound) {
+			continue
+		}
+		if err == nil {
+			checked = true
+			err = fv.validateAgainst(p, resource, errs, nil)
+		}
+		if err != nil {
+			e := errs.issuef(SeverityError, IssueProcessing, ruleProfile, "Meta.Profile", "%s: %v", profile, err)
+			e.Expression = fmt.Sprintf("%s.meta.profile[%d]", resourceType, i)
+		}
+		errs.fieldExpressions(from, nil)
+	}
+	return checked
+}
+
+// validateInvariants checks the constraints and bindings of the core
+// definition of the resource's type, such as dom-3 and obs-6, when the
+// registry holds it. Cardinalities and types are left to the struct tags.
+// It reports whether the definition was found.
+func (fv *FHIRValidator) validateInvariants(resource any, resourceType string, errs *Errors) bool {
+	if fv.profiles == nil || resourceType == "" {
+		return false
+	}
+	p, err := fv.profiles.get(coreCanonical+resourceType, "")
+	if err != nil {
+		return false
+	}
+	m, err := fv.instance(resource)
+	if err != nil {
+		errs.issuef(SeverityFatal, IssueStructure, "", "", "%v", err)
+		return true
+	}
+	from := len(errs.errors)
+	iv := &instanceValidator{set: fv.profiles, errs: errs, root: m, terminology: fv.terminologyService(), invariantsOnly: true}
+	iv.validate(p, m, p.root.def.Path)
+	errs.fieldExpressions(from, nil)
+	return true
+}
+
+// ValidateProfile checks a resource against the profile StructureDefinition
+// a canonical resolves to in the registry, whether or not the resource
+// claims it. resource is a generated resource struct or its JSON. The
+// profile's snapshot is generated when it has none. Cardinalities, fixed
+// values, patterns, types, target profiles, slicing and the extensions used
+// are checked; locations in the returned *Errors are FHIRPath-like, such as
+// Patient.identifier[1].system. Failed invariants are errors unless their
+// severity is warning. Bound codes are checked against their ValueSets; see
+// SetTerminology. Warnings and information are left out, and CheckProfile
+// returns them.
+func (fv *FHIRValidator) ValidateProfile(resource any, profile string) error {
+	p, err := fv.profile(profile)
+	if err != nil {
+		return err
+	}
+	errs, err := fv.checkProfile(p, resource)
+	if err != nil {
+		return err
+	}
+	if errs.HasErrors() {
+		return errs.errorsOnly()
+	}
+	return nil
+}
+
+// CheckProfile is like ValidateProfile but returns every issue found,
+// warnings and information included, such as failed best-practice
+// constraints and codes outside a preferred ValueSet. err is only set when
+// the check could not run.
+func (fv *FHIRValidator) CheckProfile(resource any, profile string) (*Errors, error) {
+	p, err := fv.profile(profile)
+	if err != nil {
+		return nil, err
+	}
+	return fv.checkProfile(p, resource)
+}
+
+func (fv *FHIRValidator) checkProfile(p *profile, resource any) (*Errors, error) {
+	errs := &Errors{}
+	if err := fv.validateAgainst(p, resource, errs, nil); err != nil {
+		return nil, err
+	}
+	if data, ok := resource.([]byte); ok {
+		errs.locate(0, jsonPositions(data))
+	}
+	return errs, nil
+}
+
+// MissingMustSupport lists the mustSupport elements of a profile that a
+// resource has no value for, such as Patient.identifier:NID. Elements are
+// only listed when their parent is present. Missing mustSupport elements
+// are not errors; systems report them to show what data they lack.
+func (fv *FHIRValidator) MissingMustSupport(resource any, profile string) ([]string, error) {
+	p, err := fv.profile(profile)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	if err := fv.validateAgainst(p, resource, &Errors{}, &missing); err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
+
+func (fv *FHIRValidator) profile(canonical string) (*profile, error) {
+	if fv.profiles == nil {
+		return nil, fmt.Errorf("profile %s: no registry set", canonical)
+	}
+	return fv.profiles.get(canonical, "")
+}
+
+// validateAgainst checks resource against p, collecting the mustSupport
+// elements without a value in mustSupport when it is not nil.
+func (fv *FHIRValidator) validateAgainst(p *profile, resource any, errs *Errors, mustSupport *[]string) error {
+	m, err := fv.instance(resource)
+	if err != nil {
+		return fmt.Errorf("profile %s: %w", p.sd.URL, err)
+	}
+	from := len(errs.errors)
+	iv := &instanceValidator{set: fv.profiles, errs: errs, root: m, terminology: fv.terminologyService(), mustSupport: mustSupport}
+	iv.validate(p, m, p.root.def.Path)
+	errs.fieldExpressions(from, nil)
+	return nil
+}
+
+// instance returns the JSON form of a resource.
+func (fv *FHIRValidator) instance(resource any) (map[string]any, error) {
+	m, err := jsonObject(resource)
+	if err != nil {
+		return nil, err
+	}
+	// Generated structs leave resourceType empty unless it is set; their
+	// type names the resource
+	if _, ok := m["resourceType"]; !ok || m["resourceType"] == "" {
+		if t := fv.dereferenceValue(reflect.ValueOf(resource)); t.Kind() == reflect.Struct {
+			m["resourceType"] = t.Type().Name()
+		}
+	}
+	return m, nil
+}
+
+// claimedProfiles returns Meta.Profile of a resource, or nil if it has none.
+func (fv *FHIRValidator) claimedProfiles(v reflect.Value) []string {
+	v = fv.dereferenceValue(v)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	meta := fv.dereferenceValue(v.FieldByName("Meta"))
+	if meta.Kind() != reflect.Struct {
+		return nil
+	}
+	profile := meta.FieldByName("Profile")
+	if profile.Kind() != reflect.Slice || profile.Type().Elem().Kind() != reflect.String {
+		return nil
+	}
+	profiles := make([]string, profile.Len())
+	for i := range profiles {
+		profiles[i] = profile.Index(i).String()
+	}
+	return profiles
+}
+
 // validateStruct recursively validates a struct and its fields.
 func (fv *FHIRValidator) validateStruct(v reflect.Value, path string, errs *Errors) {
 	// Dereference pointers
@@ -253,6 +766,15 @@ func (fv *FHIRValidator) validateField(v reflect.Value, path string, errs *Error
 		v = v.Elem()
 	}
 
+	if v.Type().PkgPath() == primitivesPkg && v.Type().Implements(stringer) {
+		fv.checkPrimitiveValue(v, path, errs)
+		return
+	}
+	if v.Kind() == reflect.String && v.Type().Implements(codeEnum) {
+		fv.checkCode(v, path, errs)
+		return
+	}
+
 	switch v.Kind() {
 	case reflect.Struct:
 		fv.validateStruct(v, path, errs)
@@ -268,20 +790,32 @@ func (fv *FHIRValidator) validateField(v reflect.Value, path string, errs *Error
 func (fv *FHIRValidator) validateFHIRTag(v reflect.Value, path, tag string, errs *Errors) {
 	parts := strings.Split(tag, ",")
 
+	// Only one option of a choice is set, so a required choice is checked
+	// for the group in validateChoiceGroups rather than for each option
+	choice := getChoiceGroup(tag) != ""
+
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
 
 		switch {
 		case part == "required":
-			fv.checkRequired(v, path, errs)
+			if !choice {
+				fv.checkRequired(v, path, errs)
+			}
 		case strings.HasPrefix(part, "cardinality="):
 			cardStr := strings.TrimPrefix(part, "cardinality=")
+			if _, max, ok := strings.Cut(cardStr, ".."); ok && choice {
+				cardStr = "0.." + max
+			}
 			fv.checkCardinality(v, path, cardStr, errs)
 		case strings.HasPrefix(part, "enum="):
 			enumStr := strings.TrimPrefix(part, "enum=")
 			fv.checkEnum(v, path, enumStr, errs)
+		case strings.HasPrefix(part, "type="):
+			fv.checkPrimitive(v, path, strings.TrimPrefix(part, "type="), errs)
 		}
-		// Note: choice validation is handled separately in validateChoice
+		// Note: choice validation is handled separately in validateChoice,
+		// and bindings in validateBindings
 		// summary is metadata only, no validation needed
 	}
 }
@@ -291,7 +825,7 @@ func (fv *FHIRValidator) checkRequired(v reflect.Value, path string, errs *Error
 	// Dereference pointer
 	for v.Kind() == reflect.Ptr {
 		if v.IsNil() {
-			errs.Add(path, "required field is missing")
+			errs.issuef(SeverityError, IssueRequired, ruleCardinality, path, "required field is missing")
 			return
 		}
 		v = v.Elem()
@@ -299,7 +833,7 @@ func (fv *FHIRValidator) checkRequired(v reflect.Value, path string, errs *Error
 
 	// Check for zero values
 	if v.IsZero() {
-		errs.Add(path, "required field is missing or empty")
+		errs.issuef(SeverityError, IssueRequired, ruleCardinality, path, "required field is missing or empty")
 	}
 }
 
@@ -307,13 +841,13 @@ func (fv *FHIRValidator) checkRequired(v reflect.Value, path string, errs *Error
 func (fv *FHIRValidator) checkCardinality(v reflect.Value, path, cardinalityStr string, errs *Errors) {
 	parts := strings.Split(cardinalityStr, "..")
 	if len(parts) != 2 {
-		errs.Addf(path, "invalid cardinality format: %s", cardinalityStr)
+		errs.issuef(SeverityError, IssueProcessing, ruleCardinality, path, "invalid cardinality format: %s", cardinalityStr)
 		return
 	}
 
 	minCount, err := strconv.Atoi(parts[0])
 	if err != nil {
-		errs.Addf(path, "invalid cardinality min: %s", parts[0])
+		errs.issuef(SeverityError, IssueProcessing, ruleCardinality, path, "invalid cardinality min: %s", parts[0])
 		return
 	}
 
@@ -323,7 +857,7 @@ func (fv *FHIRValidator) checkCardinality(v reflect.Value, path, cardinalityStr
 	} else {
 		maxCount, err = strconv.Atoi(parts[1])
 		if err != nil {
-			errs.Addf(path, "invalid cardinality max: %s", parts[1])
+			errs.issuef(SeverityError, IssueProcessing, ruleCardinality, path, "invalid cardinality max: %s", parts[1])
 			return
 		}
 	}
@@ -347,14 +881,14 @@ func (fv *FHIRValidator) checkCardinality(v reflect.Value, path, cardinalityStr
 	// Validate
 	if minCount > 0 && count < minCount {
 		if minCount == 1 {
-			errs.Add(path, "required field is missing")
+			errs.issuef(SeverityError, IssueRequired, ruleCardinality, path, "required field is missing")
 		} else {
-			errs.Addf(path, "requires at least %d element(s), got %d", minCount, count)
+			errs.issuef(SeverityError, IssueRequired, ruleCardinality, path, "requires at least %d element(s), got %d", minCount, count)
 		}
 	}
 
 	if maxCount >= 0 && count > maxCount {
-		errs.Addf(path, "requires at most %d element(s), got %d", maxCount, count)
+		errs.issuef(SeverityError, IssueStructure, ruleCardinality, path, "requires at most %d element(s), got %d", maxCount, count)
 	}
 }
 
@@ -389,7 +923,145 @@ func (fv *FHIRValidator) checkEnum(v reflect.Value, path, enumStr string, errs *
 		}
 	}
 
-	errs.Addf(path, "invalid enum value '%s', must be one of: %s", strValue, enumStr)
+	errs.issuef(SeverityError, IssueCodeInvalid, ruleEnum, path, "invalid enum value '%s', must be one of: %s", strValue, enumStr)
+}
+
+// codeEnum is implemented by the typed enums of required code bindings,
+// such as r5.AdministrativeGender.
+var codeEnum = reflect.TypeFor[interface{ IsValid() bool }]()
+
+// checkCode checks that a typed enum that is set holds one of its codes.
+func (fv *FHIRValidator) checkCode(v reflect.Value, path string, errs *Errors) {
+	if v.String() == "" || v.Interface().(interface{ IsValid() bool }).IsValid() {
+		return
+	}
+	errs.issuef(SeverityError, IssueCodeInvalid, ruleEnum, path, "invalid %s code '%s'", v.Type().Name(), v.String())
+}
+
+// primitivesPkg is the import path of the primitive types, such as Date
+// and Canonical, whose values are checked against the format of their FHIR
+// type.
+var (
+	primitivesPkg = reflect.TypeOf(primitives.Date{}).PkgPath()
+	stringer      = reflect.TypeFor[fmt.Stringer]()
+)
+
+// checkPrimitive checks the values of a field against the format of the
+// FHIR primitive type typ, given by a type= tag, such as id for
+// Resource.id. Numbers are checked as written in JSON.
+func (fv *FHIRValidator) checkPrimitive(v reflect.Value, path, typ string, errs *Errors) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	var value string
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fv.checkPrimitive(v.Index(i), fmt.Sprintf("%s[%d]", path, i), typ, errs)
+		}
+		return
+	case reflect.String:
+		value = v.String()
+		if value == "" {
+			return // unset; cardinality covers required fields
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		value = strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	default:
+		return
+	}
+	if err := primitives.Validate(typ, value); err != nil {
+		errs.issuef(SeverityError, IssueValue, rulePrimitive, path, "%v", err)
+	}
+}
+
+// checkPrimitiveValue checks a value of the primitives package that is
+// set, against the format of its FHIR type: Date is a date, Base64Binary a
+// base64Binary, and so on.
+func (fv *FHIRValidator) checkPrimitiveValue(v reflect.Value, path string, errs *Errors) {
+	s := v.Interface().(fmt.Stringer)
+	if s.String() == "" {
+		return
+	}
+	name := v.Type().Name()
+	typ := strings.ToLower(name[:1]) + name[1:]
+	if err := primitives.Validate(typ, s.String()); err != nil {
+		errs.issuef(SeverityError, IssueValue, rulePrimitive, path, "%v", err)
+	}
+}
+
+// validateBindings checks the fields with a binding in their fhir tag, and
+// the fields of nested structs. It only runs when the resource was not
+// checked against a StructureDefinition, whose bindings are the same.
+func (fv *FHIRValidator) validateBindings(v reflect.Value, path string, errs *Errors) {
+	if fv.terminologyService() == nil {
+		return
+	}
+	v = fv.dereferenceValue(v)
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := fv.buildFieldPath(path, field.Name)
+			for _, part := range strings.Split(field.Tag.Get("fhir"), ",") {
+				if b, ok := strings.CutPrefix(strings.TrimSpace(part), "binding="); ok {
+					fv.checkTagBinding(v.Field(i), fieldPath, b, errs)
+				}
+			}
+			fv.validateBindings(v.Field(i), fieldPath, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fv.validateBindings(v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+}
+
+// checkTagBinding checks a field against a binding=strength:valueSet tag.
+// Fields of type string are codes; struct fields are typed by their Go type
+// name, such as CodeableConcept.
+func (fv *FHIRValidator) checkTagBinding(v reflect.Value, path, bindingStr string, errs *Errors) {
+	ts := fv.terminologyService()
+	if ts == nil {
+		return
+	}
+	strength, valueSet, ok := strings.Cut(bindingStr, ":")
+	if !ok {
+		errs.issuef(SeverityError, IssueProcessing, ruleBinding, path, "invalid binding format: %s", bindingStr)
+		return
+	}
+	b := &binding{Strength: strength, ValueSet: valueSet}
+
+	v = fv.dereferenceValue(v)
+	if v.Kind() == reflect.Slice {
+		for i := 0; i < v.Len(); i++ {
+			fv.checkTagBinding(v.Index(i), fmt.Sprintf("%s[%d]", path, i), bindingStr, errs)
+		}
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		checkBinding(ts, b, "code", v.String(), path, errs)
+	case reflect.Struct:
+		value, err := jsonObject(v.Interface())
+		if err != nil {
+			errs.issuef(SeverityError, IssueProcessing, ruleBinding, path, "%v", err)
+			return
+		}
+		checkBinding(ts, b, v.Type().Name(), value, path, errs)
+	}
 }
 
 // validateCardinality is a custom validator for go-playground/validator.
@@ -424,7 +1096,7 @@ func (fv *FHIRValidator) validateChoiceTypes(v reflect.Value, path string, errs
 	case reflect.Struct:
 		// Validate choice groups at this struct level
 		choiceGroups := fv.collectChoiceGroups(v, path, errs)
-		fv.validateChoiceGroups(choiceGroups, errs)
+		fv.validateChoiceGroups(choiceGroups, path, errs)
 
 	case reflect.Slice, reflect.Array:
 		// Recurse into each element of the slice/array
@@ -500,6 +1172,9 @@ func (fv *FHIRValidator) processChoiceField(field reflect.StructField, fieldValu
 
 	if isFieldSet(fieldValue) {
 		choiceGroups[choiceGroup] = append(choiceGroups[choiceGroup], fieldPath)
+	} else if _, ok := choiceGroups[choiceGroup]; !ok && hasTagPart(fhirTag, "required") {
+		// Record the required group, so that it is reported if no option is set
+		choiceGroups[choiceGroup] = nil
 	}
 }
 
@@ -510,11 +1185,18 @@ func (fv *FHIRValidator) recurseIntoStruct(fieldType reflect.Type, fieldValue re
 	fv.validateChoiceTypes(fieldValue, fieldPath, errs)
 }
 
-// validateChoiceGroups checks that each choice group has at most one field set.
-func (fv *FHIRValidator) validateChoiceGroups(choiceGroups map[string][]string, errs *Errors) {
+// validateChoiceGroups checks that each choice group has at most one field
+// set, and that required groups have one. Groups with no fields set are only
+// collected if they are required.
+func (fv *FHIRValidator) validateChoiceGroups(choiceGroups map[string][]string, path string, errs *Errors) {
 	for choiceGroup, fields := range choiceGroups {
+		if len(fields) == 0 {
+			element := strings.ToUpper(choiceGroup[:1]) + choiceGroup[1:] + "[x]"
+			errs.issuef(SeverityError, IssueRequired, ruleCardinality, fv.buildFieldPath(path, element),
+				"required field is missing")
+		}
 		if len(fields) > 1 {
-			errs.Addf(strings.Join(fields, ", "),
+			errs.issuef(SeverityError, IssueStructure, ruleChoice, strings.Join(fields, ", "),
 				"choice type '%s' has multiple fields set, only one is allowed: %s",
 				choiceGroup, strings.Join(fields, ", "))
 		}
@@ -534,6 +1216,17 @@ func getChoiceGroup(tag string) string {
 	return ""
 }
 
+// hasTagPart reports whether a FHIR struct tag has the given part, such as
+// "required" or "summary".
+func hasTagPart(tag, part string) bool {
+	for _, p := range strings.Split(tag, ",") {
+		if strings.TrimSpace(p) == part {
+			return true
+		}
+	}
+	return false
+}
+
 // isFieldSet checks if a field has a non-zero/non-nil value.
 func isFieldSet(v reflect.Value) bool {
 	switch v.Kind() {
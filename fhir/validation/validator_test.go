@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/zs-health/zh-fhir-go/fhir/internal/testutil"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
 )
 
 func TestValidateRequired(t *testing.T) {
@@ -676,3 +677,72 @@ func TestFHIRValidator_ChoiceTypeWithOtherValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestFHIRValidator_RegisterProfileRule(t *testing.T) {
+	type meta struct {
+		Profile []string
+	}
+	type resource struct {
+		Meta *meta
+		Name *string
+	}
+
+	fv := NewFHIRValidator()
+	calls := 0
+	fv.RegisterProfileRule("http://example.org/profile", func(r any, errs *Errors) {
+		calls++
+		if r.(*resource).Name == nil {
+			errs.Add("Name", "required by profile")
+		}
+	})
+
+	err := fv.Validate(&resource{Meta: &meta{Profile: []string{"http://example.org/profile"}}})
+	if err == nil {
+		t.Fatal("expected profile rule error, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("expected rule to run once, ran %d times", calls)
+	}
+
+	if err := fv.Validate(&resource{Meta: &meta{Profile: []string{"http://example.org/profile|1.2.0"}}}); err == nil {
+		t.Error("expected profile rule error for a versioned profile, got nil")
+	}
+	if calls != 2 {
+		t.Errorf("expected rule to run for a versioned profile, ran %d times", calls)
+	}
+
+	if err := fv.Validate(&resource{Meta: &meta{Profile: []string{"http://example.org/other"}}}); err != nil {
+		t.Errorf("unexpected error for unclaimed profile: %v", err)
+	}
+	if err := fv.Validate(&resource{}); err != nil {
+		t.Errorf("unexpected error without meta: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("rule ran for a resource that does not claim the profile")
+	}
+}
+
+func TestFHIRValidator_GeneratedPrimitiveTypes(t *testing.T) {
+	// The type= tags of the generated data types give their primitive formats
+	system, value, count := "has spaces", "12345", 0
+	p := &r5.Patient{Identifier: []r5.Identifier{{System: &system, Value: &value}}}
+	p.BaseResource.ResourceType = "Patient"
+	reg := &r5.Timing{Repeat: &r5.TimingRepeat{Count: &count}}
+
+	fv := NewFHIRValidator()
+	checkErrors(t, errorList(t, fv.Validate(p)), []string{
+		`Identifier[0].System: invalid FHIR uri: "has spaces"`,
+	})
+	checkErrors(t, errorList(t, fv.Validate(reg)), []string{
+		`Repeat.Count: invalid FHIR positiveInt: "0"`,
+	})
+
+	system = "http://example.org/mrn"
+	count = 2
+	if err := fv.Validate(p); err != nil {
+		t.Errorf("Validate(Patient) error = %v", err)
+	}
+	if err := fv.Validate(reg); err != nil {
+		t.Errorf("Validate(Timing) error = %v", err)
+	}
+}
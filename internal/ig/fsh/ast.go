@@ -0,0 +1,324 @@
+// Package fsh parses FHIR Shorthand (FSH) and exports the result as FHIR JSON.
+//
+// Parse turns one .fsh file into a Document: a list of entities (Profile,
+// Extension, Logical, Resource, Instance, Invariant, ValueSet, CodeSystem,
+// RuleSet, Mapping, Alias) carrying their metadata and rules with source
+// positions. Rule paths are stored fully qualified, with indentation already
+// resolved. Exporter combines the documents of an IG, expands rule sets and
+// aliases and produces CodeSystem, ValueSet, StructureDefinition and instance
+// resources the way SUSHI does for the parts of the grammar described at
+// https://hl7.org/fhir/uv/shorthand/.
+package fsh
+
+// Kind is the type of an FSH entity.
+type Kind int
+
+const (
+	KindAlias Kind = iota
+	KindProfile
+	KindExtension
+	KindLogical
+	KindResource
+	KindInstance
+	KindInvariant
+	KindValueSet
+	KindCodeSystem
+	KindRuleSet
+	KindMapping
+)
+
+var kindNames = [...]string{
+	KindAlias:      "Alias",
+	KindProfile:    "Profile",
+	KindExtension:  "Extension",
+	KindLogical:    "Logical",
+	KindResource:   "Resource",
+	KindInstance:   "Instance",
+	KindInvariant:  "Invariant",
+	KindValueSet:   "ValueSet",
+	KindCodeSystem: "CodeSystem",
+	KindRuleSet:    "RuleSet",
+	KindMapping:    "Mapping",
+}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// IsStructureDefinition reports whether entities of this kind export as a
+// StructureDefinition.
+func (k Kind) IsStructureDefinition() bool {
+	return k == KindProfile || k == KindExtension || k == KindLogical || k == KindResource
+}
+
+// Document is the parse result of one FSH file.
+type Document struct {
+	File     string
+	Entities []*Entity
+}
+
+// Entity is a top-level FSH declaration. Only the metadata fields that the
+// keyword allows are set.
+type Entity struct {
+	Kind Kind
+	Name string
+	Pos  Position
+
+	Parent          string
+	ID              string
+	Title           string
+	Description     string
+	InstanceOf      string
+	Usage           string // example, definition or inline
+	Severity        string // error or warning
+	Expression      string
+	XPath           string
+	Source          string
+	Target          string
+	Context         []Context
+	Characteristics []string
+
+	// Alias only
+	Value string
+
+	// RuleSet only: the unparsed body, which is parsed per insertion after
+	// parameter substitution
+	Params  []string
+	Body    string
+	BodyPos Position
+
+	Rules []Rule
+}
+
+// Context is one entry of an Extension's Context keyword. Quoted entries are
+// FHIRPath expressions.
+type Context struct {
+	Value  string
+	Quoted bool
+}
+
+// Rule is a single '*' line of an entity.
+type Rule interface {
+	// Position returns the location of the rule's '*'.
+	Position() Position
+	// RulePath returns the fully qualified element path, or "" if the rule
+	// applies to the entity itself.
+	RulePath() string
+}
+
+// RuleBase holds the fields every rule has.
+type RuleBase struct {
+	Pos  Position
+	Path string
+}
+
+func (r *RuleBase) Position() Position { return r.Pos }
+func (r *RuleBase) RulePath() string   { return r.Path }
+
+// PathRule names a path without changing it, to set the context for
+// indented rules.
+type PathRule struct {
+	RuleBase
+}
+
+// CardRule constrains cardinality: "* path min..max flags".
+type CardRule struct {
+	RuleBase
+	Min   string // "" if omitted
+	Max   string // "" if omitted
+	Flags []string
+}
+
+// FlagRule sets flags on one or more paths: "* path1 and path2 MS SU".
+type FlagRule struct {
+	RuleBase
+	Paths []string
+	Flags []string
+}
+
+// BindingRule binds a value set: "* path from ValueSet (strength)".
+type BindingRule struct {
+	RuleBase
+	ValueSet string
+	Strength string // "" defaults to required
+}
+
+// AssignmentRule assigns a value: "* path = value (exactly)".
+type AssignmentRule struct {
+	RuleBase
+	Value   Value
+	Exactly bool
+}
+
+// ContainsRule adds slices: "* path contains a 0..1 and Ext named b 1..1 MS".
+type ContainsRule struct {
+	RuleBase
+	Items []ContainsItem
+}
+
+// ContainsItem is one slice of a ContainsRule. Type is the extension or
+// profile for "Type named name" items.
+type ContainsItem struct {
+	Name  string
+	Type  string
+	Min   string
+	Max   string
+	Flags []string
+}
+
+// OnlyRule restricts types: "* path only Type or Reference(A | B)".
+type OnlyRule struct {
+	RuleBase
+	Types []TypeRef
+}
+
+// TypeRef is one type of an OnlyRule or AddElementRule.
+type TypeRef struct {
+	Name    string   // Type name, or Reference, Canonical or CodeableReference
+	Targets []string // Targets of Reference, Canonical and CodeableReference
+}
+
+// ObeysRule attaches invariants: "* path obeys inv-1 and inv-2".
+type ObeysRule struct {
+	RuleBase
+	Invariants []string
+}
+
+// CaretValueRule sets a property of the definition rather than the instance:
+// "* path ^short = "text"". Codes is set for rules on CodeSystem concepts
+// ("* #a #b ^property[0].code = #x") and ValueSet concepts.
+type CaretValueRule struct {
+	RuleBase
+	Codes   []CodeValue
+	Caret   string
+	Value   Value
+	Exactly bool
+}
+
+// InsertRule inserts a rule set: "* path insert RuleSet(param1, param2)".
+// Codes is the concept context when the rule is indented below a CodeSystem
+// concept.
+type InsertRule struct {
+	RuleBase
+	RuleSet string
+	Params  []string
+	Codes   []string
+}
+
+// ConceptRule defines a CodeSystem concept. Codes holds the hierarchy from
+// the top-level ancestor down to the concept.
+type ConceptRule struct {
+	RuleBase
+	Codes      []string
+	Display    string
+	Definition string
+}
+
+// Code returns the concept's own code.
+func (r *ConceptRule) Code() string {
+	return r.Codes[len(r.Codes)-1]
+}
+
+// ValueSetRule includes or excludes codes in a ValueSet, either listed
+// concepts or codes from systems and value sets, optionally filtered.
+type ValueSetRule struct {
+	RuleBase
+	Include   bool
+	Concepts  []CodeValue
+	System    string
+	ValueSets []string
+	Filters   []Filter
+}
+
+// Filter is one "where property operator value" clause.
+type Filter struct {
+	Property string
+	Op       string
+	Value    Value
+}
+
+// MappingRule maps a path: "* path -> "map" "comment" #language".
+type MappingRule struct {
+	RuleBase
+	Map      string
+	Comment  string
+	Language string
+}
+
+// AddElementRule defines a new element in a Logical or Resource:
+// "* path 0..1 MS Type "short" "definition"".
+type AddElementRule struct {
+	RuleBase
+	Min              string
+	Max              string
+	Flags            []string
+	Types            []TypeRef
+	ContentReference string
+	Short            string
+	Definition       string
+}
+
+// Value is the right-hand side of an assignment.
+type Value interface {
+	value()
+}
+
+// StringValue is a quoted or multi-line string.
+type StringValue string
+
+// NumberValue is a number as written.
+type NumberValue string
+
+// BoolValue is true or false.
+type BoolValue bool
+
+// CodeValue is a code with optional system, version and display.
+type CodeValue struct {
+	System  string
+	Version string
+	Code    string
+	Display string
+}
+
+// QuantityValue is a number with a UCUM unit ('mg') or a coded unit
+// (UCUM#mg). Unit is zero for a bare number in a ratio.
+type QuantityValue struct {
+	Value string
+	Unit  CodeValue
+}
+
+// RatioValue is "numerator : denominator".
+type RatioValue struct {
+	Numerator   Value
+	Denominator Value
+}
+
+// ReferenceValue is Reference(target) with an optional display.
+type ReferenceValue struct {
+	Target  string
+	Display string
+}
+
+// CanonicalValue is Canonical(target|version).
+type CanonicalValue struct {
+	Target  string
+	Version string
+}
+
+// RegexValue is /regex/, used in ValueSet filters.
+type RegexValue string
+
+// NameValue is an unquoted word: an instance name, a date or time, or an
+// alias. The exporter resolves it.
+type NameValue string
+
+func (StringValue) value()    {}
+func (NumberValue) value()    {}
+func (BoolValue) value()      {}
+func (CodeValue) value()      {}
+func (QuantityValue) value()  {}
+func (RatioValue) value()     {}
+func (ReferenceValue) value() {}
+func (CanonicalValue) value() {}
+func (RegexValue) value()     {}
+func (NameValue) value()      {}
@@ -0,0 +1,636 @@
+package fsh
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Config holds the IG settings that SUSHI reads from sushi-config.yaml.
+type Config struct {
+	// Canonical is the base of generated URLs: {Canonical}/{ResourceType}/{id}.
+	Canonical string
+	// FHIRVersion is written to StructureDefinition.fhirVersion. Defaults to 4.0.1.
+	FHIRVersion string
+	// Version is written to the version of exported conformance resources.
+	Version string
+	// Status is written to the status of exported conformance resources.
+	// Defaults to active.
+	Status string
+	// ElementType optionally returns the FHIR type of an element, such as
+	// "Coding" for Encounter.class. Without it the exporter infers the shape of
+	// assigned codes from the element name.
+	ElementType func(resourceType, path string) string
+	// IsArray optionally reports whether an element repeats, such as
+	// Patient.name. Without it only indexed paths produce arrays.
+	IsArray func(resourceType, path string) bool
+}
+
+// Resource is an exported FHIR resource in its JSON form.
+type Resource map[string]any
+
+// ResourceType returns the resourceType, or "" for data type instances.
+func (r Resource) ResourceType() string {
+	s, _ := r["resourceType"].(string)
+	return s
+}
+
+// ID returns the resource id.
+func (r Resource) ID() string {
+	s, _ := r["id"].(string)
+	return s
+}
+
+// URL returns the canonical URL of conformance resources.
+func (r Resource) URL() string {
+	s, _ := r["url"].(string)
+	return s
+}
+
+var leadingKeys = []string{"resourceType", "id", "meta", "url"}
+
+// MarshalJSON writes resourceType, id, meta and url first and the remaining
+// elements in name order.
+func (r Resource) MarshalJSON() ([]byte, error) {
+	keys := make([]string, 0, len(r))
+	for k := range r {
+		keys = append(keys, k)
+	}
+	rank := func(k string) int {
+		for i, lead := range leadingKeys {
+			if k == lead {
+				return i
+			}
+		}
+		return len(leadingKeys)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ri, rj := rank(keys[i]), rank(keys[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return keys[i] < keys[j]
+	})
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		kb, _ := json.Marshal(k)
+		buf.Write(kb)
+		buf.WriteByte(':')
+		vb, err := json.Marshal(r[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(vb)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Exporter turns parsed FSH documents into FHIR resources. Entities in all
+// documents share one namespace, so aliases, rule sets and profiles may be
+// defined in any file.
+type Exporter struct {
+	cfg      Config
+	docs     []*Document
+	entities map[string][]*Entity // by name, and by id where it differs
+	aliases  map[string]string
+	errs     ErrorList
+
+	rules     map[*Entity][]Rule
+	instances map[*Entity]Resource
+	active    map[*Entity]bool
+}
+
+// NewExporter prepares the export of docs.
+func NewExporter(cfg Config, docs ...*Document) *Exporter {
+	if cfg.FHIRVersion == "" {
+		cfg.FHIRVersion = "4.0.1"
+	}
+	if cfg.Status == "" {
+		cfg.Status = "active"
+	}
+	cfg.Canonical = strings.TrimSuffix(cfg.Canonical, "/")
+	return &Exporter{
+		cfg:       cfg,
+		docs:      docs,
+		entities:  make(map[string][]*Entity),
+		aliases:   make(map[string]string),
+		rules:     make(map[*Entity][]Rule),
+		instances: make(map[*Entity]Resource),
+		active:    make(map[*Entity]bool),
+	}
+}
+
+// Export returns the CodeSystems, ValueSets, StructureDefinitions and
+// instances defined by the documents, in source order. Inline instances are
+// only used where they are assigned. The error, if not nil, is an ErrorList;
+// resources that could be exported are returned regardless.
+func (x *Exporter) Export() ([]Resource, error) {
+	x.index()
+
+	var out []Resource
+	for _, doc := range x.docs {
+		for _, e := range doc.Entities {
+			var r Resource
+			switch e.Kind {
+			case KindCodeSystem:
+				r = x.exportCodeSystem(e)
+			case KindValueSet:
+				r = x.exportValueSet(e)
+			case KindProfile, KindExtension, KindLogical, KindResource:
+				r = x.exportStructureDefinition(e)
+			case KindInstance:
+				if e.Usage == "inline" {
+					x.instance(e)
+					continue
+				}
+				r = x.instance(e)
+				if r != nil && r.ResourceType() == "" {
+					x.errs.Add(e.Pos, "instance %s of data type %s must have Usage: #inline", e.Name, e.InstanceOf)
+					continue
+				}
+			case KindMapping:
+				if x.lookup(e.Source, KindProfile, KindExtension, KindLogical, KindResource) == nil {
+					x.errs.Add(e.Pos, "mapping %s: unknown Source %q", e.Name, e.Source)
+				}
+			}
+			if r != nil {
+				out = append(out, r)
+			}
+		}
+	}
+	x.errs.Sort()
+	return out, x.errs.Err()
+}
+
+func (x *Exporter) index() {
+	for _, doc := range x.docs {
+		for _, e := range doc.Entities {
+			if e.Kind == KindAlias {
+				if prev, ok := x.aliases[e.Name]; ok && prev != e.Value {
+					x.errs.Add(e.Pos, "alias %s redefined", e.Name)
+				}
+				x.aliases[e.Name] = e.Value
+				continue
+			}
+			if prev := x.lookup(e.Name, e.Kind); prev != nil {
+				x.errs.Add(e.Pos, "duplicate %s %s; first defined at %s", e.Kind, e.Name, prev.Pos)
+				continue
+			}
+			x.entities[e.Name] = append(x.entities[e.Name], e)
+		}
+	}
+	for _, doc := range x.docs {
+		for _, e := range doc.Entities {
+			if e.ID != "" && e.ID != e.Name && x.lookup(e.ID, e.Kind) == nil {
+				x.entities[e.ID] = append(x.entities[e.ID], e)
+			}
+		}
+	}
+}
+
+// lookup finds an entity by name or id, restricted to the given kinds.
+func (x *Exporter) lookup(name string, kinds ...Kind) *Entity {
+	for _, e := range x.entities[name] {
+		for _, k := range kinds {
+			if e.Kind == k {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// id returns the resource id of an entity.
+func (x *Exporter) id(e *Entity) string {
+	if e.ID != "" {
+		return e.ID
+	}
+	return e.Name
+}
+
+// entityRules returns the rules of e with rule sets inserted and soft
+// indexes resolved.
+func (x *Exporter) entityRules(e *Entity) []Rule {
+	if rules, ok := x.rules[e]; ok {
+		return rules
+	}
+	rules := x.resolveSoftIndexes(x.expand(e.Kind, e.Rules, nil))
+	x.rules[e] = rules
+	return rules
+}
+
+func (x *Exporter) expand(kind Kind, rules []Rule, inserting []string) []Rule {
+	var out []Rule
+	for _, r := range rules {
+		ins, ok := r.(*InsertRule)
+		if !ok {
+			out = append(out, r)
+			continue
+		}
+		rs := x.lookup(ins.RuleSet, KindRuleSet)
+		switch {
+		case rs == nil:
+			x.errs.Add(ins.Pos, "unknown RuleSet %s", ins.RuleSet)
+			continue
+		case containsString(inserting, rs.Name):
+			x.errs.Add(ins.Pos, "RuleSet %s inserts itself", rs.Name)
+			continue
+		case len(ins.Params) != len(rs.Params):
+			x.errs.Add(ins.Pos, "RuleSet %s takes %d parameters, got %d", rs.Name, len(rs.Params), len(ins.Params))
+			continue
+		}
+
+		body := rs.Body
+		for i, name := range rs.Params {
+			body = strings.ReplaceAll(body, "{"+name+"}", ins.Params[i])
+		}
+		if strings.Contains(ins.Path, "[+]") {
+			out = append(out, &PathRule{RuleBase: ins.RuleBase})
+		}
+		root := frame{path: strings.ReplaceAll(ins.Path, "[+]", "[=]"), codes: ins.Codes}
+		inserted, errs := parseRules(body, rs.BodyPos, kind, root)
+		for _, err := range errs {
+			err.Msg += " (inserted by " + ins.Pos.String() + ")"
+		}
+		x.errs = append(x.errs, errs...)
+		out = append(out, x.expand(kind, inserted, append(inserting, rs.Name))...)
+	}
+	return out
+}
+
+// resolveSoftIndexes returns copies of the rules with [+] and [=] replaced by
+// numeric indexes. Element paths and the caret paths of each element are
+// indexed independently.
+func (x *Exporter) resolveSoftIndexes(rules []Rule) []Rule {
+	idx := newSoftIndexer()
+	out := make([]Rule, 0, len(rules))
+	for _, rule := range rules {
+		r := cloneRule(rule)
+		base := r.ruleBase()
+		var err error
+		if base.Path, err = idx.resolve("", base.Path); err != nil {
+			x.errs.Add(base.Pos, "%v", err)
+		}
+		switch r := Rule(r).(type) {
+		case *FlagRule:
+			for i := range r.Paths {
+				r.Paths[i], _ = idx.resolve("", r.Paths[i])
+			}
+		case *CaretValueRule:
+			scope := "^" + r.Path
+			for _, c := range r.Codes {
+				scope += "#" + c.Code
+			}
+			if r.Caret, err = idx.resolve(scope, r.Caret); err != nil {
+				x.errs.Add(r.Pos, "%v", err)
+			}
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func (r *RuleBase) ruleBase() *RuleBase { return r }
+
+// cloneRule returns a shallow copy of r that can be rewritten without
+// changing the parsed document.
+func cloneRule(r Rule) interface {
+	Rule
+	ruleBase() *RuleBase
+} {
+	switch r := r.(type) {
+	case *PathRule:
+		c := *r
+		return &c
+	case *CardRule:
+		c := *r
+		return &c
+	case *FlagRule:
+		c := *r
+		c.Paths = append([]string(nil), r.Paths...)
+		return &c
+	case *BindingRule:
+		c := *r
+		return &c
+	case *AssignmentRule:
+		c := *r
+		return &c
+	case *ContainsRule:
+		c := *r
+		return &c
+	case *OnlyRule:
+		c := *r
+		return &c
+	case *ObeysRule:
+		c := *r
+		return &c
+	case *CaretValueRule:
+		c := *r
+		return &c
+	case *InsertRule:
+		c := *r
+		return &c
+	case *ConceptRule:
+		c := *r
+		return &c
+	case *ValueSetRule:
+		c := *r
+		return &c
+	case *MappingRule:
+		c := *r
+		return &c
+	case *AddElementRule:
+		c := *r
+		return &c
+	}
+	panic("fsh: unknown rule type")
+}
+
+// url returns the canonical URL of a conformance entity: a ^url caret rule
+// if it has one, else one built from the configured canonical.
+func (x *Exporter) url(e *Entity) string {
+	for _, r := range e.Rules {
+		if c, ok := r.(*CaretValueRule); ok && c.Path == "" && c.Caret == "url" && len(c.Codes) == 0 {
+			if s, ok := c.Value.(StringValue); ok {
+				return string(s)
+			}
+		}
+	}
+	if x.cfg.Canonical == "" {
+		return ""
+	}
+	var typ string
+	switch e.Kind {
+	case KindCodeSystem:
+		typ = "CodeSystem"
+	case KindValueSet:
+		typ = "ValueSet"
+	case KindInstance:
+		typ, _, _ = x.instanceType(e)
+	default:
+		typ = "StructureDefinition"
+	}
+	return x.cfg.Canonical + "/" + typ + "/" + x.id(e)
+}
+
+// canonical resolves a reference to a conformance resource, written as an
+// alias, an entity name or id, or a URL, with an optional |version.
+func (x *Exporter) canonical(ref string) string {
+	ref, version, hasVersion := strings.Cut(ref, "|")
+	url := ref
+	if v, ok := x.aliases[ref]; ok {
+		url = v
+	} else if e := x.lookup(ref, conformanceKinds...); e != nil {
+		if u := x.url(e); u != "" {
+			url = u
+		}
+	}
+	if hasVersion {
+		url += "|" + version
+	}
+	return url
+}
+
+// system resolves the system part of a code.
+func (x *Exporter) system(ref string) string {
+	if ref == "" {
+		return ""
+	}
+	if v, ok := x.aliases[ref]; ok {
+		return v
+	}
+	if e := x.lookup(ref, KindCodeSystem, KindInstance); e != nil {
+		return x.url(e)
+	}
+	return ref
+}
+
+// conformanceKinds are the entity kinds that export a canonical resource.
+var conformanceKinds = []Kind{KindCodeSystem, KindValueSet, KindProfile, KindExtension, KindLogical, KindResource, KindInstance}
+
+const coreBase = "http://hl7.org/fhir/StructureDefinition/"
+
+func coreURL(name string) string {
+	return coreBase + name
+}
+
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+// isCoreName reports whether name looks like a FHIR type rather than a URL or
+// local name.
+func isCoreName(name string) bool {
+	return namePattern.MatchString(name) && (primitiveTypes[name] || complexTypes[name] || name[0] >= 'A' && name[0] <= 'Z')
+}
+
+// jsonNumber converts FSH number syntax to a JSON number.
+func jsonNumber(s string) json.Number {
+	s = strings.TrimPrefix(s, "+")
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !jsonNumberPattern.MatchString(s) {
+		return json.Number(strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	return json.Number(s)
+}
+
+var jsonNumberPattern = regexp.MustCompile(`^-?(0|[1-9]\d*)(\.\d+)?([eE][+-]?\d+)?$`)
+
+// codeShape is the JSON form an assigned code takes.
+type codeShape int
+
+const (
+	shapeCode codeShape = iota
+	shapeCoding
+	shapeCodeableConcept
+	shapeQuantity
+)
+
+// shapeOf decides how to write a code assigned to path. The element type
+// hook wins; otherwise element names ending in Coding or named coding hold a
+// Coding, codes with a system go into a CodeableConcept and the rest are
+// plain code strings.
+func (x *Exporter) shapeOf(resourceType, path string, code CodeValue) codeShape {
+	if x.cfg.ElementType != nil {
+		switch x.cfg.ElementType(resourceType, stripIndexes(path)) {
+		case "Coding":
+			return shapeCoding
+		case "CodeableConcept":
+			return shapeCodeableConcept
+		case "code", "string", "uri":
+			return shapeCode
+		case "Quantity":
+			return shapeQuantity
+		}
+	}
+	name := lastName(path)
+	switch {
+	case name == "coding" || strings.HasSuffix(name, "Coding"):
+		return shapeCoding
+	case strings.HasSuffix(name, "CodeableConcept"):
+		return shapeCodeableConcept
+	case strings.HasSuffix(name, "Quantity"):
+		return shapeQuantity
+	case strings.HasSuffix(name, "Code") || code.System == "":
+		return shapeCode
+	}
+	return shapeCodeableConcept
+}
+
+func lastName(path string) string {
+	segs := splitPath(path)
+	if len(segs) == 0 {
+		return ""
+	}
+	return segs[len(segs)-1].Name
+}
+
+func stripIndexes(path string) string {
+	segs := splitPath(path)
+	for i := range segs {
+		segs[i].Brackets = nil
+	}
+	return joinSegments(segs)
+}
+
+// assign sets v at path below obj. base is the path of obj within a
+// resourceType, so that the element hooks see the full element path.
+func (x *Exporter) assign(obj map[string]any, resourceType, base, path string, v Value, pos Position, slices map[string]int) {
+	full := joinPath(base, stripIndexes(path))
+	value := x.jsonValue(v, resourceType, full, pos)
+	if value == nil {
+		return
+	}
+	var isArray func(string) bool
+	if x.cfg.IsArray != nil {
+		isArray = func(p string) bool { return x.cfg.IsArray(resourceType, joinPath(base, p)) }
+	}
+	if err := setPath(obj, path, value, slices, isArray); err != nil {
+		x.errs.Add(pos, "%s: %v", path, err)
+	}
+}
+
+// jsonValue converts an assigned value to JSON for the element at path.
+func (x *Exporter) jsonValue(v Value, resourceType, path string, pos Position) any {
+	switch v := v.(type) {
+	case StringValue:
+		return string(v)
+	case NumberValue:
+		return jsonNumber(string(v))
+	case BoolValue:
+		return bool(v)
+	case RegexValue:
+		return string(v)
+	case CodeValue:
+		coding := x.coding(v)
+		switch x.shapeOf(resourceType, path, v) {
+		case shapeCoding:
+			return coding
+		case shapeCodeableConcept:
+			return map[string]any{"coding": []any{coding}}
+		case shapeQuantity:
+			return x.quantity(QuantityValue{Unit: v})
+		}
+		return v.Code
+	case QuantityValue:
+		return x.quantity(v)
+	case RatioValue:
+		return map[string]any{
+			"numerator":   x.quantity(asQuantity(v.Numerator)),
+			"denominator": x.quantity(asQuantity(v.Denominator)),
+		}
+	case ReferenceValue:
+		ref := map[string]any{"reference": x.reference(v.Target)}
+		if v.Display != "" {
+			ref["display"] = v.Display
+		}
+		return ref
+	case CanonicalValue:
+		c := x.canonical(v.Target)
+		if v.Version != "" {
+			c += "|" + v.Version
+		}
+		return c
+	case NameValue:
+		name := string(v)
+		if e := x.lookup(name, KindInstance); e != nil {
+			if r := x.instance(e); r != nil {
+				return deepCopy(map[string]any(r))
+			}
+			return nil
+		}
+		if a, ok := x.aliases[name]; ok {
+			return a
+		}
+		return name
+	}
+	x.errs.Add(pos, "unsupported value %T", v)
+	return nil
+}
+
+func asQuantity(v Value) QuantityValue {
+	switch v := v.(type) {
+	case QuantityValue:
+		return v
+	case NumberValue:
+		return QuantityValue{Value: string(v)}
+	}
+	return QuantityValue{}
+}
+
+func (x *Exporter) coding(c CodeValue) map[string]any {
+	m := map[string]any{"code": c.Code}
+	if c.System != "" {
+		m["system"] = x.system(c.System)
+	}
+	if c.Version != "" {
+		m["version"] = c.Version
+	}
+	if c.Display != "" {
+		m["display"] = c.Display
+	}
+	return m
+}
+
+func (x *Exporter) quantity(q QuantityValue) map[string]any {
+	m := make(map[string]any)
+	if q.Value != "" {
+		m["value"] = jsonNumber(q.Value)
+	}
+	if q.Unit.Code != "" {
+		m["code"] = q.Unit.Code
+		m["system"] = x.system(q.Unit.System)
+	}
+	if q.Unit.Display != "" {
+		m["unit"] = q.Unit.Display
+	}
+	return m
+}
+
+// reference resolves Reference(target): a local instance becomes Type/id.
+func (x *Exporter) reference(target string) string {
+	if e := x.lookup(target, KindInstance); e != nil {
+		if typ, _, ok := x.instanceType(e); ok {
+			return typ + "/" + x.instanceID(e)
+		}
+	}
+	if a, ok := x.aliases[target]; ok {
+		return a
+	}
+	return target
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,386 @@
+package fsh
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCanonical = "https://fhir.example.org"
+
+// exportCorpus exports every file in testdata and returns the resources by id.
+func exportCorpus(t *testing.T) map[string]map[string]any {
+	t.Helper()
+	files, err := filepath.Glob("testdata/*.fsh")
+	require.NoError(t, err)
+
+	var docs []*Document
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		doc, err := Parse(file, src)
+		require.NoError(t, err)
+		docs = append(docs, doc)
+	}
+
+	resources, err := NewExporter(Config{Canonical: testCanonical, Version: "0.1.0"}, docs...).Export()
+	require.NoError(t, err)
+
+	out := make(map[string]map[string]any)
+	for _, r := range resources {
+		out[r.ResourceType()+"/"+r.ID()] = roundTrip(t, r)
+	}
+	return out
+}
+
+// roundTrip returns r as decoded JSON, so tests see exactly what is written.
+func roundTrip(t *testing.T, r Resource) map[string]any {
+	t.Helper()
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(b, &m))
+	return m
+}
+
+// at returns the value at a dotted path such as "concept.2.display".
+func at(t *testing.T, v any, path string) any {
+	t.Helper()
+	for _, part := range strings.Split(path, ".") {
+		switch x := v.(type) {
+		case map[string]any:
+			v = x[part]
+		case []any:
+			i, err := strconv.Atoi(part)
+			require.NoError(t, err, path)
+			require.Less(t, i, len(x), path)
+			v = x[i]
+		default:
+			t.Fatalf("%s: %s is not an object or array", path, part)
+		}
+	}
+	return v
+}
+
+// element returns the differential element with the given id.
+func element(t *testing.T, sd map[string]any, id string) map[string]any {
+	t.Helper()
+	for _, el := range at(t, sd, "differential.element").([]any) {
+		if el := el.(map[string]any); el["id"] == id {
+			return el
+		}
+	}
+	t.Fatalf("no element %s", id)
+	return nil
+}
+
+func TestExportCorpus(t *testing.T) {
+	out := exportCorpus(t)
+
+	var ids []string
+	for id := range out {
+		ids = append(ids, id)
+	}
+	assert.ElementsMatch(t, []string{
+		"CodeSystem/rohingya-camp",
+		"ValueSet/rohingya-camp", "ValueSet/vitals", "ValueSet/MaritalVS", "ValueSet/ReligionVS",
+		"StructureDefinition/bd-patient", "StructureDefinition/BDHeartRate",
+		"StructureDefinition/religion", "StructureDefinition/shelter-location",
+		"StructureDefinition/camp-visit", "StructureDefinition/Referral", "StructureDefinition/CampVisitSD",
+		"Patient/ShafiqPatient", "Observation/PulseExample",
+	}, ids, "inline instances, invariants, rule sets, mappings and aliases are not exported")
+}
+
+func TestExportCodeSystem(t *testing.T) {
+	cs := exportCorpus(t)["CodeSystem/rohingya-camp"]
+
+	assert.Equal(t, "https://fhir.example.org/CodeSystem/rohingya-camp", cs["url"])
+	assert.Equal(t, "RohingyaCampCS", cs["name"])
+	assert.Equal(t, "0.1.0", cs["version"])
+	assert.Equal(t, "active", cs["status"])
+	assert.Equal(t, "complete", cs["content"])
+	assert.Equal(t, true, cs["caseSensitive"])
+	assert.Equal(t, float64(6), cs["count"])
+	assert.Equal(t, "Camps in Cox's Bazar hosting Rohingya refugees.\nCodes follow the RRRC numbering.", cs["description"])
+
+	assert.Equal(t, "Kutupalong camp 1 east", at(t, cs, "concept.0.definition"))
+	assert.Equal(t, "kutupalong", at(t, cs, "concept.2.code"))
+	assert.Equal(t, "Camp 2W", at(t, cs, "concept.2.concept.1.display"))
+	assert.Equal(t, "block-a", at(t, cs, "concept.2.concept.1.concept.0.code"))
+	assert.Equal(t, "bn", at(t, cs, "concept.2.designation.0.language"))
+	assert.Equal(t, "কুতুপালং", at(t, cs, "concept.2.designation.0.value"))
+	assert.Equal(t, "active", at(t, cs, "concept.2.concept.0.property.0.valueCode"))
+}
+
+func TestExportValueSet(t *testing.T) {
+	out := exportCorpus(t)
+
+	camps := out["ValueSet/rohingya-camp"]
+	assert.Equal(t, "https://fhir.example.org/CodeSystem/rohingya-camp", at(t, camps, "compose.include.0.system"))
+	assert.Equal(t, "camp-1w", at(t, camps, "compose.exclude.0.concept.0.code"))
+
+	vitals := out["ValueSet/vitals"]
+	assert.Equal(t, false, vitals["experimental"])
+	assert.Equal(t, "http://loinc.org", at(t, vitals, "compose.include.0.system"))
+	assert.Len(t, at(t, vitals, "compose.include.0.concept"), 2, "codes of one system share a component")
+	assert.Equal(t, "Pulse", at(t, vitals, "compose.include.0.concept.0.designation.0.value"))
+	assert.Equal(t, "271649006", at(t, vitals, "compose.include.1.concept.0.code"))
+	assert.Equal(t, []any{
+		map[string]any{"property": "concept", "op": "is-a", "value": "75367002"},
+		map[string]any{"property": "display", "op": "regex", "value": "pressure"},
+	}, at(t, vitals, "compose.include.2.filter"))
+	assert.Equal(t, []any{"https://fhir.example.org/ValueSet/rohingya-camp"}, at(t, vitals, "compose.include.3.valueSet"))
+}
+
+func TestExportProfile(t *testing.T) {
+	sd := exportCorpus(t)["StructureDefinition/bd-patient"]
+
+	assert.Equal(t, "https://fhir.example.org/StructureDefinition/bd-patient", sd["url"])
+	assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/Patient", sd["baseDefinition"])
+	assert.Equal(t, "constraint", sd["derivation"])
+	assert.Equal(t, "resource", sd["kind"])
+	assert.Equal(t, "Patient", sd["type"])
+	assert.Equal(t, "4.0.1", sd["fhirVersion"])
+	assert.Equal(t, "draft", sd["status"])
+	assert.Equal(t, "Directorate General of Health Services", sd["publisher"], "rule set inserted")
+	assert.Equal(t, "Profile Bangladesh patient, id bd-patient.", sd["purpose"], "rule set parameters substituted")
+
+	identifier := element(t, sd, "Patient.identifier")
+	assert.Equal(t, float64(1), identifier["min"])
+	assert.Equal(t, true, identifier["mustSupport"])
+	assert.Equal(t, "value", at(t, identifier, "slicing.discriminator.0.type"))
+
+	nid := element(t, sd, "Patient.identifier:nid")
+	assert.Equal(t, "nid", nid["sliceName"])
+	assert.Equal(t, "1", nid["max"])
+	assert.Equal(t, "http://example.org/nid", element(t, sd, "Patient.identifier:nid.system")["fixedUri"])
+	assert.Equal(t, "http://example.org/brn", element(t, sd, "Patient.identifier:brn.system")["patternUri"])
+
+	assert.Equal(t, true, element(t, sd, "Patient.name.family")["isSummary"], "indented rule under name")
+	assert.Equal(t, "required", at(t, element(t, sd, "Patient.gender"), "binding.strength"))
+	assert.Equal(t, "https://fhir.example.org/ValueSet/MaritalVS", at(t, element(t, sd, "Patient.maritalStatus"), "binding.valueSet"))
+
+	assert.Equal(t, "url", at(t, element(t, sd, "Patient.extension"), "slicing.discriminator.0.path"))
+	religion := element(t, sd, "Patient.extension:religion")
+	assert.Equal(t, "https://fhir.example.org/StructureDefinition/religion", at(t, religion, "type.0.profile.0"))
+
+	assert.Equal(t, []any{
+		"http://hl7.org/fhir/StructureDefinition/Practitioner",
+		"http://hl7.org/fhir/StructureDefinition/Organization",
+	}, at(t, element(t, sd, "Patient.generalPractitioner"), "type.0.targetProfile"))
+	assert.Equal(t, "boolean", at(t, element(t, sd, "Patient.deceased[x]"), "type.0.code"))
+
+	root := element(t, sd, "Patient")
+	assert.Equal(t, "bd-1", at(t, root, "constraint.0.key"))
+	assert.Equal(t, "name.exists() or identifier.exists()", at(t, root, "constraint.0.expression"))
+	assert.Equal(t, "warning", at(t, element(t, sd, "Patient.birthDate"), "constraint.0.severity"), "invariant with rules")
+}
+
+func TestExportProfileChoiceAssignment(t *testing.T) {
+	sd := exportCorpus(t)["StructureDefinition/BDHeartRate"]
+
+	assert.Equal(t, "final", element(t, sd, "Observation.status")["patternCode"])
+	assert.Equal(t, "vital-signs", at(t, element(t, sd, "Observation.category"), "patternCodeableConcept.coding.0.code"))
+
+	q := element(t, sd, "Observation.value[x]:valueQuantity")
+	assert.Equal(t, "valueQuantity", q["sliceName"])
+	assert.Equal(t, map[string]any{"value": float64(60), "system": UCUM, "code": "beats/minute"}, q["patternQuantity"])
+	assert.Equal(t, float64(1), element(t, sd, "Observation.value[x]:valueQuantity.value")["min"])
+}
+
+func TestExportExtensions(t *testing.T) {
+	out := exportCorpus(t)
+
+	religion := out["StructureDefinition/religion"]
+	assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/Extension", religion["baseDefinition"])
+	assert.Equal(t, "complex-type", religion["kind"])
+	assert.Equal(t, "Extension", religion["type"])
+	assert.Equal(t, []any{map[string]any{"type": "element", "expression": "Patient"}}, religion["context"])
+	assert.Equal(t, "https://fhir.example.org/StructureDefinition/religion", element(t, religion, "Extension.url")["fixedUri"])
+	assert.Equal(t, "extensible", at(t, element(t, religion, "Extension.value[x]:valueCodeableConcept"), "binding.strength"))
+
+	shelter := out["StructureDefinition/shelter-location"]
+	assert.Equal(t, map[string]any{"type": "fhirpath", "expression": "Patient.address"}, at(t, shelter, "context.1"))
+	assert.Equal(t, "0", element(t, shelter, "Extension.value[x]")["max"], "complex extensions have no value")
+	assert.Equal(t, float64(1), element(t, shelter, "Extension.extension:camp")["min"])
+	assert.Equal(t, "camp", element(t, shelter, "Extension.extension:camp.url")["fixedUri"])
+	assert.Equal(t, "Shelter number", element(t, shelter, "Extension.extension:shelter")["short"])
+	assert.Equal(t, "https://fhir.example.org/ValueSet/rohingya-camp",
+		at(t, element(t, shelter, "Extension.extension:camp.value[x]:valueCoding"), "binding.valueSet"))
+}
+
+func TestExportLogicalAndResource(t *testing.T) {
+	out := exportCorpus(t)
+
+	visit := out["StructureDefinition/camp-visit"]
+	assert.Equal(t, "logical", visit["kind"])
+	assert.Equal(t, "specialization", visit["derivation"])
+	assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/Base", visit["baseDefinition"])
+	assert.Equal(t, "can-be-target", at(t, visit, "extension.0.valueCode"))
+
+	visitor := element(t, visit, "CampVisit.visitor")
+	assert.Equal(t, "Visitor", visitor["short"])
+	assert.Equal(t, "Health worker who made the visit", visitor["definition"])
+	assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/Practitioner", at(t, visitor, "type.0.targetProfile.0"))
+	assert.Equal(t, "markdown", at(t, element(t, visit, "CampVisit.finding.note"), "type.0.code"))
+	assert.Equal(t, "#CampVisit.finding", element(t, visit, "CampVisit.shelter")["contentReference"])
+
+	assert.Equal(t, map[string]any{"identity": "encounter", "name": "FHIR Encounter", "uri": "http://hl7.org/fhir/StructureDefinition/Encounter"}, at(t, visit, "mapping.0"))
+	assert.Equal(t, "Encounter", at(t, element(t, visit, "CampVisit"), "mapping.0.map"))
+	assert.Equal(t, "Only health workers", at(t, visitor, "mapping.0.comment"))
+
+	referral := out["StructureDefinition/Referral"]
+	assert.Equal(t, "resource", referral["kind"])
+	assert.Equal(t, "Referral", referral["type"])
+	assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/DomainResource", referral["baseDefinition"])
+	assert.Equal(t, "required", at(t, element(t, referral, "Referral.status"), "binding.strength"))
+}
+
+func TestExportInstances(t *testing.T) {
+	out := exportCorpus(t)
+
+	p := out["Patient/ShafiqPatient"]
+	assert.Equal(t, []any{"https://fhir.example.org/StructureDefinition/bd-patient"}, at(t, p, "meta.profile"))
+	assert.Equal(t, "1990-02-14", p["birthDate"])
+	assert.Equal(t, "male", p["gender"])
+	assert.Equal(t, []any{"Shafiq", "Ul"}, at(t, p, "name.given"))
+	assert.Equal(t, "https://fhir.example.org/StructureDefinition/religion", at(t, p, "extension.0.url"))
+	assert.Equal(t, "1023", at(t, p, "extension.0.valueCodeableConcept.coding.0.code"))
+
+	shelter := at(t, p, "address.0.extension.0").(map[string]any)
+	assert.Equal(t, "https://fhir.example.org/StructureDefinition/shelter-location", shelter["url"])
+	assert.Equal(t, "camp", at(t, shelter, "extension.0.url"))
+	assert.Equal(t, "https://fhir.example.org/CodeSystem/rohingya-camp", at(t, shelter, "extension.0.valueCoding.system"))
+	assert.Equal(t, map[string]any{"url": "block", "valueString": "B-12"}, at(t, shelter, "extension.1"))
+
+	assert.Equal(t, map[string]any{"reference": "Practitioner/DrKarim", "display": "Dr Karim"}, p["generalPractitioner"])
+	assert.Equal(t, map[string]any{
+		"resourceType": "Practitioner",
+		"id":           "DrKarim",
+		"name":         map[string]any{"family": "Karim", "text": "Dr. Abdul Karim"},
+	}, at(t, p, "contained.0"), "inline instance embedded by name")
+
+	obs := out["Observation/PulseExample"]
+	assert.Equal(t, "Patient/ShafiqPatient", at(t, obs, "subject.reference"))
+	assert.Equal(t, map[string]any{"value": float64(72), "system": UCUM, "code": "beats/minute", "unit": "bpm"}, obs["valueQuantity"])
+	assert.Equal(t, []any{
+		map[string]any{"text": "Resting", "authorString": "Nurse"},
+		map[string]any{"text": "Follow up"},
+	}, obs["note"], "soft indexes")
+
+	def := out["StructureDefinition/CampVisitSD"]
+	assert.Equal(t, "https://fhir.example.org/StructureDefinition/CampVisitSD", def["url"], "definitional instance")
+	assert.Equal(t, "0.1.0", def["version"])
+	assert.Equal(t, "generated", at(t, def, "meta.tag.0.coding.0.code"))
+}
+
+func TestExportResourceJSONOrder(t *testing.T) {
+	b, err := json.Marshal(Resource{"url": "u", "b": 1, "id": "x", "a": 2, "resourceType": "ValueSet"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"resourceType":"ValueSet","id":"x","url":"u","a":2,"b":1}`, string(b))
+}
+
+func TestExportElementHooks(t *testing.T) {
+	doc, err := Parse("test.fsh", []byte(`Instance: E
+InstanceOf: Encounter
+* class = http://terminology.hl7.org/CodeSystem/v3-ActCode#AMB
+* type = $SCT#11429006
+* participant.individual = Reference(P)
+`))
+	require.NoError(t, err)
+
+	cfg := Config{
+		ElementType: func(resourceType, path string) string {
+			if resourceType == "Encounter" && path == "class" {
+				return "Coding"
+			}
+			return ""
+		},
+		IsArray: func(resourceType, path string) bool {
+			return path == "type" || path == "participant"
+		},
+	}
+	resources, err := NewExporter(cfg, doc).Export()
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	e := roundTrip(t, resources[0])
+	assert.Equal(t, "AMB", at(t, e, "class.code"))
+	assert.Equal(t, "11429006", at(t, e, "type.0.coding.0.code"))
+	assert.Equal(t, "P", at(t, e, "participant.0.individual.reference"))
+}
+
+func TestExportErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "unknown rule set",
+			src:  "Profile: P\nParent: Patient\n* insert Missing\n",
+			want: "test.fsh:3:1: unknown RuleSet Missing",
+		},
+		{
+			name: "rule set parameter count",
+			src:  "RuleSet: R(a, b)\n* ^title = \"{a} {b}\"\n\nProfile: P\nParent: Patient\n* insert R(x)\n",
+			want: "test.fsh:6:1: RuleSet R takes 2 parameters, got 1",
+		},
+		{
+			name: "recursive rule set",
+			src:  "RuleSet: R\n* insert R\n\nProfile: P\nParent: Patient\n* insert R\n",
+			want: "inserts itself",
+		},
+		{
+			name: "duplicate entity",
+			src:  "CodeSystem: CS\n* #a\n\nCodeSystem: CS\n* #b\n",
+			want: "test.fsh:4:1: duplicate CodeSystem CS",
+		},
+		{
+			name: "soft index before any element",
+			src:  "Instance: I\nInstanceOf: Patient\n* name[=].family = \"A\"\n",
+			want: "test.fsh:3:1: [=] in name[=].family",
+		},
+		{
+			name: "skipped index",
+			src:  "Instance: I\nInstanceOf: Patient\n* name[1].family = \"A\"\n",
+			want: "name[0] has no value",
+		},
+		{
+			name: "undefined parent concept",
+			src:  "CodeSystem: CS\n* #a #b ^designation[0].value = \"x\"\n",
+			want: "test.fsh:2:1: concept #a is not defined",
+		},
+		{
+			name: "parent cycle",
+			src:  "Profile: A\nParent: B\n\nProfile: B\nParent: A\n",
+			want: "test.fsh:1:1: Profile A is its own ancestor",
+		},
+		{
+			name: "unknown invariant",
+			src:  "Profile: P\nParent: Patient\n* obeys nope-1\n",
+			want: "test.fsh:3:1: unknown Invariant nope-1",
+		},
+		{
+			name: "instance without InstanceOf",
+			src:  "Instance: I\n* id = \"x\"\n",
+			want: "test.fsh:1:1: Instance I has no InstanceOf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse("test.fsh", []byte(tt.src))
+			require.NoError(t, err)
+			_, err = NewExporter(Config{Canonical: testCanonical}, doc).Export()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
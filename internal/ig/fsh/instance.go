@@ -0,0 +1,195 @@
+package fsh
+
+import (
+	"sort"
+	"strings"
+)
+
+// definitionalTypes are resource types whose definitional instances get a
+// canonical url, version and status like other conformance resources.
+var definitionalTypes = map[string]bool{
+	"ActivityDefinition": true, "CapabilityStatement": true, "CodeSystem": true, "ConceptMap": true,
+	"GraphDefinition": true, "ImplementationGuide": true, "Measure": true, "MessageDefinition": true,
+	"OperationDefinition": true, "PlanDefinition": true, "Questionnaire": true, "SearchParameter": true,
+	"StructureDefinition": true, "StructureMap": true, "ValueSet": true,
+}
+
+// instanceType returns the resource or data type of an instance and the
+// profile it claims, if InstanceOf names a local profile or extension.
+func (x *Exporter) instanceType(e *Entity) (typ, profile string, ok bool) {
+	if e.InstanceOf == "" {
+		return "", "", false
+	}
+	of := e.InstanceOf
+	if a, found := x.aliases[of]; found {
+		of = a
+	}
+	if sd := x.lookup(of, KindProfile, KindExtension, KindLogical, KindResource); sd != nil {
+		if sd.Kind == KindLogical || sd.Kind == KindResource {
+			return sd.Name, x.url(sd), true
+		}
+		_, typ, ok := x.parentOf(sd, nil)
+		return typ, x.url(sd), ok
+	}
+	if strings.HasPrefix(of, coreBase) {
+		return strings.TrimPrefix(of, coreBase), "", true
+	}
+	if isCoreName(of) {
+		return of, "", true
+	}
+	return "", "", false
+}
+
+// instanceID returns the id an instance is exported with: an assigned id, or
+// its name.
+func (x *Exporter) instanceID(e *Entity) string {
+	for _, r := range e.Rules {
+		if a, ok := r.(*AssignmentRule); ok && a.Path == "id" {
+			if s, ok := a.Value.(StringValue); ok {
+				return string(s)
+			}
+			if s, ok := a.Value.(NameValue); ok {
+				return string(s)
+			}
+		}
+	}
+	return e.Name
+}
+
+// instance exports an instance, or returns the cached result. Instances of
+// data types have no resourceType and id.
+func (x *Exporter) instance(e *Entity) Resource {
+	if r, ok := x.instances[e]; ok {
+		return r
+	}
+	if x.active[e] {
+		x.errs.Add(e.Pos, "instance %s contains itself", e.Name)
+		return nil
+	}
+	x.active[e] = true
+	defer delete(x.active, e)
+
+	typ, profile, ok := x.instanceType(e)
+	if !ok {
+		if e.InstanceOf == "" {
+			x.errs.Add(e.Pos, "Instance %s has no InstanceOf", e.Name)
+		} else {
+			x.errs.Add(e.Pos, "Instance %s: cannot resolve InstanceOf %s", e.Name, e.InstanceOf)
+		}
+		x.instances[e] = nil
+		return nil
+	}
+
+	r := Resource{}
+	isResource := kindOfType(typ) == "resource"
+	if isResource {
+		r["resourceType"] = typ
+		r["id"] = e.Name
+	}
+	if profile != "" && isResource {
+		r["meta"] = map[string]any{"profile": []any{profile}}
+	}
+	if e.Usage == "definition" && definitionalTypes[typ] {
+		if x.cfg.Canonical != "" {
+			r["url"] = x.cfg.Canonical + "/" + typ + "/" + e.Name
+		}
+		if x.cfg.Version != "" {
+			r["version"] = x.cfg.Version
+		}
+		r["status"] = x.cfg.Status
+	}
+
+	slices := make(map[string]int)
+	for _, rule := range x.entityRules(e) {
+		a, ok := rule.(*AssignmentRule)
+		if !ok {
+			continue
+		}
+		for _, ext := range x.extensionURLs(e, a.Path) {
+			if err := setPath(r, ext.path+".url", ext.url, slices, nil); err != nil {
+				x.errs.Add(a.Pos, "%s: %v", a.Path, err)
+			}
+		}
+		x.assign(r, typ, "", a.Path, a.Value, a.Pos, slices)
+	}
+
+	holes := findHoles(map[string]any(r), "")
+	sort.Strings(holes)
+	for _, h := range holes {
+		x.errs.Add(e.Pos, "instance %s: %s has no value", e.Name, h)
+	}
+
+	x.instances[e] = r
+	return r
+}
+
+// extensionURL is the url an extension slice named in an instance path
+// is given.
+type extensionURL struct {
+	path, url string
+}
+
+// extensionURLs returns the urls of the extension slices along an instance
+// path, such as extension[religion] or extension[shelter].extension[camp].
+// Slices are looked up in the contains rules of the instance's profile, then
+// of the extension each slice resolves to; names that no rule declares are
+// taken as extension names or ids.
+func (x *Exporter) extensionURLs(e *Entity, path string) []extensionURL {
+	var sd *Entity
+	if e.InstanceOf != "" {
+		sd = x.lookup(e.InstanceOf, KindProfile, KindExtension)
+	}
+	var urls []extensionURL
+	var within []string
+	segs := splitPath(path)
+	for i, s := range segs {
+		within = append(within, s.Name)
+		if s.Name != "extension" && s.Name != "modifierExtension" {
+			continue
+		}
+		var slice string
+		for _, br := range s.Brackets {
+			if !isIndex(br) && br != "+" && br != "=" {
+				slice = br
+			}
+		}
+		if slice == "" {
+			sd, within = nil, nil
+			continue
+		}
+		url, ext := x.sliceURL(sd, strings.Join(within, "."), slice)
+		urls = append(urls, extensionURL{path: joinSegments(segs[:i+1]), url: url})
+		sd, within = ext, nil
+	}
+	return urls
+}
+
+// sliceURL resolves the extension slice name declared at path of sd.
+func (x *Exporter) sliceURL(sd *Entity, path, name string) (string, *Entity) {
+	seen := make(map[*Entity]bool)
+	for ; sd != nil && !seen[sd]; sd = x.lookup(sd.Parent, KindProfile, KindExtension) {
+		seen[sd] = true
+		for _, r := range x.entityRules(sd) {
+			c, ok := r.(*ContainsRule)
+			if !ok || stripIndexes(c.Path) != path {
+				continue
+			}
+			for _, item := range c.Items {
+				if item.Name != name {
+					continue
+				}
+				if item.Type == "" {
+					return name, nil
+				}
+				if ext := x.lookup(item.Type, KindExtension); ext != nil {
+					return x.url(ext), ext
+				}
+				return x.canonical(item.Type), nil
+			}
+		}
+	}
+	if ext := x.lookup(name, KindExtension); ext != nil {
+		return x.url(ext), ext
+	}
+	return x.canonical(name), nil
+}
@@ -0,0 +1,413 @@
+package fsh
+
+import (
+	"regexp"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF         tokenKind = iota
+	tokKeyword               // Entity or metadata keyword; text is the keyword without ':'
+	tokStar                  // '*' starting a rule; indent holds its column offset
+	tokString                // "..." with escapes resolved
+	tokMultiline             // """...""" with indentation removed
+	tokCode                  // SYSTEM#code or #"quoted code", raw
+	tokCard                  // min..max
+	tokReference             // Reference(...); text is the content of the parentheses
+	tokCanonical             // Canonical(...)
+	tokCodeableRef           // CodeableReference(...)
+	tokParamRef              // Name(params); text is Name, args holds the parameters
+	tokCaret                 // ^path; text excludes the caret
+	tokEqual                 // =
+	tokArrow                 // ->
+	tokUnit                  // 'unit'; text excludes the quotes
+	tokRegex                 // /regex/; text excludes the slashes
+	tokParen                 // (word) such as a binding strength or (exactly)
+	tokWord                  // Any other run of non-whitespace characters
+)
+
+var tokenNames = [...]string{
+	tokEOF:         "end of file",
+	tokKeyword:     "keyword",
+	tokStar:        "'*'",
+	tokString:      "string",
+	tokMultiline:   "multi-line string",
+	tokCode:        "code",
+	tokCard:        "cardinality",
+	tokReference:   "Reference",
+	tokCanonical:   "Canonical",
+	tokCodeableRef: "CodeableReference",
+	tokParamRef:    "parameterized name",
+	tokCaret:       "caret path",
+	tokEqual:       "'='",
+	tokArrow:       "'->'",
+	tokUnit:        "unit",
+	tokRegex:       "regular expression",
+	tokParen:       "parenthesized word",
+	tokWord:        "word",
+}
+
+func (k tokenKind) String() string {
+	return tokenNames[k]
+}
+
+type token struct {
+	kind   tokenKind
+	text   string
+	args   []string
+	pos    Position
+	off    int  // byte offset of the first character
+	bol    bool // first token on its line
+	indent int  // leading whitespace width, for tokStar
+}
+
+// describe renders the token for error messages.
+func (t token) describe() string {
+	switch t.kind {
+	case tokEOF, tokStar, tokEqual, tokArrow:
+		return t.kind.String()
+	case tokKeyword:
+		return "'" + t.text + ":'"
+	default:
+		return "'" + t.text + "'"
+	}
+}
+
+var keywords = map[string]bool{
+	"Alias": true, "Profile": true, "Extension": true, "Logical": true, "Resource": true,
+	"Instance": true, "Invariant": true, "ValueSet": true, "CodeSystem": true, "RuleSet": true,
+	"Mapping": true, "Parent": true, "Id": true, "Title": true, "Description": true,
+	"Expression": true, "XPath": true, "Severity": true, "InstanceOf": true, "Usage": true,
+	"Source": true, "Target": true, "Context": true, "Characteristics": true,
+}
+
+// unknownKeyword matches what looks like a misspelled keyword at the start of
+// a line. Lexing it as a keyword ends the statement above it, so that only
+// the keyword itself is reported.
+var unknownKeyword = regexp.MustCompile(`^[A-Z][A-Za-z]*:$`)
+
+var cardPattern = regexp.MustCompile(`^\d*\.\.(\d+|\*)?$`)
+
+type lexer struct {
+	src  []byte
+	file string
+	off  int
+	line int
+	col  int
+	bol  bool
+	errs *ErrorList
+}
+
+// lex splits src into tokens. Lines are numbered from firstLine so that rule
+// set bodies report positions in their original file.
+func lex(file string, src []byte, firstLine int, errs *ErrorList) []token {
+	l := &lexer{src: src, file: file, line: firstLine, col: 1, bol: true, errs: errs}
+	var toks []token
+	for {
+		t := l.next()
+		toks = append(toks, t)
+		if t.kind == tokEOF {
+			return toks
+		}
+	}
+}
+
+func (l *lexer) pos() Position {
+	return Position{File: l.file, Line: l.line, Col: l.col}
+}
+
+func (l *lexer) peek(n int) byte {
+	if l.off+n < len(l.src) {
+		return l.src[l.off+n]
+	}
+	return 0
+}
+
+func (l *lexer) advance() {
+	if l.src[l.off] == '\n' {
+		l.line++
+		l.col = 1
+		l.bol = true
+	} else {
+		l.col++
+	}
+	l.off++
+}
+
+func (l *lexer) eof() bool {
+	return l.off >= len(l.src)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f'
+}
+
+// skip consumes whitespace and comments.
+func (l *lexer) skip() {
+	for !l.eof() {
+		c := l.peek(0)
+		switch {
+		case isSpace(c):
+			l.advance()
+		case c == '/' && l.peek(1) == '/':
+			for !l.eof() && l.peek(0) != '\n' {
+				l.advance()
+			}
+		case c == '/' && l.peek(1) == '*':
+			start := l.pos()
+			l.advance()
+			l.advance()
+			for !l.eof() && !(l.peek(0) == '*' && l.peek(1) == '/') {
+				l.advance()
+			}
+			if l.eof() {
+				l.errs.Add(start, "unterminated block comment")
+				return
+			}
+			l.advance()
+			l.advance()
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) next() token {
+	l.skip()
+	t := token{pos: l.pos(), off: l.off, bol: l.bol}
+	if l.eof() {
+		t.kind = tokEOF
+		return t
+	}
+	l.bol = false
+
+	c := l.peek(0)
+	switch {
+	case c == '*' && t.bol && (l.off+1 == len(l.src) || isSpace(l.peek(1))):
+		t.kind = tokStar
+		t.indent = t.pos.Col - 1
+		l.advance()
+	case c == '"' && l.peek(1) == '"' && l.peek(2) == '"':
+		t.kind = tokMultiline
+		t.text = l.multiline(t.pos)
+	case c == '"':
+		t.kind = tokString
+		t.text = l.quoted(t.pos)
+	case c == '\'':
+		t.kind = tokUnit
+		t.text = l.delimited(t.pos, '\'', "unit")
+	case c == '/':
+		t.kind = tokRegex
+		t.text = l.delimited(t.pos, '/', "regular expression")
+	case c == '(':
+		t.kind = tokParen
+		t.text = strings.TrimSpace(l.delimited(t.pos, ')', "parenthesis"))
+	case c == '^':
+		l.advance()
+		t.kind = tokCaret
+		t.text = l.word()
+	default:
+		l.sequence(&t)
+	}
+	return t
+}
+
+// quoted reads a "..." string, which may span lines, and resolves \" and \\.
+func (l *lexer) quoted(start Position) string {
+	l.advance()
+	var b strings.Builder
+	for !l.eof() {
+		c := l.peek(0)
+		switch {
+		case c == '\\' && (l.peek(1) == '"' || l.peek(1) == '\\'):
+			b.WriteByte(l.peek(1))
+			l.advance()
+			l.advance()
+		case c == '"':
+			l.advance()
+			return b.String()
+		default:
+			b.WriteByte(c)
+			l.advance()
+		}
+	}
+	l.errs.Add(start, "unterminated string")
+	return b.String()
+}
+
+// multiline reads a """...""" string. A blank first and last line are dropped
+// and the common indentation of the remaining lines is removed.
+func (l *lexer) multiline(start Position) string {
+	l.advance()
+	l.advance()
+	l.advance()
+	from := l.off
+	for !l.eof() && !(l.peek(0) == '"' && l.peek(1) == '"' && l.peek(2) == '"') {
+		l.advance()
+	}
+	if l.eof() {
+		l.errs.Add(start, "unterminated multi-line string")
+		return string(l.src[from:])
+	}
+	raw := string(l.src[from:l.off])
+	l.advance()
+	l.advance()
+	l.advance()
+	return trimMultiline(raw)
+}
+
+func trimMultiline(raw string) string {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	if len(lines) > 1 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	if len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		} else if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// delimited reads from the current opening character to the closing one on
+// the same line. A backslash escapes the closing character.
+func (l *lexer) delimited(start Position, closing byte, what string) string {
+	l.advance()
+	var b strings.Builder
+	for !l.eof() && l.peek(0) != '\n' {
+		c := l.peek(0)
+		if c == '\\' && l.peek(1) == closing {
+			b.WriteByte(closing)
+			l.advance()
+			l.advance()
+			continue
+		}
+		l.advance()
+		if c == closing {
+			return b.String()
+		}
+		b.WriteByte(c)
+	}
+	l.errs.Add(start, "unterminated %s", what)
+	return b.String()
+}
+
+// word reads a run of non-whitespace characters.
+func (l *lexer) word() string {
+	from := l.off
+	for !l.eof() && !isSpace(l.peek(0)) {
+		l.advance()
+	}
+	return string(l.src[from:l.off])
+}
+
+// sequence reads a word and classifies it. Quoted codes (#"a b") and
+// parenthesized arguments (Reference(A or B), RuleSet(x, y)) may contain spaces.
+func (l *lexer) sequence(t *token) {
+	from := l.off
+	for !l.eof() && !isSpace(l.peek(0)) {
+		c := l.peek(0)
+		if c == '#' && l.peek(1) == '"' {
+			l.advance()
+			l.quoted(l.pos())
+			continue
+		}
+		if c == '(' {
+			name := string(l.src[from:l.off])
+			args := l.delimited(l.pos(), ')', "parenthesis")
+			t.text = name
+			switch name {
+			case "Reference":
+				t.kind, t.text = tokReference, strings.TrimSpace(args)
+			case "Canonical":
+				t.kind, t.text = tokCanonical, strings.TrimSpace(args)
+			case "CodeableReference":
+				t.kind, t.text = tokCodeableRef, strings.TrimSpace(args)
+			default:
+				t.kind = tokParamRef
+				t.args = splitParams(string(l.src[from+len(name)+1 : l.off-1]))
+			}
+			// Anything glued to the closing parenthesis is part of the token
+			l.word()
+			return
+		}
+		l.advance()
+	}
+	text := string(l.src[from:l.off])
+	t.text = text
+
+	switch {
+	case text == "=":
+		t.kind = tokEqual
+	case text == "->":
+		t.kind = tokArrow
+	case cardPattern.MatchString(text):
+		t.kind = tokCard
+	case t.bol && strings.HasSuffix(text, ":") && (keywords[strings.TrimSuffix(text, ":")] || unknownKeyword.MatchString(text)):
+		t.kind = tokKeyword
+		t.text = strings.TrimSuffix(text, ":")
+	case t.bol && keywords[text] && l.colonFollows():
+		t.kind = tokKeyword
+	case strings.Contains(text, "#"):
+		t.kind = tokCode
+	default:
+		t.kind = tokWord
+	}
+}
+
+// colonFollows consumes a ':' separated from the preceding word by blanks.
+func (l *lexer) colonFollows() bool {
+	i := l.off
+	for i < len(l.src) && (l.src[i] == ' ' || l.src[i] == '\t') {
+		i++
+	}
+	if i < len(l.src) && l.src[i] == ':' {
+		for l.off <= i {
+			l.advance()
+		}
+		return true
+	}
+	return false
+}
+
+// splitParams splits rule set arguments at unescaped commas. "\," and "\)"
+// stand for a literal comma and parenthesis.
+func splitParams(s string) []string {
+	var params []string
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) && (s[i+1] == ',' || s[i+1] == ')' || s[i+1] == '\\') {
+			b.WriteByte(s[i+1])
+			i++
+			continue
+		}
+		if c == ',' {
+			params = append(params, strings.TrimSpace(b.String()))
+			b.Reset()
+			continue
+		}
+		b.WriteByte(c)
+	}
+	if last := strings.TrimSpace(b.String()); last != "" || len(params) > 0 {
+		params = append(params, last)
+	}
+	return params
+}
@@ -0,0 +1,997 @@
+package fsh
+
+import (
+	"regexp"
+	"strings"
+)
+
+// UCUM is the code system of units written as 'unit'.
+const UCUM = "http://unitsofmeasure.org"
+
+var numberPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+var flags = map[string]bool{"MS": true, "SU": true, "?!": true, "N": true, "TU": true, "D": true}
+
+var entityKinds = map[string]Kind{
+	"Alias": KindAlias, "Profile": KindProfile, "Extension": KindExtension, "Logical": KindLogical,
+	"Resource": KindResource, "Instance": KindInstance, "Invariant": KindInvariant,
+	"ValueSet": KindValueSet, "CodeSystem": KindCodeSystem, "RuleSet": KindRuleSet, "Mapping": KindMapping,
+}
+
+// metadata lists the keywords each entity kind accepts.
+var metadata = map[Kind]map[string]bool{
+	KindProfile:    {"Parent": true, "Id": true, "Title": true, "Description": true},
+	KindExtension:  {"Parent": true, "Id": true, "Title": true, "Description": true, "Context": true},
+	KindLogical:    {"Parent": true, "Id": true, "Title": true, "Description": true, "Characteristics": true},
+	KindResource:   {"Parent": true, "Id": true, "Title": true, "Description": true},
+	KindInstance:   {"InstanceOf": true, "Title": true, "Description": true, "Usage": true},
+	KindInvariant:  {"Description": true, "Expression": true, "XPath": true, "Severity": true},
+	KindValueSet:   {"Id": true, "Title": true, "Description": true},
+	KindCodeSystem: {"Id": true, "Title": true, "Description": true},
+	KindMapping:    {"Id": true, "Source": true, "Target": true, "Title": true, "Description": true},
+}
+
+// Parse parses one FSH file. The Document holds everything that could be
+// parsed; the error, if not nil, is an ErrorList describing the rest.
+func Parse(file string, src []byte) (*Document, error) {
+	p := newParser(file, src, 1)
+	p.doc = &Document{File: file}
+	p.parseDocument()
+	p.errs.Sort()
+	return p.doc, p.errs.Err()
+}
+
+// parseRules parses a rule set body as rules of an entity of the given kind,
+// nested under the context of the inserting rule.
+func parseRules(body string, pos Position, kind Kind, root frame) ([]Rule, ErrorList) {
+	p := newParser(pos.File, []byte(body), pos.Line)
+	p.entity = &Entity{Kind: kind}
+	p.root = root
+	for p.tok().kind != tokEOF {
+		stmt := p.statement()
+		if stmt[0].kind != tokStar {
+			p.errs.Add(stmt[0].pos, "unexpected %s in rule set; expected a rule", stmt[0].describe())
+			continue
+		}
+		p.ruleStatement(stmt)
+	}
+	return p.entity.Rules, p.errs
+}
+
+// frame is the context an indented rule inherits from the rule above it.
+type frame struct {
+	path  string
+	codes []string
+}
+
+type parser struct {
+	file string
+	src  []byte
+	toks []token
+	i    int
+	errs ErrorList
+
+	doc    *Document
+	entity *Entity
+	root   frame
+	stack  []frame
+}
+
+func newParser(file string, src []byte, firstLine int) *parser {
+	p := &parser{file: file, src: src}
+	p.toks = lex(file, src, firstLine, &p.errs)
+	return p
+}
+
+func (p *parser) tok() token {
+	return p.toks[p.i]
+}
+
+// statement returns the tokens from the current one up to the next rule or
+// keyword. Rules may continue over several lines.
+func (p *parser) statement() []token {
+	start := p.i
+	p.i++
+	for k := p.toks[p.i].kind; k != tokEOF && k != tokStar && k != tokKeyword; k = p.toks[p.i].kind {
+		p.i++
+	}
+	return p.toks[start:p.i]
+}
+
+func (p *parser) parseDocument() {
+	for p.tok().kind != tokEOF {
+		stmt := p.statement()
+		switch stmt[0].kind {
+		case tokKeyword:
+			p.keyword(stmt[0], stmt[1:])
+		case tokStar:
+			if p.entity == nil {
+				p.errs.Add(stmt[0].pos, "rule outside of an entity")
+				continue
+			}
+			p.ruleStatement(stmt)
+		default:
+			p.errs.Add(stmt[0].pos, "unexpected %s; expected a keyword or a rule", stmt[0].describe())
+		}
+	}
+}
+
+func (p *parser) keyword(kw token, args []token) {
+	kind, isEntity := entityKinds[kw.text]
+	if !isEntity {
+		p.metadata(kw, args)
+		return
+	}
+
+	p.entity = nil
+	p.stack = nil
+	p.root = frame{}
+
+	if kind == KindAlias {
+		if len(args) != 3 || args[1].kind != tokEqual {
+			p.errs.Add(kw.pos, "Alias must have the form 'Alias: NAME = value'")
+			return
+		}
+		p.doc.Entities = append(p.doc.Entities, &Entity{Kind: KindAlias, Name: args[0].text, Pos: kw.pos, Value: args[2].text})
+		return
+	}
+
+	if len(args) != 1 || (args[0].kind != tokWord && !(kind == KindRuleSet && args[0].kind == tokParamRef)) {
+		p.errs.Add(kw.pos, "%s: expected a name", kw.text)
+		return
+	}
+	e := &Entity{Kind: kind, Name: args[0].text, Pos: kw.pos}
+	p.doc.Entities = append(p.doc.Entities, e)
+	if kind == KindRuleSet {
+		e.Params = args[0].args
+		p.ruleSetBody(e)
+		return
+	}
+	p.entity = e
+}
+
+// ruleSetBody captures the source up to the next entity as the rule set body.
+func (p *parser) ruleSetBody(e *Entity) {
+	first := p.i
+	for t := p.tok(); t.kind != tokEOF; t = p.tok() {
+		if _, ok := entityKinds[t.text]; ok && t.kind == tokKeyword {
+			break
+		}
+		p.i++
+	}
+	if first == p.i {
+		p.errs.Add(e.Pos, "RuleSet %s has no rules", e.Name)
+		return
+	}
+	from := p.lineStart(p.toks[first].off)
+	to := len(p.src)
+	if p.tok().kind != tokEOF {
+		to = p.lineStart(p.tok().off)
+	}
+	e.Body = strings.TrimRight(string(p.src[from:to]), " \t\r\n") + "\n"
+	e.BodyPos = Position{File: p.file, Line: p.toks[first].pos.Line, Col: 1}
+}
+
+func (p *parser) lineStart(off int) int {
+	for off > 0 && p.src[off-1] != '\n' {
+		off--
+	}
+	return off
+}
+
+func (p *parser) metadata(kw token, args []token) {
+	e := p.entity
+	if e == nil && keywords[kw.text] {
+		p.errs.Add(kw.pos, "%s: keyword outside of an entity", kw.text)
+		return
+	}
+	if !keywords[kw.text] {
+		p.errs.Add(kw.pos, "unknown keyword %s", kw.text)
+		return
+	}
+	if !metadata[e.Kind][kw.text] {
+		p.errs.Add(kw.pos, "%s is not allowed in %s", kw.text, article(e.Kind))
+		return
+	}
+
+	switch kw.text {
+	case "Context":
+		if e.Context != nil {
+			p.errs.Add(kw.pos, "duplicate Context")
+		}
+		e.Context = nil
+		for _, t := range args {
+			for _, v := range strings.Split(t.text, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					e.Context = append(e.Context, Context{Value: v, Quoted: t.kind == tokString})
+				}
+			}
+		}
+		if len(e.Context) == 0 {
+			p.errs.Add(kw.pos, "Context: expected at least one context")
+		}
+		return
+	case "Characteristics":
+		e.Characteristics = nil
+		for _, t := range args {
+			for _, v := range strings.Split(t.text, ",") {
+				if v = strings.TrimPrefix(strings.TrimSpace(v), "#"); v != "" {
+					e.Characteristics = append(e.Characteristics, v)
+				}
+			}
+		}
+		return
+	}
+
+	if len(args) == 0 {
+		p.errs.Add(kw.pos, "%s: expected a value", kw.text)
+		return
+	}
+	if len(args) > 1 {
+		p.errs.Add(args[1].pos, "unexpected %s after the %s value", args[1].describe(), kw.text)
+		return
+	}
+	arg := args[0]
+	var field *string
+	switch kw.text {
+	case "Parent":
+		field = &e.Parent
+	case "Id":
+		field = &e.ID
+	case "InstanceOf":
+		field = &e.InstanceOf
+	case "Source":
+		field = &e.Source
+	case "Title":
+		field = &e.Title
+	case "Description":
+		field = &e.Description
+	case "Expression":
+		field = &e.Expression
+	case "XPath":
+		field = &e.XPath
+	case "Target":
+		field = &e.Target
+	case "Usage":
+		field = &e.Usage
+	case "Severity":
+		field = &e.Severity
+	}
+	if *field != "" {
+		p.errs.Add(kw.pos, "duplicate %s", kw.text)
+	}
+
+	switch kw.text {
+	case "Title", "Description", "Expression", "XPath", "Target":
+		if arg.kind != tokString && arg.kind != tokMultiline {
+			p.errs.Add(arg.pos, "%s: expected a string, found %s", kw.text, arg.describe())
+			return
+		}
+	case "Usage", "Severity":
+		code, err := parseCode(arg)
+		if err != nil {
+			p.errs.AddError(err)
+			return
+		}
+		*field = code.Code
+		return
+	default:
+		if arg.kind != tokWord {
+			p.errs.Add(arg.pos, "%s: expected a name, found %s", kw.text, arg.describe())
+			return
+		}
+	}
+	*field = arg.text
+}
+
+func (p *parser) ruleStatement(stmt []token) {
+	star := stmt[0]
+	if star.indent%2 != 0 {
+		p.errs.Add(star.pos, "indentation must be a multiple of two spaces")
+	}
+	level := star.indent / 2
+	if level > len(p.stack) {
+		p.errs.Add(star.pos, "rule is indented more than one level below the rule above it")
+		level = len(p.stack)
+	}
+	p.stack = p.stack[:level]
+	parent := p.root
+	if level > 0 {
+		parent = p.stack[level-1]
+	}
+
+	c := &cursor{ts: stmt[1:], end: star.pos}
+	r, err := p.rule(star.pos, c, parent)
+	if err == nil && !c.done() {
+		err = errorAt(c.peek().pos, "unexpected %s", c.peek().describe())
+	}
+	if err == nil && !allowed(p.entity.Kind, r) {
+		err = errorAt(star.pos, "%s rules are not allowed in %s", ruleName(r), article(p.entity.Kind))
+	}
+	if err != nil {
+		p.errs.AddError(err)
+		p.stack = append(p.stack, parent)
+		return
+	}
+
+	p.entity.Rules = append(p.entity.Rules, r)
+	p.stack = append(p.stack, contextOf(r, parent))
+}
+
+// contextOf returns the frame a rule passes to rules indented below it. Soft
+// indexes advance on the parent only, so children refer to the same element.
+func contextOf(r Rule, parent frame) frame {
+	switch r := r.(type) {
+	case *ConceptRule:
+		return frame{codes: r.Codes}
+	case *CaretValueRule, *InsertRule, *FlagRule:
+		return parent
+	}
+	if path := r.RulePath(); path != "" {
+		return frame{path: strings.ReplaceAll(path, "[+]", "[=]")}
+	}
+	return parent
+}
+
+func joinPath(parent, path string) string {
+	switch {
+	case parent == "" || parent == ".":
+		return path
+	case path == "" || path == ".":
+		return parent
+	}
+	return parent + "." + path
+}
+
+func (p *parser) rule(pos Position, c *cursor, parent frame) (Rule, error) {
+	kind := p.entity.Kind
+	first := c.peek()
+	base := RuleBase{Pos: pos}
+
+	switch {
+	case first.kind == tokEOF:
+		return nil, errorAt(pos, "empty rule")
+	case first.kind == tokCode && kind == KindCodeSystem:
+		return p.concept(base, c, parent)
+	case first.kind == tokCode && kind == KindValueSet && c.has(tokCaret):
+		return p.codeCaret(base, c)
+	case kind == KindValueSet && (first.kind == tokCode || c.isWord("include") || c.isWord("exclude") || c.isWord("codes")):
+		return p.valueSetComponent(base, c)
+	case first.kind == tokCaret:
+		base.Path = parent.path
+		if len(parent.codes) > 0 {
+			return p.caret(base, c, codeValues(parent.codes))
+		}
+		return p.caret(base, c, nil)
+	case c.isWord("insert"):
+		base.Path = parent.path
+		r, err := p.insert(base, c)
+		if err == nil {
+			r.Codes = parent.codes
+		}
+		return r, err
+	case c.isWord("obeys"):
+		base.Path = parent.path
+		return p.obeys(base, c)
+	case first.kind == tokArrow:
+		base.Path = parent.path
+		return p.mapping(base, c)
+	case first.kind != tokWord:
+		return nil, errorAt(first.pos, "unexpected %s; expected a path", first.describe())
+	}
+
+	base.Path = joinPath(parent.path, c.next().text)
+	next := c.peek()
+	switch {
+	case next.kind == tokEOF:
+		return &PathRule{RuleBase: base}, nil
+	case next.kind == tokCard && (kind == KindLogical || kind == KindResource) && c.has(tokString):
+		return p.addElement(base, c)
+	case next.kind == tokCard:
+		return p.card(base, c)
+	case c.isWord("and") || isFlag(next):
+		return p.flag(base, c, parent)
+	case c.isWord("from"):
+		return p.binding(base, c)
+	case next.kind == tokEqual:
+		c.next()
+		v, exactly, err := p.assignedValue(c)
+		return &AssignmentRule{RuleBase: base, Value: v, Exactly: exactly}, err
+	case c.isWord("contains"):
+		return p.contains(base, c)
+	case c.isWord("only"):
+		return p.only(base, c)
+	case c.isWord("obeys"):
+		return p.obeys(base, c)
+	case next.kind == tokCaret:
+		return p.caret(base, c, nil)
+	case c.isWord("insert"):
+		return p.insert(base, c)
+	case next.kind == tokArrow:
+		return p.mapping(base, c)
+	}
+	return nil, errorAt(next.pos, "unexpected %s after path %s", next.describe(), base.Path)
+}
+
+// allowed reports whether an entity of the given kind accepts the rule.
+func allowed(kind Kind, r Rule) bool {
+	sd := kind.IsStructureDefinition()
+	switch r.(type) {
+	case *InsertRule:
+		return true
+	case *PathRule:
+		return sd || kind == KindInstance || kind == KindInvariant || kind == KindMapping
+	case *CardRule, *FlagRule, *BindingRule, *ContainsRule, *OnlyRule, *ObeysRule:
+		return sd
+	case *AssignmentRule:
+		return sd || kind == KindInstance || kind == KindInvariant
+	case *CaretValueRule:
+		return sd || kind == KindValueSet || kind == KindCodeSystem
+	case *ConceptRule:
+		return kind == KindCodeSystem
+	case *ValueSetRule:
+		return kind == KindValueSet
+	case *MappingRule:
+		return kind == KindMapping
+	case *AddElementRule:
+		return kind == KindLogical || kind == KindResource
+	}
+	return false
+}
+
+func ruleName(r Rule) string {
+	switch r.(type) {
+	case *InsertRule:
+		return "insert"
+	case *PathRule:
+		return "path"
+	case *CardRule:
+		return "cardinality"
+	case *FlagRule:
+		return "flag"
+	case *BindingRule:
+		return "binding"
+	case *ContainsRule:
+		return "contains"
+	case *OnlyRule:
+		return "only"
+	case *ObeysRule:
+		return "obeys"
+	case *AssignmentRule:
+		return "assignment"
+	case *CaretValueRule:
+		return "caret value"
+	case *ConceptRule:
+		return "concept"
+	case *ValueSetRule:
+		return "value set component"
+	case *MappingRule:
+		return "mapping"
+	case *AddElementRule:
+		return "add element"
+	}
+	return "unknown"
+}
+
+func (p *parser) concept(base RuleBase, c *cursor, parent frame) (Rule, error) {
+	codes := append([]string(nil), parent.codes...)
+	var values []CodeValue
+	for c.peek().kind == tokCode {
+		t := c.next()
+		code, err := parseCode(t)
+		if err != nil {
+			return nil, err
+		}
+		if code.System != "" {
+			return nil, errorAt(t.pos, "do not include a system when defining a concept in a CodeSystem")
+		}
+		codes = append(codes, code.Code)
+		values = append(values, code)
+	}
+	if c.peek().kind == tokCaret {
+		return p.caret(base, c, codeValues(codes))
+	}
+
+	r := &ConceptRule{RuleBase: base, Codes: codes}
+	if t := c.peek(); t.kind == tokString || t.kind == tokMultiline {
+		r.Display = c.next().text
+	}
+	if t := c.peek(); t.kind == tokString || t.kind == tokMultiline {
+		r.Definition = c.next().text
+	}
+	return r, nil
+}
+
+func codeValues(codes []string) []CodeValue {
+	values := make([]CodeValue, len(codes))
+	for i, code := range codes {
+		values[i] = CodeValue{Code: code}
+	}
+	return values
+}
+
+// codeCaret parses "SYSTEM#code ^caret = value" in a ValueSet.
+func (p *parser) codeCaret(base RuleBase, c *cursor) (Rule, error) {
+	var codes []CodeValue
+	for c.peek().kind == tokCode {
+		code, err := parseCode(c.next())
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return p.caret(base, c, codes)
+}
+
+func (p *parser) caret(base RuleBase, c *cursor, codes []CodeValue) (Rule, error) {
+	t := c.next()
+	if t.kind != tokCaret || t.text == "" {
+		return nil, errorAt(t.pos, "expected a caret path, found %s", t.describe())
+	}
+	if eq := c.next(); eq.kind != tokEqual {
+		return nil, errorAt(eq.pos, "expected '=' after ^%s, found %s", t.text, eq.describe())
+	}
+	v, exactly, err := p.assignedValue(c)
+	return &CaretValueRule{RuleBase: base, Codes: codes, Caret: t.text, Value: v, Exactly: exactly}, err
+}
+
+func (p *parser) assignedValue(c *cursor) (Value, bool, error) {
+	v, err := p.value(c)
+	if err != nil {
+		return nil, false, err
+	}
+	exactly := false
+	if t := c.peek(); t.kind == tokParen && t.text == "exactly" {
+		c.next()
+		exactly = true
+	}
+	return v, exactly, nil
+}
+
+func (p *parser) value(c *cursor) (Value, error) {
+	t := c.next()
+	switch t.kind {
+	case tokString, tokMultiline:
+		return StringValue(t.text), nil
+	case tokCode:
+		code, err := parseCode(t)
+		if err != nil {
+			return nil, err
+		}
+		if s := c.peek(); s.kind == tokString {
+			code.Display = c.next().text
+		}
+		return code, nil
+	case tokReference:
+		r := ReferenceValue{Target: t.text}
+		if s := c.peek(); s.kind == tokString {
+			r.Display = c.next().text
+		}
+		return r, nil
+	case tokCanonical:
+		target, version, _ := strings.Cut(t.text, "|")
+		return CanonicalValue{Target: strings.TrimSpace(target), Version: strings.TrimSpace(version)}, nil
+	case tokRegex:
+		return RegexValue(t.text), nil
+	case tokWord:
+		switch {
+		case t.text == "true" || t.text == "false":
+			return BoolValue(t.text == "true"), nil
+		case numberPattern.MatchString(t.text):
+			num, err := p.quantity(t, c)
+			if err != nil || !c.isWord(":") {
+				return num, err
+			}
+			c.next()
+			d := c.next()
+			if d.kind != tokWord || !numberPattern.MatchString(d.text) {
+				return nil, errorAt(d.pos, "expected a ratio denominator, found %s", d.describe())
+			}
+			den, err := p.quantity(d, c)
+			return RatioValue{Numerator: num, Denominator: den}, err
+		}
+		return NameValue(t.text), nil
+	}
+	return nil, errorAt(t.pos, "expected a value, found %s", t.describe())
+}
+
+// quantity parses the optional unit and display after a number.
+func (p *parser) quantity(num token, c *cursor) (Value, error) {
+	var unit CodeValue
+	switch t := c.peek(); t.kind {
+	case tokUnit:
+		c.next()
+		unit = CodeValue{System: UCUM, Code: t.text}
+	case tokCode:
+		c.next()
+		code, err := parseCode(t)
+		if err != nil {
+			return nil, err
+		}
+		unit = code
+	default:
+		return NumberValue(num.text), nil
+	}
+	if s := c.peek(); s.kind == tokString {
+		unit.Display = c.next().text
+	}
+	return QuantityValue{Value: num.text, Unit: unit}, nil
+}
+
+func (p *parser) card(base RuleBase, c *cursor) (Rule, error) {
+	min, max := parseCard(c.next().text)
+	return &CardRule{RuleBase: base, Min: min, Max: max, Flags: c.flags()}, nil
+}
+
+func (p *parser) flag(base RuleBase, c *cursor, parent frame) (Rule, error) {
+	r := &FlagRule{RuleBase: base, Paths: []string{base.Path}}
+	for c.isWord("and") {
+		c.next()
+		t := c.next()
+		if t.kind != tokWord {
+			return nil, errorAt(t.pos, "expected a path, found %s", t.describe())
+		}
+		r.Paths = append(r.Paths, joinPath(parent.path, t.text))
+	}
+	r.Flags = c.flags()
+	if len(r.Flags) == 0 {
+		return nil, errorAt(c.peek().pos, "expected a flag, found %s", c.peek().describe())
+	}
+	return r, nil
+}
+
+func (p *parser) binding(base RuleBase, c *cursor) (Rule, error) {
+	c.next()
+	vs := c.next()
+	if vs.kind != tokWord {
+		return nil, errorAt(vs.pos, "expected a value set, found %s", vs.describe())
+	}
+	r := &BindingRule{RuleBase: base, ValueSet: vs.text}
+	if t := c.peek(); t.kind == tokParen {
+		c.next()
+		switch t.text {
+		case "required", "extensible", "preferred", "example":
+			r.Strength = t.text
+		default:
+			return nil, errorAt(t.pos, "invalid binding strength %q", t.text)
+		}
+	}
+	return r, nil
+}
+
+func (p *parser) contains(base RuleBase, c *cursor) (Rule, error) {
+	c.next()
+	r := &ContainsRule{RuleBase: base}
+	for {
+		t := c.next()
+		if t.kind != tokWord {
+			return nil, errorAt(t.pos, "expected a slice name, found %s", t.describe())
+		}
+		item := ContainsItem{Name: t.text}
+		if c.isWord("named") {
+			c.next()
+			name := c.next()
+			if name.kind != tokWord {
+				return nil, errorAt(name.pos, "expected a slice name, found %s", name.describe())
+			}
+			item.Type, item.Name = item.Name, name.text
+		}
+		card := c.next()
+		if card.kind != tokCard {
+			return nil, errorAt(card.pos, "expected a cardinality for %s, found %s", item.Name, card.describe())
+		}
+		item.Min, item.Max = parseCard(card.text)
+		item.Flags = c.flags()
+		r.Items = append(r.Items, item)
+		if !c.isWord("and") {
+			return r, nil
+		}
+		c.next()
+	}
+}
+
+func (p *parser) only(base RuleBase, c *cursor) (Rule, error) {
+	c.next()
+	types, err := p.types(c)
+	return &OnlyRule{RuleBase: base, Types: types}, err
+}
+
+// types parses "Type or Reference(A | B) or ...".
+func (p *parser) types(c *cursor) ([]TypeRef, error) {
+	var types []TypeRef
+	for {
+		t := c.next()
+		switch t.kind {
+		case tokWord:
+			types = append(types, TypeRef{Name: t.text})
+		case tokReference:
+			types = append(types, TypeRef{Name: "Reference", Targets: splitTargets(t.text)})
+		case tokCanonical:
+			types = append(types, TypeRef{Name: "Canonical", Targets: splitTargets(t.text)})
+		case tokCodeableRef:
+			types = append(types, TypeRef{Name: "CodeableReference", Targets: splitTargets(t.text)})
+		default:
+			return nil, errorAt(t.pos, "expected a type, found %s", t.describe())
+		}
+		if !c.isWord("or") {
+			return types, nil
+		}
+		c.next()
+	}
+}
+
+// splitTargets splits "A or B" and "A | B".
+func splitTargets(s string) []string {
+	var targets []string
+	for _, part := range strings.Split(strings.ReplaceAll(s, " or ", "|"), "|") {
+		if part = strings.TrimSpace(part); part != "" {
+			targets = append(targets, part)
+		}
+	}
+	return targets
+}
+
+func (p *parser) obeys(base RuleBase, c *cursor) (Rule, error) {
+	c.next()
+	r := &ObeysRule{RuleBase: base}
+	for {
+		t := c.next()
+		if t.kind != tokWord {
+			return nil, errorAt(t.pos, "expected an invariant name, found %s", t.describe())
+		}
+		r.Invariants = append(r.Invariants, t.text)
+		if !c.isWord("and") {
+			return r, nil
+		}
+		c.next()
+	}
+}
+
+func (p *parser) insert(base RuleBase, c *cursor) (*InsertRule, error) {
+	c.next()
+	t := c.next()
+	switch t.kind {
+	case tokWord:
+		return &InsertRule{RuleBase: base, RuleSet: t.text}, nil
+	case tokParamRef:
+		return &InsertRule{RuleBase: base, RuleSet: t.text, Params: t.args}, nil
+	}
+	return nil, errorAt(t.pos, "expected a rule set name, found %s", t.describe())
+}
+
+func (p *parser) mapping(base RuleBase, c *cursor) (Rule, error) {
+	c.next()
+	t := c.next()
+	if t.kind != tokString {
+		return nil, errorAt(t.pos, "expected a mapping string, found %s", t.describe())
+	}
+	r := &MappingRule{RuleBase: base, Map: t.text}
+	if t := c.peek(); t.kind == tokString {
+		r.Comment = c.next().text
+	}
+	if t := c.peek(); t.kind == tokCode {
+		code, err := parseCode(c.next())
+		if err != nil {
+			return nil, err
+		}
+		r.Language = code.Code
+	}
+	return r, nil
+}
+
+func (p *parser) addElement(base RuleBase, c *cursor) (Rule, error) {
+	r := &AddElementRule{RuleBase: base}
+	r.Min, r.Max = parseCard(c.next().text)
+	r.Flags = c.flags()
+	if c.isWord("contentreference") || c.isWord("contentReference") {
+		c.next()
+		t := c.next()
+		if t.kind != tokWord && t.kind != tokCode {
+			return nil, errorAt(t.pos, "expected a content reference, found %s", t.describe())
+		}
+		r.ContentReference = t.text
+	} else {
+		types, err := p.types(c)
+		if err != nil {
+			return nil, err
+		}
+		r.Types = types
+	}
+	t := c.next()
+	if t.kind != tokString && t.kind != tokMultiline {
+		return nil, errorAt(t.pos, "expected a short description, found %s", t.describe())
+	}
+	r.Short = t.text
+	if t := c.peek(); t.kind == tokString || t.kind == tokMultiline {
+		r.Definition = c.next().text
+	}
+	return r, nil
+}
+
+func (p *parser) valueSetComponent(base RuleBase, c *cursor) (Rule, error) {
+	r := &ValueSetRule{RuleBase: base, Include: true}
+	if c.isWord("include") {
+		c.next()
+	} else if c.isWord("exclude") {
+		c.next()
+		r.Include = false
+	}
+
+	if c.peek().kind == tokCode {
+		for {
+			code, err := parseCode(c.next())
+			if err != nil {
+				return nil, err
+			}
+			if s := c.peek(); s.kind == tokString {
+				code.Display = c.next().text
+			}
+			r.Concepts = append(r.Concepts, code)
+			if !c.isWord("and") || c.peekAt(1).kind != tokCode {
+				return r, nil
+			}
+			c.next()
+		}
+	}
+
+	if !c.isWord("codes") {
+		return nil, errorAt(c.peek().pos, "expected a code or 'codes from', found %s", c.peek().describe())
+	}
+	c.next()
+	if !c.isWord("from") {
+		return nil, errorAt(c.peek().pos, "expected 'from', found %s", c.peek().describe())
+	}
+	c.next()
+
+	for {
+		switch {
+		case c.isWord("system"):
+			c.next()
+			t := c.next()
+			if t.kind != tokWord {
+				return nil, errorAt(t.pos, "expected a code system, found %s", t.describe())
+			}
+			if r.System != "" {
+				return nil, errorAt(t.pos, "only one system may be given")
+			}
+			r.System = t.text
+		case c.isWord("valueset"):
+			c.next()
+			for {
+				t := c.next()
+				if t.kind != tokWord {
+					return nil, errorAt(t.pos, "expected a value set, found %s", t.describe())
+				}
+				r.ValueSets = append(r.ValueSets, t.text)
+				if !c.isWord("and") || c.peekAt(1).text == "system" {
+					break
+				}
+				c.next()
+			}
+		default:
+			return nil, errorAt(c.peek().pos, "expected 'system' or 'valueset', found %s", c.peek().describe())
+		}
+		if !c.isWord("and") {
+			break
+		}
+		c.next()
+	}
+
+	if c.isWord("where") {
+		c.next()
+		for {
+			prop, op := c.next(), c.next()
+			if prop.kind != tokWord || op.kind != tokWord {
+				return nil, errorAt(prop.pos, "expected 'property operator value'")
+			}
+			v, err := p.value(c)
+			if err != nil {
+				return nil, err
+			}
+			r.Filters = append(r.Filters, Filter{Property: prop.text, Op: op.text, Value: v})
+			if !c.isWord("and") {
+				break
+			}
+			c.next()
+		}
+	}
+	return r, nil
+}
+
+func isFlag(t token) bool {
+	return t.kind == tokWord && flags[t.text]
+}
+
+func parseCard(s string) (min, max string) {
+	min, max, _ = strings.Cut(s, "..")
+	return min, max
+}
+
+// parseCode splits SYSTEM|version#code, resolving a quoted code.
+func parseCode(t token) (CodeValue, error) {
+	system, code, _ := strings.Cut(t.text, "#")
+	if strings.HasPrefix(code, `"`) {
+		end := strings.LastIndex(code, `"`)
+		if end == 0 {
+			return CodeValue{}, errorAt(t.pos, "unterminated quoted code %s", t.text)
+		}
+		code = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(code[1:end])
+	}
+	if code == "" {
+		return CodeValue{}, errorAt(t.pos, "missing code in %s", t.text)
+	}
+	system, version, _ := strings.Cut(system, "|")
+	return CodeValue{System: system, Version: version, Code: code}, nil
+}
+
+func errorAt(pos Position, format string, args ...any) *Error {
+	var l ErrorList
+	l.Add(pos, format, args...)
+	return l[0]
+}
+
+// cursor walks the tokens of one rule.
+type cursor struct {
+	ts  []token
+	i   int
+	end Position
+}
+
+func (c *cursor) peekAt(n int) token {
+	if c.i+n < len(c.ts) {
+		return c.ts[c.i+n]
+	}
+	pos := c.end
+	if len(c.ts) > 0 {
+		pos = c.ts[len(c.ts)-1].pos
+	}
+	return token{kind: tokEOF, pos: pos}
+}
+
+func (c *cursor) peek() token {
+	return c.peekAt(0)
+}
+
+func (c *cursor) next() token {
+	t := c.peek()
+	if c.i < len(c.ts) {
+		c.i++
+	}
+	return t
+}
+
+func (c *cursor) done() bool {
+	return c.i >= len(c.ts)
+}
+
+func (c *cursor) isWord(s string) bool {
+	t := c.peek()
+	return t.kind == tokWord && t.text == s
+}
+
+// has reports whether a token of the kind remains.
+func (c *cursor) has(kind tokenKind) bool {
+	for _, t := range c.ts[c.i:] {
+		if t.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *cursor) flags() []string {
+	var fs []string
+	for isFlag(c.peek()) {
+		fs = append(fs, c.next().text)
+	}
+	return fs
+}
+
+// article returns the entity kind with its indefinite article, as in
+// "an Instance".
+func article(k Kind) string {
+	if strings.ContainsRune("AEIOU", rune(k.String()[0])) {
+		return "an " + k.String()
+	}
+	return "a " + k.String()
+}
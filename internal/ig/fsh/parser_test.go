@@ -0,0 +1,383 @@
+package fsh
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseOne(t *testing.T, src string) *Entity {
+	t.Helper()
+	doc, err := Parse("test.fsh", []byte(src))
+	require.NoError(t, err)
+	require.Len(t, doc.Entities, 1)
+	return doc.Entities[0]
+}
+
+func TestParseCorpus(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.fsh")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			require.NoError(t, err)
+			doc, err := Parse(file, src)
+			require.NoError(t, err)
+			assert.NotEmpty(t, doc.Entities)
+		})
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	e := parseOne(t, `
+// A profile with every metadata keyword it accepts.
+Profile:  BDPatient
+Parent:   Patient
+Id:       bd-patient
+Title:    "Bangladesh Patient"
+Description: """
+    First line.
+      Indented line.
+    """
+`)
+	assert.Equal(t, KindProfile, e.Kind)
+	assert.Equal(t, "BDPatient", e.Name)
+	assert.Equal(t, "Patient", e.Parent)
+	assert.Equal(t, "bd-patient", e.ID)
+	assert.Equal(t, "Bangladesh Patient", e.Title)
+	assert.Equal(t, "First line.\n  Indented line.", e.Description)
+	assert.Equal(t, Position{File: "test.fsh", Line: 3, Col: 1}, e.Pos)
+}
+
+func TestParseExtensionContext(t *testing.T) {
+	e := parseOne(t, `Extension: Ext
+Context: Patient, Address, "Patient.address.where(use = 'home')"`)
+	assert.Equal(t, []Context{
+		{Value: "Patient"},
+		{Value: "Address"},
+		{Value: "Patient.address.where(use = 'home')", Quoted: true},
+	}, e.Context)
+}
+
+func TestParseAlias(t *testing.T) {
+	doc, err := Parse("test.fsh", []byte("Alias: $SCT = http://snomed.info/sct\nAlias: LNC = http://loinc.org|2.77"))
+	require.NoError(t, err)
+	require.Len(t, doc.Entities, 2)
+	assert.Equal(t, "$SCT", doc.Entities[0].Name)
+	assert.Equal(t, "http://snomed.info/sct", doc.Entities[0].Value)
+	assert.Equal(t, "http://loinc.org|2.77", doc.Entities[1].Value)
+}
+
+func TestParseProfileRules(t *testing.T) {
+	e := parseOne(t, `Profile: P
+Parent: Observation
+* status 1..1 MS SU
+* code and subject MS
+* category from CategoryVS (extensible)
+* code = http://loinc.org#8867-4 "Heart rate" (exactly)
+* component contains systolic 1..1 MS and diastolic 0..1
+* extension contains Religion named religion 0..1
+* subject only Reference(Patient or Group)
+* value[x] only Quantity or CodeableConcept
+* obeys inv-1 and inv-2
+* code ^short = "Heart rate code"
+* ^status = #draft
+* valueQuantity = 5.5 'mg' "milligram"
+* insert Common(a, b\, c)
+`)
+	require.Len(t, e.Rules, 13)
+
+	assert.Equal(t, &CardRule{RuleBase: RuleBase{Pos: Position{"test.fsh", 3, 1}, Path: "status"}, Min: "1", Max: "1", Flags: []string{"MS", "SU"}}, e.Rules[0])
+	assert.Equal(t, []string{"code", "subject"}, e.Rules[1].(*FlagRule).Paths)
+	assert.Equal(t, "extensible", e.Rules[2].(*BindingRule).Strength)
+
+	a := e.Rules[3].(*AssignmentRule)
+	assert.True(t, a.Exactly)
+	assert.Equal(t, CodeValue{System: "http://loinc.org", Code: "8867-4", Display: "Heart rate"}, a.Value)
+
+	c := e.Rules[4].(*ContainsRule)
+	assert.Equal(t, []ContainsItem{
+		{Name: "systolic", Min: "1", Max: "1", Flags: []string{"MS"}},
+		{Name: "diastolic", Min: "0", Max: "1"},
+	}, c.Items)
+	assert.Equal(t, ContainsItem{Name: "religion", Type: "Religion", Min: "0", Max: "1"}, e.Rules[5].(*ContainsRule).Items[0])
+
+	assert.Equal(t, []TypeRef{{Name: "Reference", Targets: []string{"Patient", "Group"}}}, e.Rules[6].(*OnlyRule).Types)
+	assert.Equal(t, []TypeRef{{Name: "Quantity"}, {Name: "CodeableConcept"}}, e.Rules[7].(*OnlyRule).Types)
+
+	obeys := e.Rules[8].(*ObeysRule)
+	assert.Equal(t, "", obeys.Path)
+	assert.Equal(t, []string{"inv-1", "inv-2"}, obeys.Invariants)
+
+	caret := e.Rules[9].(*CaretValueRule)
+	assert.Equal(t, "code", caret.Path)
+	assert.Equal(t, "short", caret.Caret)
+	assert.Equal(t, StringValue("Heart rate code"), caret.Value)
+	assert.Equal(t, CodeValue{Code: "draft"}, e.Rules[10].(*CaretValueRule).Value)
+
+	assert.Equal(t, QuantityValue{Value: "5.5", Unit: CodeValue{System: UCUM, Code: "mg", Display: "milligram"}}, e.Rules[11].(*AssignmentRule).Value)
+
+	ins := e.Rules[12].(*InsertRule)
+	assert.Equal(t, "Common", ins.RuleSet)
+	assert.Equal(t, []string{"a", "b, c"}, ins.Params)
+}
+
+func TestParseMapping(t *testing.T) {
+	e := parseOne(t, `Mapping: PatientToV2
+Source: BDPatient
+Target: "http://hl7.org/v2"
+Id: v2
+* -> "PID"
+* identifier -> "PID-3" "Patient identifier list" #v2
+`)
+	assert.Equal(t, "BDPatient", e.Source)
+	assert.Equal(t, "http://hl7.org/v2", e.Target)
+	require.Len(t, e.Rules, 2)
+	assert.Equal(t, "", e.Rules[0].RulePath())
+
+	m := e.Rules[1].(*MappingRule)
+	assert.Equal(t, "identifier", m.Path)
+	assert.Equal(t, "PID-3", m.Map)
+	assert.Equal(t, "Patient identifier list", m.Comment)
+	assert.Equal(t, "v2", m.Language)
+}
+
+func TestParseAssignmentValues(t *testing.T) {
+	tests := []struct {
+		rule string
+		want Value
+	}{
+		{`* a = "text"`, StringValue("text")},
+		{`* a = "say \"hi\""`, StringValue(`say "hi"`)},
+		{`* a = 42`, NumberValue("42")},
+		{`* a = -1.5e3`, NumberValue("-1.5e3")},
+		{`* a = true`, BoolValue(true)},
+		{`* a = #male`, CodeValue{Code: "male"}},
+		{`* a = #"with space"`, CodeValue{Code: "with space"}},
+		{`* a = $SCT|2024#123 "Display"`, CodeValue{System: "$SCT", Version: "2024", Code: "123", Display: "Display"}},
+		{`* a = 10 'mg'`, QuantityValue{Value: "10", Unit: CodeValue{System: UCUM, Code: "mg"}}},
+		{`* a = 10 $UCUM#kg "kilogram"`, QuantityValue{Value: "10", Unit: CodeValue{System: "$UCUM", Code: "kg", Display: "kilogram"}}},
+		{`* a = 1 'mg' : 2 'mL'`, RatioValue{
+			Numerator:   QuantityValue{Value: "1", Unit: CodeValue{System: UCUM, Code: "mg"}},
+			Denominator: QuantityValue{Value: "2", Unit: CodeValue{System: UCUM, Code: "mL"}},
+		}},
+		{`* a = Reference(DrKarim) "Dr Karim"`, ReferenceValue{Target: "DrKarim", Display: "Dr Karim"}},
+		{`* a = Canonical(BDPatient|1.0.0)`, CanonicalValue{Target: "BDPatient", Version: "1.0.0"}},
+		{`* a = 2024-05-01`, NameValue("2024-05-01")},
+		{`* a = DrKarim`, NameValue("DrKarim")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			e := parseOne(t, "Instance: X\nInstanceOf: Patient\n"+tt.rule)
+			require.Len(t, e.Rules, 1)
+			assert.Equal(t, tt.want, e.Rules[0].(*AssignmentRule).Value)
+		})
+	}
+}
+
+func TestParseIndentedPaths(t *testing.T) {
+	e := parseOne(t, `Instance: X
+InstanceOf: Patient
+* name[0]
+  * given[+] = "A"
+  * period
+    * start = 2020-01-01
+  * family = "B"
+* gender = #male
+`)
+	var paths []string
+	for _, r := range e.Rules {
+		paths = append(paths, r.RulePath())
+	}
+	assert.Equal(t, []string{"name[0]", "name[0].given[+]", "name[0].period", "name[0].period.start", "name[0].family", "gender"}, paths)
+}
+
+func TestParseCodeSystem(t *testing.T) {
+	e := parseOne(t, `CodeSystem: CS
+* #a "A" "Definition of A"
+  * #a1 "A1"
+    * #a11
+* #b
+* #a #a1 ^property[0].code = #status
+* #"with space" "Spaced"
+`)
+	require.Len(t, e.Rules, 6)
+	c := e.Rules[2].(*ConceptRule)
+	assert.Equal(t, []string{"a", "a1", "a11"}, c.Codes)
+	assert.Equal(t, "a11", c.Code())
+	assert.Equal(t, "Definition of A", e.Rules[0].(*ConceptRule).Definition)
+
+	caret := e.Rules[4].(*CaretValueRule)
+	assert.Equal(t, []CodeValue{{Code: "a"}, {Code: "a1"}}, caret.Codes)
+	assert.Equal(t, "property[0].code", caret.Caret)
+	assert.Equal(t, "with space", e.Rules[5].(*ConceptRule).Code())
+}
+
+func TestParseValueSet(t *testing.T) {
+	e := parseOne(t, `ValueSet: VS
+* $SCT#1 "One"
+* include $SCT#2 and $SCT#3
+* exclude codes from system $SCT where concept is-a #4 and display regex /^A.*/
+* include codes from valueset OtherVS and ThirdVS
+* include codes from system $LNC and valueset LoincVS
+`)
+	require.Len(t, e.Rules, 5)
+
+	r := e.Rules[0].(*ValueSetRule)
+	assert.True(t, r.Include)
+	assert.Equal(t, []CodeValue{{System: "$SCT", Code: "1", Display: "One"}}, r.Concepts)
+	assert.Len(t, e.Rules[1].(*ValueSetRule).Concepts, 2)
+
+	r = e.Rules[2].(*ValueSetRule)
+	assert.False(t, r.Include)
+	assert.Equal(t, "$SCT", r.System)
+	assert.Equal(t, []Filter{
+		{Property: "concept", Op: "is-a", Value: CodeValue{Code: "4"}},
+		{Property: "display", Op: "regex", Value: RegexValue("^A.*")},
+	}, r.Filters)
+
+	assert.Equal(t, []string{"OtherVS", "ThirdVS"}, e.Rules[3].(*ValueSetRule).ValueSets)
+	r = e.Rules[4].(*ValueSetRule)
+	assert.Equal(t, "$LNC", r.System)
+	assert.Equal(t, []string{"LoincVS"}, r.ValueSets)
+}
+
+func TestParseLogical(t *testing.T) {
+	e := parseOne(t, `Logical: L
+Characteristics: #can-be-target, #has-length
+* a 0..* string or Reference(Patient) "Short" "Definition"
+* b 1..1 MS contentReference #L.a "B"
+`)
+	assert.Equal(t, []string{"can-be-target", "has-length"}, e.Characteristics)
+
+	a := e.Rules[0].(*AddElementRule)
+	assert.Equal(t, "0", a.Min)
+	assert.Equal(t, "*", a.Max)
+	assert.Equal(t, []TypeRef{{Name: "string"}, {Name: "Reference", Targets: []string{"Patient"}}}, a.Types)
+	assert.Equal(t, "Short", a.Short)
+	assert.Equal(t, "Definition", a.Definition)
+
+	b := e.Rules[1].(*AddElementRule)
+	assert.Equal(t, []string{"MS"}, b.Flags)
+	assert.Equal(t, "#L.a", b.ContentReference)
+	assert.Equal(t, "B", b.Short)
+	assert.Empty(t, b.Definition)
+}
+
+func TestParseRuleSet(t *testing.T) {
+	doc, err := Parse("test.fsh", []byte(`RuleSet: Named(system, code)
+* code = {system}#{code}
+  * text = "{code}"
+
+RuleSet: Plain
+* ^publisher = "DGHS"
+`))
+	require.NoError(t, err)
+	require.Len(t, doc.Entities, 2)
+
+	rs := doc.Entities[0]
+	assert.Equal(t, []string{"system", "code"}, rs.Params)
+	assert.Equal(t, "* code = {system}#{code}\n  * text = \"{code}\"\n", rs.Body)
+	assert.Equal(t, 2, rs.BodyPos.Line)
+	assert.Empty(t, doc.Entities[1].Params)
+}
+
+func TestParseInvariant(t *testing.T) {
+	e := parseOne(t, `Invariant: bd-1
+Description: "Name or identifier"
+Expression: "name.exists() or identifier.exists()"
+Severity: #error
+XPath: "f:name or f:identifier"`)
+	assert.Equal(t, KindInvariant, e.Kind)
+	assert.Equal(t, "name.exists() or identifier.exists()", e.Expression)
+	assert.Equal(t, "error", e.Severity)
+	assert.Equal(t, "f:name or f:identifier", e.XPath)
+}
+
+func TestParseComments(t *testing.T) {
+	e := parseOne(t, `CodeSystem: CS // trailing comment
+/* block
+   comment */
+* #a "http://not-a-comment" // comment
+* #b "/* not a comment */"`)
+	require.Len(t, e.Rules, 2)
+	assert.Equal(t, "http://not-a-comment", e.Rules[0].(*ConceptRule).Display)
+	assert.Equal(t, "/* not a comment */", e.Rules[1].(*ConceptRule).Display)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "unknown keyword",
+			src:  "Profile: P\nParent: Patient\nColour: red\n",
+			want: []string{"test.fsh:3:1: unknown keyword Colour"},
+		},
+		{
+			name: "metadata not allowed",
+			src:  "CodeSystem: CS\nParent: Foo\n",
+			want: []string{"test.fsh:2:1: Parent is not allowed in a CodeSystem"},
+		},
+		{
+			name: "rule not allowed",
+			src:  "Instance: I\nInstanceOf: Patient\n* name 1..1\n",
+			want: []string{"test.fsh:3:1: cardinality rules are not allowed in an Instance"},
+		},
+		{
+			name: "rule outside entity",
+			src:  "* name 1..1\n",
+			want: []string{"test.fsh:1:1: rule outside of an entity"},
+		},
+		{
+			name: "unterminated string",
+			src:  "Profile: P\nTitle: \"open\n",
+			want: []string{"test.fsh:2:8: unterminated string"},
+		},
+		{
+			name: "odd indentation",
+			src:  "Instance: I\nInstanceOf: Patient\n* name\n   * family = \"A\"\n",
+			want: []string{"test.fsh:4:4:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("test.fsh", []byte(tt.src))
+			require.Error(t, err)
+			for _, want := range tt.want {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestParseRecovers(t *testing.T) {
+	doc, err := Parse("test.fsh", []byte(`Profile: P
+Parent: Patient
+* name from
+* gender MS
+Bogus: x
+CodeSystem: CS
+* #a "A"
+`))
+	require.Error(t, err)
+
+	var list ErrorList
+	require.ErrorAs(t, err, &list)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 3, list[0].Pos.Line)
+	assert.Equal(t, 5, list[1].Pos.Line)
+
+	require.Len(t, doc.Entities, 2)
+	assert.Len(t, doc.Entities[0].Rules, 1, "the rule after the bad one is kept")
+	assert.Len(t, doc.Entities[1].Rules, 1)
+}
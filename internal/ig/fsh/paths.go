@@ -0,0 +1,294 @@
+package fsh
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// segment is one dotted part of an FSH path: a name followed by zero or more
+// bracketed indexes or slice names, as in extension[race] or name[0].
+type segment struct {
+	Name     string
+	Brackets []string
+}
+
+func (s segment) String() string {
+	var b strings.Builder
+	b.WriteString(s.Name)
+	for _, br := range s.Brackets {
+		b.WriteString("[" + br + "]")
+	}
+	return b.String()
+}
+
+// splitPath splits an FSH path at dots outside brackets.
+func splitPath(path string) []segment {
+	var segs []segment
+	var cur segment
+	var b strings.Builder
+	depth := 0
+	inBracket := false
+	flushName := func() {
+		if !inBracket && cur.Name == "" {
+			cur.Name = b.String()
+			b.Reset()
+		}
+	}
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c == '[':
+			if depth == 0 {
+				flushName()
+				inBracket = true
+				b.Reset()
+			} else {
+				b.WriteByte(c)
+			}
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+			if depth == 0 {
+				cur.Brackets = append(cur.Brackets, b.String())
+				b.Reset()
+			} else {
+				b.WriteByte(c)
+			}
+		case c == '.' && depth == 0:
+			flushName()
+			segs = append(segs, cur)
+			cur = segment{}
+			inBracket = false
+		default:
+			b.WriteByte(c)
+		}
+	}
+	flushName()
+	if cur.Name != "" || len(cur.Brackets) > 0 {
+		segs = append(segs, cur)
+	}
+	return segs
+}
+
+func joinSegments(segs []segment) string {
+	parts := make([]string, len(segs))
+	for i, s := range segs {
+		parts[i] = s.String()
+	}
+	return strings.Join(parts, ".")
+}
+
+func isIndex(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// softIndexer replaces [+] and [=] with numeric indexes. [+] selects the
+// element after the last one referenced at that path and [=] the last one.
+type softIndexer struct {
+	last map[string]int
+}
+
+func newSoftIndexer() *softIndexer {
+	return &softIndexer{last: make(map[string]int)}
+}
+
+// resolve rewrites path. scope separates independent index spaces, such as
+// the caret paths of different elements.
+func (s *softIndexer) resolve(scope, path string) (string, error) {
+	if path == "" {
+		return path, nil
+	}
+	segs := splitPath(path)
+	key := scope
+	for i := range segs {
+		key += "." + segs[i].Name
+		for j, br := range segs[i].Brackets {
+			switch br {
+			case "+":
+				n, seen := s.last[key]
+				if seen {
+					n++
+				}
+				s.last[key] = n
+				segs[i].Brackets[j] = strconv.Itoa(n)
+			case "=":
+				n, seen := s.last[key]
+				if !seen {
+					return path, fmt.Errorf("[=] in %s refers to %s before any element was selected", path, strings.TrimPrefix(key, scope+"."))
+				}
+				segs[i].Brackets[j] = strconv.Itoa(n)
+			default:
+				if n, err := strconv.Atoi(br); err == nil {
+					s.last[key] = n
+				}
+			}
+			key += "[" + segs[i].Brackets[j] + "]"
+		}
+	}
+	return joinSegments(segs), nil
+}
+
+// setPath assigns v at the FSH path below obj, creating objects and arrays as
+// needed. Bracketed slice names select a named element of an array; slices
+// remembers which index each name was given. Objects assigned over objects are
+// merged. When a path continues below a primitive, the primitive's extension
+// sibling (_name) is used, as FHIR JSON requires. isArray, if not nil, reports
+// whether the element at an index-free path repeats, so that unindexed paths
+// to repeating elements still produce arrays.
+func setPath(obj map[string]any, path string, v any, slices map[string]int, isArray func(string) bool) error {
+	segs := splitPath(path)
+	if len(segs) == 0 {
+		return fmt.Errorf("empty path")
+	}
+	return setSegments(obj, segs, v, "", "", slices, isArray)
+}
+
+func setSegments(obj map[string]any, segs []segment, v any, prefix, plain string, slices map[string]int, isArray func(string) bool) error {
+	s := segs[0]
+	last := len(segs) == 1
+	name := s.Name
+	prefix += "." + name
+	plain = joinPath(plain, name)
+
+	idx := -1
+	for _, br := range s.Brackets {
+		if n, err := strconv.Atoi(br); err == nil {
+			idx = n
+			continue
+		}
+		key := prefix + "[" + br + "]"
+		n, ok := slices[key]
+		if !ok {
+			n = len(asArray(obj[name]))
+			slices[key] = n
+		}
+		idx = n
+	}
+	if idx < 0 {
+		if _, ok := obj[name].([]any); ok || (isArray != nil && isArray(plain)) {
+			idx = 0
+		}
+	}
+
+	if !last && idx < 0 && isPrimitive(obj[name]) {
+		name = "_" + name
+	}
+	if !last && idx >= 0 {
+		if arr := asArray(obj[name]); idx < len(arr) && isPrimitive(arr[idx]) {
+			name = "_" + name
+		}
+	}
+
+	if idx < 0 {
+		if last {
+			obj[name] = merge(obj[name], v)
+			return nil
+		}
+		child, ok := obj[name].(map[string]any)
+		if !ok {
+			if obj[name] != nil {
+				return fmt.Errorf("%s is not an object", strings.TrimPrefix(prefix, "."))
+			}
+			child = make(map[string]any)
+			obj[name] = child
+		}
+		return setSegments(child, segs[1:], v, prefix, plain, slices, isArray)
+	}
+
+	arr := asArray(obj[name])
+	for len(arr) <= idx {
+		arr = append(arr, nil)
+	}
+	obj[name] = arr
+	if last {
+		arr[idx] = merge(arr[idx], v)
+		return nil
+	}
+	child, ok := arr[idx].(map[string]any)
+	if !ok {
+		if arr[idx] != nil {
+			return fmt.Errorf("%s[%d] is not an object", strings.TrimPrefix(prefix, "."), idx)
+		}
+		child = make(map[string]any)
+		arr[idx] = child
+	}
+	return setSegments(child, segs[1:], v, fmt.Sprintf("%s[%d]", prefix, idx), plain, slices, isArray)
+}
+
+func asArray(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case nil:
+		return nil
+	default:
+		return []any{v}
+	}
+}
+
+func isPrimitive(v any) bool {
+	switch v.(type) {
+	case nil, map[string]any, []any:
+		return false
+	}
+	return true
+}
+
+// merge combines an existing value with an assigned one. Objects are merged
+// key by key; anything else is replaced.
+func merge(old, v any) any {
+	om, ok1 := old.(map[string]any)
+	vm, ok2 := v.(map[string]any)
+	if !ok1 || !ok2 {
+		return v
+	}
+	for k, x := range vm {
+		om[k] = merge(om[k], x)
+	}
+	return om
+}
+
+// findHoles returns the paths of array entries that were skipped by explicit
+// indexes and so have no value.
+func findHoles(v any, path string) []string {
+	var holes []string
+	switch v := v.(type) {
+	case map[string]any:
+		for k, x := range v {
+			holes = append(holes, findHoles(x, joinPath(path, k))...)
+		}
+	case []any:
+		for i, x := range v {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			if x == nil {
+				holes = append(holes, p)
+				continue
+			}
+			holes = append(holes, findHoles(x, p)...)
+		}
+	}
+	return holes
+}
+
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, x := range v {
+			m[k] = deepCopy(x)
+		}
+		return m
+	case Resource:
+		return deepCopy(map[string]any(v))
+	case []any:
+		a := make([]any, len(v))
+		for i, x := range v {
+			a[i] = deepCopy(x)
+		}
+		return a
+	default:
+		return v
+	}
+}
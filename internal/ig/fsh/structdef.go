@@ -0,0 +1,607 @@
+package fsh
+
+import (
+	"strconv"
+	"strings"
+)
+
+var primitiveTypes = map[string]bool{
+	"boolean": true, "integer": true, "integer64": true, "string": true, "decimal": true,
+	"uri": true, "url": true, "canonical": true, "base64Binary": true, "instant": true,
+	"date": true, "dateTime": true, "time": true, "code": true, "oid": true, "id": true,
+	"markdown": true, "unsignedInt": true, "positiveInt": true, "uuid": true, "xhtml": true,
+}
+
+var complexTypes = map[string]bool{
+	"Address": true, "Age": true, "Annotation": true, "Attachment": true, "Availability": true,
+	"BackboneElement": true, "CodeableConcept": true, "CodeableReference": true, "Coding": true,
+	"ContactDetail": true, "ContactPoint": true, "Contributor": true, "Count": true,
+	"DataRequirement": true, "Distance": true, "Dosage": true, "Duration": true, "Element": true,
+	"ElementDefinition": true, "Expression": true, "ExtendedContactDetail": true, "Extension": true,
+	"HumanName": true, "Identifier": true, "MarketingStatus": true, "Meta": true, "Money": true,
+	"MoneyQuantity": true, "Narrative": true, "ParameterDefinition": true, "Period": true,
+	"Population": true, "ProdCharacteristic": true, "ProductShelfLife": true, "Quantity": true,
+	"Range": true, "Ratio": true, "RatioRange": true, "Reference": true, "RelatedArtifact": true,
+	"SampledData": true, "Signature": true, "SimpleQuantity": true, "SubstanceAmount": true,
+	"Timing": true, "TriggerDefinition": true, "UsageContext": true, "VirtualServiceDetail": true,
+}
+
+// choicePrefixes are the choice elements ([x]) whose typed names, such as
+// valueQuantity, are written as type slices of the choice.
+var choicePrefixes = []string{
+	"value", "effective", "onset", "abatement", "deceased", "multipleBirth",
+	"occurrence", "performed", "medication", "reported", "timing", "serviced",
+}
+
+// choiceType splits a typed choice name like valueQuantity into the choice
+// element and its type code.
+func choiceType(name string) (choice, typ string, ok bool) {
+	for _, prefix := range choicePrefixes {
+		rest, found := strings.CutPrefix(name, prefix)
+		if !found || rest == "" {
+			continue
+		}
+		if complexTypes[rest] {
+			return prefix + "[x]", rest, true
+		}
+		if lower := strings.ToLower(rest[:1]) + rest[1:]; primitiveTypes[lower] {
+			return prefix + "[x]", lower, true
+		}
+	}
+	return "", "", false
+}
+
+// sdBuilder accumulates the differential of one StructureDefinition.
+type sdBuilder struct {
+	x        *Exporter
+	entity   *Entity
+	sd       Resource
+	url      string
+	typ      string
+	root     string
+	elements []map[string]any
+	byID     map[string]map[string]any
+}
+
+func (x *Exporter) exportStructureDefinition(e *Entity) Resource {
+	parentURL, typ, ok := x.parentOf(e, nil)
+	if !ok {
+		return nil
+	}
+
+	b := &sdBuilder{x: x, entity: e, url: x.url(e), typ: typ, root: typ, byID: make(map[string]map[string]any)}
+	sd := Resource{
+		"resourceType":   "StructureDefinition",
+		"id":             x.id(e),
+		"name":           e.Name,
+		"status":         x.cfg.Status,
+		"fhirVersion":    x.cfg.FHIRVersion,
+		"abstract":       false,
+		"baseDefinition": parentURL,
+		"derivation":     "constraint",
+	}
+	b.sd = sd
+	if b.url != "" {
+		sd["url"] = b.url
+	}
+	if x.cfg.Version != "" {
+		sd["version"] = x.cfg.Version
+	}
+	if e.Title != "" {
+		sd["title"] = e.Title
+	}
+	if e.Description != "" {
+		sd["description"] = e.Description
+	}
+
+	switch e.Kind {
+	case KindProfile:
+		sd["kind"] = kindOfType(typ)
+	case KindExtension:
+		sd["kind"] = "complex-type"
+		sd["context"] = x.extensionContext(e)
+		root := b.element(".")
+		if e.Title != "" {
+			root["short"] = e.Title
+		}
+		if e.Description != "" {
+			root["definition"] = e.Description
+		}
+		b.element("url")["fixedUri"] = b.url
+	case KindLogical, KindResource:
+		sd["kind"] = "logical"
+		b.root = e.Name
+		sd["type"] = firstNonEmpty(b.url, e.Name)
+		if e.Kind == KindResource {
+			sd["kind"] = "resource"
+			sd["type"] = e.Name
+		}
+		sd["derivation"] = "specialization"
+		if len(e.Characteristics) > 0 {
+			exts := make([]any, len(e.Characteristics))
+			for i, c := range e.Characteristics {
+				exts[i] = map[string]any{
+					"url":       "http://hl7.org/fhir/tools/StructureDefinition/type-characteristics",
+					"valueCode": c,
+				}
+			}
+			sd["extension"] = exts
+		}
+		root := b.element(".")
+		root["short"] = firstNonEmpty(e.Title, e.Name)
+		root["definition"] = firstNonEmpty(e.Description, e.Title, e.Name)
+	}
+	if _, set := sd["type"]; !set {
+		sd["type"] = typ
+	}
+
+	for _, r := range x.entityRules(e) {
+		b.apply(r)
+	}
+	b.applyMappings()
+
+	elements := make([]any, len(b.elements))
+	for i, el := range b.elements {
+		elements[i] = el
+	}
+	sd["differential"] = map[string]any{"element": elements}
+	return sd
+}
+
+// parentOf resolves the base definition URL and the type an SD constrains.
+func (x *Exporter) parentOf(e *Entity, seen []*Entity) (url, typ string, ok bool) {
+	for _, s := range seen {
+		if s == e {
+			x.errs.Add(e.Pos, "%s %s is its own ancestor", e.Kind, e.Name)
+			return "", "", false
+		}
+	}
+	parent := e.Parent
+	if parent == "" {
+		switch e.Kind {
+		case KindProfile:
+			x.errs.Add(e.Pos, "Profile %s has no Parent", e.Name)
+			return "", "", false
+		case KindExtension:
+			parent = "Extension"
+		case KindLogical:
+			parent = "Base"
+		case KindResource:
+			parent = "DomainResource"
+		}
+	}
+
+	if a, found := x.aliases[parent]; found {
+		parent = a
+	}
+	if p := x.lookup(parent, KindProfile, KindExtension, KindLogical, KindResource); p != nil {
+		_, ptyp, ok := x.parentOf(p, append(seen, e))
+		if !ok {
+			return "", "", false
+		}
+		if p.Kind == KindLogical || p.Kind == KindResource {
+			ptyp = p.Name
+		}
+		return x.url(p), ptyp, true
+	}
+	if strings.HasPrefix(parent, coreBase) {
+		return parent, strings.TrimPrefix(parent, coreBase), true
+	}
+	if isCoreName(parent) {
+		return coreURL(parent), parent, true
+	}
+	x.errs.Add(e.Pos, "%s %s: cannot resolve Parent %s", e.Kind, e.Name, parent)
+	return "", "", false
+}
+
+func kindOfType(typ string) string {
+	switch {
+	case primitiveTypes[typ]:
+		return "primitive-type"
+	case complexTypes[typ]:
+		return "complex-type"
+	}
+	return "resource"
+}
+
+func (x *Exporter) extensionContext(e *Entity) []any {
+	if len(e.Context) == 0 {
+		return []any{map[string]any{"type": "element", "expression": "Element"}}
+	}
+	var ctx []any
+	for _, c := range e.Context {
+		switch ext := x.lookup(c.Value, KindExtension); {
+		case c.Quoted:
+			ctx = append(ctx, map[string]any{"type": "fhirpath", "expression": c.Value})
+		case ext != nil:
+			ctx = append(ctx, map[string]any{"type": "extension", "expression": x.url(ext)})
+		case strings.Contains(c.Value, "://"):
+			ctx = append(ctx, map[string]any{"type": "extension", "expression": c.Value})
+		default:
+			ctx = append(ctx, map[string]any{"type": "element", "expression": c.Value})
+		}
+	}
+	return ctx
+}
+
+// element returns the differential element for an FSH path, adding it if
+// needed. Slice names become ":name" in the id, and typed choice names such
+// as valueQuantity become the type slice value[x]:valueQuantity.
+func (b *sdBuilder) element(fshPath string) map[string]any {
+	id, path := b.root, b.root
+	if fshPath != "" && fshPath != "." {
+		for _, s := range splitPath(fshPath) {
+			idPart, pathPart := s.Name, s.Name
+			choice, typ, isChoice := "", "", false
+			if len(s.Brackets) == 0 {
+				choice, typ, isChoice = choiceType(s.Name)
+			}
+			if isChoice {
+				idPart, pathPart = choice+":"+s.Name, choice
+			}
+			for _, br := range s.Brackets {
+				switch {
+				case br == "x":
+					idPart += "[x]"
+					pathPart += "[x]"
+				case isIndex(br):
+				default:
+					idPart += ":" + br
+				}
+			}
+
+			if isChoice && b.byID[id+"."+idPart] == nil {
+				base := b.elementByID(id+"."+choice, path+"."+choice)
+				if _, sliced := base["slicing"]; !sliced {
+					base["slicing"] = map[string]any{
+						"discriminator": []any{map[string]any{"type": "type", "path": "$this"}},
+						"ordered":       false,
+						"rules":         "open",
+					}
+				}
+				slice := b.elementByID(id+"."+idPart, path+"."+pathPart)
+				slice["sliceName"] = s.Name
+				slice["type"] = []any{map[string]any{"code": typ}}
+			}
+			id += "." + idPart
+			path += "." + pathPart
+		}
+	}
+	return b.elementByID(id, path)
+}
+
+func (b *sdBuilder) elementByID(id, path string) map[string]any {
+	if el := b.byID[id]; el != nil {
+		return el
+	}
+	el := map[string]any{"id": id, "path": path}
+	b.elements = append(b.elements, el)
+	b.byID[id] = el
+	return el
+}
+
+func (b *sdBuilder) apply(r Rule) {
+	x := b.x
+	switch r := r.(type) {
+	case *CardRule:
+		el := b.element(r.Path)
+		b.card(el, r.Min, r.Max, r.Pos)
+		applyFlags(el, r.Flags)
+	case *FlagRule:
+		for _, p := range r.Paths {
+			applyFlags(b.element(p), r.Flags)
+		}
+	case *BindingRule:
+		strength := r.Strength
+		if strength == "" {
+			strength = "required"
+		}
+		b.element(r.Path)["binding"] = map[string]any{"strength": strength, "valueSet": x.canonical(r.ValueSet)}
+	case *AssignmentRule:
+		el := b.element(r.Path)
+		prefix := "pattern"
+		if r.Exactly {
+			prefix = "fixed"
+		}
+		el[prefix+b.typeSuffix(r.Path, r.Value)] = x.jsonValue(r.Value, b.typ, r.Path, r.Pos)
+	case *ContainsRule:
+		b.contains(r)
+	case *OnlyRule:
+		b.element(r.Path)["type"] = b.types(r.Types, r.Pos)
+	case *ObeysRule:
+		el := b.element(r.Path)
+		for _, name := range r.Invariants {
+			if c := b.constraint(name, r.Pos); c != nil {
+				el["constraint"] = append(asArray(el["constraint"]), c)
+			}
+		}
+	case *CaretValueRule:
+		target, typ := map[string]any(b.sd), "StructureDefinition"
+		if r.Path != "" {
+			target, typ = b.element(r.Path), "ElementDefinition"
+		}
+		x.assign(target, typ, "", r.Caret, r.Value, r.Pos, map[string]int{})
+	case *AddElementRule:
+		el := b.element(r.Path)
+		b.card(el, r.Min, r.Max, r.Pos)
+		applyFlags(el, r.Flags)
+		if r.ContentReference != "" {
+			el["contentReference"] = r.ContentReference
+		} else {
+			el["type"] = b.types(r.Types, r.Pos)
+		}
+		el["short"] = r.Short
+		el["definition"] = firstNonEmpty(r.Definition, r.Short)
+	}
+}
+
+func (b *sdBuilder) card(el map[string]any, min, max string, pos Position) {
+	if min != "" {
+		n, err := strconv.Atoi(min)
+		if err != nil {
+			b.x.errs.Add(pos, "invalid minimum cardinality %q", min)
+			return
+		}
+		el["min"] = n
+	}
+	if max != "" {
+		if n, err := strconv.Atoi(min); err == nil && max != "*" {
+			if m, _ := strconv.Atoi(max); m < n {
+				b.x.errs.Add(pos, "minimum cardinality %s is greater than maximum %s", min, max)
+			}
+		}
+		el["max"] = max
+	}
+}
+
+func applyFlags(el map[string]any, flags []string) {
+	for _, f := range flags {
+		switch f {
+		case "MS":
+			el["mustSupport"] = true
+		case "SU":
+			el["isSummary"] = true
+		case "?!":
+			el["isModifier"] = true
+		case "N", "TU", "D":
+			status := map[string]string{"N": "normative", "TU": "trial-use", "D": "draft"}[f]
+			el["extension"] = append(asArray(el["extension"]), map[string]any{
+				"url":       "http://hl7.org/fhir/StructureDefinition/structuredefinition-standards-status",
+				"valueCode": status,
+			})
+		}
+	}
+}
+
+// typeSuffix names the fixed[x] or pattern[x] type of an assigned value.
+func (b *sdBuilder) typeSuffix(path string, v Value) string {
+	switch v := v.(type) {
+	case StringValue:
+		switch lastName(path) {
+		case "url", "system":
+			return "Uri"
+		}
+		return "String"
+	case NumberValue:
+		if strings.ContainsAny(string(v), ".eE") {
+			return "Decimal"
+		}
+		return "Integer"
+	case BoolValue:
+		return "Boolean"
+	case CodeValue:
+		switch b.x.shapeOf(b.typ, path, v) {
+		case shapeCoding:
+			return "Coding"
+		case shapeCodeableConcept:
+			return "CodeableConcept"
+		case shapeQuantity:
+			return "Quantity"
+		}
+		return "Code"
+	case QuantityValue:
+		return "Quantity"
+	case RatioValue:
+		return "Ratio"
+	case ReferenceValue:
+		return "Reference"
+	case CanonicalValue:
+		return "Canonical"
+	case NameValue:
+		if e := b.x.lookup(string(v), KindInstance); e != nil {
+			if typ, _, ok := b.x.instanceType(e); ok {
+				return typ
+			}
+		}
+	}
+	return "String"
+}
+
+// types converts an only or add element rule's types to ElementDefinition.type.
+// Local profiles become a profile of their base type, and all Reference
+// targets are collected into one Reference type.
+func (b *sdBuilder) types(refs []TypeRef, pos Position) []any {
+	x := b.x
+	var out []any
+	byCode := make(map[string]map[string]any)
+	for _, ref := range refs {
+		code := ref.Name
+		switch code {
+		case "Reference", "Canonical", "CodeableReference":
+			if code == "Canonical" {
+				code = "canonical"
+			}
+			t := byCode[code]
+			if t == nil {
+				t = map[string]any{"code": code}
+				byCode[code] = t
+				out = append(out, t)
+			}
+			for _, target := range ref.Targets {
+				t["targetProfile"] = append(asArray(t["targetProfile"]), b.profileURL(target, pos))
+			}
+			continue
+		}
+
+		t := map[string]any{"code": code}
+		if e := x.lookup(code, KindProfile, KindExtension, KindLogical, KindResource); e != nil {
+			if _, typ, ok := x.parentOf(e, nil); ok {
+				t["code"] = typ
+				if e.Kind == KindLogical || e.Kind == KindResource {
+					t["code"] = x.url(e)
+				} else {
+					t["profile"] = []any{x.url(e)}
+				}
+			}
+		} else if a, ok := x.aliases[code]; ok {
+			t["code"] = a
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// profileURL resolves a type or profile named in Reference() or Canonical().
+func (b *sdBuilder) profileURL(name string, pos Position) string {
+	x := b.x
+	if a, ok := x.aliases[name]; ok {
+		return a
+	}
+	if e := x.lookup(name, conformanceKinds...); e != nil {
+		return x.url(e)
+	}
+	if strings.Contains(name, "://") || isCoreName(name) {
+		if isCoreName(name) {
+			return coreURL(name)
+		}
+		return name
+	}
+	x.errs.Add(pos, "cannot resolve %s", name)
+	return name
+}
+
+func (b *sdBuilder) contains(r *ContainsRule) {
+	x := b.x
+	base := b.element(r.Path)
+	name := lastName(r.Path)
+	isExtension := name == "extension" || name == "modifierExtension"
+	if _, sliced := base["slicing"]; !sliced {
+		slicing := map[string]any{"ordered": false, "rules": "open"}
+		if isExtension {
+			slicing["discriminator"] = []any{map[string]any{"type": "value", "path": "url"}}
+		}
+		base["slicing"] = slicing
+	}
+
+	for _, item := range r.Items {
+		path := r.Path + "[" + item.Name + "]"
+		el := b.element(path)
+		el["sliceName"] = item.Name
+		b.card(el, item.Min, item.Max, r.Pos)
+		applyFlags(el, item.Flags)
+
+		typ := item.Type
+		if typ == "" && isExtension {
+			// An item without "named" may still name an extension
+			if x.lookup(item.Name, KindExtension) != nil || x.aliases[item.Name] != "" {
+				typ = item.Name
+			}
+		}
+		switch {
+		case typ != "" && isExtension:
+			el["type"] = []any{map[string]any{"code": "Extension", "profile": []any{b.profileURL(typ, r.Pos)}}}
+		case typ != "":
+			el["type"] = b.types([]TypeRef{{Name: typ}}, r.Pos)
+		case isExtension && b.entity.Kind == KindExtension:
+			// Inline sub-extension of a complex extension
+			b.element(path + ".url")["fixedUri"] = item.Name
+			value := b.element("value[x]")
+			value["min"] = 0
+			value["max"] = "0"
+		}
+	}
+}
+
+// constraint builds an ElementDefinition.constraint from an Invariant.
+func (b *sdBuilder) constraint(name string, pos Position) map[string]any {
+	x := b.x
+	inv := x.lookup(name, KindInvariant)
+	if inv == nil {
+		x.errs.Add(pos, "unknown Invariant %s", name)
+		return nil
+	}
+	c := map[string]any{"key": inv.Name}
+	if inv.Severity != "" {
+		c["severity"] = inv.Severity
+	}
+	if inv.Description != "" {
+		c["human"] = inv.Description
+	}
+	if inv.Expression != "" {
+		c["expression"] = inv.Expression
+	}
+	if inv.XPath != "" {
+		c["xpath"] = inv.XPath
+	}
+	if b.url != "" {
+		c["source"] = b.url
+	}
+	for _, r := range x.entityRules(inv) {
+		if a, ok := r.(*AssignmentRule); ok {
+			x.assign(c, "ElementDefinition", "constraint", a.Path, a.Value, a.Pos, map[string]int{})
+		}
+	}
+	if c["severity"] == nil || c["human"] == nil {
+		x.errs.Add(inv.Pos, "Invariant %s needs a Severity and a Description", inv.Name)
+	}
+	return c
+}
+
+// applyMappings adds the Mapping entities whose Source is this definition.
+func (b *sdBuilder) applyMappings() {
+	x := b.x
+	for _, doc := range x.docs {
+		for _, m := range doc.Entities {
+			if m.Kind != KindMapping || x.lookup(m.Source, b.entity.Kind) != b.entity {
+				continue
+			}
+			identity := x.id(m)
+			mapping := map[string]any{"identity": identity}
+			if m.Target != "" {
+				mapping["uri"] = m.Target
+			}
+			if m.Title != "" {
+				mapping["name"] = m.Title
+			}
+			if m.Description != "" {
+				mapping["comment"] = m.Description
+			}
+			b.sd["mapping"] = append(asArray(b.sd["mapping"]), mapping)
+
+			for _, r := range x.entityRules(m) {
+				mr, ok := r.(*MappingRule)
+				if !ok {
+					continue
+				}
+				el := b.element(mr.Path)
+				entry := map[string]any{"identity": identity, "map": mr.Map}
+				if mr.Comment != "" {
+					entry["comment"] = mr.Comment
+				}
+				if mr.Language != "" {
+					entry["language"] = mr.Language
+				}
+				el["mapping"] = append(asArray(el["mapping"]), entry)
+			}
+		}
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
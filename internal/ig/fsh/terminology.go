@@ -0,0 +1,265 @@
+package fsh
+
+import (
+	"strconv"
+	"strings"
+)
+
+// filterOps are the ValueSet filter operators FHIR defines.
+var filterOps = map[string]bool{
+	"=": true, "is-a": true, "descendent-of": true, "is-not-a": true, "regex": true, "in": true,
+	"not-in": true, "generalizes": true, "exists": true, "child-of": true, "descendent-leaf": true,
+}
+
+// terminology returns the elements CodeSystem and ValueSet share.
+func (x *Exporter) terminology(e *Entity, resourceType string) Resource {
+	r := Resource{
+		"resourceType": resourceType,
+		"id":           x.id(e),
+		"name":         e.Name,
+		"status":       x.cfg.Status,
+	}
+	if url := x.url(e); url != "" {
+		r["url"] = url
+	}
+	if x.cfg.Version != "" {
+		r["version"] = x.cfg.Version
+	}
+	if e.Title != "" {
+		r["title"] = e.Title
+	}
+	if e.Description != "" {
+		r["description"] = e.Description
+	}
+	return r
+}
+
+// conceptNode is a CodeSystem concept while its children are collected.
+type conceptNode struct {
+	json     map[string]any
+	children []*conceptNode
+}
+
+func findConcept(nodes []*conceptNode, code string) *conceptNode {
+	for _, n := range nodes {
+		if n.json["code"] == code {
+			return n
+		}
+	}
+	return nil
+}
+
+func conceptJSON(nodes []*conceptNode) []any {
+	out := make([]any, len(nodes))
+	for i, n := range nodes {
+		if len(n.children) > 0 {
+			n.json["concept"] = conceptJSON(n.children)
+		}
+		out[i] = n.json
+	}
+	return out
+}
+
+func (x *Exporter) exportCodeSystem(e *Entity) Resource {
+	cs := x.terminology(e, "CodeSystem")
+	cs["content"] = "complete"
+	root := &conceptNode{}
+	count := 0
+
+	for _, r := range x.entityRules(e) {
+		switch r := r.(type) {
+		case *ConceptRule:
+			parent := x.conceptByPath(root, codeValues(r.Codes[:len(r.Codes)-1]), r.Pos)
+			if parent == nil {
+				continue
+			}
+			if findConcept(parent.children, r.Code()) != nil {
+				x.errs.Add(r.Pos, "duplicate concept #%s", r.Code())
+				continue
+			}
+			c := map[string]any{"code": r.Code()}
+			if r.Display != "" {
+				c["display"] = r.Display
+			}
+			if r.Definition != "" {
+				c["definition"] = r.Definition
+			}
+			parent.children = append(parent.children, &conceptNode{json: c})
+			count++
+		case *CaretValueRule:
+			target, base := map[string]any(cs), ""
+			if len(r.Codes) > 0 {
+				n := x.conceptByPath(root, r.Codes, r.Pos)
+				if n == nil {
+					continue
+				}
+				target, base = n.json, "concept"
+			} else if r.Caret == "concept" || stripIndexes(r.Caret) == "concept" {
+				x.errs.Add(r.Pos, "use concept rules to define concepts")
+				continue
+			}
+			x.assign(target, "CodeSystem", base, r.Caret, r.Value, r.Pos, map[string]int{})
+		}
+	}
+
+	if len(root.children) > 0 {
+		cs["concept"] = conceptJSON(root.children)
+	}
+	if _, set := cs["count"]; !set && cs["content"] == "complete" {
+		cs["count"] = count
+	}
+	return cs
+}
+
+// conceptByPath walks a concept hierarchy. An empty path returns root.
+func (x *Exporter) conceptByPath(root *conceptNode, codes []CodeValue, pos Position) *conceptNode {
+	n := root
+	for _, code := range codes {
+		if n = findConcept(n.children, code.Code); n == nil {
+			x.errs.Add(pos, "concept #%s is not defined", code.Code)
+			return nil
+		}
+	}
+	return n
+}
+
+func (x *Exporter) exportValueSet(e *Entity) Resource {
+	vs := x.terminology(e, "ValueSet")
+	var include, exclude []any
+
+	for _, r := range x.entityRules(e) {
+		switch r := r.(type) {
+		case *ValueSetRule:
+			target := &include
+			if !r.Include {
+				target = &exclude
+			}
+			if len(r.Concepts) > 0 {
+				for _, c := range r.Concepts {
+					x.addValueSetConcept(target, c, r.Pos)
+				}
+				continue
+			}
+			*target = append(*target, x.valueSetComponent(r))
+		case *CaretValueRule:
+			target, base := map[string]any(vs), ""
+			if len(r.Codes) > 0 {
+				target, base = x.valueSetConcept(include, r.Codes[0]), "compose.include.concept"
+				if target == nil {
+					x.errs.Add(r.Pos, "%s#%s is not included in the ValueSet", r.Codes[0].System, r.Codes[0].Code)
+					continue
+				}
+			}
+			x.assign(target, "ValueSet", base, r.Caret, r.Value, r.Pos, map[string]int{})
+		}
+	}
+
+	if len(include) == 0 && len(exclude) > 0 {
+		x.errs.Add(e.Pos, "ValueSet %s excludes codes but includes none", e.Name)
+	}
+	if len(include) > 0 {
+		compose := map[string]any{"include": include}
+		if len(exclude) > 0 {
+			compose["exclude"] = exclude
+		}
+		if existing, ok := vs["compose"].(map[string]any); ok {
+			compose = merge(existing, compose).(map[string]any)
+		}
+		vs["compose"] = compose
+	}
+	return vs
+}
+
+// addValueSetConcept adds a listed concept to the component for its system,
+// creating the component if needed.
+func (x *Exporter) addValueSetConcept(components *[]any, c CodeValue, pos Position) {
+	if c.System == "" {
+		x.errs.Add(pos, "concept #%s needs a system", c.Code)
+		return
+	}
+	system := x.system(c.System)
+	concept := map[string]any{"code": c.Code}
+	if c.Display != "" {
+		concept["display"] = c.Display
+	}
+	for _, comp := range *components {
+		m := comp.(map[string]any)
+		if m["system"] == system && m["version"] == versionOrNil(c.Version) && m["filter"] == nil && m["valueSet"] == nil {
+			m["concept"] = append(asArray(m["concept"]), concept)
+			return
+		}
+	}
+	comp := map[string]any{"system": system, "concept": []any{concept}}
+	if c.Version != "" {
+		comp["version"] = c.Version
+	}
+	*components = append(*components, comp)
+}
+
+func versionOrNil(v string) any {
+	if v == "" {
+		return nil
+	}
+	return v
+}
+
+func (x *Exporter) valueSetComponent(r *ValueSetRule) map[string]any {
+	comp := make(map[string]any)
+	if r.System != "" {
+		system, version, _ := strings.Cut(r.System, "|")
+		comp["system"] = x.system(system)
+		if version != "" {
+			comp["version"] = version
+		}
+	}
+	for _, vs := range r.ValueSets {
+		comp["valueSet"] = append(asArray(comp["valueSet"]), x.canonical(vs))
+	}
+	if len(r.Filters) > 0 && r.System == "" {
+		x.errs.Add(r.Pos, "filters need a system")
+	}
+	for _, f := range r.Filters {
+		if !filterOps[f.Op] {
+			x.errs.Add(r.Pos, "unknown filter operator %q", f.Op)
+			continue
+		}
+		var value string
+		switch v := f.Value.(type) {
+		case CodeValue:
+			value = v.Code
+		case StringValue:
+			value = string(v)
+		case RegexValue:
+			value = string(v)
+		case BoolValue:
+			value = strconv.FormatBool(bool(v))
+		case NameValue:
+			value = string(v)
+		default:
+			x.errs.Add(r.Pos, "filter %s %s: unsupported value", f.Property, f.Op)
+			continue
+		}
+		comp["filter"] = append(asArray(comp["filter"]), map[string]any{
+			"property": f.Property,
+			"op":       f.Op,
+			"value":    value,
+		})
+	}
+	return comp
+}
+
+func (x *Exporter) valueSetConcept(include []any, code CodeValue) map[string]any {
+	system := x.system(code.System)
+	for _, comp := range include {
+		m := comp.(map[string]any)
+		if m["system"] != system {
+			continue
+		}
+		for _, c := range asArray(m["concept"]) {
+			if c := c.(map[string]any); c["code"] == code.Code {
+				return c
+			}
+		}
+	}
+	return nil
+}
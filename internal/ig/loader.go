@@ -1,147 +1,401 @@
 package ig
 
 import (
-	"bufio"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
 	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	"github.com/zs-health/zh-fhir-go/fhir/r5/valuesets/bd"
+	"github.com/zs-health/zh-fhir-go/internal/ig/fsh"
+	"gopkg.in/yaml.v3"
 )
 
 // Loader handles loading FHIR resources from the IG
 type Loader struct {
-	CodeSystems map[string]*r4.CodeSystem
-	ValueSets   map[string]*r4.ValueSet
+	// Registry holds the loaded conformance resources by canonical URL and
+	// version.
+	Registry *conformance.Registry
+
+	// Packages holds the manifests of the loaded packages by name#version.
+	Packages map[string]Manifest
+	// Cache is where package dependencies are looked up.
+	Cache *PackageCache
 }
 
 func NewLoader() *Loader {
 	return &Loader{
-		CodeSystems: make(map[string]*r4.CodeSystem),
-		ValueSets:   make(map[string]*r4.ValueSet),
+		Registry: conformance.NewRegistry(),
+		Packages: make(map[string]Manifest),
+		Cache:    DefaultPackageCache(),
 	}
 }
 
-// LoadFromIG loads terminologies from the given IG path
+// LoadFromIG loads the terminology of the SUSHI project at igPath. The
+// dependencies listed in sushi-config.yaml are loaded from the package cache.
+// Every .fsh file under input/fsh is then parsed and exported with the
+// settings of sushi-config.yaml, and the resulting CodeSystems and ValueSets
+// are registered. A project without FSH sources loads nothing.
 func (l *Loader) LoadFromIG(igPath string) error {
-	fshPath := filepath.Join(igPath, "input", "fsh")
+	sc, err := readSushiConfig(filepath.Join(igPath, "sushi-config.yaml"))
+	if err != nil {
+		return err
+	}
 
-	// Load CodeSystems
-	csDir := filepath.Join(fshPath, "codeSystems")
-	err := filepath.Walk(csDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil || info.IsDir() || !strings.HasSuffix(info.Name(), ".fsh") {
+	var errs []error
+	for _, dep := range sortedKeys(sc.dependencies()) {
+		if err := l.LoadCachedPackage(dep, sc.dependencies()[dep]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	var docs []*fsh.Document
+	fshPath := filepath.Join(igPath, "input", "fsh")
+	err = filepath.WalkDir(fshPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == fshPath && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".fsh" {
 			return nil
 		}
-		return l.parseFSHFile(path)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		doc, err := fsh.Parse(path, src)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		docs = append(docs, doc)
+		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("walk codeSystems: %w", err)
+		return fmt.Errorf("walk %s: %w", fshPath, err)
 	}
+	cfg := sc.exportConfig()
+	cfg.ElementType = r4ElementType
+	cfg.IsArray = r4IsArray
+	resources, err := fsh.NewExporter(cfg, docs...).Export()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, res := range resources {
+		data, err := json.Marshal(res)
+		if err == nil {
+			err = l.AddResource(data, cfg.FHIRVersion, igPath)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", res.ResourceType(), res.ID(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
 
-	// Load ValueSets
-	vsDir := filepath.Join(fshPath, "valueSets")
-	err = filepath.Walk(vsDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil || info.IsDir() || !strings.HasSuffix(info.Name(), ".fsh") {
+// LoadPackage loads the conformance resources of the FHIR NPM package at path,
+// a package.tgz or an extracted package directory, after its dependencies.
+func (l *Loader) LoadPackage(path string) error {
+	pkg, err := ReadPackage(path)
+	if err != nil {
+		return err
+	}
+	return l.loadPackage(pkg)
+}
+
+// LoadCachedPackage loads a package and its dependencies from the package
+// cache. version may be exact, a wildcard such as 4.0.x, or latest.
+func (l *Loader) LoadCachedPackage(name, version string) error {
+	for _, m := range l.Packages {
+		if m.Name == name && conformance.MatchVersion(version, m.Version) {
 			return nil
 		}
-		return l.parseFSHFile(path)
-	})
+	}
+	dir, err := l.Cache.Find(name, version)
 	if err != nil {
-		return fmt.Errorf("walk valueSets: %w", err)
+		return err
+	}
+	return l.LoadPackage(dir)
+}
+
+func (l *Loader) loadPackage(pkg *Package) error {
+	id := pkg.Manifest.ID()
+	if _, ok := l.Packages[id]; ok {
+		return nil
+	}
+	l.Packages[id] = pkg.Manifest
+
+	var errs []error
+	deps := pkg.Manifest.Dependencies
+	for _, name := range sortedKeys(deps) {
+		if err := l.LoadCachedPackage(name, deps[name]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: dependency: %w", id, err))
+		}
+	}
+	for _, name := range pkg.ConformanceFiles() {
+		if err := l.AddResource(pkg.Files[name], fhirVersion(pkg.Manifest), id); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %w", id, name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fhirVersion returns the FHIR version a package is written for.
+func fhirVersion(m Manifest) string {
+	if len(m.FHIRVersions) > 0 {
+		return m.FHIRVersions[0]
+	}
+	return "4.0.1"
+}
+
+// r4Resources and r5Resources make the Go types conformance resources are
+// decoded into.
+var (
+	r4Resources = map[string]func() any{
+		"CodeSystem":          func() any { return new(r4.CodeSystem) },
+		"ValueSet":            func() any { return new(r4.ValueSet) },
+		"StructureDefinition": func() any { return new(r4.StructureDefinition) },
+		"ConceptMap":          func() any { return new(r4.ConceptMap) },
+		"SearchParameter":     func() any { return new(r4.SearchParameter) },
+		"OperationDefinition": func() any { return new(r4.OperationDefinition) },
+		"NamingSystem":        func() any { return new(r4.NamingSystem) },
+	}
+	r5Resources = map[string]func() any{
+		"CodeSystem":          func() any { return new(r5.CodeSystem) },
+		"ValueSet":            func() any { return new(r5.ValueSet) },
+		"StructureDefinition": func() any { return new(r5.StructureDefinition) },
+		"ConceptMap":          func() any { return new(r5.ConceptMap) },
+		"SearchParameter":     func() any { return new(r5.SearchParameter) },
+		"OperationDefinition": func() any { return new(r5.OperationDefinition) },
+		"NamingSystem":        func() any { return new(r5.NamingSystem) },
+	}
+)
+
+// AddResource registers a conformance resource given as JSON, written for
+// FHIR version fhirVersion, in the registry. R5 resources are decoded into
+// the r5 types and anything else into the r4 types. source records where the
+// resource came from. Resources of other types are ignored. A resource that
+// replaces a different one with the same canonical URL and version is still
+// registered and reported as a *conformance.Conflict.
+func (l *Loader) AddResource(data []byte, fhirVersion, source string) error {
+	var head struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+	factories := r4Resources
+	if strings.HasPrefix(fhirVersion, "5.") {
+		factories = r5Resources
+	}
+	factory, ok := factories[head.ResourceType]
+	if !ok {
+		return nil
+	}
+	res := factory()
+	if err := json.Unmarshal(data, res); err != nil {
+		return err
+	}
+	if ns, ok := res.(*r4.NamingSystem); ok {
+		return l.addNamingSystem(ns, fhirVersion, source)
 	}
+	return l.Registry.Register(res, fhirVersion, source)
+}
+
+// addNamingSystem registers an R4 NamingSystem, which has no url, under each
+// of its unique ids, such as a code system URI or an OID.
+func (l *Loader) addNamingSystem(ns *r4.NamingSystem, fhirVersion, source string) error {
+	var errs []error
+	for _, id := range ns.UniqueId {
+		errs = append(errs, l.Registry.Add(&conformance.Entry{
+			ResourceType: "NamingSystem",
+			URL:          id.Value,
+			FHIRVersion:  fhirVersion,
+			Source:       source,
+			Resource:     ns,
+		}))
+	}
+	return errors.Join(errs...)
+}
 
+// LoadBuiltin registers the terminology compiled into the module, such as the
+// Bangladesh administrative geography, so the server has it without an IG.
+func (l *Loader) LoadBuiltin() error {
+	var cs r4.CodeSystem
+	if err := convertJSON(bd.GeographyCodeSystem(), &cs); err != nil {
+		return fmt.Errorf("load bd geography: %w", err)
+	}
+	if err := l.AddCodeSystem(&cs); err != nil {
+		return err
+	}
+
+	for _, src := range bd.GeographyValueSets() {
+		var vs r4.ValueSet
+		if err := convertJSON(src, &vs); err != nil {
+			return fmt.Errorf("load bd geography: %w", err)
+		}
+		if err := l.AddValueSet(&vs); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (l *Loader) parseFSHFile(path string) error {
-	file, err := os.Open(path)
+// AddCodeSystem registers an R4 CodeSystem under its canonical URL.
+func (l *Loader) AddCodeSystem(cs *r4.CodeSystem) error {
+	return l.Registry.Register(cs, "4.0.1", "")
+}
+
+// AddValueSet registers an R4 ValueSet under its canonical URL.
+func (l *Loader) AddValueSet(vs *r4.ValueSet) error {
+	return l.Registry.Register(vs, "4.0.1", "")
+}
+
+// convertJSON copies a resource into another Go type through its JSON form.
+// It turns the R5 built-in terminology into R4 CodeSystems and ValueSets,
+// which share the same JSON layout for the elements the server uses.
+func convertJSON(src, dst any) error {
+	data, err := json.Marshal(src)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	return json.Unmarshal(data, dst)
+}
 
-	scanner := bufio.NewScanner(file)
-	var currentCS *r4.CodeSystem
-	var currentVS *r4.ValueSet
+// sushiConfig holds the sushi-config.yaml settings the loader uses.
+type sushiConfig struct {
+	Canonical   string `yaml:"canonical"`
+	FHIRVersion any    `yaml:"fhirVersion"`
+	Version     string `yaml:"version"`
+	Status      string `yaml:"status"`
+	// Dependencies map a package name to a version, or to an object with a
+	// version.
+	Dependencies map[string]any `yaml:"dependencies"`
+}
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "//") {
-			continue
+// readSushiConfig reads the settings of a SUSHI project. A missing file
+// gives the defaults.
+func readSushiConfig(path string) (sushiConfig, error) {
+	var sc sushiConfig
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return sc, nil
+	}
+	if err != nil {
+		return sc, err
+	}
+	if err := yaml.Unmarshal(data, &sc); err != nil {
+		return sc, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return sc, nil
+}
+
+func (sc sushiConfig) exportConfig() fsh.Config {
+	cfg := fsh.Config{Canonical: strings.TrimSuffix(sc.Canonical, "/"), Version: sc.Version, Status: sc.Status}
+	// fhirVersion is a single version or a list of them
+	switch v := sc.FHIRVersion.(type) {
+	case string:
+		cfg.FHIRVersion = v
+	case []any:
+		if len(v) > 0 {
+			cfg.FHIRVersion = fmt.Sprint(v[0])
 		}
+	}
+	return cfg
+}
 
-		parts := strings.SplitN(line, ":", 2)
-		if len(parts) < 2 {
-			// Handle code entries starting with * #
-			if strings.HasPrefix(line, "* #") && currentCS != nil {
-				codeLine := strings.TrimPrefix(line, "* #")
-				codeParts := strings.SplitN(codeLine, "\"", 2)
-				code := strings.TrimSpace(codeParts[0])
-				display := ""
-				if len(codeParts) > 1 {
-					display = strings.Trim(codeParts[1], "\" ")
-				}
-				currentCS.Concept = append(currentCS.Concept, r4.CodeSystemConcept{
-					Code:    code,
-					Display: &display,
-				})
-			}
-			continue
+func (sc sushiConfig) dependencies() map[string]string {
+	deps := make(map[string]string, len(sc.Dependencies))
+	for name, v := range sc.Dependencies {
+		switch v := v.(type) {
+		case map[string]any:
+			deps[name] = fmt.Sprint(v["version"])
+		case nil:
+			deps[name] = "latest"
+		default:
+			deps[name] = fmt.Sprint(v)
 		}
+	}
+	return deps
+}
 
-		key := strings.TrimSpace(parts[0])
-		value := strings.TrimSpace(parts[1])
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
 
-		switch key {
-		case "CodeSystem":
-			currentCS = &r4.CodeSystem{
-				Name:    &value,
-				Status:  "active",
-				Content: "complete",
-			}
-			currentVS = nil
-		case "ValueSet":
-			currentVS = &r4.ValueSet{
-				Name:   &value,
-				Status: "active",
-			}
-			currentCS = nil
-		case "Id":
-			if currentCS != nil {
-				currentCS.ID = &value
-			} else if currentVS != nil {
-				currentVS.ID = &value
-			}
-		case "Title":
-			val := strings.Trim(value, "\"")
-			if currentCS != nil {
-				currentCS.Title = &val
-			} else if currentVS != nil {
-				currentVS.Title = &val
-			}
-		case "Description":
-			val := strings.Trim(value, "\"")
-			if currentCS != nil {
-				currentCS.Description = &val
-			} else if currentVS != nil {
-				currentVS.Description = &val
-			}
-		case "* ^url":
-			val := strings.Trim(value, "=\" ")
-			if currentCS != nil {
-				currentCS.URL = &val
-				l.CodeSystems[val] = currentCS
-			} else if currentVS != nil {
-				currentVS.URL = &val
-				l.ValueSets[val] = currentVS
+// r4Types are the Go types the exporter's element hooks consult.
+var r4Types = map[string]reflect.Type{
+	"CodeSystem": reflect.TypeOf(r4.CodeSystem{}),
+	"ValueSet":   reflect.TypeOf(r4.ValueSet{}),
+}
+
+// r4Field returns the Go type of the element at a dotted JSON path.
+func r4Field(resourceType, path string) (reflect.Type, bool) {
+	t, ok := r4Types[resourceType]
+	if !ok {
+		return nil, false
+	}
+	for _, name := range strings.Split(path, ".") {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, false
+		}
+		f, ok := fieldByJSONName(t, name)
+		if !ok {
+			return nil, false
+		}
+		t = f.Type
+	}
+	return t, true
+}
+
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			if f, ok := fieldByJSONName(f.Type, name); ok {
+				return f, true
 			}
+			continue
+		}
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == name {
+			return f, true
 		}
 	}
+	return reflect.StructField{}, false
+}
 
-	return scanner.Err()
+func r4ElementType(resourceType, path string) string {
+	t, ok := r4Field(resourceType, path)
+	if !ok {
+		return ""
+	}
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		return t.Name()
+	}
+	return ""
 }
 
-func pointer[T any](v T) *T {
-	return &v
+func r4IsArray(resourceType, path string) bool {
+	t, ok := r4Field(resourceType, path)
+	return ok && t.Kind() == reflect.Slice
 }
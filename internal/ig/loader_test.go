@@ -0,0 +1,100 @@
+package ig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestLoadFromIG(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "sushi-config.yaml"), `
+id: bd.core
+canonical: https://fhir.example.org/
+fhirVersion: [4.0.1]
+version: 0.2.0
+status: draft
+`)
+	writeFile(t, filepath.Join(dir, "input", "fsh", "codeSystems", "camps.fsh"), `
+Alias: $camp = https://fhir.example.org/CodeSystem/rohingya-camp
+
+CodeSystem: RohingyaCampCS
+Id: rohingya-camp
+Title: "Rohingya Camps"
+* ^contact.name = "DGHS"
+* #camp-1e "Camp 1E"
+* #camp-1w "Camp 1W"
+`)
+	writeFile(t, filepath.Join(dir, "input", "fsh", "valueSets", "nested", "camps.fsh"), `
+ValueSet: RohingyaCampVS
+Id: rohingya-camp
+* include codes from system $camp
+* exclude $camp#camp-1w
+`)
+
+	l := NewLoader()
+	require.NoError(t, l.LoadFromIG(dir))
+
+	cs := resource[*r4.CodeSystem](t, l, "CodeSystem", "https://fhir.example.org/CodeSystem/rohingya-camp")
+	assert.Equal(t, "RohingyaCampCS", *cs.Name)
+	assert.Equal(t, "0.2.0", *cs.Version)
+	assert.EqualValues(t, "draft", cs.Status)
+	require.Len(t, cs.Contact, 1, "contact repeats even without an index")
+	assert.Equal(t, "DGHS", *cs.Contact[0].Name)
+	require.Len(t, cs.Concept, 2)
+	assert.Equal(t, "camp-1w", cs.Concept[1].Code)
+
+	vs := resource[*r4.ValueSet](t, l, "ValueSet", "https://fhir.example.org/ValueSet/rohingya-camp")
+	require.NotNil(t, vs.Compose)
+	assert.Equal(t, "https://fhir.example.org/CodeSystem/rohingya-camp", *vs.Compose.Include[0].System)
+	assert.Len(t, vs.Compose.Exclude, 1)
+}
+
+func TestLoadFromIGErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "input", "fsh", "bad.fsh"), `
+CodeSystem: Good
+* ^url = "http://example.org/good"
+* #a "A"
+
+CodeSystem: Bad
+* #b "B" "def" "extra"
+`)
+
+	l := NewLoader()
+	err := l.LoadFromIG(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad.fsh:7:")
+	assert.True(t, registered(l, "CodeSystem", "http://example.org/good"), "valid entities still load")
+}
+
+func TestLoadFromIGWithoutFSH(t *testing.T) {
+	l := NewLoader()
+	require.NoError(t, l.LoadFromIG(t.TempDir()))
+	assert.Zero(t, l.Registry.Len())
+}
+
+// resource returns the registered resource a canonical resolves to.
+func resource[T any](t *testing.T, l *Loader, resourceType, canonical string) T {
+	t.Helper()
+	e, ok := l.Registry.Resolve(resourceType, canonical)
+	require.True(t, ok, "%s %s is not registered", resourceType, canonical)
+	res, ok := e.Resource.(T)
+	require.True(t, ok, "%s is a %T", canonical, e.Resource)
+	return res
+}
+
+func registered(l *Loader, resourceType, canonical string) bool {
+	_, ok := l.Registry.Resolve(resourceType, canonical)
+	return ok
+}
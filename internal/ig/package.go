@@ -0,0 +1,239 @@
+package ig
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+)
+
+// conformanceTypes are the resource types loaded from packages.
+var conformanceTypes = map[string]bool{
+	"StructureDefinition": true,
+	"ValueSet":            true,
+	"CodeSystem":          true,
+	"ConceptMap":          true,
+	"SearchParameter":     true,
+	"OperationDefinition": true,
+	"NamingSystem":        true,
+}
+
+// Manifest is the package.json of a FHIR NPM package.
+type Manifest struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	Canonical    string            `json:"canonical,omitempty"`
+	FHIRVersions []string          `json:"fhirVersions,omitempty"`
+	Type         string            `json:"type,omitempty"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// ID returns the package id, name#version.
+func (m Manifest) ID() string {
+	return m.Name + "#" + m.Version
+}
+
+// packageIndex is the .index.json of a package: one entry per resource file.
+type packageIndex struct {
+	Files []struct {
+		Filename     string `json:"filename"`
+		ResourceType string `json:"resourceType"`
+	} `json:"files"`
+}
+
+// Package is a FHIR NPM package read into memory. Files holds the JSON files
+// of its package folder by name.
+type Package struct {
+	Manifest Manifest
+	Files    map[string][]byte
+}
+
+// ReadPackage reads a package from a package.tgz or from a directory holding
+// an extracted package, as found in the package cache. The directory may be
+// the package folder itself or its parent.
+func ReadPackage(p string) (*Package, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	var pkg *Package
+	if info.IsDir() {
+		pkg, err = readPackageDir(p)
+	} else {
+		pkg, err = readPackageTgz(p)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read package %s: %w", p, err)
+	}
+	return pkg, nil
+}
+
+func readPackageDir(dir string) (*Package, error) {
+	if _, err := os.Stat(filepath.Join(dir, "package", "package.json")); err == nil {
+		dir = filepath.Join(dir, "package")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[e.Name()] = data
+	}
+	return newPackage(files)
+}
+
+func readPackageTgz(file string) (*Package, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Only the files directly in package/ are part of the package
+		dir, name := path.Split(path.Clean(hdr.Name))
+		if dir != "package/" || path.Ext(name) != ".json" {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+	}
+	return newPackage(files)
+}
+
+func newPackage(files map[string][]byte) (*Package, error) {
+	data, ok := files["package.json"]
+	if !ok {
+		return nil, errors.New("package.json not found")
+	}
+	pkg := &Package{Files: files}
+	if err := json.Unmarshal(data, &pkg.Manifest); err != nil {
+		return nil, fmt.Errorf("package.json: %w", err)
+	}
+	if pkg.Manifest.Name == "" || pkg.Manifest.Version == "" {
+		return nil, errors.New("package.json: name and version are required")
+	}
+	return pkg, nil
+}
+
+// ConformanceFiles returns the names of the files holding conformance
+// resources, in name order. The .index.json is used when present; otherwise
+// every file is inspected.
+func (p *Package) ConformanceFiles() []string {
+	var names []string
+	if data, ok := p.Files[".index.json"]; ok {
+		var index packageIndex
+		if err := json.Unmarshal(data, &index); err == nil {
+			for _, f := range index.Files {
+				if _, ok := p.Files[f.Filename]; ok && conformanceTypes[f.ResourceType] {
+					names = append(names, f.Filename)
+				}
+			}
+			sort.Strings(names)
+			return names
+		}
+	}
+	for name, data := range p.Files {
+		if name == "package.json" || strings.HasPrefix(name, ".") {
+			continue
+		}
+		var head struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if json.Unmarshal(data, &head) == nil && conformanceTypes[head.ResourceType] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PackageCache is a FHIR package cache directory, laid out as the FHIR
+// tooling does: one name#version directory per package.
+type PackageCache struct {
+	Dir string
+}
+
+// DefaultPackageCache returns the cache used by the FHIR tooling:
+// $FHIR_PACKAGE_CACHE if set, otherwise ~/.fhir/packages.
+func DefaultPackageCache() *PackageCache {
+	if dir := os.Getenv("FHIR_PACKAGE_CACHE"); dir != "" {
+		return &PackageCache{Dir: dir}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return &PackageCache{}
+	}
+	return &PackageCache{Dir: filepath.Join(home, ".fhir", "packages")}
+}
+
+// Find returns the directory of a cached package. version may be exact, a
+// wildcard such as 4.0.x, or latest, in which case the highest matching
+// cached version is chosen.
+func (c *PackageCache) Find(name, version string) (string, error) {
+	if c == nil || c.Dir == "" {
+		return "", fmt.Errorf("package %s#%s: no package cache", name, version)
+	}
+	exact := filepath.Join(c.Dir, name+"#"+version)
+	if _, err := os.Stat(exact); err == nil {
+		return exact, nil
+	}
+
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	var best string
+	for _, e := range entries {
+		n, v, ok := strings.Cut(e.Name(), "#")
+		if !e.IsDir() || !ok || n != name || !conformance.MatchVersion(version, v) {
+			continue
+		}
+		if best == "" || conformance.CompareVersions(v, best) > 0 {
+			best = v
+		}
+	}
+	if best == "" {
+		return "", fmt.Errorf("package %s#%s not found in %s", name, version, c.Dir)
+	}
+	return filepath.Join(c.Dir, name+"#"+best), nil
+}
@@ -0,0 +1,281 @@
+package ig
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// writeTgz writes files into a gzipped tar, the layout of package.tgz.
+func writeTgz(t *testing.T, file string, files map[string]string) {
+	t.Helper()
+	f, err := os.Create(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+}
+
+// writeCached writes an extracted package into a package cache directory.
+func writeCached(t *testing.T, cache, id string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		writeFile(t, filepath.Join(cache, id, "package", name), content)
+	}
+}
+
+const bdCorePackage = `{
+  "name": "bd.fhir.core",
+  "version": "0.2.0",
+  "canonical": "https://fhir.example.org",
+  "fhirVersions": ["4.0.1"],
+  "dependencies": {"bd.fhir.terminology": "1.0.x"}
+}`
+
+func TestReadPackageTgz(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "package.tgz")
+	writeTgz(t, file, map[string]string{
+		"package/package.json":                        bdCorePackage,
+		"package/StructureDefinition-bd-patient.json": `{"resourceType": "StructureDefinition", "url": "https://fhir.example.org/StructureDefinition/bd-patient"}`,
+		"package/Patient-example.json":                `{"resourceType": "Patient", "id": "example"}`,
+		"package/other/ig-r4.json":                    `{"resourceType": "ImplementationGuide"}`,
+		"package/example/CodeSystem-x.json":           `{"resourceType": "CodeSystem"}`,
+	})
+
+	pkg, err := ReadPackage(file)
+	require.NoError(t, err)
+	assert.Equal(t, "bd.fhir.core#0.2.0", pkg.Manifest.ID())
+	assert.Equal(t, []string{"4.0.1"}, pkg.Manifest.FHIRVersions)
+	assert.Equal(t, map[string]string{"bd.fhir.terminology": "1.0.x"}, pkg.Manifest.Dependencies)
+	assert.Len(t, pkg.Files, 3, "files in subfolders of package/ are not part of the package")
+	assert.Equal(t, []string{"StructureDefinition-bd-patient.json"}, pkg.ConformanceFiles())
+}
+
+func TestReadPackageIndex(t *testing.T) {
+	dir := t.TempDir()
+	writeCached(t, dir, "p#1.0.0", map[string]string{
+		"package.json":     `{"name": "p", "version": "1.0.0"}`,
+		".index.json":      `{"index-version": 1, "files": [{"filename": "a.json", "resourceType": "ValueSet"}, {"filename": "b.json", "resourceType": "Patient"}]}`,
+		"a.json":           `{"resourceType": "ValueSet"}`,
+		"b.json":           `{"resourceType": "Patient"}`,
+		"not-indexed.json": `{"resourceType": "CodeSystem"}`,
+	})
+
+	for _, p := range []string{filepath.Join(dir, "p#1.0.0"), filepath.Join(dir, "p#1.0.0", "package")} {
+		pkg, err := ReadPackage(p)
+		require.NoError(t, err, p)
+		assert.Equal(t, []string{"a.json"}, pkg.ConformanceFiles(), "the index decides which files are read")
+	}
+}
+
+func TestReadPackageErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := ReadPackage(filepath.Join(dir, "missing.tgz"))
+	assert.Error(t, err)
+
+	writeFile(t, filepath.Join(dir, "nomanifest", "package", "a.json"), `{}`)
+	_, err = ReadPackage(filepath.Join(dir, "nomanifest"))
+	assert.ErrorContains(t, err, "package.json not found")
+
+	writeFile(t, filepath.Join(dir, "noversion", "package.json"), `{"name": "p"}`)
+	_, err = ReadPackage(filepath.Join(dir, "noversion"))
+	assert.ErrorContains(t, err, "name and version are required")
+
+	writeFile(t, filepath.Join(dir, "bad.tgz"), "not gzip")
+	_, err = ReadPackage(filepath.Join(dir, "bad.tgz"))
+	assert.Error(t, err)
+}
+
+func TestPackageCacheFind(t *testing.T) {
+	dir := t.TempDir()
+	for _, id := range []string{"hl7.fhir.r4.core#4.0.1", "p#1.0.0", "p#1.0.2", "p#1.1.0-ballot", "p#1.1.0", "p#1.10.0", "q#2.0.0"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, id), 0o755))
+	}
+	c := &PackageCache{Dir: dir}
+
+	tests := []struct {
+		name, version, want string
+	}{
+		{"hl7.fhir.r4.core", "4.0.1", "hl7.fhir.r4.core#4.0.1"},
+		{"p", "1.0.0", "p#1.0.0"},
+		{"p", "1.0.x", "p#1.0.2"},
+		{"p", "1.x", "p#1.10.0"},
+		{"p", "latest", "p#1.10.0"},
+		{"p", "1.1.0-ballot", "p#1.1.0-ballot"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"#"+tt.version, func(t *testing.T) {
+			got, err := c.Find(tt.name, tt.version)
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join(dir, tt.want), got)
+		})
+	}
+
+	_, err := c.Find("p", "2.0.0")
+	assert.ErrorContains(t, err, "p#2.0.0 not found")
+	_, err = (&PackageCache{}).Find("p", "1.0.0")
+	assert.Error(t, err)
+}
+
+func TestLoadPackageWithDependencies(t *testing.T) {
+	cache := t.TempDir()
+	writeCached(t, cache, "bd.fhir.terminology#1.0.3", map[string]string{
+		"package.json": `{"name": "bd.fhir.terminology", "version": "1.0.3", "dependencies": {"bd.fhir.base": "1.0.0"}}`,
+		"CodeSystem-camp.json": `{
+			"resourceType": "CodeSystem", "url": "https://fhir.example.org/CodeSystem/camp",
+			"status": "active", "content": "complete", "concept": [{"code": "camp-1e", "display": "Camp 1E"}]
+		}`,
+		"ValueSet-camp.json": `{
+			"resourceType": "ValueSet", "url": "https://fhir.example.org/ValueSet/camp", "status": "active",
+			"compose": {"include": [{"system": "https://fhir.example.org/CodeSystem/camp"}]}
+		}`,
+		"NamingSystem-nid.json": `{
+			"resourceType": "NamingSystem", "name": "NID", "status": "active", "kind": "identifier", "date": "2024-01-01",
+			"uniqueId": [{"type": "uri", "value": "http://example.org/nid"}, {"type": "oid", "value": "2.16.50.1"}]
+		}`,
+	})
+	writeCached(t, cache, "bd.fhir.base#1.0.0", map[string]string{
+		"package.json":           `{"name": "bd.fhir.base", "version": "1.0.0", "dependencies": {"bd.fhir.core": "0.2.0"}}`,
+		"ConceptMap-gender.json": `{"resourceType": "ConceptMap", "url": "https://fhir.example.org/ConceptMap/gender", "status": "active"}`,
+	})
+
+	file := filepath.Join(t.TempDir(), "package.tgz")
+	writeTgz(t, file, map[string]string{
+		"package/package.json": bdCorePackage,
+		"package/StructureDefinition-bd-patient.json": `{
+			"resourceType": "StructureDefinition", "url": "https://fhir.example.org/StructureDefinition/bd-patient",
+			"name": "BDPatient", "status": "active", "kind": "resource", "abstract": false, "type": "Patient",
+			"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Patient", "derivation": "constraint"
+		}`,
+		"package/SearchParameter-patient-nid.json": `{
+			"resourceType": "SearchParameter", "url": "https://fhir.example.org/SearchParameter/patient-nid",
+			"name": "nid", "status": "active", "code": "nid", "base": ["Patient"], "type": "token", "description": "NID"
+		}`,
+		"package/OperationDefinition-match.json": `{
+			"resourceType": "OperationDefinition", "url": "https://fhir.example.org/OperationDefinition/match",
+			"name": "match", "status": "active", "kind": "operation", "code": "match", "system": false, "type": true, "instance": false
+		}`,
+	})
+
+	l := NewLoader()
+	l.Cache = &PackageCache{Dir: cache}
+	require.NoError(t, l.LoadPackage(file))
+
+	assert.ElementsMatch(t, []string{"bd.fhir.core#0.2.0", "bd.fhir.terminology#1.0.3", "bd.fhir.base#1.0.0"}, keys(l.Packages),
+		"dependencies are resolved from the cache, and the cycle back to bd.fhir.core ends")
+	assert.True(t, registered(l, "StructureDefinition", "https://fhir.example.org/StructureDefinition/bd-patient"))
+	assert.True(t, registered(l, "SearchParameter", "https://fhir.example.org/SearchParameter/patient-nid"))
+	assert.True(t, registered(l, "OperationDefinition", "https://fhir.example.org/OperationDefinition/match"))
+	assert.True(t, registered(l, "ConceptMap", "https://fhir.example.org/ConceptMap/gender"))
+	cs := resource[*r4.CodeSystem](t, l, "CodeSystem", "https://fhir.example.org/CodeSystem/camp")
+	assert.Equal(t, "camp-1e", cs.Concept[0].Code)
+	assert.True(t, registered(l, "ValueSet", "https://fhir.example.org/ValueSet/camp"))
+	ns := resource[*r4.NamingSystem](t, l, "NamingSystem", "http://example.org/nid")
+	assert.Equal(t, "NID", ns.Name)
+	assert.Same(t, ns, resource[*r4.NamingSystem](t, l, "NamingSystem", "2.16.50.1"))
+
+	e, _ := l.Registry.Resolve("CodeSystem", "https://fhir.example.org/CodeSystem/camp")
+	assert.Equal(t, "bd.fhir.terminology#1.0.3", e.Source)
+	assert.Equal(t, "4.0.1", e.FHIRVersion)
+}
+
+func TestLoadPackageMissingDependency(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "package.tgz")
+	writeTgz(t, file, map[string]string{
+		"package/package.json":    bdCorePackage,
+		"package/ValueSet-a.json": `{"resourceType": "ValueSet", "url": "http://example.org/a", "status": "active"}`,
+	})
+
+	l := NewLoader()
+	l.Cache = &PackageCache{Dir: t.TempDir()}
+	err := l.LoadPackage(file)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bd.fhir.core#0.2.0: dependency: package bd.fhir.terminology#1.0.x not found")
+	assert.True(t, registered(l, "ValueSet", "http://example.org/a"), "the package itself still loads")
+}
+
+func TestLoadFromIGDependencies(t *testing.T) {
+	cache := t.TempDir()
+	writeCached(t, cache, "bd.fhir.terminology#1.0.0", map[string]string{
+		"package.json":    `{"name": "bd.fhir.terminology", "version": "1.0.0"}`,
+		"ValueSet-a.json": `{"resourceType": "ValueSet", "url": "http://example.org/a", "status": "active"}`,
+	})
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "sushi-config.yaml"), `
+canonical: https://fhir.example.org
+fhirVersion: 4.0.1
+dependencies:
+  bd.fhir.terminology:
+    id: bdterm
+    uri: http://example.org/ImplementationGuide/bd.fhir.terminology
+    version: 1.0.0
+`)
+
+	l := NewLoader()
+	l.Cache = &PackageCache{Dir: cache}
+	require.NoError(t, l.LoadFromIG(dir))
+	assert.Contains(t, l.Packages, "bd.fhir.terminology#1.0.0")
+	assert.True(t, registered(l, "ValueSet", "http://example.org/a"))
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+func TestLoadPackageFHIRVersions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "r5", "package.json"), `{"name": "r5.example", "version": "1.0.0", "fhirVersions": ["5.0.0"]}`)
+	writeFile(t, filepath.Join(dir, "r5", "CodeSystem-a.json"), `{"resourceType": "CodeSystem", "url": "http://example.org/a", "version": "2.0.0", "status": "active", "content": "complete"}`)
+	writeFile(t, filepath.Join(dir, "r4", "package.json"), `{"name": "r4.example", "version": "1.0.0", "fhirVersions": ["4.0.1"]}`)
+	writeFile(t, filepath.Join(dir, "r4", "CodeSystem-a.json"), `{"resourceType": "CodeSystem", "url": "http://example.org/a", "version": "1.0.0", "status": "active", "content": "complete"}`)
+
+	l := NewLoader()
+	require.NoError(t, l.LoadPackage(filepath.Join(dir, "r5")))
+	require.NoError(t, l.LoadPackage(filepath.Join(dir, "r4")))
+
+	latest := resource[*r5.CodeSystem](t, l, "CodeSystem", "http://example.org/a")
+	assert.Equal(t, "2.0.0", *latest.Version)
+	old := resource[*r4.CodeSystem](t, l, "CodeSystem", "http://example.org/a|1.0.0")
+	assert.Equal(t, "1.0.0", *old.Version)
+	e, ok := l.Registry.ResolveFHIR("CodeSystem", "http://example.org/a", "4.0.1")
+	require.True(t, ok)
+	assert.Equal(t, "r4.example#1.0.0", e.Source)
+}
+
+func TestLoadPackageConflict(t *testing.T) {
+	dir := t.TempDir()
+	for name, title := range map[string]string{"one": "One", "two": "Two"} {
+		writeFile(t, filepath.Join(dir, name, "package.json"), `{"name": "`+name+`", "version": "1.0.0"}`)
+		writeFile(t, filepath.Join(dir, name, "ValueSet-a.json"), `{"resourceType": "ValueSet", "url": "http://example.org/a", "version": "1.0.0", "title": "`+title+`", "status": "active"}`)
+	}
+
+	l := NewLoader()
+	require.NoError(t, l.LoadPackage(filepath.Join(dir, "one")))
+	err := l.LoadPackage(filepath.Join(dir, "two"))
+	var conflict *conformance.Conflict
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, "one#1.0.0", conflict.Existing.Source)
+	assert.Len(t, l.Registry.Conflicts(), 1)
+	assert.Equal(t, "Two", *resource[*r4.ValueSet](t, l, "ValueSet", "http://example.org/a|1.0.0").Title, "the later package wins")
+	require.NoError(t, l.LoadPackage(filepath.Join(dir, "two")), "loading a package again is a no-op")
+}
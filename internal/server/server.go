@@ -9,25 +9,62 @@ import (
 	"sync"
 
 	"github.com/google/uuid"
-	"github.com/zs-health/zh-fhir-go/internal/ig"
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+	"github.com/zs-health/zh-fhir-go/fhir/validation"
 )
 
 // Server represents the main FHIR server
 type Server struct {
 	mu        sync.RWMutex
 	resources map[string]map[string]any
-	loader    *ig.Loader
+	registry  *conformance.Registry
+	validator *validation.FHIRValidator
 	term      *TerminologyServer
 }
 
-func NewServer(loader *ig.Loader) *Server {
+// NewServer creates a server backed by the conformance resources in reg.
+// Created and updated resources are validated against the profiles and
+// ValueSets in reg.
+func NewServer(reg *conformance.Registry) *Server {
+	fv := validation.NewFHIRValidator()
+	fv.SetRegistry(reg)
 	return &Server{
 		resources: make(map[string]map[string]any),
-		loader:    loader,
-		term:      NewTerminologyServer(loader),
+		registry:  reg,
+		validator: fv,
+		term:      NewTerminologyServer(reg),
 	}
 }
 
+// Validator returns the validator the server checks resources with, so
+// that callers can register profile rules or a terminology service.
+func (s *Server) Validator() *validation.FHIRValidator {
+	return s.validator
+}
+
+// validate checks a resource about to be stored. When it has errors, it
+// writes them as an OperationOutcome with status 422 and returns false.
+func (s *Server) validate(w http.ResponseWriter, resource map[string]any) bool {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return false
+	}
+	errs, err := s.validator.Check(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	if !errs.HasErrors() {
+		return true
+	}
+	w.Header().Set("Content-Type", "application/fhir+json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	json.NewEncoder(w).Encode(errs.AtLeast(validation.SeverityError).OperationOutcome())
+	return false
+}
+
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := strings.TrimPrefix(r.URL.Path, "/")
 	parts := strings.Split(path, "/")
@@ -80,6 +117,9 @@ func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request, resourceTy
 	id := uuid.New().String()
 	resource["id"] = id
 	resource["resourceType"] = resourceType
+	if !s.validate(w, resource) {
+		return
+	}
 
 	s.mu.Lock()
 	if _, ok := s.resources[resourceType]; !ok {
@@ -94,6 +134,15 @@ func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request, resourceTy
 }
 
 func (s *Server) handleRead(w http.ResponseWriter, r *http.Request, resourceType, id string) {
+	sub, err := parseSubset(r.URL.Query())
+	if err == nil && sub.count {
+		err = fmt.Errorf("_summary=count only applies to searches")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -103,6 +152,12 @@ func (s *Server) handleRead(w http.ResponseWriter, r *http.Request, resourceType
 		return
 	}
 
+	if m, ok := res.(map[string]any); ok {
+		// A read has no place for the warning of a resource that can't be
+		// subsetted; it gets the whole resource, untagged
+		res, _ = sub.apply(m)
+	}
+
 	w.Header().Set("Content-Type", "application/fhir+json")
 	json.NewEncoder(w).Encode(res)
 }
@@ -116,6 +171,9 @@ func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request, resourceTy
 
 	resource["id"] = id
 	resource["resourceType"] = resourceType
+	if !s.validate(w, resource) {
+		return
+	}
 
 	s.mu.Lock()
 	if _, ok := s.resources[resourceType]; !ok {
@@ -137,6 +195,12 @@ func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request, resourceTy
 }
 
 func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request, resourceType string) {
+	sub, err := parseSubset(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -144,7 +208,7 @@ func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request, resourceTy
 		ResourceType string `json:"resourceType"`
 		Type         string `json:"type"`
 		Total        int    `json:"total"`
-		Entry        []any  `json:"entry"`
+		Entry        []any  `json:"entry,omitempty"`
 	}
 
 	bundle := Bundle{
@@ -153,8 +217,12 @@ func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request, resourceTy
 		Entry:        make([]any, 0),
 	}
 
+	var outcome *r5.OperationOutcome
 	if resources, ok := s.resources[resourceType]; ok {
 		for _, res := range resources {
+			if m, ok := res.(map[string]any); ok {
+				res, outcome = sub.apply(m)
+			}
 			bundle.Entry = append(bundle.Entry, struct {
 				Resource any `json:"resource"`
 			}{Resource: res})
@@ -162,6 +230,17 @@ func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request, resourceTy
 	}
 
 	bundle.Total = len(bundle.Entry)
+	if outcome != nil {
+		// The resources of a type couldn't be subsetted
+		bundle.Entry = append(bundle.Entry, map[string]any{
+			"resource": outcome,
+			"search":   map[string]any{"mode": "outcome"},
+		})
+	}
+	if sub.count {
+		// _summary=count returns the total alone
+		bundle.Entry = nil
+	}
 	w.Header().Set("Content-Type", "application/fhir+json")
 	json.NewEncoder(w).Encode(bundle)
 }
@@ -0,0 +1,337 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
+	"github.com/zs-health/zh-fhir-go/internal/ig"
+)
+
+const testPatient = `{
+	"resourceType": "Patient",
+	"meta": {"tag": [{"system": "http://example.org/tags", "code": "camp"}]},
+	"text": {"status": "generated", "div": "<div>Rahim Uddin</div>"},
+	"active": true,
+	"gender": "male",
+	"birthDate": "1990-01-02",
+	"name": [{"family": "Uddin", "given": ["Rahim"]}],
+	"maritalStatus": {"text": "Married"},
+	"contact": [{"name": {"family": "Begum"}, "gender": "female"}]
+}`
+
+// newTestServer returns a server holding testPatient, and its id.
+func newTestServer(t *testing.T) (*Server, string) {
+	t.Helper()
+	s := NewServer(conformance.NewRegistry())
+	return s, create(t, s, "Patient", testPatient)
+}
+
+// get sends a GET request and decodes the response.
+func get(t *testing.T, s *Server, target string) (int, map[string]any) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("%s: %v", target, err)
+	}
+	return rec.Code, body
+}
+
+// tagCodes returns the codes of the meta.tag of a resource.
+func tagCodes(resource map[string]any) []string {
+	meta, _ := resource["meta"].(map[string]any)
+	tags, _ := meta["tag"].([]any)
+	var codes []string
+	for _, tag := range tags {
+		if coding, ok := tag.(map[string]any); ok {
+			codes = append(codes, coding["code"].(string))
+		}
+	}
+	return codes
+}
+
+func TestHandleRead_Subset(t *testing.T) {
+	s, id := newTestServer(t)
+
+	tests := []struct {
+		name      string
+		query     string
+		want      []string
+		notWant   []string
+		subsetted bool
+	}{
+		{
+			name:  "whole resource",
+			query: "",
+			want:  []string{"text", "active", "maritalStatus", "contact"},
+		},
+		{
+			name:  "summary false",
+			query: "?_summary=false",
+			want:  []string{"text", "maritalStatus", "contact"},
+		},
+		{
+			name:      "summary true",
+			query:     "?_summary=true",
+			want:      []string{"id", "meta", "active", "gender", "birthDate", "name"},
+			notWant:   []string{"text", "maritalStatus", "contact"},
+			subsetted: true,
+		},
+		{
+			name:      "summary text",
+			query:     "?_summary=text",
+			want:      []string{"id", "meta", "text"},
+			notWant:   []string{"active", "name", "contact"},
+			subsetted: true,
+		},
+		{
+			name:      "summary data",
+			query:     "?_summary=data",
+			want:      []string{"active", "maritalStatus", "contact"},
+			notWant:   []string{"text"},
+			subsetted: true,
+		},
+		{
+			name:      "elements",
+			query:     "?_elements=gender,name",
+			want:      []string{"resourceType", "id", "meta", "gender", "name"},
+			notWant:   []string{"text", "active", "birthDate", "contact"},
+			subsetted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, res := get(t, s, "/fhir/Patient/"+id+tt.query)
+			if code != http.StatusOK {
+				t.Fatalf("status = %d", code)
+			}
+			for _, key := range tt.want {
+				if _, ok := res[key]; !ok {
+					t.Errorf("%s should be included", key)
+				}
+			}
+			for _, key := range tt.notWant {
+				if _, ok := res[key]; ok {
+					t.Errorf("%s should not be included", key)
+				}
+			}
+			codes := tagCodes(res)
+			if !strings.Contains(strings.Join(codes, ","), "camp") {
+				t.Errorf("meta.tag = %v, should keep the resource's tags", codes)
+			}
+			if got := strings.Contains(strings.Join(codes, ","), "SUBSETTED"); got != tt.subsetted {
+				t.Errorf("meta.tag = %v, SUBSETTED tag = %v, want %v", codes, got, tt.subsetted)
+			}
+		})
+	}
+
+	// The stored resource is left as it was
+	_, res := get(t, s, "/fhir/Patient/"+id)
+	if codes := tagCodes(res); len(codes) != 1 {
+		t.Errorf("stored meta.tag = %v", codes)
+	}
+}
+
+func TestHandleRead_SubsetErrors(t *testing.T) {
+	s, id := newTestServer(t)
+
+	for _, query := range []string{
+		"?_summary=bogus",
+		"?_summary=count",
+		"?_summary=true&_elements=name",
+	} {
+		if code, _ := get(t, s, "/fhir/Patient/"+id+query); code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", query, code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestHandleSearch_Subset(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	_, bundle := get(t, s, "/fhir/Patient?_elements=contact.gender")
+	entries, _ := bundle["entry"].([]any)
+	if len(entries) != 1 {
+		t.Fatalf("entry = %v", bundle["entry"])
+	}
+	res := entries[0].(map[string]any)["resource"].(map[string]any)
+	contacts, _ := res["contact"].([]any)
+	if len(contacts) != 1 {
+		t.Fatalf("contact = %v", res["contact"])
+	}
+	contact := contacts[0].(map[string]any)
+	if _, ok := contact["name"]; ok || contact["gender"] != "female" {
+		t.Errorf("contact = %v, want only its gender", contact)
+	}
+	if _, ok := res["name"]; ok {
+		t.Error("name should not be included")
+	}
+	if !strings.Contains(strings.Join(tagCodes(res), ","), "SUBSETTED") {
+		t.Errorf("meta.tag = %v, want SUBSETTED", tagCodes(res))
+	}
+
+	_, bundle = get(t, s, "/fhir/Patient?_summary=count")
+	if bundle["total"] != float64(1) {
+		t.Errorf("total = %v, want 1", bundle["total"])
+	}
+	if _, ok := bundle["entry"]; ok {
+		t.Error("_summary=count should return no entries")
+	}
+}
+
+// create stores a resource and returns its id.
+func create(t *testing.T, s *Server, resourceType, body string) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/fhir/"+resourceType, strings.NewReader(body)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create %s: status %d: %s", resourceType, rec.Code, rec.Body)
+	}
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatal(err)
+	}
+	return created.ID
+}
+
+func TestHandleRead_SubsetR5Resource(t *testing.T) {
+	s := NewServer(conformance.NewRegistry())
+	id := create(t, s, "SubscriptionTopic", `{"resourceType":"SubscriptionTopic","url":"http://example.org/topic","status":"active","description":"Admissions"}`)
+
+	_, res := get(t, s, "/fhir/SubscriptionTopic/"+id+"?_summary=true")
+	if res["url"] != "http://example.org/topic" || res["status"] != "active" {
+		t.Errorf("summary = %v, want url and status", res)
+	}
+	if _, ok := res["description"]; ok {
+		t.Errorf("summary = %v, description is not a summary element", res)
+	}
+	if !strings.Contains(strings.Join(tagCodes(res), ","), "SUBSETTED") {
+		t.Errorf("meta.tag = %v, want SUBSETTED", tagCodes(res))
+	}
+}
+
+func TestHandleRead_SubsetR5Elements(t *testing.T) {
+	s := NewServer(conformance.NewRegistry())
+	id := create(t, s, "Observation", `{"resourceType":"Observation","status":"final","code":{"text":"Referral"},`+
+		`"instantiatesCanonical":"http://example.org/ActivityDefinition/referral",`+
+		`"valueReference":{"reference":"DocumentReference/1"},"note":[{"text":"Seen"}]}`)
+
+	// Summary elements R4 doesn't have are kept
+	_, res := get(t, s, "/fhir/Observation/"+id+"?_summary=true")
+	if res["instantiatesCanonical"] != "http://example.org/ActivityDefinition/referral" {
+		t.Errorf("summary = %v, want instantiatesCanonical", res)
+	}
+	if _, ok := res["valueReference"]; !ok {
+		t.Errorf("summary = %v, want valueReference", res)
+	}
+	if _, ok := res["note"]; ok {
+		t.Errorf("summary = %v, note is not a summary element", res)
+	}
+}
+
+func TestSubset_UnknownResourceType(t *testing.T) {
+	s := NewServer(conformance.NewRegistry())
+	id := create(t, s, "Shelter", `{"resourceType":"Shelter","name":"Camp 4","block":"B"}`)
+
+	// A read gets the whole resource, not tagged SUBSETTED
+	_, res := get(t, s, "/fhir/Shelter/"+id+"?_summary=true")
+	if res["name"] != "Camp 4" || res["block"] != "B" {
+		t.Errorf("read = %v, want the whole resource", res)
+	}
+	if codes := tagCodes(res); len(codes) != 0 {
+		t.Errorf("meta.tag = %v, want none", codes)
+	}
+
+	// A search says why in an OperationOutcome entry
+	_, bundle := get(t, s, "/fhir/Shelter?_elements=name")
+	if bundle["total"] != float64(1) {
+		t.Errorf("total = %v, want 1", bundle["total"])
+	}
+	entries, _ := bundle["entry"].([]any)
+	if len(entries) != 2 {
+		t.Fatalf("entry = %v, want the resource and an OperationOutcome", bundle["entry"])
+	}
+	if res := entries[0].(map[string]any)["resource"].(map[string]any); res["block"] != "B" {
+		t.Errorf("resource = %v, want the whole resource", res)
+	}
+	outcome := entries[1].(map[string]any)
+	if mode := outcome["search"].(map[string]any)["mode"]; mode != "outcome" {
+		t.Errorf("search.mode = %v, want outcome", mode)
+	}
+	oo := outcome["resource"].(map[string]any)
+	issue := oo["issue"].([]any)[0].(map[string]any)
+	if oo["resourceType"] != "OperationOutcome" || issue["severity"] != "warning" || issue["code"] != "not-supported" {
+		t.Errorf("outcome = %v, want a not-supported warning", oo)
+	}
+}
+
+const testProfile = `{
+	"resourceType": "StructureDefinition",
+	"url": "http://example.org/StructureDefinition/identified-patient",
+	"version": "1.0.0",
+	"name": "IdentifiedPatient",
+	"status": "active",
+	"fhirVersion": "4.0.1",
+	"kind": "resource",
+	"abstract": false,
+	"type": "Patient",
+	"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Patient",
+	"derivation": "constraint",
+	"snapshot": {"element": [
+		{"id": "Patient", "path": "Patient", "min": 0, "max": "*"},
+		{"id": "Patient.identifier", "path": "Patient.identifier", "min": 1, "max": "*", "type": [{"code": "Identifier"}]}
+	]}
+}`
+
+func TestHandleCreate_EnforcesRegistryProfiles(t *testing.T) {
+	loader := ig.NewLoader()
+	if err := loader.AddResource([]byte(testProfile), "4.0.1", "test"); err != nil {
+		t.Fatal(err)
+	}
+	reg := loader.Registry
+	s := NewServer(reg)
+
+	send := func(method, target, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rec
+	}
+	missing := `{"resourceType": "Patient", "meta": {"profile": ["http://example.org/StructureDefinition/identified-patient"]}}`
+	present := `{"resourceType": "Patient", "meta": {"profile": ["http://example.org/StructureDefinition/identified-patient"]},
+		"identifier": [{"system": "http://example.org/mrn", "value": "42"}]}`
+
+	rec := send(http.MethodPost, "/fhir/Patient", missing)
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("create without identifier: status %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+	var outcome map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &outcome); err != nil {
+		t.Fatal(err)
+	}
+	if outcome["resourceType"] != "OperationOutcome" || !strings.Contains(rec.Body.String(), "Patient.identifier") {
+		t.Errorf("body = %s, want an OperationOutcome about Patient.identifier", rec.Body)
+	}
+	if code, bundle := get(t, s, "/fhir/Patient"); code != http.StatusOK || bundle["total"] != float64(0) {
+		t.Errorf("rejected resource was stored: %v", bundle)
+	}
+
+	if rec := send(http.MethodPost, "/fhir/Patient", present); rec.Code != http.StatusCreated {
+		t.Errorf("create with identifier: status %d: %s", rec.Code, rec.Body)
+	}
+	if rec := send(http.MethodPut, "/fhir/Patient/p1", missing); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("update without identifier: status %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+	if rec := send(http.MethodPut, "/fhir/Patient/p1", present); rec.Code != http.StatusOK {
+		t.Errorf("update with identifier: status %d: %s", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/zs-health/zh-fhir-go/fhir"
+	"github.com/zs-health/zh-fhir-go/fhir/r4"
+	"github.com/zs-health/zh-fhir-go/fhir/r5"
+)
+
+// subsettedTag is the Meta.tag of a resource returned with some of its
+// elements left out.
+var subsettedTag = map[string]any{
+	"system":  "http://terminology.hl7.org/CodeSystem/v3-ObservationValue",
+	"code":    "SUBSETTED",
+	"display": "subsetted",
+}
+
+// subset holds the _summary and _elements parameters of a request.
+type subset struct {
+	summary  fhir.SummaryMode
+	count    bool
+	elements []string
+}
+
+// parseSubset reads the _summary and _elements parameters of a query.
+func parseSubset(query url.Values) (subset, error) {
+	var s subset
+	switch v := query.Get("_summary"); v {
+	case "", "false":
+		// _summary=false asks for the whole resource
+	case "true":
+		s.summary = fhir.SummaryModeTrue
+	case "text":
+		s.summary = fhir.SummaryModeText
+	case "data":
+		s.summary = fhir.SummaryModeData
+	case "count":
+		s.count = true
+	default:
+		return s, fmt.Errorf("unknown _summary value %q: expected true, text, data, count or false", v)
+	}
+
+	for _, v := range query["_elements"] {
+		for _, element := range strings.Split(v, ",") {
+			if element = strings.TrimSpace(element); element != "" {
+				s.elements = append(s.elements, element)
+			}
+		}
+	}
+	if len(s.elements) > 0 && s.summary != fhir.SummaryModeAll {
+		return s, fmt.Errorf("_elements can't be combined with _summary=%s", query.Get("_summary"))
+	}
+	return s, nil
+}
+
+// apply returns resource with only the requested elements, tagged
+// SUBSETTED. It filters the stored JSON itself, so elements the Go types
+// don't have are kept in it. Which elements are summary, mandatory or
+// choice elements comes from the R5 Go type of the resource, as the server
+// stores R5 resources, or the R4 one for a type R5 dropped; for a resource
+// type neither release has, apply returns the resource whole, with
+// an OperationOutcome warning saying that it couldn't be subsetted.
+func (s subset) apply(resource map[string]any) (map[string]any, *r5.OperationOutcome) {
+	if s.summary == fhir.SummaryModeAll && len(s.elements) == 0 {
+		return resource, nil
+	}
+
+	resourceType, _ := resource["resourceType"].(string)
+	t := resourceStructType(resourceType)
+	if t == nil {
+		return resource, notSubsetted(resourceType)
+	}
+	var filtered map[string]any
+	if len(s.elements) > 0 {
+		filtered = fhir.FilterElements(resource, t, s.elements)
+	} else {
+		filtered = fhir.FilterSummary(resource, t, s.summary)
+	}
+
+	// The filtered resource shares its values with the stored one
+	meta := make(map[string]any)
+	if m, ok := filtered["meta"].(map[string]any); ok {
+		maps.Copy(meta, m)
+	}
+	tags, _ := meta["tag"].([]any)
+	meta["tag"] = append(slices.Clone(tags), subsettedTag)
+	filtered["meta"] = meta
+	return filtered, nil
+}
+
+// resourceStructType returns the Go type of the R5 resource type with the
+// given name, or of the R4 one if R5 doesn't have it, or nil if neither
+// release has it.
+func resourceStructType(name string) reflect.Type {
+	if r, err := r5.NewResource(name); err == nil {
+		return reflect.TypeOf(r)
+	}
+	if r, err := r4.NewResource(name); err == nil {
+		return reflect.TypeOf(r)
+	}
+	return nil
+}
+
+// notSubsetted returns the warning for a resource of the given type that is
+// returned whole because its elements aren't known.
+func notSubsetted(resourceType string) *r5.OperationOutcome {
+	message := fmt.Sprintf("_summary and _elements aren't supported for %s, which isn't an R4 or R5 resource type; the whole resource is returned", resourceType)
+	oo := &r5.OperationOutcome{}
+	oo.BaseResource.ResourceType = r5.ResourceTypeOperationOutcome
+	oo.Issue = []r5.OperationOutcomeIssue{{
+		Severity: "warning",
+		Code:     "not-supported",
+		Details:  &r5.CodeableConcept{Text: &message},
+	}}
+	return oo
+}
@@ -6,36 +6,82 @@ import (
 	"strings"
 	"time"
 
+	"github.com/zs-health/zh-fhir-go/fhir/conformance"
 	"github.com/zs-health/zh-fhir-go/fhir/primitives"
 	"github.com/zs-health/zh-fhir-go/fhir/r4"
-	"github.com/zs-health/zh-fhir-go/internal/ig"
 )
 
 // TerminologyServer handles FHIR terminology operations
 type TerminologyServer struct {
-	loader *ig.Loader
+	registry *conformance.Registry
 }
 
-func NewTerminologyServer(loader *ig.Loader) *TerminologyServer {
+// NewTerminologyServer creates a terminology server that expands the
+// ValueSets and CodeSystems in reg.
+func NewTerminologyServer(reg *conformance.Registry) *TerminologyServer {
 	return &TerminologyServer{
-		loader: loader,
+		registry: reg,
 	}
 }
 
+// valueSet resolves a ValueSet canonical, url or url|version, to an R4
+// ValueSet. Other FHIR versions are converted through their JSON form.
+func (s *TerminologyServer) valueSet(canonical string) (*r4.ValueSet, bool) {
+	e, ok := s.registry.Resolve("ValueSet", canonical)
+	if !ok {
+		return nil, false
+	}
+	if vs, ok := e.Resource.(*r4.ValueSet); ok {
+		return vs, true
+	}
+	var vs r4.ValueSet
+	if err := convertJSON(e.Resource, &vs); err != nil {
+		return nil, false
+	}
+	return &vs, true
+}
+
+// codeSystem resolves a CodeSystem canonical to an R4 CodeSystem.
+func (s *TerminologyServer) codeSystem(canonical string) (*r4.CodeSystem, bool) {
+	e, ok := s.registry.Resolve("CodeSystem", canonical)
+	if !ok {
+		return nil, false
+	}
+	if cs, ok := e.Resource.(*r4.CodeSystem); ok {
+		return cs, true
+	}
+	var cs r4.CodeSystem
+	if err := convertJSON(e.Resource, &cs); err != nil {
+		return nil, false
+	}
+	return &cs, true
+}
+
+// convertJSON copies a resource into another Go type through its JSON form.
+func convertJSON(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
 // HandleExpand handles the ValueSet/$expand operation
 func (s *TerminologyServer) HandleExpand(w http.ResponseWriter, r *http.Request) {
 	url := r.URL.Query().Get("url")
 	filter := r.URL.Query().Get("filter")
 
-	vs, ok := s.loader.ValueSets[url]
+	vs, ok := s.valueSet(url)
 	if !ok {
 		// If not found in ValueSets, check if it's a CodeSystem and expand it fully
-		cs, ok := s.loader.CodeSystems[url]
+		cs, ok := s.codeSystem(url)
 		if !ok {
 			http.Error(w, "Terminology resource not found", http.StatusNotFound)
 			return
 		}
 		vs = s.expandCodeSystem(cs)
+	} else if vs.Expansion == nil && vs.Compose != nil {
+		vs = s.expandCompose(vs)
 	}
 
 	// Apply filter
@@ -72,6 +118,50 @@ func (s *TerminologyServer) expandCodeSystem(cs *r4.CodeSystem) *r4.ValueSet {
 	return vs
 }
 
+// expandCompose expands a ValueSet whose compose lists its concepts inline,
+// taking displays from the CodeSystem when the include omits them.
+func (s *TerminologyServer) expandCompose(vs *r4.ValueSet) *r4.ValueSet {
+	contains := make([]r4.ValueSetExpansionContains, 0)
+	for _, inc := range vs.Compose.Include {
+		var cs *r4.CodeSystem
+		if inc.System != nil {
+			canonical := *inc.System
+			if inc.Version != nil {
+				canonical += "|" + *inc.Version
+			}
+			cs, _ = s.codeSystem(canonical)
+		}
+		if len(inc.Concept) == 0 && cs != nil {
+			contains = append(contains, s.expandCodeSystem(cs).Expansion.Contains...)
+			continue
+		}
+		for _, c := range inc.Concept {
+			code := c.Code
+			display := c.Display
+			if display == nil && cs != nil {
+				for _, csc := range cs.Concept {
+					if csc.Code == code {
+						display = csc.Display
+						break
+					}
+				}
+			}
+			contains = append(contains, r4.ValueSetExpansionContains{
+				System:  inc.System,
+				Code:    &code,
+				Display: display,
+			})
+		}
+	}
+
+	newVS := *vs
+	newVS.Expansion = &r4.ValueSetExpansion{
+		Timestamp: primitives.FromTimeDateTime(time.Now()),
+		Contains:  contains,
+	}
+	return &newVS
+}
+
 func (s *TerminologyServer) filterValueSet(vs *r4.ValueSet, filter string) *r4.ValueSet {
 	if vs.Expansion == nil {
 		return vs
@@ -87,9 +177,9 @@ func (s *TerminologyServer) filterValueSet(vs *r4.ValueSet, filter string) *r4.V
 	}
 
 	newVS := *vs
-	newVS.Expansion = &r4.ValueSetExpansion{
-		Contains: newContains,
-	}
+	expansion := *vs.Expansion
+	expansion.Contains = newContains
+	newVS.Expansion = &expansion
 	return &newVS
 }
 